@@ -212,6 +212,11 @@ describe('protocolTranslations', () => {
       ['UNRECOGNIZED', IndexerOrder_ConditionType.UNRECOGNIZED, OrderType.LIMIT],
       ['STOP_LOSS', IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS, OrderType.STOP_LIMIT],
       ['TAKE_PROFIT', IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT, OrderType.TAKE_PROFIT],
+      [
+        'TRAILING_STOP',
+        IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP,
+        OrderType.TRAILING_STOP,
+      ],
     ])('successfully gets order type given protocol condition type: %s', (
       _name: string,
       conditionType: IndexerOrder_ConditionType,
@@ -240,7 +245,7 @@ describe('protocolTranslations', () => {
       [
         'TRAILING_STOP',
         OrderType.TRAILING_STOP,
-        IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
+        IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP,
       ],
       ['STOP_LIMIT', OrderType.STOP_LIMIT, IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS],
       ['STOP_MARKET', OrderType.STOP_MARKET, IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS],
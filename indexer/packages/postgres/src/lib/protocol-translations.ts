@@ -45,6 +45,7 @@ const CONDITION_TYPE_TO_ORDER_TYPE_MAP: Record<IndexerOrder_ConditionType, Order
   [IndexerOrder_ConditionType.UNRECOGNIZED]: OrderType.LIMIT,
   [IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS]: OrderType.STOP_LIMIT,
   [IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT]: OrderType.TAKE_PROFIT,
+  [IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP]: OrderType.TRAILING_STOP,
 };
 
 // Reverse mapping of above
@@ -60,13 +61,14 @@ const ORDER_TYPE_TO_CONDITION_TYPE_MAP: Record<OrderType, IndexerOrder_Condition
   [OrderType.TAKE_PROFIT]: IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT,
   [OrderType.TAKE_PROFIT_MARKET]: IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT,
 
+  [OrderType.TRAILING_STOP]: IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP,
+
   // TODO(IND-356): Remove irrelevant order types
   // Unused order types
   [OrderType.HARD_TRADE]: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
   [OrderType.FAILED_HARD_TRADE]: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
   [OrderType.MARKET]: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
   [OrderType.TRANSFER_PLACEHOLDER]: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
-  [OrderType.TRAILING_STOP]: IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED,
 };
 
 /**
@@ -332,9 +334,11 @@ export function protocolConditionTypeToOrderType(
 /**
  * Converts OrderType enum to protocol ConditionType.
  * Special cased:
- * - all unused types (not LIMIT / STOP-LIMIT/MARKET / TAKE-PROFIT (MARKET)) default to unspecified
+ * - all unused types (not LIMIT / STOP-LIMIT/MARKET / TAKE-PROFIT (MARKET) / TRAILING-STOP) default
+ *   to unspecified
  * - STOP_LIMIT and STOP_MARKET map to STOP_LOSS
  * - TAKE_PROFIT and TAKE_PROFIT_MARKET map to TAKE_PROFIT
+ * - TRAILING_STOP maps to TRAILING_STOP
  * @param orderType
  * @returns
  */
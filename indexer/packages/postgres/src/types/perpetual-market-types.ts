@@ -60,4 +60,5 @@ export enum PerpetualMarketStatus {
   CANCEL_ONLY = 'CANCEL_ONLY',
   POST_ONLY = 'POST_ONLY',
   INITIALIZING = 'INITIALIZING',
+  FINAL_SETTLEMENT = 'FINAL_SETTLEMENT',
 }
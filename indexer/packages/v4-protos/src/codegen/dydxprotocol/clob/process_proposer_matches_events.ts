@@ -12,6 +12,7 @@ import { DeepPartial } from "../../helpers";
  * - Stateful order IDs forcefully removed in the last block.
  * - Conditional order IDs triggered in the last block.
  * - Conditional order IDs placed, but not triggered in the last block.
+ * - Clob pair IDs moved to final settlement in the last block.
  * - The height of the block in which the events occurred.
  */
 
@@ -24,6 +25,7 @@ export interface ProcessProposerMatchesEvents {
   conditionalOrderIdsTriggeredInLastBlock: OrderId[];
   placedConditionalOrderIds: OrderId[];
   blockHeight: number;
+  finalSettlementClobPairIds: number[];
 }
 /**
  * ProcessProposerMatchesEvents is used for communicating which events occurred
@@ -36,6 +38,7 @@ export interface ProcessProposerMatchesEvents {
  * - Stateful order IDs forcefully removed in the last block.
  * - Conditional order IDs triggered in the last block.
  * - Conditional order IDs placed, but not triggered in the last block.
+ * - Clob pair IDs moved to final settlement in the last block.
  * - The height of the block in which the events occurred.
  */
 
@@ -48,6 +51,7 @@ export interface ProcessProposerMatchesEventsSDKType {
   conditional_order_ids_triggered_in_last_block: OrderIdSDKType[];
   placed_conditional_order_ids: OrderIdSDKType[];
   block_height: number;
+  final_settlement_clob_pair_ids: number[];
 }
 
 function createBaseProcessProposerMatchesEvents(): ProcessProposerMatchesEvents {
@@ -59,7 +63,8 @@ function createBaseProcessProposerMatchesEvents(): ProcessProposerMatchesEvents
     removedStatefulOrderIds: [],
     conditionalOrderIdsTriggeredInLastBlock: [],
     placedConditionalOrderIds: [],
-    blockHeight: 0
+    blockHeight: 0,
+    finalSettlementClobPairIds: []
   };
 }
 
@@ -97,6 +102,13 @@ export const ProcessProposerMatchesEvents = {
       writer.uint32(64).uint32(message.blockHeight);
     }
 
+    writer.uint32(74).fork();
+
+    for (const v of message.finalSettlementClobPairIds) {
+      writer.uint32(v);
+    }
+
+    writer.ldelim();
     return writer;
   },
 
@@ -141,6 +153,19 @@ export const ProcessProposerMatchesEvents = {
           message.blockHeight = reader.uint32();
           break;
 
+        case 9:
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+
+            while (reader.pos < end2) {
+              message.finalSettlementClobPairIds.push(reader.uint32());
+            }
+          } else {
+            message.finalSettlementClobPairIds.push(reader.uint32());
+          }
+
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -160,6 +185,7 @@ export const ProcessProposerMatchesEvents = {
     message.conditionalOrderIdsTriggeredInLastBlock = object.conditionalOrderIdsTriggeredInLastBlock?.map(e => OrderId.fromPartial(e)) || [];
     message.placedConditionalOrderIds = object.placedConditionalOrderIds?.map(e => OrderId.fromPartial(e)) || [];
     message.blockHeight = object.blockHeight ?? 0;
+    message.finalSettlementClobPairIds = object.finalSettlementClobPairIds?.map(e => e) || [];
     return message;
   }
 
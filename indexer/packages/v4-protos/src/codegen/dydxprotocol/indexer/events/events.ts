@@ -152,13 +152,21 @@ export interface FundingUpdateV1SDKType {
 
 export interface FundingEventV1 {
   /**
-   * updates is a list of per-market funding updates for all existing perpetual
-   * markets. The list is sorted by `perpetualId`s which are unique.
+   * updates is a list of per-market funding updates for all perpetual markets
+   * whose funding epoch started in this block. The list is sorted by
+   * `perpetualId`s which are unique.
    */
   updates: FundingUpdateV1[];
   /** type stores the type of funding updates. */
 
   type: FundingEventV1_Type;
+  /**
+   * interval_seconds is the duration in seconds of the epoch the updates were
+   * computed over, i.e. the `funding-tick` epoch for funding rates and the
+   * `funding-sample` epoch for premium samples.
+   */
+
+  intervalSeconds: number;
 }
 /**
  * FundingEvent message contains a list of per-market funding values. The
@@ -174,13 +182,21 @@ export interface FundingEventV1 {
 
 export interface FundingEventV1SDKType {
   /**
-   * updates is a list of per-market funding updates for all existing perpetual
-   * markets. The list is sorted by `perpetualId`s which are unique.
+   * updates is a list of per-market funding updates for all perpetual markets
+   * whose funding epoch started in this block. The list is sorted by
+   * `perpetualId`s which are unique.
    */
   updates: FundingUpdateV1SDKType[];
   /** type stores the type of funding updates. */
 
   type: FundingEventV1_TypeSDKType;
+  /**
+   * interval_seconds is the duration in seconds of the epoch the updates were
+   * computed over, i.e. the `funding-tick` epoch for funding rates and the
+   * `funding-sample` epoch for premium samples.
+   */
+
+  interval_seconds: number;
 }
 /**
  * MarketEvent message contains all the information about a market event on
@@ -597,8 +613,8 @@ export interface SubaccountUpdateEventV1SDKType {
 /**
  * StatefulOrderEvent message contains information about a change to a stateful
  * order. Currently, this is either the placement of a long-term order, the
- * placement or triggering of a conditional order, or the removal of a
- * stateful order.
+ * placement, triggering or trigger price update of a conditional order, or the
+ * removal of a stateful order.
  */
 
 export interface StatefulOrderEventV1 {
@@ -607,12 +623,13 @@ export interface StatefulOrderEventV1 {
   conditionalOrderPlacement?: StatefulOrderEventV1_ConditionalOrderPlacementV1;
   conditionalOrderTriggered?: StatefulOrderEventV1_ConditionalOrderTriggeredV1;
   longTermOrderPlacement?: StatefulOrderEventV1_LongTermOrderPlacementV1;
+  conditionalOrderTriggerUpdated?: StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1;
 }
 /**
  * StatefulOrderEvent message contains information about a change to a stateful
  * order. Currently, this is either the placement of a long-term order, the
- * placement or triggering of a conditional order, or the removal of a
- * stateful order.
+ * placement, triggering or trigger price update of a conditional order, or the
+ * removal of a stateful order.
  */
 
 export interface StatefulOrderEventV1SDKType {
@@ -621,6 +638,7 @@ export interface StatefulOrderEventV1SDKType {
   conditional_order_placement?: StatefulOrderEventV1_ConditionalOrderPlacementV1SDKType;
   conditional_order_triggered?: StatefulOrderEventV1_ConditionalOrderTriggeredV1SDKType;
   long_term_order_placement?: StatefulOrderEventV1_LongTermOrderPlacementV1SDKType;
+  conditional_order_trigger_updated?: StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1SDKType;
 }
 /** A stateful order placement contains an order. */
 
@@ -692,6 +710,30 @@ export interface StatefulOrderEventV1_LongTermOrderPlacementV1 {
 export interface StatefulOrderEventV1_LongTermOrderPlacementV1SDKType {
   order?: IndexerOrderSDKType;
 }
+/**
+ * A conditional order trigger update contains an order id and the new
+ * trigger price of the order. It is emitted when the trigger price of an
+ * untriggered trailing stop order moves with the oracle price.
+ */
+
+export interface StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 {
+  orderId?: IndexerOrderId;
+  /** The new trigger price of the order, in subticks. */
+
+  triggerSubticks: Long;
+}
+/**
+ * A conditional order trigger update contains an order id and the new
+ * trigger price of the order. It is emitted when the trigger price of an
+ * untriggered trailing stop order moves with the oracle price.
+ */
+
+export interface StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1SDKType {
+  order_id?: IndexerOrderIdSDKType;
+  /** The new trigger price of the order, in subticks. */
+
+  trigger_subticks: Long;
+}
 /**
  * AssetCreateEventV1 message contains all the information about an new Asset on
  * the dYdX chain.
@@ -1114,6 +1156,50 @@ export interface UpdatePerpetualEventV1SDKType {
 
   liquidity_tier: number;
 }
+/**
+ * PerpetualSettlementEventV1 message contains all the information about the
+ * final settlement of a delisted perpetual on the dYdX chain. It is emitted
+ * once all open positions in the perpetual were closed.
+ */
+
+export interface PerpetualSettlementEventV1 {
+  /**
+   * Unique Perpetual id.
+   * Defined in perpetuals.perpetual
+   */
+  perpetualId: number;
+  /**
+   * The price at which all open positions were closed, denominated in the
+   * exponent of the perpetual's market.
+   */
+
+  settlementPrice: Long;
+  /** The funding index of the perpetual after the final funding payment. */
+
+  fundingIndex: Uint8Array;
+}
+/**
+ * PerpetualSettlementEventV1 message contains all the information about the
+ * final settlement of a delisted perpetual on the dYdX chain. It is emitted
+ * once all open positions in the perpetual were closed.
+ */
+
+export interface PerpetualSettlementEventV1SDKType {
+  /**
+   * Unique Perpetual id.
+   * Defined in perpetuals.perpetual
+   */
+  perpetual_id: number;
+  /**
+   * The price at which all open positions were closed, denominated in the
+   * exponent of the perpetual's market.
+   */
+
+  settlement_price: Long;
+  /** The funding index of the perpetual after the final funding payment. */
+
+  funding_index: Uint8Array;
+}
 
 function createBaseFundingUpdateV1(): FundingUpdateV1 {
   return {
@@ -1183,7 +1269,8 @@ export const FundingUpdateV1 = {
 function createBaseFundingEventV1(): FundingEventV1 {
   return {
     updates: [],
-    type: 0
+    type: 0,
+    intervalSeconds: 0
   };
 }
 
@@ -1197,6 +1284,10 @@ export const FundingEventV1 = {
       writer.uint32(16).int32(message.type);
     }
 
+    if (message.intervalSeconds !== 0) {
+      writer.uint32(24).uint32(message.intervalSeconds);
+    }
+
     return writer;
   },
 
@@ -1217,6 +1308,10 @@ export const FundingEventV1 = {
           message.type = (reader.int32() as any);
           break;
 
+        case 3:
+          message.intervalSeconds = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -1230,6 +1325,7 @@ export const FundingEventV1 = {
     const message = createBaseFundingEventV1();
     message.updates = object.updates?.map(e => FundingUpdateV1.fromPartial(e)) || [];
     message.type = object.type ?? 0;
+    message.intervalSeconds = object.intervalSeconds ?? 0;
     return message;
   }
 
@@ -2036,7 +2132,8 @@ function createBaseStatefulOrderEventV1(): StatefulOrderEventV1 {
     orderRemoval: undefined,
     conditionalOrderPlacement: undefined,
     conditionalOrderTriggered: undefined,
-    longTermOrderPlacement: undefined
+    longTermOrderPlacement: undefined,
+    conditionalOrderTriggerUpdated: undefined
   };
 }
 
@@ -2062,6 +2159,10 @@ export const StatefulOrderEventV1 = {
       StatefulOrderEventV1_LongTermOrderPlacementV1.encode(message.longTermOrderPlacement, writer.uint32(58).fork()).ldelim();
     }
 
+    if (message.conditionalOrderTriggerUpdated !== undefined) {
+      StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.encode(message.conditionalOrderTriggerUpdated, writer.uint32(66).fork()).ldelim();
+    }
+
     return writer;
   },
 
@@ -2094,6 +2195,10 @@ export const StatefulOrderEventV1 = {
           message.longTermOrderPlacement = StatefulOrderEventV1_LongTermOrderPlacementV1.decode(reader, reader.uint32());
           break;
 
+        case 8:
+          message.conditionalOrderTriggerUpdated = StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.decode(reader, reader.uint32());
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -2110,6 +2215,7 @@ export const StatefulOrderEventV1 = {
     message.conditionalOrderPlacement = object.conditionalOrderPlacement !== undefined && object.conditionalOrderPlacement !== null ? StatefulOrderEventV1_ConditionalOrderPlacementV1.fromPartial(object.conditionalOrderPlacement) : undefined;
     message.conditionalOrderTriggered = object.conditionalOrderTriggered !== undefined && object.conditionalOrderTriggered !== null ? StatefulOrderEventV1_ConditionalOrderTriggeredV1.fromPartial(object.conditionalOrderTriggered) : undefined;
     message.longTermOrderPlacement = object.longTermOrderPlacement !== undefined && object.longTermOrderPlacement !== null ? StatefulOrderEventV1_LongTermOrderPlacementV1.fromPartial(object.longTermOrderPlacement) : undefined;
+    message.conditionalOrderTriggerUpdated = object.conditionalOrderTriggerUpdated !== undefined && object.conditionalOrderTriggerUpdated !== null ? StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.fromPartial(object.conditionalOrderTriggerUpdated) : undefined;
     return message;
   }
 
@@ -2350,6 +2456,61 @@ export const StatefulOrderEventV1_LongTermOrderPlacementV1 = {
 
 };
 
+function createBaseStatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1(): StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 {
+  return {
+    orderId: undefined,
+    triggerSubticks: Long.UZERO
+  };
+}
+
+export const StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 = {
+  encode(message: StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.orderId !== undefined) {
+      IndexerOrderId.encode(message.orderId, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (!message.triggerSubticks.isZero()) {
+      writer.uint32(16).uint64(message.triggerSubticks);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.orderId = IndexerOrderId.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.triggerSubticks = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1>): StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 {
+    const message = createBaseStatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1();
+    message.orderId = object.orderId !== undefined && object.orderId !== null ? IndexerOrderId.fromPartial(object.orderId) : undefined;
+    message.triggerSubticks = object.triggerSubticks !== undefined && object.triggerSubticks !== null ? Long.fromValue(object.triggerSubticks) : Long.UZERO;
+    return message;
+  }
+
+};
+
 function createBaseAssetCreateEventV1(): AssetCreateEventV1 {
   return {
     id: 0,
@@ -2823,4 +2984,69 @@ export const UpdatePerpetualEventV1 = {
     return message;
   }
 
+};
+
+function createBasePerpetualSettlementEventV1(): PerpetualSettlementEventV1 {
+  return {
+    perpetualId: 0,
+    settlementPrice: Long.UZERO,
+    fundingIndex: new Uint8Array()
+  };
+}
+
+export const PerpetualSettlementEventV1 = {
+  encode(message: PerpetualSettlementEventV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (!message.settlementPrice.isZero()) {
+      writer.uint32(16).uint64(message.settlementPrice);
+    }
+
+    if (message.fundingIndex.length !== 0) {
+      writer.uint32(26).bytes(message.fundingIndex);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PerpetualSettlementEventV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePerpetualSettlementEventV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.settlementPrice = (reader.uint64() as Long);
+          break;
+
+        case 3:
+          message.fundingIndex = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<PerpetualSettlementEventV1>): PerpetualSettlementEventV1 {
+    const message = createBasePerpetualSettlementEventV1();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.settlementPrice = object.settlementPrice !== undefined && object.settlementPrice !== null ? Long.fromValue(object.settlementPrice) : Long.UZERO;
+    message.fundingIndex = object.fundingIndex ?? new Uint8Array();
+    return message;
+  }
+
 };
\ No newline at end of file
@@ -208,6 +208,15 @@ export enum IndexerOrder_ConditionType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a fixed trailing distance as the oracle
+   * price moves in the order's favor: down for buys and up for sells. The
+   * trigger price never moves against the order.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export enum IndexerOrder_ConditionTypeSDKType {
@@ -230,6 +239,15 @@ export enum IndexerOrder_ConditionTypeSDKType {
    * price for buys and at or above the trigger price for sells.
    */
   CONDITION_TYPE_TAKE_PROFIT = 2,
+
+  /**
+   * CONDITION_TYPE_TRAILING_STOP - CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+   * trailing stop order triggers like a stop order, but its trigger price
+   * follows the oracle price at a fixed trailing distance as the oracle
+   * price moves in the order's favor: down for buys and up for sells. The
+   * trigger price never moves against the order.
+   */
+  CONDITION_TYPE_TRAILING_STOP = 3,
   UNRECOGNIZED = -1,
 }
 export function indexerOrder_ConditionTypeFromJSON(object: any): IndexerOrder_ConditionType {
@@ -246,6 +264,10 @@ export function indexerOrder_ConditionTypeFromJSON(object: any): IndexerOrder_Co
     case "CONDITION_TYPE_TAKE_PROFIT":
       return IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT;
 
+    case 3:
+    case "CONDITION_TYPE_TRAILING_STOP":
+      return IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -263,6 +285,9 @@ export function indexerOrder_ConditionTypeToJSON(object: IndexerOrder_ConditionT
     case IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT:
       return "CONDITION_TYPE_TAKE_PROFIT";
 
+    case IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP:
+      return "CONDITION_TYPE_TRAILING_STOP";
+
     case IndexerOrder_ConditionType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -307,6 +332,13 @@ export enum ClobPairStatus {
    * both short-term and post-only.
    */
   CLOB_PAIR_STATUS_INITIALIZING = 5,
+
+  /**
+   * CLOB_PAIR_STATUS_FINAL_SETTLEMENT - CLOB_PAIR_STATUS_FINAL_SETTLEMENT represents a clob pair which was
+   * delisted. All open positions in its perpetual were closed at the
+   * settlement price, and clob pairs in this state don't accept any orders.
+   */
+  CLOB_PAIR_STATUS_FINAL_SETTLEMENT = 6,
   UNRECOGNIZED = -1,
 }
 /**
@@ -348,6 +380,13 @@ export enum ClobPairStatusSDKType {
    * both short-term and post-only.
    */
   CLOB_PAIR_STATUS_INITIALIZING = 5,
+
+  /**
+   * CLOB_PAIR_STATUS_FINAL_SETTLEMENT - CLOB_PAIR_STATUS_FINAL_SETTLEMENT represents a clob pair which was
+   * delisted. All open positions in its perpetual were closed at the
+   * settlement price, and clob pairs in this state don't accept any orders.
+   */
+  CLOB_PAIR_STATUS_FINAL_SETTLEMENT = 6,
   UNRECOGNIZED = -1,
 }
 export function clobPairStatusFromJSON(object: any): ClobPairStatus {
@@ -376,6 +415,10 @@ export function clobPairStatusFromJSON(object: any): ClobPairStatus {
     case "CLOB_PAIR_STATUS_INITIALIZING":
       return ClobPairStatus.CLOB_PAIR_STATUS_INITIALIZING;
 
+    case 6:
+    case "CLOB_PAIR_STATUS_FINAL_SETTLEMENT":
+      return ClobPairStatus.CLOB_PAIR_STATUS_FINAL_SETTLEMENT;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -402,6 +445,9 @@ export function clobPairStatusToJSON(object: ClobPairStatus): string {
     case ClobPairStatus.CLOB_PAIR_STATUS_INITIALIZING:
       return "CLOB_PAIR_STATUS_INITIALIZING";
 
+    case ClobPairStatus.CLOB_PAIR_STATUS_FINAL_SETTLEMENT:
+      return "CLOB_PAIR_STATUS_FINAL_SETTLEMENT";
+
     case ClobPairStatus.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -554,6 +600,22 @@ export interface IndexerOrder {
    */
 
   conditionalOrderTriggerSubticks: Long;
+  /**
+   * conditional_order_trailing_ppm represents the trailing distance of a
+   * trailing stop order as parts-per-million of the oracle price. Exactly one
+   * of conditional_order_trailing_ppm and conditional_order_trailing_subticks
+   * must be nonzero if the condition_type is CONDITION_TYPE_TRAILING_STOP, and
+   * both are enforced to be 0 otherwise. Must be less than 1,000,000.
+   */
+
+  conditionalOrderTrailingPpm: number;
+  /**
+   * conditional_order_trailing_subticks represents the trailing distance of a
+   * trailing stop order in subticks. Must be a multiple of
+   * ClobPair.SubticksPerTick (where `ClobPair.Id = orderId.ClobPairId`).
+   */
+
+  conditionalOrderTrailingSubticks: Long;
 }
 /**
  * IndexerOrderV1 represents a single order belonging to a `Subaccount`
@@ -624,6 +686,22 @@ export interface IndexerOrderSDKType {
    */
 
   conditional_order_trigger_subticks: Long;
+  /**
+   * conditional_order_trailing_ppm represents the trailing distance of a
+   * trailing stop order as parts-per-million of the oracle price. Exactly one
+   * of conditional_order_trailing_ppm and conditional_order_trailing_subticks
+   * must be nonzero if the condition_type is CONDITION_TYPE_TRAILING_STOP, and
+   * both are enforced to be 0 otherwise. Must be less than 1,000,000.
+   */
+
+  conditional_order_trailing_ppm: number;
+  /**
+   * conditional_order_trailing_subticks represents the trailing distance of a
+   * trailing stop order in subticks. Must be a multiple of
+   * ClobPair.SubticksPerTick (where `ClobPair.Id = orderId.ClobPairId`).
+   */
+
+  conditional_order_trailing_subticks: Long;
 }
 
 function createBaseIndexerOrderId(): IndexerOrderId {
@@ -713,7 +791,9 @@ function createBaseIndexerOrder(): IndexerOrder {
     reduceOnly: false,
     clientMetadata: 0,
     conditionType: 0,
-    conditionalOrderTriggerSubticks: Long.UZERO
+    conditionalOrderTriggerSubticks: Long.UZERO,
+    conditionalOrderTrailingPpm: 0,
+    conditionalOrderTrailingSubticks: Long.UZERO
   };
 }
 
@@ -763,6 +843,14 @@ export const IndexerOrder = {
       writer.uint32(88).uint64(message.conditionalOrderTriggerSubticks);
     }
 
+    if (message.conditionalOrderTrailingPpm !== 0) {
+      writer.uint32(96).uint32(message.conditionalOrderTrailingPpm);
+    }
+
+    if (!message.conditionalOrderTrailingSubticks.isZero()) {
+      writer.uint32(104).uint64(message.conditionalOrderTrailingSubticks);
+    }
+
     return writer;
   },
 
@@ -819,6 +907,14 @@ export const IndexerOrder = {
           message.conditionalOrderTriggerSubticks = (reader.uint64() as Long);
           break;
 
+        case 12:
+          message.conditionalOrderTrailingPpm = reader.uint32();
+          break;
+
+        case 13:
+          message.conditionalOrderTrailingSubticks = (reader.uint64() as Long);
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -841,6 +937,8 @@ export const IndexerOrder = {
     message.clientMetadata = object.clientMetadata ?? 0;
     message.conditionType = object.conditionType ?? 0;
     message.conditionalOrderTriggerSubticks = object.conditionalOrderTriggerSubticks !== undefined && object.conditionalOrderTriggerSubticks !== null ? Long.fromValue(object.conditionalOrderTriggerSubticks) : Long.UZERO;
+    message.conditionalOrderTrailingPpm = object.conditionalOrderTrailingPpm ?? 0;
+    message.conditionalOrderTrailingSubticks = object.conditionalOrderTrailingSubticks !== undefined && object.conditionalOrderTrailingSubticks !== null ? Long.fromValue(object.conditionalOrderTrailingSubticks) : Long.UZERO;
     return message;
   }
 
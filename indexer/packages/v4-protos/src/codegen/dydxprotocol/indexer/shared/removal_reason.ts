@@ -75,6 +75,18 @@ export enum OrderRemovalReason {
    * equity tier requirements.
    */
   ORDER_REMOVAL_REASON_EQUITY_TIER = 13,
+
+  /**
+   * ORDER_REMOVAL_REASON_ORDER_GROUP - The order has been removed since another order of its order group was
+   * triggered, fully filled, canceled or removed.
+   */
+  ORDER_REMOVAL_REASON_ORDER_GROUP = 14,
+
+  /**
+   * ORDER_REMOVAL_REASON_FINAL_SETTLEMENT - The order has been removed since its clob pair was delisted and moved to
+   * final settlement.
+   */
+  ORDER_REMOVAL_REASON_FINAL_SETTLEMENT = 15,
   UNRECOGNIZED = -1,
 }
 /** OrderRemovalReason is an enum of all the reasons an order was removed. */
@@ -155,6 +167,18 @@ export enum OrderRemovalReasonSDKType {
    * equity tier requirements.
    */
   ORDER_REMOVAL_REASON_EQUITY_TIER = 13,
+
+  /**
+   * ORDER_REMOVAL_REASON_ORDER_GROUP - The order has been removed since another order of its order group was
+   * triggered, fully filled, canceled or removed.
+   */
+  ORDER_REMOVAL_REASON_ORDER_GROUP = 14,
+
+  /**
+   * ORDER_REMOVAL_REASON_FINAL_SETTLEMENT - The order has been removed since its clob pair was delisted and moved to
+   * final settlement.
+   */
+  ORDER_REMOVAL_REASON_FINAL_SETTLEMENT = 15,
   UNRECOGNIZED = -1,
 }
 export function orderRemovalReasonFromJSON(object: any): OrderRemovalReason {
@@ -215,6 +239,14 @@ export function orderRemovalReasonFromJSON(object: any): OrderRemovalReason {
     case "ORDER_REMOVAL_REASON_EQUITY_TIER":
       return OrderRemovalReason.ORDER_REMOVAL_REASON_EQUITY_TIER;
 
+    case 14:
+    case "ORDER_REMOVAL_REASON_ORDER_GROUP":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_ORDER_GROUP;
+
+    case 15:
+    case "ORDER_REMOVAL_REASON_FINAL_SETTLEMENT":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_FINAL_SETTLEMENT;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -265,6 +297,12 @@ export function orderRemovalReasonToJSON(object: OrderRemovalReason): string {
     case OrderRemovalReason.ORDER_REMOVAL_REASON_EQUITY_TIER:
       return "ORDER_REMOVAL_REASON_EQUITY_TIER";
 
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_ORDER_GROUP:
+      return "ORDER_REMOVAL_REASON_ORDER_GROUP";
+
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_FINAL_SETTLEMENT:
+      return "ORDER_REMOVAL_REASON_FINAL_SETTLEMENT";
+
     case OrderRemovalReason.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
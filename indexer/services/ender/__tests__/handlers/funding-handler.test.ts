@@ -125,9 +125,28 @@ describe('fundingHandler', () => {
     );
   });
 
+  it('successfully processes single premium sample event with version 1', async () => {
+    const kafkaMessage: KafkaMessage = createKafkaMessageFromFundingEvents({
+      fundingEvents: [{ ...defaultFundingUpdateSampleEvent, intervalSeconds: 0 }],
+      height: defaultHeight,
+      time: defaultTime,
+      version: 1,
+    });
+
+    await onMessage(kafkaMessage);
+
+    await expectNextFundingRate(
+      'BTC-USD',
+      new Big(protocolTranslations.funding8HourValuePpmTo1HourRate(
+        defaultFundingUpdateSampleEvent.updates[0].fundingValuePpm,
+      )),
+    );
+  });
+
   it('successfully processes multiple premium sample event for different markets', async () => {
     const fundingUpdateSampleEvent2: FundingEventV1 = {
       type: FundingEventV1_Type.TYPE_PREMIUM_SAMPLE,
+      intervalSeconds: 60,
       updates: [
         {
           perpetualId: 0,
@@ -204,6 +223,7 @@ describe('fundingHandler', () => {
   it('successfully processes and clears cache for multiple new funding rates', async () => {
     const fundingSampleEvent: FundingEventV1 = {
       type: FundingEventV1_Type.TYPE_PREMIUM_SAMPLE,
+      intervalSeconds: 60,
       updates: [
         {
           perpetualId: 0,
@@ -242,6 +262,7 @@ describe('fundingHandler', () => {
 
     const fundingRateEvent: FundingEventMessage = {
       type: FundingEventV1_Type.TYPE_FUNDING_RATE_AND_INDEX,
+      intervalSeconds: 3600,
       updates: [
         {
           perpetualId: 0,
@@ -303,10 +324,12 @@ function createKafkaMessageFromFundingEvents({
   fundingEvents,
   height,
   time,
+  version = 2,
 }: {
   fundingEvents: FundingEventV1[],
   height: number,
   time: Timestamp,
+  version?: number,
 }) {
   const events: IndexerTendermintEvent[] = [];
   let eventIndex: number = 0;
@@ -318,6 +341,7 @@ function createKafkaMessageFromFundingEvents({
         FundingEventV1.encode(fundingEvent).finish(),
         transactionIndex,
         eventIndex,
+        version,
       ),
     );
     eventIndex += 1;
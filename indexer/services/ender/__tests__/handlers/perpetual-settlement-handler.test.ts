@@ -0,0 +1,150 @@
+import {
+  PerpetualMarketFromDatabase,
+  PerpetualMarketStatus,
+  PerpetualMarketTable,
+  dbHelpers,
+  liquidityTierRefresher,
+  perpetualMarketRefresher,
+  testMocks,
+} from '@dydxprotocol-indexer/postgres';
+import { updateBlockCache } from '../../src/caches/block-cache';
+import {
+  defaultHeight,
+  defaultPerpetualSettlementEvent,
+  defaultPreviousHeight,
+  defaultTime,
+  defaultTxHash,
+} from '../helpers/constants';
+import {
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+  PerpetualSettlementEventV1,
+  Timestamp,
+} from '@dydxprotocol-indexer/v4-protos';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+  expectPerpetualMarketKafkaMessage,
+} from '../helpers/indexer-proto-helpers';
+import { DydxIndexerSubtypes } from '../../src/lib/types';
+import { PerpetualSettlementHandler } from '../../src/handlers/perpetual-settlement-handler';
+import { createKafkaMessage, producer } from '@dydxprotocol-indexer/kafka';
+import { KafkaMessage } from 'kafkajs';
+import { onMessage } from '../../src/lib/on-message';
+import { createPostgresFunctions } from '../../src/helpers/postgres/postgres-functions';
+
+describe('perpetual-settlement-handler', () => {
+  beforeAll(async () => {
+    await dbHelpers.migrate();
+    await createPostgresFunctions();
+  });
+
+  beforeEach(async () => {
+    await testMocks.seedData();
+    updateBlockCache(defaultPreviousHeight);
+    await perpetualMarketRefresher.updatePerpetualMarkets();
+    await liquidityTierRefresher.updateLiquidityTiers();
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    jest.clearAllMocks();
+    perpetualMarketRefresher.clear();
+    liquidityTierRefresher.clear();
+  });
+
+  afterAll(async () => {
+    await dbHelpers.teardown();
+    jest.resetAllMocks();
+  });
+
+  describe('getParallelizationIds', () => {
+    it('returns the correct parallelization ids', () => {
+      const transactionIndex: number = 0;
+      const eventIndex: number = 0;
+
+      const indexerTendermintEvent: IndexerTendermintEvent = createIndexerTendermintEvent(
+        DydxIndexerSubtypes.PERPETUAL_SETTLEMENT,
+        PerpetualSettlementEventV1.encode(defaultPerpetualSettlementEvent).finish(),
+        transactionIndex,
+        eventIndex,
+      );
+      const block: IndexerTendermintBlock = createIndexerTendermintBlock(
+        0,
+        defaultTime,
+        [indexerTendermintEvent],
+        [defaultTxHash],
+      );
+
+      const handler: PerpetualSettlementHandler = new PerpetualSettlementHandler(
+        block,
+        indexerTendermintEvent,
+        0,
+        defaultPerpetualSettlementEvent,
+      );
+
+      expect(handler.getParallelizationIds()).toEqual([]);
+    });
+  });
+
+  it('marks the perpetual market as final settled', async () => {
+    const transactionIndex: number = 0;
+    const kafkaMessage: KafkaMessage = createKafkaMessageFromPerpetualSettlementEvent({
+      perpetualSettlementEvent: defaultPerpetualSettlementEvent,
+      transactionIndex,
+      height: defaultHeight,
+      time: defaultTime,
+      txHash: defaultTxHash,
+    });
+    const producerSendMock: jest.SpyInstance = jest.spyOn(producer, 'send');
+    await onMessage(kafkaMessage);
+
+    const perpetualMarket:
+    PerpetualMarketFromDatabase | undefined = await PerpetualMarketTable.findById(
+      defaultPerpetualSettlementEvent.perpetualId.toString(),
+    );
+    expect(perpetualMarket).toEqual(expect.objectContaining({
+      id: defaultPerpetualSettlementEvent.perpetualId.toString(),
+      status: PerpetualMarketStatus.FINAL_SETTLEMENT,
+      openInterest: '0',
+    }));
+    expect(perpetualMarket).toEqual(
+      perpetualMarketRefresher.getPerpetualMarketFromId(
+        defaultPerpetualSettlementEvent.perpetualId.toString()));
+    expectPerpetualMarketKafkaMessage(producerSendMock, [perpetualMarket!]);
+  });
+});
+
+function createKafkaMessageFromPerpetualSettlementEvent({
+  perpetualSettlementEvent,
+  transactionIndex,
+  height,
+  time,
+  txHash,
+}: {
+  perpetualSettlementEvent: PerpetualSettlementEventV1,
+  transactionIndex: number,
+  height: number,
+  time: Timestamp,
+  txHash: string,
+}) {
+  const events: IndexerTendermintEvent[] = [];
+  events.push(
+    createIndexerTendermintEvent(
+      DydxIndexerSubtypes.PERPETUAL_SETTLEMENT,
+      PerpetualSettlementEventV1.encode(perpetualSettlementEvent).finish(),
+      transactionIndex,
+      0,
+    ),
+  );
+
+  const block: IndexerTendermintBlock = createIndexerTendermintBlock(
+    height,
+    time,
+    events,
+    [txHash],
+  );
+
+  const binaryBlock: Uint8Array = IndexerTendermintBlock.encode(block).finish();
+  return createKafkaMessage(Buffer.from(binaryBlock));
+}
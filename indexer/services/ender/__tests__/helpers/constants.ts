@@ -24,6 +24,7 @@ import {
   OrderFillEventV1,
   OrderRemovalReason,
   PerpetualMarketCreateEventV1,
+  PerpetualSettlementEventV1,
   StatefulOrderEventV1,
   SubaccountMessage,
   SubaccountUpdateEventV1,
@@ -62,6 +63,7 @@ export const defaultMarketPriceUpdate2: MarketEventV1 = {
 
 export const defaultFundingUpdateSampleEvent: FundingEventMessage = {
   type: FundingEventV1_Type.TYPE_PREMIUM_SAMPLE,
+  intervalSeconds: 60,
   updates: [
     {
       perpetualId: 0,
@@ -73,6 +75,7 @@ export const defaultFundingUpdateSampleEvent: FundingEventMessage = {
 
 export const defaultFundingRateEvent: FundingEventMessage = {
   type: FundingEventV1_Type.TYPE_FUNDING_RATE_AND_INDEX,
+  intervalSeconds: 3600,
   updates: [
     {
       perpetualId: 0,
@@ -139,6 +142,12 @@ export const defaultUpdatePerpetualEvent: UpdatePerpetualEventV1 = {
   liquidityTier: 1,
 };
 
+export const defaultPerpetualSettlementEvent: PerpetualSettlementEventV1 = {
+  perpetualId: 0,
+  settlementPrice: Long.fromValue(500_000_000, true),
+  fundingIndex: bigIntToBytes(BigInt(0)),
+};
+
 export const defaultUpdateClobPairEvent: UpdateClobPairEventV1 = {
   clobPairId: 1,
   status: ClobPairStatus.CLOB_PAIR_STATUS_ACTIVE,
@@ -374,6 +383,15 @@ export const defaultConditionalOrderTriggeredEvent: StatefulOrderEventV1 = {
     },
   },
 };
+export const defaultConditionalOrderTriggerUpdatedEvent: StatefulOrderEventV1 = {
+  conditionalOrderTriggerUpdated: {
+    orderId: {
+      ...defaultOrderId,
+      orderFlags: ORDER_FLAG_CONDITIONAL,
+    },
+    triggerSubticks: Long.fromValue(2000000, true),
+  },
+};
 export const defaultLongTermOrderPlacementEvent: StatefulOrderEventV1 = {
   longTermOrderPlacement: {
     order: {
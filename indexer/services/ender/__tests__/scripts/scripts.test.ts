@@ -167,6 +167,7 @@ describe('SQL Function Tests', () => {
     ['LIMIT', IndexerOrder_ConditionType.CONDITION_TYPE_UNSPECIFIED],
     ['TAKE_PROFIT', IndexerOrder_ConditionType.CONDITION_TYPE_TAKE_PROFIT],
     ['STOP_LIMIT', IndexerOrder_ConditionType.CONDITION_TYPE_STOP_LOSS],
+    ['TRAILING_STOP', IndexerOrder_ConditionType.CONDITION_TYPE_TRAILING_STOP],
   ])('dydx_protocol_condition_type_to_order_type (%s)', async (_name: string, value: IndexerOrder_ConditionType) => {
     const result = await getSingleRawQueryResultRow(`SELECT dydx_protocol_condition_type_to_order_type('${value}') AS result`);
     expect(result).toEqual(protocolTranslations.protocolConditionTypeToOrderType(value));
@@ -54,6 +54,7 @@ describe('funding-validator', () => {
         'does not specify valid type',
         {
           type: FundingEventV1_Type.TYPE_UNSPECIFIED,
+          intervalSeconds: 3600,
           updates: [
             {
               perpetualId: 0,
@@ -69,6 +70,7 @@ describe('funding-validator', () => {
         'perpetual market does not exist',
         {
           type: FundingEventV1_Type.TYPE_FUNDING_RATE_AND_INDEX,
+          intervalSeconds: 3600,
           updates: [
             {
               perpetualId: 10,
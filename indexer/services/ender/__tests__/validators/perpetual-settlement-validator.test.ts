@@ -0,0 +1,105 @@
+import { logger, ParseMessageError } from '@dydxprotocol-indexer/base';
+import {
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+  PerpetualSettlementEventV1,
+} from '@dydxprotocol-indexer/v4-protos';
+import {
+  dbHelpers, testMocks, perpetualMarketRefresher,
+} from '@dydxprotocol-indexer/postgres';
+import Long from 'long';
+import { DydxIndexerSubtypes } from '../../src/lib/types';
+import {
+  defaultPerpetualSettlementEvent,
+  defaultHeight,
+  defaultTime,
+  defaultTxHash,
+} from '../helpers/constants';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+} from '../helpers/indexer-proto-helpers';
+import { expectDidntLogError } from '../helpers/validator-helpers';
+import { PerpetualSettlementValidator } from '../../src/validators/perpetual-settlement-validator';
+
+describe('perpetual-settlement-validator', () => {
+  beforeAll(async () => {
+    await dbHelpers.migrate();
+  });
+
+  beforeEach(async () => {
+    await testMocks.seedData();
+    await perpetualMarketRefresher.updatePerpetualMarkets();
+    jest.spyOn(logger, 'error');
+  });
+
+  afterEach(async () => {
+    await dbHelpers.clearData();
+    await perpetualMarketRefresher.clear();
+    jest.clearAllMocks();
+  });
+
+  afterAll(async () => {
+    await dbHelpers.teardown();
+  });
+
+  describe('validate', () => {
+    it('does not throw error on valid perpetual settlement event', () => {
+      const validator: PerpetualSettlementValidator = new PerpetualSettlementValidator(
+        defaultPerpetualSettlementEvent,
+        createBlock(defaultPerpetualSettlementEvent),
+      );
+
+      validator.validate();
+      expectDidntLogError();
+    });
+
+    it.each([
+      [
+        'perpetualId does not correspond to an existing perpetual market',
+        {
+          ...defaultPerpetualSettlementEvent,
+          perpetualId: 20,
+        },
+        'PerpetualSettlementEvent.perpetualId must correspond with an existing perpetual_market.id',
+      ],
+      [
+        'settlementPrice is zero',
+        {
+          ...defaultPerpetualSettlementEvent,
+          settlementPrice: Long.UZERO,
+        },
+        'PerpetualSettlementEvent.settlementPrice must be positive',
+      ],
+    ])('throws error if %s', (
+      _message: string,
+      event: PerpetualSettlementEventV1,
+      expectedError: string,
+    ) => {
+      const validator: PerpetualSettlementValidator = new PerpetualSettlementValidator(
+        event,
+        createBlock(event),
+      );
+
+      expect(() => validator.validate()).toThrow(new ParseMessageError(expectedError));
+    });
+  });
+});
+
+function createBlock(
+  perpetualSettlementEvent: PerpetualSettlementEventV1,
+): IndexerTendermintBlock {
+  const event: IndexerTendermintEvent = createIndexerTendermintEvent(
+    DydxIndexerSubtypes.PERPETUAL_SETTLEMENT,
+    PerpetualSettlementEventV1.encode(perpetualSettlementEvent).finish(),
+    0,
+    0,
+  );
+
+  return createIndexerTendermintBlock(
+    defaultHeight,
+    defaultTime,
+    [event],
+    [defaultTxHash],
+  );
+}
@@ -12,6 +12,7 @@ import { StatefulOrderValidator } from '../../src/validators/stateful-order-vali
 import {
   defaultConditionalOrderPlacementEvent,
   defaultConditionalOrderTriggeredEvent,
+  defaultConditionalOrderTriggerUpdatedEvent,
   defaultHeight,
   defaultLongTermOrderPlacementEvent,
   defaultMakerOrder,
@@ -42,6 +43,7 @@ describe('stateful-order-validator', () => {
       ['conditional order placement', defaultConditionalOrderPlacementEvent],
       ['conditional order triggered', defaultConditionalOrderTriggeredEvent],
       ['long term order placement', defaultLongTermOrderPlacementEvent],
+      ['conditional order trigger updated', defaultConditionalOrderTriggerUpdatedEvent],
     ])('does not throw error on valid %s', (_message: string, event: StatefulOrderEventV1) => {
       const validator: StatefulOrderValidator = new StatefulOrderValidator(
         event,
@@ -58,7 +60,8 @@ describe('stateful-order-validator', () => {
         'does not contain any event',
         {},
         'One of orderPlace, orderRemoval, conditionalOrderPlacement, ' +
-        'conditionalOrderTriggered, longTermOrderPlacement must be defined in StatefulOrderEvent',
+        'conditionalOrderTriggered, longTermOrderPlacement, conditionalOrderTriggerUpdated ' +
+        'must be defined in StatefulOrderEvent',
       ],
 
       // TODO(IND-334): Remove tests after deprecating StatefulOrderPlacement events
@@ -338,6 +341,45 @@ describe('stateful-order-validator', () => {
         `StatefulOrderEvent conditional order triggered must have order flag ${ORDER_FLAG_CONDITIONAL}`,
       ],
 
+      // Conditional Order Trigger Updated Validations
+      [
+        'conditional order trigger updated does not contain orderId',
+        {
+          conditionalOrderTriggerUpdated: {
+            orderId: undefined,
+            triggerSubticks: Long.fromValue(2000000, true),
+          },
+        },
+        'StatefulOrderEvent conditional order trigger updated must contain an orderId',
+      ],
+      [
+        'conditional order trigger updated does not contain the correct order flag',
+        {
+          conditionalOrderTriggerUpdated: {
+            orderId: {
+              ...defaultOrderId,
+              orderFlags: ORDER_FLAG_SHORT_TERM,
+            },
+            triggerSubticks: Long.fromValue(2000000, true),
+          },
+        },
+        'StatefulOrderEvent conditional order trigger updated must have order flag ' +
+        `${ORDER_FLAG_CONDITIONAL}`,
+      ],
+      [
+        'conditional order trigger updated does not contain a trigger price',
+        {
+          conditionalOrderTriggerUpdated: {
+            orderId: {
+              ...defaultOrderId,
+              orderFlags: ORDER_FLAG_CONDITIONAL,
+            },
+            triggerSubticks: Long.fromValue(0, true),
+          },
+        },
+        'StatefulOrderEvent conditional order trigger updated must have trigger price > 0',
+      ],
+
     ])('throws error if event %s', (
       _message: string,
       event: StatefulOrderEventV1,
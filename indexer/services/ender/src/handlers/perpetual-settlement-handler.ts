@@ -0,0 +1,51 @@
+import { logger } from '@dydxprotocol-indexer/base';
+import {
+  PerpetualMarketFromDatabase,
+  perpetualMarketRefresher,
+  storeHelpers,
+  PerpetualMarketModel,
+} from '@dydxprotocol-indexer/postgres';
+import { PerpetualSettlementEventV1 } from '@dydxprotocol-indexer/v4-protos';
+import * as pg from 'pg';
+
+import { generatePerpetualMarketMessage } from '../helpers/kafka-helper';
+import { ConsolidatedKafkaEvent } from '../lib/types';
+import { Handler } from './handler';
+
+export class PerpetualSettlementHandler extends Handler<PerpetualSettlementEventV1> {
+  eventType: string = 'PerpetualSettlementEventV1';
+
+  public getParallelizationIds(): string[] {
+    return [];
+  }
+
+  // eslint-disable-next-line @typescript-eslint/require-await
+  public async internalHandle(): Promise<ConsolidatedKafkaEvent[]> {
+    const eventDataBinary: Uint8Array = this.indexerTendermintEvent.dataBytes;
+    const result: pg.QueryResult = await storeHelpers.rawQuery(
+      `SELECT dydx_perpetual_settlement_handler(
+        '${JSON.stringify(PerpetualSettlementEventV1.decode(eventDataBinary))}'
+      ) AS result;`,
+      { txId: this.txId },
+    ).catch((error: Error) => {
+      logger.error({
+        at: 'PerpetualSettlementHandler#internalHandle',
+        message: 'Failed to handle PerpetualSettlementEventV1',
+        error,
+      });
+
+      throw error;
+    });
+
+    const perpetualMarket: PerpetualMarketFromDatabase = PerpetualMarketModel.fromJson(
+      result.rows[0].result.perpetual_market) as PerpetualMarketFromDatabase;
+
+    await perpetualMarketRefresher.upsertPerpetualMarket(perpetualMarket);
+
+    return [
+      this.generateConsolidatedMarketKafkaEvent(
+        JSON.stringify(generatePerpetualMarketMessage([perpetualMarket])),
+      ),
+    ];
+  }
+}
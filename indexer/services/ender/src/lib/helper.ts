@@ -23,6 +23,7 @@ import {
   UpdateClobPairEventV1,
   SubaccountMessage,
   DeleveragingEventV1,
+  PerpetualSettlementEventV1,
 } from '@dydxprotocol-indexer/v4-protos';
 import Big from 'big.js';
 import _ from 'lodash';
@@ -193,6 +194,14 @@ export function indexerTendermintEventToEventProtoWithType(
         version,
       };
     }
+    case (DydxIndexerSubtypes.PERPETUAL_SETTLEMENT.toString()): {
+      return {
+        type: DydxIndexerSubtypes.PERPETUAL_SETTLEMENT,
+        eventProto: PerpetualSettlementEventV1.decode(eventDataBinary),
+        indexerTendermintEvent: event,
+        version,
+      };
+    }
     default: {
       const message: string = `Unable to parse event subtype: ${event.subtype}`;
       logger.error({
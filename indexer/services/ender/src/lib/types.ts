@@ -31,6 +31,7 @@ import {
   UpdatePerpetualEventV1,
   UpdateClobPairEventV1,
   DeleveragingEventV1,
+  PerpetualSettlementEventV1,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
 
@@ -49,6 +50,7 @@ export enum DydxIndexerSubtypes {
   UPDATE_PERPETUAL = 'update_perpetual',
   UPDATE_CLOB_PAIR = 'update_clob_pair',
   DELEVERAGING = 'deleveraging',
+  PERPETUAL_SETTLEMENT = 'perpetual_settlement',
 }
 
 // Generic interface used for creating the Handler objects
@@ -120,6 +122,11 @@ export type EventProtoWithTypeAndVersion = {
   eventProto: DeleveragingEventV1,
   indexerTendermintEvent: IndexerTendermintEvent,
   version: number,
+} | {
+  type: DydxIndexerSubtypes.PERPETUAL_SETTLEMENT,
+  eventProto: PerpetualSettlementEventV1,
+  indexerTendermintEvent: IndexerTendermintEvent,
+  version: number,
 });
 
 // Events grouped into events block events and events for each transactionIndex
@@ -166,6 +173,7 @@ export type OrderFillEventWithLiquidation = {
 export type FundingEventMessage = {
   type: FundingEventV1_Type.TYPE_FUNDING_RATE_AND_INDEX | FundingEventV1_Type.TYPE_PREMIUM_SAMPLE,
   updates: FundingUpdateV1[],
+  intervalSeconds: number,
 };
 
 export type SumFields = PerpetualPositionColumns.sumOpen | PerpetualPositionColumns.sumClose;
@@ -11,6 +11,7 @@ BEGIN
         WHEN '0'::jsonb THEN RETURN 'LIMIT'; /** CONDITION_TYPE_UNSPECIFIED */
         WHEN '1'::jsonb THEN RETURN 'STOP_LIMIT'; /** CONDITION_TYPE_STOP_LOSS */
         WHEN '2'::jsonb THEN RETURN 'TAKE_PROFIT'; /** CONDITION_TYPE_TAKE_PROFIT */
+        WHEN '3'::jsonb THEN RETURN 'TRAILING_STOP'; /** CONDITION_TYPE_TRAILING_STOP */
         ELSE RAISE EXCEPTION 'Unexpected ConditionType: %', condition_type;
     END CASE;
 END;
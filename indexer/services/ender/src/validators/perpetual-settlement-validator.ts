@@ -0,0 +1,41 @@
+import { perpetualMarketRefresher } from '@dydxprotocol-indexer/postgres';
+import { IndexerTendermintEvent, PerpetualSettlementEventV1 } from '@dydxprotocol-indexer/v4-protos';
+
+import { Handler } from '../handlers/handler';
+import { PerpetualSettlementHandler } from '../handlers/perpetual-settlement-handler';
+import { Validator } from './validator';
+
+export class PerpetualSettlementValidator extends Validator<PerpetualSettlementEventV1> {
+  public validate(): void {
+    if (
+      perpetualMarketRefresher.getPerpetualMarketFromId(this.event.perpetualId.toString()) ===
+      undefined
+    ) {
+      return this.logAndThrowParseMessageError(
+        'PerpetualSettlementEvent.perpetualId must correspond with an existing perpetual_market.id',
+        { event: this.event },
+      );
+    }
+
+    if (this.event.settlementPrice.isZero()) {
+      return this.logAndThrowParseMessageError(
+        'PerpetualSettlementEvent.settlementPrice must be positive',
+        { event: this.event },
+      );
+    }
+  }
+
+  public createHandlers(
+    indexerTendermintEvent: IndexerTendermintEvent,
+    txId: number,
+  ): Handler<PerpetualSettlementEventV1>[] {
+    const handler: Handler<PerpetualSettlementEventV1> = new PerpetualSettlementHandler(
+      this.block,
+      indexerTendermintEvent,
+      txId,
+      this.event,
+    );
+
+    return [handler];
+  }
+}
@@ -9,12 +9,14 @@ import {
   StatefulOrderEventV1_ConditionalOrderPlacementV1,
   StatefulOrderEventV1_ConditionalOrderTriggeredV1,
   StatefulOrderEventV1_LongTermOrderPlacementV1,
+  StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1,
   IndexerOrder_ConditionType,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
 
 import { Handler, HandlerInitializer } from '../handlers/handler';
 import { ConditionalOrderPlacementHandler } from '../handlers/stateful-order/conditional-order-placement-handler';
+import { ConditionalOrderTriggerUpdatedHandler } from '../handlers/stateful-order/conditional-order-trigger-updated-handler';
 import { ConditionalOrderTriggeredHandler } from '../handlers/stateful-order/conditional-order-triggered-handler';
 import { StatefulOrderPlacementHandler } from '../handlers/stateful-order/stateful-order-placement-handler';
 import { StatefulOrderRemovalHandler } from '../handlers/stateful-order/stateful-order-removal-handler';
@@ -28,11 +30,13 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       this.event.orderRemoval === undefined &&
       this.event.conditionalOrderPlacement === undefined &&
       this.event.conditionalOrderTriggered === undefined &&
-      this.event.longTermOrderPlacement === undefined
+      this.event.longTermOrderPlacement === undefined &&
+      this.event.conditionalOrderTriggerUpdated === undefined
     ) {
       return this.logAndThrowParseMessageError(
         'One of orderPlace, orderRemoval, conditionalOrderPlacement, conditionalOrderTriggered, ' +
-        'longTermOrderPlacement must be defined in StatefulOrderEvent',
+        'longTermOrderPlacement, conditionalOrderTriggerUpdated must be defined in ' +
+        'StatefulOrderEvent',
         { event: this.event },
       );
     }
@@ -44,8 +48,10 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       this.validateConditionalOrderPlacement(this.event.conditionalOrderPlacement);
     } else if (this.event.conditionalOrderTriggered !== undefined) {
       this.validateConditionalOrderTriggered(this.event.conditionalOrderTriggered);
-    } else { // longTermOrderPlacement
-      this.validateLongTermOrderPlacement(this.event.longTermOrderPlacement!);
+    } else if (this.event.longTermOrderPlacement !== undefined) {
+      this.validateLongTermOrderPlacement(this.event.longTermOrderPlacement);
+    } else { // conditionalOrderTriggerUpdated
+      this.validateConditionalOrderTriggerUpdated(this.event.conditionalOrderTriggerUpdated!);
     }
   }
 
@@ -172,6 +178,42 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
     }
   }
 
+  private validateConditionalOrderTriggerUpdated(
+    conditionalOrderTriggerUpdated: StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1,
+  ): void {
+    if (conditionalOrderTriggerUpdated.orderId === undefined) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger updated must contain an orderId',
+        { event: this.event },
+      );
+    }
+
+    if (conditionalOrderTriggerUpdated.orderId.orderFlags !== ORDER_FLAG_CONDITIONAL) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger updated must have order flag ' +
+        `${ORDER_FLAG_CONDITIONAL}`,
+        { event: this.event },
+      );
+    }
+
+    if (conditionalOrderTriggerUpdated.triggerSubticks <= Long.fromValue(0)) {
+      return this.logAndThrowParseMessageError(
+        'StatefulOrderEvent conditional order trigger updated must have trigger price > 0',
+        { event: this.event },
+      );
+    }
+
+    const orderIdErrorMessage: string | undefined = validateOrderIdAndReturnErrorMessage(
+      conditionalOrderTriggerUpdated.orderId,
+    );
+    if (orderIdErrorMessage !== undefined) {
+      return this.logAndThrowParseMessageError(
+        `StatefulOrderEvent conditional order trigger updated ${orderIdErrorMessage}`,
+        { event: this.event },
+      );
+    }
+  }
+
   private validateLongTermOrderPlacement(
     longTermOrderPlacement: StatefulOrderEventV1_LongTermOrderPlacementV1,
   ): void {
@@ -204,6 +246,8 @@ export class StatefulOrderValidator extends Validator<StatefulOrderEventV1> {
       return ConditionalOrderTriggeredHandler;
     } else if (this.event.longTermOrderPlacement !== undefined) {
       return StatefulOrderPlacementHandler;
+    } else if (this.event.conditionalOrderTriggerUpdated !== undefined) {
+      return ConditionalOrderTriggerUpdatedHandler;
     }
     return undefined;
   }
@@ -34,6 +34,17 @@ func ShouldSkipSequenceValidation(msgs []sdk.Msg) (shouldSkipValidation bool) {
 			}
 			// This is a `GoodTilBlock` message, continue to check the next message.
 			continue
+		case
+			*clobtypes.MsgReplaceOrder:
+			// Only Short-Term orders can be replaced, continue to check the next message.
+			continue
+		case
+			*clobtypes.MsgBatchCancel:
+			if !typedMsg.IsShortTermBatch() {
+				return false
+			}
+			// This is a `GoodTilBlock` message, continue to check the next message.
+			continue
 		default:
 			// Early return for messages that require sequence number validation.
 			return false
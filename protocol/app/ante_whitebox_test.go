@@ -69,6 +69,7 @@ func newTestHandlerOptions() HandlerOptions {
 		nil,
 		nil,
 		nil,
+		nil,
 		flags.GetDefaultClobFlags(),
 		rate_limit.NewNoOpRateLimiter[*types.MsgPlaceOrder](),
 		rate_limit.NewNoOpRateLimiter[*types.MsgCancelOrder](),
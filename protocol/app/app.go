@@ -104,6 +104,10 @@ import (
 	// Mempool
 	"github.com/dydxprotocol/v4-chain/protocol/mempool"
 
+	// Grpc Streaming
+	streaming "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
+	streamingtypes "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
+
 	// Daemons
 	bridgeclient "github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/client"
 	daemonflags "github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
@@ -278,8 +282,9 @@ type App struct {
 	// module configurator
 	configurator module.Configurator
 
-	IndexerEventManager indexer_manager.IndexerEventManager
-	Server              *daemonserver.Server
+	IndexerEventManager  indexer_manager.IndexerEventManager
+	GrpcStreamingManager streamingtypes.GrpcStreamingManager
+	Server               *daemonserver.Server
 
 	// startDaemons encapsulates the logic that starts all daemons and daemon services. This function contains a
 	// closure of all relevant data structures that are shared with various keepers. Daemon services startup is
@@ -387,6 +392,9 @@ func New(
 			if app.Server != nil {
 				app.Server.Stop()
 			}
+			if app.GrpcStreamingManager != nil {
+				app.GrpcStreamingManager.Stop()
+			}
 			return errors.Join(
 				// TODO(CORE-538): Remove this if possible during upgrade to Cosmos 0.50.
 				app.db.Close(),
@@ -550,6 +558,7 @@ func New(
 		tkeys[indexer_manager.TransientStoreKey],
 		indexerFlags.SendOffchainData,
 	)
+	app.GrpcStreamingManager = getGrpcStreamingManagerFromOptions(appFlags, logger)
 	timeProvider := &timelib.TimeProviderImpl{}
 
 	app.EpochsKeeper = *epochsmodulekeeper.NewKeeper(
@@ -574,7 +583,8 @@ func New(
 	// Setup server for pricefeed messages. The server will wait for gRPC messages containing price
 	// updates and then encode them into an in-memory cache shared by the prices module.
 	// The in-memory data structure is shared by the x/prices module and PriceFeed daemon.
-	indexPriceCache := pricefeedtypes.NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+	indexPriceCache := pricefeedtypes.NewMarketToExchangePrices(pricefeed_types.MaxPriceAge).
+		WithOutlierBandPpm(daemonFlags.Price.OutlierBandPpm)
 	app.Server.WithPriceFeedMarketToExchangePrices(indexPriceCache)
 
 	// Setup server for liquidation messages. The server will wait for gRPC messages containing
@@ -620,7 +630,12 @@ func New(
 
 		// Non-validating full-nodes have no need to run the price daemon.
 		if !appFlags.NonValidatingFullNode && daemonFlags.Price.Enabled {
-			exchangeQueryConfig := constants.StaticExchangeQueryConfig
+			exchangeQueryConfig, exchangeDetails, err := constants.GetExchangeQueryConfigsAndDetails(
+				daemonFlags.Price.ExchangeDefinitionsFilePath,
+			)
+			if err != nil {
+				panic(err)
+			}
 			app.Server.ExpectPricefeedDaemon(daemonservertypes.MaximumAcceptableUpdateDelay(daemonFlags.Price.LoopDelayMs))
 			// Start pricefeed client for sending prices for the pricefeed server to consume. These prices
 			// are retrieved via third-party APIs like Binance and then are encoded in-memory and
@@ -634,7 +649,7 @@ func New(
 				logger,
 				&daemontypes.GrpcClientImpl{},
 				exchangeQueryConfig,
-				constants.StaticExchangeDetails,
+				exchangeDetails,
 				&pricefeedclient.SubTaskRunnerImpl{},
 			)
 		}
@@ -707,7 +722,13 @@ func New(
 		appCodec,
 		keys[assetsmoduletypes.StoreKey],
 		app.PricesKeeper,
+		app.EpochsKeeper,
 		app.IndexerEventManager,
+		// set the governance and delaymsg module accounts as the authority for conducting upgrades
+		[]string{
+			lib.GovModuleAddress.String(),
+			delaymsgmoduletypes.ModuleAddress.String(),
+		},
 	)
 	assetsModule := assetsmodule.NewAppModule(appCodec, app.AssetsKeeper)
 
@@ -831,7 +852,9 @@ func New(
 	clobFlags := clobflags.GetClobFlagValuesFromOptions(appOpts)
 	logger.Info("Parsed CLOB flags", "Flags", clobFlags)
 
-	memClob := clobmodulememclob.NewMemClobPriceTimePriority(app.IndexerEventManager.Enabled())
+	memClob := clobmodulememclob.NewMemClobPriceTimePriority(
+		app.IndexerEventManager.Enabled() || app.GrpcStreamingManager.Enabled(),
+	)
 
 	app.ClobKeeper = clobmodulekeeper.NewKeeper(
 		appCodec,
@@ -853,6 +876,7 @@ func New(
 		app.StatsKeeper,
 		app.RewardsKeeper,
 		app.IndexerEventManager,
+		app.GrpcStreamingManager,
 		txConfig.TxDecoder(),
 		clobFlags,
 		rate_limit.NewPanicRateLimiter[*clobmoduletypes.MsgPlaceOrder](),
@@ -1514,3 +1538,16 @@ func getIndexerFromOptions(
 	}
 	return indexerMessageSender, indexerFlags
 }
+
+// getGrpcStreamingManagerFromOptions returns an instance of a streamingtypes.GrpcStreamingManager from the specified
+// options. This function will default to returning a no-op instance.
+func getGrpcStreamingManagerFromOptions(
+	appFlags flags.Flags,
+	logger log.Logger,
+) (manager streamingtypes.GrpcStreamingManager) {
+	if appFlags.GrpcStreamingEnabled {
+		logger.Info("GRPC streaming is enabled")
+		return streaming.NewGrpcStreamingManager(logger, appFlags.GrpcStreamingBufferSize)
+	}
+	return streaming.NewNoopGrpcStreamingManager()
+}
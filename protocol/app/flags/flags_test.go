@@ -27,6 +27,12 @@ func TestAddFlagsToCommand(t *testing.T) {
 		},
 		fmt.Sprintf("Has %s flag", flags.DdTraceAgentPort): {
 			flagName: flags.DdTraceAgentPort,
+		},
+		fmt.Sprintf("Has %s flag", flags.GrpcStreamingEnabled): {
+			flagName: flags.GrpcStreamingEnabled,
+		},
+		fmt.Sprintf("Has %s flag", flags.GrpcStreamingBufferSize): {
+			flagName: flags.GrpcStreamingBufferSize,
 		}}
 
 	for name, tc := range tests {
@@ -62,6 +68,40 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("grpc.enable must be set to true - validating requires gRPC server"),
 		},
+		"success - full node & gRPC streaming enabled": {
+			flags: flags.Flags{
+				NonValidatingFullNode:   true,
+				GrpcEnable:              true,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: flags.DefaultGrpcStreamingBufferSize,
+			},
+		},
+		"failure - gRPC streaming enabled with gRPC disabled": {
+			flags: flags.Flags{
+				NonValidatingFullNode:   true,
+				GrpcEnable:              false,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: flags.DefaultGrpcStreamingBufferSize,
+			},
+			expectedErr: fmt.Errorf("grpc.enable must be set to true - grpc streaming requires gRPC server"),
+		},
+		"failure - gRPC streaming enabled for validating nodes": {
+			flags: flags.Flags{
+				NonValidatingFullNode:   false,
+				GrpcEnable:              true,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: flags.DefaultGrpcStreamingBufferSize,
+			},
+			expectedErr: fmt.Errorf("grpc-streaming-enabled can only be set to true for non-validating full nodes"),
+		},
+		"failure - gRPC streaming enabled with zero buffer size": {
+			flags: flags.Flags{
+				NonValidatingFullNode: true,
+				GrpcEnable:            true,
+				GrpcStreamingEnabled:  true,
+			},
+			expectedErr: fmt.Errorf("grpc-streaming-buffer-size must be positive"),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -86,6 +126,8 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 		expectedDdTraceAgentPort          uint16
 		expectedGrpcAddress               string
 		expectedGrpcEnable                bool
+		expectedGrpcStreamingEnabled      bool
+		expectedGrpcStreamingBufferSize   uint32
 	}{
 		"Sets to default if unset": {
 			expectedNonValidatingFullNodeFlag: false,
@@ -93,6 +135,8 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 			expectedDdTraceAgentPort:          8126,
 			expectedGrpcAddress:               "localhost:9090",
 			expectedGrpcEnable:                true,
+			expectedGrpcStreamingEnabled:      false,
+			expectedGrpcStreamingBufferSize:   1000,
 		},
 		"Sets values from options": {
 			optsMap: map[string]any{
@@ -101,12 +145,16 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				flags.DdTraceAgentPort:          uint16(777),
 				flags.GrpcEnable:                false,
 				flags.GrpcAddress:               "localhost:9091",
+				flags.GrpcStreamingEnabled:      "true",
+				flags.GrpcStreamingBufferSize:   uint32(500),
 			},
 			expectedNonValidatingFullNodeFlag: true,
 			expectedDdAgentHost:               "agentHostTest",
 			expectedDdTraceAgentPort:          777,
 			expectedGrpcEnable:                false,
 			expectedGrpcAddress:               "localhost:9091",
+			expectedGrpcStreamingEnabled:      true,
+			expectedGrpcStreamingBufferSize:   500,
 		},
 	}
 
@@ -144,6 +192,16 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				tc.expectedGrpcAddress,
 				flags.GrpcAddress,
 			)
+			require.Equal(
+				t,
+				tc.expectedGrpcStreamingEnabled,
+				flags.GrpcStreamingEnabled,
+			)
+			require.Equal(
+				t,
+				tc.expectedGrpcStreamingBufferSize,
+				flags.GrpcStreamingBufferSize,
+			)
 		})
 	}
 }
@@ -133,6 +133,12 @@ var (
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse":    {},
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal":       {},
 
+		// assets
+		"/dydxprotocol.assets.MsgSetCollateralWeight":         {},
+		"/dydxprotocol.assets.MsgSetCollateralWeightResponse": {},
+		"/dydxprotocol.assets.MsgSetMarginParams":             {},
+		"/dydxprotocol.assets.MsgSetMarginParamsResponse":     {},
+
 		// blocktime
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParams":         {},
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse": {},
@@ -150,14 +156,22 @@ var (
 		"/dydxprotocol.bridge.MsgUpdateSafetyParamsResponse":  {},
 
 		// clob
+		"/dydxprotocol.clob.MsgBatchCancel":                                {},
+		"/dydxprotocol.clob.MsgBatchCancelResponse":                        {},
+		"/dydxprotocol.clob.MsgCancelAllOrders":                            {},
+		"/dydxprotocol.clob.MsgCancelAllOrdersResponse":                    {},
 		"/dydxprotocol.clob.MsgCancelOrder":                                {},
 		"/dydxprotocol.clob.MsgCancelOrderResponse":                        {},
 		"/dydxprotocol.clob.MsgCreateClobPair":                             {},
 		"/dydxprotocol.clob.MsgCreateClobPairResponse":                     {},
+		"/dydxprotocol.clob.MsgDelistClobPair":                             {},
+		"/dydxprotocol.clob.MsgDelistClobPairResponse":                     {},
 		"/dydxprotocol.clob.MsgPlaceOrder":                                 {},
 		"/dydxprotocol.clob.MsgPlaceOrderResponse":                         {},
 		"/dydxprotocol.clob.MsgProposedOperations":                         {},
 		"/dydxprotocol.clob.MsgProposedOperationsResponse":                 {},
+		"/dydxprotocol.clob.MsgReplaceOrder":                               {},
+		"/dydxprotocol.clob.MsgReplaceOrderResponse":                       {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration":          {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse":  {},
 		"/dydxprotocol.clob.MsgUpdateClobPair":                             {},
@@ -196,14 +210,16 @@ var (
 		"/dydxprotocol.prices.MsgUpdateMarketParamResponse":  {},
 
 		// sending
-		"/dydxprotocol.sending.MsgCreateTransfer":                  {},
-		"/dydxprotocol.sending.MsgCreateTransferResponse":          {},
-		"/dydxprotocol.sending.MsgDepositToSubaccount":             {},
-		"/dydxprotocol.sending.MsgDepositToSubaccountResponse":     {},
-		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":          {},
-		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse":  {},
-		"/dydxprotocol.sending.MsgSendFromModuleToAccount":         {},
-		"/dydxprotocol.sending.MsgSendFromModuleToAccountResponse": {},
+		"/dydxprotocol.sending.MsgCreateTransfer":                     {},
+		"/dydxprotocol.sending.MsgCreateTransferResponse":             {},
+		"/dydxprotocol.sending.MsgDepositToSubaccount":                {},
+		"/dydxprotocol.sending.MsgDepositToSubaccountResponse":        {},
+		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":             {},
+		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse":     {},
+		"/dydxprotocol.sending.MsgSendFromModuleToAccount":            {},
+		"/dydxprotocol.sending.MsgSendFromModuleToAccountResponse":    {},
+		"/dydxprotocol.sending.MsgTransferIsolatedCollateral":         {},
+		"/dydxprotocol.sending.MsgTransferIsolatedCollateralResponse": {},
 
 		// stats
 		"/dydxprotocol.stats.MsgUpdateParams":         {},
@@ -12,6 +12,7 @@ import (
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assets "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktime "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	bridge "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	clob "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -78,6 +79,12 @@ var (
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse": nil,
 
 		// ------- Custom modules
+		// assets
+		"/dydxprotocol.assets.MsgSetCollateralWeight":         &assets.MsgSetCollateralWeight{},
+		"/dydxprotocol.assets.MsgSetCollateralWeightResponse": nil,
+		"/dydxprotocol.assets.MsgSetMarginParams":             &assets.MsgSetMarginParams{},
+		"/dydxprotocol.assets.MsgSetMarginParamsResponse":     nil,
+
 		// blocktime
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParams":         &blocktime.MsgUpdateDowntimeParams{},
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse": nil,
@@ -95,6 +102,8 @@ var (
 		// clob
 		"/dydxprotocol.clob.MsgCreateClobPair":                             &clob.MsgCreateClobPair{},
 		"/dydxprotocol.clob.MsgCreateClobPairResponse":                     nil,
+		"/dydxprotocol.clob.MsgDelistClobPair":                             &clob.MsgDelistClobPair{},
+		"/dydxprotocol.clob.MsgDelistClobPairResponse":                     nil,
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration":          &clob.MsgUpdateBlockRateLimitConfiguration{},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse":  nil,
 		"/dydxprotocol.clob.MsgUpdateClobPair":                             &clob.MsgUpdateClobPair{},
@@ -63,6 +63,12 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse",
 
+		// assets
+		"/dydxprotocol.assets.MsgSetCollateralWeight",
+		"/dydxprotocol.assets.MsgSetCollateralWeightResponse",
+		"/dydxprotocol.assets.MsgSetMarginParams",
+		"/dydxprotocol.assets.MsgSetMarginParamsResponse",
+
 		// blocktime
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParams",
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse",
@@ -80,6 +86,8 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		// clob
 		"/dydxprotocol.clob.MsgCreateClobPair",
 		"/dydxprotocol.clob.MsgCreateClobPairResponse",
+		"/dydxprotocol.clob.MsgDelistClobPair",
+		"/dydxprotocol.clob.MsgDelistClobPairResponse",
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration",
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse",
 		"/dydxprotocol.clob.MsgUpdateClobPair",
@@ -121,22 +121,30 @@ var (
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal":       nil,
 
 		// clob
-		"/dydxprotocol.clob.MsgCancelOrder":         &clob.MsgCancelOrder{},
-		"/dydxprotocol.clob.MsgCancelOrderResponse": nil,
-		"/dydxprotocol.clob.MsgPlaceOrder":          &clob.MsgPlaceOrder{},
-		"/dydxprotocol.clob.MsgPlaceOrderResponse":  nil,
+		"/dydxprotocol.clob.MsgBatchCancel":             &clob.MsgBatchCancel{},
+		"/dydxprotocol.clob.MsgBatchCancelResponse":     nil,
+		"/dydxprotocol.clob.MsgCancelAllOrders":         &clob.MsgCancelAllOrders{},
+		"/dydxprotocol.clob.MsgCancelAllOrdersResponse": nil,
+		"/dydxprotocol.clob.MsgCancelOrder":             &clob.MsgCancelOrder{},
+		"/dydxprotocol.clob.MsgCancelOrderResponse":     nil,
+		"/dydxprotocol.clob.MsgPlaceOrder":              &clob.MsgPlaceOrder{},
+		"/dydxprotocol.clob.MsgPlaceOrderResponse":      nil,
+		"/dydxprotocol.clob.MsgReplaceOrder":            &clob.MsgReplaceOrder{},
+		"/dydxprotocol.clob.MsgReplaceOrderResponse":    nil,
 
 		// perpetuals
 
 		// prices
 
 		// sending
-		"/dydxprotocol.sending.MsgCreateTransfer":                 &sending.MsgCreateTransfer{},
-		"/dydxprotocol.sending.MsgCreateTransferResponse":         nil,
-		"/dydxprotocol.sending.MsgDepositToSubaccount":            &sending.MsgDepositToSubaccount{},
-		"/dydxprotocol.sending.MsgDepositToSubaccountResponse":    nil,
-		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":         &sending.MsgWithdrawFromSubaccount{},
-		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse": nil,
+		"/dydxprotocol.sending.MsgCreateTransfer":                     &sending.MsgCreateTransfer{},
+		"/dydxprotocol.sending.MsgCreateTransferResponse":             nil,
+		"/dydxprotocol.sending.MsgDepositToSubaccount":                &sending.MsgDepositToSubaccount{},
+		"/dydxprotocol.sending.MsgDepositToSubaccountResponse":        nil,
+		"/dydxprotocol.sending.MsgTransferIsolatedCollateral":         &sending.MsgTransferIsolatedCollateral{},
+		"/dydxprotocol.sending.MsgTransferIsolatedCollateralResponse": nil,
+		"/dydxprotocol.sending.MsgWithdrawFromSubaccount":             &sending.MsgWithdrawFromSubaccount{},
+		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse":     nil,
 
 		// ibc.applications
 		"/ibc.applications.transfer.v1.MsgTransfer":           &ibctransfer.MsgTransfer{},
@@ -108,10 +108,16 @@ func TestNormalMsgs_Key(t *testing.T) {
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
 
 		// clob
+		"/dydxprotocol.clob.MsgBatchCancel",
+		"/dydxprotocol.clob.MsgBatchCancelResponse",
+		"/dydxprotocol.clob.MsgCancelAllOrders",
+		"/dydxprotocol.clob.MsgCancelAllOrdersResponse",
 		"/dydxprotocol.clob.MsgCancelOrder",
 		"/dydxprotocol.clob.MsgCancelOrderResponse",
 		"/dydxprotocol.clob.MsgPlaceOrder",
 		"/dydxprotocol.clob.MsgPlaceOrderResponse",
+		"/dydxprotocol.clob.MsgReplaceOrder",
+		"/dydxprotocol.clob.MsgReplaceOrderResponse",
 
 		// perpetuals
 
@@ -122,6 +128,8 @@ func TestNormalMsgs_Key(t *testing.T) {
 		"/dydxprotocol.sending.MsgCreateTransferResponse",
 		"/dydxprotocol.sending.MsgDepositToSubaccount",
 		"/dydxprotocol.sending.MsgDepositToSubaccountResponse",
+		"/dydxprotocol.sending.MsgTransferIsolatedCollateral",
+		"/dydxprotocol.sending.MsgTransferIsolatedCollateralResponse",
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccount",
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse",
 
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/dydxprotocol/v4-chain/protocol/app/process"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/ante"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
@@ -56,7 +57,7 @@ func RemoveDisallowMsgs(ctx sdk.Context, decoder sdk.TxDecoder, txs [][]byte) []
 		// For each msg in tx, check if it is disallowed.
 		containsDisllowMsg := false
 		for _, msg := range tx.GetMsgs() {
-			if ante.IsDisallowExternalSubmitMsg(msg) {
+			if ante.IsDisallowExternalSubmitMsg(msg) || process.IsDisallowClobOrderMsgInOtherTxs(msg) {
 				telemetry.IncrCounterWithLabels(
 					[]string{ModuleName, metrics.RemoveDisallowMsgs, metrics.DisallowMsg, metrics.Count},
 					1,
@@ -7,6 +7,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/app/prepare"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/encoding"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +25,16 @@ var (
 	)
 	multiMsgsTxHasDisallowMixedTxBytes, _ = constants.TestEncodingCfg.TxConfig.TxEncoder()(
 		constants.TestTxBuilder.GetTx())
+
+	_ = constants.TestTxBuilder.SetMsgs(
+		clobtypes.NewMsgBatchCancelShortTerm(
+			constants.Alice_Num0,
+			[]clobtypes.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0, 1}}},
+			20,
+		),
+	)
+	shortTermBatchCancelTxBytes, _ = constants.TestEncodingCfg.TxConfig.TxEncoder()(
+		constants.TestTxBuilder.GetTx())
 )
 
 func TestGetGroupMsgOther(t *testing.T) {
@@ -103,6 +114,10 @@ func TestRemoveDisallowMsgs(t *testing.T) {
 			txs:         [][]byte{constants.Msg_Send_TxBytes},
 			expectedTxs: [][]byte{constants.Msg_Send_TxBytes},
 		},
+		"Single Tx, Single Msg Tx, Short-Term Batch Cancel": {
+			txs:         [][]byte{shortTermBatchCancelTxBytes},
+			expectedTxs: nil,
+		},
 		"Single Tx, Multi Msgs Tx, Disallowed Msg": {
 			txs:         [][]byte{multiMsgsTxHasDisallowMixedTxBytes},
 			expectedTxs: nil,
@@ -18,6 +18,10 @@ func IsDisallowClobOrderMsgInOtherTxs(targetMsg sdk.Msg) bool {
 		order := msg.GetOrder()
 		orderId := order.GetOrderId()
 		return !orderId.IsStatefulOrder() // not stateful -> returns true -> disallow
+	case *clobtypes.MsgReplaceOrder:
+		return true // only Short-Term orders can be replaced -> disallow
+	case *clobtypes.MsgBatchCancel:
+		return msg.IsShortTermBatch() // not stateful -> returns true -> disallow
 	}
 	return false
 }
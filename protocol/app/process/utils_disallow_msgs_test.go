@@ -28,6 +28,9 @@ func TestIsDisallowClobOrderMsgInOtherTxs(t *testing.T) {
 		case *clobtypes.MsgCancelOrder, *clobtypes.MsgPlaceOrder:
 			// The sample msgs are short-term orders, so we expect these to be disallowed.
 			require.True(t, result) // true -> disallow
+		case *clobtypes.MsgReplaceOrder:
+			// Only Short-Term orders can be replaced, so replacements are always disallowed.
+			require.True(t, result) // true -> disallow
 		default:
 			require.False(t, result) // false -> not disallow -> allow
 		}
@@ -7,6 +7,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/dydxprotocol/v4-chain/protocol/app/upgrades"
 	v3_0_0 "github.com/dydxprotocol/v4-chain/protocol/app/upgrades/v3.0.0"
+	v4_0_0 "github.com/dydxprotocol/v4-chain/protocol/app/upgrades/v4.0.0"
 )
 
 var (
@@ -14,6 +15,7 @@ var (
 	// New upgrades should be added to this slice after they are implemented.
 	Upgrades = []upgrades.Upgrade{
 		v3_0_0.Upgrade,
+		v4_0_0.Upgrade,
 	}
 	Forks = []upgrades.Fork{}
 )
@@ -32,6 +34,19 @@ func (app *App) setupUpgradeHandlers() {
 			app.AccountKeeper,
 		),
 	)
+
+	if app.UpgradeKeeper.HasHandler(v4_0_0.UpgradeName) {
+		panic(fmt.Sprintf("Cannot register duplicate upgrade handler '%s'", v4_0_0.UpgradeName))
+	}
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v4_0_0.UpgradeName,
+		v4_0_0.CreateUpgradeHandler(
+			app.ModuleManager,
+			app.configurator,
+			app.SubaccountsKeeper,
+			app.ClobKeeper,
+		),
+	)
 }
 
 // setUpgradeStoreLoaders sets custom store loaders to customize the rootMultiStore
@@ -0,0 +1,26 @@
+package v_4_0_0
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clobkeeper "github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	sakeeper "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	sk sakeeper.Keeper,
+	ck *clobkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Running %s Upgrade...", UpgradeName)
+		// Build the secondary subaccount indices for the subaccounts written before they existed.
+		sk.InitializeSubaccountIndices(ctx)
+		// Enter the positions that were opened before entry notionals were tracked at the oracle price,
+		// so that they are ranked for auto-deleveraging.
+		ck.InitializePerpetualPositionEntries(ctx)
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
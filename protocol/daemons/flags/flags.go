@@ -11,8 +11,11 @@ const (
 	// Flag names
 	FlagUnixSocketAddress = "unix-socket-address"
 
-	FlagPriceDaemonEnabled     = "price-daemon-enabled"
-	FlagPriceDaemonLoopDelayMs = "price-daemon-loop-delay-ms"
+	FlagPriceDaemonEnabled                     = "price-daemon-enabled"
+	FlagPriceDaemonLoopDelayMs                 = "price-daemon-loop-delay-ms"
+	FlagPriceDaemonStreamingEnabled            = "price-daemon-streaming-enabled"
+	FlagPriceDaemonOutlierBandPpm              = "price-daemon-outlier-band-ppm"
+	FlagPriceDaemonExchangeDefinitionsFilePath = "price-daemon-exchange-definitions-file-path"
 
 	FlagBridgeDaemonEnabled        = "bridge-daemon-enabled"
 	FlagBridgeDaemonLoopDelayMs    = "bridge-daemon-loop-delay-ms"
@@ -57,6 +60,14 @@ type PriceFlags struct {
 	Enabled bool
 	// LoopDelayMs configures the update frequency of the price daemon.
 	LoopDelayMs uint32
+	// StreamingEnabled toggles streaming prices over WebSocket connections for exchanges that support it.
+	StreamingEnabled bool
+	// OutlierBandPpm configures the maximum deviation of an exchange price from the median price of all
+	// exchanges, in parts-per-million, before the price is excluded as an outlier. 0 disables outlier detection.
+	OutlierBandPpm uint32
+	// ExchangeDefinitionsFilePath is the optional path to a json file of declarative exchange definitions,
+	// which add to or replace the exchanges supported by the price daemon.
+	ExchangeDefinitionsFilePath string
 }
 
 // DaemonFlags contains the collected configuration flags for all daemons.
@@ -88,8 +99,11 @@ func GetDefaultDaemonFlags() DaemonFlags {
 				RequestChunkSize:    50,
 			},
 			Price: PriceFlags{
-				Enabled:     true,
-				LoopDelayMs: 3_000,
+				Enabled:                     true,
+				LoopDelayMs:                 3_000,
+				StreamingEnabled:            false,
+				OutlierBandPpm:              0,
+				ExchangeDefinitionsFilePath: "",
 			},
 		}
 	}
@@ -163,6 +177,24 @@ func AddDaemonFlagsToCmd(
 		df.Price.LoopDelayMs,
 		"Delay in milliseconds between sending price updates to the application.",
 	)
+	cmd.Flags().Bool(
+		FlagPriceDaemonStreamingEnabled,
+		df.Price.StreamingEnabled,
+		"Enable streaming prices over WebSocket connections for exchanges that support it, "+
+			"falling back to polling while a stream is disconnected or stale.",
+	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonOutlierBandPpm,
+		df.Price.OutlierBandPpm,
+		"Maximum deviation in parts-per-million of an exchange price from the median of all exchange prices "+
+			"before it is excluded as an outlier. Set to 0 to disable outlier detection.",
+	)
+	cmd.Flags().String(
+		FlagPriceDaemonExchangeDefinitionsFilePath,
+		df.Price.ExchangeDefinitionsFilePath,
+		"Path to a json file of exchange definitions that add to or replace the exchanges queried by the "+
+			"Price Daemon.",
+	)
 }
 
 // GetDaemonFlagValuesFromOptions gets all daemon flag values from the `AppOptions` struct.
@@ -229,6 +261,21 @@ func GetDaemonFlagValuesFromOptions(
 			result.Price.LoopDelayMs = v
 		}
 	}
+	if option := appOpts.Get(FlagPriceDaemonStreamingEnabled); option != nil {
+		if v, err := cast.ToBoolE(option); err == nil {
+			result.Price.StreamingEnabled = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonOutlierBandPpm); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.OutlierBandPpm = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonExchangeDefinitionsFilePath); option != nil {
+		if v, err := cast.ToStringE(option); err == nil {
+			result.Price.ExchangeDefinitionsFilePath = v
+		}
+	}
 
 	return result
 }
@@ -27,6 +27,9 @@ func TestAddDaemonFlagsToCmd(t *testing.T) {
 
 		flags.FlagPriceDaemonEnabled,
 		flags.FlagPriceDaemonLoopDelayMs,
+		flags.FlagPriceDaemonStreamingEnabled,
+		flags.FlagPriceDaemonOutlierBandPpm,
+		flags.FlagPriceDaemonExchangeDefinitionsFilePath,
 	}
 
 	for _, v := range tests {
@@ -53,6 +56,9 @@ func TestGetDaemonFlagValuesFromOptions_Custom(t *testing.T) {
 
 	optsMap[flags.FlagPriceDaemonEnabled] = true
 	optsMap[flags.FlagPriceDaemonLoopDelayMs] = uint32(4444)
+	optsMap[flags.FlagPriceDaemonStreamingEnabled] = true
+	optsMap[flags.FlagPriceDaemonOutlierBandPpm] = uint32(5555)
+	optsMap[flags.FlagPriceDaemonExchangeDefinitionsFilePath] = "test-exchange-definitions-file-path"
 
 	mockOpts := mocks.AppOptions{}
 	mockOpts.On("Get", mock.Anything).
@@ -79,6 +85,13 @@ func TestGetDaemonFlagValuesFromOptions_Custom(t *testing.T) {
 	// Price Daemon.
 	require.Equal(t, optsMap[flags.FlagPriceDaemonEnabled], r.Price.Enabled)
 	require.Equal(t, optsMap[flags.FlagPriceDaemonLoopDelayMs], r.Price.LoopDelayMs)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonStreamingEnabled], r.Price.StreamingEnabled)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonOutlierBandPpm], r.Price.OutlierBandPpm)
+	require.Equal(
+		t,
+		optsMap[flags.FlagPriceDaemonExchangeDefinitionsFilePath],
+		r.Price.ExchangeDefinitionsFilePath,
+	)
 }
 
 func TestGetDaemonFlagValuesFromOptions_Defaul(t *testing.T) {
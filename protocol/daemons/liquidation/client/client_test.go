@@ -81,18 +81,13 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 	}{
 		"Success": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
-					Pagination: &query.PageRequest{
-						Limit: df.Liquidation.SubaccountPageLimit,
-					},
-				}
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_1BTC_Short,
-						constants.Dave_Num0_1BTC_Long_50000USD,
-					},
-				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
+				setupSubaccountIdsToCheckMocks(
+					ctx,
+					mck,
+					df.Liquidation.SubaccountPageLimit,
+					[]satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+					[]satypes.SubaccountId{},
+				)
 
 				req2 := &clobtypes.AreSubaccountsLiquidatableRequest{
 					SubaccountIds: []satypes.SubaccountId{
@@ -125,18 +120,13 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 		},
 		"Success - no open position": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
-					Pagination: &query.PageRequest{
-						Limit: df.Liquidation.SubaccountPageLimit,
-					},
-				}
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_599USD, // no open positions
-						constants.Dave_Num0_599USD, // no open positions
-					},
-				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
+				setupSubaccountIdsToCheckMocks(
+					ctx,
+					mck,
+					df.Liquidation.SubaccountPageLimit,
+					[]satypes.SubaccountId{},
+					[]satypes.SubaccountId{},
+				)
 				req2 := &api.LiquidateSubaccountsRequest{
 					SubaccountIds: []satypes.SubaccountId{},
 				}
@@ -144,20 +134,83 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				mck.On("LiquidateSubaccounts", ctx, req2).Return(response2, nil)
 			},
 		},
-		"Success - no liquidatable subaccounts": {
+		"Success - borrowed asset": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
-					Pagination: &query.PageRequest{
-						Limit: df.Liquidation.SubaccountPageLimit,
+				setupSubaccountIdsToCheckMocks(
+					ctx,
+					mck,
+					df.Liquidation.SubaccountPageLimit,
+					[]satypes.SubaccountId{},
+					[]satypes.SubaccountId{constants.Carl_Num0},
+				)
+
+				req2 := &clobtypes.AreSubaccountsLiquidatableRequest{
+					SubaccountIds: []satypes.SubaccountId{
+						constants.Carl_Num0,
+					},
+				}
+				response2 := &clobtypes.AreSubaccountsLiquidatableResponse{
+					Results: []clobtypes.AreSubaccountsLiquidatableResponse_Result{
+						{
+							SubaccountId:   constants.Carl_Num0,
+							IsLiquidatable: true,
+						},
+					},
+				}
+				mck.On("AreSubaccountsLiquidatable", ctx, req2).Return(response2, nil)
+
+				req3 := &api.LiquidateSubaccountsRequest{
+					SubaccountIds: []satypes.SubaccountId{
+						constants.Carl_Num0,
+					},
+				}
+				response3 := &api.LiquidateSubaccountsResponse{}
+				mck.On("LiquidateSubaccounts", ctx, req3).Return(response3, nil)
+			},
+		},
+		"Success - negative USDC balance": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				setupSubaccountIdsToCheckMocks(
+					ctx,
+					mck,
+					df.Liquidation.SubaccountPageLimit,
+					[]satypes.SubaccountId{constants.Carl_Num0},
+					[]satypes.SubaccountId{constants.Carl_Num0},
+				)
+
+				req2 := &clobtypes.AreSubaccountsLiquidatableRequest{
+					SubaccountIds: []satypes.SubaccountId{
+						constants.Carl_Num0,
 					},
 				}
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_1BTC_Short,
-						constants.Dave_Num0_1BTC_Long_50000USD,
+				response2 := &clobtypes.AreSubaccountsLiquidatableResponse{
+					Results: []clobtypes.AreSubaccountsLiquidatableResponse_Result{
+						{
+							SubaccountId:   constants.Carl_Num0,
+							IsLiquidatable: true,
+						},
 					},
 				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
+				mck.On("AreSubaccountsLiquidatable", ctx, req2).Return(response2, nil)
+
+				req3 := &api.LiquidateSubaccountsRequest{
+					SubaccountIds: []satypes.SubaccountId{
+						constants.Carl_Num0,
+					},
+				}
+				response3 := &api.LiquidateSubaccountsResponse{}
+				mck.On("LiquidateSubaccounts", ctx, req3).Return(response3, nil)
+			},
+		},
+		"Success - no liquidatable subaccounts": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				setupSubaccountIdsToCheckMocks(
+					ctx,
+					mck,
+					df.Liquidation.SubaccountPageLimit,
+					[]satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
+					[]satypes.SubaccountId{},
+				)
 
 				req2 := &clobtypes.AreSubaccountsLiquidatableRequest{
 					SubaccountIds: []satypes.SubaccountId{
@@ -185,30 +238,42 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				mck.On("LiquidateSubaccounts", ctx, req3).Return(response3, nil)
 			},
 		},
-		"Panics on error - SubaccountAll": {
+		"Panics on error - ClobPairAll": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
+				mck.On("ClobPairAll", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
 			},
 			expectedError: errors.New("test error"),
 		},
-		"Panics on error - AreSubaccountsLiquidatable": {
+		"Panics on error - SubaccountsByPerpetual": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(&satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_1BTC_Short,
-					},
+				mck.On("ClobPairAll", mock.Anything, mock.Anything).Return(&clobtypes.QueryClobPairAllResponse{
+					ClobPair: []clobtypes.ClobPair{constants.ClobPair_Btc},
 				}, nil)
+				mck.On("SubaccountsByPerpetual", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
+			},
+			expectedError: errors.New("test error"),
+		},
+		"Panics on error - AreSubaccountsLiquidatable": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				setupSubaccountIdsToCheckMocks(
+					ctx,
+					mck,
+					df.Liquidation.SubaccountPageLimit,
+					[]satypes.SubaccountId{constants.Carl_Num0},
+					[]satypes.SubaccountId{},
+				)
 				mck.On("AreSubaccountsLiquidatable", mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
 			},
 			expectedError: errors.New("test error"),
 		},
 		"Panics on error - LiquidateSubaccounts": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(&satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_1BTC_Short,
-					},
-				}, nil,
+				setupSubaccountIdsToCheckMocks(
+					ctx,
+					mck,
+					df.Liquidation.SubaccountPageLimit,
+					[]satypes.SubaccountId{constants.Carl_Num0},
+					[]satypes.SubaccountId{},
 				)
 				mck.On("AreSubaccountsLiquidatable", mock.Anything, mock.Anything).Return(
 					&clobtypes.AreSubaccountsLiquidatableResponse{},
@@ -246,6 +311,42 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 	}
 }
 
+// setupSubaccountIdsToCheckMocks sets up the queries made by `GetSubaccountIdsToCheck` for a single page of
+// clob pairs containing the BTC perpetual and spot clob pairs, and single pages of subaccount ids.
+func setupSubaccountIdsToCheckMocks(
+	ctx context.Context,
+	mck *mocks.QueryClient,
+	limit uint64,
+	btcSubaccountIds []satypes.SubaccountId,
+	borrowerSubaccountIds []satypes.SubaccountId,
+) {
+	mck.On("ClobPairAll", ctx, &clobtypes.QueryAllClobPairRequest{
+		Pagination: &query.PageRequest{
+			Limit: limit,
+		},
+	}).Return(&clobtypes.QueryClobPairAllResponse{
+		ClobPair: []clobtypes.ClobPair{
+			constants.ClobPair_Btc,
+			constants.ClobPair_Spot_Btc,
+		},
+	}, nil)
+	mck.On("SubaccountsByPerpetual", ctx, &satypes.QuerySubaccountsByPerpetualRequest{
+		PerpetualId: constants.ClobPair_Btc.MustGetPerpetualId(),
+		Pagination: &query.PageRequest{
+			Limit: limit,
+		},
+	}).Return(&satypes.QuerySubaccountsByPerpetualResponse{
+		SubaccountIds: btcSubaccountIds,
+	}, nil)
+	mck.On("SubaccountsWithBorrowings", ctx, &satypes.QuerySubaccountsWithBorrowingsRequest{
+		Pagination: &query.PageRequest{
+			Limit: limit,
+		},
+	}).Return(&satypes.QuerySubaccountsWithBorrowingsResponse{
+		SubaccountIds: borrowerSubaccountIds,
+	}, nil)
+}
+
 // FakeSubTaskRunner is a mock implementation of the SubTaskRunner interface for testing.
 type FakeSubTaskRunner struct {
 	err    error
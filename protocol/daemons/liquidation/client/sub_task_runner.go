@@ -49,11 +49,12 @@ func (s *SubTaskRunnerImpl) RunLiquidationDaemonTaskLoop(
 		metrics.Latency,
 	)
 
-	// 1. Fetch all subaccounts from query service.
-	subaccounts, err := GetAllSubaccounts(
+	// 1. Fetch the ids of all subaccounts with open positions or borrowings from query service.
+	subaccountIds, err := GetSubaccountIdsToCheck(
 		client,
 		ctx,
 		subaccountQueryClient,
+		clobQueryClient,
 		liqFlags.SubaccountPageLimit,
 	)
 	if err != nil {
@@ -66,7 +67,7 @@ func (s *SubTaskRunnerImpl) RunLiquidationDaemonTaskLoop(
 		ctx,
 		clobQueryClient,
 		liqFlags,
-		subaccounts,
+		subaccountIds,
 	)
 	if err != nil {
 		return err
@@ -145,95 +146,176 @@ func SendLiquidatableSubaccountIds(
 	return nil
 }
 
-func getSubaccountsFromKey(
-	ctx context.Context,
-	client satypes.QueryClient,
+// getPageFromKey calls `queryPage` with a page request of size `limit` starting at `pageRequestKey`, and
+// returns the key of the next page.
+func getPageFromKey(
 	limit uint64,
 	pageRequestKey []byte,
+	queryPage func(pageRequest *query.PageRequest) (pageResponse *query.PageResponse, err error),
 ) (
-	subaccounts []satypes.Subaccount,
 	nextKey []byte,
 	err error,
 ) {
 	defer metrics.ModuleMeasureSinceWithLabels(
 		metrics.LiquidationDaemon,
-		[]string{metrics.GetSubaccountsFromKey, metrics.Latency},
+		[]string{metrics.GetPageFromKey, metrics.Latency},
 		time.Now(),
 		[]gometrics.Label{
 			metrics.GetLabelForIntValue(metrics.PageLimit, int(limit)),
 		},
 	)
 
-	query := &satypes.QueryAllSubaccountRequest{
-		Pagination: &query.PageRequest{
-			Key:   pageRequestKey,
-			Limit: limit,
-		},
+	pageResponse, err := queryPage(&query.PageRequest{
+		Key:   pageRequestKey,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
 	}
+	if pageResponse != nil {
+		nextKey = pageResponse.NextKey
+	}
+	return nextKey, nil
+}
 
-	response, err := client.SubaccountAll(ctx, query)
-	if err != nil {
-		return nil, nil, err
+// getAllPages repeatedly calls `queryPage` with page requests of size `limit`, starting from the first page
+// and continuing from the next key returned by the previous call, until all pages have been queried.
+func getAllPages(
+	limit uint64,
+	queryPage func(pageRequest *query.PageRequest) (pageResponse *query.PageResponse, err error),
+) error {
+	var nextKey []byte
+	for {
+		next, err := getPageFromKey(limit, nextKey, queryPage)
+		if err != nil {
+			return err
+		}
+
+		nextKey = next
+		if len(nextKey) == 0 {
+			return nil
+		}
 	}
-	if response.Pagination != nil {
-		nextKey = response.Pagination.NextKey
+}
+
+// GetPerpetualIds queries a gRPC server and returns the IDs of all perpetuals that are traded on a clob pair.
+func GetPerpetualIds(
+	ctx context.Context,
+	client clobtypes.QueryClient,
+	limit uint64,
+) (
+	perpetualIds []uint32,
+	err error,
+) {
+	perpetualIds = make([]uint32, 0)
+	err = getAllPages(limit, func(pageRequest *query.PageRequest) (*query.PageResponse, error) {
+		response, err := client.ClobPairAll(ctx, &clobtypes.QueryAllClobPairRequest{Pagination: pageRequest})
+		if err != nil {
+			return nil, err
+		}
+		for _, clobPair := range response.ClobPair {
+			if metadata := clobPair.GetPerpetualClobMetadata(); metadata != nil {
+				perpetualIds = append(perpetualIds, metadata.PerpetualId)
+			}
+		}
+		return response.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return response.Subaccount, nextKey, nil
+	return perpetualIds, nil
 }
 
-// GetAllSubaccounts queries a gRPC server and returns a list of subaccounts and
-// their balances and open positions.
-func GetAllSubaccounts(
+// GetSubaccountIdsToCheck queries a gRPC server and returns the unique IDs of all subaccounts with at least
+// one open perpetual position or negative asset position. The subaccounts are looked up through the
+// per-perpetual position index and the borrower index, so that subaccounts without any positions that
+// could be liquidated are never fetched.
+func GetSubaccountIdsToCheck(
 	daemon *Client,
 	ctx context.Context,
-	client satypes.QueryClient,
+	subaccountQueryClient satypes.QueryClient,
+	clobQueryClient clobtypes.QueryClient,
 	limit uint64,
 ) (
-	subaccounts []satypes.Subaccount,
+	subaccountIds []satypes.SubaccountId,
 	err error,
 ) {
-	defer telemetry.ModuleMeasureSince(metrics.LiquidationDaemon, time.Now(), metrics.GetAllSubaccounts, metrics.Latency)
-	subaccounts = make([]satypes.Subaccount, 0)
+	defer telemetry.ModuleMeasureSince(
+		metrics.LiquidationDaemon,
+		time.Now(),
+		metrics.GetSubaccountIdsToCheck,
+		metrics.Latency,
+	)
 
-	var nextKey []byte
-	for {
-		subaccountsFromKey, next, err := getSubaccountsFromKey(
-			ctx,
-			client,
-			limit,
-			nextKey,
-		)
+	subaccountIds = make([]satypes.SubaccountId, 0)
+	seenSubaccountIds := make(map[satypes.SubaccountId]bool)
+	addSubaccountIds := func(ids []satypes.SubaccountId) {
+		for _, subaccountId := range ids {
+			if !seenSubaccountIds[subaccountId] {
+				seenSubaccountIds[subaccountId] = true
+				subaccountIds = append(subaccountIds, subaccountId)
+			}
+		}
+	}
+
+	perpetualIds, err := GetPerpetualIds(ctx, clobQueryClient, limit)
+	if err != nil {
+		return nil, err
+	}
 
+	for _, perpetualId := range perpetualIds {
+		err = getAllPages(limit, func(pageRequest *query.PageRequest) (*query.PageResponse, error) {
+			response, err := subaccountQueryClient.SubaccountsByPerpetual(
+				ctx,
+				&satypes.QuerySubaccountsByPerpetualRequest{
+					PerpetualId: perpetualId,
+					Pagination:  pageRequest,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			addSubaccountIds(response.SubaccountIds)
+			return response.Pagination, nil
+		})
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		subaccounts = append(subaccounts, subaccountsFromKey...)
-		nextKey = next
-
-		if len(nextKey) == 0 {
-			break
+	err = getAllPages(limit, func(pageRequest *query.PageRequest) (*query.PageResponse, error) {
+		response, err := subaccountQueryClient.SubaccountsWithBorrowings(
+			ctx,
+			&satypes.QuerySubaccountsWithBorrowingsRequest{Pagination: pageRequest},
+		)
+		if err != nil {
+			return nil, err
 		}
+		addSubaccountIds(response.SubaccountIds)
+		return response.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	telemetry.ModuleSetGauge(
 		metrics.LiquidationDaemon,
-		float32(len(subaccounts)),
-		metrics.GetAllSubaccounts,
+		float32(len(subaccountIds)),
+		metrics.SubaccountsWithOpenPositions,
 		metrics.Count,
 	)
 
-	return subaccounts, nil
+	return subaccountIds, nil
 }
 
-// GetLiquidatableSubaccountIds verifies collateralization statuses of subaccounts with
-// at least one open position and returns a list of unique and potentially liquidatable subaccount ids.
+// GetLiquidatableSubaccountIds verifies collateralization statuses of the given subaccounts
+// and returns a list of potentially liquidatable subaccount ids.
 func GetLiquidatableSubaccountIds(
 	daemon *Client,
 	ctx context.Context,
 	client clobtypes.QueryClient,
 	liqFlags flags.LiquidationFlags,
-	subaccounts []satypes.Subaccount,
+	subaccountsToCheck []satypes.SubaccountId,
 ) (
 	liquidatableSubaccountIds []satypes.SubaccountId,
 	err error,
@@ -245,21 +327,6 @@ func GetLiquidatableSubaccountIds(
 		metrics.Latency,
 	)
 
-	// Filter out subaccounts with no open positions.
-	subaccountsToCheck := make([]satypes.SubaccountId, 0)
-	for _, subaccount := range subaccounts {
-		if len(subaccount.PerpetualPositions) > 0 {
-			subaccountsToCheck = append(subaccountsToCheck, *subaccount.Id)
-		}
-	}
-
-	telemetry.ModuleSetGauge(
-		metrics.LiquidationDaemon,
-		float32(len(subaccountsToCheck)),
-		metrics.SubaccountsWithOpenPositions,
-		metrics.Count,
-	)
-
 	// Query the gRPC server in chunks of size `liqFlags.RequestChunkSize`.
 	liquidatableSubaccountIds = make([]satypes.SubaccountId, 0)
 	for start := 0; start < len(subaccountsToCheck); start += int(liqFlags.RequestChunkSize) {
@@ -17,79 +17,108 @@ import (
 	"testing"
 )
 
-func TestGetAllSubaccounts(t *testing.T) {
+func TestGetSubaccountIdsToCheck(t *testing.T) {
 	df := flags.GetDefaultDaemonFlags()
+	clobPairAllRequest := &clobtypes.QueryAllClobPairRequest{
+		Pagination: &query.PageRequest{
+			Limit: df.Liquidation.SubaccountPageLimit,
+		},
+	}
+	subaccountsByPerpetualRequest := func(perpetualId uint32, key []byte) *satypes.QuerySubaccountsByPerpetualRequest {
+		return &satypes.QuerySubaccountsByPerpetualRequest{
+			PerpetualId: perpetualId,
+			Pagination: &query.PageRequest{
+				Key:   key,
+				Limit: df.Liquidation.SubaccountPageLimit,
+			},
+		}
+	}
+	subaccountsWithBorrowingsRequest := &satypes.QuerySubaccountsWithBorrowingsRequest{
+		Pagination: &query.PageRequest{
+			Limit: df.Liquidation.SubaccountPageLimit,
+		},
+	}
 	tests := map[string]struct {
 		// mocks
 		setupMocks func(ctx context.Context, mck *mocks.QueryClient)
 
 		// expectations
-		expectedSubaccounts []satypes.Subaccount
-		expectedError       error
+		expectedSubaccountIds []satypes.SubaccountId
+		expectedError         error
 	}{
 		"Success": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
-					Pagination: &query.PageRequest{
-						Limit: df.Liquidation.SubaccountPageLimit,
+				mck.On("ClobPairAll", ctx, clobPairAllRequest).Return(&clobtypes.QueryClobPairAllResponse{
+					ClobPair: []clobtypes.ClobPair{
+						constants.ClobPair_Btc,
+						constants.ClobPair_Eth,
+						constants.ClobPair_Spot_Btc,
 					},
-				}
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_599USD,
-						constants.Dave_Num0_599USD,
+				}, nil)
+				mck.On("SubaccountsByPerpetual", ctx, subaccountsByPerpetualRequest(0, nil)).Return(
+					&satypes.QuerySubaccountsByPerpetualResponse{
+						SubaccountIds: []satypes.SubaccountId{constants.Carl_Num0, constants.Dave_Num0},
 					},
-				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
+					nil,
+				)
+				mck.On("SubaccountsByPerpetual", ctx, subaccountsByPerpetualRequest(1, nil)).Return(
+					&satypes.QuerySubaccountsByPerpetualResponse{
+						SubaccountIds: []satypes.SubaccountId{constants.Dave_Num0, constants.Alice_Num0},
+					},
+					nil,
+				)
+				mck.On("SubaccountsWithBorrowings", ctx, subaccountsWithBorrowingsRequest).Return(
+					&satypes.QuerySubaccountsWithBorrowingsResponse{
+						SubaccountIds: []satypes.SubaccountId{constants.Carl_Num0, constants.Bob_Num0},
+					},
+					nil,
+				)
 			},
-			expectedSubaccounts: []satypes.Subaccount{
-				constants.Carl_Num0_599USD,
-				constants.Dave_Num0_599USD,
+			expectedSubaccountIds: []satypes.SubaccountId{
+				constants.Carl_Num0,
+				constants.Dave_Num0,
+				constants.Alice_Num0,
+				constants.Bob_Num0,
 			},
 		},
 		"Success Paginated": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
-					Pagination: &query.PageRequest{
-						Limit: df.Liquidation.SubaccountPageLimit,
-					},
-				}
+				mck.On("ClobPairAll", ctx, clobPairAllRequest).Return(&clobtypes.QueryClobPairAllResponse{
+					ClobPair: []clobtypes.ClobPair{constants.ClobPair_Btc},
+				}, nil)
 				nextKey := []byte("next key")
-				response := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Carl_Num0_599USD,
+				mck.On("SubaccountsByPerpetual", ctx, subaccountsByPerpetualRequest(0, nil)).Return(
+					&satypes.QuerySubaccountsByPerpetualResponse{
+						SubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+						Pagination: &query.PageResponse{
+							NextKey: nextKey,
+						},
 					},
-					Pagination: &query.PageResponse{
-						NextKey: nextKey,
+					nil,
+				)
+				mck.On("SubaccountsByPerpetual", ctx, subaccountsByPerpetualRequest(0, nextKey)).Return(
+					&satypes.QuerySubaccountsByPerpetualResponse{
+						SubaccountIds: []satypes.SubaccountId{constants.Dave_Num0},
 					},
-				}
-				mck.On("SubaccountAll", ctx, req).Return(response, nil)
-				req2 := &satypes.QueryAllSubaccountRequest{
-					Pagination: &query.PageRequest{
-						Key:   nextKey,
-						Limit: df.Liquidation.SubaccountPageLimit,
-					},
-				}
-				response2 := &satypes.QuerySubaccountAllResponse{
-					Subaccount: []satypes.Subaccount{
-						constants.Dave_Num0_599USD,
-					},
-				}
-				mck.On("SubaccountAll", ctx, req2).Return(response2, nil)
+					nil,
+				)
+				mck.On("SubaccountsWithBorrowings", ctx, subaccountsWithBorrowingsRequest).Return(
+					&satypes.QuerySubaccountsWithBorrowingsResponse{},
+					nil,
+				)
 			},
-			expectedSubaccounts: []satypes.Subaccount{
-				constants.Carl_Num0_599USD,
-				constants.Dave_Num0_599USD,
+			expectedSubaccountIds: []satypes.SubaccountId{
+				constants.Carl_Num0,
+				constants.Dave_Num0,
 			},
 		},
 		"Errors are propagated": {
 			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
-				req := &satypes.QueryAllSubaccountRequest{
-					Pagination: &query.PageRequest{
-						Limit: df.Liquidation.SubaccountPageLimit,
-					},
-				}
-				mck.On("SubaccountAll", ctx, req).Return(nil, errors.New("test error"))
+				mck.On("ClobPairAll", ctx, clobPairAllRequest).Return(&clobtypes.QueryClobPairAllResponse{}, nil)
+				mck.On("SubaccountsWithBorrowings", ctx, subaccountsWithBorrowingsRequest).Return(
+					nil,
+					errors.New("test error"),
+				)
 			},
 			expectedError: errors.New("test error"),
 		},
@@ -101,16 +130,17 @@ func TestGetAllSubaccounts(t *testing.T) {
 			tc.setupMocks(grpc.Ctx, queryClientMock)
 
 			daemonClient := client.NewClient(log.NewNopLogger())
-			actual, err := client.GetAllSubaccounts(
+			actual, err := client.GetSubaccountIdsToCheck(
 				daemonClient,
 				grpc.Ctx,
 				queryClientMock,
+				queryClientMock,
 				df.Liquidation.SubaccountPageLimit,
 			)
 			if err != nil {
 				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
-				require.Equal(t, tc.expectedSubaccounts, actual)
+				require.Equal(t, tc.expectedSubaccountIds, actual)
 			}
 		})
 	}
@@ -119,6 +119,12 @@ type ExchangePrice struct {
 	ExchangeId     string     `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
 	Price          uint64     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
 	LastUpdateTime *time.Time `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	// The volume traded on the exchange over the last 24 hours, in units of the
+	// market's base asset, as the fixed-point number
+	// `volume * 10^volume_exponent`. Zero if the exchange does not report volume.
+	Volume uint64 `protobuf:"varint,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	// The exponent of `volume`.
+	VolumeExponent int32 `protobuf:"zigzag32,5,opt,name=volume_exponent,json=volumeExponent,proto3" json:"volume_exponent,omitempty"`
 }
 
 func (m *ExchangePrice) Reset()         { *m = ExchangePrice{} }
@@ -175,6 +181,20 @@ func (m *ExchangePrice) GetLastUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ExchangePrice) GetVolume() uint64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *ExchangePrice) GetVolumeExponent() int32 {
+	if m != nil {
+		return m.VolumeExponent
+	}
+	return 0
+}
+
 // MarketPriceUpdate represents an update to a single market
 type MarketPriceUpdate struct {
 	MarketId       uint32           `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -228,11 +248,209 @@ func (m *MarketPriceUpdate) GetExchangePrices() []*ExchangePrice {
 	return nil
 }
 
+// ExchangeHealthScoresRequest is a request message for querying exchange
+// health scores.
+type ExchangeHealthScoresRequest struct {
+	// The markets to return health scores for. All markets are returned if empty.
+	MarketIds []uint32 `protobuf:"varint,1,rep,packed,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+}
+
+func (m *ExchangeHealthScoresRequest) Reset()         { *m = ExchangeHealthScoresRequest{} }
+func (m *ExchangeHealthScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeHealthScoresRequest) ProtoMessage()    {}
+func (*ExchangeHealthScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{4}
+}
+func (m *ExchangeHealthScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeHealthScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeHealthScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeHealthScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeHealthScoresRequest.Merge(m, src)
+}
+func (m *ExchangeHealthScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeHealthScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeHealthScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeHealthScoresRequest proto.InternalMessageInfo
+
+func (m *ExchangeHealthScoresRequest) GetMarketIds() []uint32 {
+	if m != nil {
+		return m.MarketIds
+	}
+	return nil
+}
+
+// ExchangeHealthScoresResponse is a response message containing exchange
+// health scores.
+type ExchangeHealthScoresResponse struct {
+	ExchangeHealthScores []ExchangeHealthScore `protobuf:"bytes,1,rep,name=exchange_health_scores,json=exchangeHealthScores,proto3" json:"exchange_health_scores"`
+}
+
+func (m *ExchangeHealthScoresResponse) Reset()         { *m = ExchangeHealthScoresResponse{} }
+func (m *ExchangeHealthScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*ExchangeHealthScoresResponse) ProtoMessage()    {}
+func (*ExchangeHealthScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{5}
+}
+func (m *ExchangeHealthScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeHealthScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeHealthScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeHealthScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeHealthScoresResponse.Merge(m, src)
+}
+func (m *ExchangeHealthScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeHealthScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeHealthScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeHealthScoresResponse proto.InternalMessageInfo
+
+func (m *ExchangeHealthScoresResponse) GetExchangeHealthScores() []ExchangeHealthScore {
+	if m != nil {
+		return m.ExchangeHealthScores
+	}
+	return nil
+}
+
+// ExchangeHealthScore represents the health of a specific exchange's prices for
+// a single market. All rates are moving averages over recent price reads, in
+// parts-per-million.
+type ExchangeHealthScore struct {
+	MarketId   uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	ExchangeId string `protobuf:"bytes,2,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	// The overall health score of the exchange, where 1_000_000 is perfectly
+	// healthy and 0 is completely unhealthy.
+	ScorePpm uint32 `protobuf:"varint,3,opt,name=score_ppm,json=scorePpm,proto3" json:"score_ppm,omitempty"`
+	// The average deviation of the exchange's price from the median price of all
+	// exchanges.
+	DeviationPpm uint32 `protobuf:"varint,4,opt,name=deviation_ppm,json=deviationPpm,proto3" json:"deviation_ppm,omitempty"`
+	// The rate at which the exchange's price was too stale to be used.
+	StaleRatePpm uint32 `protobuf:"varint,5,opt,name=stale_rate_ppm,json=staleRatePpm,proto3" json:"stale_rate_ppm,omitempty"`
+	// The rate at which the exchange's price was rejected as an outlier.
+	ErrorRatePpm uint32 `protobuf:"varint,6,opt,name=error_rate_ppm,json=errorRatePpm,proto3" json:"error_rate_ppm,omitempty"`
+	// The time of the last price update from the exchange.
+	LastUpdateTime *time.Time `protobuf:"bytes,7,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+}
+
+func (m *ExchangeHealthScore) Reset()         { *m = ExchangeHealthScore{} }
+func (m *ExchangeHealthScore) String() string { return proto.CompactTextString(m) }
+func (*ExchangeHealthScore) ProtoMessage()    {}
+func (*ExchangeHealthScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{6}
+}
+func (m *ExchangeHealthScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeHealthScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeHealthScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeHealthScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeHealthScore.Merge(m, src)
+}
+func (m *ExchangeHealthScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeHealthScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeHealthScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeHealthScore proto.InternalMessageInfo
+
+func (m *ExchangeHealthScore) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *ExchangeHealthScore) GetExchangeId() string {
+	if m != nil {
+		return m.ExchangeId
+	}
+	return ""
+}
+
+func (m *ExchangeHealthScore) GetScorePpm() uint32 {
+	if m != nil {
+		return m.ScorePpm
+	}
+	return 0
+}
+
+func (m *ExchangeHealthScore) GetDeviationPpm() uint32 {
+	if m != nil {
+		return m.DeviationPpm
+	}
+	return 0
+}
+
+func (m *ExchangeHealthScore) GetStaleRatePpm() uint32 {
+	if m != nil {
+		return m.StaleRatePpm
+	}
+	return 0
+}
+
+func (m *ExchangeHealthScore) GetErrorRatePpm() uint32 {
+	if m != nil {
+		return m.ErrorRatePpm
+	}
+	return 0
+}
+
+func (m *ExchangeHealthScore) GetLastUpdateTime() *time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UpdateMarketPricesRequest)(nil), "dydxprotocol.daemons.pricefeed.UpdateMarketPricesRequest")
 	proto.RegisterType((*UpdateMarketPricesResponse)(nil), "dydxprotocol.daemons.pricefeed.UpdateMarketPricesResponse")
 	proto.RegisterType((*ExchangePrice)(nil), "dydxprotocol.daemons.pricefeed.ExchangePrice")
 	proto.RegisterType((*MarketPriceUpdate)(nil), "dydxprotocol.daemons.pricefeed.MarketPriceUpdate")
+	proto.RegisterType((*ExchangeHealthScoresRequest)(nil), "dydxprotocol.daemons.pricefeed.ExchangeHealthScoresRequest")
+	proto.RegisterType((*ExchangeHealthScoresResponse)(nil), "dydxprotocol.daemons.pricefeed.ExchangeHealthScoresResponse")
+	proto.RegisterType((*ExchangeHealthScore)(nil), "dydxprotocol.daemons.pricefeed.ExchangeHealthScore")
 }
 
 func init() {
@@ -240,35 +458,48 @@ func init() {
 }
 
 var fileDescriptor_3d8cd2726a0e97cb = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0x2d, 0xa0, 0xf6, 0xa2, 0x96, 0x72, 0xca, 0x60, 0x0c, 0xb2, 0x2d, 0x4f, 0x5e,
-	0x7a, 0x16, 0x81, 0x05, 0x16, 0xa4, 0x4a, 0x20, 0x75, 0x00, 0x21, 0xf3, 0x22, 0xc1, 0x62, 0x5d,
-	0x7c, 0x4f, 0x1d, 0x8b, 0xd8, 0x67, 0x7c, 0xe7, 0xaa, 0x6c, 0x8c, 0x2c, 0x48, 0x9d, 0xf8, 0x00,
-	0x7c, 0x9a, 0x8e, 0x19, 0x99, 0x00, 0x25, 0x5f, 0x04, 0xf9, 0xce, 0x89, 0x12, 0x02, 0x89, 0xc4,
-	0xf6, 0xbc, 0xfd, 0xef, 0xfe, 0xcf, 0xcf, 0x3e, 0x1c, 0xf1, 0x8f, 0xfc, 0xa2, 0xaa, 0x85, 0x12,
-	0xa9, 0x18, 0x47, 0x9c, 0x41, 0x21, 0x4a, 0x19, 0x55, 0x75, 0x9e, 0xc2, 0x19, 0x00, 0x37, 0x51,
-	0xd2, 0x86, 0x54, 0x4f, 0x11, 0x77, 0x59, 0x40, 0x3b, 0x01, 0x5d, 0x08, 0x9c, 0x7e, 0x26, 0x32,
-	0xa1, 0xfb, 0x51, 0x1b, 0x19, 0x95, 0xe3, 0x65, 0x42, 0x64, 0x63, 0x88, 0x74, 0x36, 0x6c, 0xce,
-	0x22, 0x95, 0x17, 0x20, 0x15, 0x2b, 0x2a, 0x33, 0x10, 0x7c, 0x42, 0xf8, 0xf6, 0xeb, 0x8a, 0x33,
-	0x05, 0xcf, 0x58, 0xfd, 0x1e, 0xd4, 0x8b, 0xf6, 0x40, 0x19, 0xc3, 0x87, 0x06, 0xa4, 0x22, 0x29,
-	0xee, 0x17, 0xba, 0x9c, 0x18, 0x3f, 0x8d, 0x9e, 0x94, 0x36, 0xf2, 0x77, 0xc3, 0xde, 0xe0, 0x1e,
-	0xdd, 0xec, 0x89, 0x2e, 0x1d, 0x69, 0xee, 0x88, 0x49, 0xf1, 0x67, 0x49, 0x06, 0x77, 0xb1, 0xf3,
-	0x37, 0x07, 0xb2, 0x12, 0xa5, 0x84, 0xe0, 0x2b, 0xc2, 0x07, 0x4f, 0x2e, 0xd2, 0x11, 0x2b, 0x33,
-	0xd0, 0x2d, 0xe2, 0xe1, 0x1e, 0x74, 0x85, 0x24, 0xe7, 0x36, 0xf2, 0x51, 0xb8, 0x1f, 0xe3, 0x79,
-	0xe9, 0x94, 0x93, 0x3e, 0xbe, 0xae, 0x3d, 0xd8, 0x3b, 0x3e, 0x0a, 0xaf, 0xc5, 0x26, 0x21, 0xcf,
-	0xf1, 0xd1, 0x98, 0x49, 0xd5, 0xed, 0x90, 0xb4, 0x20, 0xec, 0x5d, 0x1f, 0x85, 0xbd, 0x81, 0x43,
-	0x0d, 0x25, 0x3a, 0xa7, 0x44, 0x5f, 0xcd, 0x29, 0x9d, 0xec, 0x5d, 0xfd, 0xf0, 0xd0, 0xe5, 0x4f,
-	0x0f, 0xc5, 0x87, 0xad, 0xda, 0x18, 0x6d, 0xdb, 0xc1, 0x67, 0x84, 0x6f, 0xad, 0x2d, 0x48, 0xee,
-	0xe0, 0xfd, 0x8e, 0x58, 0x67, 0xed, 0x20, 0xde, 0x33, 0x85, 0x53, 0x4e, 0xde, 0xe0, 0x9b, 0x0b,
-	0xe7, 0xda, 0x94, 0xb4, 0x77, 0x34, 0xc9, 0xe3, 0x6d, 0x24, 0x57, 0x08, 0xc4, 0x87, 0xb0, 0x9c,
-	0xca, 0xc1, 0x37, 0x84, 0x8f, 0x74, 0xf8, 0x14, 0x80, 0xbf, 0x84, 0xfa, 0xbc, 0xdd, 0xf7, 0x0b,
-	0xc2, 0x64, 0x9d, 0x2b, 0x79, 0xb8, 0xed, 0xaa, 0x7f, 0xfe, 0x0d, 0xce, 0xa3, 0xff, 0x91, 0x76,
-	0x9f, 0xd1, 0x3a, 0x79, 0x7b, 0x35, 0x75, 0xd1, 0x64, 0xea, 0xa2, 0x5f, 0x53, 0x17, 0x5d, 0xce,
-	0x5c, 0x6b, 0x32, 0x73, 0xad, 0xef, 0x33, 0xd7, 0x7a, 0xf7, 0x38, 0xcb, 0xd5, 0xa8, 0x19, 0xd2,
-	0x54, 0x14, 0xab, 0xcf, 0xe2, 0xfc, 0xc1, 0x71, 0x3a, 0x62, 0x79, 0x19, 0x6d, 0x78, 0x28, 0xac,
-	0xca, 0x87, 0x37, 0x74, 0xff, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x1e, 0xd0, 0x18,
-	0x55, 0x03, 0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x18, 0xed, 0x94, 0x82, 0xf4, 0xc3, 0x16, 0x18, 0x1b, 0xb2, 0x16, 0xdc, 0x36, 0xab, 0x89, 0xbd,
+	0xb0, 0x1b, 0xc1, 0x8b, 0x4a, 0x62, 0x42, 0x82, 0x91, 0x83, 0x86, 0x2c, 0x6a, 0xa2, 0x97, 0xcd,
+	0xb2, 0xfb, 0xd1, 0x6e, 0xec, 0xee, 0xac, 0x3b, 0xd3, 0x06, 0x6f, 0x1e, 0xbd, 0x18, 0x49, 0xfc,
+	0x25, 0xde, 0xfc, 0x09, 0x1c, 0xb9, 0xe9, 0x09, 0x0d, 0xfc, 0x11, 0xb3, 0x33, 0xbb, 0xa5, 0x40,
+	0x2d, 0xa6, 0xb7, 0x99, 0x37, 0xef, 0xcd, 0xbc, 0xf9, 0xbe, 0xb7, 0xb3, 0x60, 0xf9, 0x1f, 0xfd,
+	0x83, 0x38, 0x61, 0x82, 0x79, 0xac, 0x6b, 0xf9, 0x2e, 0x86, 0x2c, 0xe2, 0x56, 0x9c, 0x04, 0x1e,
+	0xee, 0x23, 0xfa, 0x6a, 0xe4, 0xa4, 0x43, 0x53, 0xb2, 0xa8, 0x3e, 0x2c, 0x30, 0x33, 0x81, 0x39,
+	0x10, 0xd4, 0x6b, 0x6d, 0xd6, 0x66, 0x72, 0xdd, 0x4a, 0x47, 0x4a, 0x55, 0x6f, 0xb4, 0x19, 0x6b,
+	0x77, 0xd1, 0x92, 0xb3, 0xbd, 0xde, 0xbe, 0x25, 0x82, 0x10, 0xb9, 0x70, 0xc3, 0x58, 0x11, 0x8c,
+	0x4f, 0x04, 0x6e, 0xbf, 0x8e, 0x7d, 0x57, 0xe0, 0x0b, 0x37, 0x79, 0x8f, 0x62, 0x27, 0xdd, 0x90,
+	0xdb, 0xf8, 0xa1, 0x87, 0x5c, 0x50, 0x0f, 0x6a, 0xa1, 0x84, 0x1d, 0xe5, 0xa7, 0x27, 0x99, 0x5c,
+	0x23, 0xcd, 0xa9, 0xd6, 0xdc, 0xda, 0x03, 0x73, 0xbc, 0x27, 0x73, 0x68, 0x4b, 0x75, 0x86, 0x4d,
+	0xc3, 0xcb, 0x10, 0x37, 0x56, 0xa0, 0x3e, 0xca, 0x01, 0x8f, 0x59, 0xc4, 0xd1, 0xf8, 0x49, 0xa0,
+	0xb2, 0x75, 0xe0, 0x75, 0xdc, 0xa8, 0x8d, 0x72, 0x89, 0x36, 0x60, 0x0e, 0x33, 0xc0, 0x09, 0x7c,
+	0x8d, 0x34, 0x49, 0xab, 0x6c, 0x43, 0x0e, 0x6d, 0xfb, 0xb4, 0x06, 0xd3, 0xd2, 0x83, 0x56, 0x6c,
+	0x92, 0x56, 0xc9, 0x56, 0x13, 0xfa, 0x12, 0x16, 0xba, 0x2e, 0x17, 0xd9, 0x1d, 0x9c, 0xb4, 0x10,
+	0xda, 0x54, 0x93, 0xb4, 0xe6, 0xd6, 0xea, 0xa6, 0xaa, 0x92, 0x99, 0x57, 0xc9, 0x7c, 0x95, 0x57,
+	0x69, 0x73, 0xf6, 0xe8, 0xa4, 0x41, 0x0e, 0x7f, 0x37, 0x88, 0x5d, 0x4d, 0xd5, 0xca, 0x68, 0xba,
+	0x4c, 0x97, 0x60, 0xa6, 0xcf, 0xba, 0xbd, 0x10, 0xb5, 0x92, 0x3c, 0x26, 0x9b, 0xd1, 0xfb, 0x30,
+	0xaf, 0x46, 0x0e, 0x1e, 0xc4, 0x2c, 0xc2, 0x48, 0x68, 0xd3, 0x4d, 0xd2, 0x5a, 0xb4, 0xab, 0x0a,
+	0xde, 0xca, 0x50, 0xe3, 0x33, 0x81, 0xc5, 0x2b, 0x15, 0xa2, 0xcb, 0x50, 0xce, 0x4a, 0x9e, 0xdd,
+	0xad, 0x62, 0xcf, 0x2a, 0x60, 0xdb, 0xa7, 0x6f, 0x60, 0x7e, 0x70, 0x75, 0x79, 0x2b, 0xae, 0x15,
+	0x65, 0x2b, 0x56, 0xaf, 0x6b, 0xc5, 0x85, 0x12, 0xda, 0x55, 0x1c, 0x9e, 0x72, 0x63, 0x03, 0x96,
+	0x73, 0xc2, 0x73, 0x74, 0xbb, 0xa2, 0xb3, 0xeb, 0xb1, 0xe4, 0x3c, 0x06, 0x77, 0x00, 0x06, 0x9e,
+	0x54, 0xf3, 0x2b, 0x76, 0x39, 0x37, 0xc5, 0x8d, 0xaf, 0x04, 0x56, 0x46, 0xcb, 0x55, 0x0f, 0x29,
+	0x83, 0xa5, 0x81, 0xed, 0x8e, 0x24, 0x38, 0x5c, 0x32, 0xb2, 0x20, 0xad, 0xff, 0xaf, 0xfb, 0xa1,
+	0xdd, 0x37, 0x4b, 0x47, 0x27, 0x8d, 0x82, 0x5d, 0xc3, 0x11, 0x07, 0x1b, 0xdf, 0x8b, 0x70, 0x6b,
+	0x84, 0x66, 0x7c, 0x71, 0x2f, 0xe5, 0xaa, 0x78, 0x25, 0x57, 0xcb, 0x50, 0x96, 0xb6, 0x9d, 0x38,
+	0x0e, 0x65, 0x74, 0x2a, 0xf6, 0xac, 0x04, 0x76, 0xe2, 0x90, 0xde, 0x85, 0x8a, 0x8f, 0xfd, 0xc0,
+	0x15, 0x01, 0x8b, 0x24, 0xa1, 0x24, 0x09, 0x37, 0x07, 0x60, 0x4a, 0xba, 0x07, 0x55, 0x2e, 0xdc,
+	0x2e, 0x3a, 0x49, 0x1a, 0xc1, 0x94, 0x35, 0xad, 0x58, 0x12, 0xb5, 0x5d, 0x81, 0x19, 0x0b, 0x93,
+	0x84, 0x25, 0xe7, 0xac, 0x19, 0xc5, 0x92, 0x68, 0xce, 0x1a, 0x95, 0xe7, 0x1b, 0x93, 0xe7, 0x79,
+	0xed, 0x47, 0x11, 0x16, 0x64, 0x1c, 0x9e, 0x21, 0xfa, 0xbb, 0x98, 0xf4, 0xd3, 0x8f, 0xe6, 0x0b,
+	0x01, 0x7a, 0xf5, 0xe3, 0xa4, 0x8f, 0xae, 0x6b, 0xd8, 0x3f, 0x9f, 0x94, 0xfa, 0xe3, 0x49, 0xa4,
+	0xd9, 0x5b, 0x50, 0xa0, 0xdf, 0x08, 0xd4, 0x46, 0x45, 0x8d, 0x3e, 0x99, 0x20, 0x42, 0x03, 0x4f,
+	0x1b, 0x93, 0x89, 0x73, 0x57, 0x9b, 0x6f, 0x8f, 0x4e, 0x75, 0x72, 0x7c, 0xaa, 0x93, 0x3f, 0xa7,
+	0x3a, 0x39, 0x3c, 0xd3, 0x0b, 0xc7, 0x67, 0x7a, 0xe1, 0xd7, 0x99, 0x5e, 0x78, 0xf7, 0xb4, 0x1d,
+	0x88, 0x4e, 0x6f, 0xcf, 0xf4, 0x58, 0x78, 0xf1, 0xc5, 0xef, 0x3f, 0x5c, 0xf5, 0x3a, 0x6e, 0x10,
+	0x59, 0x63, 0xfe, 0x01, 0x6e, 0x1c, 0xec, 0xcd, 0xc8, 0xf5, 0xf5, 0xbf, 0x03, 0x00, 0x78, 0x50,
+	0xc1, 0xf8, 0x30, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +516,8 @@ const _ = grpc.SupportPackageIsVersion4
 type PriceFeedServiceClient interface {
 	// Updates market prices.
 	UpdateMarketPrices(ctx context.Context, in *UpdateMarketPricesRequest, opts ...grpc.CallOption) (*UpdateMarketPricesResponse, error)
+	// Queries the health scores of each exchange for each market.
+	ExchangeHealthScores(ctx context.Context, in *ExchangeHealthScoresRequest, opts ...grpc.CallOption) (*ExchangeHealthScoresResponse, error)
 }
 
 type priceFeedServiceClient struct {
@@ -304,10 +537,21 @@ func (c *priceFeedServiceClient) UpdateMarketPrices(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *priceFeedServiceClient) ExchangeHealthScores(ctx context.Context, in *ExchangeHealthScoresRequest, opts ...grpc.CallOption) (*ExchangeHealthScoresResponse, error) {
+	out := new(ExchangeHealthScoresResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.daemons.pricefeed.PriceFeedService/ExchangeHealthScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PriceFeedServiceServer is the server API for PriceFeedService service.
 type PriceFeedServiceServer interface {
 	// Updates market prices.
 	UpdateMarketPrices(context.Context, *UpdateMarketPricesRequest) (*UpdateMarketPricesResponse, error)
+	// Queries the health scores of each exchange for each market.
+	ExchangeHealthScores(context.Context, *ExchangeHealthScoresRequest) (*ExchangeHealthScoresResponse, error)
 }
 
 // UnimplementedPriceFeedServiceServer can be embedded to have forward compatible implementations.
@@ -317,6 +561,9 @@ type UnimplementedPriceFeedServiceServer struct {
 func (*UnimplementedPriceFeedServiceServer) UpdateMarketPrices(ctx context.Context, req *UpdateMarketPricesRequest) (*UpdateMarketPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketPrices not implemented")
 }
+func (*UnimplementedPriceFeedServiceServer) ExchangeHealthScores(ctx context.Context, req *ExchangeHealthScoresRequest) (*ExchangeHealthScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeHealthScores not implemented")
+}
 
 func RegisterPriceFeedServiceServer(s grpc1.Server, srv PriceFeedServiceServer) {
 	s.RegisterService(&_PriceFeedService_serviceDesc, srv)
@@ -340,6 +587,24 @@ func _PriceFeedService_UpdateMarketPrices_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PriceFeedService_ExchangeHealthScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeHealthScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceFeedServiceServer).ExchangeHealthScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.daemons.pricefeed.PriceFeedService/ExchangeHealthScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceFeedServiceServer).ExchangeHealthScores(ctx, req.(*ExchangeHealthScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PriceFeedService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.daemons.pricefeed.PriceFeedService",
 	HandlerType: (*PriceFeedServiceServer)(nil),
@@ -348,6 +613,10 @@ var _PriceFeedService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMarketPrices",
 			Handler:    _PriceFeedService_UpdateMarketPrices_Handler,
 		},
+		{
+			MethodName: "ExchangeHealthScores",
+			Handler:    _PriceFeedService_ExchangeHealthScores_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/daemons/pricefeed/price_feed.proto",
@@ -433,6 +702,16 @@ func (m *ExchangePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VolumeExponent != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64((uint32(m.VolumeExponent)<<1)^uint32((m.VolumeExponent>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Volume != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LastUpdateTime != nil {
 		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime):])
 		if err1 != nil {
@@ -500,6 +779,149 @@ func (m *MarketPriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExchangeHealthScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeHealthScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeHealthScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketIds) > 0 {
+		dAtA3 := make([]byte, len(m.MarketIds)*10)
+		var j2 int
+		for _, num := range m.MarketIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintPriceFeed(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeHealthScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeHealthScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeHealthScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeHealthScores) > 0 {
+		for iNdEx := len(m.ExchangeHealthScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeHealthScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeHealthScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeHealthScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeHealthScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintPriceFeed(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ErrorRatePpm != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.ErrorRatePpm))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StaleRatePpm != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.StaleRatePpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DeviationPpm != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.DeviationPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScorePpm != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.ScorePpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExchangeId) > 0 {
+		i -= len(m.ExchangeId)
+		copy(dAtA[i:], m.ExchangeId)
+		i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.ExchangeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPriceFeed(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPriceFeed(v)
 	base := offset
@@ -552,6 +974,12 @@ func (m *ExchangePrice) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime)
 		n += 1 + l + sovPriceFeed(uint64(l))
 	}
+	if m.Volume != 0 {
+		n += 1 + sovPriceFeed(uint64(m.Volume))
+	}
+	if m.VolumeExponent != 0 {
+		n += 1 + sozPriceFeed(uint64(m.VolumeExponent))
+	}
 	return n
 }
 
@@ -573,14 +1001,77 @@ func (m *MarketPriceUpdate) Size() (n int) {
 	return n
 }
 
-func sovPriceFeed(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPriceFeed(x uint64) (n int) {
-	return sovPriceFeed(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *UpdateMarketPricesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *ExchangeHealthScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketIds) > 0 {
+		l = 0
+		for _, e := range m.MarketIds {
+			l += sovPriceFeed(uint64(e))
+		}
+		n += 1 + sovPriceFeed(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ExchangeHealthScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeHealthScores) > 0 {
+		for _, e := range m.ExchangeHealthScores {
+			l = e.Size()
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExchangeHealthScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovPriceFeed(uint64(m.MarketId))
+	}
+	l = len(m.ExchangeId)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	if m.ScorePpm != 0 {
+		n += 1 + sovPriceFeed(uint64(m.ScorePpm))
+	}
+	if m.DeviationPpm != 0 {
+		n += 1 + sovPriceFeed(uint64(m.DeviationPpm))
+	}
+	if m.StaleRatePpm != 0 {
+		n += 1 + sovPriceFeed(uint64(m.StaleRatePpm))
+	}
+	if m.ErrorRatePpm != 0 {
+		n += 1 + sovPriceFeed(uint64(m.ErrorRatePpm))
+	}
+	if m.LastUpdateTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime)
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	return n
+}
+
+func sovPriceFeed(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceFeed(x uint64) (n int) {
+	return sovPriceFeed(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateMarketPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -829,6 +1320,46 @@ func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeExponent", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.VolumeExponent = v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPriceFeed(dAtA[iNdEx:])
@@ -953,6 +1484,429 @@ func (m *MarketPriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExchangeHealthScoresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeHealthScoresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeHealthScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPriceFeed
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MarketIds = append(m.MarketIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPriceFeed
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPriceFeed
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPriceFeed
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MarketIds) == 0 {
+					m.MarketIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPriceFeed
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MarketIds = append(m.MarketIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeHealthScoresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeHealthScoresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeHealthScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeHealthScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeHealthScores = append(m.ExchangeHealthScores, ExchangeHealthScore{})
+			if err := m.ExchangeHealthScores[len(m.ExchangeHealthScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeHealthScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeHealthScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeHealthScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScorePpm", wireType)
+			}
+			m.ScorePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScorePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationPpm", wireType)
+			}
+			m.DeviationPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleRatePpm", wireType)
+			}
+			m.StaleRatePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleRatePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRatePpm", wireType)
+			}
+			m.ErrorRatePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorRatePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPriceFeed(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
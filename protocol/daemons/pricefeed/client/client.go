@@ -19,6 +19,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/cometbft/cometbft/libs/log"
 )
@@ -122,7 +123,7 @@ func (c *Client) Stop() {
 // zero markets, pass in an initialExchangeMarketConfig object with an empty map of market tickers for that
 // exchange.
 // Implementation:
-//  1. Establish connections to gRPC servers.
+//  1. Establish connections to gRPC servers, and to the Ethereum node if configured.
 //  2. Validate daemon configuration.
 //  3. Initialize synchronized, in-memory shared daemon configuration.
 //  4. Start PriceEncoder and PriceFetcher per exchange. Each price fetcher adds itself to the shared
@@ -164,6 +165,25 @@ func (c *Client) start(ctx context.Context,
 
 	pricesQueryClient := pricestypes.NewQueryClient(queryConn)
 
+	// Connect to the Ethereum node of the bridge daemon to query on-chain exchanges. If no node is configured,
+	// on-chain exchanges are not queried.
+	queryHandler := &handler.ExchangeQueryHandlerImpl{TimeProvider: &libtime.TimeProviderImpl{}}
+	var disabledExchangeIds []types.ExchangeId
+	if daemonFlags.Bridge.EthRpcEndpoint != "" {
+		ethClient, err := ethclient.Dial(daemonFlags.Bridge.EthRpcEndpoint)
+		if err != nil {
+			c.logger.Error("Failed to establish connection to Ethereum node", "error", err)
+			return err
+		}
+		defer ethClient.Close()
+		queryHandler.EthClient = ethClient
+	} else {
+		exchangeIdToQueryConfig, disabledExchangeIds = removeOnChainExchanges(
+			exchangeIdToQueryConfig,
+			exchangeIdToExchangeDetails,
+		)
+	}
+
 	// 2. Validate daemon configuration.
 	if err := validateDaemonConfiguration(
 		exchangeIdToQueryConfig,
@@ -181,6 +201,7 @@ func (c *Client) start(ctx context.Context,
 	// 3. Initialize synchronized, in-memory shared daemon configuration.
 	priceFeedMutableMarketConfigs := types.NewPriceFeedMutableMarketConfigs(
 		canonicalExchangeIds,
+		disabledExchangeIds,
 	)
 
 	exchangeToMarketPrices, err := types.NewExchangeToMarketPrices(canonicalExchangeIds)
@@ -189,7 +210,6 @@ func (c *Client) start(ctx context.Context,
 	}
 
 	// 4. Start PriceEncoder and PriceFetcher per exchange.
-	timeProvider := &libtime.TimeProviderImpl{}
 	for _exchangeId := range exchangeIdToQueryConfig {
 		// Assign these within the loop to avoid unexpected values being passed to the goroutines.
 		exchangeId := _exchangeId
@@ -201,6 +221,11 @@ func (c *Client) start(ctx context.Context,
 			return fmt.Errorf("no exchange details exists for exchangeId: %v", exchangeId)
 		}
 
+		// Exchanges are only streamed if streaming is enabled, and are polled otherwise.
+		if !daemonFlags.Price.StreamingEnabled {
+			exchangeDetails.StreamDetails = nil
+		}
+
 		// Instantiate shared buffered channel to be written to by the price fetcher and read from
 		// by the price encoder.
 		bCh := make(chan *price_fetcher.PriceFetcherSubtaskResponse, constants.FixedBufferSize)
@@ -227,7 +252,7 @@ func (c *Client) start(ctx context.Context,
 				priceFeedMutableMarketConfigs,
 				*exchangeConfig,
 				exchangeDetails,
-				&handler.ExchangeQueryHandlerImpl{TimeProvider: timeProvider},
+				queryHandler,
 				c.logger,
 				bCh,
 			)
@@ -323,6 +348,27 @@ func StartNewClient(
 // The daemon configuration is valid iff:
 // 1) The exchangeIdToExchangeDetails map has an entry for each exchange.
 // 2) The static exchange names map has an entry for each exchange, and each name is unique.
+// removeOnChainExchanges returns a copy of the exchange query configs without any on-chain exchanges, along with
+// the ids of the removed exchanges.
+func removeOnChainExchanges(
+	exchangeIdToQueryConfig map[types.ExchangeId]*types.ExchangeQueryConfig,
+	exchangeIdToExchangeDetails map[types.ExchangeId]types.ExchangeQueryDetails,
+) (
+	offChainExchangeIdToQueryConfig map[types.ExchangeId]*types.ExchangeQueryConfig,
+	onChainExchangeIds []types.ExchangeId,
+) {
+	offChainExchangeIdToQueryConfig = make(map[types.ExchangeId]*types.ExchangeQueryConfig, len(exchangeIdToQueryConfig))
+	for exchangeId, queryConfig := range exchangeIdToQueryConfig {
+		if exchangeDetails, exists := exchangeIdToExchangeDetails[exchangeId]; exists &&
+			exchangeDetails.OnChainDetails != nil {
+			onChainExchangeIds = append(onChainExchangeIds, exchangeId)
+			continue
+		}
+		offChainExchangeIdToQueryConfig[exchangeId] = queryConfig
+	}
+	return offChainExchangeIdToQueryConfig, onChainExchangeIds
+}
+
 func validateDaemonConfiguration(
 	exchangeIdToQueryConfig map[types.ExchangeId]*types.ExchangeQueryConfig,
 	exchangeIdToExchangeDetails map[types.ExchangeId]types.ExchangeQueryDetails,
@@ -7,6 +7,7 @@ import (
 	appflags "github.com/dydxprotocol/v4-chain/protocol/app/flags"
 	daemonflags "github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
 	pricefeed_constants "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants/exchange_common"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_fetcher"
 	daemonserver "github.com/dydxprotocol/v4-chain/protocol/daemons/server"
 	pricefeed_types "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/pricefeed"
@@ -128,6 +129,27 @@ var (
 	testExchangeQueryConfigLength = len(constants.TestExchangeQueryConfigs)
 )
 
+// withOnChainExchange returns a copy of the test exchange query configs and details with the UniswapV3
+// exchange added.
+func withOnChainExchange() (
+	map[types.ExchangeId]*types.ExchangeQueryConfig,
+	map[types.ExchangeId]types.ExchangeQueryDetails,
+) {
+	exchangeIdToQueryConfig := make(map[types.ExchangeId]*types.ExchangeQueryConfig)
+	for exchangeId, queryConfig := range constants.TestExchangeQueryConfigs {
+		exchangeIdToQueryConfig[exchangeId] = queryConfig
+	}
+	exchangeIdToExchangeDetails := make(map[types.ExchangeId]types.ExchangeQueryDetails)
+	for exchangeId, exchangeDetails := range constants.TestExchangeIdToExchangeQueryDetails {
+		exchangeIdToExchangeDetails[exchangeId] = exchangeDetails
+	}
+
+	uniswapV3 := exchange_common.EXCHANGE_ID_UNISWAP_V3
+	exchangeIdToQueryConfig[uniswapV3] = pricefeed_constants.StaticExchangeQueryConfig[uniswapV3]
+	exchangeIdToExchangeDetails[uniswapV3] = pricefeed_constants.StaticExchangeDetails[uniswapV3]
+	return exchangeIdToQueryConfig, exchangeIdToExchangeDetails
+}
+
 func TestFixedBufferSize(t *testing.T) {
 	require.Equal(t, fiveKilobytes, pricefeed_constants.FixedBufferSize)
 }
@@ -140,6 +162,7 @@ func TestStart_InvalidConfig(t *testing.T) {
 		initialExchangeMarketConfig map[types.ExchangeId]*types.MutableExchangeMarketConfig
 		exchangeIdToQueryConfig     map[types.ExchangeId]*types.ExchangeQueryConfig
 		exchangeIdToExchangeDetails map[types.ExchangeId]types.ExchangeQueryDetails
+		withOnChainExchange         bool
 
 		// expectations
 		expectedError             error
@@ -177,6 +200,14 @@ func TestStart_InvalidConfig(t *testing.T) {
 			expectCloseGrpcConnection:   true,
 			expectedNumExchangeTasks:    testExchangeQueryConfigLength,
 		},
+		"Valid: on-chain exchanges are not started without an Ethereum node": {
+			mockGrpcClient:            grpc_util.GenerateMockGrpcClientWithOptionalGrpcConnectionErrors(nil, nil, true),
+			withOnChainExchange:       true,
+			expectGrpcConnection:      true,
+			expectCloseTcpConnection:  true,
+			expectCloseGrpcConnection: true,
+			expectedNumExchangeTasks:  testExchangeQueryConfigLength,
+		},
 		"Invalid: empty exchange query config": {
 			mockGrpcClient:            grpc_util.GenerateMockGrpcClientWithOptionalGrpcConnectionErrors(nil, nil, true),
 			exchangeIdToQueryConfig:   map[types.ExchangeId]*types.ExchangeQueryConfig{},
@@ -236,6 +267,12 @@ func TestStart_InvalidConfig(t *testing.T) {
 			// Wait for each encoder and fetcher call to complete.
 			faketaskRunner.WaitGroup.Add(tc.expectedNumExchangeTasks * 2)
 
+			exchangeIdToQueryConfig := tc.exchangeIdToQueryConfig
+			exchangeIdToExchangeDetails := tc.exchangeIdToExchangeDetails
+			if tc.withOnChainExchange {
+				exchangeIdToQueryConfig, exchangeIdToExchangeDetails = withOnChainExchange()
+			}
+
 			// Run Start.
 			client := newClient(log.NewNopLogger())
 			err := client.start(
@@ -243,8 +280,8 @@ func TestStart_InvalidConfig(t *testing.T) {
 				daemonflags.GetDefaultDaemonFlags(),
 				appflags.GetFlagValuesFromOptions(appoptions.GetDefaultTestAppOptions("", nil)),
 				tc.mockGrpcClient,
-				tc.exchangeIdToQueryConfig,
-				tc.exchangeIdToExchangeDetails,
+				exchangeIdToQueryConfig,
+				exchangeIdToExchangeDetails,
 				&faketaskRunner,
 			)
 
@@ -31,6 +31,10 @@ const (
 	EXCHANGE_ID_MEXC types.ExchangeId = "Mexc"
 	// EXCHANGE_ID_COINBASE_PRO is the id for CoinbasePro exchange.
 	EXCHANGE_ID_COINBASE_PRO types.ExchangeId = "CoinbasePro"
+	// EXCHANGE_ID_UNISWAP_V3 is the id for Uniswap v3 pools on Ethereum.
+	EXCHANGE_ID_UNISWAP_V3 types.ExchangeId = "UniswapV3"
+	// EXCHANGE_ID_CHAINLINK is the id for Chainlink aggregators on Ethereum.
+	EXCHANGE_ID_CHAINLINK types.ExchangeId = "Chainlink"
 	// EXCHANGE_ID_TEST_EXCHANGE is the id for test exchange.
 	EXCHANGE_ID_TEST_EXCHANGE types.ExchangeId = "TestExchange"
 	// EXCHANGE_ID_TEST_VOLATILE_EXCHANGE is the id for test volatile exchange.
@@ -0,0 +1,88 @@
+package constants
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/declarative"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+)
+
+// GetExchangeQueryConfigsAndDetails returns the query config and details of every exchange supported by the
+// daemon. These are the static exchanges, merged with the exchanges declared in the exchange definitions
+// file at the given path. Declared exchanges replace static exchanges with the same id. If the path is empty,
+// only the static exchanges are returned.
+func GetExchangeQueryConfigsAndDetails(
+	exchangeDefinitionsFilePath string,
+) (
+	exchangeIdToQueryConfig map[types.ExchangeId]*types.ExchangeQueryConfig,
+	exchangeIdToExchangeDetails map[types.ExchangeId]types.ExchangeQueryDetails,
+	err error,
+) {
+	if exchangeDefinitionsFilePath == "" {
+		return StaticExchangeQueryConfig, StaticExchangeDetails, nil
+	}
+
+	definitions, err := ReadExchangeDefinitionsFile(exchangeDefinitionsFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exchangeIdToQueryConfig = make(map[types.ExchangeId]*types.ExchangeQueryConfig, len(StaticExchangeQueryConfig))
+	for exchangeId, queryConfig := range StaticExchangeQueryConfig {
+		exchangeIdToQueryConfig[exchangeId] = queryConfig
+	}
+	exchangeIdToExchangeDetails = make(map[types.ExchangeId]types.ExchangeQueryDetails, len(StaticExchangeDetails))
+	for exchangeId, exchangeDetails := range StaticExchangeDetails {
+		exchangeIdToExchangeDetails[exchangeId] = exchangeDetails
+	}
+
+	for _, definition := range definitions {
+		exchangeIdToQueryConfig[definition.ExchangeId] = getExchangeQueryConfigFromDefinition(definition)
+		exchangeIdToExchangeDetails[definition.ExchangeId] = declarative.NewExchangeQueryDetails(definition)
+	}
+	return exchangeIdToQueryConfig, exchangeIdToExchangeDetails, nil
+}
+
+// ReadExchangeDefinitionsFile reads and validates the exchange definitions in the json file at the given path.
+func ReadExchangeDefinitionsFile(path string) ([]types.ExchangeDefinition, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exchange definitions file: %w", err)
+	}
+
+	var definitionsJson types.ExchangeDefinitionsJson
+	if err := json.Unmarshal(content, &definitionsJson); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange definitions file: %w", err)
+	}
+	if err := definitionsJson.Validate(); err != nil {
+		return nil, err
+	}
+	return definitionsJson.Exchanges, nil
+}
+
+// getExchangeQueryConfigFromDefinition returns the query config of a declared exchange, using the default
+// query config for any value the definition does not override.
+func getExchangeQueryConfigFromDefinition(definition types.ExchangeDefinition) *types.ExchangeQueryConfig {
+	queryConfig := &types.ExchangeQueryConfig{
+		ExchangeId: definition.ExchangeId,
+		IntervalMs: defaultIntervalMs,
+		TimeoutMs:  defaultTimeoutMs,
+		MaxQueries: defaultMaxQueries,
+	}
+	if definition.IsMultiMarket {
+		queryConfig.MaxQueries = defaultMultiMarketMaxQueries
+	}
+
+	if definition.IntervalMs != 0 {
+		queryConfig.IntervalMs = definition.IntervalMs
+	}
+	if definition.TimeoutMs != 0 {
+		queryConfig.TimeoutMs = definition.TimeoutMs
+	}
+	if definition.MaxQueries != 0 {
+		queryConfig.MaxQueries = definition.MaxQueries
+	}
+	return queryConfig
+}
@@ -0,0 +1,144 @@
+package constants_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants/exchange_common"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testExchangeDefinitions = `{
+  "exchanges": [
+    {
+      "exchangeId": "NewExchange",
+      "url": "https://api.new-exchange.com/tickers",
+      "isMultiMarket": true,
+      "tickersPath": "data",
+      "tickerPath": "symbol",
+      "bidPricePath": "bid",
+      "askPricePath": "ask",
+      "lastPricePath": "last"
+    },
+    {
+      "exchangeId": "CoinbasePro",
+      "url": "https://api.exchange.coinbase.com/products/$/ticker",
+      "bidPricePath": "bid",
+      "askPricePath": "ask",
+      "lastPricePath": "price",
+      "volumePath": "volume",
+      "intervalMs": 5000,
+      "maxQueries": 2
+    }
+  ]
+}`
+)
+
+// writeExchangeDefinitionsFile writes the given content to a temporary exchange definitions file and returns its
+// path.
+func writeExchangeDefinitionsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "exchange_definitions.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestGetExchangeQueryConfigsAndDetails_NoFile(t *testing.T) {
+	queryConfigs, details, err := constants.GetExchangeQueryConfigsAndDetails("")
+	require.NoError(t, err)
+	require.Equal(t, constants.StaticExchangeQueryConfig, queryConfigs)
+	require.Len(t, details, len(constants.StaticExchangeDetails))
+}
+
+func TestGetExchangeQueryConfigsAndDetails_WithFile(t *testing.T) {
+	path := writeExchangeDefinitionsFile(t, testExchangeDefinitions)
+
+	queryConfigs, details, err := constants.GetExchangeQueryConfigsAndDetails(path)
+	require.NoError(t, err)
+
+	// Declared exchanges are added to the static exchanges.
+	require.Len(t, queryConfigs, len(constants.StaticExchangeQueryConfig)+1)
+	require.Len(t, details, len(constants.StaticExchangeDetails)+1)
+
+	require.Equal(
+		t,
+		&types.ExchangeQueryConfig{
+			ExchangeId: "NewExchange",
+			IntervalMs: 2_000,
+			TimeoutMs:  3_000,
+			MaxQueries: 1,
+		},
+		queryConfigs["NewExchange"],
+	)
+	require.Equal(t, "NewExchange", details["NewExchange"].Exchange)
+	require.Equal(t, "https://api.new-exchange.com/tickers", details["NewExchange"].Url)
+	require.True(t, details["NewExchange"].IsMultiMarket)
+
+	// Declared exchanges replace static exchanges with the same id.
+	require.Equal(
+		t,
+		&types.ExchangeQueryConfig{
+			ExchangeId: exchange_common.EXCHANGE_ID_COINBASE_PRO,
+			IntervalMs: 5_000,
+			TimeoutMs:  3_000,
+			MaxQueries: 2,
+		},
+		queryConfigs[exchange_common.EXCHANGE_ID_COINBASE_PRO],
+	)
+	require.Equal(
+		t,
+		"https://api.exchange.coinbase.com/products/$/ticker",
+		details[exchange_common.EXCHANGE_ID_COINBASE_PRO].Url,
+	)
+	require.False(t, details[exchange_common.EXCHANGE_ID_COINBASE_PRO].IsMultiMarket)
+
+	// Static exchanges are not modified.
+	require.Equal(
+		t,
+		"https://api.pro.coinbase.com/products/$/ticker",
+		constants.StaticExchangeDetails[exchange_common.EXCHANGE_ID_COINBASE_PRO].Url,
+	)
+	require.Equal(
+		t,
+		uint32(2_000),
+		constants.StaticExchangeQueryConfig[exchange_common.EXCHANGE_ID_COINBASE_PRO].IntervalMs,
+	)
+	require.NotContains(t, constants.StaticExchangeDetails, "NewExchange")
+}
+
+func TestGetExchangeQueryConfigsAndDetails_Errors(t *testing.T) {
+	tests := map[string]struct {
+		content     string
+		missingFile bool
+		expectedErr string
+	}{
+		"File does not exist": {
+			missingFile: true,
+			expectedErr: "failed to read exchange definitions file",
+		},
+		"Invalid json": {
+			content:     `{"exchanges":[`,
+			expectedErr: "failed to parse exchange definitions file: unexpected end of JSON input",
+		},
+		"Invalid definition": {
+			content: `{"exchanges":[{"exchangeId":"NewExchange","url":"https://api.new-exchange.com/tickers",` +
+				`"isMultiMarket":true}]}`,
+			expectedErr: "invalid exchange definition: bid, ask and last price paths for exchange 'NewExchange' " +
+				"cannot be empty",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "missing.json")
+			if !tc.missingFile {
+				path = writeExchangeDefinitionsFile(t, tc.content)
+			}
+
+			_, _, err := constants.GetExchangeQueryConfigsAndDetails(path)
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	// 5K is chosen to be >> than the number of messages an exchange could send in any period before the
 	// price encoder is able to read the messages from the buffer, even if we add O(10-100) markets dynamically,
@@ -8,4 +10,10 @@ const (
 	// https://stackoverflow.com/questions/37774624/go-http-get-concurrency-and-connection-reset-by-peer.
 	// This is a good number to start with based on the above link. Adjustments can/will be made accordingly.
 	MaxConnectionsPerExchange = 50
+
+	// StreamReconnectInitialBackoff is the delay before reconnecting to an exchange's WebSocket stream after
+	// the connection fails. The delay doubles after every consecutive failure, up to StreamReconnectMaxBackoff,
+	// and is reset once a connection receives messages again.
+	StreamReconnectInitialBackoff = 500 * time.Millisecond
+	StreamReconnectMaxBackoff     = 30 * time.Second
 )
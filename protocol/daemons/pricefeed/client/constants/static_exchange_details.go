@@ -6,6 +6,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/bitfinex"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/bitstamp"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/bybit"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/chainlink"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/coinbase_pro"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/crypto_com"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/gate"
@@ -16,6 +17,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/okx"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/test_volatile_exchange"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testexchange"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/uniswap_v3"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 )
 
@@ -35,6 +37,8 @@ var (
 		exchange_common.EXCHANGE_ID_OKX:                    okx.OkxDetails,
 		exchange_common.EXCHANGE_ID_MEXC:                   mexc.MexcDetails,
 		exchange_common.EXCHANGE_ID_COINBASE_PRO:           coinbase_pro.CoinbaseProDetails,
+		exchange_common.EXCHANGE_ID_UNISWAP_V3:             uniswap_v3.UniswapV3Details,
+		exchange_common.EXCHANGE_ID_CHAINLINK:              chainlink.ChainlinkDetails,
 		exchange_common.EXCHANGE_ID_TEST_EXCHANGE:          testexchange.TestExchangeDetails,
 		exchange_common.EXCHANGE_ID_TEST_VOLATILE_EXCHANGE: test_volatile_exchange.TestVolatileExchangeDetails,
 	}
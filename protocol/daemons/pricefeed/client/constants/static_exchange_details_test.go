@@ -9,6 +9,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/bitfinex"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/bitstamp"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/bybit"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/chainlink"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/coinbase_pro"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/crypto_com"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/gate"
@@ -18,6 +19,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/mexc"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/okx"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testexchange"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/uniswap_v3"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	"github.com/stretchr/testify/require"
 )
@@ -101,6 +103,16 @@ func TestStaticExchangeDetailsCache(t *testing.T) {
 			expectedValue: testexchange.TestExchangeDetails,
 			expectedFound: true,
 		},
+		"Get UniswapV3 exchangeDetails": {
+			exchangeId:    exchange_common.EXCHANGE_ID_UNISWAP_V3,
+			expectedValue: uniswap_v3.UniswapV3Details,
+			expectedFound: true,
+		},
+		"Get Chainlink exchangeDetails": {
+			exchangeId:    exchange_common.EXCHANGE_ID_CHAINLINK,
+			expectedValue: chainlink.ChainlinkDetails,
+			expectedFound: true,
+		},
 		"Get unknown exchangeDetails": {
 			exchangeId:    "unknown",
 			expectedFound: false,
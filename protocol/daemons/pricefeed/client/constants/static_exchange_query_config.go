@@ -16,6 +16,10 @@ const (
 	defaultTimeoutMs             = 3_000
 	defaultMaxQueries            = 3
 	defaultMultiMarketMaxQueries = 1
+	// On-chain exchanges are read through an Ethereum JSON-RPC endpoint, which may be rate limited. TWAPs and
+	// oracle rounds update slowly, so they are read less often, with a longer timeout to read all contracts.
+	onChainIntervalMs = 10_000
+	onChainTimeoutMs  = 5_000
 )
 
 var (
@@ -121,6 +125,18 @@ var (
 			TimeoutMs:  defaultTimeoutMs,
 			MaxQueries: defaultMaxQueries,
 		},
+		exchange_common.EXCHANGE_ID_UNISWAP_V3: {
+			ExchangeId: exchange_common.EXCHANGE_ID_UNISWAP_V3,
+			IntervalMs: onChainIntervalMs,
+			TimeoutMs:  onChainTimeoutMs,
+			MaxQueries: defaultMultiMarketMaxQueries,
+		},
+		exchange_common.EXCHANGE_ID_CHAINLINK: {
+			ExchangeId: exchange_common.EXCHANGE_ID_CHAINLINK,
+			IntervalMs: onChainIntervalMs,
+			TimeoutMs:  onChainTimeoutMs,
+			MaxQueries: defaultMultiMarketMaxQueries,
+		},
 		exchange_common.EXCHANGE_ID_TEST_VOLATILE_EXCHANGE: {
 			ExchangeId: exchange_common.EXCHANGE_ID_TEST_VOLATILE_EXCHANGE,
 			IntervalMs: defaultIntervalMs,
@@ -148,6 +148,26 @@ func TestStaticExchangeQueryConfigCache(t *testing.T) {
 			},
 			expectedFound: true,
 		},
+		"Get UniswapV3 exchangeDetails": {
+			exchangeId: exchange_common.EXCHANGE_ID_UNISWAP_V3,
+			expectedValue: &types.ExchangeQueryConfig{
+				ExchangeId: exchange_common.EXCHANGE_ID_UNISWAP_V3,
+				IntervalMs: 10_000,
+				TimeoutMs:  5_000,
+				MaxQueries: 1,
+			},
+			expectedFound: true,
+		},
+		"Get Chainlink exchangeDetails": {
+			exchangeId: exchange_common.EXCHANGE_ID_CHAINLINK,
+			expectedValue: &types.ExchangeQueryConfig{
+				ExchangeId: exchange_common.EXCHANGE_ID_CHAINLINK,
+				IntervalMs: 10_000,
+				TimeoutMs:  5_000,
+				MaxQueries: 1,
+			},
+			expectedFound: true,
+		},
 		"Get unknown exchangeDetails": {
 			exchangeId:    "unknown",
 			expectedFound: false,
@@ -164,5 +184,5 @@ func TestStaticExchangeQueryConfigCache(t *testing.T) {
 }
 
 func TestStaticExchangeQueryConfigCacheLength(t *testing.T) {
-	require.Len(t, constants.StaticExchangeQueryConfig, 14)
+	require.Len(t, constants.StaticExchangeQueryConfig, 16)
 }
@@ -14,14 +14,19 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 )
 
 // ExchangeQueryHandlerImpl is the struct that implements the `ExchangeQueryHandler` interface.
 type ExchangeQueryHandlerImpl struct {
 	libtime.TimeProvider
+	// EthClient is used to read prices from exchanges with `OnChainDetails`. It is nil if the daemon is not
+	// configured with an Ethereum JSON-RPC endpoint.
+	EthClient bind.ContractCaller
 }
 
 // Ensure the `ExchangeQueryHandlerImpl` struct is implemented at compile time
@@ -45,9 +50,9 @@ type ExchangeQueryHandler interface {
 // 1) Validate `marketIds` contains at least one id.
 // 2) Convert the list of `marketIds` to tickers that are specific for a given exchange. Create a mapping of
 // tickers to price exponents and a reverse mapping of ticker back to `MarketId`.
-// 3) Make API call to an exchange and verify the response status code is not an error status code.
-// 4) Transform the API response to market prices, while tracking unavailable tickers.
-// 5) Return dual values:
+// 3) Query the exchange for market prices, while tracking unavailable tickers. Exchanges with `OnChainDetails`
+// are read through the Ethereum client, and all other exchanges are queried through their API.
+// 4) Return dual values:
 // - a slice of `MarketPriceTimestamp`s that contains resolved market prices
 // - a map of marketIds that could not be resolved with corresponding specific errors.
 func (eqh *ExchangeQueryHandlerImpl) Query(
@@ -108,7 +113,73 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 		)
 	}
 
-	// 3) Make API call to an exchange and verify the response status code is not an error status code.
+	// 3) Query the exchange for market prices, while tracking unavailable tickers.
+	var prices map[string]uint64
+	var volumes map[string]pricefeedtypes.Volume
+	var unavailableTickers map[string]error
+	if exchangeQueryDetails.OnChainDetails != nil {
+		prices, unavailableTickers, err = eqh.queryOnChain(ctx, exchangeQueryDetails, tickerToPriceExponent)
+	} else {
+		prices, volumes, unavailableTickers, err = queryApi(
+			ctx,
+			exchangeQueryDetails,
+			tickers,
+			requestHandler,
+			tickerToPriceExponent,
+		)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 4) Insert prices into MarketPriceTimestamp struct slice, convert unavailable tickers back into marketIds,
+	// and return.
+	marketPriceTimestamps = make([]*types.MarketPriceTimestamp, 0, len(prices))
+	now := eqh.Now()
+
+	for ticker, price := range prices {
+		marketId, ok := tickerToMarketId[ticker]
+		if !ok {
+			return nil, nil, fmt.Errorf("Severe unexpected error: no market id for ticker: %v", ticker)
+		}
+
+		marketPriceTimestamp := &types.MarketPriceTimestamp{
+			MarketId:      marketId,
+			Price:         price,
+			Volume:        volumes[ticker],
+			LastUpdatedAt: now,
+		}
+
+		marketPriceTimestamps = append(marketPriceTimestamps, marketPriceTimestamp)
+	}
+
+	unavailableMarkets = make(map[types.MarketId]error, len(unavailableTickers))
+	for ticker, error := range unavailableTickers {
+		marketId, ok := tickerToMarketId[ticker]
+		if !ok {
+			return nil, nil, fmt.Errorf("Severe unexpected error: no market id for ticker: %v", ticker)
+		}
+		unavailableMarkets[marketId] = error
+	}
+
+	return marketPriceTimestamps, unavailableMarkets, nil
+}
+
+// queryApi makes an API call to an exchange and transforms the response to market prices, while tracking
+// unavailable tickers.
+func queryApi(
+	ctx context.Context,
+	exchangeQueryDetails *types.ExchangeQueryDetails,
+	tickers []string,
+	requestHandler daemontypes.RequestHandler,
+	tickerToPriceExponent map[string]int32,
+) (
+	prices map[string]uint64,
+	volumes map[string]pricefeedtypes.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	// Make API call to an exchange and verify the response status code is not an error status code.
 	url := CreateRequestUrl(exchangeQueryDetails.Url, tickers)
 
 	beforeRequest := time.Now()
@@ -127,7 +198,7 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 		},
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Measure count of exchange API calls as well as what the status code is.
@@ -145,54 +216,69 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 	)
 
 	if response.StatusCode == 429 {
-		return nil, nil, constants.RateLimitingError
+		return nil, nil, nil, constants.RateLimitingError
 	}
 
 	// Verify response is not 4xx or 5xx.
 	if response.StatusCode < 200 || response.StatusCode > 299 {
-		return nil, nil, fmt.Errorf("%s %v", constants.UnexpectedResponseStatusMessage, response.StatusCode)
+		return nil, nil, nil, fmt.Errorf("%s %v", constants.UnexpectedResponseStatusMessage, response.StatusCode)
 	}
 
-	// 4) Transform the API response to market prices, while tracking unavailable tickers.
-	prices, unavailableTickers, err := exchangeQueryDetails.PriceFunction(
+	// Transform the API response to market prices, while tracking unavailable tickers.
+	prices, volumes, unavailableTickers, err = exchangeQueryDetails.PriceFunction(
 		response,
 		tickerToPriceExponent,
 		lib.Median[uint64],
 	)
 	if err != nil {
-		return nil, nil, price_function.NewExchangeError(exchangeQueryDetails.Exchange, err.Error())
+		return nil, nil, nil, price_function.NewExchangeError(exchangeQueryDetails.Exchange, err.Error())
 	}
+	return prices, volumes, unavailableTickers, nil
+}
 
-	// 5) Insert prices into MarketPriceTimestamp struct slice, convert unavailable tickers back into marketIds,
-	// and return.
-	marketPriceTimestamps = make([]*types.MarketPriceTimestamp, 0, len(prices))
-	now := eqh.Now()
-
-	for ticker, price := range prices {
-		marketId, ok := tickerToMarketId[ticker]
-		if !ok {
-			return nil, nil, fmt.Errorf("Severe unexpected error: no market id for ticker: %v", ticker)
-		}
-
-		marketPriceTimestamp := &types.MarketPriceTimestamp{
-			MarketId:      marketId,
-			Price:         price,
-			LastUpdatedAt: now,
-		}
-
-		marketPriceTimestamps = append(marketPriceTimestamps, marketPriceTimestamp)
+// queryOnChain reads market prices from the contracts of an on-chain exchange through the Ethereum client,
+// while tracking unavailable tickers.
+func (eqh *ExchangeQueryHandlerImpl) queryOnChain(
+	ctx context.Context,
+	exchangeQueryDetails *types.ExchangeQueryDetails,
+	tickerToPriceExponent map[string]int32,
+) (
+	prices map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	if eqh.EthClient == nil {
+		return nil, nil, fmt.Errorf(
+			"No Ethereum JSON-RPC endpoint configured to query on-chain exchange: %v",
+			exchangeQueryDetails.Exchange,
+		)
 	}
 
-	unavailableMarkets = make(map[types.MarketId]error, len(unavailableTickers))
-	for ticker, error := range unavailableTickers {
-		marketId, ok := tickerToMarketId[ticker]
-		if !ok {
-			return nil, nil, fmt.Errorf("Severe unexpected error: no market id for ticker: %v", ticker)
-		}
-		unavailableMarkets[marketId] = error
+	beforeRequest := time.Now()
+	prices, unavailableTickers, err = exchangeQueryDetails.OnChainDetails.OnChainPriceFunction(
+		ctx,
+		eqh.EthClient,
+		eqh.TimeProvider,
+		tickerToPriceExponent,
+		lib.Median[uint64],
+	)
+	// Measure time to read prices from the contracts of the exchange.
+	metrics.ModuleMeasureSinceWithLabels(
+		metrics.PricefeedDaemon,
+		[]string{
+			metrics.PricefeedDaemon,
+			metrics.ExchangeQueryHandlerApiRequest,
+			metrics.Latency,
+		},
+		beforeRequest,
+		[]gometrics.Label{
+			pricefeedmetrics.GetLabelForExchangeId(exchangeQueryDetails.Exchange),
+		},
+	)
+	if err != nil {
+		return nil, nil, price_function.NewExchangeError(exchangeQueryDetails.Exchange, err.Error())
 	}
-
-	return marketPriceTimestamps, unavailableMarkets, nil
+	return prices, unavailableTickers, nil
 }
 
 func CreateRequestUrl(baseUrl string, tickers []string) string {
@@ -13,9 +13,11 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	pft "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -35,6 +37,10 @@ const (
 	unavailableExponent   = -6
 )
 
+var (
+	dummyVolume = pft.Volume{Value: 2, Exponent: -8}
+)
+
 var (
 	queryError              = errors.New("Failed to query exchange")
 	priceFuncError          = errors.New("Failed to get Price")
@@ -64,7 +70,7 @@ var (
 
 func TestQuery(t *testing.T) {
 	lastUpdatedAt := time.Unix(0, 0)
-	eqh := ExchangeQueryHandlerImpl{generateMockTimeProvider(lastUpdatedAt)}
+	eqh := ExchangeQueryHandlerImpl{TimeProvider: generateMockTimeProvider(lastUpdatedAt)}
 
 	tests := map[string]struct {
 		// parameters
@@ -72,7 +78,7 @@ func TestQuery(t *testing.T) {
 			response *http.Response,
 			tickerToPriceExponent map[string]int32,
 			resolver pft.Resolver,
-		) (prices map[string]uint64, unavailable map[string]error, err error)
+		) (prices map[string]uint64, volumes map[string]pft.Volume, unavailable map[string]error, err error)
 		marketIds      []types.MarketId
 		requestHandler *mocks.RequestHandler
 
@@ -124,6 +130,32 @@ func TestQuery(t *testing.T) {
 				},
 			},
 		},
+		"Success - multiple markets with volume reported for one market": {
+			priceFunc: priceFuncWithBtcVolume,
+			marketIds: []types.MarketId{exchange_config.MARKET_BTC_USD, exchange_config.MARKET_ETH_USD},
+			requestHandler: generateMockRequestHandler(
+				CreateRequestUrl(baseEqd.Url, []string{
+					constants.BtcUsdPair,
+					constants.EthUsdPair,
+				}),
+				successStatus,
+				nil,
+			),
+			expectApiRequest: true,
+			expectedPrices: []*types.MarketPriceTimestamp{
+				{
+					Price:         dummyPrice,
+					Volume:        dummyVolume,
+					MarketId:      exchange_config.MARKET_BTC_USD,
+					LastUpdatedAt: lastUpdatedAt,
+				},
+				{
+					Price:         dummyPrice,
+					MarketId:      exchange_config.MARKET_ETH_USD,
+					LastUpdatedAt: lastUpdatedAt,
+				},
+			},
+		},
 		"Success - multiple markets and unavailable ticker": {
 			priceFunc: priceFuncWithValidAndUnavailableTickers,
 			marketIds: []types.MarketId{exchange_config.MARKET_BTC_USD, exchange_config.MARKET_ETH_USD, unavailableId},
@@ -291,6 +323,107 @@ func TestQuery(t *testing.T) {
 	}
 }
 
+func TestQuery_OnChain(t *testing.T) {
+	lastUpdatedAt := time.Unix(0, 0)
+	mockTimeProvider := generateMockTimeProvider(lastUpdatedAt)
+	ethClient := pricefeed.NewSimulatedEthBackend(t, nil)
+
+	tests := map[string]struct {
+		// parameters
+		onChainPriceFunc func(
+			ctx context.Context,
+			caller bind.ContractCaller,
+			timeProvider libtime.TimeProvider,
+			tickerToPriceExponent map[string]int32,
+			resolver pft.Resolver,
+		) (prices map[string]uint64, unavailable map[string]error, err error)
+		ethClient bind.ContractCaller
+
+		// expectations
+		expectedPrices      []*types.MarketPriceTimestamp
+		expectedUnavailable map[types.MarketId]error
+		expectedError       error
+	}{
+		"Success": {
+			onChainPriceFunc: func(
+				ctx context.Context,
+				caller bind.ContractCaller,
+				timeProvider libtime.TimeProvider,
+				tickerToPriceExponent map[string]int32,
+				resolver pft.Resolver,
+			) (prices map[string]uint64, unavailable map[string]error, err error) {
+				require.Equal(t, ethClient, caller)
+				require.Equal(t, mockTimeProvider, timeProvider)
+				return map[string]uint64{constants.BtcUsdPair: dummyPrice},
+					map[string]error{unavailableTicker: tickerNotAvailableError},
+					nil
+			},
+			ethClient: ethClient,
+			expectedPrices: []*types.MarketPriceTimestamp{
+				{
+					Price:         dummyPrice,
+					MarketId:      exchange_config.MARKET_BTC_USD,
+					LastUpdatedAt: lastUpdatedAt,
+				},
+			},
+			expectedUnavailable: map[types.MarketId]error{
+				unavailableId: tickerNotAvailableError,
+			},
+		},
+		"Failure - no Ethereum client": {
+			expectedError: errors.New("No Ethereum JSON-RPC endpoint configured to query on-chain exchange: "),
+		},
+		"Failure - OnChainPriceFunction returns error": {
+			onChainPriceFunc: func(
+				ctx context.Context,
+				caller bind.ContractCaller,
+				timeProvider libtime.TimeProvider,
+				tickerToPriceExponent map[string]int32,
+				resolver pft.Resolver,
+			) (prices map[string]uint64, unavailable map[string]error, err error) {
+				return nil, nil, priceFuncError
+			},
+			ethClient:     ethClient,
+			expectedError: price_function.NewExchangeError("", priceFuncError.Error()),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			eqh := ExchangeQueryHandlerImpl{
+				TimeProvider: mockTimeProvider,
+				EthClient:    tc.ethClient,
+			}
+			eqd := &types.ExchangeQueryDetails{
+				OnChainDetails: &types.ExchangeOnChainDetails{
+					OnChainPriceFunction: tc.onChainPriceFunc,
+				},
+			}
+			requestHandler := &mocks.RequestHandler{}
+
+			prices, unavailableMarkets, err := eqh.Query(
+				context.Background(),
+				eqd,
+				baseEmc,
+				[]types.MarketId{exchange_config.MARKET_BTC_USD, unavailableId},
+				requestHandler,
+				testMarketExponentMap,
+			)
+
+			requestHandler.AssertNotCalled(t, "Get")
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, unavailableMarkets)
+			} else {
+				require.NoError(t, err)
+				require.ElementsMatch(t, tc.expectedPrices, prices)
+				pricefeed.ErrorMapsEqual(t, tc.expectedUnavailable, unavailableMarkets)
+			}
+		})
+	}
+}
+
 func generateMockTimeProvider(time time.Time) *mocks.TimeProvider {
 	mockTimeProvider := &mocks.TimeProvider{}
 	mockTimeProvider.On("Now").Return(time)
@@ -320,52 +453,61 @@ func priceFunc(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
+) (prices map[string]uint64, volumes map[string]pft.Volume, unavailable map[string]error, err error) {
 	prices = make(map[string]uint64, len(tickerToPriceExponent))
 	for ticker := range tickerToPriceExponent {
 		prices[ticker] = dummyPrice
 	}
-	return prices, nil, nil
+	return prices, nil, nil, nil
+}
+
+func priceFuncWithBtcVolume(
+	response *http.Response,
+	tickerToPriceExponent map[string]int32,
+	resolver pft.Resolver,
+) (prices map[string]uint64, volumes map[string]pft.Volume, unavailable map[string]error, err error) {
+	prices, _, _, _ = priceFunc(response, tickerToPriceExponent, resolver)
+	return prices, map[string]pft.Volume{constants.BtcUsdPair: dummyVolume}, nil, nil
 }
 
 func priceFuncWithInvalidResponse(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
+) (prices map[string]uint64, volumes map[string]pft.Volume, unavailable map[string]error, err error) {
 	prices = make(map[string]uint64, len(tickerToPriceExponent))
 	for range tickerToPriceExponent {
 		prices[noMarketTicker] = dummyPrice
 	}
-	return prices, nil, nil
+	return prices, nil, nil, nil
 }
 
 func priceFuncWithValidAndUnavailableTickers(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
+) (prices map[string]uint64, volumes map[string]pft.Volume, unavailable map[string]error, err error) {
 	prices = make(map[string]uint64, len(tickerToPriceExponent))
 	for ticker := range tickerToPriceExponent {
 		if ticker != unavailableTicker {
 			prices[ticker] = dummyPrice
 		}
 	}
-	return prices, map[string]error{unavailableTicker: tickerNotAvailableError}, nil
+	return prices, nil, map[string]error{unavailableTicker: tickerNotAvailableError}, nil
 }
 
 func priceFuncReturnsInvalidUnavailableTicker(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
-	return nil, map[string]error{noMarketTicker: tickerNotAvailableError}, nil
+) (prices map[string]uint64, volumes map[string]pft.Volume, unavailable map[string]error, err error) {
+	return nil, nil, map[string]error{noMarketTicker: tickerNotAvailableError}, nil
 }
 
 func priceFuncWithErr(
 	response *http.Response,
 	tickerToPriceExponent map[string]int32,
 	resolver pft.Resolver,
-) (prices map[string]uint64, unavailable map[string]error, err error) {
-	return nil, nil, priceFuncError
+) (prices map[string]uint64, volumes map[string]pft.Volume, unavailable map[string]error, err error) {
+	return nil, nil, nil, priceFuncError
 }
@@ -210,9 +210,17 @@ func (p PriceEncoderImpl) convertPriceUpdate(marketPriceTimestamp *types.MarketP
 		},
 	)
 
+	// The volume of an inverted ticker is denominated in the ticker's base asset, which is not the base asset of
+	// the market. Since it is not comparable to the volume reported by other exchanges, it is dropped.
+	volume := marketPriceTimestamp.Volume
+	if conversionDetails.Invert {
+		volume = pricefeedtypes.Volume{}
+	}
+
 	return &types.MarketPriceTimestamp{
 		MarketId:      marketPriceTimestamp.MarketId,
 		Price:         price,
+		Volume:        volume,
 		LastUpdatedAt: marketPriceTimestamp.LastUpdatedAt,
 	}, nil
 }
@@ -121,6 +121,7 @@ func TestConvertPriceUpdate_Mixed(t *testing.T) {
 		adjustmentMarketIndexPrice          uint64
 		adjustmentMarketNumPricesMedianized int
 		expectedPrice                       uint64
+		expectedVolume                      pft.Volume
 		expectedErr                         error
 	}{
 		"Success - no conversion": {
@@ -140,7 +141,8 @@ func TestConvertPriceUpdate_Mixed(t *testing.T) {
 					MinExchanges: 1,
 				},
 			},
-			expectedPrice: constants.FiveBillion,
+			expectedPrice:  constants.FiveBillion,
+			expectedVolume: constants.Volume1,
 		},
 		"Success - inverted price": {
 			mutableExchangeConfig: &types.MutableExchangeMarketConfig{
@@ -160,7 +162,9 @@ func TestConvertPriceUpdate_Mixed(t *testing.T) {
 					MinExchanges: 1,
 				},
 			},
-			expectedPrice: uint64(20_000_000_000),
+			// The volume of an inverted ticker is dropped.
+			expectedPrice:  uint64(20_000_000_000),
+			expectedVolume: pft.Volume{},
 		},
 		"Success - division with adjust-by market": {
 			mutableExchangeConfig: &types.MutableExchangeMarketConfig{
@@ -218,6 +222,7 @@ func TestConvertPriceUpdate_Mixed(t *testing.T) {
 			adjustmentMarketIndexPrice:          uint64(990_000_000), // 0.99.
 			adjustmentMarketNumPricesMedianized: 1,
 			expectedPrice:                       uint64(4_950_000_000), // 5 billion * 99%.
+			expectedVolume:                      constants.Volume1,
 		},
 		"Failure - invalid index price": {
 			mutableExchangeConfig: &types.MutableExchangeMarketConfig{
@@ -269,6 +274,7 @@ func TestConvertPriceUpdate_Mixed(t *testing.T) {
 				&types.MarketPriceTimestamp{
 					MarketId:      constants.MarketId1,
 					Price:         constants.FiveBillion,
+					Volume:        constants.Volume1,
 					LastUpdatedAt: constants.TimeT,
 				},
 			)
@@ -280,6 +286,7 @@ func TestConvertPriceUpdate_Mixed(t *testing.T) {
 				require.Equal(t, constants.TimeT, convertedPriceTimestamp.LastUpdatedAt)
 				require.Equal(t, constants.MarketId1, convertedPriceTimestamp.MarketId)
 				require.Equal(t, tc.expectedPrice, convertedPriceTimestamp.Price)
+				require.Equal(t, tc.expectedVolume, convertedPriceTimestamp.Volume)
 			}
 		})
 	}
@@ -329,14 +329,25 @@ func (pf *PriceFetcher) writeToBufferedChannel(
 	exchangeId types.ExchangeId,
 	price *types.MarketPriceTimestamp,
 	err error,
+) {
+	writeToBufferedChannel(pf.bCh, pf.logger, price, err)
+}
+
+// writeToBufferedChannel writes a (price, error) pair to the buffered channel `bCh` shared by a price
+// fetcher and a price encoder.
+func writeToBufferedChannel(
+	bCh chan<- *PriceFetcherSubtaskResponse,
+	logger log.Logger,
+	price *types.MarketPriceTimestamp,
+	err error,
 ) {
 	// Sanity check that the channel is not full already.
-	if len(pf.bCh) == constants.FixedBufferSize {
+	if len(bCh) == constants.FixedBufferSize {
 		// Log if writing to buffered channel failed.
-		pf.logger.Error("Pricefeed daemon's shared buffer is full.")
+		logger.Error("Pricefeed daemon's shared buffer is full.")
 	}
 
-	pf.bCh <- &PriceFetcherSubtaskResponse{
+	bCh <- &PriceFetcherSubtaskResponse{
 		Err:   err,
 		Price: price,
 	}
@@ -0,0 +1,454 @@
+package price_fetcher
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/handler"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
+	"github.com/gorilla/websocket"
+	"golang.org/x/exp/slices"
+)
+
+// StreamPriceFetcher streams prices from an exchange over a WebSocket connection based on the
+// `ExchangeStreamDetails` of the exchange. The latest price streamed for each market is cached and
+// periodically flushed into the shared buffered channel `bCh`, in the same format as the prices
+// polled by the `PriceFetcher`. While the stream is disconnected or stale, prices are polled from
+// the exchange by a `PriceFetcher` instead.
+type StreamPriceFetcher struct {
+	exchangeQueryConfig types.ExchangeQueryConfig
+	streamDetails       types.ExchangeStreamDetails
+	timeProvider        libtime.TimeProvider
+	dialer              *websocket.Dialer
+	logger              log.Logger
+	bCh                 chan<- *PriceFetcherSubtaskResponse
+
+	// pollingPriceFetcher polls prices from the exchange while the stream is disconnected or stale.
+	pollingPriceFetcher *PriceFetcher
+	// isPolling indicates whether prices were polled in the last task loop. It is only accessed by
+	// `RunTaskLoop`, which is called serially.
+	isPolling bool
+
+	// isConnected indicates whether the stream is connected and subscribed to the markets of the exchange.
+	isConnected atomic.Bool
+
+	// initialBackoff and maxBackoff bound the delay between reconnection attempts.
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	// mutableState contains the market configuration of the exchange, which is subject to change over time.
+	mutableState *mutableState
+
+	// tickers contains the sorted tickers of the markets of the exchange. It is only accessed by
+	// `UpdateMutableExchangeConfig`, which is called serially.
+	tickers []string
+	// resubscribe is signaled when the tickers of the exchange change, in order to close the current
+	// connection and subscribe to the new tickers on a new one.
+	resubscribe chan struct{}
+
+	// latestPrices contains the latest price streamed for each market, and lastStreamedAt the time at which
+	// the latest prices were streamed for any market. Access is protected by pricesLock.
+	pricesLock     sync.Mutex
+	latestPrices   map[types.MarketId]types.MarketPriceTimestamp
+	lastStreamedAt time.Time
+}
+
+// NewStreamPriceFetcher creates a new StreamPriceFetcher struct. The exchange details must define
+// `StreamDetails`. The query handler is used to poll prices while the stream is disconnected or stale.
+func NewStreamPriceFetcher(
+	exchangeQueryConfig types.ExchangeQueryConfig,
+	exchangeDetails types.ExchangeQueryDetails,
+	mutableExchangeConfig *types.MutableExchangeMarketConfig,
+	mutableMarketConfigs []*types.MutableMarketConfig,
+	queryHandler handler.ExchangeQueryHandler,
+	timeProvider libtime.TimeProvider,
+	logger log.Logger,
+	bCh chan<- *PriceFetcherSubtaskResponse,
+) (
+	*StreamPriceFetcher,
+	error,
+) {
+	if exchangeDetails.StreamDetails == nil {
+		return nil, fmt.Errorf(
+			"NewStreamPriceFetcher: no stream details exist for exchangeId: %v",
+			exchangeQueryConfig.ExchangeId,
+		)
+	}
+
+	pollingPriceFetcher, err := NewPriceFetcher(
+		exchangeQueryConfig,
+		exchangeDetails,
+		mutableExchangeConfig,
+		mutableMarketConfigs,
+		queryHandler,
+		logger,
+		bCh,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Configure price fetcher logger to have fetcher-specific metadata.
+	pfLogger := logger.With(
+		constants.SubmoduleLogKey,
+		constants.PriceFetcherSubmoduleName,
+		constants.ExchangeIdLogKey,
+		exchangeQueryConfig.ExchangeId,
+	)
+
+	spf := &StreamPriceFetcher{
+		exchangeQueryConfig: exchangeQueryConfig,
+		streamDetails:       *exchangeDetails.StreamDetails,
+		timeProvider:        timeProvider,
+		dialer:              websocket.DefaultDialer,
+		logger:              pfLogger,
+		bCh:                 bCh,
+		pollingPriceFetcher: pollingPriceFetcher,
+		initialBackoff:      constants.StreamReconnectInitialBackoff,
+		maxBackoff:          constants.StreamReconnectMaxBackoff,
+		mutableState:        &mutableState{},
+		resubscribe:         make(chan struct{}, 1),
+		latestPrices:        make(map[types.MarketId]types.MarketPriceTimestamp),
+	}
+
+	// This will instantiate the price fetcher's mutable state.
+	err = spf.UpdateMutableExchangeConfig(mutableExchangeConfig, mutableMarketConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return spf, nil
+}
+
+// GetExchangeId returns the exchange id for the exchange streamed by the price fetcher.
+// This method is added to support the ExchangeConfigUpdater interface.
+func (spf *StreamPriceFetcher) GetExchangeId() types.ExchangeId {
+	return spf.exchangeQueryConfig.ExchangeId
+}
+
+// UpdateMutableExchangeConfig updates the price fetcher with the most current copy of the exchange config, as
+// well as all markets supported by the exchange. If the tickers of the exchange change, the price fetcher
+// resubscribes to the new tickers. The price fetcher used for polling is updated as well.
+// This method is added to support the ExchangeConfigUpdater interface.
+func (spf *StreamPriceFetcher) UpdateMutableExchangeConfig(
+	newConfig *types.MutableExchangeMarketConfig,
+	newMarketConfigs []*types.MutableMarketConfig,
+) error {
+	// 1. Validate new config.
+	if newConfig.Id != spf.exchangeQueryConfig.ExchangeId {
+		return fmt.Errorf("StreamPriceFetcher.UpdateMutableExchangeConfig: exchange id mismatch")
+	}
+
+	if err := newConfig.Validate(newMarketConfigs); err != nil {
+		return fmt.Errorf("StreamPriceFetcher.UpdateMutableExchangeConfig: invalid exchange config update: %w", err)
+	}
+
+	if err := spf.pollingPriceFetcher.UpdateMutableExchangeConfig(newConfig, newMarketConfigs); err != nil {
+		return err
+	}
+
+	// 2. Derive price fetcher mutable state. All markets are subscribed to on the same connection, so
+	// no ring of market ids is needed to select the markets to query.
+	marketExponents := make(map[types.MarketId]types.Exponent, len(newMarketConfigs))
+	for _, marketConfig := range newMarketConfigs {
+		marketExponents[marketConfig.Id] = marketConfig.Exponent
+	}
+
+	// 3. Perform update, and resubscribe if the tickers changed.
+	spf.mutableState.Update(newConfig, marketExponents, nil)
+
+	tickers, _, _ := getStreamTickers(newConfig, marketExponents)
+	if !slices.Equal(spf.tickers, tickers) {
+		spf.tickers = tickers
+		select {
+		case spf.resubscribe <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// getStreamTickers returns the sorted tickers of all markets of the exchange, as well as mappings of
+// tickers to price exponents and of tickers back to market ids.
+func getStreamTickers(
+	exchangeConfig *types.MutableExchangeMarketConfig,
+	marketExponents map[types.MarketId]types.Exponent,
+) (
+	tickers []string,
+	tickerToPriceExponent map[string]int32,
+	tickerToMarketId map[string]types.MarketId,
+) {
+	tickers = make([]string, 0, len(exchangeConfig.MarketToMarketConfig))
+	tickerToPriceExponent = make(map[string]int32, len(exchangeConfig.MarketToMarketConfig))
+	tickerToMarketId = make(map[string]types.MarketId, len(exchangeConfig.MarketToMarketConfig))
+	for marketId, config := range exchangeConfig.MarketToMarketConfig {
+		exponent, ok := marketExponents[marketId]
+		if !ok {
+			continue
+		}
+		tickers = append(tickers, config.Ticker)
+		tickerToPriceExponent[config.Ticker] = exponent
+		tickerToMarketId[config.Ticker] = marketId
+	}
+	sort.Strings(tickers)
+	return tickers, tickerToPriceExponent, tickerToMarketId
+}
+
+// RunStream connects to the exchange and streams prices until `stop` is closed. Whenever the connection
+// fails, it is re-established after an exponentially increasing backoff.
+// RunStream blocks until `stop` is closed.
+func (spf *StreamPriceFetcher) RunStream(stop <-chan bool) {
+	backoff := spf.initialBackoff
+	for {
+		receivedMessages, err := spf.stream(stop)
+
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		if receivedMessages {
+			backoff = spf.initialBackoff
+		}
+		if err != nil {
+			spf.logger.Info(
+				"stream_price_fetcher: stream disconnected, reconnecting",
+				constants.ErrorLogKey,
+				err,
+				"backoff",
+				backoff,
+			)
+			spf.writeToBufferedChannel(nil, err)
+
+			select {
+			case <-stop:
+				return
+			case <-time.After(backoff):
+			}
+			backoff = lib.Min(2*backoff, spf.maxBackoff)
+		}
+	}
+}
+
+// stream makes a single connection to the exchange, subscribes to all markets of the exchange and caches
+// every price received until the connection fails, `stop` is closed or the tickers of the exchange change.
+// It returns whether any messages were received on the connection.
+func (spf *StreamPriceFetcher) stream(
+	stop <-chan bool,
+) (
+	receivedMessages bool,
+	err error,
+) {
+	// Drain any pending resubscription, since the current tickers are read below.
+	select {
+	case <-spf.resubscribe:
+	default:
+	}
+
+	definition := spf.mutableState.getTaskLoopDefinition(true, 0)
+	tickers, tickerToPriceExponent, tickerToMarketId := getStreamTickers(
+		definition.mutableExchangeConfig,
+		definition.marketExponents,
+	)
+
+	// Wait for the exchange to be configured with markets to subscribe to.
+	if len(tickers) == 0 {
+		select {
+		case <-stop:
+		case <-spf.resubscribe:
+		}
+		return false, nil
+	}
+
+	ctxWithTimeout, cancelFunc := context.WithTimeout(
+		context.Background(),
+		time.Duration(spf.exchangeQueryConfig.TimeoutMs)*time.Millisecond,
+	)
+	conn, _, err := spf.dialer.DialContext(ctxWithTimeout, spf.streamDetails.Url, nil)
+	cancelFunc()
+	if err != nil {
+		return false, err
+	}
+
+	// Close the connection when streaming is stopped or the tickers change. This unblocks any pending read.
+	done := make(chan struct{})
+	defer close(done)
+	var isResubscribing atomic.Bool
+	go func() {
+		select {
+		case <-stop:
+		case <-spf.resubscribe:
+			isResubscribing.Store(true)
+		case <-done:
+		}
+		conn.Close()
+	}()
+
+	messages, err := spf.streamDetails.SubscribeMessages(tickers)
+	if err != nil {
+		return false, err
+	}
+	for _, message := range messages {
+		if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			return false, err
+		}
+	}
+	spf.isConnected.Store(true)
+	defer spf.isConnected.Store(false)
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(spf.streamDetails.MaxPriceAge)); err != nil {
+			return receivedMessages, err
+		}
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			// Closing the connection in order to resubscribe is not a failure of the stream.
+			if isResubscribing.Load() {
+				return receivedMessages, nil
+			}
+			return receivedMessages, err
+		}
+		receivedMessages = true
+
+		prices, volumes, _, err := spf.streamDetails.StreamPriceFunction(
+			message,
+			tickerToPriceExponent,
+			lib.Median[uint64],
+		)
+		if err != nil {
+			spf.writeToBufferedChannel(
+				nil,
+				price_function.NewExchangeError(spf.exchangeQueryConfig.ExchangeId, err.Error()),
+			)
+			continue
+		}
+
+		now := spf.timeProvider.Now()
+		spf.pricesLock.Lock()
+		for ticker, price := range prices {
+			marketId, ok := tickerToMarketId[ticker]
+			if !ok {
+				continue
+			}
+			spf.latestPrices[marketId] = types.MarketPriceTimestamp{
+				MarketId:      marketId,
+				Price:         price,
+				Volume:        volumes[ticker],
+				LastUpdatedAt: now,
+			}
+			spf.lastStreamedAt = now
+		}
+		spf.pricesLock.Unlock()
+	}
+}
+
+// RunTaskLoop writes the latest prices of the exchange into the shared buffered channel. While the stream is
+// healthy, the latest streamed prices are flushed. While the stream is disconnected or has not streamed any
+// price within the `MaxPriceAge` of the exchange, prices are polled from the exchange instead.
+// RunTaskLoop blocks until all prices have been written.
+func (spf *StreamPriceFetcher) RunTaskLoop(requestHandler daemontypes.RequestHandler) {
+	if isPolling := !spf.isStreamHealthy(); isPolling != spf.isPolling {
+		spf.isPolling = isPolling
+		spf.logger.Info(
+			"stream_price_fetcher: switching price source",
+			"polling",
+			spf.isPolling,
+		)
+	}
+
+	if spf.isPolling {
+		spf.pollingPriceFetcher.RunTaskLoop(requestHandler)
+	} else {
+		spf.FlushPrices()
+	}
+}
+
+// isStreamHealthy returns whether the stream is connected and has streamed a price for any market of the
+// exchange within the `MaxPriceAge` of the exchange.
+func (spf *StreamPriceFetcher) isStreamHealthy() bool {
+	if !spf.isConnected.Load() {
+		return false
+	}
+
+	spf.pricesLock.Lock()
+	lastStreamedAt := spf.lastStreamedAt
+	spf.pricesLock.Unlock()
+
+	return !lastStreamedAt.IsZero() && spf.timeProvider.Now().Sub(lastStreamedAt) <= spf.streamDetails.MaxPriceAge
+}
+
+// FlushPrices writes the latest price streamed for each market of the exchange into the shared buffered
+// channel. Markets whose latest price is older than the `MaxPriceAge` of the exchange are reported as stale
+// instead. Markets that have not received any price yet are skipped.
+func (spf *StreamPriceFetcher) FlushPrices() {
+	exchangeId := spf.exchangeQueryConfig.ExchangeId
+	marketIds := spf.mutableState.GetMarketIds()
+	now := spf.timeProvider.Now()
+
+	// Emit metrics at the `AvailableMarketsSampleRate`.
+	emitMetricsSample := rand.Float64() < metrics.AvailableMarketsSampleRate
+
+	spf.pricesLock.Lock()
+	latestPrices := make([]types.MarketPriceTimestamp, 0, len(marketIds))
+	for _, marketId := range marketIds {
+		if price, ok := spf.latestPrices[marketId]; ok {
+			latestPrices = append(latestPrices, price)
+		}
+	}
+	spf.pricesLock.Unlock()
+
+	for _, price := range latestPrices {
+		available := false
+		if now.Sub(price.LastUpdatedAt) > spf.streamDetails.MaxPriceAge {
+			spf.writeToBufferedChannel(
+				nil,
+				fmt.Errorf(
+					"Stale price for exchange: '%v' and market: %v, last updated at: %v",
+					exchangeId,
+					price.MarketId,
+					price.LastUpdatedAt,
+				),
+			)
+		} else if price.Price == uint64(0) {
+			// No price should validly be zero. A price of zero points to an error in the stream.
+			spf.writeToBufferedChannel(
+				nil,
+				fmt.Errorf(
+					"Invalid price of 0 for exchange: '%v' and market: %v",
+					exchangeId,
+					price.MarketId,
+				),
+			)
+		} else {
+			available = true
+			marketPriceTimestamp := price
+			spf.writeToBufferedChannel(&marketPriceTimestamp, nil)
+		}
+
+		if emitMetricsSample {
+			emitMarketAvailabilityMetrics(exchangeId, price.MarketId, available)
+		}
+	}
+}
+
+// writeToBufferedChannel writes the (price, error) generated during streaming to the price fetcher's
+// buffered channel, which outputs the result to the price encoder.
+func (spf *StreamPriceFetcher) writeToBufferedChannel(
+	price *types.MarketPriceTimestamp,
+	err error,
+) {
+	writeToBufferedChannel(spf.bCh, spf.logger, price, err)
+}
@@ -0,0 +1,443 @@
+package price_fetcher
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/handler"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	streamWaitTimeout  = 5 * time.Second
+	streamPollInterval = 10 * time.Millisecond
+)
+
+// newTestStreamDetails returns stream details for a mock exchange that subscribes with a comma-separated list
+// of tickers, and pushes prices as "<ticker>:<price>" messages.
+func newTestStreamDetails(url string) *types.ExchangeStreamDetails {
+	return &types.ExchangeStreamDetails{
+		Url: url,
+		SubscribeMessages: func(tickers []string) ([][]byte, error) {
+			return [][]byte{[]byte(strings.Join(tickers, ","))}, nil
+		},
+		StreamPriceFunction: func(
+			message []byte,
+			tickerToPriceExponent map[string]int32,
+			resolver pricefeedtypes.Resolver,
+		) (map[string]uint64, map[string]pricefeedtypes.Volume, map[string]error, error) {
+			ticker, priceString, found := strings.Cut(string(message), ":")
+			if !found {
+				return nil, nil, nil, errors.New("invalid message")
+			}
+			price, err := strconv.ParseUint(priceString, 10, 64)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			return map[string]uint64{ticker: price}, map[string]pricefeedtypes.Volume{}, map[string]error{}, nil
+		},
+		MaxPriceAge: 10 * time.Second,
+	}
+}
+
+func newTestStreamPriceFetcher(
+	t *testing.T,
+	streamDetails *types.ExchangeStreamDetails,
+	mutableExchangeConfig *types.MutableExchangeMarketConfig,
+	mutableMarketConfigs []*types.MutableMarketConfig,
+	queryHandler handler.ExchangeQueryHandler,
+	bCh chan<- *PriceFetcherSubtaskResponse,
+) *StreamPriceFetcher {
+	spf, err := NewStreamPriceFetcher(
+		constants.Exchange1_1MaxQueries_QueryConfig,
+		types.ExchangeQueryDetails{StreamDetails: streamDetails},
+		mutableExchangeConfig,
+		mutableMarketConfigs,
+		queryHandler,
+		generateMockTimeProvider(time.Now()),
+		log.NewNopLogger(),
+		bCh,
+	)
+	require.NoError(t, err)
+	spf.initialBackoff = 10 * time.Millisecond
+	spf.maxBackoff = 50 * time.Millisecond
+	return spf
+}
+
+func generateMockTimeProvider(now time.Time) *mocks.TimeProvider {
+	timeProvider := &mocks.TimeProvider{}
+	timeProvider.On("Now").Return(now)
+	return timeProvider
+}
+
+// waitForPrices flushes the stream price fetcher until the expected number of prices has been written.
+func waitForPrices(
+	t *testing.T,
+	spf *StreamPriceFetcher,
+	bCh chan *PriceFetcherSubtaskResponse,
+	expectedNumPrices int,
+) map[types.MarketId]uint64 {
+	var prices map[types.MarketId]uint64
+	require.Eventually(
+		t,
+		func() bool {
+			prices = make(map[types.MarketId]uint64)
+			spf.FlushPrices()
+			for len(bCh) > 0 {
+				response := <-bCh
+				if response.Err == nil {
+					prices[response.Price.MarketId] = response.Price.Price
+				}
+			}
+			return len(prices) == expectedNumPrices
+		},
+		streamWaitTimeout,
+		streamPollInterval,
+	)
+	return prices
+}
+
+func TestNewStreamPriceFetcher_NoStreamDetails(t *testing.T) {
+	_, err := NewStreamPriceFetcher(
+		constants.Exchange1_1MaxQueries_QueryConfig,
+		constants.SingleMarketExchangeQueryDetails,
+		&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_1Markets,
+		&mocks.ExchangeQueryHandler{},
+		generateMockTimeProvider(time.Now()),
+		log.NewNopLogger(),
+		make(chan *PriceFetcherSubtaskResponse),
+	)
+	require.EqualError(t, err, "NewStreamPriceFetcher: no stream details exist for exchangeId: Exchange1")
+}
+
+func TestStreamPriceFetcher_UpdateMutableExchangeConfig_ExchangeIdMismatch(t *testing.T) {
+	spf := newTestStreamPriceFetcher(
+		t,
+		newTestStreamDetails(""),
+		&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_1Markets,
+		&mocks.ExchangeQueryHandler{},
+		make(chan *PriceFetcherSubtaskResponse),
+	)
+
+	err := spf.UpdateMutableExchangeConfig(
+		&types.MutableExchangeMarketConfig{Id: "invalid"},
+		constants.MutableMarketConfigs_0Markets,
+	)
+	require.EqualError(t, err, "StreamPriceFetcher.UpdateMutableExchangeConfig: exchange id mismatch")
+}
+
+func TestStreamPriceFetcher_RunStream(t *testing.T) {
+	server := pricefeed.NewWebsocketServer(t)
+	defer server.CleanUp()
+
+	bCh := make(chan *PriceFetcherSubtaskResponse, 10)
+	spf := newTestStreamPriceFetcher(
+		t,
+		newTestStreamDetails(server.Url()),
+		&constants.Exchange1_2Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_2Markets,
+		&mocks.ExchangeQueryHandler{},
+		bCh,
+	)
+
+	stop := make(chan bool)
+	streamDone := make(chan struct{})
+	go func() {
+		spf.RunStream(stop)
+		close(streamDone)
+	}()
+
+	// The price fetcher subscribes to all markets of the exchange on connection.
+	require.Eventually(
+		t,
+		func() bool { return len(server.GetReceivedMessages()) == 1 },
+		streamWaitTimeout,
+		streamPollInterval,
+	)
+	require.Equal(t, []string{"BTC-USD,ETH-USD"}, server.GetReceivedMessages())
+
+	// Streamed prices are flushed to the buffered channel. Only the latest price of each market is kept.
+	server.SendMessage("BTC-USD:100")
+	server.SendMessage("ETH-USD:200")
+	server.SendMessage("BTC-USD:101")
+	prices := waitForPrices(t, spf, bCh, 2)
+	require.Equal(
+		t,
+		map[types.MarketId]uint64{
+			constants.MarketId7: 101,
+			constants.MarketId8: 200,
+		},
+		prices,
+	)
+
+	// The price fetcher reconnects and resubscribes when the connection is dropped.
+	server.DropConnections()
+	require.Eventually(
+		t,
+		func() bool { return len(server.GetReceivedMessages()) == 2 && server.GetNumConnections() == 1 },
+		streamWaitTimeout,
+		streamPollInterval,
+	)
+	server.SendMessage("ETH-USD:201")
+	require.Eventually(
+		t,
+		func() bool {
+			spf.pricesLock.Lock()
+			defer spf.pricesLock.Unlock()
+			return spf.latestPrices[constants.MarketId8].Price == 201
+		},
+		streamWaitTimeout,
+		streamPollInterval,
+	)
+
+	// The price fetcher resubscribes when the markets of the exchange change.
+	require.NoError(
+		t,
+		spf.UpdateMutableExchangeConfig(
+			&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+			constants.MutableMarketConfigs_1Markets,
+		),
+	)
+	require.Eventually(
+		t,
+		func() bool { return len(server.GetReceivedMessages()) == 3 },
+		streamWaitTimeout,
+		streamPollInterval,
+	)
+	require.Equal(t, "BTC-USD", server.GetReceivedMessages()[2])
+
+	// Updating the exchange config without changing the markets does not resubscribe.
+	require.NoError(
+		t,
+		spf.UpdateMutableExchangeConfig(
+			&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+			constants.MutableMarketConfigs_1Markets,
+		),
+	)
+
+	// Stopping the stream closes the connection.
+	close(stop)
+	<-streamDone
+	require.Eventually(
+		t,
+		func() bool { return server.GetNumConnections() == 0 },
+		streamWaitTimeout,
+		streamPollInterval,
+	)
+	require.Len(t, server.GetReceivedMessages(), 3)
+}
+
+func TestStreamPriceFetcher_RunStream_ConnectionFailure(t *testing.T) {
+	server := pricefeed.NewWebsocketServer(t)
+	url := server.Url()
+	server.CleanUp()
+
+	bCh := make(chan *PriceFetcherSubtaskResponse, 10)
+	spf := newTestStreamPriceFetcher(
+		t,
+		newTestStreamDetails(url),
+		&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_1Markets,
+		&mocks.ExchangeQueryHandler{},
+		bCh,
+	)
+
+	stop := make(chan bool)
+	streamDone := make(chan struct{})
+	go func() {
+		spf.RunStream(stop)
+		close(streamDone)
+	}()
+
+	// Connection failures are reported to the buffered channel.
+	response := <-bCh
+	require.Nil(t, response.Price)
+	require.ErrorContains(t, response.Err, "connection refused")
+
+	close(stop)
+	<-streamDone
+}
+
+func TestStreamPriceFetcher_FlushPrices(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	maxPriceAge := 10 * time.Second
+
+	tests := map[string]struct {
+		latestPrices map[types.MarketId]types.MarketPriceTimestamp
+
+		expectedPrices []*types.MarketPriceTimestamp
+		expectedErrors []error
+	}{
+		"No prices": {
+			latestPrices: map[types.MarketId]types.MarketPriceTimestamp{},
+		},
+		"Fresh price": {
+			latestPrices: map[types.MarketId]types.MarketPriceTimestamp{
+				constants.MarketId7: {
+					MarketId:      constants.MarketId7,
+					Price:         constants.Price1,
+					LastUpdatedAt: now.Add(-maxPriceAge),
+				},
+			},
+			expectedPrices: []*types.MarketPriceTimestamp{
+				{
+					MarketId:      constants.MarketId7,
+					Price:         constants.Price1,
+					LastUpdatedAt: now.Add(-maxPriceAge),
+				},
+			},
+		},
+		"Stale price": {
+			latestPrices: map[types.MarketId]types.MarketPriceTimestamp{
+				constants.MarketId7: {
+					MarketId:      constants.MarketId7,
+					Price:         constants.Price1,
+					LastUpdatedAt: now.Add(-maxPriceAge - time.Second),
+				},
+			},
+			expectedErrors: []error{
+				errors.New(
+					"Stale price for exchange: 'Exchange1' and market: 7, last updated at: " +
+						now.Add(-maxPriceAge-time.Second).String(),
+				),
+			},
+		},
+		"Zero price": {
+			latestPrices: map[types.MarketId]types.MarketPriceTimestamp{
+				constants.MarketId7: {
+					MarketId:      constants.MarketId7,
+					Price:         0,
+					LastUpdatedAt: now,
+				},
+			},
+			expectedErrors: []error{
+				errors.New("Invalid price of 0 for exchange: 'Exchange1' and market: 7"),
+			},
+		},
+		"Price of market no longer on the exchange is ignored": {
+			latestPrices: map[types.MarketId]types.MarketPriceTimestamp{
+				constants.MarketId8: {
+					MarketId:      constants.MarketId8,
+					Price:         constants.Price2,
+					LastUpdatedAt: now,
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bCh := make(chan *PriceFetcherSubtaskResponse, 10)
+			streamDetails := newTestStreamDetails("")
+			streamDetails.MaxPriceAge = maxPriceAge
+			spf := newTestStreamPriceFetcher(
+				t,
+				streamDetails,
+				&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+				constants.MutableMarketConfigs_1Markets,
+				&mocks.ExchangeQueryHandler{},
+				bCh,
+			)
+			spf.timeProvider = generateMockTimeProvider(now)
+			spf.latestPrices = tc.latestPrices
+
+			spf.FlushPrices()
+			close(bCh)
+
+			var prices []*types.MarketPriceTimestamp
+			var errs []error
+			for response := range bCh {
+				if response.Err != nil {
+					errs = append(errs, response.Err)
+				} else {
+					prices = append(prices, response.Price)
+				}
+			}
+			require.Equal(t, tc.expectedPrices, prices)
+			pricefeed.ErrorsEqual(t, tc.expectedErrors, errs)
+		})
+	}
+}
+
+func TestStreamPriceFetcher_RunTaskLoop(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	maxPriceAge := 10 * time.Second
+	streamedPrice := types.MarketPriceTimestamp{
+		MarketId:      constants.MarketId7,
+		Price:         constants.Price5,
+		LastUpdatedAt: now,
+	}
+	polledPrice := constants.CanonicalMarketPriceTimestampResponses[constants.MarketId7]
+
+	tests := map[string]struct {
+		isConnected    bool
+		lastStreamedAt time.Time
+
+		expectedPrice *types.MarketPriceTimestamp
+	}{
+		"Stream is healthy": {
+			isConnected:    true,
+			lastStreamedAt: now.Add(-maxPriceAge),
+			expectedPrice:  &streamedPrice,
+		},
+		"Stream is disconnected": {
+			isConnected:    false,
+			lastStreamedAt: now,
+			expectedPrice:  polledPrice,
+		},
+		"Stream has not streamed any price": {
+			isConnected:   true,
+			expectedPrice: polledPrice,
+		},
+		"Stream is stale": {
+			isConnected:    true,
+			lastStreamedAt: now.Add(-maxPriceAge - time.Second),
+			expectedPrice:  polledPrice,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockExchangeQueryHandler := &mocks.ExchangeQueryHandler{}
+			mockSingleMarketCalls(mockExchangeQueryHandler)
+
+			bCh := make(chan *PriceFetcherSubtaskResponse, 10)
+			streamDetails := newTestStreamDetails("")
+			streamDetails.MaxPriceAge = maxPriceAge
+			spf := newTestStreamPriceFetcher(
+				t,
+				streamDetails,
+				&constants.Exchange1_1Markets_MutableExchangeMarketConfig,
+				constants.MutableMarketConfigs_1Markets,
+				mockExchangeQueryHandler,
+				bCh,
+			)
+			spf.timeProvider = generateMockTimeProvider(now)
+			spf.isConnected.Store(tc.isConnected)
+			spf.lastStreamedAt = tc.lastStreamedAt
+			// Streamed prices are kept fresh, so that only the health of the stream decides whether
+			// prices are polled.
+			spf.latestPrices[constants.MarketId7] = streamedPrice
+
+			spf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+			close(bCh)
+
+			var prices []*types.MarketPriceTimestamp
+			for response := range bCh {
+				require.NoError(t, response.Err)
+				prices = append(prices, response.Price)
+			}
+			require.Equal(t, []*types.MarketPriceTimestamp{tc.expectedPrice}, prices)
+		})
+	}
+}
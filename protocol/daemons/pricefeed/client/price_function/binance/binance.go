@@ -2,23 +2,26 @@ package binance
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 )
 
 // BinanceTicker is our representation of ticker information returned in Binance response.
-// It implements interface `Ticker` in util.go.
+// It implements interface `VolumeTicker` in util.go.
 type BinanceTicker struct {
 	Pair      string `json:"symbol" validate:"required"`
 	AskPrice  string `json:"askPrice" validate:"required,positive-float-string"`
 	BidPrice  string `json:"bidPrice" validate:"required,positive-float-string"`
 	LastPrice string `json:"lastPrice" validate:"required,positive-float-string"`
+	Volume    string `json:"volume"`
 }
 
-// Ensure that BinanceTicker implements the Ticker interface at compile time.
-var _ price_function.Ticker = (*BinanceTicker)(nil)
+// Ensure that BinanceTicker implements the VolumeTicker interface at compile time.
+var _ price_function.VolumeTicker = (*BinanceTicker)(nil)
 
 func (t BinanceTicker) GetPair() string {
 	// needs to be wrapped in quotes to be consistent with the API request format.
@@ -37,18 +40,27 @@ func (t BinanceTicker) GetLastPrice() string {
 	return t.LastPrice
 }
 
+func (t BinanceTicker) GetVolume() string {
+	return t.Volume
+}
+
 // BinancePriceFunction transforms an API response from Binance into a map of tickers to prices that have been
 // shifted by a market specific exponent.
 func BinancePriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into a list of tickers.
 	var binanceTickers []BinanceTicker
 	err = json.NewDecoder(response.Body).Decode(&binanceTickers)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	return price_function.GetMedianPricesFromTickers(
@@ -57,3 +69,103 @@ func BinancePriceFunction(
 		resolver,
 	)
 }
+
+const (
+	// BinanceStreamTickerEventType is the event type of 24hr ticker events pushed by the Binance stream.
+	BinanceStreamTickerEventType = "24hrTicker"
+)
+
+// BinanceStreamTicker is our representation of a 24hr ticker event pushed by the Binance WebSocket stream.
+// It implements interface `VolumeTicker` in util.go.
+// Note that the Binance stream uses single-letter keys that only differ in case. All keys that collide
+// with the keys we use are defined so that json decoding does not fall back to case-insensitive matching.
+type BinanceStreamTicker struct {
+	EventType   string `json:"e"`
+	EventTime   int64  `json:"E"`
+	Pair        string `json:"s" validate:"required"`
+	LastPrice   string `json:"c" validate:"required,positive-float-string"`
+	Volume      string `json:"v"`
+	CloseTime   int64  `json:"C"`
+	BidPrice    string `json:"b" validate:"required,positive-float-string"`
+	BidQuantity string `json:"B"`
+	AskPrice    string `json:"a" validate:"required,positive-float-string"`
+	AskQuantity string `json:"A"`
+}
+
+// Ensure that BinanceStreamTicker implements the VolumeTicker interface at compile time.
+var _ price_function.VolumeTicker = (*BinanceStreamTicker)(nil)
+
+func (t BinanceStreamTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t BinanceStreamTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t BinanceStreamTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t BinanceStreamTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+func (t BinanceStreamTicker) GetVolume() string {
+	return t.Volume
+}
+
+// binanceSubscribeRequest is the request sent to the Binance stream to subscribe to a list of streams.
+type binanceSubscribeRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params"`
+	Id     uint32   `json:"id"`
+}
+
+// BinanceSubscribeMessages returns the message that subscribes to the 24hr ticker stream of each ticker.
+func BinanceSubscribeMessages(tickers []string) (messages [][]byte, err error) {
+	streams := make([]string, 0, len(tickers))
+	for _, ticker := range tickers {
+		streams = append(streams, fmt.Sprintf("%s@ticker", strings.ToLower(ticker)))
+	}
+
+	message, err := json.Marshal(binanceSubscribeRequest{
+		Method: "SUBSCRIBE",
+		Params: streams,
+		Id:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{message}, nil
+}
+
+// BinanceStreamPriceFunction transforms a message pushed by the Binance stream into a map of tickers to prices
+// that have been shifted by a market specific exponent. Messages other than ticker events, such as responses to
+// subscription requests, do not contain any prices.
+func BinanceStreamPriceFunction(
+	message []byte,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	var binanceTicker BinanceStreamTicker
+	err = json.Unmarshal(message, &binanceTicker)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if binanceTicker.EventType != BinanceStreamTickerEventType {
+		return map[string]uint64{}, map[string]types.Volume{}, map[string]error{}, nil
+	}
+
+	return price_function.GetMedianPricesFromTickers(
+		[]BinanceStreamTicker{binanceTicker},
+		tickerToExponent,
+		resolver,
+	)
+}
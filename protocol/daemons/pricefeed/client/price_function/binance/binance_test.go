@@ -7,6 +7,7 @@ import (
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/binance"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
@@ -212,9 +213,9 @@ func TestBinancePriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = binance.BinancePriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = binance.BinancePriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = binance.BinancePriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = binance.BinancePriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -229,3 +230,115 @@ func TestBinancePriceFunction_Mixed(t *testing.T) {
 		})
 	}
 }
+
+func TestBinanceSubscribeMessages(t *testing.T) {
+	messages, err := binance.BinanceSubscribeMessages([]string{BTCUSDC_TICKER, ETHUSDC_TICKER})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{`{"method":"SUBSCRIBE","params":["btcusdt@ticker","ethusdt@ticker"],"id":1}`},
+		lib.MapSlice(messages, func(message []byte) string { return string(message) }),
+	)
+}
+
+func TestBinanceStreamPriceFunction(t *testing.T) {
+	btcStreamTicker := pricefeed.ReadJsonTestFile(t, "btc_stream_ticker_binance.json")
+
+	tests := map[string]struct {
+		// parameters
+		message     string
+		exponentMap map[string]int32
+
+		// expectations
+		expectedPriceMap       map[string]uint64
+		expectedVolumeMap      map[string]types.Volume
+		expectedUnavailableMap map[string]error
+		expectedError          error
+	}{
+		"Failure - invalid message": {
+			message:       `{"e":"24hrTicker",}`,
+			exponentMap:   BtcExponentMap,
+			expectedError: errors.New("invalid character '}' looking for beginning of object key string"),
+		},
+		"Success - subscription response contains no prices": {
+			message:                `{"result":null,"id":1}`,
+			exponentMap:            BtcExponentMap,
+			expectedPriceMap:       map[string]uint64{},
+			expectedVolumeMap:      map[string]types.Volume{},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Unavailable - bid price is 0": {
+			message:           `{"e":"24hrTicker","s":"ETHUSDT","c":"1780.29000000","b":"0","a":"1780.25000000"}`,
+			exponentMap:       EthExponentMap,
+			expectedPriceMap:  make(map[string]uint64),
+			expectedVolumeMap: make(map[string]types.Volume),
+			expectedUnavailableMap: map[string]error{
+				ETHUSDC_TICKER: errors.New("Key: 'BinanceStreamTicker.BidPrice' Error:Field validation for " +
+					"'BidPrice' failed on the 'positive-float-string' tag"),
+			},
+		},
+		"Success - negative exponent": {
+			message:     btcStreamTicker,
+			exponentMap: BtcExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDC_TICKER: uint64(2_794_470_000),
+			},
+			expectedVolumeMap: map[string]types.Volume{
+				BTCUSDC_TICKER: {Value: 6_228_578_422_000, Exponent: -8},
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Mixed - ticker of another market is unavailable": {
+			message:     btcStreamTicker,
+			exponentMap: BtcAndEthExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSDC_TICKER: uint64(2_794_470_000),
+			},
+			expectedVolumeMap: map[string]types.Volume{
+				BTCUSDC_TICKER: {Value: 6_228_578_422_000, Exponent: -8},
+			},
+			expectedUnavailableMap: map[string]error{
+				ETHUSDC_TICKER: errors.New("no listing found for ticker ETHUSDT"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prices, volumes, unavailable, err := binance.BinanceStreamPriceFunction(
+				[]byte(tc.message),
+				tc.exponentMap,
+				lib.Median[uint64],
+			)
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, volumes)
+				require.Nil(t, unavailable)
+			} else {
+				require.Equal(t, tc.expectedPriceMap, prices)
+				require.Equal(t, tc.expectedVolumeMap, volumes)
+				pricefeed.ErrorMapsEqual(t, tc.expectedUnavailableMap, unavailable)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBinancePriceFunction_Volume(t *testing.T) {
+	btcTicker := pricefeed.ReadJsonTestFile(t, "btc_ticker_binance.json")
+	ethTicker := pricefeed.ReadJsonTestFile(t, "eth_ticker_binance.json")
+	response := testutil.CreateResponseFromJson(fmt.Sprintf(`[%s,%s]`, btcTicker, ethTicker))
+
+	_, volumes, _, err := binance.BinancePriceFunction(response, BtcAndEthExponentMap, lib.Median[uint64])
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]types.Volume{
+			BTCUSDC_TICKER: {Value: 6_228_578_422_000, Exponent: -8},
+			ETHUSDC_TICKER: {Value: 29_833_498_780_000, Exponent: -8},
+		},
+		volumes,
+	)
+}
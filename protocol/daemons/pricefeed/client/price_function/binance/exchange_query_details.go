@@ -1,6 +1,8 @@
 package binance
 
 import (
+	"time"
+
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants/exchange_common"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 )
@@ -11,6 +13,15 @@ var (
 		Url:           "https://data-api.binance.vision/api/v3/ticker/24hr",
 		PriceFunction: BinancePriceFunction,
 		IsMultiMarket: true,
+		StreamDetails: &BinanceStreamDetails,
+	}
+
+	BinanceStreamDetails = types.ExchangeStreamDetails{
+		Url:                 "wss://data-stream.binance.vision/ws",
+		SubscribeMessages:   BinanceSubscribeMessages,
+		StreamPriceFunction: BinanceStreamPriceFunction,
+		// Ticker events are pushed every second.
+		MaxPriceAge: 10 * time.Second,
 	}
 
 	BinanceUSDetails = types.ExchangeQueryDetails{
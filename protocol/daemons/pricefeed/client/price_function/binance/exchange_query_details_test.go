@@ -22,3 +22,11 @@ func TestBinanceIsMultiMarket(t *testing.T) {
 func TestBinanceUSIsMultiMarket(t *testing.T) {
 	require.True(t, binance.BinanceUSDetails.IsMultiMarket)
 }
+
+func TestBinanceStreamUrl(t *testing.T) {
+	require.Equal(t, "wss://data-stream.binance.vision/ws", binance.BinanceDetails.StreamDetails.Url)
+}
+
+func TestBinanceUSIsNotStreamed(t *testing.T) {
+	require.Nil(t, binance.BinanceUSDetails.StreamDetails)
+}
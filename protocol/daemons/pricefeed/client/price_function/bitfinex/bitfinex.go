@@ -56,12 +56,17 @@ func BitfinexPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into raw format first.
 	var rawResponse [][]interface{}
 	err = json.NewDecoder(response.Body).Decode(&rawResponse)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Convert raw tickers in response into a list of `BitfinexTicker`.
@@ -105,7 +110,7 @@ func BitfinexPriceFunction(
 	}
 
 	// Calculate median price of each ticker in `tickerToExponent`.
-	tickerToPrice, unavailableTickers, err = price_function.GetMedianPricesFromTickers(
+	tickerToPrice, tickerToVolume, unavailableTickers, err = price_function.GetMedianPricesFromTickers(
 		bitfinexTickers,
 		tickerToExponent,
 		resolver,
@@ -118,5 +123,5 @@ func BitfinexPriceFunction(
 		}
 	}
 
-	return tickerToPrice, unavailableTickers, err
+	return tickerToPrice, tickerToVolume, unavailableTickers, err
 }
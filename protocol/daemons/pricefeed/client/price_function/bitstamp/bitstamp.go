@@ -42,12 +42,17 @@ func BitstampPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into a list of tickers.
 	var bitstampTickers []BitstampTicker
 	err = json.NewDecoder(response.Body).Decode(&bitstampTickers)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	return price_function.GetMedianPricesFromTickers(
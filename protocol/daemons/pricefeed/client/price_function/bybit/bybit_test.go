@@ -7,6 +7,7 @@ import (
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/bybit"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
@@ -198,9 +199,9 @@ func TestBybitPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = bybit.BybitPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = bybit.BybitPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = bybit.BybitPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = bybit.BybitPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -215,3 +216,18 @@ func TestBybitPriceFunction_Mixed(t *testing.T) {
 		})
 	}
 }
+
+func TestBybitPriceFunction_Volume(t *testing.T) {
+	response := testutil.CreateResponseFromJson(BtcAndEthResponseString)
+
+	_, volumes, _, err := bybit.BybitPriceFunction(response, BtcAndEthExponentMap, lib.Median[uint64])
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]types.Volume{
+			BTCUSDC_TICKER: {Value: 583_357_073_100, Exponent: -8},
+			ETHUSDC_TICKER: {Value: 5_311_043_746_000, Exponent: -8},
+		},
+		volumes,
+	)
+}
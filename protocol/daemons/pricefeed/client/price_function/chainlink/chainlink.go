@@ -0,0 +1,138 @@
+package chainlink
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// MaxRoundAge is the maximum age of the latest round of an aggregator before its price is considered stale.
+	// The longest heartbeat of Chainlink aggregators on Ethereum is 24 hours.
+	MaxRoundAge = 25 * time.Hour
+
+	// AggregatorV3ABI is the ABI of the methods of a Chainlink aggregator used to read its latest round.
+	AggregatorV3ABI = `[
+		{
+			"inputs": [],
+			"name": "decimals",
+			"outputs": [{"internalType": "uint8", "name": "", "type": "uint8"}],
+			"stateMutability": "view",
+			"type": "function"
+		},
+		{
+			"inputs": [],
+			"name": "latestRoundData",
+			"outputs": [
+				{"internalType": "uint80", "name": "roundId", "type": "uint80"},
+				{"internalType": "int256", "name": "answer", "type": "int256"},
+				{"internalType": "uint256", "name": "startedAt", "type": "uint256"},
+				{"internalType": "uint256", "name": "updatedAt", "type": "uint256"},
+				{"internalType": "uint80", "name": "answeredInRound", "type": "uint80"}
+			],
+			"stateMutability": "view",
+			"type": "function"
+		}
+	]`
+)
+
+// aggregatorV3Abi is the parsed AggregatorV3ABI. It is initialized at most once.
+var aggregatorV3Abi = sync.OnceValue[*ethabi.ABI](
+	func() *ethabi.ABI {
+		aggregatorAbi, err := ethabi.JSON(strings.NewReader(AggregatorV3ABI))
+		if err != nil {
+			panic(err)
+		}
+		return &aggregatorAbi
+	},
+)
+
+// GetAggregatorV3Abi returns the ABI of the methods of a Chainlink aggregator used to read its latest round.
+func GetAggregatorV3Abi() *ethabi.ABI {
+	return aggregatorV3Abi()
+}
+
+// ChainlinkPriceFunction reads the answer of the latest round of each Chainlink aggregator in
+// `tickerToExponent`, where each ticker is the address of an aggregator. The answer is scaled by the decimals
+// of the aggregator and shifted by the exponent of the market. Aggregators whose latest round is incomplete,
+// older than `MaxRoundAge` as of the current time of `timeProvider`, or has a non-positive answer are marked
+// as unavailable.
+func ChainlinkPriceFunction(
+	ctx context.Context,
+	caller bind.ContractCaller,
+	timeProvider libtime.TimeProvider,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (
+	tickerToPrice map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	now := timeProvider.Now()
+	tickerToPrice, unavailableTickers = price_function.GetPricesFromContracts(
+		tickerToExponent,
+		resolver,
+		func(address ethcommon.Address) (*big.Float, error) {
+			return getLatestAnswer(ctx, caller, address, now)
+		},
+	)
+	return tickerToPrice, unavailableTickers, nil
+}
+
+// getLatestAnswer returns the answer of the latest round of an aggregator, scaled by the decimals of the
+// aggregator.
+func getLatestAnswer(
+	ctx context.Context,
+	caller bind.ContractCaller,
+	aggregatorAddress ethcommon.Address,
+	now time.Time,
+) (*big.Float, error) {
+	aggregator := bind.NewBoundContract(aggregatorAddress, *GetAggregatorV3Abi(), caller, nil, nil)
+	opts := &bind.CallOpts{Context: ctx}
+
+	var roundData []interface{}
+	if err := aggregator.Call(opts, &roundData, "latestRoundData"); err != nil {
+		return nil, fmt.Errorf("failed to get latest round of aggregator %v: %w", aggregatorAddress, err)
+	}
+	roundId := roundData[0].(*big.Int)
+	answer := roundData[1].(*big.Int)
+	updatedAt := roundData[3].(*big.Int)
+	answeredInRound := roundData[4].(*big.Int)
+
+	if updatedAt.Sign() == 0 || answeredInRound.Cmp(roundId) < 0 {
+		return nil, fmt.Errorf("latest round %v of aggregator %v is incomplete", roundId, aggregatorAddress)
+	}
+	if updatedAt.Cmp(big.NewInt(now.Add(-MaxRoundAge).Unix())) < 0 {
+		return nil, fmt.Errorf(
+			"latest round %v of aggregator %v is stale, last updated at: %v",
+			roundId,
+			aggregatorAddress,
+			time.Unix(updatedAt.Int64(), 0).UTC(),
+		)
+	}
+	if answer.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid answer of aggregator %v: %v", aggregatorAddress, answer)
+	}
+
+	var decimalsResult []interface{}
+	if err := aggregator.Call(opts, &decimalsResult, "decimals"); err != nil {
+		return nil, fmt.Errorf("failed to get decimals of aggregator %v: %w", aggregatorAddress, err)
+	}
+	decimals := decimalsResult[0].(uint8)
+
+	return new(big.Float).Quo(
+		new(big.Float).SetInt(answer),
+		new(big.Float).SetInt(lib.BigPow10(uint64(decimals))),
+	), nil
+}
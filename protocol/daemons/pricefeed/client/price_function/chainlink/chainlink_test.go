@@ -0,0 +1,190 @@
+package chainlink_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/chainlink"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// Test contract addresses.
+var (
+	ethUsdAggregatorAddress         = ethcommon.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+	btcUsdAggregatorAddress         = ethcommon.HexToAddress("0xF4030086522a5bEEa4988F8cA5B36dbC97BeE88c")
+	staleAggregatorAddress          = ethcommon.HexToAddress("0x0000000000000000000000000000000000000A01")
+	incompleteAggregatorAddress     = ethcommon.HexToAddress("0x0000000000000000000000000000000000000A02")
+	negativeAnswerAggregatorAddress = ethcommon.HexToAddress("0x0000000000000000000000000000000000000A03")
+	missingContractAddress          = ethcommon.HexToAddress("0x0000000000000000000000000000000000000C01")
+)
+
+// newAggregatorContract returns a mock Chainlink aggregator with the given decimals and latest round.
+func newAggregatorContract(
+	decimals uint8,
+	roundId int64,
+	answer int64,
+	updatedAt time.Time,
+	answeredInRound int64,
+) pricefeed.MockEthContract {
+	return pricefeed.MockEthContract{
+		Abi: chainlink.GetAggregatorV3Abi(),
+		MethodToOutputs: map[string][]interface{}{
+			"decimals": {decimals},
+			"latestRoundData": {
+				big.NewInt(roundId),
+				big.NewInt(answer),
+				big.NewInt(updatedAt.Unix()),
+				big.NewInt(updatedAt.Unix()),
+				big.NewInt(answeredInRound),
+			},
+		},
+	}
+}
+
+func TestChainlinkPriceFunction_Mixed(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	mockTimeProvider := &mocks.TimeProvider{}
+	mockTimeProvider.On("Now").Return(now)
+	staleUpdatedAt := now.Add(-chainlink.MaxRoundAge - time.Minute)
+	backend := pricefeed.NewSimulatedEthBackend(
+		t,
+		map[ethcommon.Address]pricefeed.MockEthContract{
+			ethUsdAggregatorAddress:         newAggregatorContract(8, 100, 2_000_12345678, now, 100),
+			btcUsdAggregatorAddress:         newAggregatorContract(8, 200, 29_000_50000000, now, 200),
+			staleAggregatorAddress:          newAggregatorContract(8, 300, 1_00000000, staleUpdatedAt, 300),
+			incompleteAggregatorAddress:     newAggregatorContract(8, 400, 1_00000000, now, 399),
+			negativeAnswerAggregatorAddress: newAggregatorContract(8, 500, -1, now, 500),
+		},
+	)
+
+	tests := map[string]struct {
+		// parameters
+		tickerToExponent    map[string]int32
+		medianFunctionFails bool
+
+		// expectations
+		expectedPriceMap       map[string]uint64
+		expectedUnavailableMap map[string]error
+	}{
+		"Success - multiple aggregators": {
+			tickerToExponent: map[string]int32{
+				ethUsdAggregatorAddress.Hex(): -6,
+				btcUsdAggregatorAddress.Hex(): -5,
+			},
+			expectedPriceMap: map[string]uint64{
+				ethUsdAggregatorAddress.Hex(): uint64(2_000_123_456),
+				btcUsdAggregatorAddress.Hex(): uint64(2_900_050_000),
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Success - positive exponent": {
+			tickerToExponent: map[string]int32{
+				btcUsdAggregatorAddress.Hex(): 2,
+			},
+			expectedPriceMap: map[string]uint64{
+				btcUsdAggregatorAddress.Hex(): uint64(290),
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Unavailable - invalid address": {
+			tickerToExponent: map[string]int32{
+				"ETH-USD":                     -6,
+				ethUsdAggregatorAddress.Hex(): -6,
+			},
+			expectedPriceMap: map[string]uint64{
+				ethUsdAggregatorAddress.Hex(): uint64(2_000_123_456),
+			},
+			expectedUnavailableMap: map[string]error{
+				"ETH-USD": errors.New("invalid contract address: ETH-USD"),
+			},
+		},
+		"Unavailable - no aggregator contract": {
+			tickerToExponent: map[string]int32{
+				missingContractAddress.Hex(): -6,
+			},
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				missingContractAddress.Hex(): fmt.Errorf(
+					"failed to get latest round of aggregator %v: no contract code at given address",
+					missingContractAddress,
+				),
+			},
+		},
+		"Unavailable - stale round": {
+			tickerToExponent: map[string]int32{
+				staleAggregatorAddress.Hex(): -6,
+			},
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				staleAggregatorAddress.Hex(): fmt.Errorf(
+					"latest round 300 of aggregator %v is stale, last updated at: %v",
+					staleAggregatorAddress,
+					time.Unix(staleUpdatedAt.Unix(), 0).UTC(),
+				),
+			},
+		},
+		"Unavailable - incomplete round": {
+			tickerToExponent: map[string]int32{
+				incompleteAggregatorAddress.Hex(): -6,
+			},
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				incompleteAggregatorAddress.Hex(): fmt.Errorf(
+					"latest round 400 of aggregator %v is incomplete",
+					incompleteAggregatorAddress,
+				),
+			},
+		},
+		"Unavailable - negative answer": {
+			tickerToExponent: map[string]int32{
+				negativeAnswerAggregatorAddress.Hex(): -6,
+			},
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				negativeAnswerAggregatorAddress.Hex(): fmt.Errorf(
+					"invalid answer of aggregator %v: -1",
+					negativeAnswerAggregatorAddress,
+				),
+			},
+		},
+		"Unavailable - medianization error": {
+			tickerToExponent: map[string]int32{
+				ethUsdAggregatorAddress.Hex(): -6,
+			},
+			medianFunctionFails: true,
+			expectedPriceMap:    map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				ethUsdAggregatorAddress.Hex(): testutil.MedianizationError,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resolver := lib.Median[uint64]
+			if tc.medianFunctionFails {
+				resolver = testutil.MedianErr
+			}
+
+			prices, unavailable, err := chainlink.ChainlinkPriceFunction(
+				context.Background(),
+				backend,
+				mockTimeProvider,
+				tc.tickerToExponent,
+				resolver,
+			)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPriceMap, prices)
+			pricefeed.ErrorMapsEqual(t, tc.expectedUnavailableMap, unavailable)
+		})
+	}
+}
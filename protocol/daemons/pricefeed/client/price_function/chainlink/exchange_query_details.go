@@ -0,0 +1,16 @@
+package chainlink
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants/exchange_common"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+)
+
+var (
+	ChainlinkDetails = types.ExchangeQueryDetails{
+		Exchange:      exchange_common.EXCHANGE_ID_CHAINLINK,
+		IsMultiMarket: true,
+		OnChainDetails: &types.ExchangeOnChainDetails{
+			OnChainPriceFunction: ChainlinkPriceFunction,
+		},
+	}
+)
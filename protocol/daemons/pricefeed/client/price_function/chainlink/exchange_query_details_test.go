@@ -0,0 +1,16 @@
+package chainlink_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/chainlink"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainlinkIsMultiMarket(t *testing.T) {
+	require.True(t, chainlink.ChainlinkDetails.IsMultiMarket)
+}
+
+func TestChainlinkIsOnChain(t *testing.T) {
+	require.NotNil(t, chainlink.ChainlinkDetails.OnChainDetails)
+}
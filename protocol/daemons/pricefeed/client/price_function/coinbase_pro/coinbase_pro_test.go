@@ -6,6 +6,7 @@ import (
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/coinbase_pro"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
@@ -168,9 +169,9 @@ func TestCoinbaseProPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = coinbase_pro.CoinbaseProPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = coinbase_pro.CoinbaseProPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = coinbase_pro.CoinbaseProPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = coinbase_pro.CoinbaseProPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -185,3 +186,11 @@ func TestCoinbaseProPriceFunction_Mixed(t *testing.T) {
 		})
 	}
 }
+
+func TestCoinbaseProPriceFunction_Volume(t *testing.T) {
+	response := testutil.CreateResponseFromJson(pricefeed.ReadJsonTestFile(t, "btc_ticker.json"))
+
+	_, volumes, _, err := coinbase_pro.CoinbaseProPriceFunction(response, BtcExponentMap, lib.Median[uint64])
+	require.NoError(t, err)
+	require.Equal(t, map[string]types.Volume{BTCUSDC_TICKER: {Value: 1_769_796_099_093, Exponent: -8}}, volumes)
+}
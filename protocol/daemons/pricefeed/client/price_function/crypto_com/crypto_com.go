@@ -56,16 +56,21 @@ func CryptoComPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body into a list of tickers.
 	var cryptoComResponseBody CryptoComResponseBody
 	err = json.NewDecoder(response.Body).Decode(&cryptoComResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if cryptoComResponseBody.Code != 0 {
-		return nil, nil, errors.New("response code is not 0")
+		return nil, nil, nil, errors.New("response code is not 0")
 	}
 
 	return price_function.GetMedianPricesFromTickers(
@@ -219,9 +219,9 @@ func TestCryptoComPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = crypto_com.CryptoComPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = crypto_com.CryptoComPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = crypto_com.CryptoComPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = crypto_com.CryptoComPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
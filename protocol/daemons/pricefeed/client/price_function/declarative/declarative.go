@@ -0,0 +1,281 @@
+package declarative
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+const (
+	// invertedPricePrecision is the number of significant digits kept when inverting a price.
+	invertedPricePrecision = 30
+)
+
+// DeclarativeTicker is our representation of ticker information extracted from a response using an
+// `ExchangeDefinition`.
+// DeclarativeTicker implements interface `VolumeTicker` in util.go.
+type DeclarativeTicker struct {
+	Pair      string `validate:"required"`
+	AskPrice  string `validate:"required,positive-float-string"`
+	BidPrice  string `validate:"required,positive-float-string"`
+	LastPrice string `validate:"required,positive-float-string"`
+	Volume    string
+}
+
+// Ensure that DeclarativeTicker implements the VolumeTicker interface at compile time.
+var _ price_function.VolumeTicker = (*DeclarativeTicker)(nil)
+
+func (t DeclarativeTicker) GetPair() string {
+	return t.Pair
+}
+
+func (t DeclarativeTicker) GetAskPrice() string {
+	return t.AskPrice
+}
+
+func (t DeclarativeTicker) GetBidPrice() string {
+	return t.BidPrice
+}
+
+func (t DeclarativeTicker) GetLastPrice() string {
+	return t.LastPrice
+}
+
+func (t DeclarativeTicker) GetVolume() string {
+	return t.Volume
+}
+
+// NewExchangeQueryDetails returns the `ExchangeQueryDetails` for an exchange defined by the given
+// `ExchangeDefinition`.
+func NewExchangeQueryDetails(definition types.ExchangeDefinition) types.ExchangeQueryDetails {
+	return types.ExchangeQueryDetails{
+		Exchange:      definition.ExchangeId,
+		Url:           definition.Url,
+		PriceFunction: NewPriceFunction(definition),
+		IsMultiMarket: definition.IsMultiMarket,
+	}
+}
+
+// NewPriceFunction returns a price function that transforms an API response from the exchange defined by the
+// given `ExchangeDefinition` into a map of tickers to prices that have been shifted by a market specific
+// exponent.
+func NewPriceFunction(definition types.ExchangeDefinition) func(
+	response *http.Response,
+	tickerToExponent map[string]int32,
+	resolver pricefeedtypes.Resolver,
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]pricefeedtypes.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	return func(
+		response *http.Response,
+		tickerToExponent map[string]int32,
+		resolver pricefeedtypes.Resolver,
+	) (
+		tickerToPrice map[string]uint64,
+		tickerToVolume map[string]pricefeedtypes.Volume,
+		unavailableTickers map[string]error,
+		err error,
+	) {
+		// Unmarshal response body. Numbers are kept as strings to preserve their precision.
+		var body any
+		decoder := json.NewDecoder(response.Body)
+		decoder.UseNumber()
+		if err = decoder.Decode(&body); err != nil {
+			return nil, nil, nil, err
+		}
+
+		tickersValue, err := getJsonPath(body, definition.TickersPath)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		tickerToValue, err := getTickerToValue(definition, tickersValue, tickerToExponent)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		// Extract the prices of each requested ticker, marking tickers whose prices cannot be extracted
+		// as unavailable.
+		tickers := make([]DeclarativeTicker, 0, len(tickerToValue))
+		extractionErrors := make(map[string]error)
+		for ticker, value := range tickerToValue {
+			declarativeTicker, err := newDeclarativeTicker(definition, ticker, value)
+			if err != nil {
+				extractionErrors[ticker] = err
+				continue
+			}
+			tickers = append(tickers, declarativeTicker)
+		}
+
+		tickerToPrice, tickerToVolume, unavailableTickers, err = price_function.GetMedianPricesFromTickers(
+			tickers,
+			tickerToExponent,
+			resolver,
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for ticker, err := range extractionErrors {
+			unavailableTickers[ticker] = err
+		}
+		return tickerToPrice, tickerToVolume, unavailableTickers, nil
+	}
+}
+
+// getTickerToValue returns the ticker objects of all requested tickers found in the response.
+func getTickerToValue(
+	definition types.ExchangeDefinition,
+	tickersValue any,
+	tickerToExponent map[string]int32,
+) (map[string]any, error) {
+	tickerToValue := make(map[string]any, len(tickerToExponent))
+
+	// A single-market response contains only the ticker that was queried.
+	if !definition.IsMultiMarket {
+		ticker, _, err := price_function.GetOnlyTickerAndExponent(tickerToExponent, definition.ExchangeId)
+		if err != nil {
+			return nil, err
+		}
+		tickerToValue[ticker] = tickersValue
+		return tickerToValue, nil
+	}
+
+	switch tickers := tickersValue.(type) {
+	case []any:
+		if definition.TickerPath == "" {
+			return nil, fmt.Errorf("ticker path must be defined for a response with an array of tickers")
+		}
+		for _, value := range tickers {
+			tickerValue, err := getJsonPath(value, definition.TickerPath)
+			if err != nil {
+				// Skip tickers that cannot be identified, as the response may contain tickers of any format.
+				continue
+			}
+			ticker, err := jsonValueToString(tickerValue)
+			if err != nil {
+				continue
+			}
+			if _, exists := tickerToExponent[ticker]; exists {
+				tickerToValue[ticker] = value
+			}
+		}
+	case map[string]any:
+		if definition.TickerPath != "" {
+			return nil, fmt.Errorf("ticker path must be empty for a response with an object of tickers")
+		}
+		for ticker, value := range tickers {
+			if _, exists := tickerToExponent[ticker]; exists {
+				tickerToValue[ticker] = value
+			}
+		}
+	default:
+		return nil, fmt.Errorf("tickers must be an array or an object, but got: %T", tickersValue)
+	}
+	return tickerToValue, nil
+}
+
+// newDeclarativeTicker extracts the prices and volume of a ticker from its ticker object.
+func newDeclarativeTicker(
+	definition types.ExchangeDefinition,
+	ticker string,
+	value any,
+) (DeclarativeTicker, error) {
+	declarativeTicker := DeclarativeTicker{Pair: ticker}
+	for _, field := range []struct {
+		path   string
+		target *string
+	}{
+		{definition.AskPricePath, &declarativeTicker.AskPrice},
+		{definition.BidPricePath, &declarativeTicker.BidPrice},
+		{definition.LastPricePath, &declarativeTicker.LastPrice},
+	} {
+		fieldValue, err := getJsonPath(value, field.path)
+		if err != nil {
+			return DeclarativeTicker{}, err
+		}
+		price, err := jsonValueToString(fieldValue)
+		if err != nil {
+			return DeclarativeTicker{}, fmt.Errorf("invalid value at path '%v': %w", field.path, err)
+		}
+		if definition.Invert {
+			if price, err = invertPrice(price); err != nil {
+				return DeclarativeTicker{}, err
+			}
+		}
+		*field.target = price
+	}
+
+	// Volume is optional, and is not reported for inverted tickers since it would be denominated in a
+	// different asset.
+	if definition.VolumePath != "" && !definition.Invert {
+		if volumeValue, err := getJsonPath(value, definition.VolumePath); err == nil {
+			if volume, err := jsonValueToString(volumeValue); err == nil {
+				declarativeTicker.Volume = volume
+			}
+		}
+	}
+	return declarativeTicker, nil
+}
+
+// getJsonPath returns the value at the dot-separated path of object keys and array indices within the given
+// json value. An empty path returns the value itself.
+func getJsonPath(value any, path string) (any, error) {
+	if path == "" {
+		return value, nil
+	}
+	current := value
+	for _, key := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			next, exists := node[key]
+			if !exists {
+				return nil, fmt.Errorf("key '%v' of path '%v' not found", key, path)
+			}
+			current = next
+		case []any:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("index '%v' of path '%v' is not valid", key, path)
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("key '%v' of path '%v' not found", key, path)
+		}
+	}
+	return current, nil
+}
+
+// jsonValueToString returns the string representation of a json string or number.
+func jsonValueToString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("expected a string or number, but got: %T", value)
+	}
+}
+
+// invertPrice returns the string representation of 1 / price.
+func invertPrice(price string) (string, error) {
+	bigPrice, ok := new(big.Float).SetPrec(128).SetString(price)
+	if !ok {
+		return "", fmt.Errorf("invalid, value is not a number: %v", price)
+	}
+	if bigPrice.Sign() <= 0 {
+		return "", fmt.Errorf("cannot invert non-positive price: %v", price)
+	}
+	inverted := new(big.Float).SetPrec(128).Quo(big.NewFloat(1).SetPrec(128), bigPrice)
+	return inverted.Text('g', invertedPricePrecision), nil
+}
@@ -0,0 +1,253 @@
+package declarative_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/declarative"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pft "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	"github.com/stretchr/testify/require"
+)
+
+// Test tickers.
+const (
+	BTCUSD_TICKER = "BTC-USD"
+	ETHUSD_TICKER = "ETH-USD"
+)
+
+// Test exponent maps.
+var (
+	BtcExponentMap = map[string]int32{
+		BTCUSD_TICKER: constants.BtcUsdExponent,
+	}
+	BtcAndEthExponentMap = map[string]int32{
+		BTCUSD_TICKER: constants.BtcUsdExponent,
+		ETHUSD_TICKER: constants.EthUsdExponent,
+	}
+)
+
+// Test exchange definitions.
+var (
+	arrayDefinition = types.ExchangeDefinition{
+		ExchangeId:    "ArrayExchange",
+		Url:           "https://api.example.com/tickers",
+		IsMultiMarket: true,
+		TickersPath:   "result.tickers",
+		TickerPath:    "info.symbol",
+		BidPricePath:  "bid",
+		AskPricePath:  "ask",
+		LastPricePath: "trades.0.price",
+		VolumePath:    "volume",
+	}
+	objectDefinition = types.ExchangeDefinition{
+		ExchangeId:    "ObjectExchange",
+		Url:           "https://api.example.com/tickers",
+		IsMultiMarket: true,
+		TickersPath:   "result",
+		BidPricePath:  "b",
+		AskPricePath:  "a",
+		LastPricePath: "c",
+	}
+	singleMarketDefinition = types.ExchangeDefinition{
+		ExchangeId:    "SingleMarketExchange",
+		Url:           "https://api.example.com/ticker?symbol=$",
+		BidPricePath:  "bid",
+		AskPricePath:  "ask",
+		LastPricePath: "last",
+		VolumePath:    "volume",
+	}
+)
+
+func TestNewPriceFunction_Mixed(t *testing.T) {
+	arrayResponse := `{"result":{"tickers":[
+		{"info":{"symbol":"BTC-USD"},"bid":"29000.5","ask":"29001.5","trades":[{"price":"29001"}],"volume":"1234.5"},
+		{"info":{"symbol":"ETH-USD"},"bid":1850.1,"ask":1850.3,"trades":[{"price":1850.2}]},
+		{"info":{"symbol":"SOL-USD"},"bid":"20"}
+	]}}`
+
+	tests := map[string]struct {
+		// parameters
+		definition          types.ExchangeDefinition
+		responseJsonString  string
+		exponentMap         map[string]int32
+		medianFunctionFails bool
+
+		// expectations
+		expectedPriceMap       map[string]uint64
+		expectedVolumeMap      map[string]pft.Volume
+		expectedUnavailableMap map[string]error
+		expectedError          error
+	}{
+		"Success - array of tickers with string and number prices": {
+			definition:         arrayDefinition,
+			responseJsonString: arrayResponse,
+			exponentMap:        BtcAndEthExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSD_TICKER: uint64(2_900_100_000),
+				ETHUSD_TICKER: uint64(1_850_200_000),
+			},
+			expectedVolumeMap: map[string]pft.Volume{
+				BTCUSD_TICKER: {Value: 123_450_000_000, Exponent: -8},
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Success - object of tickers": {
+			definition:         objectDefinition,
+			responseJsonString: `{"result":{"BTC-USD":{"a":"29001","b":"28999","c":"29000"},"XYZ":{}}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSD_TICKER: uint64(2_900_000_000),
+			},
+			expectedVolumeMap:      map[string]pft.Volume{},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Success - single market": {
+			definition:         singleMarketDefinition,
+			responseJsonString: `{"ask":"29001","bid":"28999","last":"29000","volume":"100"}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap: map[string]uint64{
+				BTCUSD_TICKER: uint64(2_900_000_000),
+			},
+			expectedVolumeMap: map[string]pft.Volume{
+				BTCUSD_TICKER: {Value: 10_000_000_000, Exponent: -8},
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Success - inverted prices drop volume": {
+			definition: func() types.ExchangeDefinition {
+				definition := singleMarketDefinition
+				definition.Invert = true
+				return definition
+			}(),
+			responseJsonString: `{"ask":"0.0004","bid":"0.0005","last":"0.0008","volume":"100"}`,
+			exponentMap:        map[string]int32{BTCUSD_TICKER: -2},
+			expectedPriceMap: map[string]uint64{
+				BTCUSD_TICKER: uint64(200_000), // median of 2500, 2000 and 1250
+			},
+			expectedVolumeMap:      map[string]pft.Volume{},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Unavailable - missing price field": {
+			definition:         arrayDefinition,
+			responseJsonString: `{"result":{"tickers":[{"info":{"symbol":"BTC-USD"},"bid":"1","ask":"1"}]}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   map[string]uint64{},
+			expectedVolumeMap:  map[string]pft.Volume{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: errors.New("key 'trades' of path 'trades.0.price' not found"),
+			},
+		},
+		"Unavailable - invalid price type": {
+			definition:         objectDefinition,
+			responseJsonString: `{"result":{"BTC-USD":{"a":"29001","b":true,"c":"29000"}}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   map[string]uint64{},
+			expectedVolumeMap:  map[string]pft.Volume{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: errors.New("invalid value at path 'b': expected a string or number, but got: bool"),
+			},
+		},
+		"Unavailable - price is 0": {
+			definition:         objectDefinition,
+			responseJsonString: `{"result":{"BTC-USD":{"a":"29001","b":"0","c":"29000"}}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   map[string]uint64{},
+			expectedVolumeMap:  map[string]pft.Volume{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: errors.New("Key: 'DeclarativeTicker.BidPrice' Error:Field validation for " +
+					"'BidPrice' failed on the 'positive-float-string' tag"),
+			},
+		},
+		"Unavailable - no listing found": {
+			definition:         objectDefinition,
+			responseJsonString: `{"result":{}}`,
+			exponentMap:        BtcExponentMap,
+			expectedPriceMap:   map[string]uint64{},
+			expectedVolumeMap:  map[string]pft.Volume{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: errors.New("no listing found for ticker BTC-USD"),
+			},
+		},
+		"Failure - medianization error": {
+			definition:          objectDefinition,
+			responseJsonString:  `{"result":{"BTC-USD":{"a":"29001","b":"28999","c":"29000"}}}`,
+			exponentMap:         BtcExponentMap,
+			medianFunctionFails: true,
+			expectedPriceMap:    map[string]uint64{},
+			expectedVolumeMap:   map[string]pft.Volume{},
+			expectedUnavailableMap: map[string]error{
+				BTCUSD_TICKER: testutil.MedianizationError,
+			},
+		},
+		"Failure - invalid response": {
+			definition:         objectDefinition,
+			responseJsonString: `{"result":{}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("unexpected EOF"),
+		},
+		"Failure - tickers path not found": {
+			definition:         arrayDefinition,
+			responseJsonString: `{"result":{}}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("key 'tickers' of path 'result.tickers' not found"),
+		},
+		"Failure - tickers are not an array or object": {
+			definition:         objectDefinition,
+			responseJsonString: `{"result":"BTC-USD"}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("tickers must be an array or an object, but got: string"),
+		},
+		"Failure - array of tickers without ticker path": {
+			definition:         objectDefinition,
+			responseJsonString: `{"result":[]}`,
+			exponentMap:        BtcExponentMap,
+			expectedError:      errors.New("ticker path must be defined for a response with an array of tickers"),
+		},
+		"Failure - single market queried for multiple tickers": {
+			definition:         singleMarketDefinition,
+			responseJsonString: `{"ask":"29001","bid":"28999","last":"29000"}`,
+			exponentMap:        BtcAndEthExponentMap,
+			expectedError: errors.New("Invalid market price exponent map for SingleMarketExchange price " +
+				"function of length: 2, expected length 1"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			response := testutil.CreateResponseFromJson(tc.responseJsonString)
+			priceFunction := declarative.NewPriceFunction(tc.definition)
+
+			resolver := lib.Median[uint64]
+			if tc.medianFunctionFails {
+				resolver = testutil.MedianErr
+			}
+			prices, volumes, unavailable, err := priceFunction(response, tc.exponentMap, resolver)
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, volumes)
+				require.Nil(t, unavailable)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedPriceMap, prices)
+				require.Equal(t, tc.expectedVolumeMap, volumes)
+				pricefeed.ErrorMapsEqual(t, tc.expectedUnavailableMap, unavailable)
+			}
+		})
+	}
+}
+
+func TestNewExchangeQueryDetails(t *testing.T) {
+	details := declarative.NewExchangeQueryDetails(arrayDefinition)
+
+	require.Equal(t, "ArrayExchange", details.Exchange)
+	require.Equal(t, "https://api.example.com/tickers", details.Url)
+	require.True(t, details.IsMultiMarket)
+	require.NotNil(t, details.PriceFunction)
+	require.Nil(t, details.StreamDetails)
+}
@@ -49,16 +49,21 @@ func HuobiPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body.
 	var huobiResponseBody HuobiResponseBody
 	err = json.NewDecoder(response.Body).Decode(&huobiResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if huobiResponseBody.Status != "ok" {
-		return nil, nil, errors.New(`huobi response status is not "ok"`)
+		return nil, nil, nil, errors.New(`huobi response status is not "ok"`)
 	}
 
 	return price_function.GetMedianPricesFromTickers(
@@ -60,10 +60,15 @@ func KrakenPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	var responseBody KrakenResponseBody
 	if err := json.NewDecoder(response.Body).Decode(&responseBody); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	// The Kraken API will return an empty list of errors with an API result containing valid tickers. However, it's
 	// easier for us to validate that there were no errors if this field is set to nil whenever it's empty.
@@ -76,7 +81,7 @@ func KrakenPriceFunction(
 		apiCallError := fmt.Errorf(
 			"kraken API call error: %w", errors.New(strings.Join(responseBody.Errors, ", ")),
 		)
-		return nil, nil, apiCallError
+		return nil, nil, nil, apiCallError
 	}
 
 	tickers := make([]KrakenTickerResult, 0, len(responseBody.Tickers))
@@ -105,9 +105,9 @@ func TestKrakenPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = kraken.KrakenPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = kraken.KrakenPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = kraken.KrakenPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = kraken.KrakenPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -54,16 +54,21 @@ func KucoinPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body.
 	var kucoinResponseBody KucoinResponseBody
 	err = json.NewDecoder(response.Body).Decode(&kucoinResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if kucoinResponseBody.Code != "200000" {
-		return nil, nil, errors.New(`kucoin response code is not "200000"`)
+		return nil, nil, nil, errors.New(`kucoin response code is not "200000"`)
 	}
 
 	return price_function.GetMedianPricesFromTickers(
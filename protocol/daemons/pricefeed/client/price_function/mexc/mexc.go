@@ -49,16 +49,21 @@ func MexcPriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Unmarshal response body.
 	var mexcResponseBody MexcResponseBody
 	err = json.NewDecoder(response.Body).Decode(&mexcResponseBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if mexcResponseBody.Code != 200 {
-		return nil, nil, errors.New(`mexc response code is not 200`)
+		return nil, nil, nil, errors.New(`mexc response code is not 200`)
 	}
 
 	return price_function.GetMedianPricesFromTickers(
@@ -218,9 +218,9 @@ func TestMexcPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = mexc.MexcPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = mexc.MexcPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = mexc.MexcPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = mexc.MexcPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/okx"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
@@ -218,9 +219,9 @@ func TestOkxPriceFunction_Mixed(t *testing.T) {
 			var unavailable map[string]error
 			var err error
 			if tc.medianFunctionFails {
-				prices, unavailable, err = okx.OkxPriceFunction(response, tc.exponentMap, testutil.MedianErr)
+				prices, _, unavailable, err = okx.OkxPriceFunction(response, tc.exponentMap, testutil.MedianErr)
 			} else {
-				prices, unavailable, err = okx.OkxPriceFunction(response, tc.exponentMap, lib.Median[uint64])
+				prices, _, unavailable, err = okx.OkxPriceFunction(response, tc.exponentMap, lib.Median[uint64])
 			}
 
 			if tc.expectedError != nil {
@@ -235,3 +236,20 @@ func TestOkxPriceFunction_Mixed(t *testing.T) {
 		})
 	}
 }
+
+func TestOkxPriceFunction_Volume(t *testing.T) {
+	btcTicker := pricefeed.ReadJsonTestFile(t, "btc_ticker.json")
+	ethTicker := pricefeed.ReadJsonTestFile(t, "eth_ticker.json")
+	response := testutil.CreateResponseFromJson(fmt.Sprintf(`{"code":"0","data":[%s,%s]}`, btcTicker, ethTicker))
+
+	_, volumes, _, err := okx.OkxPriceFunction(response, BtcAndEthExponentMap, lib.Median[uint64])
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]types.Volume{
+			BTCUSDC_TICKER: {Value: 1_782_641_028_782, Exponent: -8},
+			ETHUSDC_TICKER: {Value: 22_040_996_917_100, Exponent: -8},
+		},
+		volumes,
+	)
+}
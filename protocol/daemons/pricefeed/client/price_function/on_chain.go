@@ -0,0 +1,50 @@
+package price_function
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// GetPricesFromContracts computes a price for each ticker in `tickerToExponent` of an on-chain exchange, where
+// each ticker is the address of the contract that prices the market. `getPrice` reads the price of a market
+// from its contract, which is then shifted by the exponent of the market. Tickers that are not valid addresses,
+// or whose price cannot be read, are marked as unavailable.
+func GetPricesFromContracts(
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+	getPrice func(address ethcommon.Address) (*big.Float, error),
+) (
+	tickerToPrice map[string]uint64,
+	unavailableTickers map[string]error,
+) {
+	tickerToPrice = make(map[string]uint64, len(tickerToExponent))
+	unavailableTickers = make(map[string]error)
+
+	for ticker, exponent := range tickerToExponent {
+		if !ethcommon.IsHexAddress(ticker) {
+			unavailableTickers[ticker] = fmt.Errorf("invalid contract address: %v", ticker)
+			continue
+		}
+
+		price, err := getPrice(ethcommon.HexToAddress(ticker))
+		if err != nil {
+			unavailableTickers[ticker] = err
+			continue
+		}
+
+		uint64Price, err := GetUint64MedianFromReverseShiftedBigFloatValues(
+			[]*big.Float{price},
+			exponent,
+			resolver,
+		)
+		if err != nil {
+			unavailableTickers[ticker] = err
+			continue
+		}
+		tickerToPrice[ticker] = uint64Price
+	}
+	return tickerToPrice, unavailableTickers
+}
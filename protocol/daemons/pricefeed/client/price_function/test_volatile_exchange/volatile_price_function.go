@@ -49,7 +49,12 @@ func VolatileExchangePriceFunction(
 	response *http.Response,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Calculate the phase, how far in the period we are.
 	// The phase is a value that goes from 0 to 1 over the timespan of (1 day / frequency).
 	phase := math.Mod(
@@ -0,0 +1,16 @@
+package uniswap_v3
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants/exchange_common"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+)
+
+var (
+	UniswapV3Details = types.ExchangeQueryDetails{
+		Exchange:      exchange_common.EXCHANGE_ID_UNISWAP_V3,
+		IsMultiMarket: true,
+		OnChainDetails: &types.ExchangeOnChainDetails{
+			OnChainPriceFunction: UniswapV3PriceFunction,
+		},
+	}
+)
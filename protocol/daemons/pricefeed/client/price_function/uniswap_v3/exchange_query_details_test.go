@@ -0,0 +1,16 @@
+package uniswap_v3_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/uniswap_v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniswapV3IsMultiMarket(t *testing.T) {
+	require.True(t, uniswap_v3.UniswapV3Details.IsMultiMarket)
+}
+
+func TestUniswapV3IsOnChain(t *testing.T) {
+	require.NotNil(t, uniswap_v3.UniswapV3Details.OnChainDetails)
+}
@@ -0,0 +1,226 @@
+package uniswap_v3
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// TwapWindowSeconds is the period over which the time-weighted average price of a pool is computed.
+	TwapWindowSeconds uint32 = 30 * 60
+
+	// UniswapV3PoolABI is the ABI of the methods of a Uniswap v3 pool used to compute its TWAP.
+	UniswapV3PoolABI = `[
+		{
+			"inputs": [{"internalType": "uint32[]", "name": "secondsAgos", "type": "uint32[]"}],
+			"name": "observe",
+			"outputs": [
+				{"internalType": "int56[]", "name": "tickCumulatives", "type": "int56[]"},
+				{
+					"internalType": "uint160[]",
+					"name": "secondsPerLiquidityCumulativeX128s",
+					"type": "uint160[]"
+				}
+			],
+			"stateMutability": "view",
+			"type": "function"
+		},
+		{
+			"inputs": [],
+			"name": "token0",
+			"outputs": [{"internalType": "address", "name": "", "type": "address"}],
+			"stateMutability": "view",
+			"type": "function"
+		},
+		{
+			"inputs": [],
+			"name": "token1",
+			"outputs": [{"internalType": "address", "name": "", "type": "address"}],
+			"stateMutability": "view",
+			"type": "function"
+		}
+	]`
+
+	// ERC20ABI is the ABI of the methods of an ERC-20 token used to scale the prices of a pool.
+	ERC20ABI = `[
+		{
+			"inputs": [],
+			"name": "decimals",
+			"outputs": [{"internalType": "uint8", "name": "", "type": "uint8"}],
+			"stateMutability": "view",
+			"type": "function"
+		}
+	]`
+
+	// maxTick is the maximum absolute value of a tick of a pool.
+	maxTick = 887_272
+	// tickBase is the ratio between the prices of two consecutive ticks of a pool.
+	tickBase = "1.0001"
+	// tickPricePrecision is the precision in bits used to compute the price at a tick.
+	tickPricePrecision = 256
+)
+
+var (
+	// uniswapV3PoolAbi is the parsed UniswapV3PoolABI. It is initialized at most once.
+	uniswapV3PoolAbi = sync.OnceValue[*ethabi.ABI](
+		func() *ethabi.ABI {
+			poolAbi, err := ethabi.JSON(strings.NewReader(UniswapV3PoolABI))
+			if err != nil {
+				panic(err)
+			}
+			return &poolAbi
+		},
+	)
+
+	// erc20Abi is the parsed ERC20ABI. It is initialized at most once.
+	erc20Abi = sync.OnceValue[*ethabi.ABI](
+		func() *ethabi.ABI {
+			tokenAbi, err := ethabi.JSON(strings.NewReader(ERC20ABI))
+			if err != nil {
+				panic(err)
+			}
+			return &tokenAbi
+		},
+	)
+)
+
+// GetUniswapV3PoolAbi returns the ABI of the methods of a Uniswap v3 pool used to compute its TWAP.
+func GetUniswapV3PoolAbi() *ethabi.ABI {
+	return uniswapV3PoolAbi()
+}
+
+// GetErc20Abi returns the ABI of the methods of an ERC-20 token used to scale the prices of a pool.
+func GetErc20Abi() *ethabi.ABI {
+	return erc20Abi()
+}
+
+// UniswapV3PriceFunction reads the time-weighted average price over `TwapWindowSeconds` of each Uniswap v3 pool
+// in `tickerToExponent`, where each ticker is the address of a pool. The price of a pool is the price of its
+// token0 in units of its token1, scaled by the decimals of both tokens, and is shifted by the exponent of the
+// market. The TWAP window ends at the latest block, so the current time is not needed.
+func UniswapV3PriceFunction(
+	ctx context.Context,
+	caller bind.ContractCaller,
+	_ libtime.TimeProvider,
+	tickerToExponent map[string]int32,
+	resolver types.Resolver,
+) (
+	tickerToPrice map[string]uint64,
+	unavailableTickers map[string]error,
+	err error,
+) {
+	tickerToPrice, unavailableTickers = price_function.GetPricesFromContracts(
+		tickerToExponent,
+		resolver,
+		func(address ethcommon.Address) (*big.Float, error) {
+			return getPoolTwap(ctx, caller, address)
+		},
+	)
+	return tickerToPrice, unavailableTickers, nil
+}
+
+// getPoolTwap returns the time-weighted average price of the token0 of a pool in units of its token1.
+func getPoolTwap(
+	ctx context.Context,
+	caller bind.ContractCaller,
+	poolAddress ethcommon.Address,
+) (*big.Float, error) {
+	pool := bind.NewBoundContract(poolAddress, *GetUniswapV3PoolAbi(), caller, nil, nil)
+	opts := &bind.CallOpts{Context: ctx}
+
+	// Get the average tick of the pool over the TWAP window.
+	var observation []interface{}
+	if err := pool.Call(opts, &observation, "observe", []uint32{TwapWindowSeconds, 0}); err != nil {
+		return nil, fmt.Errorf("failed to observe pool %v: %w", poolAddress, err)
+	}
+	tickCumulatives := observation[0].([]*big.Int)
+	if len(tickCumulatives) != 2 {
+		return nil, fmt.Errorf(
+			"invalid observation of pool %v: expected 2 tick cumulatives, but got %v",
+			poolAddress,
+			len(tickCumulatives),
+		)
+	}
+	averageTick := getAverageTick(tickCumulatives[0], tickCumulatives[1], TwapWindowSeconds)
+	if averageTick.CmpAbs(big.NewInt(maxTick)) > 0 {
+		return nil, fmt.Errorf("invalid average tick of pool %v: %v", poolAddress, averageTick)
+	}
+
+	// Scale the price at the average tick by the decimals of both tokens.
+	token0Decimals, err := getPoolTokenDecimals(opts, caller, pool, "token0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token0 decimals of pool %v: %w", poolAddress, err)
+	}
+	token1Decimals, err := getPoolTokenDecimals(opts, caller, pool, "token1")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token1 decimals of pool %v: %w", poolAddress, err)
+	}
+
+	price := getPriceAtTick(averageTick.Int64())
+	price.Mul(price, new(big.Float).SetInt(lib.BigPow10(uint64(token0Decimals))))
+	price.Quo(price, new(big.Float).SetInt(lib.BigPow10(uint64(token1Decimals))))
+	return price, nil
+}
+
+// getPoolTokenDecimals returns the decimals of the token returned by the given method of a pool.
+func getPoolTokenDecimals(
+	opts *bind.CallOpts,
+	caller bind.ContractCaller,
+	pool *bind.BoundContract,
+	tokenMethod string,
+) (uint8, error) {
+	var tokenResult []interface{}
+	if err := pool.Call(opts, &tokenResult, tokenMethod); err != nil {
+		return 0, err
+	}
+	token := bind.NewBoundContract(tokenResult[0].(ethcommon.Address), *GetErc20Abi(), caller, nil, nil)
+
+	var decimalsResult []interface{}
+	if err := token.Call(opts, &decimalsResult, "decimals"); err != nil {
+		return 0, err
+	}
+	return decimalsResult[0].(uint8), nil
+}
+
+// getAverageTick returns the average tick between two tick cumulatives observed `windowSeconds` apart,
+// rounded towards negative infinity as done by the Uniswap v3 oracle library.
+func getAverageTick(olderTickCumulative *big.Int, newerTickCumulative *big.Int, windowSeconds uint32) *big.Int {
+	delta := new(big.Int).Sub(newerTickCumulative, olderTickCumulative)
+	// Euclidean division rounds towards negative infinity for a positive divisor.
+	return new(big.Int).Div(delta, big.NewInt(int64(windowSeconds)))
+}
+
+// getPriceAtTick returns 1.0001^tick, which is the price of token0 in units of token1 at a tick, without
+// accounting for the decimals of either token.
+func getPriceAtTick(tick int64) *big.Float {
+	base, _ := new(big.Float).SetPrec(tickPricePrecision).SetString(tickBase)
+	price := new(big.Float).SetPrec(tickPricePrecision).SetInt64(1)
+
+	// Compute base^|tick| by exponentiation by squaring.
+	exponent := tick
+	if exponent < 0 {
+		exponent = -exponent
+	}
+	for ; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			price.Mul(price, base)
+		}
+		base.Mul(base, base)
+	}
+
+	if tick < 0 {
+		return new(big.Float).SetPrec(tickPricePrecision).Quo(big.NewFloat(1), price)
+	}
+	return price
+}
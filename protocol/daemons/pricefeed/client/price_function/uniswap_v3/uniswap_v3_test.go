@@ -0,0 +1,199 @@
+package uniswap_v3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/testutil"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function/uniswap_v3"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// Test contract addresses.
+var (
+	wethUsdcPoolAddress      = ethcommon.HexToAddress("0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640")
+	usdcUsdtPoolAddress      = ethcommon.HexToAddress("0x3416cF6C708Da44DB2624D63ea0AAef7113527C6")
+	invalidTickPoolAddress   = ethcommon.HexToAddress("0x0000000000000000000000000000000000000A01")
+	missingTokenPoolAddress  = ethcommon.HexToAddress("0x0000000000000000000000000000000000000A02")
+	wethAddress              = ethcommon.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	usdcAddress              = ethcommon.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	usdtAddress              = ethcommon.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	missingTokenAddress      = ethcommon.HexToAddress("0x0000000000000000000000000000000000000B01")
+	missingContractAddress   = ethcommon.HexToAddress("0x0000000000000000000000000000000000000C01")
+	twapWindowSeconds        = int64(uniswap_v3.TwapWindowSeconds)
+	initialTickCumulative    = int64(1_000_000_000)
+	wethUsdcAverageTick      = int64(-200_311) // ~2000 USDC per WETH
+	secondsPerLiquidityX128s = []*big.Int{big.NewInt(1), big.NewInt(2)}
+)
+
+// newPoolContract returns a mock Uniswap v3 pool with the given tick cumulatives and tokens.
+func newPoolContract(
+	olderTickCumulative int64,
+	newerTickCumulative int64,
+	token0 ethcommon.Address,
+	token1 ethcommon.Address,
+) pricefeed.MockEthContract {
+	return pricefeed.MockEthContract{
+		Abi: uniswap_v3.GetUniswapV3PoolAbi(),
+		MethodToOutputs: map[string][]interface{}{
+			"observe": {
+				[]*big.Int{big.NewInt(olderTickCumulative), big.NewInt(newerTickCumulative)},
+				secondsPerLiquidityX128s,
+			},
+			"token0": {token0},
+			"token1": {token1},
+		},
+	}
+}
+
+// newTokenContract returns a mock ERC-20 token with the given decimals.
+func newTokenContract(decimals uint8) pricefeed.MockEthContract {
+	return pricefeed.MockEthContract{
+		Abi: uniswap_v3.GetErc20Abi(),
+		MethodToOutputs: map[string][]interface{}{
+			"decimals": {decimals},
+		},
+	}
+}
+
+func TestUniswapV3PriceFunction_Mixed(t *testing.T) {
+	backend := pricefeed.NewSimulatedEthBackend(
+		t,
+		map[ethcommon.Address]pricefeed.MockEthContract{
+			wethUsdcPoolAddress: newPoolContract(
+				initialTickCumulative,
+				initialTickCumulative+wethUsdcAverageTick*twapWindowSeconds,
+				wethAddress,
+				usdcAddress,
+			),
+			// The average tick of -1 / window is rounded down to -1.
+			usdcUsdtPoolAddress: newPoolContract(0, -1, usdcAddress, usdtAddress),
+			invalidTickPoolAddress: newPoolContract(
+				0,
+				887_273*twapWindowSeconds,
+				usdcAddress,
+				usdtAddress,
+			),
+			missingTokenPoolAddress: newPoolContract(0, 0, usdcAddress, missingTokenAddress),
+			wethAddress:             newTokenContract(18),
+			usdcAddress:             newTokenContract(6),
+			usdtAddress:             newTokenContract(6),
+		},
+	)
+
+	tests := map[string]struct {
+		// parameters
+		tickerToExponent    map[string]int32
+		medianFunctionFails bool
+
+		// expectations
+		expectedPriceMap       map[string]uint64
+		expectedUnavailableMap map[string]error
+	}{
+		"Success - multiple pools": {
+			tickerToExponent: map[string]int32{
+				wethUsdcPoolAddress.Hex(): -5,
+				usdcUsdtPoolAddress.Hex(): -6,
+			},
+			expectedPriceMap: map[string]uint64{
+				wethUsdcPoolAddress.Hex(): uint64(200_004_028),
+				usdcUsdtPoolAddress.Hex(): uint64(999_900),
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Success - positive exponent": {
+			tickerToExponent: map[string]int32{
+				wethUsdcPoolAddress.Hex(): 1,
+			},
+			expectedPriceMap: map[string]uint64{
+				wethUsdcPoolAddress.Hex(): uint64(200),
+			},
+			expectedUnavailableMap: map[string]error{},
+		},
+		"Unavailable - invalid address": {
+			tickerToExponent: map[string]int32{
+				"ETH-USD":                 -5,
+				wethUsdcPoolAddress.Hex(): -5,
+			},
+			expectedPriceMap: map[string]uint64{
+				wethUsdcPoolAddress.Hex(): uint64(200_004_028),
+			},
+			expectedUnavailableMap: map[string]error{
+				"ETH-USD": errors.New("invalid contract address: ETH-USD"),
+			},
+		},
+		"Unavailable - no pool contract": {
+			tickerToExponent: map[string]int32{
+				missingContractAddress.Hex(): -5,
+			},
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				missingContractAddress.Hex(): fmt.Errorf(
+					"failed to observe pool %v: no contract code at given address",
+					missingContractAddress,
+				),
+			},
+		},
+		"Unavailable - no token contract": {
+			tickerToExponent: map[string]int32{
+				missingTokenPoolAddress.Hex(): -5,
+			},
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				missingTokenPoolAddress.Hex(): fmt.Errorf(
+					"failed to get token1 decimals of pool %v: no contract code at given address",
+					missingTokenPoolAddress,
+				),
+			},
+		},
+		"Unavailable - invalid average tick": {
+			tickerToExponent: map[string]int32{
+				invalidTickPoolAddress.Hex(): -5,
+			},
+			expectedPriceMap: map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				invalidTickPoolAddress.Hex(): fmt.Errorf(
+					"invalid average tick of pool %v: 887273",
+					invalidTickPoolAddress,
+				),
+			},
+		},
+		"Unavailable - medianization error": {
+			tickerToExponent: map[string]int32{
+				wethUsdcPoolAddress.Hex(): -5,
+			},
+			medianFunctionFails: true,
+			expectedPriceMap:    map[string]uint64{},
+			expectedUnavailableMap: map[string]error{
+				wethUsdcPoolAddress.Hex(): testutil.MedianizationError,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resolver := lib.Median[uint64]
+			if tc.medianFunctionFails {
+				resolver = testutil.MedianErr
+			}
+
+			prices, unavailable, err := uniswap_v3.UniswapV3PriceFunction(
+				context.Background(),
+				backend,
+				&mocks.TimeProvider{},
+				tc.tickerToExponent,
+				resolver,
+			)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPriceMap, prices)
+			pricefeed.ErrorMapsEqual(t, tc.expectedUnavailableMap, unavailable)
+		})
+	}
+}
@@ -173,23 +173,39 @@ type Ticker interface {
 	GetLastPrice() string
 }
 
+// VolumeTicker encodes a ticker response that additionally reports the volume traded on the exchange over
+// the last 24 hours, in units of the base asset of the ticker. Tickers that implement this interface have
+// their volume reported alongside their price.
+type VolumeTicker interface {
+	Ticker
+	GetVolume() string
+}
+
 // GetMedianPricesFromTickers processes through a list of `tickers` and calculates a median price (from
 // `LastPrice`, `AskPrice`, and `BidPrice`) for each ticker in `tickerToExponent` and marks a ticker
 // as unavailable if it's not present in `tickers` or its ticker's validation or calculation fails.
+// If a ticker implements `VolumeTicker`, its 24h volume is also returned for every ticker with a price. A
+// volume that cannot be parsed is omitted, but does not make the ticker's price unavailable.
 func GetMedianPricesFromTickers[T Ticker](
 	tickers []T,
 	tickerToExponent map[string]int32,
 	resolver types.Resolver,
-) (tickerToPrice map[string]uint64, unavailableTickers map[string]error, err error) {
+) (
+	tickerToPrice map[string]uint64,
+	tickerToVolume map[string]types.Volume,
+	unavailableTickers map[string]error,
+	err error,
+) {
 	// Create API response validator, if not already.
 	if apiResponseValidator == nil {
 		apiResponseValidator, err = GetApiResponseValidator()
 		if err != nil {
-			return nil, nil, fmt.Errorf("Error creating API response validator (%w)", err)
+			return nil, nil, nil, fmt.Errorf("Error creating API response validator (%w)", err)
 		}
 	}
 
 	tickerToPrice = make(map[string]uint64, len(tickerToExponent))
+	tickerToVolume = make(map[string]types.Volume)
 	unavailableTickers = make(map[string]error)
 
 	// Iterate through every ticker in response and calculate median prices for requested
@@ -227,6 +243,13 @@ func GetMedianPricesFromTickers[T Ticker](
 			} else {
 				tickerToPrice[tickerPair] = medianPrice
 			}
+
+			// Report the volume of the ticker, if available.
+			if volumeTicker, ok := any(ticker).(VolumeTicker); ok {
+				if volume, ok := types.NewVolumeFromString(volumeTicker.GetVolume()); ok {
+					tickerToVolume[tickerPair] = volume
+				}
+			}
 		}
 	}
 
@@ -240,7 +263,7 @@ func GetMedianPricesFromTickers[T Ticker](
 		}
 	}
 
-	return tickerToPrice, unavailableTickers, nil
+	return tickerToPrice, tickerToVolume, unavailableTickers, nil
 }
 
 // ConvertFloat64ToString converts a `float64` to `string`.
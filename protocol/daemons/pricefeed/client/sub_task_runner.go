@@ -7,6 +7,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_encoder"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_fetcher"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
 	pricetypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	"net/http"
 	"time"
@@ -178,6 +179,24 @@ func (s *SubTaskRunnerImpl) StartPriceFetcher(
 		panic(err)
 	}
 
+	// Exchanges with stream details are streamed over a WebSocket connection, and only polled while the
+	// stream is disconnected or stale.
+	if exchangeDetails.StreamDetails != nil {
+		runStreamPriceFetcher(
+			ticker,
+			stop,
+			configs,
+			exchangeQueryConfig,
+			exchangeDetails,
+			exchangeMarketConfig,
+			marketConfigs,
+			queryHandler,
+			logger,
+			bCh,
+		)
+		return
+	}
+
 	// Create PriceFetcher to begin querying with.
 	priceFetcher, err := price_fetcher.NewPriceFetcher(
 		exchangeQueryConfig,
@@ -219,6 +238,62 @@ func (s *SubTaskRunnerImpl) StartPriceFetcher(
 	}
 }
 
+// runStreamPriceFetcher streams market prices from a specific exchange in a goroutine, and periodically
+// flushes the latest streamed prices to a buffered channel that's shared with the price encoder. While the
+// stream is disconnected or stale, market prices are polled from the exchange instead. The streaming
+// goroutine is stopped before the shared channel is closed.
+func runStreamPriceFetcher(
+	ticker *time.Ticker,
+	stop <-chan bool,
+	configs types.PricefeedMutableMarketConfigs,
+	exchangeQueryConfig types.ExchangeQueryConfig,
+	exchangeDetails types.ExchangeQueryDetails,
+	exchangeMarketConfig *types.MutableExchangeMarketConfig,
+	marketConfigs []*types.MutableMarketConfig,
+	queryHandler handler.ExchangeQueryHandler,
+	logger log.Logger,
+	bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
+) {
+	streamPriceFetcher, err := price_fetcher.NewStreamPriceFetcher(
+		exchangeQueryConfig,
+		exchangeDetails,
+		exchangeMarketConfig,
+		marketConfigs,
+		queryHandler,
+		&libtime.TimeProviderImpl{},
+		logger,
+		bCh,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	configs.AddPriceFetcher(streamPriceFetcher)
+
+	streamDone := make(chan struct{})
+	go func() {
+		defer close(streamDone)
+		streamPriceFetcher.RunStream(stop)
+	}()
+
+	requestHandler := daemontypes.NewRequestHandlerImpl(
+		&HttpClient,
+	)
+	for {
+		select {
+		case <-ticker.C:
+			streamPriceFetcher.RunTaskLoop(requestHandler)
+
+		case <-stop:
+			// Wait for the stream to stop writing to the shared channel, then signal to the encoder that the
+			// price fetcher is done.
+			<-streamDone
+			close(bCh)
+			return
+		}
+	}
+}
+
 // StartMarketParamUpdater periodically starts a goroutine to update the market parameters that control which
 // markets the daemon queries and how they are queried and computed from each exchange.
 func (s *SubTaskRunnerImpl) StartMarketParamUpdater(
@@ -436,6 +511,8 @@ func transformPriceUpdates(
 				ExchangeId:     exchangeId,
 				Price:          marketPriceTimestamp.Price,
 				LastUpdateTime: &priceUpdateTime,
+				Volume:         marketPriceTimestamp.Volume.Value,
+				VolumeExponent: marketPriceTimestamp.Volume.Exponent,
 			}
 			marketPriceUpdate.ExchangePrices = append(marketPriceUpdate.ExchangePrices, exchangePrice)
 		}
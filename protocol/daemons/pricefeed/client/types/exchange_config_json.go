@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 )
 
 // ExchangeConfigJson demarshals the exchange configuration json for a particular market.
@@ -14,6 +16,9 @@ import (
 // because the id is expected to be known at the time the object is in use.
 type ExchangeConfigJson struct {
 	Exchanges []ExchangeMarketConfigJson `json:"exchanges"`
+	// AggregationMode defines how exchange prices are resolved into the index price of the market.
+	// This value is optional and defaults to the median of all exchange prices.
+	AggregationMode types.AggregationMode `json:"aggregationMode,omitempty"`
 }
 
 // Validate validates the exchange configuration json, checking that required fields are defined
@@ -32,5 +37,9 @@ func (ecj *ExchangeConfigJson) Validate(
 			return fmt.Errorf("invalid exchange: %w", err)
 		}
 	}
+
+	if err := ecj.AggregationMode.Validate(); err != nil {
+		return fmt.Errorf("invalid aggregation mode: %w", err)
+	}
 	return nil
 }
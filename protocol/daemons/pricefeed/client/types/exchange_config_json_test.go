@@ -3,6 +3,7 @@ package types_test
 import (
 	"fmt"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -22,6 +23,17 @@ func TestExchangeConfigJsonValidate_Mixed(t *testing.T) {
 				},
 			},
 		},
+		"Valid - volume weighted median": {
+			exchangeConfigJson: types.ExchangeConfigJson{
+				Exchanges: []types.ExchangeMarketConfigJson{
+					{
+						ExchangeName: "binance",
+						Ticker:       "BTC-USDT",
+					},
+				},
+				AggregationMode: pricefeedtypes.AggregationModeVolumeWeightedMedian,
+			},
+		},
 		"Invalid - no exchanges": {
 			exchangeConfigJson: types.ExchangeConfigJson{},
 			expectedErr:        fmt.Errorf("exchanges cannot be empty"),
@@ -37,6 +49,18 @@ func TestExchangeConfigJsonValidate_Mixed(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("invalid exchange: exchange name 'not-a-real-exchange' is not valid"),
 		},
+		"Invalid - invalid aggregation mode": {
+			exchangeConfigJson: types.ExchangeConfigJson{
+				Exchanges: []types.ExchangeMarketConfigJson{
+					{
+						ExchangeName: "binance",
+						Ticker:       "BTC-USDT",
+					},
+				},
+				AggregationMode: "mean", // invalid
+			},
+			expectedErr: fmt.Errorf("invalid aggregation mode: aggregation mode 'mean' is not valid"),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ExchangeDefinitionsJson demarshals a file of declarative exchange definitions. Each definition describes
+// how to query an exchange's REST API and how to extract prices from its JSON response, so that exchanges
+// can be added or patched without writing a new price function.
+type ExchangeDefinitionsJson struct {
+	Exchanges []ExchangeDefinition `json:"exchanges"`
+}
+
+// Validate validates each exchange definition and checks that no exchange is defined more than once.
+func (edj *ExchangeDefinitionsJson) Validate() error {
+	seenExchangeIds := make(map[ExchangeId]struct{}, len(edj.Exchanges))
+	for _, definition := range edj.Exchanges {
+		if err := definition.Validate(); err != nil {
+			return fmt.Errorf("invalid exchange definition: %w", err)
+		}
+		if _, exists := seenExchangeIds[definition.ExchangeId]; exists {
+			return fmt.Errorf("exchange '%v' is defined more than once", definition.ExchangeId)
+		}
+		seenExchangeIds[definition.ExchangeId] = struct{}{}
+	}
+	return nil
+}
+
+// ExchangeDefinition declaratively defines how to query an exchange and resolve prices from its response.
+// Json paths are dot-separated lists of object keys and array indices, e.g. `data.0.last`. An empty path
+// refers to the root of the response.
+type ExchangeDefinition struct {
+	// ExchangeId is the name of the exchange, as referenced by the exchange config json of markets.
+	ExchangeId ExchangeId `json:"exchangeId"`
+	// Url is the url to query the exchange. Any "$" in the url is replaced with the queried tickers.
+	Url string `json:"url"`
+	// IsMultiMarket indicates whether the url query response contains multiple tickers.
+	IsMultiMarket bool `json:"isMultiMarket"`
+	// TickersPath is the path to the ticker information in the response. For multi-market exchanges, this
+	// is either an array of tickers or an object keyed by ticker. For single-market exchanges, this is the
+	// single ticker object.
+	TickersPath string `json:"tickersPath"`
+	// TickerPath is the path to the ticker name within each ticker object of a multi-market response. If the
+	// tickers are an object keyed by ticker, this must be empty.
+	TickerPath string `json:"tickerPath"`
+	// BidPricePath, AskPricePath and LastPricePath are the paths to each price within a ticker object.
+	BidPricePath  string `json:"bidPricePath"`
+	AskPricePath  string `json:"askPricePath"`
+	LastPricePath string `json:"lastPricePath"`
+	// VolumePath is the optional path to the 24h volume within a ticker object.
+	VolumePath string `json:"volumePath,omitempty"`
+	// Invert indicates that the exchange quotes prices inverted relative to its tickers, so that each price
+	// must be inverted before use.
+	Invert bool `json:"invert,omitempty"`
+	// IntervalMs, TimeoutMs and MaxQueries optionally override the default query configuration of the
+	// exchange. See `ExchangeQueryConfig`.
+	IntervalMs uint32 `json:"intervalMs,omitempty"`
+	TimeoutMs  uint32 `json:"timeoutMs,omitempty"`
+	MaxQueries uint32 `json:"maxQueries,omitempty"`
+}
+
+// Validate checks that the required fields of the exchange definition are defined and that the url is valid.
+func (ed *ExchangeDefinition) Validate() error {
+	if ed.ExchangeId == "" {
+		return fmt.Errorf("exchange id cannot be empty")
+	}
+
+	parsedUrl, err := url.Parse(ed.Url)
+	if err != nil {
+		return fmt.Errorf("url for exchange '%v' is not valid: %w", ed.ExchangeId, err)
+	}
+	if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https" {
+		return fmt.Errorf("url for exchange '%v' must use http or https", ed.ExchangeId)
+	}
+	if !ed.IsMultiMarket && !strings.Contains(ed.Url, "$") {
+		return fmt.Errorf("url for single-market exchange '%v' must contain a ticker placeholder '$'", ed.ExchangeId)
+	}
+
+	if ed.BidPricePath == "" || ed.AskPricePath == "" || ed.LastPricePath == "" {
+		return fmt.Errorf("bid, ask and last price paths for exchange '%v' cannot be empty", ed.ExchangeId)
+	}
+	return nil
+}
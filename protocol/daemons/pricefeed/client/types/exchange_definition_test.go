@@ -0,0 +1,139 @@
+package types_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	validMultiMarketDefinition = types.ExchangeDefinition{
+		ExchangeId:    "MultiMarketExchange",
+		Url:           "https://api.example.com/tickers",
+		IsMultiMarket: true,
+		TickersPath:   "data",
+		TickerPath:    "symbol",
+		BidPricePath:  "bid",
+		AskPricePath:  "ask",
+		LastPricePath: "last",
+	}
+	validSingleMarketDefinition = types.ExchangeDefinition{
+		ExchangeId:    "SingleMarketExchange",
+		Url:           "https://api.example.com/ticker?symbol=$",
+		BidPricePath:  "bid",
+		AskPricePath:  "ask",
+		LastPricePath: "last",
+	}
+)
+
+func TestExchangeDefinitionValidate_Mixed(t *testing.T) {
+	tests := map[string]struct {
+		modify      func(definition *types.ExchangeDefinition)
+		definition  types.ExchangeDefinition
+		expectedErr error
+	}{
+		"Valid - multi-market": {
+			definition: validMultiMarketDefinition,
+		},
+		"Valid - single-market": {
+			definition: validSingleMarketDefinition,
+		},
+		"Invalid - no exchange id": {
+			definition: validMultiMarketDefinition,
+			modify: func(definition *types.ExchangeDefinition) {
+				definition.ExchangeId = ""
+			},
+			expectedErr: fmt.Errorf("exchange id cannot be empty"),
+		},
+		"Invalid - unparseable url": {
+			definition: validMultiMarketDefinition,
+			modify: func(definition *types.ExchangeDefinition) {
+				definition.Url = "https://api.example.com/%zz"
+			},
+			expectedErr: errors.New(
+				"url for exchange 'MultiMarketExchange' is not valid: " +
+					"parse \"https://api.example.com/%zz\": invalid URL escape \"%zz\"",
+			),
+		},
+		"Invalid - url is not http": {
+			definition: validMultiMarketDefinition,
+			modify: func(definition *types.ExchangeDefinition) {
+				definition.Url = "wss://api.example.com/tickers"
+			},
+			expectedErr: fmt.Errorf("url for exchange 'MultiMarketExchange' must use http or https"),
+		},
+		"Invalid - single-market url has no ticker placeholder": {
+			definition: validSingleMarketDefinition,
+			modify: func(definition *types.ExchangeDefinition) {
+				definition.Url = "https://api.example.com/ticker"
+			},
+			expectedErr: fmt.Errorf(
+				"url for single-market exchange 'SingleMarketExchange' must contain a ticker placeholder '$'",
+			),
+		},
+		"Invalid - no last price path": {
+			definition: validMultiMarketDefinition,
+			modify: func(definition *types.ExchangeDefinition) {
+				definition.LastPricePath = ""
+			},
+			expectedErr: fmt.Errorf(
+				"bid, ask and last price paths for exchange 'MultiMarketExchange' cannot be empty",
+			),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			definition := tc.definition
+			if tc.modify != nil {
+				tc.modify(&definition)
+			}
+			err := definition.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestExchangeDefinitionsJsonValidate_Mixed(t *testing.T) {
+	tests := map[string]struct {
+		exchangeDefinitionsJson types.ExchangeDefinitionsJson
+		expectedErr             error
+	}{
+		"Valid - empty": {
+			exchangeDefinitionsJson: types.ExchangeDefinitionsJson{},
+		},
+		"Valid": {
+			exchangeDefinitionsJson: types.ExchangeDefinitionsJson{
+				Exchanges: []types.ExchangeDefinition{validMultiMarketDefinition, validSingleMarketDefinition},
+			},
+		},
+		"Invalid - invalid definition": {
+			exchangeDefinitionsJson: types.ExchangeDefinitionsJson{
+				Exchanges: []types.ExchangeDefinition{validMultiMarketDefinition, {}},
+			},
+			expectedErr: fmt.Errorf("invalid exchange definition: exchange id cannot be empty"),
+		},
+		"Invalid - duplicate exchange": {
+			exchangeDefinitionsJson: types.ExchangeDefinitionsJson{
+				Exchanges: []types.ExchangeDefinition{validMultiMarketDefinition, validMultiMarketDefinition},
+			},
+			expectedErr: fmt.Errorf("exchange 'MultiMarketExchange' is defined more than once"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.exchangeDefinitionsJson.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// ExchangeOnChainDetails represents the information needed to read prices from smart contracts, such as AMM
+// pools or oracle aggregators, through an Ethereum JSON-RPC endpoint. The ticker of each market on an on-chain
+// exchange is the address of the contract that prices the market.
+type ExchangeOnChainDetails struct {
+	// OnChainPriceFunction computes a map of tickers to prices by calling the contract at the address of
+	// each ticker. Tickers whose contracts cannot be read or report an invalid price are returned as
+	// unavailable. The time provider is used to check the freshness of prices reported by contracts.
+	OnChainPriceFunction func(
+		ctx context.Context,
+		caller bind.ContractCaller,
+		timeProvider libtime.TimeProvider,
+		tickerToPriceExponent map[string]int32,
+		resolver types.Resolver,
+	) (
+		tickerToPrice map[string]uint64,
+		unavailableTickers map[string]error,
+		err error,
+	)
+}
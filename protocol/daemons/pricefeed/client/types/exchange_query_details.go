@@ -11,16 +11,25 @@ type ExchangeQueryDetails struct {
 	Exchange ExchangeId
 	// Url is the url to query the exchange.
 	Url string
-	// PriceFunction computes a map of tickers to prices from an exchange's response
+	// PriceFunction computes a map of tickers to prices from an exchange's response, as well as a map of
+	// tickers to the 24h volume traded on the exchange for exchanges that report it.
 	PriceFunction func(
 		response *http.Response,
 		tickerToPriceExponent map[string]int32,
 		resolver types.Resolver,
 	) (
 		tickerToPrice map[string]uint64,
+		tickerToVolume map[string]types.Volume,
 		unavailableTickers map[string]error,
 		err error,
 	)
 	// IsMultiMarket indicates whether the url query response contains multiple tickers.
 	IsMultiMarket bool
+	// StreamDetails, if set, indicates that prices can be streamed from the exchange over a WebSocket connection.
+	// Streaming is only used if enabled by the daemon flags, and prices are still polled from `Url` while the
+	// stream is disconnected or stale.
+	StreamDetails *ExchangeStreamDetails
+	// OnChainDetails, if set, indicates that prices are read from smart contracts through an Ethereum JSON-RPC
+	// endpoint instead of being queried from `Url`.
+	OnChainDetails *ExchangeOnChainDetails
 }
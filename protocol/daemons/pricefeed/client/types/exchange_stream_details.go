@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+// ExchangeStreamDetails represents the information needed to stream prices from a specific exchange over a
+// WebSocket connection. Instead of being polled on a fixed interval, streaming exchanges push ticker or trade
+// updates to the daemon as they happen.
+type ExchangeStreamDetails struct {
+	// Url is the WebSocket url to connect to.
+	Url string
+	// SubscribeMessages returns the messages sent to the exchange after connecting in order to subscribe to
+	// updates for the given tickers.
+	SubscribeMessages func(tickers []string) (messages [][]byte, err error)
+	// StreamPriceFunction computes a map of tickers to prices, and tickers to 24h volumes if reported, from a
+	// single message pushed by the exchange. Messages that do not contain any prices, such as subscription
+	// acknowledgements, return empty maps.
+	StreamPriceFunction func(
+		message []byte,
+		tickerToPriceExponent map[string]int32,
+		resolver types.Resolver,
+	) (
+		tickerToPrice map[string]uint64,
+		tickerToVolume map[string]types.Volume,
+		unavailableTickers map[string]error,
+		err error,
+	)
+	// MaxPriceAge is the maximum age of the last price streamed for a market. Markets without an update
+	// within this duration are reported as stale. If no message at all is received within this duration,
+	// the connection is considered dead and is re-established.
+	MaxPriceAge time.Duration
+}
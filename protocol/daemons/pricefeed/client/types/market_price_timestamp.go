@@ -1,10 +1,16 @@
 package types
 
-import "time"
+import (
+	"time"
 
-// MarketPriceTimestamp maintains a `MarketId`, `Price` and `LastUpdatedAt`.
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+)
+
+// MarketPriceTimestamp maintains a `MarketId`, `Price`, `Volume` and `LastUpdatedAt`. `Volume` is the 24h volume
+// traded on the exchange in units of the market's base asset, or zero if the exchange does not report it.
 type MarketPriceTimestamp struct {
 	MarketId      uint32
 	Price         uint64
+	Volume        types.Volume
 	LastUpdatedAt time.Time
 }
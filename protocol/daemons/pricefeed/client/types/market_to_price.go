@@ -39,7 +39,11 @@ func (mtp *MarketToPrice) UpdatePrice(
 		priceTimestamp = types.NewPriceTimestamp()
 		mtp.MarketToPriceTimestamp[marketId] = priceTimestamp
 	}
-	isUpdated := priceTimestamp.UpdatePrice(marketPriceTimestamp.Price, &marketPriceTimestamp.LastUpdatedAt)
+	isUpdated := priceTimestamp.UpdatePrice(
+		marketPriceTimestamp.Price,
+		marketPriceTimestamp.Volume,
+		&marketPriceTimestamp.LastUpdatedAt,
+	)
 
 	validity := metrics.Valid
 	if !isUpdated {
@@ -61,6 +65,7 @@ func (mtp *MarketToPrice) GetAllPrices() []MarketPriceTimestamp {
 			MarketId:      marketId,
 			LastUpdatedAt: priceTimestamp.LastUpdateTime,
 			Price:         priceTimestamp.Price,
+			Volume:        priceTimestamp.Volume,
 		}
 		marketPricesForExchange = append(marketPricesForExchange, mpt)
 	}
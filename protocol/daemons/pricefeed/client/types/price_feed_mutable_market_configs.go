@@ -89,6 +89,10 @@ type PricefeedMutableMarketConfigsImpl struct {
 	// created at application start. Individual updaters manage their own synchronization.
 	mutableExchangeConfigUpdaters map[ExchangeId]UpdatersForExchange
 
+	// disabledExchangeIds contains the exchanges that are not queried by this daemon. They are dropped from
+	// the exchange config of every market. The set is fixed at creation.
+	disabledExchangeIds map[ExchangeId]struct{}
+
 	// updatersInitialized tracks whether all expected updaters have been added to the pricefeed mutable market configs.
 	// This is used to ensure that all updaters are subscribed before the pricefeed mutable market configs processes or
 	// emits updates. The pfmmc only emit updates to exchange config updaters when the config changes, so any missing
@@ -97,9 +101,11 @@ type PricefeedMutableMarketConfigsImpl struct {
 }
 
 // NewPriceFeedMutableMarketConfigs creates a new PricefeedMutableMarketConfigsImpl with no markets assigned
-// to any exchanges. Apply market settings by calling `UpdateMarkets`.
+// to any exchanges. Apply market settings by calling `UpdateMarkets`. Disabled exchanges are ignored wherever
+// they appear in the exchange config of a market.
 func NewPriceFeedMutableMarketConfigs(
 	canonicalExchangeIds []ExchangeId,
+	disabledExchangeIds []ExchangeId,
 ) *PricefeedMutableMarketConfigsImpl {
 	exchangeIdToMutableExchangeConfigUpdater := make(
 		map[ExchangeId]UpdatersForExchange,
@@ -115,10 +121,16 @@ func NewPriceFeedMutableMarketConfigs(
 		}
 	}
 
+	disabledExchangeIdSet := make(map[ExchangeId]struct{}, len(disabledExchangeIds))
+	for _, exchangeId := range disabledExchangeIds {
+		disabledExchangeIdSet[exchangeId] = struct{}{}
+	}
+
 	pfmmc := &PricefeedMutableMarketConfigsImpl{
 		mutableExchangeToConfigs:      mutableExchangeToConfigs,
 		mutableMarketToConfigs:        nil,
 		mutableExchangeConfigUpdaters: exchangeIdToMutableExchangeConfigUpdater,
+		disabledExchangeIds:           disabledExchangeIdSet,
 	}
 
 	// Add the expected number of registered updaters to the wait group.
@@ -245,6 +257,21 @@ func (pfmmc *PricefeedMutableMarketConfigsImpl) ValidateAndTransformParams(marke
 			continue
 		}
 
+		// Drop disabled exchanges from the config. Markets that are only priced by disabled exchanges are
+		// not priced by this daemon.
+		if len(pfmmc.disabledExchangeIds) > 0 {
+			enabledExchanges := make([]ExchangeMarketConfigJson, 0, len(exchangeConfigJson.Exchanges))
+			for _, exchangeConfig := range exchangeConfigJson.Exchanges {
+				if _, disabled := pfmmc.disabledExchangeIds[exchangeConfig.ExchangeName]; !disabled {
+					enabledExchanges = append(enabledExchanges, exchangeConfig)
+				}
+			}
+			if len(exchangeConfigJson.Exchanges) > 0 && len(enabledExchanges) == 0 {
+				continue
+			}
+			exchangeConfigJson.Exchanges = enabledExchanges
+		}
+
 		err = exchangeConfigJson.Validate(exchangeNames, marketNameToId)
 		if err != nil {
 			marketParamErrors[marketParam.Id] = fmt.Errorf(
@@ -65,6 +65,7 @@ func newTestPriceFeedMutableMarketConfigs() (
 ) {
 	pfmmc = types.NewPriceFeedMutableMarketConfigs(
 		[]types.ExchangeId{exchangeIdCoinbase, exchangeIdBinance},
+		nil,
 	)
 	for _, exchange := range []types.ExchangeId{exchangeIdCoinbase, exchangeIdBinance} {
 		encoder, fetcher = newMockUpdatersForExchange(exchange)
@@ -501,6 +502,71 @@ func TestValidateAndTransformParams_Mixed(t *testing.T) {
 	}
 }
 
+func TestValidateAndTransformParams_DisabledExchanges(t *testing.T) {
+	pfmmc := types.NewPriceFeedMutableMarketConfigs(
+		[]types.ExchangeId{exchangeIdCoinbase},
+		[]types.ExchangeId{exchangeIdBinance},
+	)
+
+	btcParam := validMarketParamWithExchangeConfig(
+		fmt.Sprintf(`{"exchanges":[%v,%v]}`, exchangeConfigCoinbaseBtcAdjustByEth, exchangeConfigBinanceBtc),
+	)
+	ethParam := validMarketParamWithExchangeConfig(fmt.Sprintf(`{"exchanges":[%v]}`, exchangeConfigBinanceEth))
+	ethParam.Id = 2
+	ethParam.Pair = "ETH-USD"
+	invalidParam := validMarketParamWithExchangeConfig(
+		fmt.Sprintf(`{"exchanges":[%v]}`, exchangeConfigInvalidExchangeName),
+	)
+	invalidParam.Id = 3
+	invalidParam.Pair = "SOL-USD"
+
+	mutableExchangeConfigs,
+		mutableMarketConfigs,
+		marketParamErrors,
+		err := pfmmc.ValidateAndTransformParams([]prices_types.MarketParam{btcParam, ethParam, invalidParam})
+	require.NoError(t, err)
+
+	// Disabled exchanges are dropped from the config of each market. Markets only priced by disabled
+	// exchanges are skipped without an error, while unknown exchanges are still invalid.
+	pricefeed.MarketParamErrorsEqual(
+		t,
+		map[types.MarketId]error{
+			3: errors.New(
+				"invalid exchange config json for market param 3: invalid exchange: exchange name 'invalid' " +
+					"is not valid",
+			),
+		},
+		marketParamErrors,
+	)
+	require.Equal(
+		t,
+		map[types.MarketId]*types.MutableMarketConfig{
+			1: {
+				Id:           1,
+				Exponent:     -2,
+				Pair:         "BTC-USD",
+				MinExchanges: 1,
+			},
+		},
+		mutableMarketConfigs,
+	)
+	require.Equal(
+		t,
+		map[types.ExchangeId]*types.MutableExchangeMarketConfig{
+			exchangeIdCoinbase: {
+				Id: exchangeIdCoinbase,
+				MarketToMarketConfig: map[types.MarketId]types.MarketConfig{
+					1: {
+						Ticker:         "BTC-USD",
+						AdjustByMarket: newUint32WithValue(2),
+					},
+				},
+			},
+		},
+		mutableExchangeConfigs,
+	)
+}
+
 // TestUpdatesEncoderAndFetcherInOrder tests that the price feed mutable market configs updates the encoder
 // before the fetcher. This test is confirmed to fail if update order is switched.
 func TestUpdatesEncoderAndFetcherInOrder(t *testing.T) {
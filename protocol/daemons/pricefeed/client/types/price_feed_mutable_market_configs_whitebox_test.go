@@ -148,7 +148,7 @@ func newMarketIdWithValue(id MarketId) *MarketId {
 }
 
 func TestAddExchangeConfigUpdater(t *testing.T) {
-	pfmmc := NewPriceFeedMutableMarketConfigs([]ExchangeId{exchangeIdCoinbase})
+	pfmmc := NewPriceFeedMutableMarketConfigs([]ExchangeId{exchangeIdCoinbase}, nil)
 
 	mockPriceFetcher := MockUpdater{ExchangeId: exchangeIdCoinbase}
 	pfmmc.AddPriceFetcher(&mockPriceFetcher)
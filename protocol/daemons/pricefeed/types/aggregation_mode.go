@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AggregationMode defines how the index price of a market is computed from the prices reported by
+// each exchange. Volume-weighted modes weight each exchange's ticker price by its reported 24h volume;
+// a trade-level VWAP computed from individual exchange trades is not supported.
+type AggregationMode string
+
+const (
+	// AggregationModeMedian resolves the index price as the median of all valid exchange prices. This is
+	// the default mode, used when no mode is configured for a market.
+	AggregationModeMedian AggregationMode = "median"
+	// AggregationModeVolumeWeightedMedian resolves the index price as the median of all valid exchange
+	// prices, with each price weighted by the 24h volume reported by the exchange.
+	AggregationModeVolumeWeightedMedian AggregationMode = "volumeWeightedMedian"
+	// AggregationModeVolumeWeightedMean resolves the index price as the mean of all valid exchange
+	// prices, with each price weighted by the 24h volume reported by the exchange.
+	AggregationModeVolumeWeightedMean AggregationMode = "volumeWeightedMean"
+)
+
+// IsVolumeWeighted returns true if the aggregation mode weights exchange prices by volume.
+func (m AggregationMode) IsVolumeWeighted() bool {
+	return m == AggregationModeVolumeWeightedMedian || m == AggregationModeVolumeWeightedMean
+}
+
+// Validate returns an error if the aggregation mode is not supported. An empty mode is valid and
+// is treated as `AggregationModeMedian`.
+func (m AggregationMode) Validate() error {
+	switch m {
+	case "", AggregationModeMedian, AggregationModeVolumeWeightedMedian, AggregationModeVolumeWeightedMean:
+		return nil
+	default:
+		return fmt.Errorf("aggregation mode '%v' is not valid", m)
+	}
+}
+
+// aggregationModeJson is used to demarshal the aggregation mode from a market's exchange config json.
+type aggregationModeJson struct {
+	AggregationMode AggregationMode `json:"aggregationMode"`
+}
+
+// GetAggregationModeFromExchangeConfigJson returns the aggregation mode configured in the exchange config
+// json of a market. If no mode is configured, `AggregationModeMedian` is returned.
+func GetAggregationModeFromExchangeConfigJson(exchangeConfigJson string) (AggregationMode, error) {
+	var config aggregationModeJson
+	if err := json.Unmarshal([]byte(exchangeConfigJson), &config); err != nil {
+		return "", err
+	}
+	if err := config.AggregationMode.Validate(); err != nil {
+		return "", err
+	}
+	if config.AggregationMode == "" {
+		return AggregationModeMedian, nil
+	}
+	return config.AggregationMode, nil
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregationMode_Validate(t *testing.T) {
+	tests := map[string]struct {
+		mode        types.AggregationMode
+		expectedErr string
+	}{
+		"Valid - empty": {
+			mode: "",
+		},
+		"Valid - median": {
+			mode: types.AggregationModeMedian,
+		},
+		"Valid - volume weighted median": {
+			mode: types.AggregationModeVolumeWeightedMedian,
+		},
+		"Valid - volume weighted mean": {
+			mode: types.AggregationModeVolumeWeightedMean,
+		},
+		"Invalid - unknown mode": {
+			mode:        "mean",
+			expectedErr: "aggregation mode 'mean' is not valid",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.mode.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAggregationMode_IsVolumeWeighted(t *testing.T) {
+	require.False(t, types.AggregationMode("").IsVolumeWeighted())
+	require.False(t, types.AggregationModeMedian.IsVolumeWeighted())
+	require.True(t, types.AggregationModeVolumeWeightedMedian.IsVolumeWeighted())
+	require.True(t, types.AggregationModeVolumeWeightedMean.IsVolumeWeighted())
+}
+
+func TestGetAggregationModeFromExchangeConfigJson(t *testing.T) {
+	tests := map[string]struct {
+		exchangeConfigJson string
+		expectedMode       types.AggregationMode
+		expectedErr        string
+	}{
+		"No mode defaults to median": {
+			exchangeConfigJson: `{"exchanges":[{"exchangeName":"Binance","ticker":"BTCUSDT"}]}`,
+			expectedMode:       types.AggregationModeMedian,
+		},
+		"Volume weighted median": {
+			exchangeConfigJson: `{"exchanges":[],"aggregationMode":"volumeWeightedMedian"}`,
+			expectedMode:       types.AggregationModeVolumeWeightedMedian,
+		},
+		"Volume weighted mean": {
+			exchangeConfigJson: `{"aggregationMode":"volumeWeightedMean"}`,
+			expectedMode:       types.AggregationModeVolumeWeightedMean,
+		},
+		"Invalid mode": {
+			exchangeConfigJson: `{"aggregationMode":"mean"}`,
+			expectedErr:        "aggregation mode 'mean' is not valid",
+		},
+		"Invalid json": {
+			exchangeConfigJson: `{`,
+			expectedErr:        "unexpected end of JSON input",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mode, err := types.GetAggregationModeFromExchangeConfigJson(tc.exchangeConfigJson)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedMode, mode)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
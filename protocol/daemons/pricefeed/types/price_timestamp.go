@@ -4,10 +4,11 @@ import (
 	"time"
 )
 
-// PriceTimestamp maintains a price and its last update timestamp.
+// PriceTimestamp maintains a price, the 24h volume reported alongside it and its last update timestamp.
 type PriceTimestamp struct {
 	LastUpdateTime time.Time
 	Price          uint64
+	Volume         Volume
 }
 
 // NewPriceTimestamp creates a new PriceTimestamp.
@@ -15,12 +16,13 @@ func NewPriceTimestamp() *PriceTimestamp {
 	return &PriceTimestamp{}
 }
 
-// UpdatePrice updates the price if the given update has a greater timestamp. Returns true if
+// UpdatePrice updates the price and volume if the given update has a greater timestamp. Returns true if
 // updating succeeds. Otherwise, returns false.
-func (pt *PriceTimestamp) UpdatePrice(price uint64, newUpdateTime *time.Time) bool {
+func (pt *PriceTimestamp) UpdatePrice(price uint64, volume Volume, newUpdateTime *time.Time) bool {
 	if newUpdateTime.After(pt.LastUpdateTime) {
 		pt.LastUpdateTime = *newUpdateTime
 		pt.Price = price
+		pt.Volume = volume
 
 		return true
 	}
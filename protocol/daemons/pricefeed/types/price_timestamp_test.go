@@ -20,7 +20,7 @@ func TestUpdatePrice_NoPreviousPriceSuccess(t *testing.T) {
 	pt := types.NewPriceTimestamp()
 
 	// No previous Price exists
-	ok := pt.UpdatePrice(constants.Price1, &constants.TimeT)
+	ok := pt.UpdatePrice(constants.Price1, types.Volume{}, &constants.TimeT)
 	require.True(t, ok)
 
 	require.Equal(t, constants.TimeT, pt.LastUpdateTime)
@@ -31,42 +31,44 @@ func TestUpdatePrice_GreaterUpdateTimeSuccess(t *testing.T) {
 	pt := types.NewPriceTimestamp()
 
 	// Last update @ timeT
-	ok := pt.UpdatePrice(constants.Price1, &constants.TimeT)
+	ok := pt.UpdatePrice(constants.Price1, constants.Volume1, &constants.TimeT)
 	require.True(t, ok)
 
 	// New update @ timeT + threshold
-	ok = pt.UpdatePrice(constants.Price2, &constants.TimeTPlusThreshold)
+	ok = pt.UpdatePrice(constants.Price2, constants.Volume2, &constants.TimeTPlusThreshold)
 	require.True(t, ok)
 
 	require.Equal(t, constants.TimeTPlusThreshold, pt.LastUpdateTime)
 	require.Equal(t, constants.Price2, pt.Price)
+	require.Equal(t, constants.Volume2, pt.Volume)
 }
 
 func TestUpdatePrice_EqualUpdateTimeFail(t *testing.T) {
 	pt := types.NewPriceTimestamp()
 
 	// Last update @ timeT
-	ok := pt.UpdatePrice(constants.Price1, &constants.TimeT)
+	ok := pt.UpdatePrice(constants.Price1, constants.Volume1, &constants.TimeT)
 	require.True(t, ok)
 
 	// New update @ timeT
-	ok = pt.UpdatePrice(constants.Price2, &constants.TimeT)
+	ok = pt.UpdatePrice(constants.Price2, constants.Volume2, &constants.TimeT)
 	require.False(t, ok)
 
 	// No update should be made because the new update time is not greater.
 	require.Equal(t, constants.TimeT, pt.LastUpdateTime)
 	require.Equal(t, constants.Price1, pt.Price)
+	require.Equal(t, constants.Volume1, pt.Volume)
 }
 
 func TestUpdatePrice_SmallerUpdateTimeFail(t *testing.T) {
 	pt := types.NewPriceTimestamp()
 
 	// Last update @ timeT
-	ok := pt.UpdatePrice(constants.Price1, &constants.TimeT)
+	ok := pt.UpdatePrice(constants.Price1, types.Volume{}, &constants.TimeT)
 	require.True(t, ok)
 
 	// New update @ timeT - threshold
-	ok = pt.UpdatePrice(constants.Price2, &constants.TimeTMinusThreshold)
+	ok = pt.UpdatePrice(constants.Price2, types.Volume{}, &constants.TimeTMinusThreshold)
 	require.False(t, ok)
 
 	// No update should be made because the new update time is not greater.
@@ -78,7 +80,7 @@ func TestGetValidPrice_ValidLastUpdateTimeSuccess(t *testing.T) {
 	pt := types.NewPriceTimestamp()
 
 	// Last update @ timeT
-	ok := pt.UpdatePrice(constants.Price1, &constants.TimeT)
+	ok := pt.UpdatePrice(constants.Price1, types.Volume{}, &constants.TimeT)
 	require.True(t, ok)
 
 	r, ok := pt.GetValidPrice(constants.TimeT)
@@ -90,7 +92,7 @@ func TestGetValidPrice_InvalidLastUpdateTimeFail(t *testing.T) {
 	pt := types.NewPriceTimestamp()
 
 	// Last update @ timeT
-	ok := pt.UpdatePrice(constants.Price1, &constants.TimeT)
+	ok := pt.UpdatePrice(constants.Price1, types.Volume{}, &constants.TimeT)
 	require.True(t, ok)
 
 	// Updates @ timeT are no longer valid at this cutoff time.
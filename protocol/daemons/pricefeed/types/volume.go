@@ -0,0 +1,69 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// MinVolumeExponent is the exponent of the smallest unit of volume that is reported. Volumes are reported
+// in units of 10^MinVolumeExponent of the base asset, unless they are too large to be represented in a
+// `uint64` with that precision.
+const MinVolumeExponent int32 = -8
+
+// Volume is a fixed-point volume of `Value * 10^Exponent` units of the base asset of a market.
+type Volume struct {
+	Value    uint64
+	Exponent int32
+}
+
+// NewVolumeFromString converts a decimal volume reported by an exchange into a fixed-point `Volume`. The
+// volume is represented with an exponent of `MinVolumeExponent`, or with the smallest larger exponent for
+// which its value fits into a `uint64`. Digits beyond the precision of the exponent are truncated.
+// Returns false if the volume is not a non-negative decimal number.
+func NewVolumeFromString(volume string) (Volume, bool) {
+	bigRatVolume, ok := new(big.Rat).SetString(volume)
+	if !ok || bigRatVolume.Sign() < 0 {
+		return Volume{}, false
+	}
+
+	exponent := MinVolumeExponent
+	value := new(big.Int).Quo(
+		new(big.Int).Mul(bigRatVolume.Num(), lib.BigPow10(uint64(-exponent))),
+		bigRatVolume.Denom(),
+	)
+	for !value.IsUint64() {
+		exponent++
+		value.Quo(value, big.NewInt(10))
+	}
+	return Volume{Value: value.Uint64(), Exponent: exponent}, true
+}
+
+// IsZero returns true if the volume is zero.
+func (v Volume) IsZero() bool {
+	return v.Value == 0
+}
+
+// GetVolumeWeights converts `volumes` into integer weights that are proportional to each volume. All non-zero
+// volumes are scaled to the smallest exponent among them, and zero volumes have a weight of zero.
+func GetVolumeWeights(volumes []Volume) []*big.Int {
+	minExponent := int32(0)
+	for _, volume := range volumes {
+		if !volume.IsZero() && volume.Exponent < minExponent {
+			minExponent = volume.Exponent
+		}
+	}
+
+	weights := make([]*big.Int, len(volumes))
+	for i, volume := range volumes {
+		if volume.IsZero() {
+			weights[i] = new(big.Int)
+			continue
+		}
+		weights[i] = new(big.Int).Mul(
+			new(big.Int).SetUint64(volume.Value),
+			lib.BigPow10(uint64(volume.Exponent-minExponent)),
+		)
+	}
+	return weights
+}
@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVolumeFromString(t *testing.T) {
+	tests := map[string]struct {
+		// parameters
+		volume string
+
+		// expectations
+		expectedVolume types.Volume
+		expectedOk     bool
+	}{
+		"Success with a whole number": {
+			volume:         "62285",
+			expectedVolume: types.Volume{Value: 6_228_500_000_000, Exponent: -8},
+			expectedOk:     true,
+		},
+		"Success with fractional units": {
+			volume:         "62285.78422000",
+			expectedVolume: types.Volume{Value: 6_228_578_422_000, Exponent: -8},
+			expectedOk:     true,
+		},
+		"Success with less than one unit": {
+			volume:         "0.5",
+			expectedVolume: types.Volume{Value: 50_000_000, Exponent: -8},
+			expectedOk:     true,
+		},
+		"Success with digits beyond the minimum exponent truncated": {
+			volume:         "0.123456789",
+			expectedVolume: types.Volume{Value: 12_345_678, Exponent: -8},
+			expectedOk:     true,
+		},
+		"Success with zero": {
+			volume:         "0",
+			expectedVolume: types.Volume{Value: 0, Exponent: -8},
+			expectedOk:     true,
+		},
+		"Success with a volume too large for the minimum exponent": {
+			volume:         "18446744073709551616",
+			expectedVolume: types.Volume{Value: 1_844_674_407_370_955_161, Exponent: 1},
+			expectedOk:     true,
+		},
+		"Failure with an empty string": {
+			volume: "",
+		},
+		"Failure with an invalid number": {
+			volume: "abc",
+		},
+		"Failure with a negative number": {
+			volume: "-1",
+		},
+		"Failure with infinity": {
+			volume: "Inf",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			volume, ok := types.NewVolumeFromString(tc.volume)
+
+			require.Equal(t, tc.expectedOk, ok)
+			require.Equal(t, tc.expectedVolume, volume)
+		})
+	}
+}
+
+func TestGetVolumeWeights(t *testing.T) {
+	tests := map[string]struct {
+		volumes         []types.Volume
+		expectedWeights []*big.Int
+	}{
+		"Empty": {
+			volumes:         []types.Volume{},
+			expectedWeights: []*big.Int{},
+		},
+		"Same exponent": {
+			volumes: []types.Volume{
+				{Value: 1, Exponent: -8},
+				{Value: 3, Exponent: -8},
+			},
+			expectedWeights: []*big.Int{big.NewInt(1), big.NewInt(3)},
+		},
+		"Scaled to the smallest exponent": {
+			volumes: []types.Volume{
+				{Value: 1, Exponent: -8},
+				{Value: 3, Exponent: -6},
+				{Value: 5, Exponent: 2},
+			},
+			expectedWeights: []*big.Int{
+				big.NewInt(1),
+				big.NewInt(300),
+				big.NewInt(50_000_000_000),
+			},
+		},
+		"Zero volumes have zero weight": {
+			volumes: []types.Volume{
+				{},
+				{Value: 0, Exponent: -10},
+				{Value: 2, Exponent: 1},
+			},
+			expectedWeights: []*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(20)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedWeights, types.GetVolumeWeights(tc.volumes))
+		})
+	}
+}
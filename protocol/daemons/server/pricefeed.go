@@ -77,6 +77,23 @@ func (s *Server) UpdateMarketPrices(
 	return &api.UpdateMarketPricesResponse{}, nil
 }
 
+// ExchangeHealthScores returns the health scores of each exchange for the requested markets.
+func (s *Server) ExchangeHealthScores(
+	ctx context.Context,
+	req *api.ExchangeHealthScoresRequest,
+) (*api.ExchangeHealthScoresResponse, error) {
+	if s.marketToExchange == nil {
+		return nil, errorsmod.Wrapf(
+			types.ErrServerNotInitializedCorrectly,
+			"MarketToExchange not initialized",
+		)
+	}
+
+	return &api.ExchangeHealthScoresResponse{
+		ExchangeHealthScores: s.marketToExchange.GetExchangeHealthScores(req.MarketIds),
+	}, nil
+}
+
 // validateMarketPricesUpdatesMessage validates a `UpdateMarketPricesRequest`.
 func validateMarketPricesUpdatesMessage(req *api.UpdateMarketPricesRequest) error {
 	if len(req.MarketPriceUpdates) == 0 {
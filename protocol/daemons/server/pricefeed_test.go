@@ -167,6 +167,67 @@ func TestUpdateMarketPrices_InvalidExchangePrices(t *testing.T) {
 	}
 }
 
+func TestExchangeHealthScores(t *testing.T) {
+	mockGrpcServer := &mocks.GrpcServer{}
+	mockFileHandler := &mocks.FileHandler{}
+
+	marketToExchange := pricefeedserver_types.NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+	marketToExchange.UpdatePrices(constants.AtTimeTPriceUpdate)
+	s := createServerWithMocks(
+		t,
+		mockGrpcServer,
+		mockFileHandler,
+	).WithPriceFeedMarketToExchangePrices(marketToExchange)
+
+	response, err := s.ExchangeHealthScores(
+		context.TODO(),
+		&api.ExchangeHealthScoresRequest{MarketIds: []uint32{constants.MarketId9}},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&api.ExchangeHealthScoresResponse{
+			ExchangeHealthScores: []api.ExchangeHealthScore{
+				{
+					MarketId:       constants.MarketId9,
+					ExchangeId:     constants.ExchangeId1,
+					ScorePpm:       1_000_000,
+					LastUpdateTime: &constants.TimeT,
+				},
+				{
+					MarketId:       constants.MarketId9,
+					ExchangeId:     constants.ExchangeId2,
+					ScorePpm:       1_000_000,
+					LastUpdateTime: &constants.TimeT,
+				},
+			},
+		},
+		response,
+	)
+}
+
+func TestExchangeHealthScores_NotInitialized(t *testing.T) {
+	mockGrpcServer := &mocks.GrpcServer{}
+	mockFileHandler := &mocks.FileHandler{}
+
+	// Create a new server without initializing `MarketToExchange` field.
+	s := createServerWithMocks(
+		t,
+		mockGrpcServer,
+		mockFileHandler,
+	)
+
+	_, err := s.ExchangeHealthScores(context.TODO(), &api.ExchangeHealthScoresRequest{})
+	require.EqualError(
+		t,
+		err,
+		errorsmod.Wrapf(
+			types.ErrServerNotInitializedCorrectly,
+			"MarketToExchange not initialized",
+		).Error(),
+	)
+}
+
 func sendAndCheckPriceUpdate(
 	t *testing.T,
 	s *server.Server,
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+const (
+	// healthSmoothingPeriod is the number of price reads over which exchange health metrics are averaged.
+	// Each new read contributes 1/healthSmoothingPeriod of its value to the moving averages.
+	healthSmoothingPeriod = 20
+)
+
+// ExchangeHealth tracks the health of a single exchange's prices for a single market. Health is measured
+// each time the prices of the market are read, and all metrics are exponential moving averages over
+// recent reads, in parts-per-million. A new exchange starts out perfectly healthy.
+type ExchangeHealth struct {
+	// DeviationPpm is the average deviation of the exchange's price from the median price of all exchanges.
+	DeviationPpm uint32
+	// StaleRatePpm is the rate at which the exchange's price was too stale to be used.
+	StaleRatePpm uint32
+	// ErrorRatePpm is the rate at which the exchange's price was rejected as an outlier.
+	ErrorRatePpm uint32
+}
+
+// NewExchangeHealth creates a new, perfectly healthy ExchangeHealth.
+func NewExchangeHealth() *ExchangeHealth {
+	return &ExchangeHealth{}
+}
+
+// RecordStalePrice records a read where the exchange's price was too stale to be used.
+func (eh *ExchangeHealth) RecordStalePrice() {
+	eh.StaleRatePpm = movingAveragePpm(eh.StaleRatePpm, lib.OneMillion)
+}
+
+// RecordValidPrice records a read where the exchange's price was fresh, along with its deviation from the
+// median price of all exchanges and whether it was rejected as an outlier.
+func (eh *ExchangeHealth) RecordValidPrice(deviationPpm uint32, isOutlier bool) {
+	eh.StaleRatePpm = movingAveragePpm(eh.StaleRatePpm, 0)
+	eh.DeviationPpm = movingAveragePpm(eh.DeviationPpm, deviationPpm)
+	if isOutlier {
+		eh.ErrorRatePpm = movingAveragePpm(eh.ErrorRatePpm, lib.OneMillion)
+	} else {
+		eh.ErrorRatePpm = movingAveragePpm(eh.ErrorRatePpm, 0)
+	}
+}
+
+// GetScorePpm returns the overall health score of the exchange, where 1_000_000 is perfectly healthy and
+// 0 is completely unhealthy. The score is the product of the complements of each health metric, so that
+// an exchange that is always stale, always an outlier, or always 100% away from the median scores 0.
+func (eh *ExchangeHealth) GetScorePpm() uint32 {
+	score := uint64(lib.OneMillion)
+	for _, metricPpm := range []uint32{eh.DeviationPpm, eh.StaleRatePpm, eh.ErrorRatePpm} {
+		score = score * uint64(lib.OneMillion-lib.Min(metricPpm, lib.OneMillion)) / uint64(lib.OneMillion)
+	}
+	return uint32(score)
+}
+
+// movingAveragePpm returns the exponential moving average after including the given sample.
+func movingAveragePpm(average uint32, sample uint32) uint32 {
+	delta := (int64(sample) - int64(average)) / healthSmoothingPeriod
+	// Always move at least 1 ppm towards the sample so that the average can fully converge.
+	if delta == 0 && sample != average {
+		if sample > average {
+			delta = 1
+		} else {
+			delta = -1
+		}
+	}
+	return uint32(int64(average) + delta)
+}
+
+// getDeviationPpm returns the absolute deviation of the price from the median in parts-per-million, capped at
+// 1_000_000.
+func getDeviationPpm(price uint64, median uint64) uint32 {
+	if median == 0 {
+		return lib.OneMillion
+	}
+	diff := new(big.Int).SetUint64(price)
+	diff.Sub(diff, new(big.Int).SetUint64(median))
+	diff.Abs(diff)
+	diff.Mul(diff, lib.BigIntOneMillion())
+	diff.Quo(diff, new(big.Int).SetUint64(median))
+	if diff.Cmp(lib.BigIntOneMillion()) > 0 {
+		return lib.OneMillion
+	}
+	return uint32(diff.Uint64())
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExchangeHealth_IsHealthy(t *testing.T) {
+	eh := NewExchangeHealth()
+
+	require.Equal(t, uint32(0), eh.DeviationPpm)
+	require.Equal(t, uint32(0), eh.StaleRatePpm)
+	require.Equal(t, uint32(0), eh.ErrorRatePpm)
+	require.Equal(t, uint32(1_000_000), eh.GetScorePpm())
+}
+
+func TestExchangeHealth_RecordStalePrice(t *testing.T) {
+	eh := NewExchangeHealth()
+
+	eh.RecordStalePrice()
+	require.Equal(t, uint32(50_000), eh.StaleRatePpm)
+	require.Equal(t, uint32(0), eh.DeviationPpm)
+	require.Equal(t, uint32(0), eh.ErrorRatePpm)
+	require.Equal(t, uint32(950_000), eh.GetScorePpm())
+
+	// A fresh price reduces the stale rate.
+	eh.RecordValidPrice(0, false)
+	require.Equal(t, uint32(47_500), eh.StaleRatePpm)
+}
+
+func TestExchangeHealth_RecordValidPrice(t *testing.T) {
+	eh := NewExchangeHealth()
+
+	eh.RecordValidPrice(200_000, true)
+	require.Equal(t, uint32(0), eh.StaleRatePpm)
+	require.Equal(t, uint32(10_000), eh.DeviationPpm)
+	require.Equal(t, uint32(50_000), eh.ErrorRatePpm)
+	// 1_000_000 * (1 - 0.01) * (1 - 0.05)
+	require.Equal(t, uint32(940_500), eh.GetScorePpm())
+
+	eh.RecordValidPrice(0, false)
+	require.Equal(t, uint32(9_500), eh.DeviationPpm)
+	require.Equal(t, uint32(47_500), eh.ErrorRatePpm)
+}
+
+func TestExchangeHealth_Converges(t *testing.T) {
+	eh := NewExchangeHealth()
+
+	for i := 0; i < 1_000; i++ {
+		eh.RecordStalePrice()
+	}
+	require.Equal(t, uint32(1_000_000), eh.StaleRatePpm)
+	require.Equal(t, uint32(0), eh.GetScorePpm())
+
+	for i := 0; i < 1_000; i++ {
+		eh.RecordValidPrice(0, false)
+	}
+	require.Equal(t, uint32(0), eh.StaleRatePpm)
+	require.Equal(t, uint32(1_000_000), eh.GetScorePpm())
+}
+
+func TestGetDeviationPpm(t *testing.T) {
+	tests := map[string]struct {
+		price    uint64
+		median   uint64
+		expected uint32
+	}{
+		"Equal to median": {
+			price:    1_000,
+			median:   1_000,
+			expected: 0,
+		},
+		"Above median": {
+			price:    1_050,
+			median:   1_000,
+			expected: 50_000,
+		},
+		"Below median": {
+			price:    950,
+			median:   1_000,
+			expected: 50_000,
+		},
+		"Deviation is capped": {
+			price:    3_000,
+			median:   1_000,
+			expected: 1_000_000,
+		},
+		"Zero median": {
+			price:    1_000,
+			median:   0,
+			expected: 1_000_000,
+		},
+		"Large prices do not overflow": {
+			price:    math.MaxUint64,
+			median:   math.MaxUint64 - 1_000_000_000,
+			expected: 0,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, getDeviationPpm(tc.price, tc.median))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"sort"
 	"time"
 
 	gometrics "github.com/armon/go-metrics"
@@ -8,14 +9,22 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
 
+const (
+	// minPricesForOutlierDetection is the minimum number of valid prices of a market required to exclude
+	// outliers. With fewer prices, every price deviates equally from the median.
+	minPricesForOutlierDetection = 3
+)
+
 // ExchangeToPrice maintains multiple prices from different exchanges for
 // the same market, along with the last time the each exchange price was updated.
 type ExchangeToPrice struct {
 	marketId                 uint32
 	exchangeToPriceTimestamp map[string]*types.PriceTimestamp
+	exchangeToHealth         map[string]*ExchangeHealth
 }
 
 // NewExchangeToPrice creates a new ExchangeToPrice. It takes a market ID, which is used in logging and metrics to
@@ -25,6 +34,7 @@ func NewExchangeToPrice(marketId uint32) *ExchangeToPrice {
 	return &ExchangeToPrice{
 		marketId:                 marketId,
 		exchangeToPriceTimestamp: make(map[string]*types.PriceTimestamp),
+		exchangeToHealth:         make(map[string]*ExchangeHealth),
 	}
 }
 
@@ -40,7 +50,14 @@ func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 			etp.exchangeToPriceTimestamp[exchangeId] = priceTimestamp
 		}
 
-		isUpdated := priceTimestamp.UpdatePrice(exchangePrice.Price, exchangePrice.LastUpdateTime)
+		isUpdated := priceTimestamp.UpdatePrice(
+			exchangePrice.Price,
+			types.Volume{
+				Value:    exchangePrice.Volume,
+				Exponent: exchangePrice.VolumeExponent,
+			},
+			exchangePrice.LastUpdateTime,
+		)
 
 		validity := metrics.Valid
 		if exists && !isUpdated {
@@ -59,18 +76,128 @@ func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 	}
 }
 
-// GetValidPrices returns a list of "valid" prices. Prices are considered
-// "valid" iff the last update time is greater than or equal to the given cutoff time.
+// GetValidPrices returns a list of "valid" prices, along with the 24h volume reported for each price.
+// Prices are considered "valid" iff the last update time is greater than or equal to the given cutoff time.
+// The returned volume at each index corresponds to the price at the same index, and is zero if the exchange
+// did not report a volume.
 func (etp *ExchangeToPrice) GetValidPrices(
 	cutoffTime time.Time,
-) []uint64 {
+) (prices []uint64, volumes []types.Volume) {
+	_, prices, volumes = etp.getValidExchangePrices(cutoffTime)
+	return prices, volumes
+}
+
+// GetValidPricesExcludingOutliers returns the "valid" prices and volumes as in `GetValidPrices`, excluding
+// any price that deviates from the median of all valid prices by more than `outlierBandPpm`. An
+// `outlierBandPpm` of 0 disables outlier detection. Outliers are only excluded if there are at least
+// `minPricesForOutlierDetection` valid prices, since fewer prices cannot form a consensus on which of them
+// is the outlier. The health of each exchange is updated with the result.
+func (etp *ExchangeToPrice) GetValidPricesExcludingOutliers(
+	cutoffTime time.Time,
+	outlierBandPpm uint32,
+) (prices []uint64, volumes []types.Volume) {
+	validExchangeIds, validPrices, validVolumes := etp.getValidExchangePrices(cutoffTime)
+
+	// Exchanges without a valid price are stale.
+	isValidExchange := make(map[string]bool, len(validExchangeIds))
+	for _, exchangeId := range validExchangeIds {
+		isValidExchange[exchangeId] = true
+	}
+	for exchangeId := range etp.exchangeToPriceTimestamp {
+		if !isValidExchange[exchangeId] {
+			etp.getExchangeHealth(exchangeId).RecordStalePrice()
+		}
+	}
+
+	if len(validPrices) == 0 {
+		return validPrices, validVolumes
+	}
+	median, err := lib.Median(validPrices)
+	if err != nil {
+		return validPrices, validVolumes
+	}
+
+	isOutlierDetectionEnabled := outlierBandPpm > 0 && len(validPrices) >= minPricesForOutlierDetection
+	prices = make([]uint64, 0, len(validPrices))
+	volumes = make([]types.Volume, 0, len(validVolumes))
+	for i, exchangeId := range validExchangeIds {
+		deviationPpm := getDeviationPpm(validPrices[i], median)
+		isOutlier := isOutlierDetectionEnabled && deviationPpm > outlierBandPpm
+
+		health := etp.getExchangeHealth(exchangeId)
+		health.RecordValidPrice(deviationPpm, isOutlier)
+
+		labels := []gometrics.Label{
+			pricefeedmetrics.GetLabelForMarketId(etp.marketId),
+			pricefeedmetrics.GetLabelForExchangeId(exchangeId),
+		}
+		telemetry.SetGaugeWithLabels(
+			[]string{metrics.PricefeedServer, metrics.ExchangeHealthScore},
+			float32(health.GetScorePpm()),
+			labels,
+		)
+
+		if isOutlier {
+			// Measure count of prices excluded as outliers.
+			telemetry.IncrCounterWithLabels(
+				[]string{metrics.PricefeedServer, metrics.PriceOutlier, metrics.Count},
+				1,
+				labels,
+			)
+			continue
+		}
+		prices = append(prices, validPrices[i])
+		volumes = append(volumes, validVolumes[i])
+	}
+	return prices, volumes
+}
+
+// GetExchangeHealthScores returns the health score of each exchange, sorted by exchange id.
+func (etp *ExchangeToPrice) GetExchangeHealthScores() []api.ExchangeHealthScore {
+	exchangeIds := lib.GetSortedKeys[sort.StringSlice](etp.exchangeToPriceTimestamp)
+	scores := make([]api.ExchangeHealthScore, 0, len(exchangeIds))
+	for _, exchangeId := range exchangeIds {
+		health := etp.getExchangeHealth(exchangeId)
+		lastUpdateTime := etp.exchangeToPriceTimestamp[exchangeId].LastUpdateTime
+		scores = append(scores, api.ExchangeHealthScore{
+			MarketId:       etp.marketId,
+			ExchangeId:     exchangeId,
+			ScorePpm:       health.GetScorePpm(),
+			DeviationPpm:   health.DeviationPpm,
+			StaleRatePpm:   health.StaleRatePpm,
+			ErrorRatePpm:   health.ErrorRatePpm,
+			LastUpdateTime: &lastUpdateTime,
+		})
+	}
+	return scores
+}
+
+// getExchangeHealth returns the health of the exchange, creating it if it does not exist.
+func (etp *ExchangeToPrice) getExchangeHealth(exchangeId string) *ExchangeHealth {
+	health, exists := etp.exchangeToHealth[exchangeId]
+	if !exists {
+		health = NewExchangeHealth()
+		etp.exchangeToHealth[exchangeId] = health
+	}
+	return health
+}
+
+// getValidExchangePrices returns the exchange ids, prices and volumes of all "valid" prices. Prices are
+// considered "valid" iff the last update time is greater than or equal to the given cutoff time.
+func (etp *ExchangeToPrice) getValidExchangePrices(
+	cutoffTime time.Time,
+) (exchangeIds []string, prices []uint64, volumes []types.Volume) {
+	validExchangeIdsForMarket := make([]string, 0, len(etp.exchangeToPriceTimestamp))
 	validExchangePricesForMarket := make([]uint64, 0, len(etp.exchangeToPriceTimestamp))
+	validExchangeVolumesForMarket := make([]types.Volume, 0, len(etp.exchangeToPriceTimestamp))
 	for exchangeId, priceTimestamp := range etp.exchangeToPriceTimestamp {
 		validity := metrics.Valid
 
 		// PriceTimestamp returns price if the last update time is valid.
 		if price, ok := priceTimestamp.GetValidPrice(cutoffTime); ok {
+			validExchangeIdsForMarket = append(validExchangeIdsForMarket, exchangeId)
 			validExchangePricesForMarket = append(validExchangePricesForMarket, price)
+			validExchangeVolumesForMarket = append(validExchangeVolumesForMarket, priceTimestamp.Volume)
 		} else {
 			// Price is invalid.
 			validity = metrics.PriceIsInvalid
@@ -91,5 +218,5 @@ func (etp *ExchangeToPrice) GetValidPrices(
 			},
 		)
 	}
-	return validExchangePricesForMarket
+	return validExchangeIdsForMarket, validExchangePricesForMarket, validExchangeVolumesForMarket
 }
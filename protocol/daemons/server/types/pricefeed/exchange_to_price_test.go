@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -113,15 +114,52 @@ func TestGetValidPrices(t *testing.T) {
 			constants.Exchange1_Price1_TimeT,
 		})
 
-	r := etp.GetValidPrices(constants.TimeT)
+	r, volumes := etp.GetValidPrices(constants.TimeT)
 	require.Len(t, r, 1)
 	require.Equal(t, constants.Price1, r[0])
+	require.Equal(t, []pricefeedtypes.Volume{{}}, volumes)
+}
+
+func TestGetValidPrices_WithVolume(t *testing.T) {
+	etp := NewExchangeToPrice(0)
+
+	etp.UpdatePrices(
+		[]*api.ExchangePrice{
+			{
+				ExchangeId:     constants.ExchangeId1,
+				Price:          constants.Price1,
+				Volume:         constants.Volume1.Value,
+				VolumeExponent: constants.Volume1.Exponent,
+				LastUpdateTime: &constants.TimeT,
+			},
+			{
+				ExchangeId:     constants.ExchangeId2,
+				Price:          constants.Price2,
+				LastUpdateTime: &constants.TimeT,
+			},
+		})
+
+	r, volumes := etp.GetValidPrices(constants.TimeT)
+	require.Len(t, r, 2)
+	require.Len(t, volumes, 2)
+
+	// Volumes are returned at the same index as their corresponding price.
+	for i, price := range r {
+		switch price {
+		case constants.Price1:
+			require.Equal(t, constants.Volume1, volumes[i])
+		case constants.Price2:
+			require.True(t, volumes[i].IsZero())
+		default:
+			t.Fatalf("unexpected price %v", price)
+		}
+	}
 }
 
 func TestGetValidPrices_Empty(t *testing.T) {
 	etp := NewExchangeToPrice(0)
 
-	r := etp.GetValidPrices(constants.TimeT)
+	r, _ := etp.GetValidPrices(constants.TimeT)
 	require.Empty(t, r)
 }
 
@@ -134,7 +172,7 @@ func TestGetValidPrices_OldPricesEmpty(t *testing.T) {
 			constants.Exchange2_Price2_TimeT,
 		})
 
-	r := etp.GetValidPrices(constants.TimeTPlus1)
+	r, _ := etp.GetValidPrices(constants.TimeTPlus1)
 	require.Empty(t, r)
 }
 
@@ -149,9 +187,158 @@ func TestGetValidPrices_ValidAndOldPrices(t *testing.T) {
 		})
 
 	// Exchange 1's Price is before cutoff, so it's ignored
-	r := etp.GetValidPrices(constants.TimeTPlus1)
+	r, _ := etp.GetValidPrices(constants.TimeTPlus1)
 	require.Len(t, r, 2)
 
 	expected := []uint64{constants.Price3, constants.Price4}
 	assert.ElementsMatch(t, expected, r)
 }
+
+func TestGetValidPricesExcludingOutliers(t *testing.T) {
+	tests := map[string]struct {
+		updates        []*api.ExchangePrice
+		outlierBandPpm uint32
+
+		expectedPrices         []uint64
+		expectedOutliers       []string
+		expectedStaleExchanges []string
+	}{
+		"No prices": {
+			updates:        []*api.ExchangePrice{},
+			outlierBandPpm: 100_000,
+			expectedPrices: []uint64{},
+		},
+		"No outliers": {
+			updates: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId1, Price: 1_000, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId2, Price: 1_050, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId3, Price: 1_100, LastUpdateTime: &constants.TimeT},
+			},
+			outlierBandPpm: 100_000,
+			expectedPrices: []uint64{1_000, 1_050, 1_100},
+		},
+		"Outlier is excluded": {
+			updates: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId1, Price: 1_000, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId2, Price: 1_050, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId3, Price: 2_000, LastUpdateTime: &constants.TimeT},
+			},
+			outlierBandPpm:   100_000,
+			expectedPrices:   []uint64{1_000, 1_050},
+			expectedOutliers: []string{constants.ExchangeId3},
+		},
+		"Outlier detection disabled": {
+			updates: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId1, Price: 1_000, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId2, Price: 1_050, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId3, Price: 2_000, LastUpdateTime: &constants.TimeT},
+			},
+			outlierBandPpm: 0,
+			expectedPrices: []uint64{1_000, 1_050, 2_000},
+		},
+		"Too few prices to detect outliers": {
+			updates: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId1, Price: 1_000, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId2, Price: 2_000, LastUpdateTime: &constants.TimeT},
+			},
+			outlierBandPpm: 100_000,
+			expectedPrices: []uint64{1_000, 2_000},
+		},
+		"Too few fresh prices to detect outliers": {
+			updates: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId1, Price: 1_000, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId2, Price: 2_000, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId3, Price: 1_050, LastUpdateTime: &constants.TimeTMinusThreshold},
+			},
+			outlierBandPpm:         100_000,
+			expectedPrices:         []uint64{1_000, 2_000},
+			expectedStaleExchanges: []string{constants.ExchangeId3},
+		},
+		"Stale prices are excluded before computing the median": {
+			updates: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId1, Price: 1_000, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId2, Price: 1_050, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId3, Price: 2_000, LastUpdateTime: &constants.TimeTMinusThreshold},
+			},
+			outlierBandPpm:         100_000,
+			expectedPrices:         []uint64{1_000, 1_050},
+			expectedStaleExchanges: []string{constants.ExchangeId3},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			etp := NewExchangeToPrice(0)
+			etp.UpdatePrices(tc.updates)
+
+			prices, volumes := etp.GetValidPricesExcludingOutliers(constants.TimeT, tc.outlierBandPpm)
+			assert.ElementsMatch(t, tc.expectedPrices, prices)
+			require.Len(t, volumes, len(prices))
+
+			for _, update := range tc.updates {
+				health := etp.exchangeToHealth[update.ExchangeId]
+				require.NotNil(t, health)
+
+				isOutlier := false
+				for _, outlier := range tc.expectedOutliers {
+					isOutlier = isOutlier || outlier == update.ExchangeId
+				}
+				isStale := false
+				for _, stale := range tc.expectedStaleExchanges {
+					isStale = isStale || stale == update.ExchangeId
+				}
+
+				require.Equal(t, isOutlier, health.ErrorRatePpm > 0)
+				require.Equal(t, isStale, health.StaleRatePpm > 0)
+			}
+		})
+	}
+}
+
+func TestGetExchangeHealthScores(t *testing.T) {
+	etp := NewExchangeToPrice(constants.MarketId9)
+	etp.UpdatePrices(
+		[]*api.ExchangePrice{
+			{ExchangeId: constants.ExchangeId2, Price: 1_000, LastUpdateTime: &constants.TimeT},
+			{ExchangeId: constants.ExchangeId1, Price: 1_000, LastUpdateTime: &constants.TimeT},
+			{ExchangeId: constants.ExchangeId3, Price: 2_000, LastUpdateTime: &constants.TimeT},
+		})
+
+	// All exchanges are perfectly healthy before prices are read.
+	scores := etp.GetExchangeHealthScores()
+	require.Equal(
+		t,
+		[]api.ExchangeHealthScore{
+			{
+				MarketId:       constants.MarketId9,
+				ExchangeId:     constants.ExchangeId1,
+				ScorePpm:       1_000_000,
+				LastUpdateTime: &constants.TimeT,
+			},
+			{
+				MarketId:       constants.MarketId9,
+				ExchangeId:     constants.ExchangeId2,
+				ScorePpm:       1_000_000,
+				LastUpdateTime: &constants.TimeT,
+			},
+			{
+				MarketId:       constants.MarketId9,
+				ExchangeId:     constants.ExchangeId3,
+				ScorePpm:       1_000_000,
+				LastUpdateTime: &constants.TimeT,
+			},
+		},
+		scores,
+	)
+
+	// Exchange 3 is an outlier.
+	etp.GetValidPricesExcludingOutliers(constants.TimeT, 100_000)
+	scores = etp.GetExchangeHealthScores()
+	require.Len(t, scores, 3)
+	require.Equal(t, uint32(1_000_000), scores[0].ScorePpm)
+	require.Equal(t, uint32(1_000_000), scores[1].ScorePpm)
+	require.Equal(t, constants.ExchangeId3, scores[2].ExchangeId)
+	require.Equal(t, uint32(50_000), scores[2].DeviationPpm) // Average of a 100% deviation.
+	require.Equal(t, uint32(50_000), scores[2].ErrorRatePpm)
+	require.Equal(t, uint32(0), scores[2].StaleRatePpm)
+	require.Equal(t, uint32(902_500), scores[2].ScorePpm)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -23,16 +24,40 @@ type MarketToExchangePrices struct {
 	// maxPriceAge is the maximum age of a price before it is considered too stale to be used.
 	// Prices older than this age will not be used to calculate the median price.
 	maxPriceAge time.Duration
+	// outlierBandPpm is the maximum deviation of an exchange price from the median price of all exchanges,
+	// in parts-per-million. Prices beyond this band are considered outliers and are not used to calculate
+	// the median price. A value of 0 disables outlier detection.
+	outlierBandPpm uint32
+	// marketToAggregationMode caches the aggregation mode parsed from the exchange config json of each market,
+	// so that the json is only re-parsed when it changes.
+	marketToAggregationMode map[uint32]aggregationModeCacheEntry
+}
+
+// aggregationModeCacheEntry is the aggregation mode parsed from an exchange config json.
+type aggregationModeCacheEntry struct {
+	exchangeConfigJson string
+	aggregationMode    pricefeedtypes.AggregationMode
 }
 
 // NewMarketToExchangePrices creates a new MarketToExchangePrices.
 func NewMarketToExchangePrices(maxPriceAge time.Duration) *MarketToExchangePrices {
 	return &MarketToExchangePrices{
-		marketToExchangePrices: make(map[uint32]*ExchangeToPrice),
-		maxPriceAge:            maxPriceAge,
+		marketToExchangePrices:  make(map[uint32]*ExchangeToPrice),
+		maxPriceAge:             maxPriceAge,
+		marketToAggregationMode: make(map[uint32]aggregationModeCacheEntry),
 	}
 }
 
+// WithOutlierBandPpm sets the maximum deviation of an exchange price from the median price of all
+// exchanges, in parts-per-million, before the price is excluded as an outlier. A value of 0 disables
+// outlier detection.
+func (mte *MarketToExchangePrices) WithOutlierBandPpm(outlierBandPpm uint32) *MarketToExchangePrices {
+	mte.Lock()
+	defer mte.Unlock()
+	mte.outlierBandPpm = outlierBandPpm
+	return mte
+}
+
 // UpdatePrices updates market prices given a list of price updates. Prices are
 // only updated if the timestamp on the updates are greater than the timestamp
 // on existing prices.
@@ -57,8 +82,15 @@ func (mte *MarketToExchangePrices) UpdatePrices(
 // a price is valid iff
 // 1) the last update time is within a predefined threshold away from the given
 // read time.
-// 2) the number of prices that meet 1) are greater than the minimum number of
+// 2) the price is within the outlier band of the median of all prices that meet 1).
+// 3) the number of prices that meet 1) and 2) are greater than the minimum number of
 // exchanges specified in the given input.
+//
+// Reading prices also updates the health score of each exchange. See `ExchangeHealth`.
+//
+// Markets that configure a volume-weighted aggregation mode in their exchange config json
+// resolve their valid prices weighted by the 24h volume reported by each exchange. See
+// `resolvePrice` for details.
 func (mte *MarketToExchangePrices) GetValidMedianPrices(
 	marketParams []types.MarketParam,
 	readTime time.Time,
@@ -87,8 +119,8 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 			continue
 		}
 
-		// GetValidPriceForMarket filters prices based on cutoff time.
-		validPrices := exchangeToPrice.GetValidPrices(cutoffTime)
+		// GetValidPricesExcludingOutliers filters prices based on cutoff time and the outlier band.
+		validPrices, validVolumes := exchangeToPrice.GetValidPricesExcludingOutliers(cutoffTime, mte.outlierBandPpm)
 		telemetry.SetGaugeWithLabels(
 			[]string{
 				metrics.PricefeedServer,
@@ -104,7 +136,13 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 		// The number of valid prices must be >= min number of exchanges.
 		if len(validPrices) >= int(marketParam.MinExchanges) {
 			// Calculate the median. Returns an error if the input is empty.
-			median, err := lib.Median(validPrices)
+			median, err := resolvePrice(
+				mte.getAggregationMode(marketParam),
+				validPrices,
+				validVolumes,
+				marketParam.MinExchanges,
+				marketId,
+			)
 			if err != nil {
 				telemetry.IncrCounterWithLabels(
 					[]string{
@@ -125,3 +163,97 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 
 	return marketIdToMedianPrice
 }
+
+// GetExchangeHealthScores returns the health score of each exchange for the given markets, in the order of
+// the given market ids and then sorted by exchange id. Scores for all markets are returned, sorted by market
+// id, if no market ids are given. Markets without any prices are skipped.
+func (mte *MarketToExchangePrices) GetExchangeHealthScores(marketIds []uint32) []api.ExchangeHealthScore {
+	mte.Lock()
+	defer mte.Unlock()
+
+	if len(marketIds) == 0 {
+		marketIds = lib.GetSortedKeys[lib.Sortable[uint32]](mte.marketToExchangePrices)
+	}
+
+	scores := make([]api.ExchangeHealthScore, 0)
+	for _, marketId := range marketIds {
+		if exchangeToPrice, ok := mte.marketToExchangePrices[marketId]; ok {
+			scores = append(scores, exchangeToPrice.GetExchangeHealthScores()...)
+		}
+	}
+	return scores
+}
+
+// getAggregationMode returns the aggregation mode configured for the market. Markets with no
+// configured mode, or with an exchange config json that cannot be parsed, use the median.
+func (mte *MarketToExchangePrices) getAggregationMode(
+	marketParam types.MarketParam,
+) pricefeedtypes.AggregationMode {
+	if entry, ok := mte.marketToAggregationMode[marketParam.Id]; ok &&
+		entry.exchangeConfigJson == marketParam.ExchangeConfigJson {
+		return entry.aggregationMode
+	}
+
+	mode, err := pricefeedtypes.GetAggregationModeFromExchangeConfigJson(marketParam.ExchangeConfigJson)
+	if err != nil {
+		telemetry.IncrCounterWithLabels(
+			[]string{
+				metrics.PricefeedServer,
+				metrics.InvalidAggregationMode,
+				metrics.Count,
+			},
+			1,
+			[]gometrics.Label{
+				pricefeedmetrics.GetLabelForMarketId(marketParam.Id),
+			},
+		)
+		mode = pricefeedtypes.AggregationModeMedian
+	}
+	mte.marketToAggregationMode[marketParam.Id] = aggregationModeCacheEntry{
+		exchangeConfigJson: marketParam.ExchangeConfigJson,
+		aggregationMode:    mode,
+	}
+	return mode
+}
+
+// resolvePrice resolves the valid prices of a market into a single price using the given aggregation mode.
+// Volume-weighted modes only consider prices with a non-zero reported volume. If fewer than `minExchanges`
+// prices have a reported volume, the median of all valid prices is used instead, so that a market never
+// depends on fewer exchanges than required.
+func resolvePrice(
+	mode pricefeedtypes.AggregationMode,
+	prices []uint64,
+	volumes []pricefeedtypes.Volume,
+	minExchanges uint32,
+	marketId uint32,
+) (uint64, error) {
+	if mode.IsVolumeWeighted() {
+		numWeighted := 0
+		for _, volume := range volumes {
+			if !volume.IsZero() {
+				numWeighted++
+			}
+		}
+
+		if numWeighted > 0 && numWeighted >= int(minExchanges) {
+			weights := pricefeedtypes.GetVolumeWeights(volumes)
+			if mode == pricefeedtypes.AggregationModeVolumeWeightedMean {
+				return lib.WeightedMeanUint64(prices, weights)
+			}
+			return lib.WeightedMedianUint64(prices, weights)
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{
+				metrics.PricefeedServer,
+				metrics.VolumeWeightedPriceFallback,
+				metrics.Count,
+			},
+			1,
+			[]gometrics.Label{
+				pricefeedmetrics.GetLabelForMarketId(marketId),
+			},
+		)
+	}
+	return lib.Median(prices)
+}
@@ -193,3 +193,226 @@ func TestGetValidMedianPrices_MultiMarketSuccess(t *testing.T) {
 	// Market7 only has 1 valid price due to update time constraint,
 	// but the min exchanges required is 2. Therefore, no median price.
 }
+
+func TestGetValidMedianPrices_AggregationModes(t *testing.T) {
+	// Volumes of 1, 1 and 6 units, reported with different exponents.
+	exchangePrices := []*api.ExchangePrice{
+		{
+			ExchangeId:     constants.ExchangeId1,
+			Price:          1000,
+			Volume:         10,
+			VolumeExponent: -1,
+			LastUpdateTime: &constants.TimeT,
+		},
+		{
+			ExchangeId:     constants.ExchangeId2,
+			Price:          2000,
+			Volume:         100_000_000,
+			VolumeExponent: -8,
+			LastUpdateTime: &constants.TimeT,
+		},
+		{
+			ExchangeId:     constants.ExchangeId3,
+			Price:          4000,
+			Volume:         6,
+			LastUpdateTime: &constants.TimeT,
+		},
+	}
+	exchangePricesPartialVolume := []*api.ExchangePrice{
+		exchangePrices[0],
+		exchangePrices[1],
+		{
+			ExchangeId:     constants.ExchangeId3,
+			Price:          4000,
+			LastUpdateTime: &constants.TimeT,
+		},
+	}
+
+	tests := map[string]struct {
+		exchangePrices     []*api.ExchangePrice
+		exchangeConfigJson string
+		minExchanges       uint32
+		expectedPrice      uint64
+	}{
+		"Median by default": {
+			exchangePrices:     exchangePrices,
+			exchangeConfigJson: `{"exchanges":[]}`,
+			minExchanges:       2,
+			expectedPrice:      2000,
+		},
+		"Median": {
+			exchangePrices:     exchangePrices,
+			exchangeConfigJson: `{"exchanges":[],"aggregationMode":"median"}`,
+			minExchanges:       2,
+			expectedPrice:      2000,
+		},
+		"Volume weighted median": {
+			exchangePrices:     exchangePrices,
+			exchangeConfigJson: `{"exchanges":[],"aggregationMode":"volumeWeightedMedian"}`,
+			minExchanges:       2,
+			expectedPrice:      4000,
+		},
+		"Volume weighted mean": {
+			exchangePrices:     exchangePrices,
+			exchangeConfigJson: `{"exchanges":[],"aggregationMode":"volumeWeightedMean"}`,
+			minExchanges:       2,
+			expectedPrice:      3375, // (1000 + 2000 + 6 * 4000) / 8
+		},
+		"Volume weighted median ignores prices without volume": {
+			exchangePrices:     exchangePricesPartialVolume,
+			exchangeConfigJson: `{"exchanges":[],"aggregationMode":"volumeWeightedMedian"}`,
+			minExchanges:       2,
+			expectedPrice:      1500,
+		},
+		"Volume weighted mean falls back to median if too few prices have volume": {
+			exchangePrices:     exchangePricesPartialVolume,
+			exchangeConfigJson: `{"exchanges":[],"aggregationMode":"volumeWeightedMean"}`,
+			minExchanges:       3,
+			expectedPrice:      2000,
+		},
+		"Invalid aggregation mode falls back to median": {
+			exchangePrices:     exchangePrices,
+			exchangeConfigJson: `{"exchanges":[],"aggregationMode":"mean"}`,
+			minExchanges:       2,
+			expectedPrice:      2000,
+		},
+		"Invalid exchange config json falls back to median": {
+			exchangePrices:     exchangePrices,
+			exchangeConfigJson: `{`,
+			minExchanges:       2,
+			expectedPrice:      2000,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+			mte.UpdatePrices(
+				[]*api.MarketPriceUpdate{
+					{
+						MarketId:       constants.MarketId9,
+						ExchangePrices: tc.exchangePrices,
+					},
+				},
+			)
+			r := mte.GetValidMedianPrices(
+				[]types.MarketParam{
+					{
+						Id:                 constants.MarketId9,
+						MinExchanges:       tc.minExchanges,
+						ExchangeConfigJson: tc.exchangeConfigJson,
+					},
+				},
+				constants.TimeT,
+			)
+
+			require.Equal(t, map[uint32]uint64{constants.MarketId9: tc.expectedPrice}, r)
+		})
+	}
+}
+
+func TestGetValidMedianPrices_AggregationModeUpdated(t *testing.T) {
+	mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+	mte.UpdatePrices(
+		[]*api.MarketPriceUpdate{
+			{
+				MarketId: constants.MarketId9,
+				ExchangePrices: []*api.ExchangePrice{
+					{
+						ExchangeId:     constants.ExchangeId1,
+						Price:          1000,
+						Volume:         1,
+						LastUpdateTime: &constants.TimeT,
+					},
+					{
+						ExchangeId:     constants.ExchangeId2,
+						Price:          2000,
+						Volume:         3,
+						LastUpdateTime: &constants.TimeT,
+					},
+				},
+			},
+		},
+	)
+	marketParam := types.MarketParam{
+		Id:                 constants.MarketId9,
+		MinExchanges:       2,
+		ExchangeConfigJson: `{"exchanges":[]}`,
+	}
+
+	r := mte.GetValidMedianPrices([]types.MarketParam{marketParam}, constants.TimeT)
+	require.Equal(t, uint64(1500), r[constants.MarketId9])
+
+	// Changing the exchange config json of the market updates its aggregation mode.
+	marketParam.ExchangeConfigJson = `{"exchanges":[],"aggregationMode":"volumeWeightedMean"}`
+	r = mte.GetValidMedianPrices([]types.MarketParam{marketParam}, constants.TimeT)
+	require.Equal(t, uint64(1750), r[constants.MarketId9])
+}
+
+func TestGetValidMedianPrices_OutliersDoNotCountTowardsMinExchanges(t *testing.T) {
+	updates := []*api.MarketPriceUpdate{
+		{
+			MarketId: constants.MarketId9,
+			ExchangePrices: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId1, Price: 1_000, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId2, Price: 1_020, LastUpdateTime: &constants.TimeT},
+				{ExchangeId: constants.ExchangeId3, Price: 100_000, LastUpdateTime: &constants.TimeT},
+			},
+		},
+	}
+	marketParams := func(minExchanges uint32) []types.MarketParam {
+		return []types.MarketParam{
+			{
+				Id:                 constants.MarketId9,
+				MinExchanges:       minExchanges,
+				ExchangeConfigJson: `{"exchanges":[]}`,
+			},
+		}
+	}
+
+	// Without outlier detection, the outlier is used to compute the median.
+	mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+	mte.UpdatePrices(updates)
+	r := mte.GetValidMedianPrices(marketParams(3), constants.TimeT)
+	require.Equal(t, map[uint32]uint64{constants.MarketId9: 1_020}, r)
+
+	// With outlier detection, the outlier is excluded from the median.
+	mte = NewMarketToExchangePrices(pricefeed_types.MaxPriceAge).WithOutlierBandPpm(100_000)
+	mte.UpdatePrices(updates)
+	r = mte.GetValidMedianPrices(marketParams(2), constants.TimeT)
+	require.Equal(t, map[uint32]uint64{constants.MarketId9: 1_010}, r)
+
+	// The outlier does not count towards the minimum number of exchanges.
+	r = mte.GetValidMedianPrices(marketParams(3), constants.TimeT)
+	require.Empty(t, r)
+}
+
+func TestGetExchangeHealthScores_MultiMarket(t *testing.T) {
+	mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge).WithOutlierBandPpm(100_000)
+	mte.UpdatePrices(constants.AtTimeTPriceUpdate)
+
+	require.Empty(t, mte.GetExchangeHealthScores([]uint32{constants.MarketId11}))
+
+	// Scores of all markets are sorted by market id.
+	scores := mte.GetExchangeHealthScores(nil)
+	require.Len(t, scores, 6)
+	require.Equal(t, constants.MarketId7, scores[0].MarketId)
+	require.Equal(t, constants.MarketId7, scores[1].MarketId)
+	require.Equal(t, constants.MarketId8, scores[2].MarketId)
+	require.Equal(t, constants.MarketId8, scores[3].MarketId)
+	require.Equal(t, constants.MarketId9, scores[4].MarketId)
+	require.Equal(t, constants.MarketId9, scores[5].MarketId)
+
+	// Reading prices updates the health scores. Exchange prices of 1001 and 2002 both deviate from their
+	// median of 1501, but two prices are too few to reject either of them as an outlier.
+	r := mte.GetValidMedianPrices(constants.AllMarketParamsMinExchanges2, constants.TimeT)
+	require.Contains(t, r, constants.MarketId9)
+	scores = mte.GetExchangeHealthScores([]uint32{constants.MarketId9})
+	require.Len(t, scores, 2)
+	for _, score := range scores {
+		require.Equal(t, constants.MarketId9, score.MarketId)
+		require.Zero(t, score.ErrorRatePpm)
+		require.Positive(t, score.DeviationPpm)
+		require.Less(t, score.ScorePpm, uint32(1_000_000))
+	}
+}
@@ -9,8 +9,8 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
-	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
-	shared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	types "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1/types"
+	types1 "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -136,11 +136,16 @@ func (m *FundingUpdateV1) GetFundingValuePpm() int32 {
 //     during a `funding-tick` epoch and funding index accordingly updated with
 //     `funding rate * price`.
 type FundingEventV1 struct {
-	// updates is a list of per-market funding updates for all existing perpetual
-	// markets. The list is sorted by `perpetualId`s which are unique.
+	// updates is a list of per-market funding updates for all perpetual markets
+	// whose funding epoch started in this block. The list is sorted by
+	// `perpetualId`s which are unique.
 	Updates []FundingUpdateV1 `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
 	// type stores the type of funding updates.
 	Type FundingEventV1_Type `protobuf:"varint,2,opt,name=type,proto3,enum=dydxprotocol.indexer.events.FundingEventV1_Type" json:"type,omitempty"`
+	// interval_seconds is the duration in seconds of the epoch the updates were
+	// computed over, i.e. the `funding-tick` epoch for funding rates and the
+	// `funding-sample` epoch for premium samples.
+	IntervalSeconds uint32 `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
 }
 
 func (m *FundingEventV1) Reset()         { *m = FundingEventV1{} }
@@ -190,6 +195,13 @@ func (m *FundingEventV1) GetType() FundingEventV1_Type {
 	return FundingEventV1_TYPE_UNSPECIFIED
 }
 
+func (m *FundingEventV1) GetIntervalSeconds() uint32 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
 // MarketEvent message contains all the information about a market event on
 // the dYdX chain.
 type MarketEventV1 struct {
@@ -198,6 +210,7 @@ type MarketEventV1 struct {
 	// either an event for price update, market creation, or market modification.
 	//
 	// Types that are valid to be assigned to Event:
+	//
 	//	*MarketEventV1_PriceUpdate
 	//	*MarketEventV1_MarketCreate
 	//	*MarketEventV1_MarketModify
@@ -512,8 +525,7 @@ func (m *MarketModifyEventV1) GetBase() *MarketBaseEventV1 {
 
 // SourceOfFunds is the source of funds in a transfer event.
 type SourceOfFunds struct {
-	//	one of below
-	//
+	//  one of below
 	// - a subaccount ID
 	// - a wallet address
 	//
@@ -564,7 +576,7 @@ type isSourceOfFunds_Source interface {
 }
 
 type SourceOfFunds_SubaccountId struct {
-	SubaccountId *v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3,oneof" json:"subaccount_id,omitempty"`
+	SubaccountId *types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3,oneof" json:"subaccount_id,omitempty"`
 }
 type SourceOfFunds_Address struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof" json:"address,omitempty"`
@@ -580,7 +592,7 @@ func (m *SourceOfFunds) GetSource() isSourceOfFunds_Source {
 	return nil
 }
 
-func (m *SourceOfFunds) GetSubaccountId() *v1.IndexerSubaccountId {
+func (m *SourceOfFunds) GetSubaccountId() *types.IndexerSubaccountId {
 	if x, ok := m.GetSource().(*SourceOfFunds_SubaccountId); ok {
 		return x.SubaccountId
 	}
@@ -607,8 +619,8 @@ func (*SourceOfFunds) XXX_OneofWrappers() []interface{} {
 // When a subaccount is involved, a SubaccountUpdateEvent message will
 // be produced with the updated asset positions.
 type TransferEventV1 struct {
-	SenderSubaccountId    *v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=sender_subaccount_id,json=senderSubaccountId,proto3" json:"sender_subaccount_id,omitempty"`
-	RecipientSubaccountId *v1.IndexerSubaccountId `protobuf:"bytes,2,opt,name=recipient_subaccount_id,json=recipientSubaccountId,proto3" json:"recipient_subaccount_id,omitempty"`
+	SenderSubaccountId    *types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=sender_subaccount_id,json=senderSubaccountId,proto3" json:"sender_subaccount_id,omitempty"`
+	RecipientSubaccountId *types.IndexerSubaccountId `protobuf:"bytes,2,opt,name=recipient_subaccount_id,json=recipientSubaccountId,proto3" json:"recipient_subaccount_id,omitempty"`
 	// Id of the asset transfered.
 	AssetId uint32 `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 	// The amount of asset in quantums to transfer.
@@ -656,14 +668,14 @@ func (m *TransferEventV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferEventV1 proto.InternalMessageInfo
 
-func (m *TransferEventV1) GetSenderSubaccountId() *v1.IndexerSubaccountId {
+func (m *TransferEventV1) GetSenderSubaccountId() *types.IndexerSubaccountId {
 	if m != nil {
 		return m.SenderSubaccountId
 	}
 	return nil
 }
 
-func (m *TransferEventV1) GetRecipientSubaccountId() *v1.IndexerSubaccountId {
+func (m *TransferEventV1) GetRecipientSubaccountId() *types.IndexerSubaccountId {
 	if m != nil {
 		return m.RecipientSubaccountId
 	}
@@ -702,7 +714,7 @@ func (m *TransferEventV1) GetRecipient() *SourceOfFunds {
 // the dYdX chain. This includes the maker/taker orders that matched and the
 // amount filled.
 type OrderFillEventV1 struct {
-	MakerOrder v1.IndexerOrder `protobuf:"bytes,1,opt,name=maker_order,json=makerOrder,proto3" json:"maker_order"`
+	MakerOrder types.IndexerOrder `protobuf:"bytes,1,opt,name=maker_order,json=makerOrder,proto3" json:"maker_order"`
 	// The type of order fill this event represents.
 	//
 	// Types that are valid to be assigned to TakerOrder:
@@ -763,7 +775,7 @@ type isOrderFillEventV1_TakerOrder interface {
 }
 
 type OrderFillEventV1_Order struct {
-	Order *v1.IndexerOrder `protobuf:"bytes,2,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	Order *types.IndexerOrder `protobuf:"bytes,2,opt,name=order,proto3,oneof" json:"order,omitempty"`
 }
 type OrderFillEventV1_LiquidationOrder struct {
 	LiquidationOrder *LiquidationOrderV1 `protobuf:"bytes,4,opt,name=liquidation_order,json=liquidationOrder,proto3,oneof" json:"liquidation_order,omitempty"`
@@ -779,14 +791,14 @@ func (m *OrderFillEventV1) GetTakerOrder() isOrderFillEventV1_TakerOrder {
 	return nil
 }
 
-func (m *OrderFillEventV1) GetMakerOrder() v1.IndexerOrder {
+func (m *OrderFillEventV1) GetMakerOrder() types.IndexerOrder {
 	if m != nil {
 		return m.MakerOrder
 	}
-	return v1.IndexerOrder{}
+	return types.IndexerOrder{}
 }
 
-func (m *OrderFillEventV1) GetOrder() *v1.IndexerOrder {
+func (m *OrderFillEventV1) GetOrder() *types.IndexerOrder {
 	if x, ok := m.GetTakerOrder().(*OrderFillEventV1_Order); ok {
 		return x.Order
 	}
@@ -848,9 +860,9 @@ func (*OrderFillEventV1) XXX_OneofWrappers() []interface{} {
 // the amount filled.
 type DeleveragingEventV1 struct {
 	// ID of the subaccount that was liquidated.
-	Liquidated v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
+	Liquidated types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
 	// ID of the subaccount that was used to offset the position.
-	Offsetting v1.IndexerSubaccountId `protobuf:"bytes,2,opt,name=offsetting,proto3" json:"offsetting"`
+	Offsetting types.IndexerSubaccountId `protobuf:"bytes,2,opt,name=offsetting,proto3" json:"offsetting"`
 	// The ID of the perpetual that was liquidated.
 	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
 	// The amount filled between the liquidated and offsetting position, in
@@ -895,18 +907,18 @@ func (m *DeleveragingEventV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleveragingEventV1 proto.InternalMessageInfo
 
-func (m *DeleveragingEventV1) GetLiquidated() v1.IndexerSubaccountId {
+func (m *DeleveragingEventV1) GetLiquidated() types.IndexerSubaccountId {
 	if m != nil {
 		return m.Liquidated
 	}
-	return v1.IndexerSubaccountId{}
+	return types.IndexerSubaccountId{}
 }
 
-func (m *DeleveragingEventV1) GetOffsetting() v1.IndexerSubaccountId {
+func (m *DeleveragingEventV1) GetOffsetting() types.IndexerSubaccountId {
 	if m != nil {
 		return m.Offsetting
 	}
-	return v1.IndexerSubaccountId{}
+	return types.IndexerSubaccountId{}
 }
 
 func (m *DeleveragingEventV1) GetPerpetualId() uint32 {
@@ -941,7 +953,7 @@ func (m *DeleveragingEventV1) GetIsBuy() bool {
 // liquidation order fill event.
 type LiquidationOrderV1 struct {
 	// ID of the subaccount that was liquidated.
-	Liquidated v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
+	Liquidated types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
 	// The ID of the clob pair involved in the liquidation.
 	ClobPairId uint32 `protobuf:"varint,2,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
 	// The ID of the perpetual involved in the liquidation.
@@ -992,11 +1004,11 @@ func (m *LiquidationOrderV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidationOrderV1 proto.InternalMessageInfo
 
-func (m *LiquidationOrderV1) GetLiquidated() v1.IndexerSubaccountId {
+func (m *LiquidationOrderV1) GetLiquidated() types.IndexerSubaccountId {
 	if m != nil {
 		return m.Liquidated
 	}
-	return v1.IndexerSubaccountId{}
+	return types.IndexerSubaccountId{}
 }
 
 func (m *LiquidationOrderV1) GetClobPairId() uint32 {
@@ -1041,11 +1053,11 @@ func (m *LiquidationOrderV1) GetSubticks() uint64 {
 // at the end of a block which is why multiple asset/perpetual position
 // updates may exist.
 type SubaccountUpdateEventV1 struct {
-	SubaccountId *v1.IndexerSubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	SubaccountId *types.IndexerSubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
 	// updated_perpetual_positions will each be for unique perpetuals.
-	UpdatedPerpetualPositions []*v1.IndexerPerpetualPosition `protobuf:"bytes,3,rep,name=updated_perpetual_positions,json=updatedPerpetualPositions,proto3" json:"updated_perpetual_positions,omitempty"`
+	UpdatedPerpetualPositions []*types.IndexerPerpetualPosition `protobuf:"bytes,3,rep,name=updated_perpetual_positions,json=updatedPerpetualPositions,proto3" json:"updated_perpetual_positions,omitempty"`
 	// updated_asset_positions will each be for unique assets.
-	UpdatedAssetPositions []*v1.IndexerAssetPosition `protobuf:"bytes,4,rep,name=updated_asset_positions,json=updatedAssetPositions,proto3" json:"updated_asset_positions,omitempty"`
+	UpdatedAssetPositions []*types.IndexerAssetPosition `protobuf:"bytes,4,rep,name=updated_asset_positions,json=updatedAssetPositions,proto3" json:"updated_asset_positions,omitempty"`
 }
 
 func (m *SubaccountUpdateEventV1) Reset()         { *m = SubaccountUpdateEventV1{} }
@@ -1081,21 +1093,21 @@ func (m *SubaccountUpdateEventV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SubaccountUpdateEventV1 proto.InternalMessageInfo
 
-func (m *SubaccountUpdateEventV1) GetSubaccountId() *v1.IndexerSubaccountId {
+func (m *SubaccountUpdateEventV1) GetSubaccountId() *types.IndexerSubaccountId {
 	if m != nil {
 		return m.SubaccountId
 	}
 	return nil
 }
 
-func (m *SubaccountUpdateEventV1) GetUpdatedPerpetualPositions() []*v1.IndexerPerpetualPosition {
+func (m *SubaccountUpdateEventV1) GetUpdatedPerpetualPositions() []*types.IndexerPerpetualPosition {
 	if m != nil {
 		return m.UpdatedPerpetualPositions
 	}
 	return nil
 }
 
-func (m *SubaccountUpdateEventV1) GetUpdatedAssetPositions() []*v1.IndexerAssetPosition {
+func (m *SubaccountUpdateEventV1) GetUpdatedAssetPositions() []*types.IndexerAssetPosition {
 	if m != nil {
 		return m.UpdatedAssetPositions
 	}
@@ -1104,8 +1116,8 @@ func (m *SubaccountUpdateEventV1) GetUpdatedAssetPositions() []*v1.IndexerAssetP
 
 // StatefulOrderEvent message contains information about a change to a stateful
 // order. Currently, this is either the placement of a long-term order, the
-// placement or triggering of a conditional order, or the removal of a
-// stateful order.
+// placement, triggering or trigger price update of a conditional order, or the
+// removal of a stateful order.
 type StatefulOrderEventV1 struct {
 	// The type of event that this StatefulOrderEvent contains.
 	//
@@ -1116,6 +1128,7 @@ type StatefulOrderEventV1 struct {
 	//	*StatefulOrderEventV1_ConditionalOrderPlacement
 	//	*StatefulOrderEventV1_ConditionalOrderTriggered
 	//	*StatefulOrderEventV1_LongTermOrderPlacement
+	//	*StatefulOrderEventV1_ConditionalOrderTriggerUpdated
 	Event isStatefulOrderEventV1_Event `protobuf_oneof:"event"`
 }
 
@@ -1173,12 +1186,16 @@ type StatefulOrderEventV1_ConditionalOrderTriggered struct {
 type StatefulOrderEventV1_LongTermOrderPlacement struct {
 	LongTermOrderPlacement *StatefulOrderEventV1_LongTermOrderPlacementV1 `protobuf:"bytes,7,opt,name=long_term_order_placement,json=longTermOrderPlacement,proto3,oneof" json:"long_term_order_placement,omitempty"`
 }
+type StatefulOrderEventV1_ConditionalOrderTriggerUpdated struct {
+	ConditionalOrderTriggerUpdated *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 `protobuf:"bytes,8,opt,name=conditional_order_trigger_updated,json=conditionalOrderTriggerUpdated,proto3,oneof" json:"conditional_order_trigger_updated,omitempty"`
+}
 
-func (*StatefulOrderEventV1_OrderPlace) isStatefulOrderEventV1_Event()                {}
-func (*StatefulOrderEventV1_OrderRemoval) isStatefulOrderEventV1_Event()              {}
-func (*StatefulOrderEventV1_ConditionalOrderPlacement) isStatefulOrderEventV1_Event() {}
-func (*StatefulOrderEventV1_ConditionalOrderTriggered) isStatefulOrderEventV1_Event() {}
-func (*StatefulOrderEventV1_LongTermOrderPlacement) isStatefulOrderEventV1_Event()    {}
+func (*StatefulOrderEventV1_OrderPlace) isStatefulOrderEventV1_Event()                     {}
+func (*StatefulOrderEventV1_OrderRemoval) isStatefulOrderEventV1_Event()                   {}
+func (*StatefulOrderEventV1_ConditionalOrderPlacement) isStatefulOrderEventV1_Event()      {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggered) isStatefulOrderEventV1_Event()      {}
+func (*StatefulOrderEventV1_LongTermOrderPlacement) isStatefulOrderEventV1_Event()         {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerUpdated) isStatefulOrderEventV1_Event() {}
 
 func (m *StatefulOrderEventV1) GetEvent() isStatefulOrderEventV1_Event {
 	if m != nil {
@@ -1222,6 +1239,13 @@ func (m *StatefulOrderEventV1) GetLongTermOrderPlacement() *StatefulOrderEventV1
 	return nil
 }
 
+func (m *StatefulOrderEventV1) GetConditionalOrderTriggerUpdated() *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 {
+	if x, ok := m.GetEvent().(*StatefulOrderEventV1_ConditionalOrderTriggerUpdated); ok {
+		return x.ConditionalOrderTriggerUpdated
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*StatefulOrderEventV1) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1230,12 +1254,13 @@ func (*StatefulOrderEventV1) XXX_OneofWrappers() []interface{} {
 		(*StatefulOrderEventV1_ConditionalOrderPlacement)(nil),
 		(*StatefulOrderEventV1_ConditionalOrderTriggered)(nil),
 		(*StatefulOrderEventV1_LongTermOrderPlacement)(nil),
+		(*StatefulOrderEventV1_ConditionalOrderTriggerUpdated)(nil),
 	}
 }
 
 // A stateful order placement contains an order.
 type StatefulOrderEventV1_StatefulOrderPlacementV1 struct {
-	Order *v1.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order *types.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Reset() {
@@ -1275,7 +1300,7 @@ func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StatefulOrderEventV1_StatefulOrderPlacementV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) GetOrder() *v1.IndexerOrder {
+func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) GetOrder() *types.IndexerOrder {
 	if m != nil {
 		return m.Order
 	}
@@ -1285,8 +1310,8 @@ func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) GetOrder() *v1.IndexerOr
 // A stateful order removal contains the id of an order that was already
 // placed and is now removed and the reason for the removal.
 type StatefulOrderEventV1_StatefulOrderRemovalV1 struct {
-	RemovedOrderId *v1.IndexerOrderId        `protobuf:"bytes,1,opt,name=removed_order_id,json=removedOrderId,proto3" json:"removed_order_id,omitempty"`
-	Reason         shared.OrderRemovalReason `protobuf:"varint,2,opt,name=reason,proto3,enum=dydxprotocol.indexer.shared.OrderRemovalReason" json:"reason,omitempty"`
+	RemovedOrderId *types.IndexerOrderId     `protobuf:"bytes,1,opt,name=removed_order_id,json=removedOrderId,proto3" json:"removed_order_id,omitempty"`
+	Reason         types1.OrderRemovalReason `protobuf:"varint,2,opt,name=reason,proto3,enum=dydxprotocol.indexer.shared.OrderRemovalReason" json:"reason,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Reset() {
@@ -1326,24 +1351,24 @@ func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StatefulOrderEventV1_StatefulOrderRemovalV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) GetRemovedOrderId() *v1.IndexerOrderId {
+func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) GetRemovedOrderId() *types.IndexerOrderId {
 	if m != nil {
 		return m.RemovedOrderId
 	}
 	return nil
 }
 
-func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) GetReason() shared.OrderRemovalReason {
+func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) GetReason() types1.OrderRemovalReason {
 	if m != nil {
 		return m.Reason
 	}
-	return shared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED
+	return types1.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED
 }
 
 // A conditional order placement contains an order. The order is newly-placed
 // and untriggered when this event is emitted.
 type StatefulOrderEventV1_ConditionalOrderPlacementV1 struct {
-	Order *v1.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order *types.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) Reset() {
@@ -1383,7 +1408,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) XXX_DiscardUnknown()
 
 var xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderPlacementV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) GetOrder() *v1.IndexerOrder {
+func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) GetOrder() *types.IndexerOrder {
 	if m != nil {
 		return m.Order
 	}
@@ -1393,7 +1418,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) GetOrder() *v1.Indexe
 // A conditional order trigger event contains an order id and is emitted when
 // an order is triggered.
 type StatefulOrderEventV1_ConditionalOrderTriggeredV1 struct {
-	TriggeredOrderId *v1.IndexerOrderId `protobuf:"bytes,1,opt,name=triggered_order_id,json=triggeredOrderId,proto3" json:"triggered_order_id,omitempty"`
+	TriggeredOrderId *types.IndexerOrderId `protobuf:"bytes,1,opt,name=triggered_order_id,json=triggeredOrderId,proto3" json:"triggered_order_id,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) Reset() {
@@ -1433,7 +1458,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) XXX_DiscardUnknown()
 
 var xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggeredV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) GetTriggeredOrderId() *v1.IndexerOrderId {
+func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) GetTriggeredOrderId() *types.IndexerOrderId {
 	if m != nil {
 		return m.TriggeredOrderId
 	}
@@ -1442,7 +1467,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) GetTriggeredOrderId()
 
 // A long term order placement contains an order.
 type StatefulOrderEventV1_LongTermOrderPlacementV1 struct {
-	Order *v1.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order *types.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Reset() {
@@ -1482,13 +1507,73 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StatefulOrderEventV1_LongTermOrderPlacementV1 proto.InternalMessageInfo
 
-func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) GetOrder() *v1.IndexerOrder {
+func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) GetOrder() *types.IndexerOrder {
 	if m != nil {
 		return m.Order
 	}
 	return nil
 }
 
+// A conditional order trigger update contains an order id and the new
+// trigger price of the order. It is emitted when the trigger price of an
+// untriggered trailing stop order moves with the oracle price.
+type StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 struct {
+	OrderId *types.IndexerOrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// The new trigger price of the order, in subticks.
+	TriggerSubticks uint64 `protobuf:"varint,2,opt,name=trigger_subticks,json=triggerSubticks,proto3" json:"trigger_subticks,omitempty"`
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Reset() {
+	*m = StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1{}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) ProtoMessage() {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{13, 5}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.Merge(m, src)
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 proto.InternalMessageInfo
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) GetOrderId() *types.IndexerOrderId {
+	if m != nil {
+		return m.OrderId
+	}
+	return nil
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) GetTriggerSubticks() uint64 {
+	if m != nil {
+		return m.TriggerSubticks
+	}
+	return 0
+}
+
 // AssetCreateEventV1 message contains all the information about an new Asset on
 // the dYdX chain.
 type AssetCreateEventV1 struct {
@@ -1595,7 +1680,7 @@ type PerpetualMarketCreateEventV1 struct {
 	// Defined in perpetuals.perpetual
 	MarketId uint32 `protobuf:"varint,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// Status of the CLOB
-	Status v1.ClobPairStatus `protobuf:"varint,5,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
+	Status types.ClobPairStatus `protobuf:"varint,5,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
 	// `10^Exponent` gives the number of QuoteQuantums traded per BaseQuantum
 	// per Subtick.
 	// Defined in clob.clob_pair
@@ -1681,11 +1766,11 @@ func (m *PerpetualMarketCreateEventV1) GetMarketId() uint32 {
 	return 0
 }
 
-func (m *PerpetualMarketCreateEventV1) GetStatus() v1.ClobPairStatus {
+func (m *PerpetualMarketCreateEventV1) GetStatus() types.ClobPairStatus {
 	if m != nil {
 		return m.Status
 	}
-	return v1.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
+	return types.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
 }
 
 func (m *PerpetualMarketCreateEventV1) GetQuantumConversionExponent() int32 {
@@ -1817,7 +1902,7 @@ type UpdateClobPairEventV1 struct {
 	// Defined in clob.clob_pair
 	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
 	// Status of the CLOB
-	Status v1.ClobPairStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
+	Status types.ClobPairStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
 	// `10^Exponent` gives the number of QuoteQuantums traded per BaseQuantum
 	// per Subtick.
 	// Defined in clob.clob_pair
@@ -1873,11 +1958,11 @@ func (m *UpdateClobPairEventV1) GetClobPairId() uint32 {
 	return 0
 }
 
-func (m *UpdateClobPairEventV1) GetStatus() v1.ClobPairStatus {
+func (m *UpdateClobPairEventV1) GetStatus() types.ClobPairStatus {
 	if m != nil {
 		return m.Status
 	}
-	return v1.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
+	return types.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
 }
 
 func (m *UpdateClobPairEventV1) GetQuantumConversionExponent() int32 {
@@ -1992,6 +2077,67 @@ func (m *UpdatePerpetualEventV1) GetLiquidityTier() uint32 {
 	return 0
 }
 
+// PerpetualSettlementEventV1 message contains all the information about the
+// final settlement of a delisted perpetual on the dYdX chain. It is emitted
+// once all open positions in the perpetual were closed.
+type PerpetualSettlementEventV1 struct {
+	// Unique Perpetual id.
+	// Defined in perpetuals.perpetual
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The price at which all open positions were closed, denominated in the
+	// exponent of the perpetual's market.
+	SettlementPrice uint64 `protobuf:"varint,2,opt,name=settlement_price,json=settlementPrice,proto3" json:"settlement_price,omitempty"`
+	// The funding index of the perpetual after the final funding payment.
+	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
+}
+
+func (m *PerpetualSettlementEventV1) Reset()         { *m = PerpetualSettlementEventV1{} }
+func (m *PerpetualSettlementEventV1) String() string { return proto.CompactTextString(m) }
+func (*PerpetualSettlementEventV1) ProtoMessage()    {}
+func (*PerpetualSettlementEventV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{19}
+}
+func (m *PerpetualSettlementEventV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualSettlementEventV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualSettlementEventV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualSettlementEventV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualSettlementEventV1.Merge(m, src)
+}
+func (m *PerpetualSettlementEventV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualSettlementEventV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualSettlementEventV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualSettlementEventV1 proto.InternalMessageInfo
+
+func (m *PerpetualSettlementEventV1) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *PerpetualSettlementEventV1) GetSettlementPrice() uint64 {
+	if m != nil {
+		return m.SettlementPrice
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dydxprotocol.indexer.events.FundingEventV1_Type", FundingEventV1_Type_name, FundingEventV1_Type_value)
 	proto.RegisterType((*FundingUpdateV1)(nil), "dydxprotocol.indexer.events.FundingUpdateV1")
@@ -2013,11 +2159,13 @@ func init() {
 	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderPlacementV1")
 	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggeredV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggeredV1")
 	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderPlacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggerUpdatedV1")
 	proto.RegisterType((*AssetCreateEventV1)(nil), "dydxprotocol.indexer.events.AssetCreateEventV1")
 	proto.RegisterType((*PerpetualMarketCreateEventV1)(nil), "dydxprotocol.indexer.events.PerpetualMarketCreateEventV1")
 	proto.RegisterType((*LiquidityTierUpsertEventV1)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV1")
 	proto.RegisterType((*UpdateClobPairEventV1)(nil), "dydxprotocol.indexer.events.UpdateClobPairEventV1")
 	proto.RegisterType((*UpdatePerpetualEventV1)(nil), "dydxprotocol.indexer.events.UpdatePerpetualEventV1")
+	proto.RegisterType((*PerpetualSettlementEventV1)(nil), "dydxprotocol.indexer.events.PerpetualSettlementEventV1")
 }
 
 func init() {
@@ -2025,131 +2173,138 @@ func init() {
 }
 
 var fileDescriptor_6331dfb59c6fd2bb = []byte{
-	// 1971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x4f, 0x23, 0xc9,
-	0x15, 0xc7, 0x76, 0x63, 0xe0, 0x81, 0x19, 0xbb, 0x06, 0x18, 0x03, 0x09, 0x43, 0x5a, 0x8a, 0x84,
-	0xf6, 0xc3, 0x0c, 0x93, 0x49, 0xb4, 0xca, 0x21, 0x0a, 0x06, 0xb3, 0x78, 0x04, 0x8c, 0xd3, 0x98,
-	0xd9, 0xdd, 0x49, 0xb4, 0x9d, 0xa2, 0xbb, 0x6c, 0x4a, 0xf4, 0xd7, 0x76, 0x95, 0xc9, 0x30, 0x52,
-	0xce, 0xc9, 0x2d, 0x91, 0x72, 0xce, 0x21, 0x87, 0x5c, 0x22, 0xe5, 0x10, 0x29, 0xd7, 0x95, 0x22,
-	0xe5, 0xb2, 0xb7, 0xac, 0x72, 0x49, 0x94, 0xc3, 0x28, 0x9a, 0x39, 0xe4, 0xdf, 0x88, 0xea, 0xa3,
-	0xdb, 0x36, 0xb6, 0x19, 0xcf, 0xc0, 0x9e, 0x70, 0xbd, 0x57, 0xef, 0xf7, 0x3e, 0xab, 0xde, 0xab,
-	0x06, 0x36, 0xdc, 0x4b, 0xf7, 0x79, 0x14, 0x87, 0x3c, 0x74, 0x42, 0x6f, 0x93, 0x06, 0x2e, 0x79,
-	0x4e, 0xe2, 0x4d, 0x72, 0x41, 0x02, 0xce, 0xf4, 0x9f, 0x8a, 0x64, 0xa3, 0xd5, 0xde, 0x9d, 0x15,
-	0xbd, 0xb3, 0xa2, 0xb6, 0xac, 0x2c, 0x3b, 0x21, 0xf3, 0x43, 0x66, 0x4b, 0xfe, 0xa6, 0x5a, 0x28,
-	0xb9, 0x95, 0x85, 0x76, 0xd8, 0x0e, 0x15, 0x5d, 0xfc, 0xd2, 0xd4, 0x07, 0x43, 0xf5, 0xb2, 0x33,
-	0x1c, 0x13, 0x77, 0x33, 0x26, 0x7e, 0x78, 0x81, 0x3d, 0x3b, 0x26, 0x98, 0x85, 0x81, 0x96, 0x78,
-	0x7f, 0xa8, 0x44, 0x4a, 0xb8, 0xd8, 0xda, 0x74, 0xbc, 0xf0, 0x54, 0x6f, 0xde, 0x7a, 0xe3, 0x66,
-	0xd6, 0x39, 0xc5, 0x8e, 0x13, 0x76, 0x02, 0xae, 0x44, 0xcc, 0x7f, 0x64, 0xe0, 0xce, 0x5e, 0x27,
-	0x70, 0x69, 0xd0, 0x3e, 0x89, 0x5c, 0xcc, 0xc9, 0xd3, 0x2d, 0xf4, 0x1d, 0x98, 0x8b, 0x48, 0x1c,
-	0x11, 0xde, 0xc1, 0x9e, 0x4d, 0xdd, 0x72, 0x66, 0x3d, 0xb3, 0x51, 0xb0, 0x66, 0x53, 0x5a, 0xdd,
-	0x45, 0xef, 0x41, 0xa9, 0xa5, 0xa4, 0xec, 0x0b, 0xec, 0x75, 0x88, 0x1d, 0x45, 0x7e, 0x39, 0xbb,
-	0x9e, 0xd9, 0x98, 0xb4, 0xee, 0x68, 0xc6, 0x53, 0x41, 0x6f, 0x44, 0x3e, 0xf2, 0xa1, 0x90, 0xec,
-	0x95, 0x26, 0x95, 0x73, 0xeb, 0x99, 0x8d, 0xb9, 0xea, 0xfe, 0x57, 0x2f, 0xef, 0x4f, 0xfc, 0xe7,
-	0xe5, 0xfd, 0x1f, 0xb7, 0x29, 0x3f, 0xeb, 0x9c, 0x56, 0x9c, 0xd0, 0xdf, 0xec, 0xb3, 0xff, 0xe2,
-	0xd1, 0x87, 0xce, 0x19, 0xa6, 0x41, 0xd7, 0x01, 0x97, 0x5f, 0x46, 0x84, 0x55, 0x8e, 0x49, 0x4c,
-	0xb1, 0x47, 0x5f, 0xe0, 0x53, 0x8f, 0xd4, 0x03, 0x6e, 0xcd, 0x69, 0xf8, 0xba, 0x40, 0x37, 0x7f,
-	0x97, 0x85, 0x79, 0xed, 0x51, 0x4d, 0xa4, 0xe9, 0xe9, 0x16, 0x3a, 0x80, 0xa9, 0x8e, 0x74, 0x8e,
-	0x95, 0x33, 0xeb, 0xb9, 0x8d, 0xd9, 0x87, 0x1f, 0x54, 0xae, 0x49, 0x6b, 0xe5, 0x4a, 0x3c, 0xaa,
-	0x86, 0xb0, 0xd4, 0x4a, 0x20, 0xd0, 0x2e, 0x18, 0xc2, 0x0e, 0xe9, 0xee, 0xfc, 0xc3, 0x07, 0xe3,
-	0x40, 0x69, 0x43, 0x2a, 0xcd, 0xcb, 0x88, 0x58, 0x52, 0xda, 0xf4, 0xc1, 0x10, 0x2b, 0xb4, 0x00,
-	0xc5, 0xe6, 0x67, 0x8d, 0x9a, 0x7d, 0x72, 0x74, 0xdc, 0xa8, 0xed, 0xd4, 0xf7, 0xea, 0xb5, 0xdd,
-	0xe2, 0x04, 0xba, 0x07, 0x77, 0x25, 0xb5, 0x61, 0xd5, 0x0e, 0xeb, 0x27, 0x87, 0xf6, 0xf1, 0xf6,
-	0x61, 0xe3, 0xa0, 0x56, 0xcc, 0xa0, 0xfb, 0xb0, 0x2a, 0x19, 0x7b, 0x27, 0x47, 0xbb, 0xf5, 0xa3,
-	0x8f, 0x6d, 0x6b, 0xbb, 0x59, 0xb3, 0xb7, 0x8f, 0x76, 0xed, 0xfa, 0xd1, 0x6e, 0xed, 0xd3, 0x62,
-	0x16, 0x2d, 0x42, 0xa9, 0x4f, 0xf2, 0xe9, 0x93, 0x66, 0xad, 0x98, 0x33, 0xff, 0x9e, 0x85, 0xc2,
-	0x21, 0x8e, 0xcf, 0x09, 0x4f, 0x82, 0xb2, 0x0a, 0x33, 0xbe, 0x24, 0x74, 0x53, 0x3c, 0xad, 0x08,
-	0x75, 0x17, 0x3d, 0x83, 0xb9, 0x28, 0xa6, 0x0e, 0xb1, 0x95, 0xd3, 0xd2, 0xd7, 0xd9, 0x87, 0xdf,
-	0xbf, 0xd6, 0x57, 0x05, 0xdf, 0x10, 0x62, 0x2a, 0x74, 0x5a, 0xd3, 0xfe, 0x84, 0x35, 0x1b, 0x75,
-	0xa9, 0xe8, 0x13, 0x28, 0x68, 0xc5, 0x4e, 0x4c, 0x04, 0x78, 0x4e, 0x82, 0x3f, 0x18, 0x03, 0x7c,
-	0x47, 0x0a, 0x74, 0x71, 0xe7, 0xfc, 0x1e, 0x72, 0x0f, 0xb0, 0x1f, 0xba, 0xb4, 0x75, 0x59, 0x36,
-	0xc6, 0x06, 0x3e, 0x94, 0x02, 0x03, 0xc0, 0x8a, 0x5c, 0x9d, 0x82, 0x49, 0xb9, 0xdb, 0x7c, 0x0c,
-	0xe5, 0x51, 0x5e, 0xa2, 0x0a, 0xdc, 0x55, 0x21, 0xfb, 0x05, 0xe5, 0x67, 0x36, 0x79, 0x1e, 0x85,
-	0x01, 0x09, 0xb8, 0x8c, 0xac, 0x61, 0x95, 0x24, 0xeb, 0x13, 0xca, 0xcf, 0x6a, 0x9a, 0x61, 0x7e,
-	0x0a, 0x25, 0x85, 0x55, 0xc5, 0x2c, 0x05, 0x41, 0x60, 0x44, 0x98, 0xc6, 0x52, 0x6a, 0xc6, 0x92,
-	0xbf, 0xd1, 0x26, 0x2c, 0xf8, 0x34, 0xb0, 0x15, 0xb8, 0x73, 0x86, 0x83, 0x76, 0xf7, 0xb8, 0x15,
-	0xac, 0x92, 0x4f, 0x03, 0x69, 0xcd, 0x8e, 0xe4, 0x34, 0x22, 0xdf, 0xec, 0xc0, 0xdd, 0x21, 0xe1,
-	0x42, 0x55, 0x30, 0x4e, 0x31, 0x23, 0x12, 0x7b, 0xf6, 0x61, 0x65, 0x8c, 0xa8, 0xf4, 0x58, 0x66,
-	0x49, 0x59, 0xb4, 0x02, 0xd3, 0xa9, 0x67, 0x42, 0x7f, 0xc9, 0x4a, 0xd7, 0xe6, 0x67, 0x89, 0xda,
-	0xbe, 0x60, 0xde, 0x86, 0x5a, 0xf3, 0xcf, 0x19, 0x28, 0x1c, 0x87, 0x9d, 0xd8, 0x21, 0x4f, 0x5a,
-	0xe2, 0x48, 0x31, 0xf4, 0x33, 0x28, 0x74, 0xef, 0xb2, 0xa4, 0x82, 0x47, 0x56, 0x68, 0x4a, 0xb8,
-	0xd8, 0xaa, 0xd4, 0x15, 0xed, 0x38, 0x95, 0xae, 0xbb, 0x22, 0xe1, 0xac, 0x67, 0x8d, 0x1e, 0xc1,
-	0x14, 0x76, 0xdd, 0x98, 0x30, 0x26, 0xbd, 0x9c, 0xa9, 0x96, 0xff, 0xf9, 0xd7, 0x0f, 0x17, 0xf4,
-	0x05, 0xbf, 0xad, 0x38, 0xc7, 0x3c, 0xa6, 0x41, 0x7b, 0x7f, 0xc2, 0x4a, 0xb6, 0x56, 0xa7, 0x21,
-	0xcf, 0xa4, 0x91, 0xe6, 0x9f, 0x72, 0x70, 0xa7, 0x19, 0xe3, 0x80, 0xb5, 0x48, 0x9c, 0xc4, 0xa1,
-	0x0d, 0x0b, 0x8c, 0x04, 0x2e, 0x89, 0xed, 0xdb, 0x33, 0xdc, 0x42, 0x0a, 0xb2, 0x97, 0x86, 0x7c,
-	0xb8, 0x17, 0x13, 0x87, 0x46, 0x94, 0x04, 0xfc, 0x8a, 0xae, 0xec, 0x4d, 0x74, 0x2d, 0xa6, 0xa8,
-	0x7d, 0xea, 0x96, 0x61, 0x1a, 0x33, 0xa6, 0xae, 0x91, 0x9c, 0x2c, 0xc9, 0x29, 0xb9, 0xae, 0xbb,
-	0x68, 0x09, 0xf2, 0xd8, 0x17, 0xdb, 0xe4, 0x49, 0x34, 0x2c, 0xbd, 0x42, 0x55, 0xc8, 0x2b, 0xbb,
-	0xcb, 0x93, 0xd2, 0xa0, 0xf7, 0xae, 0x2d, 0x8a, 0xbe, 0xc4, 0x5b, 0x5a, 0x12, 0xed, 0xc3, 0x4c,
-	0x6a, 0x4f, 0x39, 0xff, 0xd6, 0x30, 0x5d, 0x61, 0xf3, 0x5f, 0x39, 0x28, 0x3e, 0x89, 0x5d, 0x12,
-	0xef, 0x51, 0xcf, 0x4b, 0xb2, 0x75, 0x02, 0xb3, 0x3e, 0x3e, 0x27, 0xb1, 0x1d, 0x0a, 0xce, 0xf5,
-	0xc5, 0x3b, 0x24, 0x70, 0x12, 0x4f, 0x37, 0x0e, 0x90, 0x40, 0x92, 0x82, 0xf6, 0x60, 0x52, 0x01,
-	0x66, 0xdf, 0x05, 0x70, 0x7f, 0xc2, 0x52, 0xe2, 0xe8, 0x73, 0x28, 0x79, 0xf4, 0x8b, 0x0e, 0x75,
-	0x31, 0xa7, 0x61, 0xa0, 0x8d, 0x54, 0xd7, 0xdd, 0xe6, 0xb5, 0x51, 0x38, 0xe8, 0x4a, 0x49, 0x48,
-	0x79, 0xdb, 0x15, 0xbd, 0x2b, 0x54, 0x74, 0x1f, 0x66, 0x5b, 0xd4, 0xf3, 0x6c, 0x9d, 0xbe, 0x9c,
-	0x4c, 0x1f, 0x08, 0xd2, 0xb6, 0x4a, 0xa1, 0xec, 0x1e, 0x22, 0x3e, 0x2d, 0x42, 0x64, 0x16, 0x91,
-	0xe8, 0x1e, 0xe7, 0x24, 0xde, 0x23, 0x44, 0x30, 0x79, 0xca, 0xcc, 0x2b, 0x26, 0x4f, 0x98, 0x1f,
-	0x00, 0xe2, 0x21, 0xc7, 0x9e, 0x2d, 0xd0, 0x88, 0x6b, 0x4b, 0xa9, 0xf2, 0x94, 0xd4, 0x50, 0x94,
-	0x9c, 0x3d, 0xc9, 0x38, 0x14, 0xf4, 0x81, 0xdd, 0x12, 0xa6, 0x3c, 0x3d, 0xb0, 0xbb, 0x29, 0xe8,
-	0xd5, 0x02, 0xcc, 0xf2, 0x6e, 0xd6, 0xcc, 0xbf, 0x65, 0xe1, 0xee, 0x2e, 0xf1, 0xc8, 0x05, 0x89,
-	0x71, 0xbb, 0x67, 0x1e, 0xf8, 0x29, 0x40, 0xe2, 0x31, 0xb9, 0xd9, 0x01, 0x4c, 0x52, 0xdc, 0x85,
-	0x13, 0xe0, 0x61, 0xab, 0xc5, 0x08, 0xe7, 0x34, 0x68, 0xdf, 0xe8, 0xc4, 0x25, 0xe0, 0x5d, 0xb8,
-	0x81, 0xd1, 0x2c, 0x37, 0x38, 0x9a, 0x5d, 0x49, 0x9d, 0x31, 0x90, 0xba, 0x05, 0x98, 0x94, 0xbd,
-	0x44, 0xa6, 0xcd, 0xb0, 0xd4, 0x02, 0x2d, 0x42, 0x9e, 0x32, 0xfb, 0xb4, 0x73, 0x29, 0x13, 0x36,
-	0x6d, 0x4d, 0x52, 0x56, 0xed, 0x5c, 0x9a, 0xbf, 0xce, 0x02, 0x1a, 0xac, 0x99, 0x6f, 0x36, 0x82,
-	0xeb, 0x30, 0x27, 0x86, 0x5a, 0x5b, 0x74, 0xbf, 0xe4, 0xd6, 0x2a, 0x58, 0x20, 0x68, 0x0d, 0x4c,
-	0xe3, 0xba, 0x3b, 0x4e, 0x18, 0xbe, 0x0d, 0xa0, 0x0a, 0x87, 0xd1, 0x17, 0x44, 0x47, 0x61, 0x46,
-	0x52, 0x8e, 0xe9, 0x8b, 0x5e, 0x77, 0x27, 0x7b, 0xdc, 0x15, 0xfd, 0x8d, 0x75, 0x4e, 0x39, 0x75,
-	0xce, 0x99, 0x8c, 0x83, 0x61, 0xa5, 0x6b, 0xf3, 0x7f, 0x59, 0xb8, 0xd7, 0xb5, 0xbc, 0xbf, 0xf9,
-	0x3f, 0xbb, 0xcd, 0x76, 0x74, 0xa5, 0x19, 0xbd, 0x80, 0x55, 0x35, 0x85, 0xb9, 0x76, 0xd7, 0xe9,
-	0x28, 0x64, 0x54, 0x24, 0x84, 0x95, 0x73, 0x72, 0xa2, 0xfd, 0xe1, 0xd8, 0x9a, 0x1a, 0x09, 0x46,
-	0x43, 0x43, 0x58, 0xcb, 0x1a, 0x7e, 0x80, 0xc3, 0x50, 0x00, 0xf7, 0x12, 0xdd, 0xea, 0x92, 0xef,
-	0xea, 0x35, 0xa4, 0xde, 0x1f, 0x8c, 0xad, 0x77, 0x5b, 0xc8, 0xa7, 0x3a, 0x17, 0x35, 0x6c, 0x1f,
-	0x95, 0x3d, 0x36, 0xa6, 0xb3, 0xc5, 0x9c, 0xf9, 0x07, 0x80, 0x85, 0x63, 0x8e, 0x39, 0x69, 0x75,
-	0x3c, 0x59, 0x71, 0x49, 0x98, 0x7d, 0x98, 0x95, 0x27, 0xdb, 0x8e, 0x3c, 0xec, 0x24, 0x23, 0xc5,
-	0xe3, 0xeb, 0xaf, 0xfd, 0x21, 0x38, 0xfd, 0xc4, 0x86, 0xc0, 0xf2, 0x93, 0xc9, 0x0f, 0xc2, 0x94,
-	0x86, 0x42, 0x28, 0x28, 0x75, 0xfa, 0x69, 0xa6, 0x6f, 0xd8, 0xfd, 0x1b, 0x2a, 0xb4, 0x14, 0x9a,
-	0x1a, 0x34, 0xc3, 0x1e, 0x0a, 0xfa, 0x4d, 0x06, 0x56, 0x9d, 0x30, 0x70, 0x65, 0x34, 0xb0, 0x67,
-	0xf7, 0x38, 0x2b, 0x0c, 0xd4, 0xed, 0xf2, 0xf0, 0xed, 0xf5, 0xef, 0x74, 0x41, 0x87, 0xf8, 0xbc,
-	0xec, 0x8c, 0x62, 0x8f, 0xb0, 0x88, 0xc7, 0xb4, 0xdd, 0x26, 0x31, 0x71, 0x75, 0xe7, 0xbd, 0x05,
-	0x8b, 0x9a, 0x09, 0xe4, 0x70, 0x8b, 0x52, 0x36, 0xfa, 0x55, 0x06, 0x96, 0xbd, 0x30, 0x68, 0xdb,
-	0x9c, 0xc4, 0xfe, 0x40, 0x84, 0xa6, 0xde, 0xb5, 0x24, 0x0e, 0xc2, 0xa0, 0xdd, 0x24, 0xb1, 0x3f,
-	0x24, 0x3c, 0x4b, 0xde, 0x50, 0xde, 0xca, 0xcf, 0xa1, 0x3c, 0xaa, 0x90, 0xd0, 0x6e, 0xd2, 0xe8,
-	0xdf, 0x69, 0x72, 0xd0, 0x6d, 0x7e, 0xe5, 0xcb, 0x0c, 0x2c, 0x0d, 0x2f, 0x1d, 0xf4, 0x0c, 0x8a,
-	0xb2, 0x2a, 0x89, 0xab, 0x63, 0x90, 0x5e, 0x3a, 0x0f, 0xde, 0x4e, 0x57, 0xdd, 0xb5, 0xe6, 0x35,
-	0x92, 0x5e, 0xa3, 0x8f, 0x21, 0xaf, 0x3e, 0x42, 0xe8, 0x37, 0xee, 0x88, 0x91, 0x42, 0x7d, 0xb7,
-	0xa8, 0xf4, 0x1a, 0x66, 0x49, 0x31, 0x4b, 0x8b, 0xaf, 0x38, 0xb0, 0x7a, 0x4d, 0xe5, 0xdd, 0x52,
-	0x90, 0x7e, 0x39, 0xa8, 0xa4, 0xa7, 0x98, 0xd0, 0xe7, 0x80, 0xd2, 0x72, 0xbd, 0x79, 0xa8, 0x8a,
-	0x29, 0x96, 0xa6, 0x88, 0x2a, 0x18, 0x55, 0x3b, 0xb7, 0xe3, 0x60, 0xfa, 0xfc, 0x54, 0xb7, 0xe3,
-	0x63, 0x63, 0x3a, 0x57, 0x34, 0xcc, 0x3f, 0x66, 0x00, 0xc9, 0xcb, 0xb3, 0xff, 0x91, 0x37, 0x0f,
-	0xd9, 0xf4, 0x39, 0x9f, 0xa5, 0x72, 0x04, 0x67, 0x97, 0xfe, 0x69, 0xe8, 0xa9, 0x87, 0x8c, 0xa5,
-	0x57, 0xa2, 0x3d, 0x9e, 0x61, 0x66, 0xab, 0x67, 0xae, 0xec, 0x9f, 0xd3, 0xd6, 0xcc, 0x19, 0x66,
-	0xea, 0x05, 0xd6, 0xff, 0x71, 0xc0, 0xb8, 0xf2, 0x71, 0xe0, 0x7d, 0x28, 0x61, 0x1e, 0xfa, 0xd4,
-	0xb1, 0x63, 0xc2, 0x42, 0xaf, 0x23, 0x02, 0x2f, 0xaf, 0xa6, 0x92, 0x55, 0x54, 0x0c, 0x2b, 0xa5,
-	0x9b, 0x5f, 0xe6, 0xe0, 0x5b, 0x69, 0x63, 0x19, 0xf6, 0x2c, 0xbd, 0x6a, 0xf1, 0x9b, 0xbb, 0xff,
-	0x12, 0xe4, 0x45, 0x47, 0x26, 0xb1, 0xb4, 0x7b, 0xc6, 0xd2, 0xab, 0xeb, 0x8d, 0xde, 0x87, 0x3c,
-	0xe3, 0x98, 0x77, 0x98, 0xb4, 0x74, 0x7e, 0x9c, 0xd4, 0xef, 0x68, 0x95, 0xc7, 0x52, 0xce, 0xd2,
-	0xf2, 0xe8, 0x47, 0xb0, 0xfa, 0x45, 0x07, 0x07, 0xbc, 0xe3, 0xdb, 0x4e, 0x18, 0x5c, 0x90, 0x98,
-	0x89, 0x11, 0x3c, 0x7d, 0x16, 0xe7, 0x65, 0x20, 0x96, 0xf5, 0x96, 0x9d, 0x74, 0x47, 0xf2, 0xf0,
-	0x1f, 0x1e, 0xbe, 0xa9, 0xe1, 0xe1, 0x43, 0xef, 0x41, 0x29, 0x19, 0x40, 0x44, 0xf7, 0xb7, 0xc5,
-	0x2f, 0x39, 0xfe, 0x16, 0xac, 0x3b, 0x09, 0xa3, 0x41, 0xe2, 0x26, 0x75, 0xce, 0xc5, 0xac, 0xcc,
-	0x38, 0x89, 0x6c, 0xf1, 0x64, 0xb6, 0xb5, 0x7e, 0x56, 0x9e, 0x51, 0xb3, 0xb2, 0xe0, 0x88, 0x87,
-	0xf5, 0x4f, 0x34, 0x1d, 0x7d, 0x17, 0xe6, 0xd5, 0xcc, 0x45, 0xf9, 0xa5, 0xcd, 0x29, 0x89, 0xcb,
-	0x20, 0x61, 0x0b, 0x29, 0xb5, 0x49, 0x49, 0x6c, 0xbe, 0xcc, 0xc0, 0xca, 0x41, 0x2f, 0xe5, 0x24,
-	0x62, 0x24, 0xe6, 0xa3, 0xb2, 0x87, 0xc0, 0x08, 0xb0, 0x4f, 0x74, 0xb5, 0xc9, 0xdf, 0xc2, 0x2e,
-	0x1a, 0x50, 0x4e, 0xb1, 0x27, 0xea, 0xad, 0x4d, 0x03, 0xf9, 0xf9, 0x42, 0xcd, 0x6c, 0x45, 0xcd,
-	0x39, 0x94, 0x8c, 0x46, 0xe4, 0xa3, 0x8f, 0xa0, 0xec, 0x63, 0x1a, 0x70, 0x12, 0xe0, 0xc0, 0x21,
-	0x76, 0x2b, 0xc6, 0x8e, 0x7c, 0xe3, 0x08, 0x19, 0x95, 0xd4, 0xa5, 0x1e, 0xfe, 0x9e, 0x66, 0x0b,
-	0xc9, 0x47, 0xb0, 0x24, 0x5d, 0x4f, 0x66, 0x14, 0x3b, 0x08, 0xd5, 0x9d, 0xa0, 0x27, 0xdd, 0x05,
-	0xc1, 0x4d, 0x66, 0x8d, 0x23, 0xcd, 0x33, 0x7f, 0x9f, 0x85, 0x45, 0x35, 0xcc, 0x25, 0xf9, 0x4e,
-	0x7c, 0xbb, 0x5a, 0x89, 0x99, 0x81, 0x4a, 0xec, 0x16, 0x55, 0xf6, 0x9b, 0x2d, 0xaa, 0xdc, 0x9b,
-	0x8a, 0x6a, 0x68, 0x9d, 0x18, 0x6f, 0x53, 0x27, 0x93, 0xc3, 0xeb, 0xc4, 0xfc, 0x4b, 0x06, 0x96,
-	0x54, 0x7c, 0xd2, 0x63, 0x7c, 0xcd, 0x65, 0xa3, 0x0f, 0x66, 0x76, 0xf4, 0xc1, 0xcc, 0x8d, 0x73,
-	0x9b, 0x18, 0x23, 0x8e, 0xc3, 0x60, 0xd1, 0x4e, 0x0e, 0x29, 0xda, 0xaa, 0xf5, 0xd5, 0xab, 0xb5,
-	0xcc, 0xd7, 0xaf, 0xd6, 0x32, 0xff, 0x7d, 0xb5, 0x96, 0xf9, 0xed, 0xeb, 0xb5, 0x89, 0xaf, 0x5f,
-	0xaf, 0x4d, 0xfc, 0xfb, 0xf5, 0xda, 0xc4, 0xb3, 0x8f, 0xc6, 0xff, 0xda, 0xdc, 0xff, 0x6f, 0x81,
-	0xd3, 0xbc, 0x64, 0x7c, 0xef, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x9d, 0xde, 0x80, 0x3c,
-	0x18, 0x00, 0x00,
+	// 2087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0xf0, 0x25, 0xaa, 0x28, 0x5a, 0x54, 0x5b, 0x96, 0x29, 0x29, 0x91, 0xb5, 0x03, 0x04,
+	0xf0, 0xbe, 0x28, 0x5b, 0x71, 0x82, 0x45, 0x0e, 0x41, 0x44, 0x89, 0x5a, 0xd1, 0x91, 0x64, 0x66,
+	0x48, 0x79, 0x77, 0x9d, 0x60, 0x27, 0xa3, 0x99, 0x26, 0xd5, 0xd0, 0xbc, 0x76, 0xba, 0xa9, 0x58,
+	0x06, 0x72, 0x4e, 0x6e, 0xc9, 0x39, 0x40, 0x90, 0x53, 0x2e, 0x01, 0x72, 0x08, 0x90, 0xeb, 0x06,
+	0x01, 0x72, 0xd9, 0x5b, 0x16, 0xb9, 0xe4, 0x71, 0x30, 0x16, 0xf6, 0x21, 0x7f, 0x23, 0xe8, 0xc7,
+	0x0c, 0x49, 0xf1, 0x61, 0xda, 0xd2, 0xe6, 0xc4, 0xe9, 0xaa, 0xae, 0xaf, 0xaa, 0xab, 0xaa, 0xbb,
+	0xaa, 0x9b, 0x70, 0xd7, 0xb9, 0x70, 0x9e, 0x86, 0x51, 0xc0, 0x02, 0x3b, 0x70, 0x37, 0x89, 0xef,
+	0xe0, 0xa7, 0x38, 0xda, 0xc4, 0xe7, 0xd8, 0x67, 0x54, 0xfd, 0x54, 0x04, 0x1b, 0xad, 0xf5, 0xcf,
+	0xac, 0xa8, 0x99, 0x15, 0x39, 0x65, 0x75, 0xc5, 0x0e, 0xa8, 0x17, 0x50, 0x53, 0xf0, 0x37, 0xe5,
+	0x40, 0xca, 0xad, 0x2e, 0x75, 0x82, 0x4e, 0x20, 0xe9, 0xfc, 0x4b, 0x51, 0xef, 0x8d, 0xd4, 0x4b,
+	0x4f, 0xad, 0x08, 0x3b, 0x9b, 0x11, 0xf6, 0x82, 0x73, 0xcb, 0x35, 0x23, 0x6c, 0xd1, 0xc0, 0x57,
+	0x12, 0xef, 0x8e, 0x94, 0x48, 0x08, 0xe7, 0xf7, 0x37, 0x6d, 0x37, 0x38, 0x51, 0x93, 0xef, 0xbf,
+	0x72, 0x32, 0xed, 0x9e, 0x58, 0xb6, 0x1d, 0x74, 0x7d, 0x26, 0x45, 0xf4, 0xbf, 0x6b, 0xb0, 0xb0,
+	0xd7, 0xf5, 0x1d, 0xe2, 0x77, 0x8e, 0x43, 0xc7, 0x62, 0xf8, 0xf1, 0x7d, 0xf4, 0x16, 0xcc, 0x87,
+	0x38, 0x0a, 0x31, 0xeb, 0x5a, 0xae, 0x49, 0x9c, 0xb2, 0xb6, 0xa1, 0xdd, 0x2d, 0x1a, 0x85, 0x84,
+	0x56, 0x77, 0xd0, 0x3b, 0xb0, 0xd8, 0x96, 0x52, 0xe6, 0xb9, 0xe5, 0x76, 0xb1, 0x19, 0x86, 0x5e,
+	0x39, 0xb5, 0xa1, 0xdd, 0xcd, 0x1a, 0x0b, 0x8a, 0xf1, 0x98, 0xd3, 0x1b, 0xa1, 0x87, 0x3c, 0x28,
+	0xc6, 0x73, 0x85, 0x49, 0xe5, 0xf4, 0x86, 0x76, 0x77, 0xbe, 0xba, 0xff, 0xc5, 0xf3, 0x3b, 0x33,
+	0xff, 0x79, 0x7e, 0xe7, 0x07, 0x1d, 0xc2, 0x4e, 0xbb, 0x27, 0x15, 0x3b, 0xf0, 0x36, 0x07, 0xec,
+	0x3f, 0x7f, 0xf0, 0xbe, 0x7d, 0x6a, 0x11, 0xbf, 0xb7, 0x00, 0x87, 0x5d, 0x84, 0x98, 0x56, 0x9a,
+	0x38, 0x22, 0x96, 0x4b, 0x9e, 0x59, 0x27, 0x2e, 0xae, 0xfb, 0xcc, 0x98, 0x57, 0xf0, 0x75, 0x8e,
+	0xae, 0xff, 0x25, 0x05, 0x37, 0xd4, 0x8a, 0x6a, 0x3c, 0x4c, 0x8f, 0xef, 0xa3, 0x03, 0x98, 0xed,
+	0x8a, 0xc5, 0xd1, 0xb2, 0xb6, 0x91, 0xbe, 0x5b, 0xd8, 0x7a, 0xaf, 0x32, 0x21, 0xac, 0x95, 0x4b,
+	0xfe, 0xa8, 0x66, 0xb8, 0xa5, 0x46, 0x0c, 0x81, 0x76, 0x21, 0xc3, 0xed, 0x10, 0xcb, 0xbd, 0xb1,
+	0x75, 0x6f, 0x1a, 0x28, 0x65, 0x48, 0xa5, 0x75, 0x11, 0x62, 0x43, 0x48, 0xa3, 0xb7, 0xa1, 0x44,
+	0x7c, 0x86, 0x23, 0x1e, 0x71, 0x8a, 0xed, 0xc0, 0x77, 0xa8, 0x70, 0x4c, 0xd1, 0x58, 0x88, 0xe9,
+	0x4d, 0x49, 0xd6, 0x3d, 0xc8, 0x70, 0x41, 0xb4, 0x04, 0xa5, 0xd6, 0x27, 0x8d, 0x9a, 0x79, 0x7c,
+	0xd4, 0x6c, 0xd4, 0x76, 0xea, 0x7b, 0xf5, 0xda, 0x6e, 0x69, 0x06, 0xdd, 0x86, 0x9b, 0x82, 0xda,
+	0x30, 0x6a, 0x87, 0xf5, 0xe3, 0x43, 0xb3, 0xb9, 0x7d, 0xd8, 0x38, 0xa8, 0x95, 0x34, 0x74, 0x07,
+	0xd6, 0x04, 0x63, 0xef, 0xf8, 0x68, 0xb7, 0x7e, 0xf4, 0xa1, 0x69, 0x6c, 0xb7, 0x6a, 0xe6, 0xf6,
+	0xd1, 0xae, 0x59, 0x3f, 0xda, 0xad, 0x7d, 0x5c, 0x4a, 0xa1, 0x5b, 0xb0, 0x38, 0x20, 0xf9, 0xf8,
+	0x51, 0xab, 0x56, 0x4a, 0xeb, 0x7f, 0x4b, 0x41, 0xf1, 0xd0, 0x8a, 0xce, 0x30, 0x8b, 0xfd, 0xb7,
+	0x06, 0x73, 0x9e, 0x20, 0xf4, 0xb2, 0x21, 0x2f, 0x09, 0x75, 0x07, 0x3d, 0x81, 0xf9, 0x30, 0x22,
+	0x36, 0x36, 0xa5, 0x7f, 0x84, 0x5b, 0x0a, 0x5b, 0xdf, 0x99, 0xe8, 0x16, 0x09, 0xdf, 0xe0, 0x62,
+	0xd2, 0xcb, 0x4a, 0xd3, 0xfe, 0x8c, 0x51, 0x08, 0x7b, 0x54, 0xf4, 0x11, 0x14, 0x95, 0x62, 0x3b,
+	0xc2, 0x1c, 0x3c, 0x2d, 0xc0, 0xef, 0x4d, 0x01, 0xbe, 0x13, 0xe1, 0x01, 0xdc, 0x79, 0xaf, 0x8f,
+	0xdc, 0x07, 0xec, 0x05, 0x0e, 0x69, 0x5f, 0x94, 0x33, 0x53, 0x03, 0x1f, 0x0a, 0x81, 0x21, 0x60,
+	0x49, 0xae, 0xce, 0x42, 0x56, 0xcc, 0xd6, 0x1f, 0x42, 0x79, 0xdc, 0x2a, 0x51, 0x05, 0x6e, 0x4a,
+	0x97, 0xfd, 0x8c, 0xb0, 0x53, 0x13, 0x3f, 0x0d, 0x03, 0x1f, 0xfb, 0x4c, 0x78, 0x36, 0x63, 0x2c,
+	0x0a, 0xd6, 0x47, 0x84, 0x9d, 0xd6, 0x14, 0x43, 0xff, 0x18, 0x16, 0x25, 0x56, 0xd5, 0xa2, 0x09,
+	0x08, 0x82, 0x4c, 0x68, 0x91, 0x48, 0x48, 0xcd, 0x19, 0xe2, 0x1b, 0x6d, 0xc2, 0x92, 0x47, 0x7c,
+	0x53, 0x82, 0xdb, 0xa7, 0x96, 0xdf, 0xe9, 0xed, 0xcc, 0xa2, 0xb1, 0xe8, 0x11, 0x5f, 0x58, 0xb3,
+	0x23, 0x38, 0x8d, 0xd0, 0xd3, 0xbb, 0x70, 0x73, 0x84, 0xbb, 0x50, 0x15, 0x32, 0x27, 0x16, 0xc5,
+	0x02, 0xbb, 0xb0, 0x55, 0x99, 0xc2, 0x2b, 0x7d, 0x96, 0x19, 0x42, 0x16, 0xad, 0x42, 0x3e, 0x59,
+	0x19, 0xd7, 0xbf, 0x68, 0x24, 0x63, 0xfd, 0x93, 0x58, 0xed, 0x80, 0x33, 0xaf, 0x43, 0xad, 0xfe,
+	0x47, 0x0d, 0x8a, 0xcd, 0xa0, 0x1b, 0xd9, 0xf8, 0x51, 0x9b, 0xef, 0x3e, 0x8a, 0x7e, 0x02, 0xc5,
+	0xde, 0xb1, 0x17, 0x67, 0xf0, 0xd8, 0x0c, 0x4d, 0x08, 0xe7, 0xf7, 0x2b, 0x75, 0x49, 0x6b, 0x26,
+	0xd2, 0x75, 0x87, 0x07, 0x9c, 0xf6, 0x8d, 0xd1, 0x03, 0x98, 0xb5, 0x1c, 0x27, 0xc2, 0x94, 0x8a,
+	0x55, 0xce, 0x55, 0xcb, 0xff, 0xf8, 0xf3, 0xfb, 0x4b, 0xaa, 0x16, 0x6c, 0x4b, 0x4e, 0x93, 0x45,
+	0xc4, 0xef, 0xec, 0xcf, 0x18, 0xf1, 0xd4, 0x6a, 0x1e, 0x72, 0x54, 0x18, 0xa9, 0xff, 0x21, 0x0d,
+	0x0b, 0xad, 0xc8, 0xf2, 0x69, 0x1b, 0x47, 0xb1, 0x1f, 0x3a, 0xb0, 0x44, 0xb1, 0xef, 0xe0, 0xc8,
+	0xbc, 0x3e, 0xc3, 0x0d, 0x24, 0x21, 0xfb, 0x69, 0xc8, 0x83, 0xdb, 0x11, 0xb6, 0x49, 0x48, 0xb0,
+	0xcf, 0x2e, 0xe9, 0x4a, 0x5d, 0x45, 0xd7, 0xad, 0x04, 0x75, 0x40, 0xdd, 0x0a, 0xe4, 0x2d, 0x4a,
+	0xe5, 0x31, 0x22, 0xcf, 0xba, 0x59, 0x31, 0xae, 0x3b, 0x68, 0x19, 0x72, 0x96, 0xc7, 0xa7, 0x89,
+	0x9d, 0x98, 0x31, 0xd4, 0x08, 0x55, 0x21, 0x27, 0xed, 0x2e, 0x67, 0x85, 0x41, 0xef, 0x4c, 0x4c,
+	0x8a, 0x81, 0xc0, 0x1b, 0x4a, 0x12, 0xed, 0xc3, 0x5c, 0x62, 0x4f, 0x39, 0xf7, 0xda, 0x30, 0x3d,
+	0x61, 0xfd, 0x9f, 0x69, 0x28, 0x3d, 0x8a, 0x1c, 0x1c, 0xed, 0x11, 0xd7, 0x8d, 0xa3, 0x75, 0x0c,
+	0x05, 0xcf, 0x3a, 0xc3, 0x91, 0x19, 0x70, 0xce, 0xe4, 0xe4, 0x1d, 0xe1, 0x38, 0x81, 0xa7, 0x6a,
+	0x0c, 0x08, 0x20, 0x41, 0x41, 0x7b, 0x90, 0x95, 0x80, 0xa9, 0x37, 0x01, 0xdc, 0x9f, 0x31, 0xa4,
+	0x38, 0xfa, 0x14, 0x16, 0x5d, 0xf2, 0x59, 0x97, 0x38, 0x16, 0x23, 0x81, 0xaf, 0x8c, 0x94, 0xc7,
+	0xdd, 0xe6, 0x44, 0x2f, 0x1c, 0xf4, 0xa4, 0x04, 0xa4, 0x38, 0xed, 0x4a, 0xee, 0x25, 0x2a, 0xba,
+	0x03, 0x85, 0x36, 0x71, 0x5d, 0x53, 0x85, 0x2f, 0x2d, 0xc2, 0x07, 0x9c, 0xb4, 0x2d, 0x43, 0x28,
+	0xaa, 0x07, 0xf7, 0x4f, 0x1b, 0x63, 0x11, 0x45, 0xc4, 0xab, 0xc7, 0x19, 0x8e, 0xf6, 0x30, 0xe6,
+	0x4c, 0x96, 0x30, 0x73, 0x92, 0xc9, 0x62, 0xe6, 0x7b, 0x80, 0x58, 0xc0, 0x2c, 0xd7, 0xe4, 0x68,
+	0xd8, 0x31, 0x85, 0x54, 0x79, 0x56, 0x68, 0x28, 0x09, 0xce, 0x9e, 0x60, 0x1c, 0x72, 0xfa, 0xd0,
+	0x6c, 0x01, 0x53, 0xce, 0x0f, 0xcd, 0x6e, 0x71, 0x7a, 0xb5, 0x08, 0x05, 0xd6, 0x8b, 0x9a, 0xfe,
+	0xd7, 0x14, 0xdc, 0xdc, 0xc5, 0x2e, 0x3e, 0xc7, 0x91, 0xd5, 0xe9, 0x6b, 0x1d, 0x7e, 0x0c, 0x10,
+	0xaf, 0x18, 0x5f, 0x6d, 0x03, 0xc6, 0x21, 0xee, 0xc1, 0x71, 0xf0, 0xa0, 0xdd, 0xa6, 0x98, 0x31,
+	0xe2, 0x77, 0xca, 0xa9, 0x6b, 0x00, 0xef, 0xc1, 0x0d, 0x75, 0x71, 0xe9, 0xe1, 0x2e, 0xee, 0x52,
+	0xe8, 0x32, 0x43, 0xa1, 0x5b, 0x82, 0xac, 0xa8, 0x25, 0x22, 0x6c, 0x19, 0x43, 0x0e, 0xd0, 0x2d,
+	0xc8, 0x11, 0x6a, 0x9e, 0x74, 0x2f, 0x44, 0xc0, 0xf2, 0x46, 0x96, 0xd0, 0x6a, 0xf7, 0x42, 0xff,
+	0x65, 0x0a, 0xd0, 0x70, 0xce, 0x7c, 0xbd, 0x1e, 0xdc, 0x80, 0x79, 0xde, 0xff, 0x9a, 0xbc, 0xfa,
+	0xc5, 0xa7, 0x56, 0xd1, 0x00, 0x4e, 0x6b, 0x58, 0x24, 0xaa, 0x3b, 0xd3, 0xb8, 0xe1, 0x9b, 0x00,
+	0x32, 0x71, 0x28, 0x79, 0x86, 0x95, 0x17, 0xe6, 0x04, 0xa5, 0x49, 0x9e, 0xf5, 0x2f, 0x37, 0xdb,
+	0xb7, 0x5c, 0x5e, 0xdf, 0x68, 0xf7, 0x84, 0x11, 0xfb, 0x8c, 0x0a, 0x3f, 0x64, 0x8c, 0x64, 0xac,
+	0xff, 0x37, 0x05, 0xb7, 0x7b, 0x96, 0x0f, 0x16, 0xff, 0x27, 0xd7, 0x59, 0x8e, 0x2e, 0x15, 0xa3,
+	0x67, 0xb0, 0x26, 0xbb, 0x30, 0xc7, 0xec, 0x2d, 0x3a, 0x0c, 0x28, 0xe1, 0x01, 0xe1, 0xfd, 0x25,
+	0x6f, 0x7e, 0xbf, 0x37, 0xb5, 0xa6, 0x46, 0x8c, 0xd1, 0x50, 0x10, 0xc6, 0x8a, 0x82, 0x1f, 0xe2,
+	0x50, 0xe4, 0xc3, 0xed, 0x58, 0xb7, 0x3c, 0xe4, 0x7b, 0x7a, 0x33, 0x42, 0xef, 0x77, 0xa7, 0xd6,
+	0xbb, 0xcd, 0xe5, 0x13, 0x9d, 0xb7, 0x14, 0xec, 0x00, 0x95, 0x3e, 0xcc, 0xe4, 0x53, 0xa5, 0xb4,
+	0xfe, 0xd5, 0x3c, 0x2c, 0x35, 0x99, 0xc5, 0x70, 0xbb, 0xeb, 0x8a, 0x8c, 0x8b, 0xdd, 0xec, 0x41,
+	0x41, 0xec, 0x6c, 0x33, 0x74, 0x2d, 0x3b, 0x6e, 0x29, 0x1e, 0x4e, 0x3e, 0xf6, 0x47, 0xe0, 0x0c,
+	0x12, 0x1b, 0x1c, 0xcb, 0x8b, 0x3b, 0x3f, 0x08, 0x12, 0x1a, 0x0a, 0xa0, 0x28, 0xd5, 0xa9, 0x5b,
+	0x9c, 0x3a, 0x61, 0xf7, 0xaf, 0xa8, 0xd0, 0x90, 0x68, 0xb2, 0xd1, 0x0c, 0xfa, 0x28, 0xe8, 0x57,
+	0x1a, 0xac, 0xd9, 0x81, 0xef, 0x08, 0x6f, 0x58, 0xae, 0xd9, 0xb7, 0x58, 0x6e, 0xa0, 0x2a, 0x97,
+	0x87, 0xaf, 0xaf, 0x7f, 0xa7, 0x07, 0x3a, 0x62, 0xcd, 0x2b, 0xf6, 0x38, 0xf6, 0x18, 0x8b, 0x58,
+	0x44, 0x3a, 0x1d, 0x1c, 0x61, 0xa7, 0x9c, 0xbb, 0x2e, 0x8b, 0x5a, 0x31, 0xe4, 0x68, 0x8b, 0x12,
+	0x36, 0xfa, 0x85, 0x06, 0x2b, 0x6e, 0xe0, 0x77, 0x4c, 0x86, 0x23, 0x6f, 0xc8, 0x43, 0xb3, 0x6f,
+	0x9a, 0x12, 0x07, 0x81, 0xdf, 0x69, 0xe1, 0xc8, 0x1b, 0xe1, 0x9e, 0x65, 0x77, 0x24, 0x0f, 0xfd,
+	0x4e, 0x83, 0xb7, 0xc6, 0xfa, 0x46, 0xdd, 0x9c, 0x1c, 0x51, 0xab, 0x0a, 0x5b, 0xc6, 0xb5, 0x79,
+	0x48, 0x1e, 0x3c, 0xd2, 0x4d, 0xeb, 0xf6, 0xc4, 0x39, 0xab, 0x3f, 0x85, 0xf2, 0xb8, 0x54, 0x47,
+	0xbb, 0x71, 0x2b, 0xf2, 0x46, 0xbd, 0x8d, 0x6a, 0x44, 0x56, 0x3f, 0xd7, 0x60, 0x79, 0x74, 0x72,
+	0xa3, 0x27, 0x50, 0x12, 0xfb, 0x06, 0x3b, 0xca, 0x33, 0xc9, 0xb1, 0x78, 0xef, 0xf5, 0x74, 0xd5,
+	0x1d, 0xe3, 0x86, 0x42, 0x52, 0x63, 0xf4, 0x21, 0xe4, 0xe4, 0x8b, 0x8a, 0xba, 0xb0, 0x8f, 0x69,
+	0x7a, 0xe4, 0x23, 0x4c, 0xa5, 0xdf, 0x30, 0x43, 0x88, 0x19, 0x4a, 0x7c, 0xd5, 0x86, 0xb5, 0x09,
+	0x7b, 0xe3, 0x9a, 0x9c, 0xf4, 0xf3, 0x61, 0x25, 0x7d, 0xe9, 0x8e, 0x3e, 0x05, 0x94, 0x6c, 0xa8,
+	0xab, 0xbb, 0xaa, 0x94, 0x60, 0x29, 0x0a, 0xcf, 0x82, 0x71, 0xd9, 0x7d, 0x4d, 0x0b, 0xfc, 0x8d,
+	0x06, 0x1b, 0xaf, 0x4a, 0x57, 0xf4, 0x43, 0xc8, 0x5f, 0x79, 0x71, 0xb3, 0x81, 0xfc, 0xe0, 0x2f,
+	0x2d, 0xf1, 0x46, 0x4b, 0x0a, 0x76, 0x4a, 0x14, 0xec, 0x05, 0x45, 0x6f, 0x2a, 0x72, 0x72, 0x7b,
+	0x97, 0xc5, 0xe5, 0x61, 0x26, 0x9f, 0x2e, 0x65, 0xf4, 0xdf, 0x6b, 0x80, 0x44, 0xed, 0x19, 0xbc,
+	0x23, 0xdf, 0x80, 0x54, 0xf2, 0x1a, 0x92, 0x22, 0xe2, 0x06, 0x43, 0x2f, 0xbc, 0x93, 0xc0, 0x95,
+	0xf7, 0x40, 0x43, 0x8d, 0x78, 0x77, 0x71, 0x6a, 0x51, 0x53, 0xbe, 0x12, 0x88, 0xf6, 0x23, 0x6f,
+	0xcc, 0x9d, 0x5a, 0x54, 0x5e, 0x60, 0x07, 0xdf, 0x56, 0x32, 0x97, 0xde, 0x56, 0xde, 0x85, 0x45,
+	0x8b, 0x05, 0x1e, 0xb1, 0xcd, 0x08, 0xd3, 0xc0, 0xed, 0x72, 0x9f, 0x89, 0x93, 0x7d, 0xd1, 0x28,
+	0x49, 0x86, 0x91, 0xd0, 0xf5, 0xcf, 0xd3, 0xf0, 0x8d, 0xa4, 0x2e, 0x8f, 0xba, 0xd5, 0x5f, 0xb6,
+	0xf8, 0xd5, 0xcd, 0xd3, 0x32, 0xe4, 0xb8, 0x63, 0x70, 0x24, 0xec, 0x9e, 0x33, 0xd4, 0x68, 0xb2,
+	0xd1, 0xfb, 0x90, 0xa3, 0xcc, 0x62, 0x5d, 0x5a, 0xce, 0x4e, 0x7a, 0x21, 0xeb, 0x0f, 0xdd, 0x8e,
+	0x52, 0xd9, 0x14, 0x72, 0x86, 0x92, 0x47, 0xdf, 0x87, 0xb5, 0xcf, 0xba, 0x96, 0xcf, 0xba, 0x9e,
+	0x69, 0x07, 0xfe, 0x39, 0x8e, 0x28, 0xbf, 0xc1, 0x24, 0xaf, 0x0a, 0x39, 0xe1, 0x88, 0x15, 0x35,
+	0x65, 0x27, 0x99, 0x11, 0xbf, 0x9b, 0x8c, 0x76, 0xdf, 0xec, 0x68, 0xf7, 0xf1, 0x27, 0xcd, 0x38,
+	0x3d, 0x78, 0xf3, 0x64, 0xf2, 0x2f, 0x71, 0x22, 0x17, 0x8d, 0x85, 0x98, 0xd1, 0xc0, 0x51, 0x8b,
+	0xd8, 0x67, 0xfc, 0xaa, 0x41, 0x19, 0x0e, 0x4d, 0xfe, 0xe2, 0x60, 0x2a, 0xfd, 0xb4, 0x3c, 0x27,
+	0xaf, 0x1a, 0x9c, 0xc3, 0xdf, 0x25, 0x7e, 0xa4, 0xe8, 0xe8, 0x5b, 0x70, 0x43, 0xb6, 0xac, 0x84,
+	0x5d, 0x98, 0x8c, 0xe0, 0xa8, 0x0c, 0x02, 0xb6, 0x98, 0x50, 0x5b, 0x04, 0x47, 0xfa, 0x73, 0x0d,
+	0x56, 0x0f, 0xfa, 0x29, 0xc7, 0x21, 0xc5, 0x11, 0x1b, 0x17, 0x3d, 0x04, 0x19, 0xdf, 0xf2, 0xb0,
+	0xca, 0x36, 0xf1, 0xcd, 0xed, 0x22, 0x3e, 0x61, 0xc4, 0x72, 0x79, 0xbe, 0x75, 0xf8, 0x53, 0x50,
+	0xe8, 0xa9, 0x96, 0xb7, 0xa4, 0x38, 0x87, 0x82, 0xc1, 0x1f, 0x66, 0x3f, 0x80, 0xb2, 0x67, 0x11,
+	0x9f, 0x61, 0xdf, 0xf2, 0x6d, 0x6c, 0xb6, 0x23, 0xcb, 0x16, 0x57, 0x44, 0x2e, 0x23, 0x83, 0xba,
+	0xdc, 0xc7, 0xdf, 0x53, 0x6c, 0x2e, 0xf9, 0x00, 0x96, 0xc5, 0xd2, 0xe3, 0x16, 0xcf, 0xf4, 0x03,
+	0xb9, 0x9d, 0xd5, 0x45, 0x61, 0x89, 0x73, 0xe3, 0x56, 0xed, 0x48, 0xf1, 0xf4, 0xdf, 0xa6, 0xe0,
+	0x96, 0xdc, 0xe3, 0x71, 0xbc, 0xe3, 0xb5, 0x5d, 0xce, 0x44, 0x6d, 0x28, 0x13, 0x7b, 0x49, 0x95,
+	0xfa, 0x7a, 0x93, 0x2a, 0xfd, 0xaa, 0xa4, 0x1a, 0x99, 0x27, 0x99, 0xd7, 0xc9, 0x93, 0xec, 0xe8,
+	0x3c, 0xd1, 0xff, 0xa4, 0xc1, 0xb2, 0xf4, 0x4f, 0xb2, 0x8d, 0x27, 0x1c, 0x36, 0x6a, 0x63, 0xa6,
+	0xc6, 0x6f, 0xcc, 0xf4, 0x34, 0xa7, 0x49, 0x66, 0xcc, 0x76, 0x18, 0x4e, 0xda, 0xec, 0xa8, 0xa4,
+	0xfd, 0xb7, 0x06, 0xab, 0x89, 0xb5, 0x4d, 0xcc, 0x98, 0x2b, 0xca, 0x45, 0x6c, 0xf7, 0x14, 0x7f,
+	0x25, 0xbc, 0x0d, 0x25, 0x9a, 0xc8, 0xc9, 0xa7, 0xcb, 0xf8, 0x78, 0xee, 0xd1, 0xc5, 0xb3, 0xe5,
+	0xff, 0xf9, 0x9f, 0x84, 0xaa, 0xf1, 0xc5, 0x8b, 0x75, 0xed, 0xcb, 0x17, 0xeb, 0xda, 0x57, 0x2f,
+	0xd6, 0xb5, 0x5f, 0xbf, 0x5c, 0x9f, 0xf9, 0xf2, 0xe5, 0xfa, 0xcc, 0xbf, 0x5e, 0xae, 0xcf, 0x3c,
+	0xf9, 0x60, 0x7a, 0x4d, 0x83, 0x7f, 0x2e, 0x9d, 0xe4, 0x04, 0xe3, 0xdb, 0xff, 0x1b, 0x00, 0x51,
+	0x29, 0xdd, 0x5e, 0x82, 0x1a, 0x00, 0x00,
 }
 
 func (m *FundingUpdateV1) Marshal() (dAtA []byte, err error) {
@@ -2215,6 +2370,11 @@ func (m *FundingEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Type != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
 		i--
@@ -3063,6 +3223,27 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacement) MarshalToSizedBuffer(dAtA
 	}
 	return len(dAtA) - i, nil
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConditionalOrderTriggerUpdated != nil {
+		{
+			size, err := m.ConditionalOrderTriggerUpdated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3243,6 +3424,46 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggerSubticks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TriggerSubticks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != nil {
+		{
+			size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AssetCreateEventV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3521,6 +3742,49 @@ func (m *UpdatePerpetualEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PerpetualSettlementEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualSettlementEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualSettlementEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FundingIndex.Size()
+		i -= size
+		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SettlementPrice != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementPrice))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -3564,6 +3828,9 @@ func (m *FundingEventV1) Size() (n int) {
 	if m.Type != 0 {
 		n += 1 + sovEvents(uint64(m.Type))
 	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.IntervalSeconds))
+	}
 	return n
 }
 
@@ -3941,6 +4208,18 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacement) Size() (n int) {
 	}
 	return n
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConditionalOrderTriggerUpdated != nil {
+		l = m.ConditionalOrderTriggerUpdated.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4009,6 +4288,22 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Size() (n int) {
 	return n
 }
 
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != nil {
+		l = m.OrderId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TriggerSubticks != 0 {
+		n += 1 + sovEvents(uint64(m.TriggerSubticks))
+	}
+	return n
+}
+
 func (m *AssetCreateEventV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4148,6 +4443,23 @@ func (m *UpdatePerpetualEventV1) Size() (n int) {
 	return n
 }
 
+func (m *PerpetualSettlementEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovEvents(uint64(m.PerpetualId))
+	}
+	if m.SettlementPrice != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementPrice))
+	}
+	l = m.FundingIndex.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4357,6 +4669,25 @@ func (m *FundingEventV1) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4973,7 +5304,7 @@ func (m *SourceOfFunds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v1.IndexerSubaccountId{}
+			v := &types.IndexerSubaccountId{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5091,7 +5422,7 @@ func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SenderSubaccountId == nil {
-				m.SenderSubaccountId = &v1.IndexerSubaccountId{}
+				m.SenderSubaccountId = &types.IndexerSubaccountId{}
 			}
 			if err := m.SenderSubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5127,7 +5458,7 @@ func (m *TransferEventV1) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RecipientSubaccountId == nil {
-				m.RecipientSubaccountId = &v1.IndexerSubaccountId{}
+				m.RecipientSubaccountId = &types.IndexerSubaccountId{}
 			}
 			if err := m.RecipientSubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5355,7 +5686,7 @@ func (m *OrderFillEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v1.IndexerOrder{}
+			v := &types.IndexerOrder{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5947,7 +6278,7 @@ func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SubaccountId == nil {
-				m.SubaccountId = &v1.IndexerSubaccountId{}
+				m.SubaccountId = &types.IndexerSubaccountId{}
 			}
 			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5982,7 +6313,7 @@ func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedPerpetualPositions = append(m.UpdatedPerpetualPositions, &v1.IndexerPerpetualPosition{})
+			m.UpdatedPerpetualPositions = append(m.UpdatedPerpetualPositions, &types.IndexerPerpetualPosition{})
 			if err := m.UpdatedPerpetualPositions[len(m.UpdatedPerpetualPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -6016,7 +6347,7 @@ func (m *SubaccountUpdateEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAssetPositions = append(m.UpdatedAssetPositions, &v1.IndexerAssetPosition{})
+			m.UpdatedAssetPositions = append(m.UpdatedAssetPositions, &types.IndexerAssetPosition{})
 			if err := m.UpdatedAssetPositions[len(m.UpdatedAssetPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -6246,6 +6577,41 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &StatefulOrderEventV1_LongTermOrderPlacement{v}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggerUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_ConditionalOrderTriggerUpdated{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6326,7 +6692,7 @@ func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Unmarshal(dAtA []byte) e
 				return io.ErrUnexpectedEOF
 			}
 			if m.Order == nil {
-				m.Order = &v1.IndexerOrder{}
+				m.Order = &types.IndexerOrder{}
 			}
 			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6412,7 +6778,7 @@ func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Unmarshal(dAtA []byte) err
 				return io.ErrUnexpectedEOF
 			}
 			if m.RemovedOrderId == nil {
-				m.RemovedOrderId = &v1.IndexerOrderId{}
+				m.RemovedOrderId = &types.IndexerOrderId{}
 			}
 			if err := m.RemovedOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6432,7 +6798,7 @@ func (m *StatefulOrderEventV1_StatefulOrderRemovalV1) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= shared.OrderRemovalReason(b&0x7F) << shift
+				m.Reason |= types1.OrderRemovalReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6517,7 +6883,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderPlacementV1) Unmarshal(dAtA []byte
 				return io.ErrUnexpectedEOF
 			}
 			if m.Order == nil {
-				m.Order = &v1.IndexerOrder{}
+				m.Order = &types.IndexerOrder{}
 			}
 			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6603,7 +6969,7 @@ func (m *StatefulOrderEventV1_ConditionalOrderTriggeredV1) Unmarshal(dAtA []byte
 				return io.ErrUnexpectedEOF
 			}
 			if m.TriggeredOrderId == nil {
-				m.TriggeredOrderId = &v1.IndexerOrderId{}
+				m.TriggeredOrderId = &types.IndexerOrderId{}
 			}
 			if err := m.TriggeredOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6689,7 +7055,7 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Unmarshal(dAtA []byte) e
 				return io.ErrUnexpectedEOF
 			}
 			if m.Order == nil {
-				m.Order = &v1.IndexerOrder{}
+				m.Order = &types.IndexerOrder{}
 			}
 			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6716,6 +7082,111 @@ func (m *StatefulOrderEventV1_LongTermOrderPlacementV1) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrderTriggerUpdatedV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrderTriggerUpdatedV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderId == nil {
+				m.OrderId = &types.IndexerOrderId{}
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerSubticks", wireType)
+			}
+			m.TriggerSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7009,7 +7480,7 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= v1.ClobPairStatus(b&0x7F) << shift
+				m.Status |= types.ClobPairStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7354,7 +7825,7 @@ func (m *UpdateClobPairEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= v1.ClobPairStatus(b&0x7F) << shift
+				m.Status |= types.ClobPairStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7599,6 +8070,127 @@ func (m *UpdatePerpetualEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PerpetualSettlementEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualSettlementEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualSettlementEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			m.SettlementPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingIndex", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,34 +1,40 @@
 package events
 
 // NewPremiumSamplesEvent creates a FundingEvent representing a list of new funding premium
-// samples generated at the end of each `funding-sample` epoch.
+// samples generated at the end of a `funding-sample` epoch of `intervalSeconds` seconds.
 func NewPremiumSamplesEvent(
 	newSamplesForEvent []FundingUpdateV1,
+	intervalSeconds uint32,
 ) *FundingEventV1 {
 	return newFundingEvent(
 		newSamplesForEvent,
 		FundingEventV1_TYPE_PREMIUM_SAMPLE,
+		intervalSeconds,
 	)
 }
 
 // NewFundingRatesAndIndicesEvent creates a FundingEvent representing a list of new
-// funding rates generated at the end of each `funding-tick` epoch and funding indices
-// accordingly updated with `funding rate * price`.
+// funding rates generated at the end of a `funding-tick` epoch of `intervalSeconds` seconds
+// and funding indices accordingly updated with `funding rate * price`.
 func NewFundingRatesAndIndicesEvent(
 	newFundingRatesAndIndicesForEvent []FundingUpdateV1,
+	intervalSeconds uint32,
 ) *FundingEventV1 {
 	return newFundingEvent(
 		newFundingRatesAndIndicesForEvent,
 		FundingEventV1_TYPE_FUNDING_RATE_AND_INDEX,
+		intervalSeconds,
 	)
 }
 
 func newFundingEvent(
 	newUpdatesForEvent []FundingUpdateV1,
 	updateType FundingEventV1_Type,
+	intervalSeconds uint32,
 ) *FundingEventV1 {
 	return &FundingEventV1{
-		Updates: newUpdatesForEvent,
-		Type:    updateType,
+		Updates:         newUpdatesForEvent,
+		Type:            updateType,
+		IntervalSeconds: intervalSeconds,
 	}
 }
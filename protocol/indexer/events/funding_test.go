@@ -13,10 +13,11 @@ import (
 
 func TestNewFundingEvent(t *testing.T) {
 	tests := map[string]struct {
-		updateType   events.FundingEventV1_Type
-		updates      []events.FundingUpdateV1
-		txnHash      lib.TxHash
-		newEventFunc func(updates []events.FundingUpdateV1) *events.FundingEventV1
+		updateType      events.FundingEventV1_Type
+		updates         []events.FundingUpdateV1
+		intervalSeconds uint32
+		txnHash         lib.TxHash
+		newEventFunc    func(updates []events.FundingUpdateV1, intervalSeconds uint32) *events.FundingEventV1
 	}{
 		"premium samples": {
 			updateType: events.FundingEventV1_TYPE_PREMIUM_SAMPLE,
@@ -30,8 +31,9 @@ func TestNewFundingEvent(t *testing.T) {
 					FundingValuePpm: 0,
 				},
 			},
-			txnHash:      constants.TestTxHashString,
-			newEventFunc: events.NewPremiumSamplesEvent,
+			intervalSeconds: 60,
+			txnHash:         constants.TestTxHashString,
+			newEventFunc:    events.NewPremiumSamplesEvent,
 		},
 		"funding rates and indices": {
 			updateType: events.FundingEventV1_TYPE_FUNDING_RATE_AND_INDEX,
@@ -52,17 +54,19 @@ func TestNewFundingEvent(t *testing.T) {
 					FundingIndex:    dtypes.NewInt(-1000),
 				},
 			},
-			txnHash:      constants.TestTxHashString,
-			newEventFunc: events.NewFundingRatesAndIndicesEvent,
+			intervalSeconds: 3600,
+			txnHash:         constants.TestTxHashString,
+			newEventFunc:    events.NewFundingRatesAndIndicesEvent,
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			FundingEvent := tc.newEventFunc(tc.updates)
+			FundingEvent := tc.newEventFunc(tc.updates, tc.intervalSeconds)
 			expectedFundingEventProto := &events.FundingEventV1{
-				Type:    tc.updateType,
-				Updates: tc.updates,
+				Type:            tc.updateType,
+				Updates:         tc.updates,
+				IntervalSeconds: tc.intervalSeconds,
 			}
 			require.Equal(t, expectedFundingEventProto, FundingEvent)
 		})
@@ -3,7 +3,7 @@ package events
 import (
 	"testing"
 
-	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
+	v1types "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 
 	"github.com/stretchr/testify/require"
@@ -27,7 +27,7 @@ func TestNewPerpetualMarketCreateEvent_Success(t *testing.T) {
 		ClobPairId:                0,
 		Ticker:                    "BTC",
 		MarketId:                  0,
-		Status:                    v1.ClobPairStatus_CLOB_PAIR_STATUS_ACTIVE,
+		Status:                    v1types.ClobPairStatus_CLOB_PAIR_STATUS_ACTIVE,
 		QuantumConversionExponent: -8,
 		AtomicResolution:          8,
 		SubticksPerTick:           5,
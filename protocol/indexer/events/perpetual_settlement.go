@@ -0,0 +1,19 @@
+package events
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+)
+
+// NewPerpetualSettlementEvent creates a PerpetualSettlementEventV1 representing
+// the final settlement of a delisted perpetual.
+func NewPerpetualSettlementEvent(
+	perpetualId uint32,
+	settlementPrice uint64,
+	fundingIndex dtypes.SerializableInt,
+) *PerpetualSettlementEventV1 {
+	return &PerpetualSettlementEventV1{
+		PerpetualId:     perpetualId,
+		SettlementPrice: settlementPrice,
+		FundingIndex:    fundingIndex,
+	}
+}
@@ -0,0 +1,22 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPerpetualSettlementEvent_Success(t *testing.T) {
+	perpetualSettlementEvent := NewPerpetualSettlementEvent(
+		1,
+		5_000_000_000,
+		dtypes.NewInt(-100),
+	)
+	expectedPerpetualSettlementEventProto := &PerpetualSettlementEventV1{
+		PerpetualId:     1,
+		SettlementPrice: 5_000_000_000,
+		FundingIndex:    dtypes.NewInt(-100),
+	}
+	require.Equal(t, expectedPerpetualSettlementEventProto, perpetualSettlementEvent)
+}
@@ -2,7 +2,7 @@ package events
 
 import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
-	"github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 )
 
@@ -22,7 +22,7 @@ func NewLongTermOrderPlacementEvent(
 
 func NewStatefulOrderRemovalEvent(
 	removedOrderId clobtypes.OrderId,
-	reason shared.OrderRemovalReason,
+	reason sharedtypes.OrderRemovalReason,
 ) *StatefulOrderEventV1 {
 	orderId := v1.OrderIdToIndexerOrderId(removedOrderId)
 	orderRemoval := StatefulOrderEventV1_StatefulOrderRemovalV1{
@@ -63,3 +63,19 @@ func NewConditionalOrderTriggeredEvent(
 		},
 	}
 }
+
+func NewConditionalOrderTriggerUpdatedEvent(
+	orderId clobtypes.OrderId,
+	triggerSubticks clobtypes.Subticks,
+) *StatefulOrderEventV1 {
+	indexerOrderId := v1.OrderIdToIndexerOrderId(orderId)
+	triggerUpdated := StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1{
+		OrderId:         &indexerOrderId,
+		TriggerSubticks: triggerSubticks.ToUint64(),
+	}
+	return &StatefulOrderEventV1{
+		Event: &StatefulOrderEventV1_ConditionalOrderTriggerUpdated{
+			ConditionalOrderTriggerUpdated: &triggerUpdated,
+		},
+	}
+}
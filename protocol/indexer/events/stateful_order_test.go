@@ -5,7 +5,7 @@ import (
 
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
-	"github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +15,7 @@ var (
 	indexerOrder   = v1.OrderToIndexerOrder(order)
 	orderId        = constants.OrderId_Alice_Num0_ClientId0_Clob0
 	indexerOrderId = v1.OrderIdToIndexerOrderId(orderId)
-	reason         = shared.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED
+	reason         = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED
 )
 
 func TestLongTermOrderPlacementEvent_Success(t *testing.T) {
@@ -66,3 +66,16 @@ func TestConditionalOrderTriggeredEvent_Success(t *testing.T) {
 	}
 	require.Equal(t, expectedStatefulOrderEventProto, conditionalOrderTriggeredEvent)
 }
+
+func TestConditionalOrderTriggerUpdatedEvent_Success(t *testing.T) {
+	conditionalOrderTriggerUpdatedEvent := events.NewConditionalOrderTriggerUpdatedEvent(orderId, 500)
+	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
+		Event: &events.StatefulOrderEventV1_ConditionalOrderTriggerUpdated{
+			ConditionalOrderTriggerUpdated: &events.StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1{
+				OrderId:         &indexerOrderId,
+				TriggerSubticks: 500,
+			},
+		},
+	}
+	require.Equal(t, expectedStatefulOrderEventProto, conditionalOrderTriggerUpdatedEvent)
+}
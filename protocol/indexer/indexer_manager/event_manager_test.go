@@ -178,7 +178,7 @@ func TestProduceBlockBasicBlockEvent(t *testing.T) {
 		ctx,
 		indexerevents.SubtypeFundingValues,
 		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
-		EventVersion,
+		indexerevents.FundingValuesEventVersion,
 		indexer_manager.GetBytes(
 			&FundingRateAndIndexEvent,
 		),
@@ -282,7 +282,7 @@ func TestProduceBlockMultipleTxnAndBlockEvents(t *testing.T) {
 		ctx,
 		indexerevents.SubtypeFundingValues,
 		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
-		EventVersion,
+		indexerevents.FundingValuesEventVersion,
 		indexer_manager.GetBytes(
 			&FundingRateAndIndexEvent,
 		),
@@ -291,7 +291,7 @@ func TestProduceBlockMultipleTxnAndBlockEvents(t *testing.T) {
 		ctx,
 		indexerevents.SubtypeFundingValues,
 		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
-		EventVersion,
+		indexerevents.FundingValuesEventVersion,
 		indexer_manager.GetBytes(
 			&FundingPremiumSampleEvent,
 		),
@@ -300,7 +300,7 @@ func TestProduceBlockMultipleTxnAndBlockEvents(t *testing.T) {
 		ctx,
 		indexerevents.SubtypeFundingValues,
 		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_BEGIN_BLOCK,
-		EventVersion,
+		indexerevents.FundingValuesEventVersion,
 		indexer_manager.GetBytes(
 			&FundingPremiumSampleEvent,
 		),
@@ -309,7 +309,7 @@ func TestProduceBlockMultipleTxnAndBlockEvents(t *testing.T) {
 		ctx,
 		indexerevents.SubtypeFundingValues,
 		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_BEGIN_BLOCK,
-		EventVersion,
+		indexerevents.FundingValuesEventVersion,
 		indexer_manager.GetBytes(
 			&FundingRateAndIndexEvent,
 		),
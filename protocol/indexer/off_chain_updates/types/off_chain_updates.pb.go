@@ -1,13 +1,13 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: dydxprotocol/indexer/off_chain_updates/off_chain_updates.proto
 
-package off_chain_updates
+package types
 
 import (
 	fmt "fmt"
 	proto "github.com/cosmos/gogoproto/proto"
-	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
-	shared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	types "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1/types"
+	types1 "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -120,7 +120,7 @@ func (OrderRemoveV1_OrderRemovalStatus) EnumDescriptor() ([]byte, []int) {
 
 // OrderPlace messages contain the order placed/replaced.
 type OrderPlaceV1 struct {
-	Order           *v1.IndexerOrder                  `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order           *types.IndexerOrder               `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 	PlacementStatus OrderPlaceV1_OrderPlacementStatus `protobuf:"varint,2,opt,name=placement_status,json=placementStatus,proto3,enum=dydxprotocol.indexer.off_chain_updates.OrderPlaceV1_OrderPlacementStatus" json:"placement_status,omitempty"`
 }
 
@@ -157,7 +157,7 @@ func (m *OrderPlaceV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrderPlaceV1 proto.InternalMessageInfo
 
-func (m *OrderPlaceV1) GetOrder() *v1.IndexerOrder {
+func (m *OrderPlaceV1) GetOrder() *types.IndexerOrder {
 	if m != nil {
 		return m.Order
 	}
@@ -174,8 +174,8 @@ func (m *OrderPlaceV1) GetPlacementStatus() OrderPlaceV1_OrderPlacementStatus {
 // OrderRemove messages contain the id of the order removed, the reason for the
 // removal and the resulting status from the removal.
 type OrderRemoveV1 struct {
-	RemovedOrderId *v1.IndexerOrderId               `protobuf:"bytes,1,opt,name=removed_order_id,json=removedOrderId,proto3" json:"removed_order_id,omitempty"`
-	Reason         shared.OrderRemovalReason        `protobuf:"varint,2,opt,name=reason,proto3,enum=dydxprotocol.indexer.shared.OrderRemovalReason" json:"reason,omitempty"`
+	RemovedOrderId *types.IndexerOrderId            `protobuf:"bytes,1,opt,name=removed_order_id,json=removedOrderId,proto3" json:"removed_order_id,omitempty"`
+	Reason         types1.OrderRemovalReason        `protobuf:"varint,2,opt,name=reason,proto3,enum=dydxprotocol.indexer.shared.OrderRemovalReason" json:"reason,omitempty"`
 	RemovalStatus  OrderRemoveV1_OrderRemovalStatus `protobuf:"varint,3,opt,name=removal_status,json=removalStatus,proto3,enum=dydxprotocol.indexer.off_chain_updates.OrderRemoveV1_OrderRemovalStatus" json:"removal_status,omitempty"`
 }
 
@@ -212,18 +212,18 @@ func (m *OrderRemoveV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrderRemoveV1 proto.InternalMessageInfo
 
-func (m *OrderRemoveV1) GetRemovedOrderId() *v1.IndexerOrderId {
+func (m *OrderRemoveV1) GetRemovedOrderId() *types.IndexerOrderId {
 	if m != nil {
 		return m.RemovedOrderId
 	}
 	return nil
 }
 
-func (m *OrderRemoveV1) GetReason() shared.OrderRemovalReason {
+func (m *OrderRemoveV1) GetReason() types1.OrderRemovalReason {
 	if m != nil {
 		return m.Reason
 	}
-	return shared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED
+	return types1.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED
 }
 
 func (m *OrderRemoveV1) GetRemovalStatus() OrderRemoveV1_OrderRemovalStatus {
@@ -236,8 +236,8 @@ func (m *OrderRemoveV1) GetRemovalStatus() OrderRemoveV1_OrderRemovalStatus {
 // OrderUpdate messages contain the id of the order being updated, and the
 // updated total filled quantums of the order.
 type OrderUpdateV1 struct {
-	OrderId             *v1.IndexerOrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	TotalFilledQuantums uint64             `protobuf:"varint,2,opt,name=total_filled_quantums,json=totalFilledQuantums,proto3" json:"total_filled_quantums,omitempty"`
+	OrderId             *types.IndexerOrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TotalFilledQuantums uint64                `protobuf:"varint,2,opt,name=total_filled_quantums,json=totalFilledQuantums,proto3" json:"total_filled_quantums,omitempty"`
 }
 
 func (m *OrderUpdateV1) Reset()         { *m = OrderUpdateV1{} }
@@ -273,7 +273,7 @@ func (m *OrderUpdateV1) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrderUpdateV1 proto.InternalMessageInfo
 
-func (m *OrderUpdateV1) GetOrderId() *v1.IndexerOrderId {
+func (m *OrderUpdateV1) GetOrderId() *types.IndexerOrderId {
 	if m != nil {
 		return m.OrderId
 	}
@@ -287,15 +287,73 @@ func (m *OrderUpdateV1) GetTotalFilledQuantums() uint64 {
 	return 0
 }
 
+// OrderReplace messages contain the replacement order of an order that was
+// atomically replaced with a `MsgReplaceOrder`. The replacement order has the
+// same order id as the replaced order.
+type OrderReplaceV1 struct {
+	Order           *types.IndexerOrder               `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	PlacementStatus OrderPlaceV1_OrderPlacementStatus `protobuf:"varint,2,opt,name=placement_status,json=placementStatus,proto3,enum=dydxprotocol.indexer.off_chain_updates.OrderPlaceV1_OrderPlacementStatus" json:"placement_status,omitempty"`
+}
+
+func (m *OrderReplaceV1) Reset()         { *m = OrderReplaceV1{} }
+func (m *OrderReplaceV1) String() string { return proto.CompactTextString(m) }
+func (*OrderReplaceV1) ProtoMessage()    {}
+func (*OrderReplaceV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3058c1b66f59e98, []int{3}
+}
+func (m *OrderReplaceV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderReplaceV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderReplaceV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderReplaceV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReplaceV1.Merge(m, src)
+}
+func (m *OrderReplaceV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderReplaceV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReplaceV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderReplaceV1 proto.InternalMessageInfo
+
+func (m *OrderReplaceV1) GetOrder() *types.IndexerOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (m *OrderReplaceV1) GetPlacementStatus() OrderPlaceV1_OrderPlacementStatus {
+	if m != nil {
+		return m.PlacementStatus
+	}
+	return OrderPlaceV1_ORDER_PLACEMENT_STATUS_UNSPECIFIED
+}
+
 // An OffChainUpdate message is the message type which will be sent on Kafka to
 // the Indexer.
 type OffChainUpdateV1 struct {
-	// Contains one of an OrderPlaceV1, OrderRemoveV1, and OrderUpdateV1 message.
+	// Contains one of an OrderPlaceV1, OrderRemoveV1, OrderUpdateV1, and
+	// OrderReplaceV1 message.
 	//
 	// Types that are valid to be assigned to UpdateMessage:
+	//
 	//	*OffChainUpdateV1_OrderPlace
 	//	*OffChainUpdateV1_OrderRemove
 	//	*OffChainUpdateV1_OrderUpdate
+	//	*OffChainUpdateV1_OrderReplace
 	UpdateMessage isOffChainUpdateV1_UpdateMessage `protobuf_oneof:"update_message"`
 }
 
@@ -303,7 +361,7 @@ func (m *OffChainUpdateV1) Reset()         { *m = OffChainUpdateV1{} }
 func (m *OffChainUpdateV1) String() string { return proto.CompactTextString(m) }
 func (*OffChainUpdateV1) ProtoMessage()    {}
 func (*OffChainUpdateV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3058c1b66f59e98, []int{3}
+	return fileDescriptor_a3058c1b66f59e98, []int{4}
 }
 func (m *OffChainUpdateV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,10 +405,14 @@ type OffChainUpdateV1_OrderRemove struct {
 type OffChainUpdateV1_OrderUpdate struct {
 	OrderUpdate *OrderUpdateV1 `protobuf:"bytes,3,opt,name=order_update,json=orderUpdate,proto3,oneof" json:"order_update,omitempty"`
 }
+type OffChainUpdateV1_OrderReplace struct {
+	OrderReplace *OrderReplaceV1 `protobuf:"bytes,4,opt,name=order_replace,json=orderReplace,proto3,oneof" json:"order_replace,omitempty"`
+}
 
-func (*OffChainUpdateV1_OrderPlace) isOffChainUpdateV1_UpdateMessage()  {}
-func (*OffChainUpdateV1_OrderRemove) isOffChainUpdateV1_UpdateMessage() {}
-func (*OffChainUpdateV1_OrderUpdate) isOffChainUpdateV1_UpdateMessage() {}
+func (*OffChainUpdateV1_OrderPlace) isOffChainUpdateV1_UpdateMessage()   {}
+func (*OffChainUpdateV1_OrderRemove) isOffChainUpdateV1_UpdateMessage()  {}
+func (*OffChainUpdateV1_OrderUpdate) isOffChainUpdateV1_UpdateMessage()  {}
+func (*OffChainUpdateV1_OrderReplace) isOffChainUpdateV1_UpdateMessage() {}
 
 func (m *OffChainUpdateV1) GetUpdateMessage() isOffChainUpdateV1_UpdateMessage {
 	if m != nil {
@@ -380,12 +442,20 @@ func (m *OffChainUpdateV1) GetOrderUpdate() *OrderUpdateV1 {
 	return nil
 }
 
+func (m *OffChainUpdateV1) GetOrderReplace() *OrderReplaceV1 {
+	if x, ok := m.GetUpdateMessage().(*OffChainUpdateV1_OrderReplace); ok {
+		return x.OrderReplace
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*OffChainUpdateV1) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*OffChainUpdateV1_OrderPlace)(nil),
 		(*OffChainUpdateV1_OrderRemove)(nil),
 		(*OffChainUpdateV1_OrderUpdate)(nil),
+		(*OffChainUpdateV1_OrderReplace)(nil),
 	}
 }
 
@@ -395,6 +465,7 @@ func init() {
 	proto.RegisterType((*OrderPlaceV1)(nil), "dydxprotocol.indexer.off_chain_updates.OrderPlaceV1")
 	proto.RegisterType((*OrderRemoveV1)(nil), "dydxprotocol.indexer.off_chain_updates.OrderRemoveV1")
 	proto.RegisterType((*OrderUpdateV1)(nil), "dydxprotocol.indexer.off_chain_updates.OrderUpdateV1")
+	proto.RegisterType((*OrderReplaceV1)(nil), "dydxprotocol.indexer.off_chain_updates.OrderReplaceV1")
 	proto.RegisterType((*OffChainUpdateV1)(nil), "dydxprotocol.indexer.off_chain_updates.OffChainUpdateV1")
 }
 
@@ -403,46 +474,48 @@ func init() {
 }
 
 var fileDescriptor_a3058c1b66f59e98 = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xd1, 0x6e, 0xd3, 0x3c,
-	0x18, 0x4d, 0xba, 0xff, 0x1f, 0xc8, 0xdb, 0x4a, 0x64, 0x40, 0x9a, 0x86, 0x08, 0x23, 0x42, 0xd3,
-	0x10, 0x5a, 0xb2, 0x96, 0x71, 0x8b, 0xd4, 0xa5, 0x29, 0x8b, 0xe8, 0x9a, 0xe2, 0x76, 0x43, 0xaa,
-	0x84, 0xac, 0xb4, 0x71, 0xd7, 0x4a, 0x69, 0x5d, 0x92, 0xb4, 0x1a, 0x6f, 0xb1, 0x17, 0xe1, 0x92,
-	0x77, 0xe0, 0x72, 0x37, 0x48, 0xdc, 0x20, 0xa1, 0xf6, 0x45, 0x50, 0x6c, 0x37, 0x6b, 0xd7, 0x4c,
-	0xa3, 0xe3, 0xf2, 0xfb, 0x72, 0xbe, 0xe3, 0xe3, 0x73, 0x3e, 0xb7, 0xe0, 0xad, 0xf7, 0xc5, 0x3b,
-	0x1f, 0x04, 0x34, 0xa2, 0x2d, 0xea, 0x1b, 0xdd, 0xbe, 0x47, 0xce, 0x49, 0x60, 0xd0, 0x76, 0x1b,
-	0xb7, 0x3a, 0x6e, 0xb7, 0x8f, 0x87, 0x03, 0xcf, 0x8d, 0x48, 0xb8, 0xd8, 0xd1, 0xd9, 0x10, 0xdc,
-	0x99, 0x9d, 0xd7, 0xc5, 0xbc, 0xbe, 0x80, 0xde, 0xda, 0x4f, 0x3d, 0x27, 0xec, 0xb8, 0x01, 0xf1,
-	0x8c, 0x80, 0xf4, 0xe8, 0xc8, 0xf5, 0x71, 0x40, 0xdc, 0x90, 0xf6, 0x39, 0xf3, 0xd6, 0xab, 0xd4,
-	0x89, 0xa4, 0x31, 0xca, 0x19, 0x2d, 0x9f, 0x36, 0x39, 0x58, 0xfb, 0x95, 0x01, 0xeb, 0x4e, 0xe0,
-	0x91, 0xa0, 0xea, 0xbb, 0x2d, 0x72, 0x9a, 0x83, 0x45, 0xf0, 0x3f, 0x8d, 0xeb, 0x4d, 0x79, 0x5b,
-	0xde, 0x5d, 0xcb, 0xeb, 0x7a, 0xaa, 0xce, 0xa4, 0x31, 0xca, 0xe9, 0x36, 0xef, 0x31, 0x16, 0xc4,
-	0x87, 0x61, 0x04, 0x94, 0x41, 0x4c, 0xd8, 0x23, 0xfd, 0x08, 0x87, 0x91, 0x1b, 0x0d, 0xc3, 0xcd,
-	0xcc, 0xb6, 0xbc, 0x9b, 0xcd, 0xdb, 0xfa, 0xdf, 0x5d, 0x5c, 0x9f, 0x55, 0x35, 0x53, 0xc4, 0x8c,
-	0x35, 0x46, 0x88, 0x1e, 0x0c, 0xe6, 0x1b, 0xda, 0x85, 0x0c, 0x1e, 0xa5, 0x21, 0xe1, 0x0e, 0xd0,
-	0x1c, 0x54, 0xb4, 0x10, 0xae, 0x96, 0x0b, 0xa6, 0x75, 0x6c, 0x55, 0xea, 0xb8, 0x56, 0x2f, 0xd4,
-	0x4f, 0x6a, 0xf8, 0xa4, 0x52, 0xab, 0x5a, 0xa6, 0x5d, 0xb2, 0xad, 0xa2, 0x22, 0xc1, 0x3d, 0xf0,
-	0xf2, 0x06, 0xdc, 0xa1, 0x55, 0xab, 0x63, 0xab, 0x54, 0x72, 0x50, 0x1d, 0x3b, 0x55, 0xab, 0x62,
-	0x15, 0x15, 0x19, 0x3e, 0x07, 0x4f, 0x6f, 0x80, 0x0b, 0x48, 0x46, 0xfb, 0xb1, 0x02, 0x36, 0xb8,
-	0x33, 0x71, 0x54, 0xb1, 0xc1, 0x0d, 0xa0, 0xb0, 0xd8, 0x88, 0x87, 0x99, 0x57, 0xb8, 0xeb, 0x09,
-	0xaf, 0xf7, 0x97, 0xf3, 0xda, 0xf6, 0x50, 0x56, 0x30, 0x89, 0x1a, 0xbe, 0x03, 0xab, 0x7c, 0x15,
-	0x84, 0xd9, 0x46, 0x3a, 0x23, 0xdf, 0x1e, 0xfd, 0x4a, 0x97, 0xeb, 0x23, 0x36, 0x86, 0xc4, 0x38,
-	0xa4, 0x20, 0x3b, 0xdd, 0x2d, 0x91, 0xde, 0x0a, 0x23, 0x3c, 0x5a, 0x2a, 0xbd, 0xe9, 0x9d, 0xe7,
-	0x4e, 0x12, 0xe1, 0x6d, 0x04, 0xb3, 0xa5, 0xf6, 0x55, 0x06, 0x70, 0x11, 0x05, 0x5f, 0x80, 0x6d,
-	0xee, 0x30, 0xb2, 0x8e, 0x9d, 0xd3, 0x42, 0xf9, 0x96, 0xd8, 0xae, 0xa1, 0x66, 0x43, 0x33, 0x0b,
-	0x15, 0xd3, 0x2a, 0xcf, 0xc7, 0x76, 0x0d, 0x9e, 0x40, 0x32, 0xf0, 0x19, 0x78, 0x92, 0x0a, 0x29,
-	0xd9, 0xe5, 0x18, 0xb0, 0x12, 0xaf, 0x1a, 0xcf, 0xf5, 0x84, 0x5d, 0xf8, 0x34, 0x07, 0xdf, 0x83,
-	0xfb, 0xff, 0x9c, 0xe7, 0x3d, 0x2a, 0x82, 0xcc, 0x83, 0xc7, 0x11, 0x8d, 0x5c, 0x1f, 0xb7, 0xbb,
-	0xbe, 0x4f, 0x3c, 0xfc, 0x79, 0xe8, 0xf6, 0xa3, 0x61, 0x8f, 0x3f, 0xa2, 0xff, 0xd0, 0x43, 0xf6,
-	0xb1, 0xc4, 0xbe, 0x7d, 0x10, 0x9f, 0xb4, 0x6f, 0x19, 0xa0, 0x38, 0xed, 0xb6, 0x19, 0xe7, 0x90,
-	0xa8, 0xfa, 0x08, 0xd6, 0xb8, 0x2a, 0xf6, 0x56, 0x84, 0xb0, 0x83, 0xbb, 0xbc, 0xc1, 0x23, 0x09,
-	0x01, 0x9a, 0xd4, 0xb0, 0x01, 0xd6, 0x39, 0x31, 0x5f, 0x41, 0x26, 0x6c, 0x2d, 0xff, 0xe6, 0x4e,
-	0xfb, 0x71, 0x24, 0x21, 0xae, 0x92, 0x37, 0xae, 0xb8, 0x39, 0x9a, 0xed, 0xde, 0xb2, 0xdc, 0x53,
-	0x07, 0x12, 0x6e, 0xde, 0x38, 0x54, 0x40, 0x96, 0xe3, 0x70, 0x8f, 0x84, 0xa1, 0x7b, 0x46, 0x0e,
-	0x3f, 0x7d, 0x1f, 0xab, 0xf2, 0xe5, 0x58, 0x95, 0x7f, 0x8f, 0x55, 0xf9, 0x62, 0xa2, 0x4a, 0x97,
-	0x13, 0x55, 0xfa, 0x39, 0x51, 0xa5, 0x86, 0x79, 0xd6, 0x8d, 0x3a, 0xc3, 0xa6, 0xde, 0xa2, 0x3d,
-	0x63, 0xee, 0x47, 0x75, 0x74, 0xb0, 0xc7, 0x8e, 0x34, 0x6e, 0xff, 0x03, 0x68, 0xae, 0x32, 0xcc,
-	0xeb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xd9, 0x86, 0x42, 0x31, 0x06, 0x00, 0x00,
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0xb6, 0x88, 0xe6, 0x15, 0x6a, 0x33, 0x6a, 0x42, 0x30, 0x56, 0x6c, 0x0c, 0xc1, 0x18,
+	0x76, 0x69, 0x45, 0x8f, 0x26, 0xa5, 0xdd, 0xca, 0xc6, 0xd2, 0xd6, 0x69, 0xc1, 0x84, 0xc4, 0x4c,
+	0x96, 0xdd, 0x29, 0x34, 0xd9, 0x76, 0xd6, 0xdd, 0x6d, 0x03, 0xff, 0x82, 0x83, 0x7f, 0xc3, 0xff,
+	0xe1, 0xc1, 0x03, 0x17, 0x13, 0x2f, 0x26, 0x06, 0xfe, 0x88, 0xd9, 0x99, 0xe9, 0xb2, 0x85, 0x25,
+	0x02, 0xde, 0x3c, 0xbe, 0x37, 0xdf, 0xfb, 0xe6, 0xbd, 0xef, 0x7b, 0x93, 0x81, 0xb7, 0xf6, 0x91,
+	0x7d, 0xe8, 0x7a, 0x2c, 0x60, 0x16, 0x73, 0xb4, 0xfe, 0xd0, 0xa6, 0x87, 0xd4, 0xd3, 0x58, 0xaf,
+	0x47, 0xac, 0x03, 0xb3, 0x3f, 0x24, 0x23, 0xd7, 0x36, 0x03, 0xea, 0x5f, 0xce, 0xa8, 0xbc, 0x08,
+	0x2d, 0xc7, 0xeb, 0x55, 0x59, 0xaf, 0x5e, 0x42, 0x2f, 0xae, 0x25, 0xde, 0xe3, 0x1f, 0x98, 0x1e,
+	0xb5, 0x35, 0x8f, 0x0e, 0xd8, 0xd8, 0x74, 0x88, 0x47, 0x4d, 0x9f, 0x0d, 0x05, 0xf3, 0xe2, 0xcb,
+	0xc4, 0x8a, 0x28, 0x31, 0x2e, 0x69, 0x96, 0xc3, 0xf6, 0x04, 0xb8, 0xf8, 0x2b, 0x0d, 0x73, 0x2d,
+	0xcf, 0xa6, 0x5e, 0xdb, 0x31, 0x2d, 0xba, 0x53, 0x42, 0x35, 0xb8, 0xc3, 0xc2, 0x78, 0x41, 0x59,
+	0x52, 0x56, 0xb2, 0x65, 0x55, 0x4d, 0xec, 0x33, 0x4a, 0x8c, 0x4b, 0xaa, 0x21, 0x72, 0x9c, 0x05,
+	0x8b, 0x62, 0x14, 0x40, 0xde, 0x0d, 0x09, 0x07, 0x74, 0x18, 0x10, 0x3f, 0x30, 0x83, 0x91, 0xbf,
+	0x90, 0x5e, 0x52, 0x56, 0x72, 0x65, 0x43, 0xbd, 0xde, 0xe0, 0x6a, 0xbc, 0xab, 0x58, 0x10, 0x32,
+	0x76, 0x38, 0x21, 0xbe, 0xef, 0x4e, 0x27, 0x8a, 0xc7, 0x0a, 0x3c, 0x4c, 0x42, 0xa2, 0x65, 0x28,
+	0xb6, 0x70, 0x4d, 0xc7, 0xa4, 0xdd, 0xa8, 0x54, 0xf5, 0x2d, 0xbd, 0xd9, 0x25, 0x9d, 0x6e, 0xa5,
+	0xbb, 0xdd, 0x21, 0xdb, 0xcd, 0x4e, 0x5b, 0xaf, 0x1a, 0x75, 0x43, 0xaf, 0xe5, 0x53, 0x68, 0x15,
+	0x5e, 0x5c, 0x81, 0xdb, 0xd0, 0x3b, 0x5d, 0xa2, 0xd7, 0xeb, 0x2d, 0xdc, 0x25, 0xad, 0xb6, 0xde,
+	0xd4, 0x6b, 0x79, 0x05, 0x3d, 0x83, 0x27, 0x57, 0xc0, 0x25, 0x24, 0x5d, 0xfc, 0x91, 0x81, 0x79,
+	0xa1, 0x4c, 0x68, 0x55, 0x28, 0xf0, 0x2e, 0xe4, 0xb9, 0x6d, 0xd4, 0x26, 0x5c, 0x2b, 0xd2, 0xb7,
+	0xa5, 0xd6, 0x6b, 0x37, 0xd3, 0xda, 0xb0, 0x71, 0x4e, 0x32, 0xc9, 0x18, 0xbd, 0x83, 0x59, 0xb1,
+	0x0a, 0x52, 0x6c, 0x2d, 0x99, 0x51, 0x6c, 0x8f, 0x7a, 0xde, 0x97, 0xe9, 0x60, 0x5e, 0x86, 0x65,
+	0x39, 0x62, 0x90, 0x9b, 0xec, 0x96, 0x74, 0x2f, 0xc3, 0x09, 0x37, 0x6f, 0xe4, 0xde, 0x64, 0xe6,
+	0xa9, 0x9b, 0xa4, 0x79, 0xf3, 0x5e, 0x3c, 0x2c, 0x7e, 0x55, 0x00, 0x5d, 0x46, 0xa1, 0xe7, 0xb0,
+	0x24, 0x14, 0xc6, 0xfa, 0x56, 0x6b, 0xa7, 0xd2, 0xf8, 0x8b, 0x6d, 0x17, 0x50, 0x71, 0xd3, 0xaa,
+	0x95, 0x66, 0x55, 0x6f, 0x4c, 0xdb, 0x76, 0x01, 0x1e, 0x41, 0xd2, 0xe8, 0x29, 0x3c, 0x4e, 0x84,
+	0xd4, 0x8d, 0x46, 0x08, 0xc8, 0x84, 0xab, 0x26, 0x7c, 0xdd, 0xe6, 0x03, 0xef, 0x94, 0xd0, 0x7b,
+	0xb8, 0xf7, 0xcf, 0x7e, 0xde, 0x65, 0xd2, 0xc8, 0x32, 0x3c, 0x0a, 0x58, 0x60, 0x3a, 0xa4, 0xd7,
+	0x77, 0x1c, 0x6a, 0x93, 0xcf, 0x23, 0x73, 0x18, 0x8c, 0x06, 0xe2, 0x11, 0xcd, 0xe0, 0x07, 0xfc,
+	0xb0, 0xce, 0xcf, 0x3e, 0xc8, 0xa3, 0xe2, 0x77, 0x05, 0x72, 0x52, 0x42, 0xf7, 0x3f, 0x78, 0xcc,
+	0x5f, 0x32, 0x90, 0x6f, 0xf5, 0x7a, 0xd5, 0x90, 0x27, 0x12, 0xf9, 0x23, 0x64, 0x85, 0xc8, 0x1c,
+	0x2d, 0xc7, 0x5a, 0xbf, 0x4d, 0x17, 0x9b, 0x29, 0x0c, 0x2c, 0x8a, 0xd1, 0x2e, 0xcc, 0x09, 0x62,
+	0xf1, 0xa2, 0xf8, 0x7c, 0xd9, 0xf2, 0xeb, 0x5b, 0xad, 0xfb, 0x66, 0x0a, 0x67, 0xd9, 0x79, 0xe2,
+	0x9c, 0x5b, 0xa0, 0x17, 0x32, 0xb7, 0xe0, 0x9e, 0x28, 0x10, 0x71, 0x8b, 0x04, 0xfa, 0x04, 0xf3,
+	0x93, 0xbe, 0x85, 0x24, 0x33, 0x9c, 0xfc, 0xcd, 0x0d, 0x1b, 0x77, 0x23, 0x51, 0xe6, 0x58, 0x2c,
+	0xb3, 0x91, 0x87, 0x9c, 0x40, 0x92, 0x01, 0xf5, 0x7d, 0x73, 0x9f, 0x6e, 0x58, 0xdf, 0x4e, 0x0b,
+	0xca, 0xc9, 0x69, 0x41, 0xf9, 0x7d, 0x5a, 0x50, 0x8e, 0xcf, 0x0a, 0xa9, 0x93, 0xb3, 0x42, 0xea,
+	0xe7, 0x59, 0x21, 0xb5, 0x6b, 0xec, 0xf7, 0x83, 0x83, 0xd1, 0x9e, 0x6a, 0xb1, 0x81, 0x36, 0xf5,
+	0x05, 0x8d, 0xd7, 0x57, 0xf9, 0xa5, 0xda, 0x35, 0xbe, 0xcb, 0xe0, 0xc8, 0xa5, 0xfe, 0xde, 0x2c,
+	0x47, 0xbe, 0xfa, 0x33, 0x00, 0x56, 0xe2, 0xae, 0xf5, 0x65, 0x07, 0x00, 0x00,
 }
 
 func (m *OrderPlaceV1) Marshal() (dAtA []byte, err error) {
@@ -570,6 +643,46 @@ func (m *OrderUpdateV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OrderReplaceV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderReplaceV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderReplaceV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlacementStatus != 0 {
+		i = encodeVarintOffChainUpdates(dAtA, i, uint64(m.PlacementStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOffChainUpdates(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OffChainUpdateV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -665,6 +778,27 @@ func (m *OffChainUpdateV1_OrderUpdate) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *OffChainUpdateV1_OrderReplace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffChainUpdateV1_OrderReplace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderReplace != nil {
+		{
+			size, err := m.OrderReplace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOffChainUpdates(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintOffChainUpdates(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOffChainUpdates(v)
 	base := offset
@@ -727,6 +861,22 @@ func (m *OrderUpdateV1) Size() (n int) {
 	return n
 }
 
+func (m *OrderReplaceV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovOffChainUpdates(uint64(l))
+	}
+	if m.PlacementStatus != 0 {
+		n += 1 + sovOffChainUpdates(uint64(m.PlacementStatus))
+	}
+	return n
+}
+
 func (m *OffChainUpdateV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -775,6 +925,18 @@ func (m *OffChainUpdateV1_OrderUpdate) Size() (n int) {
 	}
 	return n
 }
+func (m *OffChainUpdateV1_OrderReplace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderReplace != nil {
+		l = m.OrderReplace.Size()
+		n += 1 + l + sovOffChainUpdates(uint64(l))
+	}
+	return n
+}
 
 func sovOffChainUpdates(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -841,7 +1003,7 @@ func (m *OrderPlaceV1) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Order == nil {
-				m.Order = &v1.IndexerOrder{}
+				m.Order = &types.IndexerOrder{}
 			}
 			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -946,7 +1108,7 @@ func (m *OrderRemoveV1) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RemovedOrderId == nil {
-				m.RemovedOrderId = &v1.IndexerOrderId{}
+				m.RemovedOrderId = &types.IndexerOrderId{}
 			}
 			if err := m.RemovedOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -966,7 +1128,7 @@ func (m *OrderRemoveV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= shared.OrderRemovalReason(b&0x7F) << shift
+				m.Reason |= types1.OrderRemovalReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1070,7 +1232,7 @@ func (m *OrderUpdateV1) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.OrderId == nil {
-				m.OrderId = &v1.IndexerOrderId{}
+				m.OrderId = &types.IndexerOrderId{}
 			}
 			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1116,6 +1278,111 @@ func (m *OrderUpdateV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OrderReplaceV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOffChainUpdates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderReplaceV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderReplaceV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffChainUpdates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOffChainUpdates
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffChainUpdates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &types.IndexerOrder{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementStatus", wireType)
+			}
+			m.PlacementStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffChainUpdates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlacementStatus |= OrderPlaceV1_OrderPlacementStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOffChainUpdates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOffChainUpdates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OffChainUpdateV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1250,6 +1517,41 @@ func (m *OffChainUpdateV1) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdateMessage = &OffChainUpdateV1_OrderUpdate{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderReplace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffChainUpdates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOffChainUpdates
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffChainUpdates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OrderReplaceV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.UpdateMessage = &OffChainUpdateV1_OrderReplace{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOffChainUpdates(dAtA[iNdEx:])
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: dydxprotocol/indexer/protocol/v1/clob.proto
 
-package v1
+package types
 
 import (
 	encoding_binary "encoding/binary"
@@ -47,6 +47,10 @@ const (
 	// Clob pairs in this state only accept orders which are
 	// both short-term and post-only.
 	ClobPairStatus_CLOB_PAIR_STATUS_INITIALIZING ClobPairStatus = 5
+	// CLOB_PAIR_STATUS_FINAL_SETTLEMENT represents a clob pair which was
+	// delisted. All open positions in its perpetual were closed at the
+	// settlement price, and clob pairs in this state don't accept any orders.
+	ClobPairStatus_CLOB_PAIR_STATUS_FINAL_SETTLEMENT ClobPairStatus = 6
 )
 
 var ClobPairStatus_name = map[int32]string{
@@ -56,15 +60,17 @@ var ClobPairStatus_name = map[int32]string{
 	3: "CLOB_PAIR_STATUS_CANCEL_ONLY",
 	4: "CLOB_PAIR_STATUS_POST_ONLY",
 	5: "CLOB_PAIR_STATUS_INITIALIZING",
+	6: "CLOB_PAIR_STATUS_FINAL_SETTLEMENT",
 }
 
 var ClobPairStatus_value = map[string]int32{
-	"CLOB_PAIR_STATUS_UNSPECIFIED":  0,
-	"CLOB_PAIR_STATUS_ACTIVE":       1,
-	"CLOB_PAIR_STATUS_PAUSED":       2,
-	"CLOB_PAIR_STATUS_CANCEL_ONLY":  3,
-	"CLOB_PAIR_STATUS_POST_ONLY":    4,
-	"CLOB_PAIR_STATUS_INITIALIZING": 5,
+	"CLOB_PAIR_STATUS_UNSPECIFIED":      0,
+	"CLOB_PAIR_STATUS_ACTIVE":           1,
+	"CLOB_PAIR_STATUS_PAUSED":           2,
+	"CLOB_PAIR_STATUS_CANCEL_ONLY":      3,
+	"CLOB_PAIR_STATUS_POST_ONLY":        4,
+	"CLOB_PAIR_STATUS_INITIALIZING":     5,
+	"CLOB_PAIR_STATUS_FINAL_SETTLEMENT": 6,
 }
 
 func (x ClobPairStatus) String() string {
@@ -170,18 +176,26 @@ const (
 	// order will trigger when the oracle price moves at or below the trigger
 	// price for buys and at or above the trigger price for sells.
 	IndexerOrder_CONDITION_TYPE_TAKE_PROFIT IndexerOrder_ConditionType = 2
+	// CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+	// trailing stop order triggers like a stop order, but its trigger price
+	// follows the oracle price at a fixed trailing distance as the oracle
+	// price moves in the order's favor: down for buys and up for sells. The
+	// trigger price never moves against the order.
+	IndexerOrder_CONDITION_TYPE_TRAILING_STOP IndexerOrder_ConditionType = 3
 )
 
 var IndexerOrder_ConditionType_name = map[int32]string{
 	0: "CONDITION_TYPE_UNSPECIFIED",
 	1: "CONDITION_TYPE_STOP_LOSS",
 	2: "CONDITION_TYPE_TAKE_PROFIT",
+	3: "CONDITION_TYPE_TRAILING_STOP",
 }
 
 var IndexerOrder_ConditionType_value = map[string]int32{
-	"CONDITION_TYPE_UNSPECIFIED": 0,
-	"CONDITION_TYPE_STOP_LOSS":   1,
-	"CONDITION_TYPE_TAKE_PROFIT": 2,
+	"CONDITION_TYPE_UNSPECIFIED":   0,
+	"CONDITION_TYPE_STOP_LOSS":     1,
+	"CONDITION_TYPE_TAKE_PROFIT":   2,
+	"CONDITION_TYPE_TRAILING_STOP": 3,
 }
 
 func (x IndexerOrder_ConditionType) String() string {
@@ -299,6 +313,7 @@ type IndexerOrder struct {
 	// Information about when the order expires.
 	//
 	// Types that are valid to be assigned to GoodTilOneof:
+	//
 	//	*IndexerOrder_GoodTilBlock
 	//	*IndexerOrder_GoodTilBlockTime
 	GoodTilOneof isIndexerOrder_GoodTilOneof `protobuf_oneof:"good_til_oneof"`
@@ -322,6 +337,16 @@ type IndexerOrder struct {
 	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
 	// orderId.ClobPairId`).
 	ConditionalOrderTriggerSubticks uint64 `protobuf:"varint,11,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+	// conditional_order_trailing_ppm represents the trailing distance of a
+	// trailing stop order as parts-per-million of the oracle price. Exactly one
+	// of conditional_order_trailing_ppm and conditional_order_trailing_subticks
+	// must be nonzero if the condition_type is CONDITION_TYPE_TRAILING_STOP, and
+	// both are enforced to be 0 otherwise. Must be less than 1,000,000.
+	ConditionalOrderTrailingPpm uint32 `protobuf:"varint,12,opt,name=conditional_order_trailing_ppm,json=conditionalOrderTrailingPpm,proto3" json:"conditional_order_trailing_ppm,omitempty"`
+	// conditional_order_trailing_subticks represents the trailing distance of a
+	// trailing stop order in subticks. Must be a multiple of
+	// ClobPair.SubticksPerTick (where `ClobPair.Id = orderId.ClobPairId`).
+	ConditionalOrderTrailingSubticks uint64 `protobuf:"varint,13,opt,name=conditional_order_trailing_subticks,json=conditionalOrderTrailingSubticks,proto3" json:"conditional_order_trailing_subticks,omitempty"`
 }
 
 func (m *IndexerOrder) Reset()         { *m = IndexerOrder{} }
@@ -457,6 +482,20 @@ func (m *IndexerOrder) GetConditionalOrderTriggerSubticks() uint64 {
 	return 0
 }
 
+func (m *IndexerOrder) GetConditionalOrderTrailingPpm() uint32 {
+	if m != nil {
+		return m.ConditionalOrderTrailingPpm
+	}
+	return 0
+}
+
+func (m *IndexerOrder) GetConditionalOrderTrailingSubticks() uint64 {
+	if m != nil {
+		return m.ConditionalOrderTrailingSubticks
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*IndexerOrder) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -479,58 +518,63 @@ func init() {
 }
 
 var fileDescriptor_fac8923e70f7ca3c = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x73, 0xdb, 0x54,
-	0x14, 0xb5, 0x1c, 0xb7, 0x71, 0xae, 0x3f, 0x10, 0x8f, 0x32, 0x15, 0x49, 0xeb, 0x18, 0x2f, 0x20,
-	0x03, 0x83, 0x4d, 0x5a, 0x58, 0xc0, 0xc0, 0xc2, 0x56, 0xe4, 0xf6, 0x4d, 0x54, 0x49, 0x48, 0x0a,
-	0x4c, 0xba, 0xe0, 0x21, 0x4b, 0x2f, 0xee, 0x9b, 0xca, 0x7a, 0x41, 0x96, 0x32, 0xf5, 0x8e, 0x9f,
-	0xc0, 0xcf, 0xea, 0xb2, 0xb0, 0x60, 0x58, 0x31, 0x4c, 0xf2, 0x47, 0x98, 0x27, 0x09, 0x55, 0x8e,
-	0x3b, 0x94, 0xec, 0x74, 0xcf, 0x3d, 0xf7, 0xcc, 0x3d, 0xe7, 0xca, 0x32, 0x7c, 0x1a, 0xac, 0x82,
-	0x17, 0xe7, 0x31, 0x4f, 0xb8, 0xcf, 0xc3, 0x11, 0x8b, 0x02, 0xfa, 0x82, 0xc6, 0xa3, 0x12, 0xb8,
-	0x38, 0x1c, 0xf9, 0x21, 0x9f, 0x0d, 0x33, 0x00, 0xf5, 0xab, 0xe4, 0x61, 0x41, 0x1e, 0x96, 0xc0,
-	0xc5, 0xe1, 0xee, 0xe1, 0x5b, 0xe5, 0x96, 0xe9, 0xcc, 0xf3, 0x7d, 0x9e, 0x46, 0x49, 0x3e, 0xb8,
-	0x7b, 0x67, 0xce, 0xe7, 0x3c, 0x7b, 0x1c, 0x89, 0xa7, 0x1c, 0x1d, 0xfc, 0x2e, 0x41, 0x17, 0xe7,
-	0xe3, 0x66, 0x1c, 0xd0, 0x18, 0x07, 0xe8, 0x27, 0xe8, 0xbc, 0x1e, 0x26, 0x2c, 0x50, 0xa4, 0xbe,
-	0x74, 0xd0, 0x7a, 0xf0, 0xe5, 0xf0, 0x6d, 0x5b, 0x0d, 0x0b, 0x21, 0xa7, 0x9c, 0xc6, 0xc1, 0xa4,
-	0xf1, 0xf2, 0xaf, 0xfd, 0x9a, 0xdd, 0x5e, 0x56, 0x30, 0xb4, 0x07, 0x3b, 0x7e, 0xc8, 0x68, 0xae,
-	0x5e, 0xef, 0x4b, 0x07, 0xdb, 0x76, 0x33, 0x07, 0x70, 0x80, 0xf6, 0xa1, 0xc5, 0xc5, 0x26, 0xe4,
-	0x2c, 0xf4, 0xe6, 0x4b, 0x65, 0xab, 0x2f, 0x1d, 0x74, 0x6c, 0xc8, 0xa0, 0xa9, 0x40, 0x50, 0x1f,
-	0xda, 0x22, 0x2b, 0x72, 0xee, 0xb1, 0x58, 0x08, 0x34, 0x72, 0x86, 0xc0, 0x2c, 0x8f, 0xc5, 0x38,
-	0x18, 0xfc, 0xb1, 0x0d, 0xed, 0xaa, 0x29, 0xf4, 0x1d, 0x34, 0x73, 0xcd, 0xd2, 0xcd, 0xe7, 0xff,
-	0xdb, 0x4d, 0x11, 0x4b, 0x61, 0x64, 0x9b, 0x17, 0x29, 0x3d, 0x82, 0xc6, 0x92, 0x05, 0x34, 0x5b,
-	0xbf, 0xfb, 0xe0, 0xe1, 0xcd, 0xe4, 0x86, 0x0e, 0x0b, 0xa8, 0x9d, 0x09, 0xa0, 0x5d, 0x68, 0xfe,
-	0x9c, 0x7a, 0x51, 0x92, 0x2e, 0x72, 0xb3, 0x0d, 0xbb, 0xac, 0x45, 0x6f, 0x99, 0xce, 0x12, 0xe6,
-	0x3f, 0x5f, 0x66, 0x36, 0x1b, 0x76, 0x59, 0xa3, 0x8f, 0xa0, 0x3b, 0xe7, 0x3c, 0x20, 0x09, 0x0b,
-	0xc9, 0x2c, 0xe4, 0xfe, 0x73, 0xe5, 0x96, 0x08, 0xe2, 0x71, 0xcd, 0x6e, 0x0b, 0xdc, 0x65, 0xe1,
-	0x44, 0xa0, 0x68, 0x04, 0xef, 0xad, 0xf3, 0x48, 0xc2, 0x16, 0x54, 0xb9, 0x2d, 0x62, 0x7f, 0x5c,
-	0xb3, 0xe5, 0x2a, 0xd9, 0x65, 0x0b, 0x8a, 0x7e, 0x84, 0x8e, 0x60, 0x10, 0x16, 0x91, 0x33, 0x1e,
-	0xfb, 0x54, 0xd9, 0xce, 0x2c, 0x7e, 0x7d, 0x43, 0x8b, 0x42, 0x0b, 0x47, 0x53, 0xa1, 0x60, 0xb7,
-	0x92, 0xd7, 0x85, 0x38, 0x70, 0x4c, 0x83, 0xd4, 0xa7, 0x84, 0x47, 0xe1, 0x4a, 0x69, 0xf6, 0xa5,
-	0x83, 0xa6, 0x0d, 0x39, 0x64, 0x46, 0xe1, 0x0a, 0x7d, 0x0c, 0xef, 0x14, 0xaf, 0xc7, 0x82, 0x26,
-	0x5e, 0xe0, 0x25, 0x9e, 0xb2, 0x93, 0xdd, 0xb8, 0x9b, 0xc3, 0x4f, 0x0a, 0x14, 0xf9, 0xd0, 0xf5,
-	0x79, 0x14, 0xb0, 0x84, 0xf1, 0x88, 0x24, 0xab, 0x73, 0xaa, 0x40, 0xb6, 0xea, 0x37, 0x37, 0x5c,
-	0x55, 0xfd, 0x57, 0xc4, 0x5d, 0x9d, 0x53, 0xbb, 0xe3, 0x57, 0x4b, 0x74, 0x0c, 0x83, 0x12, 0xf0,
-	0x42, 0x92, 0xbf, 0x47, 0x49, 0xcc, 0xe6, 0x73, 0x1a, 0x93, 0xf2, 0x3a, 0xad, 0xec, 0x3a, 0xfb,
-	0x15, 0x66, 0x26, 0xed, 0xe6, 0x3c, 0xa7, 0xa0, 0x0d, 0xbe, 0x82, 0x86, 0x38, 0x3d, 0xba, 0x03,
-	0xb2, 0x83, 0x8f, 0x34, 0x72, 0x62, 0x38, 0x96, 0xa6, 0xe2, 0x29, 0xd6, 0x8e, 0xe4, 0x1a, 0x6a,
-	0x43, 0x33, 0x43, 0x27, 0x27, 0xa7, 0xb2, 0x84, 0x3a, 0xb0, 0x93, 0x55, 0x8e, 0xa6, 0xeb, 0x72,
-	0x7d, 0xf0, 0x8b, 0x04, 0xad, 0x4a, 0xa6, 0xe8, 0x3e, 0x7c, 0xe0, 0xe2, 0x27, 0x1a, 0xc1, 0x06,
-	0x99, 0x9a, 0xb6, 0x7a, 0x5d, 0xeb, 0x7d, 0x78, 0x77, 0xbd, 0x8d, 0x4d, 0x55, 0x96, 0xd0, 0x1e,
-	0xdc, 0x5d, 0x87, 0x2d, 0xd3, 0x71, 0x89, 0x69, 0xe8, 0xa7, 0x72, 0x1d, 0xf5, 0x60, 0x77, 0xbd,
-	0x39, 0xc5, 0xba, 0x4e, 0x4c, 0x9b, 0x1c, 0x63, 0x5d, 0x97, 0xb7, 0x06, 0x0b, 0xe8, 0xac, 0x45,
-	0x25, 0x06, 0x54, 0xd3, 0x38, 0xc2, 0x2e, 0x36, 0x0d, 0xe2, 0x9e, 0x5a, 0xd7, 0x97, 0xb8, 0x07,
-	0xca, 0xb5, 0xbe, 0xe3, 0x9a, 0x16, 0xd1, 0x4d, 0xc7, 0x91, 0xa5, 0x37, 0x4c, 0xbb, 0xe3, 0x63,
-	0x8d, 0x58, 0xb6, 0x39, 0xc5, 0xae, 0x5c, 0x9f, 0xc8, 0x95, 0x37, 0x9c, 0x47, 0x94, 0x9f, 0x7d,
-	0xf2, 0x9b, 0x04, 0x5d, 0xb5, 0xf8, 0x9d, 0x3b, 0x89, 0x97, 0xa4, 0xe2, 0x6b, 0x70, 0x4f, 0xd5,
-	0xcd, 0x09, 0xb1, 0xc6, 0xd8, 0x26, 0x8e, 0x3b, 0x76, 0x4f, 0x9c, 0x6b, 0x4b, 0xec, 0xc1, 0xdd,
-	0x0d, 0xc6, 0x58, 0x75, 0xf1, 0xf7, 0x5a, 0x9e, 0xc7, 0x46, 0xd3, 0x1a, 0x9f, 0x38, 0xda, 0x91,
-	0x5c, 0x7f, 0xa3, 0xb6, 0x3a, 0x36, 0x54, 0x4d, 0xcf, 0x13, 0xdb, 0xca, 0x2c, 0x6c, 0x8c, 0x97,
-	0x89, 0x36, 0xd0, 0x87, 0x70, 0x7f, 0xa3, 0x8f, 0x0d, 0xec, 0xe2, 0xb1, 0x8e, 0x9f, 0x62, 0xe3,
-	0x91, 0x7c, 0x6b, 0xf2, 0xc3, 0xcb, 0xcb, 0x9e, 0xf4, 0xea, 0xb2, 0x27, 0xfd, 0x7d, 0xd9, 0x93,
-	0x7e, 0xbd, 0xea, 0xd5, 0x5e, 0x5d, 0xf5, 0x6a, 0x7f, 0x5e, 0xf5, 0x6a, 0x4f, 0xbf, 0x9d, 0xb3,
-	0xe4, 0x59, 0x3a, 0x1b, 0xfa, 0x7c, 0x31, 0x5a, 0xfb, 0xde, 0x5f, 0x7c, 0xf1, 0x99, 0xff, 0xcc,
-	0x63, 0xd1, 0xe8, 0xbf, 0xfe, 0x01, 0x66, 0xb7, 0xb3, 0xe2, 0xe1, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x19, 0x52, 0x63, 0x4b, 0x7b, 0x06, 0x00, 0x00,
+	// 883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x73, 0xdb, 0x44,
+	0x14, 0xb5, 0x12, 0x37, 0x71, 0x6e, 0x6c, 0x23, 0x96, 0x32, 0x15, 0x49, 0xeb, 0xb8, 0x66, 0x80,
+	0x0c, 0x0c, 0x36, 0x69, 0xe1, 0x01, 0x86, 0x17, 0x5b, 0x91, 0xd3, 0x9d, 0x28, 0x92, 0x91, 0x36,
+	0xcc, 0xa4, 0x33, 0xb0, 0xc8, 0x92, 0xe2, 0xee, 0x54, 0xd6, 0x1a, 0x59, 0xce, 0xd4, 0x6f, 0xfc,
+	0x01, 0x06, 0x7e, 0x56, 0x1f, 0x3b, 0x3c, 0xf1, 0xc4, 0x30, 0xc9, 0x9f, 0xe0, 0x91, 0xd9, 0x95,
+	0xa3, 0xfa, 0x23, 0xb4, 0xe4, 0x4d, 0x7b, 0xee, 0xb9, 0x67, 0xce, 0xb9, 0x77, 0x25, 0xc1, 0x67,
+	0xc1, 0x34, 0x78, 0x31, 0x4a, 0x78, 0xca, 0x7d, 0x1e, 0xb5, 0x58, 0x1c, 0x84, 0x2f, 0xc2, 0xa4,
+	0x95, 0x03, 0x17, 0x07, 0x2d, 0x3f, 0xe2, 0xfd, 0xa6, 0x04, 0x50, 0x7d, 0x9e, 0xdc, 0x9c, 0x91,
+	0x9b, 0x39, 0x70, 0x71, 0xb0, 0x73, 0xf0, 0x56, 0xb9, 0xf1, 0xa4, 0xef, 0xf9, 0x3e, 0x9f, 0xc4,
+	0x69, 0xd6, 0xb8, 0x73, 0x77, 0xc0, 0x07, 0x5c, 0x3e, 0xb6, 0xc4, 0x53, 0x86, 0x36, 0xfe, 0x50,
+	0xa0, 0x8a, 0xb3, 0x76, 0x3b, 0x09, 0xc2, 0x04, 0x07, 0xe8, 0x27, 0xa8, 0xbc, 0x6e, 0xa6, 0x2c,
+	0xd0, 0x94, 0xba, 0xb2, 0xbf, 0xfd, 0xe8, 0xab, 0xe6, 0xdb, 0x5c, 0x35, 0x67, 0x42, 0x6e, 0xde,
+	0x8d, 0x83, 0x4e, 0xf1, 0xe5, 0x5f, 0x7b, 0x05, 0xa7, 0x3c, 0x9e, 0xc3, 0xd0, 0x2e, 0x6c, 0xf9,
+	0x11, 0x0b, 0x33, 0xf5, 0xb5, 0xba, 0xb2, 0xbf, 0xe9, 0x94, 0x32, 0x00, 0x07, 0x68, 0x0f, 0xb6,
+	0xb9, 0x70, 0x42, 0xcf, 0x23, 0x6f, 0x30, 0xd6, 0xd6, 0xeb, 0xca, 0x7e, 0xc5, 0x01, 0x09, 0x75,
+	0x05, 0x82, 0xea, 0x50, 0x16, 0xb3, 0xa2, 0x23, 0x8f, 0x25, 0x42, 0xa0, 0x98, 0x31, 0x04, 0xd6,
+	0xf3, 0x58, 0x82, 0x83, 0xc6, 0xaf, 0x5b, 0x50, 0x9e, 0x0f, 0x85, 0xbe, 0x83, 0x52, 0xa6, 0x99,
+	0xa7, 0xf9, 0xe2, 0x7f, 0xa7, 0x99, 0x8d, 0x65, 0x16, 0x64, 0x93, 0xcf, 0xa6, 0x74, 0x04, 0xc5,
+	0x31, 0x0b, 0x42, 0x69, 0xbf, 0xfa, 0xe8, 0xf1, 0xed, 0xe4, 0x9a, 0x2e, 0x0b, 0x42, 0x47, 0x0a,
+	0xa0, 0x1d, 0x28, 0xfd, 0x3c, 0xf1, 0xe2, 0x74, 0x32, 0xcc, 0xc2, 0x16, 0x9d, 0xfc, 0x2c, 0x6a,
+	0xe3, 0x49, 0x3f, 0x65, 0xfe, 0xf3, 0xb1, 0x8c, 0x59, 0x74, 0xf2, 0x33, 0xfa, 0x18, 0xaa, 0x03,
+	0xce, 0x03, 0x9a, 0xb2, 0x88, 0xf6, 0x23, 0xee, 0x3f, 0xd7, 0xee, 0x88, 0x41, 0x3c, 0x29, 0x38,
+	0x65, 0x81, 0x13, 0x16, 0x75, 0x04, 0x8a, 0x5a, 0xf0, 0xde, 0x22, 0x8f, 0xa6, 0x6c, 0x18, 0x6a,
+	0x1b, 0x62, 0xec, 0x4f, 0x0a, 0x8e, 0x3a, 0x4f, 0x26, 0x6c, 0x18, 0xa2, 0x1f, 0xa1, 0x22, 0x18,
+	0x94, 0xc5, 0xf4, 0x9c, 0x27, 0x7e, 0xa8, 0x6d, 0xca, 0x88, 0xdf, 0xdc, 0x32, 0xa2, 0xd0, 0xc2,
+	0x71, 0x57, 0x28, 0x38, 0xdb, 0xe9, 0xeb, 0x83, 0x58, 0x70, 0x12, 0x06, 0x13, 0x3f, 0xa4, 0x3c,
+	0x8e, 0xa6, 0x5a, 0xa9, 0xae, 0xec, 0x97, 0x1c, 0xc8, 0x20, 0x3b, 0x8e, 0xa6, 0xe8, 0x13, 0x78,
+	0x67, 0x76, 0x3d, 0x86, 0x61, 0xea, 0x05, 0x5e, 0xea, 0x69, 0x5b, 0x72, 0xc7, 0xd5, 0x0c, 0x3e,
+	0x99, 0xa1, 0xc8, 0x87, 0xaa, 0xcf, 0xe3, 0x80, 0xa5, 0x8c, 0xc7, 0x34, 0x9d, 0x8e, 0x42, 0x0d,
+	0xa4, 0xd5, 0x6f, 0x6f, 0x69, 0x55, 0xbf, 0x16, 0x21, 0xd3, 0x51, 0xe8, 0x54, 0xfc, 0xf9, 0x23,
+	0x3a, 0x86, 0x46, 0x0e, 0x78, 0x11, 0xcd, 0xee, 0x51, 0x9a, 0xb0, 0xc1, 0x20, 0x4c, 0x68, 0xbe,
+	0x9d, 0x6d, 0xb9, 0x9d, 0xbd, 0x39, 0xa6, 0x94, 0x26, 0x19, 0xcf, 0xbd, 0x5e, 0x9a, 0x0e, 0xb5,
+	0x9b, 0xc4, 0x3c, 0x16, 0xb1, 0x78, 0x40, 0x47, 0xa3, 0xa1, 0x56, 0x96, 0x49, 0x77, 0x57, 0x85,
+	0x32, 0x4e, 0x6f, 0x34, 0x44, 0x27, 0xf0, 0xe1, 0x1b, 0x44, 0x72, 0x4b, 0x15, 0x69, 0xa9, 0xfe,
+	0x5f, 0x4a, 0xd7, 0x9e, 0x1a, 0x5f, 0x43, 0x51, 0x5c, 0x47, 0x74, 0x17, 0x54, 0x17, 0x1f, 0x1a,
+	0xf4, 0xd4, 0x72, 0x7b, 0x86, 0x8e, 0xbb, 0xd8, 0x38, 0x54, 0x0b, 0xa8, 0x0c, 0x25, 0x89, 0x76,
+	0x4e, 0xcf, 0x54, 0x05, 0x55, 0x60, 0x4b, 0x9e, 0x5c, 0xc3, 0x34, 0xd5, 0xb5, 0xc6, 0x2f, 0x0a,
+	0x6c, 0xcf, 0xed, 0x19, 0x3d, 0x80, 0x0f, 0x08, 0x3e, 0x31, 0x28, 0xb6, 0x68, 0xd7, 0x76, 0xf4,
+	0x65, 0xad, 0xf7, 0xe1, 0xdd, 0xc5, 0x32, 0xb6, 0x75, 0x55, 0x41, 0xbb, 0x70, 0x6f, 0x11, 0xee,
+	0xd9, 0x2e, 0xa1, 0xb6, 0x65, 0x9e, 0xa9, 0x6b, 0xa8, 0x06, 0x3b, 0x8b, 0xc5, 0x2e, 0x36, 0x4d,
+	0x6a, 0x3b, 0xf4, 0x18, 0x9b, 0xa6, 0xba, 0xde, 0xf8, 0x4d, 0x81, 0xca, 0xc2, 0xfe, 0x44, 0x87,
+	0x6e, 0x5b, 0x87, 0x98, 0x60, 0xdb, 0xa2, 0xe4, 0xac, 0xb7, 0xec, 0xe2, 0x3e, 0x68, 0x4b, 0x75,
+	0x97, 0xd8, 0x3d, 0x6a, 0xda, 0xae, 0xab, 0x2a, 0x37, 0x74, 0x93, 0xf6, 0xb1, 0x41, 0x7b, 0x8e,
+	0xdd, 0xc5, 0x44, 0x5d, 0x43, 0x75, 0xb8, 0xbf, 0x5c, 0x77, 0xda, 0xd8, 0xc4, 0xd6, 0x91, 0x94,
+	0x51, 0xd7, 0x3b, 0xea, 0xdc, 0x8b, 0xc9, 0xe3, 0x90, 0x9f, 0x7f, 0xfa, 0x8f, 0x02, 0x55, 0x7d,
+	0xf6, 0x79, 0x72, 0x53, 0x2f, 0x9d, 0x8c, 0xa5, 0x8c, 0x69, 0x77, 0x68, 0xaf, 0x8d, 0x1d, 0xea,
+	0x92, 0x36, 0x39, 0x75, 0x97, 0x6c, 0xee, 0xc2, 0xbd, 0x15, 0x46, 0x5b, 0x27, 0xf8, 0x7b, 0x43,
+	0x55, 0x6e, 0x2c, 0xf6, 0xda, 0xa7, 0xae, 0x71, 0x38, 0xb3, 0xb8, 0x5c, 0xd4, 0xdb, 0x96, 0x6e,
+	0x98, 0xd9, 0x50, 0xd7, 0x65, 0xc8, 0x95, 0xf6, 0x7c, 0xe8, 0x45, 0xf4, 0x10, 0x1e, 0xac, 0xd4,
+	0xb1, 0x85, 0x09, 0x6e, 0x9b, 0xf8, 0x29, 0xb6, 0x8e, 0xd4, 0x3b, 0xe8, 0x23, 0x78, 0xb8, 0x42,
+	0xe9, 0x62, 0xab, 0x6d, 0x52, 0xd7, 0x20, 0xc4, 0x34, 0x4e, 0x0c, 0x8b, 0xa8, 0x1b, 0x9d, 0x1f,
+	0x5e, 0x5e, 0xd6, 0x94, 0x57, 0x97, 0x35, 0xe5, 0xef, 0xcb, 0x9a, 0xf2, 0xfb, 0x55, 0xad, 0xf0,
+	0xea, 0xaa, 0x56, 0xf8, 0xf3, 0xaa, 0x56, 0x78, 0xaa, 0x0f, 0x58, 0xfa, 0x6c, 0xd2, 0x6f, 0xfa,
+	0x7c, 0xd8, 0x5a, 0xf8, 0x9b, 0x5d, 0x7c, 0xf9, 0xb9, 0xff, 0xcc, 0x63, 0x71, 0xeb, 0x8d, 0xff,
+	0x37, 0xf1, 0xba, 0x8f, 0xfb, 0x1b, 0x12, 0x7a, 0xfc, 0xef, 0x00, 0x79, 0x0e, 0xa3, 0x5c, 0x5f,
+	0x07, 0x00, 0x00,
 }
 
 func (m *IndexerOrderId) Marshal() (dAtA []byte, err error) {
@@ -602,6 +646,16 @@ func (m *IndexerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConditionalOrderTrailingSubticks != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.ConditionalOrderTrailingSubticks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ConditionalOrderTrailingPpm != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.ConditionalOrderTrailingPpm))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		i = encodeVarintClob(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
@@ -760,6 +814,12 @@ func (m *IndexerOrder) Size() (n int) {
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		n += 1 + sovClob(uint64(m.ConditionalOrderTriggerSubticks))
 	}
+	if m.ConditionalOrderTrailingPpm != 0 {
+		n += 1 + sovClob(uint64(m.ConditionalOrderTrailingPpm))
+	}
+	if m.ConditionalOrderTrailingSubticks != 0 {
+		n += 1 + sovClob(uint64(m.ConditionalOrderTrailingSubticks))
+	}
 	return n
 }
 
@@ -1165,6 +1225,44 @@ func (m *IndexerOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTrailingPpm", wireType)
+			}
+			m.ConditionalOrderTrailingPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderTrailingPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTrailingSubticks", wireType)
+			}
+			m.ConditionalOrderTrailingSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderTrailingSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClob(dAtA[iNdEx:])
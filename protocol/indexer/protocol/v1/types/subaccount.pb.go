@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: dydxprotocol/indexer/protocol/v1/subaccount.proto
 
-package v1
+package types
 
 import (
 	fmt "fmt"
@@ -209,33 +209,33 @@ func init() {
 }
 
 var fileDescriptor_4c5845963309ad8f = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3f, 0xef, 0xd2, 0x40,
-	0x1c, 0xc6, 0x5b, 0x7f, 0x7f, 0xfc, 0x79, 0x82, 0x26, 0xb5, 0x31, 0x85, 0xa1, 0x20, 0x13, 0x0b,
-	0x6d, 0x88, 0xae, 0x26, 0xc2, 0x64, 0x37, 0x52, 0x06, 0x13, 0x13, 0x43, 0xae, 0xbd, 0xb3, 0x5c,
-	0xd2, 0xde, 0x95, 0xfb, 0x83, 0xe0, 0xee, 0xee, 0x1b, 0x71, 0xf3, 0x45, 0x30, 0x12, 0x27, 0xe3,
-	0x40, 0x0c, 0xbc, 0x11, 0xc3, 0x5d, 0x29, 0x9a, 0x38, 0x38, 0x10, 0xb7, 0x7b, 0x3e, 0xf9, 0x7e,
-	0x9f, 0x27, 0x79, 0xbe, 0x39, 0x30, 0x44, 0x6b, 0xb4, 0x2a, 0x39, 0x93, 0x2c, 0x65, 0x79, 0x48,
-	0x28, 0xc2, 0x2b, 0xcc, 0xc3, 0x1a, 0x2c, 0x87, 0xa1, 0x50, 0x09, 0x4c, 0x53, 0xa6, 0xa8, 0x0c,
-	0x34, 0x76, 0xba, 0xbf, 0xaf, 0x04, 0xd5, 0x4a, 0x50, 0x83, 0xe5, 0xb0, 0xdd, 0x4a, 0x99, 0x28,
-	0x98, 0x98, 0x69, 0x16, 0x1a, 0x61, 0x06, 0xda, 0x6e, 0xc6, 0x32, 0x66, 0xf8, 0xf1, 0x65, 0x68,
-	0xef, 0x1d, 0x78, 0x12, 0x19, 0x9f, 0x69, 0x9d, 0x16, 0x21, 0x27, 0x00, 0x37, 0xec, 0x03, 0xc5,
-	0xdc, 0xb3, 0xbb, 0x76, 0xff, 0xc1, 0xd8, 0xfb, 0xf6, 0x75, 0xe0, 0x56, 0x6e, 0x23, 0x84, 0x38,
-	0x16, 0x62, 0x2a, 0x39, 0xa1, 0x59, 0x6c, 0xc6, 0x9c, 0xa7, 0xe0, 0x96, 0xaa, 0x22, 0xc1, 0xdc,
-	0xbb, 0xd7, 0xb5, 0xfb, 0xcd, 0xb8, 0x52, 0xbd, 0x4f, 0x57, 0xc0, 0xab, 0xfc, 0x27, 0x98, 0x97,
-	0x58, 0x2a, 0x98, 0x4f, 0x98, 0x20, 0x92, 0x30, 0xea, 0x3c, 0x03, 0x8d, 0xf2, 0x04, 0x67, 0x04,
-	0xe9, 0xac, 0x66, 0xfc, 0xb0, 0x66, 0x11, 0x72, 0x10, 0xb8, 0x5b, 0x28, 0x48, 0xa5, 0x2a, 0x84,
-	0x76, 0x6e, 0x8c, 0x5f, 0x6f, 0x76, 0x1d, 0xeb, 0xc7, 0xae, 0xf3, 0x2a, 0x23, 0x72, 0xae, 0x92,
-	0x20, 0x65, 0x45, 0xf8, 0x47, 0x93, 0xcb, 0x17, 0x83, 0x74, 0x0e, 0x09, 0x3d, 0x57, 0x89, 0xe4,
-	0xba, 0xc4, 0x22, 0x98, 0x62, 0x4e, 0x60, 0x4e, 0x3e, 0xc2, 0x24, 0xc7, 0x11, 0x95, 0x71, 0xed,
-	0xec, 0x14, 0xa0, 0xf9, 0x5e, 0x51, 0x44, 0x68, 0x36, 0xd3, 0xa5, 0x7a, 0x57, 0x17, 0x8e, 0x6a,
-	0x54, 0xf6, 0xba, 0x0a, 0x67, 0x01, 0x1e, 0x9f, 0xe2, 0x4a, 0xb8, 0x2e, 0x30, 0x95, 0xde, 0xf5,
-	0x85, 0x03, 0x1f, 0x55, 0x01, 0x13, 0xe3, 0xdf, 0xfb, 0x62, 0x03, 0xb7, 0xba, 0xc3, 0x48, 0x08,
-	0x2c, 0xeb, 0x1b, 0xb4, 0xc0, 0x1d, 0x3c, 0x82, 0x73, 0xff, 0xf7, 0xb5, 0xfe, 0x6f, 0xdd, 0xbb,
-	0xe0, 0xe6, 0xdc, 0xf9, 0x75, 0x6c, 0xc4, 0xf8, 0xcd, 0x66, 0xef, 0xdb, 0xdb, 0xbd, 0x6f, 0xff,
-	0xdc, 0xfb, 0xf6, 0xe7, 0x83, 0x6f, 0x6d, 0x0f, 0xbe, 0xf5, 0xfd, 0xe0, 0x5b, 0x6f, 0x5f, 0xfe,
-	0x7b, 0xf6, 0x5f, 0xfe, 0x54, 0x72, 0xab, 0xc5, 0xf3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7e,
-	0xd9, 0xb3, 0xdc, 0x7e, 0x03, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcf, 0xae, 0xd2, 0x40,
+	0x18, 0xc5, 0x5b, 0xef, 0x1f, 0xaf, 0x23, 0x68, 0x52, 0x1b, 0xd3, 0xcb, 0xa2, 0x20, 0x2b, 0x36,
+	0xb4, 0x21, 0xfa, 0x00, 0x82, 0x1b, 0xbb, 0x23, 0x65, 0x67, 0x42, 0xc8, 0xb4, 0x33, 0x96, 0x49,
+	0xda, 0x99, 0x32, 0x7f, 0x10, 0xdc, 0xbb, 0xf7, 0x45, 0xdc, 0xf9, 0x10, 0x2c, 0x89, 0x2b, 0xe3,
+	0x82, 0x18, 0x78, 0x11, 0xc3, 0x4c, 0x29, 0x9a, 0xb8, 0x70, 0x41, 0xdc, 0xf5, 0xfc, 0xf2, 0x7d,
+	0xe7, 0x34, 0xe7, 0xcb, 0x80, 0x01, 0x5a, 0xa3, 0x55, 0xc9, 0x99, 0x64, 0x29, 0xcb, 0x43, 0x42,
+	0x11, 0x5e, 0x61, 0x1e, 0xd6, 0x60, 0x39, 0x08, 0x85, 0x4a, 0x60, 0x9a, 0x32, 0x45, 0x65, 0xa0,
+	0xb1, 0xd3, 0xf9, 0x7d, 0x25, 0xa8, 0x56, 0x82, 0x1a, 0x2c, 0x07, 0xad, 0xfb, 0x94, 0x89, 0x82,
+	0x89, 0x99, 0x66, 0xa1, 0x11, 0x66, 0xa0, 0xe5, 0x66, 0x2c, 0x63, 0x86, 0x1f, 0xbf, 0x0c, 0xed,
+	0x4e, 0xc1, 0xb3, 0xc8, 0xf8, 0x4c, 0xea, 0xb4, 0x08, 0x39, 0x01, 0xb8, 0x61, 0x1f, 0x28, 0xe6,
+	0x9e, 0xdd, 0xb1, 0x7b, 0x8f, 0x46, 0xde, 0xb7, 0xaf, 0x7d, 0xb7, 0x72, 0x1b, 0x22, 0xc4, 0xb1,
+	0x10, 0x13, 0xc9, 0x09, 0xcd, 0x62, 0x33, 0xe6, 0x3c, 0x07, 0xb7, 0x54, 0x15, 0x09, 0xe6, 0xde,
+	0x83, 0x8e, 0xdd, 0x6b, 0xc6, 0x95, 0xea, 0x7e, 0xba, 0x02, 0x5e, 0xe5, 0x3f, 0xc6, 0xbc, 0xc4,
+	0x52, 0xc1, 0x7c, 0xcc, 0x04, 0x91, 0x84, 0x51, 0xe7, 0x05, 0x68, 0x94, 0x27, 0x38, 0x23, 0x48,
+	0x67, 0x35, 0xe3, 0xc7, 0x35, 0x8b, 0x90, 0x83, 0xc0, 0xdd, 0x42, 0x41, 0x2a, 0x55, 0x21, 0xb4,
+	0x73, 0x63, 0xf4, 0x76, 0xb3, 0x6b, 0x5b, 0x3f, 0x76, 0xed, 0xd7, 0x19, 0x91, 0x73, 0x95, 0x04,
+	0x29, 0x2b, 0xc2, 0x3f, 0x9a, 0x5c, 0xbe, 0xea, 0xa7, 0x73, 0x48, 0xe8, 0xb9, 0x4a, 0x24, 0xd7,
+	0x25, 0x16, 0xc1, 0x04, 0x73, 0x02, 0x73, 0xf2, 0x11, 0x26, 0x39, 0x8e, 0xa8, 0x8c, 0x6b, 0x67,
+	0xa7, 0x00, 0xcd, 0xf7, 0x8a, 0x22, 0x42, 0xb3, 0x99, 0x2e, 0xd5, 0xbb, 0xba, 0x70, 0x54, 0xa3,
+	0xb2, 0xd7, 0x55, 0x38, 0x0b, 0xf0, 0xf4, 0x14, 0x57, 0xc2, 0x75, 0x81, 0xa9, 0xf4, 0xae, 0x2f,
+	0x1c, 0xf8, 0xa4, 0x0a, 0x18, 0x1b, 0xff, 0xee, 0x17, 0x1b, 0xb8, 0xd5, 0x1d, 0x86, 0x42, 0x60,
+	0x59, 0xdf, 0xe0, 0x1e, 0xdc, 0xc1, 0x23, 0x38, 0xf7, 0xff, 0x50, 0xeb, 0xff, 0xd6, 0xbd, 0x0b,
+	0x6e, 0xce, 0x9d, 0x5f, 0xc7, 0x46, 0x8c, 0xa6, 0x9b, 0xbd, 0x6f, 0x6f, 0xf7, 0xbe, 0xfd, 0x73,
+	0xef, 0xdb, 0x9f, 0x0f, 0xbe, 0xb5, 0x3d, 0xf8, 0xd6, 0xf7, 0x83, 0x6f, 0xbd, 0x7b, 0xf3, 0xef,
+	0xd9, 0x7f, 0x7b, 0x53, 0xfa, 0x77, 0x92, 0x5b, 0x8d, 0x5e, 0xfe, 0x1a, 0x00, 0x07, 0xb5, 0x3e,
+	0xa3, 0x84, 0x03, 0x00, 0x00,
 }
 
 func (m *IndexerSubaccountId) Marshal() (dAtA []byte, err error) {
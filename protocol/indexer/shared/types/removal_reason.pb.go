@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: dydxprotocol/indexer/shared/removal_reason.proto
 
-package shared
+package types
 
 import (
 	fmt "fmt"
@@ -68,6 +68,12 @@ const (
 	// The order has been removed since the subaccount does not satisfy the
 	// equity tier requirements.
 	OrderRemovalReason_ORDER_REMOVAL_REASON_EQUITY_TIER OrderRemovalReason = 13
+	// The order has been removed since another order of its order group was
+	// triggered, fully filled, canceled or removed.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP OrderRemovalReason = 14
+	// The order has been removed since its clob pair was delisted and moved to
+	// final settlement.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_FINAL_SETTLEMENT OrderRemovalReason = 15
 )
 
 var OrderRemovalReason_name = map[int32]string{
@@ -85,6 +91,8 @@ var OrderRemovalReason_name = map[int32]string{
 	11: "ORDER_REMOVAL_REASON_REPLACED",
 	12: "ORDER_REMOVAL_REASON_FULLY_FILLED",
 	13: "ORDER_REMOVAL_REASON_EQUITY_TIER",
+	14: "ORDER_REMOVAL_REASON_ORDER_GROUP",
+	15: "ORDER_REMOVAL_REASON_FINAL_SETTLEMENT",
 }
 
 var OrderRemovalReason_value = map[string]int32{
@@ -102,6 +110,8 @@ var OrderRemovalReason_value = map[string]int32{
 	"ORDER_REMOVAL_REASON_REPLACED":                               11,
 	"ORDER_REMOVAL_REASON_FULLY_FILLED":                           12,
 	"ORDER_REMOVAL_REASON_EQUITY_TIER":                            13,
+	"ORDER_REMOVAL_REASON_ORDER_GROUP":                            14,
+	"ORDER_REMOVAL_REASON_FINAL_SETTLEMENT":                       15,
 }
 
 func (x OrderRemovalReason) String() string {
@@ -121,33 +131,35 @@ func init() {
 }
 
 var fileDescriptor_0d5eea5cab8c58ba = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x13, 0xa0, 0x05, 0x16, 0x90, 0x56, 0x7b, 0x05, 0xac, 0x16, 0x5a, 0x5a, 0xfe, 0xc5,
-	0x48, 0x20, 0x54, 0x01, 0x12, 0xda, 0x78, 0x27, 0xd2, 0x2a, 0x1b, 0x6f, 0x18, 0xdb, 0xd0, 0xe4,
-	0x32, 0x72, 0x63, 0x8b, 0x44, 0x6a, 0x63, 0xe4, 0x96, 0xaa, 0xbc, 0x01, 0x47, 0x1e, 0x8b, 0x63,
-	0x8e, 0x1c, 0x51, 0xf2, 0x22, 0x48, 0x76, 0x40, 0x54, 0x5a, 0x5f, 0x7c, 0xf1, 0xef, 0xf7, 0xcd,
-	0x67, 0xef, 0x2c, 0x7b, 0x91, 0x7d, 0xcb, 0x2e, 0xbe, 0x94, 0xc5, 0x59, 0x31, 0x29, 0x8e, 0xfd,
-	0xd9, 0x3c, 0xcb, 0x2f, 0xf2, 0xd2, 0x3f, 0x9d, 0xa6, 0x65, 0x9e, 0xf9, 0x65, 0x7e, 0x52, 0x9c,
-	0xa7, 0xc7, 0x54, 0xe6, 0xe9, 0x69, 0x31, 0xef, 0x54, 0x98, 0xb8, 0xfb, 0xbf, 0xd1, 0x59, 0x1b,
-	0x9d, 0xda, 0x78, 0xf2, 0x7d, 0x83, 0x09, 0x5b, 0x66, 0x79, 0x89, 0xb5, 0x8a, 0x95, 0x29, 0x76,
-	0xd8, 0x96, 0x45, 0x05, 0x48, 0x08, 0x03, 0xfb, 0x51, 0x1a, 0x42, 0x90, 0x91, 0x0d, 0x29, 0x09,
-	0xa3, 0x21, 0x04, 0xba, 0xa7, 0x41, 0xf1, 0x96, 0xd8, 0x62, 0xf7, 0x9c, 0x14, 0x1c, 0x0e, 0x35,
-	0x82, 0xe2, 0x6d, 0xf1, 0x88, 0x3d, 0x70, 0xe7, 0x44, 0x80, 0x14, 0xc8, 0x30, 0x00, 0x03, 0x8a,
-	0x5f, 0x11, 0xcf, 0xd8, 0x7e, 0xc3, 0x3c, 0x05, 0x18, 0x58, 0x63, 0x64, 0x0c, 0x28, 0x8d, 0x1e,
-	0x83, 0xe2, 0x57, 0xc5, 0x1e, 0x7b, 0xe8, 0xa4, 0x75, 0x18, 0x03, 0x86, 0xd2, 0x10, 0x20, 0x5a,
-	0xe4, 0xd7, 0xc4, 0x63, 0xb6, 0xeb, 0x04, 0x23, 0x30, 0x3d, 0x8a, 0x51, 0x2a, 0x58, 0xa3, 0x1b,
-	0xe2, 0x0d, 0x7b, 0xed, 0x44, 0x87, 0x36, 0x8a, 0xc9, 0x86, 0x66, 0x44, 0x9f, 0x6c, 0x62, 0x14,
-	0x05, 0x68, 0xa3, 0x88, 0x06, 0xb2, 0x0f, 0x48, 0x95, 0xc0, 0x37, 0xc5, 0x7b, 0xf6, 0xd6, 0xdd,
-	0x67, 0x30, 0x00, 0xa5, 0x65, 0x0c, 0x64, 0xff, 0x7e, 0xed, 0x3a, 0x05, 0xa1, 0x4a, 0xa5, 0xae,
-	0xb5, 0x7d, 0x7e, 0x5d, 0xbc, 0x63, 0x07, 0xce, 0x80, 0x9e, 0xed, 0xd7, 0x43, 0x28, 0xa8, 0xb4,
-	0xd0, 0xc6, 0xd4, 0x05, 0xea, 0x25, 0xc6, 0x8c, 0xaa, 0x27, 0x28, 0x7e, 0x43, 0x3c, 0x65, 0x7b,
-	0x4e, 0x1b, 0x41, 0x25, 0x01, 0xd4, 0xe5, 0x11, 0x22, 0x3d, 0x06, 0x7e, 0x53, 0xec, 0xb3, 0x9d,
-	0x86, 0x7f, 0xa7, 0xe0, 0x10, 0xf0, 0xdf, 0xd9, 0x31, 0xb1, 0xcd, 0xee, 0x37, 0xc4, 0x0e, 0x8d,
-	0x0c, 0x40, 0xf1, 0x5b, 0x62, 0x97, 0x6d, 0xbb, 0x7b, 0xd7, 0x05, 0x75, 0x55, 0xf0, 0x76, 0xe3,
-	0x36, 0xc1, 0x87, 0x44, 0xc7, 0x23, 0x8a, 0x35, 0x20, 0xbf, 0xd3, 0xc5, 0x9f, 0x4b, 0xaf, 0xbd,
-	0x58, 0x7a, 0xed, 0xdf, 0x4b, 0xaf, 0xfd, 0x63, 0xe5, 0xb5, 0x16, 0x2b, 0xaf, 0xf5, 0x6b, 0xe5,
-	0xb5, 0xc6, 0x07, 0x9f, 0x67, 0x67, 0xd3, 0xaf, 0x47, 0x9d, 0x49, 0x71, 0xe2, 0x5f, 0x5a, 0xff,
-	0xf3, 0x57, 0xcf, 0x27, 0xd3, 0x74, 0x36, 0xf7, 0x1b, 0x2e, 0xc4, 0xd1, 0x66, 0xf5, 0xe2, 0xe5,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x84, 0xfa, 0x05, 0x36, 0x03, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x5d, 0x6f, 0xd3, 0x3e,
+	0x14, 0xc6, 0xdb, 0xff, 0x9f, 0x0d, 0x30, 0x6f, 0x96, 0x6f, 0x81, 0x68, 0x83, 0x8d, 0x8d, 0xb7,
+	0x06, 0x09, 0x84, 0x10, 0x20, 0x90, 0x1b, 0x9f, 0x20, 0xab, 0x4e, 0x1c, 0x4e, 0x1c, 0x68, 0x7b,
+	0x63, 0x75, 0x4d, 0x44, 0x2b, 0x6d, 0xcd, 0x94, 0x96, 0x69, 0xfb, 0x16, 0x7c, 0x2c, 0x2e, 0x77,
+	0x09, 0x77, 0xa8, 0xfd, 0x22, 0x88, 0xa4, 0x20, 0x90, 0x92, 0x1b, 0x5f, 0xd8, 0xcf, 0xef, 0x39,
+	0x8f, 0x7d, 0x7c, 0xc8, 0x93, 0xf4, 0x2c, 0x3d, 0x3d, 0x2e, 0xf2, 0x45, 0x3e, 0xce, 0x0f, 0xdd,
+	0xe9, 0x2c, 0xcd, 0x4e, 0xb3, 0xc2, 0x9d, 0x4f, 0x46, 0x45, 0x96, 0xba, 0x45, 0x76, 0x94, 0x9f,
+	0x8c, 0x0e, 0x6d, 0x91, 0x8d, 0xe6, 0xf9, 0xac, 0x53, 0xca, 0xd8, 0xcd, 0xbf, 0x89, 0xce, 0x9a,
+	0xe8, 0x54, 0xc4, 0x83, 0xef, 0x1b, 0x84, 0xe9, 0x22, 0xcd, 0x0a, 0xac, 0x50, 0x2c, 0x49, 0xb6,
+	0x43, 0xb6, 0x34, 0x0a, 0x40, 0x8b, 0x10, 0xe8, 0x0f, 0x5c, 0x59, 0x04, 0x1e, 0xeb, 0xd0, 0x26,
+	0x61, 0x1c, 0x81, 0x27, 0x7d, 0x09, 0x82, 0xb6, 0xd8, 0x16, 0xb9, 0x55, 0xab, 0x82, 0x7e, 0x24,
+	0x11, 0x04, 0x6d, 0xb3, 0x7b, 0xe4, 0x4e, 0xbd, 0x4f, 0x0c, 0x68, 0x3d, 0x1e, 0x7a, 0xa0, 0x40,
+	0xd0, 0xff, 0xd8, 0x23, 0xb2, 0xdf, 0x50, 0x4f, 0x00, 0x7a, 0x5a, 0x29, 0x6e, 0x00, 0xb9, 0x92,
+	0x43, 0x10, 0xf4, 0x7f, 0xb6, 0x47, 0xee, 0xd6, 0xaa, 0x65, 0x68, 0x00, 0x43, 0xae, 0x2c, 0x20,
+	0x6a, 0xa4, 0x17, 0xd8, 0x7d, 0xb2, 0x5b, 0x2b, 0x8c, 0x41, 0xf9, 0xd6, 0x20, 0x17, 0xb0, 0x96,
+	0x6e, 0xb0, 0x97, 0xe4, 0x79, 0xad, 0x34, 0xd2, 0xb1, 0xb1, 0x3a, 0x54, 0x03, 0xfb, 0x51, 0x27,
+	0x4a, 0x58, 0x0f, 0x75, 0x1c, 0xdb, 0x80, 0xf7, 0x00, 0x6d, 0x09, 0xd0, 0x4d, 0xf6, 0x96, 0xbc,
+	0xaa, 0xcf, 0x13, 0x04, 0x20, 0x24, 0x37, 0x60, 0xf5, 0xef, 0xdb, 0xae, 0x5d, 0x10, 0x4a, 0x57,
+	0xdb, 0xd5, 0xba, 0x47, 0x2f, 0xb2, 0xd7, 0xe4, 0x45, 0xad, 0x81, 0xaf, 0x7b, 0x55, 0x11, 0xeb,
+	0x95, 0x58, 0xa8, 0x8d, 0xed, 0x82, 0xf5, 0x13, 0xa5, 0x06, 0xe5, 0x0a, 0x82, 0x5e, 0x62, 0x0f,
+	0xc9, 0x5e, 0x2d, 0x8d, 0x20, 0x12, 0x0f, 0xaa, 0xf0, 0x08, 0xb1, 0x1c, 0x02, 0xbd, 0xcc, 0xf6,
+	0xc9, 0x4e, 0xc3, 0xdb, 0x09, 0xe8, 0x03, 0xfe, 0xe9, 0x1d, 0x61, 0xdb, 0xe4, 0x76, 0x83, 0x6d,
+	0xa4, 0xb8, 0x07, 0x82, 0x5e, 0x61, 0xbb, 0x64, 0xbb, 0x3e, 0x77, 0x15, 0x50, 0x96, 0x01, 0xaf,
+	0x36, 0xfe, 0x26, 0x78, 0x9f, 0x48, 0x33, 0xb0, 0x46, 0x02, 0xd2, 0x6b, 0x8d, 0xaa, 0x6a, 0xf3,
+	0x1d, 0xea, 0x24, 0xa2, 0xd7, 0x1b, 0x5b, 0xea, 0xcb, 0x5f, 0x8d, 0x8f, 0xc1, 0x18, 0x05, 0x01,
+	0x84, 0x86, 0xde, 0xe8, 0xf6, 0xbf, 0x2e, 0x9d, 0xf6, 0xf9, 0xd2, 0x69, 0xff, 0x58, 0x3a, 0xed,
+	0x2f, 0x2b, 0xa7, 0x75, 0xbe, 0x72, 0x5a, 0xdf, 0x56, 0x4e, 0x6b, 0xf8, 0xe6, 0xd3, 0x74, 0x31,
+	0xf9, 0x7c, 0xd0, 0x19, 0xe7, 0x47, 0xee, 0x3f, 0xf3, 0x74, 0xf2, 0xec, 0xf1, 0x78, 0x32, 0x9a,
+	0xce, 0xdc, 0xa6, 0x09, 0x5b, 0x9c, 0x1d, 0x67, 0xf3, 0x83, 0xcd, 0xf2, 0xf8, 0xe9, 0xcf, 0x01,
+	0x00, 0x35, 0xf2, 0x73, 0x5d, 0x8d, 0x03, 0x00, 0x00,
 }
@@ -11,6 +11,7 @@ import (
 	slashing "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	assets "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktime "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	bridge "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	clob "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -61,6 +62,10 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		*upgrade.MsgSoftwareUpgrade,
 
 		// ------- Custom modules
+		// assets
+		*assets.MsgSetCollateralWeight,
+		*assets.MsgSetMarginParams,
+
 		// blocktime
 		*blocktime.MsgUpdateDowntimeParams,
 
@@ -72,6 +77,7 @@ func IsInternalMsg(msg sdk.Msg) bool {
 
 		// clob
 		*clob.MsgCreateClobPair,
+		*clob.MsgDelistClobPair,
 		*clob.MsgUpdateBlockRateLimitConfiguration,
 		*clob.MsgUpdateClobPair,
 		*clob.MsgUpdateEquityTierLimitConfiguration,
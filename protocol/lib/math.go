@@ -210,3 +210,89 @@ func Median[V uint64 | uint32 | int64 | int32](input []V) (V, error) {
 	// x and y are both negative.
 	return x + (y-x)/2, nil
 }
+
+// WeightedMedianUint64 calculates the median of `values` where each value is weighted by the corresponding entry
+// of `weights`. Values with a weight of zero are ignored. If the cumulative weight of the values below a value is
+// exactly half of the total weight, the result is the average of that value and the next larger value, rounded up,
+// which matches `Median` when all weights are equal.
+// Returns an error if the lengths of `values` and `weights` differ, if any weight is negative or if the total
+// weight is zero.
+func WeightedMedianUint64(values []uint64, weights []*big.Int) (uint64, error) {
+	if len(values) != len(weights) {
+		return 0, fmt.Errorf(
+			"values and weights must have the same length, but got %v values and %v weights",
+			len(values),
+			len(weights),
+		)
+	}
+
+	type weightedValue struct {
+		value  uint64
+		weight *big.Int
+	}
+	weightedValues := make([]weightedValue, 0, len(values))
+	totalWeight := new(big.Int)
+	for i, value := range values {
+		if weights[i].Sign() < 0 {
+			return 0, errors.New("weights cannot be negative")
+		}
+		if weights[i].Sign() == 0 {
+			continue
+		}
+		weightedValues = append(weightedValues, weightedValue{value: value, weight: weights[i]})
+		totalWeight.Add(totalWeight, weights[i])
+	}
+	if totalWeight.Sign() == 0 {
+		return 0, errors.New("total weight cannot be zero")
+	}
+	sort.Slice(weightedValues, func(i, j int) bool { return weightedValues[i].value < weightedValues[j].value })
+
+	// Find the first value where twice the cumulative weight reaches the total weight.
+	cumulativeWeight := new(big.Int)
+	for i, weightedValue := range weightedValues {
+		cumulativeWeight.Add(cumulativeWeight, weightedValue.weight)
+		switch new(big.Int).Lsh(cumulativeWeight, 1).Cmp(totalWeight) {
+		case 1:
+			return weightedValue.value, nil
+		case 0:
+			// Note x <= y since `weightedValues` is sorted, and a next value must exist since the remaining
+			// weight is non-zero.
+			x := weightedValue.value
+			y := weightedValues[i+1].value
+			return y - (y-x)/2, nil
+		}
+	}
+
+	// Unreachable, since the cumulative weight of all values equals the total weight.
+	return 0, errors.New("failed to find weighted median")
+}
+
+// WeightedMeanUint64 calculates the mean of `values` where each value is weighted by the corresponding entry of
+// `weights`, rounded down to the nearest integer.
+// Returns an error if the lengths of `values` and `weights` differ, if any weight is negative or if the total
+// weight is zero.
+func WeightedMeanUint64(values []uint64, weights []*big.Int) (uint64, error) {
+	if len(values) != len(weights) {
+		return 0, fmt.Errorf(
+			"values and weights must have the same length, but got %v values and %v weights",
+			len(values),
+			len(weights),
+		)
+	}
+
+	weightedSum := new(big.Int)
+	totalWeight := new(big.Int)
+	for i, value := range values {
+		if weights[i].Sign() < 0 {
+			return 0, errors.New("weights cannot be negative")
+		}
+		weightedSum.Add(weightedSum, new(big.Int).Mul(new(big.Int).SetUint64(value), weights[i]))
+		totalWeight.Add(totalWeight, weights[i])
+	}
+	if totalWeight.Sign() == 0 {
+		return 0, errors.New("total weight cannot be zero")
+	}
+
+	// The weighted mean is at most the largest value, so it always fits into a uint64.
+	return weightedSum.Quo(weightedSum, totalWeight).Uint64(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
@@ -750,3 +751,136 @@ func TestMedian_Uint64(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedMedianUint64(t *testing.T) {
+	tests := map[string]struct {
+		values         []uint64
+		weights        []*big.Int
+		expectedResult uint64
+		expectedError  string
+	}{
+		"Mismatched lengths causes error": {
+			values:        []uint64{1, 2},
+			weights:       []*big.Int{big.NewInt(1)},
+			expectedError: "values and weights must have the same length, but got 2 values and 1 weights",
+		},
+		"Empty input causes error": {
+			values:        []uint64{},
+			weights:       []*big.Int{},
+			expectedError: "total weight cannot be zero",
+		},
+		"Zero total weight causes error": {
+			values:        []uint64{1, 2},
+			weights:       []*big.Int{big.NewInt(0), big.NewInt(0)},
+			expectedError: "total weight cannot be zero",
+		},
+		"Equal weights, odd number input": {
+			values:         []uint64{2, 0, 1, 3, 4},
+			weights:        []*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1)},
+			expectedResult: 2,
+		},
+		"Equal weights, even number input": {
+			values:         []uint64{5, 12, 1, 3, 12, 50}, // median is (5+12)/2=8.5
+			weights: []*big.Int{
+				big.NewInt(7), big.NewInt(7), big.NewInt(7), big.NewInt(7), big.NewInt(7), big.NewInt(7),
+			},
+			expectedResult: 9,
+		},
+		"Heaviest value outweighs all others": {
+			values:         []uint64{100, 200, 300},
+			weights:        []*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(3)},
+			expectedResult: 300,
+		},
+		"Weight split exactly in half": {
+			values:         []uint64{100, 200, 300},
+			weights:        []*big.Int{big.NewInt(2), big.NewInt(0), big.NewInt(2)},
+			expectedResult: 200,
+		},
+		"Zero-weight values are ignored": {
+			values:         []uint64{1, 100, 1_000_000},
+			weights:        []*big.Int{big.NewInt(0), big.NewInt(5), big.NewInt(0)},
+			expectedResult: 100,
+		},
+		"Weights larger than uint64": {
+			values: []uint64{100, 200, 300},
+			weights: []*big.Int{
+				new(big.Int).Lsh(big.NewInt(1), 100),
+				new(big.Int).Lsh(big.NewInt(1), 100),
+				new(big.Int).Lsh(big.NewInt(3), 100),
+			},
+			expectedResult: 300,
+		},
+		"Negative weight causes error": {
+			values:        []uint64{1, 2},
+			weights:       []*big.Int{big.NewInt(1), big.NewInt(-1)},
+			expectedError: "weights cannot be negative",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := lib.WeightedMedianUint64(tc.values, tc.weights)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestWeightedMeanUint64(t *testing.T) {
+	tests := map[string]struct {
+		values         []uint64
+		weights        []*big.Int
+		expectedResult uint64
+		expectedError  string
+	}{
+		"Mismatched lengths causes error": {
+			values:        []uint64{1},
+			weights:       []*big.Int{big.NewInt(1), big.NewInt(2)},
+			expectedError: "values and weights must have the same length, but got 1 values and 2 weights",
+		},
+		"Zero total weight causes error": {
+			values:        []uint64{1, 2},
+			weights:       []*big.Int{big.NewInt(0), big.NewInt(0)},
+			expectedError: "total weight cannot be zero",
+		},
+		"Equal weights": {
+			values:         []uint64{100, 200, 300},
+			weights:        []*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1)},
+			expectedResult: 200,
+		},
+		"Unequal weights": {
+			values:         []uint64{100, 200},
+			weights:        []*big.Int{big.NewInt(3), big.NewInt(1)}, // (300 + 200) / 4 = 125
+			expectedResult: 125,
+		},
+		"Result is rounded down": {
+			values:         []uint64{100, 101},
+			weights:        []*big.Int{big.NewInt(1), big.NewInt(1)}, // 100.5
+			expectedResult: 100,
+		},
+		"Large values and weights do not overflow": {
+			values:         []uint64{math.MaxUint64, math.MaxUint64},
+			weights:        []*big.Int{new(big.Int).SetUint64(math.MaxUint64), big.NewInt(1)},
+			expectedResult: math.MaxUint64,
+		},
+		"Negative weight causes error": {
+			values:        []uint64{1, 2},
+			weights:       []*big.Int{big.NewInt(2), big.NewInt(-1)},
+			expectedError: "weights cannot be negative",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := lib.WeightedMeanUint64(tc.values, tc.weights)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
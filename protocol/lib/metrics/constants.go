@@ -90,9 +90,11 @@ const (
 	// CLOB.
 	AddPerpetualFillAmount                       = "add_perpetual_fill_amount"
 	BaseQuantums                                 = "base_quantums"
+	BatchCancel                                  = "batch_cancel"
 	BestAskClobPair                              = "best_ask_clob_pair"
 	BestBidClobPair                              = "best_bid_clob_pair"
 	Buy                                          = "buy"
+	CancelAllOrders                              = "cancel_all_orders"
 	CancelOrder                                  = "cancel_order"
 	CancelOrderAccounts                          = "cancel_order_accounts"
 	CancelShortTermOrder                         = "cancel_short_term_order"
@@ -111,6 +113,7 @@ const (
 	ConvertToUpdates                             = "convert_to_updates"
 	CreateClobPair                               = "create_clob_pair"
 	Expired                                      = "expired"
+	FinalSettlement                              = "final_settlement"
 	FullyFilled                                  = "fully_filled"
 	GetFillQuoteQuantums                         = "get_fill_quote_quantums"
 	Hydrate                                      = "hydrate"
@@ -136,10 +139,12 @@ const (
 	NumUniqueSubaccountsDeleveraged              = "num_unique_subaccounts_deleveraged"
 	NumUniqueSubaccountsLiquidated               = "num_unique_subaccounts_liquidated"
 	NumUniqueSubaccountsOffsettingDeleveraged    = "num_unique_subaccounts_offsetting_deleveraged"
+	OffsettingSubaccountAssetPosition            = "offsetting_subaccount_asset_position"
 	OffsettingSubaccountPerpetualPosition        = "offsetting_subaccount_perpetual_position"
 	OperationsQueueLength                        = "operations_queue_length"
 	OrderConflictsWithClobPairStatus             = "order_conflicts_with_clob_pair_status"
 	OrderFlag                                    = "order_flag"
+	OrderGroup                                   = "order_group"
 	OrderSide                                    = "order_side"
 	OrderId                                      = "order_id"
 	PartiallyFilled                              = "partially_filled"
@@ -158,11 +163,14 @@ const (
 	ReduceOnly                                   = "reduce_only"
 	RemovalReason                                = "removal_reason"
 	RemoveAndClearOperationsQueue                = "remove_and_clear_operations_queue"
+	ReplaceOrder                                 = "replace_order"
 	ReplayOperations                             = "replay_operations"
 	SortLiquidationOrders                        = "sort_liquidation_orders"
 	SendCancelOrderOffchainUpdates               = "send_cancel_order_offchain_updates"
 	SendPlaceOrderOffchainUpdates                = "send_place_order_offchain_updates"
+	SendReplaceOrderOffchainUpdates              = "send_replace_order_offchain_updates"
 	SendPlacePerpetualLiquidationOffchainUpdates = "send_perpetual_liquidation_offchain_updates"
+	SendPlaceAssetLiquidationOffchainUpdates     = "send_asset_liquidation_offchain_updates"
 	SendPrepareCheckStateOffchainUpdates         = "send_prepare_check_state_offchain_updates"
 	SendProcessProposerMatchesOffchainUpdates    = "send_process_proposer_matches_offchain_updates"
 	SendProposedOperationsOffchainUpdates        = "send_proposed_operations_offchain_updates"
@@ -268,22 +276,24 @@ const (
 	UpdateSmoothedPrices                         = "update_smoothed_prices"
 
 	// Sending.
-	Account                       = "account"
-	New                           = "new"
-	ProcessTransfer               = "process_transfer"
-	Transfer                      = "transfer"
-	ProcessDepositToSubaccount    = "process_deposit_to_subaccount"
-	ProcessWithdrawFromSubaccount = "process_withdraw_from_subaccount"
-	SendFromModuleToAccount       = "send_from_module_to_account"
-	AssetId                       = "asset_id"
-	SenderAddress                 = "sender_address"
-	SenderModuleName              = "sender_module_name"
-	SenderSubaccount              = "sender_subaccount"
-	RecipientAddress              = "recipient_address"
-	RecipientSubaccount           = "recipient_subaccount"
+	Account                           = "account"
+	New                               = "new"
+	ProcessTransfer                   = "process_transfer"
+	Transfer                          = "transfer"
+	ProcessDepositToSubaccount        = "process_deposit_to_subaccount"
+	ProcessWithdrawFromSubaccount     = "process_withdraw_from_subaccount"
+	ProcessTransferIsolatedCollateral = "process_transfer_isolated_collateral"
+	SendFromModuleToAccount           = "send_from_module_to_account"
+	AssetId                           = "asset_id"
+	SenderAddress                     = "sender_address"
+	SenderModuleName                  = "sender_module_name"
+	SenderSubaccount                  = "sender_subaccount"
+	RecipientAddress                  = "recipient_address"
+	RecipientSubaccount               = "recipient_subaccount"
 
 	// Subaccount.
 	CanUpdateSubaccounts                  = "can_update_subaccounts"
+	ForceUpdateSubaccounts                = "force_update_subaccounts"
 	GetNetCollateralAndMarginRequirements = "get_net_collateral_and_margin_requirements"
 	GetSubaccount                         = "get_subaccount"
 	UpdateSubaccounts                     = "update_subaccounts"
@@ -291,9 +301,9 @@ const (
 
 	// Liquidation Daemon.
 	CheckCollateralizationForSubaccounts = "check_collateralization_for_subaccounts"
-	GetAllSubaccounts                    = "get_all_subaccounts"
 	GetLiquidatableSubaccountIds         = "get_liquidatable_subaccount_ids"
-	GetSubaccountsFromKey                = "get_subaccounts_from_key"
+	GetPageFromKey                       = "get_page_from_key"
+	GetSubaccountIdsToCheck              = "get_subaccount_ids_to_check"
 	LiquidatableSubaccountIds            = "liquidatable_subaccount_ids"
 	LiquidationDaemon                    = "liquidation_daemon"
 	PageLimit                            = "page_limit"
@@ -307,6 +317,7 @@ const (
 	Liquidations                          = "liquidations"
 	MaybeGetLiquidationOrder              = "maybe_get_liquidation_order"
 	PlacePerpetualLiquidation             = "place_perpetual_liquidation"
+	PlaceAssetLiquidation                 = "place_asset_liquidation"
 	PercentFilled                         = "percent_filled"
 	ProcessLiquidationMatches             = "process_liquidation_matches"
 	SubaccountsNotLiquidatable            = "subaccounts_not_liquidatable"
@@ -361,6 +372,10 @@ const (
 	PricefeedServerUpdatePrices   = "pricefeed_server_update_prices"
 	PricefeedServerValidatePrices = "pricefeed_server_validate_prices"
 	PriceIsInvalid                = "price_is_invalid"
+	InvalidAggregationMode        = "invalid_aggregation_mode"
+	VolumeWeightedPriceFallback   = "volume_weighted_price_fallback"
+	ExchangeHealthScore           = "exchange_health_score"
+	PriceOutlier                  = "price_outlier"
 
 	// Shared Pricefeed Server and Daemon.
 	UpdatePrice = "update_price"
@@ -47,6 +47,116 @@ func (_m *ClobKeeper) AddOrderToOrderbookCollatCheck(ctx types.Context, clobPair
 	return r0, r1
 }
 
+// BatchCancelShortTermOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) BatchCancelShortTermOrders(ctx types.Context, msg *clobtypes.MsgBatchCancel) ([]clobtypes.OrderId, []clobtypes.OrderId, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 []clobtypes.OrderId
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchCancel) []clobtypes.OrderId); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderId)
+		}
+	}
+
+	var r1 []clobtypes.OrderId
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgBatchCancel) []clobtypes.OrderId); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]clobtypes.OrderId)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(types.Context, *clobtypes.MsgBatchCancel) error); ok {
+		r2 = rf(ctx, msg)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// BatchCancelStatefulOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) BatchCancelStatefulOrders(ctx types.Context, msg *clobtypes.MsgBatchCancel) ([]clobtypes.OrderId, []clobtypes.OrderId, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 []clobtypes.OrderId
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchCancel) []clobtypes.OrderId); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderId)
+		}
+	}
+
+	var r1 []clobtypes.OrderId
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgBatchCancel) []clobtypes.OrderId); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]clobtypes.OrderId)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(types.Context, *clobtypes.MsgBatchCancel) error); ok {
+		r2 = rf(ctx, msg)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CancelAllShortTermOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) CancelAllShortTermOrders(ctx types.Context, msg *clobtypes.MsgCancelAllOrders) ([]clobtypes.OrderId, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 []clobtypes.OrderId
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgCancelAllOrders) []clobtypes.OrderId); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderId)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgCancelAllOrders) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelAllStatefulOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) CancelAllStatefulOrders(ctx types.Context, msg *clobtypes.MsgCancelAllOrders) ([]clobtypes.OrderId, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 []clobtypes.OrderId
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgCancelAllOrders) []clobtypes.OrderId); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderId)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgCancelAllOrders) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CancelShortTermOrder provides a mock function with given fields: ctx, msg
 func (_m *ClobKeeper) CancelShortTermOrder(ctx types.Context, msg *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, msg)
@@ -110,11 +220,46 @@ func (_m *ClobKeeper) CreatePerpetualClobPair(ctx types.Context, clobPairId uint
 	return r0, r1
 }
 
+// CreateSpotClobPair provides a mock function with given fields: ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status
+func (_m *ClobKeeper) CreateSpotClobPair(ctx types.Context, clobPairId uint32, baseAssetId uint32, quoteAssetId uint32, stepSizeInBaseQuantums subaccountstypes.BaseQuantums, quantumConversionExponent int32, subticksPerTick uint32, status clobtypes.ClobPair_Status) (clobtypes.ClobPair, error) {
+	ret := _m.Called(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+
+	var r0 clobtypes.ClobPair
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint32, uint32, subaccountstypes.BaseQuantums, int32, uint32, clobtypes.ClobPair_Status) clobtypes.ClobPair); ok {
+		r0 = rf(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+	} else {
+		r0 = ret.Get(0).(clobtypes.ClobPair)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, uint32, uint32, subaccountstypes.BaseQuantums, int32, uint32, clobtypes.ClobPair_Status) error); ok {
+		r1 = rf(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteLongTermOrderPlacement provides a mock function with given fields: ctx, orderId
 func (_m *ClobKeeper) DeleteLongTermOrderPlacement(ctx types.Context, orderId clobtypes.OrderId) {
 	_m.Called(ctx, orderId)
 }
 
+// DelistClobPair provides a mock function with given fields: ctx, clobPairId, settlementPrice
+func (_m *ClobKeeper) DelistClobPair(ctx types.Context, clobPairId clobtypes.ClobPairId, settlementPrice uint64) error {
+	ret := _m.Called(ctx, clobPairId, settlementPrice)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId, uint64) error); ok {
+		r0 = rf(ctx, clobPairId, settlementPrice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAllClobPairs provides a mock function with given fields: ctx
 func (_m *ClobKeeper) GetAllClobPairs(ctx types.Context) []clobtypes.ClobPair {
 	ret := _m.Called(ctx)
@@ -549,6 +694,29 @@ func (_m *ClobKeeper) MaybeDeleverageSubaccount(ctx types.Context, subaccountId
 	return r0, r1
 }
 
+// MaybeDeleverageSubaccountAsset provides a mock function with given fields: ctx, subaccountId
+func (_m *ClobKeeper) MaybeDeleverageSubaccountAsset(ctx types.Context, subaccountId subaccountstypes.SubaccountId) (*big.Int, error) {
+	ret := _m.Called(ctx, subaccountId)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId) *big.Int); ok {
+		r0 = rf(ctx, subaccountId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId) error); ok {
+		r1 = rf(ctx, subaccountId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MaybeGetLiquidationOrder provides a mock function with given fields: ctx, subaccountId
 func (_m *ClobKeeper) MaybeGetLiquidationOrder(ctx types.Context, subaccountId subaccountstypes.SubaccountId) (*clobtypes.LiquidationOrder, error) {
 	ret := _m.Called(ctx, subaccountId)
@@ -753,6 +921,34 @@ func (_m *ClobKeeper) PruneStateFillAmountsForShortTermOrders(ctx types.Context)
 	_m.Called(ctx)
 }
 
+// RateLimitBatchCancel provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) RateLimitBatchCancel(ctx types.Context, msg *clobtypes.MsgBatchCancel) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchCancel) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RateLimitCancelAllOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) RateLimitCancelAllOrders(ctx types.Context, msg *clobtypes.MsgCancelAllOrders) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgCancelAllOrders) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RateLimitCancelOrder provides a mock function with given fields: ctx, order
 func (_m *ClobKeeper) RateLimitCancelOrder(ctx types.Context, order *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, order)
@@ -781,6 +977,20 @@ func (_m *ClobKeeper) RateLimitPlaceOrder(ctx types.Context, order *clobtypes.Ms
 	return r0
 }
 
+// RateLimitReplaceOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) RateLimitReplaceOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveClobPair provides a mock function with given fields: ctx, id
 func (_m *ClobKeeper) RemoveClobPair(ctx types.Context, id clobtypes.ClobPairId) {
 	_m.Called(ctx, id)
@@ -807,6 +1017,34 @@ func (_m *ClobKeeper) RemoveOrderFillAmount(ctx types.Context, orderId clobtypes
 	_m.Called(ctx, orderId)
 }
 
+// ReplaceShortTermOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) ReplaceShortTermOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 subaccountstypes.BaseQuantums
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) subaccountstypes.BaseQuantums); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Get(0).(subaccountstypes.BaseQuantums)
+	}
+
+	var r1 clobtypes.OrderStatus
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgReplaceOrder) clobtypes.OrderStatus); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Get(1).(clobtypes.OrderStatus)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r2 = rf(ctx, msg)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SetLongTermOrderPlacement provides a mock function with given fields: ctx, order, blockHeight
 func (_m *ClobKeeper) SetLongTermOrderPlacement(ctx types.Context, order clobtypes.Order, blockHeight uint32) {
 	_m.Called(ctx, order, blockHeight)
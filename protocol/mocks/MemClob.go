@@ -85,6 +85,29 @@ func (_m *MemClob) DeleverageSubaccount(ctx types.Context, subaccountId subaccou
 	return r0, r1
 }
 
+// DeleverageSubaccountAsset provides a mock function with given fields: ctx, subaccountId, assetId, deltaQuantums
+func (_m *MemClob) DeleverageSubaccountAsset(ctx types.Context, subaccountId subaccountstypes.SubaccountId, assetId uint32, deltaQuantums *big.Int) (*big.Int, error) {
+	ret := _m.Called(ctx, subaccountId, assetId, deltaQuantums)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, uint32, *big.Int) *big.Int); ok {
+		r0 = rf(ctx, subaccountId, assetId, deltaQuantums)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId, uint32, *big.Int) error); ok {
+		r1 = rf(ctx, subaccountId, assetId, deltaQuantums)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCancelOrder provides a mock function with given fields: ctx, orderId
 func (_m *MemClob) GetCancelOrder(ctx types.Context, orderId clobtypes.OrderId) (uint32, bool) {
 	ret := _m.Called(ctx, orderId)
@@ -127,6 +150,22 @@ func (_m *MemClob) GetMidPrice(ctx types.Context, clobPairId clobtypes.ClobPairI
 	return r0, r1
 }
 
+// GetOffchainUpdatesForOrderbookSnapshot provides a mock function with given fields: ctx, clobPairId
+func (_m *MemClob) GetOffchainUpdatesForOrderbookSnapshot(ctx types.Context, clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+	ret := _m.Called(ctx, clobPairId)
+
+	var r0 *clobtypes.OffchainUpdates
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId) *clobtypes.OffchainUpdates); ok {
+		r0 = rf(ctx, clobPairId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	return r0
+}
+
 // GetOperationsRaw provides a mock function with given fields: ctx
 func (_m *MemClob) GetOperationsRaw(ctx types.Context) []clobtypes.OperationRaw {
 	ret := _m.Called(ctx)
@@ -224,6 +263,38 @@ func (_m *MemClob) GetOrderRemainingAmount(ctx types.Context, order clobtypes.Or
 	return r0, r1
 }
 
+// GetOrderbookLevels provides a mock function with given fields: ctx, clobPairId, depth
+func (_m *MemClob) GetOrderbookLevels(ctx types.Context, clobPairId clobtypes.ClobPairId, depth uint32) ([]clobtypes.OrderbookLevel, []clobtypes.OrderbookLevel, error) {
+	ret := _m.Called(ctx, clobPairId, depth)
+
+	var r0 []clobtypes.OrderbookLevel
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId, uint32) []clobtypes.OrderbookLevel); ok {
+		r0 = rf(ctx, clobPairId, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderbookLevel)
+		}
+	}
+
+	var r1 []clobtypes.OrderbookLevel
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.ClobPairId, uint32) []clobtypes.OrderbookLevel); ok {
+		r1 = rf(ctx, clobPairId, depth)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]clobtypes.OrderbookLevel)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(types.Context, clobtypes.ClobPairId, uint32) error); ok {
+		r2 = rf(ctx, clobPairId, depth)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetPricePremium provides a mock function with given fields: ctx, clobPair, params
 func (_m *MemClob) GetPricePremium(ctx types.Context, clobPair clobtypes.ClobPair, params perpetualstypes.GetPricePremiumParams) (int32, error) {
 	ret := _m.Called(ctx, clobPair, params)
@@ -245,6 +316,22 @@ func (_m *MemClob) GetPricePremium(ctx types.Context, clobPair clobtypes.ClobPai
 	return r0, r1
 }
 
+// GetSubaccountOpenOrders provides a mock function with given fields: ctx, subaccountId
+func (_m *MemClob) GetSubaccountOpenOrders(ctx types.Context, subaccountId subaccountstypes.SubaccountId) []clobtypes.Order {
+	ret := _m.Called(ctx, subaccountId)
+
+	var r0 []clobtypes.Order
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId) []clobtypes.Order); ok {
+		r0 = rf(ctx, subaccountId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.Order)
+		}
+	}
+
+	return r0
+}
+
 // GetSubaccountOrders provides a mock function with given fields: ctx, clobPairId, subaccountId, side
 func (_m *MemClob) GetSubaccountOrders(ctx types.Context, clobPairId clobtypes.ClobPairId, subaccountId subaccountstypes.SubaccountId, side clobtypes.Order_Side) ([]clobtypes.Order, error) {
 	ret := _m.Called(ctx, clobPairId, subaccountId, side)
@@ -268,6 +355,43 @@ func (_m *MemClob) GetSubaccountOrders(ctx types.Context, clobPairId clobtypes.C
 	return r0, r1
 }
 
+// PlaceAssetLiquidation provides a mock function with given fields: ctx, liquidationOrder
+func (_m *MemClob) PlaceAssetLiquidation(ctx types.Context, liquidationOrder clobtypes.LiquidationOrder) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, *clobtypes.OffchainUpdates, error) {
+	ret := _m.Called(ctx, liquidationOrder)
+
+	var r0 subaccountstypes.BaseQuantums
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.LiquidationOrder) subaccountstypes.BaseQuantums); ok {
+		r0 = rf(ctx, liquidationOrder)
+	} else {
+		r0 = ret.Get(0).(subaccountstypes.BaseQuantums)
+	}
+
+	var r1 clobtypes.OrderStatus
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.LiquidationOrder) clobtypes.OrderStatus); ok {
+		r1 = rf(ctx, liquidationOrder)
+	} else {
+		r1 = ret.Get(1).(clobtypes.OrderStatus)
+	}
+
+	var r2 *clobtypes.OffchainUpdates
+	if rf, ok := ret.Get(2).(func(types.Context, clobtypes.LiquidationOrder) *clobtypes.OffchainUpdates); ok {
+		r2 = rf(ctx, liquidationOrder)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(types.Context, clobtypes.LiquidationOrder) error); ok {
+		r3 = rf(ctx, liquidationOrder)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // PlaceOrder provides a mock function with given fields: ctx, order
 func (_m *MemClob) PlaceOrder(ctx types.Context, order clobtypes.Order) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, *clobtypes.OffchainUpdates, error) {
 	ret := _m.Called(ctx, order)
@@ -368,6 +492,59 @@ func (_m *MemClob) RemoveOrderIfFilled(ctx types.Context, orderId clobtypes.Orde
 	_m.Called(ctx, orderId)
 }
 
+// RemoveOrdersForClobPair provides a mock function with given fields: ctx, clobPairId, existingOffchainUpdates
+func (_m *MemClob) RemoveOrdersForClobPair(ctx types.Context, clobPairId clobtypes.ClobPairId, existingOffchainUpdates *clobtypes.OffchainUpdates) *clobtypes.OffchainUpdates {
+	ret := _m.Called(ctx, clobPairId, existingOffchainUpdates)
+
+	var r0 *clobtypes.OffchainUpdates
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId, *clobtypes.OffchainUpdates) *clobtypes.OffchainUpdates); ok {
+		r0 = rf(ctx, clobPairId, existingOffchainUpdates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	return r0
+}
+
+// ReplaceOrder provides a mock function with given fields: ctx, msgReplaceOrder
+func (_m *MemClob) ReplaceOrder(ctx types.Context, msgReplaceOrder *clobtypes.MsgReplaceOrder) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, *clobtypes.OffchainUpdates, error) {
+	ret := _m.Called(ctx, msgReplaceOrder)
+
+	var r0 subaccountstypes.BaseQuantums
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) subaccountstypes.BaseQuantums); ok {
+		r0 = rf(ctx, msgReplaceOrder)
+	} else {
+		r0 = ret.Get(0).(subaccountstypes.BaseQuantums)
+	}
+
+	var r1 clobtypes.OrderStatus
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgReplaceOrder) clobtypes.OrderStatus); ok {
+		r1 = rf(ctx, msgReplaceOrder)
+	} else {
+		r1 = ret.Get(1).(clobtypes.OrderStatus)
+	}
+
+	var r2 *clobtypes.OffchainUpdates
+	if rf, ok := ret.Get(2).(func(types.Context, *clobtypes.MsgReplaceOrder) *clobtypes.OffchainUpdates); ok {
+		r2 = rf(ctx, msgReplaceOrder)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r3 = rf(ctx, msgReplaceOrder)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // ReplayOperations provides a mock function with given fields: ctx, localOperations, shortTermOrderTxBytes, existingOffchainUpdates
 func (_m *MemClob) ReplayOperations(ctx types.Context, localOperations []clobtypes.InternalOperation, shortTermOrderTxBytes map[clobtypes.OrderHash][]byte, existingOffchainUpdates *clobtypes.OffchainUpdates) *clobtypes.OffchainUpdates {
 	ret := _m.Called(ctx, localOperations, shortTermOrderTxBytes, existingOffchainUpdates)
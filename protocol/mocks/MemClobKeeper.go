@@ -84,20 +84,20 @@ func (_m *MemClobKeeper) AddPreexistingStatefulOrder(ctx types.Context, order *c
 	return r0, r1, r2, r3
 }
 
-// CanDeleverageSubaccount provides a mock function with given fields: ctx, subaccountId
-func (_m *MemClobKeeper) CanDeleverageSubaccount(ctx types.Context, subaccountId subaccountstypes.SubaccountId) (bool, error) {
-	ret := _m.Called(ctx, subaccountId)
+// CanDeleverageSubaccount provides a mock function with given fields: ctx, subaccountId, perpetualId
+func (_m *MemClobKeeper) CanDeleverageSubaccount(ctx types.Context, subaccountId subaccountstypes.SubaccountId, perpetualId uint32) (bool, error) {
+	ret := _m.Called(ctx, subaccountId, perpetualId)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId) bool); ok {
-		r0 = rf(ctx, subaccountId)
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, uint32) bool); ok {
+		r0 = rf(ctx, subaccountId, perpetualId)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId) error); ok {
-		r1 = rf(ctx, subaccountId)
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId, uint32) error); ok {
+		r1 = rf(ctx, subaccountId, perpetualId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -242,6 +242,31 @@ func (_m *MemClobKeeper) MustAddOrderToStatefulOrdersTimeSlice(ctx types.Context
 	_m.Called(ctx, goodTilBlockTime, orderId)
 }
 
+// OffsetSubaccountAssetPosition provides a mock function with given fields: ctx, liquidatedSubaccountId, assetId, deltaQuantumsTotal
+func (_m *MemClobKeeper) OffsetSubaccountAssetPosition(ctx types.Context, liquidatedSubaccountId subaccountstypes.SubaccountId, assetId uint32, deltaQuantumsTotal *big.Int) ([]clobtypes.MatchPerpetualDeleveraging_Fill, *big.Int) {
+	ret := _m.Called(ctx, liquidatedSubaccountId, assetId, deltaQuantumsTotal)
+
+	var r0 []clobtypes.MatchPerpetualDeleveraging_Fill
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, uint32, *big.Int) []clobtypes.MatchPerpetualDeleveraging_Fill); ok {
+		r0 = rf(ctx, liquidatedSubaccountId, assetId, deltaQuantumsTotal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.MatchPerpetualDeleveraging_Fill)
+		}
+	}
+
+	var r1 *big.Int
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId, uint32, *big.Int) *big.Int); ok {
+		r1 = rf(ctx, liquidatedSubaccountId, assetId, deltaQuantumsTotal)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*big.Int)
+		}
+	}
+
+	return r0, r1
+}
+
 // OffsetSubaccountPerpetualPosition provides a mock function with given fields: ctx, liquidatedSubaccountId, perpetualId, deltaQuantumsTotal
 func (_m *MemClobKeeper) OffsetSubaccountPerpetualPosition(ctx types.Context, liquidatedSubaccountId subaccountstypes.SubaccountId, perpetualId uint32, deltaQuantumsTotal *big.Int) ([]clobtypes.MatchPerpetualDeleveraging_Fill, *big.Int) {
 	ret := _m.Called(ctx, liquidatedSubaccountId, perpetualId, deltaQuantumsTotal)
@@ -348,6 +373,43 @@ func (_m *MemClobKeeper) ReplayPlaceOrder(ctx types.Context, msg *clobtypes.MsgP
 	return r0, r1, r2, r3
 }
 
+// ReplayReplaceOrder provides a mock function with given fields: ctx, msg
+func (_m *MemClobKeeper) ReplayReplaceOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, *clobtypes.OffchainUpdates, error) {
+	ret := _m.Called(ctx, msg)
+
+	var r0 subaccountstypes.BaseQuantums
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) subaccountstypes.BaseQuantums); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Get(0).(subaccountstypes.BaseQuantums)
+	}
+
+	var r1 clobtypes.OrderStatus
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgReplaceOrder) clobtypes.OrderStatus); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Get(1).(clobtypes.OrderStatus)
+	}
+
+	var r2 *clobtypes.OffchainUpdates
+	if rf, ok := ret.Get(2).(func(types.Context, *clobtypes.MsgReplaceOrder) *clobtypes.OffchainUpdates); ok {
+		r2 = rf(ctx, msg)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r3 = rf(ctx, msg)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // SetLongTermOrderPlacement provides a mock function with given fields: ctx, order, blockHeight
 func (_m *MemClobKeeper) SetLongTermOrderPlacement(ctx types.Context, order clobtypes.Order, blockHeight uint32) {
 	_m.Called(ctx, order, blockHeight)
@@ -30,20 +30,20 @@ func (_m *PerpetualsKeeper) AddPremiumVotes(ctx types.Context, votes []perpetual
 	return r0
 }
 
-// CreatePerpetual provides a mock function with given fields: ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier
-func (_m *PerpetualsKeeper) CreatePerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, atomicResolution int32, defaultFundingPpm int32, liquidityTier uint32) (perpetualstypes.Perpetual, error) {
-	ret := _m.Called(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier)
+// CreatePerpetual provides a mock function with given fields: ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier, openInterestCapNotional, fundingRateBounds, interestRatePpm, fundingTickEpoch, fundingSampleEpoch
+func (_m *PerpetualsKeeper) CreatePerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, atomicResolution int32, defaultFundingPpm int32, liquidityTier uint32, openInterestCapNotional uint64, fundingRateBounds *perpetualstypes.FundingRateBounds, interestRatePpm int32, fundingTickEpoch string, fundingSampleEpoch string) (perpetualstypes.Perpetual, error) {
+	ret := _m.Called(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier, openInterestCapNotional, fundingRateBounds, interestRatePpm, fundingTickEpoch, fundingSampleEpoch)
 
 	var r0 perpetualstypes.Perpetual
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, int32, uint32) perpetualstypes.Perpetual); ok {
-		r0 = rf(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, int32, uint32, uint64, *perpetualstypes.FundingRateBounds, int32, string, string) perpetualstypes.Perpetual); ok {
+		r0 = rf(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier, openInterestCapNotional, fundingRateBounds, interestRatePpm, fundingTickEpoch, fundingSampleEpoch)
 	} else {
 		r0 = ret.Get(0).(perpetualstypes.Perpetual)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, int32, uint32) error); ok {
-		r1 = rf(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, int32, uint32, uint64, *perpetualstypes.FundingRateBounds, int32, string, string) error); ok {
+		r1 = rf(ctx, id, ticker, marketId, atomicResolution, defaultFundingPpm, liquidityTier, openInterestCapNotional, fundingRateBounds, interestRatePpm, fundingTickEpoch, fundingSampleEpoch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -192,20 +192,20 @@ func (_m *PerpetualsKeeper) MaybeProcessNewFundingTickEpoch(ctx types.Context) {
 	_m.Called(ctx)
 }
 
-// ModifyPerpetual provides a mock function with given fields: ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier
-func (_m *PerpetualsKeeper) ModifyPerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, defaultFundingPpm int32, liquidityTier uint32) (perpetualstypes.Perpetual, error) {
-	ret := _m.Called(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+// ModifyPerpetual provides a mock function with given fields: ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, openInterestCapNotional, fundingRateBounds, interestRatePpm, fundingTickEpoch, fundingSampleEpoch
+func (_m *PerpetualsKeeper) ModifyPerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, defaultFundingPpm int32, liquidityTier uint32, openInterestCapNotional uint64, fundingRateBounds *perpetualstypes.FundingRateBounds, interestRatePpm int32, fundingTickEpoch string, fundingSampleEpoch string) (perpetualstypes.Perpetual, error) {
+	ret := _m.Called(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, openInterestCapNotional, fundingRateBounds, interestRatePpm, fundingTickEpoch, fundingSampleEpoch)
 
 	var r0 perpetualstypes.Perpetual
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, uint32) perpetualstypes.Perpetual); ok {
-		r0 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, uint32, uint64, *perpetualstypes.FundingRateBounds, int32, string, string) perpetualstypes.Perpetual); ok {
+		r0 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, openInterestCapNotional, fundingRateBounds, interestRatePpm, fundingTickEpoch, fundingSampleEpoch)
 	} else {
 		r0 = ret.Get(0).(perpetualstypes.Perpetual)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, uint32) error); ok {
-		r1 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, uint32, uint64, *perpetualstypes.FundingRateBounds, int32, string, string) error); ok {
+		r1 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, openInterestCapNotional, fundingRateBounds, interestRatePpm, fundingTickEpoch, fundingSampleEpoch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -227,20 +227,20 @@ func (_m *PerpetualsKeeper) PerformStatefulPremiumVotesValidation(ctx types.Cont
 	return r0
 }
 
-// SetLiquidityTier provides a mock function with given fields: ctx, id, name, initialMarginPpm, maintenanceFractionPpm, basePositionNotional, impactNotional
-func (_m *PerpetualsKeeper) SetLiquidityTier(ctx types.Context, id uint32, name string, initialMarginPpm uint32, maintenanceFractionPpm uint32, basePositionNotional uint64, impactNotional uint64) (perpetualstypes.LiquidityTier, error) {
-	ret := _m.Called(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, basePositionNotional, impactNotional)
+// SetLiquidityTier provides a mock function with given fields: ctx, id, name, initialMarginPpm, maintenanceFractionPpm, basePositionNotional, impactNotional, openInterestCapNotional
+func (_m *PerpetualsKeeper) SetLiquidityTier(ctx types.Context, id uint32, name string, initialMarginPpm uint32, maintenanceFractionPpm uint32, basePositionNotional uint64, impactNotional uint64, openInterestCapNotional uint64) (perpetualstypes.LiquidityTier, error) {
+	ret := _m.Called(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, basePositionNotional, impactNotional, openInterestCapNotional)
 
 	var r0 perpetualstypes.LiquidityTier
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64) perpetualstypes.LiquidityTier); ok {
-		r0 = rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, basePositionNotional, impactNotional)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64, uint64) perpetualstypes.LiquidityTier); ok {
+		r0 = rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, basePositionNotional, impactNotional, openInterestCapNotional)
 	} else {
 		r0 = ret.Get(0).(perpetualstypes.LiquidityTier)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64) error); ok {
-		r1 = rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, basePositionNotional, impactNotional)
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64, uint64) error); ok {
+		r1 = rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, basePositionNotional, impactNotional, openInterestCapNotional)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,6 +56,36 @@ func (_m *QueryClient) AddBridgeEvents(ctx context.Context, in *api.AddBridgeEve
 	return r0, r1
 }
 
+// AdlRank provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AdlRank(ctx context.Context, in *clobtypes.QueryAdlRankRequest, opts ...grpc.CallOption) (*clobtypes.QueryAdlRankResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryAdlRankResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryAdlRankRequest, ...grpc.CallOption) *clobtypes.QueryAdlRankResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryAdlRankResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryAdlRankRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AllMarketParams provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) AllMarketParams(ctx context.Context, in *types.QueryAllMarketParamsRequest, opts ...grpc.CallOption) (*types.QueryAllMarketParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -266,6 +296,36 @@ func (_m *QueryClient) EquityTierLimitConfiguration(ctx context.Context, in *clo
 	return r0, r1
 }
 
+// ExchangeHealthScores provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ExchangeHealthScores(ctx context.Context, in *pricefeedapi.ExchangeHealthScoresRequest, opts ...grpc.CallOption) (*pricefeedapi.ExchangeHealthScoresResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pricefeedapi.ExchangeHealthScoresResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pricefeedapi.ExchangeHealthScoresRequest, ...grpc.CallOption) *pricefeedapi.ExchangeHealthScoresResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricefeedapi.ExchangeHealthScoresResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pricefeedapi.ExchangeHealthScoresRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LiquidateSubaccounts provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) LiquidateSubaccounts(ctx context.Context, in *liquidationapi.LiquidateSubaccountsRequest, opts ...grpc.CallOption) (*liquidationapi.LiquidateSubaccountsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -416,6 +476,126 @@ func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtyp
 	return r0, r1
 }
 
+// OpenInterest provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) OpenInterest(ctx context.Context, in *subaccountstypes.QueryOpenInterestRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryOpenInterestResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *subaccountstypes.QueryOpenInterestResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryOpenInterestRequest, ...grpc.CallOption) *subaccountstypes.QueryOpenInterestResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryOpenInterestResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryOpenInterestRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderFillState provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) OrderFillState(ctx context.Context, in *clobtypes.QueryOrderFillStateRequest, opts ...grpc.CallOption) (*clobtypes.QueryOrderFillStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryOrderFillStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryOrderFillStateRequest, ...grpc.CallOption) *clobtypes.QueryOrderFillStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryOrderFillStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryOrderFillStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderbookL2 provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) OrderbookL2(ctx context.Context, in *clobtypes.QueryOrderbookL2Request, opts ...grpc.CallOption) (*clobtypes.QueryOrderbookL2Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QueryOrderbookL2Response
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryOrderbookL2Request, ...grpc.CallOption) *clobtypes.QueryOrderbookL2Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryOrderbookL2Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryOrderbookL2Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamOrderbookUpdates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) StreamOrderbookUpdates(ctx context.Context, in *clobtypes.StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (clobtypes.Query_StreamOrderbookUpdatesClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 clobtypes.Query_StreamOrderbookUpdatesClient
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.StreamOrderbookUpdatesRequest, ...grpc.CallOption) clobtypes.Query_StreamOrderbookUpdatesClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clobtypes.Query_StreamOrderbookUpdatesClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.StreamOrderbookUpdatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Subaccount provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Subaccount(ctx context.Context, in *subaccountstypes.QueryGetSubaccountRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -476,6 +656,96 @@ func (_m *QueryClient) SubaccountAll(ctx context.Context, in *subaccountstypes.Q
 	return r0, r1
 }
 
+// SubaccountOpenOrders provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountOpenOrders(ctx context.Context, in *clobtypes.QuerySubaccountOpenOrdersRequest, opts ...grpc.CallOption) (*clobtypes.QuerySubaccountOpenOrdersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *clobtypes.QuerySubaccountOpenOrdersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QuerySubaccountOpenOrdersRequest, ...grpc.CallOption) *clobtypes.QuerySubaccountOpenOrdersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QuerySubaccountOpenOrdersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QuerySubaccountOpenOrdersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubaccountsByPerpetual provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountsByPerpetual(ctx context.Context, in *subaccountstypes.QuerySubaccountsByPerpetualRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountsByPerpetualResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *subaccountstypes.QuerySubaccountsByPerpetualResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountsByPerpetualRequest, ...grpc.CallOption) *subaccountstypes.QuerySubaccountsByPerpetualResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QuerySubaccountsByPerpetualResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QuerySubaccountsByPerpetualRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubaccountsWithBorrowings provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountsWithBorrowings(ctx context.Context, in *subaccountstypes.QuerySubaccountsWithBorrowingsRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountsWithBorrowingsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *subaccountstypes.QuerySubaccountsWithBorrowingsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountsWithBorrowingsRequest, ...grpc.CallOption) *subaccountstypes.QuerySubaccountsWithBorrowingsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QuerySubaccountsWithBorrowingsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QuerySubaccountsWithBorrowingsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMarketPrices provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) UpdateMarketPrices(ctx context.Context, in *pricefeedapi.UpdateMarketPricesRequest, opts ...grpc.CallOption) (*pricefeedapi.UpdateMarketPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -70,6 +70,20 @@ func (_m *SendingKeeper) ProcessWithdrawFromSubaccount(ctx cosmos_sdktypes.Conte
 	return r0
 }
 
+// ProcessTransferIsolatedCollateral provides a mock function with given fields: ctx, msgTransferIsolatedCollateral
+func (_m *SendingKeeper) ProcessTransferIsolatedCollateral(ctx cosmos_sdktypes.Context, msgTransferIsolatedCollateral *types.MsgTransferIsolatedCollateral) error {
+	ret := _m.Called(ctx, msgTransferIsolatedCollateral)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(cosmos_sdktypes.Context, *types.MsgTransferIsolatedCollateral) error); ok {
+		r0 = rf(ctx, msgTransferIsolatedCollateral)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendFromModuleToAccount provides a mock function with given fields: ctx, msg
 func (_m *SendingKeeper) SendFromModuleToAccount(ctx cosmos_sdktypes.Context, msg *types.MsgSendFromModuleToAccount) error {
 	ret := _m.Called(ctx, msg)
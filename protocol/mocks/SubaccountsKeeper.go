@@ -62,6 +62,20 @@ func (_m *SubaccountsKeeper) DepositFundsFromAccountToSubaccount(ctx types.Conte
 	return r0
 }
 
+// ForceUpdateSubaccounts provides a mock function with given fields: ctx, updates
+func (_m *SubaccountsKeeper) ForceUpdateSubaccounts(ctx types.Context, updates []subaccountstypes.Update) error {
+	ret := _m.Called(ctx, updates)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, []subaccountstypes.Update) error); ok {
+		r0 = rf(ctx, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAllSubaccount provides a mock function with given fields: ctx
 func (_m *SubaccountsKeeper) GetAllSubaccount(ctx types.Context) []subaccountstypes.Subaccount {
 	ret := _m.Called(ctx)
@@ -78,6 +92,88 @@ func (_m *SubaccountsKeeper) GetAllSubaccount(ctx types.Context) []subaccountsty
 	return r0
 }
 
+// GetCrossNetCollateralAndMarginRequirements provides a mock function with given fields: ctx, subaccountId
+func (_m *SubaccountsKeeper) GetCrossNetCollateralAndMarginRequirements(ctx types.Context, subaccountId subaccountstypes.SubaccountId) (*big.Int, *big.Int, *big.Int, error) {
+	ret := _m.Called(ctx, subaccountId)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId) *big.Int); ok {
+		r0 = rf(ctx, subaccountId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 *big.Int
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId) *big.Int); ok {
+		r1 = rf(ctx, subaccountId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*big.Int)
+		}
+	}
+
+	var r2 *big.Int
+	if rf, ok := ret.Get(2).(func(types.Context, subaccountstypes.SubaccountId) *big.Int); ok {
+		r2 = rf(ctx, subaccountId)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*big.Int)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(types.Context, subaccountstypes.SubaccountId) error); ok {
+		r3 = rf(ctx, subaccountId)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetIsolatedNetCollateralAndMarginRequirements provides a mock function with given fields: ctx, subaccountId, perpetualId
+func (_m *SubaccountsKeeper) GetIsolatedNetCollateralAndMarginRequirements(ctx types.Context, subaccountId subaccountstypes.SubaccountId, perpetualId uint32) (*big.Int, *big.Int, *big.Int, error) {
+	ret := _m.Called(ctx, subaccountId, perpetualId)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, uint32) *big.Int); ok {
+		r0 = rf(ctx, subaccountId, perpetualId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 *big.Int
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.SubaccountId, uint32) *big.Int); ok {
+		r1 = rf(ctx, subaccountId, perpetualId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*big.Int)
+		}
+	}
+
+	var r2 *big.Int
+	if rf, ok := ret.Get(2).(func(types.Context, subaccountstypes.SubaccountId, uint32) *big.Int); ok {
+		r2 = rf(ctx, subaccountId, perpetualId)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*big.Int)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(types.Context, subaccountstypes.SubaccountId, uint32) error); ok {
+		r3 = rf(ctx, subaccountId, perpetualId)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // GetNetCollateralAndMarginRequirements provides a mock function with given fields: ctx, update
 func (_m *SubaccountsKeeper) GetNetCollateralAndMarginRequirements(ctx types.Context, update subaccountstypes.Update) (*big.Int, *big.Int, *big.Int, error) {
 	ret := _m.Called(ctx, update)
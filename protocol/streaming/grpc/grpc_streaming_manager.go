@@ -0,0 +1,348 @@
+package grpc
+
+import (
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/log"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+var _ types.GrpcStreamingManager = (*GrpcStreamingManagerImpl)(nil)
+
+// GrpcStreamingManagerImpl manages gRPC streams of orderbook updates and fills.
+// Subscriptions are added and removed from gRPC server goroutines while responses are queued
+// from ABCI methods, so all access to the subscriptions is guarded by the mutex.
+type GrpcStreamingManagerImpl struct {
+	sync.Mutex
+
+	logger log.Logger
+
+	// orderbookSubscriptions maps subscription IDs to their subscriptions.
+	orderbookSubscriptions map[uint32]*OrderbookSubscription
+	nextSubscriptionId     uint32
+
+	// bufferSize is the number of responses that may be queued for a subscription before the
+	// subscription is closed.
+	bufferSize uint32
+}
+
+// OrderbookSubscription is a subscription to orderbook updates and fills for a set of CLOB pairs.
+type OrderbookSubscription struct {
+	clobPairIds map[uint32]struct{}
+
+	// Whether the orderbook snapshot has been queued for this subscription. Updates and fills
+	// are only queued for initialized subscriptions.
+	initialized bool
+
+	// Responses waiting to be sent on the stream. Closed when the subscription is removed.
+	updatesChan chan *clobtypes.StreamOrderbookUpdatesResponse
+
+	// The reason the manager removed the subscription, if any. Only read after `updatesChan`
+	// is closed.
+	err error
+}
+
+// clobPairUpdate is a decoded off-chain update along with the CLOB pair it belongs to.
+type clobPairUpdate struct {
+	clobPairId uint32
+	update     ocutypes.OffChainUpdateV1
+}
+
+func NewGrpcStreamingManager(
+	logger log.Logger,
+	bufferSize uint32,
+) *GrpcStreamingManagerImpl {
+	return &GrpcStreamingManagerImpl{
+		logger:                 logger.With("module", "grpc-streaming"),
+		orderbookSubscriptions: make(map[uint32]*OrderbookSubscription),
+		bufferSize:             bufferSize,
+	}
+}
+
+func (sm *GrpcStreamingManagerImpl) Enabled() bool {
+	return true
+}
+
+// Subscribe registers a subscription for the requested CLOB pairs and sends queued responses on
+// the stream until the client disconnects or the subscription is closed. The first response is a
+// snapshot of the orderbooks, which is queued in the next `PrepareCheckState`.
+func (sm *GrpcStreamingManagerImpl) Subscribe(
+	req clobtypes.StreamOrderbookUpdatesRequest,
+	srv clobtypes.Query_StreamOrderbookUpdatesServer,
+) error {
+	if len(req.GetClobPairId()) == 0 {
+		return clobtypes.ErrInvalidGrpcStreamingRequest
+	}
+
+	subscription := &OrderbookSubscription{
+		clobPairIds: make(map[uint32]struct{}, len(req.GetClobPairId())),
+		updatesChan: make(chan *clobtypes.StreamOrderbookUpdatesResponse, sm.bufferSize),
+	}
+	for _, clobPairId := range req.GetClobPairId() {
+		subscription.clobPairIds[clobPairId] = struct{}{}
+	}
+
+	sm.Lock()
+	subscriptionId := sm.nextSubscriptionId
+	sm.orderbookSubscriptions[subscriptionId] = subscription
+	sm.nextSubscriptionId++
+	sm.Unlock()
+
+	sm.logger.Info(
+		"New gRPC stream subscription",
+		"subscription_id", subscriptionId,
+		"clob_pair_ids", req.GetClobPairId(),
+	)
+
+	for {
+		select {
+		case response, ok := <-subscription.updatesChan:
+			if !ok {
+				return subscription.err
+			}
+			if err := srv.Send(response); err != nil {
+				sm.logger.Info(
+					"Failed to send on gRPC stream, closing the subscription",
+					"subscription_id", subscriptionId,
+					"error", err,
+				)
+				sm.removeSubscription(subscriptionId)
+				return err
+			}
+		case <-srv.Context().Done():
+			sm.removeSubscription(subscriptionId)
+			return nil
+		}
+	}
+}
+
+// InitializeNewStreams queues an orderbook snapshot for all subscriptions that have not
+// received one yet. `getOrderbookSnapshot` returns the off-chain updates that recreate the
+// orderbook of a CLOB pair.
+func (sm *GrpcStreamingManagerImpl) InitializeNewStreams(
+	getOrderbookSnapshot func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates,
+	blockHeight uint32,
+) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	snapshots := make(map[uint32][]ocutypes.OffChainUpdateV1)
+	for subscriptionId, subscription := range sm.orderbookSubscriptions {
+		if subscription.initialized {
+			continue
+		}
+
+		clobPairIds := lib.GetSortedKeys[lib.Sortable[uint32]](subscription.clobPairIds)
+		updates := make([]ocutypes.OffChainUpdateV1, 0)
+		for _, clobPairId := range clobPairIds {
+			if _, exists := snapshots[clobPairId]; !exists {
+				snapshot := sm.decodeOffchainUpdates(getOrderbookSnapshot(clobtypes.ClobPairId(clobPairId)))
+				snapshots[clobPairId] = make([]ocutypes.OffChainUpdateV1, 0, len(snapshot))
+				for _, update := range snapshot {
+					snapshots[clobPairId] = append(snapshots[clobPairId], update.update)
+				}
+			}
+			updates = append(updates, snapshots[clobPairId]...)
+		}
+
+		subscription.initialized = true
+		sm.queueResponse(
+			subscriptionId,
+			subscription,
+			&clobtypes.StreamOrderbookUpdatesResponse{
+				Updates:     updates,
+				Fills:       []clobtypes.StreamOrderbookFill{},
+				Snapshot:    true,
+				BlockHeight: blockHeight,
+			},
+		)
+	}
+}
+
+// SendOrderbookUpdates queues the off-chain updates for all initialized subscriptions to
+// the CLOB pairs of the updates.
+func (sm *GrpcStreamingManagerImpl) SendOrderbookUpdates(
+	offchainUpdates *clobtypes.OffchainUpdates,
+	blockHeight uint32,
+) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	if len(sm.orderbookSubscriptions) == 0 || len(offchainUpdates.Messages) == 0 {
+		return
+	}
+
+	clobPairUpdates := sm.decodeOffchainUpdates(offchainUpdates)
+	for subscriptionId, subscription := range sm.orderbookSubscriptions {
+		if !subscription.initialized {
+			continue
+		}
+
+		updates := make([]ocutypes.OffChainUpdateV1, 0)
+		for _, clobPairUpdate := range clobPairUpdates {
+			if _, exists := subscription.clobPairIds[clobPairUpdate.clobPairId]; exists {
+				updates = append(updates, clobPairUpdate.update)
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+
+		sm.queueResponse(
+			subscriptionId,
+			subscription,
+			&clobtypes.StreamOrderbookUpdatesResponse{
+				Updates:     updates,
+				Fills:       []clobtypes.StreamOrderbookFill{},
+				BlockHeight: blockHeight,
+			},
+		)
+	}
+}
+
+// SendOrderbookFillUpdates queues the fills for all initialized subscriptions to the CLOB pairs
+// of the fills.
+func (sm *GrpcStreamingManagerImpl) SendOrderbookFillUpdates(
+	fills []clobtypes.StreamOrderbookFill,
+	blockHeight uint32,
+) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	if len(sm.orderbookSubscriptions) == 0 || len(fills) == 0 {
+		return
+	}
+
+	for subscriptionId, subscription := range sm.orderbookSubscriptions {
+		if !subscription.initialized {
+			continue
+		}
+
+		subscriptionFills := make([]clobtypes.StreamOrderbookFill, 0)
+		for _, fill := range fills {
+			clobPairId, ok := getFillClobPairId(fill)
+			if !ok {
+				continue
+			}
+			if _, exists := subscription.clobPairIds[clobPairId]; exists {
+				subscriptionFills = append(subscriptionFills, fill)
+			}
+		}
+		if len(subscriptionFills) == 0 {
+			continue
+		}
+
+		sm.queueResponse(
+			subscriptionId,
+			subscription,
+			&clobtypes.StreamOrderbookUpdatesResponse{
+				Updates:     []ocutypes.OffChainUpdateV1{},
+				Fills:       subscriptionFills,
+				BlockHeight: blockHeight,
+			},
+		)
+	}
+}
+
+// Stop closes all subscriptions.
+func (sm *GrpcStreamingManagerImpl) Stop() {
+	sm.Lock()
+	defer sm.Unlock()
+
+	for subscriptionId := range sm.orderbookSubscriptions {
+		sm.removeSubscriptionLocked(subscriptionId, nil)
+	}
+}
+
+// GetNumSubscriptions returns the number of active subscriptions.
+func (sm *GrpcStreamingManagerImpl) GetNumSubscriptions() int {
+	sm.Lock()
+	defer sm.Unlock()
+
+	return len(sm.orderbookSubscriptions)
+}
+
+// queueResponse queues a response for a subscription without blocking. If the subscription's
+// buffer is full, the client is not keeping up and the subscription is closed.
+// The caller must hold the lock.
+func (sm *GrpcStreamingManagerImpl) queueResponse(
+	subscriptionId uint32,
+	subscription *OrderbookSubscription,
+	response *clobtypes.StreamOrderbookUpdatesResponse,
+) {
+	select {
+	case subscription.updatesChan <- response:
+	default:
+		sm.logger.Error(
+			"gRPC stream subscription buffer is full, closing the subscription",
+			"subscription_id", subscriptionId,
+		)
+		sm.removeSubscriptionLocked(subscriptionId, clobtypes.ErrGrpcStreamingBufferFull)
+	}
+}
+
+func (sm *GrpcStreamingManagerImpl) removeSubscription(subscriptionId uint32) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	sm.removeSubscriptionLocked(subscriptionId, nil)
+}
+
+// removeSubscriptionLocked removes a subscription if it exists and closes its channel.
+// The caller must hold the lock.
+func (sm *GrpcStreamingManagerImpl) removeSubscriptionLocked(subscriptionId uint32, err error) {
+	subscription, exists := sm.orderbookSubscriptions[subscriptionId]
+	if !exists {
+		return
+	}
+
+	subscription.err = err
+	close(subscription.updatesChan)
+	delete(sm.orderbookSubscriptions, subscriptionId)
+	sm.logger.Info("gRPC stream subscription closed", "subscription_id", subscriptionId)
+}
+
+// decodeOffchainUpdates unmarshals the `OffChainUpdateV1` of each off-chain update message.
+// Messages that fail to unmarshal are logged and skipped.
+func (sm *GrpcStreamingManagerImpl) decodeOffchainUpdates(
+	offchainUpdates *clobtypes.OffchainUpdates,
+) []clobPairUpdate {
+	clobPairUpdates := make([]clobPairUpdate, 0, len(offchainUpdates.Messages))
+	for _, message := range offchainUpdates.Messages {
+		var update ocutypes.OffChainUpdateV1
+		if err := update.Unmarshal(message.Message.Value); err != nil {
+			sm.logger.Error(
+				"Failed to unmarshal off-chain update",
+				"order_id", message.OrderId,
+				"error", err,
+			)
+			continue
+		}
+		clobPairUpdates = append(
+			clobPairUpdates,
+			clobPairUpdate{
+				clobPairId: message.OrderId.ClobPairId,
+				update:     update,
+			},
+		)
+	}
+	return clobPairUpdates
+}
+
+// getFillClobPairId returns the CLOB pair of a fill. Returns false for matches that are not
+// on a CLOB pair, such as deleveraging.
+func getFillClobPairId(fill clobtypes.StreamOrderbookFill) (uint32, bool) {
+	switch match := fill.ClobMatch.GetMatch().(type) {
+	case *clobtypes.ClobMatch_MatchOrders:
+		return match.MatchOrders.TakerOrderId.ClobPairId, true
+	case *clobtypes.ClobMatch_MatchPerpetualLiquidation:
+		return match.MatchPerpetualLiquidation.ClobPairId, true
+	case *clobtypes.ClobMatch_MatchAssetLiquidation:
+		return match.MatchAssetLiquidation.ClobPairId, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,251 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	streaming "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeStreamServer records the responses sent on a stream. If `block` is set, sends wait until
+// it is closed.
+type fakeStreamServer struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	block     chan struct{}
+	responses chan *clobtypes.StreamOrderbookUpdatesResponse
+}
+
+func newFakeStreamServer(ctx context.Context) *fakeStreamServer {
+	return &fakeStreamServer{
+		ctx:       ctx,
+		responses: make(chan *clobtypes.StreamOrderbookUpdatesResponse, 100),
+	}
+}
+
+func (s *fakeStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStreamServer) Send(response *clobtypes.StreamOrderbookUpdatesResponse) error {
+	if s.block != nil {
+		<-s.block
+	}
+	s.responses <- response
+	return nil
+}
+
+// subscribe starts a subscription in a goroutine and waits for it to be registered. The returned
+// channel receives the result of `Subscribe`.
+func subscribe(
+	t *testing.T,
+	manager *streaming.GrpcStreamingManagerImpl,
+	clobPairIds []uint32,
+	srv *fakeStreamServer,
+) chan error {
+	numSubscriptions := manager.GetNumSubscriptions()
+	result := make(chan error, 1)
+	go func() {
+		result <- manager.Subscribe(
+			clobtypes.StreamOrderbookUpdatesRequest{ClobPairId: clobPairIds},
+			srv,
+		)
+	}()
+	require.Eventually(
+		t,
+		func() bool { return manager.GetNumSubscriptions() == numSubscriptions+1 },
+		time.Second,
+		time.Millisecond,
+	)
+	return result
+}
+
+func placeOrderUpdates(orders ...clobtypes.Order) *clobtypes.OffchainUpdates {
+	offchainUpdates := clobtypes.NewOffchainUpdates()
+	for _, order := range orders {
+		offchainUpdates.AddPlaceMessage(
+			order.OrderId,
+			off_chain_updates.MustCreateOrderPlaceMessage(log.NewNopLogger(), order),
+		)
+	}
+	return offchainUpdates
+}
+
+func decodeUpdates(t *testing.T, offchainUpdates *clobtypes.OffchainUpdates) []ocutypes.OffChainUpdateV1 {
+	updates := make([]ocutypes.OffChainUpdateV1, 0, len(offchainUpdates.Messages))
+	for _, message := range offchainUpdates.Messages {
+		var update ocutypes.OffChainUpdateV1
+		require.NoError(t, update.Unmarshal(message.Message.Value))
+		updates = append(updates, update)
+	}
+	return updates
+}
+
+func receive(t *testing.T, srv *fakeStreamServer) *clobtypes.StreamOrderbookUpdatesResponse {
+	select {
+	case response := <-srv.responses:
+		return response
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for a response")
+		return nil
+	}
+}
+
+func TestSubscribe_InvalidRequest(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), 10)
+	err := manager.Subscribe(
+		clobtypes.StreamOrderbookUpdatesRequest{},
+		newFakeStreamServer(context.Background()),
+	)
+	require.ErrorIs(t, err, clobtypes.ErrInvalidGrpcStreamingRequest)
+	require.Equal(t, 0, manager.GetNumSubscriptions())
+}
+
+func TestSubscribe_SnapshotThenDeltas(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := newFakeStreamServer(ctx)
+	result := subscribe(t, manager, []uint32{0}, srv)
+
+	// Updates are not sent before the snapshot.
+	manager.SendOrderbookUpdates(placeOrderUpdates(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15), 2)
+	require.Empty(t, srv.responses)
+
+	// The snapshot only includes the subscribed CLOB pairs.
+	snapshot := placeOrderUpdates(constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32)
+	manager.InitializeNewStreams(
+		func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+			require.Equal(t, clobtypes.ClobPairId(0), clobPairId)
+			return snapshot
+		},
+		2,
+	)
+	require.Equal(
+		t,
+		&clobtypes.StreamOrderbookUpdatesResponse{
+			Updates:     decodeUpdates(t, snapshot),
+			Fills:       []clobtypes.StreamOrderbookFill{},
+			Snapshot:    true,
+			BlockHeight: 2,
+		},
+		receive(t, srv),
+	)
+
+	// Snapshots are only sent once.
+	manager.InitializeNewStreams(
+		func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+			require.FailNow(t, "unexpected snapshot")
+			return nil
+		},
+		3,
+	)
+
+	// Updates are filtered by CLOB pair.
+	manager.SendOrderbookUpdates(
+		placeOrderUpdates(
+			constants.Order_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB15,
+			constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+		),
+		3,
+	)
+	require.Equal(
+		t,
+		&clobtypes.StreamOrderbookUpdatesResponse{
+			Updates:     decodeUpdates(t, placeOrderUpdates(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15)),
+			Fills:       []clobtypes.StreamOrderbookFill{},
+			BlockHeight: 3,
+		},
+		receive(t, srv),
+	)
+
+	// Fills are filtered by CLOB pair.
+	fill := clobtypes.StreamOrderbookFill{
+		ClobMatch: clobtypes.NewClobMatchFromMatchOrders(
+			&clobtypes.MatchOrders{
+				TakerOrderId: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+				Fills: []clobtypes.MakerFill{
+					{
+						MakerOrderId: constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32.OrderId,
+						FillAmount:   5,
+					},
+				},
+			},
+		),
+		Orders: []clobtypes.Order{
+			constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+			constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+		},
+		FillAmounts: []uint64{5, 5},
+	}
+	otherFill := clobtypes.StreamOrderbookFill{
+		ClobMatch: clobtypes.NewClobMatchFromMatchPerpetualLiquidation(
+			&clobtypes.MatchPerpetualLiquidation{
+				Liquidated: constants.Carl_Num0,
+				ClobPairId: 1,
+			},
+		),
+	}
+	manager.SendOrderbookFillUpdates([]clobtypes.StreamOrderbookFill{otherFill, fill}, 4)
+	require.Equal(
+		t,
+		&clobtypes.StreamOrderbookUpdatesResponse{
+			Updates:     []ocutypes.OffChainUpdateV1{},
+			Fills:       []clobtypes.StreamOrderbookFill{fill},
+			BlockHeight: 4,
+		},
+		receive(t, srv),
+	)
+
+	// Nothing is sent if there are no updates for the subscription.
+	manager.SendOrderbookFillUpdates([]clobtypes.StreamOrderbookFill{otherFill}, 4)
+	require.Empty(t, srv.responses)
+
+	// The subscription is removed once the client disconnects.
+	cancel()
+	require.NoError(t, <-result)
+	require.Equal(t, 0, manager.GetNumSubscriptions())
+}
+
+func TestSubscribe_ClosedWhenBufferIsFull(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), 1)
+	srv := newFakeStreamServer(context.Background())
+	srv.block = make(chan struct{})
+	result := subscribe(t, manager, []uint32{0}, srv)
+
+	manager.InitializeNewStreams(
+		func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates {
+			return clobtypes.NewOffchainUpdates()
+		},
+		2,
+	)
+	for i := 0; i < 2; i++ {
+		manager.SendOrderbookUpdates(placeOrderUpdates(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15), 2)
+	}
+	require.Equal(t, 0, manager.GetNumSubscriptions())
+
+	close(srv.block)
+	require.ErrorIs(t, <-result, clobtypes.ErrGrpcStreamingBufferFull)
+}
+
+func TestStop(t *testing.T) {
+	manager := streaming.NewGrpcStreamingManager(log.NewNopLogger(), 10)
+	results := []chan error{
+		subscribe(t, manager, []uint32{0}, newFakeStreamServer(context.Background())),
+		subscribe(t, manager, []uint32{0, 1}, newFakeStreamServer(context.Background())),
+	}
+
+	manager.Stop()
+	require.Equal(t, 0, manager.GetNumSubscriptions())
+	for _, result := range results {
+		require.NoError(t, <-result)
+	}
+}
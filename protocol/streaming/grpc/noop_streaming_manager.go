@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+var _ types.GrpcStreamingManager = (*NoopGrpcStreamingManager)(nil)
+
+// NoopGrpcStreamingManager is used when gRPC streaming is disabled. It rejects all subscriptions
+// and drops all updates.
+type NoopGrpcStreamingManager struct{}
+
+func NewNoopGrpcStreamingManager() *NoopGrpcStreamingManager {
+	return &NoopGrpcStreamingManager{}
+}
+
+func (sm *NoopGrpcStreamingManager) Enabled() bool {
+	return false
+}
+
+func (sm *NoopGrpcStreamingManager) Subscribe(
+	req clobtypes.StreamOrderbookUpdatesRequest,
+	srv clobtypes.Query_StreamOrderbookUpdatesServer,
+) error {
+	return clobtypes.ErrGrpcStreamingManagerNotEnabled
+}
+
+func (sm *NoopGrpcStreamingManager) InitializeNewStreams(
+	getOrderbookSnapshot func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates,
+	blockHeight uint32,
+) {
+}
+
+func (sm *NoopGrpcStreamingManager) SendOrderbookUpdates(
+	offchainUpdates *clobtypes.OffchainUpdates,
+	blockHeight uint32,
+) {
+}
+
+func (sm *NoopGrpcStreamingManager) SendOrderbookFillUpdates(
+	fills []clobtypes.StreamOrderbookFill,
+	blockHeight uint32,
+) {
+}
+
+func (sm *NoopGrpcStreamingManager) Stop() {
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+type GrpcStreamingManager interface {
+	Enabled() bool
+	Stop()
+
+	// L3 gRPC stream subscription methods.
+	Subscribe(
+		req clobtypes.StreamOrderbookUpdatesRequest,
+		srv clobtypes.Query_StreamOrderbookUpdatesServer,
+	) error
+	InitializeNewStreams(
+		getOrderbookSnapshot func(clobPairId clobtypes.ClobPairId) *clobtypes.OffchainUpdates,
+		blockHeight uint32,
+	)
+	SendOrderbookUpdates(
+		offchainUpdates *clobtypes.OffchainUpdates,
+		blockHeight uint32,
+	)
+	SendOrderbookFillUpdates(
+		fills []clobtypes.StreamOrderbookFill,
+		blockHeight uint32,
+	)
+}
@@ -0,0 +1,267 @@
+package gov_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	feetiertypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+// This tests `MsgDelistClobPair` in `x/clob`.
+func TestDelistClobPair(t *testing.T) {
+	// A long-term order that does not expire during the voting period.
+	longTermOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT5
+	longTermOrder.GoodTilOneof = &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 3_600}
+	shortTermOrder := constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price15_GTB20
+
+	tests := map[string]struct {
+		msg                       *clobtypes.MsgDelistClobPair
+		expectedProposalStatus    govtypesv1.ProposalStatus
+		expectSubmitProposalFails bool
+
+		expectedSubaccounts []satypes.Subaccount
+		expectedSettlePrice uint64
+	}{
+		"Success: positions are settled at the oracle price": {
+			msg: &clobtypes.MsgDelistClobPair{
+				Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPairId: 0,
+			},
+			expectedProposalStatus: govtypesv1.ProposalStatus_PROPOSAL_STATUS_PASSED,
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(4_999_000_000), // $54,999 - $50,000
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(100_000_000_000), // $50,000 + $50,000
+						},
+					},
+				},
+			},
+			expectedSettlePrice: constants.FiveBillion,
+		},
+		"Success: positions are settled at the given price": {
+			msg: &clobtypes.MsgDelistClobPair{
+				Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPairId:      0,
+				SettlementPrice: 4_500_000_000, // $45,000
+			},
+			expectedProposalStatus: govtypesv1.ProposalStatus_PROPOSAL_STATUS_PASSED,
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(9_999_000_000), // $54,999 - $45,000
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(95_000_000_000), // $50,000 + $45,000
+						},
+					},
+				},
+			},
+			expectedSettlePrice: 4_500_000_000,
+		},
+		"Success: undercollateralized positions are settled at their bankruptcy price": {
+			msg: &clobtypes.MsgDelistClobPair{
+				Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPairId:      0,
+				SettlementPrice: 5_599_900_000, // $55,999
+			},
+			expectedProposalStatus: govtypesv1.ProposalStatus_PROPOSAL_STATUS_PASSED,
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(105_999_000_000), // $50,000 + $55,999
+						},
+					},
+				},
+			},
+			expectedSettlePrice: 5_599_900_000,
+		},
+		"Failure: clob pair does not exist": {
+			msg: &clobtypes.MsgDelistClobPair{
+				Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ClobPairId: 99,
+			},
+			expectedProposalStatus: govtypesv1.ProposalStatus_PROPOSAL_STATUS_FAILED,
+		},
+		"Failure: invalid authority": {
+			msg: &clobtypes.MsgDelistClobPair{
+				Authority:  authtypes.NewModuleAddress(clobtypes.ModuleName).String(),
+				ClobPairId: 0,
+			},
+			expectSubmitProposalFails: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *govtypesv1.GenesisState) {
+						genesisState.Params.VotingPeriod = &testapp.TestVotingPeriod
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							constants.Alice_Num0_10_000USD,
+							constants.Bob_Num0_10_000USD,
+							constants.Carl_Num0_1BTC_Short_54999USD,
+							constants.Dave_Num0_1BTC_Long_50000USD,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *pricestypes.GenesisState) {
+						*genesisState = constants.TestPricesGenesisState
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.Params = constants.PerpetualsGenesisParams
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{
+							constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{
+							constants.ClobPair_Btc,
+						}
+						genesisState.LiquidationsConfig = clobtypes.LiquidationsConfig_Default
+						genesisState.EquityTierLimitConfig = clobtypes.EquityTierLimitConfiguration{}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *feetiertypes.GenesisState) {
+						genesisState.Params = constants.PerpetualFeeParamsNoFee
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+
+			// Place a long-term and a short-term order on the clob pair. The long-term order is included
+			// in the block that submits the proposal.
+			for _, order := range []clobtypes.Order{longTermOrder, shortTermOrder} {
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+					ctx,
+					tApp.App,
+					*clobtypes.NewMsgPlaceOrder(order),
+				) {
+					resp := tApp.CheckTx(checkTx)
+					require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+				}
+			}
+
+			// Submit and tally governance proposal that includes `MsgDelistClobPair`.
+			ctx = testapp.SubmitAndTallyProposal(
+				t,
+				ctx,
+				tApp,
+				[]sdk.Msg{tc.msg},
+				false,
+				tc.expectSubmitProposalFails,
+				tc.expectedProposalStatus,
+			)
+
+			clobPair, found := tApp.App.ClobKeeper.GetClobPair(ctx, 0)
+			require.True(t, found)
+			perpetual, err := tApp.App.PerpetualsKeeper.GetPerpetual(ctx, 0)
+			require.NoError(t, err)
+			_, longTermOrderFound := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, longTermOrder.OrderId)
+			_, shortTermOrderFound := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, shortTermOrder.OrderId)
+
+			if tc.expectedProposalStatus != govtypesv1.ProposalStatus_PROPOSAL_STATUS_PASSED {
+				// Verify that the clob pair, its orders, and the positions are unchanged.
+				require.Equal(t, clobtypes.ClobPair_STATUS_ACTIVE, clobPair.Status)
+				require.False(t, perpetual.Settled)
+				if !tc.expectSubmitProposalFails {
+					require.True(t, longTermOrderFound)
+				}
+				require.True(t, shortTermOrderFound)
+				require.Equal(
+					t,
+					constants.Carl_Num0_1BTC_Short_54999USD.PerpetualPositions[0].Quantums,
+					tApp.App.SubaccountsKeeper.GetSubaccount(ctx, constants.Carl_Num0).PerpetualPositions[0].Quantums,
+				)
+				return
+			}
+
+			// Verify that the clob pair is in final settlement and all of its orders were removed.
+			require.Equal(t, clobtypes.ClobPair_STATUS_FINAL_SETTLEMENT, clobPair.Status)
+			require.False(t, longTermOrderFound)
+			require.False(t, shortTermOrderFound)
+
+			// Verify that all positions were closed at the settlement price.
+			for _, expectedSubaccount := range tc.expectedSubaccounts {
+				require.Equal(
+					t,
+					expectedSubaccount,
+					tApp.App.SubaccountsKeeper.GetSubaccount(ctx, *expectedSubaccount.Id),
+				)
+			}
+
+			// Verify that the perpetual is settled.
+			require.True(t, perpetual.Settled)
+			require.Equal(t, tc.expectedSettlePrice, perpetual.SettlementPrice)
+
+			// Verify that new orders are rejected.
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+				ctx,
+				tApp.App,
+				*clobtypes.NewMsgPlaceOrder(constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price15_GTB20),
+			) {
+				resp := tApp.CheckTx(checkTx)
+				require.Conditionf(t, resp.IsErr, "Expected CheckTx to fail. Response: %+v", resp)
+				require.Contains(t, resp.Log, clobtypes.ErrOrderConflictsWithClobPairStatus.Error())
+			}
+		})
+	}
+}
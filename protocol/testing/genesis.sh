@@ -160,6 +160,7 @@ function edit_genesis() {
 	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.funding_rate_clamp_factor_ppm' -v '6000000' # 600 % (same as 75% on hourly rate)
 	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.premium_vote_clamp_factor_ppm' -v '60000000' # 6000 % (some multiples of funding rate clamp factor)
 	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.min_num_votes_per_sample' -v '15' # half of expected number of votes
+	dasel put -t int -f "$GENESIS" '.app_state.perpetuals.params.removed_tail_sample_ratio_ppm' -v '0' # samples are already medians of votes
 
 	# Perpetuals.
 	dasel put -t json -f "$GENESIS" '.app_state.perpetuals.perpetuals' -v "[]"
@@ -1350,8 +1351,11 @@ function edit_genesis() {
 	dasel put -t int -f "$GENESIS" '.app_state.clob.liquidations_config.position_block_limits.max_position_portion_liquidated_ppm' -v '100000'  # 10%
 	dasel put -t int -f "$GENESIS" '.app_state.clob.liquidations_config.subaccount_block_limits.max_notional_liquidated' -v '100000000000'  # 100_000 USDC
 	dasel put -t int -f "$GENESIS" '.app_state.clob.liquidations_config.subaccount_block_limits.max_quantums_insurance_lost' -v '1000000000000' # 1_000_000 USDC
+	dasel put -t int -f "$GENESIS" '.app_state.clob.liquidations_config.subaccount_block_limits.max_perpetual_positions_liquidated' -v '5'
 	dasel put -t int -f "$GENESIS" '.app_state.clob.liquidations_config.fillable_price_config.bankruptcy_adjustment_ppm' -v '1000000'
 	dasel put -t int -f "$GENESIS" '.app_state.clob.liquidations_config.fillable_price_config.spread_to_maintenance_margin_ratio_ppm' -v '1500000'  # 150%
+	dasel put -t bool -f "$GENESIS" '.app_state.clob.liquidations_config.target_margin_config.enabled' -v 'false'
+	dasel put -t int -f "$GENESIS" '.app_state.clob.liquidations_config.target_margin_config.maintenance_margin_buffer_ppm' -v '0'
 
 	# Block Rate Limit
 	# Max 50 short term orders per block
@@ -1149,7 +1149,13 @@ func launchValidatorInDir(
 
 // MustMakeCheckTxsWithClobMsg creates one signed RequestCheckTx for each msg passed in.
 // The messsage must use one of the hard-coded well known subaccount owners otherwise this will panic.
-func MustMakeCheckTxsWithClobMsg[T clobtypes.MsgPlaceOrder | clobtypes.MsgCancelOrder](
+func MustMakeCheckTxsWithClobMsg[
+	T clobtypes.MsgPlaceOrder |
+		clobtypes.MsgCancelOrder |
+		clobtypes.MsgReplaceOrder |
+		clobtypes.MsgBatchCancel |
+		clobtypes.MsgCancelAllOrders,
+](
 	ctx sdk.Context,
 	app *app.App,
 	messages ...T,
@@ -1163,6 +1169,12 @@ func MustMakeCheckTxsWithClobMsg[T clobtypes.MsgPlaceOrder | clobtypes.MsgCancel
 			m = &v
 		case clobtypes.MsgCancelOrder:
 			m = &v
+		case clobtypes.MsgReplaceOrder:
+			m = &v
+		case clobtypes.MsgBatchCancel:
+			m = &v
+		case clobtypes.MsgCancelAllOrders:
+			m = &v
 		default:
 			panic(fmt.Errorf("MustMakeCheckTxsWithClobMsg: Unknown message type %T", msg))
 		}
@@ -164,7 +164,16 @@ func NewMatchOperationRaw(
 	takerMatchableOrder types.MatchableOrder,
 	makerFills []types.MakerFill,
 ) types.OperationRaw {
-	if takerMatchableOrder.IsLiquidation() {
+	if takerMatchableOrder.IsAssetLiquidation() {
+		return NewMatchOperationRawFromAssetLiquidation(types.MatchAssetLiquidation{
+			Liquidated: takerMatchableOrder.GetSubaccountId(),
+			ClobPairId: takerMatchableOrder.GetClobPairId().ToUint32(),
+			AssetId:    takerMatchableOrder.MustGetLiquidatedAssetId(),
+			TotalSize:  takerMatchableOrder.GetBaseQuantums().ToUint64(),
+			IsBuy:      takerMatchableOrder.IsBuy(),
+			Fills:      makerFills,
+		})
+	} else if takerMatchableOrder.IsLiquidation() {
 		return types.OperationRaw{
 			Operation: &types.OperationRaw_Match{
 				Match: &types.ClobMatch{
@@ -214,6 +223,22 @@ func NewMatchOperationRawFromPerpetualLiquidation(
 	}
 }
 
+// NewMatchOperationRawFromAssetLiquidation returns a new raw match operation
+// wrapping the `assetLiquidation` object.
+func NewMatchOperationRawFromAssetLiquidation(
+	assetLiquidation types.MatchAssetLiquidation,
+) types.OperationRaw {
+	return types.OperationRaw{
+		Operation: &types.OperationRaw_Match{
+			Match: &types.ClobMatch{
+				Match: &types.ClobMatch_MatchAssetLiquidation{
+					MatchAssetLiquidation: &assetLiquidation,
+				},
+			},
+		},
+	}
+}
+
 // NewMatchOperationRawFromPerpetualDeleveragingLiquidation returns a new raw match operation
 // wrapping the `perpDeleveraging` object.
 func NewMatchOperationRawFromPerpetualDeleveragingLiquidation(
@@ -230,6 +255,22 @@ func NewMatchOperationRawFromPerpetualDeleveragingLiquidation(
 	}
 }
 
+// NewMatchOperationRawFromAssetDeleveraging returns a new raw match operation
+// wrapping the `assetDeleveraging` object.
+func NewMatchOperationRawFromAssetDeleveraging(
+	assetDeleveraging types.MatchAssetDeleveraging,
+) types.OperationRaw {
+	return types.OperationRaw{
+		Operation: &types.OperationRaw_Match{
+			Match: &types.ClobMatch{
+				Match: &types.ClobMatch_MatchAssetDeleveraging{
+					MatchAssetDeleveraging: &assetDeleveraging,
+				},
+			},
+		},
+	}
+}
+
 // NewOrderRemovalOperationRaw returns a new raw order removal operation.
 func NewOrderRemovalOperationRaw(
 	orderId types.OrderId,
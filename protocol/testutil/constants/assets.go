@@ -46,6 +46,30 @@ var (
 		AtomicResolution: int32(-8),
 	}
 
+	BtcUsd_80PercentCollateralWeight = &asstypes.Asset{
+		Id:                  1,
+		Symbol:              "BTC",
+		Denom:               "btc-denom",
+		DenomExponent:       int32(-8),
+		HasMarket:           true,
+		MarketId:            uint32(0),
+		AtomicResolution:    int32(-8),
+		CollateralWeightPpm: 800_000,
+	}
+
+	BtcUsd_Borrowable = &asstypes.Asset{
+		Id:                     1,
+		Symbol:                 "BTC",
+		Denom:                  "btc-denom",
+		DenomExponent:          int32(-8),
+		HasMarket:              true,
+		MarketId:               uint32(0),
+		AtomicResolution:       int32(-8),
+		InitialMarginPpm:       200_000,
+		MaintenanceFractionPpm: 500_000,
+		BorrowRatePpm:          100_000,
+	}
+
 	Usdc = &asstypes.Asset{
 		Id:               0,
 		Symbol:           "USDC",
@@ -67,6 +67,19 @@ var (
 		QuantumConversionExponent: 10,
 		Status:                    clobtypes.ClobPair_STATUS_ACTIVE,
 	}
+	ClobPair_Spot_BtcUsdc = clobtypes.ClobPair{
+		Id: 1,
+		Metadata: &clobtypes.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &clobtypes.SpotClobMetadata{
+				BaseAssetId:  1,
+				QuoteAssetId: 0,
+			},
+		},
+		StepBaseQuantums:          10,
+		SubticksPerTick:           100,
+		QuantumConversionExponent: -8,
+		Status:                    clobtypes.ClobPair_STATUS_ACTIVE,
+	}
 	ClobPair_Btc2 = clobtypes.ClobPair{
 		Id: 101,
 		Metadata: &clobtypes.ClobPair_PerpetualClobMetadata{
@@ -103,6 +116,18 @@ var (
 		QuantumConversionExponent: -8,
 		Status:                    clobtypes.ClobPair_STATUS_INITIALIZING,
 	}
+	ClobPair_Btc_FinalSettlement = clobtypes.ClobPair{
+		Id: 0,
+		Metadata: &clobtypes.ClobPair_PerpetualClobMetadata{
+			PerpetualClobMetadata: &clobtypes.PerpetualClobMetadata{
+				PerpetualId: 0,
+			},
+		},
+		StepBaseQuantums:          5,
+		SubticksPerTick:           5,
+		QuantumConversionExponent: -8,
+		Status:                    clobtypes.ClobPair_STATUS_FINAL_SETTLEMENT,
+	}
 	ClobPair_Btc_Paused = clobtypes.ClobPair{
 		Id: 0,
 		Metadata: &clobtypes.ClobPair_PerpetualClobMetadata{
@@ -34,10 +34,15 @@ const GenesisState = `{
       "assets": [
         {
           "atomic_resolution": -6,
+          "borrow_index": "0",
+          "borrow_rate_ppm": 0,
+          "collateral_weight_ppm": 0,
           "denom": "ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5",
           "denom_exponent": "-6",
           "has_market": false,
           "id": 0,
+          "initial_margin_ppm": 0,
+          "maintenance_fraction_ppm": 0,
           "market_id": 0,
           "symbol": "USDC"
         }
@@ -329,7 +334,12 @@ const GenesisState = `{
         },
         "subaccount_block_limits": {
           "max_notional_liquidated": 100000000000000,
-          "max_quantums_insurance_lost": 100000000000000
+          "max_quantums_insurance_lost": 100000000000000,
+          "max_perpetual_positions_liquidated": 5
+        },
+        "target_margin_config": {
+          "enabled": false,
+          "maintenance_margin_buffer_ppm": 0
         }
       }
     },
@@ -880,7 +890,8 @@ const GenesisState = `{
       "params": {
         "funding_rate_clamp_factor_ppm": 6000000,
         "min_num_votes_per_sample": 15,
-        "premium_vote_clamp_factor_ppm": 60000000
+        "premium_vote_clamp_factor_ppm": 60000000,
+        "removed_tail_sample_ratio_ppm": 0
       },
       "perpetuals": [
         {
@@ -22,8 +22,9 @@ var (
 		MaxPositionPortionLiquidatedPpm: 1_000_000,
 	}
 	SubaccountBlockLimits_Default = clobtypes.SubaccountBlockLimits{
-		MaxNotionalLiquidated:    100_000_000_000_000,
-		MaxQuantumsInsuranceLost: 100_000_000_000_000,
+		MaxNotionalLiquidated:           100_000_000_000_000,
+		MaxQuantumsInsuranceLost:        100_000_000_000_000,
+		MaxPerpetualPositionsLiquidated: 5,
 	}
 	PositionBlockLimits_No_Limit = clobtypes.PositionBlockLimits{
 		MinPositionNotionalLiquidated:   1,
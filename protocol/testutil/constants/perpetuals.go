@@ -194,6 +194,18 @@ var (
 		},
 		FundingIndex: dtypes.ZeroInt(),
 	}
+	BtcUsd_SmallMarginRequirement_OpenInterestCap60000USD = perptypes.Perpetual{
+		Params: perptypes.PerpetualParams{
+			Id:                      0,
+			Ticker:                  "BTC-USD small margin requirement, $60,000 open interest cap",
+			MarketId:                uint32(0),
+			AtomicResolution:        int32(-8),
+			DefaultFundingPpm:       int32(0),
+			LiquidityTier:           uint32(8),
+			OpenInterestCapNotional: 60_000_000_000, // $60,000
+		},
+		FundingIndex: dtypes.ZeroInt(),
+	}
 	BtcUsd_100PercentMarginRequirement = perptypes.Perpetual{
 		Params: perptypes.PerpetualParams{
 			Id:                0,
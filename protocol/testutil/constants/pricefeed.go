@@ -4,6 +4,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/constants"
 	daemonClientTypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/client"
 	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
@@ -164,6 +165,11 @@ var (
 	Price6       uint64 = 60006
 	Price7       uint64 = 7007
 
+	// Volumes
+	Volume1 = pricefeedtypes.Volume{Value: 100_000_000, Exponent: -8}
+	Volume2 = pricefeedtypes.Volume{Value: 200_000_000, Exponent: -8}
+	Volume3 = pricefeedtypes.Volume{Value: 300_000_000, Exponent: -8}
+
 	// Exchange 0 prices
 	Exchange0_Price4_TimeT = &api.ExchangePrice{
 		ExchangeId:     ExchangeId1,
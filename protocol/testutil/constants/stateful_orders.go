@@ -977,6 +977,21 @@ var (
 		ConditionType:                   clobtypes.Order_CONDITION_TYPE_STOP_LOSS,
 		ConditionalOrderTriggerSubticks: 49_999_000_000,
 	}
+	ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49000_Trail1000 = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Bob_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                             clobtypes.Order_SIDE_SELL,
+		Quantums:                         100_000_000,
+		Subticks:                         50_000_000_000,
+		GoodTilOneof:                     &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                    clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks:  49_000_000_000,
+		ConditionalOrderTrailingSubticks: 1_000_000_000,
+	}
 	ConditionalOrder_Carl_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10 = clobtypes.Order{
 		OrderId: clobtypes.OrderId{
 			SubaccountId: Carl_Num0,
@@ -1146,6 +1161,38 @@ var (
 		ConditionalOrderTriggerSubticks: 49_999_000_000,
 	}
 
+	// Trailing stop orders.
+	ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5 = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                             clobtypes.Order_SIDE_SELL,
+		Quantums:                         5,
+		Subticks:                         10,
+		GoodTilOneof:                     &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                    clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks:  20,
+		ConditionalOrderTrailingSubticks: 5,
+	}
+	ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price30_GTBT15_TrailingStop20_Trail10Percent = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     1,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_BUY,
+		Quantums:                        5,
+		Subticks:                        30,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks: 20,
+		ConditionalOrderTrailingPpm:     100_000,
+	}
+
 	// Long-Term post-only orders.
 	LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15_PO = clobtypes.Order{
 		OrderId: clobtypes.OrderId{
@@ -1244,4 +1291,133 @@ var (
 		GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
 		TimeInForce:  clobtypes.Order_TIME_IN_FORCE_IOC,
 	}
+
+	// Order group orders.
+	LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_BracketEntry = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+			ClobPairId:   0,
+		},
+		Side:         clobtypes.Order_SIDE_BUY,
+		Quantums:     5,
+		Subticks:     10,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		OrderGroup: &clobtypes.OrderGroup{
+			Id:   0,
+			Type: clobtypes.OrderGroup_TYPE_BRACKET,
+			Leg:  clobtypes.OrderGroup_LEG_ENTRY,
+		},
+	}
+	ConditionalOrder_Alice_Num0_Id1_Clob0_Sell5_Price20_GTBT15_TakeProfit20_BracketTakeProfit = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     1,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        5,
+		Subticks:                        20,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TAKE_PROFIT,
+		ConditionalOrderTriggerSubticks: 20,
+		OrderGroup: &clobtypes.OrderGroup{
+			Id:   0,
+			Type: clobtypes.OrderGroup_TYPE_BRACKET,
+			Leg:  clobtypes.OrderGroup_LEG_TAKE_PROFIT,
+		},
+	}
+	ConditionalOrder_Alice_Num0_Id2_Clob0_Sell5_Price5_GTBT15_StopLoss5_BracketStopLoss = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     2,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        5,
+		Subticks:                        5,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_STOP_LOSS,
+		ConditionalOrderTriggerSubticks: 5,
+		OrderGroup: &clobtypes.OrderGroup{
+			Id:   0,
+			Type: clobtypes.OrderGroup_TYPE_BRACKET,
+			Leg:  clobtypes.OrderGroup_LEG_STOP_LOSS,
+		},
+	}
+	ConditionalOrder_Alice_Num0_Id3_Clob0_Sell5_Price20_GTBT15_TakeProfit20_OCO = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     3,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        5,
+		Subticks:                        20,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TAKE_PROFIT,
+		ConditionalOrderTriggerSubticks: 20,
+		OrderGroup: &clobtypes.OrderGroup{
+			Id:   1,
+			Type: clobtypes.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+		},
+	}
+	ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price5_GTBT15_StopLoss5_OCO = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     4,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        5,
+		Subticks:                        5,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_STOP_LOSS,
+		ConditionalOrderTriggerSubticks: 5,
+		OrderGroup: &clobtypes.OrderGroup{
+			Id:   1,
+			Type: clobtypes.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+		},
+	}
+	ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Bob_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        100_000_000,
+		Subticks:                        50_000_000_000,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TAKE_PROFIT,
+		ConditionalOrderTriggerSubticks: 50_001_000_000,
+		OrderGroup: &clobtypes.OrderGroup{
+			Id:   0,
+			Type: clobtypes.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+		},
+	}
+	ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49995_OCO = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Bob_Num0,
+			ClientId:     1,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        100_000_000,
+		Subticks:                        50_000_000_000,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_STOP_LOSS,
+		ConditionalOrderTriggerSubticks: 49_995_000_000,
+		OrderGroup: &clobtypes.OrderGroup{
+			Id:   0,
+			Type: clobtypes.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+		},
+	}
 )
@@ -73,6 +73,20 @@ var (
 			},
 		},
 	}
+	Carl_Num0_1BTC_Borrowed = satypes.Subaccount{
+		Id: &Carl_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&Usdc_Asset_100_000,
+			&Short_Asset_1BTC,
+		},
+	}
+	Carl_Num0_1BTC_Collateral_Short_46000USD = satypes.Subaccount{
+		Id: &Carl_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&Short_Usdc_Asset_46_000,
+			&Long_Asset_1BTC,
+		},
+	}
 	Carl_Num1_1BTC_Short = satypes.Subaccount{
 		Id: &Carl_Num1,
 		AssetPositions: []*satypes.AssetPosition{
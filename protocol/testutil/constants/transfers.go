@@ -69,3 +69,12 @@ var (
 		Quantums:  750_000_000, // $750
 	}
 )
+
+// Test constants for transfer-isolated-collateral messages.
+var (
+	MsgTransferIsolatedCollateral_Alice_Num0_To_Btc_500 = types.MsgTransferIsolatedCollateral{
+		SubaccountId: Alice_Num0,
+		PerpetualId:  0,
+		Quantums:     500_000_000, // $500
+	}
+)
@@ -0,0 +1,123 @@
+package pricefeed
+
+import (
+	"encoding/binary"
+	"sort"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+)
+
+// EVM opcodes used by mock contracts.
+const (
+	opCallDataLoad = 0x35
+	opCodeCopy     = 0x39
+	opShr          = 0x1c
+	opEq           = 0x14
+	opJumpI        = 0x57
+	opJumpDest     = 0x5b
+	opPush1        = 0x60
+	opPush2        = 0x61
+	opPush4        = 0x63
+	opDup1         = 0x80
+	opReturn       = 0xf3
+	opRevert       = 0xfd
+
+	simulatedEthBackendGasLimit = 30_000_000
+)
+
+// MockEthContract is a contract deployed on a simulated Ethereum backend that returns fixed values for each of
+// its methods, regardless of the arguments of the call. Calls to any other method revert.
+type MockEthContract struct {
+	Abi *ethabi.ABI
+	// MethodToOutputs maps the name of each method of the contract to the values it returns.
+	MethodToOutputs map[string][]interface{}
+}
+
+// NewSimulatedEthBackend returns a simulated Ethereum backend with each mock contract deployed at its address.
+// The backend is closed when the test completes.
+func NewSimulatedEthBackend(
+	t *testing.T,
+	addressToContract map[ethcommon.Address]MockEthContract,
+) *backends.SimulatedBackend {
+	alloc := make(core.GenesisAlloc, len(addressToContract))
+	for address, contract := range addressToContract {
+		alloc[address] = core.GenesisAccount{
+			Code:    getMockEthContractCode(t, contract),
+			Balance: ethcommon.Big0,
+		}
+	}
+
+	backend := backends.NewSimulatedBackend(alloc, simulatedEthBackendGasLimit)
+	t.Cleanup(func() {
+		require.NoError(t, backend.Close())
+	})
+	return backend
+}
+
+// getMockEthContractCode returns the EVM bytecode of a mock contract. The bytecode dispatches on the method
+// selector of the call data, and copies the packed outputs of the method from the end of the bytecode into
+// the return data.
+func getMockEthContractCode(t *testing.T, contract MockEthContract) []byte {
+	methods := make([]string, 0, len(contract.MethodToOutputs))
+	for method := range contract.MethodToOutputs {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	selectors := make([][]byte, 0, len(methods))
+	returnData := make([][]byte, 0, len(methods))
+	for _, method := range methods {
+		abiMethod, exists := contract.Abi.Methods[method]
+		require.True(t, exists, "method %v does not exist in ABI", method)
+		data, err := abiMethod.Outputs.Pack(contract.MethodToOutputs[method]...)
+		require.NoError(t, err)
+		selectors = append(selectors, abiMethod.ID)
+		returnData = append(returnData, data)
+	}
+
+	const (
+		headerLength   = 6
+		dispatchLength = 11
+		revertLength   = 4
+		handlerLength  = 16
+	)
+	handlersOffset := headerLength + dispatchLength*len(methods) + revertLength
+	dataOffset := handlersOffset + handlerLength*len(methods)
+	codeLength := dataOffset
+
+	// Load the method selector from the first 4 bytes of the call data.
+	code := []byte{opPush1, 0x00, opCallDataLoad, opPush1, 0xe0, opShr}
+
+	// Jump to the handler of the matching method, or revert if no method matches.
+	for i, selector := range selectors {
+		code = append(code, opDup1, opPush4)
+		code = append(code, selector...)
+		code = append(code, opEq, opPush2)
+		code = binary.BigEndian.AppendUint16(code, uint16(handlersOffset+handlerLength*i))
+		code = append(code, opJumpI)
+	}
+	code = append(code, opPush1, 0x00, opDup1, opRevert)
+
+	// Return the outputs of each method.
+	for _, data := range returnData {
+		code = append(code, opJumpDest, opPush2)
+		code = binary.BigEndian.AppendUint16(code, uint16(len(data)))
+		code = append(code, opPush2)
+		code = binary.BigEndian.AppendUint16(code, uint16(dataOffset))
+		code = append(code, opPush1, 0x00, opCodeCopy, opPush2)
+		code = binary.BigEndian.AppendUint16(code, uint16(len(data)))
+		code = append(code, opPush1, 0x00, opReturn)
+		dataOffset += len(data)
+	}
+
+	require.Len(t, code, codeLength)
+	for _, data := range returnData {
+		code = append(code, data...)
+	}
+	return code
+}
@@ -0,0 +1,106 @@
+package pricefeed
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// WebsocketServer is a mock exchange WebSocket server used to test streaming price fetchers. It records all
+// messages received from clients, and pushes messages to all connected clients on demand.
+type WebsocketServer struct {
+	t        *testing.T
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+
+	lock             sync.Mutex
+	connections      map[*websocket.Conn]struct{}
+	receivedMessages []string
+}
+
+// NewWebsocketServer starts a new mock WebSocket server. The server must be cleaned up with `CleanUp`.
+func NewWebsocketServer(t *testing.T) *WebsocketServer {
+	ws := &WebsocketServer{
+		t:           t,
+		connections: make(map[*websocket.Conn]struct{}),
+	}
+	ws.server = httptest.NewServer(http.HandlerFunc(ws.handle))
+	return ws
+}
+
+// handle upgrades incoming requests to WebSocket connections and records all messages received on them.
+func (ws *WebsocketServer) handle(w http.ResponseWriter, r *http.Request) {
+	conn, err := ws.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	ws.lock.Lock()
+	ws.connections[conn] = struct{}{}
+	ws.lock.Unlock()
+
+	defer func() {
+		ws.lock.Lock()
+		delete(ws.connections, conn)
+		ws.lock.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		ws.lock.Lock()
+		ws.receivedMessages = append(ws.receivedMessages, string(message))
+		ws.lock.Unlock()
+	}
+}
+
+// Url returns the WebSocket url of the server.
+func (ws *WebsocketServer) Url() string {
+	return "ws" + strings.TrimPrefix(ws.server.URL, "http")
+}
+
+// SendMessage pushes a message to all connected clients.
+func (ws *WebsocketServer) SendMessage(message string) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	for conn := range ws.connections {
+		require.NoError(ws.t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+	}
+}
+
+// DropConnections closes all client connections without a close handshake.
+func (ws *WebsocketServer) DropConnections() {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	for conn := range ws.connections {
+		conn.Close()
+	}
+}
+
+// GetNumConnections returns the number of currently connected clients.
+func (ws *WebsocketServer) GetNumConnections() int {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	return len(ws.connections)
+}
+
+// GetReceivedMessages returns all messages received from clients, in the order they were received.
+func (ws *WebsocketServer) GetReceivedMessages() []string {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	return append([]string{}, ws.receivedMessages...)
+}
+
+// CleanUp closes all client connections and shuts down the server.
+func (ws *WebsocketServer) CleanUp() {
+	ws.DropConnections()
+	ws.server.Close()
+}
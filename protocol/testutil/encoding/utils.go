@@ -76,6 +76,9 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 		&clobtypes.MsgProposedOperations{},
 		&clobtypes.MsgPlaceOrder{},
 		&clobtypes.MsgCancelOrder{},
+		&clobtypes.MsgReplaceOrder{},
+		&clobtypes.MsgBatchCancel{},
+		&clobtypes.MsgCancelAllOrders{},
 
 		// Perpetuals.
 		&perpetualtypes.MsgAddPremiumVotes{},
@@ -87,6 +90,7 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 		&sendingtypes.MsgCreateTransfer{},
 		&sendingtypes.MsgDepositToSubaccount{},
 		&sendingtypes.MsgWithdrawFromSubaccount{},
+		&sendingtypes.MsgTransferIsolatedCollateral{},
 	}
 
 	for _, msg := range msgInterfacesToRegister {
@@ -6,6 +6,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/common"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 
 	tmdb "github.com/cometbft/cometbft-db"
@@ -18,6 +19,8 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	delaymsgmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
+	epochskeeper "github.com/dydxprotocol/v4-chain/protocol/x/epochs/keeper"
 	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
 )
 
@@ -32,6 +35,7 @@ func CreateUsdcAsset(ctx sdk.Context, assetsKeeper *keeper.Keeper) error {
 		constants.Usdc.HasMarket,
 		constants.Usdc.MarketId,
 		constants.Usdc.AtomicResolution,
+		constants.Usdc.CollateralWeightPpm,
 	)
 	return err
 }
@@ -46,6 +50,7 @@ func AssetsKeepers(
 	accountKeeper *authkeeper.AccountKeeper,
 	bankKeeper *bankkeeper.BaseKeeper,
 	storeKey storetypes.StoreKey,
+	epochsKeeper *epochskeeper.Keeper,
 ) {
 	var mockTimeProvider *mocks.TimeProvider
 	ctx = initKeepers(t, func(
@@ -59,13 +64,22 @@ func AssetsKeepers(
 		pricesKeeper, _, _, _, mockTimeProvider = createPricesKeeper(stateStore, db, cdc, transientStoreKey)
 		accountKeeper, _ = createAccountKeeper(stateStore, db, cdc, registry)
 		bankKeeper, _ = createBankKeeper(stateStore, db, cdc, accountKeeper)
-		keeper, storeKey = createAssetsKeeper(stateStore, db, cdc, pricesKeeper, transientStoreKey, msgSenderEnabled)
+		epochsKeeper, _ = createEpochsKeeper(stateStore, db, cdc)
+		keeper, storeKey = createAssetsKeeper(
+			stateStore,
+			db,
+			cdc,
+			pricesKeeper,
+			epochsKeeper,
+			transientStoreKey,
+			msgSenderEnabled,
+		)
 
 		return []GenesisInitializer{pricesKeeper, keeper}
 	})
 	// Mock time provider response for market creation.
 	mockTimeProvider.On("Now").Return(constants.TimeT)
-	return ctx, keeper, pricesKeeper, accountKeeper, bankKeeper, storeKey
+	return ctx, keeper, pricesKeeper, accountKeeper, bankKeeper, storeKey, epochsKeeper
 }
 
 func createAssetsKeeper(
@@ -73,6 +87,7 @@ func createAssetsKeeper(
 	db *tmdb.MemDB,
 	cdc *codec.ProtoCodec,
 	pk *priceskeeper.Keeper,
+	ek *epochskeeper.Keeper,
 	transientStoreKey storetypes.StoreKey,
 	msgSenderEnabled bool,
 ) (*keeper.Keeper, storetypes.StoreKey) {
@@ -88,7 +103,12 @@ func createAssetsKeeper(
 		cdc,
 		storeKey,
 		pk,
+		ek,
 		mockIndexerEventsManager,
+		[]string{
+			lib.GovModuleAddress.String(),
+			delaymsgmoduletypes.ModuleAddress.String(),
+		},
 	)
 
 	return k, storeKey
@@ -6,6 +6,7 @@ import (
 
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	streaming "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
 	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	delaymsgmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
 	"github.com/stretchr/testify/require"
@@ -97,6 +98,7 @@ func NewClobKeepersTestContextWithUninitializedMemStore(
 			db,
 			cdc,
 			ks.PricesKeeper,
+			epochsKeeper,
 			indexerEventsTransientStoreKey,
 			true,
 		)
@@ -211,6 +213,7 @@ func createClobKeeper(
 		statsKeeper,
 		rewardsKeeper,
 		indexerEventManager,
+		streaming.NewNoopGrpcStreamingManager(),
 		constants.TestEncodingCfg.TxConfig.TxDecoder(),
 		flags.GetDefaultClobFlags(),
 		rate_limit.NewNoOpRateLimiter[*types.MsgPlaceOrder](),
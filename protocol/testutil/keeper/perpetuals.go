@@ -176,6 +176,7 @@ func CreateTestLiquidityTiers(t *testing.T, ctx sdk.Context, k *keeper.Keeper) {
 			l.MaintenanceFractionPpm,
 			l.BasePositionNotional,
 			l.ImpactNotional,
+			l.OpenInterestCapNotional,
 		)
 
 		require.NoError(t, err)
@@ -240,6 +241,11 @@ func CreateNPerpetuals(
 			int32(i),             // AtomicResolution
 			defaultFundingPpm,    // DefaultFundingPpm
 			allLiquidityTiers[i%len(allLiquidityTiers)].Id, // LiquidityTier
+			0,   // OpenInterestCapNotional
+			nil, // FundingRateBounds
+			0,   // InterestRatePpm
+			"",  // FundingTickEpoch
+			"",  // FundingSampleEpoch
 		)
 		if err != nil {
 			return items, err
@@ -289,6 +295,11 @@ func CreateTestPricesAndPerpetualMarkets(
 			perp.Params.AtomicResolution,
 			perp.Params.DefaultFundingPpm,
 			perp.Params.LiquidityTier,
+			perp.Params.OpenInterestCapNotional,
+			perp.Params.FundingRateBounds,
+			perp.Params.InterestRatePpm,
+			perp.Params.FundingTickEpoch,
+			perp.Params.FundingSampleEpoch,
 		)
 		require.NoError(t, err)
 	}
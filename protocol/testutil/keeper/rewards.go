@@ -46,6 +46,7 @@ func RewardsKeepers(
 			db,
 			cdc,
 			pricesKeeper,
+			epochsKeeper,
 			transientStoreKey,
 			true,
 		)
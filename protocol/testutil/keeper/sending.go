@@ -69,6 +69,7 @@ func SendingKeepersWithSubaccountsKeeper(t testing.TB, saKeeper types.Subaccount
 			db,
 			cdc,
 			ks.PricesKeeper,
+			epochsKeeper,
 			transientStoreKey,
 			true,
 		)
@@ -52,7 +52,15 @@ func SubaccountsKeepers(
 		pricesKeeper, _, _, _, mockTimeProvider = createPricesKeeper(stateStore, db, cdc, transientStoreKey)
 		epochsKeeper, _ := createEpochsKeeper(stateStore, db, cdc)
 		perpetualsKeeper, _ = createPerpetualsKeeper(stateStore, db, cdc, pricesKeeper, epochsKeeper, transientStoreKey)
-		assetsKeeper, _ = createAssetsKeeper(stateStore, db, cdc, pricesKeeper, transientStoreKey, msgSenderEnabled)
+		assetsKeeper, _ = createAssetsKeeper(
+			stateStore,
+			db,
+			cdc,
+			pricesKeeper,
+			epochsKeeper,
+			transientStoreKey,
+			msgSenderEnabled,
+		)
 
 		accountKeeper, _ = createAccountKeeper(stateStore, db, cdc, registry)
 
@@ -87,6 +87,18 @@ func (f *FakeMemClobKeeper) ReplayPlaceOrder(
 	panic("PlaceShortTermOrder not currently implemented on FakeMemClobKeeper")
 }
 
+func (f *FakeMemClobKeeper) ReplayReplaceOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	panic("ReplayReplaceOrder not currently implemented on FakeMemClobKeeper")
+}
+
 func (f *FakeMemClobKeeper) PlaceShortTermOrder(
 	ctx sdk.Context,
 	msg *types.MsgPlaceOrder,
@@ -108,6 +120,7 @@ func (f *FakeMemClobKeeper) CancelShortTermOrder(
 func (f *FakeMemClobKeeper) CanDeleverageSubaccount(
 	ctx sdk.Context,
 	msg satypes.SubaccountId,
+	perpetualId uint32,
 ) (
 	bool,
 	error,
@@ -470,6 +483,18 @@ func (f *FakeMemClobKeeper) OffsetSubaccountPerpetualPosition(
 	panic("This function should not be implemented as FakeMemClobKeeper is getting deprecated (CLOB-175)")
 }
 
+func (f *FakeMemClobKeeper) OffsetSubaccountAssetPosition(
+	ctx sdk.Context,
+	liquidatedSubaccountId satypes.SubaccountId,
+	assetId uint32,
+	deltaQuantumsTotal *big.Int,
+) (
+	fills []types.MatchPerpetualDeleveraging_Fill,
+	deltaQuantumsRemaining *big.Int,
+) {
+	panic("This function should not be implemented as FakeMemClobKeeper is getting deprecated (CLOB-175)")
+}
+
 func (f *FakeMemClobKeeper) AddPreexistingStatefulOrder(
 	ctx sdk.Context,
 	order *types.Order,
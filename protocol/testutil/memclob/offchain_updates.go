@@ -6,7 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/typ.v4/slices"
@@ -27,8 +28,8 @@ func RequireCancelOrderMessage(t *testing.T, ctx sdk.Context, message *msgsender
 	expectedMessage, _ := off_chain_updates.CreateOrderRemoveMessageWithReason(
 		ctx.Logger(),
 		orderId,
-		indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
-		off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+		sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+		ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 	)
 	require.Equal(t, *message, expectedMessage)
 }
@@ -39,6 +39,27 @@ func WithLiquidityTier(liquidityTier uint32) PerpetualModifierOption {
 	}
 }
 
+func WithOpenInterestCapNotional(openInterestCapNotional uint64) PerpetualModifierOption {
+	return func(cp *perptypes.Perpetual) {
+		cp.Params.OpenInterestCapNotional = openInterestCapNotional
+	}
+}
+
+func WithFundingRateBounds(minFundingRatePpm int32, maxFundingRatePpm int32) PerpetualModifierOption {
+	return func(cp *perptypes.Perpetual) {
+		cp.Params.FundingRateBounds = &perptypes.FundingRateBounds{
+			MinPpm: minFundingRatePpm,
+			MaxPpm: maxFundingRatePpm,
+		}
+	}
+}
+
+func WithInterestRatePpm(interestRatePpm int32) PerpetualModifierOption {
+	return func(cp *perptypes.Perpetual) {
+		cp.Params.InterestRatePpm = interestRatePpm
+	}
+}
+
 // GeneratePerpetual returns a `Perpetual` object set to default values.
 // Passing in `PerpetualModifierOption` methods alters the value of the `Perpetual` returned.
 // It will start with the default, valid `Perpetual` value defined within the method
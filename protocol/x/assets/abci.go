@@ -0,0 +1,11 @@
+package assets
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
+)
+
+// EndBlocker executes all ABCI EndBlock logic respective to the assets module.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.MaybeAccrueBorrowInterest(ctx)
+}
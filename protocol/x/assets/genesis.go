@@ -1,6 +1,8 @@
 package assets
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
@@ -21,10 +23,33 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			asset.HasMarket,
 			asset.MarketId,
 			asset.AtomicResolution,
+			asset.CollateralWeightPpm,
 		)
 		if err != nil {
 			panic(err)
 		}
+
+		if asset.IsBorrowable() {
+			if _, err := k.SetMarginParams(
+				ctx,
+				asset.Id,
+				asset.InitialMarginPpm,
+				asset.MaintenanceFractionPpm,
+				asset.BorrowRatePpm,
+			); err != nil {
+				panic(err)
+			}
+		}
+
+		if asset.BorrowIndex != 0 {
+			if err := k.ModifyBorrowIndex(
+				ctx,
+				asset.Id,
+				new(big.Int).SetUint64(asset.BorrowIndex),
+			); err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"testing"
 
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
@@ -13,7 +14,7 @@ import (
 
 func TestGenesis(t *testing.T) {
 	expected := types.DefaultGenesis()
-	ctx, k, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, k, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	assets.InitGenesis(ctx, *k, *expected)
 	assertAssetCreateEventsInIndexerBlock(t, k, ctx, len(expected.Assets))
 	actual := assets.ExportGenesis(ctx, *k)
@@ -21,6 +22,25 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, actual.Assets, expected.Assets)
 }
 
+func TestGenesis_MarginParams(t *testing.T) {
+	borrowable := *constants.BtcUsd_Borrowable
+	borrowable.BorrowIndex = 1_000
+	expected := &types.GenesisState{
+		Assets: []types.Asset{
+			*constants.Usdc,
+			borrowable,
+		},
+	}
+	require.NoError(t, expected.Validate())
+
+	ctx, k, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	assets.InitGenesis(ctx, *k, *expected)
+	actual := assets.ExportGenesis(ctx, *k)
+	require.NotNil(t, actual)
+	require.ElementsMatch(t, actual.Assets, expected.Assets)
+}
+
 // assertAssetCreateEventsInIndexerBlock checks that the number of asset create events
 // included in the Indexer block kafka message.
 func assertAssetCreateEventsInIndexerBlock(
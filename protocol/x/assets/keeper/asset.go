@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"math/big"
 	"sort"
 
@@ -11,8 +12,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
 func (k Keeper) CreateAsset(
@@ -24,6 +28,7 @@ func (k Keeper) CreateAsset(
 	hasMarket bool,
 	marketId uint32,
 	atomicResolution int32,
+	collateralWeightPpm uint32,
 ) (types.Asset, error) {
 	if prevAsset, exists := k.GetAsset(ctx, assetId); exists {
 		return types.Asset{}, errorsmod.Wrapf(
@@ -67,13 +72,14 @@ func (k Keeper) CreateAsset(
 
 	// Create the asset
 	asset := types.Asset{
-		Id:               assetId,
-		Symbol:           symbol,
-		Denom:            denom,
-		DenomExponent:    denomExponent,
-		HasMarket:        hasMarket,
-		MarketId:         marketId,
-		AtomicResolution: atomicResolution,
+		Id:                  assetId,
+		Symbol:              symbol,
+		Denom:               denom,
+		DenomExponent:       denomExponent,
+		HasMarket:           hasMarket,
+		MarketId:            marketId,
+		AtomicResolution:    atomicResolution,
+		CollateralWeightPpm: collateralWeightPpm,
 	}
 
 	// Validate market
@@ -89,6 +95,11 @@ func (k Keeper) CreateAsset(
 		)
 	}
 
+	// Validate collateral weight
+	if err := asset.ValidateCollateralWeight(); err != nil {
+		return asset, err
+	}
+
 	// Store the new asset
 	k.setAsset(ctx, asset)
 
@@ -131,6 +142,86 @@ func (k Keeper) ModifyAsset(
 	asset.HasMarket = hasMarket
 	asset.MarketId = marketId
 
+	// Collateral and borrowable assets must keep a market to be priced with.
+	if err := asset.ValidateCollateralWeight(); err != nil {
+		return asset, err
+	}
+	if err := asset.ValidateMarginParams(); err != nil {
+		return asset, err
+	}
+
+	// Store the modified asset
+	k.setAsset(ctx, asset)
+
+	return asset, nil
+}
+
+// SetCollateralWeight sets the collateral weight of an existing asset. A collateral weight
+// of zero means that positive balances of the asset no longer count toward the net collateral
+// of subaccounts.
+func (k Keeper) SetCollateralWeight(
+	ctx sdk.Context,
+	id uint32,
+	collateralWeightPpm uint32,
+) (types.Asset, error) {
+	// Get asset
+	asset, exists := k.GetAsset(ctx, id)
+	if !exists {
+		return asset, errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
+	}
+
+	// Modify asset
+	asset.CollateralWeightPpm = collateralWeightPpm
+
+	// Validate collateral weight
+	if err := asset.ValidateCollateralWeight(); err != nil {
+		return asset, err
+	}
+
+	// Store the modified asset
+	k.setAsset(ctx, asset)
+
+	return asset, nil
+}
+
+// SetMarginParams sets the borrowing parameters of an existing asset. An initial margin
+// of zero means that the asset can no longer be borrowed.
+func (k Keeper) SetMarginParams(
+	ctx sdk.Context,
+	id uint32,
+	initialMarginPpm uint32,
+	maintenanceFractionPpm uint32,
+	borrowRatePpm uint32,
+) (types.Asset, error) {
+	// Get asset
+	asset, exists := k.GetAsset(ctx, id)
+	if !exists {
+		return asset, errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
+	}
+
+	// Margin requirements of outstanding borrows cannot be changed, since disabling borrowing would
+	// leave borrowers unable to settle their positions. Only the borrow rate may be changed.
+	if asset.InitialMarginPpm != initialMarginPpm || asset.MaintenanceFractionPpm != maintenanceFractionPpm {
+		if totalBorrowed := k.GetTotalBorrowed(ctx, id); totalBorrowed.Sign() != 0 {
+			return asset, errorsmod.Wrapf(
+				types.ErrAssetHasOpenBorrows,
+				"asset id = %d, total borrowed = %v",
+				id,
+				totalBorrowed,
+			)
+		}
+	}
+
+	// Modify asset
+	asset.InitialMarginPpm = initialMarginPpm
+	asset.MaintenanceFractionPpm = maintenanceFractionPpm
+	asset.BorrowRatePpm = borrowRatePpm
+
+	// Validate margin params
+	if err := asset.ValidateMarginParams(); err != nil {
+		return asset, err
+	}
+
 	// Store the modified asset
 	k.setAsset(ctx, asset)
 
@@ -182,8 +273,37 @@ func (k Keeper) GetAllAssets(
 	return list
 }
 
+// GetAssetAndMarketPrice returns the asset and the price of the market it is priced by.
+// Returns an error if the asset does not exist or does not have a market.
+func (k Keeper) GetAssetAndMarketPrice(
+	ctx sdk.Context,
+	id uint32,
+) (types.Asset, pricestypes.MarketPrice, error) {
+	asset, exists := k.GetAsset(ctx, id)
+	if !exists {
+		return asset, pricestypes.MarketPrice{}, errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
+	}
+
+	if !asset.HasMarket {
+		return asset, pricestypes.MarketPrice{}, errorsmod.Wrapf(types.ErrAssetWithoutMarket, "asset id = %d", id)
+	}
+
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+	if err != nil {
+		return asset, marketPrice, err
+	}
+
+	return asset, marketPrice, nil
+}
+
 // GetNetCollateral returns the net collateral that a given position (quantums)
 // for a given assetId contributes to an account.
+//
+// USDC always contributes its full balance. A positive balance of any other asset
+// contributes its oracle value multiplied by the asset's collateral weight, rounded
+// down. Assets with a collateral weight of zero do not contribute to net collateral.
+// A negative balance contributes its negative oracle value, and returns an error if
+// the asset cannot be borrowed.
 func (k Keeper) GetNetCollateral(
 	ctx sdk.Context,
 	id uint32,
@@ -197,7 +317,7 @@ func (k Keeper) GetNetCollateral(
 	}
 
 	// Get asset
-	_, exists := k.GetAsset(ctx, id)
+	asset, exists := k.GetAsset(ctx, id)
 	if !exists {
 		return big.NewInt(0), errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
 	}
@@ -208,18 +328,53 @@ func (k Keeper) GetNetCollateral(
 	}
 
 	// Balance is positive.
-	// TODO(DEC-581): add multi-collateral support.
 	if bigQuantums.Sign() == 1 {
-		return big.NewInt(0), types.ErrNotImplementedMulticollateral
+		// Assets which are not collateral do not contribute to net collateral.
+		if !asset.IsCollateral() {
+			return big.NewInt(0), nil
+		}
+
+		marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+		if err != nil {
+			return big.NewInt(0), err
+		}
+
+		bigQuoteQuantums := lib.BaseToQuoteQuantums(
+			bigQuantums,
+			asset.AtomicResolution,
+			marketPrice.Price,
+			marketPrice.Exponent,
+		)
+
+		return lib.BigIntMulPpm(bigQuoteQuantums, asset.CollateralWeightPpm), nil
 	}
 
 	// Balance is negative.
-	// TODO(DEC-582): add margin-trading support.
-	return big.NewInt(0), types.ErrNotImplementedMargin
+	if !asset.IsBorrowable() {
+		return big.NewInt(0), errorsmod.Wrapf(types.ErrAssetNotBorrowable, "asset id = %d", id)
+	}
+
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+
+	// A borrowed balance counts against net collateral at its full oracle value.
+	return lib.BaseToQuoteQuantums(
+		bigQuantums,
+		asset.AtomicResolution,
+		marketPrice.Price,
+		marketPrice.Exponent,
+	), nil
 }
 
 // GetMarginRequirements returns the initial and maintenance margin-
 // requirements for a given position size for a given assetId.
+//
+// Only negative balances have margin requirements. The initial margin requirement is the
+// oracle value of the borrowed balance multiplied by the asset's initial margin, and the
+// maintenance margin requirement is the initial margin requirement multiplied by the
+// asset's maintenance fraction. Both are rounded up.
 func (k Keeper) GetMarginRequirements(
 	ctx sdk.Context,
 	id uint32,
@@ -235,7 +390,7 @@ func (k Keeper) GetMarginRequirements(
 	}
 
 	// Get asset
-	_, exists := k.GetAsset(ctx, id)
+	asset, exists := k.GetAsset(ctx, id)
 	if !exists {
 		return big.NewInt(0), big.NewInt(0), errorsmod.Wrap(
 			types.ErrAssetDoesNotExist, lib.UintToString(id))
@@ -247,8 +402,177 @@ func (k Keeper) GetMarginRequirements(
 	}
 
 	// Balance is negative.
-	// TODO(DEC-582): margin-trading
-	return big.NewInt(0), big.NewInt(0), types.ErrNotImplementedMargin
+	if !asset.IsBorrowable() {
+		return big.NewInt(0), big.NewInt(0), errorsmod.Wrapf(types.ErrAssetNotBorrowable, "asset id = %d", id)
+	}
+
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+	if err != nil {
+		return big.NewInt(0), big.NewInt(0), err
+	}
+
+	bigBorrowedQuoteQuantums := lib.BaseToQuoteQuantums(
+		new(big.Int).Abs(bigQuantums),
+		asset.AtomicResolution,
+		marketPrice.Price,
+		marketPrice.Exponent,
+	)
+
+	// Margin requirements are always rounded up.
+	bigInitialMarginQuoteQuantums = lib.BigRatRound(
+		lib.BigRatMulPpm(
+			new(big.Rat).SetInt(bigBorrowedQuoteQuantums),
+			asset.InitialMarginPpm,
+		),
+		true,
+	)
+	bigMaintenanceMarginQuoteQuantums = lib.BigRatRound(
+		lib.BigRatMulPpm(
+			new(big.Rat).SetInt(bigInitialMarginQuoteQuantums),
+			asset.MaintenanceFractionPpm,
+		),
+		true,
+	)
+
+	return bigInitialMarginQuoteQuantums, bigMaintenanceMarginQuoteQuantums, nil
+}
+
+// MaybeAccrueBorrowInterest adds the borrow interest accrued over the last funding-tick
+// epoch to the borrow index of every borrowable asset, if the current block is the start of
+// a new funding-tick epoch. Otherwise, it does nothing.
+//
+// The interest rate for the epoch is the asset's annualized borrow rate prorated by the
+// duration of the epoch, and it is converted to an index delta using the current oracle price.
+// Assets whose oracle price cannot be retrieved are skipped, and do not accrue interest for the epoch.
+func (k Keeper) MaybeAccrueBorrowInterest(ctx sdk.Context) {
+	numBlocks, err := k.epochsKeeper.NumBlocksSinceEpochStart(
+		ctx,
+		epochstypes.FundingTickEpochInfoName,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// If the current block is not the start of a new funding-tick epoch, do nothing.
+	if numBlocks != 0 {
+		return
+	}
+
+	fundingTickEpochInfo := k.epochsKeeper.MustGetFundingTickEpochInfo(ctx)
+
+	for _, asset := range k.GetAllAssets(ctx) {
+		if !asset.IsBorrowable() || asset.BorrowRatePpm == 0 {
+			continue
+		}
+
+		marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"MaybeAccrueBorrowInterest: failed to get market price. Skipping borrow interest",
+				"assetId", asset.Id,
+				"marketId", asset.MarketId,
+				"error", err,
+			)
+			continue
+		}
+
+		// Get the borrow rate prorated by the duration of the epoch.
+		proratedBorrowRate := new(big.Rat).SetFrac(
+			new(big.Int).SetUint64(uint64(asset.BorrowRatePpm)*uint64(fundingTickEpochInfo.Duration)),
+			big.NewInt(types.SecondsPerYear),
+		)
+
+		bigBorrowIndexDelta := lib.FundingRateToIndex(
+			proratedBorrowRate,
+			asset.AtomicResolution,
+			marketPrice.Price,
+			marketPrice.Exponent,
+		)
+
+		if err := k.ModifyBorrowIndex(ctx, asset.Id, bigBorrowIndexDelta); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ModifyBorrowIndex adds `bigBorrowIndexDelta` to the borrow index of the asset with id `assetId`.
+// Returns an error if the asset does not exist or the resulting borrow index is not a valid `uint64`.
+func (k Keeper) ModifyBorrowIndex(
+	ctx sdk.Context,
+	assetId uint32,
+	bigBorrowIndexDelta *big.Int,
+) (
+	err error,
+) {
+	asset, exists := k.GetAsset(ctx, assetId)
+	if !exists {
+		return errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(assetId))
+	}
+
+	bigNewBorrowIndex := new(big.Int).Add(
+		new(big.Int).SetUint64(asset.BorrowIndex),
+		bigBorrowIndexDelta,
+	)
+	if !bigNewBorrowIndex.IsUint64() {
+		return fmt.Errorf(
+			"borrow index out of range for asset %d: %v",
+			assetId,
+			bigNewBorrowIndex,
+		)
+	}
+
+	asset.BorrowIndex = bigNewBorrowIndex.Uint64()
+	k.setAsset(ctx, asset)
+	return nil
+}
+
+// GetTotalBorrowed returns the total quantums of the asset that are borrowed across all subaccounts,
+// as a non-negative number.
+func (k Keeper) GetTotalBorrowed(
+	ctx sdk.Context,
+	assetId uint32,
+) *big.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TotalBorrowedKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(assetId))
+	if b == nil {
+		return new(big.Int)
+	}
+
+	var totalBorrowed dtypes.SerializableInt
+	if err := totalBorrowed.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return totalBorrowed.BigInt()
+}
+
+// ModifyTotalBorrowed adds `bigBorrowedDelta` to the total borrowed quantums of the asset with id
+// `assetId`. It is called whenever the negative balance of a subaccount's asset position changes.
+func (k Keeper) ModifyTotalBorrowed(
+	ctx sdk.Context,
+	assetId uint32,
+	bigBorrowedDelta *big.Int,
+) {
+	if bigBorrowedDelta.Sign() == 0 {
+		return
+	}
+
+	totalBorrowed := k.GetTotalBorrowed(ctx, assetId)
+	totalBorrowed.Add(totalBorrowed, bigBorrowedDelta)
+	if totalBorrowed.Sign() < 0 {
+		panic(fmt.Sprintf("total borrowed of asset %d is negative: %v", assetId, totalBorrowed))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TotalBorrowedKeyPrefix))
+	if totalBorrowed.Sign() == 0 {
+		store.Delete(lib.Uint32ToKey(assetId))
+		return
+	}
+
+	b, err := dtypes.NewIntFromBigInt(totalBorrowed).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(lib.Uint32ToKey(assetId), b)
 }
 
 // ConvertAssetToCoin converts the given `assetId` and `quantums` used in `x/asset`,
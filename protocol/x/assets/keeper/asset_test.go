@@ -2,18 +2,22 @@ package keeper_test
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"testing"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/nullify"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	"github.com/stretchr/testify/require"
@@ -51,6 +55,7 @@ func createNAssets(
 			hasMarket,                   // HasMarket
 			marketId,                    // MarketId
 			int32(i),                    // AtomicResolution
+			0,                           // CollateralWeightPpm
 		)
 		if err != nil {
 			return items, err
@@ -63,7 +68,7 @@ func createNAssets(
 }
 
 func TestCreateAsset_MarketNotFound(t *testing.T) {
-	ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 
 	// Throws error when creating asset for invalid marketId.
 	_, err := keeper.CreateAsset(
@@ -75,6 +80,7 @@ func TestCreateAsset_MarketNotFound(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		0,
 	)
 	require.EqualError(t, err, errorsmod.Wrap(pricestypes.ErrMarketPriceDoesNotExist, "999").Error())
 
@@ -83,7 +89,7 @@ func TestCreateAsset_MarketNotFound(t *testing.T) {
 }
 
 func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
-	ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 
 	// Throws error when creating an asset with id 0 that's not USDC.
 	_, err := keeper.CreateAsset(
@@ -95,6 +101,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		0,
 	)
 	require.ErrorIs(t, err, types.ErrUsdcMustBeAssetZero)
 
@@ -111,6 +118,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		0,
 	)
 	require.ErrorIs(t, err, types.ErrUsdcMustBeAssetZero)
 
@@ -127,6 +135,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		0,
 	)
 	require.ErrorIs(t, err, types.ErrUnexpectedUsdcDenomExponent)
 
@@ -135,7 +144,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 }
 
 func TestCreateAsset_MarketIdInvalid(t *testing.T) {
-	ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 
 	// Throws error when creating asset for invalid marketId.
 	_, err := keeper.CreateAsset(
@@ -147,6 +156,7 @@ func TestCreateAsset_MarketIdInvalid(t *testing.T) {
 		false,
 		uint32(1),
 		int32(-1),
+		0,
 	)
 	require.EqualError(t, err, errorsmod.Wrap(types.ErrInvalidMarketId, "Market ID: 1").Error())
 
@@ -155,7 +165,7 @@ func TestCreateAsset_MarketIdInvalid(t *testing.T) {
 }
 
 func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 
 	keepertest.CreateNMarkets(t, ctx, pricesKeeper, 1)
 
@@ -168,6 +178,7 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,       // hasMarket
 		0,           // marketId
 		10,          // atomicResolution
+		0,           // collateralWeightPpm
 	)
 	require.NoError(t, err)
 
@@ -181,6 +192,7 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,       // hasMarket
 		0,           // marketId
 		10,          // atomicResolution
+		0,           // collateralWeightPpm
 	)
 	require.EqualError(t, err, errorsmod.Wrap(types.ErrAssetDenomAlreadyExists, "btc-denom").Error())
 
@@ -194,12 +206,13 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,            // hasMarket
 		0,                // marketId
 		10,               // atomicResolution
+		0,                // collateralWeightPpm
 	)
 	require.ErrorIs(t, err, types.ErrAssetIdAlreadyExists)
 }
 
 func TestModifyAsset_Success(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	items, err := createNAssets(t, ctx, keeper, pricesKeeper, 10)
 	require.NoError(t, err)
 
@@ -242,7 +255,7 @@ func TestModifyAsset_Success(t *testing.T) {
 }
 
 func TestModifyAsset_NotFound(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 
 	// Expect error when modifying non-existent asset
 	_, err := keeper.ModifyAsset(
@@ -269,7 +282,7 @@ func TestModifyAsset_NotFound(t *testing.T) {
 }
 
 func TestModifyAsset_MarketNotFound(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 1)
 	require.NoError(t, err)
 
@@ -282,8 +295,192 @@ func TestModifyAsset_MarketNotFound(t *testing.T) {
 	require.EqualError(t, err, errorsmod.Wrap(pricestypes.ErrMarketPriceDoesNotExist, "999").Error())
 }
 
+func TestSetCollateralWeight(t *testing.T) {
+	tests := map[string]struct {
+		assetId             uint32
+		collateralWeightPpm uint32
+		expectedErr         error
+	}{
+		"Success": {
+			assetId:             firstValidAssetId,
+			collateralWeightPpm: 800_000,
+		},
+		"Success: full weight": {
+			assetId:             firstValidAssetId,
+			collateralWeightPpm: 1_000_000,
+		},
+		"Success: zero weight": {
+			assetId:             firstValidAssetId,
+			collateralWeightPpm: 0,
+		},
+		"Failure: asset does not exist": {
+			assetId:             uint32(999),
+			collateralWeightPpm: 800_000,
+			expectedErr:         types.ErrAssetDoesNotExist,
+		},
+		"Failure: weight exceeds one million": {
+			assetId:             firstValidAssetId,
+			collateralWeightPpm: 1_000_001,
+			expectedErr:         types.ErrInvalidCollateralWeight,
+		},
+		"Failure: asset has no market": {
+			assetId:             firstValidAssetId + 1,
+			collateralWeightPpm: 800_000,
+			expectedErr:         types.ErrCollateralAssetWithoutMarket,
+		},
+		"Failure: USDC": {
+			assetId:             types.AssetUsdc.Id,
+			collateralWeightPpm: 800_000,
+			expectedErr:         types.ErrInvalidCollateralWeight,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, keeper))
+			_, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
+			require.NoError(t, err)
+
+			asset, err := keeper.SetCollateralWeight(ctx, tc.assetId, tc.collateralWeightPpm)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.collateralWeightPpm, asset.CollateralWeightPpm)
+			stored, exists := keeper.GetAsset(ctx, tc.assetId)
+			require.True(t, exists)
+			require.Equal(t, asset, stored)
+		})
+	}
+}
+
+func TestSetMarginParams(t *testing.T) {
+	tests := map[string]struct {
+		assetId                uint32
+		initialMarginPpm       uint32
+		maintenanceFractionPpm uint32
+		borrowRatePpm          uint32
+		expectedErr            error
+	}{
+		"Success": {
+			assetId:                firstValidAssetId,
+			initialMarginPpm:       200_000,
+			maintenanceFractionPpm: 500_000,
+			borrowRatePpm:          50_000,
+		},
+		"Success: disable borrowing": {
+			assetId: firstValidAssetId,
+		},
+		"Failure: asset does not exist": {
+			assetId:          uint32(999),
+			initialMarginPpm: 200_000,
+			expectedErr:      types.ErrAssetDoesNotExist,
+		},
+		"Failure: initial margin exceeds one million": {
+			assetId:          firstValidAssetId,
+			initialMarginPpm: 1_000_001,
+			expectedErr:      types.ErrInvalidMarginParams,
+		},
+		"Failure: maintenance fraction exceeds one million": {
+			assetId:                firstValidAssetId,
+			initialMarginPpm:       200_000,
+			maintenanceFractionPpm: 1_000_001,
+			expectedErr:            types.ErrInvalidMarginParams,
+		},
+		"Failure: asset has no market": {
+			assetId:          firstValidAssetId + 1,
+			initialMarginPpm: 200_000,
+			expectedErr:      types.ErrBorrowableAssetWithoutMarket,
+		},
+		"Failure: USDC": {
+			assetId:          types.AssetUsdc.Id,
+			initialMarginPpm: 200_000,
+			expectedErr:      types.ErrInvalidMarginParams,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, keeper))
+			_, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
+			require.NoError(t, err)
+
+			asset, err := keeper.SetMarginParams(
+				ctx,
+				tc.assetId,
+				tc.initialMarginPpm,
+				tc.maintenanceFractionPpm,
+				tc.borrowRatePpm,
+			)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.initialMarginPpm, asset.InitialMarginPpm)
+			require.Equal(t, tc.maintenanceFractionPpm, asset.MaintenanceFractionPpm)
+			require.Equal(t, tc.borrowRatePpm, asset.BorrowRatePpm)
+			stored, exists := keeper.GetAsset(ctx, tc.assetId)
+			require.True(t, exists)
+			require.Equal(t, asset, stored)
+		})
+	}
+}
+
+func TestSetMarginParams_OpenBorrows(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, keeper))
+	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 1)
+	require.NoError(t, err)
+	_, err = keeper.SetMarginParams(ctx, firstValidAssetId, 200_000, 500_000, 50_000)
+	require.NoError(t, err)
+
+	keeper.ModifyTotalBorrowed(ctx, firstValidAssetId, big.NewInt(100))
+
+	// Margin requirements cannot be changed, and borrowing cannot be disabled, while borrows are open.
+	_, err = keeper.SetMarginParams(ctx, firstValidAssetId, 300_000, 500_000, 50_000)
+	require.ErrorIs(t, err, types.ErrAssetHasOpenBorrows)
+	_, err = keeper.SetMarginParams(ctx, firstValidAssetId, 200_000, 600_000, 50_000)
+	require.ErrorIs(t, err, types.ErrAssetHasOpenBorrows)
+	_, err = keeper.SetMarginParams(ctx, firstValidAssetId, 0, 0, 0)
+	require.ErrorIs(t, err, types.ErrAssetHasOpenBorrows)
+
+	// The borrow rate can still be changed.
+	asset, err := keeper.SetMarginParams(ctx, firstValidAssetId, 200_000, 500_000, 80_000)
+	require.NoError(t, err)
+	require.Equal(t, uint32(80_000), asset.BorrowRatePpm)
+
+	// Once all borrows are repaid, borrowing can be disabled.
+	keeper.ModifyTotalBorrowed(ctx, firstValidAssetId, big.NewInt(-100))
+	asset, err = keeper.SetMarginParams(ctx, firstValidAssetId, 0, 0, 0)
+	require.NoError(t, err)
+	require.False(t, asset.IsBorrowable())
+}
+
+func TestModifyTotalBorrowed(t *testing.T) {
+	ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	require.Equal(t, big.NewInt(0), keeper.GetTotalBorrowed(ctx, firstValidAssetId))
+
+	keeper.ModifyTotalBorrowed(ctx, firstValidAssetId, big.NewInt(100))
+	keeper.ModifyTotalBorrowed(ctx, firstValidAssetId, big.NewInt(50))
+	require.Equal(t, big.NewInt(150), keeper.GetTotalBorrowed(ctx, firstValidAssetId))
+	require.Equal(t, big.NewInt(0), keeper.GetTotalBorrowed(ctx, firstValidAssetId+1))
+
+	keeper.ModifyTotalBorrowed(ctx, firstValidAssetId, big.NewInt(-150))
+	require.Equal(t, big.NewInt(0), keeper.GetTotalBorrowed(ctx, firstValidAssetId))
+
+	require.Panics(t, func() {
+		keeper.ModifyTotalBorrowed(ctx, firstValidAssetId, big.NewInt(-1))
+	})
+}
+
 func TestGetAsset_Success(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	items, err := createNAssets(t, ctx, keeper, pricesKeeper, 10)
 	require.NoError(t, err)
 
@@ -300,7 +497,7 @@ func TestGetAsset_Success(t *testing.T) {
 }
 
 func TestGetAsset_NotFound(t *testing.T) {
-	ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	_, exists := keeper.GetAsset(ctx,
 		uint32(0),
 	)
@@ -308,7 +505,7 @@ func TestGetAsset_NotFound(t *testing.T) {
 }
 
 func TestGetAllAssets_Success(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	items, err := createNAssets(t, ctx, keeper, pricesKeeper, 10)
 	require.NoError(t, err)
 
@@ -318,8 +515,30 @@ func TestGetAllAssets_Success(t *testing.T) {
 	)
 }
 
+func TestGetAssetAndMarketPrice(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	items, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
+	require.NoError(t, err)
+
+	// Asset with a market.
+	asset, marketPrice, err := keeper.GetAssetAndMarketPrice(ctx, items[0].Id)
+	require.NoError(t, err)
+	require.Equal(t, items[0], asset)
+	expectedMarketPrice, err := pricesKeeper.GetMarketPrice(ctx, items[0].MarketId)
+	require.NoError(t, err)
+	require.Equal(t, expectedMarketPrice, marketPrice)
+
+	// Asset without a market.
+	_, _, err = keeper.GetAssetAndMarketPrice(ctx, items[1].Id)
+	require.ErrorIs(t, err, types.ErrAssetWithoutMarket)
+
+	// Asset does not exist.
+	_, _, err = keeper.GetAssetAndMarketPrice(ctx, uint32(100))
+	require.ErrorIs(t, err, types.ErrAssetDoesNotExist)
+}
+
 func TestGetNetCollateral(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
 	require.NoError(t, err)
 
@@ -331,23 +550,49 @@ func TestGetNetCollateral(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, new(big.Int).SetInt64(100), netCollateral)
 
-	_, err = keeper.GetNetCollateral(
+	// Assets with zero collateral weight do not contribute to net collateral.
+	netCollateral, err = keeper.GetNetCollateral(
 		ctx,
 		uint32(1),
 		new(big.Int).SetInt64(100),
 	)
-	require.EqualError(t, types.ErrNotImplementedMulticollateral, err.Error())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).SetInt64(0), netCollateral)
 
+	// Asset 1 has a market price of 1000 and an atomic resolution of 0, so 100 base quantums
+	// are worth 100 * 1000 * 10^6 quote quantums, of which 50% count toward net collateral.
+	_, err = keeper.SetCollateralWeight(ctx, uint32(1), 500_000)
+	require.NoError(t, err)
+	netCollateral, err = keeper.GetNetCollateral(
+		ctx,
+		uint32(1),
+		new(big.Int).SetInt64(100),
+	)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).SetInt64(50_000_000_000), netCollateral)
+
+	// Assets which cannot be borrowed do not allow negative balances.
 	_, err = keeper.GetNetCollateral(
 		ctx,
 		uint32(1),
 		new(big.Int).SetInt64(-100),
 	)
-	require.EqualError(t, types.ErrNotImplementedMargin, err.Error())
+	require.ErrorIs(t, err, types.ErrAssetNotBorrowable)
+
+	// A borrowed balance counts against net collateral at its full oracle value.
+	_, err = keeper.SetMarginParams(ctx, uint32(1), 200_000, 500_000, 0)
+	require.NoError(t, err)
+	netCollateral, err = keeper.GetNetCollateral(
+		ctx,
+		uint32(1),
+		new(big.Int).SetInt64(-100),
+	)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).SetInt64(-100_000_000_000), netCollateral)
 }
 
 func TestGetMarginRequirements(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
 	require.NoError(t, err)
 
@@ -369,12 +614,198 @@ func TestGetMarginRequirements(t *testing.T) {
 	require.Equal(t, new(big.Int), initial)
 	require.Equal(t, new(big.Int), maintenance)
 
+	// Assets which cannot be borrowed do not allow negative balances.
 	_, _, err = keeper.GetMarginRequirements(
 		ctx,
 		uint32(1),
 		new(big.Int).SetInt64(-100),
 	)
-	require.EqualError(t, types.ErrNotImplementedMargin, err.Error())
+	require.ErrorIs(t, err, types.ErrAssetNotBorrowable)
+
+	// 100 base quantums of asset 1 are worth 100 * 1000 * 10^6 quote quantums. The initial
+	// margin is 20% of that, and the maintenance margin is 50% of the initial margin.
+	_, err = keeper.SetMarginParams(ctx, uint32(1), 200_000, 500_000, 0)
+	require.NoError(t, err)
+	initial, maintenance, err = keeper.GetMarginRequirements(
+		ctx,
+		uint32(1),
+		new(big.Int).SetInt64(-100),
+	)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).SetInt64(20_000_000_000), initial)
+	require.Equal(t, new(big.Int).SetInt64(10_000_000_000), maintenance)
+
+	// Margin requirements are rounded up.
+	_, err = keeper.SetMarginParams(ctx, uint32(1), 1, 333_333, 0)
+	require.NoError(t, err)
+	initial, maintenance, err = keeper.GetMarginRequirements(
+		ctx,
+		uint32(1),
+		new(big.Int).SetInt64(-1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).SetInt64(1_000), initial)
+	require.Equal(t, new(big.Int).SetInt64(334), maintenance)
+}
+
+func TestMaybeAccrueBorrowInterest(t *testing.T) {
+	tests := map[string]struct {
+		blockHeight         int64
+		borrowRatePpm       uint32
+		initialMarginPpm    uint32
+		expectedBorrowIndex uint64
+	}{
+		"Accrues interest at the start of a funding-tick epoch": {
+			blockHeight:      23,
+			borrowRatePpm:    100_000,
+			initialMarginPpm: 200_000,
+			// 1000 * (10% * 3600 / 31_536_000) * 10^6, rounded down.
+			expectedBorrowIndex: 11_415_525_114,
+		},
+		"Does not accrue interest in the middle of a funding-tick epoch": {
+			blockHeight:         24,
+			borrowRatePpm:       100_000,
+			initialMarginPpm:    200_000,
+			expectedBorrowIndex: 0,
+		},
+		"Does not accrue interest for assets which cannot be borrowed": {
+			blockHeight:         23,
+			borrowRatePpm:       100_000,
+			initialMarginPpm:    0,
+			expectedBorrowIndex: 0,
+		},
+		"Does not accrue interest with a zero borrow rate": {
+			blockHeight:         23,
+			borrowRatePpm:       0,
+			initialMarginPpm:    200_000,
+			expectedBorrowIndex: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, _, _, _, epochsKeeper := keepertest.AssetsKeepers(t, true)
+			_, err := createNAssets(t, ctx, keeper, pricesKeeper, 1)
+			require.NoError(t, err)
+			_, err = keeper.SetMarginParams(ctx, firstValidAssetId, tc.initialMarginPpm, 500_000, tc.borrowRatePpm)
+			require.NoError(t, err)
+
+			err = epochsKeeper.CreateEpochInfo(
+				ctx,
+				epochstypes.EpochInfo{
+					Name:                   string(epochstypes.FundingTickEpochInfoName),
+					Duration:               3600,
+					CurrentEpochStartBlock: 23,
+					CurrentEpoch:           1,
+				},
+			)
+			require.NoError(t, err)
+
+			keeper.MaybeAccrueBorrowInterest(ctx.WithBlockHeight(tc.blockHeight))
+
+			asset, exists := keeper.GetAsset(ctx, firstValidAssetId)
+			require.True(t, exists)
+			require.Equal(t, tc.expectedBorrowIndex, asset.BorrowIndex)
+
+			// Interest accrues on top of the existing borrow index.
+			keeper.MaybeAccrueBorrowInterest(ctx.WithBlockHeight(tc.blockHeight))
+			asset, _ = keeper.GetAsset(ctx, firstValidAssetId)
+			require.Equal(t, 2*tc.expectedBorrowIndex, asset.BorrowIndex)
+		})
+	}
+}
+
+func TestMaybeAccrueBorrowInterest_SkipsAssetWithoutMarketPrice(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, storeKey, epochsKeeper := keepertest.AssetsKeepers(t, true)
+	// Assets with ids 1 and 3 have markets.
+	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 3)
+	require.NoError(t, err)
+	for _, assetId := range []uint32{1, 3} {
+		_, err = keeper.SetMarginParams(ctx, assetId, 200_000, 500_000, 100_000)
+		require.NoError(t, err)
+	}
+
+	// Point the first asset at a market which does not exist.
+	asset, exists := keeper.GetAsset(ctx, 1)
+	require.True(t, exists)
+	asset.MarketId = 999
+	b, err := asset.Marshal()
+	require.NoError(t, err)
+	prefix.NewStore(ctx.KVStore(storeKey), []byte(types.AssetKeyPrefix)).Set(lib.Uint32ToKey(asset.Id), b)
+
+	err = epochsKeeper.CreateEpochInfo(
+		ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			CurrentEpochStartBlock: 23,
+			CurrentEpoch:           1,
+		},
+	)
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() {
+		keeper.MaybeAccrueBorrowInterest(ctx.WithBlockHeight(23))
+	})
+
+	// The asset without a market price is skipped, and other assets still accrue interest.
+	asset, _ = keeper.GetAsset(ctx, 1)
+	require.Zero(t, asset.BorrowIndex)
+	asset, _ = keeper.GetAsset(ctx, 3)
+	require.NotZero(t, asset.BorrowIndex)
+}
+
+func TestModifyBorrowIndex(t *testing.T) {
+	tests := map[string]struct {
+		assetId             uint32
+		borrowIndexDeltas   []*big.Int
+		expectedBorrowIndex uint64
+		expectedErr         error
+	}{
+		"Success": {
+			assetId:             firstValidAssetId,
+			borrowIndexDeltas:   []*big.Int{big.NewInt(100), big.NewInt(50)},
+			expectedBorrowIndex: 150,
+		},
+		"Failure: asset does not exist": {
+			assetId:           uint32(999),
+			borrowIndexDeltas: []*big.Int{big.NewInt(100)},
+			expectedErr:       types.ErrAssetDoesNotExist,
+		},
+		"Failure: borrow index underflow": {
+			assetId:           firstValidAssetId,
+			borrowIndexDeltas: []*big.Int{big.NewInt(-1)},
+		},
+		"Failure: borrow index overflow": {
+			assetId:           firstValidAssetId,
+			borrowIndexDeltas: []*big.Int{new(big.Int).SetUint64(math.MaxUint64), big.NewInt(1)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+			_, err := createNAssets(t, ctx, keeper, pricesKeeper, 1)
+			require.NoError(t, err)
+
+			for _, delta := range tc.borrowIndexDeltas {
+				err = keeper.ModifyBorrowIndex(ctx, tc.assetId, delta)
+			}
+
+			if tc.expectedBorrowIndex == 0 {
+				require.Error(t, err)
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			asset, exists := keeper.GetAsset(ctx, tc.assetId)
+			require.True(t, exists)
+			require.Equal(t, tc.expectedBorrowIndex, asset.BorrowIndex)
+		})
+	}
 }
 
 func TestConvertAssetToCoin_Success(t *testing.T) {
@@ -455,7 +886,7 @@ func TestConvertAssetToCoin_Success(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+			ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 
 			// Create test asset with the given DenomExponent and AtomicResolution values
 			asset, err := keeper.CreateAsset(
@@ -467,6 +898,7 @@ func TestConvertAssetToCoin_Success(t *testing.T) {
 				false,
 				0,
 				tc.atomicResolution,
+				0,
 			)
 			require.NoError(t, err)
 
@@ -497,7 +929,7 @@ func TestConvertAssetToCoin_Success(t *testing.T) {
 }
 
 func TestConvertAssetToCoin_Failure(t *testing.T) {
-	ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 
 	// Test convert asset with invalid asset ID.
 	_, _, err := keeper.ConvertAssetToCoin(
@@ -522,6 +954,7 @@ func TestConvertAssetToCoin_Failure(t *testing.T) {
 		false,
 		0,
 		-6,
+		0,
 	)
 	require.NoError(t, err)
 
@@ -542,6 +975,7 @@ func TestConvertAssetToCoin_Failure(t *testing.T) {
 		false,
 		0,
 		-50, /* invalid asset atomic resolution */
+		0,
 	)
 	require.NoError(t, err)
 	_, _, err = keeper.ConvertAssetToCoin(ctx, 2, big.NewInt(100))
@@ -553,7 +987,7 @@ func TestConvertAssetToCoin_Failure(t *testing.T) {
 }
 
 func TestIsPositionUpdatable(t *testing.T) {
-	ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	require.NoError(t, keepertest.CreateUsdcAsset(ctx, keeper))
 
 	// Check Usdc asset is updatable.
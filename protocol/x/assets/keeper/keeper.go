@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 
 	"github.com/cometbft/cometbft/libs/log"
 
@@ -19,7 +20,9 @@ type (
 		cdc                 codec.BinaryCodec
 		storeKey            storetypes.StoreKey
 		pricesKeeper        types.PricesKeeper
+		epochsKeeper        types.EpochsKeeper
 		indexerEventManager indexer_manager.IndexerEventManager
+		authorities         map[string]struct{}
 	}
 )
 
@@ -27,13 +30,17 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	pricesKeeper types.PricesKeeper,
+	epochsKeeper types.EpochsKeeper,
 	indexerEventManager indexer_manager.IndexerEventManager,
+	authorities []string,
 ) *Keeper {
 	return &Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
 		pricesKeeper:        pricesKeeper,
+		epochsKeeper:        epochsKeeper,
 		indexerEventManager: indexerEventManager,
+		authorities:         lib.UniqueSliceToSet(authorities),
 	}
 }
 
@@ -41,6 +48,11 @@ func (k Keeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
 	return k.indexerEventManager
 }
 
+func (k Keeper) HasAuthority(authority string) bool {
+	_, ok := k.authorities[authority]
+	return ok
+}
+
 func (k Keeper) InitializeForGenesis(ctx sdk.Context) {
 }
 
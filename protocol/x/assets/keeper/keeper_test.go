@@ -8,7 +8,7 @@ import (
 )
 
 func TestLogger(t *testing.T) {
-	ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	ctx, keeper, _, _, _, _, _ := keepertest.AssetsKeepers(t, true)
 	logger := keeper.Logger(ctx)
 	require.NotNil(t, logger)
 }
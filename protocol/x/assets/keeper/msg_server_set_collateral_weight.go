@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+)
+
+func (k msgServer) SetCollateralWeight(
+	goCtx context.Context,
+	msg *types.MsgSetCollateralWeight,
+) (*types.MsgSetCollateralWeightResponse, error) {
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.SetCollateralWeight(
+		ctx,
+		msg.AssetId,
+		msg.CollateralWeightPpm,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCollateralWeightResponse{}, nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCollateralWeight_MsgServer(t *testing.T) {
+	tests := map[string]struct {
+		msg         *types.MsgSetCollateralWeight
+		expectedErr string
+	}{
+		"Success": {
+			msg: &types.MsgSetCollateralWeight{
+				Authority:           lib.GovModuleAddress.String(),
+				AssetId:             firstValidAssetId,
+				CollateralWeightPpm: 750_000,
+			},
+		},
+		"Failure: asset has no market": {
+			msg: &types.MsgSetCollateralWeight{
+				Authority:           lib.GovModuleAddress.String(),
+				AssetId:             firstValidAssetId + 1,
+				CollateralWeightPpm: 750_000,
+			},
+			expectedErr: "Collateral asset must have a market",
+		},
+		"Failure: asset does not exist": {
+			msg: &types.MsgSetCollateralWeight{
+				Authority:           lib.GovModuleAddress.String(),
+				AssetId:             uint32(999),
+				CollateralWeightPpm: 750_000,
+			},
+			expectedErr: "Asset does not exist",
+		},
+		"Failure: invalid authority": {
+			msg: &types.MsgSetCollateralWeight{
+				Authority:           constants.BobAccAddress.String(),
+				AssetId:             firstValidAssetId,
+				CollateralWeightPpm: 750_000,
+			},
+			expectedErr: "invalid authority",
+		},
+		"Failure: empty authority": {
+			msg: &types.MsgSetCollateralWeight{
+				Authority:           "",
+				AssetId:             firstValidAssetId,
+				CollateralWeightPpm: 750_000,
+			},
+			expectedErr: "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, assetsKeeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+			_, err := createNAssets(t, ctx, assetsKeeper, pricesKeeper, 2)
+			require.NoError(t, err)
+
+			msgServer := keeper.NewMsgServerImpl(*assetsKeeper)
+			wrappedCtx := sdk.WrapSDKContext(ctx)
+
+			_, err = msgServer.SetCollateralWeight(wrappedCtx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				// Verify that the collateral weight is unchanged.
+				asset, exists := assetsKeeper.GetAsset(ctx, tc.msg.AssetId)
+				if exists {
+					require.Zero(t, asset.CollateralWeightPpm)
+				}
+			} else {
+				require.NoError(t, err)
+
+				// Verify that the collateral weight is updated.
+				asset, exists := assetsKeeper.GetAsset(ctx, tc.msg.AssetId)
+				require.True(t, exists)
+				require.Equal(t, tc.msg.CollateralWeightPpm, asset.CollateralWeightPpm)
+			}
+		})
+	}
+}
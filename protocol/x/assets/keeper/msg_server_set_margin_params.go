@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+)
+
+func (k msgServer) SetMarginParams(
+	goCtx context.Context,
+	msg *types.MsgSetMarginParams,
+) (*types.MsgSetMarginParamsResponse, error) {
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.SetMarginParams(
+		ctx,
+		msg.AssetId,
+		msg.InitialMarginPpm,
+		msg.MaintenanceFractionPpm,
+		msg.BorrowRatePpm,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMarginParamsResponse{}, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMarginParams_MsgServer(t *testing.T) {
+	tests := map[string]struct {
+		msg         *types.MsgSetMarginParams
+		expectedErr string
+	}{
+		"Success": {
+			msg: &types.MsgSetMarginParams{
+				Authority:              lib.GovModuleAddress.String(),
+				AssetId:                firstValidAssetId,
+				InitialMarginPpm:       200_000,
+				MaintenanceFractionPpm: 500_000,
+				BorrowRatePpm:          50_000,
+			},
+		},
+		"Failure: asset has no market": {
+			msg: &types.MsgSetMarginParams{
+				Authority:        lib.GovModuleAddress.String(),
+				AssetId:          firstValidAssetId + 1,
+				InitialMarginPpm: 200_000,
+			},
+			expectedErr: "Borrowable asset must have a market",
+		},
+		"Failure: asset does not exist": {
+			msg: &types.MsgSetMarginParams{
+				Authority:        lib.GovModuleAddress.String(),
+				AssetId:          uint32(999),
+				InitialMarginPpm: 200_000,
+			},
+			expectedErr: "Asset does not exist",
+		},
+		"Failure: invalid authority": {
+			msg: &types.MsgSetMarginParams{
+				Authority:        constants.BobAccAddress.String(),
+				AssetId:          firstValidAssetId,
+				InitialMarginPpm: 200_000,
+			},
+			expectedErr: "invalid authority",
+		},
+		"Failure: empty authority": {
+			msg: &types.MsgSetMarginParams{
+				Authority:        "",
+				AssetId:          firstValidAssetId,
+				InitialMarginPpm: 200_000,
+			},
+			expectedErr: "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, assetsKeeper, pricesKeeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+			_, err := createNAssets(t, ctx, assetsKeeper, pricesKeeper, 2)
+			require.NoError(t, err)
+
+			msgServer := keeper.NewMsgServerImpl(*assetsKeeper)
+			wrappedCtx := sdk.WrapSDKContext(ctx)
+
+			_, err = msgServer.SetMarginParams(wrappedCtx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				// Verify that the margin params are unchanged.
+				asset, exists := assetsKeeper.GetAsset(ctx, tc.msg.AssetId)
+				if exists {
+					require.Zero(t, asset.InitialMarginPpm)
+				}
+			} else {
+				require.NoError(t, err)
+
+				// Verify that the margin params are updated.
+				asset, exists := assetsKeeper.GetAsset(ctx, tc.msg.AssetId)
+				require.True(t, exists)
+				require.Equal(t, tc.msg.InitialMarginPpm, asset.InitialMarginPpm)
+				require.Equal(t, tc.msg.MaintenanceFractionPpm, asset.MaintenanceFractionPpm)
+				require.Equal(t, tc.msg.BorrowRatePpm, asset.BorrowRatePpm)
+			}
+		})
+	}
+}
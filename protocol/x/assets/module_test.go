@@ -13,10 +13,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets"
 	assets_keeper "github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
+	epochs_keeper "github.com/dydxprotocol/v4-chain/protocol/x/epochs/keeper"
+	epochs_types "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/stretchr/testify/mock"
@@ -32,15 +35,23 @@ func createAppModule(t *testing.T) assets.AppModule {
 // This is useful for tests which want to write/read state
 // to/from the keeper.
 func createAppModuleWithKeeper(t *testing.T) (assets.AppModule, *assets_keeper.Keeper, sdk.Context) {
+	am, keeper, _, ctx := createAppModuleWithKeeperAndEpochs(t)
+	return am, keeper, ctx
+}
+
+// Returns the keeper, epochs keeper and context along with the AppModule.
+func createAppModuleWithKeeperAndEpochs(
+	t *testing.T,
+) (assets.AppModule, *assets_keeper.Keeper, *epochs_keeper.Keeper, sdk.Context) {
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
 	appCodec := codec.NewProtoCodec(interfaceRegistry)
 
-	ctx, keeper, _, _, _, _ := keeper.AssetsKeepers(t, true)
+	ctx, keeper, _, _, _, _, epochsKeeper := keeper.AssetsKeepers(t, true)
 
 	return assets.NewAppModule(
 		appCodec,
 		*keeper,
-	), keeper, ctx
+	), keeper, epochsKeeper, ctx
 }
 
 func createAppModuleBasic(t *testing.T) assets.AppModuleBasic {
@@ -68,7 +79,7 @@ func TestAppModuleBasic_RegisterCodec(t *testing.T) {
 	var buf bytes.Buffer
 	err := cdc.Amino.PrintTypes(&buf)
 	require.NoError(t, err)
-	require.NotContains(t, buf.String(), "Msg") // assets does not support any messages.
+	require.NotContains(t, buf.String(), "Msg") // assets does not register any legacy amino messages.
 }
 
 func TestAppModuleBasic_RegisterCodecLegacyAmino(t *testing.T) {
@@ -80,15 +91,17 @@ func TestAppModuleBasic_RegisterCodecLegacyAmino(t *testing.T) {
 	var buf bytes.Buffer
 	err := cdc.Amino.PrintTypes(&buf)
 	require.NoError(t, err)
-	require.NotContains(t, buf.String(), "Msg") // assets does not support any messages.
+	require.NotContains(t, buf.String(), "Msg") // assets does not register any legacy amino messages.
 }
 
 func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	am := createAppModuleBasic(t)
 
 	mockRegistry := new(mocks.InterfaceRegistry)
+	mockRegistry.On("RegisterImplementations", (*sdk.Msg)(nil), mock.Anything).Return()
+	mockRegistry.On("RegisterImplementations", (*tx.MsgResponse)(nil), mock.Anything).Return()
 	am.RegisterInterfaces(mockRegistry)
-	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 0)
+	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 4)
 	mockRegistry.AssertExpectations(t)
 }
 
@@ -105,7 +118,8 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	expected := `{"assets":[{"id":0,"symbol":"USDC","denom":`
 	expected += `"ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5",`
 	expected += `"denom_exponent":-6,"has_market":false,`
-	expected += `"market_id":0,"atomic_resolution":-6}]}`
+	expected += `"market_id":0,"atomic_resolution":-6,"collateral_weight_ppm":0,"initial_margin_ppm":0,`
+	expected += `"maintenance_fraction_ppm":0,"borrow_rate_ppm":0,"borrow_index":"0"}]}`
 	require.Equal(t, expected, string(json))
 }
 
@@ -249,7 +263,8 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected := `{"assets":[{"id":0,"symbol":"USDC","denom":`
 	expected += `"ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5",`
 	expected += `"denom_exponent":-6,"has_market":false,`
-	expected += `"market_id":0,"atomic_resolution":-6}]}`
+	expected += `"market_id":0,"atomic_resolution":-6,"collateral_weight_ppm":0,"initial_margin_ppm":0,`
+	expected += `"maintenance_fraction_ppm":0,"borrow_rate_ppm":0,"borrow_index":"0"}]}`
 	require.Equal(t, expected, string(genesisJson))
 }
 
@@ -276,9 +291,14 @@ func TestAppModule_BeginBlock(t *testing.T) {
 }
 
 func TestAppModule_EndBlock(t *testing.T) {
-	am := createAppModule(t)
+	am, _, epochsKeeper, ctx := createAppModuleWithKeeperAndEpochs(t)
+
+	for _, epochInfo := range epochs_types.DefaultGenesis().EpochInfoList {
+		if err := epochsKeeper.CreateEpochInfo(ctx, epochInfo); err != nil {
+			t.Errorf("failed to create an epoch %s", err)
+		}
+	}
 
-	var ctx sdk.Context
 	var req abci.RequestEndBlock
 	result := am.EndBlock(ctx, req)
 	require.Equal(t, 0, len(result))
@@ -0,0 +1,96 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// IsCollateral returns true if a positive balance of the asset counts toward the
+// net collateral of a subaccount.
+func (a *Asset) IsCollateral() bool {
+	return a.Id == AssetUsdc.Id || a.CollateralWeightPpm > 0
+}
+
+// ValidateCollateralWeight validates the collateral weight of the asset.
+// The collateral weight must not exceed one million ppm, and a non-zero collateral
+// weight is only allowed for non-USDC assets that have a market to price them.
+func (a *Asset) ValidateCollateralWeight() error {
+	if a.CollateralWeightPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidCollateralWeight,
+			"collateral weight %d ppm exceeds %d ppm",
+			a.CollateralWeightPpm,
+			lib.OneMillion,
+		)
+	}
+
+	if a.CollateralWeightPpm == 0 {
+		return nil
+	}
+
+	if a.Id == AssetUsdc.Id {
+		return errorsmod.Wrap(
+			ErrInvalidCollateralWeight,
+			"USDC collateral weight must be zero",
+		)
+	}
+
+	if !a.HasMarket {
+		return errorsmod.Wrapf(
+			ErrCollateralAssetWithoutMarket,
+			"asset id = %d",
+			a.Id,
+		)
+	}
+
+	return nil
+}
+
+// IsBorrowable returns true if a negative balance of the asset is allowed, i.e. the
+// asset has a non-zero initial margin requirement.
+func (a *Asset) IsBorrowable() bool {
+	return a.InitialMarginPpm > 0
+}
+
+// ValidateMarginParams validates the borrowing parameters of the asset.
+// The initial margin and maintenance fraction must not exceed one million ppm. USDC
+// cannot be borrowed, and borrowing any other asset requires a market to price it.
+func (a *Asset) ValidateMarginParams() error {
+	if a.InitialMarginPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidMarginParams,
+			"initial margin %d ppm exceeds %d ppm",
+			a.InitialMarginPpm,
+			lib.OneMillion,
+		)
+	}
+
+	if a.MaintenanceFractionPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidMarginParams,
+			"maintenance fraction %d ppm exceeds %d ppm",
+			a.MaintenanceFractionPpm,
+			lib.OneMillion,
+		)
+	}
+
+	if a.Id == AssetUsdc.Id {
+		if a.InitialMarginPpm != 0 || a.MaintenanceFractionPpm != 0 || a.BorrowRatePpm != 0 || a.BorrowIndex != 0 {
+			return errorsmod.Wrap(
+				ErrInvalidMarginParams,
+				"USDC margin params must be zero",
+			)
+		}
+		return nil
+	}
+
+	if a.IsBorrowable() && !a.HasMarket {
+		return errorsmod.Wrapf(
+			ErrBorrowableAssetWithoutMarket,
+			"asset id = %d",
+			a.Id,
+		)
+	}
+
+	return nil
+}
@@ -53,6 +53,26 @@ type Asset struct {
 	// then an `asset_position` with `base_quantums = 1e8` is equivalent to
 	// a position size of one full coin.
 	AtomicResolution int32 `protobuf:"zigzag32,7,opt,name=atomic_resolution,json=atomicResolution,proto3" json:"atomic_resolution,omitempty"`
+	// The fraction of the oracle value of a positive balance of this `Asset`
+	// that counts toward the net collateral of a subaccount, in parts-per-million.
+	// A value of zero means the `Asset` is not accepted as collateral. Must be
+	// zero for USDC, which always counts toward net collateral at full value.
+	CollateralWeightPpm uint32 `protobuf:"varint,8,opt,name=collateral_weight_ppm,json=collateralWeightPpm,proto3" json:"collateral_weight_ppm,omitempty"`
+	// The initial margin required to borrow this `Asset`, as a fraction of the
+	// oracle value of the borrowed amount, in parts-per-million. A value of
+	// zero means the `Asset` cannot be borrowed. Must be zero for USDC.
+	InitialMarginPpm uint32 `protobuf:"varint,9,opt,name=initial_margin_ppm,json=initialMarginPpm,proto3" json:"initial_margin_ppm,omitempty"`
+	// The maintenance margin required on a borrowed balance of this `Asset`, as
+	// a fraction of the initial margin requirement, in parts-per-million.
+	MaintenanceFractionPpm uint32 `protobuf:"varint,10,opt,name=maintenance_fraction_ppm,json=maintenanceFractionPpm,proto3" json:"maintenance_fraction_ppm,omitempty"`
+	// The annualized interest rate charged on a borrowed balance of this
+	// `Asset`, in parts-per-million.
+	BorrowRatePpm uint32 `protobuf:"varint,11,opt,name=borrow_rate_ppm,json=borrowRatePpm,proto3" json:"borrow_rate_ppm,omitempty"`
+	// The cumulative borrow interest index of this `Asset`. Interest owed on a
+	// borrowed balance is the difference between this value and the `index` of
+	// the `AssetPosition`, multiplied by the size of the position in quantums
+	// and divided by one million, in quote quantums.
+	BorrowIndex uint64 `protobuf:"varint,12,opt,name=borrow_index,json=borrowIndex,proto3" json:"borrow_index,omitempty"`
 }
 
 func (m *Asset) Reset()         { *m = Asset{} }
@@ -137,6 +157,41 @@ func (m *Asset) GetAtomicResolution() int32 {
 	return 0
 }
 
+func (m *Asset) GetCollateralWeightPpm() uint32 {
+	if m != nil {
+		return m.CollateralWeightPpm
+	}
+	return 0
+}
+
+func (m *Asset) GetInitialMarginPpm() uint32 {
+	if m != nil {
+		return m.InitialMarginPpm
+	}
+	return 0
+}
+
+func (m *Asset) GetMaintenanceFractionPpm() uint32 {
+	if m != nil {
+		return m.MaintenanceFractionPpm
+	}
+	return 0
+}
+
+func (m *Asset) GetBorrowRatePpm() uint32 {
+	if m != nil {
+		return m.BorrowRatePpm
+	}
+	return 0
+}
+
+func (m *Asset) GetBorrowIndex() uint64 {
+	if m != nil {
+		return m.BorrowIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Asset)(nil), "dydxprotocol.assets.Asset")
 }
@@ -144,25 +199,33 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/assets/asset.proto", fileDescriptor_d0b73b5c910a62b5) }
 
 var fileDescriptor_d0b73b5c910a62b5 = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0x31, 0x4b, 0xc4, 0x30,
-	0x14, 0x07, 0xf0, 0xcb, 0x69, 0xeb, 0x35, 0x70, 0x87, 0x17, 0x45, 0x02, 0x62, 0x2c, 0x82, 0x50,
-	0x10, 0xdb, 0x41, 0x07, 0x57, 0x05, 0x07, 0x07, 0x97, 0x8c, 0x2e, 0x25, 0x6d, 0x82, 0x0d, 0xb6,
-	0x4d, 0x69, 0x72, 0xd2, 0x7e, 0x0b, 0x3f, 0x96, 0xe3, 0x2d, 0x82, 0xa3, 0xb4, 0x5f, 0x44, 0x4c,
-	0xce, 0x43, 0xa7, 0xbc, 0xf7, 0xfb, 0x3f, 0xc8, 0xe3, 0xc1, 0x53, 0xde, 0xf3, 0xae, 0x69, 0x95,
-	0x51, 0xb9, 0x2a, 0x13, 0xa6, 0xb5, 0x30, 0xda, 0x3d, 0xb1, 0x55, 0x74, 0xf0, 0x77, 0x20, 0x76,
-	0x03, 0x67, 0x1f, 0x00, 0x7a, 0xb7, 0x3f, 0x25, 0x5a, 0xc0, 0xa9, 0xe4, 0x18, 0x84, 0x20, 0x9a,
-	0xd3, 0xa9, 0xe4, 0xe8, 0x08, 0xfa, 0xba, 0xaf, 0x32, 0x55, 0xe2, 0x69, 0x08, 0xa2, 0x80, 0x6e,
-	0x3a, 0x74, 0x08, 0x3d, 0x2e, 0x6a, 0x55, 0xe1, 0x1d, 0xcb, 0xae, 0x41, 0xe7, 0x70, 0x61, 0x8b,
-	0x54, 0x74, 0x8d, 0xaa, 0x45, 0x6d, 0xf0, 0x6e, 0x08, 0xa2, 0x25, 0x9d, 0x5b, 0xbd, 0xdf, 0x20,
-	0x3a, 0x81, 0xb0, 0x60, 0x3a, 0xad, 0x58, 0xfb, 0x22, 0x0c, 0xf6, 0x42, 0x10, 0xcd, 0x68, 0x50,
-	0x30, 0xfd, 0x68, 0x01, 0x1d, 0xc3, 0xc0, 0x45, 0xa9, 0xe4, 0xd8, 0xb7, 0xab, 0xcc, 0x1c, 0x3c,
-	0x70, 0x74, 0x01, 0x97, 0xcc, 0xa8, 0x4a, 0xe6, 0x69, 0x2b, 0xb4, 0x2a, 0x57, 0x46, 0xaa, 0x1a,
-	0xef, 0xd9, 0x5f, 0xf6, 0x5d, 0x40, 0xb7, 0x7e, 0x47, 0xdf, 0x07, 0x02, 0xd6, 0x03, 0x01, 0x5f,
-	0x03, 0x01, 0x6f, 0x23, 0x99, 0xac, 0x47, 0x32, 0xf9, 0x1c, 0xc9, 0xe4, 0xe9, 0xe6, 0x59, 0x9a,
-	0x62, 0x95, 0xc5, 0xb9, 0xaa, 0x92, 0x7f, 0x27, 0x7b, 0xbd, 0xbe, 0xcc, 0x0b, 0x26, 0xeb, 0x64,
-	0x2b, 0xdd, 0xef, 0x19, 0x4d, 0xdf, 0x08, 0x9d, 0xf9, 0x36, 0xb8, 0xfa, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0x03, 0x1b, 0xd1, 0xd6, 0x6a, 0x01, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0x6e, 0x2d, 0xed, 0xb7, 0x75, 0x6c, 0x1e, 0x4c, 0x96, 0x10, 0x21, 0x20, 0x81,
+	0x22, 0x01, 0xad, 0x04, 0x1c, 0x76, 0x05, 0x09, 0xa4, 0x1d, 0x26, 0xa1, 0x5c, 0x90, 0xb8, 0x44,
+	0x6e, 0x6c, 0x1a, 0x8b, 0xd8, 0x8e, 0x6c, 0x8f, 0xa5, 0x6f, 0xc1, 0x03, 0xf1, 0x00, 0x1c, 0x77,
+	0xe4, 0x88, 0xda, 0x17, 0x41, 0xf9, 0x1c, 0x46, 0x39, 0xc5, 0xfe, 0xfd, 0xfe, 0xff, 0xf8, 0x3b,
+	0x7c, 0xf0, 0x48, 0xac, 0x45, 0xdb, 0x38, 0x1b, 0x6c, 0x69, 0xeb, 0x05, 0xf7, 0x5e, 0x06, 0x1f,
+	0x3f, 0x73, 0xa4, 0xf4, 0x74, 0x37, 0x30, 0x8f, 0x81, 0x27, 0x3f, 0xf6, 0x60, 0xf4, 0xb6, 0x3b,
+	0xd2, 0x23, 0x18, 0x2a, 0xc1, 0x48, 0x4a, 0xb2, 0x59, 0x3e, 0x54, 0x82, 0x9e, 0xc1, 0xd8, 0xaf,
+	0xf5, 0xd2, 0xd6, 0x6c, 0x98, 0x92, 0x6c, 0x9a, 0xf7, 0x37, 0x7a, 0x0f, 0x46, 0x42, 0x1a, 0xab,
+	0xd9, 0x1e, 0xe2, 0x78, 0xa1, 0x4f, 0xe1, 0x08, 0x0f, 0x85, 0x6c, 0x1b, 0x6b, 0xa4, 0x09, 0x6c,
+	0x3f, 0x25, 0xd9, 0x49, 0x3e, 0x43, 0xfa, 0xbe, 0x87, 0xf4, 0x21, 0x40, 0xc5, 0x7d, 0xa1, 0xb9,
+	0xfb, 0x2a, 0x03, 0x1b, 0xa5, 0x24, 0x9b, 0xe4, 0xd3, 0x8a, 0xfb, 0x4b, 0x04, 0xf4, 0x01, 0x4c,
+	0xa3, 0x2a, 0x94, 0x60, 0x63, 0x1c, 0x65, 0x12, 0xc1, 0x85, 0xa0, 0xcf, 0xe1, 0x84, 0x07, 0xab,
+	0x55, 0x59, 0x38, 0xe9, 0x6d, 0x7d, 0x15, 0x94, 0x35, 0xec, 0x0e, 0xbe, 0x72, 0x1c, 0x45, 0x7e,
+	0xcb, 0xe9, 0x2b, 0xb8, 0x5f, 0xda, 0xba, 0xe6, 0x41, 0x3a, 0x5e, 0x17, 0xd7, 0x52, 0xad, 0xaa,
+	0x50, 0x34, 0x8d, 0x66, 0x13, 0xfc, 0xeb, 0xe9, 0x3f, 0xf9, 0x09, 0xdd, 0xc7, 0x46, 0xd3, 0x17,
+	0x40, 0x95, 0x51, 0x41, 0xf1, 0xba, 0x1b, 0x70, 0xa5, 0x0c, 0x16, 0xa6, 0x58, 0x38, 0xee, 0xcd,
+	0x25, 0x8a, 0x2e, 0x7d, 0x0e, 0x4c, 0x73, 0x65, 0x82, 0x34, 0xdc, 0x94, 0xb2, 0xf8, 0xe2, 0x78,
+	0xd9, 0xbd, 0x8c, 0x1d, 0xc0, 0xce, 0xd9, 0x8e, 0xff, 0xd0, 0xeb, 0xae, 0xf9, 0x0c, 0xee, 0x2e,
+	0xad, 0x73, 0xf6, 0xba, 0x70, 0x3c, 0x48, 0x2c, 0x1c, 0x60, 0x61, 0x16, 0x71, 0xce, 0x83, 0xec,
+	0x72, 0x8f, 0xe1, 0xb0, 0xcf, 0x29, 0x23, 0x64, 0xcb, 0x0e, 0x53, 0x92, 0xed, 0xe7, 0x07, 0x91,
+	0x5d, 0x74, 0xe8, 0x5d, 0xfe, 0x73, 0x93, 0x90, 0x9b, 0x4d, 0x42, 0x7e, 0x6f, 0x12, 0xf2, 0x7d,
+	0x9b, 0x0c, 0x6e, 0xb6, 0xc9, 0xe0, 0xd7, 0x36, 0x19, 0x7c, 0x3e, 0x5f, 0xa9, 0x50, 0x5d, 0x2d,
+	0xe7, 0xa5, 0xd5, 0x8b, 0xff, 0x36, 0xe3, 0xdb, 0x9b, 0x97, 0x65, 0xc5, 0x95, 0x59, 0xdc, 0x92,
+	0xf6, 0xef, 0xb6, 0x84, 0x75, 0x23, 0xfd, 0x72, 0x8c, 0xe2, 0xf5, 0x9f, 0x01, 0x00, 0x2d, 0xb2,
+	0x72, 0x74, 0x51, 0x02, 0x00, 0x00,
 }
 
 func (m *Asset) Marshal() (dAtA []byte, err error) {
@@ -185,6 +248,31 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BorrowIndex != 0 {
+		i = encodeVarintAsset(dAtA, i, uint64(m.BorrowIndex))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BorrowRatePpm != 0 {
+		i = encodeVarintAsset(dAtA, i, uint64(m.BorrowRatePpm))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaintenanceFractionPpm != 0 {
+		i = encodeVarintAsset(dAtA, i, uint64(m.MaintenanceFractionPpm))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.InitialMarginPpm != 0 {
+		i = encodeVarintAsset(dAtA, i, uint64(m.InitialMarginPpm))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CollateralWeightPpm != 0 {
+		i = encodeVarintAsset(dAtA, i, uint64(m.CollateralWeightPpm))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.AtomicResolution != 0 {
 		i = encodeVarintAsset(dAtA, i, uint64((uint32(m.AtomicResolution)<<1)^uint32((m.AtomicResolution>>31))))
 		i--
@@ -272,6 +360,21 @@ func (m *Asset) Size() (n int) {
 	if m.AtomicResolution != 0 {
 		n += 1 + sozAsset(uint64(m.AtomicResolution))
 	}
+	if m.CollateralWeightPpm != 0 {
+		n += 1 + sovAsset(uint64(m.CollateralWeightPpm))
+	}
+	if m.InitialMarginPpm != 0 {
+		n += 1 + sovAsset(uint64(m.InitialMarginPpm))
+	}
+	if m.MaintenanceFractionPpm != 0 {
+		n += 1 + sovAsset(uint64(m.MaintenanceFractionPpm))
+	}
+	if m.BorrowRatePpm != 0 {
+		n += 1 + sovAsset(uint64(m.BorrowRatePpm))
+	}
+	if m.BorrowIndex != 0 {
+		n += 1 + sovAsset(uint64(m.BorrowIndex))
+	}
 	return n
 }
 
@@ -474,6 +577,101 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			}
 			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
 			m.AtomicResolution = v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralWeightPpm", wireType)
+			}
+			m.CollateralWeightPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CollateralWeightPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
+			}
+			m.InitialMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFractionPpm", wireType)
+			}
+			m.MaintenanceFractionPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceFractionPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowRatePpm", wireType)
+			}
+			m.BorrowRatePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorrowRatePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowIndex", wireType)
+			}
+			m.BorrowIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorrowIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsset(dAtA[iNdEx:])
@@ -4,4 +4,7 @@ const (
 	// MaxAssetSymbolLength is the maximum exponent for a asset/coin unit
 	// (e.g. DenomExponent, AtomicResolution)
 	MaxAssetUnitExponentAbs = 32
+
+	// SecondsPerYear is the number of seconds over which `BorrowRatePpm` is charged.
+	SecondsPerYear = 365 * 24 * 60 * 60
 )
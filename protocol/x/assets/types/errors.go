@@ -18,8 +18,12 @@ var (
 	ErrInvalidDenomExponent         = errorsmod.Register(ModuleName, 11, "Invalid denom exponent")
 	ErrAssetAlreadyExists           = errorsmod.Register(ModuleName, 12, "Asset already exists")
 	ErrUnexpectedUsdcDenomExponent  = errorsmod.Register(ModuleName, 13, "USDC denom exponent is unexpected")
-
-	// Errors for Not Implemented
-	ErrNotImplementedMulticollateral = errorsmod.Register(ModuleName, 401, "Not Implemented: Multi-Collateral")
-	ErrNotImplementedMargin          = errorsmod.Register(ModuleName, 402, "Not Implemented: Margin-Trading of Assets")
+	ErrInvalidCollateralWeight      = errorsmod.Register(ModuleName, 14, "Invalid collateral weight")
+	ErrCollateralAssetWithoutMarket = errorsmod.Register(ModuleName, 15, "Collateral asset must have a market")
+	ErrInvalidAuthority             = errorsmod.Register(ModuleName, 16, "Authority is invalid")
+	ErrInvalidMarginParams          = errorsmod.Register(ModuleName, 17, "Invalid margin params")
+	ErrBorrowableAssetWithoutMarket = errorsmod.Register(ModuleName, 18, "Borrowable asset must have a market")
+	ErrAssetNotBorrowable           = errorsmod.Register(ModuleName, 19, "Asset cannot be borrowed")
+	ErrAssetWithoutMarket           = errorsmod.Register(ModuleName, 20, "Asset does not have a market")
+	ErrAssetHasOpenBorrows          = errorsmod.Register(ModuleName, 21, "Asset has open borrows")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	prices "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
@@ -12,3 +13,13 @@ type PricesKeeper interface {
 	) (market prices.MarketPrice, err error)
 	// Methods imported from prices should be defined here
 }
+
+type EpochsKeeper interface {
+	NumBlocksSinceEpochStart(
+		ctx sdk.Context,
+		id epochstypes.EpochInfoName,
+	) (uint32, error)
+	MustGetFundingTickEpochInfo(
+		ctx sdk.Context,
+	) epochstypes.EpochInfo
+}
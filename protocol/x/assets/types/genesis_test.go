@@ -28,12 +28,17 @@ func TestGenesisState_Validate(t *testing.T) {
 						AtomicResolution: lib.QuoteCurrencyAtomicResolution,
 					},
 					{
-						Id:               1,
-						Symbol:           "BTC",
-						Denom:            "btc-denom",
-						HasMarket:        true,
-						MarketId:         0,
-						AtomicResolution: int32(-6),
+						Id:                     1,
+						Symbol:                 "BTC",
+						Denom:                  "btc-denom",
+						HasMarket:              true,
+						MarketId:               0,
+						AtomicResolution:       int32(-6),
+						CollateralWeightPpm:    800_000,
+						InitialMarginPpm:       200_000,
+						MaintenanceFractionPpm: 500_000,
+						BorrowRatePpm:          50_000,
+						BorrowIndex:            1_000,
 					},
 				},
 			},
@@ -165,6 +170,96 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedErr: types.ErrInvalidMarketId,
 		},
+		"collateral weight exceeds one million": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					{
+						Id:               0,
+						Symbol:           types.AssetUsdc.Symbol,
+						Denom:            types.AssetUsdc.Denom,
+						DenomExponent:    types.AssetUsdc.DenomExponent,
+						HasMarket:        false,
+						AtomicResolution: lib.QuoteCurrencyAtomicResolution,
+					},
+					{
+						Id:                  1,
+						Symbol:              "BTC",
+						Denom:               "btc-denom",
+						HasMarket:           true,
+						MarketId:            0,
+						AtomicResolution:    int32(-6),
+						CollateralWeightPpm: 1_000_001,
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidCollateralWeight,
+		},
+		"collateral asset without market": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					{
+						Id:               0,
+						Symbol:           types.AssetUsdc.Symbol,
+						Denom:            types.AssetUsdc.Denom,
+						DenomExponent:    types.AssetUsdc.DenomExponent,
+						HasMarket:        false,
+						AtomicResolution: lib.QuoteCurrencyAtomicResolution,
+					},
+					{
+						Id:                  1,
+						Denom:               "USDT",
+						HasMarket:           false,
+						AtomicResolution:    int32(-6),
+						CollateralWeightPpm: 900_000,
+					},
+				},
+			},
+			expectedErr: types.ErrCollateralAssetWithoutMarket,
+		},
+		"initial margin exceeds one million": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					{
+						Id:               0,
+						Symbol:           types.AssetUsdc.Symbol,
+						Denom:            types.AssetUsdc.Denom,
+						DenomExponent:    types.AssetUsdc.DenomExponent,
+						HasMarket:        false,
+						AtomicResolution: lib.QuoteCurrencyAtomicResolution,
+					},
+					{
+						Id:               1,
+						Denom:            "btc-denom",
+						HasMarket:        true,
+						AtomicResolution: int32(-6),
+						InitialMarginPpm: 1_000_001,
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidMarginParams,
+		},
+		"borrowable asset without market": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					{
+						Id:               0,
+						Symbol:           types.AssetUsdc.Symbol,
+						Denom:            types.AssetUsdc.Denom,
+						DenomExponent:    types.AssetUsdc.DenomExponent,
+						HasMarket:        false,
+						AtomicResolution: lib.QuoteCurrencyAtomicResolution,
+					},
+					{
+						Id:               1,
+						Denom:            "USDT",
+						HasMarket:        false,
+						AtomicResolution: int32(-6),
+						InitialMarginPpm: 200_000,
+					},
+				},
+			},
+			expectedErr: types.ErrBorrowableAssetWithoutMarket,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
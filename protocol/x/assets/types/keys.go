@@ -13,4 +13,7 @@ const (
 const (
 	// AssetKeyPrefix is the prefix to retrieve all Assets
 	AssetKeyPrefix = "Asset:"
+
+	// TotalBorrowedKeyPrefix is the prefix to retrieve the total borrowed quantums of an asset
+	TotalBorrowedKeyPrefix = "TotalBorrowed:"
 )
@@ -14,4 +14,5 @@ func TestModuleKeys(t *testing.T) {
 
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "Asset:", types.AssetKeyPrefix)
+	require.Equal(t, "TotalBorrowed:", types.TotalBorrowedKeyPrefix)
 }
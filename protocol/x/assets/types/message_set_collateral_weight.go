@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+var _ sdk.Msg = &MsgSetCollateralWeight{}
+
+func (msg *MsgSetCollateralWeight) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetCollateralWeight) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	if msg.CollateralWeightPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidCollateralWeight,
+			"collateral weight %d ppm exceeds %d ppm",
+			msg.CollateralWeightPpm,
+			lib.OneMillion,
+		)
+	}
+	if msg.AssetId == AssetUsdc.Id && msg.CollateralWeightPpm != 0 {
+		return errorsmod.Wrap(
+			ErrInvalidCollateralWeight,
+			"USDC collateral weight must be zero",
+		)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetCollateralWeight_GetSigners(t *testing.T) {
+	msg := types.MsgSetCollateralWeight{
+		Authority: constants.BobAccAddress.String(),
+	}
+	require.Equal(t, []sdk.AccAddress{constants.BobAccAddress}, msg.GetSigners())
+}
+
+func TestMsgSetCollateralWeight_ValidateBasic(t *testing.T) {
+	validAuthority := constants.AliceAccAddress.String()
+
+	tests := map[string]struct {
+		msg         types.MsgSetCollateralWeight
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgSetCollateralWeight{
+				Authority:           validAuthority,
+				AssetId:             1,
+				CollateralWeightPpm: 800_000,
+			},
+		},
+		"Success: zero weight": {
+			msg: types.MsgSetCollateralWeight{
+				Authority:           validAuthority,
+				AssetId:             1,
+				CollateralWeightPpm: 0,
+			},
+		},
+		"Success: zero weight for USDC": {
+			msg: types.MsgSetCollateralWeight{
+				Authority:           validAuthority,
+				AssetId:             types.AssetUsdc.Id,
+				CollateralWeightPpm: 0,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetCollateralWeight{
+				Authority: "",
+			},
+			expectedErr: "Authority is invalid",
+		},
+		"Failure: collateral weight is greater than 100%": {
+			msg: types.MsgSetCollateralWeight{
+				Authority:           validAuthority,
+				AssetId:             1,
+				CollateralWeightPpm: 1_000_001,
+			},
+			expectedErr: "Invalid collateral weight",
+		},
+		"Failure: non-zero weight for USDC": {
+			msg: types.MsgSetCollateralWeight{
+				Authority:           validAuthority,
+				AssetId:             types.AssetUsdc.Id,
+				CollateralWeightPpm: 500_000,
+			},
+			expectedErr: "USDC collateral weight must be zero",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
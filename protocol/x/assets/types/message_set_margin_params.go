@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+var _ sdk.Msg = &MsgSetMarginParams{}
+
+func (msg *MsgSetMarginParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgSetMarginParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+	if msg.InitialMarginPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidMarginParams,
+			"initial margin %d ppm exceeds %d ppm",
+			msg.InitialMarginPpm,
+			lib.OneMillion,
+		)
+	}
+	if msg.MaintenanceFractionPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidMarginParams,
+			"maintenance fraction %d ppm exceeds %d ppm",
+			msg.MaintenanceFractionPpm,
+			lib.OneMillion,
+		)
+	}
+	if msg.AssetId == AssetUsdc.Id &&
+		(msg.InitialMarginPpm != 0 || msg.MaintenanceFractionPpm != 0 || msg.BorrowRatePpm != 0) {
+		return errorsmod.Wrap(
+			ErrInvalidMarginParams,
+			"USDC margin params must be zero",
+		)
+	}
+	return nil
+}
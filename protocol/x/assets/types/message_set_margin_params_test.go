@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetMarginParams_GetSigners(t *testing.T) {
+	msg := types.MsgSetMarginParams{
+		Authority: constants.BobAccAddress.String(),
+	}
+	require.Equal(t, []sdk.AccAddress{constants.BobAccAddress}, msg.GetSigners())
+}
+
+func TestMsgSetMarginParams_ValidateBasic(t *testing.T) {
+	validAuthority := constants.AliceAccAddress.String()
+
+	tests := map[string]struct {
+		msg         types.MsgSetMarginParams
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgSetMarginParams{
+				Authority:              validAuthority,
+				AssetId:                1,
+				InitialMarginPpm:       200_000,
+				MaintenanceFractionPpm: 500_000,
+				BorrowRatePpm:          50_000,
+			},
+		},
+		"Success: disable borrowing": {
+			msg: types.MsgSetMarginParams{
+				Authority: validAuthority,
+				AssetId:   1,
+			},
+		},
+		"Success: zero params for USDC": {
+			msg: types.MsgSetMarginParams{
+				Authority: validAuthority,
+				AssetId:   types.AssetUsdc.Id,
+			},
+		},
+		"Failure: Invalid authority": {
+			msg: types.MsgSetMarginParams{
+				Authority: "",
+			},
+			expectedErr: "Authority is invalid",
+		},
+		"Failure: initial margin is greater than 100%": {
+			msg: types.MsgSetMarginParams{
+				Authority:        validAuthority,
+				AssetId:          1,
+				InitialMarginPpm: 1_000_001,
+			},
+			expectedErr: "Invalid margin params",
+		},
+		"Failure: maintenance fraction is greater than 100%": {
+			msg: types.MsgSetMarginParams{
+				Authority:              validAuthority,
+				AssetId:                1,
+				InitialMarginPpm:       200_000,
+				MaintenanceFractionPpm: 1_000_001,
+			},
+			expectedErr: "Invalid margin params",
+		},
+		"Failure: borrow rate for USDC": {
+			msg: types.MsgSetMarginParams{
+				Authority:     validAuthority,
+				AssetId:       types.AssetUsdc.Id,
+				BorrowRatePpm: 50_000,
+			},
+			expectedErr: "USDC margin params must be zero",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -6,10 +6,16 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,19 +29,270 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgSetCollateralWeight is a message used by x/gov to set the collateral
+// weight of an asset.
+type MsgSetCollateralWeight struct {
+	// The address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the asset to update.
+	AssetId uint32 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The new collateral weight of the asset, in parts-per-million. Setting this
+	// to zero stops the asset from counting toward net collateral.
+	CollateralWeightPpm uint32 `protobuf:"varint,3,opt,name=collateral_weight_ppm,json=collateralWeightPpm,proto3" json:"collateral_weight_ppm,omitempty"`
+}
+
+func (m *MsgSetCollateralWeight) Reset()         { *m = MsgSetCollateralWeight{} }
+func (m *MsgSetCollateralWeight) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCollateralWeight) ProtoMessage()    {}
+func (*MsgSetCollateralWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b715ccf58d5be126, []int{0}
+}
+func (m *MsgSetCollateralWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCollateralWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCollateralWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCollateralWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCollateralWeight.Merge(m, src)
+}
+func (m *MsgSetCollateralWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCollateralWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCollateralWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCollateralWeight proto.InternalMessageInfo
+
+func (m *MsgSetCollateralWeight) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetCollateralWeight) GetAssetId() uint32 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
+}
+
+func (m *MsgSetCollateralWeight) GetCollateralWeightPpm() uint32 {
+	if m != nil {
+		return m.CollateralWeightPpm
+	}
+	return 0
+}
+
+// MsgSetCollateralWeightResponse defines the SetCollateralWeight response type.
+type MsgSetCollateralWeightResponse struct {
+}
+
+func (m *MsgSetCollateralWeightResponse) Reset()         { *m = MsgSetCollateralWeightResponse{} }
+func (m *MsgSetCollateralWeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCollateralWeightResponse) ProtoMessage()    {}
+func (*MsgSetCollateralWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b715ccf58d5be126, []int{1}
+}
+func (m *MsgSetCollateralWeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCollateralWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCollateralWeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCollateralWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCollateralWeightResponse.Merge(m, src)
+}
+func (m *MsgSetCollateralWeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCollateralWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCollateralWeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCollateralWeightResponse proto.InternalMessageInfo
+
+// MsgSetMarginParams is a message used by x/gov to set the borrowing
+// parameters of an asset.
+type MsgSetMarginParams struct {
+	// The address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the asset to update.
+	AssetId uint32 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The new initial margin of the asset, in parts-per-million. Setting this
+	// to zero stops the asset from being borrowed.
+	InitialMarginPpm uint32 `protobuf:"varint,3,opt,name=initial_margin_ppm,json=initialMarginPpm,proto3" json:"initial_margin_ppm,omitempty"`
+	// The new maintenance fraction of the asset, in parts-per-million.
+	MaintenanceFractionPpm uint32 `protobuf:"varint,4,opt,name=maintenance_fraction_ppm,json=maintenanceFractionPpm,proto3" json:"maintenance_fraction_ppm,omitempty"`
+	// The new annualized borrow rate of the asset, in parts-per-million.
+	BorrowRatePpm uint32 `protobuf:"varint,5,opt,name=borrow_rate_ppm,json=borrowRatePpm,proto3" json:"borrow_rate_ppm,omitempty"`
+}
+
+func (m *MsgSetMarginParams) Reset()         { *m = MsgSetMarginParams{} }
+func (m *MsgSetMarginParams) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginParams) ProtoMessage()    {}
+func (*MsgSetMarginParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b715ccf58d5be126, []int{2}
+}
+func (m *MsgSetMarginParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarginParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarginParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMarginParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginParams.Merge(m, src)
+}
+func (m *MsgSetMarginParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarginParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMarginParams proto.InternalMessageInfo
+
+func (m *MsgSetMarginParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMarginParams) GetAssetId() uint32 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
+}
+
+func (m *MsgSetMarginParams) GetInitialMarginPpm() uint32 {
+	if m != nil {
+		return m.InitialMarginPpm
+	}
+	return 0
+}
+
+func (m *MsgSetMarginParams) GetMaintenanceFractionPpm() uint32 {
+	if m != nil {
+		return m.MaintenanceFractionPpm
+	}
+	return 0
+}
+
+func (m *MsgSetMarginParams) GetBorrowRatePpm() uint32 {
+	if m != nil {
+		return m.BorrowRatePpm
+	}
+	return 0
+}
+
+// MsgSetMarginParamsResponse defines the SetMarginParams response type.
+type MsgSetMarginParamsResponse struct {
+}
+
+func (m *MsgSetMarginParamsResponse) Reset()         { *m = MsgSetMarginParamsResponse{} }
+func (m *MsgSetMarginParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarginParamsResponse) ProtoMessage()    {}
+func (*MsgSetMarginParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b715ccf58d5be126, []int{3}
+}
+func (m *MsgSetMarginParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMarginParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMarginParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMarginParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarginParamsResponse.Merge(m, src)
+}
+func (m *MsgSetMarginParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMarginParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarginParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMarginParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetCollateralWeight)(nil), "dydxprotocol.assets.MsgSetCollateralWeight")
+	proto.RegisterType((*MsgSetCollateralWeightResponse)(nil), "dydxprotocol.assets.MsgSetCollateralWeightResponse")
+	proto.RegisterType((*MsgSetMarginParams)(nil), "dydxprotocol.assets.MsgSetMarginParams")
+	proto.RegisterType((*MsgSetMarginParamsResponse)(nil), "dydxprotocol.assets.MsgSetMarginParamsResponse")
+}
+
 func init() { proto.RegisterFile("dydxprotocol/assets/tx.proto", fileDescriptor_b715ccf58d5be126) }
 
 var fileDescriptor_b715ccf58d5be126 = []byte{
-	// 130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0x29, 0xd6,
-	0x2f, 0xa9, 0xd0, 0x03, 0x0b, 0x09, 0x09, 0x23, 0xcb, 0xea, 0x41, 0x64, 0x8d, 0x58, 0xb9, 0x98,
-	0x7d, 0x8b, 0xd3, 0x9d, 0x82, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
-	0x22, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xc5, 0xf8, 0x32, 0x13,
-	0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x7d, 0xb8, 0x48, 0x05, 0xdc, 0xca, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0x84, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x82, 0x5a, 0xd9, 0x5c, 0x96, 0x00,
-	0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xbf, 0x6f, 0xd4, 0x30,
+	0x18, 0x3d, 0xb7, 0xfc, 0xaa, 0xa5, 0x52, 0xe4, 0x83, 0x92, 0x46, 0x55, 0x74, 0xba, 0x01, 0x2a,
+	0xa0, 0xb1, 0x68, 0x11, 0xaa, 0xd8, 0x28, 0x12, 0x12, 0xc3, 0x49, 0x55, 0x3a, 0x20, 0xb1, 0x44,
+	0x3e, 0xc7, 0x24, 0x16, 0xb1, 0x1d, 0xd9, 0x6e, 0xef, 0x6e, 0x65, 0x61, 0xe5, 0x3f, 0x81, 0x81,
+	0x3f, 0x82, 0xb1, 0x62, 0x62, 0x44, 0x77, 0x03, 0x03, 0xff, 0x04, 0xaa, 0x9d, 0xbb, 0x86, 0x23,
+	0x42, 0x30, 0x30, 0x45, 0xdf, 0xf7, 0xde, 0xfb, 0xbe, 0xe7, 0xe7, 0x18, 0x6e, 0x67, 0x93, 0x6c,
+	0x5c, 0x69, 0x65, 0x15, 0x55, 0x25, 0x26, 0xc6, 0x30, 0x6b, 0xb0, 0x1d, 0xc7, 0xae, 0x85, 0xba,
+	0x4d, 0x34, 0xf6, 0x68, 0xb8, 0x45, 0x95, 0x11, 0xca, 0xa4, 0xae, 0x8f, 0x7d, 0xe1, 0xf9, 0xe1,
+	0x6d, 0x5f, 0x61, 0x61, 0x72, 0x7c, 0xfa, 0xf0, 0xfc, 0xe3, 0x81, 0xfe, 0x07, 0x00, 0x37, 0x07,
+	0x26, 0x3f, 0x66, 0xf6, 0x99, 0x2a, 0x4b, 0x62, 0x99, 0x26, 0xe5, 0x4b, 0xc6, 0xf3, 0xc2, 0xa2,
+	0xc7, 0x70, 0x8d, 0x9c, 0xd8, 0x42, 0x69, 0x6e, 0x27, 0x01, 0xe8, 0x81, 0x9d, 0xb5, 0xc3, 0xe0,
+	0xcb, 0xa7, 0xdd, 0x9b, 0xf5, 0xe0, 0xa7, 0x59, 0xa6, 0x99, 0x31, 0xc7, 0x56, 0x73, 0x99, 0x27,
+	0x17, 0x54, 0xb4, 0x05, 0xaf, 0x39, 0x43, 0x29, 0xcf, 0x82, 0x95, 0x1e, 0xd8, 0x59, 0x4f, 0xae,
+	0xba, 0xfa, 0x45, 0x86, 0xf6, 0xe0, 0x2d, 0xba, 0x58, 0x93, 0x8e, 0xdc, 0x9e, 0xb4, 0xaa, 0x44,
+	0xb0, 0xea, 0x78, 0x5d, 0xba, 0xe4, 0xe1, 0xa8, 0x12, 0x4f, 0xae, 0xbf, 0xfd, 0xfe, 0xf1, 0xde,
+	0xc5, 0xf8, 0x7e, 0x0f, 0x46, 0xed, 0x86, 0x13, 0x66, 0x2a, 0x25, 0x0d, 0xeb, 0xbf, 0x5b, 0x81,
+	0xc8, 0x53, 0x06, 0x44, 0xe7, 0x5c, 0x1e, 0x11, 0x4d, 0x84, 0xf9, 0x1f, 0xe7, 0x79, 0x00, 0x11,
+	0x97, 0xdc, 0x72, 0x52, 0xa6, 0xc2, 0xad, 0x6a, 0x1c, 0xe6, 0x46, 0x8d, 0xd4, 0x1e, 0x2a, 0x81,
+	0x0e, 0x60, 0x20, 0x08, 0x97, 0x96, 0x49, 0x22, 0x29, 0x4b, 0x5f, 0x6b, 0x42, 0x2d, 0x57, 0x5e,
+	0x73, 0xc9, 0x69, 0x36, 0x1b, 0xf8, 0xf3, 0x1a, 0x3e, 0x57, 0xde, 0x81, 0x1b, 0x43, 0xa5, 0xb5,
+	0x1a, 0xa5, 0x9a, 0x58, 0xe6, 0x04, 0x97, 0x9d, 0x60, 0xdd, 0xb7, 0x13, 0x62, 0x59, 0x5b, 0x56,
+	0xdb, 0x30, 0xfc, 0x3d, 0x88, 0x79, 0x4e, 0x7b, 0x3f, 0x00, 0x5c, 0x1d, 0x98, 0x1c, 0x8d, 0x60,
+	0xb7, 0xed, 0xfe, 0xef, 0xc7, 0x2d, 0x3f, 0x59, 0xdc, 0x9e, 0x7d, 0xb8, 0xff, 0x0f, 0xe4, 0xb9,
+	0x01, 0xf4, 0x06, 0x6e, 0x2c, 0x5f, 0xd2, 0xdd, 0x3f, 0xcc, 0x69, 0x12, 0x43, 0xfc, 0x97, 0xc4,
+	0xf9, 0xb2, 0xc3, 0xe4, 0xf3, 0x34, 0x02, 0x67, 0xd3, 0x08, 0x7c, 0x9b, 0x46, 0xe0, 0xfd, 0x2c,
+	0xea, 0x9c, 0xcd, 0xa2, 0xce, 0xd7, 0x59, 0xd4, 0x79, 0x75, 0x90, 0x73, 0x5b, 0x9c, 0x0c, 0x63,
+	0xaa, 0x04, 0xfe, 0xe5, 0xd5, 0x9d, 0x3e, 0xda, 0xa5, 0x05, 0xe1, 0x12, 0x2f, 0x3a, 0xe3, 0xc5,
+	0x4b, 0x9c, 0x54, 0xcc, 0x0c, 0xaf, 0x38, 0x60, 0xff, 0xe7, 0x00, 0x2d, 0x89, 0x14, 0x79, 0xad,
+	0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -50,6 +307,10 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// SetCollateralWeight sets the collateral weight of an existing asset.
+	SetCollateralWeight(ctx context.Context, in *MsgSetCollateralWeight, opts ...grpc.CallOption) (*MsgSetCollateralWeightResponse, error)
+	// SetMarginParams sets the borrowing parameters of an existing asset.
+	SetMarginParams(ctx context.Context, in *MsgSetMarginParams, opts ...grpc.CallOption) (*MsgSetMarginParamsResponse, error)
 }
 
 type msgClient struct {
@@ -60,22 +321,774 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) SetCollateralWeight(ctx context.Context, in *MsgSetCollateralWeight, opts ...grpc.CallOption) (*MsgSetCollateralWeightResponse, error) {
+	out := new(MsgSetCollateralWeightResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.assets.Msg/SetCollateralWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMarginParams(ctx context.Context, in *MsgSetMarginParams, opts ...grpc.CallOption) (*MsgSetMarginParamsResponse, error) {
+	out := new(MsgSetMarginParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.assets.Msg/SetMarginParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// SetCollateralWeight sets the collateral weight of an existing asset.
+	SetCollateralWeight(context.Context, *MsgSetCollateralWeight) (*MsgSetCollateralWeightResponse, error)
+	// SetMarginParams sets the borrowing parameters of an existing asset.
+	SetMarginParams(context.Context, *MsgSetMarginParams) (*MsgSetMarginParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) SetCollateralWeight(ctx context.Context, req *MsgSetCollateralWeight) (*MsgSetCollateralWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollateralWeight not implemented")
+}
+func (*UnimplementedMsgServer) SetMarginParams(ctx context.Context, req *MsgSetMarginParams) (*MsgSetMarginParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginParams not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_SetCollateralWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCollateralWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCollateralWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.assets.Msg/SetCollateralWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCollateralWeight(ctx, req.(*MsgSetCollateralWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarginParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarginParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarginParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.assets.Msg/SetMarginParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarginParams(ctx, req.(*MsgSetMarginParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.assets.Msg",
 	HandlerType: (*MsgServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "dydxprotocol/assets/tx.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetCollateralWeight",
+			Handler:    _Msg_SetCollateralWeight_Handler,
+		},
+		{
+			MethodName: "SetMarginParams",
+			Handler:    _Msg_SetMarginParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/assets/tx.proto",
+}
+
+func (m *MsgSetCollateralWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCollateralWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCollateralWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CollateralWeightPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CollateralWeightPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCollateralWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCollateralWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCollateralWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarginParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarginParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarginParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BorrowRatePpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BorrowRatePpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaintenanceFractionPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaintenanceFractionPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialMarginPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InitialMarginPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
+
+func (m *MsgSetMarginParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarginParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarginParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetCollateralWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovTx(uint64(m.AssetId))
+	}
+	if m.CollateralWeightPpm != 0 {
+		n += 1 + sovTx(uint64(m.CollateralWeightPpm))
+	}
+	return n
+}
+
+func (m *MsgSetCollateralWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMarginParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovTx(uint64(m.AssetId))
+	}
+	if m.InitialMarginPpm != 0 {
+		n += 1 + sovTx(uint64(m.InitialMarginPpm))
+	}
+	if m.MaintenanceFractionPpm != 0 {
+		n += 1 + sovTx(uint64(m.MaintenanceFractionPpm))
+	}
+	if m.BorrowRatePpm != 0 {
+		n += 1 + sovTx(uint64(m.BorrowRatePpm))
+	}
+	return n
+}
+
+func (m *MsgSetMarginParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetCollateralWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCollateralWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCollateralWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralWeightPpm", wireType)
+			}
+			m.CollateralWeightPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CollateralWeightPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCollateralWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCollateralWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCollateralWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMarginParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarginParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarginParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
+			}
+			m.InitialMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFractionPpm", wireType)
+			}
+			m.MaintenanceFractionPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceFractionPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowRatePpm", wireType)
+			}
+			m.BorrowRatePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorrowRatePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMarginParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarginParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarginParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
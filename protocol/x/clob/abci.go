@@ -10,7 +10,7 @@ import (
 	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/liquidations"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
@@ -59,7 +59,7 @@ func EndBlocker(
 			indexer_manager.GetBytes(
 				indexerevents.NewStatefulOrderRemovalEvent(
 					orderId,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
 				),
 			),
 		)
@@ -70,10 +70,12 @@ func EndBlocker(
 		)
 	}
 
-	// Prune expired untriggered conditional orders from the in-memory UntriggeredConditionalOrders struct.
+	// Prune expired, cancelled and removed untriggered conditional orders from the in-memory
+	// UntriggeredConditionalOrders struct.
 	keeper.PruneUntriggeredConditionalOrders(
 		expiredStatefulOrderIds,
 		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
 	)
 
 	// Update the memstore with expired order ids.
@@ -82,14 +84,15 @@ func EndBlocker(
 
 	// Before triggering conditional orders, add newly-placed conditional orders to the clob keeper's
 	// in-memory UntriggeredConditionalOrders data structure to allow conditional orders to
-	// trigger in the same block they are placed. Skip triggering orders which have been cancelled
-	// or expired.
+	// trigger in the same block they are placed. Skip triggering orders which have been cancelled,
+	// expired or removed.
 	// TODO(CLOB-773) Support conditional order replacements. Ensure replacements are de-duplicated.
 	keeper.AddUntriggeredConditionalOrders(
 		ctx,
 		processProposerMatchesEvents.PlacedConditionalOrderIds,
 		lib.UniqueSliceToSet(processProposerMatchesEvents.GetPlacedStatefulCancellationOrderIds()),
 		lib.UniqueSliceToSet(expiredStatefulOrderIds),
+		lib.UniqueSliceToSet(processProposerMatchesEvents.RemovedStatefulOrderIds),
 	)
 
 	// Poll out all triggered conditional orders from `UntriggeredConditionalOrders` and update state.
@@ -97,6 +100,11 @@ func EndBlocker(
 	// Update the memstore with conditional order ids triggered in the last block.
 	// These triggered conditional orders will be placed in the `PrepareCheckState``.
 	processProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock = triggeredConditionalOrderIds
+	// Triggered conditional orders may have removed the other orders of their order groups, which are
+	// added to the memstore by `RemoveOrderGroupSiblings`.
+	processProposerMatchesEvents.RemovedStatefulOrderIds = keeper.GetProcessProposerMatchesEvents(
+		ctx,
+	).RemovedStatefulOrderIds
 
 	// Write the ProcessProposerMatchcesEvents with all the EndBlocker updates to state.
 	keeper.MustSetProcessProposerMatchesEvents(
@@ -107,6 +115,9 @@ func EndBlocker(
 	// Prune any rate limiting information that is no longer relevant.
 	keeper.PruneRateLimits(ctx)
 
+	// Close the remaining positions of clob pairs in final settlement.
+	keeper.SettlePendingFinalSettlements(ctx)
+
 	// Emit relevant metrics at the end of every block.
 	telemetry.SetGaugeWithLabels(
 		[]string{metrics.InsuranceFundBalance},
@@ -156,6 +167,15 @@ func PrepareCheckState(
 		offchainUpdates,
 	)
 
+	// Remove all remaining orders of clob pairs moved to final settlement in the last block from the memclob.
+	for _, clobPairId := range processProposerMatchesEvents.FinalSettlementClobPairIds {
+		offchainUpdates = keeper.MemClob.RemoveOrdersForClobPair(
+			ctx,
+			types.ClobPairId(clobPairId),
+			offchainUpdates,
+		)
+	}
+
 	// 3. Place all stateful order placements included in the last block on the memclob.
 	// Note telemetry is measured outside of the function call because `PlaceStatefulOrdersFromLastBlock`
 	// is called within `PlaceConditionalOrdersTriggeredInLastBlock`.
@@ -205,7 +225,11 @@ func PrepareCheckState(
 	}
 
 	// Send all off-chain Indexer events
-	keeper.SendOffchainMessages(offchainUpdates, nil, metrics.SendPrepareCheckStateOffchainUpdates)
+	keeper.SendOffchainMessages(ctx, offchainUpdates, nil, metrics.SendPrepareCheckStateOffchainUpdates)
+
+	// Send a snapshot of the orderbooks to newly subscribed gRPC streams now that the memclob
+	// reflects the latest committed state.
+	keeper.InitializeNewGrpcStreams(ctx)
 
 	newLocalValidatorOperationsQueue, _ := keeper.MemClob.GetOperationsToReplay(ctx)
 	keeper.Logger(ctx).Debug(
@@ -14,7 +14,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	prices "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -150,7 +150,7 @@ func TestEndBlocker_Failure(t *testing.T) {
 					indexer_manager.GetBytes(
 						indexerevents.NewStatefulOrderRemovalEvent(
 							orderId,
-							indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
+							sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
 						),
 					),
 				).Once().Return()
@@ -686,6 +686,11 @@ func TestEndBlocker_Success(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -771,7 +776,7 @@ func TestEndBlocker_Success(t *testing.T) {
 					indexer_manager.GetBytes(
 						indexerevents.NewStatefulOrderRemovalEvent(
 							orderId,
-							indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
+							sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
 						),
 					),
 				).Once().Return()
@@ -1369,6 +1374,11 @@ func TestPrepareCheckState(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -5,8 +5,9 @@ import (
 )
 
 // SingleMsgClobTxAnteWrapper is a wrapper for Antehandlers that need to be skipped for
-// single msg clob txs `MsgPlaceOrder` and `MsgCancelOrder`. These transactions should always have `0` Gas,
-// and therefore should never be charged a gas fee.
+// single msg clob txs `MsgPlaceOrder`, `MsgCancelOrder`, `MsgReplaceOrder`, `MsgBatchCancel` and
+// `MsgCancelAllOrders`.
+// These transactions should always have `0` Gas, and therefore should never be charged a gas fee.
 type SingleMsgClobTxAnteWrapper struct {
 	antehandler sdk.AnteDecorator
 }
@@ -40,7 +41,8 @@ func (anteWrapper SingleMsgClobTxAnteWrapper) AnteHandle(
 }
 
 // ShortTermSingleMsgClobTxAnteWrapper is a wrapper for Antehandlers that need to be skipped for
-// single msg clob txs `MsgPlaceOrder` and `MsgCancelOrder` which reference Short-Term orders.
+// single msg clob txs `MsgPlaceOrder`, `MsgCancelOrder`, `MsgReplaceOrder` and `MsgBatchCancel` which reference
+// Short-Term orders.
 // For example, these transactions do not require sequence number validation.
 type ShortTermSingleMsgClobTxAnteWrapper struct {
 	antehandler sdk.AnteDecorator
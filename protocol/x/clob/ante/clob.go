@@ -12,21 +12,24 @@ import (
 )
 
 // ClobDecorator is an AnteDecorator which is responsible for:
-//   - adding short term order placements and cancelations to the in-memory orderbook (`CheckTx` only).
+//   - adding short term order placements, replacements and cancelations to the in-memory orderbook
+//     (`CheckTx` only).
 //   - adding stateful order placements and cancelations to state (`CheckTx` and `RecheckTx` only).
 //
-// This AnteDecorator also enforces that any Transaction which contains a `MsgPlaceOrder`,
-// or a `MsgCancelOrder`, must consist only of a single message.
+// This AnteDecorator also enforces that any Transaction which contains a `MsgPlaceOrder`, `MsgCancelOrder`,
+// `MsgReplaceOrder`, `MsgBatchCancel` or `MsgCancelAllOrders` must consist only of a single message.
 //
 // This AnteDecorator is a no-op if:
-//   - No messages in the transaction are `MsgPlaceOrder` or `MsgCancelOrder`.
+//   - No messages in the transaction are `MsgPlaceOrder`, `MsgCancelOrder`, `MsgReplaceOrder`,
+//     `MsgBatchCancel` or `MsgCancelAllOrders`.
 //   - This AnteDecorator is called during `DeliverTx`.
 //
 // This AnteDecorator returns an error if:
-//   - The transaction contains multiple messages, and one of them is a `MsgPlaceOrder`
-//     or `MsgCancelOrder` message.
-//   - The underlying `PlaceStatefulOrder`, `PlaceShortTermOrder`, `CancelStatefulOrder`, or `CancelShortTermOrder`
-//     methods on the keeper return errors.
+//   - The transaction contains multiple messages, and one of them is a `MsgPlaceOrder`, `MsgCancelOrder`,
+//     `MsgReplaceOrder`, `MsgBatchCancel` or `MsgCancelAllOrders` message.
+//   - The underlying `PlaceStatefulOrder`, `PlaceShortTermOrder`, `ReplaceShortTermOrder`, `CancelStatefulOrder`,
+//     `CancelShortTermOrder`, `BatchCancelStatefulOrders`, `BatchCancelShortTermOrders` or
+//     `CancelAllShortTermOrders` methods on the keeper return errors.
 type ClobDecorator struct {
 	clobKeeper types.ClobKeeper
 }
@@ -89,6 +92,59 @@ func (cd ClobDecorator) AnteHandle(
 			lib.TxMode(ctx),
 		)
 
+	case *types.MsgBatchCancel:
+		var canceledOrderIds, failedOrderIds []types.OrderId
+		if msg.IsShortTermBatch() {
+			// No need to process short term order cancelations on `ReCheckTx`.
+			if ctx.IsReCheckTx() {
+				return next(ctx, tx, simulate)
+			}
+
+			canceledOrderIds, failedOrderIds, err = cd.clobKeeper.BatchCancelShortTermOrders(ctx, msg)
+		} else {
+			canceledOrderIds, failedOrderIds, err = cd.clobKeeper.BatchCancelStatefulOrders(ctx, msg)
+		}
+		cd.clobKeeper.Logger(ctx).Debug("Received new batch cancelation",
+			"tx",
+			log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			"msg",
+			msg,
+			"canceledOrderIds",
+			canceledOrderIds,
+			"failedOrderIds",
+			failedOrderIds,
+			"err",
+			err,
+			"block",
+			ctx.BlockHeight(),
+			"txMode",
+			lib.TxMode(ctx),
+		)
+
+	case *types.MsgCancelAllOrders:
+		// Stateful orders are canceled during `DeliverTx`, and there is no need to process
+		// short term order cancelations on `ReCheckTx`.
+		if ctx.IsReCheckTx() || !msg.CancelsShortTermOrders() {
+			return next(ctx, tx, simulate)
+		}
+
+		var canceledOrderIds []types.OrderId
+		canceledOrderIds, err = cd.clobKeeper.CancelAllShortTermOrders(ctx, msg)
+		cd.clobKeeper.Logger(ctx).Debug("Received new cancel all orders",
+			"tx",
+			log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			"msg",
+			msg,
+			"canceledOrderIds",
+			canceledOrderIds,
+			"err",
+			err,
+			"block",
+			ctx.BlockHeight(),
+			"txMode",
+			lib.TxMode(ctx),
+		)
+
 	case *types.MsgPlaceOrder:
 		if msg.Order.OrderId.IsStatefulOrder() {
 			err = cd.clobKeeper.PlaceStatefulOrder(ctx, msg)
@@ -139,6 +195,39 @@ func (cd ClobDecorator) AnteHandle(
 				lib.TxMode(ctx),
 			)
 		}
+
+	case *types.MsgReplaceOrder:
+		// No need to process short term order replacements on `ReCheckTx`.
+		if ctx.IsReCheckTx() {
+			return next(ctx, tx, simulate)
+		}
+
+		var orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums
+		var status types.OrderStatus
+		// Note that `msg.ValidateBasic` is called before all AnteHandlers.
+		// This guarantees that `MsgReplaceOrder` has undergone stateless validation.
+		orderSizeOptimisticallyFilledFromMatchingQuantums, status, err = cd.clobKeeper.ReplaceShortTermOrder(
+			ctx,
+			msg,
+		)
+		cd.clobKeeper.Logger(ctx).Debug("Received new short term order replacement",
+			"tx",
+			log.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			"orderHash",
+			log.NewLazySprintf("%X", msg.Order.GetOrderHash()),
+			"msg",
+			msg,
+			"status",
+			status,
+			"orderSizeOptimisticallyFilledFromMatchingQuantums",
+			orderSizeOptimisticallyFilledFromMatchingQuantums,
+			"err",
+			err,
+			"block",
+			ctx.BlockHeight(),
+			"txMode",
+			lib.TxMode(ctx),
+		)
 	}
 	if err != nil {
 		return ctx, err
@@ -148,15 +237,20 @@ func (cd ClobDecorator) AnteHandle(
 }
 
 // IsSingleClobMsgTx returns `true` if the supplied `tx` consist of a single clob message
-// (`MsgPlaceOrder` or `MsgCancelOrder`). If `msgs` consist of multiple clob messages,
-// or a mix of on-chain and clob messages, an error is returned.
+// (`MsgPlaceOrder`, `MsgCancelOrder`, `MsgReplaceOrder`, `MsgBatchCancel` or `MsgCancelAllOrders`).
+// If `msgs` consist of multiple clob messages, or a mix of on-chain and clob messages, an error is returned.
 func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	msgs := tx.GetMsgs()
 	var hasMessage = false
 
 	for _, msg := range msgs {
 		switch msg.(type) {
-		case *types.MsgCancelOrder, *types.MsgPlaceOrder:
+		case
+			*types.MsgCancelOrder,
+			*types.MsgPlaceOrder,
+			*types.MsgReplaceOrder,
+			*types.MsgBatchCancel,
+			*types.MsgCancelAllOrders:
 			hasMessage = true
 		}
 
@@ -173,7 +267,8 @@ func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	if numMsgs > 1 {
 		return false, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"a transaction containing MsgCancelOrder or MsgPlaceOrder may not contain more than one message",
+			"a transaction containing MsgCancelOrder, MsgPlaceOrder, MsgReplaceOrder, MsgBatchCancel or "+
+				"MsgCancelAllOrders may not contain more than one message",
 		)
 	}
 
@@ -181,7 +276,8 @@ func IsSingleClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 }
 
 // IsShortTermClobMsgTx returns `true` if the supplied `tx` consist of a single clob message
-// (`MsgPlaceOrder` or `MsgCancelOrder`) which references a Short-Term Order. If `msgs` consist of multiple
+// (`MsgPlaceOrder`, `MsgCancelOrder`, `MsgReplaceOrder` or `MsgBatchCancel`) which references Short-Term Orders.
+// Note that `MsgCancelAllOrders` always uses sequence numbers for replay prevention. If `msgs` consist of multiple
 // clob messages, or a mix of on-chain and clob messages, an error is returned.
 func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	msgs := tx.GetMsgs()
@@ -202,6 +298,18 @@ func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 					isShortTermOrder = true
 				}
 			}
+		case *types.MsgReplaceOrder:
+			{
+				if msg.Order.OrderId.IsShortTermOrder() {
+					isShortTermOrder = true
+				}
+			}
+		case *types.MsgBatchCancel:
+			{
+				if msg.IsShortTermBatch() {
+					isShortTermOrder = true
+				}
+			}
 		}
 
 		if isShortTermOrder {
@@ -217,7 +325,8 @@ func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	if numMsgs > 1 {
 		return false, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"a transaction containing MsgCancelOrder or MsgPlaceOrder may not contain more than one message",
+			"a transaction containing MsgCancelOrder, MsgPlaceOrder, MsgReplaceOrder, MsgBatchCancel or "+
+				"MsgCancelAllOrders may not contain more than one message",
 		)
 	}
 
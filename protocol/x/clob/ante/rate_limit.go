@@ -7,15 +7,17 @@ import (
 
 var _ sdktypes.AnteDecorator = (*ClobRateLimitDecorator)(nil)
 
-// ClobRateLimitDecorator is an AnteDecorator which is responsible for rate limiting MsgCancelOrder and MsgPlaceOrder
-// requests.
+// ClobRateLimitDecorator is an AnteDecorator which is responsible for rate limiting MsgCancelOrder, MsgPlaceOrder,
+// MsgReplaceOrder, MsgBatchCancel and MsgCancelAllOrders requests. A MsgBatchCancel or MsgCancelAllOrders counts
+// as a single order cancellation, and a MsgReplaceOrder counts as a single order placement.
 //
 // This AnteDecorator is a no-op if:
-//   - No messages in the transaction are `MsgCancelOrder` or `MsgPlaceOrder`.
+//   - No messages in the transaction are `MsgCancelOrder`, `MsgPlaceOrder`, `MsgReplaceOrder`, `MsgBatchCancel`
+//     or `MsgCancelAllOrders`.
 //
 // This AnteDecorator returns an error if:
-//   - The rate limit is exceeded for any `MsgCancelOrder` messages.
-//   - The rate limit is exceeded for any `MsgPlaceOrder` messages.
+//   - The rate limit is exceeded for any `MsgCancelOrder`, `MsgBatchCancel` or `MsgCancelAllOrders` messages.
+//   - The rate limit is exceeded for any `MsgPlaceOrder` or `MsgReplaceOrder` messages.
 //
 // TODO(CLOB-721): Rate limit short term order cancellations.
 type ClobRateLimitDecorator struct {
@@ -43,6 +45,18 @@ func (r ClobRateLimitDecorator) AnteHandle(
 			if err = r.clobKeeper.RateLimitPlaceOrder(ctx, msg); err != nil {
 				return ctx, err
 			}
+		case *types.MsgReplaceOrder:
+			if err = r.clobKeeper.RateLimitReplaceOrder(ctx, msg); err != nil {
+				return ctx, err
+			}
+		case *types.MsgBatchCancel:
+			if err = r.clobKeeper.RateLimitBatchCancel(ctx, msg); err != nil {
+				return ctx, err
+			}
+		case *types.MsgCancelAllOrders:
+			if err = r.clobKeeper.RateLimitCancelAllOrders(ctx, msg); err != nil {
+				return ctx, err
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
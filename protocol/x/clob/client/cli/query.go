@@ -26,6 +26,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdGetBlockRateLimitConfiguration())
 	cmd.AddCommand(CmdGetEquityTierLimitConfig())
 	cmd.AddCommand(CmdGetLiquidationsConfiguration())
+	cmd.AddCommand(CmdShowOrderbookL2())
+	cmd.AddCommand(CmdListSubaccountOpenOrders())
+	cmd.AddCommand(CmdShowOrderFillState())
+	cmd.AddCommand(CmdShowAdlRank())
 
 	return cmd
 }
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowOrderFillState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-order-fill-state [owner] [number] [client-id] [order-flags] [clob-pair-id]",
+		Short: "shows the fill state of an order",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOwner := args[0]
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argClientId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argOrderFlags, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argClobPairId, err := cast.ToUint32E(args[4])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOrderFillStateRequest{
+				Owner:      argOwner,
+				Number:     argNumber,
+				ClientId:   argClientId,
+				OrderFlags: argOrderFlags,
+				ClobPairId: argClobPairId,
+			}
+
+			res, err := queryClient.OrderFillState(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const flagDepth = "depth"
+
+func CmdShowOrderbookL2() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-orderbook-l2 [clob-pair-id]",
+		Short: "shows the price levels of a clob pair's orderbook",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argClobPairId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			depth, err := cmd.Flags().GetUint32(flagDepth)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOrderbookL2Request{
+				ClobPairId: argClobPairId,
+				Depth:      depth,
+			}
+
+			res, err := queryClient.OrderbookL2(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flagDepth, 0, "Number of price levels to return on each side, or 0 for all levels")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
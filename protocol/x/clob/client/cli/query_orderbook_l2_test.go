@@ -0,0 +1,32 @@
+//go:build all || integration_test
+
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	tmcli "github.com/cometbft/cometbft/libs/cli"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/client/cli"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestCmdShowOrderbookL2(t *testing.T) {
+	net, objs := networkWithClobPairObjects(t, 2)
+	ctx := net.Validators[0].ClientCtx
+	args := []string{
+		lib.UintToString(objs[0].Id),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowOrderbookL2(), args)
+	require.NoError(t, err)
+	var resp types.QueryOrderbookL2Response
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Empty(t, resp.Bids)
+	require.Empty(t, resp.Asks)
+}
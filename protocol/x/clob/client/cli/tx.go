@@ -27,6 +27,9 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdPlaceOrder())
 	cmd.AddCommand(CmdCancelOrder())
+	cmd.AddCommand(CmdReplaceOrder())
+	cmd.AddCommand(CmdBatchCancel())
+	cmd.AddCommand(CmdCancelAllOrders())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdBatchCancel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-cancel owner number clobPairId clientIds goodTilBlock",
+		Short: "Broadcast message batch_cancel. clientIds is a comma-separated list of Short-Term order client IDs",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argClobPairId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argClientIds := make([]uint32, 0)
+			for _, clientId := range strings.Split(args[3], ",") {
+				argClientId, err := cast.ToUint32E(strings.TrimSpace(clientId))
+				if err != nil {
+					return err
+				}
+				argClientIds = append(argClientIds, argClientId)
+			}
+
+			argGoodTilBlock, err := cast.ToUint32E(args[4])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchCancelShortTerm(
+				satypes.SubaccountId{
+					Owner:  argOwner,
+					Number: argNumber,
+				},
+				[]types.OrderBatch{
+					{
+						ClobPairId: argClobPairId,
+						ClientIds:  argClientIds,
+					},
+				},
+				argGoodTilBlock,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
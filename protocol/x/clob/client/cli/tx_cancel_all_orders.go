@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagClobPairIds = "clob-pair-ids"
+	flagOrderFlags  = "order-flags"
+)
+
+func CmdCancelAllOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all-orders owner number goodTilBlock",
+		Short: "Broadcast message cancel_all_orders",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argGoodTilBlock, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clobPairIds, err := cmd.Flags().GetUintSlice(flagClobPairIds)
+			if err != nil {
+				return err
+			}
+
+			orderFlags, err := cmd.Flags().GetUintSlice(flagOrderFlags)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelAllOrders{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  argOwner,
+					Number: argNumber,
+				},
+				ClobPairIds:  make([]uint32, 0, len(clobPairIds)),
+				OrderFlags:   make([]uint32, 0, len(orderFlags)),
+				GoodTilBlock: argGoodTilBlock,
+			}
+			for _, clobPairId := range clobPairIds {
+				msg.ClobPairIds = append(msg.ClobPairIds, cast.ToUint32(clobPairId))
+			}
+			for _, orderFlag := range orderFlags {
+				msg.OrderFlags = append(msg.OrderFlags, cast.ToUint32(orderFlag))
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().UintSlice(flagClobPairIds, nil, "Clob pair IDs to cancel orders of, or all clob pairs if empty")
+	cmd.Flags().UintSlice(flagOrderFlags, nil, "Order flags of the orders to cancel, or all orders if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
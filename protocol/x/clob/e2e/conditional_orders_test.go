@@ -387,6 +387,64 @@ func TestConditionalOrder(t *testing.T) {
 				4: {constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_SL_49999.OrderId: true},
 			},
 		},
+		"TrailingStop/Sell conditional order trails the oracle price up and is triggered in later blocks": {
+			subaccounts: []satypes.Subaccount{
+				constants.Bob_Num0_100_000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49000_Trail1000,
+			},
+			// The trigger price moves up from 49,000 to 50,000 with the oracle price.
+			priceUpdateForFirstBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 5_100_000_000),
+				},
+			},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 4_990_000_000),
+				},
+			},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49000_Trail1000.OrderId: true,
+			},
+			expectedInTriggeredStateAfterBlock: map[uint32]map[clobtypes.OrderId]bool{
+				2: {constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49000_Trail1000.OrderId: false},
+				3: {constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49000_Trail1000.OrderId: true},
+				4: {constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49000_Trail1000.OrderId: true},
+			},
+		},
+		"OrderGroup/Triggering a one-cancels-other conditional order removes the other order": {
+			subaccounts: []satypes.Subaccount{
+				constants.Bob_Num0_100_000USD,
+			},
+			orders: []clobtypes.Order{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO,
+				constants.ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49995_OCO,
+			},
+			priceUpdateForFirstBlock: &prices.MsgUpdateMarketPrices{},
+			priceUpdateForSecondBlock: &prices.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*prices.MsgUpdateMarketPrices_MarketPrice{
+					prices.NewMarketPriceUpdate(0, 5_000_300_000),
+				},
+			},
+
+			expectedExistInState: map[clobtypes.OrderId]bool{
+				constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO.OrderId: true,
+				constants.ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49995_OCO.OrderId: false,
+			},
+			expectedInTriggeredStateAfterBlock: map[uint32]map[clobtypes.OrderId]bool{
+				2: {
+					constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO.OrderId: false,
+					constants.ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49995_OCO.OrderId: false,
+				},
+				3: {
+					constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TP_50001_OCO.OrderId: true,
+					constants.ConditionalOrder_Bob_Num0_Id1_Clob0_Sell1BTC_Price50000_GTBT10_SL_49995_OCO.OrderId: false,
+				},
+			},
+		},
 		"TakeProfit/Buy conditional order is placed, triggered, and partially matched": {
 			subaccounts: []satypes.Subaccount{
 				constants.Alice_Num0_100_000USD,
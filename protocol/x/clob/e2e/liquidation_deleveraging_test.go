@@ -12,6 +12,7 @@ import (
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobflags "github.com/dydxprotocol/v4-chain/protocol/x/clob/flags"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	feetiertypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
@@ -31,10 +32,11 @@ func TestLiquidationConfig(t *testing.T) {
 		liquidatableSubaccountIds []satypes.SubaccountId
 
 		// Configuration.
-		liquidationConfig clobtypes.LiquidationsConfig
-		liquidityTiers    []perptypes.LiquidityTier
-		perpetuals        []perptypes.Perpetual
-		clobPairs         []clobtypes.ClobPair
+		liquidationConfig              clobtypes.LiquidationsConfig
+		liquidityTiers                 []perptypes.LiquidityTier
+		perpetuals                     []perptypes.Perpetual
+		clobPairs                      []clobtypes.ClobPair
+		maxLiquidationAttemptsPerBlock uint32
 
 		// Expectations.
 		expectedSubaccounts []satypes.Subaccount
@@ -89,6 +91,75 @@ func TestLiquidationConfig(t *testing.T) {
 				},
 			},
 		},
+		`Liquidating short counts collateral assets toward net collateral`: {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(50_500_000_000), // $50,500
+						},
+						{
+							AssetId:  constants.BtcUsd_80PercentCollateralWeight.Id,
+							Quantums: dtypes.NewInt(10_000_000), // 0.1 BTC, $4,000 of collateral
+						},
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(-100_000_000), // -1 BTC
+						},
+					},
+				},
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+
+			placedMatchableOrders: []clobtypes.MatchableOrder{
+				&constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10, // Order at $50,000
+			},
+			liquidatableSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+			liquidationConfig: clobtypes.LiquidationsConfig{
+				MaxLiquidationFeePpm: 5_000,
+				FillablePriceConfig:  constants.FillablePriceConfig_Default,
+				PositionBlockLimits: clobtypes.PositionBlockLimits{
+					MinPositionNotionalLiquidated:   100_000_000_000, // $100,000
+					MaxPositionPortionLiquidatedPpm: 10_000,          // 1%
+				},
+				SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
+			},
+
+			liquidityTiers: constants.LiquidityTiers,
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			clobPairs: []clobtypes.ClobPair{constants.ClobPair_Btc},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(50_500_000_000 - 50_000_000_000 - 250_000_000),
+						},
+						{
+							AssetId:  constants.BtcUsd_80PercentCollateralWeight.Id,
+							Quantums: dtypes.NewInt(10_000_000),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(100_000_000_000), // $100,000
+						},
+					},
+				},
+			},
+		},
 		`Liquidatiing long respects position block limit - MinPositionNotionalLiquidated`: {
 			subaccounts: []satypes.Subaccount{
 				constants.Carl_Num0_1BTC_Short_100000USD,
@@ -536,17 +607,229 @@ func TestLiquidationConfig(t *testing.T) {
 				},
 			},
 		},
+		`Liquidating multiple positions of a subaccount in the same block`: {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(53_000_000_000), // $53,000
+						},
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(-100_000_000), // -1 BTC
+						},
+						{
+							PerpetualId: 1,
+							Quantums:    dtypes.NewInt(-1_000_000_000), // -1 ETH
+						},
+					},
+				},
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+
+			placedMatchableOrders: []clobtypes.MatchableOrder{
+				&constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10, // Order at $50,000
+				&constants.Order_Dave_Num0_Id3_Clob1_Sell1ETH_Price3000,        // Order at $3,000
+			},
+			liquidatableSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+			liquidationConfig:         constants.LiquidationsConfig_No_Limit,
+
+			liquidityTiers: constants.LiquidityTiers,
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			},
+			clobPairs: []clobtypes.ClobPair{constants.ClobPair_Btc, constants.ClobPair_Eth},
+
+			// The subaccount is bankrupt, so both positions are closed at the oracle price without any fees.
+			// The BTC position is liquidated first since it has the larger maintenance margin requirement.
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(50_000_000_000 + 50_000_000_000 + 3_000_000_000),
+						},
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId:  1,
+							Quantums:     dtypes.NewInt(-1_000_000_000), // -1 ETH
+							FundingIndex: dtypes.NewInt(0),
+						},
+					},
+				},
+			},
+		},
+		`Liquidating multiple positions respects subaccount block limit - MaxPerpetualPositionsLiquidated`: {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(53_000_000_000), // $53,000
+						},
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(-100_000_000), // -1 BTC
+						},
+						{
+							PerpetualId: 1,
+							Quantums:    dtypes.NewInt(-1_000_000_000), // -1 ETH
+						},
+					},
+				},
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+
+			placedMatchableOrders: []clobtypes.MatchableOrder{
+				&constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10, // Order at $50,000
+				&constants.Order_Dave_Num0_Id3_Clob1_Sell1ETH_Price3000,        // Order at $3,000
+			},
+			liquidatableSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+			liquidationConfig: clobtypes.LiquidationsConfig{
+				MaxLiquidationFeePpm: 5_000,
+				FillablePriceConfig:  constants.FillablePriceConfig_Default,
+				PositionBlockLimits:  constants.PositionBlockLimits_No_Limit,
+				SubaccountBlockLimits: clobtypes.SubaccountBlockLimits{
+					MaxNotionalLiquidated:           100_000_000_000_000,
+					MaxQuantumsInsuranceLost:        100_000_000_000_000,
+					MaxPerpetualPositionsLiquidated: 1,
+				},
+			},
+
+			liquidityTiers: constants.LiquidityTiers,
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			},
+			clobPairs: []clobtypes.ClobPair{constants.ClobPair_Btc, constants.ClobPair_Eth},
+
+			// Only the BTC position is liquidated in this block.
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(3_000_000_000), // $3,000
+						},
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId:  1,
+							Quantums:     dtypes.NewInt(-1_000_000_000), // -1 ETH
+							FundingIndex: dtypes.NewInt(0),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(100_000_000_000), // $100,000
+						},
+					},
+				},
+			},
+		},
+		`Liquidating multiple positions respects MaxLiquidationAttemptsPerBlock`: {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(53_000_000_000), // $53,000
+						},
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(-100_000_000), // -1 BTC
+						},
+						{
+							PerpetualId: 1,
+							Quantums:    dtypes.NewInt(-1_000_000_000), // -1 ETH
+						},
+					},
+				},
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+
+			placedMatchableOrders: []clobtypes.MatchableOrder{
+				&constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10, // Order at $50,000
+				&constants.Order_Dave_Num0_Id3_Clob1_Sell1ETH_Price3000,        // Order at $3,000
+			},
+			liquidatableSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+			liquidationConfig: clobtypes.LiquidationsConfig{
+				MaxLiquidationFeePpm:  5_000,
+				FillablePriceConfig:   constants.FillablePriceConfig_Default,
+				PositionBlockLimits:   constants.PositionBlockLimits_No_Limit,
+				SubaccountBlockLimits: constants.SubaccountBlockLimits_No_Limit,
+			},
+			maxLiquidationAttemptsPerBlock: 1,
+
+			liquidityTiers: constants.LiquidityTiers,
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			},
+			clobPairs: []clobtypes.ClobPair{constants.ClobPair_Btc, constants.ClobPair_Eth},
+
+			// Only the BTC position is liquidated in this block.
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(3_000_000_000), // $3,000
+						},
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId:  1,
+							Quantums:     dtypes.NewInt(-1_000_000_000), // -1 ETH
+							FundingIndex: dtypes.NewInt(0),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(100_000_000_000), // $100,000
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+			builder := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
 				genesis = testapp.DefaultGenesis()
 				testapp.UpdateGenesisDocWithAppStateForModule(
 					&genesis,
 					func(genesisState *assettypes.GenesisState) {
 						genesisState.Assets = []assettypes.Asset{
 							*constants.Usdc,
+							*constants.BtcUsd_80PercentCollateralWeight,
 						}
 					},
 				)
@@ -604,7 +887,13 @@ func TestLiquidationConfig(t *testing.T) {
 					},
 				)
 				return genesis
-			}).Build()
+			})
+			if tc.maxLiquidationAttemptsPerBlock != 0 {
+				builder = builder.WithAppOptions(map[string]interface{}{
+					clobflags.MaxLiquidationAttemptsPerBlock: tc.maxLiquidationAttemptsPerBlock,
+				})
+			}
+			tApp := builder.Build()
 
 			ctx := tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
 
@@ -1267,3 +1556,414 @@ func TestPlacePerpetualLiquidation_Deleveraging(t *testing.T) {
 		})
 	}
 }
+
+func TestLiquidateSubaccounts_AssetDeleveraging(t *testing.T) {
+	// Borrow interest is disabled so that balances are not affected by funding-tick epochs.
+	btcBorrowable := *constants.BtcUsd_Borrowable
+	btcBorrowable.BorrowRatePpm = 0
+
+	tests := map[string]struct {
+		// State.
+		subaccounts []satypes.Subaccount
+
+		// Parameters.
+		liquidatableSubaccountIds []satypes.SubaccountId
+
+		// Expectations.
+		expectedSubaccounts []satypes.Subaccount
+	}{
+		`Liquidatable subaccount with TNC > 0 repays its borrowing at the oracle price`: {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(54_000_000_000),
+						},
+						&constants.Short_Asset_1BTC,
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Long_Asset_1BTC,
+					},
+				},
+			},
+			liquidatableSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(54_000_000_000 - 50_000_000_000),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(50_000_000_000),
+						},
+					},
+				},
+			},
+		},
+		`Liquidatable subaccount with TNC < 0 repays its borrowing at the bankruptcy price`: {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(49_000_000_000),
+						},
+						&constants.Short_Asset_1BTC,
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Long_Asset_1BTC,
+					},
+				},
+			},
+			liquidatableSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(49_000_000_000),
+						},
+					},
+				},
+			},
+		},
+		`Well-collateralized borrowing is not deleveraged`: {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(60_000_000_000),
+						},
+						&constants.Short_Asset_1BTC,
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Long_Asset_1BTC,
+					},
+				},
+			},
+			liquidatableSubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(60_000_000_000),
+						},
+						&constants.Short_Asset_1BTC,
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Long_Asset_1BTC,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *assettypes.GenesisState) {
+						genesisState.Assets = []assettypes.Asset{
+							*constants.Usdc,
+							btcBorrowable,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *prices.GenesisState) {
+						*genesisState = constants.TestPricesGenesisState
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.Params = constants.PerpetualsGenesisParams
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{
+							constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = tc.subaccounts
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{constants.ClobPair_Btc}
+						genesisState.LiquidationsConfig = constants.LiquidationsConfig_FillablePrice_Max_Smmr
+						genesisState.EquityTierLimitConfig = clobtypes.EquityTierLimitConfiguration{}
+					},
+				)
+				return genesis
+			}).Build()
+
+			ctx := tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+			_, err := tApp.App.Server.LiquidateSubaccounts(ctx, &api.LiquidateSubaccountsRequest{
+				SubaccountIds: tc.liquidatableSubaccountIds,
+			})
+			require.NoError(t, err)
+
+			// Verify test expectations.
+			ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+			for _, expectedSubaccount := range tc.expectedSubaccounts {
+				require.Equal(
+					t,
+					expectedSubaccount,
+					tApp.App.SubaccountsKeeper.GetSubaccount(ctx, *expectedSubaccount.Id),
+				)
+			}
+		})
+	}
+}
+
+func TestLiquidateSubaccounts_AssetLiquidation(t *testing.T) {
+	// Borrow interest is disabled so that balances are not affected by funding-tick epochs.
+	btcBorrowable := *constants.BtcUsd_Borrowable
+	btcBorrowable.BorrowRatePpm = 0
+
+	placeOrderDaveSell := func(quantums uint64) clobtypes.MsgPlaceOrder {
+		return *clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+			OrderId: clobtypes.OrderId{
+				SubaccountId: constants.Dave_Num0,
+				ClientId:     0,
+				ClobPairId:   constants.ClobPair_Spot_BtcUsdc.Id,
+			},
+			Side:         clobtypes.Order_SIDE_SELL,
+			Quantums:     quantums,
+			Subticks:     50_000_000_000,
+			GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+		})
+	}
+
+	// Carl has a TNC of $4,000 and a maintenance margin requirement of $5,000.
+	carlSubaccount := satypes.Subaccount{
+		Id: &constants.Carl_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			{
+				AssetId:  0,
+				Quantums: dtypes.NewInt(54_000_000_000),
+			},
+			&constants.Short_Asset_1BTC,
+		},
+	}
+	daveSubaccount := satypes.Subaccount{
+		Id: &constants.Dave_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&constants.Long_Asset_1BTC,
+		},
+	}
+
+	tests := map[string]struct {
+		// Orders placed before liquidating.
+		orders []clobtypes.MsgPlaceOrder
+
+		// Expectations.
+		expectedSubaccounts []satypes.Subaccount
+	}{
+		`Borrowing is repaid by buying the asset on the spot orderbook`: {
+			orders: []clobtypes.MsgPlaceOrder{placeOrderDaveSell(100_000_000)},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId: 0,
+							// $50,000 for 1 BTC and a $250 liquidation fee.
+							Quantums: dtypes.NewInt(54_000_000_000 - 50_000_000_000 - 250_000_000),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId: 0,
+							// $50,000 for 1 BTC and a 200 ppm maker fee.
+							Quantums: dtypes.NewInt(50_000_000_000 - 10_000_000),
+						},
+					},
+				},
+			},
+		},
+		`Borrowing is partially repaid when the spot orderbook has insufficient liquidity`: {
+			orders: []clobtypes.MsgPlaceOrder{placeOrderDaveSell(50_000_000)},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId: 0,
+							// $25,000 for 0.5 BTC and a $125 liquidation fee.
+							Quantums: dtypes.NewInt(54_000_000_000 - 25_000_000_000 - 125_000_000),
+						},
+						{
+							AssetId:  btcBorrowable.Id,
+							Quantums: dtypes.NewInt(-50_000_000),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId: 0,
+							// $25,000 for 0.5 BTC and a 200 ppm maker fee.
+							Quantums: dtypes.NewInt(25_000_000_000 - 5_000_000),
+						},
+						{
+							AssetId:  btcBorrowable.Id,
+							Quantums: dtypes.NewInt(50_000_000),
+						},
+					},
+				},
+			},
+		},
+		`Borrowing is deleveraged when the spot orderbook is empty`: {
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(54_000_000_000 - 50_000_000_000),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(50_000_000_000),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *assettypes.GenesisState) {
+						genesisState.Assets = []assettypes.Asset{
+							*constants.Usdc,
+							btcBorrowable,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *prices.GenesisState) {
+						*genesisState = constants.TestPricesGenesisState
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.Params = constants.PerpetualsGenesisParams
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{
+							constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *feetiertypes.GenesisState) {
+						genesisState.Params = constants.PerpetualFeeParams
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{carlSubaccount, daveSubaccount}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{
+							constants.ClobPair_Btc,
+							constants.ClobPair_Spot_BtcUsdc,
+						}
+						genesisState.LiquidationsConfig = constants.LiquidationsConfig_FillablePrice_Max_Smmr
+						genesisState.EquityTierLimitConfig = clobtypes.EquityTierLimitConfiguration{}
+					},
+				)
+				return genesis
+			}).Build()
+
+			ctx := tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+			for _, order := range tc.orders {
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, order) {
+					resp := tApp.CheckTx(checkTx)
+					require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+				}
+			}
+
+			_, err := tApp.App.Server.LiquidateSubaccounts(ctx, &api.LiquidateSubaccountsRequest{
+				SubaccountIds: []satypes.SubaccountId{constants.Carl_Num0},
+			})
+			require.NoError(t, err)
+
+			// Verify test expectations.
+			ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+			for _, expectedSubaccount := range tc.expectedSubaccounts {
+				require.Equal(
+					t,
+					expectedSubaccount,
+					tApp.App.SubaccountsKeeper.GetSubaccount(ctx, *expectedSubaccount.Id),
+				)
+			}
+		})
+	}
+}
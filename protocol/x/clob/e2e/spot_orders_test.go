@@ -0,0 +1,258 @@
+package clob_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	feetierstypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	prices "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestPlaceOrder_Spot(t *testing.T) {
+	// Buy and sell 0.1 BTC at $50,000 on the spot BTC-USDC CLOB pair.
+	placeOrderAliceBuy := *clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: constants.Alice_Num0,
+			ClientId:     0,
+			ClobPairId:   constants.ClobPair_Spot_BtcUsdc.Id,
+		},
+		Side:         clobtypes.Order_SIDE_BUY,
+		Quantums:     10_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+	placeOrderBobSell := *clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: constants.Bob_Num0,
+			ClientId:     0,
+			ClobPairId:   constants.ClobPair_Spot_BtcUsdc.Id,
+		},
+		Side:         clobtypes.Order_SIDE_SELL,
+		Quantums:     10_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+	placeOrderBobSellHalfBTC := *clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: constants.Bob_Num0,
+			ClientId:     1,
+			ClobPairId:   constants.ClobPair_Spot_BtcUsdc.Id,
+		},
+		Side:         clobtypes.Order_SIDE_SELL,
+		Quantums:     50_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+	placeOrderBobSell2BTC := *clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: constants.Bob_Num0,
+			ClientId:     2,
+			ClobPairId:   constants.ClobPair_Spot_BtcUsdc.Id,
+		},
+		Side:         clobtypes.Order_SIDE_SELL,
+		Quantums:     200_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 20},
+	})
+
+	aliceSubaccount := satypes.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			{
+				AssetId:  assettypes.AssetUsdc.Id,
+				Quantums: dtypes.NewInt(10_000_000_000), // $10,000
+			},
+		},
+	}
+	bobSubaccount := satypes.Subaccount{
+		Id: &constants.Bob_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&constants.Long_Asset_1BTC,
+		},
+	}
+
+	tests := map[string]struct {
+		// Orders, placed in order.
+		orders []clobtypes.MsgPlaceOrder
+
+		// Expectations.
+		expectedRestingOrders  []clobtypes.OrderId
+		expectedSubaccounts    []satypes.Subaccount
+		expectedOrderFillEvent *indexerevents.OrderFillEventV1
+	}{
+		"Spot orders are matched and settled by moving asset positions": {
+			orders: []clobtypes.MsgPlaceOrder{placeOrderBobSell, placeOrderAliceBuy},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId: assettypes.AssetUsdc.Id,
+							// $5,000 for 0.1 BTC and a 500 ppm taker fee.
+							Quantums: dtypes.NewInt(10_000_000_000 - 5_000_000_000 - 2_500_000),
+						},
+						{
+							AssetId:  constants.BtcUsd.Id,
+							Quantums: dtypes.NewInt(10_000_000),
+						},
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId: assettypes.AssetUsdc.Id,
+							// $5,000 for 0.1 BTC and a 200 ppm maker fee.
+							Quantums: dtypes.NewInt(5_000_000_000 - 1_000_000),
+						},
+						{
+							AssetId:  constants.BtcUsd.Id,
+							Quantums: dtypes.NewInt(90_000_000),
+						},
+					},
+				},
+			},
+			expectedOrderFillEvent: indexerevents.NewOrderFillEvent(
+				placeOrderBobSell.Order,
+				placeOrderAliceBuy.Order,
+				placeOrderAliceBuy.Order.GetBaseQuantums(),
+				1_000_000,
+				2_500_000,
+				placeOrderAliceBuy.Order.GetBaseQuantums(),
+				placeOrderAliceBuy.Order.GetBaseQuantums(),
+			),
+		},
+		"Selling less of the base asset than the subaccount holds passes the collateral check": {
+			orders: []clobtypes.MsgPlaceOrder{placeOrderBobSellHalfBTC},
+
+			expectedRestingOrders: []clobtypes.OrderId{placeOrderBobSellHalfBTC.Order.OrderId},
+			expectedSubaccounts:   []satypes.Subaccount{aliceSubaccount, bobSubaccount},
+		},
+		"Selling more of the base asset than the subaccount holds fails the collateral check": {
+			orders: []clobtypes.MsgPlaceOrder{placeOrderBobSell2BTC},
+
+			expectedSubaccounts: []satypes.Subaccount{aliceSubaccount, bobSubaccount},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			msgSender := msgsender.NewIndexerMessageSenderInMemoryCollector()
+			tApp := testapp.NewTestAppBuilder(t).WithAppOptions(map[string]interface{}{
+				indexer.MsgSenderInstanceForTest: msgSender,
+			}).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *assettypes.GenesisState) {
+						genesisState.Assets = []assettypes.Asset{
+							*constants.Usdc,
+							*constants.BtcUsd,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *prices.GenesisState) {
+						*genesisState = constants.TestPricesGenesisState
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.Params = constants.PerpetualsGenesisParams
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{
+							constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *feetierstypes.GenesisState) {
+						genesisState.Params = constants.PerpetualFeeParams
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{aliceSubaccount, bobSubaccount}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{
+							constants.ClobPair_Btc,
+							constants.ClobPair_Spot_BtcUsdc,
+						}
+						genesisState.EquityTierLimitConfig = clobtypes.EquityTierLimitConfiguration{}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+
+			for _, order := range tc.orders {
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, order) {
+					resp := tApp.CheckTx(checkTx)
+					require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+				}
+			}
+
+			// Clear the messages produced prior to advancing to the next block.
+			msgSender.Clear()
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+			// Orders that failed the collateral check or were fully filled are not resting on the book.
+			for _, order := range tc.orders {
+				_, exists := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, order.Order.OrderId)
+				require.Equal(t, slices.Contains(tc.expectedRestingOrders, order.Order.OrderId), exists)
+			}
+
+			for _, expectedSubaccount := range tc.expectedSubaccounts {
+				require.Equal(
+					t,
+					expectedSubaccount,
+					tApp.App.SubaccountsKeeper.GetSubaccount(ctx, *expectedSubaccount.Id),
+				)
+			}
+
+			// Verify the indexer received an order fill event for the spot match.
+			var orderFillEvents [][]byte
+			for _, message := range msgSender.GetOnchainMessages() {
+				var block indexer_manager.IndexerTendermintBlock
+				require.NoError(t, block.Unmarshal(message.Value))
+				for _, event := range block.Events {
+					if event.Subtype == indexerevents.SubtypeOrderFill {
+						orderFillEvents = append(orderFillEvents, event.DataBytes)
+					}
+				}
+			}
+			if tc.expectedOrderFillEvent == nil {
+				require.Empty(t, orderFillEvents)
+			} else {
+				require.Equal(
+					t,
+					[][]byte{indexer_manager.GetBytes(tc.expectedOrderFillEvent)},
+					orderFillEvents,
+				)
+			}
+		})
+	}
+}
@@ -15,6 +15,23 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 
 	// Create all `ClobPair` structs.
 	for _, elem := range genState.ClobPairs {
+		if spotClobMetadata := elem.GetSpotClobMetadata(); spotClobMetadata != nil {
+			_, err := k.CreateSpotClobPair(
+				ctx,
+				elem.Id,
+				spotClobMetadata.BaseAssetId,
+				spotClobMetadata.QuoteAssetId,
+				satypes.BaseQuantums(elem.StepBaseQuantums),
+				elem.QuantumConversionExponent,
+				elem.SubticksPerTick,
+				elem.Status,
+			)
+			if err != nil {
+				panic(err)
+			}
+			continue
+		}
+
 		perpetualId, err := elem.GetPerpetualId()
 		if err != nil {
 			panic(errorsmod.Wrap(types.ErrInvalidClobPairParameter, err.Error()))
@@ -207,7 +207,7 @@ func TestGenesis(t *testing.T) {
 			expectedErr:     "Asset orders are not implemented",
 			expectedErrType: types.ErrInvalidClobPairParameter,
 		},
-		"Genesis state is invalid when there is a spot metadata on a CLOB pair": {
+		"Genesis state is invalid when a spot CLOB pair is not quoted in USDC": {
 			genesis: types.GenesisState{
 				ClobPairs: []types.ClobPair{
 					{
@@ -244,7 +244,8 @@ func TestGenesis(t *testing.T) {
 					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
 				},
 			},
-			expectedErr:     "Asset orders are not implemented",
+			expectedErr: "CLOB pair (spot_clob_metadata:<quote_asset_id:1 > step_base_quantums:5 " +
+				"subticks_per_tick:5 status:STATUS_ACTIVE ) must have USDC as its quote asset.",
 			expectedErrType: types.ErrInvalidClobPairParameter,
 		},
 		"Genesis state is invalid when spread to maintenance margin ratio ppm is 0": {
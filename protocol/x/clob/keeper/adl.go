@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// perpetualPositionEntryKey returns the key of the entry notional of a subaccount's perpetual position in state.
+func perpetualPositionEntryKey(subaccountId satypes.SubaccountId, perpetualId uint32) []byte {
+	return append(lib.Uint32ToKey(perpetualId), subaccountId.ToStateKey()...)
+}
+
+// GetPerpetualPositionEntryQuoteQuantums returns the entry notional of a subaccount's perpetual position in
+// quote quantums, and whether it exists in state. The entry notional is the signed quote value at which the
+// current position was opened, and is positive for long positions and negative for short positions.
+func (k Keeper) GetPerpetualPositionEntryQuoteQuantums(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+) (
+	entryQuoteQuantums *big.Int,
+	exists bool,
+) {
+	store := k.getPerpetualPositionEntryStore(ctx)
+	b := store.Get(perpetualPositionEntryKey(subaccountId, perpetualId))
+	if b == nil {
+		return new(big.Int), false
+	}
+
+	var entry dtypes.SerializableInt
+	if err := entry.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return entry.BigInt(), true
+}
+
+// SetPerpetualPositionEntryQuoteQuantums sets the entry notional of a subaccount's perpetual position in state.
+func (k Keeper) SetPerpetualPositionEntryQuoteQuantums(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	entryQuoteQuantums *big.Int,
+) {
+	b, err := dtypes.NewIntFromBigInt(entryQuoteQuantums).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store := k.getPerpetualPositionEntryStore(ctx)
+	store.Set(perpetualPositionEntryKey(subaccountId, perpetualId), b)
+}
+
+// updatePerpetualPositionEntry updates the entry notional of a subaccount's perpetual position after the
+// position changed by `deltaQuantums` for a quote value of `deltaQuoteQuantums`. The quote value is positive
+// when buying and negative when selling. This function must be called after the position update has been
+// written to state.
+//
+// The entry notional is updated as follows:
+// - If the position was opened or increased, the quote value is added to the entry notional.
+// - If the position was reduced, the entry notional is scaled down such that the entry price is unchanged.
+// - If the position flipped sides, the new position is entered at the price of the update.
+// - If the position was closed, the entry notional is removed from state.
+// Positions without a tracked entry notional remain untracked until they are closed or flip sides. Entry
+// notionals of positions that existed before they were tracked are initialized by
+// `InitializePerpetualPositionEntries`.
+func (k Keeper) updatePerpetualPositionEntry(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	deltaQuantums *big.Int,
+	deltaQuoteQuantums *big.Int,
+) {
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists {
+		store := k.getPerpetualPositionEntryStore(ctx)
+		store.Delete(perpetualPositionEntryKey(subaccountId, perpetualId))
+		return
+	}
+
+	newQuantums := position.GetBigQuantums()
+	oldQuantums := new(big.Int).Sub(newQuantums, deltaQuantums)
+
+	entryQuoteQuantums, found := k.GetPerpetualPositionEntryQuoteQuantums(ctx, subaccountId, perpetualId)
+	if !found && oldQuantums.Sign() == newQuantums.Sign() {
+		// The position was opened before its entry notional was tracked, and its entry price is unknown.
+		// Leave it untracked until it is closed or flips sides.
+		return
+	}
+
+	switch {
+	case oldQuantums.Sign() == 0 || oldQuantums.Sign() == deltaQuantums.Sign():
+		entryQuoteQuantums = new(big.Int).Add(entryQuoteQuantums, deltaQuoteQuantums)
+	case oldQuantums.Sign() == newQuantums.Sign():
+		entryQuoteQuantums = new(big.Int).Quo(
+			new(big.Int).Mul(entryQuoteQuantums, newQuantums),
+			oldQuantums,
+		)
+	default:
+		entryQuoteQuantums = new(big.Int).Quo(
+			new(big.Int).Mul(deltaQuoteQuantums, newQuantums),
+			deltaQuantums,
+		)
+	}
+
+	k.SetPerpetualPositionEntryQuoteQuantums(ctx, subaccountId, perpetualId, entryQuoteQuantums)
+}
+
+// GetPerpetualPositionUnrealizedPnl returns the unrealized PnL of a perpetual position with size
+// `bigQuantums` in quote quantums, measured against the oracle price, along with the position's net notional.
+// Positions without a tracked entry notional have an unrealized PnL of zero.
+func (k Keeper) GetPerpetualPositionUnrealizedPnl(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	bigQuantums *big.Int,
+) (
+	bigUnrealizedPnl *big.Int,
+	bigNetNotional *big.Int,
+	err error,
+) {
+	bigNetNotional, err = k.perpetualsKeeper.GetNetNotional(ctx, perpetualId, bigQuantums)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entryQuoteQuantums, exists := k.GetPerpetualPositionEntryQuoteQuantums(ctx, subaccountId, perpetualId)
+	if !exists {
+		return new(big.Int), bigNetNotional, nil
+	}
+	return new(big.Int).Sub(bigNetNotional, entryQuoteQuantums), bigNetNotional, nil
+}
+
+// InitializePerpetualPositionEntries sets the entry notional of every open perpetual position without a
+// tracked entry notional to the position's net notional at the current oracle price. This is used to track
+// the positions that were opened before entry notionals were tracked.
+func (k Keeper) InitializePerpetualPositionEntries(ctx sdk.Context) {
+	for _, subaccount := range k.subaccountsKeeper.GetAllSubaccount(ctx) {
+		for _, position := range subaccount.PerpetualPositions {
+			if _, exists := k.GetPerpetualPositionEntryQuoteQuantums(
+				ctx,
+				*subaccount.Id,
+				position.PerpetualId,
+			); exists {
+				continue
+			}
+
+			bigNetNotional, err := k.perpetualsKeeper.GetNetNotional(
+				ctx,
+				position.PerpetualId,
+				position.GetBigQuantums(),
+			)
+			if err != nil {
+				panic(err)
+			}
+			k.SetPerpetualPositionEntryQuoteQuantums(ctx, *subaccount.Id, position.PerpetualId, bigNetNotional)
+		}
+	}
+}
+
+// adlCandidate is a subaccount with a position that may be auto-deleveraged, along with its ranking score.
+type adlCandidate struct {
+	subaccountId          satypes.SubaccountId
+	hasPositiveCollateral bool
+	score                 *big.Rat
+}
+
+// GetAdlRankedSubaccountIds returns the IDs of all subaccounts with an open position on the given side of a
+// perpetual, in the order in which they should be used to offset deleveraged positions.
+//
+// Positions are ranked in descending order of unrealized PnL multiplied by effective leverage, where
+// effective leverage is the absolute net notional of the position divided by the subaccount's net
+// collateral. Subaccounts with non-positive net collateral are ranked last, and ties are broken by
+// ascending subaccount ID. The ranking depends on oracle prices and net collateral, which change every
+// block, so every position on the given side is scored whenever the ranking is requested. This is only
+// done when offsetting positions in the memclob and when querying ranks, and never while delivering
+// transactions.
+func (k Keeper) GetAdlRankedSubaccountIds(
+	ctx sdk.Context,
+	perpetualId uint32,
+	isLong bool,
+) []satypes.SubaccountId {
+	candidates := make([]adlCandidate, 0)
+	k.subaccountsKeeper.ForEachSubaccountIdWithPerpetualPosition(
+		ctx,
+		perpetualId,
+		isLong,
+		func(subaccountId satypes.SubaccountId) (finished bool) {
+			candidates = append(candidates, k.getAdlCandidate(ctx, subaccountId, perpetualId))
+			return false
+		},
+	)
+
+	sort.Slice(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if ci.hasPositiveCollateral != cj.hasPositiveCollateral {
+			return ci.hasPositiveCollateral
+		}
+		if ci.hasPositiveCollateral {
+			if cmp := ci.score.Cmp(cj.score); cmp != 0 {
+				return cmp > 0
+			}
+		}
+		return satypes.SortedSubaccountIds{ci.subaccountId, cj.subaccountId}.Less(0, 1)
+	})
+
+	subaccountIds := make([]satypes.SubaccountId, len(candidates))
+	for i, candidate := range candidates {
+		subaccountIds[i] = candidate.subaccountId
+	}
+	return subaccountIds
+}
+
+// getAdlCandidate returns the ranking score of a subaccount's position in a perpetual for auto-deleveraging.
+func (k Keeper) getAdlCandidate(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+) adlCandidate {
+	candidate := adlCandidate{subaccountId: subaccountId}
+
+	bigNetCollateral, _, _, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{SubaccountId: subaccountId},
+	)
+	if err != nil || bigNetCollateral.Sign() <= 0 {
+		return candidate
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists {
+		return candidate
+	}
+
+	bigUnrealizedPnl, bigNetNotional, err := k.GetPerpetualPositionUnrealizedPnl(
+		ctx,
+		subaccountId,
+		perpetualId,
+		position.GetBigQuantums(),
+	)
+	if err != nil {
+		return candidate
+	}
+
+	candidate.hasPositiveCollateral = true
+	candidate.score = new(big.Rat).SetFrac(
+		new(big.Int).Mul(bigUnrealizedPnl, new(big.Int).Abs(bigNetNotional)),
+		bigNetCollateral,
+	)
+	return candidate
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newAdlTestContext returns a clob keepers test context with the test markets and the BTC and ETH
+// perpetuals with 100% margin requirements.
+func newAdlTestContext(t *testing.T) keepertest.ClobKeepersTestContext {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On("AddTxnEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+
+	keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+	require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_100PercentMarginRequirement,
+		constants.EthUsd_100PercentMarginRequirement,
+	} {
+		_, err := ks.PerpetualsKeeper.CreatePerpetual(
+			ks.Ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.OpenInterestCapNotional,
+			p.Params.FundingRateBounds,
+			p.Params.InterestRatePpm,
+			p.Params.FundingTickEpoch,
+			p.Params.FundingSampleEpoch,
+		)
+		require.NoError(t, err)
+	}
+	return ks
+}
+
+// newOneBtcLongSubaccount returns a subaccount with a 1 BTC long position and the given USDC balance.
+func newOneBtcLongSubaccount(id satypes.SubaccountId, usdcQuantums int64) satypes.Subaccount {
+	return satypes.Subaccount{
+		Id: &id,
+		AssetPositions: []*satypes.AssetPosition{
+			{
+				AssetId:  0,
+				Quantums: dtypes.NewInt(usdcQuantums),
+			},
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			{
+				PerpetualId: 0,
+				Quantums:    dtypes.NewInt(100_000_000), // 1 BTC
+			},
+		},
+	}
+}
+
+func TestGetAdlRankedSubaccountIds(t *testing.T) {
+	ks := newAdlTestContext(t)
+
+	for _, subaccount := range []satypes.Subaccount{
+		// Net collateral of $10,000 and unrealized PnL of $2,000, score = 2,000 * 50,000 / 10,000 = 10,000.
+		newOneBtcLongSubaccount(constants.Alice_Num0, -40_000_000_000),
+		// Net collateral of $100,000 and unrealized PnL of $10,000, score = 10,000 * 50,000 / 100,000 = 5,000.
+		newOneBtcLongSubaccount(constants.Dave_Num0, 50_000_000_000),
+		// No tracked entry notional, score = 0.
+		newOneBtcLongSubaccount(constants.Dave_Num1, 50_000_000_000),
+		// Net collateral of $100,000 and unrealized PnL of -$10,000, score = -10,000 * 50,000 / 100,000 = -5,000.
+		newOneBtcLongSubaccount(constants.Bob_Num0, 50_000_000_000),
+		// Negative net collateral, ranked last despite its unrealized PnL.
+		newOneBtcLongSubaccount(constants.Carl_Num1, -50_001_000_000),
+		constants.Carl_Num0_1BTC_Short_54999USD,
+	} {
+		ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+	}
+
+	for subaccountId, entry := range map[satypes.SubaccountId]int64{
+		constants.Alice_Num0: 48_000_000_000,
+		constants.Dave_Num0:  40_000_000_000,
+		constants.Bob_Num0:   60_000_000_000,
+		constants.Carl_Num1:  1,
+	} {
+		ks.ClobKeeper.SetPerpetualPositionEntryQuoteQuantums(ks.Ctx, subaccountId, 0, big.NewInt(entry))
+	}
+
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{
+			constants.Alice_Num0,
+			constants.Dave_Num0,
+			constants.Dave_Num1,
+			constants.Bob_Num0,
+			constants.Carl_Num1,
+		},
+		ks.ClobKeeper.GetAdlRankedSubaccountIds(ks.Ctx, 0, true),
+	)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Carl_Num0},
+		ks.ClobKeeper.GetAdlRankedSubaccountIds(ks.Ctx, 0, false),
+	)
+	require.Empty(t, ks.ClobKeeper.GetAdlRankedSubaccountIds(ks.Ctx, 1, false))
+}
+
+func TestInitializePerpetualPositionEntries(t *testing.T) {
+	ks := newAdlTestContext(t)
+
+	ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, constants.Carl_Num0_1BTC_Short_54999USD)
+	ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, newOneBtcLongSubaccount(constants.Dave_Num0, 50_000_000_000))
+	ks.ClobKeeper.SetPerpetualPositionEntryQuoteQuantums(
+		ks.Ctx,
+		constants.Dave_Num0,
+		0,
+		big.NewInt(40_000_000_000),
+	)
+
+	ks.ClobKeeper.InitializePerpetualPositionEntries(ks.Ctx)
+
+	// Carl's untracked position is entered at the oracle price of $50,000 per BTC, and Dave's tracked
+	// position is unchanged.
+	entry, exists := ks.ClobKeeper.GetPerpetualPositionEntryQuoteQuantums(ks.Ctx, constants.Carl_Num0, 0)
+	require.True(t, exists)
+	require.Equal(t, big.NewInt(-50_000_000_000), entry)
+	entry, exists = ks.ClobKeeper.GetPerpetualPositionEntryQuoteQuantums(ks.Ctx, constants.Dave_Num0, 0)
+	require.True(t, exists)
+	require.Equal(t, big.NewInt(40_000_000_000), entry)
+	_, exists = ks.ClobKeeper.GetPerpetualPositionEntryQuoteQuantums(ks.Ctx, constants.Dave_Num0, 1)
+	require.False(t, exists)
+}
+
+func TestProcessDeleveraging_UpdatesPerpetualPositionEntries(t *testing.T) {
+	ks := newAdlTestContext(t)
+
+	ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, constants.Carl_Num0_1BTC_Short_54999USD)
+	ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, newOneBtcLongSubaccount(constants.Dave_Num0, 50_000_000_000))
+	ks.ClobKeeper.SetPerpetualPositionEntryQuoteQuantums(
+		ks.Ctx,
+		constants.Dave_Num0,
+		0,
+		big.NewInt(40_000_000_000),
+	)
+
+	// Deleverage half of the position. Both positions are reduced, so their entry prices are unchanged.
+	// Carl's position has no tracked entry notional, so it remains untracked.
+	require.NoError(
+		t,
+		ks.ClobKeeper.ProcessDeleveraging(ks.Ctx, constants.Carl_Num0, constants.Dave_Num0, 0, big.NewInt(50_000_000)),
+	)
+	_, exists := ks.ClobKeeper.GetPerpetualPositionEntryQuoteQuantums(ks.Ctx, constants.Carl_Num0, 0)
+	require.False(t, exists)
+	entry, exists := ks.ClobKeeper.GetPerpetualPositionEntryQuoteQuantums(ks.Ctx, constants.Dave_Num0, 0)
+	require.True(t, exists)
+	require.Equal(t, big.NewInt(20_000_000_000), entry)
+
+	pnl, netNotional, err := ks.ClobKeeper.GetPerpetualPositionUnrealizedPnl(
+		ks.Ctx,
+		constants.Dave_Num0,
+		0,
+		big.NewInt(50_000_000),
+	)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5_000_000_000), pnl)
+	require.Equal(t, big.NewInt(25_000_000_000), netNotional)
+
+	// Deleverage the rest of the position. Both positions are closed, so their entry notionals are removed.
+	require.NoError(
+		t,
+		ks.ClobKeeper.ProcessDeleveraging(ks.Ctx, constants.Carl_Num0, constants.Dave_Num0, 0, big.NewInt(50_000_000)),
+	)
+	_, exists = ks.ClobKeeper.GetPerpetualPositionEntryQuoteQuantums(ks.Ctx, constants.Carl_Num0, 0)
+	require.False(t, exists)
+	_, exists = ks.ClobKeeper.GetPerpetualPositionEntryQuoteQuantums(ks.Ctx, constants.Dave_Num0, 0)
+	require.False(t, exists)
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// BatchCancelShortTermOrders cancels each Short-Term order of a `MsgBatchCancel` in the in-memory
+// orderbook. The cancellations are best-effort, a failure to cancel one order does not prevent the
+// other orders of the batch from being canceled. This method is meant to be used in the CheckTx flow.
+//
+// The IDs of the canceled orders and of the orders that failed to be canceled are returned. An error is
+// returned if none of the orders could be canceled.
+func (k Keeper) BatchCancelShortTermOrders(
+	ctx sdk.Context,
+	msg *types.MsgBatchCancel,
+) (
+	canceledOrderIds []types.OrderId,
+	failedOrderIds []types.OrderId,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.BatchCancel, metrics.Latency)
+
+	canceledOrderIds = make([]types.OrderId, 0)
+	failedOrderIds = make([]types.OrderId, 0)
+	for _, msgCancelOrder := range msg.GetCancelOrderMsgs() {
+		msgCancelOrder.OrderId.MustBeShortTermOrder()
+		if err := k.CancelShortTermOrder(ctx, msgCancelOrder); err != nil {
+			k.Logger(ctx).Debug(
+				"BatchCancelShortTermOrders: failed to cancel order",
+				"orderId", msgCancelOrder.OrderId,
+				"error", err,
+			)
+			failedOrderIds = append(failedOrderIds, msgCancelOrder.OrderId)
+			continue
+		}
+		canceledOrderIds = append(canceledOrderIds, msgCancelOrder.OrderId)
+	}
+
+	if len(canceledOrderIds) == 0 {
+		return canceledOrderIds, failedOrderIds, errorsmod.Wrapf(
+			types.ErrBatchCancelFailed,
+			"failed to cancel orders %+v",
+			failedOrderIds,
+		)
+	}
+	return canceledOrderIds, failedOrderIds, nil
+}
+
+// BatchCancelStatefulOrders cancels each stateful order of a `MsgBatchCancel` by calling `CancelStatefulOrder`
+// in a branched context, which is only written if the cancellation succeeds. Cancellations are written to
+// uncommitted state during CheckTx and the orders are removed from state during DeliverTx.
+//
+// The IDs of the canceled orders and of the orders that failed to be canceled are returned. An error is
+// returned if none of the orders could be canceled.
+func (k Keeper) BatchCancelStatefulOrders(
+	ctx sdk.Context,
+	msg *types.MsgBatchCancel,
+) (
+	canceledOrderIds []types.OrderId,
+	failedOrderIds []types.OrderId,
+	err error,
+) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.BatchCancel, metrics.Latency)
+
+	canceledOrderIds = make([]types.OrderId, 0)
+	failedOrderIds = make([]types.OrderId, 0)
+	for _, msgCancelOrder := range msg.GetCancelOrderMsgs() {
+		cancelOrderCtx, writeCache := ctx.CacheContext()
+		if err := k.CancelStatefulOrder(cancelOrderCtx, msgCancelOrder); err != nil {
+			k.Logger(ctx).Debug(
+				"BatchCancelStatefulOrders: failed to cancel order",
+				"orderId", msgCancelOrder.OrderId,
+				"error", err,
+				"txMode", lib.TxMode(ctx),
+			)
+			failedOrderIds = append(failedOrderIds, msgCancelOrder.OrderId)
+			continue
+		}
+		writeCache()
+		canceledOrderIds = append(canceledOrderIds, msgCancelOrder.OrderId)
+	}
+
+	if len(canceledOrderIds) == 0 {
+		return canceledOrderIds, failedOrderIds, errorsmod.Wrapf(
+			types.ErrBatchCancelFailed,
+			"failed to cancel orders %+v",
+			failedOrderIds,
+		)
+	}
+	return canceledOrderIds, failedOrderIds, nil
+}
+
+// CancelAllShortTermOrders cancels all Short-Term orders of the subaccount of a `MsgCancelAllOrders` that
+// rest on the in-memory orderbook and are within the scope of the message. Each order is canceled until
+// the later of its own `GoodTilBlock` and the `GoodTilBlock` of the message, such that the cancellation
+// removes the order from the book. This method is meant to be used in the CheckTx flow.
+//
+// An error is returned if the `GoodTilBlock` of the message is invalid.
+func (k Keeper) CancelAllShortTermOrders(
+	ctx sdk.Context,
+	msg *types.MsgCancelAllOrders,
+) (canceledOrderIds []types.OrderId, err error) {
+	lib.AssertCheckTxMode(ctx)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.CancelAllOrders, metrics.Latency)
+
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+	if err := k.validateGoodTilBlock(msg.GoodTilBlock, nextBlockHeight); err != nil {
+		return nil, err
+	}
+
+	canceledOrderIds = make([]types.OrderId, 0)
+	for _, order := range k.MemClob.GetSubaccountOpenOrders(ctx, msg.SubaccountId) {
+		if !order.IsShortTermOrder() || !msg.CancelsOrder(order.OrderId) {
+			continue
+		}
+
+		msgCancelOrder := types.NewMsgCancelOrderShortTerm(
+			order.OrderId,
+			lib.Max(msg.GoodTilBlock, order.GetGoodTilBlock()),
+		)
+		if err := k.CancelShortTermOrder(ctx, msgCancelOrder); err != nil {
+			k.Logger(ctx).Error(
+				"CancelAllShortTermOrders: failed to cancel open order",
+				"orderId", order.OrderId,
+				"error", err,
+			)
+			continue
+		}
+		canceledOrderIds = append(canceledOrderIds, order.OrderId)
+	}
+	return canceledOrderIds, nil
+}
+
+// CancelAllStatefulOrders removes all stateful orders of the subaccount of a `MsgCancelAllOrders` that are
+// within the scope of the message from state. Orders that are removed because an order of their order group
+// was canceled are not considered canceled by the message. This method is meant to be used in the
+// DeliverTx flow.
+//
+// An error is returned if the `GoodTilBlock` of the message has passed.
+func (k Keeper) CancelAllStatefulOrders(
+	ctx sdk.Context,
+	msg *types.MsgCancelAllOrders,
+) (canceledOrderIds []types.OrderId, err error) {
+	lib.AssertDeliverTxMode(ctx)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.CancelAllOrders, metrics.Latency)
+
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	if err := k.validateGoodTilBlock(msg.GoodTilBlock, blockHeight); err != nil {
+		return nil, err
+	}
+
+	canceledOrderIds = make([]types.OrderId, 0)
+	for _, order := range k.GetSubaccountStatefulOrders(ctx, msg.SubaccountId) {
+		if !msg.CancelsOrder(order.OrderId) {
+			continue
+		}
+
+		// The order may have been removed by the cancellation of another order of its order group.
+		if _, found := k.GetLongTermOrderPlacement(ctx, order.OrderId); !found {
+			continue
+		}
+
+		k.MustRemoveStatefulOrder(ctx, order.OrderId)
+		canceledOrderIds = append(canceledOrderIds, order.OrderId)
+	}
+	return canceledOrderIds, nil
+}
@@ -11,6 +11,7 @@ import (
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -110,10 +111,60 @@ func (k Keeper) CreatePerpetualClobPair(
 	return clobPair, nil
 }
 
+// CreateSpotClobPair creates a new spot CLOB pair in the store.
+// Additionally, it creates an order book matching the ID of the newly created CLOB pair.
+//
+// An error will occur if any of the fields fail validation (see validateClobPair for details).
+// In the event of an error, the store will not be updated nor will a matching order book be created.
+//
+// Returns the newly created CLOB pair and an error if one occurs.
+func (k Keeper) CreateSpotClobPair(
+	ctx sdk.Context,
+	clobPairId uint32,
+	baseAssetId uint32,
+	quoteAssetId uint32,
+	stepSizeBaseQuantums satypes.BaseQuantums,
+	quantumConversionExponent int32,
+	subticksPerTick uint32,
+	status types.ClobPair_Status,
+) (types.ClobPair, error) {
+	// If the desired CLOB pair ID is already in use, return an error.
+	if clobPair, exists := k.GetClobPair(ctx, types.ClobPairId(clobPairId)); exists {
+		return types.ClobPair{}, errorsmod.Wrapf(
+			types.ErrClobPairAlreadyExists,
+			"id=%v, existing clob pair=%v",
+			clobPairId,
+			clobPair,
+		)
+	}
+
+	clobPair := types.ClobPair{
+		Metadata: &types.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &types.SpotClobMetadata{
+				BaseAssetId:  baseAssetId,
+				QuoteAssetId: quoteAssetId,
+			},
+		},
+		Id:                        clobPairId,
+		StepBaseQuantums:          stepSizeBaseQuantums.ToUint64(),
+		QuantumConversionExponent: quantumConversionExponent,
+		SubticksPerTick:           subticksPerTick,
+		Status:                    status,
+	}
+	if err := k.validateClobPair(ctx, &clobPair); err != nil {
+		return clobPair, err
+	}
+
+	k.createClobPair(ctx, clobPair)
+
+	return clobPair, nil
+}
+
 // validateClobPair validates a CLOB pair's fields are suitable for CLOB pair creation.
 //
 // Stateful Validation:
-//   - Must be a perpetual CLOB pair with a perpetualId matching a perpetual in the store.
+//   - A perpetual CLOB pair must have a perpetualId matching a perpetual in the store.
+//   - A spot CLOB pair must have a base asset with a market, and must be quoted in USDC.
 //
 // Stateless Validation
 //   - `clobPair.Validate()` returns no error.
@@ -122,7 +173,6 @@ func (k Keeper) validateClobPair(ctx sdk.Context, clobPair *types.ClobPair) erro
 		return err
 	}
 
-	// TODO(DEC-1535): update this validation when we implement "spot"/"asset" clob pairs.
 	switch clobPair.Metadata.(type) {
 	case *types.ClobPair_PerpetualClobMetadata:
 		perpetualId, err := clobPair.GetPerpetualId()
@@ -141,11 +191,28 @@ func (k Keeper) validateClobPair(ctx sdk.Context, clobPair *types.ClobPair) erro
 				clobPair,
 			)
 		}
+	case *types.ClobPair_SpotClobMetadata:
+		spotClobMetadata := clobPair.GetSpotClobMetadata()
+		// Fees and collateral are denominated in USDC, so spot CLOB pairs must be quoted in USDC.
+		if spotClobMetadata.GetQuoteAssetId() != assettypes.AssetUsdc.Id {
+			return errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) must have USDC as its quote asset.",
+				clobPair,
+			)
+		}
+		// Validate the base asset referenced by the CLOB pair exists and can be priced.
+		if _, _, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, spotClobMetadata.GetBaseAssetId()); err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"CLOB pair (%+v) has invalid base asset.",
+				clobPair,
+			)
+		}
 	default:
 		return errorsmod.Wrapf(
 			types.ErrInvalidClobPairParameter,
-			// TODO(DEC-1535): update this error message when we implement "spot"/"asset" clob pairs.
-			"CLOB pair (%+v) is not a perpetual CLOB.",
+			"CLOB pair (%+v) is not a perpetual or spot CLOB.",
 			clobPair,
 		)
 	}
@@ -373,6 +440,15 @@ func (k Keeper) validateOrderAgainstClobPairStatus(
 				clobPair.Status,
 			)
 		}
+	case types.ClobPair_STATUS_FINAL_SETTLEMENT:
+		// Reject all orders. The positions of clob pairs in final settlement were closed at the
+		// settlement price, and no further trading may happen.
+		return errorsmod.Wrapf(
+			types.ErrOrderConflictsWithClobPairStatus,
+			"Order %+v cannot be placed for clob pair with status %+v",
+			order,
+			clobPair.Status,
+		)
 	}
 
 	return nil
@@ -409,6 +485,32 @@ func (k Keeper) mustGetClobPairForPerpetualId(
 	return k.mustGetClobPair(ctx, clobPairId)
 }
 
+// GetSpotClobPairForAsset returns the active spot ClobPair with the lowest ID that trades the provided
+// asset against USDC. Returns an error if no such ClobPair exists.
+func (k Keeper) GetSpotClobPairForAsset(
+	ctx sdk.Context,
+	assetId uint32,
+) (
+	clobPair types.ClobPair,
+	err error,
+) {
+	for _, clobPair := range k.GetAllClobPairs(ctx) {
+		spotClobMetadata := clobPair.GetSpotClobMetadata()
+		if spotClobMetadata != nil &&
+			spotClobMetadata.BaseAssetId == assetId &&
+			spotClobMetadata.QuoteAssetId == assettypes.AssetUsdc.Id &&
+			clobPair.Status == types.ClobPair_STATUS_ACTIVE {
+			return clobPair, nil
+		}
+	}
+
+	return types.ClobPair{}, errorsmod.Wrapf(
+		types.ErrNoSpotClobPairForAsset,
+		"asset ID = (%d)",
+		assetId,
+	)
+}
+
 // UpdateClobPair overwrites a ClobPair in state and sends an update to the indexer.
 // This function returns an error if the update includes an unsupported transition
 // for the ClobPair's status.
@@ -425,27 +527,38 @@ func (k Keeper) UpdateClobPair(
 		)
 	}
 
-	// Note, only perpetual clob pairs are currently supported. Neither the old nor the
-	// new clob pair should be spot.
-	perpetualId, err := clobPair.GetPerpetualId()
-	if err != nil {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			err.Error(),
-		)
-	}
-	oldPerpetualId, err := oldClobPair.GetPerpetualId()
-	if err != nil {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			err.Error(),
-		)
-	}
-	if perpetualId != oldPerpetualId {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			"UpdateClobPair: cannot update ClobPair perpetual id",
-		)
+	if oldSpotClobMetadata := oldClobPair.GetSpotClobMetadata(); oldSpotClobMetadata != nil {
+		// Spot clob pairs cannot change their base or quote asset.
+		spotClobMetadata := clobPair.GetSpotClobMetadata()
+		if spotClobMetadata == nil ||
+			spotClobMetadata.BaseAssetId != oldSpotClobMetadata.BaseAssetId ||
+			spotClobMetadata.QuoteAssetId != oldSpotClobMetadata.QuoteAssetId {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				"UpdateClobPair: cannot update ClobPair spot metadata",
+			)
+		}
+	} else {
+		perpetualId, err := clobPair.GetPerpetualId()
+		if err != nil {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				err.Error(),
+			)
+		}
+		oldPerpetualId, err := oldClobPair.GetPerpetualId()
+		if err != nil {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				err.Error(),
+			)
+		}
+		if perpetualId != oldPerpetualId {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				"UpdateClobPair: cannot update ClobPair perpetual id",
+			)
+		}
 	}
 	if clobPair.StepBaseQuantums != oldClobPair.StepBaseQuantums {
 		return errorsmod.Wrapf(
@@ -519,14 +632,23 @@ func (k Keeper) getInternalOperationClobPairId(
 			clobPairId = types.ClobPairId(castedMatch.MatchOrders.TakerOrderId.ClobPairId)
 		case *types.ClobMatch_MatchPerpetualLiquidation:
 			clobPairId = types.ClobPairId(castedMatch.MatchPerpetualLiquidation.ClobPairId)
+		case *types.ClobMatch_MatchAssetLiquidation:
+			clobPairId = types.ClobPairId(castedMatch.MatchAssetLiquidation.ClobPairId)
 		case *types.ClobMatch_MatchPerpetualDeleveraging:
 			clobPairId, err = k.GetClobPairIdForPerpetual(
 				ctx,
 				castedMatch.MatchPerpetualDeleveraging.PerpetualId,
 			)
+		case *types.ClobMatch_MatchAssetDeleveraging:
+			panic(
+				"getInternalOperationClobPairId: should never be called for asset deleveraging " +
+					"internal operations",
+			)
 		}
 	case *types.InternalOperation_ShortTermOrderPlacement:
 		clobPairId = types.ClobPairId(castedOperation.ShortTermOrderPlacement.Order.OrderId.ClobPairId)
+	case *types.InternalOperation_ShortTermOrderReplacement:
+		clobPairId = types.ClobPairId(castedOperation.ShortTermOrderReplacement.Order.OrderId.ClobPairId)
 	case *types.InternalOperation_OrderRemoval:
 		clobPairId = types.ClobPairId(castedOperation.OrderRemoval.OrderId.ClobPairId)
 	case *types.InternalOperation_PreexistingStatefulOrder:
@@ -556,6 +678,11 @@ func (k Keeper) validateInternalOperationAgainstClobPairStatus(
 	ctx sdk.Context,
 	internalOperation types.InternalOperation,
 ) error {
+	// Asset deleveraging matches are not associated with any ClobPair.
+	if internalOperation.GetMatch().GetMatchAssetDeleveraging() != nil {
+		return nil
+	}
+
 	clobPairId, err := k.getInternalOperationClobPairId(ctx, internalOperation)
 	if err != nil {
 		return err
@@ -589,6 +716,15 @@ func (k Keeper) validateInternalOperationAgainstClobPairStatus(
 			clobPairId,
 			types.ClobPair_STATUS_INITIALIZING,
 		)
+	case types.ClobPair_STATUS_FINAL_SETTLEMENT:
+		// All operations are invalid for clob pairs in final settlement.
+		return errorsmod.Wrapf(
+			types.ErrOperationConflictsWithClobPairStatus,
+			"Operation %s invalid for ClobPair with id %d with status %s",
+			internalOperation.GetInternalOperationTextString(),
+			clobPairId,
+			types.ClobPair_STATUS_FINAL_SETTLEMENT,
+		)
 	}
 
 	return nil
@@ -318,6 +318,163 @@ func TestCreatePerpetualClobPair(t *testing.T) {
 	}
 }
 
+func TestGetSpotClobPairForAsset(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+	require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+	_, err := ks.AssetsKeeper.CreateAsset(
+		ks.Ctx,
+		constants.BtcUsd.Id,
+		constants.BtcUsd.Symbol,
+		constants.BtcUsd.Denom,
+		constants.BtcUsd.DenomExponent,
+		constants.BtcUsd.HasMarket,
+		constants.BtcUsd.MarketId,
+		constants.BtcUsd.AtomicResolution,
+		constants.BtcUsd.CollateralWeightPpm,
+	)
+	require.NoError(t, err)
+
+	// No spot CLOB pair exists for the asset.
+	_, err = ks.ClobKeeper.GetSpotClobPairForAsset(ks.Ctx, constants.BtcUsd.Id)
+	require.ErrorIs(t, err, types.ErrNoSpotClobPairForAsset)
+
+	// Inactive spot CLOB pairs are ignored.
+	for _, clobPair := range []types.ClobPair{
+		*clobtest.GenerateClobPair(
+			clobtest.WithId(0),
+			clobtest.WithSpotMetadata(&types.ClobPair_SpotClobMetadata{
+				SpotClobMetadata: &types.SpotClobMetadata{BaseAssetId: constants.BtcUsd.Id},
+			}),
+			clobtest.WithStatus(types.ClobPair_STATUS_INITIALIZING),
+		),
+		constants.ClobPair_Spot_BtcUsdc,
+	} {
+		spotClobMetadata := clobPair.GetSpotClobMetadata()
+		_, err = ks.ClobKeeper.CreateSpotClobPair(
+			ks.Ctx,
+			clobPair.Id,
+			spotClobMetadata.BaseAssetId,
+			spotClobMetadata.QuoteAssetId,
+			satypes.BaseQuantums(clobPair.StepBaseQuantums),
+			clobPair.QuantumConversionExponent,
+			clobPair.SubticksPerTick,
+			clobPair.Status,
+		)
+		require.NoError(t, err)
+	}
+
+	clobPair, err := ks.ClobKeeper.GetSpotClobPairForAsset(ks.Ctx, constants.BtcUsd.Id)
+	require.NoError(t, err)
+	require.Equal(t, constants.ClobPair_Spot_BtcUsdc, clobPair)
+
+	// USDC is never the base asset of a spot CLOB pair.
+	_, err = ks.ClobKeeper.GetSpotClobPairForAsset(ks.Ctx, constants.Usdc.Id)
+	require.ErrorIs(t, err, types.ErrNoSpotClobPairForAsset)
+}
+
+func TestCreateSpotClobPair(t *testing.T) {
+	spotMetadata := func(baseAssetId uint32, quoteAssetId uint32) *types.ClobPair_SpotClobMetadata {
+		return &types.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &types.SpotClobMetadata{
+				BaseAssetId:  baseAssetId,
+				QuoteAssetId: quoteAssetId,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		// CLOB pair.
+		clobPair types.ClobPair
+		// Whether a CLOB pair with the same ID already exists.
+		clobPairExists bool
+
+		// Expectations.
+		expectedErr string
+	}{
+		"CLOB pair is valid": {
+			clobPair: *clobtest.GenerateClobPair(clobtest.WithSpotMetadata(spotMetadata(1, 0))),
+		},
+		"CLOB pair is invalid when the quote asset is not USDC": {
+			clobPair:    *clobtest.GenerateClobPair(clobtest.WithSpotMetadata(spotMetadata(0, 1))),
+			expectedErr: "must have USDC as its quote asset.",
+		},
+		"CLOB pair is invalid when the base and quote assets are the same": {
+			clobPair:    *clobtest.GenerateClobPair(clobtest.WithSpotMetadata(spotMetadata(0, 0))),
+			expectedErr: "must have different base and quote assets.",
+		},
+		"CLOB pair is invalid when the base asset does not exist": {
+			clobPair:    *clobtest.GenerateClobPair(clobtest.WithSpotMetadata(spotMetadata(1000, 0))),
+			expectedErr: "has invalid base asset.",
+		},
+		"CLOB pair is invalid when the ID is already in use": {
+			clobPair:       *clobtest.GenerateClobPair(clobtest.WithSpotMetadata(spotMetadata(1, 0))),
+			clobPairExists: true,
+			expectedErr:    types.ErrClobPairAlreadyExists.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Boilerplate setup.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+
+			prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+			_, err := ks.AssetsKeeper.CreateAsset(
+				ks.Ctx,
+				constants.BtcUsd.Id,
+				constants.BtcUsd.Symbol,
+				constants.BtcUsd.Denom,
+				constants.BtcUsd.DenomExponent,
+				constants.BtcUsd.HasMarket,
+				constants.BtcUsd.MarketId,
+				constants.BtcUsd.AtomicResolution,
+				constants.BtcUsd.CollateralWeightPpm,
+			)
+			require.NoError(t, err)
+
+			if tc.clobPairExists {
+				registry := codectypes.NewInterfaceRegistry()
+				cdc := codec.NewProtoCodec(registry)
+				store := prefix.NewStore(ks.Ctx.KVStore(ks.StoreKey), []byte(types.ClobPairKeyPrefix))
+				store.Set(lib.Uint32ToKey(constants.ClobPair_Btc.Id), cdc.MustMarshal(&constants.ClobPair_Btc))
+			}
+
+			// Perform the method under test.
+			spotClobMetadata := tc.clobPair.GetSpotClobMetadata()
+			createdClobPair, actualErr := ks.ClobKeeper.CreateSpotClobPair(
+				ks.Ctx,
+				tc.clobPair.Id,
+				spotClobMetadata.BaseAssetId,
+				spotClobMetadata.QuoteAssetId,
+				satypes.BaseQuantums(tc.clobPair.StepBaseQuantums),
+				tc.clobPair.QuantumConversionExponent,
+				tc.clobPair.SubticksPerTick,
+				tc.clobPair.Status,
+			)
+			storedClobPair, found := ks.ClobKeeper.GetClobPair(ks.Ctx, types.ClobPairId(tc.clobPair.Id))
+
+			if tc.expectedErr == "" {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.clobPair, createdClobPair)
+
+				// The CLOB pair should be stored without a perpetual mapping or indexer market event.
+				require.True(t, found)
+				require.Equal(t, tc.clobPair, storedClobPair)
+				require.Empty(t, ks.ClobKeeper.PerpetualIdToClobPairId)
+				mockIndexerEventManager.AssertNotCalled(t, "AddTxnEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				require.ErrorContains(t, actualErr, tc.expectedErr)
+				require.Equal(t, tc.clobPairExists, found)
+			}
+		})
+	}
+}
+
 func TestCreateMultipleClobPairs(t *testing.T) {
 	type CreationExpectation struct {
 		// CLOB pair.
@@ -955,14 +1112,14 @@ func TestClobPairValidate(t *testing.T) {
 		expectedErr string
 	}{
 		{
-			desc: "Invalid Metadata (SpotClobMetadata)",
+			desc: "Invalid Metadata (SpotClobMetadata with same base and quote asset)",
 			clobPair: types.ClobPair{
 				Metadata:         &types.ClobPair_SpotClobMetadata{},
 				StepBaseQuantums: 1,
 				SubticksPerTick:  1,
 				Status:           types.ClobPair_STATUS_ACTIVE,
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "must have different base and quote assets",
 		},
 		{
 			desc: "Unsupported Status",
@@ -1004,6 +1161,21 @@ func TestClobPairValidate(t *testing.T) {
 			},
 			expectedErr: "",
 		},
+		{
+			desc: "Valid spot ClobPair",
+			clobPair: types.ClobPair{
+				Metadata: &types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  1,
+						QuoteAssetId: 0,
+					},
+				},
+				StepBaseQuantums: 1,
+				SubticksPerTick:  1,
+				Status:           types.ClobPair_STATUS_ACTIVE,
+			},
+			expectedErr: "",
+		},
 	}
 
 	for _, tc := range tests {
@@ -17,6 +17,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -34,7 +35,7 @@ func (k Keeper) MaybeDeleverageSubaccount(
 ) {
 	lib.AssertCheckTxMode(ctx)
 
-	canPerformDeleveraging, err := k.CanDeleverageSubaccount(ctx, subaccountId)
+	canPerformDeleveraging, err := k.CanDeleverageSubaccount(ctx, subaccountId, perpetualId)
 	if err != nil {
 		return new(big.Int), err
 	}
@@ -133,20 +134,23 @@ func (k Keeper) GetInsuranceFundBalance(
 	return insuranceFundBalance.Amount.BigInt()
 }
 
-// CanDeleverageSubaccount returns true if a subaccount can be deleveraged.
+// CanDeleverageSubaccount returns true if a subaccount's position in the perpetual can be deleveraged.
 // Specifically, this function returns true if both of the following are true:
-// - The subaccount's total net collateral is negative.
-// This function returns an error if `GetNetCollateralAndMarginRequirements` returns an error.
+// - The net collateral of the margin group of the position is negative. This is the position itself
+// if it is isolated, and the cross-margined positions of the subaccount otherwise.
+// This function returns an error if fetching the net collateral returns an error.
 func (k Keeper) CanDeleverageSubaccount(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
 ) (bool, error) {
 	bigNetCollateral,
 		_,
 		_,
-		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		err := k.getNetCollateralAndMarginRequirementsForPerpetual(
 		ctx,
-		satypes.Update{SubaccountId: subaccountId},
+		subaccountId,
+		perpetualId,
 	)
 	if err != nil {
 		return false, err
@@ -180,8 +184,9 @@ func (k Keeper) IsValidInsuranceFundDelta(
 	return new(big.Int).Add(currentInsuranceFundBalance, insuranceFundDelta).Sign() >= 0
 }
 
-// OffsetSubaccountPerpetualPosition iterates over all subaccounts and use those with positions
-// on the opposite side to offset the liquidated subaccount's position by `deltaQuantumsTotal`.
+// OffsetSubaccountPerpetualPosition iterates over the subaccounts with positions on the opposite side
+// in order of their auto-deleveraging rank, and uses them to offset the liquidated subaccount's position
+// by `deltaQuantumsTotal`. See `GetAdlRankedSubaccountIds` for how positions are ranked.
 //
 // This function returns the fills that were processed and the remaining amount to offset.
 // Note that each deleveraging fill is being processed _optimistically_, and the state transitions are
@@ -208,77 +213,78 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 	deltaQuantumsRemaining = new(big.Int).Set(deltaQuantumsTotal)
 	fills = make([]types.MatchPerpetualDeleveraging_Fill, 0)
 
-	k.subaccountsKeeper.ForEachSubaccountRandomStart(
-		ctx,
-		func(offsettingSubaccount satypes.Subaccount) (finished bool) {
-			// Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
-			if numSubaccountsIterated >= k.Flags.MaxDeleveragingSubaccountsToIterate {
-				return true
-			}
+	// Offsetting positions are on the same side as `deltaQuantumsTotal`, and are used in order of their
+	// auto-deleveraging rank.
+	rankedSubaccountIds := k.GetAdlRankedSubaccountIds(ctx, perpetualId, deltaQuantumsTotal.Sign() > 0)
+	for _, offsettingSubaccountId := range rankedSubaccountIds {
+		// Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
+		if numSubaccountsIterated >= k.Flags.MaxDeleveragingSubaccountsToIterate {
+			break
+		}
 
-			numSubaccountsIterated++
-			offsettingPosition, _ := offsettingSubaccount.GetPerpetualPositionForId(perpetualId)
-			bigOffsettingPositionQuantums := offsettingPosition.GetBigQuantums()
+		numSubaccountsIterated++
+		offsettingSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, offsettingSubaccountId)
+		offsettingPosition, _ := offsettingSubaccount.GetPerpetualPositionForId(perpetualId)
+		bigOffsettingPositionQuantums := offsettingPosition.GetBigQuantums()
 
-			// Skip subaccounts that do not have a position in the opposite direction as the liquidated subaccount.
-			if deltaQuantumsRemaining.Sign() != bigOffsettingPositionQuantums.Sign() {
-				numSubaccountsWithNoOpenPositionOnOppositeSide++
-				return false
-			}
+		// Skip subaccounts that do not have a position in the opposite direction as the liquidated subaccount.
+		if deltaQuantumsRemaining.Sign() != bigOffsettingPositionQuantums.Sign() {
+			numSubaccountsWithNoOpenPositionOnOppositeSide++
+			continue
+		}
 
-			// TODO(DEC-1495): Determine max amount to offset per offsetting subaccount.
-			var deltaQuantums *big.Int
-			if deltaQuantumsRemaining.CmpAbs(bigOffsettingPositionQuantums) > 0 {
-				deltaQuantums = new(big.Int).Set(bigOffsettingPositionQuantums)
-			} else {
-				deltaQuantums = new(big.Int).Set(deltaQuantumsRemaining)
-			}
+		// TODO(DEC-1495): Determine max amount to offset per offsetting subaccount.
+		var deltaQuantums *big.Int
+		if deltaQuantumsRemaining.CmpAbs(bigOffsettingPositionQuantums) > 0 {
+			deltaQuantums = new(big.Int).Set(bigOffsettingPositionQuantums)
+		} else {
+			deltaQuantums = new(big.Int).Set(deltaQuantumsRemaining)
+		}
 
-			// Try to process the deleveraging operation for both subaccounts.
-			if err := k.ProcessDeleveraging(
-				ctx,
-				liquidatedSubaccountId,
-				*offsettingSubaccount.Id,
-				perpetualId,
+		// Try to process the deleveraging operation for both subaccounts.
+		if err := k.ProcessDeleveraging(
+			ctx,
+			liquidatedSubaccountId,
+			offsettingSubaccountId,
+			perpetualId,
+			deltaQuantums,
+		); err == nil {
+			// Update the remaining liquidatable quantums.
+			deltaQuantumsRemaining = new(big.Int).Sub(
+				deltaQuantumsRemaining,
 				deltaQuantums,
-			); err == nil {
-				// Update the remaining liquidatable quantums.
-				deltaQuantumsRemaining = new(big.Int).Sub(
-					deltaQuantumsRemaining,
-					deltaQuantums,
-				)
-				fills = append(fills, types.MatchPerpetualDeleveraging_Fill{
-					OffsettingSubaccountId: *offsettingSubaccount.Id,
-					FillAmount:             new(big.Int).Abs(deltaQuantums).Uint64(),
-				})
-			} else if errors.Is(err, types.ErrInvalidPerpetualPositionSizeDelta) {
-				panic(
-					fmt.Sprintf(
-						"Invalid perpetual position size delta when processing deleveraging. error: %v",
-						err,
-					),
-				)
-			} else {
-				// If an error is returned, it's likely because the subaccounts' bankruptcy prices do not overlap.
-				// TODO(CLOB-75): Support deleveraging subaccounts with non overlapping bankruptcy prices.
-				liquidatedSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, liquidatedSubaccountId)
-				offsettingSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, *offsettingSubaccount.Id)
-				k.Logger(ctx).Debug(
-					"Encountered error when processing deleveraging",
-					"error", err,
-					"blockHeight", ctx.BlockHeight(),
-					"checkTx", ctx.IsCheckTx(),
-					"perpetualId", perpetualId,
-					"deltaQuantums", deltaQuantums,
-					"liquidatedSubaccount", liquidatedSubaccount,
-					"offsettingSubaccount", offsettingSubaccount,
-				)
-				numSubaccountsWithNonOverlappingBankruptcyPrices++
-			}
-			return deltaQuantumsRemaining.Sign() == 0
-		},
-		k.GetPseudoRand(ctx),
-	)
+			)
+			fills = append(fills, types.MatchPerpetualDeleveraging_Fill{
+				OffsettingSubaccountId: offsettingSubaccountId,
+				FillAmount:             new(big.Int).Abs(deltaQuantums).Uint64(),
+			})
+		} else if errors.Is(err, types.ErrInvalidPerpetualPositionSizeDelta) {
+			panic(
+				fmt.Sprintf(
+					"Invalid perpetual position size delta when processing deleveraging. error: %v",
+					err,
+				),
+			)
+		} else {
+			// If an error is returned, it's likely because the subaccounts' bankruptcy prices do not overlap.
+			// TODO(CLOB-75): Support deleveraging subaccounts with non overlapping bankruptcy prices.
+			liquidatedSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, liquidatedSubaccountId)
+			k.Logger(ctx).Debug(
+				"Encountered error when processing deleveraging",
+				"error", err,
+				"blockHeight", ctx.BlockHeight(),
+				"checkTx", ctx.IsCheckTx(),
+				"perpetualId", perpetualId,
+				"deltaQuantums", deltaQuantums,
+				"liquidatedSubaccount", liquidatedSubaccount,
+				"offsettingSubaccount", offsettingSubaccount,
+			)
+			numSubaccountsWithNonOverlappingBankruptcyPrices++
+		}
+		if deltaQuantumsRemaining.Sign() == 0 {
+			break
+		}
+	}
 
 	labels := []gometrics.Label{
 		metrics.GetLabelForIntValue(metrics.PerpetualId, int(perpetualId)),
@@ -417,6 +423,22 @@ func (k Keeper) ProcessDeleveraging(
 		return updateErr
 	}
 
+	// Track the entry notionals of both perpetual positions for ranking auto-deleveraging.
+	k.updatePerpetualPositionEntry(
+		ctx,
+		liquidatedSubaccountId,
+		perpetualId,
+		deleveragedSubaccountPerpetualQuantumsDelta,
+		offsettingSubaccountQuoteBalanceDelta,
+	)
+	k.updatePerpetualPositionEntry(
+		ctx,
+		offsettingSubaccountId,
+		perpetualId,
+		offsettingSubaccountPerpetualQuantumsDelta,
+		deleveragedSubaccountQuoteBalanceDelta,
+	)
+
 	// Stat quantums deleveraged in quote quantums.
 	if deleveragedQuoteQuantums, err := k.perpetualsKeeper.GetNetCollateral(
 		ctx,
@@ -473,3 +495,425 @@ func (k Keeper) ProcessDeleveraging(
 
 	return nil
 }
+
+// MaybeDeleverageSubaccountAsset deleverages the asset positions of a liquidatable subaccount which
+// could not be liquidated against the orderbook. The full position is offset against other subaccounts:
+// - A borrowed asset position is repaid by subaccounts which hold a positive balance of the asset.
+// - If the subaccount does not borrow any asset but has a negative USDC balance, one of its
+// non-USDC collateral positions is seized and bought by subaccounts with a positive USDC balance.
+// Note that only one asset position is deleveraged per call.
+func (k Keeper) MaybeDeleverageSubaccountAsset(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) (
+	quantumsDeleveraged *big.Int,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	isLiquidatable, err := k.IsLiquidatable(ctx, subaccountId)
+	if err != nil {
+		return new(big.Int), err
+	}
+
+	// Early return to skip deleveraging if the subaccount is not liquidatable.
+	if !isLiquidatable {
+		telemetry.IncrCounter(
+			1,
+			types.ModuleName,
+			metrics.PrepareCheckState,
+			metrics.CannotDeleverageSubaccount,
+		)
+		return new(big.Int), nil
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	var assetId uint32
+	var deltaQuantums *big.Int
+	if position, exists := subaccount.GetBorrowedAssetPosition(); exists {
+		assetId = position.AssetId
+		deltaQuantums = new(big.Int).Neg(position.GetBigQuantums())
+	} else if assetId, deltaQuantums, exists = k.getCollateralToSeize(ctx, subaccount); !exists {
+		// Early return to skip deleveraging if the subaccount does not have an asset position to deleverage.
+		k.Logger(ctx).Debug(
+			"Subaccount does not have an asset position to deleverage",
+			"subaccount", subaccount,
+		)
+		return new(big.Int), nil
+	}
+
+	quantumsDeleveraged, err = k.MemClob.DeleverageSubaccountAsset(ctx, subaccountId, assetId, deltaQuantums)
+
+	labels := []gometrics.Label{
+		metrics.GetLabelForIntValue(metrics.AssetId, int(assetId)),
+	}
+	if quantumsDeleveraged.Sign() == 0 {
+		labels = append(labels, metrics.GetLabelForStringValue(metrics.Status, metrics.Unfilled))
+	} else if quantumsDeleveraged.CmpAbs(deltaQuantums) == 0 {
+		labels = append(labels, metrics.GetLabelForStringValue(metrics.Status, metrics.FullyFilled))
+	} else {
+		labels = append(labels, metrics.GetLabelForStringValue(metrics.Status, metrics.PartiallyFilled))
+	}
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, metrics.DeleverageSubaccount}, 1, labels)
+
+	return quantumsDeleveraged, err
+}
+
+// getCollateralToSeize returns the first non-USDC collateral position of the subaccount with a positive
+// balance if its USDC balance is negative, along with the negative delta in quantums that seizes enough of
+// the position to repay the USDC balance at the oracle price. Returns false if there is no such position.
+func (k Keeper) getCollateralToSeize(
+	ctx sdk.Context,
+	subaccount satypes.Subaccount,
+) (
+	assetId uint32,
+	deltaQuantums *big.Int,
+	exists bool,
+) {
+	usdcBalance := subaccount.GetUsdcPosition()
+	if usdcBalance.Sign() >= 0 {
+		return 0, nil, false
+	}
+
+	for _, position := range subaccount.AssetPositions {
+		if position.AssetId == assettypes.AssetUsdc.Id || position.GetBigQuantums().Sign() <= 0 {
+			continue
+		}
+
+		asset, marketPrice, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, position.AssetId)
+		if err != nil || !asset.IsCollateral() {
+			continue
+		}
+
+		// Round up so that the seized collateral repays the full USDC balance.
+		debt := new(big.Int).Neg(usdcBalance)
+		quantumsToSeize := lib.QuoteToBaseQuantums(debt, asset.AtomicResolution, marketPrice.Price, marketPrice.Exponent)
+		if lib.BaseToQuoteQuantums(
+			quantumsToSeize,
+			asset.AtomicResolution,
+			marketPrice.Price,
+			marketPrice.Exponent,
+		).Cmp(debt) < 0 {
+			quantumsToSeize.Add(quantumsToSeize, big.NewInt(1))
+		}
+
+		return position.AssetId, new(big.Int).Neg(lib.BigMin(quantumsToSeize, position.GetBigQuantums())), true
+	}
+	return 0, nil, false
+}
+
+// OffsetSubaccountAssetPosition iterates over all subaccounts and uses them to offset the liquidated
+// subaccount's asset position by `deltaQuantumsTotal`. A positive `deltaQuantumsTotal` repays a borrowed
+// position with the balances of subaccounts which hold the asset. A negative `deltaQuantumsTotal` seizes
+// a collateral position, which is bought by subaccounts with a positive USDC balance at the oracle price.
+//
+// This function returns the fills that were processed and the remaining amount to offset.
+// As with perpetual positions, each fill is processed optimistically.
+func (k Keeper) OffsetSubaccountAssetPosition(
+	ctx sdk.Context,
+	liquidatedSubaccountId satypes.SubaccountId,
+	assetId uint32,
+	deltaQuantumsTotal *big.Int,
+) (
+	fills []types.MatchPerpetualDeleveraging_Fill,
+	deltaQuantumsRemaining *big.Int,
+) {
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		types.ModuleName,
+		metrics.OffsettingSubaccountAssetPosition,
+	)
+
+	numSubaccountsIterated := uint32(0)
+	deltaQuantumsRemaining = new(big.Int).Set(deltaQuantumsTotal)
+	fills = make([]types.MatchPerpetualDeleveraging_Fill, 0)
+
+	isSeizure := deltaQuantumsTotal.Sign() < 0
+	var asset assettypes.Asset
+	var marketPrice pricestypes.MarketPrice
+	if isSeizure {
+		var err error
+		asset, marketPrice, err = k.assetsKeeper.GetAssetAndMarketPrice(ctx, assetId)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"Failed to get asset and market price when seizing collateral",
+				"error", err,
+				"assetId", assetId,
+				"liquidatedSubaccountId", liquidatedSubaccountId,
+			)
+			return fills, deltaQuantumsRemaining
+		}
+	}
+
+	k.subaccountsKeeper.ForEachSubaccountRandomStart(
+		ctx,
+		func(offsettingSubaccount satypes.Subaccount) (finished bool) {
+			// Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
+			if numSubaccountsIterated >= k.Flags.MaxDeleveragingSubaccountsToIterate {
+				return true
+			}
+
+			numSubaccountsIterated++
+
+			// The maximum amount the offsetting subaccount can offset, with the same sign as `deltaQuantumsTotal`.
+			var maxDeltaQuantums *big.Int
+			if isSeizure {
+				// Seized collateral is bought with the USDC balance of the offsetting subaccount.
+				maxDeltaQuantums = new(big.Int).Neg(
+					lib.QuoteToBaseQuantums(
+						offsettingSubaccount.GetUsdcPosition(),
+						asset.AtomicResolution,
+						marketPrice.Price,
+						marketPrice.Exponent,
+					),
+				)
+			} else {
+				offsettingPosition, _ := offsettingSubaccount.GetAssetPositionForId(assetId)
+				maxDeltaQuantums = offsettingPosition.GetBigQuantums()
+			}
+
+			// Skip subaccounts that cannot offset the position.
+			if maxDeltaQuantums.Sign() != deltaQuantumsTotal.Sign() {
+				return false
+			}
+
+			var deltaQuantums *big.Int
+			if deltaQuantumsRemaining.CmpAbs(maxDeltaQuantums) > 0 {
+				deltaQuantums = new(big.Int).Set(maxDeltaQuantums)
+			} else {
+				deltaQuantums = new(big.Int).Set(deltaQuantumsRemaining)
+			}
+
+			if err := k.ProcessAssetDeleveraging(
+				ctx,
+				liquidatedSubaccountId,
+				*offsettingSubaccount.Id,
+				assetId,
+				deltaQuantums,
+			); err == nil {
+				deltaQuantumsRemaining = new(big.Int).Sub(
+					deltaQuantumsRemaining,
+					deltaQuantums,
+				)
+				fills = append(fills, types.MatchPerpetualDeleveraging_Fill{
+					OffsettingSubaccountId: *offsettingSubaccount.Id,
+					FillAmount:             new(big.Int).Abs(deltaQuantums).Uint64(),
+				})
+			} else if errors.Is(err, types.ErrInvalidAssetPositionSizeDelta) {
+				panic(
+					fmt.Sprintf(
+						"Invalid asset position size delta when processing deleveraging. error: %v",
+						err,
+					),
+				)
+			} else {
+				// The offsetting subaccount may not be able to offset the position, for example if
+				// it would become undercollateralized.
+				k.Logger(ctx).Debug(
+					"Encountered error when processing asset deleveraging",
+					"error", err,
+					"blockHeight", ctx.BlockHeight(),
+					"checkTx", ctx.IsCheckTx(),
+					"assetId", assetId,
+					"deltaQuantums", deltaQuantums,
+					"liquidatedSubaccountId", liquidatedSubaccountId,
+					"offsettingSubaccountId", *offsettingSubaccount.Id,
+				)
+			}
+			return deltaQuantumsRemaining.Sign() == 0
+		},
+		k.GetPseudoRand(ctx),
+	)
+
+	gometrics.AddSampleWithLabels(
+		[]string{
+			types.ModuleName, metrics.Deleveraging, metrics.NumSubaccountsIterated, metrics.Count,
+		},
+		float32(numSubaccountsIterated),
+		[]gometrics.Label{
+			metrics.GetLabelForIntValue(metrics.AssetId, int(assetId)),
+		},
+	)
+	return fills, deltaQuantumsRemaining
+}
+
+// ProcessAssetDeleveraging offsets `deltaQuantums` of the liquidated subaccount's asset position against
+// the offsetting subaccount, in quote quantums at the price returned by
+// `GetAssetDeleveragingPriceInQuoteQuantums`.
+// - If `deltaQuantums` is positive, the liquidated subaccount's borrowed position is repaid with the asset
+// balance of the offsetting subaccount.
+// - If `deltaQuantums` is negative, the liquidated subaccount's collateral position is seized to repay its
+// negative USDC balance, and bought with the USDC balance of the offsetting subaccount.
+//
+// This function returns an error if:
+// - `deltaQuantums` is not valid with respect to either of the subaccounts.
+// - the offsetting subaccount does not have enough USDC to buy seized collateral.
+// - `GetAssetDeleveragingPriceInQuoteQuantums` returns an error.
+// - subaccount updates cannot be applied.
+func (k Keeper) ProcessAssetDeleveraging(
+	ctx sdk.Context,
+	liquidatedSubaccountId satypes.SubaccountId,
+	offsettingSubaccountId satypes.SubaccountId,
+	assetId uint32,
+	deltaQuantums *big.Int,
+) (
+	err error,
+) {
+	liquidatedSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, liquidatedSubaccountId)
+	liquidatedPosition, _ := liquidatedSubaccount.GetAssetPositionForId(assetId)
+	liquidatedPositionQuantums := liquidatedPosition.GetBigQuantums()
+
+	offsettingSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, offsettingSubaccountId)
+	offsettingPosition, _ := offsettingSubaccount.GetAssetPositionForId(assetId)
+	offsettingPositionQuantums := offsettingPosition.GetBigQuantums()
+
+	// `deltaQuantums` must reduce the size of the liquidated subaccount's position without changing its side.
+	// A borrowing cannot be repaid by more than the offsetting subaccount's balance, and collateral can only
+	// be seized from a subaccount with a negative USDC balance.
+	isValidDelta := liquidatedPositionQuantums.Sign() == -deltaQuantums.Sign() &&
+		liquidatedPositionQuantums.CmpAbs(deltaQuantums) >= 0
+	switch deltaQuantums.Sign() {
+	case 1:
+		isValidDelta = isValidDelta && offsettingPositionQuantums.Cmp(deltaQuantums) >= 0
+	case -1:
+		isValidDelta = isValidDelta && liquidatedSubaccount.GetUsdcPosition().Sign() < 0
+	}
+	if !isValidDelta {
+		return errorsmod.Wrapf(
+			types.ErrInvalidAssetPositionSizeDelta,
+			"ProcessAssetDeleveraging: liquidated = (%s), offsetting = (%s), asset id = (%d), deltaQuantums = (%+v)",
+			lib.MaybeGetJsonString(liquidatedSubaccount),
+			lib.MaybeGetJsonString(offsettingSubaccount),
+			assetId,
+			deltaQuantums,
+		)
+	}
+
+	deleveragedSubaccountQuoteBalanceDelta, err := k.GetAssetDeleveragingPriceInQuoteQuantums(
+		ctx,
+		liquidatedSubaccountId,
+		assetId,
+		deltaQuantums,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Seized collateral must be paid for with the offsetting subaccount's USDC balance, and not by
+	// borrowing USDC against its other collateral.
+	if deltaQuantums.Sign() == -1 &&
+		offsettingSubaccount.GetUsdcPosition().Cmp(deleveragedSubaccountQuoteBalanceDelta) < 0 {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientQuoteBalanceToBuySeizedCollateral,
+			"ProcessAssetDeleveraging: offsetting = (%s), asset id = (%d), deltaQuantums = (%+v), cost = (%+v)",
+			lib.MaybeGetJsonString(offsettingSubaccount),
+			assetId,
+			deltaQuantums,
+			deleveragedSubaccountQuoteBalanceDelta,
+		)
+	}
+
+	updates := []satypes.Update{
+		// Liquidated subaccount update.
+		{
+			AssetUpdates: []satypes.AssetUpdate{
+				{
+					AssetId:          assettypes.AssetUsdc.Id,
+					BigQuantumsDelta: deleveragedSubaccountQuoteBalanceDelta,
+				},
+				{
+					AssetId:          assetId,
+					BigQuantumsDelta: deltaQuantums,
+				},
+			},
+			SubaccountId: liquidatedSubaccountId,
+		},
+		// Offsetting subaccount update.
+		{
+			AssetUpdates: []satypes.AssetUpdate{
+				{
+					AssetId:          assettypes.AssetUsdc.Id,
+					BigQuantumsDelta: new(big.Int).Neg(deleveragedSubaccountQuoteBalanceDelta),
+				},
+				{
+					AssetId:          assetId,
+					BigQuantumsDelta: new(big.Int).Neg(deltaQuantums),
+				},
+			},
+			SubaccountId: offsettingSubaccountId,
+		},
+	}
+
+	success, successPerUpdate, err := k.subaccountsKeeper.UpdateSubaccounts(ctx, updates)
+	if err != nil {
+		return err
+	}
+
+	return satypes.GetErrorFromUpdateResults(success, successPerUpdate, updates)
+}
+
+// GetAssetDeleveragingPriceInQuoteQuantums returns the change in quote balance of the liquidated
+// subaccount for offsetting `deltaQuantums` of its asset position.
+// - For repaying a borrowed position, the equation is `-DNNV - min(TNC, 0) * abs(DMMR) / TMMR`, which is
+// the oracle value of the repaid borrowing while the subaccount is solvent, and its bankruptcy price otherwise.
+// - For seizing a collateral position, it is the oracle value of the seized collateral without the
+// collateral weight applied, rounded down.
+func (k Keeper) GetAssetDeleveragingPriceInQuoteQuantums(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	assetId uint32,
+	deltaQuantums *big.Int,
+) (
+	deltaQuoteQuantums *big.Int,
+	err error,
+) {
+	tncBig, _, tmmrBig, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{SubaccountId: subaccountId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, _ := subaccount.GetAssetPositionForId(assetId)
+	psBig := position.GetBigQuantums()
+	psadBig := new(big.Int).Add(psBig, deltaQuantums)
+
+	if psBig.Sign() == 0 || psBig.Sign() != -deltaQuantums.Sign() || psadBig.Sign() == deltaQuantums.Sign() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidAssetPositionSizeDelta,
+			"Asset position size delta %v is invalid for %v and asset %v, outstanding position size is %v",
+			deltaQuantums,
+			subaccountId,
+			assetId,
+			psBig,
+		)
+	}
+
+	// Seized collateral is sold at the oracle price.
+	if deltaQuantums.Sign() == -1 {
+		asset, marketPrice, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, assetId)
+		if err != nil {
+			return nil, err
+		}
+		return lib.BaseToQuoteQuantums(
+			new(big.Int).Neg(deltaQuantums),
+			asset.AtomicResolution,
+			marketPrice.Price,
+			marketPrice.Exponent,
+		), nil
+	}
+
+	// A solvent subaccount repays the borrowing at the oracle price, which is the bankruptcy price
+	// with a `TNC` of zero.
+	return k.getAssetBankruptcyPriceInQuoteQuantums(
+		ctx,
+		assetId,
+		psBig,
+		deltaQuantums,
+		lib.BigMin(tncBig, new(big.Int)),
+		tmmrBig,
+	)
+}
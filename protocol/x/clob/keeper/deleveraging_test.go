@@ -14,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
@@ -87,6 +88,7 @@ func TestGetInsuranceFundBalance(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -308,6 +310,11 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 					perpetual.Params.AtomicResolution,
 					perpetual.Params.DefaultFundingPpm,
 					perpetual.Params.LiquidityTier,
+					perpetual.Params.OpenInterestCapNotional,
+					perpetual.Params.FundingRateBounds,
+					perpetual.Params.InterestRatePpm,
+					perpetual.Params.FundingTickEpoch,
+					perpetual.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -317,6 +324,7 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 			canDeleverageSubaccount, err := ks.ClobKeeper.CanDeleverageSubaccount(
 				ks.Ctx,
 				*tc.subaccount.Id,
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.Id,
 			)
 			require.NoError(t, err)
 			require.Equal(
@@ -331,7 +339,8 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
-		subaccounts []satypes.Subaccount
+		subaccounts              []satypes.Subaccount
+		perpetualPositionEntries map[satypes.SubaccountId]*big.Int
 
 		// Parameters.
 		liquidatedSubaccountId satypes.SubaccountId
@@ -463,6 +472,61 @@ func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 			},
 			expectedQuantumsRemaining: new(big.Int),
 		},
+		"Offsets subaccounts in order of their auto-deleveraging rank": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_54999USD,
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(50_000_000), // 0.5 BTC
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(50_000_000), // 0.5 BTC
+						},
+					},
+				},
+			},
+			// Dave_Num1 entered their position at $40,000 and has an unrealized PnL of $5,000, so they are
+			// ranked ahead of Dave_Num0 who has no unrealized PnL.
+			perpetualPositionEntries: map[satypes.SubaccountId]*big.Int{
+				constants.Dave_Num0: big.NewInt(25_000_000_000),
+				constants.Dave_Num1: big.NewInt(20_000_000_000),
+			},
+			liquidatedSubaccountId: constants.Carl_Num0,
+			perpetualId:            0,
+			deltaQuantums:          big.NewInt(50_000_000),
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Dave_Num1,
+					// TNC of liquidated subaccount is $4,999, which means the bankruptcy price
+					// to close 0.5 BTC short is $27,499.5 and we close both positions at this price.
+					AssetPositions: keepertest.CreateUsdcAssetPosition(
+						big.NewInt(50_000_000_000 + 27_499_500_000),
+					),
+				},
+			},
+			expectedFills: []types.MatchPerpetualDeleveraging_Fill{
+				{
+					OffsettingSubaccountId: constants.Dave_Num1,
+					FillAmount:             50_000_000,
+				},
+			},
+			expectedQuantumsRemaining: new(big.Int),
+		},
 		"Skips subaccounts with positions on the same side": {
 			subaccounts: []satypes.Subaccount{
 				constants.Carl_Num0_1BTC_Short_54999USD,
@@ -636,6 +700,11 @@ func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -643,6 +712,9 @@ func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 			for _, subaccount := range tc.subaccounts {
 				ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
 			}
+			for subaccountId, entry := range tc.perpetualPositionEntries {
+				ks.ClobKeeper.SetPerpetualPositionEntryQuoteQuantums(ks.Ctx, subaccountId, tc.perpetualId, entry)
+			}
 
 			ks.BlockTimeKeeper.SetPreviousBlockInfo(ks.Ctx, &blocktimetypes.BlockInfo{
 				Timestamp: time.Unix(5, 0),
@@ -1058,6 +1130,11 @@ func TestProcessDeleveraging(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -1119,6 +1196,582 @@ func TestProcessDeleveraging(t *testing.T) {
 	}
 }
 
+func TestProcessAssetDeleveraging(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		liquidatedSubaccount satypes.Subaccount
+		offsettingSubaccount satypes.Subaccount
+		deltaQuantums        *big.Int
+
+		// Expectations.
+		expectedLiquidatedSubaccount satypes.Subaccount
+		expectedOffsettingSubaccount satypes.Subaccount
+		expectedErr                  error
+	}{
+		"Liquidated: under-collateralized, TNC > 0, repays borrowing at oracle price": {
+			liquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+					&constants.Short_Asset_1BTC,
+				),
+			},
+			offsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					&constants.Long_Asset_1BTC,
+				},
+			},
+			deltaQuantums: big.NewInt(100_000_000), // 1 BTC
+
+			expectedLiquidatedSubaccount: satypes.Subaccount{
+				Id:             &constants.Carl_Num0,
+				AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(4_000_000_000)),
+			},
+			expectedOffsettingSubaccount: satypes.Subaccount{
+				Id:             &constants.Dave_Num0,
+				AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(50_000_000_000)),
+			},
+		},
+		"Liquidated: under-collateralized, TNC > 0, partially repays borrowing": {
+			liquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+					&constants.Short_Asset_1BTC,
+				),
+			},
+			offsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					&constants.Long_Asset_1BTC,
+				},
+			},
+			deltaQuantums: big.NewInt(50_000_000), // 0.5 BTC
+
+			expectedLiquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(29_000_000_000)),
+					&satypes.AssetPosition{
+						AssetId:  constants.BtcUsd_Borrowable.Id,
+						Quantums: dtypes.NewInt(-50_000_000),
+					},
+				),
+			},
+			expectedOffsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(25_000_000_000)),
+					&satypes.AssetPosition{
+						AssetId:  constants.BtcUsd_Borrowable.Id,
+						Quantums: dtypes.NewInt(50_000_000),
+					},
+				),
+			},
+		},
+		"Liquidated: under-collateralized, TNC < 0, repays borrowing at bankruptcy price": {
+			liquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(49_000_000_000)),
+					&constants.Short_Asset_1BTC,
+				),
+			},
+			offsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					&constants.Long_Asset_1BTC,
+				},
+			},
+			deltaQuantums: big.NewInt(100_000_000), // 1 BTC
+
+			expectedLiquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+			},
+			expectedOffsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				// TNC of liquidated subaccount is -$1,000, which means the bankruptcy price
+				// to repay 1 BTC is $49,000.
+				AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(49_000_000_000)),
+			},
+		},
+		"Fails when deltaQuantums does not reduce the borrowing": {
+			liquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+					&constants.Short_Asset_1BTC,
+				),
+			},
+			offsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					&constants.Long_Asset_1BTC,
+				},
+			},
+			deltaQuantums: big.NewInt(-100_000_000), // -1 BTC
+
+			expectedErr: types.ErrInvalidAssetPositionSizeDelta,
+		},
+		"Fails when deltaQuantums is larger than the borrowing": {
+			liquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+					&constants.Short_Asset_1BTC,
+				),
+			},
+			offsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					{
+						AssetId:  constants.BtcUsd_Borrowable.Id,
+						Quantums: dtypes.NewInt(500_000_000), // 5 BTC
+					},
+				},
+			},
+			deltaQuantums: big.NewInt(500_000_000), // 5 BTC
+
+			expectedErr: types.ErrInvalidAssetPositionSizeDelta,
+		},
+		"Fails when deltaQuantums is larger than the offsetting subaccount's balance": {
+			liquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+					&constants.Short_Asset_1BTC,
+				),
+			},
+			offsettingSubaccount: constants.Dave_Num0_599USD,
+			deltaQuantums:        big.NewInt(100_000_000), // 1 BTC
+
+			expectedErr: types.ErrInvalidAssetPositionSizeDelta,
+		},
+		"Liquidated: negative USDC, seizes collateral at oracle price": {
+			liquidatedSubaccount: constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+			offsettingSubaccount: satypes.Subaccount{
+				Id:             &constants.Dave_Num0,
+				AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(50_000_000_000)),
+			},
+			deltaQuantums: big.NewInt(-92_000_000), // -0.92 BTC
+
+			expectedLiquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					{
+						AssetId:  constants.BtcUsd_Borrowable.Id,
+						Quantums: dtypes.NewInt(8_000_000),
+					},
+				},
+			},
+			expectedOffsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(4_000_000_000)),
+					&satypes.AssetPosition{
+						AssetId:  constants.BtcUsd_Borrowable.Id,
+						Quantums: dtypes.NewInt(92_000_000),
+					},
+				),
+			},
+		},
+		"Liquidated: negative USDC, partially seizes collateral": {
+			liquidatedSubaccount: constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+			offsettingSubaccount: satypes.Subaccount{
+				Id:             &constants.Dave_Num0,
+				AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(50_000_000_000)),
+			},
+			deltaQuantums: big.NewInt(-46_000_000), // -0.46 BTC
+
+			expectedLiquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(-23_000_000_000)),
+					&satypes.AssetPosition{
+						AssetId:  constants.BtcUsd_Borrowable.Id,
+						Quantums: dtypes.NewInt(54_000_000),
+					},
+				),
+			},
+			expectedOffsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(27_000_000_000)),
+					&satypes.AssetPosition{
+						AssetId:  constants.BtcUsd_Borrowable.Id,
+						Quantums: dtypes.NewInt(46_000_000),
+					},
+				),
+			},
+		},
+		"Fails to seize collateral when the liquidated subaccount's USDC balance is not negative": {
+			liquidatedSubaccount: satypes.Subaccount{
+				Id: &constants.Carl_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(1_000_000_000)),
+					&constants.Long_Asset_1BTC,
+				),
+			},
+			offsettingSubaccount: satypes.Subaccount{
+				Id:             &constants.Dave_Num0,
+				AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(50_000_000_000)),
+			},
+			deltaQuantums: big.NewInt(-100_000_000), // -1 BTC
+
+			expectedErr: types.ErrInvalidAssetPositionSizeDelta,
+		},
+		"Fails when deltaQuantums is larger than the seized collateral": {
+			liquidatedSubaccount: constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+			offsettingSubaccount: satypes.Subaccount{
+				Id:             &constants.Dave_Num0,
+				AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(500_000_000_000)),
+			},
+			deltaQuantums: big.NewInt(-200_000_000), // -2 BTC
+
+			expectedErr: types.ErrInvalidAssetPositionSizeDelta,
+		},
+		"Fails when the offsetting subaccount cannot pay for the seized collateral with USDC": {
+			liquidatedSubaccount: constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+			offsettingSubaccount: satypes.Subaccount{
+				Id: &constants.Dave_Num0,
+				AssetPositions: append(
+					keepertest.CreateUsdcAssetPosition(big.NewInt(10_000_000_000)),
+					&constants.Long_Asset_1BTC,
+				),
+			},
+			deltaQuantums: big.NewInt(-92_000_000), // -0.92 BTC
+
+			expectedErr: types.ErrInsufficientQuoteBalanceToBuySeizedCollateral,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			// Create the default markets.
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+
+			err := keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper)
+			require.NoError(t, err)
+
+			a := constants.BtcUsd_Borrowable
+			_, err = ks.AssetsKeeper.CreateAsset(
+				ks.Ctx,
+				a.Id,
+				a.Symbol,
+				a.Denom,
+				a.DenomExponent,
+				a.HasMarket,
+				a.MarketId,
+				a.AtomicResolution,
+				a.CollateralWeightPpm,
+			)
+			require.NoError(t, err)
+			_, err = ks.AssetsKeeper.SetMarginParams(
+				ks.Ctx,
+				a.Id,
+				a.InitialMarginPpm,
+				a.MaintenanceFractionPpm,
+				a.BorrowRatePpm,
+			)
+			require.NoError(t, err)
+			_, err = ks.AssetsKeeper.SetCollateralWeight(
+				ks.Ctx,
+				a.Id,
+				constants.BtcUsd_80PercentCollateralWeight.CollateralWeightPpm,
+			)
+			require.NoError(t, err)
+
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.liquidatedSubaccount)
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.offsettingSubaccount)
+
+			err = ks.ClobKeeper.ProcessAssetDeleveraging(
+				ks.Ctx,
+				*tc.liquidatedSubaccount.GetId(),
+				*tc.offsettingSubaccount.GetId(),
+				a.Id,
+				tc.deltaQuantums,
+			)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+
+				actualLiquidated := ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, *tc.liquidatedSubaccount.GetId())
+				require.Equal(
+					t,
+					tc.expectedLiquidatedSubaccount,
+					actualLiquidated,
+				)
+
+				actualOffsetting := ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, *tc.offsettingSubaccount.GetId())
+				require.Equal(
+					t,
+					tc.expectedOffsettingSubaccount,
+					actualOffsetting,
+				)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestMaybeDeleverageSubaccountAsset(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		subaccounts            []satypes.Subaccount
+		liquidatedSubaccountId satypes.SubaccountId
+
+		// Expectations.
+		expectedQuantumsDeleveraged *big.Int
+		expectedSubaccounts         []satypes.Subaccount
+		expectedOperationsQueue     []types.OperationRaw
+	}{
+		"Repays borrowed asset": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+						&constants.Short_Asset_1BTC,
+					),
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Long_Asset_1BTC,
+					},
+				},
+			},
+			liquidatedSubaccountId: constants.Carl_Num0,
+
+			expectedQuantumsDeleveraged: big.NewInt(100_000_000),
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id:             &constants.Carl_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(4_000_000_000)),
+				},
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(50_000_000_000)),
+				},
+			},
+			expectedOperationsQueue: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromAssetDeleveraging(
+					types.MatchAssetDeleveraging{
+						Liquidated: constants.Carl_Num0,
+						AssetId:    constants.BtcUsd_Borrowable.Id,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             100_000_000,
+							},
+						},
+					},
+				),
+			},
+		},
+		"Seizes collateral to repay negative USDC balance": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)),
+				},
+			},
+			liquidatedSubaccountId: constants.Carl_Num0,
+
+			// Only the 0.92 BTC needed to repay the -$46,000 USDC balance is seized.
+			expectedQuantumsDeleveraged: big.NewInt(92_000_000),
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.BtcUsd_Borrowable.Id,
+							Quantums: dtypes.NewInt(8_000_000),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+						&satypes.AssetPosition{
+							AssetId:  constants.BtcUsd_Borrowable.Id,
+							Quantums: dtypes.NewInt(92_000_000),
+						},
+					),
+				},
+			},
+			expectedOperationsQueue: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromAssetDeleveraging(
+					types.MatchAssetDeleveraging{
+						Liquidated: constants.Carl_Num0,
+						AssetId:    constants.BtcUsd_Borrowable.Id,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             92_000_000,
+							},
+						},
+					},
+				),
+			},
+		},
+		"Partially seizes collateral when offsetting subaccount has insufficient USDC": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(23_000_000_000)),
+				},
+			},
+			liquidatedSubaccountId: constants.Carl_Num0,
+
+			expectedQuantumsDeleveraged: big.NewInt(46_000_000),
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(-23_000_000_000)),
+						&satypes.AssetPosition{
+							AssetId:  constants.BtcUsd_Borrowable.Id,
+							Quantums: dtypes.NewInt(54_000_000),
+						},
+					),
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.BtcUsd_Borrowable.Id,
+							Quantums: dtypes.NewInt(46_000_000),
+						},
+					},
+				},
+			},
+			expectedOperationsQueue: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromAssetDeleveraging(
+					types.MatchAssetDeleveraging{
+						Liquidated: constants.Carl_Num0,
+						AssetId:    constants.BtcUsd_Borrowable.Id,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             46_000_000,
+							},
+						},
+					},
+				),
+			},
+		},
+		"Does not seize collateral when no subaccount has USDC to buy it": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Long_Asset_1BTC,
+					},
+				},
+			},
+			liquidatedSubaccountId: constants.Carl_Num0,
+
+			expectedQuantumsDeleveraged: big.NewInt(0),
+			expectedSubaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+			},
+			expectedOperationsQueue: []types.OperationRaw{},
+		},
+		"Does not seize collateral of a subaccount which is not liquidatable": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(-30_000_000_000)),
+						&constants.Long_Asset_1BTC,
+					),
+				},
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)),
+				},
+			},
+			liquidatedSubaccountId: constants.Carl_Num0,
+
+			expectedQuantumsDeleveraged: big.NewInt(0),
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(-30_000_000_000)),
+						&constants.Long_Asset_1BTC,
+					),
+				},
+			},
+			expectedOperationsQueue: []types.OperationRaw{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+			ctx := ks.Ctx.WithIsCheckTx(true)
+
+			// Create the default markets.
+			keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+
+			err := keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper)
+			require.NoError(t, err)
+
+			// BTC can be both borrowed and used as collateral.
+			a := constants.BtcUsd_Borrowable
+			_, err = ks.AssetsKeeper.CreateAsset(
+				ctx,
+				a.Id,
+				a.Symbol,
+				a.Denom,
+				a.DenomExponent,
+				a.HasMarket,
+				a.MarketId,
+				a.AtomicResolution,
+				constants.BtcUsd_80PercentCollateralWeight.CollateralWeightPpm,
+			)
+			require.NoError(t, err)
+			_, err = ks.AssetsKeeper.SetMarginParams(
+				ctx,
+				a.Id,
+				a.InitialMarginPpm,
+				a.MaintenanceFractionPpm,
+				a.BorrowRatePpm,
+			)
+			require.NoError(t, err)
+
+			for _, subaccount := range tc.subaccounts {
+				ks.SubaccountsKeeper.SetSubaccount(ctx, subaccount)
+			}
+
+			quantumsDeleveraged, err := ks.ClobKeeper.MaybeDeleverageSubaccountAsset(ctx, tc.liquidatedSubaccountId)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedQuantumsDeleveraged, quantumsDeleveraged)
+
+			for _, subaccount := range tc.expectedSubaccounts {
+				require.Equal(t, subaccount, ks.SubaccountsKeeper.GetSubaccount(ctx, *subaccount.Id))
+			}
+
+			require.Equal(
+				t,
+				tc.expectedOperationsQueue,
+				ks.ClobKeeper.GetOperations(ctx).GetOperationsQueue(),
+			)
+		})
+	}
+}
+
 func TestProcessDeleveraging_Rounding(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
@@ -1216,6 +1869,11 @@ func TestProcessDeleveraging_Rounding(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
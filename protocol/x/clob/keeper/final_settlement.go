@@ -0,0 +1,464 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	gometrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// DelistClobPair delists a perpetual clob pair by moving it to final settlement. This function:
+//   - Moves the clob pair to `STATUS_FINAL_SETTLEMENT`, after which no orders or operations are accepted
+//     for it.
+//   - Removes all stateful orders of the clob pair from state. The removed orders are added to the
+//     `RemovedStatefulOrderIds` of the `ProcessProposerMatchesEvents` so that they are purged from the
+//     memclob, and the clob pair is added to its `FinalSettlementClobPairIds` so that the remaining
+//     short-term orders are removed from the memclob in `PrepareCheckState`.
+//   - Pays the funding accrued by the perpetual during its current funding-tick epoch.
+//   - Marks the perpetual as settled at `settlementPrice`, or at the current oracle price if
+//     `settlementPrice` is zero.
+//
+// The open positions in the perpetual are closed at the settlement price over the following blocks, see
+// `SettlePendingFinalSettlements`.
+//
+// An error is returned if the clob pair can't be delisted, in which case the caller must discard all
+// state changes.
+func (k Keeper) DelistClobPair(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	settlementPrice uint64,
+) error {
+	clobPair, found := k.GetClobPair(ctx, clobPairId)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidDelistClobPair,
+			"ClobPair with id %d not found in state",
+			clobPairId,
+		)
+	}
+
+	perpetualId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return errorsmod.Wrapf(
+			types.ErrClobPairNotDelistable,
+			"ClobPair with id %d is not a perpetual clob pair",
+			clobPairId,
+		)
+	}
+	if clobPair.Status == types.ClobPair_STATUS_FINAL_SETTLEMENT {
+		return errorsmod.Wrapf(
+			types.ErrClobPairNotDelistable,
+			"ClobPair with id %d is already in final settlement",
+			clobPairId,
+		)
+	}
+
+	if settlementPrice == 0 {
+		_, marketPrice, err := k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, perpetualId)
+		if err != nil {
+			return err
+		}
+		if marketPrice.Price == 0 {
+			return errorsmod.Wrapf(
+				types.ErrInvalidDelistClobPair,
+				"perpetual %d has no oracle price to settle positions at",
+				perpetualId,
+			)
+		}
+		settlementPrice = marketPrice.Price
+	}
+
+	clobPair.Status = types.ClobPair_STATUS_FINAL_SETTLEMENT
+	k.setClobPair(ctx, clobPair)
+
+	// Send UpdateClobPair to indexer.
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeUpdateClobPair,
+		indexerevents.UpdateClobPairEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewUpdateClobPairEvent(
+				clobPair.GetClobPairId(),
+				clobPair.Status,
+				clobPair.QuantumConversionExponent,
+				types.SubticksPerTick(clobPair.GetSubticksPerTick()),
+				satypes.BaseQuantums(clobPair.GetStepBaseQuantums()),
+			),
+		),
+	)
+
+	k.removeStatefulOrdersForClobPair(ctx, clobPairId)
+
+	processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+	processProposerMatchesEvents.FinalSettlementClobPairIds = append(
+		processProposerMatchesEvents.FinalSettlementClobPairIds,
+		clobPairId.ToUint32(),
+	)
+	k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+
+	// Funding is settled on positions when they are updated, so the final funding payment must be
+	// processed before the positions are closed.
+	if err := k.perpetualsKeeper.ProcessFinalFunding(ctx, perpetualId); err != nil {
+		return err
+	}
+
+	if err := k.perpetualsKeeper.SettlePerpetual(ctx, perpetualId, settlementPrice); err != nil {
+		return err
+	}
+
+	k.setPendingFinalSettlementQuoteQuantums(ctx, clobPairId, new(big.Int))
+
+	return nil
+}
+
+// getPendingFinalSettlementQuoteQuantums returns the total quote quantums paid out to subaccounts so far
+// for closing their positions in the final settlement of a clob pair, and whether the final settlement of
+// the clob pair is pending.
+func (k Keeper) getPendingFinalSettlementQuoteQuantums(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (
+	totalQuoteQuantumsDelta *big.Int,
+	pending bool,
+) {
+	store := k.getPendingFinalSettlementStore(ctx)
+	b := store.Get(lib.Uint32ToKey(clobPairId.ToUint32()))
+	if b == nil {
+		return new(big.Int), false
+	}
+
+	var total dtypes.SerializableInt
+	if err := total.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return total.BigInt(), true
+}
+
+// setPendingFinalSettlementQuoteQuantums marks the final settlement of a clob pair as pending, with the
+// total quote quantums paid out to subaccounts so far for closing their positions.
+func (k Keeper) setPendingFinalSettlementQuoteQuantums(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	totalQuoteQuantumsDelta *big.Int,
+) {
+	b, err := dtypes.NewIntFromBigInt(totalQuoteQuantumsDelta).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store := k.getPendingFinalSettlementStore(ctx)
+	store.Set(lib.Uint32ToKey(clobPairId.ToUint32()), b)
+}
+
+// getPendingFinalSettlementClobPairIds returns the IDs of all clob pairs whose final settlement is
+// pending, in ascending order.
+func (k Keeper) getPendingFinalSettlementClobPairIds(ctx sdk.Context) (clobPairIds []types.ClobPairId) {
+	iterator := k.getPendingFinalSettlementStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		clobPairIds = append(clobPairIds, types.ClobPairId(binary.BigEndian.Uint32(iterator.Key())))
+	}
+	return clobPairIds
+}
+
+// SettlePendingFinalSettlements closes the open positions in the perpetuals of clob pairs in final
+// settlement at their settlement prices, closing at most `MaxFinalSettlementPositionsPerBlock` positions
+// per block. Positions are closed with forced subaccount updates, so that the final settlement can't be
+// blocked by the state of any subaccount. A position that can't be closed is logged and retried in the
+// next block.
+//
+// Once all positions in the perpetual of a clob pair are closed, the total quote quantums paid out to
+// subaccounts for closing them is covered by the insurance fund, and the final settlement of the clob pair
+// is complete. If the insurance fund can't cover the payment, this is retried in the next block.
+func (k Keeper) SettlePendingFinalSettlements(ctx sdk.Context) {
+	numPositionsLeftToSettle := types.MaxFinalSettlementPositionsPerBlock
+	for _, clobPairId := range k.getPendingFinalSettlementClobPairIds(ctx) {
+		clobPair := k.mustGetClobPair(ctx, clobPairId)
+		perpetualId := clobPair.MustGetPerpetualId()
+		perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpetualId)
+		if err != nil {
+			panic(err)
+		}
+
+		// Collect the subaccounts first since closing their positions modifies the index being iterated
+		// over.
+		subaccountIds := make([]satypes.SubaccountId, 0)
+		for _, isLong := range []bool{true, false} {
+			k.subaccountsKeeper.ForEachSubaccountIdWithPerpetualPosition(
+				ctx,
+				perpetualId,
+				isLong,
+				func(subaccountId satypes.SubaccountId) (finished bool) {
+					if len(subaccountIds) >= numPositionsLeftToSettle {
+						return true
+					}
+					subaccountIds = append(subaccountIds, subaccountId)
+					return false
+				},
+			)
+		}
+		numPositionsLeftToSettle -= len(subaccountIds)
+
+		totalQuoteQuantumsDelta, _ := k.getPendingFinalSettlementQuoteQuantums(ctx, clobPairId)
+		numPositionsNotSettled := 0
+		for _, subaccountId := range subaccountIds {
+			// Branch the state so that a position that fails to close leaves no partial updates behind.
+			settleCtx, writeCache := ctx.CacheContext()
+			quoteQuantumsDelta, err := k.settlePerpetualPosition(
+				settleCtx,
+				subaccountId,
+				perpetualId,
+				perpetual.SettlementPrice,
+			)
+			if err != nil {
+				numPositionsNotSettled++
+				k.Logger(ctx).Error(
+					"SettlePendingFinalSettlements: failed to close position",
+					"clobPairId",
+					clobPairId,
+					"subaccountId",
+					subaccountId,
+					"error",
+					err,
+				)
+				continue
+			}
+			writeCache()
+			totalQuoteQuantumsDelta.Add(totalQuoteQuantumsDelta, quoteQuantumsDelta)
+		}
+		k.setPendingFinalSettlementQuoteQuantums(ctx, clobPairId, totalQuoteQuantumsDelta)
+
+		// Positions are left to close if the budget ran out or if any of them failed to close.
+		if numPositionsNotSettled > 0 || k.hasPerpetualPositions(ctx, perpetualId) {
+			continue
+		}
+
+		insuranceFundDelta := new(big.Int).Neg(totalQuoteQuantumsDelta)
+		if !k.IsValidInsuranceFundDelta(ctx, insuranceFundDelta) {
+			k.Logger(ctx).Error(
+				"SettlePendingFinalSettlements: insurance fund can't cover final settlement",
+				"error",
+				errorsmod.Wrapf(
+					types.ErrInsufficientInsuranceFundForFinalSettlement,
+					"ClobPair id = (%d), insurance fund delta = (%s)",
+					clobPairId,
+					insuranceFundDelta,
+				),
+			)
+			continue
+		}
+		if err := k.subaccountsKeeper.TransferInsuranceFundPayments(ctx, insuranceFundDelta); err != nil {
+			k.Logger(ctx).Error(
+				"SettlePendingFinalSettlements: failed to transfer insurance fund payment",
+				"clobPairId",
+				clobPairId,
+				"error",
+				err,
+			)
+			continue
+		}
+
+		k.getPendingFinalSettlementStore(ctx).Delete(lib.Uint32ToKey(clobPairId.ToUint32()))
+
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.FinalSettlement, metrics.Count},
+			1,
+			[]gometrics.Label{
+				metrics.GetLabelForIntValue(metrics.ClobPairId, int(clobPairId)),
+			},
+		)
+	}
+}
+
+// hasPerpetualPositions returns true if any subaccount has a position in the perpetual.
+func (k Keeper) hasPerpetualPositions(ctx sdk.Context, perpetualId uint32) (hasPositions bool) {
+	for _, isLong := range []bool{true, false} {
+		k.subaccountsKeeper.ForEachSubaccountIdWithPerpetualPosition(
+			ctx,
+			perpetualId,
+			isLong,
+			func(subaccountId satypes.SubaccountId) (finished bool) {
+				hasPositions = true
+				return true
+			},
+		)
+	}
+	return hasPositions
+}
+
+// removeStatefulOrdersForClobPair removes all stateful orders of a clob pair from state, including
+// untriggered conditional orders, and emits an on-chain indexer event for each removed order. The removed
+// order IDs are added to the `RemovedStatefulOrderIds` of the `ProcessProposerMatchesEvents` so that they
+// are purged from the memclob. The other orders of the order groups of removed orders are removed as
+// described by `RemoveOrderGroupSiblings`.
+func (k Keeper) removeStatefulOrdersForClobPair(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) {
+	removedOrderIds := make([]types.OrderId, 0)
+	for _, order := range k.GetAllStatefulOrders(ctx) {
+		orderId := order.OrderId
+		if orderId.ClobPairId != clobPairId.ToUint32() {
+			continue
+		}
+
+		// The order may have already been removed as the sibling of an order group.
+		if _, found := k.GetLongTermOrderPlacement(ctx, orderId); !found {
+			continue
+		}
+
+		removedOrderIds = append(removedOrderIds, orderId)
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					orderId,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_FINAL_SETTLEMENT,
+				),
+			),
+		)
+		k.MustRemoveStatefulOrder(ctx, orderId)
+	}
+
+	if len(removedOrderIds) > 0 {
+		processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+		processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+			processProposerMatchesEvents.RemovedStatefulOrderIds,
+			removedOrderIds...,
+		)
+		k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+	}
+}
+
+// settlePerpetualPosition closes the position of a subaccount in a perpetual at `settlementPrice` and
+// returns the change in the subaccount's quote balance. The position is closed at the bankruptcy price
+// instead if that is more favorable to the subaccount, so that closing the position never leaves the
+// subaccount with negative collateral; the difference is covered by the insurance fund. Any collateral
+// left in the isolated margin of the position is returned to the cross-margined collateral.
+func (k Keeper) settlePerpetualPosition(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	settlementPrice uint64,
+) (
+	quoteQuantumsDelta *big.Int,
+	err error,
+) {
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(perpetualId)
+	if !exists {
+		return new(big.Int), nil
+	}
+	deltaQuantums := new(big.Int).Neg(position.GetBigQuantums())
+
+	perpetual, marketPrice, err := k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, perpetualId)
+	if err != nil {
+		return nil, err
+	}
+	quoteQuantumsDelta = new(big.Int).Neg(
+		lib.BaseToQuoteQuantums(
+			deltaQuantums,
+			perpetual.Params.AtomicResolution,
+			settlementPrice,
+			marketPrice.Exponent,
+		),
+	)
+
+	// The bankruptcy price is only defined if the margin group of the position has a maintenance margin
+	// requirement.
+	_, _, maintenanceMargin, err := k.getNetCollateralAndMarginRequirementsForPerpetual(
+		ctx,
+		subaccountId,
+		perpetualId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if maintenanceMargin.Sign() > 0 {
+		bankruptcyPriceQuoteQuantums, err := k.GetBankruptcyPriceInQuoteQuantums(
+			ctx,
+			subaccountId,
+			perpetualId,
+			deltaQuantums,
+		)
+		if err != nil {
+			return nil, err
+		}
+		quoteQuantumsDelta = lib.BigMax(quoteQuantumsDelta, bankruptcyPriceQuoteQuantums)
+	}
+
+	updates := []satypes.Update{
+		{
+			AssetUpdates: []satypes.AssetUpdate{
+				{
+					AssetId:          assettypes.AssetUsdc.Id,
+					BigQuantumsDelta: quoteQuantumsDelta,
+				},
+			},
+			PerpetualUpdates: []satypes.PerpetualUpdate{
+				{
+					PerpetualId:      perpetualId,
+					BigQuantumsDelta: deltaQuantums,
+				},
+			},
+			SubaccountId: subaccountId,
+		},
+	}
+	if err := k.applySettlementUpdates(ctx, updates); err != nil {
+		return nil, err
+	}
+
+	k.updatePerpetualPositionEntry(
+		ctx,
+		subaccountId,
+		perpetualId,
+		deltaQuantums,
+		new(big.Int).Neg(quoteQuantumsDelta),
+	)
+
+	// Return the collateral left in the isolated margin of the closed position to the cross-margined
+	// collateral, since positions in the settled perpetual can't be opened again.
+	subaccount = k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	if isolatedMargin, isolated := subaccount.GetIsolatedMarginForId(perpetualId); isolated {
+		updates := []satypes.Update{
+			{
+				IsolatedCollateralUpdates: []satypes.IsolatedCollateralUpdate{
+					{
+						PerpetualId:      perpetualId,
+						BigQuantumsDelta: new(big.Int).Neg(isolatedMargin.GetBigQuoteBalance()),
+					},
+				},
+				SubaccountId: subaccountId,
+			},
+		}
+		if err := k.applySettlementUpdates(ctx, updates); err != nil {
+			return nil, err
+		}
+	}
+
+	return quoteQuantumsDelta, nil
+}
+
+// applySettlementUpdates applies subaccount updates made during final settlement. The updates are forced,
+// since the perpetual is already settled and positions must be closed regardless of the collateralization
+// of their subaccounts.
+func (k Keeper) applySettlementUpdates(
+	ctx sdk.Context,
+	updates []satypes.Update,
+) error {
+	return k.subaccountsKeeper.ForceUpdateSubaccounts(ctx, updates)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdlRank returns the auto-deleveraging rank of a subaccount's position in a perpetual among all positions
+// on the same side. Returns a `NotFound` error if the subaccount has no position in the perpetual.
+func (k Keeper) AdlRank(
+	c context.Context,
+	req *types.QueryAdlRankRequest,
+) (*types.QueryAdlRankResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	subaccountId := satypes.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	if err := subaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetPerpetualPositionForId(req.PerpetualId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	bigUnrealizedPnl, _, err := k.GetPerpetualPositionUnrealizedPnl(
+		ctx,
+		subaccountId,
+		req.PerpetualId,
+		position.GetBigQuantums(),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &types.QueryAdlRankResponse{
+		IsLong:                     position.GetIsLong(),
+		UnrealizedPnlQuoteQuantums: dtypes.NewIntFromBigInt(bigUnrealizedPnl),
+	}
+	rankedSubaccountIds := k.GetAdlRankedSubaccountIds(ctx, req.PerpetualId, response.IsLong)
+	response.Total = uint32(len(rankedSubaccountIds))
+	for i, rankedSubaccountId := range rankedSubaccountIds {
+		if rankedSubaccountId == subaccountId {
+			response.Rank = uint32(i + 1)
+			break
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdlRank(t *testing.T) {
+	tests := map[string]struct {
+		req *types.QueryAdlRankRequest
+
+		// Expectations.
+		res     *types.QueryAdlRankResponse
+		errCode codes.Code
+	}{
+		"Success: highest ranked long position": {
+			req: &types.QueryAdlRankRequest{
+				Owner:       constants.Dave_Num1.Owner,
+				Number:      constants.Dave_Num1.Number,
+				PerpetualId: 0,
+			},
+			res: &types.QueryAdlRankResponse{
+				Rank:                       1,
+				Total:                      2,
+				IsLong:                     true,
+				UnrealizedPnlQuoteQuantums: dtypes.NewInt(10_000_000_000),
+			},
+		},
+		"Success: long position without a tracked entry notional": {
+			req: &types.QueryAdlRankRequest{
+				Owner:       constants.Dave_Num0.Owner,
+				Number:      constants.Dave_Num0.Number,
+				PerpetualId: 0,
+			},
+			res: &types.QueryAdlRankResponse{
+				Rank:                       2,
+				Total:                      2,
+				IsLong:                     true,
+				UnrealizedPnlQuoteQuantums: dtypes.NewInt(0),
+			},
+		},
+		"Success: short position": {
+			req: &types.QueryAdlRankRequest{
+				Owner:       constants.Carl_Num0.Owner,
+				Number:      constants.Carl_Num0.Number,
+				PerpetualId: 0,
+			},
+			res: &types.QueryAdlRankResponse{
+				Rank:                       1,
+				Total:                      1,
+				IsLong:                     false,
+				UnrealizedPnlQuoteQuantums: dtypes.NewInt(0),
+			},
+		},
+		"Failure: no position in the perpetual": {
+			req: &types.QueryAdlRankRequest{
+				Owner:       constants.Dave_Num0.Owner,
+				Number:      constants.Dave_Num0.Number,
+				PerpetualId: 1,
+			},
+			errCode: codes.NotFound,
+		},
+		"Failure: invalid owner": {
+			req: &types.QueryAdlRankRequest{
+				Owner:       "invalid",
+				Number:      0,
+				PerpetualId: 0,
+			},
+			errCode: codes.InvalidArgument,
+		},
+		"Failure: nil request": {
+			req:     nil,
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ks := newAdlTestContext(t)
+			for _, subaccount := range []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_54999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+				constants.Dave_Num1_1BTC_Long_50000USD,
+			} {
+				ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+			}
+			ks.ClobKeeper.SetPerpetualPositionEntryQuoteQuantums(
+				ks.Ctx,
+				constants.Dave_Num1,
+				0,
+				big.NewInt(40_000_000_000),
+			)
+
+			res, err := ks.ClobKeeper.AdlRank(sdk.WrapSDKContext(ks.Ctx), tc.req)
+			if tc.errCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tc.errCode, status.Code(err))
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
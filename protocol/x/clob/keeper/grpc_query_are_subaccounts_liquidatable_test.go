@@ -141,6 +141,11 @@ func TestAreSubaccountsLiquidatable(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
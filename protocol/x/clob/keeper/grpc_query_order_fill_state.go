@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderFillState returns the fill amount of an order, and the order itself if it is resting in the
+// memclob or is a placed stateful order. Returns a `NotFound` error if the order is unknown.
+func (k Keeper) OrderFillState(
+	c context.Context,
+	req *types.QueryOrderFillStateRequest,
+) (*types.QueryOrderFillStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orderId := types.OrderId{
+		SubaccountId: satypes.SubaccountId{
+			Owner:  req.Owner,
+			Number: req.Number,
+		},
+		ClientId:   req.ClientId,
+		OrderFlags: req.OrderFlags,
+		ClobPairId: req.ClobPairId,
+	}
+	if err := orderId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	exists, fillAmount, prunableBlockHeight := k.GetOrderFillAmount(ctx, orderId)
+	response := &types.QueryOrderFillStateResponse{
+		FillAmount:          fillAmount.ToUint64(),
+		PrunableBlockHeight: prunableBlockHeight,
+	}
+
+	if order, found := k.MemClob.GetOrder(ctx, orderId); found {
+		response.IsResting = true
+		response.Order = &order
+	} else if orderId.IsStatefulOrder() {
+		if placement, found := k.GetLongTermOrderPlacement(ctx, orderId); found {
+			response.Order = &placement.Order
+		}
+	}
+
+	if !exists && response.Order == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return response, nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func newQueryOrderFillStateRequest(orderId types.OrderId) *types.QueryOrderFillStateRequest {
+	return &types.QueryOrderFillStateRequest{
+		Owner:      orderId.SubaccountId.Owner,
+		Number:     orderId.SubaccountId.Number,
+		ClientId:   orderId.ClientId,
+		OrderFlags: orderId.OrderFlags,
+		ClobPairId: orderId.ClobPairId,
+	}
+}
+
+func TestOrderFillState(t *testing.T) {
+	shortTermOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	longTermOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+
+	tests := map[string]struct {
+		req *types.QueryOrderFillStateRequest
+
+		// State.
+		restingOrders        []types.Order
+		longTermOrders       []types.Order
+		orderFillAmounts     map[types.OrderId]uint64
+		prunableBlockHeights map[types.OrderId]uint32
+
+		// Expectations.
+		res     *types.QueryOrderFillStateResponse
+		err     error
+		errCode codes.Code
+	}{
+		"Success: resting short-term order that is partially filled": {
+			req:                  newQueryOrderFillStateRequest(shortTermOrder.OrderId),
+			restingOrders:        []types.Order{shortTermOrder},
+			orderFillAmounts:     map[types.OrderId]uint64{shortTermOrder.OrderId: 2},
+			prunableBlockHeights: map[types.OrderId]uint32{shortTermOrder.OrderId: 35},
+			res: &types.QueryOrderFillStateResponse{
+				FillAmount:          2,
+				PrunableBlockHeight: 35,
+				IsResting:           true,
+				Order:               &shortTermOrder,
+			},
+		},
+		"Success: short-term order that is fully filled": {
+			req:                  newQueryOrderFillStateRequest(shortTermOrder.OrderId),
+			orderFillAmounts:     map[types.OrderId]uint64{shortTermOrder.OrderId: 5},
+			prunableBlockHeights: map[types.OrderId]uint32{shortTermOrder.OrderId: 35},
+			res: &types.QueryOrderFillStateResponse{
+				FillAmount:          5,
+				PrunableBlockHeight: 35,
+			},
+		},
+		"Success: unfilled resting short-term order": {
+			req:           newQueryOrderFillStateRequest(shortTermOrder.OrderId),
+			restingOrders: []types.Order{shortTermOrder},
+			res: &types.QueryOrderFillStateResponse{
+				IsResting: true,
+				Order:     &shortTermOrder,
+			},
+		},
+		"Success: long-term order placed in state that is not resting": {
+			req:              newQueryOrderFillStateRequest(longTermOrder.OrderId),
+			longTermOrders:   []types.Order{longTermOrder},
+			orderFillAmounts: map[types.OrderId]uint64{longTermOrder.OrderId: 3},
+			res: &types.QueryOrderFillStateResponse{
+				FillAmount: 3,
+				Order:      &longTermOrder,
+			},
+		},
+		"Failure: order not found": {
+			req: newQueryOrderFillStateRequest(shortTermOrder.OrderId),
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		"Failure: invalid order flags": {
+			req: &types.QueryOrderFillStateRequest{
+				Owner:      constants.Alice_Num0.Owner,
+				Number:     constants.Alice_Num0.Number,
+				OrderFlags: 3,
+			},
+			errCode: codes.InvalidArgument,
+		},
+		"Failure: nil request": {
+			req: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			for _, order := range tc.restingOrders {
+				memClob.On("GetOrder", mock.Anything, order.OrderId).Return(order, true)
+			}
+			memClob.On("GetOrder", mock.Anything, mock.Anything).Return(types.Order{}, false)
+
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			for _, order := range tc.longTermOrders {
+				ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, order, 1)
+			}
+			for orderId, fillAmount := range tc.orderFillAmounts {
+				ks.ClobKeeper.SetOrderFillAmount(
+					ks.Ctx,
+					orderId,
+					satypes.BaseQuantums(fillAmount),
+					tc.prunableBlockHeights[orderId],
+				)
+			}
+
+			res, err := ks.ClobKeeper.OrderFillState(sdk.WrapSDKContext(ks.Ctx), tc.req)
+			switch {
+			case tc.err != nil:
+				require.ErrorIs(t, err, tc.err)
+			case tc.errCode != codes.OK:
+				require.Equal(t, tc.errCode, status.Code(err))
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderbookL2 returns the price levels of a CLOB pair's orderbook in the memclob.
+func (k Keeper) OrderbookL2(
+	c context.Context,
+	req *types.QueryOrderbookL2Request,
+) (*types.QueryOrderbookL2Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	clobPairId := types.ClobPairId(req.ClobPairId)
+	if _, found := k.GetClobPair(ctx, clobPairId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	bids, asks, err := k.MemClob.GetOrderbookLevels(ctx, clobPairId, req.Depth)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOrderbookL2Response{
+		Bids: bids,
+		Asks: asks,
+	}, nil
+}
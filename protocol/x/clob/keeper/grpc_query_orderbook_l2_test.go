@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestOrderbookL2(t *testing.T) {
+	bids := []types.OrderbookLevel{
+		{Subticks: 20, Quantums: 10, NumOrders: 2},
+		{Subticks: 10, Quantums: 5, NumOrders: 1},
+	}
+	asks := []types.OrderbookLevel{
+		{Subticks: 30, Quantums: 15, NumOrders: 1},
+	}
+
+	tests := map[string]struct {
+		req                   *types.QueryOrderbookL2Request
+		setUpMockMemClob      func(mck *mocks.MemClob)
+		res                   *types.QueryOrderbookL2Response
+		err                   error
+		expectedErrorContains string
+	}{
+		"Success": {
+			req: &types.QueryOrderbookL2Request{ClobPairId: 0, Depth: 2},
+			setUpMockMemClob: func(mck *mocks.MemClob) {
+				mck.On("GetOrderbookLevels", mock.Anything, types.ClobPairId(0), uint32(2)).
+					Return(bids, asks, nil)
+			},
+			res: &types.QueryOrderbookL2Response{Bids: bids, Asks: asks},
+		},
+		"Success: empty orderbook": {
+			req: &types.QueryOrderbookL2Request{ClobPairId: 1},
+			setUpMockMemClob: func(mck *mocks.MemClob) {
+				mck.On("GetOrderbookLevels", mock.Anything, types.ClobPairId(1), uint32(0)).
+					Return([]types.OrderbookLevel{}, []types.OrderbookLevel{}, nil)
+			},
+			res: &types.QueryOrderbookL2Response{
+				Bids: []types.OrderbookLevel{},
+				Asks: []types.OrderbookLevel{},
+			},
+		},
+		"Failure: memclob error": {
+			req: &types.QueryOrderbookL2Request{ClobPairId: 0},
+			setUpMockMemClob: func(mck *mocks.MemClob) {
+				mck.On("GetOrderbookLevels", mock.Anything, types.ClobPairId(0), uint32(0)).
+					Return(nil, nil, errors.New("GetOrderbookLevels error"))
+			},
+			expectedErrorContains: "GetOrderbookLevels error",
+		},
+		"Failure: clob pair not found": {
+			req:              &types.QueryOrderbookL2Request{ClobPairId: 100},
+			setUpMockMemClob: func(mck *mocks.MemClob) {},
+			err:              status.Error(codes.NotFound, "not found"),
+		},
+		"Failure: nil request": {
+			req:              nil,
+			setUpMockMemClob: func(mck *mocks.MemClob) {},
+			err:              status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("CreateOrderbook", mock.Anything, mock.Anything).Return()
+			tc.setUpMockMemClob(memClob)
+
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+			keepertest.CreateNClobPair(
+				t,
+				ks.ClobKeeper,
+				ks.PerpetualsKeeper,
+				ks.PricesKeeper,
+				ks.Ctx,
+				2,
+				mockIndexerEventManager,
+			)
+
+			res, err := ks.ClobKeeper.OrderbookL2(sdk.WrapSDKContext(ks.Ctx), tc.req)
+			switch {
+			case tc.err != nil:
+				require.ErrorIs(t, err, tc.err)
+			case tc.expectedErrorContains != "":
+				require.ErrorContains(t, err, tc.expectedErrorContains)
+				require.Equal(t, codes.Internal, status.Code(err))
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
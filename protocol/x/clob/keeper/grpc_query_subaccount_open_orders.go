@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubaccountOpenOrders returns the orders of a subaccount resting in the memclob.
+func (k Keeper) SubaccountOpenOrders(
+	c context.Context,
+	req *types.QuerySubaccountOpenOrdersRequest,
+) (*types.QuerySubaccountOpenOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orders := k.MemClob.GetSubaccountOpenOrders(
+		ctx,
+		satypes.SubaccountId{
+			Owner:  req.Owner,
+			Number: req.Number,
+		},
+	)
+
+	return &types.QuerySubaccountOpenOrdersResponse{Orders: orders}, nil
+}
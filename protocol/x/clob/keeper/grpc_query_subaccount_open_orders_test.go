@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestSubaccountOpenOrders(t *testing.T) {
+	tests := map[string]struct {
+		req              *types.QuerySubaccountOpenOrdersRequest
+		setUpMockMemClob func(mck *mocks.MemClob)
+		res              *types.QuerySubaccountOpenOrdersResponse
+		err              error
+	}{
+		"Success": {
+			req: &types.QuerySubaccountOpenOrdersRequest{
+				Owner:  constants.Alice_Num0.Owner,
+				Number: constants.Alice_Num0.Number,
+			},
+			setUpMockMemClob: func(mck *mocks.MemClob) {
+				mck.On("GetSubaccountOpenOrders", mock.Anything, constants.Alice_Num0).Return(
+					[]types.Order{
+						constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+						constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+					},
+				)
+			},
+			res: &types.QuerySubaccountOpenOrdersResponse{
+				Orders: []types.Order{
+					constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+					constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+				},
+			},
+		},
+		"Success: no open orders": {
+			req: &types.QuerySubaccountOpenOrdersRequest{
+				Owner:  constants.Bob_Num0.Owner,
+				Number: constants.Bob_Num0.Number,
+			},
+			setUpMockMemClob: func(mck *mocks.MemClob) {
+				mck.On("GetSubaccountOpenOrders", mock.Anything, constants.Bob_Num0).Return([]types.Order{})
+			},
+			res: &types.QuerySubaccountOpenOrdersResponse{Orders: []types.Order{}},
+		},
+		"Failure: nil request": {
+			req:              nil,
+			setUpMockMemClob: func(mck *mocks.MemClob) {},
+			err:              status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			tc.setUpMockMemClob(memClob)
+
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			res, err := ks.ClobKeeper.SubaccountOpenOrders(sdk.WrapSDKContext(ks.Ctx), tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+				memClob.AssertExpectations(t)
+			}
+		})
+	}
+}
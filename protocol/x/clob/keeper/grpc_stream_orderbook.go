@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamOrderbookUpdates streams orderbook updates and fills for the requested CLOB pairs until the
+// client disconnects.
+func (k Keeper) StreamOrderbookUpdates(
+	req *types.StreamOrderbookUpdatesRequest,
+	stream types.Query_StreamOrderbookUpdatesServer,
+) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return k.GetGrpcStreamingManager().Subscribe(*req, stream)
+}
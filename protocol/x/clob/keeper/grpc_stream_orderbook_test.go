@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestStreamOrderbookUpdates(t *testing.T) {
+	tests := map[string]struct {
+		req *types.StreamOrderbookUpdatesRequest
+		err error
+	}{
+		"Failure: nil request": {
+			req: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Failure: streaming is not enabled": {
+			req: &types.StreamOrderbookUpdatesRequest{ClobPairId: []uint32{0}},
+			err: types.ErrGrpcStreamingManagerNotEnabled,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			err := ks.ClobKeeper.StreamOrderbookUpdates(tc.req, nil)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
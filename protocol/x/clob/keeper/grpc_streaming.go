@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// InitializeNewGrpcStreams sends a snapshot of the orderbooks to gRPC stream subscriptions that
+// have not received one yet. Must be called after the memclob has been updated in `PrepareCheckState`
+// so that later updates apply on top of the snapshot.
+func (k Keeper) InitializeNewGrpcStreams(ctx sdk.Context) {
+	streamingManager := k.GetGrpcStreamingManager()
+	if !streamingManager.Enabled() {
+		return
+	}
+
+	streamingManager.InitializeNewStreams(
+		func(clobPairId types.ClobPairId) *types.OffchainUpdates {
+			return k.MemClob.GetOffchainUpdatesForOrderbookSnapshot(ctx, clobPairId)
+		},
+		lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+	)
+}
+
+// SendOrderbookUpdates sends off-chain updates generated by the memclob to gRPC stream subscriptions.
+func (k Keeper) SendOrderbookUpdates(
+	ctx sdk.Context,
+	offchainUpdates *types.OffchainUpdates,
+) {
+	streamingManager := k.GetGrpcStreamingManager()
+	if !streamingManager.Enabled() || offchainUpdates == nil || len(offchainUpdates.Messages) == 0 {
+		return
+	}
+
+	streamingManager.SendOrderbookUpdates(
+		offchainUpdates,
+		lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+	)
+}
+
+// SendOrderbookFillUpdates sends fills that were committed to state to gRPC stream subscriptions.
+func (k Keeper) SendOrderbookFillUpdates(
+	ctx sdk.Context,
+	fills []types.StreamOrderbookFill,
+) {
+	streamingManager := k.GetGrpcStreamingManager()
+	if !streamingManager.Enabled() || len(fills) == 0 {
+		return
+	}
+
+	streamingManager.SendOrderbookFillUpdates(
+		fills,
+		lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	streamingtypes "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/rate_limit"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -33,15 +34,16 @@ type (
 		UntriggeredConditionalOrders map[types.ClobPairId]*UntriggeredConditionalOrders
 		PerpetualIdToClobPairId      map[uint32][]types.ClobPairId
 
-		subaccountsKeeper   types.SubaccountsKeeper
-		assetsKeeper        types.AssetsKeeper
-		bankKeeper          types.BankKeeper
-		blockTimeKeeper     types.BlockTimeKeeper
-		feeTiersKeeper      types.FeeTiersKeeper
-		perpetualsKeeper    types.PerpetualsKeeper
-		statsKeeper         types.StatsKeeper
-		rewardsKeeper       types.RewardsKeeper
-		indexerEventManager indexer_manager.IndexerEventManager
+		subaccountsKeeper    types.SubaccountsKeeper
+		assetsKeeper         types.AssetsKeeper
+		bankKeeper           types.BankKeeper
+		blockTimeKeeper      types.BlockTimeKeeper
+		feeTiersKeeper       types.FeeTiersKeeper
+		perpetualsKeeper     types.PerpetualsKeeper
+		statsKeeper          types.StatsKeeper
+		rewardsKeeper        types.RewardsKeeper
+		indexerEventManager  indexer_manager.IndexerEventManager
+		grpcStreamingManager streamingtypes.GrpcStreamingManager
 
 		memStoreInitialized *atomic.Bool
 
@@ -78,6 +80,7 @@ func NewKeeper(
 	statsKeeper types.StatsKeeper,
 	rewardsKeeper types.RewardsKeeper,
 	indexerEventManager indexer_manager.IndexerEventManager,
+	grpcStreamingManager streamingtypes.GrpcStreamingManager,
 	txDecoder sdk.TxDecoder,
 	clobFlags flags.ClobFlags,
 	placeOrderRateLimiter rate_limit.RateLimiter[*types.MsgPlaceOrder],
@@ -101,6 +104,7 @@ func NewKeeper(
 		statsKeeper:                  statsKeeper,
 		rewardsKeeper:                rewardsKeeper,
 		indexerEventManager:          indexerEventManager,
+		grpcStreamingManager:         grpcStreamingManager,
 		memStoreInitialized:          &atomic.Bool{},
 		txDecoder:                    txDecoder,
 		mevTelemetryConfig: MevTelemetryConfig{
@@ -129,6 +133,10 @@ func (k Keeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
 	return k.indexerEventManager
 }
 
+func (k Keeper) GetGrpcStreamingManager() streamingtypes.GrpcStreamingManager {
+	return k.grpcStreamingManager
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With(
 		sdklog.ModuleKey, "x/clob",
@@ -22,6 +22,9 @@ import (
 // LiquidateSubaccountsAgainstOrderbook takes a list of subaccount IDs and liquidates them against
 // the orderbook. It will liquidate as many subaccounts as possible up to the maximum number of
 // liquidations per block. Subaccounts are selected with a pseudo-randomly generated offset.
+// Multiple perpetual positions of a subaccount may be liquidated in the same block, as long as the
+// subaccount remains liquidatable and within the subaccount block limits. Every liquidation order
+// placed counts against the maximum number of liquidations per block.
 func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 	ctx sdk.Context,
 	subaccountIds []satypes.SubaccountId,
@@ -55,6 +58,9 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 	// in the slice. Note `numSubaccounts` is guaranteed to be non-zero at this point, so `Intn` shouldn't panic.
 	pseudoRand := k.GetPseudoRand(ctx)
 	liquidationOrders := make([]types.LiquidationOrder, 0)
+	// Liquidatable subaccounts without perpetual positions to liquidate, whose asset borrowings are
+	// liquidated instead.
+	assetLiquidationSubaccountIds := make([]satypes.SubaccountId, 0)
 	numLiqOrders := lib.Min(numSubaccounts, int(k.Flags.MaxLiquidationAttemptsPerBlock))
 	indexOffset := pseudoRand.Intn(numSubaccounts)
 
@@ -72,6 +78,11 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 				continue
 			}
 
+			if errors.Is(err, types.ErrNoPerpetualPositionsToLiquidate) {
+				assetLiquidationSubaccountIds = append(assetLiquidationSubaccountIds, subaccountId)
+				continue
+			}
+
 			// Return unexpected errors.
 			return err
 		}
@@ -99,9 +110,63 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 	// Attempt to place each liquidation order and perform deleveraging if necessary.
 	startPlaceLiquidationOrders := time.Now()
 	unfilledLiquidations := make([]types.LiquidationOrder, 0)
+	numLiquidationAttemptsLeft := int(k.Flags.MaxLiquidationAttemptsPerBlock)
 	for _, subaccountId := range subaccountIdsToLiquidate {
-		// Generate a new liquidation order with the appropriate order size from the sorted subaccount ids.
-		liquidationOrder, err := k.MaybeGetLiquidationOrder(ctx, subaccountId)
+		// Liquidate the perpetual positions of the subaccount in ranked order until the subaccount is no longer
+		// liquidatable, a liquidation order is not filled, or the subaccount or per-block limits are reached.
+		for numPositionsLiquidated := 0; numLiquidationAttemptsLeft > 0; numPositionsLiquidated++ {
+			if numPositionsLiquidated > 0 && !k.canLiquidateAnotherPerpetualPosition(ctx, subaccountId) {
+				break
+			}
+
+			// Generate a new liquidation order with the appropriate order size from the sorted subaccount ids.
+			liquidationOrder, err := k.MaybeGetLiquidationOrder(ctx, subaccountId)
+			if err != nil {
+				// Subaccount might not always be liquidatable if previous liquidation orders
+				// improves the net collateral of this subaccount.
+				if errors.Is(err, types.ErrSubaccountNotLiquidatable) {
+					break
+				}
+
+				// All remaining perpetual positions of the subaccount have been liquidated in this block.
+				if numPositionsLiquidated > 0 && errors.Is(err, types.ErrNoPerpetualPositionsToLiquidate) {
+					break
+				}
+
+				// Return unexpected errors.
+				return err
+			}
+
+			numLiquidationAttemptsLeft--
+			optimisticallyFilledQuantums, _, err := k.PlacePerpetualLiquidation(ctx, *liquidationOrder)
+			if err != nil {
+				k.Logger(ctx).Error(
+					"Failed to liquidate subaccount",
+					"liquidationOrder", *liquidationOrder,
+					"error", err,
+				)
+				return err
+			}
+
+			// Unfilled liquidations are deleveraged instead. Remaining positions of the subaccount are
+			// liquidated in a later block if the subaccount is still liquidatable.
+			if optimisticallyFilledQuantums == 0 {
+				unfilledLiquidations = append(unfilledLiquidations, *liquidationOrder)
+				break
+			}
+		}
+	}
+
+	// Attempt to buy back the borrowed assets of subaccounts without perpetual positions to liquidate.
+	// Subaccounts whose liquidation is unfilled, that cannot be liquidated against a spot CLOB pair, or
+	// that do not borrow any asset are deleveraged instead.
+	assetDeleveragingSubaccountIds := make([]satypes.SubaccountId, 0)
+	for _, subaccountId := range assetLiquidationSubaccountIds {
+		if numLiquidationAttemptsLeft <= 0 {
+			break
+		}
+
+		liquidationOrder, err := k.MaybeGetAssetLiquidationOrder(ctx, subaccountId)
 		if err != nil {
 			// Subaccount might not always be liquidatable if previous liquidation orders
 			// improves the net collateral of this subaccount.
@@ -109,14 +174,20 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 				continue
 			}
 
+			if errors.Is(err, types.ErrNoBorrowedAssetPosition) || errors.Is(err, types.ErrNoSpotClobPairForAsset) {
+				assetDeleveragingSubaccountIds = append(assetDeleveragingSubaccountIds, subaccountId)
+				continue
+			}
+
 			// Return unexpected errors.
 			return err
 		}
 
-		optimisticallyFilledQuantums, _, err := k.PlacePerpetualLiquidation(ctx, *liquidationOrder)
+		numLiquidationAttemptsLeft--
+		optimisticallyFilledQuantums, _, err := k.PlaceAssetLiquidation(ctx, *liquidationOrder)
 		if err != nil {
 			k.Logger(ctx).Error(
-				"Failed to liquidate subaccount",
+				"Failed to liquidate subaccount asset",
 				"liquidationOrder", *liquidationOrder,
 				"error", err,
 			)
@@ -124,7 +195,7 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 		}
 
 		if optimisticallyFilledQuantums == 0 {
-			unfilledLiquidations = append(unfilledLiquidations, *liquidationOrder)
+			assetDeleveragingSubaccountIds = append(assetDeleveragingSubaccountIds, subaccountId)
 		}
 	}
 	telemetry.MeasureSince(
@@ -136,7 +207,9 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 
 	// For each unfilled liquidation, attempt to deleverage the subaccount.
 	startDeleverageSubaccounts := time.Now()
+	numDeleveragingAttempts := 0
 	for i := 0; i < int(k.Flags.MaxDeleveragingAttemptsPerBlock) && i < len(unfilledLiquidations); i++ {
+		numDeleveragingAttempts++
 		liquidationOrder := unfilledLiquidations[i]
 
 		subaccountId := liquidationOrder.GetSubaccountId()
@@ -153,6 +226,24 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 			return err
 		}
 	}
+
+	// Deleverage borrowed assets which could not be liquidated against the orderbook and seize collateral
+	// with the remaining deleveraging attempts.
+	for i := 0; numDeleveragingAttempts < int(k.Flags.MaxDeleveragingAttemptsPerBlock) &&
+		i < len(assetDeleveragingSubaccountIds); i++ {
+		numDeleveragingAttempts++
+		subaccountId := assetDeleveragingSubaccountIds[i]
+
+		_, err := k.MaybeDeleverageSubaccountAsset(ctx, subaccountId)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"Failed to deleverage subaccount asset.",
+				"subaccount", subaccountId,
+				"error", err,
+			)
+			return err
+		}
+	}
 	telemetry.MeasureSince(
 		startDeleverageSubaccounts,
 		types.ModuleName,
@@ -245,6 +336,90 @@ func (k Keeper) GetLiquidationOrderForPerpetual(
 	return liquidationOrder, nil
 }
 
+// MaybeGetAssetLiquidationOrder takes a subaccount ID and returns a liquidation order that can be used to
+// liquidate the borrowed asset position of the subaccount.
+// If the subaccount is not currently liquidatable, it will do nothing. This function will return an error if
+// the subaccount does not borrow any asset, or if calling `GetLiquidationOrderForAsset` returns an error.
+func (k Keeper) MaybeGetAssetLiquidationOrder(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) (
+	liquidationOrder *types.LiquidationOrder,
+	err error,
+) {
+	// If the subaccount is not liquidatable, do nothing.
+	if err := k.EnsureIsLiquidatable(ctx, subaccountId); err != nil {
+		return nil, err
+	}
+
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.ConstructLiquidationOrder,
+	)
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, exists := subaccount.GetBorrowedAssetPosition()
+	if !exists {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoBorrowedAssetPosition,
+			"Subaccount %v does not have a borrowed asset position to liquidate",
+			subaccountId,
+		)
+	}
+
+	return k.GetLiquidationOrderForAsset(
+		ctx,
+		subaccountId,
+		position.AssetId,
+	)
+}
+
+// GetLiquidationOrderForAsset returns a liquidation order for a subaccount given the ID of a
+// borrowed asset. The liquidation order buys back the full borrowed position at its fillable price
+// on the spot CLOB pair returned by `GetSpotClobPairForAsset`.
+func (k Keeper) GetLiquidationOrderForAsset(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	assetId uint32,
+) (
+	liquidationOrder *types.LiquidationOrder,
+	err error,
+) {
+	clobPair, err := k.GetSpotClobPairForAsset(ctx, assetId)
+	if err != nil {
+		return nil, err
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, _ := subaccount.GetAssetPositionForId(assetId)
+	deltaQuantums := new(big.Int).Neg(position.GetBigQuantums())
+
+	// Get the fillable price of the liquidation order in subticks.
+	fillablePriceRat, err := k.GetAssetFillablePrice(ctx, subaccountId, assetId, deltaQuantums)
+	if err != nil {
+		return nil, err
+	}
+
+	// Borrowed positions are short, so the liquidation order is a buy.
+	fillablePriceSubticks := k.ConvertFillablePriceToSubticks(
+		ctx,
+		fillablePriceRat,
+		false,
+		clobPair,
+	)
+
+	// Create the liquidation order.
+	liquidationOrder = types.NewAssetLiquidationOrder(
+		subaccountId,
+		clobPair,
+		true,
+		satypes.BaseQuantums(deltaQuantums.Uint64()),
+		fillablePriceSubticks,
+	)
+	return liquidationOrder, nil
+}
+
 // PlacePerpetualLiquidation places an IOC liquidation order onto the book that results in fills of type
 // `PerpetualLiquidation`.
 func (k Keeper) PlacePerpetualLiquidation(
@@ -336,14 +511,65 @@ func (k Keeper) PlacePerpetualLiquidation(
 		)
 	}
 
-	k.SendOffchainMessages(offchainUpdates, nil, metrics.SendPlacePerpetualLiquidationOffchainUpdates)
+	k.SendOffchainMessages(ctx, offchainUpdates, nil, metrics.SendPlacePerpetualLiquidationOffchainUpdates)
+	return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, err
+}
+
+// PlaceAssetLiquidation places an IOC liquidation order of a borrowed asset position onto the book of its
+// spot CLOB pair that results in fills of type `AssetLiquidation`.
+func (k Keeper) PlaceAssetLiquidation(
+	ctx sdk.Context,
+	liquidationOrder types.LiquidationOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.PlaceAssetLiquidation,
+	)
+
+	orderSizeOptimisticallyFilledFromMatchingQuantums,
+		orderStatus,
+		offchainUpdates,
+		err :=
+		k.MemClob.PlaceAssetLiquidation(
+			ctx,
+			liquidationOrder,
+		)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	labels := []gometrics.Label{
+		metrics.GetLabelForIntValue(metrics.AssetId, int(liquidationOrder.MustGetLiquidatedAssetId())),
+	}
+	if orderSizeOptimisticallyFilledFromMatchingQuantums == 0 {
+		labels = append(labels, metrics.GetLabelForStringValue(metrics.Status, metrics.Unfilled))
+	} else if orderSizeOptimisticallyFilledFromMatchingQuantums == liquidationOrder.GetBaseQuantums() {
+		labels = append(labels, metrics.GetLabelForStringValue(metrics.Status, metrics.FullyFilled))
+	} else {
+		labels = append(labels, metrics.GetLabelForStringValue(metrics.Status, metrics.PartiallyFilled))
+	}
+	// Stat the number of liquidation orders placed.
+	telemetry.IncrCounterWithLabels(
+		[]string{metrics.Liquidations, metrics.PlaceAssetLiquidation, metrics.Count},
+		1,
+		labels,
+	)
+
+	k.SendOffchainMessages(ctx, offchainUpdates, nil, metrics.SendPlaceAssetLiquidationOffchainUpdates)
 	return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, err
 }
 
 // IsLiquidatable returns true if the subaccount is able to be liquidated; that is,
-// if-and-only-if the maintenance margin requirement is non-zero and greater than the net collateral
-// of the subaccount.
-// If `GetNetCollateralAndMarginRequirements` returns an error, this function will return that
+// if-and-only-if either its cross-margined positions or any of its isolated positions are liquidatable.
+// See `isCrossMarginLiquidatable` and `isIsolatedPositionLiquidatable` for more details.
+// If fetching the net collateral and margin requirements returns an error, this function will return that
 // error to the caller.
 func (k Keeper) IsLiquidatable(
 	ctx sdk.Context,
@@ -351,23 +577,122 @@ func (k Keeper) IsLiquidatable(
 ) (
 	bool,
 	error,
+) {
+	isLiquidatable, err := k.isCrossMarginLiquidatable(ctx, subaccountId)
+	if err != nil || isLiquidatable {
+		return isLiquidatable, err
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	for _, isolatedMargin := range subaccount.IsolatedMargins {
+		isLiquidatable, err := k.isIsolatedPositionLiquidatable(ctx, subaccountId, isolatedMargin.PerpetualId)
+		if err != nil || isLiquidatable {
+			return isLiquidatable, err
+		}
+	}
+	return false, nil
+}
+
+// isCrossMarginLiquidatable returns true if the cross-margined positions of the subaccount are able to be
+// liquidated; that is, if-and-only-if the maintenance margin requirement is greater than the cross net
+// collateral of the subaccount, and either the maintenance margin requirement is non-zero or the subaccount
+// has non-USDC collateral that can be seized to repay its negative USDC balance.
+func (k Keeper) isCrossMarginLiquidatable(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) (
+	bool,
+	error,
 ) {
 	bigNetCollateral,
 		_,
 		bigMaintenanceMargin,
-		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		err := k.subaccountsKeeper.GetCrossNetCollateralAndMarginRequirements(
 		ctx,
-		satypes.Update{SubaccountId: subaccountId},
+		subaccountId,
 	)
 	if err != nil {
 		return false, err
 	}
 
 	// The subaccount is liquidatable if both of the following are true:
-	// - The maintenance margin requirements are greater than zero (note that they can never be negative).
+	// - The maintenance margin requirements are greater than zero (note that they can never be negative),
+	// or the subaccount has collateral that can be seized. Since USDC has no margin requirements, this
+	// allows insolvent subaccounts with no other positions to be liquidated.
 	// - The maintenance margin requirements are greater than the subaccount's net collateral.
-	isLiquidatable := bigMaintenanceMargin.Sign() > 0 && bigMaintenanceMargin.Cmp(bigNetCollateral) == 1
-	return isLiquidatable, nil
+	if bigMaintenanceMargin.Cmp(bigNetCollateral) != 1 {
+		return false, nil
+	}
+	if bigMaintenanceMargin.Sign() > 0 {
+		return true, nil
+	}
+
+	_, _, hasCollateralToSeize := k.getCollateralToSeize(ctx, k.subaccountsKeeper.GetSubaccount(ctx, subaccountId))
+	return hasCollateralToSeize, nil
+}
+
+// isIsolatedPositionLiquidatable returns true if the isolated position of the subaccount in the perpetual
+// is able to be liquidated; that is, if-and-only-if its maintenance margin requirement is non-zero and
+// greater than the collateral allotted to it.
+func (k Keeper) isIsolatedPositionLiquidatable(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+) (
+	bool,
+	error,
+) {
+	bigNetCollateral,
+		_,
+		bigMaintenanceMargin,
+		err := k.subaccountsKeeper.GetIsolatedNetCollateralAndMarginRequirements(
+		ctx,
+		subaccountId,
+		perpetualId,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return bigMaintenanceMargin.Sign() > 0 && bigMaintenanceMargin.Cmp(bigNetCollateral) == 1, nil
+}
+
+// isPerpetualPositionLiquidatable returns true if the margin group the subaccount's position in the
+// perpetual belongs to is liquidatable. Isolated positions are liquidatable independently of the rest of
+// the subaccount, while all other positions are liquidatable if the cross-margined positions are.
+func (k Keeper) isPerpetualPositionLiquidatable(
+	ctx sdk.Context,
+	subaccount satypes.Subaccount,
+	perpetualId uint32,
+) (
+	bool,
+	error,
+) {
+	if _, isolated := subaccount.GetIsolatedMarginForId(perpetualId); isolated {
+		return k.isIsolatedPositionLiquidatable(ctx, *subaccount.Id, perpetualId)
+	}
+	return k.isCrossMarginLiquidatable(ctx, *subaccount.Id)
+}
+
+// getNetCollateralAndMarginRequirementsForPerpetual returns the net collateral, initial margin requirement,
+// and maintenance margin requirement of the margin group the subaccount's position in the perpetual
+// belongs to. That is the isolated position itself if the perpetual is isolated for the subaccount, and the
+// cross-margined positions otherwise.
+func (k Keeper) getNetCollateralAndMarginRequirementsForPerpetual(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+) (
+	bigNetCollateral *big.Int,
+	bigInitialMargin *big.Int,
+	bigMaintenanceMargin *big.Int,
+	err error,
+) {
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	if _, isolated := subaccount.GetIsolatedMarginForId(perpetualId); isolated {
+		return k.subaccountsKeeper.GetIsolatedNetCollateralAndMarginRequirements(ctx, subaccountId, perpetualId)
+	}
+	return k.subaccountsKeeper.GetCrossNetCollateralAndMarginRequirements(ctx, subaccountId)
 }
 
 // EnsureIsLiquidatable returns an error if the subaccount is not liquidatable.
@@ -416,10 +741,13 @@ func (k Keeper) GetBankruptcyPriceInQuoteQuantums(
 	// - TNC (total net collateral).
 	// - DMMR (delta maintenance margin requirement).
 	// - TMMR (total maintenance margin requirement).
+	// Note that TNC and TMMR only take into account the margin group of the position, which is the
+	// position itself if it is isolated.
 
-	tncBig, _, tmmrBig, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+	tncBig, _, tmmrBig, err := k.getNetCollateralAndMarginRequirementsForPerpetual(
 		ctx,
-		satypes.Update{SubaccountId: subaccountId},
+		subaccountId,
+		perpetualId,
 	)
 	if err != nil {
 		return nil, err
@@ -513,6 +841,106 @@ func (k Keeper) GetBankruptcyPriceInQuoteQuantums(
 	return bankruptcyPriceQuoteQuantumsBig, nil
 }
 
+// GetAssetBankruptcyPriceInQuoteQuantums returns the bankruptcy-price of repaying `deltaQuantums` of a
+// subaccount's borrowed asset position in quote quantums. It uses the same equation as
+// `GetBankruptcyPriceInQuoteQuantums`, `-DNNV - (TNC * (abs(DMMR) / TMMR))`, where `DNNV` and `DMMR` are
+// the changes in net collateral and maintenance margin requirement of the borrowed asset position.
+// Note that the result `deltaQuoteQuantums` is signed and always rounded towards positive infinity.
+//
+// This function does not check whether the given subaccount is liquidatable, but validates that the
+// provided deltaQuantums repays at most the full borrowed position.
+func (k Keeper) GetAssetBankruptcyPriceInQuoteQuantums(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	assetId uint32,
+	deltaQuantums *big.Int,
+) (
+	deltaQuoteQuantums *big.Int,
+	err error,
+) {
+	tncBig, _, tmmrBig, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{SubaccountId: subaccountId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, _ := subaccount.GetAssetPositionForId(assetId)
+	psBig := position.GetBigQuantums()
+
+	if psBig.Sign() != -1 || deltaQuantums.Sign() != 1 || psBig.CmpAbs(deltaQuantums) == -1 {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidAssetPositionSizeDelta,
+			"Asset position size delta %v is invalid for %v and asset %v, outstanding position size is %v",
+			deltaQuantums,
+			subaccountId,
+			assetId,
+			psBig,
+		)
+	}
+
+	return k.getAssetBankruptcyPriceInQuoteQuantums(ctx, assetId, psBig, deltaQuantums, tncBig, tmmrBig)
+}
+
+// getAssetBankruptcyPriceInQuoteQuantums returns `-DNNV - (TNC * (abs(DMMR) / TMMR))` for repaying
+// `deltaQuantums` of a borrowed asset position of size `psBig`.
+func (k Keeper) getAssetBankruptcyPriceInQuoteQuantums(
+	ctx sdk.Context,
+	assetId uint32,
+	psBig *big.Int,
+	deltaQuantums *big.Int,
+	tncBig *big.Int,
+	tmmrBig *big.Int,
+) (
+	deltaQuoteQuantums *big.Int,
+	err error,
+) {
+	psadBig := new(big.Int).Add(psBig, deltaQuantums)
+
+	// `DNNV = PNNVAD - PNNV`. Computed from both position sizes to avoid rounding errors.
+	pnnvBig, err := k.assetsKeeper.GetNetCollateral(ctx, assetId, psBig)
+	if err != nil {
+		return nil, err
+	}
+	pnnvadBig, err := k.assetsKeeper.GetNetCollateral(ctx, assetId, psadBig)
+	if err != nil {
+		return nil, err
+	}
+	dnnvBig := new(big.Int).Sub(pnnvadBig, pnnvBig)
+
+	if tncBig.Sign() == 0 || tmmrBig.Sign() == 0 {
+		return new(big.Int).Neg(dnnvBig), nil
+	}
+
+	// `DMMR = PMMRAD - PMMR`.
+	_, pmmrBig, err := k.assetsKeeper.GetMarginRequirements(ctx, assetId, psBig)
+	if err != nil {
+		return nil, err
+	}
+	_, pmmradBig, err := k.assetsKeeper.GetMarginRequirements(ctx, assetId, psadBig)
+	if err != nil {
+		return nil, err
+	}
+	dmmrBig := new(big.Int).Sub(pmmradBig, pmmrBig)
+	if dmmrBig.Sign() == 1 {
+		panic("getAssetBankruptcyPriceInQuoteQuantums: DMMR is positive")
+	}
+
+	// Rounded towards negative infinity so that the final result is rounded towards positive infinity,
+	// as in `GetBankruptcyPriceInQuoteQuantums`.
+	quoteQuantumsBeforeBankruptcyBig := new(big.Int).Div(
+		new(big.Int).Mul(tncBig, new(big.Int).Abs(dmmrBig)),
+		tmmrBig,
+	)
+
+	return new(big.Int).Sub(
+		new(big.Int).Neg(dnnvBig),
+		quoteQuantumsBeforeBankruptcyBig,
+	), nil
+}
+
 // GetFillablePrice returns the fillable-price of a subaccount’s position. It returns a rational
 // number to avoid rounding errors.
 func (k Keeper) GetFillablePrice(
@@ -566,9 +994,10 @@ func (k Keeper) GetFillablePrice(
 	tncBig,
 		_,
 		tmmrBig,
-		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		err := k.getNetCollateralAndMarginRequirementsForPerpetual(
 		ctx,
-		satypes.Update{SubaccountId: subaccountId},
+		subaccountId,
+		perpetualId,
 	)
 	if err != nil {
 		return nil, err
@@ -603,22 +1032,94 @@ func (k Keeper) GetFillablePrice(
 		)
 	}
 
+	return k.getFillablePrice(ctx, psBig, pnnvBig, pmmrBig, tncBig, tmmrBig), nil
+}
+
+// GetAssetFillablePrice returns the fillable-price of a subaccount's borrowed asset position. It uses the same
+// equation as `GetFillablePrice`, where `PNNV` and `PMMR` are the net collateral and maintenance margin
+// requirement of the borrowed asset position.
+func (k Keeper) GetAssetFillablePrice(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	assetId uint32,
+	deltaQuantums *big.Int,
+) (
+	fillablePrice *big.Rat,
+	err error,
+) {
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	position, _ := subaccount.GetAssetPositionForId(assetId)
+	psBig := position.GetBigQuantums()
+
+	// Validate that the position is borrowed and that the provided deltaQuantums repays
+	// at most the full borrowed position.
+	if psBig.Sign() != -1 || deltaQuantums.Sign() != 1 || psBig.CmpAbs(deltaQuantums) == -1 {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidAssetPositionSizeDelta,
+			"Asset position size delta %v is invalid for %v and asset %v, outstanding position size is %v",
+			deltaQuantums,
+			subaccountId,
+			assetId,
+			psBig,
+		)
+	}
+
+	pnnvBig, err := k.assetsKeeper.GetNetCollateral(ctx, assetId, psBig)
+	if err != nil {
+		return nil, err
+	}
+
+	_, pmmrBig, err := k.assetsKeeper.GetMarginRequirements(ctx, assetId, psBig)
+	if err != nil {
+		return nil, err
+	}
+
+	tncBig,
+		_,
+		tmmrBig,
+		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{SubaccountId: subaccountId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.getFillablePrice(ctx, psBig, pnnvBig, pmmrBig, tncBig, tmmrBig), nil
+}
+
+// getFillablePrice returns `(PNNV - ABR * SMMR * PMMR) / PS`, where `ABR = BA * (1 - (TNC / TMMR))`.
+// See `GetFillablePrice` for the definitions of each term.
+func (k Keeper) getFillablePrice(
+	ctx sdk.Context,
+	psBig *big.Int,
+	pnnvBig *big.Int,
+	pmmrBig *big.Int,
+	tncBig *big.Int,
+	tmmrBig *big.Int,
+) (
+	fillablePrice *big.Rat,
+) {
 	liquidationsConfig := k.GetLiquidationsConfig(ctx)
 	ba := liquidationsConfig.FillablePriceConfig.BankruptcyAdjustmentPpm
 	smmr := liquidationsConfig.FillablePriceConfig.SpreadToMaintenanceMarginRatioPpm
 
-	// Calculate the ABR (adjusted bankruptcy rating).
-	tncDivTmmrRat := new(big.Rat).SetFrac(tncBig, tmmrBig)
-	unboundedAbrRat := lib.BigRatMulPpm(
-		new(big.Rat).Sub(
-			lib.BigRat1(),
-			tncDivTmmrRat,
-		),
-		ba,
-	)
+	// Calculate the ABR (adjusted bankruptcy rating). If the subaccount has no maintenance margin
+	// requirements, then `PMMR` is also zero and the ABR has no effect on the fillable price.
+	abrRat := lib.BigRat1()
+	if tmmrBig.Sign() != 0 {
+		tncDivTmmrRat := new(big.Rat).SetFrac(tncBig, tmmrBig)
+		unboundedAbrRat := lib.BigRatMulPpm(
+			new(big.Rat).Sub(
+				lib.BigRat1(),
+				tncDivTmmrRat,
+			),
+			ba,
+		)
 
-	// Bound the ABR between 0 and 1.
-	abrRat := lib.BigRatClamp(unboundedAbrRat, lib.BigRat0(), lib.BigRat1())
+		// Bound the ABR between 0 and 1.
+		abrRat = lib.BigRatClamp(unboundedAbrRat, lib.BigRat0(), lib.BigRat1())
+	}
 
 	// Calculate `SMMR * PMMR` (the maximum liquidation spread in quote quantums).
 	maxLiquidationSpreadQuoteQuantumsRat := lib.BigRatMulPpm(
@@ -645,10 +1146,10 @@ func (k Keeper) GetFillablePrice(
 		new(big.Rat).SetInt(psBig),
 	)
 	if fillablePrice.Sign() < 0 {
-		panic("GetFillablePrice: Calculated fillable price is negative")
+		panic("getFillablePrice: Calculated fillable price is negative")
 	}
 
-	return fillablePrice, nil
+	return fillablePrice
 }
 
 // GetLiquidationInsuranceFundDelta returns the net payment value between the liquidated account
@@ -703,6 +1204,83 @@ func (k Keeper) GetLiquidationInsuranceFundDelta(
 		return nil, err
 	}
 
+	return k.getLiquidationInsuranceFundDelta(ctx, deltaQuoteQuantums, bankruptcyPriceInQuoteQuantumsBig), nil
+}
+
+// GetAssetLiquidationInsuranceFundDelta returns the net payment value between the liquidated account
+// and the insurance fund for a fill of an asset liquidation order on the spot `clobPair`. Positive if the
+// liquidated account pays fees to the insurance fund. Negative if the insurance fund covers losses from
+// the subaccount.
+func (k Keeper) GetAssetLiquidationInsuranceFundDelta(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPair types.ClobPair,
+	isBuy bool,
+	fillAmount uint64,
+	subticks types.Subticks,
+) (
+	insuranceFundDeltaQuoteQuantums *big.Int,
+	err error,
+) {
+	spotClobMetadata := clobPair.GetSpotClobMetadata()
+	if spotClobMetadata == nil {
+		return nil, errorsmod.Wrapf(
+			types.ErrClobPairAndAssetDoNotMatch,
+			"CLOB pair %v is not a spot CLOB pair",
+			clobPair.Id,
+		)
+	}
+	assetId := spotClobMetadata.BaseAssetId
+
+	// Verify that fill amount is not zero.
+	if fillAmount == 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidQuantumsForInsuranceFundDeltaCalculation,
+			"FillAmount is zero for subaccount %v and asset %v.",
+			subaccountId,
+			assetId,
+		)
+	}
+
+	// Get the delta quantums and delta quote quantums.
+	deltaQuantums := new(big.Int).SetUint64(fillAmount)
+	deltaQuoteQuantums, err := getFillQuoteQuantums(
+		clobPair,
+		subticks,
+		satypes.BaseQuantums(fillAmount),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if isBuy {
+		deltaQuoteQuantums.Neg(deltaQuoteQuantums)
+	} else {
+		deltaQuantums.Neg(deltaQuantums)
+	}
+
+	// To determine the liquidation insurance fund delta we need the bankruptcy price.
+	bankruptcyPriceInQuoteQuantumsBig, err := k.GetAssetBankruptcyPriceInQuoteQuantums(
+		ctx,
+		subaccountId,
+		assetId,
+		deltaQuantums,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.getLiquidationInsuranceFundDelta(ctx, deltaQuoteQuantums, bankruptcyPriceInQuoteQuantumsBig), nil
+}
+
+// getLiquidationInsuranceFundDelta returns the insurance fund delta of a liquidation fill given the quote
+// quantums received by the liquidated subaccount and the bankruptcy price in quote quantums.
+func (k Keeper) getLiquidationInsuranceFundDelta(
+	ctx sdk.Context,
+	deltaQuoteQuantums *big.Int,
+	bankruptcyPriceInQuoteQuantumsBig *big.Int,
+) (
+	insuranceFundDeltaQuoteQuantums *big.Int,
+) {
 	// Determine the delta between the quote quantums received from closing the position and the
 	// bankruptcy price in quote quantums.
 	insuranceFundDeltaQuoteQuantumsBig := new(big.Int).Sub(
@@ -714,7 +1292,7 @@ func (k Keeper) GetLiquidationInsuranceFundDelta(
 	// needs to cover the difference between the quote quantums received from closing the position
 	// and the bankruptcy price in quote quantums.
 	if insuranceFundDeltaQuoteQuantumsBig.Sign() <= 0 {
-		return insuranceFundDeltaQuoteQuantumsBig, nil
+		return insuranceFundDeltaQuoteQuantumsBig
 	}
 
 	// The insurance fund delta is positive. We must read the liquidations config from state to
@@ -733,12 +1311,12 @@ func (k Keeper) GetLiquidationInsuranceFundDelta(
 	return lib.BigMin(
 		maxLiquidationFeeQuoteQuantumsBig,
 		insuranceFundDeltaQuoteQuantumsBig,
-	), nil
+	)
 }
 
 // GetPerpetualPositionToLiquidate determines which position to liquidate on the
-// passed-in subaccount (after accounting for the `update`). It will return the perpetual id that
-// will be used for liquidating the perpetual position.
+// passed-in subaccount. It will return the perpetual id that will be used for liquidating the
+// perpetual position, which is the highest ranked position returned by `GetPerpetualPositionsToLiquidate`.
 // This function returns an error if the subaccount has no perpetual positions to liquidate.
 func (k Keeper) GetPerpetualPositionToLiquidate(
 	ctx sdk.Context,
@@ -746,36 +1324,87 @@ func (k Keeper) GetPerpetualPositionToLiquidate(
 ) (
 	perpetualId uint32,
 	err error,
+) {
+	perpetualIds, err := k.GetPerpetualPositionsToLiquidate(ctx, subaccountId)
+	if err != nil {
+		return 0, err
+	}
+	return perpetualIds[0], nil
+}
+
+// GetPerpetualPositionsToLiquidate returns the perpetual ids of all positions of the passed-in subaccount
+// that can still be liquidated in this block, ranked in the order they should be liquidated.
+// Positions are ranked by their maintenance margin requirement in descending order, such that the positions
+// contributing the most to the maintenance margin of the subaccount are liquidated first. Ties are broken
+// by perpetual id in ascending order.
+// Positions that were already liquidated in this block, and isolated positions whose margin group is not
+// liquidatable, are skipped.
+// This function returns an error if the subaccount has no perpetual positions to liquidate.
+func (k Keeper) GetPerpetualPositionsToLiquidate(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) (
+	perpetualIds []uint32,
+	err error,
 ) {
 	// Fetch the subaccount from state.
 	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	subaccountLiquidationInfo := k.GetSubaccountLiquidationInfo(ctx, subaccountId)
 
-	numPositions := len(subaccount.PerpetualPositions)
-	if numPositions > 0 {
-		subaccountLiquidationInfo := k.GetSubaccountLiquidationInfo(ctx, subaccountId)
-		indexOffset := k.GetPseudoRand(ctx).Intn(numPositions)
-		for i := 0; i < numPositions; i++ {
-			position := subaccount.PerpetualPositions[(i+indexOffset)%numPositions]
-			// Note that this could run in O(n^2) time. This is fine for now because we have less than a hundred
-			// perpetuals and only liquidate once per subaccount per block. This means that the position with smallest
-			// id will be liquidated first.
-			if !subaccountLiquidationInfo.HasPerpetualBeenLiquidatedForSubaccount(position.PerpetualId) {
-				return position.PerpetualId, nil
+	perpetualIds = make([]uint32, 0, len(subaccount.PerpetualPositions))
+	bigMaintenanceMargins := make(map[uint32]*big.Int, len(subaccount.PerpetualPositions))
+	for _, position := range subaccount.PerpetualPositions {
+		if subaccountLiquidationInfo.HasPerpetualBeenLiquidatedForSubaccount(position.PerpetualId) {
+			continue
+		}
+		// Positions of subaccounts with isolated margins are only liquidated if their margin group is.
+		if len(subaccount.IsolatedMargins) > 0 {
+			isLiquidatable, err := k.isPerpetualPositionLiquidatable(ctx, subaccount, position.PerpetualId)
+			if err != nil {
+				return nil, err
 			}
+			if !isLiquidatable {
+				continue
+			}
+		}
+
+		_, bigMaintenanceMargin, err := k.perpetualsKeeper.GetMarginRequirements(
+			ctx,
+			position.PerpetualId,
+			position.GetBigQuantums(),
+		)
+		if err != nil {
+			return nil, err
 		}
+		bigMaintenanceMargins[position.PerpetualId] = bigMaintenanceMargin
+		perpetualIds = append(perpetualIds, position.PerpetualId)
 	}
 
 	// Return an error if there are no perpetual positions to liquidate.
-	return 0,
-		errorsmod.Wrapf(
-			types.ErrNoPerpetualPositionsToLiquidate,
-			"Subaccount ID: %v",
-			subaccount.Id,
-		)
+	if len(perpetualIds) == 0 {
+		return nil,
+			errorsmod.Wrapf(
+				types.ErrNoPerpetualPositionsToLiquidate,
+				"Subaccount ID: %v",
+				subaccount.Id,
+			)
+	}
+
+	sort.Slice(perpetualIds, func(i, j int) bool {
+		x, y := perpetualIds[i], perpetualIds[j]
+		if cmp := bigMaintenanceMargins[x].Cmp(bigMaintenanceMargins[y]); cmp != 0 {
+			return cmp == 1
+		}
+		return x < y
+	})
+
+	return perpetualIds, nil
 }
 
 // GetLiquidatablePositionSizeDelta returns the max number of base quantums to liquidate
 // from the perpetual position without exceeding the block and position limits.
+// If the target margin config is enabled, this is further capped to the smallest number of base
+// quantums that restores the health of the subaccount, see `getTargetMarginAbsDeltaQuantums`.
 func (k Keeper) GetLiquidatablePositionSizeDelta(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -798,8 +1427,8 @@ func (k Keeper) GetLiquidatablePositionSizeDelta(
 
 	clobPair := k.mustGetClobPairForPerpetualId(ctx, perpetualId)
 
-	// Get the maximum notional liquidatable for this position.
-	_, bigMaxPositionNotionalLiquidatable, err := k.GetMaxAndMinPositionNotionalLiquidatable(
+	// Get the minimum and maximum notional liquidatable for this position.
+	bigMinPositionNotionalLiquidatable, bigMaxPositionNotionalLiquidatable, err := k.GetMaxAndMinPositionNotionalLiquidatable(
 		ctx,
 		perpetualPosition,
 	)
@@ -832,47 +1461,240 @@ func (k Keeper) GetLiquidatablePositionSizeDelta(
 		panic(err)
 	}
 
-	// Return the full position to avoid any rounding errors.
+	var absDeltaQuantums *big.Int
 	if bigQuoteQuantums.CmpAbs(bigMaxQuoteQuantumsLiquidatable) <= 0 ||
 		perpetualPosition.GetBigQuantums().CmpAbs(
 			new(big.Int).SetUint64(clobPair.StepBaseQuantums),
 		) <= 0 {
-		return new(big.Int).Neg(perpetualPosition.GetBigQuantums()), nil
+		// Liquidate the full position to avoid any rounding errors.
+		absDeltaQuantums = new(big.Int).Abs(perpetualPosition.GetBigQuantums())
+	} else {
+		// Convert the max notional liquidatable to base quantums.
+		absDeltaQuantums, err = k.perpetualsKeeper.GetNotionalInBaseQuantums(
+			ctx,
+			perpetualId,
+			bigMaxQuoteQuantumsLiquidatable,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		// Round to the nearest step size.
+		absDeltaQuantums = lib.BigIntRoundToMultiple(
+			absDeltaQuantums,
+			new(big.Int).SetUint64(clobPair.StepBaseQuantums),
+			false,
+		)
+
+		// Clamp the base quantums to liquidate to the step size and the size of the position
+		// in case there's rounding errors.
+		absDeltaQuantums = lib.BigIntClamp(
+			absDeltaQuantums,
+			new(big.Int).SetUint64(clobPair.StepBaseQuantums),
+			new(big.Int).Abs(perpetualPosition.GetBigQuantums()),
+		)
+	}
+
+	// Don't liquidate more of the position than is needed to restore the health of the subaccount, but
+	// liquidate at least the minimum notional liquidatable for this position.
+	targetMarginConfig := k.GetLiquidationsConfig(ctx).TargetMarginConfig
+	if targetMarginConfig.Enabled {
+		bigTargetAbsDeltaQuantums, err := k.getTargetMarginAbsDeltaQuantums(
+			ctx,
+			subaccountId,
+			perpetualPosition,
+			clobPair,
+			targetMarginConfig,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		bigMinAbsDeltaQuantums, err := k.perpetualsKeeper.GetNotionalInBaseQuantums(
+			ctx,
+			perpetualId,
+			bigMinPositionNotionalLiquidatable,
+		)
+		if err != nil {
+			return nil, err
+		}
+		bigMinAbsDeltaQuantums = lib.BigMin(
+			lib.BigIntRoundToMultiple(
+				bigMinAbsDeltaQuantums.Abs(bigMinAbsDeltaQuantums),
+				new(big.Int).SetUint64(clobPair.StepBaseQuantums),
+				true,
+			),
+			new(big.Int).Abs(perpetualPosition.GetBigQuantums()),
+		)
+
+		absDeltaQuantums = lib.BigMin(
+			absDeltaQuantums,
+			lib.BigMax(bigTargetAbsDeltaQuantums, bigMinAbsDeltaQuantums),
+		)
+	}
+
+	// Negate the position size if it's a long position to get the size delta.
+	if perpetualPosition.GetIsLong() {
+		return absDeltaQuantums.Neg(absDeltaQuantums), nil
 	}
 
-	// Convert the max notional liquidatable to base quantums.
-	absDeltaQuantums, err := k.perpetualsKeeper.GetNotionalInBaseQuantums(
+	return absDeltaQuantums, nil
+}
+
+// getTargetMarginAbsDeltaQuantums returns the smallest number of base quantums, as a multiple of the
+// step size of the clob pair, to liquidate from the perpetual position such that the margin group of the
+// position is back above its maintenance margin requirement plus `MaintenanceMarginBufferPpm`.
+// The position is assumed to be closed at its fillable price, with the maximum liquidation fee paid to
+// the insurance fund. If no reduction smaller than the full position restores the health of the
+// subaccount, the size of the full position is returned.
+//
+// The smallest reduction is found by binary search over the number of steps, which assumes the health of
+// the subaccount increases with the size of the reduction. Since slippage of the fillable price and fees
+// can break this assumption, the reduction found is checked, and the size of the full position is
+// returned if it doesn't restore the health of the subaccount.
+func (k Keeper) getTargetMarginAbsDeltaQuantums(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualPosition *satypes.PerpetualPosition,
+	clobPair types.ClobPair,
+	targetMarginConfig types.TargetMarginConfig,
+) (
+	absDeltaQuantums *big.Int,
+	err error,
+) {
+	perpetualId := perpetualPosition.PerpetualId
+	psBig := perpetualPosition.GetBigQuantums()
+	absPsBig := new(big.Int).Abs(psBig)
+
+	// The following values are needed for calculating the net collateral and maintenance margin
+	// requirement of the margin group after the reduction:
+	// - TNC (total net collateral).
+	// - TMMR (total maintenance margin requirement).
+	// - PNNV (position net notional value).
+	// - PMMR (position maintenance margin requirement).
+	// - FP (fillable price of the position).
+	tncBig, _, tmmrBig, err := k.getNetCollateralAndMarginRequirementsForPerpetual(
 		ctx,
+		subaccountId,
 		perpetualId,
-		bigMaxQuoteQuantumsLiquidatable,
 	)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	// Round to the nearest step size.
-	absDeltaQuantums = lib.BigIntRoundToMultiple(
-		absDeltaQuantums,
-		new(big.Int).SetUint64(clobPair.StepBaseQuantums),
-		false,
-	)
+	pnnvBig, err := k.perpetualsKeeper.GetNetCollateral(ctx, perpetualId, psBig)
+	if err != nil {
+		return nil, err
+	}
+
+	_, pmmrBig, err := k.perpetualsKeeper.GetMarginRequirements(ctx, perpetualId, psBig)
+	if err != nil {
+		return nil, err
+	}
+
+	fillablePriceRat, err := k.GetFillablePrice(ctx, subaccountId, perpetualId, new(big.Int).Neg(psBig))
+	if err != nil {
+		return nil, err
+	}
 
-	// Clamp the base quantums to liquidate to the step size and the size of the position
-	// in case there's rounding errors.
-	absDeltaQuantums = lib.BigIntClamp(
-		absDeltaQuantums,
-		new(big.Int).SetUint64(clobPair.StepBaseQuantums),
-		new(big.Int).Abs(perpetualPosition.GetBigQuantums()),
+	maxLiquidationFeePpm := k.GetLiquidationsConfig(ctx).MaxLiquidationFeePpm
+	targetMarginRatioPpm := new(big.Int).Add(
+		lib.BigIntOneMillion(),
+		new(big.Int).SetUint64(uint64(targetMarginConfig.MaintenanceMarginBufferPpm)),
 	)
 
-	// Negate the position size if it's a long position to get the size delta.
-	if perpetualPosition.GetIsLong() {
-		return absDeltaQuantums.Neg(absDeltaQuantums), nil
+	// isHealthyAfterDelta returns true if `TNC + DNNV + DQQ - FEE >= (TMMR + DMMR) * (1 + buffer)`, where:
+	// - DNNV is the change in position net notional value.
+	// - DQQ is the quote quantums received for closing the delta at the fillable price.
+	// - FEE is the maximum liquidation fee for `DQQ`.
+	// - DMMR is the change in position maintenance margin requirement.
+	isHealthyAfterDelta := func(absDeltaQuantums *big.Int) (bool, error) {
+		deltaQuantums := new(big.Int).Set(absDeltaQuantums)
+		if psBig.Sign() > 0 {
+			deltaQuantums.Neg(deltaQuantums)
+		}
+		psadBig := new(big.Int).Add(psBig, deltaQuantums)
+
+		pnnvadBig, err := k.perpetualsKeeper.GetNetCollateral(ctx, perpetualId, psadBig)
+		if err != nil {
+			return false, err
+		}
+		_, pmmradBig, err := k.perpetualsKeeper.GetMarginRequirements(ctx, perpetualId, psadBig)
+		if err != nil {
+			return false, err
+		}
+
+		// Round the quote quantums received towards negative infinity to be conservative.
+		deltaQuoteQuantumsBig := lib.BigRatRound(
+			new(big.Rat).Mul(fillablePriceRat, new(big.Rat).SetInt(new(big.Int).Neg(deltaQuantums))),
+			false,
+		)
+		liquidationFeeBig := lib.BigIntMulPpm(new(big.Int).Abs(deltaQuoteQuantumsBig), maxLiquidationFeePpm)
+
+		netCollateralBig := new(big.Int).Add(tncBig, new(big.Int).Sub(pnnvadBig, pnnvBig))
+		netCollateralBig.Add(netCollateralBig, deltaQuoteQuantumsBig)
+		netCollateralBig.Sub(netCollateralBig, liquidationFeeBig)
+
+		maintenanceMarginBig := new(big.Int).Add(tmmrBig, new(big.Int).Sub(pmmradBig, pmmrBig))
+
+		return new(big.Int).Mul(netCollateralBig, lib.BigIntOneMillion()).Cmp(
+			new(big.Int).Mul(maintenanceMarginBig, targetMarginRatioPpm),
+		) >= 0, nil
+	}
+
+	// Binary search for the smallest number of steps that restores the health of the subaccount.
+	// The last step is capped to the size of the position.
+	stepBig := new(big.Int).SetUint64(clobPair.StepBaseQuantums)
+	getAbsDeltaQuantumsForSteps := func(numSteps *big.Int) *big.Int {
+		return lib.BigMin(new(big.Int).Mul(numSteps, stepBig), absPsBig)
+	}
+	low := big.NewInt(1)
+	high := lib.BigIntRoundToMultiple(absPsBig, stepBig, true)
+	high.Div(high, stepBig)
+	for low.Cmp(high) < 0 {
+		mid := new(big.Int).Add(low, high)
+		mid.Rsh(mid, 1)
+		isHealthy, err := isHealthyAfterDelta(getAbsDeltaQuantumsForSteps(mid))
+		if err != nil {
+			return nil, err
+		}
+		if isHealthy {
+			high = mid
+		} else {
+			low = mid.Add(mid, big.NewInt(1))
+		}
 	}
 
+	absDeltaQuantums = getAbsDeltaQuantumsForSteps(low)
+	isHealthy, err := isHealthyAfterDelta(absDeltaQuantums)
+	if err != nil {
+		return nil, err
+	}
+	if !isHealthy {
+		return absPsBig, nil
+	}
 	return absDeltaQuantums, nil
 }
 
+// canLiquidateAnotherPerpetualPosition returns true if the subaccount has not exhausted any of its
+// subaccount block limits with the liquidations that already occurred in this block.
+func (k Keeper) canLiquidateAnotherPerpetualPosition(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) bool {
+	subaccountLiquidationInfo := k.GetSubaccountLiquidationInfo(ctx, subaccountId)
+	subaccountBlockLimits := k.GetLiquidationsConfig(ctx).SubaccountBlockLimits
+
+	maxPositionsLiquidated := subaccountBlockLimits.MaxPerpetualPositionsLiquidated
+	if maxPositionsLiquidated != 0 &&
+		uint32(len(subaccountLiquidationInfo.PerpetualsLiquidated)) >= maxPositionsLiquidated {
+		return false
+	}
+
+	return subaccountLiquidationInfo.NotionalLiquidated < subaccountBlockLimits.MaxNotionalLiquidated &&
+		subaccountLiquidationInfo.QuantumsInsuranceLost < subaccountBlockLimits.MaxQuantumsInsuranceLost
+}
+
 // GetSubaccountMaxNotionalLiquidatable returns the maximum notional that the subaccount can liquidate
 // without exceeding the subaccount block limits.
 // This function takes into account any previous liquidations in the same block and returns an error if
@@ -1108,14 +1930,25 @@ func (k Keeper) validateMatchedLiquidation(
 
 	// Calculate the insurance fund delta for this fill.
 	liquidatedSubaccountId := order.GetSubaccountId()
-	insuranceFundDelta, err = k.GetLiquidationInsuranceFundDelta(
-		ctx,
-		liquidatedSubaccountId,
-		perpetualId,
-		order.IsBuy(),
-		fillAmount.ToUint64(),
-		makerSubticks,
-	)
+	if order.IsAssetLiquidation() {
+		insuranceFundDelta, err = k.GetAssetLiquidationInsuranceFundDelta(
+			ctx,
+			liquidatedSubaccountId,
+			k.mustGetClobPair(ctx, order.GetClobPairId()),
+			order.IsBuy(),
+			fillAmount.ToUint64(),
+			makerSubticks,
+		)
+	} else {
+		insuranceFundDelta, err = k.GetLiquidationInsuranceFundDelta(
+			ctx,
+			liquidatedSubaccountId,
+			perpetualId,
+			order.IsBuy(),
+			fillAmount.ToUint64(),
+			makerSubticks,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1132,6 +1965,12 @@ func (k Keeper) validateMatchedLiquidation(
 		)
 	}
 
+	// Subaccount block limits are tracked per perpetual. Asset liquidations always liquidate the full
+	// borrowed position and are only limited by the insurance fund balance.
+	if order.IsAssetLiquidation() {
+		return insuranceFundDelta, nil
+	}
+
 	// Validate that total notional liquidated and total insurance funds lost do not exceed subaccount block limits.
 	if err := k.validateLiquidationAgainstSubaccountBlockLimits(
 		ctx,
@@ -1152,6 +1991,8 @@ func (k Keeper) validateMatchedLiquidation(
 //
 // The following validation occurs in this method:
 //   - The subaccount and perpetual ID pair has not been previously liquidated in the same block.
+//   - The number of perpetual positions of the subaccount liquidated in the same block, including this one,
+//     does not exceed the maximum number of positions that a single subaccount can have liquidated per block.
 //   - The total notional liquidated does not exceed the maximum notional amount that a single subaccount
 //     can have liquidated per block.
 //   - The total insurance lost does not exceed the maximum insurance lost per block.
@@ -1175,6 +2016,22 @@ func (k Keeper) validateLiquidationAgainstSubaccountBlockLimits(
 		return err
 	}
 
+	// Validate that this liquidation does not exceed the maximum number of perpetual positions that a single
+	// subaccount can have liquidated per block. Note that `perpetualId` is guaranteed to not be part of the
+	// previously liquidated perpetuals at this point.
+	maxPositionsLiquidated := k.GetLiquidationsConfig(ctx).SubaccountBlockLimits.MaxPerpetualPositionsLiquidated
+	numPositionsLiquidated := len(k.GetSubaccountLiquidationInfo(ctx, subaccountId).PerpetualsLiquidated)
+	if maxPositionsLiquidated != 0 && uint32(numPositionsLiquidated) >= maxPositionsLiquidated {
+		return errorsmod.Wrapf(
+			types.ErrLiquidationExceedsSubaccountMaxPositionsLiquidated,
+			"Subaccount ID: %v, Perpetual ID: %v, Max Positions Liquidated: %v, Positions Liquidated: %v",
+			subaccountId,
+			perpetualId,
+			maxPositionsLiquidated,
+			numPositionsLiquidated,
+		)
+	}
+
 	bigNotionalLiquidated, err := k.perpetualsKeeper.GetNetNotional(ctx, perpetualId, fillAmount.ToBigInt())
 	if err != nil {
 		return err
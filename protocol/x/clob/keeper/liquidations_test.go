@@ -20,6 +20,7 @@ import (
 	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -294,6 +295,11 @@ func TestPlacePerpetualLiquidation(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -1038,6 +1044,11 @@ func TestPlacePerpetualLiquidation_PreexistingLiquidation(t *testing.T) {
 					perpetual.Params.AtomicResolution,
 					perpetual.Params.DefaultFundingPpm,
 					perpetual.Params.LiquidityTier,
+					perpetual.Params.OpenInterestCapNotional,
+					perpetual.Params.FundingRateBounds,
+					perpetual.Params.InterestRatePpm,
+					perpetual.Params.FundingTickEpoch,
+					perpetual.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -1919,6 +1930,11 @@ func TestPlacePerpetualLiquidation_Deleveraging(t *testing.T) {
 					perpetual.Params.AtomicResolution,
 					perpetual.Params.DefaultFundingPpm,
 					perpetual.Params.LiquidityTier,
+					perpetual.Params.OpenInterestCapNotional,
+					perpetual.Params.FundingRateBounds,
+					perpetual.Params.InterestRatePpm,
+					perpetual.Params.FundingTickEpoch,
+					perpetual.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -2044,6 +2060,86 @@ func TestPlacePerpetualLiquidation_Deleveraging(t *testing.T) {
 	}
 }
 
+func TestLiquidateSubaccountsAgainstOrderbook_SeizesCollateral(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+	ctx := ks.Ctx.WithIsCheckTx(true)
+
+	// Create the default markets.
+	keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper))
+	a := constants.BtcUsd_80PercentCollateralWeight
+	_, err := ks.AssetsKeeper.CreateAsset(
+		ctx,
+		a.Id,
+		a.Symbol,
+		a.Denom,
+		a.DenomExponent,
+		a.HasMarket,
+		a.MarketId,
+		a.AtomicResolution,
+		a.CollateralWeightPpm,
+	)
+	require.NoError(t, err)
+
+	// Carl has no perpetual positions, a -$46,000 USDC balance and 1 BTC of collateral worth $40,000.
+	ks.SubaccountsKeeper.SetSubaccount(ctx, constants.Carl_Num0_1BTC_Collateral_Short_46000USD)
+	ks.SubaccountsKeeper.SetSubaccount(ctx, satypes.Subaccount{
+		Id:             &constants.Dave_Num0,
+		AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)),
+	})
+
+	require.NoError(t, ks.ClobKeeper.LiquidateSubaccountsAgainstOrderbook(ctx, []satypes.SubaccountId{constants.Carl_Num0}))
+
+	// 0.92 BTC of Carl's collateral is seized and sold to Dave at the oracle price to repay the USDC balance.
+	require.Equal(
+		t,
+		satypes.Subaccount{
+			Id: &constants.Carl_Num0,
+			AssetPositions: []*satypes.AssetPosition{
+				{
+					AssetId:  a.Id,
+					Quantums: dtypes.NewInt(8_000_000),
+				},
+			},
+		},
+		ks.SubaccountsKeeper.GetSubaccount(ctx, constants.Carl_Num0),
+	)
+	require.Equal(
+		t,
+		satypes.Subaccount{
+			Id: &constants.Dave_Num0,
+			AssetPositions: append(
+				keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+				&satypes.AssetPosition{
+					AssetId:  a.Id,
+					Quantums: dtypes.NewInt(92_000_000),
+				},
+			),
+		},
+		ks.SubaccountsKeeper.GetSubaccount(ctx, constants.Dave_Num0),
+	)
+	require.Equal(
+		t,
+		[]types.OperationRaw{
+			clobtest.NewMatchOperationRawFromAssetDeleveraging(
+				types.MatchAssetDeleveraging{
+					Liquidated: constants.Carl_Num0,
+					AssetId:    a.Id,
+					Fills: []types.MatchPerpetualDeleveraging_Fill{
+						{
+							OffsettingSubaccountId: constants.Dave_Num0,
+							FillAmount:             92_000_000,
+						},
+					},
+				},
+			),
+		},
+		ks.ClobKeeper.GetOperations(ctx).GetOperationsQueue(),
+	)
+}
+
 func TestPlacePerpetualLiquidation_SendOffchainMessages(t *testing.T) {
 	indexerEventManager := &mocks.IndexerEventManager{}
 	for _, message := range constants.TestOffchainMessages {
@@ -2113,10 +2209,12 @@ func TestIsLiquidatable(t *testing.T) {
 	tests := map[string]struct {
 		// State.
 		perpetuals []perptypes.Perpetual
+		assets     []assettypes.Asset
 
 		// Subaccount state.
 		assetPositions     []*satypes.AssetPosition
 		perpetualPositions []*satypes.PerpetualPosition
+		isolatedMargins    []*satypes.IsolatedMargin
 
 		// Expectations.
 		expectedIsLiquidatable bool
@@ -2181,6 +2279,76 @@ func TestIsLiquidatable(t *testing.T) {
 			),
 			expectedIsLiquidatable: true,
 		},
+		"Subaccount with collateral and negative net collateral is liquidatable": {
+			assets: []assettypes.Asset{
+				*constants.BtcUsd_80PercentCollateralWeight,
+			},
+			assetPositions: append(
+				keepertest.CreateUsdcAssetPosition(
+					big.NewInt(constants.QuoteBalance_OneDollar*-40_001),
+				),
+				&constants.Long_Asset_1BTC, // $40,000 of collateral.
+			),
+			expectedIsLiquidatable: true,
+		},
+		"Subaccount with collateral and zero net collateral is not liquidatable": {
+			assets: []assettypes.Asset{
+				*constants.BtcUsd_80PercentCollateralWeight,
+			},
+			assetPositions: append(
+				keepertest.CreateUsdcAssetPosition(
+					big.NewInt(constants.QuoteBalance_OneDollar*-40_000),
+				),
+				&constants.Long_Asset_1BTC, // $40,000 of collateral.
+			),
+			expectedIsLiquidatable: false,
+		},
+		"Isolated position below maintenance margin requirements is liquidatable": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+
+			perpetualPositions: []*satypes.PerpetualPosition{
+				{
+					PerpetualId: uint32(0),
+					Quantums:    dtypes.NewInt(10_000_000), // 0.1 BTC, $5,000 notional.
+				},
+			},
+			// $100,000 of collateral, of which -$4,501 is allotted to the isolated position.
+			assetPositions: keepertest.CreateUsdcAssetPosition(
+				big.NewInt(constants.QuoteBalance_OneDollar * 100_000),
+			),
+			isolatedMargins: []*satypes.IsolatedMargin{
+				{
+					PerpetualId:  uint32(0),
+					QuoteBalance: dtypes.NewInt(constants.QuoteBalance_OneDollar * -4_501),
+				},
+			},
+			expectedIsLiquidatable: true,
+		},
+		"Isolated position at maintenance margin requirements is not liquidatable": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+
+			perpetualPositions: []*satypes.PerpetualPosition{
+				{
+					PerpetualId: uint32(0),
+					Quantums:    dtypes.NewInt(10_000_000), // 0.1 BTC, $5,000 notional.
+				},
+			},
+			// $1,000 of collateral, of which -$4,500 is allotted to the isolated position.
+			assetPositions: keepertest.CreateUsdcAssetPosition(
+				big.NewInt(constants.QuoteBalance_OneDollar * 1_000),
+			),
+			isolatedMargins: []*satypes.IsolatedMargin{
+				{
+					PerpetualId:  uint32(0),
+					QuoteBalance: dtypes.NewInt(constants.QuoteBalance_OneDollar * -4_500),
+				},
+			},
+			expectedIsLiquidatable: false,
+		},
 	}
 
 	for name, tc := range tests {
@@ -2205,6 +2373,28 @@ func TestIsLiquidatable(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
+				)
+				require.NoError(t, err)
+			}
+
+			// Create all assets.
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+			for _, a := range tc.assets {
+				_, err := ks.AssetsKeeper.CreateAsset(
+					ks.Ctx,
+					a.Id,
+					a.Symbol,
+					a.Denom,
+					a.DenomExponent,
+					a.HasMarket,
+					a.MarketId,
+					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -2217,6 +2407,7 @@ func TestIsLiquidatable(t *testing.T) {
 				},
 				AssetPositions:     tc.assetPositions,
 				PerpetualPositions: tc.perpetualPositions,
+				IsolatedMargins:    tc.isolatedMargins,
 			}
 			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
 			isLiquidatable, err := ks.ClobKeeper.IsLiquidatable(ks.Ctx, *subaccount.Id)
@@ -2624,6 +2815,11 @@ func TestGetBankruptcyPriceInQuoteQuantums(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -3154,6 +3350,11 @@ func TestGetFillablePrice(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -3578,6 +3779,11 @@ func TestGetLiquidationInsuranceFundDelta(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -3802,6 +4008,7 @@ func TestGetPerpetualPositionToLiquidate(t *testing.T) {
 	tests := map[string]struct {
 		// Subaccount state.
 		perpetualPositions []*satypes.PerpetualPosition
+		assetPositions     []*satypes.AssetPosition
 		// Perpetual state.
 		perpetuals []perptypes.Perpetual
 		// Clob state.
@@ -4277,6 +4484,188 @@ func TestGetPerpetualPositionToLiquidate(t *testing.T) {
 				),
 			),
 		},
+		`Position with the largest maintenance margin requirement is returned first`: {
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneTenthBTCLong,
+				&constants.PerpetualPosition_OneTenthEthLong,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_NoMarginRequirement,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			},
+			liquidationConfig: constants.LiquidationsConfig_No_Limit,
+
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+				constants.ClobPair_Eth,
+			},
+
+			expectedClobPair: constants.ClobPair_Eth,
+			expectedQuantums: new(big.Int).Neg(
+				constants.PerpetualPosition_OneTenthEthLong.GetBigQuantums(),
+			),
+		},
+		`Position with the smallest perpetual id is returned first when maintenance margins are equal`: {
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneTenthEthLong,
+				&constants.PerpetualPosition_OneTenthBTCLong,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_NoMarginRequirement,
+				constants.EthUsd_NoMarginRequirement,
+			},
+			liquidationConfig: constants.LiquidationsConfig_No_Limit,
+
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+				constants.ClobPair_Eth,
+			},
+
+			expectedClobPair: constants.ClobPair_Btc,
+			expectedQuantums: new(big.Int).Neg(
+				constants.PerpetualPosition_OneTenthBTCLong.GetBigQuantums(),
+			),
+		},
+		`Target margin: smallest reduction that restores maintenance margin is returned`: {
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneBTCLong, // 1 BTC, $50,000 notional, $5,000 MMR
+			},
+			// TNC = $50,000 - $45,500 = $4,500.
+			assetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(-45_500_000_000)),
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			liquidationConfig: types.LiquidationsConfig{
+				MaxLiquidationFeePpm:  5_000,
+				FillablePriceConfig:   constants.FillablePriceConfig_Default,
+				PositionBlockLimits:   constants.PositionBlockLimits_No_Limit,
+				SubaccountBlockLimits: constants.SubaccountBlockLimits_No_Limit,
+				TargetMarginConfig: types.TargetMarginConfig{
+					Enabled: true,
+				},
+			},
+
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+
+			expectedClobPair: constants.ClobPair_Btc,
+			// The fillable price is $49,950 and the max liquidation fee is 0.5%, so closing `x` BTC
+			// reduces TNC by `$299.75x` and MMR by `$5,000x`. `$4,500 - $299.75x >= $5,000 - $5,000x`
+			// requires `x >= 0.10637732`, which is rounded up to a multiple of the step size.
+			expectedQuantums: new(big.Int).SetInt64(-10_637_735),
+		},
+		`Target margin: smallest reduction that restores maintenance margin plus buffer is returned`: {
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneBTCLong,
+			},
+			assetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(-45_500_000_000)),
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			liquidationConfig: types.LiquidationsConfig{
+				MaxLiquidationFeePpm:  5_000,
+				FillablePriceConfig:   constants.FillablePriceConfig_Default,
+				PositionBlockLimits:   constants.PositionBlockLimits_No_Limit,
+				SubaccountBlockLimits: constants.SubaccountBlockLimits_No_Limit,
+				TargetMarginConfig: types.TargetMarginConfig{
+					Enabled:                    true,
+					MaintenanceMarginBufferPpm: 100_000,
+				},
+			},
+
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+
+			expectedClobPair: constants.ClobPair_Btc,
+			// `$4,500 - $299.75x >= ($5,000 - $5,000x) * 1.1` requires `x >= 0.19229845`.
+			expectedQuantums: new(big.Int).SetInt64(-19_229_845),
+		},
+		`Target margin: position block limits are respected`: {
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneBTCLong,
+			},
+			assetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(-45_500_000_000)),
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			liquidationConfig: types.LiquidationsConfig{
+				MaxLiquidationFeePpm: 5_000,
+				FillablePriceConfig:  constants.FillablePriceConfig_Default,
+				PositionBlockLimits: types.PositionBlockLimits{
+					MinPositionNotionalLiquidated:   1_000,
+					MaxPositionPortionLiquidatedPpm: 100_000,
+				},
+				SubaccountBlockLimits: constants.SubaccountBlockLimits_No_Limit,
+				TargetMarginConfig: types.TargetMarginConfig{
+					Enabled: true,
+				},
+			},
+
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+
+			expectedClobPair: constants.ClobPair_Btc,
+			// 10% of the position is less than the reduction that restores maintenance margin.
+			expectedQuantums: new(big.Int).SetInt64(-10_000_000),
+		},
+		`Target margin: minimum position notional liquidated is respected`: {
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneBTCLong,
+			},
+			assetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(-45_500_000_000)),
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			liquidationConfig: types.LiquidationsConfig{
+				MaxLiquidationFeePpm: 5_000,
+				FillablePriceConfig:  constants.FillablePriceConfig_Default,
+				PositionBlockLimits: types.PositionBlockLimits{
+					MinPositionNotionalLiquidated:   10_000_000_000, // $10,000
+					MaxPositionPortionLiquidatedPpm: lib.OneMillion,
+				},
+				SubaccountBlockLimits: constants.SubaccountBlockLimits_No_Limit,
+				TargetMarginConfig: types.TargetMarginConfig{
+					Enabled: true,
+				},
+			},
+
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+
+			expectedClobPair: constants.ClobPair_Btc,
+			// The reduction that restores maintenance margin is less than the $10,000 minimum.
+			expectedQuantums: new(big.Int).SetInt64(-20_000_000),
+		},
+		`Target margin: full position is returned if no smaller reduction restores maintenance margin`: {
+			perpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneBTCLong,
+			},
+			// TNC = $50,000 - $50,500 = -$500.
+			assetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(-50_500_000_000)),
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			liquidationConfig: types.LiquidationsConfig{
+				MaxLiquidationFeePpm:  5_000,
+				FillablePriceConfig:   constants.FillablePriceConfig_Default,
+				PositionBlockLimits:   constants.PositionBlockLimits_No_Limit,
+				SubaccountBlockLimits: constants.SubaccountBlockLimits_No_Limit,
+				TargetMarginConfig: types.TargetMarginConfig{
+					Enabled: true,
+				},
+			},
+
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+
+			expectedClobPair: constants.ClobPair_Btc,
+			expectedQuantums: new(big.Int).SetInt64(-100_000_000),
+		},
 	}
 
 	for name, tc := range tests {
@@ -4302,6 +4691,11 @@ func TestGetPerpetualPositionToLiquidate(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -4313,6 +4707,7 @@ func TestGetPerpetualPositionToLiquidate(t *testing.T) {
 					Number: 0,
 				},
 				PerpetualPositions: tc.perpetualPositions,
+				AssetPositions:     tc.assetPositions,
 			}
 			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
 
@@ -4550,6 +4945,11 @@ func TestMaybeGetLiquidationOrder(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -4873,6 +5273,11 @@ func TestGetMaxAndMinPositionNotionalLiquidatable(t *testing.T) {
 				constants.BtcUsd_100PercentMarginRequirement.Params.AtomicResolution,
 				constants.BtcUsd_100PercentMarginRequirement.Params.DefaultFundingPpm,
 				constants.BtcUsd_100PercentMarginRequirement.Params.LiquidityTier,
+				constants.BtcUsd_100PercentMarginRequirement.Params.OpenInterestCapNotional,
+				constants.BtcUsd_100PercentMarginRequirement.Params.FundingRateBounds,
+				constants.BtcUsd_100PercentMarginRequirement.Params.InterestRatePpm,
+				constants.BtcUsd_100PercentMarginRequirement.Params.FundingTickEpoch,
+				constants.BtcUsd_100PercentMarginRequirement.Params.FundingSampleEpoch,
 			)
 			require.NoError(t, err)
 
@@ -5026,6 +5431,11 @@ func TestSortLiquidationOrders(t *testing.T) {
 				constants.BtcUsd_100PercentMarginRequirement.Params.AtomicResolution,
 				constants.BtcUsd_100PercentMarginRequirement.Params.DefaultFundingPpm,
 				constants.BtcUsd_100PercentMarginRequirement.Params.LiquidityTier,
+				constants.BtcUsd_100PercentMarginRequirement.Params.OpenInterestCapNotional,
+				constants.BtcUsd_100PercentMarginRequirement.Params.FundingRateBounds,
+				constants.BtcUsd_100PercentMarginRequirement.Params.InterestRatePpm,
+				constants.BtcUsd_100PercentMarginRequirement.Params.FundingTickEpoch,
+				constants.BtcUsd_100PercentMarginRequirement.Params.FundingSampleEpoch,
 			)
 			require.NoError(t, err)
 
@@ -414,9 +414,14 @@ func (k Keeper) InitializeCumulativePnLs(
 		}
 
 		// Get a mapping from perpetual Id to current perpetual funding index.
-		perpetual, err := perpetualKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
-		if err != nil {
-			panic(perptypes.ErrPerpetualDoesNotExist)
+		// Spot CLOB pairs have no perpetual and therefore no funding.
+		perpetualFundingIndex := big.NewInt(0)
+		if clobPair.GetPerpetualClobMetadata() != nil {
+			perpetual, err := perpetualKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
+			if err != nil {
+				panic(perptypes.ErrPerpetualDoesNotExist)
+			}
+			perpetualFundingIndex = perpetual.FundingIndex.BigInt()
 		}
 
 		for _, cumulativePnL := range []map[types.ClobPairId]*CumulativePnL{
@@ -430,7 +435,7 @@ func (k Keeper) InitializeCumulativePnLs(
 				VolumeQuoteQuantums:         big.NewInt(0),
 				ClobPair:                    clobPair,
 				MidPriceSubticks:            midPriceSubticks,
-				PerpetualFundingIndex:       perpetual.FundingIndex.BigInt(),
+				PerpetualFundingIndex:       perpetualFundingIndex,
 			}
 		}
 	}
@@ -465,8 +470,7 @@ func (k Keeper) GetMEVDataFromOperations(
 			if err != nil {
 				return nil, err
 			}
-			msgPlaceOrder := tx.GetMsgs()[0].(*types.MsgPlaceOrder)
-			order := msgPlaceOrder.Order
+			order := types.MustGetShortTermOrderFromPlacementMsg(tx.GetMsgs()[0])
 			placedShortTermOrders[order.GetOrderId()] = order
 		case *types.OperationRaw_Match:
 			switch match := typedOperation.Match.Match.(type) {
@@ -671,8 +675,7 @@ func (k Keeper) CalculateSubaccountPnLForMatches(
 			if err != nil {
 				return err
 			}
-			msgPlaceOrder := tx.GetMsgs()[0].(*types.MsgPlaceOrder)
-			order := msgPlaceOrder.Order
+			order := types.MustGetShortTermOrderFromPlacementMsg(tx.GetMsgs()[0])
 			placedShortTermOrders[order.GetOrderId()] = order
 		case *types.OperationRaw_Match:
 			switch match := typedOperation.Match.Match.(type) {
@@ -753,6 +756,10 @@ func (k Keeper) AddSettlementForPositionDelta(
 	clobPairToPnLs map[types.ClobPairId]*CumulativePnL,
 ) (err error) {
 	for _, cumulativePnL := range clobPairToPnLs {
+		// Spot CLOB pairs do not have funding payments.
+		if cumulativePnL.ClobPair.GetPerpetualClobMetadata() == nil {
+			continue
+		}
 		perpetualId := cumulativePnL.ClobPair.MustGetPerpetualId()
 		for subaccountId, deltaQuantums := range cumulativePnL.SubaccountPositionSizeDelta {
 			// Get the subaccount and its perpetual positions.
@@ -878,6 +878,11 @@ func TestRecordMevMetrics(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -1219,6 +1224,11 @@ func TestGetMidPrices(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
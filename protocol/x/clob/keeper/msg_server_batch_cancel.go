@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	errorlib "github.com/dydxprotocol/v4-chain/protocol/lib/error"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// BatchCancel performs batch cancellation functionality for stateful orders. Short-Term batch
+// cancellations are only processed during CheckTx and are never included in a block.
+func (k msgServer) BatchCancel(
+	goCtx context.Context,
+	msg *types.MsgBatchCancel,
+) (resp *types.MsgBatchCancelResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.BatchCancel,
+			metrics.DeliverTx,
+		)
+		if err != nil {
+			errorlib.LogDeliverTxError(k.Keeper.Logger(ctx), err, ctx.BlockHeight(), "BatchCancel", msg)
+		}
+	}()
+
+	// 1. Short-Term batches are never included in a block, so reject them if they reach DeliverTx.
+	if msg.IsShortTermBatch() {
+		return nil, errorsmod.Wrap(
+			types.ErrInvalidBatchCancel,
+			"Short-Term batch cancellations cannot be delivered in a block",
+		)
+	}
+
+	// 2. Cancel the orders on the ClobKeeper, which removes the canceled orders from state and the memstore.
+	canceledOrderIds, failedOrderIds, err := k.Keeper.BatchCancelStatefulOrders(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Update `ProcessProposerMatchesEvents` and add the on-chain Indexer events for the cancellations.
+	k.addStatefulOrderCancellations(ctx, canceledOrderIds)
+
+	return &types.MsgBatchCancelResponse{
+		CanceledOrderIds: canceledOrderIds,
+		FailedOrderIds:   failedOrderIds,
+	}, nil
+}
+
+// addStatefulOrderCancellations adds the IDs of stateful orders canceled by the user to
+// `ProcessProposerMatchesEvents` and emits an on-chain Indexer event for each cancellation.
+func (k msgServer) addStatefulOrderCancellations(ctx sdk.Context, canceledOrderIds []types.OrderId) {
+	processProposerMatchesEvents := k.Keeper.GetProcessProposerMatchesEvents(ctx)
+	processProposerMatchesEvents.PlacedStatefulCancellationOrderIds = append(
+		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
+		canceledOrderIds...,
+	)
+	k.Keeper.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+
+	for _, orderId := range canceledOrderIds {
+		k.Keeper.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					orderId,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+				),
+			),
+		)
+	}
+}
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
+	keeper "github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchCancel_ErrorIfShortTermBatch(t *testing.T) {
+	memClob := &mocks.MemClob{}
+	memClob.On("SetClobKeeper", mock.Anything).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+	msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+
+	msg := types.NewMsgBatchCancelShortTerm(
+		constants.Alice_Num0,
+		[]types.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0, 1}}},
+		20,
+	)
+	resp, err := msgServer.BatchCancel(ks.Ctx.WithIsCheckTx(false), msg)
+	require.ErrorIs(t, err, types.ErrInvalidBatchCancel)
+	require.Nil(t, resp)
+}
+
+func TestBatchCancel(t *testing.T) {
+	longTermOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+	otherLongTermOrder := constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10
+	conditionalOrder := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+
+	tests := map[string]struct {
+		// State.
+		orders []types.Order
+
+		// Parameters.
+		orderBatches     []types.OrderBatch
+		goodTilBlockTime uint32
+
+		// Expectations.
+		expectedCanceledOrderIds  []types.OrderId
+		expectedFailedOrderIds    []types.OrderId
+		expectedRemainingOrderIds []types.OrderId
+		expectedErr               error
+	}{
+		"Cancels all orders of the batch": {
+			orders: []types.Order{longTermOrder, otherLongTermOrder, conditionalOrder},
+			orderBatches: []types.OrderBatch{
+				{ClobPairId: 0, OrderFlags: types.OrderIdFlags_LongTerm, ClientIds: []uint32{0, 1}},
+				{ClobPairId: 0, OrderFlags: types.OrderIdFlags_Conditional, ClientIds: []uint32{0}},
+			},
+			goodTilBlockTime: 20,
+			expectedCanceledOrderIds: []types.OrderId{
+				longTermOrder.OrderId,
+				otherLongTermOrder.OrderId,
+				conditionalOrder.OrderId,
+			},
+			expectedFailedOrderIds:    []types.OrderId{},
+			expectedRemainingOrderIds: []types.OrderId{},
+		},
+		"Cancels the orders of the batch that exist and returns the orders that failed to be canceled": {
+			orders: []types.Order{longTermOrder, conditionalOrder},
+			orderBatches: []types.OrderBatch{
+				{ClobPairId: 0, OrderFlags: types.OrderIdFlags_LongTerm, ClientIds: []uint32{0, 1}},
+			},
+			goodTilBlockTime:          20,
+			expectedCanceledOrderIds:  []types.OrderId{longTermOrder.OrderId},
+			expectedFailedOrderIds:    []types.OrderId{otherLongTermOrder.OrderId},
+			expectedRemainingOrderIds: []types.OrderId{conditionalOrder.OrderId},
+		},
+		"Does not cancel orders with a GoodTilBlockTime greater than the batch": {
+			orders: []types.Order{longTermOrder, otherLongTermOrder},
+			orderBatches: []types.OrderBatch{
+				{ClobPairId: 0, OrderFlags: types.OrderIdFlags_LongTerm, ClientIds: []uint32{0, 1}},
+			},
+			goodTilBlockTime:          10,
+			expectedCanceledOrderIds:  []types.OrderId{otherLongTermOrder.OrderId},
+			expectedFailedOrderIds:    []types.OrderId{longTermOrder.OrderId},
+			expectedRemainingOrderIds: []types.OrderId{longTermOrder.OrderId},
+		},
+		"Returns an error if none of the orders could be canceled": {
+			orders: []types.Order{longTermOrder},
+			orderBatches: []types.OrderBatch{
+				{ClobPairId: 0, OrderFlags: types.OrderIdFlags_LongTerm, ClientIds: []uint32{1, 2}},
+			},
+			goodTilBlockTime:          20,
+			expectedRemainingOrderIds: []types.OrderId{longTermOrder.OrderId},
+			expectedErr:               types.ErrBatchCancelFailed,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			indexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+
+			ctx := ks.Ctx.WithBlockHeight(2).WithIsCheckTx(false)
+			ctx = ctx.WithBlockTime(time.Unix(int64(2), 0))
+			ks.BlockTimeKeeper.SetPreviousBlockInfo(ctx, &blocktimetypes.BlockInfo{
+				Height:    1,
+				Timestamp: time.Unix(int64(2), 0),
+			})
+			ks.ClobKeeper.MustSetProcessProposerMatchesEvents(
+				ctx,
+				types.ProcessProposerMatchesEvents{BlockHeight: 2},
+			)
+
+			for _, order := range tc.orders {
+				ks.ClobKeeper.SetLongTermOrderPlacement(ctx, order, 1)
+				ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(
+					ctx,
+					order.MustGetUnixGoodTilBlockTime(),
+					order.OrderId,
+				)
+			}
+
+			for _, orderId := range tc.expectedCanceledOrderIds {
+				indexerEventManager.On(
+					"AddTxnEvent",
+					ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewStatefulOrderRemovalEvent(
+							orderId,
+							sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+						),
+					),
+				).Return().Once()
+			}
+
+			resp, err := msgServer.BatchCancel(
+				ctx,
+				types.NewMsgBatchCancelStateful(constants.Alice_Num0, tc.orderBatches, tc.goodTilBlockTime),
+			)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedCanceledOrderIds, resp.CanceledOrderIds)
+				require.Equal(t, tc.expectedFailedOrderIds, resp.FailedOrderIds)
+			}
+
+			indexerEventManager.AssertExpectations(t)
+			indexerEventManager.AssertNumberOfCalls(t, "AddTxnEvent", len(tc.expectedCanceledOrderIds))
+
+			require.ElementsMatch(
+				t,
+				tc.expectedCanceledOrderIds,
+				ks.ClobKeeper.GetProcessProposerMatchesEvents(ctx).PlacedStatefulCancellationOrderIds,
+			)
+			for _, orderId := range tc.expectedCanceledOrderIds {
+				_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+				require.False(t, found)
+			}
+			for _, orderId := range tc.expectedRemainingOrderIds {
+				_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+				require.True(t, found)
+			}
+		})
+	}
+}
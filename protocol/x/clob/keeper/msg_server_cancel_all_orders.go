@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorlib "github.com/dydxprotocol/v4-chain/protocol/lib/error"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// CancelAllOrders cancels all stateful orders of a subaccount within the scope of the message.
+// Short-Term orders are canceled in the in-memory orderbook during CheckTx.
+func (k msgServer) CancelAllOrders(
+	goCtx context.Context,
+	msg *types.MsgCancelAllOrders,
+) (resp *types.MsgCancelAllOrdersResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.CancelAllOrders,
+			metrics.DeliverTx,
+		)
+		if err != nil {
+			errorlib.LogDeliverTxError(k.Keeper.Logger(ctx), err, ctx.BlockHeight(), "CancelAllOrders", msg)
+		}
+	}()
+
+	// 1. Remove the stateful orders within the scope of the message from state and the memstore.
+	canceledOrderIds := make([]types.OrderId, 0)
+	if msg.CancelsStatefulOrders() {
+		canceledOrderIds, err = k.Keeper.CancelAllStatefulOrders(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Update `ProcessProposerMatchesEvents` and add the on-chain Indexer events for the cancellations.
+	k.addStatefulOrderCancellations(ctx, canceledOrderIds)
+
+	return &types.MsgCancelAllOrdersResponse{CanceledOrderIds: canceledOrderIds}, nil
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	keeper "github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelAllOrders(t *testing.T) {
+	longTermOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+	otherClobPairLongTermOrder := constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25
+	conditionalOrder := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+	otherSubaccountOrder := constants.LongTermOrder_Alice_Num1_Id0_Clob0_Buy5_Price10_GTBT5
+	ocoTakeProfitOrder := constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Sell5_Price20_GTBT15_TakeProfit20_OCO
+	ocoStopLossOrder := constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price5_GTBT15_StopLoss5_OCO
+
+	tests := map[string]struct {
+		// State.
+		orders []types.Order
+
+		// Parameters.
+		msg types.MsgCancelAllOrders
+
+		// Expectations.
+		expectedCanceledOrderIds  []types.OrderId
+		expectedRemovedOrderIds   []types.OrderId
+		expectedRemainingOrderIds []types.OrderId
+		expectedErr               error
+	}{
+		"Cancels all stateful orders of the subaccount": {
+			orders: []types.Order{longTermOrder, otherClobPairLongTermOrder, conditionalOrder, otherSubaccountOrder},
+			msg: types.MsgCancelAllOrders{
+				SubaccountId: constants.Alice_Num0,
+				GoodTilBlock: 5,
+			},
+			expectedCanceledOrderIds: []types.OrderId{
+				longTermOrder.OrderId,
+				otherClobPairLongTermOrder.OrderId,
+				conditionalOrder.OrderId,
+			},
+			expectedRemovedOrderIds:   []types.OrderId{},
+			expectedRemainingOrderIds: []types.OrderId{otherSubaccountOrder.OrderId},
+		},
+		"Cancels the stateful orders of the clob pairs of the message": {
+			orders: []types.Order{longTermOrder, otherClobPairLongTermOrder, conditionalOrder},
+			msg: types.MsgCancelAllOrders{
+				SubaccountId: constants.Alice_Num0,
+				ClobPairIds:  []uint32{1},
+				GoodTilBlock: 5,
+			},
+			expectedCanceledOrderIds:  []types.OrderId{otherClobPairLongTermOrder.OrderId},
+			expectedRemovedOrderIds:   []types.OrderId{},
+			expectedRemainingOrderIds: []types.OrderId{longTermOrder.OrderId, conditionalOrder.OrderId},
+		},
+		"Cancels the stateful orders with the order flags of the message": {
+			orders: []types.Order{longTermOrder, otherClobPairLongTermOrder, conditionalOrder},
+			msg: types.MsgCancelAllOrders{
+				SubaccountId: constants.Alice_Num0,
+				OrderFlags:   []uint32{types.OrderIdFlags_Conditional},
+				GoodTilBlock: 5,
+			},
+			expectedCanceledOrderIds:  []types.OrderId{conditionalOrder.OrderId},
+			expectedRemovedOrderIds:   []types.OrderId{},
+			expectedRemainingOrderIds: []types.OrderId{longTermOrder.OrderId, otherClobPairLongTermOrder.OrderId},
+		},
+		"Does not cancel stateful orders if the message only cancels Short-Term orders": {
+			orders: []types.Order{longTermOrder, conditionalOrder},
+			msg: types.MsgCancelAllOrders{
+				SubaccountId: constants.Alice_Num0,
+				OrderFlags:   []uint32{types.OrderIdFlags_ShortTerm},
+				GoodTilBlock: 1,
+			},
+			expectedCanceledOrderIds:  []types.OrderId{},
+			expectedRemovedOrderIds:   []types.OrderId{},
+			expectedRemainingOrderIds: []types.OrderId{longTermOrder.OrderId, conditionalOrder.OrderId},
+		},
+		"Orders removed by the cancellation of their order group are not canceled": {
+			orders: []types.Order{ocoTakeProfitOrder, ocoStopLossOrder},
+			msg: types.MsgCancelAllOrders{
+				SubaccountId: constants.Alice_Num0,
+				GoodTilBlock: 5,
+			},
+			expectedCanceledOrderIds:  []types.OrderId{ocoTakeProfitOrder.OrderId},
+			expectedRemovedOrderIds:   []types.OrderId{ocoStopLossOrder.OrderId},
+			expectedRemainingOrderIds: []types.OrderId{},
+		},
+		"Returns an error if the GoodTilBlock has passed": {
+			orders: []types.Order{longTermOrder},
+			msg: types.MsgCancelAllOrders{
+				SubaccountId: constants.Alice_Num0,
+				GoodTilBlock: 1,
+			},
+			expectedRemainingOrderIds: []types.OrderId{longTermOrder.OrderId},
+			expectedErr:               types.ErrHeightExceedsGoodTilBlock,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			indexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+
+			ctx := ks.Ctx.WithBlockHeight(2).WithIsCheckTx(false)
+			ks.ClobKeeper.MustSetProcessProposerMatchesEvents(
+				ctx,
+				types.ProcessProposerMatchesEvents{BlockHeight: 2},
+			)
+
+			for _, order := range tc.orders {
+				ks.ClobKeeper.SetLongTermOrderPlacement(ctx, order, 1)
+				ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(
+					ctx,
+					order.MustGetUnixGoodTilBlockTime(),
+					order.OrderId,
+				)
+			}
+
+			for _, orderId := range tc.expectedCanceledOrderIds {
+				indexerEventManager.On(
+					"AddTxnEvent",
+					ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewStatefulOrderRemovalEvent(
+							orderId,
+							sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+						),
+					),
+				).Return().Once()
+			}
+			for _, orderId := range tc.expectedRemovedOrderIds {
+				indexerEventManager.On(
+					"AddTxnEvent",
+					ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewStatefulOrderRemovalEvent(
+							orderId,
+							sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP,
+						),
+					),
+				).Return().Once()
+			}
+
+			resp, err := msgServer.CancelAllOrders(ctx, &tc.msg)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedCanceledOrderIds, resp.CanceledOrderIds)
+			}
+
+			indexerEventManager.AssertExpectations(t)
+			indexerEventManager.AssertNumberOfCalls(
+				t,
+				"AddTxnEvent",
+				len(tc.expectedCanceledOrderIds)+len(tc.expectedRemovedOrderIds),
+			)
+
+			processProposerMatchesEvents := ks.ClobKeeper.GetProcessProposerMatchesEvents(ctx)
+			require.ElementsMatch(
+				t,
+				tc.expectedCanceledOrderIds,
+				processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
+			)
+			require.ElementsMatch(t, tc.expectedRemovedOrderIds, processProposerMatchesEvents.RemovedStatefulOrderIds)
+			for _, orderId := range append(tc.expectedCanceledOrderIds, tc.expectedRemovedOrderIds...) {
+				_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+				require.False(t, found)
+			}
+			for _, orderId := range tc.expectedRemainingOrderIds {
+				_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+				require.True(t, found)
+			}
+		})
+	}
+}
@@ -9,7 +9,7 @@ import (
 
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
@@ -224,7 +224,7 @@ func TestCancelOrder_Success(t *testing.T) {
 				indexer_manager.GetBytes(
 					indexerevents.NewStatefulOrderRemovalEvent(
 						tc.StatefulOrderPlacement.GetOrderId(),
-						indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+						sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
 					),
 				),
 			).Return().Once()
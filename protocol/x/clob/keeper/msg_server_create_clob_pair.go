@@ -26,16 +26,30 @@ func (k msgServer) CreateClobPair(
 		)
 	}
 
+	if spotClobMetadata := msg.ClobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		if _, err := k.Keeper.CreateSpotClobPair(
+			ctx,
+			msg.ClobPair.Id,
+			spotClobMetadata.BaseAssetId,
+			spotClobMetadata.QuoteAssetId,
+			satypes.BaseQuantums(msg.ClobPair.StepBaseQuantums),
+			msg.ClobPair.QuantumConversionExponent,
+			msg.ClobPair.SubticksPerTick,
+			msg.ClobPair.Status,
+		); err != nil {
+			return nil, err
+		}
+		return &types.MsgCreateClobPairResponse{}, nil
+	}
+
 	perpetualId, err := msg.ClobPair.GetPerpetualId()
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO(DEC-1535): update this when additional clob pair types are supported.
 	if _, err := k.Keeper.CreatePerpetualClobPair(
 		ctx,
 		msg.ClobPair.Id,
-		// `MsgCreateClobPair.ValidateBasic` ensures that `msg.ClobPair.Metadata` is `PerpetualClobMetadata`.
 		perpetualId,
 		satypes.BaseQuantums(msg.ClobPair.StepBaseQuantums),
 		msg.ClobPair.QuantumConversionExponent,
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func (k msgServer) DelistClobPair(
+	goCtx context.Context,
+	msg *types.MsgDelistClobPair,
+) (resp *types.MsgDelistClobPairResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	if err := k.Keeper.DelistClobPair(
+		ctx,
+		types.ClobPairId(msg.ClobPairId),
+		msg.SettlementPrice,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelistClobPairResponse{}, nil
+}
@@ -129,6 +129,11 @@ func TestPlaceOrder_Error(t *testing.T) {
 				perpetual.Params.AtomicResolution,
 				perpetual.Params.DefaultFundingPpm,
 				perpetual.Params.LiquidityTier,
+				perpetual.Params.OpenInterestCapNotional,
+				perpetual.Params.FundingRateBounds,
+				perpetual.Params.InterestRatePpm,
+				perpetual.Params.FundingTickEpoch,
+				perpetual.Params.FundingSampleEpoch,
 			)
 			require.NoError(t, err)
 
@@ -274,6 +279,11 @@ func TestPlaceOrder_Success(t *testing.T) {
 				perpetual.Params.AtomicResolution,
 				perpetual.Params.DefaultFundingPpm,
 				perpetual.Params.LiquidityTier,
+				perpetual.Params.OpenInterestCapNotional,
+				perpetual.Params.FundingRateBounds,
+				perpetual.Params.InterestRatePpm,
+				perpetual.Params.FundingTickEpoch,
+				perpetual.Params.FundingSampleEpoch,
 			)
 			require.NoError(t, err)
 
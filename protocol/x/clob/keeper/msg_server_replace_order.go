@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorlib "github.com/dydxprotocol/v4-chain/protocol/lib/error"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// ReplaceOrder is the entry point for `MsgReplaceOrder` messages executed in `runMsgs` during `DeliverTx`.
+// Only Short-Term orders can be replaced, and Short-Term order replacements are included in blocks as part
+// of the operations queue. Therefore this handler is not expected to be invoked due to the filtering logic in
+// the mempool in our CometBFT fork, and it returns an error if it is.
+func (k msgServer) ReplaceOrder(
+	goCtx context.Context,
+	msg *types.MsgReplaceOrder,
+) (resp *types.MsgReplaceOrderResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.ReplaceOrder,
+			metrics.DeliverTx,
+			msg.Order.GetOrderLabels()...,
+		)
+		if err != nil {
+			errorlib.LogDeliverTxError(k.Keeper.Logger(ctx), err, ctx.BlockHeight(), "ReplaceOrder", msg)
+		}
+	}()
+
+	return nil, errorsmod.Wrapf(
+		types.ErrInvalidReplaceOrder,
+		"ReplaceOrder: Short-Term order replacements must be included in the operations queue: %+v",
+		msg,
+	)
+}
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetOrderGroupOrderIds returns the IDs of the stateful orders in state that belong to the order group
+// with ID `orderGroupId` of a subaccount, sorted by order ID. Returns an empty slice if the order group
+// has no orders in state.
+func (k Keeper) GetOrderGroupOrderIds(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	orderGroupId uint32,
+) []types.OrderId {
+	store := k.getOrderGroupStore(ctx)
+	b := store.Get(types.OrderGroupKey(subaccountId, orderGroupId))
+	if b == nil {
+		return []types.OrderId{}
+	}
+
+	var orderGroupMembers types.OrderGroupMembers
+	k.cdc.MustUnmarshal(b, &orderGroupMembers)
+	return orderGroupMembers.OrderIds
+}
+
+// setOrderGroupOrderIds sets the IDs of the stateful orders that belong to an order group in state,
+// removing the order group from state if there are none. The order IDs are sorted before being written.
+func (k Keeper) setOrderGroupOrderIds(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	orderGroupId uint32,
+	orderIds []types.OrderId,
+) {
+	store := k.getOrderGroupStore(ctx)
+	key := types.OrderGroupKey(subaccountId, orderGroupId)
+	if len(orderIds) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	types.MustSortAndHaveNoDuplicates(orderIds)
+	orderGroupMembers := types.OrderGroupMembers{
+		OrderIds: orderIds,
+	}
+	store.Set(key, k.cdc.MustMarshal(&orderGroupMembers))
+}
+
+// addOrderToOrderGroup adds a stateful order to the order group it belongs to in state.
+// This function is a no-op if the order is not part of an order group.
+func (k Keeper) addOrderToOrderGroup(ctx sdk.Context, order types.Order) {
+	if order.OrderGroup == nil {
+		return
+	}
+
+	subaccountId := order.OrderId.SubaccountId
+	orderIds := k.GetOrderGroupOrderIds(ctx, subaccountId, order.OrderGroup.Id)
+	k.setOrderGroupOrderIds(ctx, subaccountId, order.OrderGroup.Id, append(orderIds, order.OrderId))
+}
+
+// removeOrderFromOrderGroup removes a stateful order from the order group it belongs to in state.
+// This function is a no-op if the order is not part of an order group.
+func (k Keeper) removeOrderFromOrderGroup(ctx sdk.Context, order types.Order) {
+	if order.OrderGroup == nil {
+		return
+	}
+
+	subaccountId := order.OrderId.SubaccountId
+	orderIds := k.GetOrderGroupOrderIds(ctx, subaccountId, order.OrderGroup.Id)
+	updatedOrderIds := make([]types.OrderId, 0, len(orderIds))
+	for _, orderId := range orderIds {
+		if orderId != order.OrderId {
+			updatedOrderIds = append(updatedOrderIds, orderId)
+		}
+	}
+	k.setOrderGroupOrderIds(ctx, subaccountId, order.OrderGroup.Id, updatedOrderIds)
+}
+
+// validateOrderAgainstOrderGroup validates a new stateful order against the orders of its order group
+// that are already in state. It returns an error if:
+//   - The order group is already full.
+//   - The order group type differs from the type of the orders in state.
+//   - The order's `GoodTilBlockTime` differs from the orders in state.
+//   - For bracket groups, the order is on a different `ClobPair`, its leg is already in state, or it
+//     is on the same side as the entry order or on a different side than the other exit order.
+//
+// This function is a no-op if the order is not part of an order group.
+func (k Keeper) validateOrderAgainstOrderGroup(ctx sdk.Context, order types.Order) error {
+	orderGroup := order.OrderGroup
+	if orderGroup == nil {
+		return nil
+	}
+
+	orderIds := k.GetOrderGroupOrderIds(ctx, order.OrderId.SubaccountId, orderGroup.Id)
+	if len(orderIds) >= orderGroup.Type.MaxSize() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderGroup,
+			"order group %d already contains %d orders",
+			orderGroup.Id,
+			len(orderIds),
+		)
+	}
+
+	for _, orderId := range orderIds {
+		orderPlacement, found := k.GetLongTermOrderPlacement(ctx, orderId)
+		if !found {
+			panic(
+				fmt.Sprintf(
+					"validateOrderAgainstOrderGroup: order %+v of order group %d does not exist in state",
+					orderId,
+					orderGroup.Id,
+				),
+			)
+		}
+		groupOrder := orderPlacement.Order
+
+		if groupOrder.OrderGroup.Type != orderGroup.Type {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderGroup,
+				"order group %d has type %v, got %v",
+				orderGroup.Id,
+				groupOrder.OrderGroup.Type,
+				orderGroup.Type,
+			)
+		}
+
+		if groupOrder.GetGoodTilBlockTime() != order.GetGoodTilBlockTime() {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderGroup,
+				"orders of order group %d must have the same GoodTilBlockTime %d, got %d",
+				orderGroup.Id,
+				groupOrder.GetGoodTilBlockTime(),
+				order.GetGoodTilBlockTime(),
+			)
+		}
+
+		if orderGroup.Type != types.OrderGroup_TYPE_BRACKET {
+			continue
+		}
+
+		if groupOrder.GetClobPairId() != order.GetClobPairId() {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderGroup,
+				"orders of bracket order group %d must have the same ClobPairId %d, got %d",
+				orderGroup.Id,
+				groupOrder.GetClobPairId(),
+				order.GetClobPairId(),
+			)
+		}
+
+		if groupOrder.OrderGroup.Leg == orderGroup.Leg {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderGroup,
+				"bracket order group %d already contains leg %v",
+				orderGroup.Id,
+				orderGroup.Leg,
+			)
+		}
+
+		// The take profit and stop loss orders close the position opened by the entry order.
+		isEntryAndExit := groupOrder.OrderGroup.IsBracketEntry() || orderGroup.IsBracketEntry()
+		if isEntryAndExit == (groupOrder.Side == order.Side) {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderGroup,
+				"take profit and stop loss orders of bracket order group %d must be on the opposite side "+
+					"of the entry order",
+				orderGroup.Id,
+			)
+		}
+	}
+
+	return nil
+}
+
+// RemoveOrderGroupSiblings removes the other orders of the order group of `order` from state after
+// `order` was removed or triggered. For one-cancels-other groups all other orders are removed. For
+// bracket groups, the take profit and stop loss orders remove each other, and the entry order removes
+// both unless it was fully filled or triggered, which is indicated by `orderFilledOrTriggered`.
+//
+// An on-chain indexer event is emitted for each removed order, and the removed order IDs are added to
+// the `RemovedStatefulOrderIds` of the `ProcessProposerMatchesEvents` so that they are purged from the
+// memclob. Returns the removed order IDs, sorted by order ID. This function is a no-op if the order is
+// not part of an order group.
+func (k Keeper) RemoveOrderGroupSiblings(
+	ctx sdk.Context,
+	order types.Order,
+	orderFilledOrTriggered bool,
+) (removedOrderIds []types.OrderId) {
+	removedOrderIds = make([]types.OrderId, 0)
+	orderGroup := order.OrderGroup
+	if orderGroup == nil || (orderGroup.IsBracketEntry() && orderFilledOrTriggered) {
+		return removedOrderIds
+	}
+
+	for _, orderId := range k.GetOrderGroupOrderIds(ctx, order.OrderId.SubaccountId, orderGroup.Id) {
+		if orderId == order.OrderId {
+			continue
+		}
+
+		orderPlacement, found := k.GetLongTermOrderPlacement(ctx, orderId)
+		if !found {
+			panic(
+				fmt.Sprintf(
+					"RemoveOrderGroupSiblings: order %+v of order group %d does not exist in state",
+					orderId,
+					orderGroup.Id,
+				),
+			)
+		}
+
+		// The take profit and stop loss orders of a bracket group do not remove the entry order.
+		if orderGroup.Type == types.OrderGroup_TYPE_BRACKET && orderPlacement.Order.OrderGroup.IsBracketEntry() {
+			continue
+		}
+
+		k.mustRemoveStatefulOrder(ctx, orderId)
+		removedOrderIds = append(removedOrderIds, orderId)
+
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					orderId,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP,
+				),
+			),
+		)
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.OrderGroup, metrics.StatefulOrderRemoved, metrics.Count},
+			1,
+			orderId.GetOrderIdLabels(),
+		)
+	}
+
+	if len(removedOrderIds) > 0 {
+		processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+		processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+			processProposerMatchesEvents.RemovedStatefulOrderIds,
+			removedOrderIds...,
+		)
+		k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+	}
+
+	return removedOrderIds
+}
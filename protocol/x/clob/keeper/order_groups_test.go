@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderGroupOrderIds(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	entryOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_BracketEntry
+	takeProfitOrder := constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Sell5_Price20_GTBT15_TakeProfit20_BracketTakeProfit
+	subaccountId := entryOrder.OrderId.SubaccountId
+	orderGroupId := entryOrder.OrderGroup.Id
+
+	require.Empty(t, ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, subaccountId, orderGroupId))
+
+	// Orders are added to their order group when placed, and the group is sorted by order ID.
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, takeProfitOrder, 0)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, entryOrder, 0)
+	require.Equal(
+		t,
+		[]types.OrderId{entryOrder.OrderId, takeProfitOrder.OrderId},
+		ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, subaccountId, orderGroupId),
+	)
+
+	// Triggering a conditional order does not change its order group.
+	ks.ClobKeeper.MustTriggerConditionalOrder(ks.Ctx, takeProfitOrder.OrderId)
+	require.Equal(
+		t,
+		[]types.OrderId{entryOrder.OrderId, takeProfitOrder.OrderId},
+		ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, subaccountId, orderGroupId),
+	)
+
+	// Orders are removed from their order group when deleted.
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, entryOrder.OrderId)
+	require.Equal(
+		t,
+		[]types.OrderId{takeProfitOrder.OrderId},
+		ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, subaccountId, orderGroupId),
+	)
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, takeProfitOrder.OrderId)
+	require.Empty(t, ks.ClobKeeper.GetOrderGroupOrderIds(ks.Ctx, subaccountId, orderGroupId))
+}
+
+func TestMustRemoveStatefulOrder_OrderGroups(t *testing.T) {
+	entryOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_BracketEntry
+	takeProfitOrder := constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Sell5_Price20_GTBT15_TakeProfit20_BracketTakeProfit
+	stopLossOrder := constants.ConditionalOrder_Alice_Num0_Id2_Clob0_Sell5_Price5_GTBT15_StopLoss5_BracketStopLoss
+	ocoTakeProfitOrder := constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Sell5_Price20_GTBT15_TakeProfit20_OCO
+	ocoStopLossOrder := constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price5_GTBT15_StopLoss5_OCO
+
+	tests := map[string]struct {
+		// State.
+		orders            []types.Order
+		filledOrderAmount satypes.BaseQuantums
+
+		// Parameters.
+		orderIdToRemove types.OrderId
+
+		// Expectations.
+		expectedRemovedOrderIds   []types.OrderId
+		expectedRemainingOrderIds []types.OrderId
+	}{
+		"Removing an order without an order group does not remove other orders": {
+			orders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+				ocoTakeProfitOrder,
+				ocoStopLossOrder,
+			},
+			orderIdToRemove:         constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId,
+			expectedRemovedOrderIds: []types.OrderId{},
+			expectedRemainingOrderIds: []types.OrderId{
+				ocoTakeProfitOrder.OrderId,
+				ocoStopLossOrder.OrderId,
+			},
+		},
+		"Removing a one-cancels-other order removes the other order": {
+			orders:                    []types.Order{ocoTakeProfitOrder, ocoStopLossOrder},
+			orderIdToRemove:           ocoStopLossOrder.OrderId,
+			expectedRemovedOrderIds:   []types.OrderId{ocoTakeProfitOrder.OrderId},
+			expectedRemainingOrderIds: []types.OrderId{},
+		},
+		"Removing the only order of a one-cancels-other group removes nothing else": {
+			orders:                    []types.Order{ocoTakeProfitOrder},
+			orderIdToRemove:           ocoTakeProfitOrder.OrderId,
+			expectedRemovedOrderIds:   []types.OrderId{},
+			expectedRemainingOrderIds: []types.OrderId{},
+		},
+		"Removing a bracket take profit order removes the stop loss order but not the entry order": {
+			orders:                    []types.Order{entryOrder, takeProfitOrder, stopLossOrder},
+			orderIdToRemove:           takeProfitOrder.OrderId,
+			expectedRemovedOrderIds:   []types.OrderId{stopLossOrder.OrderId},
+			expectedRemainingOrderIds: []types.OrderId{entryOrder.OrderId},
+		},
+		"Removing a bracket entry order removes the take profit and stop loss orders": {
+			orders:                    []types.Order{entryOrder, takeProfitOrder, stopLossOrder},
+			orderIdToRemove:           entryOrder.OrderId,
+			expectedRemovedOrderIds:   []types.OrderId{takeProfitOrder.OrderId, stopLossOrder.OrderId},
+			expectedRemainingOrderIds: []types.OrderId{},
+		},
+		"Removing a partially filled bracket entry order removes the take profit and stop loss orders": {
+			orders:                    []types.Order{entryOrder, takeProfitOrder, stopLossOrder},
+			filledOrderAmount:         entryOrder.GetBaseQuantums() - 1,
+			orderIdToRemove:           entryOrder.OrderId,
+			expectedRemovedOrderIds:   []types.OrderId{takeProfitOrder.OrderId, stopLossOrder.OrderId},
+			expectedRemainingOrderIds: []types.OrderId{},
+		},
+		"Removing a fully filled bracket entry order does not remove the take profit and stop loss orders": {
+			orders:                  []types.Order{entryOrder, takeProfitOrder, stopLossOrder},
+			filledOrderAmount:       entryOrder.GetBaseQuantums(),
+			orderIdToRemove:         entryOrder.OrderId,
+			expectedRemovedOrderIds: []types.OrderId{},
+			expectedRemainingOrderIds: []types.OrderId{
+				takeProfitOrder.OrderId,
+				stopLossOrder.OrderId,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			indexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+			ctx := ks.Ctx.WithBlockHeight(2).WithIsCheckTx(false)
+			ks.ClobKeeper.MustSetProcessProposerMatchesEvents(
+				ctx,
+				types.ProcessProposerMatchesEvents{BlockHeight: 2},
+			)
+
+			for _, order := range tc.orders {
+				ks.ClobKeeper.SetLongTermOrderPlacement(ctx, order, 1)
+				ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(
+					ctx,
+					order.MustGetUnixGoodTilBlockTime(),
+					order.OrderId,
+				)
+			}
+			if tc.filledOrderAmount > 0 {
+				ks.ClobKeeper.SetOrderFillAmount(ctx, tc.orderIdToRemove, tc.filledOrderAmount, 20)
+			}
+
+			for _, orderId := range tc.expectedRemovedOrderIds {
+				indexerEventManager.On(
+					"AddTxnEvent",
+					ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewStatefulOrderRemovalEvent(
+							orderId,
+							sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP,
+						),
+					),
+				).Once().Return()
+			}
+
+			ks.ClobKeeper.MustRemoveStatefulOrder(ctx, tc.orderIdToRemove)
+
+			indexerEventManager.AssertExpectations(t)
+			indexerEventManager.AssertNumberOfCalls(t, "AddTxnEvent", len(tc.expectedRemovedOrderIds))
+
+			// The removed orders are purged from the memclob in `PrepareCheckState`.
+			require.ElementsMatch(
+				t,
+				tc.expectedRemovedOrderIds,
+				ks.ClobKeeper.GetProcessProposerMatchesEvents(ctx).RemovedStatefulOrderIds,
+			)
+
+			_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, tc.orderIdToRemove)
+			require.False(t, found)
+			for _, orderId := range tc.expectedRemovedOrderIds {
+				_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+				require.False(t, found)
+			}
+			for _, orderId := range tc.expectedRemainingOrderIds {
+				_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+				require.True(t, found)
+			}
+			require.Equal(
+				t,
+				uint32(len(tc.orders)-1-len(tc.expectedRemovedOrderIds)),
+				ks.ClobKeeper.GetStatefulOrderCount(ctx, constants.Alice_Num0),
+			)
+		})
+	}
+}
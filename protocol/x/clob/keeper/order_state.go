@@ -58,12 +58,22 @@ func (k Keeper) SetOrderFillAmount(
 	fillAmount satypes.BaseQuantums,
 	prunableBlockHeight uint32,
 ) {
-	// Define `OrderFillState` based on the provided arguments.
-	var orderFillState = types.OrderFillState{
-		FillAmount:          uint64(fillAmount),
-		PrunableBlockHeight: prunableBlockHeight,
-	}
+	k.SetOrderFillState(
+		ctx,
+		orderId,
+		types.OrderFillState{
+			FillAmount:          uint64(fillAmount),
+			PrunableBlockHeight: prunableBlockHeight,
+		},
+	)
+}
 
+// SetOrderFillState writes the `OrderFillState` of an order to on-chain state and the memStore.
+func (k Keeper) SetOrderFillState(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	orderFillState types.OrderFillState,
+) {
 	// Marshal `orderFillState` to bytes.
 	orderFillStateBytes := k.cdc.MustMarshal(&orderFillState)
 
@@ -100,6 +110,18 @@ func (k Keeper) GetOrderFillAmount(
 	exists bool,
 	fillAmount satypes.BaseQuantums,
 	prunableBlockHeight uint32,
+) {
+	orderFillState, exists := k.GetOrderFillState(ctx, orderId)
+	return exists, satypes.BaseQuantums(orderFillState.FillAmount), orderFillState.PrunableBlockHeight
+}
+
+// GetOrderFillState returns the `OrderFillState` of an order from the memStore.
+func (k Keeper) GetOrderFillState(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) (
+	orderFillState types.OrderFillState,
+	exists bool,
 ) {
 	memStore := ctx.KVStore(k.memKey)
 
@@ -116,29 +138,57 @@ func (k Keeper) GetOrderFillAmount(
 
 	// If the `OrderFillState` does not exist, early return.
 	if orderFillStateBytes == nil {
-		return false, 0, 0
+		return orderFillState, false
 	}
 
-	// Unmarshal the `orderFillStateBytes` into a struct, and return relevant values.
-	var orderFillState types.OrderFillState
+	// Unmarshal the `orderFillStateBytes` into a struct.
 	k.cdc.MustUnmarshal(orderFillStateBytes, &orderFillState)
 
-	return true, satypes.BaseQuantums(orderFillState.FillAmount), orderFillState.PrunableBlockHeight
+	return orderFillState, true
 }
 
 // AddOrdersForPruning creates or updates a slice of `orderIds` to state for potential future pruning from state.
 // These orders will be checked for pruning from state at `prunableBlockHeight`. If the `orderIds` slice provided
 // contains duplicates, the duplicates will be ignored.
 func (k Keeper) AddOrdersForPruning(ctx sdk.Context, orderIds []types.OrderId, prunableBlockHeight uint32) {
+	k.addOrdersForPruning(
+		ctx,
+		types.BlockHeightToPotentiallyPrunableOrdersPrefix,
+		orderIds,
+		prunableBlockHeight,
+	)
+}
+
+// AddOrdersForPruningByBlockTime creates or updates a slice of `orderIds` to state for potential future pruning
+// from state. These orders will be checked for pruning from state at the first block with a block time greater
+// than or equal to `prunableBlockTime`. If the `orderIds` slice provided contains duplicates, the duplicates
+// will be ignored.
+func (k Keeper) AddOrdersForPruningByBlockTime(ctx sdk.Context, orderIds []types.OrderId, prunableBlockTime uint32) {
+	k.addOrdersForPruning(
+		ctx,
+		types.BlockTimeToPotentiallyPrunableOrdersPrefix,
+		orderIds,
+		prunableBlockTime,
+	)
+}
+
+// addOrdersForPruning adds `orderIds` to the list of potentially prunable orders stored under `keyPrefix`
+// at `prunableAt`, which is either a block height or a block time.
+func (k Keeper) addOrdersForPruning(
+	ctx sdk.Context,
+	keyPrefix string,
+	orderIds []types.OrderId,
+	prunableAt uint32,
+) {
 	// Retrieve an instance of the store.
 	store := prefix.NewStore(
 		ctx.KVStore(k.storeKey),
-		[]byte(types.BlockHeightToPotentiallyPrunableOrdersPrefix),
+		[]byte(keyPrefix),
 	)
 
 	// Retrieve the `PotentiallyPrunableOrders` bytes from the store.
 	potentiallyPrunableOrdersBytes := store.Get(
-		lib.Uint32ToKey(prunableBlockHeight),
+		lib.Uint32ToKey(prunableAt),
 	)
 
 	var potentiallyPrunableOrdersSet = make(map[types.OrderId]bool)
@@ -149,7 +199,7 @@ func (k Keeper) AddOrdersForPruning(ctx sdk.Context, orderIds []types.OrderId, p
 	// Copy to avoid mutating the provided `orderIds`.
 	copy(potentiallyPrunableOrderIds, orderIds)
 
-	// If the state already contains `potentiallyPrunableOrders` for this `prunableBlockHeight`, add them to the list of
+	// If the state already contains `potentiallyPrunableOrders` at `prunableAt`, add them to the list of
 	// `potentiallyPrunableOrderIds`.
 	if potentiallyPrunableOrdersBytes != nil {
 		k.cdc.MustUnmarshal(potentiallyPrunableOrdersBytes, &potentiallyPrunableOrders)
@@ -176,9 +226,9 @@ func (k Keeper) AddOrdersForPruning(ctx sdk.Context, orderIds []types.OrderId, p
 	// Marshal `prunableOrders` back to bytes.
 	potentiallyPrunableOrdersBytes = k.cdc.MustMarshal(&potentiallyPrunableOrders)
 
-	// Write `prunableOrders` to state for the appropriate block height.
+	// Write `prunableOrders` to state for the appropriate block height or block time.
 	store.Set(
-		lib.Uint32ToKey(prunableBlockHeight),
+		lib.Uint32ToKey(prunableAt),
 		potentiallyPrunableOrdersBytes,
 	)
 }
@@ -232,6 +282,54 @@ func (k Keeper) PruneOrdersForBlockHeight(ctx sdk.Context, blockHeight uint32) (
 	return prunedOrderIds
 }
 
+// PruneOrdersForBlockTime checks all orders added for pruning by block time at or before `blockTime` for
+// prunability. An order is only deemed prunable if the `prunableBlockTime` on the `OrderFillState` is non-zero
+// and less than or equal to `blockTime`. Returns a slice of unique `OrderIds` which were pruned from state.
+func (k Keeper) PruneOrdersForBlockTime(ctx sdk.Context, blockTime uint32) (prunedOrderIds []types.OrderId) {
+	blockTimeToPotentiallyPrunableOrdersStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.BlockTimeToPotentiallyPrunableOrdersPrefix),
+	)
+
+	// Collect all keys with a block time less than or equal to `blockTime`.
+	var keys [][]byte
+	prunedOrderIdsSet := make(map[types.OrderId]bool)
+	iterator := blockTimeToPotentiallyPrunableOrdersStore.Iterator(
+		nil,
+		sdk.PrefixEndBytes(lib.Uint32ToKey(blockTime)),
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+
+		var potentiallyPrunableOrders types.PotentiallyPrunableOrders
+		k.cdc.MustUnmarshal(iterator.Value(), &potentiallyPrunableOrders)
+
+		for _, orderId := range potentiallyPrunableOrders.OrderIds {
+			// Check if the order can be pruned, and prune if so.
+			orderFillState, exists := k.GetOrderFillState(ctx, orderId)
+			if exists &&
+				orderFillState.PrunableBlockTime != 0 &&
+				orderFillState.PrunableBlockTime <= blockTime &&
+				!prunedOrderIdsSet[orderId] {
+				prunedOrderIdsSet[orderId] = true
+				prunedOrderIds = append(prunedOrderIds, orderId)
+			}
+		}
+	}
+	iterator.Close()
+
+	for _, orderId := range prunedOrderIds {
+		k.RemoveOrderFillAmount(ctx, orderId)
+	}
+
+	// Delete the keys for prunable orders at or before this block time.
+	for _, key := range keys {
+		blockTimeToPotentiallyPrunableOrdersStore.Delete(key)
+	}
+
+	return prunedOrderIds
+}
+
 // RemoveOrderFillAmount removes the fill amount of an Order from state and the memstore.
 // This function is a no-op if no order fill amount exists in state and the mem store with `orderId`.
 func (k Keeper) RemoveOrderFillAmount(ctx sdk.Context, orderId types.OrderId) {
@@ -252,7 +350,7 @@ func (k Keeper) RemoveOrderFillAmount(ctx sdk.Context, orderId types.OrderId) {
 }
 
 // PruneStateFillAmountsForShortTermOrders prunes Short-Term order fill amounts from state that are pruneable
-// at the block height of the most recently committed block.
+// at the block height or block time of the most recently committed block.
 func (k Keeper) PruneStateFillAmountsForShortTermOrders(
 	ctx sdk.Context,
 ) {
@@ -260,4 +358,7 @@ func (k Keeper) PruneStateFillAmountsForShortTermOrders(
 
 	// Prune all fill amounts from state which have a pruneable block height of the current `blockHeight`.
 	k.PruneOrdersForBlockHeight(ctx, blockHeight)
+
+	// Prune all fill amounts from state which have a pruneable block time at or before the current block time.
+	k.PruneOrdersForBlockTime(ctx, lib.MustConvertIntegerToUint32(ctx.BlockTime().Unix()))
 }
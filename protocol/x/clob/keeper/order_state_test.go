@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math"
 	"sort"
 	"testing"
 
@@ -587,6 +588,69 @@ func TestPruning(t *testing.T) {
 	}
 }
 
+func TestPruneOrdersForBlockTime(t *testing.T) {
+	memClob := &mocks.MemClob{}
+	memClob.On("SetClobKeeper", mock.Anything).Return()
+	ks := keepertest.NewClobKeepersTestContext(
+		t,
+		memClob,
+		&mocks.BankKeeper{},
+		&mocks.IndexerEventManager{},
+	)
+
+	prunedOrderId := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId
+	extendedOrderId := constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15.OrderId
+	futureOrderId := constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15.OrderId
+
+	// The first order is prunable at block time 45.
+	ks.ClobKeeper.SetOrderFillState(
+		ks.Ctx,
+		prunedOrderId,
+		types.OrderFillState{FillAmount: 100, PrunableBlockHeight: math.MaxUint32, PrunableBlockTime: 45},
+	)
+	ks.ClobKeeper.AddOrdersForPruningByBlockTime(ks.Ctx, []types.OrderId{prunedOrderId}, 45)
+
+	// The second order was added for pruning at block time 45, but was later replaced and is now
+	// prunable at block time 60.
+	ks.ClobKeeper.SetOrderFillState(
+		ks.Ctx,
+		extendedOrderId,
+		types.OrderFillState{FillAmount: 100, PrunableBlockHeight: math.MaxUint32, PrunableBlockTime: 60},
+	)
+	ks.ClobKeeper.AddOrdersForPruningByBlockTime(ks.Ctx, []types.OrderId{extendedOrderId}, 45)
+	ks.ClobKeeper.AddOrdersForPruningByBlockTime(ks.Ctx, []types.OrderId{extendedOrderId}, 60)
+
+	// The third order is prunable at block time 60.
+	ks.ClobKeeper.SetOrderFillState(
+		ks.Ctx,
+		futureOrderId,
+		types.OrderFillState{FillAmount: 100, PrunableBlockHeight: math.MaxUint32, PrunableBlockTime: 60},
+	)
+	ks.ClobKeeper.AddOrdersForPruningByBlockTime(ks.Ctx, []types.OrderId{futureOrderId}, 60)
+
+	// Pruning at a block time after 45 prunes only the first order.
+	prunedOrderIds := ks.ClobKeeper.PruneOrdersForBlockTime(ks.Ctx, 50)
+	require.Equal(t, []types.OrderId{prunedOrderId}, prunedOrderIds)
+
+	_, exists := ks.ClobKeeper.GetOrderFillState(ks.Ctx, prunedOrderId)
+	require.False(t, exists)
+	fillState, exists := ks.ClobKeeper.GetOrderFillState(ks.Ctx, extendedOrderId)
+	require.True(t, exists)
+	require.Equal(t, uint32(60), fillState.PrunableBlockTime)
+
+	blockTimeToPotentiallyPrunableOrdersStore := prefix.NewStore(
+		ks.Ctx.KVStore(ks.StoreKey),
+		[]byte(types.BlockTimeToPotentiallyPrunableOrdersPrefix),
+	)
+	require.False(t, blockTimeToPotentiallyPrunableOrdersStore.Has(lib.Uint32ToKey(45)))
+	require.True(t, blockTimeToPotentiallyPrunableOrdersStore.Has(lib.Uint32ToKey(60)))
+
+	// Pruning at block time 60 prunes the remaining orders.
+	prunedOrderIds = ks.ClobKeeper.PruneOrdersForBlockTime(ks.Ctx, 60)
+	require.ElementsMatch(t, []types.OrderId{extendedOrderId, futureOrderId}, prunedOrderIds)
+	require.False(t, blockTimeToPotentiallyPrunableOrdersStore.Has(lib.Uint32ToKey(60)))
+}
+
 func TestRemoveOrderFillAmount(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
@@ -14,7 +14,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -83,6 +84,7 @@ func (k Keeper) CancelShortTermOrder(
 	}
 
 	k.sendOffchainMessagesWithTxHash(
+		ctx,
 		offchainUpdates,
 		tmhash.Sum(ctx.TxBytes()),
 		metrics.SendCancelOrderOffchainUpdates,
@@ -155,6 +157,7 @@ func (k Keeper) PlaceShortTermOrder(
 	// Off-chain update messages should be only be returned if the `IndexerMessageSender`
 	// is enabled (`msgSender.Enabled()` returns true).
 	k.sendOffchainMessagesWithTxHash(
+		ctx,
 		offchainUpdates,
 		tmhash.Sum(ctx.TxBytes()),
 		metrics.SendPlaceOrderOffchainUpdates,
@@ -171,6 +174,81 @@ func (k Keeper) PlaceShortTermOrder(
 	return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, err
 }
 
+// ReplaceShortTermOrder atomically replaces an existing Short-Term order on the corresponding orderbook with
+// the order of `msg`, and performs matching if placing the replacement order causes an overlap. This function
+// will return the result of calling `ReplaceOrder` on the keeper's memclob. This method is meant to be used in
+// the CheckTx flow. It uses the next block height.
+//
+// An error will be returned if any of the following conditions are true:
+//   - Standard stateful validation fails.
+//   - Replacing the short term order on the memclob returns an error.
+//
+// Note that the equity tier limit is not validated since the replacement order replaces an open order.
+//
+// This method will panic if the provided order is not a Short-Term order.
+func (k Keeper) ReplaceShortTermOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+
+	order := msg.GetOrder()
+	order.OrderId.MustBeShortTermOrder()
+	orderLabels := order.GetOrderLabels()
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.ReplaceOrder, metrics.Latency)
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.ReplaceOrder, metrics.Count},
+			1,
+			orderLabels,
+		)
+		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, metrics.ReplaceOrder, metrics.Rejected},
+				1,
+				orderLabels,
+			)
+		}
+	}()
+
+	// Perform stateful validation.
+	err = k.PerformStatefulOrderValidation(ctx, &order, nextBlockHeight, true)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Replace the order on the memclob and return the result.
+	orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err := k.MemClob.ReplaceOrder(
+		ctx,
+		msg,
+	)
+
+	// Send off-chain updates generated from replacing the order. `SendOffchainData` enqueues the
+	// the messages to be sent in a channel and should be non-blocking.
+	k.sendOffchainMessagesWithTxHash(
+		ctx,
+		offchainUpdates,
+		tmhash.Sum(ctx.TxBytes()),
+		metrics.SendReplaceOrderOffchainUpdates,
+	)
+
+	if orderSizeOptimisticallyFilledFromMatchingQuantums > 0 {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.ReplaceOrder, metrics.Matched},
+			1,
+			orderLabels,
+		)
+	}
+
+	return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, err
+}
+
 // CancelStatefulOrder performs stateful order cancellation validation and removes the stateful order
 // from state and the memstore.
 //
@@ -383,6 +461,40 @@ func (k Keeper) ReplayPlaceOrder(
 	return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err
 }
 
+// ReplayReplaceOrder returns the result of calling `ReplaceOrder` on the memclob.
+// This method does not forward events directly to indexer, but instead returns
+// them in the form of `OffchainUpdates`. This method is meant to be used in the
+// `ReplayOperations` flow, where we replay Short-Term order replacements back onto
+// the memclob.
+//
+// An error will be returned if any of the following conditions are true:
+// - Standard stateful validation fails.
+// - The memclob itself returns an error.
+func (k Keeper) ReplayReplaceOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	order := msg.GetOrder()
+
+	// Use the height of the next block. Check if this order would be valid if it were included
+	// in the next block height, not in the block that was already committed.
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+
+	// Perform stateful validation.
+	err = k.PerformStatefulOrderValidation(ctx, &order, nextBlockHeight, true)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	// Replace the order on the memclob and return the result.
+	return k.MemClob.ReplaceOrder(ctx, msg)
+}
+
 // AddPreexistingStatefulOrder performs stateful validation on an order and adds it to the specified memclob.
 // This function does not add the order into state, since it is assumed to be preexisting. Function panics
 // if the specified order is not stateful.
@@ -485,8 +597,8 @@ func (k Keeper) PlaceStatefulOrdersFromLastBlock(
 					order.OrderId,
 					orderStatus,
 					err,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
 				); success {
 					existingOffchainUpdates.AddRemoveMessage(order.OrderId, message)
 				}
@@ -685,6 +797,39 @@ func (k Keeper) validateGoodTilBlock(goodTilBlock uint32, blockHeight uint32) er
 	return nil
 }
 
+// validateShortTermOrderGoodTilBlockTime validates that the good til block time (GTBT) of a Short-Term
+// order is within valid bounds, specifically
+// previousBlockTime < GTBT <= previousBlockTime + ShortTermOrderTimeWindow.
+func (k Keeper) validateShortTermOrderGoodTilBlockTime(ctx sdk.Context, goodTilBlockTime uint32) error {
+	previousBlockTime := k.blockTimeKeeper.GetPreviousBlockInfo(ctx).Timestamp
+	previousBlockTimeUnix := lib.MustConvertIntegerToUint32(previousBlockTime.Unix())
+
+	// Return an error if `goodTilBlockTime` is less than or equal to the block time of the previous block.
+	if goodTilBlockTime <= previousBlockTimeUnix {
+		return errorsmod.Wrapf(
+			types.ErrTimeExceedsGoodTilBlockTime,
+			"GoodTilBlockTime %v is less than the previous blockTime %v",
+			goodTilBlockTime,
+			previousBlockTimeUnix,
+		)
+	}
+
+	// Return an error if `goodTilBlockTime` is further into the future than the previous block time
+	// plus `ShortTermOrderTimeWindow`.
+	endTimeUnix := lib.MustConvertIntegerToUint32(
+		previousBlockTime.Add(types.ShortTermOrderTimeWindow).Unix(),
+	)
+	if goodTilBlockTime > endTimeUnix {
+		return errorsmod.Wrapf(
+			types.ErrGoodTilBlockTimeExceedsShortTermOrderTimeWindow,
+			"GoodTilBlockTime %v exceeds the previous blockTime plus ShortTermOrderTimeWindow %v",
+			goodTilBlockTime,
+			endTimeUnix,
+		)
+	}
+	return nil
+}
+
 // PerformStatefulOrderValidation performs stateful validation on an order. This validation performs
 // state reads.
 //
@@ -698,6 +843,9 @@ func (k Keeper) validateGoodTilBlock(goodTilBlock uint32, blockHeight uint32) er
 // For short term orders it also ensures:
 //   - The `GoodTilBlock` of the order is greater than the provided `blockHeight`.
 //   - The `GoodTilBlock` of the order does not exceed the provided `blockHeight + ShortBlockWindow`.
+//   - Or if the order uses `GoodTilBlockTime`, that GTBT is greater than the block time of the previous
+//     block and less than or equal to `ShortTermOrderTimeWindow` away from it.
+//   - That the order has the same expiration type as any fill amount of the order in state.
 //
 // For stateful orders it also ensures:
 //   - GTBT is greater than the block time of previous block.
@@ -761,8 +909,26 @@ func (k Keeper) PerformStatefulOrderValidation(
 	}
 
 	if order.OrderId.IsShortTermOrder() {
-		if err := k.validateGoodTilBlock(order.GetGoodTilBlock(), blockHeight); err != nil {
-			return err
+		if order.IsGoodTilBlockTimeShortTermOrder() {
+			if err := k.validateShortTermOrderGoodTilBlockTime(ctx, order.GetGoodTilBlockTime()); err != nil {
+				return err
+			}
+		} else {
+			if err := k.validateGoodTilBlock(order.GetGoodTilBlock(), blockHeight); err != nil {
+				return err
+			}
+		}
+
+		// The fill amount of an order is pruned by block height or by block time depending on its
+		// expiration type, so an order cannot be placed with an expiration type that differs from
+		// the expiration type of its fill amount in state.
+		if fillState, exists := k.GetOrderFillState(ctx, order.OrderId); exists &&
+			(fillState.PrunableBlockTime != 0) != order.IsGoodTilBlockTimeShortTermOrder() {
+			return errorsmod.Wrapf(
+				types.ErrInvalidReplacement,
+				"Order %s has a different expiration type than its fill amount in state",
+				order.GetOrderTextString(),
+			)
 		}
 	} else {
 		goodTilBlockTimeUnix := order.GetGoodTilBlockTime()
@@ -866,6 +1032,22 @@ func (k Keeper) PerformStatefulOrderValidation(
 					clobPair.StepBaseQuantums,
 				)
 			}
+
+			if order.ConditionalOrderTrailingSubticks%uint64(clobPair.SubticksPerTick) != 0 {
+				return errorsmod.Wrapf(
+					types.ErrInvalidConditionalOrderTrailingDistance,
+					"Conditional order trailing subticks %v must be a multiple of the ClobPair's SubticksPerTick %v",
+					order.ConditionalOrderTrailingSubticks,
+					clobPair.SubticksPerTick,
+				)
+			}
+		}
+
+		// Pre-existing stateful orders are already part of their order group.
+		if !isPreexistingStatefulOrder {
+			if err := k.validateOrderAgainstOrderGroup(ctx, *order); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -959,9 +1141,14 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 
 	pendingUpdates := types.NewPendingUpdates()
 
-	// Retrieve the associated `PerpetualId` for the `ClobPair`.
 	oraclePriceSubticksRat := k.GetOraclePriceSubticksRat(ctx, clobPair)
-	perpetualId := clobPair.MustGetPerpetualId()
+
+	// Spot `ClobPair`s are settled against the base asset position rather than a perpetual position.
+	spotClobMetadata := clobPair.GetSpotClobMetadata()
+	var perpetualId uint32
+	if spotClobMetadata == nil {
+		perpetualId = clobPair.MustGetPerpetualId()
+	}
 
 	// TODO(DEC-1713): Complete as many calculations from getPessimisticCollateralCheckPrice as possible here
 	// so we aren't recalculating the same thing within the loop.
@@ -995,8 +1182,8 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 				k.Logger(ctx).Error(
 					fmt.Sprintf(
 						"Integer overflow: oracle price (subticks) exceeded uint64 max. "+
-							"perpetual ID = (%d), oracle price = (%+v), is buy = (%t)",
-						perpetualId,
+							"clob pair ID = (%d), oracle price = (%+v), is buy = (%t)",
+						clobPairId,
 						oraclePriceSubticksRat,
 						openOrder.IsBuy,
 					),
@@ -1005,8 +1192,8 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 				panic(
 					errorsmod.Wrapf(
 						err,
-						"perpetual id = (%d), oracle price = (%+v), is buy = (%t)",
-						perpetualId,
+						"clob pair id = (%d), oracle price = (%+v), is buy = (%t)",
+						clobPairId,
 						oraclePriceSubticksRat,
 						openOrder.IsBuy,
 					),
@@ -1025,6 +1212,18 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 			}
 
 			bigFillAmount := openOrder.RemainingQuantums.ToBigInt()
+			if spotClobMetadata != nil {
+				pendingUpdates.AddAssetFill(
+					subaccountId,
+					spotClobMetadata.BaseAssetId,
+					openOrder.IsBuy,
+					makerFeePpm,
+					bigFillAmount,
+					bigFillQuoteQuantums,
+				)
+				continue
+			}
+
 			addPerpetualFillAmountStart := time.Now()
 			pendingUpdates.AddPerpetualFill(
 				subaccountId,
@@ -1075,6 +1274,10 @@ func (k Keeper) AddOrderToOrderbookCollatCheck(
 
 // GetOraclePriceSubticksRat returns the oracle price in subticks for the given `ClobPair`.
 func (k Keeper) GetOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPair) *big.Rat {
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		return k.getSpotOraclePriceSubticksRat(ctx, clobPair, spotClobMetadata.BaseAssetId)
+	}
+
 	// Retrieve the associated `PerpetualId` for the `ClobPair`.
 	perpetualId := clobPair.MustGetPerpetualId()
 
@@ -1106,6 +1309,41 @@ func (k Keeper) GetOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPa
 	return oraclePriceSubticksRat
 }
 
+// getSpotOraclePriceSubticksRat returns the oracle price in subticks for the given spot `ClobPair`,
+// using the market price of its base asset.
+func (k Keeper) getSpotOraclePriceSubticksRat(
+	ctx sdk.Context,
+	clobPair types.ClobPair,
+	baseAssetId uint32,
+) *big.Rat {
+	// Use the base `AssetId` to retrieve the `Asset` and `Market` so we can determine the oracle price.
+	asset, marketPrice, err := k.assetsKeeper.GetAssetAndMarketPrice(ctx, baseAssetId)
+	// If an error is returned, this implies stateful validation of the `ClobPair` was not performed
+	// properly, therefore panic.
+	if err != nil {
+		panic(errorsmod.Wrapf(err, "asset ID = (%d)", baseAssetId))
+	}
+
+	oraclePriceSubticksRat := types.PriceToSubticks(
+		marketPrice,
+		clobPair,
+		asset.AtomicResolution,
+		lib.QuoteCurrencyAtomicResolution,
+	)
+	if oraclePriceSubticksRat.Cmp(big.NewRat(0, 1)) == 0 {
+		panic(
+			errorsmod.Wrapf(
+				types.ErrZeroPriceForOracle,
+				"clob pair ID = (%d), asset ID = (%d), market ID = (%d)",
+				clobPair.Id,
+				baseAssetId,
+				marketPrice.Id,
+			),
+		)
+	}
+	return oraclePriceSubticksRat
+}
+
 // GetStatePosition returns the current size of a subaccount's position for the specified `clobPairId`.
 func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.SubaccountId, clobPairId types.ClobPairId,
 ) (
@@ -1117,13 +1355,21 @@ func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.Subaccoun
 		panic(fmt.Sprintf("GetStatePosition: CLOB pair %d not found", clobPairId))
 	}
 
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+
+	// The position of a spot CLOB pair is the subaccount's balance of the base asset.
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata != nil {
+		position, _ := subaccount.GetAssetPositionForId(spotClobMetadata.BaseAssetId)
+		return position.GetBigQuantums()
+	}
+
 	// Get the perpetual ID for this CLOB pair, and panic if it is not a perpetual CLOB.
 	perpetualId, err := clobPair.GetPerpetualId()
 	if err != nil {
 		panic(
 			errorsmod.Wrap(
 				err,
-				"GetStatePosition: Reduce-only orders for assets not implemented",
+				"GetStatePosition: CLOB pair is neither a perpetual nor a spot CLOB",
 			),
 		)
 	}
@@ -1131,7 +1377,6 @@ func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.Subaccoun
 	// Get the position size corresponding to `perpetualId` held by this subaccount, negative
 	// if short and positive if long. If the subaccount does not have an open position
 	// corresponding to `perpetualId`, a position size of zero is returned.
-	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
 	position, _ := subaccount.GetPerpetualPositionForId(perpetualId)
 	return position.GetBigQuantums()
 }
@@ -1190,7 +1435,7 @@ func (k Keeper) InitStatefulOrders(
 		// the messages to be sent in a channel and should be non-blocking.
 		// Off-chain update messages should be only be returned if the `IndexerMessageSender`
 		// is enabled (`msgSender.Enabled()` returns true).
-		k.SendOffchainMessages(offchainUpdates, nil, metrics.SendPlaceOrderOffchainUpdates)
+		k.SendOffchainMessages(ctx, offchainUpdates, nil, metrics.SendPlaceOrderOffchainUpdates)
 
 		if orderSizeOptimisticallyFilledFromMatchingQuantums > 0 {
 			telemetry.IncrCounter(1, types.ModuleName, metrics.PlaceOrder, metrics.Hydrate, metrics.Matched)
@@ -1223,8 +1468,9 @@ func (k Keeper) HydrateUntriggeredConditionalOrders(
 				return o.OrderId
 			},
 		),
-		// Note both of these arguments are empty slices since the untriggered conditional orders
-		// shouldn't be expired or canceled.
+		// Note these arguments are empty sets since the untriggered conditional orders
+		// shouldn't be expired, canceled or removed.
+		map[types.OrderId]struct{}{},
 		map[types.OrderId]struct{}{},
 		map[types.OrderId]struct{}{},
 	)
@@ -1233,11 +1479,13 @@ func (k Keeper) HydrateUntriggeredConditionalOrders(
 // sendOffchainMessagesWithTxHash sends all the `Message` in the offchainUpdates passed in along with
 // an additional header for the transaction hash passed in.
 func (k Keeper) sendOffchainMessagesWithTxHash(
+	ctx sdk.Context,
 	offchainUpdates *types.OffchainUpdates,
 	txHash []byte,
 	metric string,
 ) {
 	k.SendOffchainMessages(
+		ctx,
 		offchainUpdates,
 		[]msgsender.MessageHeader{
 			{
@@ -1251,8 +1499,9 @@ func (k Keeper) sendOffchainMessagesWithTxHash(
 
 // SendOffchainMessages sends all the `Message` in the offchainUpdates passed in along with
 // any additional headers passed in. No headers will be added if a `nil` or empty list of additional
-// headers is passed in.
+// headers is passed in. The updates are also sent to any gRPC stream subscriptions.
 func (k Keeper) SendOffchainMessages(
+	ctx sdk.Context,
 	offchainUpdates *types.OffchainUpdates,
 	additionalHeaders []msgsender.MessageHeader,
 	metric string,
@@ -1269,6 +1518,8 @@ func (k Keeper) SendOffchainMessages(
 		}
 		k.GetIndexerEventManager().SendOffchainData(update)
 	}
+
+	k.SendOrderbookUpdates(ctx, offchainUpdates)
 }
 
 // getPessimisticCollateralCheckPrice returns the price in subticks we should use for collateralization checks.
@@ -1310,9 +1561,7 @@ func getPessimisticCollateralCheckPrice(
 // getFillQuoteQuantums returns the total fillAmount price in quote quantums based on the maker subticks.
 // This value is always positive.
 //
-// Returns an error if:
-// - The Order is not for a `PerpetualClob`.
-// - The underlying `Price` does not exist.
+// Returns an error if the `ClobPair` has no perpetual or spot metadata.
 func getFillQuoteQuantums(
 	clobPair types.ClobPair,
 	makerSubticks types.Subticks,
@@ -1325,7 +1574,7 @@ func getFillQuoteQuantums(
 		metrics.Latency,
 	)
 
-	if perpetualClobMetadata := clobPair.GetPerpetualClobMetadata(); perpetualClobMetadata == nil {
+	if clobPair.GetPerpetualClobMetadata() == nil && clobPair.GetSpotClobMetadata() == nil {
 		return nil, types.ErrAssetOrdersNotImplemented
 	}
 
@@ -682,6 +682,11 @@ func TestPlaceShortTermOrder(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -890,6 +895,11 @@ func TestAddPreexistingStatefulOrder(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -1228,6 +1238,81 @@ func TestPerformStatefulOrderValidation(t *testing.T) {
 			},
 			expectedErr: types.ErrGoodTilBlockExceedsShortBlockWindow.Error(),
 		},
+		"Short-Term: Succeeds with a GoodTilBlockTime of previous block time + ShortTermOrderTimeWindow": {
+			order: types.Order{
+				OrderId: types.OrderId{
+					ClientId:     0,
+					SubaccountId: constants.Alice_Num0,
+					ClobPairId:   uint32(0),
+				},
+				Side:     types.Order_SIDE_BUY,
+				Quantums: 600,
+				Subticks: 78,
+				GoodTilOneof: &types.Order_GoodTilBlockTime{
+					GoodTilBlockTime: lib.MustConvertIntegerToUint32(
+						time.Unix(5, 0).Add(types.ShortTermOrderTimeWindow).Unix(),
+					),
+				},
+			},
+		},
+		"Short-Term: Fails if GoodTilBlockTime is less than or equal to the block time of the previous block": {
+			order: types.Order{
+				OrderId: types.OrderId{
+					ClientId:     0,
+					SubaccountId: constants.Alice_Num0,
+					ClobPairId:   uint32(0),
+				},
+				Side:     types.Order_SIDE_BUY,
+				Quantums: 600,
+				Subticks: 78,
+				GoodTilOneof: &types.Order_GoodTilBlockTime{
+					GoodTilBlockTime: 5,
+				},
+			},
+			expectedErr: types.ErrTimeExceedsGoodTilBlockTime.Error(),
+		},
+		"Short-Term: Fails if GoodTilBlockTime Exceeds ShortTermOrderTimeWindow": {
+			order: types.Order{
+				OrderId: types.OrderId{
+					ClientId:     0,
+					SubaccountId: constants.Alice_Num0,
+					ClobPairId:   uint32(0),
+				},
+				Side:     types.Order_SIDE_BUY,
+				Quantums: 600,
+				Subticks: 78,
+				GoodTilOneof: &types.Order_GoodTilBlockTime{
+					GoodTilBlockTime: lib.MustConvertIntegerToUint32(
+						time.Unix(5, 0).Add(types.ShortTermOrderTimeWindow).Unix() + 1,
+					),
+				},
+			},
+			expectedErr: types.ErrGoodTilBlockTimeExceedsShortTermOrderTimeWindow.Error(),
+		},
+		"Short-Term: Fails if GoodTilBlockTime order has a fill amount pruned by block height": {
+			setupDeliverTxState: func(ctx sdk.Context, k *keeper.Keeper) {
+				k.SetOrderFillAmount(
+					ctx,
+					types.OrderId{SubaccountId: constants.Alice_Num0},
+					100,
+					blockHeight+types.ShortBlockWindow,
+				)
+			},
+			order: types.Order{
+				OrderId: types.OrderId{
+					ClientId:     0,
+					SubaccountId: constants.Alice_Num0,
+					ClobPairId:   uint32(0),
+				},
+				Side:     types.Order_SIDE_BUY,
+				Quantums: 600,
+				Subticks: 78,
+				GoodTilOneof: &types.Order_GoodTilBlockTime{
+					GoodTilBlockTime: 10,
+				},
+			},
+			expectedErr: types.ErrInvalidReplacement.Error(),
+		},
 		"Stateful: Fails if GoodTilBlockTime is less than or equal to the block time of the previous block": {
 			order: types.Order{
 				OrderId: types.OrderId{
@@ -1488,6 +1573,368 @@ func TestPerformStatefulOrderValidation(t *testing.T) {
 			},
 			expectedErr: types.ErrInvalidPlaceOrder.Error(),
 		},
+		"Order group: Succeeds with a take profit order for a bracket entry order": {
+			setupDeliverTxState: func(ctx sdk.Context, k *keeper.Keeper) {
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     0,
+							OrderFlags:   types.OrderIdFlags_LongTerm,
+						},
+						Side:         types.Order_SIDE_BUY,
+						Quantums:     600,
+						Subticks:     78,
+						GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_BRACKET,
+							Leg:  types.OrderGroup_LEG_ENTRY,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+			},
+			order: types.Order{
+				OrderId: types.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					ClientId:     1,
+					OrderFlags:   types.OrderIdFlags_Conditional,
+				},
+				Side:                            types.Order_SIDE_SELL,
+				Quantums:                        600,
+				Subticks:                        78,
+				GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+				ConditionType:                   types.Order_CONDITION_TYPE_TAKE_PROFIT,
+				ConditionalOrderTriggerSubticks: uint64(117),
+				OrderGroup: &types.OrderGroup{
+					Id:   1,
+					Type: types.OrderGroup_TYPE_BRACKET,
+					Leg:  types.OrderGroup_LEG_TAKE_PROFIT,
+				},
+			},
+		},
+		"Order group: Fails if the order group is full": {
+			setupDeliverTxState: func(ctx sdk.Context, k *keeper.Keeper) {
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     0,
+							OrderFlags:   types.OrderIdFlags_Conditional,
+						},
+						Side:                            types.Order_SIDE_BUY,
+						Quantums:                        600,
+						Subticks:                        78,
+						GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						ConditionType:                   types.Order_CONDITION_TYPE_TAKE_PROFIT,
+						ConditionalOrderTriggerSubticks: uint64(117),
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     1,
+							OrderFlags:   types.OrderIdFlags_Conditional,
+						},
+						Side:                            types.Order_SIDE_BUY,
+						Quantums:                        600,
+						Subticks:                        78,
+						GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						ConditionType:                   types.Order_CONDITION_TYPE_STOP_LOSS,
+						ConditionalOrderTriggerSubticks: uint64(39),
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+			},
+			order: types.Order{
+				OrderId: types.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					ClientId:     2,
+					OrderFlags:   types.OrderIdFlags_Conditional,
+				},
+				Side:                            types.Order_SIDE_BUY,
+				Quantums:                        600,
+				Subticks:                        78,
+				GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+				ConditionType:                   types.Order_CONDITION_TYPE_STOP_LOSS,
+				ConditionalOrderTriggerSubticks: uint64(39),
+				OrderGroup: &types.OrderGroup{
+					Id:   1,
+					Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+				},
+			},
+			expectedErr: types.ErrInvalidOrderGroup.Error(),
+		},
+		"Order group: Fails if the order group type differs": {
+			setupDeliverTxState: func(ctx sdk.Context, k *keeper.Keeper) {
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     0,
+							OrderFlags:   types.OrderIdFlags_Conditional,
+						},
+						Side:                            types.Order_SIDE_BUY,
+						Quantums:                        600,
+						Subticks:                        78,
+						GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						ConditionType:                   types.Order_CONDITION_TYPE_TAKE_PROFIT,
+						ConditionalOrderTriggerSubticks: uint64(117),
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+			},
+			order: types.Order{
+				OrderId: types.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					ClientId:     1,
+					OrderFlags:   types.OrderIdFlags_Conditional,
+				},
+				Side:                            types.Order_SIDE_SELL,
+				Quantums:                        600,
+				Subticks:                        78,
+				GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+				ConditionType:                   types.Order_CONDITION_TYPE_STOP_LOSS,
+				ConditionalOrderTriggerSubticks: uint64(39),
+				OrderGroup: &types.OrderGroup{
+					Id:   1,
+					Type: types.OrderGroup_TYPE_BRACKET,
+					Leg:  types.OrderGroup_LEG_STOP_LOSS,
+				},
+			},
+			expectedErr: types.ErrInvalidOrderGroup.Error(),
+		},
+		"Order group: Fails if GoodTilBlockTime differs": {
+			setupDeliverTxState: func(ctx sdk.Context, k *keeper.Keeper) {
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     0,
+							OrderFlags:   types.OrderIdFlags_Conditional,
+						},
+						Side:                            types.Order_SIDE_BUY,
+						Quantums:                        600,
+						Subticks:                        78,
+						GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						ConditionType:                   types.Order_CONDITION_TYPE_TAKE_PROFIT,
+						ConditionalOrderTriggerSubticks: uint64(117),
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+			},
+			order: types.Order{
+				OrderId: types.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					ClientId:     1,
+					OrderFlags:   types.OrderIdFlags_Conditional,
+				},
+				Side:                            types.Order_SIDE_BUY,
+				Quantums:                        600,
+				Subticks:                        78,
+				GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+				ConditionType:                   types.Order_CONDITION_TYPE_STOP_LOSS,
+				ConditionalOrderTriggerSubticks: uint64(39),
+				OrderGroup: &types.OrderGroup{
+					Id:   1,
+					Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+				},
+			},
+			expectedErr: types.ErrInvalidOrderGroup.Error(),
+		},
+		"Order group: Fails if the bracket leg already exists": {
+			setupDeliverTxState: func(ctx sdk.Context, k *keeper.Keeper) {
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     0,
+							OrderFlags:   types.OrderIdFlags_LongTerm,
+						},
+						Side:         types.Order_SIDE_BUY,
+						Quantums:     600,
+						Subticks:     78,
+						GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_BRACKET,
+							Leg:  types.OrderGroup_LEG_ENTRY,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     1,
+							OrderFlags:   types.OrderIdFlags_Conditional,
+						},
+						Side:                            types.Order_SIDE_SELL,
+						Quantums:                        600,
+						Subticks:                        78,
+						GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						ConditionType:                   types.Order_CONDITION_TYPE_TAKE_PROFIT,
+						ConditionalOrderTriggerSubticks: uint64(117),
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_BRACKET,
+							Leg:  types.OrderGroup_LEG_TAKE_PROFIT,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+			},
+			order: types.Order{
+				OrderId: types.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					ClientId:     2,
+					OrderFlags:   types.OrderIdFlags_Conditional,
+				},
+				Side:                            types.Order_SIDE_SELL,
+				Quantums:                        600,
+				Subticks:                        78,
+				GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+				ConditionType:                   types.Order_CONDITION_TYPE_TAKE_PROFIT,
+				ConditionalOrderTriggerSubticks: uint64(156),
+				OrderGroup: &types.OrderGroup{
+					Id:   1,
+					Type: types.OrderGroup_TYPE_BRACKET,
+					Leg:  types.OrderGroup_LEG_TAKE_PROFIT,
+				},
+			},
+			expectedErr: types.ErrInvalidOrderGroup.Error(),
+		},
+		"Order group: Fails if a bracket exit order is on the same side as the entry order": {
+			setupDeliverTxState: func(ctx sdk.Context, k *keeper.Keeper) {
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     0,
+							OrderFlags:   types.OrderIdFlags_LongTerm,
+						},
+						Side:         types.Order_SIDE_BUY,
+						Quantums:     600,
+						Subticks:     78,
+						GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_BRACKET,
+							Leg:  types.OrderGroup_LEG_ENTRY,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+			},
+			order: types.Order{
+				OrderId: types.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					ClientId:     1,
+					OrderFlags:   types.OrderIdFlags_Conditional,
+				},
+				Side:                            types.Order_SIDE_BUY,
+				Quantums:                        600,
+				Subticks:                        78,
+				GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+				ConditionType:                   types.Order_CONDITION_TYPE_STOP_LOSS,
+				ConditionalOrderTriggerSubticks: uint64(39),
+				OrderGroup: &types.OrderGroup{
+					Id:   1,
+					Type: types.OrderGroup_TYPE_BRACKET,
+					Leg:  types.OrderGroup_LEG_STOP_LOSS,
+				},
+			},
+			expectedErr: types.ErrInvalidOrderGroup.Error(),
+		},
+		"Order group: Fails if bracket exit orders are on different sides": {
+			setupDeliverTxState: func(ctx sdk.Context, k *keeper.Keeper) {
+				k.SetLongTermOrderPlacement(
+					ctx,
+					types.Order{
+						OrderId: types.OrderId{
+							SubaccountId: constants.Alice_Num0,
+							ClientId:     0,
+							OrderFlags:   types.OrderIdFlags_Conditional,
+						},
+						Side:                            types.Order_SIDE_SELL,
+						Quantums:                        600,
+						Subticks:                        78,
+						GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+						ConditionType:                   types.Order_CONDITION_TYPE_TAKE_PROFIT,
+						ConditionalOrderTriggerSubticks: uint64(117),
+						OrderGroup: &types.OrderGroup{
+							Id:   1,
+							Type: types.OrderGroup_TYPE_BRACKET,
+							Leg:  types.OrderGroup_LEG_TAKE_PROFIT,
+						},
+					},
+					lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				)
+			},
+			order: types.Order{
+				OrderId: types.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					ClientId:     1,
+					OrderFlags:   types.OrderIdFlags_Conditional,
+				},
+				Side:                            types.Order_SIDE_BUY,
+				Quantums:                        600,
+				Subticks:                        78,
+				GoodTilOneof:                    &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20},
+				ConditionType:                   types.Order_CONDITION_TYPE_STOP_LOSS,
+				ConditionalOrderTriggerSubticks: uint64(39),
+				OrderGroup: &types.OrderGroup{
+					Id:   1,
+					Type: types.OrderGroup_TYPE_BRACKET,
+					Leg:  types.OrderGroup_LEG_STOP_LOSS,
+				},
+			},
+			expectedErr: types.ErrInvalidOrderGroup.Error(),
+		},
+		"Fails with short-term order and ClobPair_Status of FINAL_SETTLEMENT": {
+			clobPairs: []types.ClobPair{
+				{
+					Metadata: &types.ClobPair_PerpetualClobMetadata{
+						PerpetualClobMetadata: &types.PerpetualClobMetadata{
+							PerpetualId: 0,
+						},
+					},
+					Status:           types.ClobPair_STATUS_FINAL_SETTLEMENT,
+					StepBaseQuantums: 10,
+					SubticksPerTick:  10,
+				},
+			},
+			order:       constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+			expectedErr: "cannot be placed for clob pair with status",
+		},
 		"Fails with long-term order and ClobPair_Status of INITIALIZING": {
 			clobPairs: []types.ClobPair{
 				{
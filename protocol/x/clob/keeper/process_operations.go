@@ -45,6 +45,11 @@ func (k Keeper) ProcessProposerOperations(
 		ctx.BlockHeight(),
 	)
 
+	// Collect the orders in order groups that are removed by order removal operations. The other orders of
+	// their order groups are removed after all operations are processed, so that operations placed after the
+	// order removal in the operations queue remain valid.
+	removedOrderGroupOrders := k.getOrderGroupOrdersRemovedByOperations(ctx, operations)
+
 	// Write results of the operations queue to state. Performs stateful validation as well.
 	if err := k.ProcessInternalOperations(ctx, operations); err != nil {
 		return err
@@ -54,6 +59,7 @@ func (k Keeper) ProcessProposerOperations(
 	processProposerMatchesEvents := k.GenerateProcessProposerMatchesEvents(ctx, operations)
 
 	// Remove fully filled orders from state.
+	filledOrderGroupOrders := make([]types.Order, 0)
 	for _, orderId := range processProposerMatchesEvents.OrderIdsFilledInLastBlock {
 		if orderId.IsShortTermOrder() {
 			continue
@@ -71,7 +77,10 @@ func (k Keeper) ProcessProposerOperations(
 
 			// If the order is fully filled, remove it from state.
 			if orderStateFillAmount == orderPlacement.Order.GetBaseQuantums() {
-				k.MustRemoveStatefulOrder(ctx, orderId)
+				k.mustRemoveStatefulOrder(ctx, orderId)
+				if orderPlacement.Order.OrderGroup != nil {
+					filledOrderGroupOrders = append(filledOrderGroupOrders, orderPlacement.Order)
+				}
 				telemetry.IncrCounterWithLabels(
 					[]string{types.ModuleName, metrics.ProcessOperations, metrics.StatefulOrderRemoved, metrics.Count},
 					1,
@@ -96,6 +105,15 @@ func (k Keeper) ProcessProposerOperations(
 		processProposerMatchesEvents,
 	)
 
+	// Remove the other orders of the order groups of fully filled and removed orders. Note that this
+	// adds the removed orders to the `ProcessProposerMatchesEvents` in the memstore.
+	for _, order := range filledOrderGroupOrders {
+		k.RemoveOrderGroupSiblings(ctx, order, true)
+	}
+	for _, order := range removedOrderGroupOrders {
+		k.RemoveOrderGroupSiblings(ctx, order, false)
+	}
+
 	// Emit stats about the proposed operations.
 	operationsStats := types.StatMsgProposedOperations(rawOperations)
 	operationsStats.EmitStats(metrics.DeliverTx)
@@ -139,6 +157,40 @@ func (k Keeper) ProcessInternalOperations(
 			}
 		case *types.InternalOperation_ShortTermOrderPlacement:
 			order := castedOperation.ShortTermOrderPlacement.GetOrder()
+			if err := k.PerformStatefulOrderValidation(
+				ctx,
+				&order,
+				lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				false,
+			); err != nil {
+				return err
+			}
+			placedShortTermOrders[order.GetOrderId()] = order
+		case *types.InternalOperation_ShortTermOrderReplacement:
+			// The replacement order overwrites the order it replaces, and subsequent matches
+			// are validated against the replacement order.
+			orderReplacement := castedOperation.ShortTermOrderReplacement
+			order := orderReplacement.GetOrder()
+
+			// The order being replaced must have been placed by a previous operation in the
+			// operations queue, and the replacement order must be a valid replacement of it.
+			replacedOrder, exists := placedShortTermOrders[order.GetOrderId()]
+			if !exists {
+				return errorsmod.Wrapf(
+					types.ErrReplacedOrderDoesNotExist,
+					"ProcessInternalOperations: Order Replacement (%+v) does not replace an order "+
+						"placed in the operations queue",
+					*orderReplacement,
+				)
+			}
+			if err := orderReplacement.ValidateReplacementOf(replacedOrder); err != nil {
+				return errorsmod.Wrapf(
+					err,
+					"ProcessInternalOperations: Order Replacement (%+v) invalid",
+					*orderReplacement,
+				)
+			}
+
 			if err := k.PerformStatefulOrderValidation(
 				ctx,
 				&order,
@@ -200,6 +252,14 @@ func (k Keeper) PersistMatchToState(
 		); err != nil {
 			return err
 		}
+	case *types.ClobMatch_MatchAssetLiquidation:
+		if err := k.PersistMatchAssetLiquidationToState(
+			ctx,
+			castedMatch.MatchAssetLiquidation,
+			ordersMap,
+		); err != nil {
+			return err
+		}
 	case *types.ClobMatch_MatchPerpetualDeleveraging:
 		if err := k.PersistMatchDeleveragingToState(
 			ctx,
@@ -207,6 +267,13 @@ func (k Keeper) PersistMatchToState(
 		); err != nil {
 			return err
 		}
+	case *types.ClobMatch_MatchAssetDeleveraging:
+		if err := k.PersistMatchAssetDeleveragingToState(
+			ctx,
+			castedMatch.MatchAssetDeleveraging,
+		); err != nil {
+			return err
+		}
 	default:
 		panic(
 			fmt.Sprintf(
@@ -400,8 +467,9 @@ func (k Keeper) PersistOrderRemovalToState(
 		)
 	}
 
-	// Remove the stateful order from state.
-	k.MustRemoveStatefulOrder(ctx, orderIdToRemove)
+	// Remove the stateful order from state. The other orders of its order group are removed in
+	// `ProcessProposerOperations` once all operations are processed.
+	k.mustRemoveStatefulOrder(ctx, orderIdToRemove)
 
 	// Emit an on-chain indexer event for Stateful Order Removal.
 	k.GetIndexerEventManager().AddTxnEvent(
@@ -429,6 +497,27 @@ func (k Keeper) PersistOrderRemovalToState(
 	return nil
 }
 
+// getOrderGroupOrdersRemovedByOperations returns the orders in order groups that are removed by the
+// order removal operations in `operations`, in the order of the operations.
+func (k Keeper) getOrderGroupOrdersRemovedByOperations(
+	ctx sdk.Context,
+	operations []types.InternalOperation,
+) []types.Order {
+	orders := make([]types.Order, 0)
+	for _, operation := range operations {
+		orderRemoval := operation.GetOrderRemoval()
+		if orderRemoval == nil || !orderRemoval.OrderId.IsStatefulOrder() {
+			continue
+		}
+
+		orderPlacement, exists := k.GetLongTermOrderPlacement(ctx, orderRemoval.OrderId)
+		if exists && orderPlacement.Order.OrderGroup != nil {
+			orders = append(orders, orderPlacement.Order)
+		}
+	}
+	return orders
+}
+
 // PersistMatchOrdersToState writes a MatchOrders object to state and emits an onchain
 // indexer event for the match.
 func (k Keeper) PersistMatchOrdersToState(
@@ -517,6 +606,25 @@ func (k Keeper) PersistMatchOrdersToState(
 				),
 			),
 		)
+
+		// Send the fill to gRPC stream subscriptions.
+		if k.GetGrpcStreamingManager().Enabled() {
+			k.SendOrderbookFillUpdates(
+				ctx,
+				[]types.StreamOrderbookFill{
+					{
+						ClobMatch: types.NewClobMatchFromMatchOrders(
+							&types.MatchOrders{
+								TakerOrderId: takerOrderId,
+								Fills:        []types.MakerFill{makerFill},
+							},
+						),
+						Orders:      []types.Order{takerOrder, makerOrder},
+						FillAmounts: []uint64{totalFilledTaker.ToUint64(), totalFilledMaker.ToUint64()},
+					},
+				},
+			)
+		}
 	}
 
 	return nil
@@ -597,6 +705,30 @@ func (k Keeper) PersistMatchLiquidationToState(
 				),
 			),
 		)
+
+		// Send the fill to gRPC stream subscriptions. The liquidation order is not included since
+		// it never rests on the book.
+		if k.GetGrpcStreamingManager().Enabled() {
+			k.SendOrderbookFillUpdates(
+				ctx,
+				[]types.StreamOrderbookFill{
+					{
+						ClobMatch: types.NewClobMatchFromMatchPerpetualLiquidation(
+							&types.MatchPerpetualLiquidation{
+								Liquidated:  matchLiquidation.Liquidated,
+								ClobPairId:  matchLiquidation.ClobPairId,
+								PerpetualId: matchLiquidation.PerpetualId,
+								TotalSize:   matchLiquidation.TotalSize,
+								IsBuy:       matchLiquidation.IsBuy,
+								Fills:       []types.MakerFill{fill},
+							},
+						),
+						Orders:      []types.Order{makerOrder},
+						FillAmounts: []uint64{totalFilledMaker.ToUint64()},
+					},
+				},
+			)
+		}
 	}
 
 	// Update the keeper transient store if-and-only-if the liquidation is valid.
@@ -608,6 +740,93 @@ func (k Keeper) PersistMatchLiquidationToState(
 	return nil
 }
 
+// PersistMatchAssetLiquidationToState writes a MatchAssetLiquidation to state.
+// It also performs stateful validation on the matchLiquidation object.
+func (k Keeper) PersistMatchAssetLiquidationToState(
+	ctx sdk.Context,
+	matchLiquidation *types.MatchAssetLiquidation,
+	ordersMap map[types.OrderId]types.Order,
+) error {
+	// If the subaccount is not liquidatable, do nothing.
+	if err := k.EnsureIsLiquidatable(ctx, matchLiquidation.Liquidated); err != nil {
+		return err
+	}
+
+	takerOrder, err := k.GetLiquidationOrderForAsset(
+		ctx,
+		matchLiquidation.Liquidated,
+		matchLiquidation.AssetId,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Perform stateless validation on the liquidation order.
+	if err := k.ValidateAssetLiquidationOrderAgainstProposedLiquidation(ctx, takerOrder, matchLiquidation); err != nil {
+		return err
+	}
+
+	for _, fill := range matchLiquidation.GetFills() {
+		// Fetch the maker order from either short term orders or state.
+		makerOrder, err := k.FetchOrderFromOrderId(ctx, fill.MakerOrderId, ordersMap)
+		if err != nil {
+			return err
+		}
+
+		matchWithOrders := types.MatchWithOrders{
+			MakerOrder: &makerOrder,
+			TakerOrder: takerOrder,
+			FillAmount: satypes.BaseQuantums(fill.FillAmount),
+		}
+
+		// Write the position updates and state fill amounts for this match.
+		// Note stateless validation on the constructed `matchWithOrders` is performed within this function.
+		_, _, _, _, err = k.ProcessSingleMatch(
+			ctx,
+			&matchWithOrders,
+		)
+		if err != nil {
+			return err
+		}
+
+		makerExists, totalFilledMaker, _ := k.GetOrderFillAmount(ctx, matchWithOrders.MakerOrder.MustGetOrder().OrderId)
+		if !makerExists {
+			panic(
+				fmt.Sprintf("PersistMatchAssetLiquidationToState: Order fill amount not found for maker order: %+v",
+					matchWithOrders.MakerOrder.MustGetOrder().OrderId,
+				),
+			)
+		}
+
+		// Send the fill to gRPC stream subscriptions. The liquidation order is not included since
+		// it never rests on the book.
+		// Note that no indexer fill event is sent, since the indexer does not support asset liquidations.
+		if k.GetGrpcStreamingManager().Enabled() {
+			k.SendOrderbookFillUpdates(
+				ctx,
+				[]types.StreamOrderbookFill{
+					{
+						ClobMatch: types.NewClobMatchFromMatchAssetLiquidation(
+							&types.MatchAssetLiquidation{
+								Liquidated: matchLiquidation.Liquidated,
+								ClobPairId: matchLiquidation.ClobPairId,
+								AssetId:    matchLiquidation.AssetId,
+								TotalSize:  matchLiquidation.TotalSize,
+								IsBuy:      matchLiquidation.IsBuy,
+								Fills:      []types.MakerFill{fill},
+							},
+						),
+						Orders:      []types.Order{makerOrder},
+						FillAmounts: []uint64{totalFilledMaker.ToUint64()},
+					},
+				},
+			)
+		}
+	}
+
+	return nil
+}
+
 // PersistMatchDeleveragingToState writes a MatchPerpetualDeleveraging object to state.
 // This function returns an error if:
 // - CanDeleverageSubaccount returns false, indicating the subaccount failed deleveraging validation.
@@ -621,7 +840,11 @@ func (k Keeper) PersistMatchDeleveragingToState(
 	liquidatedSubaccountId := matchDeleveraging.GetLiquidated()
 
 	// Validate that the provided subaccount can be deleveraged.
-	if canDeleverageSubaccount, err := k.CanDeleverageSubaccount(ctx, liquidatedSubaccountId); err != nil {
+	if canDeleverageSubaccount, err := k.CanDeleverageSubaccount(
+		ctx,
+		liquidatedSubaccountId,
+		matchDeleveraging.PerpetualId,
+	); err != nil {
 		panic(
 			fmt.Sprintf(
 				"PersistMatchDeleveragingToState: Failed to determine if subaccount can be deleveraged. "+
@@ -682,6 +905,94 @@ func (k Keeper) PersistMatchDeleveragingToState(
 	return nil
 }
 
+// PersistMatchAssetDeleveragingToState writes a MatchAssetDeleveraging object to state.
+// This function returns an error if:
+// - The subaccount is not liquidatable.
+// - The subaccount does not have a borrowed position for the asset, and does not have a collateral
+// position for the asset which can be seized to repay a negative USDC balance.
+// - ProcessAssetDeleveraging returns an error for any of the fills.
+//
+// The fills repay the borrowed position if the subaccount's position is negative, and seize the
+// collateral position otherwise.
+func (k Keeper) PersistMatchAssetDeleveragingToState(
+	ctx sdk.Context,
+	matchDeleveraging *types.MatchAssetDeleveraging,
+) error {
+	liquidatedSubaccountId := matchDeleveraging.GetLiquidated()
+
+	// Validate that the provided subaccount can be deleveraged.
+	if err := k.EnsureIsLiquidatable(ctx, liquidatedSubaccountId); err != nil {
+		return errorsmod.Wrapf(
+			types.ErrInvalidDeleveragedSubaccount,
+			"Subaccount %+v failed asset deleveraging validation: %v",
+			liquidatedSubaccountId,
+			err,
+		)
+	}
+
+	assetId := matchDeleveraging.GetAssetId()
+
+	// Note that the subaccount may still have open perpetual positions, since a perpetual position
+	// can only be liquidated once per block. Its assets are deleveraged after its perpetual positions
+	// have been liquidated in the same block.
+	liquidatedSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, liquidatedSubaccountId)
+	position, exists := liquidatedSubaccount.GetAssetPositionForId(assetId)
+	if !exists {
+		return errorsmod.Wrapf(
+			types.ErrNoBorrowedAssetPosition,
+			"Subaccount %+v does not have a borrowed position for asset %+v",
+			liquidatedSubaccountId,
+			assetId,
+		)
+	}
+
+	// Collateral is only seized from subaccounts which do not borrow any asset, and whose
+	// USDC balance is negative. Assets which are not collateral cannot be seized.
+	isSeizure := position.GetBigQuantums().Sign() > 0
+	if isSeizure {
+		asset, assetExists := k.assetsKeeper.GetAsset(ctx, assetId)
+		if _, hasBorrowedAsset := liquidatedSubaccount.GetBorrowedAssetPosition(); hasBorrowedAsset ||
+			liquidatedSubaccount.GetUsdcPosition().Sign() >= 0 ||
+			!assetExists ||
+			!asset.IsCollateral() {
+			return errorsmod.Wrapf(
+				types.ErrNoSeizableCollateralAssetPosition,
+				"Subaccount %+v does not have a collateral position for asset %+v that can be seized",
+				liquidatedSubaccountId,
+				assetId,
+			)
+		}
+	}
+
+	for _, fill := range matchDeleveraging.GetFills() {
+		deltaQuantums := new(big.Int).SetUint64(fill.FillAmount)
+		if isSeizure {
+			deltaQuantums.Neg(deltaQuantums)
+		}
+
+		if err := k.ProcessAssetDeleveraging(
+			ctx,
+			liquidatedSubaccountId,
+			fill.OffsettingSubaccountId,
+			assetId,
+			deltaQuantums,
+		); err != nil {
+			return errorsmod.Wrapf(
+				types.ErrInvalidDeleveragingFill,
+				"Failed to process asset deleveraging fill: %+v. liquidatedSubaccountId: %+v, "+
+					"assetId: %v, deltaQuantums: %v, error: %v",
+				fill,
+				liquidatedSubaccountId,
+				assetId,
+				deltaQuantums,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
 // GenerateProcessProposerMatchesEvents generates a `ProcessProposerMatchesEvents` object from
 // an operations queue.
 // Currently, it sets the `OrderIdsFilledInLastBlock` field and the `BlockHeight` field.
@@ -715,6 +1026,13 @@ func (k Keeper) GenerateProcessProposerMatchesEvents(
 					seenOrderIdsFilledInLastBlock[makerOrderId] = struct{}{}
 				}
 			}
+			// For each fill of an asset liquidation match, add maker order id to `seenOrderIdsFilledInLastBlock`
+			if assetLiquidationMatch := operationMatch.GetMatchAssetLiquidation(); assetLiquidationMatch != nil {
+				for _, fill := range assetLiquidationMatch.GetFills() {
+					makerOrderId := fill.GetMakerOrderId()
+					seenOrderIdsFilledInLastBlock[makerOrderId] = struct{}{}
+				}
+			}
 		} else if operationRemoval := operation.GetOrderRemoval(); operationRemoval != nil {
 			// For order removal, add order id to `seenOrderIdsRemovedInLastBlock`
 			orderId := operationRemoval.GetOrderId()
@@ -129,3 +129,53 @@ func (k Keeper) ValidateLiquidationOrderAgainstProposedLiquidation(
 	}
 	return nil
 }
+
+// ValidateAssetLiquidationOrderAgainstProposedLiquidation performs stateless validation of an asset
+// liquidation order against a proposed asset liquidation.
+// An error is returned when
+//   - The CLOB pair IDs of the order and proposed liquidation do not match.
+//   - The asset IDs of the order and proposed liquidation do not match.
+//   - The total size of the order and proposed liquidation do not match.
+//   - The side of the order and proposed liquidation do not match.
+func (k Keeper) ValidateAssetLiquidationOrderAgainstProposedLiquidation(
+	ctx sdk.Context,
+	order *types.LiquidationOrder,
+	proposedMatch *types.MatchAssetLiquidation,
+) error {
+	if order.GetClobPairId() != types.ClobPairId(proposedMatch.GetClobPairId()) {
+		return errorsmod.Wrapf(
+			types.ErrClobPairAndAssetDoNotMatch,
+			"Order CLOB Pair ID: %v, Match CLOB Pair ID: %v",
+			order.GetClobPairId(),
+			proposedMatch.GetClobPairId(),
+		)
+	}
+
+	if order.MustGetLiquidatedAssetId() != proposedMatch.GetAssetId() {
+		return errorsmod.Wrapf(
+			types.ErrClobPairAndAssetDoNotMatch,
+			"Order Asset ID: %v, Match Asset ID: %v",
+			order.MustGetLiquidatedAssetId(),
+			proposedMatch.GetAssetId(),
+		)
+	}
+
+	if order.GetBaseQuantums() != satypes.BaseQuantums(proposedMatch.TotalSize) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidLiquidationOrderTotalSize,
+			"Order Size: %v, Match Size: %v",
+			order.GetBaseQuantums(),
+			proposedMatch.TotalSize,
+		)
+	}
+
+	if order.IsBuy() != proposedMatch.GetIsBuy() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidLiquidationOrderSide,
+			"Order Side: %v, Match Side: %v",
+			order.IsBuy(),
+			proposedMatch.GetIsBuy(),
+		)
+	}
+	return nil
+}
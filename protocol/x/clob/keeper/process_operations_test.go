@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
@@ -64,6 +65,7 @@ type processProposerOperationsTestCase struct {
 	expectedFillAmounts                  map[types.OrderId]satypes.BaseQuantums
 	expectedQuoteBalances                map[satypes.SubaccountId]int64
 	expectedPerpetualPositions           map[satypes.SubaccountId][]*satypes.PerpetualPosition
+	expectedPerpetualPositionEntries     map[satypes.SubaccountId]map[uint32]*big.Int
 	expectedSubaccountLiquidationInfo    map[satypes.SubaccountId]types.SubaccountLiquidationInfo
 	expectedError                        error
 	expectedPanics                       string
@@ -226,6 +228,109 @@ func TestProcessProposerOperations(t *testing.T) {
 					},
 				},
 			},
+			// Both positions were opened before their entry notionals were tracked, so they remain untracked.
+			expectedPerpetualPositionEntries: map[satypes.SubaccountId]map[uint32]*big.Int{
+				constants.Alice_Num0: {0: nil},
+				constants.Bob_Num0:   {0: nil},
+			},
+		},
+		"Succeeds with singular match that flips one position and opens another": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_100_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId: 0,
+							Quantums:    dtypes.NewInt(-100_000_000), // -1 BTC
+						},
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_500_000,
+					},
+				},
+			},
+			preExistingStatefulOrders: []types.Order{},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+						Side:         types.Order_SIDE_BUY,
+						Quantums:     200_000_000, // 2 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+				),
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     200_000_000, // 2 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+				),
+				clobtest.NewMatchOperationRaw(
+					&types.Order{
+						OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+						Side:         types.Order_SIDE_SELL,
+						Quantums:     200_000_000, // 2 BTC
+						Subticks:     50_000_000,
+						GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+					},
+					[]types.MakerFill{
+						{
+							FillAmount:   200_000_000,
+							MakerOrderId: types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+						},
+					},
+				),
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				OrderIdsFilledInLastBlock: []types.OrderId{
+					{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+					{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+				},
+				BlockHeight: blockHeight,
+			},
+			expectedQuoteBalances: map[satypes.SubaccountId]int64{
+				constants.Alice_Num0: constants.Usdc_Asset_100_000.GetBigQuantums().Int64() - 100_000_000 - 20_000,
+				constants.Bob_Num0:   constants.Usdc_Asset_500_000.GetBigQuantums().Int64() + 100_000_000 - 50_000,
+			},
+			expectedPerpetualPositions: map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+				constants.Alice_Num0: {
+					{
+						PerpetualId:  0,
+						Quantums:     dtypes.NewInt(100_000_000),
+						FundingIndex: dtypes.ZeroInt(),
+					},
+				},
+				constants.Bob_Num0: {
+					{
+						PerpetualId:  0,
+						Quantums:     dtypes.NewInt(-200_000_000),
+						FundingIndex: dtypes.ZeroInt(),
+					},
+				},
+			},
+			// Alice's remaining long position is entered at the fill price, and Bob's new short position
+			// is entered at the fill price.
+			expectedPerpetualPositionEntries: map[satypes.SubaccountId]map[uint32]*big.Int{
+				constants.Alice_Num0: {0: big.NewInt(50_000_000)},
+				constants.Bob_Num0:   {0: big.NewInt(-100_000_000)},
+			},
 		},
 		"Succeeds with maker rebate": {
 			perpetuals: []*perptypes.Perpetual{
@@ -1388,6 +1493,21 @@ func TestProcessProposerOperations(t *testing.T) {
 			},
 			expectedError: types.ErrOperationConflictsWithClobPairStatus,
 		},
+		"Fails with short term order placement for market in final settlement": {
+			perpetuals: []*perptypes.Perpetual{
+				&constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_FinalSettlement,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(
+					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11,
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
 		"Fails with order removal for market in initializing mode": {
 			perpetuals: []*perptypes.Perpetual{
 				&constants.BtcUsd_100PercentMarginRequirement,
@@ -1435,6 +1555,350 @@ func TestProcessProposerOperations(t *testing.T) {
 	}
 }
 
+func TestProcessInternalOperations_ShortTermOrderReplacement(t *testing.T) {
+	tests := map[string]struct {
+		operations    []types.InternalOperation
+		expectedError error
+	}{
+		"Succeeds with replacement of order placed in operations queue": {
+			operations: []types.InternalOperation{
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11,
+				),
+				types.NewShortTermOrderReplacementInternalOperation(
+					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB12,
+				),
+			},
+		},
+		"Fails with replacement of order not placed in operations queue": {
+			operations: []types.InternalOperation{
+				types.NewShortTermOrderReplacementInternalOperation(
+					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50498_GTB11,
+				),
+			},
+			expectedError: types.ErrReplacedOrderDoesNotExist,
+		},
+		"Fails with invalid replacement of order placed in operations queue": {
+			operations: []types.InternalOperation{
+				types.NewShortTermOrderPlacementInternalOperation(
+					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB12,
+				),
+				types.NewShortTermOrderReplacementInternalOperation(
+					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50498_GTB11,
+				),
+			},
+			expectedError: types.ErrInvalidReplaceOrder,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, ks, _ := setupProcessProposerOperationsTestCase(
+				t,
+				processProposerOperationsTestCase{
+					perpetuals: []*perptypes.Perpetual{
+						&constants.BtcUsd_100PercentMarginRequirement,
+					},
+					perpetualFeeParams: &constants.PerpetualFeeParams,
+					clobPairs: []types.ClobPair{
+						constants.ClobPair_Btc,
+					},
+					subaccounts: []satypes.Subaccount{
+						constants.Dave_Num0_1BTC_Long_50000USD,
+					},
+					expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+						BlockHeight: 5,
+					},
+				},
+			)
+
+			err := ks.ClobKeeper.ProcessInternalOperations(ctx, tc.operations)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPersistMatchAssetDeleveragingToState(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		subaccounts       []satypes.Subaccount
+		assetDeleveraging types.MatchAssetDeleveraging
+
+		// Expectations.
+		expectedSubaccounts []satypes.Subaccount
+		expectedError       error
+	}{
+		"Repays borrowed asset": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+						&constants.Short_Asset_1BTC,
+					),
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Long_Asset_1BTC,
+					},
+				},
+			},
+			assetDeleveraging: types.MatchAssetDeleveraging{
+				Liquidated: constants.Carl_Num0,
+				AssetId:    constants.BtcUsd_Borrowable.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Dave_Num0,
+						FillAmount:             100_000_000,
+					},
+				},
+			},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id:             &constants.Carl_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(4_000_000_000)),
+				},
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(50_000_000_000)),
+				},
+			},
+		},
+		"Repays borrowed asset of subaccount with an open perpetual position": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(104_000_000_000)),
+						&constants.Short_Asset_1BTC,
+					),
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						&constants.PerpetualPosition_OneBTCShort,
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Long_Asset_1BTC,
+					},
+				},
+			},
+			assetDeleveraging: types.MatchAssetDeleveraging{
+				Liquidated: constants.Carl_Num0,
+				AssetId:    constants.BtcUsd_Borrowable.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Dave_Num0,
+						FillAmount:             100_000_000,
+					},
+				},
+			},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id:             &constants.Carl_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						&constants.PerpetualPosition_OneBTCShort,
+					},
+				},
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(50_000_000_000)),
+				},
+			},
+		},
+		"Seizes collateral of subaccount with negative USDC balance": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)),
+				},
+			},
+			assetDeleveraging: types.MatchAssetDeleveraging{
+				Liquidated: constants.Carl_Num0,
+				AssetId:    constants.BtcUsd_Borrowable.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Dave_Num0,
+						FillAmount:             92_000_000,
+					},
+				},
+			},
+
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.BtcUsd_Borrowable.Id,
+							Quantums: dtypes.NewInt(8_000_000),
+						},
+					},
+				},
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(54_000_000_000)),
+						&satypes.AssetPosition{
+							AssetId:  constants.BtcUsd_Borrowable.Id,
+							Quantums: dtypes.NewInt(92_000_000),
+						},
+					),
+				},
+			},
+		},
+		"Fails to seize collateral of subaccount which is not liquidatable": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(-30_000_000_000)),
+						&constants.Long_Asset_1BTC,
+					),
+				},
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)),
+				},
+			},
+			assetDeleveraging: types.MatchAssetDeleveraging{
+				Liquidated: constants.Carl_Num0,
+				AssetId:    constants.BtcUsd_Borrowable.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Dave_Num0,
+						FillAmount:             60_000_000,
+					},
+				},
+			},
+
+			expectedError: types.ErrInvalidDeleveragedSubaccount,
+		},
+		"Fails to seize more collateral than the subaccount holds": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+				{
+					Id:             &constants.Dave_Num0,
+					AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(200_000_000_000)),
+				},
+			},
+			assetDeleveraging: types.MatchAssetDeleveraging{
+				Liquidated: constants.Carl_Num0,
+				AssetId:    constants.BtcUsd_Borrowable.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Dave_Num0,
+						FillAmount:             200_000_000,
+					},
+				},
+			},
+
+			expectedError: types.ErrInvalidDeleveragingFill,
+		},
+		"Fails when offsetting subaccount cannot pay for seized collateral with USDC": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Collateral_Short_46000USD,
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: append(
+						keepertest.CreateUsdcAssetPosition(big.NewInt(10_000_000_000)),
+						&constants.Long_Asset_1BTC,
+					),
+				},
+			},
+			assetDeleveraging: types.MatchAssetDeleveraging{
+				Liquidated: constants.Carl_Num0,
+				AssetId:    constants.BtcUsd_Borrowable.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Dave_Num0,
+						FillAmount:             92_000_000,
+					},
+				},
+			},
+
+			expectedError: types.ErrInvalidDeleveragingFill,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			// Create the default markets.
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+
+			// Create liquidity tiers and the BTC perpetual.
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.OpenInterestCapNotional,
+				p.Params.FundingRateBounds,
+				p.Params.InterestRatePpm,
+				p.Params.FundingTickEpoch,
+				p.Params.FundingSampleEpoch,
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+
+			// BTC can be both borrowed and used as collateral.
+			a := constants.BtcUsd_Borrowable
+			_, err = ks.AssetsKeeper.CreateAsset(
+				ks.Ctx,
+				a.Id,
+				a.Symbol,
+				a.Denom,
+				a.DenomExponent,
+				a.HasMarket,
+				a.MarketId,
+				a.AtomicResolution,
+				constants.BtcUsd_80PercentCollateralWeight.CollateralWeightPpm,
+			)
+			require.NoError(t, err)
+			_, err = ks.AssetsKeeper.SetMarginParams(
+				ks.Ctx,
+				a.Id,
+				a.InitialMarginPpm,
+				a.MaintenanceFractionPpm,
+				a.BorrowRatePpm,
+			)
+			require.NoError(t, err)
+
+			for _, subaccount := range tc.subaccounts {
+				ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+			}
+
+			err = ks.ClobKeeper.PersistMatchAssetDeleveragingToState(ks.Ctx, &tc.assetDeleveraging)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, subaccount := range tc.expectedSubaccounts {
+				require.Equal(t, subaccount, ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, *subaccount.Id))
+			}
+		})
+	}
+}
+
 func TestGenerateProcessProposerMatchesEvents(t *testing.T) {
 	blockHeight := uint32(5)
 	tests := map[string]struct {
@@ -1690,6 +2154,11 @@ func setupProcessProposerOperationsTestCase(
 			p.Params.AtomicResolution,
 			p.Params.DefaultFundingPpm,
 			p.Params.LiquidityTier,
+			p.Params.OpenInterestCapNotional,
+			p.Params.FundingRateBounds,
+			p.Params.InterestRatePpm,
+			p.Params.FundingTickEpoch,
+			p.Params.FundingSampleEpoch,
 		)
 		require.NoError(t, err)
 	}
@@ -1850,6 +2319,16 @@ func runProcessProposerOperationsTestCase(
 	// Verify subaccount state.
 	assertSubaccountState(t, ctx, ks.SubaccountsKeeper, tc.expectedQuoteBalances, tc.expectedPerpetualPositions)
 
+	for subaccountId, perpetualIdToEntry := range tc.expectedPerpetualPositionEntries {
+		for perpetualId, entry := range perpetualIdToEntry {
+			actualEntry, exists := ks.ClobKeeper.GetPerpetualPositionEntryQuoteQuantums(ctx, subaccountId, perpetualId)
+			require.Equal(t, entry != nil, exists)
+			if entry != nil {
+				require.Equal(t, entry, actualEntry)
+			}
+		}
+	}
+
 	for orderId, fillAmount := range tc.expectedFillAmounts {
 		_, actualFillAmount, _ := ks.ClobKeeper.GetOrderFillAmount(ctx, orderId)
 		require.Equal(t, fillAmount, actualFillAmount)
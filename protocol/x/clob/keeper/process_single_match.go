@@ -31,6 +31,7 @@ import (
 //   - Validate the subaccount updates resulting from the match are valid (before persisting the updates to state)
 //   - For liquidation orders, stateful validations through
 //     calling `validateMatchPerpetualLiquidationAgainstSubaccountBlockLimits`.
+//   - Asset liquidation orders are matched against the spot `ClobPair` of the liquidated asset.
 //   - Validating that deleveraging is not required for processing liquidation orders.
 //
 // This method returns `takerUpdateResult` and `makerUpdateResult` which can be used to determine whether the maker
@@ -126,10 +127,22 @@ func (k Keeper) ProcessSingleMatch(
 		)
 	}
 
-	// Retrieve the associated perpetual id for the `ClobPair`.
-	perpetualId, err := clobPair.GetPerpetualId()
-	if err != nil {
-		return false, takerUpdateResult, makerUpdateResult, nil, err
+	// Retrieve the associated perpetual id for the `ClobPair`. Spot `ClobPair`s settle by moving the
+	// base asset between subaccounts and have no perpetual. Liquidation orders on spot `ClobPair`s must
+	// liquidate a borrowed position of the base asset, and vice versa.
+	var perpetualId uint32
+	if spotClobMetadata := clobPair.GetSpotClobMetadata(); spotClobMetadata == nil {
+		if takerMatchableOrder.IsAssetLiquidation() {
+			return false, takerUpdateResult, makerUpdateResult, nil, types.ErrClobPairAndAssetDoNotMatch
+		}
+		perpetualId, err = clobPair.GetPerpetualId()
+		if err != nil {
+			return false, takerUpdateResult, makerUpdateResult, nil, err
+		}
+	} else if takerMatchableOrder.IsLiquidation() &&
+		(!takerMatchableOrder.IsAssetLiquidation() ||
+			takerMatchableOrder.MustGetLiquidatedAssetId() != spotClobMetadata.BaseAssetId) {
+		return false, takerUpdateResult, makerUpdateResult, nil, types.ErrClobPairAndAssetDoNotMatch
 	}
 
 	// Calculate taker and maker fee ppms.
@@ -217,6 +230,7 @@ func (k Keeper) ProcessSingleMatch(
 	takerUpdateResult, makerUpdateResult, err = k.persistMatchedOrders(
 		ctx,
 		matchWithOrders,
+		clobPair,
 		perpetualId,
 		takerFeePpm,
 		makerFeePpm,
@@ -229,7 +243,8 @@ func (k Keeper) ProcessSingleMatch(
 	}
 
 	// Update subaccount total quantums liquidated and total insurance fund lost for liquidation orders.
-	if matchWithOrders.TakerOrder.IsLiquidation() {
+	// Asset liquidations are not subject to the subaccount block limits.
+	if matchWithOrders.TakerOrder.IsLiquidation() && !matchWithOrders.TakerOrder.IsAssetLiquidation() {
 		notionalLiquidatedQuoteQuantums, err := k.perpetualsKeeper.GetNetNotional(
 			ctx,
 			perpetualId,
@@ -295,13 +310,15 @@ func (k Keeper) ProcessSingleMatch(
 
 // persistMatchedOrders persists a matched order to the subaccount state,
 // by updating the quoteBalance and perpetual position size of the
-// affected subaccounts.
+// affected subaccounts. For spot `ClobPair`s, the base asset position is
+// updated instead of the perpetual position.
 // This method also transfers fees to the fee collector module, and
 // transfers insurance fund payments to the insurance fund.
 // This method mutates matchWithOrders by setting the fee fields.
 func (k Keeper) persistMatchedOrders(
 	ctx sdk.Context,
 	matchWithOrders *types.MatchWithOrders,
+	clobPair types.ClobPair,
 	perpetualId uint32,
 	takerFeePpm int32,
 	makerFeePpm int32,
@@ -340,15 +357,15 @@ func (k Keeper) persistMatchedOrders(
 	bigTakerQuoteBalanceDelta := new(big.Int).Set(bigFillQuoteQuantums)
 	bigMakerQuoteBalanceDelta := new(big.Int).Set(bigFillQuoteQuantums)
 
-	bigTakerPerpetualQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
-	bigMakerPerpetualQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
+	bigTakerBaseQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
+	bigMakerBaseQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
 
 	if matchWithOrders.TakerOrder.IsBuy() {
 		bigTakerQuoteBalanceDelta.Neg(bigTakerQuoteBalanceDelta)
-		bigMakerPerpetualQuantumsDelta.Neg(bigMakerPerpetualQuantumsDelta)
+		bigMakerBaseQuantumsDelta.Neg(bigMakerBaseQuantumsDelta)
 	} else {
 		bigMakerQuoteBalanceDelta.Neg(bigMakerQuoteBalanceDelta)
-		bigTakerPerpetualQuantumsDelta.Neg(bigTakerPerpetualQuantumsDelta)
+		bigTakerBaseQuantumsDelta.Neg(bigTakerBaseQuantumsDelta)
 	}
 
 	// Subtract quote balance delta with fees paid.
@@ -370,12 +387,6 @@ func (k Keeper) persistMatchedOrders(
 					BigQuantumsDelta: bigTakerQuoteBalanceDelta,
 				},
 			},
-			PerpetualUpdates: []satypes.PerpetualUpdate{
-				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: bigTakerPerpetualQuantumsDelta,
-				},
-			},
 			SubaccountId: matchWithOrders.TakerOrder.GetSubaccountId(),
 		},
 		// Maker update
@@ -386,16 +397,35 @@ func (k Keeper) persistMatchedOrders(
 					BigQuantumsDelta: bigMakerQuoteBalanceDelta,
 				},
 			},
-			PerpetualUpdates: []satypes.PerpetualUpdate{
-				{
-					PerpetualId:      perpetualId,
-					BigQuantumsDelta: bigMakerPerpetualQuantumsDelta,
-				},
-			},
 			SubaccountId: matchWithOrders.MakerOrder.GetSubaccountId(),
 		},
 	}
 
+	spotClobMetadata := clobPair.GetSpotClobMetadata()
+	if spotClobMetadata != nil {
+		updates[0].AssetUpdates = append(updates[0].AssetUpdates, satypes.AssetUpdate{
+			AssetId:          spotClobMetadata.BaseAssetId,
+			BigQuantumsDelta: bigTakerBaseQuantumsDelta,
+		})
+		updates[1].AssetUpdates = append(updates[1].AssetUpdates, satypes.AssetUpdate{
+			AssetId:          spotClobMetadata.BaseAssetId,
+			BigQuantumsDelta: bigMakerBaseQuantumsDelta,
+		})
+	} else {
+		updates[0].PerpetualUpdates = []satypes.PerpetualUpdate{
+			{
+				PerpetualId:      perpetualId,
+				BigQuantumsDelta: bigTakerBaseQuantumsDelta,
+			},
+		}
+		updates[1].PerpetualUpdates = []satypes.PerpetualUpdate{
+			{
+				PerpetualId:      perpetualId,
+				BigQuantumsDelta: bigMakerBaseQuantumsDelta,
+			},
+		}
+	}
+
 	// Apply the update.
 	success, successPerUpdate, err := k.subaccountsKeeper.UpdateSubaccounts(
 		ctx,
@@ -429,6 +459,28 @@ func (k Keeper) persistMatchedOrders(
 		)
 	}
 
+	// Track the entry notionals of both perpetual positions for ranking auto-deleveraging.
+	if spotClobMetadata == nil {
+		bigTakerFillValue := new(big.Int).Set(bigFillQuoteQuantums)
+		if bigTakerBaseQuantumsDelta.Sign() < 0 {
+			bigTakerFillValue.Neg(bigTakerFillValue)
+		}
+		k.updatePerpetualPositionEntry(
+			ctx,
+			matchWithOrders.TakerOrder.GetSubaccountId(),
+			perpetualId,
+			bigTakerBaseQuantumsDelta,
+			bigTakerFillValue,
+		)
+		k.updatePerpetualPositionEntry(
+			ctx,
+			matchWithOrders.MakerOrder.GetSubaccountId(),
+			perpetualId,
+			bigMakerBaseQuantumsDelta,
+			new(big.Int).Neg(bigTakerFillValue),
+		)
+	}
+
 	if err := k.subaccountsKeeper.TransferInsuranceFundPayments(ctx, insuranceFundDelta); err != nil {
 		return takerUpdateResult, makerUpdateResult, err
 	}
@@ -469,22 +521,38 @@ func (k Keeper) persistMatchedOrders(
 	)
 
 	// Emit an event indicating a match occurred.
-	ctx.EventManager().EmitEvent(
-		types.NewCreateMatchEvent(
-			matchWithOrders.TakerOrder.GetSubaccountId(),
-			matchWithOrders.MakerOrder.GetSubaccountId(),
-			bigTakerFeeQuoteQuantums,
-			bigMakerFeeQuoteQuantums,
-			bigTakerQuoteBalanceDelta,
-			bigMakerQuoteBalanceDelta,
-			bigTakerPerpetualQuantumsDelta,
-			bigMakerPerpetualQuantumsDelta,
-			insuranceFundDelta,
-			isTakerLiquidation,
-			false,
-			perpetualId,
-		),
-	)
+	if spotClobMetadata != nil {
+		ctx.EventManager().EmitEvent(
+			types.NewCreateSpotMatchEvent(
+				matchWithOrders.TakerOrder.GetSubaccountId(),
+				matchWithOrders.MakerOrder.GetSubaccountId(),
+				bigTakerFeeQuoteQuantums,
+				bigMakerFeeQuoteQuantums,
+				bigTakerQuoteBalanceDelta,
+				bigMakerQuoteBalanceDelta,
+				bigTakerBaseQuantumsDelta,
+				bigMakerBaseQuantumsDelta,
+				spotClobMetadata.BaseAssetId,
+			),
+		)
+	} else {
+		ctx.EventManager().EmitEvent(
+			types.NewCreateMatchEvent(
+				matchWithOrders.TakerOrder.GetSubaccountId(),
+				matchWithOrders.MakerOrder.GetSubaccountId(),
+				bigTakerFeeQuoteQuantums,
+				bigMakerFeeQuoteQuantums,
+				bigTakerQuoteBalanceDelta,
+				bigMakerQuoteBalanceDelta,
+				bigTakerBaseQuantumsDelta,
+				bigMakerBaseQuantumsDelta,
+				insuranceFundDelta,
+				isTakerLiquidation,
+				false,
+				perpetualId,
+			),
+		)
+	}
 
 	return takerUpdateResult, makerUpdateResult, nil
 }
@@ -496,10 +564,23 @@ func (k Keeper) setOrderFillAmountsAndPruning(
 	curPruneableBlockHeight uint32,
 ) *types.OffchainUpdates {
 	// Note that stateful orders are never pruned by `BlockHeight`, so we set the value to `math.MaxUint32` here.
+	// Only Short-Term orders using `GoodTilBlockTime` are pruned by block time.
 	pruneableBlockHeight := uint32(math.MaxUint32)
+	pruneableBlockTime := uint32(0)
 	offchainUpdates := types.NewOffchainUpdates()
 
-	if !order.IsStatefulOrder() {
+	if order.IsGoodTilBlockTimeShortTermOrder() {
+		// Compute the block time at which this state fill amount can be pruned. This is the greater of
+		// `GoodTilBlockTime + ShortTermOrderTimeWindow` and the existing `prunableBlockTime`.
+		curFillState, _ := k.GetOrderFillState(ctx, order.OrderId)
+		pruneableBlockTime = lib.Max(
+			order.GetGoodTilBlockTime()+uint32(types.ShortTermOrderTimeWindow.Seconds()),
+			curFillState.PrunableBlockTime,
+		)
+
+		// Add this order for pruning at the desired block time.
+		k.AddOrdersForPruningByBlockTime(ctx, []types.OrderId{order.OrderId}, pruneableBlockTime)
+	} else if !order.IsStatefulOrder() {
 		// Compute the block at which this state fill amount can be pruned. This is the greater of
 		// `GoodTilBlock + ShortBlockWindow` and the existing `pruneableBlockHeight`.
 		pruneableBlockHeight = lib.Max(
@@ -526,11 +607,14 @@ func (k Keeper) setOrderFillAmountsAndPruning(
 
 	// Update the state with the new `fillAmount` for this `orderId`.
 	// TODO(DEC-1219): Determine whether we should use `OrderFillState` proto for stateful order fill amounts.
-	k.SetOrderFillAmount(
+	k.SetOrderFillState(
 		ctx,
 		order.OrderId,
-		newTotalFillAmount,
-		pruneableBlockHeight,
+		types.OrderFillState{
+			FillAmount:          newTotalFillAmount.ToUint64(),
+			PrunableBlockHeight: pruneableBlockHeight,
+			PrunableBlockTime:   pruneableBlockTime,
+		},
 	)
 
 	if k.GetIndexerEventManager().Enabled() {
@@ -53,7 +53,11 @@ func (k *Keeper) RateLimitPlaceOrder(ctx sdk.Context, msg *types.MsgPlaceOrder)
 	// where short-term order placements with GTBs in the past or the far future could be replayed by an adversary.
 	// Normally transaction replay attacks rely on sequence numbers being part of the signature and being incremented
 	// for each transaction but sequence number verification is skipped for short-term orders.
-	if msg.Order.IsShortTermOrder() {
+	if msg.Order.IsGoodTilBlockTimeShortTermOrder() {
+		if err := k.validateShortTermOrderGoodTilBlockTime(ctx, msg.Order.GetGoodTilBlockTime()); err != nil {
+			return err
+		}
+	} else if msg.Order.IsShortTermOrder() {
 		nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
 		if err := k.validateGoodTilBlock(msg.Order.GetGoodTilBlock(), nextBlockHeight); err != nil {
 			return err
@@ -63,6 +67,67 @@ func (k *Keeper) RateLimitPlaceOrder(ctx sdk.Context, msg *types.MsgPlaceOrder)
 	return k.placeOrderRateLimiter.RateLimit(ctx, msg)
 }
 
+// RateLimitReplaceOrder passes order replacements to `placeOrderRateLimiter`. The replacement is counted as
+// a single order placement. The rate limiting is only performed during `CheckTx` and `ReCheckTx`.
+func (k *Keeper) RateLimitReplaceOrder(ctx sdk.Context, msg *types.MsgReplaceOrder) error {
+	return k.RateLimitPlaceOrder(ctx, types.NewMsgPlaceOrder(msg.Order))
+}
+
+// RateLimitBatchCancel passes batch cancellations to `cancelOrderRateLimiter`. The batch is counted as a
+// single order cancellation. The rate limiting is only performed during `CheckTx` and `ReCheckTx`, and
+// only during `CheckTx` for Short-Term batches.
+func (k *Keeper) RateLimitBatchCancel(ctx sdk.Context, msg *types.MsgBatchCancel) error {
+	// Only rate limit during `CheckTx` and `ReCheckTx`.
+	if lib.IsDeliverTxMode(ctx) {
+		return nil
+	}
+
+	if msg.IsShortTermBatch() {
+		// Ensure that the GTB is valid before we attempt to rate limit. See `RateLimitCancelOrder` for details.
+		nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+		if err := k.validateGoodTilBlock(msg.GetGoodTilBlock(), nextBlockHeight); err != nil {
+			return err
+		}
+
+		// Short-Term batch cancellations are not processed during `ReCheckTx` and are therefore not rate
+		// limited again while they remain in the mempool.
+		if ctx.IsReCheckTx() {
+			return nil
+		}
+	}
+
+	// Note that `ValidateBasic` guarantees that the batch is not empty.
+	return k.cancelOrderRateLimiter.RateLimit(ctx, msg.GetCancelOrderMsgs()[0])
+}
+
+// RateLimitCancelAllOrders passes cancellations of all orders of a subaccount that include Short-Term orders
+// to `cancelOrderRateLimiter`. The message is counted as a single Short-Term order cancellation.
+// The rate limiting is only performed during `CheckTx` and `ReCheckTx`.
+func (k *Keeper) RateLimitCancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders) error {
+	// Only rate limit during `CheckTx` and `ReCheckTx`.
+	if lib.IsDeliverTxMode(ctx) || !msg.CancelsShortTermOrders() {
+		return nil
+	}
+
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+	if err := k.validateGoodTilBlock(msg.GoodTilBlock, nextBlockHeight); err != nil {
+		return err
+	}
+
+	// Short-Term orders are only canceled during `CheckTx`.
+	if ctx.IsReCheckTx() {
+		return nil
+	}
+
+	return k.cancelOrderRateLimiter.RateLimit(
+		ctx,
+		types.NewMsgCancelOrderShortTerm(
+			types.OrderId{SubaccountId: msg.SubaccountId, OrderFlags: types.OrderIdFlags_ShortTerm},
+			msg.GoodTilBlock,
+		),
+	)
+}
+
 func (k *Keeper) PruneRateLimits(ctx sdk.Context) {
 	k.placeOrderRateLimiter.PruneRateLimits(ctx)
 	k.cancelOrderRateLimiter.PruneRateLimits(ctx)
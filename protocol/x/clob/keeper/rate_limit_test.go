@@ -41,3 +41,44 @@ func TestRateLimitCancelOrderIsNoopOutsideOfCheckTxAndReCheckTx(t *testing.T) {
 	// We don't expect any checks from occurring.
 	require.Nil(t, tApp.App.ClobKeeper.RateLimitCancelOrder(deliverTxCtx, msg))
 }
+
+func TestRateLimitBatchCancelIsNoopOutsideOfCheckTxAndReCheckTx(t *testing.T) {
+	tApp := testApp.NewTestAppBuilder(t).Build()
+	checkTxCtx := tApp.AdvanceToBlock(21, testApp.AdvanceToBlockOptions{})
+	deliverTxCtx := checkTxCtx.WithIsCheckTx(false).WithIsReCheckTx(false)
+	msg := clobtypes.NewMsgBatchCancelShortTerm(
+		constants.Alice_Num0,
+		[]clobtypes.OrderBatch{{ClobPairId: 0, ClientIds: []uint32{0, 1}}},
+		20,
+	)
+
+	// We expect an error and that the GTB is out of bounds.
+	require.Error(
+		t,
+		tApp.App.ClobKeeper.RateLimitBatchCancel(checkTxCtx, msg),
+		"GoodTilBlock 20 is less than the current blockHeight 22",
+	)
+
+	// We don't expect any checks from occurring.
+	require.Nil(t, tApp.App.ClobKeeper.RateLimitBatchCancel(deliverTxCtx, msg))
+}
+
+func TestRateLimitCancelAllOrdersIsNoopOutsideOfCheckTxAndReCheckTx(t *testing.T) {
+	tApp := testApp.NewTestAppBuilder(t).Build()
+	checkTxCtx := tApp.AdvanceToBlock(21, testApp.AdvanceToBlockOptions{})
+	deliverTxCtx := checkTxCtx.WithIsCheckTx(false).WithIsReCheckTx(false)
+	msg := &clobtypes.MsgCancelAllOrders{
+		SubaccountId: constants.Alice_Num0,
+		GoodTilBlock: 20,
+	}
+
+	// We expect an error and that the GTB is out of bounds.
+	require.Error(
+		t,
+		tApp.App.ClobKeeper.RateLimitCancelAllOrders(checkTxCtx, msg),
+		"GoodTilBlock 20 is less than the current blockHeight 22",
+	)
+
+	// We don't expect any checks from occurring.
+	require.Nil(t, tApp.App.ClobKeeper.RateLimitCancelAllOrders(deliverTxCtx, msg))
+}
@@ -50,6 +50,11 @@ func (k Keeper) SetLongTermOrderPlacement(
 			TransactionIndex: nextStatefulOrderTransactionIndex,
 		},
 	}
+
+	// Trailing stop orders start trailing from the trigger price they were placed with.
+	if order.IsTrailingStopOrder() {
+		longTermOrderPlacement.TrailingStopTriggerSubticks = order.ConditionalOrderTriggerSubticks
+	}
 	longTermOrderPlacementBytes := k.cdc.MustMarshal(&longTermOrderPlacement)
 
 	// For setting long term order placements, always set conditional orders to the untriggered state store.
@@ -70,6 +75,9 @@ func (k Keeper) SetLongTermOrderPlacement(
 			k.GetStatefulOrderCount(ctx, order.OrderId.SubaccountId)+1,
 		)
 
+		// Add the order to its order group, if any.
+		k.addOrderToOrderGroup(ctx, order)
+
 		telemetry.IncrCounterWithLabels(
 			[]string{types.ModuleName, metrics.StatefulOrder, metrics.Count},
 			1,
@@ -136,8 +144,8 @@ func (k Keeper) GetLongTermOrderPlacement(
 	return val, true
 }
 
-// DeleteLongTermOrderPlacement deletes a long term order and the placement information from state
-// and decrements the stateful order count if the `orderId` exists.
+// DeleteLongTermOrderPlacement deletes a long term order and the placement information from state,
+// decrements the stateful order count and removes the order from its order group if the `orderId` exists.
 // This function is a no-op if no stateful order exists in state with `orderId`.
 func (k Keeper) DeleteLongTermOrderPlacement(
 	ctx sdk.Context,
@@ -152,7 +160,11 @@ func (k Keeper) DeleteLongTermOrderPlacement(
 	// same regardless of whether the memstore has the order or not.
 	count := k.GetStatefulOrderCount(ctx, orderId.SubaccountId)
 	orderKey := orderId.ToStateKey()
-	if memStore.Has(orderKey) {
+	if b := memStore.Get(orderKey); b != nil {
+		var longTermOrderPlacement types.LongTermOrderPlacement
+		k.cdc.MustUnmarshal(b, &longTermOrderPlacement)
+		k.removeOrderFromOrderGroup(ctx, longTermOrderPlacement.Order)
+
 		if count == 0 {
 			k.Logger(ctx).Error(
 				"Stateful order count is zero but order is in the memstore. Underflow",
@@ -283,6 +295,40 @@ func (k Keeper) MustTriggerConditionalOrder(
 	)
 }
 
+// MustSetTrailingStopTriggerSubticks updates the current trigger price of an untriggered trailing stop
+// order in state. The order must already exist in untriggered state, or else this function will panic.
+func (k Keeper) MustSetTrailingStopTriggerSubticks(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	triggerSubticks types.Subticks,
+) {
+	longTermOrderPlacement, exists := k.GetUntriggeredConditionalOrderPlacement(ctx, orderId)
+	if !exists {
+		panic(
+			fmt.Sprintf(
+				"MustSetTrailingStopTriggerSubticks: conditional order Id does not exist in Untriggered state: %+v",
+				orderId,
+			),
+		)
+	}
+	if !longTermOrderPlacement.Order.IsTrailingStopOrder() {
+		panic(
+			fmt.Sprintf(
+				"MustSetTrailingStopTriggerSubticks: order is not a trailing stop order: %+v",
+				longTermOrderPlacement.Order,
+			),
+		)
+	}
+
+	longTermOrderPlacement.TrailingStopTriggerSubticks = triggerSubticks.ToUint64()
+
+	// Write the updated `LongTermOrderPlacement` to the Untriggered state store/memstore.
+	longTermOrderPlacementBytes := k.cdc.MustMarshal(&longTermOrderPlacement)
+	orderKey := orderId.ToStateKey()
+	k.GetUntriggeredConditionalOrderPlacementStore(ctx).Set(orderKey, longTermOrderPlacementBytes)
+	k.GetUntriggeredConditionalOrderPlacementMemStore(ctx).Set(orderKey, longTermOrderPlacementBytes)
+}
+
 // MustAddOrderToStatefulOrdersTimeSlice adds a new `OrderId` to an existing time slice, or creates a new time slice
 // containing the `OrderId` and writes it to state. It first sorts all order IDs before writing them
 // to state to avoid non-determinism issues.
@@ -316,11 +362,22 @@ func (k Keeper) MustAddOrderToStatefulOrdersTimeSlice(
 
 // MustRemoveStatefulOrder removes an order by `OrderId` from an existing time slice. If the time slice is empty
 // after removing the `OrderId`, then the time slice is pruned from state. For the `OrderId` which is removed,
-// this method also calls `DeleteStatefulOrderPlacement` to remove the order placement from state.
+// this method also calls `DeleteStatefulOrderPlacement` to remove the order placement from state. If the order
+// is part of an order group, the other orders of the group are removed as described by `RemoveOrderGroupSiblings`.
 func (k Keeper) MustRemoveStatefulOrder(
 	ctx sdk.Context,
 	orderId types.OrderId,
 ) {
+	order, fullyFilled := k.mustRemoveStatefulOrder(ctx, orderId)
+	k.RemoveOrderGroupSiblings(ctx, order, fullyFilled)
+}
+
+// mustRemoveStatefulOrder removes an order by `OrderId` from state like `MustRemoveStatefulOrder`, without
+// removing the other orders of its order group. It returns the removed order and whether it was fully filled.
+func (k Keeper) mustRemoveStatefulOrder(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) (order types.Order, fullyFilled bool) {
 	// If this is a Short-Term order, panic.
 	orderId.MustBeStatefulOrder()
 
@@ -328,6 +385,7 @@ func (k Keeper) MustRemoveStatefulOrder(
 	if !exists {
 		panic(fmt.Sprintf("MustRemoveStatefulOrder: order %v does not exist", orderId))
 	}
+	order = longTermOrderPlacement.Order
 
 	goodTilBlockTime := longTermOrderPlacement.Order.MustGetUnixGoodTilBlockTime()
 	longTermOrdersExpiringAtTime := k.GetStatefulOrdersTimeSlice(ctx, goodTilBlockTime)
@@ -360,11 +418,19 @@ func (k Keeper) MustRemoveStatefulOrder(
 		k.setStatefulOrdersTimeSliceInState(ctx, goodTilBlockTime, updatedStatefulOrdersExpiringAtTime)
 	}
 
+	// Whether the order was fully filled is only needed to remove the other orders of its order group.
+	if order.OrderGroup != nil {
+		_, fillAmount, _ := k.GetOrderFillAmount(ctx, orderId)
+		fullyFilled = fillAmount == order.GetBaseQuantums()
+	}
+
 	// Remove the order fill amount from state.
 	k.RemoveOrderFillAmount(ctx, orderId)
 
 	// Delete the Stateful order placement from state.
 	k.DeleteLongTermOrderPlacement(ctx, orderId)
+
+	return order, fullyFilled
 }
 
 // IsConditionalOrderTriggered checks if a given order ID is triggered or untriggered in state.
@@ -424,6 +490,38 @@ func (k Keeper) GetAllUntriggeredConditionalOrders(ctx sdk.Context) []types.Orde
 	return k.getStatefulOrders(k.getUntriggeredConditionalOrdersIterator(ctx))
 }
 
+// GetSubaccountStatefulOrders returns all Long-Term orders and triggered and untriggered conditional
+// orders of a subaccount, ordered by ascending time priority.
+func (k Keeper) GetSubaccountStatefulOrders(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) []types.Order {
+	subaccountKeyPrefix := types.OrderIdKeyPrefixForSubaccount(subaccountId)
+
+	statefulOrderPlacements := make([]types.LongTermOrderPlacement, 0)
+	for _, keyPrefix := range []string{
+		types.LongTermOrderPlacementKeyPrefix,
+		types.TriggeredConditionalOrderKeyPrefix,
+		types.UntriggeredConditionalOrderKeyPrefix,
+	} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+		iterator := sdk.KVStorePrefixIterator(store, subaccountKeyPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			statefulOrderPlacement := types.LongTermOrderPlacement{}
+			k.cdc.MustUnmarshal(iterator.Value(), &statefulOrderPlacement)
+			statefulOrderPlacements = append(statefulOrderPlacements, statefulOrderPlacement)
+		}
+		iterator.Close()
+	}
+
+	sort.Sort(types.SortedLongTermOrderPlacements(statefulOrderPlacements))
+	sortedOrders := make([]types.Order, 0, len(statefulOrderPlacements))
+	for _, orderPlacement := range statefulOrderPlacements {
+		sortedOrders = append(sortedOrders, orderPlacement.Order)
+	}
+	return sortedOrders
+}
+
 // getStatefulOrders takes an iterator and iterates over all stateful order placements in state.
 // It returns a list of stateful order placements ordered by ascending time priority. Note this
 // function handles closing the iterator.
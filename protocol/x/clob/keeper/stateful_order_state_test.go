@@ -1698,3 +1698,72 @@ func TestGetAllStatefulOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSubaccountStatefulOrders(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	require.Empty(t, ks.ClobKeeper.GetSubaccountStatefulOrders(ks.Ctx, constants.Alice_Num0))
+
+	statefulOrderPlacements := []types.LongTermOrderPlacement{
+		{
+			Order: constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 8,
+			},
+		},
+		{
+			Order: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 4,
+			},
+		},
+		{
+			Order: constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Buy25_Price25_GTBT15_StopLoss25,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 6,
+			},
+		},
+		{
+			Order: constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTB15,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 2,
+			},
+		},
+		{
+			Order: constants.ConditionalOrder_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 2,
+			},
+		},
+	}
+	for _, statefulOrderPlacement := range statefulOrderPlacements {
+		ks.ClobKeeper.SetLongTermOrderPlacement(
+			ks.Ctx,
+			statefulOrderPlacement.Order,
+			statefulOrderPlacement.PlacementIndex.BlockHeight,
+		)
+	}
+	ks.ClobKeeper.MustTriggerConditionalOrder(
+		ks.Ctx.WithBlockHeight(4),
+		constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20.OrderId,
+	)
+
+	// Long-Term orders and triggered and untriggered conditional orders of the subaccount are returned in
+	// ascending time priority, and orders of other subaccounts of the same owner are not returned.
+	require.Equal(
+		t,
+		[]types.Order{
+			constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Buy25_Price25_GTBT15_StopLoss25,
+			constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25,
+		},
+		ks.ClobKeeper.GetSubaccountStatefulOrders(ks.Ctx, constants.Alice_Num0),
+	)
+	require.Equal(
+		t,
+		[]types.Order{constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTB15},
+		ks.ClobKeeper.GetSubaccountStatefulOrders(ks.Ctx, constants.Alice_Num1),
+	)
+	require.Empty(t, ks.ClobKeeper.GetSubaccountStatefulOrders(ks.Ctx, constants.Bob_Num0))
+}
@@ -112,6 +112,33 @@ func (k Keeper) getStatefulOrdersTimeSliceStore(ctx sdk.Context) prefix.Store {
 	)
 }
 
+// getOrderGroupStore fetches a state store used for creating,
+// reading, updating, and deleting order groups from state.
+func (k Keeper) getOrderGroupStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.OrderGroupKeyPrefix),
+	)
+}
+
+// getPerpetualPositionEntryStore fetches a state store used for creating,
+// reading, updating, and deleting perpetual position entry notionals from state.
+func (k Keeper) getPerpetualPositionEntryStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.PerpetualPositionEntryKeyPrefix),
+	)
+}
+
+// getPendingFinalSettlementStore fetches a state store used for creating,
+// reading, updating, and deleting pending final settlements from state.
+func (k Keeper) getPendingFinalSettlementStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.PendingFinalSettlementKeyPrefix),
+	)
+}
+
 // getTransientStore fetches a transient store used for reading and
 // updating the transient store.
 func (k Keeper) getTransientStore(ctx sdk.Context) sdk.KVStore {
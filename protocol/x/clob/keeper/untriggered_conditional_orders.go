@@ -16,16 +16,17 @@ import (
 // conditional orders on oracle price changes for a given ClobPairId.
 // All orders contained in this data structure are placed conditional orders with the same
 // ClobPairId and are untriggered, unexpired, and uncancelled.
+// Trailing stop orders are stored with `ConditionalOrderTriggerSubticks` set to their current trigger price.
 // Note that we are using a Order list for the initial implementation, but for
 // optimal runtime a an AVL-tree backed priority queue would work.
 // TODO(CLOB-717) Change list to use priority queue.
 type UntriggeredConditionalOrders struct {
-	// All untriggered take profit buy orders and stop loss sell orders sorted by time priority.
+	// All untriggered take profit buy orders and stop loss and trailing stop sell orders sorted by time priority.
 	// These orders will be triggered when the oracle price goes lower than or equal to the trigger price.
 	// This array functions like a max heap.
 	OrdersToTriggerWhenOraclePriceLTETriggerPrice []types.Order
 
-	// All untriggered take profit sell orders and stop loss buy orders sorted by time priority.
+	// All untriggered take profit sell orders and stop loss and trailing stop buy orders sorted by time priority.
 	// These orders will be triggered when the oracle price goes greater than or equal to the trigger price.
 	// This array functions like a min heap.
 	OrdersToTriggerWhenOraclePriceGTETriggerPrice []types.Order
@@ -49,18 +50,20 @@ func (untriggeredOrders *UntriggeredConditionalOrders) IsEmpty() bool {
 }
 
 // AddUntriggeredConditionalOrders takes in a list of newly-placed conditional order ids and adds them
-// to the in-memory UntriggeredConditionalOrders struct, filtering out orders that have been cancelled
-// or expired in the last block. This function is used in EndBlocker and on application startup.
+// to the in-memory UntriggeredConditionalOrders struct, filtering out orders that have been cancelled,
+// expired or removed in the last block. This function is used in EndBlocker and on application startup.
 func (k Keeper) AddUntriggeredConditionalOrders(
 	ctx sdk.Context,
 	placedConditionalOrderIds []types.OrderId,
 	placedStatefulCancellationOrderIds map[types.OrderId]struct{},
 	expiredStatefulOrderIdsSet map[types.OrderId]struct{},
+	removedStatefulOrderIdsSet map[types.OrderId]struct{},
 ) {
 	for _, orderId := range placedConditionalOrderIds {
 		_, isCancelled := placedStatefulCancellationOrderIds[orderId]
 		_, isExpired := expiredStatefulOrderIdsSet[orderId]
-		if isCancelled || isExpired {
+		_, isRemoved := removedStatefulOrderIdsSet[orderId]
+		if isCancelled || isExpired || isRemoved {
 			continue
 		}
 
@@ -81,7 +84,11 @@ func (k Keeper) AddUntriggeredConditionalOrders(
 			untriggeredConditionalOrders = k.NewUntriggeredConditionalOrders()
 			k.UntriggeredConditionalOrders[clobPairId] = untriggeredConditionalOrders
 		}
-		untriggeredConditionalOrders.AddUntriggeredConditionalOrder(orderPlacement.GetOrder())
+		order := orderPlacement.GetOrder()
+		if order.IsTrailingStopOrder() {
+			order.ConditionalOrderTriggerSubticks = orderPlacement.TrailingStopTriggerSubticks
+		}
+		untriggeredConditionalOrders.AddUntriggeredConditionalOrder(order)
 	}
 }
 
@@ -104,7 +111,7 @@ func (untriggeredOrders *UntriggeredConditionalOrders) AddUntriggeredConditional
 		}
 	}
 
-	if order.IsStopLossOrder() {
+	if order.IsStopLossOrder() || order.IsTrailingStopOrder() {
 		if order.IsBuy() {
 			untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice = append(
 				untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
@@ -119,13 +126,14 @@ func (untriggeredOrders *UntriggeredConditionalOrders) AddUntriggeredConditional
 	}
 }
 
-// PruneUntriggeredConditionalOrders takes in lists of expired and cancelled stateful order ids and removes
-// all respective orders from the in-memory `UntriggeredConditionalOrders` data structure. This data structure
+// PruneUntriggeredConditionalOrders takes in lists of expired, cancelled and removed stateful order ids and
+// removes all respective orders from the in-memory `UntriggeredConditionalOrders` data structure. This data structure
 // stores untriggered orders in a map of ClobPairId -> []Order, so we first group orders by ClobPairId and then
 // call `UntriggeredConditionalOrders.RemoveExpiredUntriggeredConditionalOrders` on each ClobPairId.
 func (k Keeper) PruneUntriggeredConditionalOrders(
 	expiredStatefulOrderIds []types.OrderId,
 	cancelledStatefulOrderIds []types.OrderId,
+	removedStatefulOrderIds []types.OrderId,
 ) {
 	// Merge lists of order ids.
 	orderIdsToPrune := lib.UniqueSliceToSet(expiredStatefulOrderIds)
@@ -140,6 +148,10 @@ func (k Keeper) PruneUntriggeredConditionalOrders(
 		}
 		orderIdsToPrune[orderId] = struct{}{}
 	}
+	// Removed orders are no longer in state, so they cannot have been cancelled or expired afterwards.
+	for _, orderId := range removedStatefulOrderIds {
+		orderIdsToPrune[orderId] = struct{}{}
+	}
 
 	prunableUntriggeredConditionalOrderIdsByClobPair := make(map[types.ClobPairId][]types.OrderId)
 	for orderId := range orderIdsToPrune {
@@ -215,6 +227,36 @@ func (untriggeredOrders *UntriggeredConditionalOrders) RemoveUntriggeredConditio
 	untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice = newOrdersToTriggerWhenOraclePriceGTETriggerPrice
 }
 
+// UpdateTrailingStopTriggerSubticks moves the trigger price of all untriggered trailing stop orders
+// with a new oracle price for a clobPairId. It returns the updated orders in a deterministic order,
+// with `ConditionalOrderTriggerSubticks` set to their new trigger price. This is only called in EndBlocker.
+func (untriggeredOrders *UntriggeredConditionalOrders) UpdateTrailingStopTriggerSubticks(
+	oraclePriceSubticksRat *big.Rat,
+	subticksPerTick types.SubticksPerTick,
+) []types.Order {
+	updatedOrders := make([]types.Order, 0)
+	for _, orders := range [][]types.Order{
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+	} {
+		for i := range orders {
+			if !orders[i].IsTrailingStopOrder() {
+				continue
+			}
+
+			triggerSubticks, updated := orders[i].GetUpdatedTrailingStopTriggerSubticks(
+				oraclePriceSubticksRat,
+				subticksPerTick,
+			)
+			if updated {
+				orders[i].ConditionalOrderTriggerSubticks = triggerSubticks.ToUint64()
+				updatedOrders = append(updatedOrders, orders[i])
+			}
+		}
+	}
+	return updatedOrders
+}
+
 // PollTriggeredConditionalOrders removes all triggered conditional orders from the
 // `UntriggeredConditionalOrders` struct given a new oracle price for a clobPairId. It returns
 // a list of order ids that were triggered. This is only called in EndBlocker. We round up to the nearest
@@ -264,6 +306,8 @@ func (untriggeredOrders *UntriggeredConditionalOrders) PollTriggeredConditionalO
 // any conditional orders in `UntriggeredConditionalOrders` that can be triggered. For each triggered
 // order, it takes the stateful order placement stored in Untriggered state and moves it to Triggered state.
 // A conditional order trigger event is emitted for each triggered order.
+// Triggering an order removes the other orders of its order group from state and from
+// `UntriggeredConditionalOrders`, see `RemoveOrderGroupSiblings`.
 // Function returns a sorted list of conditional order ids that were triggered, intended to be written
 // to `ProcessProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock`.
 // This function is called in EndBlocker.
@@ -287,6 +331,29 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditi
 			)
 		}
 		currentOraclePriceSubticksRat := k.GetOraclePriceSubticksRat(ctx, clobPair)
+
+		// Move the trigger price of trailing stop orders with the oracle price before polling, and persist
+		// the new trigger prices so they survive restarts.
+		updatedTrailingStopOrders := untriggeredConditionalOrders.UpdateTrailingStopTriggerSubticks(
+			currentOraclePriceSubticksRat,
+			clobPair.GetClobPairSubticksPerTick(),
+		)
+		for _, order := range updatedTrailingStopOrders {
+			triggerSubticks := types.Subticks(order.ConditionalOrderTriggerSubticks)
+			k.MustSetTrailingStopTriggerSubticks(ctx, order.OrderId, triggerSubticks)
+			k.GetIndexerEventManager().AddTxnEvent(
+				ctx,
+				indexerevents.SubtypeStatefulOrder,
+				indexerevents.StatefulOrderEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewConditionalOrderTriggerUpdatedEvent(
+						order.OrderId,
+						triggerSubticks,
+					),
+				),
+			)
+		}
+
 		triggeredOrderIds := untriggeredConditionalOrders.PollTriggeredConditionalOrders(
 			currentOraclePriceSubticksRat,
 		)
@@ -297,7 +364,15 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditi
 
 	// State write - move the conditional order placement in state from untriggered to triggered state.
 	// Emit an event for each triggered conditional order.
-	for _, triggeredConditionalOrderId := range triggeredConditionalOrderIds {
+	polledConditionalOrderIds := triggeredConditionalOrderIds
+	triggeredConditionalOrderIds = make([]types.OrderId, 0, len(polledConditionalOrderIds))
+	for _, triggeredConditionalOrderId := range polledConditionalOrderIds {
+		// Skip orders that were removed from state by an order group order triggered before them.
+		orderPlacement, exists := k.GetUntriggeredConditionalOrderPlacement(ctx, triggeredConditionalOrderId)
+		if !exists {
+			continue
+		}
+
 		k.MustTriggerConditionalOrder(
 			ctx,
 			triggeredConditionalOrderId,
@@ -312,6 +387,10 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (triggeredConditi
 				),
 			),
 		)
+		triggeredConditionalOrderIds = append(triggeredConditionalOrderIds, triggeredConditionalOrderId)
+
+		removedOrderIds := k.RemoveOrderGroupSiblings(ctx, orderPlacement.Order, true)
+		k.PruneUntriggeredConditionalOrders([]types.OrderId{}, []types.OrderId{}, removedOrderIds)
 	}
 	return triggeredConditionalOrderIds
 }
@@ -66,6 +66,28 @@ func TestAddUntriggeredConditionalOrder(t *testing.T) {
 			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{},
 			expectedNumberOfMatches:                               1,
 		},
+		"Can add a trailing stop sell to the LTE array": {
+			conditionalOrdersToAdd: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5,
+			},
+
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{},
+			expectedNumberOfMatches:                               1,
+		},
+		"Can add a trailing stop buy to the GTE array": {
+			conditionalOrdersToAdd: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price30_GTBT15_TrailingStop20_Trail10Percent,
+			},
+
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price30_GTBT15_TrailingStop20_Trail10Percent,
+			},
+			expectedNumberOfMatches: 1,
+		},
 		"Can add multiple conditional orders to both heaps": {
 			conditionalOrdersToAdd: []types.Order{
 				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20,
@@ -437,3 +459,130 @@ func TestPollTriggeredConditionalOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateTrailingStopTriggerSubticks(t *testing.T) {
+	sellTrailingStop := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5
+	buyTrailingStop := constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price30_GTBT15_TrailingStop20_Trail10Percent
+	withTrigger := func(order types.Order, triggerSubticks uint64) types.Order {
+		order.ConditionalOrderTriggerSubticks = triggerSubticks
+		return order
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		conditionalOrdersToAdd []types.Order
+		currentSubticks        *big.Rat
+		subticksPerTick        types.SubticksPerTick
+
+		// Expectations.
+		expectedUpdatedOrders                                 []types.Order
+		expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice []types.Order
+		expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice []types.Order
+	}{
+		"No trailing stops updated when the oracle price moves against them": {
+			conditionalOrdersToAdd: []types.Order{
+				sellTrailingStop,
+				buyTrailingStop,
+			},
+			currentSubticks:       big.NewRat(20, 1),
+			subticksPerTick:       1,
+			expectedUpdatedOrders: []types.Order{},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				sellTrailingStop,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				buyTrailingStop,
+			},
+		},
+		"Sell trailing stop trigger price moves up with the oracle price": {
+			conditionalOrdersToAdd: []types.Order{
+				sellTrailingStop,
+				buyTrailingStop,
+			},
+			currentSubticks: big.NewRat(30, 1),
+			subticksPerTick: 1,
+			expectedUpdatedOrders: []types.Order{
+				withTrigger(sellTrailingStop, 25),
+			},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				withTrigger(sellTrailingStop, 25),
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				buyTrailingStop,
+			},
+		},
+		"Buy trailing stop trigger price moves down with the oracle price and rounds up": {
+			conditionalOrdersToAdd: []types.Order{
+				sellTrailingStop,
+				buyTrailingStop,
+			},
+			currentSubticks: big.NewRat(15, 1), // 16.5 will round up to 17
+			subticksPerTick: 1,
+			expectedUpdatedOrders: []types.Order{
+				withTrigger(buyTrailingStop, 17),
+			},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				sellTrailingStop,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				withTrigger(buyTrailingStop, 17),
+			},
+		},
+		"Trigger price is rounded to a multiple of subticks per tick": {
+			conditionalOrdersToAdd: []types.Order{
+				sellTrailingStop,
+			},
+			currentSubticks: big.NewRat(34, 1), // 29 will round down to 25
+			subticksPerTick: 5,
+			expectedUpdatedOrders: []types.Order{
+				withTrigger(sellTrailingStop, 25),
+			},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				withTrigger(sellTrailingStop, 25),
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{},
+		},
+		"Non-trailing stop orders are not updated": {
+			conditionalOrdersToAdd: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20,
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			},
+			currentSubticks:       big.NewRat(100, 1),
+			subticksPerTick:       1,
+			expectedUpdatedOrders: []types.Order{},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			untriggeredConditionalOrders := keeper.NewUntriggeredConditionalOrders()
+
+			for _, order := range tc.conditionalOrdersToAdd {
+				untriggeredConditionalOrders.AddUntriggeredConditionalOrder(order)
+			}
+
+			updatedOrders := untriggeredConditionalOrders.UpdateTrailingStopTriggerSubticks(
+				tc.currentSubticks,
+				tc.subticksPerTick,
+			)
+
+			require.Equal(t, tc.expectedUpdatedOrders, updatedOrders)
+			require.Equal(
+				t,
+				tc.expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice,
+				untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+			)
+			require.Equal(
+				t,
+				tc.expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice,
+				untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+			)
+		})
+	}
+}
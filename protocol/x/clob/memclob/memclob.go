@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math/big"
 	"runtime/debug"
+	"slices"
+	"sort"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,7 +16,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
 	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -104,6 +108,7 @@ func (m *MemClobPriceTimePriority) CancelOrder(
 
 	// If there exists a resting order on the book with a `GoodTilBlock` not-greater-than that of
 	// the short-term cancel, remove the order and add the order cancellation to the operations queue if necessary.
+	// Note that Short-Term orders using `GoodTilBlockTime` have a `GoodTilBlock` of zero and are always removed.
 	// TODO(DEC-824): Perform correct cancellation validation of stateful orders.
 	if levelOrder, orderExists := m.openOrders.orderIdToLevelOrder[orderIdToCancel]; orderExists &&
 		goodTilBlock >= levelOrder.Value.Order.GetGoodTilBlock() {
@@ -125,8 +130,8 @@ func (m *MemClobPriceTimePriority) CancelOrder(
 		if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 			m.clobKeeper.Logger(ctx),
 			orderIdToCancel,
-			indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
-			off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+			sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+			ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 		); success {
 			offchainUpdates.AddRemoveMessage(orderIdToCancel, message)
 		}
@@ -170,10 +175,14 @@ func (m *MemClobPriceTimePriority) CountSubaccountShortTermOrders(
 }
 
 // GetOrder gets an order by ID and returns it.
+// This method may be called concurrently with ABCI methods.
 func (m *MemClobPriceTimePriority) GetOrder(
 	ctx sdk.Context,
 	orderId types.OrderId,
 ) (order types.Order, found bool) {
+	m.openOrders.mtx.RLock()
+	defer m.openOrders.mtx.RUnlock()
+
 	return m.openOrders.getOrder(ctx, orderId)
 }
 
@@ -215,6 +224,141 @@ func (m *MemClobPriceTimePriority) GetSubaccountOrders(
 	)
 }
 
+// GetOrderbookLevels returns the price levels of the orderbook for `clobPairId`, sorted from the best
+// to the worst price on each side. If `depth` is non-zero, at most `depth` levels are returned per side.
+// The size of each level is the sum of the remaining amounts of the orders resting at that level.
+// This method may be called concurrently with ABCI methods.
+func (m *MemClobPriceTimePriority) GetOrderbookLevels(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	depth uint32,
+) (bids []types.OrderbookLevel, asks []types.OrderbookLevel, err error) {
+	m.openOrders.mtx.RLock()
+	defer m.openOrders.mtx.RUnlock()
+
+	orderbook, exists := m.openOrders.orderbooksMap[clobPairId]
+	if !exists {
+		return nil, nil, errorsmod.Wrapf(
+			types.ErrInvalidClob,
+			"Invalid ClobPair ID: %d",
+			clobPairId,
+		)
+	}
+
+	return m.getOrderbookSideLevels(ctx, orderbook, true, depth),
+		m.getOrderbookSideLevels(ctx, orderbook, false, depth),
+		nil
+}
+
+// getOrderbookSideLevels returns the price levels on one side of the orderbook, sorted from the best
+// to the worst price. If `depth` is non-zero, at most `depth` levels are returned.
+func (m *MemClobPriceTimePriority) getOrderbookSideLevels(
+	ctx sdk.Context,
+	orderbook *types.Orderbook,
+	isBuy bool,
+	depth uint32,
+) []types.OrderbookLevel {
+	levels := orderbook.GetSide(isBuy)
+	sortedSubticks := getSortedLevelSubticks(levels, isBuy)
+	if depth != 0 && int(depth) < len(sortedSubticks) {
+		sortedSubticks = sortedSubticks[:depth]
+	}
+
+	orderbookLevels := make([]types.OrderbookLevel, 0, len(sortedSubticks))
+	for _, subticks := range sortedSubticks {
+		orderbookLevel := types.OrderbookLevel{
+			Subticks: subticks.ToUint64(),
+		}
+		for levelOrder := levels[subticks].LevelOrders.Front; levelOrder != nil; levelOrder = levelOrder.Next {
+			remainingAmount, _ := m.GetOrderRemainingAmount(ctx, levelOrder.Value.Order)
+			orderbookLevel.Quantums += remainingAmount.ToUint64()
+			orderbookLevel.NumOrders++
+		}
+		orderbookLevels = append(orderbookLevels, orderbookLevel)
+	}
+	return orderbookLevels
+}
+
+// getSortedLevelSubticks returns the prices of the levels on one side of the orderbook, sorted from the
+// best to the worst price.
+func getSortedLevelSubticks(levels map[types.Subticks]*types.Level, isBuy bool) []types.Subticks {
+	sortedSubticks := lib.GetSortedKeys[lib.Sortable[types.Subticks]](levels)
+	if isBuy {
+		slices.Reverse(sortedSubticks)
+	}
+	return sortedSubticks
+}
+
+// GetSubaccountOpenOrders returns all orders of a subaccount resting on any orderbook, sorted by order ID.
+// This method may be called concurrently with ABCI methods.
+func (m *MemClobPriceTimePriority) GetSubaccountOpenOrders(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) []types.Order {
+	m.openOrders.mtx.RLock()
+	defer m.openOrders.mtx.RUnlock()
+
+	orderIds := make([]types.OrderId, 0)
+	for _, orderbook := range m.openOrders.orderbooksMap {
+		for _, openOrdersOnSide := range orderbook.SubaccountOpenClobOrders[subaccountId] {
+			for orderId := range openOrdersOnSide {
+				orderIds = append(orderIds, orderId)
+			}
+		}
+	}
+	sort.Sort(types.SortedOrders(orderIds))
+
+	openOrders := make([]types.Order, 0, len(orderIds))
+	for _, orderId := range orderIds {
+		order, found := m.openOrders.getOrder(ctx, orderId)
+		if !found {
+			panic("Open subaccount order does not exist in memclob")
+		}
+		openOrders = append(openOrders, order)
+	}
+	return openOrders
+}
+
+// GetOffchainUpdatesForOrderbookSnapshot returns the off-chain updates that recreate the orderbook of a
+// CLOB pair: a place message followed by an update message with the filled amount for each resting order.
+// Orders are ordered from the best to the worst price, then by time priority, with bids before asks.
+func (m *MemClobPriceTimePriority) GetOffchainUpdatesForOrderbookSnapshot(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (offchainUpdates *types.OffchainUpdates) {
+	m.openOrders.mtx.RLock()
+	defer m.openOrders.mtx.RUnlock()
+
+	offchainUpdates = types.NewOffchainUpdates()
+	orderbook, exists := m.openOrders.orderbooksMap[clobPairId]
+	if !exists {
+		return offchainUpdates
+	}
+
+	for _, isBuy := range []bool{true, false} {
+		levels := orderbook.GetSide(isBuy)
+		for _, subticks := range getSortedLevelSubticks(levels, isBuy) {
+			for levelOrder := levels[subticks].LevelOrders.Front; levelOrder != nil; levelOrder = levelOrder.Next {
+				order := levelOrder.Value.Order
+				if message, success := off_chain_updates.CreateOrderPlaceMessage(
+					m.clobKeeper.Logger(ctx),
+					order,
+				); success {
+					offchainUpdates.AddPlaceMessage(order.OrderId, message)
+				}
+				if message, success := off_chain_updates.CreateOrderUpdateMessage(
+					m.clobKeeper.Logger(ctx),
+					order.OrderId,
+					m.GetOrderFilledAmount(ctx, order.OrderId),
+				); success {
+					offchainUpdates.AddUpdateMessage(order.OrderId, message)
+				}
+			}
+		}
+	}
+	return offchainUpdates
+}
+
 // mustUpdateMemclobStateWithMatches updates the memclob state by applying matches to all bookkeeping data structures.
 // Namely, it will perform the following operations:
 //   - Append all newly-matched orders to the operations queue, along with all new matches.
@@ -336,7 +480,8 @@ func (m *MemClobPriceTimePriority) mustUpdateMemclobStateWithMatches(
 			m.operationsToPropose.MustAddStatefulOrderPlacementToOperationsQueue(
 				taker,
 			)
-		} else {
+		} else if !m.operationsToPropose.IsOrderPlacementInOperationsQueue(taker) {
+			// Note that atomic replacement orders are added to the operations queue in `ReplaceOrder`.
 			m.operationsToPropose.MustAddShortTermOrderTxBytes(
 				taker,
 				ctx.TxBytes(),
@@ -406,10 +551,141 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	orderStatus types.OrderStatus,
 	offchainUpdates *types.OffchainUpdates,
 	err error,
+) {
+	return m.placeOrder(ctx, order, false)
+}
+
+// ReplaceOrder will atomically replace the Short-Term order resting on the orderbook with the same `OrderId` as
+// the order of `msgReplaceOrder`. The replacement order may only differ from the existing order in size and price.
+// An error is returned if no such order rests on the orderbook.
+//
+// The existing order placement (if it is not already in the operations queue) and the order replacement are added
+// to the operations queue, so that the replacement can be validated against the order it replaces in `DeliverTx`.
+//
+// If the replacement order only decreases the size of the existing order, the existing order is updated in place
+// and keeps its time priority. Otherwise, the existing order is removed from the orderbook and the replacement
+// order is matched and added to the orderbook the same way as in `PlaceOrder`.
+//
+// Note that a single `OrderReplace` off-chain update is sent for the replacement order, instead of the
+// `OrderRemove` and `OrderPlace` off-chain updates that are sent when replacing an order through `PlaceOrder`.
+func (m *MemClobPriceTimePriority) ReplaceOrder(
+	ctx sdk.Context,
+	msgReplaceOrder *types.MsgReplaceOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	order := msgReplaceOrder.Order
+	existingOrder, found := m.openOrders.getOrder(ctx, order.OrderId)
+	if !found {
+		return 0, 0, types.NewOffchainUpdates(), errorsmod.Wrapf(
+			types.ErrReplacedOrderDoesNotExist,
+			"Order: %s",
+			order.GetOrderTextString(),
+		)
+	}
+
+	if err := msgReplaceOrder.ValidateReplacementOf(existingOrder); err != nil {
+		return 0, 0, types.NewOffchainUpdates(), err
+	}
+
+	// If an order with the same `OrderId` was matched, the replacement order must also be a valid
+	// replacement of it since both order placements can be included in the operations queue.
+	if matchedOrder, exists := m.operationsToPropose.MatchedOrderIdToOrder[order.OrderId]; exists {
+		if err := msgReplaceOrder.ValidateReplacementOf(matchedOrder); err != nil {
+			return 0, 0, types.NewOffchainUpdates(), err
+		}
+	}
+
+	// An order which was already placed or replaced in the operations queue cannot be placed again.
+	if m.operationsToPropose.IsOrderPlacementInOperationsQueue(order) {
+		return 0, 0, types.NewOffchainUpdates(), errorsmod.Wrapf(
+			types.ErrInvalidReplaceOrder,
+			"Order was already placed in the operations queue: %s",
+			order.GetOrderTextString(),
+		)
+	}
+
+	// Validate the order and return an error if any validation fails.
+	if err := m.validateNewOrder(ctx, order, true); err != nil {
+		return 0, 0, types.NewOffchainUpdates(), err
+	}
+
+	// Add the existing order placement followed by the order replacement to the operations queue.
+	if !m.operationsToPropose.IsOrderPlacementInOperationsQueue(existingOrder) {
+		m.operationsToPropose.MustAddShortTermOrderPlacementToOperationsQueue(existingOrder)
+	}
+	m.operationsToPropose.MustAddShortTermOrderTxBytes(
+		order,
+		ctx.TxBytes(),
+	)
+	m.operationsToPropose.MustAddShortTermOrderReplacementToOperationsQueue(existingOrder, order)
+
+	if msgReplaceOrder.IsSizeOnlyDecrease(existingOrder) {
+		return m.replaceOrderInPlace(ctx, order)
+	}
+
+	return m.placeOrder(ctx, order, true)
+}
+
+// replaceOrderInPlace replaces the order resting on the orderbook with `order`, keeping its position in the
+// price level. This function assumes that `order` was validated in `ReplaceOrder` and only decreases the size
+// of the resting order, and therefore that it cannot be matched and does not need to pass the add-to-orderbook
+// collateralization check.
+func (m *MemClobPriceTimePriority) replaceOrderInPlace(
+	ctx sdk.Context,
+	order types.Order,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	offchainUpdates = types.NewOffchainUpdates()
+
+	m.openOrders.mustReplaceOrderInPlace(ctx, order)
+
+	// Send an off-chain message with the replacement order, followed by an off-chain message with the
+	// total filled size of the order since the Indexer treats the replacement order as a new order.
+	if m.generateOffchainUpdates {
+		if message, success := off_chain_updates.CreateOrderReplaceMessage(
+			m.clobKeeper.Logger(ctx),
+			order,
+		); success {
+			offchainUpdates.AddReplaceMessage(order.OrderId, message)
+		}
+		if message, success := off_chain_updates.CreateOrderUpdateMessage(
+			m.clobKeeper.Logger(ctx),
+			order.OrderId,
+			m.GetOrderFilledAmount(ctx, order.OrderId),
+		); success {
+			offchainUpdates.AddUpdateMessage(order.OrderId, message)
+		}
+	}
+
+	return 0, types.Success, offchainUpdates, nil
+}
+
+// placeOrder contains the logic of `PlaceOrder`. If `isReplacement` is true, the order is an atomic
+// replacement of an order resting on the orderbook which has already been validated and added to the
+// operations queue in `ReplaceOrder`.
+func (m *MemClobPriceTimePriority) placeOrder(
+	ctx sdk.Context,
+	order types.Order,
+	isReplacement bool,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
 ) {
 	lib.AssertCheckTxMode(ctx)
 
-	// Perform invariant checks that the orderbook is not crossed after `PlaceOrder` finishes execution.
+	// Perform invariant checks that the orderbook is not crossed after `placeOrder` finishes execution.
 	defer func() {
 		orderbook := m.openOrders.mustGetOrderbook(ctx, order.GetClobPairId())
 		bestBid, hasBid := m.openOrders.getBestOrderOnSide(
@@ -435,20 +711,32 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 
 	offchainUpdates = types.NewOffchainUpdates()
 
-	// Validate the order and return an error if any validation fails.
-	if err := m.validateNewOrder(ctx, order); err != nil {
-		return 0, 0, offchainUpdates, err
+	// Validate the order and return an error if any validation fails. Atomic replacements are validated
+	// in `ReplaceOrder`.
+	if !isReplacement {
+		if err := m.validateNewOrder(ctx, order, false); err != nil {
+			return 0, 0, offchainUpdates, err
+		}
 	}
 
-	if m.generateOffchainUpdates {
+	if m.generateOffchainUpdates && isReplacement {
+		// If this is an atomic replacement order, send a single replace message instead of the removal
+		// and place messages below.
+		if message, success := off_chain_updates.CreateOrderReplaceMessage(
+			m.clobKeeper.Logger(ctx),
+			order,
+		); success {
+			offchainUpdates.AddReplaceMessage(order.OrderId, message)
+		}
+	} else if m.generateOffchainUpdates {
 		// If this is a replacement order, then ensure we send the appropriate removal message.
 		orderId := order.OrderId
 		if _, found := m.openOrders.getOrder(ctx, orderId); found {
 			if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 				m.clobKeeper.Logger(ctx),
 				orderId,
-				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(orderId, message)
 			}
@@ -499,7 +787,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				order.OrderId,
 				takerOrderStatus.OrderStatus,
 				err,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(order.OrderId, message)
 			}
@@ -521,7 +809,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				order.OrderId,
 				takerOrderStatus.OrderStatus,
 				nil,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(order.OrderId, message)
 			}
@@ -568,7 +856,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				order.OrderId,
 				orderStatus,
 				nil,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(order.OrderId, message)
 			}
@@ -603,7 +891,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				order.OrderId,
 				addOrderOrderStatus,
 				nil,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(order.OrderId, message)
 			}
@@ -686,6 +974,41 @@ func (m *MemClobPriceTimePriority) PlacePerpetualLiquidation(
 		err
 }
 
+// PlaceAssetLiquidation places an IOC liquidation order of a borrowed asset position onto the book of its
+// spot CLOB pair. It is matched the same way as a perpetual liquidation order, and results in fills of
+// type `AssetLiquidation`.
+// This function will panic if the liquidation order does not liquidate an asset position.
+func (m *MemClobPriceTimePriority) PlaceAssetLiquidation(
+	ctx sdk.Context,
+	liquidationOrder types.LiquidationOrder,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	if !liquidationOrder.IsAssetLiquidation() {
+		panic(
+			fmt.Sprintf(
+				"PlaceAssetLiquidation: called with a non asset liquidation order: %+v",
+				liquidationOrder,
+			),
+		)
+	}
+
+	liquidationOrderStatus, offchainUpdates, _, err := m.matchOrder(ctx, &liquidationOrder)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return liquidationOrderStatus.OrderOptimisticallyFilledQuantums,
+		liquidationOrderStatus.OrderStatus,
+		offchainUpdates,
+		err
+}
+
 // DeleverageSubaccount will deleverage a subaccount by finding perpetual positions that can be used to offset
 // the offending subaccount. All position will be closed at the bankruptcy price of the subaccount that is being
 // deleveraged.
@@ -719,6 +1042,38 @@ func (m *MemClobPriceTimePriority) DeleverageSubaccount(
 	return quantumsDeleveraged, nil
 }
 
+// DeleverageSubaccountAsset will deleverage a subaccount's asset position by finding subaccounts that can
+// offset it, either by repaying a borrowed position or by buying seized collateral.
+func (m *MemClobPriceTimePriority) DeleverageSubaccountAsset(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	assetId uint32,
+	deltaQuantums *big.Int,
+) (
+	quantumsDeleveraged *big.Int,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	fills, deltaQuantumsRemaining := m.clobKeeper.OffsetSubaccountAssetPosition(
+		ctx,
+		subaccountId,
+		assetId,
+		deltaQuantums,
+	)
+
+	if len(fills) > 0 {
+		m.operationsToPropose.MustAddAssetDeleveragingToOperationsQueue(
+			subaccountId,
+			assetId,
+			fills,
+		)
+	}
+
+	quantumsDeleveraged = new(big.Int).Abs(new(big.Int).Sub(deltaQuantums, deltaQuantumsRemaining))
+	return quantumsDeleveraged, nil
+}
+
 // matchOrder will match the provided `MatchableOrder` as a taker order against the respective orderbook.
 // This function will return the status of the matched order, along with the new taker pending matches.
 // If order matching results in any error, all state updates wil be discarded.
@@ -772,7 +1127,7 @@ func (m *MemClobPriceTimePriority) matchOrder(
 				branchedContext.Logger(),
 				makerOrderId,
 				reason,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			); success {
 				offchainUpdates.AddRemoveMessage(makerOrderId, message)
 			}
@@ -933,6 +1288,56 @@ func (m *MemClobPriceTimePriority) ReplayOperations(
 				existingOffchainUpdates,
 			)
 
+		// Replay all Short-Term order replacements.
+		case *types.InternalOperation_ShortTermOrderReplacement:
+			order := operation.GetShortTermOrderReplacement().Order
+
+			// Set underlying tx bytes so OperationsToPropose may access it and
+			// store the tx bytes on OperationHashToTxBytes data structure
+			shortTermOrderTxBytes, exists := shortTermOrderTxBytes[order.GetOrderHash()]
+			if !exists || len(shortTermOrderTxBytes) == 0 {
+				panic(
+					fmt.Sprintf(
+						"ReplayOperations: Short-Term order TX bytes not found for order %s",
+						order.GetOrderTextString(),
+					),
+				)
+			}
+			ctx = ctx.WithTxBytes(shortTermOrderTxBytes)
+
+			msg := types.NewMsgReplaceOrder(order)
+			orderSizeOptimisticallyFilledFromMatchingQuantums,
+				orderStatus, replaceOrderOffchainUpdates, err := m.clobKeeper.ReplayReplaceOrder(
+				ctx,
+				msg,
+			)
+
+			m.clobKeeper.Logger(ctx).Debug(
+				"Received replacement order",
+				"orderHash",
+				log.NewLazySprintf("%X", order.GetOrderHash()),
+				"msg",
+				msg,
+				"status",
+				orderStatus,
+				"orderSizeOptimisticallyFilledFromMatchingQuantums",
+				orderSizeOptimisticallyFilledFromMatchingQuantums,
+				"err",
+				err,
+				"block",
+				ctx.BlockHeight(),
+			)
+
+			existingOffchainUpdates = m.GenerateOffchainUpdatesForReplayPlaceOrder(
+				ctx,
+				err,
+				operation,
+				order,
+				orderStatus,
+				replaceOrderOffchainUpdates,
+				existingOffchainUpdates,
+			)
+
 		// Replay all pre-existing stateful order placements.
 		case *types.InternalOperation_PreexistingStatefulOrder:
 			orderId := operation.GetPreexistingStatefulOrder()
@@ -1064,6 +1469,8 @@ func (m *MemClobPriceTimePriority) GenerateOffchainUpdatesForReplayPlaceOrder(
 		switch operation.Operation.(type) {
 		case *types.InternalOperation_ShortTermOrderPlacement:
 			loggerString = "ReplayOperations: PlaceOrder() returned an error"
+		case *types.InternalOperation_ShortTermOrderReplacement:
+			loggerString = "ReplayOperations: ReplaceOrder() returned an error"
 		case *types.InternalOperation_PreexistingStatefulOrder:
 			loggerString = "ReplayOperations: PlaceOrder() returned an error for a pre-existing stateful order."
 		case *types.InternalOperation_OrderRemoval:
@@ -1087,8 +1494,8 @@ func (m *MemClobPriceTimePriority) GenerateOffchainUpdatesForReplayPlaceOrder(
 				orderId,
 				orderStatus,
 				err,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
-				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
 			); success {
 				existingOffchainUpdates.AddRemoveMessage(orderId, message)
 			}
@@ -1125,9 +1532,10 @@ func (m *MemClobPriceTimePriority) RemoveAndClearOperationsQueue(
 	// if it exists.
 	for _, operation := range localValidatorOperationsQueue {
 		switch operation.Operation.(type) {
-		case *types.InternalOperation_ShortTermOrderPlacement:
-			otpOrderId := operation.GetShortTermOrderPlacement().Order.OrderId
-			otpOrderHash := operation.GetShortTermOrderPlacement().Order.GetOrderHash()
+		case *types.InternalOperation_ShortTermOrderPlacement, *types.InternalOperation_ShortTermOrderReplacement:
+			order, _ := operation.GetShortTermOrder()
+			otpOrderId := order.OrderId
+			otpOrderHash := order.GetOrderHash()
 
 			// If the order exists in the book, remove it.
 			// Else, since the Short-Term order is no longer on the book or operations queue we
@@ -1136,7 +1544,6 @@ func (m *MemClobPriceTimePriority) RemoveAndClearOperationsQueue(
 			if found && existingOrder.GetOrderHash() == otpOrderHash {
 				m.mustRemoveOrder(ctx, otpOrderId)
 			} else {
-				order := operation.GetShortTermOrderPlacement().Order
 				m.operationsToPropose.RemoveShortTermOrderTxBytes(order)
 			}
 		case *types.InternalOperation_PreexistingStatefulOrder:
@@ -1237,8 +1644,8 @@ func (m *MemClobPriceTimePriority) PurgeInvalidMemclobState(
 				if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 					m.clobKeeper.Logger(ctx),
 					statefulOrderId,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
 				); success {
 					existingOffchainUpdates.AddRemoveMessage(statefulOrderId, message)
 				}
@@ -1249,21 +1656,20 @@ func (m *MemClobPriceTimePriority) PurgeInvalidMemclobState(
 	// Remove all expired Short-Term order IDs from the memclob.
 	if blockExpirations, beExists := m.openOrders.blockExpirationsForOrders[blockHeight]; beExists {
 		for shortTermOrderId := range blockExpirations {
-			if m.generateOffchainUpdates {
-				// Send an off-chain update message indicating the order should be removed from the
-				// orderbook on the Indexer. As the order is expired, the status of the order is canceled
-				// and not best-effort-canceled.
-				if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
-					m.clobKeeper.Logger(ctx),
-					shortTermOrderId,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
-				); success {
-					existingOffchainUpdates.AddRemoveMessage(shortTermOrderId, message)
-				}
-			}
+			m.mustRemoveExpiredShortTermOrder(ctx, shortTermOrderId, existingOffchainUpdates)
+		}
+	}
 
-			m.mustRemoveOrder(ctx, shortTermOrderId)
+	// Remove all Short-Term orders whose `GoodTilBlockTime` is at or before the block time.
+	blockTime := ctx.BlockTime().Unix()
+	for _, goodTilBlockTime := range lib.GetSortedKeys[lib.Sortable[uint32]](
+		m.openOrders.timeExpirationsForOrders,
+	) {
+		if int64(goodTilBlockTime) > blockTime {
+			break
+		}
+		for shortTermOrderId := range m.openOrders.timeExpirationsForOrders[goodTilBlockTime] {
+			m.mustRemoveExpiredShortTermOrder(ctx, shortTermOrderId, existingOffchainUpdates)
 		}
 	}
 
@@ -1284,11 +1690,78 @@ func (m *MemClobPriceTimePriority) PurgeInvalidMemclobState(
 	return existingOffchainUpdates
 }
 
+// mustRemoveExpiredShortTermOrder removes an expired Short-Term order from the memclob and adds an
+// off-chain update removing it from the orderbook on the Indexer.
+func (m *MemClobPriceTimePriority) mustRemoveExpiredShortTermOrder(
+	ctx sdk.Context,
+	shortTermOrderId types.OrderId,
+	existingOffchainUpdates *types.OffchainUpdates,
+) {
+	if m.generateOffchainUpdates {
+		// Send an off-chain update message indicating the order should be removed from the
+		// orderbook on the Indexer. As the order is expired, the status of the order is canceled
+		// and not best-effort-canceled.
+		if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
+			m.clobKeeper.Logger(ctx),
+			shortTermOrderId,
+			sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
+			ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
+		); success {
+			existingOffchainUpdates.AddRemoveMessage(shortTermOrderId, message)
+		}
+	}
+
+	m.mustRemoveOrder(ctx, shortTermOrderId)
+}
+
+// RemoveOrdersForClobPair removes all open orders of a clob pair from the memclob, e.g. once the clob pair
+// was moved to final settlement, and adds an off-chain update removing each order from the orderbook on
+// the Indexer. Orders are removed in ascending order of order ID.
+func (m *MemClobPriceTimePriority) RemoveOrdersForClobPair(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	existingOffchainUpdates *types.OffchainUpdates,
+) *types.OffchainUpdates {
+	lib.AssertCheckTxMode(ctx)
+
+	orderbook := m.openOrders.mustGetOrderbook(ctx, clobPairId)
+	orderIds := make([]types.OrderId, 0, orderbook.TotalOpenOrders)
+	for _, subaccountOpenOrders := range orderbook.SubaccountOpenClobOrders {
+		for _, openOrders := range subaccountOpenOrders {
+			for orderId := range openOrders {
+				orderIds = append(orderIds, orderId)
+			}
+		}
+	}
+	types.MustSortAndHaveNoDuplicates(orderIds)
+
+	for _, orderId := range orderIds {
+		if m.generateOffchainUpdates {
+			// Send an off-chain update message indicating the order should be removed from the
+			// orderbook on the Indexer.
+			if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
+				m.clobKeeper.Logger(ctx),
+				orderId,
+				sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_FINAL_SETTLEMENT,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_CANCELED,
+			); success {
+				existingOffchainUpdates.AddRemoveMessage(orderId, message)
+			}
+		}
+
+		m.mustRemoveOrder(ctx, orderId)
+	}
+
+	return existingOffchainUpdates
+}
+
 // validateNewOrder will perform the following validation against the memclob's in-memory state to ensure the order
 // can be placed (and if any condition is false, an error will be returned):
 //   - The order is not canceled (with an equal-to-or-greater-than `GoodTilBlock` than the new order).
 //   - If the order is replacing another order, then the new order's expiration must not be less than the
-//     existing order's expiration.
+//     existing order's expiration, and a Short-Term order must not already be in the operations queue.
+//     This is not validated if `isAtomicReplacement` is true, since atomic replacements are validated
+//     in `ReplaceOrder`.
 //
 // Note that it does not perform collateralization checks since that will be done when matching the order (if the order
 // overlaps the book) and when adding the order to the book (if the order has remaining size after matching).
@@ -1299,6 +1772,7 @@ func (m *MemClobPriceTimePriority) PurgeInvalidMemclobState(
 // - The `Order.ClobPairId` references a valid `ClobPair`.
 // - The order is not expired (`Order.GoodTilBlock >= currentBlock`).
 // - The order expiration is within `ShortBlockWindow` (`Order.GoodTilBlock <= currentBlock + ShortBlockWindow`).
+// - Short-Term orders using `Order.GoodTilBlockTime` expire within `ShortTermOrderTimeWindow`.
 // - This order has not already been fully filled.
 // - `Order.Side` is a valid side.
 // - The order is a valid order for the referenced `ClobPair` (where `Order.ClobPairId == ClobPair.Id`). Specifically:
@@ -1308,6 +1782,7 @@ func (m *MemClobPriceTimePriority) PurgeInvalidMemclobState(
 func (m *MemClobPriceTimePriority) validateNewOrder(
 	ctx sdk.Context,
 	order types.Order,
+	isAtomicReplacement bool,
 ) (
 	err error,
 ) {
@@ -1324,6 +1799,7 @@ func (m *MemClobPriceTimePriority) validateNewOrder(
 	if orderId.IsShortTermOrder() {
 		// If the cancelation has an equal-to-or-greater `GoodTilBlock` than the new order, return an error.
 		// If the cancelation has a lesser `GoodTilBlock` than the new order, we do not remove the cancelation.
+		// Short-Term orders using `GoodTilBlockTime` are rejected until the cancelation expires.
 		if cancelTilBlock, cancelExists := m.cancels.get(orderId); cancelExists && cancelTilBlock >= order.GetGoodTilBlock() {
 			return errorsmod.Wrapf(
 				types.ErrOrderIsCanceled,
@@ -1341,13 +1817,28 @@ func (m *MemClobPriceTimePriority) validateNewOrder(
 	// then we must validate that the new order's `GoodTilBlock` is greater-in-value than the old order.
 	// If greater, then it can be placed (replacing the old order if it was resting on the book).
 	// If equal-or-lesser, then it is dropped.
-	if restingOrderExists && existingRestingOrder.MustCmpReplacementOrder(&order) >= 0 {
+	// Orders cannot replace an order with a different expiration type (block height versus block time).
+	if restingOrderExists && !existingRestingOrder.HasSameExpirationType(&order) {
 		return types.ErrInvalidReplacement
 	}
-
-	if matchedOrderExists && existingMatchedOrder.MustCmpReplacementOrder(&order) >= 0 {
+	if matchedOrderExists && !existingMatchedOrder.HasSameExpirationType(&order) {
 		return types.ErrInvalidReplacement
 	}
+	if !isAtomicReplacement {
+		if restingOrderExists && existingRestingOrder.MustCmpReplacementOrder(&order) >= 0 {
+			return types.ErrInvalidReplacement
+		}
+
+		if matchedOrderExists && existingMatchedOrder.MustCmpReplacementOrder(&order) >= 0 {
+			return types.ErrInvalidReplacement
+		}
+
+		// An order which was atomically replaced may be greater than its replacement order, so it must
+		// be rejected if it was already placed in the operations queue.
+		if orderId.IsShortTermOrder() && m.operationsToPropose.IsOrderPlacementInOperationsQueue(order) {
+			return types.ErrInvalidReplacement
+		}
+	}
 
 	// If the order is a reduce-only order, we should ensure it does not increase the subaccount's
 	// current position size. Note that we intentionally do not validate that the reduce-only order
@@ -2069,8 +2560,8 @@ func (m *MemClobPriceTimePriority) maybeCancelReduceOnlyOrders(
 					if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 						m.clobKeeper.Logger(ctx),
 						orderId,
-						indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE,
-						off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+						sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE,
+						ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 					); success {
 						offchainUpdates.AddRemoveMessage(orderId, message)
 					}
@@ -0,0 +1,101 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOffchainUpdatesForOrderbookSnapshot(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+
+	tests := map[string]struct {
+		// State.
+		existingOrders []types.Order
+		fillAmounts    map[types.OrderId]satypes.BaseQuantums
+
+		// GetOffchainUpdatesForOrderbookSnapshot parameters.
+		clobPairId types.ClobPairId
+
+		// Expectations.
+		expectedOrders []types.Order
+	}{
+		"Returns no updates for an empty orderbook": {
+			clobPairId: 0,
+
+			expectedOrders: []types.Order{},
+		},
+		"Returns no updates if the orderbook does not exist": {
+			existingOrders: []types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+			},
+			clobPairId: 1,
+
+			expectedOrders: []types.Order{},
+		},
+		"Returns bids then asks from the best price in time priority": {
+			existingOrders: []types.Order{
+				constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+			},
+			fillAmounts: map[types.OrderId]satypes.BaseQuantums{
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22.OrderId: 15,
+			},
+			clobPairId: 0,
+
+			expectedOrders: []types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+				constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+				constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClobKeeper := testutil_memclob.NewFakeMemClobKeeper()
+			memclob := NewMemClobPriceTimePriority(true)
+			memclob.SetClobKeeper(memClobKeeper)
+
+			createOrderbooks(t, ctx, memclob, 1)
+			createAllOrders(t, ctx, memclob, tc.existingOrders)
+			for orderId, fillAmount := range tc.fillAmounts {
+				memClobKeeper.SetOrderFillAmount(ctx, orderId, fillAmount)
+			}
+
+			expectedUpdates := types.NewOffchainUpdates()
+			for _, order := range tc.expectedOrders {
+				expectedUpdates.AddPlaceMessage(
+					order.OrderId,
+					off_chain_updates.MustCreateOrderPlaceMessage(ctx.Logger(), order),
+				)
+				expectedUpdates.AddUpdateMessage(
+					order.OrderId,
+					off_chain_updates.MustCreateOrderUpdateMessage(
+						ctx.Logger(),
+						order.OrderId,
+						tc.fillAmounts[order.OrderId],
+					),
+				)
+			}
+
+			require.Equal(
+				t,
+				expectedUpdates,
+				memclob.GetOffchainUpdatesForOrderbookSnapshot(ctx, tc.clobPairId),
+			)
+		})
+	}
+}
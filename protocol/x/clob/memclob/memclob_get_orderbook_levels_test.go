@@ -0,0 +1,115 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderbookLevels(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	existingOrders := []types.Order{
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+		constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+		constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+		constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+		constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price35_GTB32,
+	}
+
+	tests := map[string]struct {
+		// State.
+		existingOrders []types.Order
+		fillAmounts    map[types.OrderId]satypes.BaseQuantums
+
+		// GetOrderbookLevels parameters.
+		clobPairId types.ClobPairId
+		depth      uint32
+
+		// Expectations.
+		expectedBids []types.OrderbookLevel
+		expectedAsks []types.OrderbookLevel
+		expectedErr  error
+	}{
+		"Returns no levels for an empty orderbook": {
+			clobPairId: 0,
+
+			expectedBids: []types.OrderbookLevel{},
+			expectedAsks: []types.OrderbookLevel{},
+		},
+		"Returns all levels sorted from the best price when depth is zero": {
+			existingOrders: existingOrders,
+			clobPairId:     0,
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 25, NumOrders: 2},
+				{Subticks: 5, Quantums: 25, NumOrders: 1},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 5, NumOrders: 1},
+				{Subticks: 35, Quantums: 20, NumOrders: 1},
+			},
+		},
+		"Returns at most depth levels on each side": {
+			existingOrders: existingOrders,
+			clobPairId:     0,
+			depth:          1,
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 25, NumOrders: 2},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 5, NumOrders: 1},
+			},
+		},
+		"Level sizes exclude filled amounts": {
+			existingOrders: existingOrders,
+			fillAmounts: map[types.OrderId]satypes.BaseQuantums{
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22.OrderId: 15,
+			},
+			clobPairId: 0,
+
+			expectedBids: []types.OrderbookLevel{
+				{Subticks: 10, Quantums: 10, NumOrders: 2},
+				{Subticks: 5, Quantums: 25, NumOrders: 1},
+			},
+			expectedAsks: []types.OrderbookLevel{
+				{Subticks: 15, Quantums: 5, NumOrders: 1},
+				{Subticks: 35, Quantums: 20, NumOrders: 1},
+			},
+		},
+		"Returns an error if the orderbook does not exist": {
+			clobPairId: 1,
+
+			expectedErr: types.ErrInvalidClob,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClobKeeper := testutil_memclob.NewFakeMemClobKeeper()
+			memclob := NewMemClobPriceTimePriority(false)
+			memclob.SetClobKeeper(memClobKeeper)
+
+			createOrderbooks(t, ctx, memclob, 1)
+			createAllOrders(t, ctx, memclob, tc.existingOrders)
+			for orderId, fillAmount := range tc.fillAmounts {
+				memClobKeeper.SetOrderFillAmount(ctx, orderId, fillAmount)
+			}
+
+			bids, asks, err := memclob.GetOrderbookLevels(ctx, tc.clobPairId, tc.depth)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedBids, bids)
+				require.Equal(t, tc.expectedAsks, asks)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
 	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
@@ -259,3 +260,61 @@ func TestGetSubaccountOrders_OrderNotFoundPanics(t *testing.T) {
 		)
 	})
 }
+
+func TestGetSubaccountOpenOrders(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	tests := map[string]struct {
+		// State.
+		existingOrders []types.Order
+
+		// GetSubaccountOpenOrders parameters.
+		subaccountId satypes.SubaccountId
+
+		// Expectations.
+		expectedOpenOrders []types.Order
+	}{
+		"Returns nothing when there are no open orders": {
+			subaccountId: constants.Alice_Num0,
+
+			expectedOpenOrders: []types.Order{},
+		},
+		"Returns nothing when a subaccount has no open orders, but orders exist on the CLOB": {
+			existingOrders: []types.Order{
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+			},
+			subaccountId: constants.Alice_Num0,
+
+			expectedOpenOrders: []types.Order{},
+		},
+		"Returns open orders across sides and CLOBs sorted by order ID": {
+			existingOrders: []types.Order{
+				constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+				constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+				constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+				constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price15_GTB20,
+			},
+			subaccountId: constants.Alice_Num0,
+
+			expectedOpenOrders: []types.Order{
+				constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15,
+				constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+				constants.Order_Alice_Num0_Id6_Clob0_Buy25_Price5_GTB20,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClobKeeper := testutil_memclob.NewFakeMemClobKeeper()
+			memclob := NewMemClobPriceTimePriority(false)
+			memclob.SetClobKeeper(memClobKeeper)
+
+			createOrderbooks(t, ctx, memclob, 2)
+			createAllOrders(t, ctx, memclob, tc.existingOrders)
+
+			require.Equal(t, tc.expectedOpenOrders, memclob.GetSubaccountOpenOrders(ctx, tc.subaccountId))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"fmt"
 	"math"
+	"sync"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -23,6 +24,13 @@ type memclobOpenOrders struct {
 	// (with each order keyed by `OrderId`). Necessary for O(1) order removal
 	// from the orderbook when expiring orders in the EndBlocker.
 	blockExpirationsForOrders map[uint32]map[types.OrderId]bool
+	// Map from `GoodTilBlockTime` (in seconds) to a set of all Short-Term orders that expire at
+	// this block time (with each order keyed by `OrderId`).
+	timeExpirationsForOrders map[uint32]map[types.OrderId]bool
+	// Guards writes to the open orders against concurrent reads from gRPC queries, which are not
+	// serialized with ABCI methods. Reads made within ABCI methods do not need to acquire it, since
+	// all writes are also made within ABCI methods.
+	mtx sync.RWMutex
 }
 
 // newMemclobOpenOrders returns a new `memclobOpenOrders`.
@@ -31,6 +39,7 @@ func newMemclobOpenOrders() *memclobOpenOrders {
 		orderbooksMap:             make(map[types.ClobPairId]*types.Orderbook),
 		orderIdToLevelOrder:       make(map[types.OrderId]*types.LevelOrder),
 		blockExpirationsForOrders: make(map[uint32]map[types.OrderId]bool),
+		timeExpirationsForOrders:  make(map[uint32]map[types.OrderId]bool),
 	}
 }
 
@@ -267,6 +276,9 @@ func (m *memclobOpenOrders) createOrderbook(
 	subticksPerTick types.SubticksPerTick,
 	minOrderBaseQuantums satypes.BaseQuantums,
 ) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
 	if _, exists := m.orderbooksMap[clobPairId]; exists {
 		panic(fmt.Sprintf("Orderbook for ClobPair ID %d already exists", clobPairId))
 	}
@@ -294,7 +306,8 @@ func (m *memclobOpenOrders) createOrderbook(
 // mustAddShortTermOrderToBlockExpirationsForOrders is a function used for providing a simple interface
 // for adding Short-Term orders to the `blockExpirationsForOrders` data structure. It will add an
 // order to the set of orders expiring at that block, and if necessary will create any intermediate maps
-// that do not already exist.
+// that do not already exist. Short-Term orders using `GoodTilBlockTime` are instead added to
+// `timeExpirationsForOrders`.
 // This function assumes that it will only be called with Short-Term orders that have passed order validation
 // in the CLOB keeper and the `validateNewOrder` function.
 func (m *memclobOpenOrders) mustAddShortTermOrderToBlockExpirationsForOrders(
@@ -310,11 +323,16 @@ func (m *memclobOpenOrders) mustAddShortTermOrderToBlockExpirationsForOrders(
 		)
 	}
 
+	expirations, expiry := m.blockExpirationsForOrders, order.GetGoodTilBlock()
+	if order.IsGoodTilBlockTimeShortTermOrder() {
+		expirations, expiry = m.timeExpirationsForOrders, order.GetGoodTilBlockTime()
+	}
+
 	// Create the map containing the set of orders expiring at this block, if it doesn't already exist.
-	ordersExpiringAtBlock, exists := m.blockExpirationsForOrders[order.GetGoodTilBlock()]
+	ordersExpiringAtBlock, exists := expirations[expiry]
 	if !exists {
 		ordersExpiringAtBlock = make(map[types.OrderId]bool)
-		m.blockExpirationsForOrders[order.GetGoodTilBlock()] = ordersExpiringAtBlock
+		expirations[expiry] = ordersExpiringAtBlock
 	}
 	ordersExpiringAtBlock[order.OrderId] = true
 }
@@ -365,6 +383,9 @@ func (m *memclobOpenOrders) mustAddOrderToOrderbook(
 	newOrder types.Order,
 	forceToFrontOfLevel bool,
 ) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
 	// Verify that the order has a valid side, and panic if that's not the case.
 	newOrder.MustBeValidOrderSide()
 
@@ -443,6 +464,65 @@ func (m *memclobOpenOrders) mustAddOrderToOrderbook(
 	}
 }
 
+// mustReplaceOrderInPlace replaces the order resting on the orderbook with the same order ID as `newOrder`
+// with `newOrder`, keeping its position in the price level. This function will panic if the order does not
+// exist, or if `newOrder` has a different price or side than the resting order.
+// This function will assume that all order validation has already been done.
+func (m *memclobOpenOrders) mustReplaceOrderInPlace(
+	ctx sdk.Context,
+	newOrder types.Order,
+) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	levelOrder, exists := m.orderIdToLevelOrder[newOrder.OrderId]
+	if !exists {
+		panic(fmt.Sprintf("mustReplaceOrderInPlace: order does not exist %v", newOrder.OrderId))
+	}
+
+	// The price level and the subaccount open orders are keyed by the price and side of the order,
+	// so these must not change.
+	existingOrder := levelOrder.Value.Order
+	if existingOrder.GetOrderSubticks() != newOrder.GetOrderSubticks() ||
+		existingOrder.Side != newOrder.Side {
+		panic(
+			fmt.Sprintf(
+				"mustReplaceOrderInPlace: order (%+v) cannot be replaced in place with order (%+v)",
+				existingOrder,
+				newOrder,
+			),
+		)
+	}
+
+	// Move the order to the expiration of the new order.
+	if existingOrder.GetGoodTilBlock() != newOrder.GetGoodTilBlock() ||
+		existingOrder.GetGoodTilBlockTime() != newOrder.GetGoodTilBlockTime() {
+		m.removeShortTermOrderFromExpirationsForOrders(existingOrder)
+		m.mustAddShortTermOrderToBlockExpirationsForOrders(ctx, newOrder)
+	}
+
+	levelOrder.Value.Order = newOrder
+}
+
+// removeShortTermOrderFromExpirationsForOrders removes a Short-Term order from
+// `blockExpirationsForOrders[goodTilBlock]`, or from `timeExpirationsForOrders[goodTilBlockTime]`
+// if it expires by block time. It does nothing for stateful orders.
+func (m *memclobOpenOrders) removeShortTermOrderFromExpirationsForOrders(order types.Order) {
+	if order.IsGoodTilBlockTimeShortTermOrder() {
+		goodTilBlockTime := order.GetGoodTilBlockTime()
+		delete(m.timeExpirationsForOrders[goodTilBlockTime], order.OrderId)
+		if len(m.timeExpirationsForOrders[goodTilBlockTime]) == 0 {
+			delete(m.timeExpirationsForOrders, goodTilBlockTime)
+		}
+	} else if order.OrderId.IsShortTermOrder() {
+		goodTilBlock := order.GetGoodTilBlock()
+		delete(m.blockExpirationsForOrders[goodTilBlock], order.OrderId)
+		if len(m.blockExpirationsForOrders[goodTilBlock]) == 0 {
+			delete(m.blockExpirationsForOrders, goodTilBlock)
+		}
+	}
+}
+
 // mustRemoveOrder completely removes an order from all data structures for tracking
 // open orders in the memclob. If the order does not exist, this method will panic.
 // NOTE: `mustRemoveOrder` does _not_ remove cancels.
@@ -451,6 +531,9 @@ func (m *memclobOpenOrders) mustRemoveOrder(
 	ctx sdk.Context,
 	levelOrder *types.LevelOrder,
 ) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
 	// Define variables related to this order for more succinct reference.
 	order := levelOrder.Value.Order
 	orderId := order.OrderId
@@ -461,14 +544,7 @@ func (m *memclobOpenOrders) mustRemoveOrder(
 	isBuy := order.IsBuy()
 
 	// Delete this order from various data structures.
-	// If this is a Short-Term order, remove the order from `blockExpirationsForOrders[goodTilBlock]`.
-	if order.OrderId.IsShortTermOrder() {
-		goodTilBlock := order.GetGoodTilBlock()
-		delete(m.blockExpirationsForOrders[goodTilBlock], orderId)
-		if len(m.blockExpirationsForOrders[goodTilBlock]) == 0 {
-			delete(m.blockExpirationsForOrders, goodTilBlock)
-		}
-	}
+	m.removeShortTermOrderFromExpirationsForOrders(order)
 
 	delete(m.orderbooksMap[clobPairId].SubaccountOpenClobOrders[subaccountId][side], orderId)
 	if len(m.orderbooksMap[clobPairId].SubaccountOpenClobOrders[subaccountId][side]) == 0 {
@@ -211,6 +211,14 @@ func TestPlaceOrder_AddOrderToOrderbook(t *testing.T) {
 			expectedErr:            types.ErrInvalidReplacement,
 			expectedToReplaceOrder: false,
 		},
+		"Replacing an order fails if the existing order has a different expiration type": {
+			existingOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+			},
+			order:                  constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			expectedErr:            types.ErrInvalidReplacement,
+			expectedToReplaceOrder: false,
+		},
 		"Replacing an order succeeds if GoodTilBlock is greater than existing order": {
 			existingOrders: []types.MatchableOrder{
 				&constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
@@ -355,7 +363,9 @@ func TestPlaceOrder_AddOrderToOrderbook(t *testing.T) {
 				// If this is an order replacement and it was successful, we assert that the old order being replaced
 				// is no longer on the book.
 				matchableOrderOrder := matchableOrder.MustGetOrder()
-				if matchableOrderOrder.OrderId == tc.order.OrderId && tc.order.MustCmpReplacementOrder(&matchableOrderOrder) > 0 {
+				if matchableOrderOrder.OrderId == tc.order.OrderId &&
+					tc.order.HasSameExpirationType(&matchableOrderOrder) &&
+					tc.order.MustCmpReplacementOrder(&matchableOrderOrder) > 0 {
 					continue
 				}
 
@@ -3,6 +3,7 @@ package memclob
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
@@ -82,6 +83,28 @@ func TestPurgeInvalidMemclobState(t *testing.T) {
 			},
 			expectedRemainingAsks: []OrderWithRemainingSize{},
 		},
+		`Memclob has Short-Term orders expired by block time and they're removed`: {
+			placedOperations: []types.Operation{
+				clobtest.NewOrderPlacementOperation(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
+				clobtest.NewOrderPlacementOperation(constants.Order_Bob_Num0_Id2_Clob0_Sell25_Price95_GTBT20),
+				clobtest.NewOrderPlacementOperation(constants.Order_Bob_Num1_Id1_Clob1_Sell25_Price85_GTB10),
+			},
+			newOrderFillAmounts: map[types.OrderId]satypes.BaseQuantums{},
+
+			fullyFilledOrderIds:     []types.OrderId{},
+			expiredStatefulOrderIds: []types.OrderId{},
+			expectedRemovedExpiredOrderIds: []types.OrderId{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+				constants.Order_Bob_Num1_Id1_Clob1_Sell25_Price85_GTB10.OrderId,
+			},
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Bob_Num0_Id2_Clob0_Sell25_Price95_GTBT20,
+					RemainingSize: 25,
+				},
+			},
+		},
 		`There are fully-filled and expired order IDs but the memclob is empty`: {
 			placedOperations:    []types.Operation{},
 			newOrderFillAmounts: map[types.OrderId]satypes.BaseQuantums{},
@@ -293,7 +316,7 @@ func TestPurgeInvalidMemclobState(t *testing.T) {
 			)
 
 			// Run the test.
-			ctx = ctx.WithBlockHeight(10)
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(15, 0))
 			offchainUpdates := types.NewOffchainUpdates()
 			memclob.PurgeInvalidMemclobState(
 				ctx,
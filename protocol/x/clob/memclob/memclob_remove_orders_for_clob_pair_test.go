@@ -0,0 +1,107 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveOrdersForClobPair(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	tests := map[string]struct {
+		// State.
+		existingOrders []types.Order
+
+		// Parameters.
+		clobPairId types.ClobPairId
+
+		// Expectations.
+		expectedRemovedOrders   []types.Order
+		expectedRemainingOrders []types.Order
+	}{
+		"Empty orderbook": {
+			existingOrders: []types.Order{},
+			clobPairId:     0,
+
+			expectedRemovedOrders:   []types.Order{},
+			expectedRemainingOrders: []types.Order{},
+		},
+		"Removes all bids and asks of the clob pair": {
+			existingOrders: []types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+				constants.Order_Alice_Num0_Id7_Clob0_Sell25_Price15_GTB20,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			clobPairId: 0,
+
+			expectedRemovedOrders: []types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22,
+				constants.Order_Alice_Num0_Id7_Clob0_Sell25_Price15_GTB20,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			expectedRemainingOrders: []types.Order{},
+		},
+		"Does not remove orders of other clob pairs": {
+			existingOrders: []types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				constants.Order_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB15,
+				constants.Order_Bob_Num1_Id1_Clob1_Sell25_Price85_GTB10,
+			},
+			clobPairId: 0,
+
+			expectedRemovedOrders: []types.Order{
+				constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+			},
+			expectedRemainingOrders: []types.Order{
+				constants.Order_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB15,
+				constants.Order_Bob_Num1_Id1_Clob1_Sell25_Price85_GTB10,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup the memclob state.
+			memClobKeeper := testutil_memclob.NewFakeMemClobKeeper()
+			memclob := NewMemClobPriceTimePriority(true)
+			memclob.SetClobKeeper(memClobKeeper)
+
+			// Create all unique orderbooks.
+			createOrderbooks(t, ctx, memclob, 2)
+
+			// Place all existing orders on the orderbook.
+			for _, order := range tc.existingOrders {
+				_, _, _, err := memclob.PlaceOrder(ctx, order)
+				require.NoError(t, err)
+			}
+
+			// Run the test case.
+			offchainUpdates := memclob.RemoveOrdersForClobPair(
+				ctx,
+				tc.clobPairId,
+				types.NewOffchainUpdates(),
+			)
+
+			removedOrderIds := make([]types.OrderId, 0)
+			for _, message := range offchainUpdates.Messages {
+				require.Equal(t, types.RemoveMessageType, message.Type)
+				removedOrderIds = append(removedOrderIds, message.OrderId)
+			}
+			require.Len(t, removedOrderIds, len(tc.expectedRemovedOrders))
+			for _, order := range tc.expectedRemovedOrders {
+				requireOrderDoesNotExistInMemclob(t, ctx, order, memclob)
+				require.Contains(t, removedOrderIds, order.OrderId)
+			}
+			for _, order := range tc.expectedRemainingOrders {
+				requireOrderExistsInMemclob(t, ctx, order, memclob)
+			}
+		})
+	}
+}
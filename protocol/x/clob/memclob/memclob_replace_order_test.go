@@ -0,0 +1,182 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceOrder_OrderDoesNotExist(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	memclob := NewMemClobPriceTimePriority(true)
+	memclob.SetClobKeeper(testutil_memclob.NewFakeMemClobKeeper())
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+
+	createAllOrderbooksForOrders(
+		t,
+		ctx,
+		memclob,
+		[]types.Order{order},
+	)
+
+	_, _, _, err := memclob.ReplaceOrder(ctx, types.NewMsgReplaceOrder(order))
+	require.ErrorIs(t, err, types.ErrReplacedOrderDoesNotExist)
+}
+
+func TestReplaceOrder_InvalidReplacement(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	memclob := NewMemClobPriceTimePriority(true)
+	memclob.SetClobKeeper(testutil_memclob.NewFakeMemClobKeeper())
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20
+
+	createAllOrderbooksForOrders(
+		t,
+		ctx,
+		memclob,
+		[]types.Order{order},
+	)
+
+	_, _, _, err := memclob.PlaceOrder(ctx, order)
+	require.NoError(t, err)
+
+	// Replacement expires before the existing order.
+	_, _, _, err = memclob.ReplaceOrder(
+		ctx,
+		types.NewMsgReplaceOrder(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidReplaceOrder)
+
+	// Existing order is unchanged.
+	gottenOrder, found := memclob.GetOrder(ctx, order.OrderId)
+	require.True(t, found)
+	require.Equal(t, order, gottenOrder)
+}
+
+func TestReplaceOrder_SizeDecreaseKeepsPriority(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	memclob := NewMemClobPriceTimePriority(true)
+	memclob.SetClobKeeper(testutil_memclob.NewFakeMemClobKeeper())
+
+	aliceOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20
+	bobOrder := constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22
+	replacementOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB21
+
+	createAllOrderbooksForOrders(
+		t,
+		ctx,
+		memclob,
+		[]types.Order{aliceOrder, bobOrder},
+	)
+
+	_, _, _, err := memclob.PlaceOrder(ctx, aliceOrder)
+	require.NoError(t, err)
+	_, _, _, err = memclob.PlaceOrder(ctx, bobOrder)
+	require.NoError(t, err)
+
+	_, orderStatus, offchainUpdates, err := memclob.ReplaceOrder(ctx, types.NewMsgReplaceOrder(replacementOrder))
+	require.NoError(t, err)
+	require.Equal(t, types.Success, orderStatus)
+	require.Len(t, offchainUpdates.GetMessages(), 2)
+
+	gottenOrder, found := memclob.GetOrder(ctx, aliceOrder.OrderId)
+	require.True(t, found)
+	require.Equal(t, replacementOrder, gottenOrder)
+
+	// The replacement order is still at the front of the level.
+	level := memclob.openOrders.orderbooksMap[types.ClobPairId(0)].Bids[types.Subticks(10)]
+	require.Equal(t, replacementOrder, level.LevelOrders.Front.Value.Order)
+	require.Equal(t, bobOrder, level.LevelOrders.Back.Value.Order)
+
+	// The replacement order expires at its own GoodTilBlock.
+	require.NotContains(t, memclob.openOrders.blockExpirationsForOrders, aliceOrder.GetGoodTilBlock())
+	require.True(
+		t,
+		memclob.openOrders.blockExpirationsForOrders[replacementOrder.GetGoodTilBlock()][replacementOrder.OrderId],
+	)
+}
+
+func TestReplaceOrder_PriceChangeLosesPriority(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	memclob := NewMemClobPriceTimePriority(true)
+	memclob.SetClobKeeper(testutil_memclob.NewFakeMemClobKeeper())
+
+	aliceOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20
+	bobOrder := constants.Order_Bob_Num0_Id5_Clob0_Buy20_Price10_GTB22
+	replacementOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price5_GTB21
+
+	createAllOrderbooksForOrders(
+		t,
+		ctx,
+		memclob,
+		[]types.Order{aliceOrder, bobOrder},
+	)
+
+	_, _, _, err := memclob.PlaceOrder(ctx, aliceOrder)
+	require.NoError(t, err)
+	_, _, _, err = memclob.PlaceOrder(ctx, bobOrder)
+	require.NoError(t, err)
+
+	_, orderStatus, _, err := memclob.ReplaceOrder(ctx, types.NewMsgReplaceOrder(replacementOrder))
+	require.NoError(t, err)
+	require.Equal(t, types.Success, orderStatus)
+
+	gottenOrder, found := memclob.GetOrder(ctx, aliceOrder.OrderId)
+	require.True(t, found)
+	require.Equal(t, replacementOrder, gottenOrder)
+
+	bids := memclob.openOrders.orderbooksMap[types.ClobPairId(0)].Bids
+	require.Equal(t, bobOrder, bids[types.Subticks(10)].LevelOrders.Front.Value.Order)
+	require.Nil(t, bids[types.Subticks(10)].LevelOrders.Front.Next)
+	require.Equal(t, replacementOrder, bids[types.Subticks(5)].LevelOrders.Front.Value.Order)
+}
+
+func TestReplaceOrder_AddsReplacedOrderAndReplacementToOperationsQueue(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	memclob := NewMemClobPriceTimePriority(true)
+	memclob.SetClobKeeper(testutil_memclob.NewFakeMemClobKeeper())
+
+	aliceOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20
+	replacementOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB21
+
+	createAllOrderbooksForOrders(
+		t,
+		ctx,
+		memclob,
+		[]types.Order{aliceOrder},
+	)
+
+	_, _, _, err := memclob.PlaceOrder(ctx, aliceOrder)
+	require.NoError(t, err)
+	require.Empty(t, memclob.operationsToPropose.OperationsQueue)
+
+	_, _, _, err = memclob.ReplaceOrder(ctx, types.NewMsgReplaceOrder(replacementOrder))
+	require.NoError(t, err)
+
+	// The replaced order placement precedes the order replacement in the operations queue.
+	require.Equal(
+		t,
+		[]types.InternalOperation{
+			types.NewShortTermOrderPlacementInternalOperation(aliceOrder),
+			types.NewShortTermOrderReplacementInternalOperation(replacementOrder),
+		},
+		memclob.operationsToPropose.OperationsQueue,
+	)
+	require.Equal(t, replacementOrder, memclob.operationsToPropose.MatchedOrderIdToOrder[aliceOrder.OrderId])
+
+	// The replaced order cannot be placed again, even if it has a higher priority than the replacement order.
+	_, _, _, err = memclob.PlaceOrder(ctx, aliceOrder)
+	require.ErrorIs(t, err, types.ErrInvalidReplacement)
+
+	// Nor can the replaced order be used to replace the replacement order.
+	_, _, _, err = memclob.ReplaceOrder(ctx, types.NewMsgReplaceOrder(aliceOrder))
+	require.ErrorIs(t, err, types.ErrInvalidReplaceOrder)
+}
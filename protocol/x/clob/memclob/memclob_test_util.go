@@ -11,7 +11,8 @@ import (
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
-	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -784,9 +785,11 @@ func requireOrderExistsInMemclob(
 	require.NoError(t, err)
 	require.Contains(t, subaccountOpenOrders, order)
 
-	// If the order is a Short-Term order, verify the order was added to the block expiration map.
+	// If the order is a Short-Term order, verify the order was added to the block or time expiration map.
 	// Else, verify it was not added to the block expiration map.
-	if order.OrderId.IsShortTermOrder() {
+	if order.IsGoodTilBlockTimeShortTermOrder() {
+		require.Contains(t, memclob.openOrders.timeExpirationsForOrders[order.GetGoodTilBlockTime()], order.OrderId)
+	} else if order.OrderId.IsShortTermOrder() {
 		require.Contains(t, memclob.openOrders.blockExpirationsForOrders[order.GetGoodTilBlock()], order.OrderId)
 	} else {
 		require.NotContains(t, memclob.openOrders.blockExpirationsForOrders[order.GetGoodTilBlock()], order.OrderId)
@@ -839,8 +842,10 @@ func requireOrderDoesNotExistInMemclob(
 	require.NoError(t, err)
 	require.NotContains(t, subaccountOpenOrders, order)
 
-	// Verify the order was not added to the block expiration map.
-	if order.OrderId.IsShortTermOrder() {
+	// Verify the order was not added to the block or time expiration map.
+	if order.IsGoodTilBlockTimeShortTermOrder() {
+		require.NotContains(t, memclob.openOrders.timeExpirationsForOrders[order.GetGoodTilBlockTime()], order.OrderId)
+	} else if order.OrderId.IsShortTermOrder() {
 		require.NotContains(t, memclob.openOrders.blockExpirationsForOrders[order.GetGoodTilBlock()], order.OrderId)
 	}
 
@@ -1326,8 +1331,8 @@ func assertPlaceOrderOffchainMessages(
 			updateMessage := off_chain_updates.MustCreateOrderRemoveMessageWithReason(
 				noopLogger,
 				order.OrderId,
-				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REPLACED,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			)
 			expectedOffchainMessages = append(
 				expectedOffchainMessages,
@@ -1355,8 +1360,8 @@ func assertPlaceOrderOffchainMessages(
 		cancelMessage := off_chain_updates.MustCreateOrderRemoveMessageWithReason(
 			noopLogger,
 			orderId,
-			indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE,
-			off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+			sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE,
+			ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 		)
 		// If the reduce-only order was seen before updates, add it to the set so we don't try to check
 		// for it later.
@@ -1384,8 +1389,8 @@ func assertPlaceOrderOffchainMessages(
 				updateMessage := off_chain_updates.MustCreateOrderRemoveMessageWithReason(
 					noopLogger,
 					matchOrder.OrderId,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 				)
 
 				expectedOffchainMessages = append(
@@ -1442,8 +1447,8 @@ func assertPlaceOrderOffchainMessages(
 		cancelMessage := off_chain_updates.MustCreateOrderRemoveMessageWithReason(
 			noopLogger,
 			orderId,
-			indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE,
-			off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+			sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE,
+			ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 		)
 		expectedOffchainMessages = append(
 			expectedOffchainMessages,
@@ -1476,7 +1481,7 @@ func assertPlaceOrderOffchainMessages(
 			order.OrderId,
 			expectedOrderStatus,
 			expectedErr,
-			off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+			ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 		)
 		expectedOffchainMessages = append(
 			expectedOffchainMessages,
@@ -1539,8 +1544,8 @@ func getExpectedPlacePerpetualLiquidationOffchainMessages(
 			updateMessage := off_chain_updates.MustCreateOrderRemoveMessageWithReason(
 				noopLogger,
 				order.OrderId,
-				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_ERROR,
-				off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_ERROR,
+				ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 			)
 
 			expectedOffchainMessages = append(
@@ -1554,8 +1559,8 @@ func getExpectedPlacePerpetualLiquidationOffchainMessages(
 				updateMessage := off_chain_updates.MustCreateOrderRemoveMessageWithReason(
 					noopLogger,
 					order.OrderId,
-					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED,
-					off_chain_updates.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 				)
 
 				expectedOffchainMessages = append(
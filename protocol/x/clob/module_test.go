@@ -45,7 +45,8 @@ func getValidGenesisStr() string {
 	gs += `"liquidations_config":{`
 	gs += `"max_liquidation_fee_ppm":5000,"position_block_limits":{"min_position_notional_liquidated":"1000",`
 	gs += `"max_position_portion_liquidated_ppm":1000000},"subaccount_block_limits":`
-	gs += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000"},`
+	gs += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000",`
+	gs += `"max_perpetual_positions_liquidated":0},`
 	gs += `"fillable_price_config":{"bankruptcy_adjustment_ppm":1000000,`
 	gs += `"spread_to_maintenance_margin_ratio_ppm":100000}},"block_rate_limit_config":`
 	gs += `{"max_short_term_orders_per_n_blocks":[{"limit": 200,"num_blocks":1}],`
@@ -152,7 +153,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	mockRegistry.On("RegisterImplementations", (*sdk.Msg)(nil), mock.Anything).Return()
 	mockRegistry.On("RegisterImplementations", (*tx.MsgResponse)(nil), mock.Anything).Return()
 	am.RegisterInterfaces(mockRegistry)
-	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 16)
+	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 24)
 	mockRegistry.AssertExpectations(t)
 }
 
@@ -169,9 +170,11 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	expected := `{"clob_pairs":[],"liquidations_config":{`
 	expected += `"max_liquidation_fee_ppm":5000,"position_block_limits":{"min_position_notional_liquidated":"1000",`
 	expected += `"max_position_portion_liquidated_ppm":1000000},"subaccount_block_limits":`
-	expected += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000"},`
+	expected += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000",`
+	expected += `"max_perpetual_positions_liquidated":5},`
 	expected += `"fillable_price_config":{"bankruptcy_adjustment_ppm":1000000,`
-	expected += `"spread_to_maintenance_margin_ratio_ppm":100000}},"block_rate_limit_config":`
+	expected += `"spread_to_maintenance_margin_ratio_ppm":100000},"target_margin_config":{"enabled":false,`
+	expected += `"maintenance_margin_buffer_ppm":0}},"block_rate_limit_config":`
 	expected += `{"max_short_term_orders_per_n_blocks":[],"max_stateful_orders_per_n_blocks":[],`
 	expected += `"max_short_term_order_cancellations_per_n_blocks":[]},`
 	expected += `"equity_tier_limit_config":{"short_term_order_equity_tiers":[], "stateful_order_equity_tiers":[]}}`
@@ -263,9 +266,12 @@ func TestAppModuleBasic_GetTxCmd(t *testing.T) {
 
 	cmd := am.GetTxCmd()
 	require.Equal(t, "clob", cmd.Use)
-	require.Equal(t, 2, len(cmd.Commands()))
-	require.Equal(t, "cancel-order", cmd.Commands()[0].Name())
-	require.Equal(t, "place-order", cmd.Commands()[1].Name())
+	require.Equal(t, 5, len(cmd.Commands()))
+	require.Equal(t, "batch-cancel", cmd.Commands()[0].Name())
+	require.Equal(t, "cancel-all-orders", cmd.Commands()[1].Name())
+	require.Equal(t, "cancel-order", cmd.Commands()[2].Name())
+	require.Equal(t, "place-order", cmd.Commands()[3].Name())
+	require.Equal(t, "replace-order", cmd.Commands()[4].Name())
 }
 
 func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
@@ -273,12 +279,16 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "clob", cmd.Use)
-	require.Equal(t, 5, len(cmd.Commands()))
+	require.Equal(t, 9, len(cmd.Commands()))
 	require.Equal(t, "get-block-rate-limit-config", cmd.Commands()[0].Name())
 	require.Equal(t, "get-equity-tier-limit-config", cmd.Commands()[1].Name())
 	require.Equal(t, "get-liquidations-config", cmd.Commands()[2].Name())
 	require.Equal(t, "list-clob-pair", cmd.Commands()[3].Name())
-	require.Equal(t, "show-clob-pair", cmd.Commands()[4].Name())
+	require.Equal(t, "list-subaccount-open-orders", cmd.Commands()[4].Name())
+	require.Equal(t, "show-adl-rank", cmd.Commands()[5].Name())
+	require.Equal(t, "show-clob-pair", cmd.Commands()[6].Name())
+	require.Equal(t, "show-order-fill-state", cmd.Commands()[7].Name())
+	require.Equal(t, "show-orderbook-l2", cmd.Commands()[8].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -458,9 +468,11 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected += `"liquidations_config":{`
 	expected += `"max_liquidation_fee_ppm":5000,"position_block_limits":{"min_position_notional_liquidated":"1000",`
 	expected += `"max_position_portion_liquidated_ppm":1000000},"subaccount_block_limits":`
-	expected += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000"},`
+	expected += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000",`
+	expected += `"max_perpetual_positions_liquidated":0},`
 	expected += `"fillable_price_config":{"bankruptcy_adjustment_ppm":1000000,`
-	expected += `"spread_to_maintenance_margin_ratio_ppm":100000}},"block_rate_limit_config":`
+	expected += `"spread_to_maintenance_margin_ratio_ppm":100000},"target_margin_config":{"enabled":false,`
+	expected += `"maintenance_margin_buffer_ppm":0}},"block_rate_limit_config":`
 	expected += `{"max_short_term_orders_per_n_blocks":[{"limit": 200,"num_blocks":1}],`
 	expected += `"max_stateful_orders_per_n_blocks":[{"limit": 2,"num_blocks":1},`
 	expected += `{"limit": 20,"num_blocks":100}],"max_short_term_order_cancellations_per_n_blocks":`
@@ -22,6 +22,18 @@ type ClobKeeper interface {
 		success bool,
 		successPerUpdate map[satypes.SubaccountId]satypes.UpdateResult,
 	)
+	BatchCancelShortTermOrders(ctx sdk.Context, msg *MsgBatchCancel) (
+		canceledOrderIds []OrderId,
+		failedOrderIds []OrderId,
+		err error,
+	)
+	BatchCancelStatefulOrders(ctx sdk.Context, msg *MsgBatchCancel) (
+		canceledOrderIds []OrderId,
+		failedOrderIds []OrderId,
+		err error,
+	)
+	CancelAllShortTermOrders(ctx sdk.Context, msg *MsgCancelAllOrders) (canceledOrderIds []OrderId, err error)
+	CancelAllStatefulOrders(ctx sdk.Context, msg *MsgCancelAllOrders) (canceledOrderIds []OrderId, err error)
 	CancelShortTermOrder(ctx sdk.Context, msg *MsgCancelOrder) error
 	CancelStatefulOrder(ctx sdk.Context, msg *MsgCancelOrder) error
 	CreatePerpetualClobPair(
@@ -36,6 +48,20 @@ type ClobKeeper interface {
 		ClobPair,
 		error,
 	)
+	CreateSpotClobPair(
+		ctx sdk.Context,
+		clobPairId uint32,
+		baseAssetId uint32,
+		quoteAssetId uint32,
+		stepSizeInBaseQuantums satypes.BaseQuantums,
+		quantumConversionExponent int32,
+		subticksPerTick uint32,
+		status ClobPair_Status,
+	) (
+		ClobPair,
+		error,
+	)
+	DelistClobPair(ctx sdk.Context, clobPairId ClobPairId, settlementPrice uint64) error
 	GetAllClobPairs(ctx sdk.Context) (list []ClobPair)
 	GetClobPair(ctx sdk.Context, id ClobPairId) (val ClobPair, found bool)
 	HasAuthority(authority string) bool
@@ -45,6 +71,11 @@ type ClobKeeper interface {
 		err error,
 	)
 	PlaceStatefulOrder(ctx sdk.Context, msg *MsgPlaceOrder) error
+	ReplaceShortTermOrder(ctx sdk.Context, msg *MsgReplaceOrder) (
+		orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+		orderStatus OrderStatus,
+		err error,
+	)
 	PruneStateFillAmountsForShortTermOrders(
 		ctx sdk.Context,
 	)
@@ -117,8 +148,11 @@ type ClobKeeper interface {
 		isPreexistingStatefulOrder bool,
 	) error
 	GetIndexerEventManager() indexer_manager.IndexerEventManager
+	RateLimitBatchCancel(ctx sdk.Context, msg *MsgBatchCancel) error
+	RateLimitCancelAllOrders(ctx sdk.Context, msg *MsgCancelAllOrders) error
 	RateLimitCancelOrder(ctx sdk.Context, order *MsgCancelOrder) error
 	RateLimitPlaceOrder(ctx sdk.Context, order *MsgPlaceOrder) error
+	RateLimitReplaceOrder(ctx sdk.Context, msg *MsgReplaceOrder) error
 	InitializeBlockRateLimit(ctx sdk.Context, config BlockRateLimitConfiguration) error
 	InitializeEquityTierLimit(ctx sdk.Context, config EquityTierLimitConfiguration) error
 	Logger(ctx sdk.Context) log.Logger
@@ -5,6 +5,10 @@ import (
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
+// MaxFinalSettlementPositionsPerBlock is the maximum number of positions closed in final settlement
+// per block, across all clob pairs in final settlement.
+const MaxFinalSettlementPositionsPerBlock = 200
+
 // SupportedClobPairStatusTransitions has keys corresponding to currently-supported
 // ClobPair_Status types with values equal to the set of ClobPair_Status types that
 // may be transitioned to from this state. Note the keys of this map may be
@@ -14,6 +18,8 @@ var SupportedClobPairStatusTransitions = map[ClobPair_Status]map[ClobPair_Status
 	ClobPair_STATUS_INITIALIZING: {
 		ClobPair_STATUS_ACTIVE: struct{}{},
 	},
+	// Clob pairs can only be moved to final settlement by delisting them, see `DelistClobPair`.
+	ClobPair_STATUS_FINAL_SETTLEMENT: {},
 }
 
 // IsSupportedClobPairStatus returns true if the provided ClobPair_Status is in the list
@@ -66,13 +72,15 @@ func (c *ClobPair) GetClobPairId() ClobPairId {
 // Stateless validation on ClobPair.
 func (c *ClobPair) Validate() error {
 	switch c.Metadata.(type) {
-	// TODO(DEC-1535): update this when additional clob pair types are supported.
 	case *ClobPair_SpotClobMetadata:
-		return errorsmod.Wrapf(
-			ErrInvalidClobPairParameter,
-			"CLOB pair (%+v) is not a perpetual CLOB.",
-			c,
-		)
+		spotClobMetadata := c.GetSpotClobMetadata()
+		if spotClobMetadata.GetBaseAssetId() == spotClobMetadata.GetQuoteAssetId() {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) must have different base and quote assets.",
+				c,
+			)
+		}
 	}
 
 	if !IsSupportedClobPairStatus(c.Status) {
@@ -43,6 +43,10 @@ const (
 	// Clob pairs in this state only accept orders which are
 	// both short-term and post-only.
 	ClobPair_STATUS_INITIALIZING ClobPair_Status = 5
+	// STATUS_FINAL_SETTLEMENT represents a clob pair which was delisted.
+	// All open positions in its perpetual were closed at the settlement
+	// price, and clob pairs in this state don't accept any orders.
+	ClobPair_STATUS_FINAL_SETTLEMENT ClobPair_Status = 6
 )
 
 var ClobPair_Status_name = map[int32]string{
@@ -52,15 +56,17 @@ var ClobPair_Status_name = map[int32]string{
 	3: "STATUS_CANCEL_ONLY",
 	4: "STATUS_POST_ONLY",
 	5: "STATUS_INITIALIZING",
+	6: "STATUS_FINAL_SETTLEMENT",
 }
 
 var ClobPair_Status_value = map[string]int32{
-	"STATUS_UNSPECIFIED":  0,
-	"STATUS_ACTIVE":       1,
-	"STATUS_PAUSED":       2,
-	"STATUS_CANCEL_ONLY":  3,
-	"STATUS_POST_ONLY":    4,
-	"STATUS_INITIALIZING": 5,
+	"STATUS_UNSPECIFIED":      0,
+	"STATUS_ACTIVE":           1,
+	"STATUS_PAUSED":           2,
+	"STATUS_CANCEL_ONLY":      3,
+	"STATUS_POST_ONLY":        4,
+	"STATUS_INITIALIZING":     5,
+	"STATUS_FINAL_SETTLEMENT": 6,
 }
 
 func (x ClobPair_Status) String() string {
@@ -323,40 +329,41 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/clob/clob_pair.proto", fileDescriptor_178b475635886947) }
 
 var fileDescriptor_178b475635886947 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xd1, 0x6b, 0xd3, 0x40,
-	0x1c, 0xc7, 0x93, 0xae, 0xab, 0xe3, 0xb6, 0xd6, 0xf4, 0x74, 0xae, 0x22, 0x84, 0x2e, 0xfa, 0x50,
-	0x44, 0x53, 0x98, 0xe2, 0xc3, 0x1e, 0x84, 0x34, 0x8b, 0x2e, 0x50, 0xbb, 0x98, 0xa4, 0x82, 0x43,
-	0x08, 0x97, 0xe4, 0x70, 0x61, 0x6d, 0xee, 0x96, 0xbb, 0x8c, 0xee, 0x8f, 0x10, 0xfc, 0xa7, 0x04,
-	0x1f, 0xf7, 0xe8, 0xa3, 0xb4, 0xff, 0x88, 0xe4, 0x9a, 0xd6, 0xae, 0xeb, 0x4b, 0x08, 0x9f, 0xdf,
-	0xe7, 0xf7, 0xe5, 0x7e, 0xbf, 0xe3, 0xc0, 0x61, 0x7c, 0x13, 0x4f, 0x68, 0x46, 0x38, 0x89, 0xc8,
-	0xa8, 0x1b, 0x8d, 0x48, 0x28, 0x3e, 0x01, 0x45, 0x49, 0xa6, 0x0b, 0x0e, 0x9b, 0xab, 0x8a, 0x5e,
-	0x54, 0xb5, 0x63, 0xb0, 0xef, 0xe0, 0x8c, 0x62, 0x9e, 0xa3, 0x91, 0x39, 0x22, 0xe1, 0x27, 0xcc,
-	0x51, 0x8c, 0x38, 0x82, 0x87, 0x60, 0x8f, 0x2e, 0x0a, 0x41, 0x12, 0xb7, 0xe4, 0xb6, 0xdc, 0xa9,
-	0xbb, 0xbb, 0x4b, 0x66, 0xc7, 0xda, 0x37, 0xa0, 0x78, 0x94, 0xf0, 0x3b, 0x6d, 0x1a, 0xa8, 0x87,
-	0x88, 0xe1, 0x00, 0x31, 0x86, 0xf9, 0x4a, 0x5f, 0x01, 0x8d, 0x82, 0xd9, 0x31, 0x7c, 0x01, 0x1a,
-	0x57, 0x39, 0xe1, 0x2b, 0x52, 0x45, 0x48, 0x7b, 0x82, 0x96, 0x96, 0xf6, 0xab, 0x0a, 0x76, 0x8a,
-	0x68, 0x07, 0x25, 0x19, 0x6c, 0x80, 0xca, 0x32, 0xab, 0x92, 0xc4, 0x30, 0x04, 0x07, 0xff, 0x4f,
-	0x27, 0xc6, 0x1c, 0x97, 0x27, 0x10, 0x59, 0xbb, 0x47, 0x1d, 0xfd, 0xde, 0xac, 0xfa, 0xc6, 0x41,
-	0x4f, 0x25, 0x77, 0x9f, 0x6e, 0xdc, 0x80, 0x07, 0x20, 0xa3, 0x84, 0xaf, 0xc5, 0x6f, 0x89, 0xf8,
-	0xe7, 0x1b, 0xe2, 0xd7, 0x77, 0x71, 0x2a, 0xb9, 0x0a, 0x5b, 0xdf, 0xcf, 0x2b, 0x00, 0x19, 0xc7,
-	0x34, 0x10, 0x4b, 0xba, 0xca, 0x51, 0xca, 0xf3, 0x31, 0x6b, 0x55, 0xdb, 0x72, 0xa7, 0xea, 0x2a,
-	0x45, 0xa5, 0x87, 0x18, 0xfe, 0x5c, 0x72, 0xf8, 0x12, 0x34, 0x59, 0x1e, 0xf2, 0x24, 0xba, 0x64,
-	0x01, 0xc5, 0x59, 0x50, 0xfc, 0xb5, 0xb6, 0xc5, 0x16, 0x1e, 0x2e, 0x0a, 0x0e, 0xce, 0xfc, 0x24,
-	0xba, 0x84, 0xef, 0xc1, 0xb3, 0x32, 0x2f, 0x88, 0x48, 0x7a, 0x8d, 0x33, 0x96, 0x90, 0x34, 0xc0,
-	0x13, 0x4a, 0x52, 0x9c, 0xf2, 0x56, 0xad, 0x2d, 0x77, 0x9a, 0xee, 0xd3, 0x52, 0x31, 0x97, 0x86,
-	0x55, 0x0a, 0xf0, 0x18, 0xd4, 0x18, 0x47, 0x3c, 0x67, 0xad, 0x07, 0x6d, 0xb9, 0xd3, 0x38, 0xd2,
-	0x36, 0x8c, 0xb8, 0xb8, 0x0f, 0xdd, 0x13, 0xa6, 0x5b, 0x76, 0x68, 0x3f, 0x64, 0x50, 0x9b, 0x23,
-	0xf8, 0x04, 0x40, 0xcf, 0x37, 0xfc, 0xa1, 0x17, 0x0c, 0x07, 0x9e, 0x63, 0x99, 0xf6, 0x07, 0xdb,
-	0x3a, 0x51, 0x24, 0xd8, 0x04, 0xf5, 0x92, 0x1b, 0xa6, 0x6f, 0x7f, 0xb1, 0x14, 0x79, 0x05, 0x39,
-	0xc6, 0xd0, 0xb3, 0x4e, 0x94, 0xca, 0x4a, 0xb7, 0x69, 0x0c, 0x4c, 0xab, 0x1f, 0x9c, 0x0d, 0xfa,
-	0x5f, 0x95, 0x2d, 0xf8, 0x18, 0x28, 0x0b, 0xf5, 0xcc, 0xf3, 0xe7, 0xb4, 0x0a, 0x0f, 0xc0, 0xa3,
-	0x92, 0xda, 0x03, 0xdb, 0xb7, 0x8d, 0xbe, 0x7d, 0x6e, 0x0f, 0x3e, 0x2a, 0xdb, 0x3d, 0x00, 0x76,
-	0x16, 0x17, 0xd6, 0x73, 0x7e, 0x4f, 0x55, 0xf9, 0x76, 0xaa, 0xca, 0x7f, 0xa7, 0xaa, 0xfc, 0x73,
-	0xa6, 0x4a, 0xb7, 0x33, 0x55, 0xfa, 0x33, 0x53, 0xa5, 0xf3, 0x77, 0xdf, 0x13, 0x7e, 0x91, 0x87,
-	0x7a, 0x44, 0xc6, 0xdd, 0x3b, 0x8f, 0xe7, 0xfa, 0xed, 0xeb, 0xe8, 0x02, 0x25, 0x69, 0x77, 0x49,
-	0x26, 0xf3, 0x07, 0xc5, 0x6f, 0x28, 0x66, 0x61, 0x4d, 0xe0, 0x37, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xc5, 0xb8, 0x66, 0x12, 0x72, 0x03, 0x00, 0x00,
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6a, 0xdb, 0x30,
+	0x1c, 0xc6, 0xed, 0x34, 0xcd, 0x8a, 0xda, 0x64, 0x8e, 0xb6, 0x2e, 0x19, 0x05, 0x93, 0x7a, 0x3b,
+	0x84, 0xb1, 0x39, 0xd0, 0x8d, 0x1d, 0x7a, 0x18, 0x38, 0xae, 0xbb, 0x1a, 0x52, 0xd7, 0xb3, 0x9d,
+	0xc1, 0xca, 0xc0, 0xc8, 0xb6, 0x58, 0x4d, 0x13, 0xcb, 0xb5, 0xe4, 0x92, 0xbe, 0xc5, 0x9e, 0x63,
+	0x4f, 0xb2, 0x63, 0x8f, 0x3b, 0x8e, 0xe4, 0x45, 0x86, 0x15, 0x27, 0x4b, 0xd3, 0x5c, 0x8c, 0xf9,
+	0x7d, 0xdf, 0xff, 0x43, 0xff, 0x4f, 0x08, 0x1c, 0x46, 0x77, 0xd1, 0x24, 0xcd, 0x08, 0x23, 0x21,
+	0x19, 0xf5, 0xc2, 0x11, 0x09, 0xf8, 0xc7, 0x4f, 0x51, 0x9c, 0xa9, 0x9c, 0xc3, 0xe6, 0xaa, 0x45,
+	0x2d, 0x54, 0xe5, 0x18, 0xec, 0xdb, 0x38, 0x4b, 0x31, 0xcb, 0xd1, 0x48, 0x1f, 0x91, 0xe0, 0x1c,
+	0x33, 0x14, 0x21, 0x86, 0xe0, 0x21, 0xd8, 0x4b, 0x17, 0x82, 0x1f, 0x47, 0x6d, 0xb1, 0x23, 0x76,
+	0xeb, 0xce, 0xee, 0x92, 0x99, 0x91, 0xf2, 0x1d, 0x48, 0x6e, 0x4a, 0xd8, 0x83, 0x31, 0x05, 0xd4,
+	0x03, 0x44, 0xb1, 0x8f, 0x28, 0xc5, 0x6c, 0x65, 0xae, 0x80, 0x5a, 0xc1, 0xcc, 0x08, 0xbe, 0x06,
+	0x8d, 0x9b, 0x9c, 0xb0, 0x15, 0x53, 0x85, 0x9b, 0xf6, 0x38, 0x2d, 0x5d, 0xca, 0xb4, 0x0a, 0x76,
+	0x8a, 0x68, 0x1b, 0xc5, 0x19, 0x6c, 0x80, 0xca, 0x32, 0xab, 0x12, 0x47, 0x30, 0x00, 0xad, 0xff,
+	0xa7, 0xe3, 0x6b, 0x8e, 0xcb, 0x13, 0xf0, 0xac, 0xdd, 0xa3, 0xae, 0xfa, 0x68, 0x57, 0x75, 0xe3,
+	0xa2, 0x67, 0x82, 0xb3, 0x9f, 0x6e, 0x6c, 0xc0, 0x05, 0x90, 0xa6, 0x84, 0xad, 0xc5, 0x6f, 0xf1,
+	0xf8, 0x57, 0x1b, 0xe2, 0xd7, 0xbb, 0x38, 0x13, 0x1c, 0x89, 0xae, 0xf7, 0xf3, 0x16, 0x40, 0xca,
+	0x70, 0xea, 0xf3, 0x92, 0x6e, 0x72, 0x94, 0xb0, 0x7c, 0x4c, 0xdb, 0xd5, 0x8e, 0xd8, 0xad, 0x3a,
+	0x52, 0xa1, 0xf4, 0x11, 0xc5, 0x5f, 0x4a, 0x0e, 0xdf, 0x80, 0x26, 0xcd, 0x03, 0x16, 0x87, 0xd7,
+	0xd4, 0x4f, 0x71, 0xe6, 0x17, 0x7f, 0xed, 0x6d, 0xde, 0xc2, 0xd3, 0x85, 0x60, 0xe3, 0xcc, 0x8b,
+	0xc3, 0x6b, 0xf8, 0x09, 0x1c, 0x94, 0x79, 0x7e, 0x48, 0x92, 0x5b, 0x9c, 0xd1, 0x98, 0x24, 0x3e,
+	0x9e, 0xa4, 0x24, 0xc1, 0x09, 0x6b, 0xd7, 0x3a, 0x62, 0xb7, 0xe9, 0xbc, 0x2c, 0x2d, 0xfa, 0xd2,
+	0x61, 0x94, 0x06, 0x78, 0x0c, 0x6a, 0x94, 0x21, 0x96, 0xd3, 0xf6, 0x93, 0x8e, 0xd8, 0x6d, 0x1c,
+	0x29, 0x1b, 0x56, 0x5c, 0xdc, 0x87, 0xea, 0x72, 0xa7, 0x53, 0x4e, 0x28, 0xbf, 0x44, 0x50, 0x9b,
+	0x23, 0xf8, 0x02, 0x40, 0xd7, 0xd3, 0xbc, 0xa1, 0xeb, 0x0f, 0x2d, 0xd7, 0x36, 0x74, 0xf3, 0xd4,
+	0x34, 0x4e, 0x24, 0x01, 0x36, 0x41, 0xbd, 0xe4, 0x9a, 0xee, 0x99, 0x5f, 0x0d, 0x49, 0x5c, 0x41,
+	0xb6, 0x36, 0x74, 0x8d, 0x13, 0xa9, 0xb2, 0x32, 0xad, 0x6b, 0x96, 0x6e, 0x0c, 0xfc, 0x0b, 0x6b,
+	0xf0, 0x4d, 0xda, 0x82, 0xcf, 0x81, 0xb4, 0xb0, 0x5e, 0xb8, 0xde, 0x9c, 0x56, 0x61, 0x0b, 0x3c,
+	0x2b, 0xa9, 0x69, 0x99, 0x9e, 0xa9, 0x0d, 0xcc, 0x4b, 0xd3, 0xfa, 0x2c, 0x6d, 0xc3, 0x03, 0xd0,
+	0x2a, 0x85, 0x53, 0xd3, 0xd2, 0x06, 0xbe, 0x6b, 0x78, 0xde, 0xc0, 0x38, 0x37, 0x2c, 0x4f, 0xaa,
+	0xf5, 0x01, 0xd8, 0x59, 0xdc, 0x66, 0xdf, 0xfe, 0x3d, 0x95, 0xc5, 0xfb, 0xa9, 0x2c, 0xfe, 0x9d,
+	0xca, 0xe2, 0xcf, 0x99, 0x2c, 0xdc, 0xcf, 0x64, 0xe1, 0xcf, 0x4c, 0x16, 0x2e, 0x3f, 0xfe, 0x88,
+	0xd9, 0x55, 0x1e, 0xa8, 0x21, 0x19, 0xf7, 0x1e, 0xbc, 0xac, 0xdb, 0x0f, 0xef, 0xc2, 0x2b, 0x14,
+	0x27, 0xbd, 0x25, 0x99, 0xcc, 0x5f, 0x1b, 0xbb, 0x4b, 0x31, 0x0d, 0x6a, 0x1c, 0xbf, 0xff, 0x37,
+	0x00, 0x96, 0xa7, 0xc6, 0x50, 0x8f, 0x03, 0x00, 0x00,
 }
 
 func (m *PerpetualClobMetadata) Marshal() (dAtA []byte, err error) {
@@ -39,9 +39,10 @@ func TestGetPerpetualId(t *testing.T) {
 }
 
 func TestIsSupportedClobPairStatus_Supported(t *testing.T) {
-	// these are the only two supported statuses
+	// these are the only three supported statuses
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_ACTIVE))
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_INITIALIZING))
+	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_FINAL_SETTLEMENT))
 }
 
 func TestIsSupportedClobPairStatus_Unsupported(t *testing.T) {
@@ -201,6 +201,16 @@ var (
 		43,
 		"Order has remaining size",
 	)
+	ErrGoodTilBlockTimeExceedsShortTermOrderTimeWindow = errorsmod.Register(
+		ModuleName,
+		44,
+		"The GoodTilBlockTime of the Short-Term order is further than ShortTermOrderTimeWindow into the future",
+	)
+	ErrInvalidShortTermOrderGoodTilBlockTime = errorsmod.Register(
+		ModuleName,
+		45,
+		"Invalid Short-Term order goodTilBlockTime",
+	)
 
 	// Liquidations errors.
 	ErrInvalidLiquidationsConfig = errorsmod.Register(
@@ -308,6 +318,51 @@ var (
 		1020,
 		"Position cannot be fully offset",
 	)
+	ErrNoBorrowedAssetPosition = errorsmod.Register(
+		ModuleName,
+		1021,
+		"Subaccount does not have a borrowed position for asset",
+	)
+	ErrDeleveragingQuoteAsset = errorsmod.Register(
+		ModuleName,
+		1022,
+		"Cannot deleverage the quote asset",
+	)
+	ErrInvalidAssetPositionSizeDelta = errorsmod.Register(
+		ModuleName,
+		1023,
+		"Invalid asset position size delta",
+	)
+	ErrNoSeizableCollateralAssetPosition = errorsmod.Register(
+		ModuleName,
+		1024,
+		"Subaccount does not have a collateral position for asset that can be seized",
+	)
+	ErrInsufficientQuoteBalanceToBuySeizedCollateral = errorsmod.Register(
+		ModuleName,
+		1025,
+		"Offsetting subaccount does not have enough quote balance to buy seized collateral",
+	)
+	ErrLiquidatingQuoteAsset = errorsmod.Register(
+		ModuleName,
+		1026,
+		"Cannot liquidate the quote asset",
+	)
+	ErrNoSpotClobPairForAsset = errorsmod.Register(
+		ModuleName,
+		1027,
+		"No spot CLOB pair exists to liquidate the asset against",
+	)
+	ErrClobPairAndAssetDoNotMatch = errorsmod.Register(
+		ModuleName,
+		1028,
+		"CLOB pair and asset ID do not match",
+	)
+	ErrLiquidationExceedsSubaccountMaxPositionsLiquidated = errorsmod.Register(
+		ModuleName,
+		1029,
+		"Liquidation exceeds the maximum number of perpetual positions a subaccount can have liquidated per block",
+	)
 
 	// Advanced order type errors.
 	ErrFokOrderCouldNotBeFullyFilled = errorsmod.Register(
@@ -463,6 +518,11 @@ var (
 		6002,
 		"Conditional order is untriggered",
 	)
+	ErrInvalidConditionalOrderTrailingDistance = errorsmod.Register(
+		ModuleName,
+		6003,
+		"Conditional order trailing distance is invalid",
+	)
 
 	// Errors for unimplemented and disabled functionality.
 	ErrAssetOrdersNotImplemented = errorsmod.Register(
@@ -497,4 +557,74 @@ var (
 		10001,
 		"Subaccount cannot open more orders due to equity tier limit.",
 	)
+
+	// GrpcStreamingManager errors.
+	ErrGrpcStreamingManagerNotEnabled = errorsmod.Register(
+		ModuleName,
+		11000,
+		"GrpcStreamingManager is not enabled",
+	)
+	ErrInvalidGrpcStreamingRequest = errorsmod.Register(
+		ModuleName,
+		11001,
+		"Invalid gRPC streaming request",
+	)
+	ErrGrpcStreamingBufferFull = errorsmod.Register(
+		ModuleName,
+		11002,
+		"gRPC stream fell too far behind and was closed",
+	)
+
+	// Order group errors.
+	ErrInvalidOrderGroup = errorsmod.Register(
+		ModuleName,
+		12000,
+		"Order group is invalid",
+	)
+
+	// Batch and mass cancellation errors.
+	ErrInvalidBatchCancel = errorsmod.Register(
+		ModuleName,
+		13000,
+		"Batch cancel is invalid",
+	)
+	ErrBatchCancelFailed = errorsmod.Register(
+		ModuleName,
+		13001,
+		"None of the orders in the batch could be canceled",
+	)
+	ErrInvalidCancelAllOrders = errorsmod.Register(
+		ModuleName,
+		13002,
+		"Cancel all orders is invalid",
+	)
+
+	// Order replacement errors.
+	ErrInvalidReplaceOrder = errorsmod.Register(
+		ModuleName,
+		14000,
+		"Replace order is invalid",
+	)
+	ErrReplacedOrderDoesNotExist = errorsmod.Register(
+		ModuleName,
+		14001,
+		"Order to be replaced does not exist",
+	)
+
+	// Final settlement errors.
+	ErrInvalidDelistClobPair = errorsmod.Register(
+		ModuleName,
+		15000,
+		"Delist clob pair is invalid",
+	)
+	ErrClobPairNotDelistable = errorsmod.Register(
+		ModuleName,
+		15001,
+		"ClobPair cannot be delisted",
+	)
+	ErrInsufficientInsuranceFundForFinalSettlement = errorsmod.Register(
+		ModuleName,
+		15002,
+		"Insurance fund balance is insufficient to settle all positions",
+	)
 )
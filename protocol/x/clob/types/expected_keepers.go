@@ -31,6 +31,25 @@ type SubaccountsKeeper interface {
 		bigMaintenanceMargin *big.Int,
 		err error,
 	)
+	GetCrossNetCollateralAndMarginRequirements(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+	) (
+		bigNetCollateral *big.Int,
+		bigInitialMargin *big.Int,
+		bigMaintenanceMargin *big.Int,
+		err error,
+	)
+	GetIsolatedNetCollateralAndMarginRequirements(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		perpetualId uint32,
+	) (
+		bigNetCollateral *big.Int,
+		bigInitialMargin *big.Int,
+		bigMaintenanceMargin *big.Int,
+		err error,
+	)
 	GetSubaccount(
 		ctx sdk.Context,
 		id satypes.SubaccountId,
@@ -47,6 +66,12 @@ type SubaccountsKeeper interface {
 		callback func(satypes.Subaccount) (finished bool),
 		rand *rand.Rand,
 	)
+	ForEachSubaccountIdWithPerpetualPosition(
+		ctx sdk.Context,
+		perpetualId uint32,
+		isLong bool,
+		callback func(satypes.SubaccountId) (finished bool),
+	)
 	GetRandomSubaccount(
 		ctx sdk.Context,
 		rand *rand.Rand,
@@ -62,6 +87,12 @@ type SubaccountsKeeper interface {
 		successPerUpdate []satypes.UpdateResult,
 		err error,
 	)
+	ForceUpdateSubaccounts(
+		ctx sdk.Context,
+		updates []satypes.Update,
+	) (
+		err error,
+	)
 	TransferFeesToFeeCollectorModule(
 		ctx sdk.Context,
 		assetId uint32,
@@ -75,6 +106,13 @@ type SubaccountsKeeper interface {
 
 type AssetsKeeper interface {
 	GetAsset(ctx sdk.Context, id uint32) (val assettypes.Asset, exists bool)
+	GetAssetAndMarketPrice(ctx sdk.Context, id uint32) (assettypes.Asset, pricestypes.MarketPrice, error)
+	GetNetCollateral(ctx sdk.Context, id uint32, bigQuantums *big.Int) (*big.Int, error)
+	GetMarginRequirements(
+		ctx sdk.Context,
+		id uint32,
+		bigQuantums *big.Int,
+	) (initialMargin *big.Int, maintenanceMargin *big.Int, err error)
 }
 
 type BlockTimeKeeper interface {
@@ -138,6 +176,8 @@ type PerpetualsKeeper interface {
 		err error,
 	)
 	MaybeProcessNewFundingTickEpoch(ctx sdk.Context)
+	ProcessFinalFunding(ctx sdk.Context, perpetualId uint32) error
+	SettlePerpetual(ctx sdk.Context, perpetualId uint32, settlementPrice uint64) error
 }
 
 type StatsKeeper interface {
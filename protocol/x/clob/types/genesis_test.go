@@ -266,6 +266,22 @@ func TestGenesisState_Validate(t *testing.T) {
 			expectedError: errors.New(
 				"0 is not a valid MaxQuantumsInsuranceLost: Proposed LiquidationsConfig is invalid"),
 		},
+		"maintenance margin buffer above 100% is invalid": {
+			genState: &types.GenesisState{
+				LiquidationsConfig: types.LiquidationsConfig{
+					MaxLiquidationFeePpm:  lib.OneMillion,
+					FillablePriceConfig:   constants.FillablePriceConfig_Default,
+					PositionBlockLimits:   constants.PositionBlockLimits_Default,
+					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
+					TargetMarginConfig: types.TargetMarginConfig{
+						Enabled:                    true,
+						MaintenanceMarginBufferPpm: lib.OneMillion + 1,
+					},
+				},
+			},
+			expectedError: errors.New(
+				"1000001 is not a valid MaintenanceMarginBufferPpm: Proposed LiquidationsConfig is invalid"),
+		},
 		"max num blocks for short term order rate limit is zero": {
 			genState: &types.GenesisState{
 				BlockRateLimitConfig: types.BlockRateLimitConfiguration{
@@ -18,6 +18,18 @@ func NewShortTermOrderPlacementInternalOperation(order Order) InternalOperation
 	}
 }
 
+// NewShortTermOrderReplacementInternalOperation returns a new internal operation for atomically
+// replacing a Short-Term order.
+// This function will panic if it's called with a non Short-Term order.
+func NewShortTermOrderReplacementInternalOperation(order Order) InternalOperation {
+	order.OrderId.MustBeShortTermOrder()
+	return InternalOperation{
+		Operation: &InternalOperation_ShortTermOrderReplacement{
+			ShortTermOrderReplacement: NewMsgReplaceOrder(order),
+		},
+	}
+}
+
 // NewPreexistingStatefulOrderPlacementInternalOperation returns a new internal operation for placing
 // a stateful order.
 // This function will panic if it's called with a non stateful order.
@@ -105,6 +117,50 @@ func NewMatchPerpetualLiquidationInternalOperation(
 	}
 }
 
+// NewMatchAssetLiquidationInternalOperation returns a new operation for matching maker orders
+// against an asset liquidation order.
+// This function panics if this is called with a non asset liquidation order or there are zero maker fills.
+func NewMatchAssetLiquidationInternalOperation(
+	takerLiquidationOrder MatchableOrder,
+	makerFills []MakerFill,
+) InternalOperation {
+	if !takerLiquidationOrder.IsAssetLiquidation() {
+		panic(
+			fmt.Sprintf(
+				"NewMatchAssetLiquidationInternalOperation: called with a non asset liquidation order: %+v",
+				takerLiquidationOrder,
+			),
+		)
+	}
+
+	if len(makerFills) == 0 {
+		panic(
+			fmt.Sprintf(
+				"NewMatchAssetLiquidationInternalOperation: cannot create a match asset "+
+					"liquidation internal operation with no maker fills: %+v",
+				takerLiquidationOrder,
+			),
+		)
+	}
+
+	return InternalOperation{
+		Operation: &InternalOperation_Match{
+			Match: &ClobMatch{
+				Match: &ClobMatch_MatchAssetLiquidation{
+					MatchAssetLiquidation: &MatchAssetLiquidation{
+						Liquidated: takerLiquidationOrder.GetSubaccountId(),
+						ClobPairId: takerLiquidationOrder.GetClobPairId().ToUint32(),
+						AssetId:    takerLiquidationOrder.MustGetLiquidatedAssetId(),
+						TotalSize:  takerLiquidationOrder.GetBaseQuantums().ToUint64(),
+						IsBuy:      takerLiquidationOrder.IsBuy(),
+						Fills:      makerFills,
+					},
+				},
+			},
+		},
+	}
+}
+
 // NewMatchPerpetualDeleveragingInternalOperation returns a new operation for deleveraging liquidated subaccount's
 // position against one or more offsetting subaccounts.
 // This function panics if there are zero maker fills.
@@ -139,6 +195,40 @@ func NewMatchPerpetualDeleveragingInternalOperation(
 	}
 }
 
+// NewMatchAssetDeleveragingInternalOperation returns a new operation for deleveraging liquidated subaccount's
+// asset position against one or more offsetting subaccounts.
+// This function panics if there are zero fills.
+func NewMatchAssetDeleveragingInternalOperation(
+	liquidatedSubaccountId satypes.SubaccountId,
+	assetId uint32,
+	fills []MatchPerpetualDeleveraging_Fill,
+) InternalOperation {
+	if len(fills) == 0 {
+		panic(
+			fmt.Sprintf(
+				"NewMatchAssetDeleveragingInternalOperation: cannot create a match asset "+
+					"deleveraging internal operation with no fills: subaccount (%+v), asset (%+v)",
+				liquidatedSubaccountId,
+				assetId,
+			),
+		)
+	}
+
+	return InternalOperation{
+		Operation: &InternalOperation_Match{
+			Match: &ClobMatch{
+				Match: &ClobMatch_MatchAssetDeleveraging{
+					MatchAssetDeleveraging: &MatchAssetDeleveraging{
+						Liquidated: liquidatedSubaccountId,
+						AssetId:    assetId,
+						Fills:      fills,
+					},
+				},
+			},
+		},
+	}
+}
+
 // NewOrderRemovalInternalOperation returns a new operation for removing an order.
 // This function panics if it's called with an order removal containing an OrderId
 // for a non stateful order or the removal reason is unspecified.
@@ -49,9 +49,25 @@ const (
 	// short term orders by block height.
 	BlockHeightToPotentiallyPrunableOrdersPrefix = "ExpHt:"
 
+	// BlockTimeToPotentiallyPrunableOrdersPrefix is the prefix to retrieve a list of potentially prunable
+	// short term orders using `GoodTilBlockTime` by block time.
+	BlockTimeToPotentiallyPrunableOrdersPrefix = "ExpShTm:"
+
 	// StatefulOrdersTimeSlicePrefix is the key to retrieve a unique list of the stateful orders that
 	// expire at a given timestamp, sorted by order ID.
 	StatefulOrdersTimeSlicePrefix = "ExpTm:"
+
+	// OrderGroupKeyPrefix is the prefix to retrieve the IDs of the stateful orders in an order group,
+	// keyed by subaccount ID and order group ID.
+	OrderGroupKeyPrefix = "OrdGrp:"
+
+	// PerpetualPositionEntryKeyPrefix is the prefix to retrieve the entry notional of a subaccount's
+	// perpetual position, keyed by perpetual ID and subaccount ID.
+	PerpetualPositionEntryKeyPrefix = "PosEntry:"
+
+	// PendingFinalSettlementKeyPrefix is the prefix to retrieve the clob pairs in final settlement whose
+	// positions are not all closed yet, keyed by clob pair ID.
+	PendingFinalSettlementKeyPrefix = "PendFinStl:"
 )
 
 // Store / Memstore
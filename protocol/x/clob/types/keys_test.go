@@ -26,6 +26,7 @@ func TestStateKeys(t *testing.T) {
 	require.Equal(t, "Fill:", types.OrderAmountFilledKeyPrefix)
 	require.Equal(t, "ExpHt:", types.BlockHeightToPotentiallyPrunableOrdersPrefix)
 	require.Equal(t, "ExpTm:", types.StatefulOrdersTimeSlicePrefix)
+	require.Equal(t, "PosEntry:", types.PerpetualPositionEntryKeyPrefix)
 }
 
 func TestStoreAndMemstoreKeys(t *testing.T) {
@@ -13,6 +13,9 @@ var _ MatchableOrder = &LiquidationOrder{}
 type LiquidationOrder struct {
 	// Information about this liquidation order.
 	perpetualLiquidationInfo PerpetualLiquidationInfo
+	// Information about this liquidation order if it liquidates a borrowed asset position on a
+	// spot CLOB pair, nil otherwise.
+	assetLiquidationInfo *AssetLiquidationInfo
 	// CLOB pair ID of the CLOB pair the liquidation order will be matched against.
 	clobPairId ClobPairId
 	// True if this is a buy order liquidating a short position, false if vice versa.
@@ -51,15 +54,55 @@ func NewLiquidationOrder(
 	}
 }
 
+// NewAssetLiquidationOrder creates and returns a new liquidation order for a borrowed asset position.
+// This function will panic if the caller attempts to create an asset liquidation order with a non-spot
+// CLOB pair.
+func NewAssetLiquidationOrder(
+	subaccountId satypes.SubaccountId,
+	clobPair ClobPair,
+	isBuy bool,
+	quantums satypes.BaseQuantums,
+	subticks Subticks,
+) *LiquidationOrder {
+	// If this is not a spot CLOB, panic.
+	spotClobMetadata := clobPair.GetSpotClobMetadata()
+	if spotClobMetadata == nil {
+		panic("NewAssetLiquidationOrder: Attempting to create asset liquidation order with a non-spot CLOB pair")
+	}
+
+	return &LiquidationOrder{
+		perpetualLiquidationInfo: PerpetualLiquidationInfo{
+			SubaccountId: subaccountId,
+		},
+		assetLiquidationInfo: &AssetLiquidationInfo{
+			SubaccountId: subaccountId,
+			AssetId:      spotClobMetadata.BaseAssetId,
+		},
+		clobPairId: clobPair.GetClobPairId(),
+		isBuy:      isBuy,
+		quantums:   quantums,
+		subticks:   subticks,
+	}
+}
+
 // IsBuy returns true if this is a buy order, false if not.
 // This function is necessary for the `LiquidationOrder` type to implement the `MatchableOrder` interface.
 func (lo *LiquidationOrder) IsBuy() bool {
 	return lo.isBuy
 }
 
-// GetOrderHash returns the SHA256 hash of the `PerpetualLiquidationInfo` field.
+// GetOrderHash returns the SHA256 hash of the `PerpetualLiquidationInfo` field, or of the
+// `AssetLiquidationInfo` field for asset liquidations.
 // This function is necessary for the `LiquidationOrder` type to implement the `MatchableOrder` interface.
 func (lo *LiquidationOrder) GetOrderHash() OrderHash {
+	if lo.IsAssetLiquidation() {
+		assetLiquidationInfoBytes, err := lo.assetLiquidationInfo.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		return sha256.Sum256(assetLiquidationInfoBytes)
+	}
+
 	perpetualLiquidationInfoBytes, err := lo.perpetualLiquidationInfo.Marshal()
 	if err != nil {
 		panic(err)
@@ -104,11 +147,31 @@ func (lo *LiquidationOrder) MustGetOrder() Order {
 }
 
 // MustGetLiquidatedPerpetualId returns the perpetual ID that this perpetual order is liquidating.
+// This function will panic if this is an asset liquidation order.
 // This function is necessary for the `LiquidationOrder` type to implement the `MatchableOrder` interface.
 func (lo *LiquidationOrder) MustGetLiquidatedPerpetualId() uint32 {
+	if lo.IsAssetLiquidation() {
+		panic("MustGetLiquidatedPerpetualId: No liquidated perpetual on an asset liquidation order.")
+	}
 	return lo.perpetualLiquidationInfo.PerpetualId
 }
 
+// IsAssetLiquidation returns true if this order liquidates a borrowed asset position.
+// This function is necessary for the `LiquidationOrder` type to implement the `MatchableOrder` interface.
+func (lo *LiquidationOrder) IsAssetLiquidation() bool {
+	return lo.assetLiquidationInfo != nil
+}
+
+// MustGetLiquidatedAssetId returns the asset ID that this asset liquidation order is liquidating.
+// This function will panic if this is a perpetual liquidation order.
+// This function is necessary for the `LiquidationOrder` type to implement the `MatchableOrder` interface.
+func (lo *LiquidationOrder) MustGetLiquidatedAssetId() uint32 {
+	if !lo.IsAssetLiquidation() {
+		panic("MustGetLiquidatedAssetId: No liquidated asset on a perpetual liquidation order.")
+	}
+	return lo.assetLiquidationInfo.AssetId
+}
+
 // IsReduceOnly returns whether this is a reduce-only order. This always returns false
 // for liquidation orders.
 func (o *LiquidationOrder) IsReduceOnly() bool {
@@ -199,3 +199,56 @@ func TestLiquidationOrder_IsReduceOnly(t *testing.T) {
 	order := types.LiquidationOrder{}
 	require.False(t, order.IsReduceOnly())
 }
+
+func TestNewAssetLiquidationOrder(t *testing.T) {
+	order := types.NewAssetLiquidationOrder(
+		constants.Alice_Num0,
+		constants.ClobPair_Spot_BtcUsdc,
+		true,
+		100,
+		200,
+	)
+
+	require.True(t, order.IsLiquidation())
+	require.True(t, order.IsAssetLiquidation())
+	require.Equal(t, constants.ClobPair_Spot_BtcUsdc.GetSpotClobMetadata().BaseAssetId, order.MustGetLiquidatedAssetId())
+	require.Equal(t, types.ClobPairId(constants.ClobPair_Spot_BtcUsdc.Id), order.GetClobPairId())
+	require.False(t, testLiquidationOrder.IsAssetLiquidation())
+
+	// Asset liquidations of the same subaccount and asset have the same hash, which differs
+	// from the hash of perpetual liquidations.
+	require.Equal(
+		t,
+		order.GetOrderHash(),
+		types.NewAssetLiquidationOrder(constants.Alice_Num0, constants.ClobPair_Spot_BtcUsdc, true, 1, 1).GetOrderHash(),
+	)
+	require.NotEqual(t, testLiquidationOrder.GetOrderHash(), order.GetOrderHash())
+}
+
+func TestNewAssetLiquidationOrder_PanicsOnNonSpotClob(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"NewAssetLiquidationOrder: Attempting to create asset liquidation order with a non-spot CLOB pair",
+		func() {
+			types.NewAssetLiquidationOrder(
+				constants.Alice_Num0,
+				constants.ClobPair_Btc,
+				true,
+				1,
+				1,
+			)
+		},
+	)
+}
+
+func TestLiquidationOrder_MustGetLiquidatedIdPanics(t *testing.T) {
+	assetLiquidationOrder := types.NewAssetLiquidationOrder(
+		constants.Alice_Num0,
+		constants.ClobPair_Spot_BtcUsdc,
+		true,
+		1,
+		1,
+	)
+	require.Panics(t, func() { assetLiquidationOrder.MustGetLiquidatedPerpetualId() })
+	require.Panics(t, func() { testLiquidationOrder.MustGetLiquidatedAssetId() })
+}
@@ -83,6 +83,64 @@ func (m *PerpetualLiquidationInfo) GetPerpetualId() uint32 {
 	return 0
 }
 
+// AssetLiquidationInfo holds information about a liquidation that occurred
+// for a borrowed asset position held by a subaccount.
+// Note this proto is defined to make it easier to hash
+// the metadata of a liquidation, and is never written to state.
+type AssetLiquidationInfo struct {
+	// The id of the subaccount that got liquidated.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The id of the asset involved.
+	AssetId uint32 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+}
+
+func (m *AssetLiquidationInfo) Reset()         { *m = AssetLiquidationInfo{} }
+func (m *AssetLiquidationInfo) String() string { return proto.CompactTextString(m) }
+func (*AssetLiquidationInfo) ProtoMessage()    {}
+func (*AssetLiquidationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e91628d349879778, []int{1}
+}
+func (m *AssetLiquidationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetLiquidationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetLiquidationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetLiquidationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetLiquidationInfo.Merge(m, src)
+}
+func (m *AssetLiquidationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetLiquidationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetLiquidationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetLiquidationInfo proto.InternalMessageInfo
+
+func (m *AssetLiquidationInfo) GetSubaccountId() types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return types.SubaccountId{}
+}
+
+func (m *AssetLiquidationInfo) GetAssetId() uint32 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
+}
+
 // SubaccountLiquidationInfo holds liquidation information per-subaccount in the
 // current block.
 type SubaccountLiquidationInfo struct {
@@ -101,7 +159,7 @@ func (m *SubaccountLiquidationInfo) Reset()         { *m = SubaccountLiquidation
 func (m *SubaccountLiquidationInfo) String() string { return proto.CompactTextString(m) }
 func (*SubaccountLiquidationInfo) ProtoMessage()    {}
 func (*SubaccountLiquidationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e91628d349879778, []int{1}
+	return fileDescriptor_e91628d349879778, []int{2}
 }
 func (m *SubaccountLiquidationInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,6 +211,7 @@ func (m *SubaccountLiquidationInfo) GetQuantumsInsuranceLost() uint64 {
 
 func init() {
 	proto.RegisterType((*PerpetualLiquidationInfo)(nil), "dydxprotocol.clob.PerpetualLiquidationInfo")
+	proto.RegisterType((*AssetLiquidationInfo)(nil), "dydxprotocol.clob.AssetLiquidationInfo")
 	proto.RegisterType((*SubaccountLiquidationInfo)(nil), "dydxprotocol.clob.SubaccountLiquidationInfo")
 }
 
@@ -161,28 +220,29 @@ func init() {
 }
 
 var fileDescriptor_e91628d349879778 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x4e, 0xf2, 0x40,
-	0x14, 0x85, 0x3b, 0x3f, 0xe4, 0x5f, 0x0c, 0xb0, 0xb0, 0x42, 0x44, 0x16, 0x15, 0x89, 0x31, 0xb8,
-	0xb0, 0x4d, 0xc4, 0xf0, 0x00, 0xec, 0x9a, 0xb0, 0xc0, 0xba, 0x73, 0xd3, 0x4c, 0xa7, 0x15, 0x26,
-	0x29, 0x73, 0x0b, 0x33, 0x63, 0xe0, 0x2d, 0x78, 0x1d, 0xdf, 0x80, 0x25, 0x4b, 0x57, 0xc6, 0xc0,
-	0x8b, 0x98, 0x96, 0xb4, 0x9d, 0xba, 0xbb, 0x39, 0xdf, 0x39, 0xe7, 0xde, 0xb4, 0x83, 0xef, 0xc2,
-	0x6d, 0xb8, 0x49, 0xd6, 0x20, 0x81, 0x42, 0xec, 0xd0, 0x18, 0x02, 0x27, 0x66, 0x2b, 0xc5, 0x42,
-	0x22, 0x19, 0x70, 0x61, 0x67, 0xc8, 0xbc, 0xd0, 0x5d, 0x76, 0xea, 0xea, 0xb5, 0xe7, 0x30, 0x87,
-	0x4c, 0x72, 0xd2, 0xe9, 0x6c, 0xec, 0x3d, 0x54, 0xea, 0x84, 0x0a, 0x08, 0xa5, 0xa0, 0xb8, 0x14,
-	0xda, 0x7c, 0xb6, 0x0e, 0x76, 0x08, 0x77, 0x67, 0xd1, 0x3a, 0x89, 0xa4, 0x22, 0xf1, 0xb4, 0xdc,
-	0xe9, 0xf2, 0x77, 0x30, 0x5f, 0x70, 0xab, 0x0c, 0xf8, 0x2c, 0xec, 0xa2, 0x3e, 0x1a, 0x36, 0x9e,
-	0xee, 0xed, 0xca, 0x21, 0x5a, 0xbf, 0xfd, 0x5a, 0xcc, 0x6e, 0x38, 0xa9, 0xef, 0xbf, 0x6f, 0x0c,
-	0xaf, 0x29, 0x34, 0xcd, 0xbc, 0xc5, 0xcd, 0x24, 0x5f, 0x97, 0x36, 0xfe, 0xeb, 0xa3, 0x61, 0xcb,
-	0x6b, 0x14, 0x9a, 0x1b, 0x0e, 0x3e, 0x11, 0xbe, 0x2e, 0x7b, 0xfe, 0xde, 0x34, 0xc2, 0x9d, 0xc2,
-	0x2c, 0xfc, 0xfc, 0x2b, 0x45, 0xe9, 0x6d, 0xb5, 0x61, 0xcb, 0x6b, 0x97, 0x70, 0x5a, 0x30, 0xd3,
-	0xc1, 0x97, 0x1c, 0xd2, 0x0a, 0x12, 0xeb, 0x91, 0x74, 0x79, 0xdd, 0x33, 0x73, 0xa4, 0x05, 0xc6,
-	0xf8, 0x6a, 0xa5, 0x08, 0x97, 0x6a, 0x29, 0x7c, 0xc6, 0x85, 0x5a, 0x13, 0x4e, 0x23, 0x3f, 0x06,
-	0x21, 0xbb, 0xb5, 0x2c, 0xd4, 0xc9, 0xb1, 0x9b, 0xd3, 0x29, 0x08, 0x39, 0x99, 0xed, 0x8f, 0x16,
-	0x3a, 0x1c, 0x2d, 0xf4, 0x73, 0xb4, 0xd0, 0xee, 0x64, 0x19, 0x87, 0x93, 0x65, 0x7c, 0x9d, 0x2c,
-	0xe3, 0x6d, 0x3c, 0x67, 0x72, 0xa1, 0x02, 0x9b, 0xc2, 0xd2, 0xa9, 0xfc, 0x9e, 0x8f, 0xe7, 0x47,
-	0xba, 0x20, 0x8c, 0x3b, 0x85, 0xb2, 0x39, 0xbf, 0x00, 0xb9, 0x4d, 0x22, 0x11, 0xfc, 0xcf, 0xe4,
-	0xd1, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xa6, 0x46, 0x21, 0x23, 0x02, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xbf, 0x4e, 0xfa, 0x40,
+	0x1c, 0xef, 0xfd, 0x20, 0x3f, 0xcd, 0x01, 0x83, 0x15, 0x22, 0x30, 0x54, 0x24, 0xc6, 0xe0, 0x60,
+	0x9b, 0x88, 0x61, 0x97, 0xad, 0x09, 0x03, 0xd6, 0xcd, 0xa5, 0xb9, 0xde, 0x55, 0xb8, 0xa4, 0xdc,
+	0x15, 0xee, 0xce, 0xc0, 0xee, 0x03, 0xf0, 0x3a, 0xbe, 0x01, 0x23, 0xa3, 0x93, 0x31, 0xf0, 0x22,
+	0xe6, 0x4a, 0xfa, 0x07, 0x1f, 0xc0, 0xed, 0xdb, 0xcf, 0xff, 0xa4, 0x07, 0xaf, 0xc9, 0x8a, 0x2c,
+	0xe3, 0x05, 0x97, 0x1c, 0xf3, 0xc8, 0xc1, 0x11, 0x0f, 0x9c, 0x88, 0xce, 0x15, 0x25, 0x48, 0x52,
+	0xce, 0x84, 0x9d, 0x50, 0xe6, 0x59, 0x51, 0x65, 0x6b, 0x55, 0xbb, 0x3e, 0xe1, 0x13, 0x9e, 0x40,
+	0x8e, 0xbe, 0x0e, 0xc2, 0xf6, 0xed, 0x51, 0x9c, 0x50, 0x01, 0xc2, 0x98, 0x2b, 0x26, 0x45, 0xe1,
+	0x3e, 0x48, 0xbb, 0x6b, 0x00, 0x9b, 0xe3, 0x70, 0x11, 0x87, 0x52, 0xa1, 0x68, 0x94, 0x77, 0xba,
+	0xec, 0x95, 0x9b, 0x4f, 0xb0, 0x96, 0x1b, 0x7c, 0x4a, 0x9a, 0xa0, 0x03, 0x7a, 0x95, 0xfb, 0x1b,
+	0xfb, 0x68, 0x48, 0x21, 0xdf, 0x7e, 0xce, 0x6e, 0x97, 0x0c, 0xcb, 0x9b, 0xaf, 0x4b, 0xc3, 0xab,
+	0x8a, 0x02, 0x66, 0x5e, 0xc1, 0x6a, 0x9c, 0xd6, 0xe9, 0xc4, 0x7f, 0x1d, 0xd0, 0xab, 0x79, 0x95,
+	0x0c, 0x73, 0x49, 0xf7, 0x1d, 0xc0, 0xfa, 0xa3, 0x10, 0xa1, 0xfc, 0x83, 0x39, 0x2d, 0x78, 0x8a,
+	0x74, 0x55, 0x3e, 0xe5, 0x24, 0xf9, 0x76, 0x49, 0xf7, 0x03, 0xc0, 0x56, 0xee, 0xff, 0xbd, 0xa5,
+	0x0f, 0x1b, 0xd9, 0x66, 0xe1, 0xa7, 0x3f, 0x2b, 0xd4, 0x9b, 0x4a, 0xbd, 0x9a, 0x57, 0xcf, 0xc9,
+	0x51, 0xc6, 0x99, 0x0e, 0x3c, 0x67, 0x5c, 0x47, 0xa0, 0xa8, 0x68, 0xd1, 0xc5, 0x65, 0xcf, 0x4c,
+	0xa9, 0x82, 0x61, 0x00, 0x2f, 0xe6, 0x0a, 0x31, 0xa9, 0x66, 0xc2, 0xa7, 0x4c, 0xa8, 0x05, 0x62,
+	0x38, 0xf4, 0x23, 0x2e, 0x64, 0xb3, 0x94, 0x98, 0x1a, 0x29, 0xed, 0xa6, 0xec, 0x88, 0x0b, 0x39,
+	0x1c, 0x6f, 0x76, 0x16, 0xd8, 0xee, 0x2c, 0xf0, 0xbd, 0xb3, 0xc0, 0x7a, 0x6f, 0x19, 0xdb, 0xbd,
+	0x65, 0x7c, 0xee, 0x2d, 0xe3, 0x65, 0x30, 0xa1, 0x72, 0xaa, 0x02, 0x1b, 0xf3, 0x99, 0x73, 0xf4,
+	0x4a, 0xde, 0x1e, 0xee, 0xf0, 0x14, 0x51, 0xe6, 0x64, 0xc8, 0xf2, 0xf0, 0x10, 0xe5, 0x2a, 0x0e,
+	0x45, 0xf0, 0x3f, 0x81, 0xfb, 0x3f, 0x03, 0x00, 0x74, 0xb9, 0xed, 0xf0, 0xaa, 0x02, 0x00, 0x00,
 }
 
 func (m *PerpetualLiquidationInfo) Marshal() (dAtA []byte, err error) {
@@ -223,6 +283,44 @@ func (m *PerpetualLiquidationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *AssetLiquidationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetLiquidationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetLiquidationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AssetId != 0 {
+		i = encodeVarintLiquidations(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SubaccountLiquidationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -254,20 +352,20 @@ func (m *SubaccountLiquidationInfo) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		dAtA[i] = 0x10
 	}
 	if len(m.PerpetualsLiquidated) > 0 {
-		dAtA3 := make([]byte, len(m.PerpetualsLiquidated)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.PerpetualsLiquidated)*10)
+		var j3 int
 		for _, num := range m.PerpetualsLiquidated {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintLiquidations(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintLiquidations(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -299,6 +397,20 @@ func (m *PerpetualLiquidationInfo) Size() (n int) {
 	return n
 }
 
+func (m *AssetLiquidationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovLiquidations(uint64(l))
+	if m.AssetId != 0 {
+		n += 1 + sovLiquidations(uint64(m.AssetId))
+	}
+	return n
+}
+
 func (m *SubaccountLiquidationInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -429,6 +541,108 @@ func (m *PerpetualLiquidationInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AssetLiquidationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetLiquidationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetLiquidationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SubaccountLiquidationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,6 +13,7 @@ import (
 // - `maxPositionPortionLiquidatedPpm == 0 || maxPositionPortionLiquidatedPpm > 1_000_000`.
 // - `maxNotionalLiquidated == 0`.
 // - `maxQuantumsInsuranceLost == 0`.
+// - `maintenanceMarginBufferPpm > 1_000_000`.
 //
 // Note that `minPositionNotionalLiquidated` is intentionally not validated.
 
@@ -76,5 +77,16 @@ func (lc *LiquidationsConfig) Validate() error {
 		)
 	}
 
+	// Validate the MaintenanceMarginBufferPpm. Liquidations don't restore subaccounts to more than twice their
+	// maintenance margin requirement.
+	maintenanceMarginBufferPpm := lc.TargetMarginConfig.MaintenanceMarginBufferPpm
+	if maintenanceMarginBufferPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidLiquidationsConfig,
+			"%v is not a valid MaintenanceMarginBufferPpm",
+			maintenanceMarginBufferPpm,
+		)
+	}
+
 	return nil
 }
@@ -37,6 +37,9 @@ type LiquidationsConfig struct {
 	// Config about how the fillable-price spread from the oracle price
 	// increases based on the adjusted bankruptcy rating of the subaccount.
 	FillablePriceConfig FillablePriceConfig `protobuf:"bytes,4,opt,name=fillable_price_config,json=fillablePriceConfig,proto3" json:"fillable_price_config"`
+	// Config about sizing liquidations to the smallest reduction of the
+	// position that restores the health of the subaccount.
+	TargetMarginConfig TargetMarginConfig `protobuf:"bytes,5,opt,name=target_margin_config,json=targetMarginConfig,proto3" json:"target_margin_config"`
 }
 
 func (m *LiquidationsConfig) Reset()         { *m = LiquidationsConfig{} }
@@ -100,6 +103,13 @@ func (m *LiquidationsConfig) GetFillablePriceConfig() FillablePriceConfig {
 	return FillablePriceConfig{}
 }
 
+func (m *LiquidationsConfig) GetTargetMarginConfig() TargetMarginConfig {
+	if m != nil {
+		return m.TargetMarginConfig
+	}
+	return TargetMarginConfig{}
+}
+
 // PositionBlockLimits stores all configurable fields related to limits
 // around how much of a single position can be liquidated within a single block.
 type PositionBlockLimits struct {
@@ -169,6 +179,9 @@ type SubaccountBlockLimits struct {
 	// The maximum insurance-fund payout amount for a given subaccount
 	// per block. I.e. how much it can cover for that subaccount.
 	MaxQuantumsInsuranceLost uint64 `protobuf:"varint,2,opt,name=max_quantums_insurance_lost,json=maxQuantumsInsuranceLost,proto3" json:"max_quantums_insurance_lost,omitempty"`
+	// The maximum number of perpetual positions of a single subaccount that
+	// can be liquidated per block. Zero means there is no limit.
+	MaxPerpetualPositionsLiquidated uint32 `protobuf:"varint,3,opt,name=max_perpetual_positions_liquidated,json=maxPerpetualPositionsLiquidated,proto3" json:"max_perpetual_positions_liquidated,omitempty"`
 }
 
 func (m *SubaccountBlockLimits) Reset()         { *m = SubaccountBlockLimits{} }
@@ -218,6 +231,13 @@ func (m *SubaccountBlockLimits) GetMaxQuantumsInsuranceLost() uint64 {
 	return 0
 }
 
+func (m *SubaccountBlockLimits) GetMaxPerpetualPositionsLiquidated() uint32 {
+	if m != nil {
+		return m.MaxPerpetualPositionsLiquidated
+	}
+	return 0
+}
+
 // FillablePriceConfig stores all configurable fields related to calculating
 // the fillable price for liquidating a position.
 type FillablePriceConfig struct {
@@ -275,11 +295,74 @@ func (m *FillablePriceConfig) GetSpreadToMaintenanceMarginRatioPpm() uint32 {
 	return 0
 }
 
+// TargetMarginConfig stores all configurable fields related to sizing
+// liquidations by the margin they restore instead of by a fixed portion
+// of the position.
+type TargetMarginConfig struct {
+	// Whether liquidations are capped to the smallest reduction of the
+	// position that brings the subaccount back above its maintenance margin
+	// requirement plus the buffer. If false, liquidations are only sized by
+	// the position and subaccount block limits.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The buffer above the maintenance margin requirement that liquidations
+	// restore the subaccount to, as a ratio against the maintenance margin
+	// requirement (in parts-per-million). Must not exceed 1,000,000.
+	MaintenanceMarginBufferPpm uint32 `protobuf:"varint,2,opt,name=maintenance_margin_buffer_ppm,json=maintenanceMarginBufferPpm,proto3" json:"maintenance_margin_buffer_ppm,omitempty"`
+}
+
+func (m *TargetMarginConfig) Reset()         { *m = TargetMarginConfig{} }
+func (m *TargetMarginConfig) String() string { return proto.CompactTextString(m) }
+func (*TargetMarginConfig) ProtoMessage()    {}
+func (*TargetMarginConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11e0d49099a14b4, []int{4}
+}
+func (m *TargetMarginConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TargetMarginConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TargetMarginConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TargetMarginConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetMarginConfig.Merge(m, src)
+}
+func (m *TargetMarginConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TargetMarginConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetMarginConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetMarginConfig proto.InternalMessageInfo
+
+func (m *TargetMarginConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TargetMarginConfig) GetMaintenanceMarginBufferPpm() uint32 {
+	if m != nil {
+		return m.MaintenanceMarginBufferPpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LiquidationsConfig)(nil), "dydxprotocol.clob.LiquidationsConfig")
 	proto.RegisterType((*PositionBlockLimits)(nil), "dydxprotocol.clob.PositionBlockLimits")
 	proto.RegisterType((*SubaccountBlockLimits)(nil), "dydxprotocol.clob.SubaccountBlockLimits")
 	proto.RegisterType((*FillablePriceConfig)(nil), "dydxprotocol.clob.FillablePriceConfig")
+	proto.RegisterType((*TargetMarginConfig)(nil), "dydxprotocol.clob.TargetMarginConfig")
 }
 
 func init() {
@@ -287,41 +370,46 @@ func init() {
 }
 
 var fileDescriptor_d11e0d49099a14b4 = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0x10, 0x71, 0x58, 0xc4, 0x01, 0xa7, 0x51, 0x02, 0x08, 0x37, 0x04, 0xa9, 0x8a,
-	0x84, 0x48, 0x24, 0x3e, 0x7a, 0x40, 0xe2, 0x40, 0x90, 0x8a, 0x90, 0x52, 0xe4, 0x06, 0x4e, 0x5c,
-	0x96, 0xf5, 0x7a, 0x93, 0x2e, 0xdd, 0xaf, 0x7a, 0xd7, 0xc8, 0x79, 0x09, 0xc4, 0x43, 0x70, 0xe4,
-	0x41, 0x7a, 0xec, 0x91, 0x13, 0x42, 0xc9, 0x43, 0x70, 0xad, 0x76, 0xed, 0x38, 0xae, 0xe2, 0x9e,
-	0x6c, 0xed, 0xfc, 0xf6, 0x3f, 0x33, 0xff, 0x99, 0x05, 0x4f, 0xe3, 0x65, 0x9c, 0xa9, 0x44, 0x1a,
-	0x89, 0x25, 0x1b, 0x63, 0x26, 0xa3, 0x31, 0xa3, 0xe7, 0x29, 0x8d, 0x91, 0xa1, 0x52, 0x68, 0x88,
-	0xa5, 0x98, 0xd3, 0xc5, 0xc8, 0x11, 0xfe, 0xbd, 0x2a, 0x3c, 0xb2, 0xf0, 0x83, 0xbd, 0x85, 0x5c,
-	0x48, 0x77, 0x34, 0xb6, 0x7f, 0x39, 0x38, 0xf8, 0xdf, 0x04, 0xfe, 0xb4, 0x22, 0xf3, 0xce, 0xa9,
-	0xf8, 0xaf, 0x40, 0x97, 0xa3, 0x0c, 0x56, 0x12, 0xc0, 0x39, 0x21, 0x50, 0x29, 0xde, 0xf3, 0xfa,
-	0xde, 0xf0, 0xee, 0x6c, 0x8f, 0xa3, 0xac, 0x72, 0xef, 0x88, 0x90, 0x50, 0x71, 0xff, 0x2b, 0xe8,
-	0x28, 0xa9, 0xa9, 0xe3, 0x23, 0x26, 0xf1, 0x19, 0x64, 0x94, 0x53, 0xa3, 0x7b, 0xcd, 0xbe, 0x37,
-	0xbc, 0xf3, 0xfc, 0x60, 0xb4, 0x53, 0xd6, 0x28, 0x2c, 0xf8, 0x89, 0xc5, 0xa7, 0x8e, 0x9e, 0xb4,
-	0x2e, 0xfe, 0xee, 0x37, 0x66, 0x6d, 0xb5, 0x1b, 0xf2, 0xe7, 0xa0, 0xab, 0xd3, 0x08, 0x61, 0x2c,
-	0x53, 0x61, 0xae, 0xe7, 0xb8, 0xe5, 0x72, 0x0c, 0x6b, 0x72, 0x7c, 0x2a, 0x6f, 0xec, 0x66, 0xe9,
-	0xe8, 0xba, 0xa0, 0xed, 0x64, 0x4e, 0x19, 0x43, 0x11, 0x23, 0x50, 0x25, 0x14, 0x93, 0xc2, 0xdf,
-	0x5e, 0xeb, 0xc6, 0x4e, 0x8e, 0x0a, 0x3e, 0xb4, 0x78, 0xee, 0xe3, 0xa6, 0x93, 0xf9, 0x6e, 0x68,
-	0xf0, 0xdb, 0x03, 0xed, 0x9a, 0xe6, 0xfd, 0xf7, 0xa0, 0xcf, 0xa9, 0x80, 0xa5, 0x8f, 0x42, 0xda,
-	0x0f, 0x62, 0xe5, 0x30, 0x48, 0xec, 0x66, 0xd0, 0x9a, 0x3d, 0xe2, 0x54, 0x6c, 0x14, 0x3e, 0x16,
-	0xd4, 0xb4, 0x84, 0xfc, 0x29, 0x78, 0x62, 0x67, 0x58, 0x0a, 0x29, 0x99, 0xb8, 0xef, 0x56, 0xc7,
-	0xcd, 0xb3, 0xe9, 0xe6, 0xb9, 0xcf, 0x51, 0xb6, 0xd1, 0x0a, 0x73, 0x70, 0x2b, 0x15, 0x2a, 0x3e,
-	0xf8, 0xe1, 0x81, 0x4e, 0xad, 0x8f, 0xfe, 0x61, 0xbe, 0x2b, 0x37, 0xd7, 0xd9, 0xe1, 0x28, 0xab,
-	0xa9, 0xef, 0x0d, 0x78, 0x68, 0xef, 0x9d, 0xa7, 0x48, 0x98, 0x94, 0x6b, 0x48, 0x85, 0x4e, 0x13,
-	0x24, 0x30, 0x81, 0x4c, 0x6a, 0xe3, 0xea, 0x6a, 0xcd, 0x7a, 0x1c, 0x65, 0x27, 0x05, 0xf1, 0x61,
-	0x03, 0x4c, 0xa5, 0x36, 0x83, 0x5f, 0x1e, 0x68, 0xd7, 0x58, 0xee, 0xbf, 0x06, 0xf7, 0x23, 0x24,
-	0xce, 0x92, 0x54, 0x19, 0xbc, 0x84, 0x28, 0xfe, 0x96, 0x6a, 0xc3, 0x89, 0x30, 0x95, 0xe5, 0xed,
-	0x6e, 0x81, 0xb7, 0x65, 0xdc, 0xee, 0xef, 0x09, 0x38, 0xd0, 0x2a, 0x21, 0x28, 0x86, 0x46, 0x42,
-	0x8e, 0xa8, 0x30, 0x44, 0xb8, 0x8a, 0x38, 0x4a, 0x16, 0x54, 0xc0, 0xc4, 0x2e, 0x7b, 0xc5, 0xb5,
-	0xc7, 0x39, 0xfd, 0x59, 0x1e, 0x6f, 0xd9, 0x63, 0x87, 0xce, 0x2c, 0x19, 0x2a, 0x3e, 0x09, 0x2f,
-	0x56, 0x81, 0x77, 0xb9, 0x0a, 0xbc, 0x7f, 0xab, 0xc0, 0xfb, 0xb9, 0x0e, 0x1a, 0x97, 0xeb, 0xa0,
-	0xf1, 0x67, 0x1d, 0x34, 0xbe, 0x1c, 0x2e, 0xa8, 0x39, 0x4d, 0xa3, 0x11, 0x96, 0x7c, 0x7c, 0xed,
-	0x6d, 0x7f, 0x7f, 0xf9, 0x0c, 0x9f, 0x22, 0x2a, 0xc6, 0xe5, 0x49, 0x96, 0xbf, 0x77, 0xb3, 0x54,
-	0x44, 0x47, 0xb7, 0xdd, 0xf1, 0x8b, 0xab, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x89, 0xd8, 0x36,
-	0x11, 0x04, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x5d, 0x6b, 0x14, 0x3d,
+	0x14, 0xc7, 0x77, 0xda, 0x7d, 0x1e, 0x25, 0xe2, 0x85, 0x69, 0x97, 0xae, 0x95, 0x6e, 0xeb, 0x8a,
+	0xa5, 0x20, 0xee, 0x82, 0x2f, 0xbd, 0x10, 0xbc, 0xe8, 0x0a, 0x15, 0x71, 0x2b, 0xd3, 0xb5, 0x57,
+	0x82, 0xc4, 0xcc, 0x6c, 0x66, 0x1a, 0x3b, 0x79, 0xe9, 0x24, 0x23, 0xd3, 0x6f, 0xe1, 0x87, 0xf0,
+	0xd2, 0x0f, 0xd2, 0xcb, 0x5e, 0x89, 0x20, 0x88, 0xb4, 0x5f, 0x44, 0x92, 0x79, 0x5d, 0x67, 0x7a,
+	0x35, 0xbb, 0x39, 0xbf, 0x9c, 0xff, 0x39, 0x39, 0xff, 0x04, 0x3c, 0x9a, 0x9f, 0xcd, 0x53, 0x19,
+	0x0b, 0x2d, 0x7c, 0x11, 0x8d, 0xfd, 0x48, 0x78, 0xe3, 0x88, 0x9e, 0x26, 0x74, 0x8e, 0x35, 0x15,
+	0x5c, 0x21, 0x5f, 0xf0, 0x80, 0x86, 0x23, 0x4b, 0xc0, 0x3b, 0x75, 0x78, 0x64, 0xe0, 0xf5, 0xd5,
+	0x50, 0x84, 0xc2, 0x2e, 0x8d, 0xcd, 0xaf, 0x0c, 0x1c, 0xfe, 0x58, 0x06, 0x70, 0x5a, 0x4b, 0xf3,
+	0xca, 0x66, 0x81, 0xcf, 0xc1, 0x1a, 0xc3, 0x29, 0xaa, 0x09, 0xa0, 0x80, 0x10, 0x24, 0x25, 0xeb,
+	0x3b, 0x5b, 0xce, 0xce, 0xed, 0xd9, 0x2a, 0xc3, 0x69, 0x6d, 0xdf, 0x3e, 0x21, 0xae, 0x64, 0xf0,
+	0x13, 0xe8, 0x49, 0xa1, 0xa8, 0xe5, 0xbd, 0x48, 0xf8, 0x27, 0x28, 0xa2, 0x8c, 0x6a, 0xd5, 0x5f,
+	0xda, 0x72, 0x76, 0x6e, 0x3d, 0xd9, 0x1e, 0x35, 0xca, 0x1a, 0xb9, 0x39, 0x3f, 0x31, 0xf8, 0xd4,
+	0xd2, 0x93, 0xee, 0xf9, 0xef, 0xcd, 0xce, 0x6c, 0x45, 0x36, 0x43, 0x30, 0x00, 0x6b, 0x2a, 0xf1,
+	0xb0, 0xef, 0x8b, 0x84, 0xeb, 0x45, 0x8d, 0x65, 0xab, 0xb1, 0xd3, 0xa2, 0xf1, 0xbe, 0xdc, 0xd1,
+	0x54, 0xe9, 0xa9, 0xb6, 0xa0, 0xe9, 0x24, 0xa0, 0x51, 0x84, 0xbd, 0x88, 0x20, 0x19, 0x53, 0x9f,
+	0xe4, 0xe7, 0xdb, 0xef, 0x5e, 0xdb, 0xc9, 0x7e, 0xce, 0xbb, 0x06, 0xcf, 0xce, 0xb1, 0xe8, 0x24,
+	0x68, 0x86, 0xe0, 0x47, 0xb0, 0xaa, 0x71, 0x1c, 0x12, 0x8d, 0x18, 0x8e, 0x43, 0xca, 0x0b, 0x81,
+	0xff, 0xac, 0xc0, 0xc3, 0x16, 0x81, 0x23, 0x8b, 0x1f, 0x58, 0x7a, 0x21, 0x3f, 0xd4, 0x8d, 0xc8,
+	0xf0, 0xbb, 0x03, 0x56, 0x5a, 0xce, 0x16, 0xbe, 0x06, 0x5b, 0x8c, 0x72, 0x54, 0x8e, 0x89, 0x0b,
+	0xf3, 0xc1, 0x51, 0x39, 0x6b, 0x32, 0xb7, 0x23, 0xee, 0xce, 0x36, 0x18, 0xe5, 0x45, 0x86, 0x77,
+	0x39, 0x35, 0x2d, 0x21, 0x38, 0x05, 0x0f, 0x8c, 0x45, 0xca, 0x44, 0x52, 0xc4, 0xf6, 0x5b, 0xe5,
+	0xb1, 0x76, 0x59, 0xb2, 0x76, 0xd9, 0x64, 0x38, 0x2d, 0x72, 0xb9, 0x19, 0x58, 0xa5, 0x72, 0x25,
+	0x1b, 0xfe, 0x72, 0x40, 0xaf, 0x75, 0x4c, 0x70, 0x37, 0xb3, 0xe2, 0xf5, 0x75, 0xf6, 0x18, 0x4e,
+	0x5b, 0xea, 0x7b, 0x09, 0xee, 0x99, 0x7d, 0xa7, 0x09, 0xe6, 0x3a, 0x61, 0x0a, 0x51, 0xae, 0x92,
+	0x18, 0x73, 0x9f, 0xa0, 0x48, 0x28, 0x6d, 0xeb, 0xea, 0xce, 0xfa, 0x0c, 0xa7, 0x87, 0x39, 0xf1,
+	0xa6, 0x00, 0xa6, 0x42, 0x69, 0xf8, 0x16, 0x0c, 0x6d, 0x7b, 0x24, 0x96, 0x44, 0x27, 0x38, 0x2a,
+	0x1b, 0x55, 0xf5, 0x0a, 0x96, 0xab, 0xee, 0x0a, 0xb0, 0x68, 0x53, 0x55, 0xb5, 0x0c, 0xbf, 0x39,
+	0x60, 0xa5, 0xc5, 0x1e, 0xf0, 0x05, 0xb8, 0xeb, 0x61, 0x7e, 0x12, 0x27, 0x52, 0xfb, 0x67, 0x08,
+	0xcf, 0x3f, 0x27, 0x4a, 0x33, 0xc2, 0x75, 0xed, 0xa2, 0xad, 0x55, 0xc0, 0x5e, 0x19, 0x37, 0x77,
+	0xed, 0x10, 0x6c, 0x2b, 0x19, 0x13, 0x3c, 0x47, 0x5a, 0x20, 0x86, 0x29, 0xd7, 0x84, 0xdb, 0xf6,
+	0x72, 0x3b, 0xc5, 0xe6, 0x62, 0xd6, 0x46, 0x70, 0x3f, 0xa3, 0x8f, 0xc4, 0x41, 0xc5, 0x66, 0x8e,
+	0x99, 0x19, 0xd2, 0x0c, 0xe1, 0x14, 0xc0, 0xa6, 0xc7, 0x60, 0x1f, 0xdc, 0x20, 0xdc, 0x54, 0x9e,
+	0x1d, 0xf8, 0xcd, 0x59, 0xf1, 0x17, 0xee, 0x81, 0x8d, 0x16, 0x61, 0x2f, 0x09, 0x02, 0x12, 0xd7,
+	0x94, 0xd7, 0xd9, 0xbf, 0x8a, 0x13, 0x8b, 0xb8, 0x92, 0x4d, 0xdc, 0xf3, 0xcb, 0x81, 0x73, 0x71,
+	0x39, 0x70, 0xfe, 0x5c, 0x0e, 0x9c, 0xaf, 0x57, 0x83, 0xce, 0xc5, 0xd5, 0xa0, 0xf3, 0xf3, 0x6a,
+	0xd0, 0xf9, 0xb0, 0x1b, 0x52, 0x7d, 0x9c, 0x78, 0x23, 0x5f, 0xb0, 0xf1, 0xc2, 0xd3, 0xf7, 0xe5,
+	0xd9, 0x63, 0xff, 0x18, 0x53, 0x3e, 0x2e, 0x57, 0xd2, 0xec, 0x39, 0xd4, 0x67, 0x92, 0x28, 0xef,
+	0x7f, 0xbb, 0xfc, 0xf4, 0xef, 0x00, 0x20, 0x05, 0xdc, 0x56, 0x30, 0x05, 0x00, 0x00,
 }
 
 func (m *LiquidationsConfig) Marshal() (dAtA []byte, err error) {
@@ -344,6 +432,16 @@ func (m *LiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TargetMarginConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidationsConfig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.FillablePriceConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -435,6 +533,11 @@ func (m *SubaccountBlockLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPerpetualPositionsLiquidated != 0 {
+		i = encodeVarintLiquidationsConfig(dAtA, i, uint64(m.MaxPerpetualPositionsLiquidated))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.MaxQuantumsInsuranceLost != 0 {
 		i = encodeVarintLiquidationsConfig(dAtA, i, uint64(m.MaxQuantumsInsuranceLost))
 		i--
@@ -481,6 +584,44 @@ func (m *FillablePriceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TargetMarginConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetMarginConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetMarginConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaintenanceMarginBufferPpm != 0 {
+		i = encodeVarintLiquidationsConfig(dAtA, i, uint64(m.MaintenanceMarginBufferPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLiquidationsConfig(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLiquidationsConfig(v)
 	base := offset
@@ -507,6 +648,8 @@ func (m *LiquidationsConfig) Size() (n int) {
 	n += 1 + l + sovLiquidationsConfig(uint64(l))
 	l = m.FillablePriceConfig.Size()
 	n += 1 + l + sovLiquidationsConfig(uint64(l))
+	l = m.TargetMarginConfig.Size()
+	n += 1 + l + sovLiquidationsConfig(uint64(l))
 	return n
 }
 
@@ -537,6 +680,9 @@ func (m *SubaccountBlockLimits) Size() (n int) {
 	if m.MaxQuantumsInsuranceLost != 0 {
 		n += 1 + sovLiquidationsConfig(uint64(m.MaxQuantumsInsuranceLost))
 	}
+	if m.MaxPerpetualPositionsLiquidated != 0 {
+		n += 1 + sovLiquidationsConfig(uint64(m.MaxPerpetualPositionsLiquidated))
+	}
 	return n
 }
 
@@ -555,6 +701,21 @@ func (m *FillablePriceConfig) Size() (n int) {
 	return n
 }
 
+func (m *TargetMarginConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaintenanceMarginBufferPpm != 0 {
+		n += 1 + sovLiquidationsConfig(uint64(m.MaintenanceMarginBufferPpm))
+	}
+	return n
+}
+
 func sovLiquidationsConfig(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -708,6 +869,39 @@ func (m *LiquidationsConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetMarginConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationsConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidationsConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidationsConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetMarginConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidationsConfig(dAtA[iNdEx:])
@@ -884,6 +1078,25 @@ func (m *SubaccountBlockLimits) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerpetualPositionsLiquidated", wireType)
+			}
+			m.MaxPerpetualPositionsLiquidated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationsConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerpetualPositionsLiquidated |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidationsConfig(dAtA[iNdEx:])
@@ -993,6 +1206,95 @@ func (m *FillablePriceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TargetMarginConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidationsConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetMarginConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetMarginConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationsConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginBufferPpm", wireType)
+			}
+			m.MaintenanceMarginBufferPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationsConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceMarginBufferPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidationsConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidationsConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLiquidationsConfig(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,8 +17,9 @@ var (
 			MaxPositionPortionLiquidatedPpm: 1_000_000,
 		},
 		SubaccountBlockLimits: SubaccountBlockLimits{
-			MaxNotionalLiquidated:    100_000_000_000_000,
-			MaxQuantumsInsuranceLost: 100_000_000_000_000,
+			MaxNotionalLiquidated:           100_000_000_000_000,
+			MaxQuantumsInsuranceLost:        100_000_000_000_000,
+			MaxPerpetualPositionsLiquidated: 5,
 		},
 	}
 )
@@ -26,6 +26,13 @@ type LiquidationsKeeper interface {
 		quantumsDeleveraged *big.Int,
 		err error,
 	)
+	MaybeDeleverageSubaccountAsset(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+	) (
+		quantumsDeleveraged *big.Int,
+		err error,
+	)
 	IsLiquidatable(
 		ctx sdk.Context,
 		subaccountId satypes.SubaccountId,
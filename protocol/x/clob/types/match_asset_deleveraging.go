@@ -0,0 +1,17 @@
+package types
+
+import (
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+)
+
+// Validate performs stateless validation on a `MatchAssetDeleveraging` object.
+// It checks the following conditions to be true:
+// - The deleveraged asset is not the quote asset
+// - All conditions checked by `MatchPerpetualDeleveraging.Validate`
+func (match *MatchAssetDeleveraging) Validate() error {
+	if match.GetAssetId() == assettypes.AssetUsdc.Id {
+		return ErrDeleveragingQuoteAsset
+	}
+
+	return validateDeleveragingFills(match.GetLiquidated(), match.GetFills())
+}
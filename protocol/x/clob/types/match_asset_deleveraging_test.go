@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerformStatelessMatchAssetDeleveragingValidation(t *testing.T) {
+	tests := map[string]struct {
+		match types.MatchAssetDeleveraging
+
+		expectedError error
+	}{
+		"Success": {
+			match: types.MatchAssetDeleveraging{
+				Liquidated: constants.Alice_Num0,
+				AssetId:    constants.BtcUsd.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Bob_Num0,
+						FillAmount:             10,
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		"Quote asset cannot be deleveraged": {
+			match: types.MatchAssetDeleveraging{
+				Liquidated: constants.Alice_Num0,
+				AssetId:    assettypes.AssetUsdc.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Bob_Num0,
+						FillAmount:             10,
+					},
+				},
+			},
+			expectedError: types.ErrDeleveragingQuoteAsset,
+		},
+		"Length of fills is zero": {
+			match: types.MatchAssetDeleveraging{
+				Liquidated: constants.Alice_Num0,
+				AssetId:    constants.BtcUsd.Id,
+				Fills:      []types.MatchPerpetualDeleveraging_Fill{},
+			},
+			expectedError: types.ErrEmptyDeleveragingFills,
+		},
+		"Deleveraging fill subaccount id the same as liquidation subaccount id": {
+			match: types.MatchAssetDeleveraging{
+				Liquidated: constants.Alice_Num0,
+				AssetId:    constants.BtcUsd.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Alice_Num0,
+						FillAmount:             10,
+					},
+				},
+			},
+			expectedError: types.ErrDeleveragingAgainstSelf,
+		},
+		"Zero fill amount": {
+			match: types.MatchAssetDeleveraging{
+				Liquidated: constants.Alice_Num0,
+				AssetId:    constants.BtcUsd.Id,
+				Fills: []types.MatchPerpetualDeleveraging_Fill{
+					{
+						OffsettingSubaccountId: constants.Bob_Num0,
+						FillAmount:             0,
+					},
+				},
+			},
+			expectedError: types.ErrZeroDeleveragingFillAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.match.Validate()
+			if tc.expectedError != nil {
+				require.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -12,12 +12,19 @@ import (
 // - Subaccount ids in fills are all unique
 // - Subaccount ids in fills cannot be the same as the liquidated subaccount id
 func (match *MatchPerpetualDeleveraging) Validate() error {
-	liquidatedSubaccountId := match.GetLiquidated()
+	return validateDeleveragingFills(match.GetLiquidated(), match.GetFills())
+}
+
+// validateDeleveragingFills performs stateless validation on the liquidated subaccount id
+// and the fills of a deleveraging match.
+func validateDeleveragingFills(
+	liquidatedSubaccountId satypes.SubaccountId,
+	fills []MatchPerpetualDeleveraging_Fill,
+) error {
 	if err := liquidatedSubaccountId.Validate(); err != nil {
 		return err
 	}
 
-	fills := match.GetFills()
 	if len(fills) == 0 {
 		return ErrEmptyDeleveragingFills
 	}
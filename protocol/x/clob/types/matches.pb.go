@@ -25,15 +25,19 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ClobMatch represents an operations queue entry around all different types
-// of matches, specifically regular matches, liquidation matches, and
-// deleveraging matches.
+// of matches, specifically regular matches, liquidation matches of perpetual
+// positions and asset borrowings, and deleveraging matches of perpetual
+// positions and asset borrowings.
 type ClobMatch struct {
 	// The match type that this message includes.
 	//
 	// Types that are valid to be assigned to Match:
+	//
 	//	*ClobMatch_MatchOrders
 	//	*ClobMatch_MatchPerpetualLiquidation
 	//	*ClobMatch_MatchPerpetualDeleveraging
+	//	*ClobMatch_MatchAssetDeleveraging
+	//	*ClobMatch_MatchAssetLiquidation
 	Match isClobMatch_Match `protobuf_oneof:"match"`
 }
 
@@ -85,10 +89,18 @@ type ClobMatch_MatchPerpetualLiquidation struct {
 type ClobMatch_MatchPerpetualDeleveraging struct {
 	MatchPerpetualDeleveraging *MatchPerpetualDeleveraging `protobuf:"bytes,3,opt,name=match_perpetual_deleveraging,json=matchPerpetualDeleveraging,proto3,oneof" json:"match_perpetual_deleveraging,omitempty"`
 }
+type ClobMatch_MatchAssetDeleveraging struct {
+	MatchAssetDeleveraging *MatchAssetDeleveraging `protobuf:"bytes,4,opt,name=match_asset_deleveraging,json=matchAssetDeleveraging,proto3,oneof" json:"match_asset_deleveraging,omitempty"`
+}
+type ClobMatch_MatchAssetLiquidation struct {
+	MatchAssetLiquidation *MatchAssetLiquidation `protobuf:"bytes,5,opt,name=match_asset_liquidation,json=matchAssetLiquidation,proto3,oneof" json:"match_asset_liquidation,omitempty"`
+}
 
 func (*ClobMatch_MatchOrders) isClobMatch_Match()                {}
 func (*ClobMatch_MatchPerpetualLiquidation) isClobMatch_Match()  {}
 func (*ClobMatch_MatchPerpetualDeleveraging) isClobMatch_Match() {}
+func (*ClobMatch_MatchAssetDeleveraging) isClobMatch_Match()     {}
+func (*ClobMatch_MatchAssetLiquidation) isClobMatch_Match()      {}
 
 func (m *ClobMatch) GetMatch() isClobMatch_Match {
 	if m != nil {
@@ -118,12 +130,28 @@ func (m *ClobMatch) GetMatchPerpetualDeleveraging() *MatchPerpetualDeleveraging
 	return nil
 }
 
+func (m *ClobMatch) GetMatchAssetDeleveraging() *MatchAssetDeleveraging {
+	if x, ok := m.GetMatch().(*ClobMatch_MatchAssetDeleveraging); ok {
+		return x.MatchAssetDeleveraging
+	}
+	return nil
+}
+
+func (m *ClobMatch) GetMatchAssetLiquidation() *MatchAssetLiquidation {
+	if x, ok := m.GetMatch().(*ClobMatch_MatchAssetLiquidation); ok {
+		return x.MatchAssetLiquidation
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ClobMatch) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ClobMatch_MatchOrders)(nil),
 		(*ClobMatch_MatchPerpetualLiquidation)(nil),
 		(*ClobMatch_MatchPerpetualDeleveraging)(nil),
+		(*ClobMatch_MatchAssetDeleveraging)(nil),
+		(*ClobMatch_MatchAssetLiquidation)(nil),
 	}
 }
 
@@ -330,6 +358,99 @@ func (m *MatchPerpetualLiquidation) GetFills() []MakerFill {
 	return nil
 }
 
+// MatchAssetLiquidation is an injected message used for liquidating the
+// borrowed asset position of a subaccount by buying back the asset on a spot
+// clob pair.
+type MatchAssetLiquidation struct {
+	// ID of the subaccount that was liquidated.
+	Liquidated types.SubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
+	// The ID of the spot clob pair involved in the liquidation.
+	ClobPairId uint32 `protobuf:"varint,2,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The ID of the borrowed asset involved in the liquidation.
+	AssetId uint32 `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The total size of the liquidation order including any unfilled size.
+	TotalSize uint64 `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// `true` if repaying a borrowed position, `false` otherwise.
+	IsBuy bool `protobuf:"varint,5,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	// An ordered list of fills created by this liquidation.
+	Fills []MakerFill `protobuf:"bytes,6,rep,name=fills,proto3" json:"fills"`
+}
+
+func (m *MatchAssetLiquidation) Reset()         { *m = MatchAssetLiquidation{} }
+func (m *MatchAssetLiquidation) String() string { return proto.CompactTextString(m) }
+func (*MatchAssetLiquidation) ProtoMessage()    {}
+func (*MatchAssetLiquidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5aa660bc05a1de4, []int{4}
+}
+func (m *MatchAssetLiquidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchAssetLiquidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchAssetLiquidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchAssetLiquidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchAssetLiquidation.Merge(m, src)
+}
+func (m *MatchAssetLiquidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchAssetLiquidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchAssetLiquidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchAssetLiquidation proto.InternalMessageInfo
+
+func (m *MatchAssetLiquidation) GetLiquidated() types.SubaccountId {
+	if m != nil {
+		return m.Liquidated
+	}
+	return types.SubaccountId{}
+}
+
+func (m *MatchAssetLiquidation) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
+	}
+	return 0
+}
+
+func (m *MatchAssetLiquidation) GetAssetId() uint32 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
+}
+
+func (m *MatchAssetLiquidation) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *MatchAssetLiquidation) GetIsBuy() bool {
+	if m != nil {
+		return m.IsBuy
+	}
+	return false
+}
+
+func (m *MatchAssetLiquidation) GetFills() []MakerFill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
 // MatchPerpetualDeleveraging is an injected message used for deleveraging a
 // subaccount.
 type MatchPerpetualDeleveraging struct {
@@ -345,7 +466,7 @@ func (m *MatchPerpetualDeleveraging) Reset()         { *m = MatchPerpetualDeleve
 func (m *MatchPerpetualDeleveraging) String() string { return proto.CompactTextString(m) }
 func (*MatchPerpetualDeleveraging) ProtoMessage()    {}
 func (*MatchPerpetualDeleveraging) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5aa660bc05a1de4, []int{4}
+	return fileDescriptor_a5aa660bc05a1de4, []int{5}
 }
 func (m *MatchPerpetualDeleveraging) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +531,7 @@ func (m *MatchPerpetualDeleveraging_Fill) Reset()         { *m = MatchPerpetualD
 func (m *MatchPerpetualDeleveraging_Fill) String() string { return proto.CompactTextString(m) }
 func (*MatchPerpetualDeleveraging_Fill) ProtoMessage()    {}
 func (*MatchPerpetualDeleveraging_Fill) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5aa660bc05a1de4, []int{4, 0}
+	return fileDescriptor_a5aa660bc05a1de4, []int{5, 0}
 }
 func (m *MatchPerpetualDeleveraging_Fill) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,56 +574,136 @@ func (m *MatchPerpetualDeleveraging_Fill) GetFillAmount() uint64 {
 	return 0
 }
 
+// MatchAssetDeleveraging is an injected message used for deleveraging the
+// asset position of a subaccount. A borrowed (negative) asset position is
+// repaid, and a collateral (positive) asset position of a subaccount with a
+// negative USDC balance is seized.
+type MatchAssetDeleveraging struct {
+	// ID of the subaccount that was liquidated.
+	Liquidated types.SubaccountId `protobuf:"bytes,1,opt,name=liquidated,proto3" json:"liquidated"`
+	// The ID of the asset that was liquidated.
+	AssetId uint32 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// An ordered list of fills created by this liquidation. For a borrowed
+	// position, each fill repays `fill_amount` base quantums of the liquidated
+	// subaccount's borrowing with the positive asset balance of the offsetting
+	// subaccount. For a collateral position, each fill sells `fill_amount` base
+	// quantums of the liquidated subaccount's collateral to the offsetting
+	// subaccount for USDC at the oracle price.
+	Fills []MatchPerpetualDeleveraging_Fill `protobuf:"bytes,3,rep,name=fills,proto3" json:"fills"`
+}
+
+func (m *MatchAssetDeleveraging) Reset()         { *m = MatchAssetDeleveraging{} }
+func (m *MatchAssetDeleveraging) String() string { return proto.CompactTextString(m) }
+func (*MatchAssetDeleveraging) ProtoMessage()    {}
+func (*MatchAssetDeleveraging) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5aa660bc05a1de4, []int{6}
+}
+func (m *MatchAssetDeleveraging) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchAssetDeleveraging) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchAssetDeleveraging.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchAssetDeleveraging) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchAssetDeleveraging.Merge(m, src)
+}
+func (m *MatchAssetDeleveraging) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchAssetDeleveraging) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchAssetDeleveraging.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchAssetDeleveraging proto.InternalMessageInfo
+
+func (m *MatchAssetDeleveraging) GetLiquidated() types.SubaccountId {
+	if m != nil {
+		return m.Liquidated
+	}
+	return types.SubaccountId{}
+}
+
+func (m *MatchAssetDeleveraging) GetAssetId() uint32 {
+	if m != nil {
+		return m.AssetId
+	}
+	return 0
+}
+
+func (m *MatchAssetDeleveraging) GetFills() []MatchPerpetualDeleveraging_Fill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClobMatch)(nil), "dydxprotocol.clob.ClobMatch")
 	proto.RegisterType((*MakerFill)(nil), "dydxprotocol.clob.MakerFill")
 	proto.RegisterType((*MatchOrders)(nil), "dydxprotocol.clob.MatchOrders")
 	proto.RegisterType((*MatchPerpetualLiquidation)(nil), "dydxprotocol.clob.MatchPerpetualLiquidation")
+	proto.RegisterType((*MatchAssetLiquidation)(nil), "dydxprotocol.clob.MatchAssetLiquidation")
 	proto.RegisterType((*MatchPerpetualDeleveraging)(nil), "dydxprotocol.clob.MatchPerpetualDeleveraging")
 	proto.RegisterType((*MatchPerpetualDeleveraging_Fill)(nil), "dydxprotocol.clob.MatchPerpetualDeleveraging.Fill")
+	proto.RegisterType((*MatchAssetDeleveraging)(nil), "dydxprotocol.clob.MatchAssetDeleveraging")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/clob/matches.proto", fileDescriptor_a5aa660bc05a1de4) }
 
 var fileDescriptor_a5aa660bc05a1de4 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xb6, 0xdd, 0x24, 0xbf, 0x5f, 0xc6, 0x29, 0x12, 0x16, 0x20, 0xd7, 0xb4, 0x4e, 0xc8, 0x01,
-	0x05, 0x89, 0x3a, 0x52, 0x40, 0x88, 0x2b, 0x29, 0xaa, 0x52, 0xa9, 0x85, 0xc8, 0xbd, 0x71, 0xb1,
-	0xfc, 0x67, 0xe3, 0xac, 0xb0, 0xbd, 0xa9, 0xbd, 0xae, 0x9a, 0xbe, 0x44, 0x79, 0x02, 0x9e, 0x84,
-	0x07, 0xe8, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x0f, 0xc0, 0x2b, 0xa0, 0x5d, 0x27, 0xb1, 0x93, 0x34,
-	0x82, 0x48, 0xdc, 0xd6, 0xdf, 0xcc, 0x7c, 0xfb, 0xcd, 0xb7, 0x33, 0x86, 0xba, 0x37, 0xf6, 0xae,
-	0x46, 0x31, 0xa1, 0xc4, 0x25, 0x41, 0xdb, 0x0d, 0x88, 0xd3, 0x0e, 0x6d, 0xea, 0x0e, 0x51, 0x62,
-	0x70, 0x54, 0x79, 0x58, 0x4c, 0x30, 0x58, 0x82, 0xf6, 0xc8, 0x27, 0x3e, 0xe1, 0x50, 0x9b, 0x9d,
-	0xb2, 0x44, 0xed, 0xc5, 0x12, 0x53, 0x92, 0x3a, 0xb6, 0xeb, 0x92, 0x34, 0xa2, 0x49, 0xe1, 0x3c,
-	0x4b, 0x3d, 0x58, 0xbf, 0x94, 0xc4, 0x1e, 0x8a, 0xb3, 0x70, 0xf3, 0x9b, 0x04, 0xd5, 0xa3, 0x80,
-	0x38, 0x67, 0x4c, 0x88, 0x72, 0x04, 0x35, 0xae, 0xc8, 0xe2, 0x29, 0x89, 0x2a, 0x36, 0xc4, 0x96,
-	0xdc, 0xd1, 0x8d, 0x35, 0x5d, 0x06, 0xcf, 0xff, 0xc8, 0xb3, 0x7a, 0x82, 0x29, 0x87, 0xf9, 0xa7,
-	0x12, 0xc1, 0xd3, 0x8c, 0x64, 0x84, 0xe2, 0x11, 0xa2, 0xa9, 0x1d, 0x58, 0x01, 0xbe, 0x48, 0xb1,
-	0x67, 0x53, 0x4c, 0x22, 0x55, 0xe2, 0x9c, 0x2f, 0x37, 0x71, 0xf6, 0xe7, 0x45, 0xa7, 0x79, 0x4d,
-	0x4f, 0x30, 0xf7, 0xc2, 0x4d, 0x41, 0xe5, 0x02, 0xf6, 0x57, 0xef, 0xf3, 0x50, 0x80, 0x2e, 0x51,
-	0x6c, 0xfb, 0x38, 0xf2, 0xd5, 0x1d, 0x7e, 0xe1, 0xe1, 0x1f, 0x2f, 0x7c, 0x5f, 0x28, 0xea, 0x09,
-	0xa6, 0x16, 0x6e, 0x8c, 0x76, 0xff, 0x83, 0x32, 0x8f, 0x36, 0x29, 0x54, 0xcf, 0xec, 0xcf, 0x28,
-	0x3e, 0xc6, 0x41, 0xa0, 0xd4, 0x41, 0x1e, 0xe0, 0x20, 0xb0, 0xec, 0x90, 0xf9, 0xcf, 0xcd, 0x2b,
-	0x99, 0xc0, 0xa0, 0x77, 0x1c, 0x51, 0x8e, 0xe1, 0x41, 0xc8, 0xb2, 0x33, 0x7b, 0x2d, 0xec, 0xcd,
-	0xcc, 0xd0, 0xee, 0xd1, 0xc6, 0xcd, 0x3c, 0xf1, 0xba, 0xa5, 0xdb, 0x1f, 0x75, 0xc1, 0xac, 0xf1,
-	0xba, 0x19, 0xd6, 0xbc, 0x11, 0x41, 0x2e, 0x3c, 0x00, 0xe3, 0xa5, 0xcb, 0xbc, 0xe2, 0xdf, 0xf2,
-	0xd2, 0x02, 0xaf, 0xf2, 0x16, 0xca, 0x4c, 0x6d, 0xa2, 0x4a, 0x8d, 0x9d, 0x96, 0xdc, 0xd9, 0xbf,
-	0xd7, 0xb2, 0x59, 0xb7, 0x33, 0x82, 0xac, 0xa0, 0xf9, 0x55, 0x82, 0xbd, 0x8d, 0xcf, 0xa7, 0x9c,
-	0x02, 0xcc, 0x27, 0x00, 0xcd, 0xb5, 0x3d, 0x5f, 0x26, 0x2f, 0xcc, 0xb0, 0x71, 0xbe, 0x38, 0x2f,
-	0x74, 0x16, 0xea, 0x95, 0x06, 0xd4, 0x98, 0x14, 0x6b, 0x64, 0xe3, 0x85, 0x87, 0xbb, 0x26, 0x30,
-	0xac, 0x6f, 0x63, 0xd6, 0xc7, 0x33, 0xa8, 0xe5, 0xb3, 0x80, 0x3d, 0x3e, 0x01, 0xbb, 0xa6, 0xbc,
-	0xc0, 0x4e, 0x3c, 0xe5, 0x00, 0x80, 0x12, 0x6a, 0x07, 0x56, 0x82, 0xaf, 0x91, 0x5a, 0xe2, 0x4f,
-	0x55, 0xe5, 0xc8, 0x39, 0xbe, 0x46, 0xca, 0x63, 0xa8, 0xe0, 0xc4, 0x72, 0xd2, 0xb1, 0x5a, 0x6e,
-	0x88, 0xad, 0xff, 0xcd, 0x32, 0x4e, 0xba, 0xe9, 0x38, 0x37, 0xa8, 0xb2, 0xad, 0x41, 0xbf, 0x24,
-	0xd0, 0x36, 0x8f, 0xdb, 0x3f, 0x76, 0x68, 0xb5, 0x7f, 0x69, 0xbd, 0xff, 0x0f, 0xf3, 0x4e, 0x76,
-	0x78, 0x27, 0x9d, 0xad, 0xb6, 0xc3, 0x58, 0xeb, 0x4f, 0xbb, 0x11, 0xa1, 0xc4, 0x97, 0x60, 0x00,
-	0x2a, 0x19, 0x0c, 0x12, 0x44, 0x29, 0x8e, 0x7c, 0x2b, 0x17, 0x9d, 0x4f, 0xe5, 0x76, 0x7d, 0x3d,
-	0xc9, 0xd9, 0x8a, 0xd1, 0xd5, 0x65, 0x93, 0x56, 0x97, 0xad, 0xdb, 0xbf, 0x9d, 0xe8, 0xe2, 0xdd,
-	0x44, 0x17, 0x7f, 0x4e, 0x74, 0xf1, 0xcb, 0x54, 0x17, 0xee, 0xa6, 0xba, 0xf0, 0x7d, 0xaa, 0x0b,
-	0x9f, 0xde, 0xf8, 0x98, 0x0e, 0x53, 0xc7, 0x70, 0x49, 0xd8, 0x5e, 0xfa, 0x3b, 0x5e, 0xbe, 0x3e,
-	0x74, 0x87, 0x36, 0x8e, 0xda, 0x0b, 0xe4, 0x2a, 0xfb, 0x63, 0xd2, 0xf1, 0x08, 0x25, 0x4e, 0x85,
-	0xc3, 0xaf, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x86, 0x7f, 0x34, 0xa0, 0xc8, 0x05, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0xb5, 0x4d, 0xc2, 0xcf, 0x4d, 0xf8, 0xa4, 0x6f, 0x54, 0x68, 0x48, 0xc1, 0xd0, 0x2c, 0x2a,
+	0x90, 0x8a, 0x23, 0xd1, 0xaa, 0xea, 0x96, 0x50, 0x21, 0x90, 0xa0, 0x45, 0x66, 0xd7, 0x8d, 0x35,
+	0xb6, 0x27, 0x61, 0x54, 0x3b, 0x13, 0x32, 0x63, 0x44, 0x78, 0x81, 0x2e, 0xa9, 0xfa, 0x00, 0x7d,
+	0x1e, 0x96, 0x74, 0xd7, 0x55, 0x55, 0xc1, 0x03, 0xf4, 0x15, 0xaa, 0x19, 0xe7, 0xc7, 0x4e, 0xec,
+	0xb6, 0x54, 0xa8, 0xdd, 0x8d, 0xef, 0xcf, 0x39, 0xf7, 0x1e, 0x1f, 0x8f, 0x61, 0xd5, 0xef, 0xf9,
+	0xe7, 0x9d, 0x2e, 0x13, 0xcc, 0x63, 0x41, 0xdd, 0x0b, 0x98, 0x5b, 0x0f, 0xb1, 0xf0, 0x4e, 0x08,
+	0xb7, 0x54, 0x14, 0xfd, 0x9f, 0x2c, 0xb0, 0x64, 0x41, 0xf5, 0x41, 0x8b, 0xb5, 0x98, 0x0a, 0xd5,
+	0xe5, 0x29, 0x2e, 0xac, 0x6e, 0xa4, 0x90, 0x78, 0xe4, 0x62, 0xcf, 0x63, 0x51, 0x5b, 0xf0, 0xc4,
+	0xb9, 0x5f, 0xba, 0x32, 0x49, 0xca, 0xba, 0x3e, 0xe9, 0xc6, 0xe9, 0xda, 0xfb, 0x02, 0xcc, 0xed,
+	0x04, 0xcc, 0x3d, 0x94, 0x83, 0xa0, 0x1d, 0x28, 0xab, 0x89, 0x1c, 0x55, 0xc2, 0x2b, 0xfa, 0x9a,
+	0xbe, 0x5e, 0xda, 0x32, 0xad, 0x89, 0xb9, 0x2c, 0x55, 0xff, 0x46, 0x55, 0xed, 0x69, 0x76, 0x29,
+	0x1c, 0x3d, 0xa2, 0x36, 0x3c, 0x8a, 0x41, 0x3a, 0xa4, 0xdb, 0x21, 0x22, 0xc2, 0x81, 0x13, 0xd0,
+	0xd3, 0x88, 0xfa, 0x58, 0x50, 0xd6, 0xae, 0x18, 0x0a, 0xf3, 0x69, 0x1e, 0xe6, 0xd1, 0xa0, 0xe9,
+	0x60, 0xd4, 0xb3, 0xa7, 0xd9, 0x4b, 0x61, 0x5e, 0x12, 0x9d, 0xc2, 0xf2, 0x38, 0x9f, 0x4f, 0x02,
+	0x72, 0x46, 0xba, 0xb8, 0x45, 0xdb, 0xad, 0xca, 0x94, 0x22, 0xdc, 0xfc, 0x25, 0xe1, 0xab, 0x44,
+	0xd3, 0x9e, 0x66, 0x57, 0xc3, 0xdc, 0x2c, 0x22, 0x50, 0x89, 0x29, 0x31, 0xe7, 0x44, 0xa4, 0xe9,
+	0x0a, 0x8a, 0x6e, 0x23, 0x8f, 0x6e, 0x5b, 0x76, 0x8c, 0x51, 0x2d, 0x86, 0x99, 0x19, 0xe4, 0xc2,
+	0xc3, 0x24, 0x4d, 0x52, 0xc5, 0xa2, 0x62, 0x59, 0xff, 0x29, 0x4b, 0x5a, 0xc1, 0x85, 0x30, 0x2b,
+	0xd1, 0x98, 0x81, 0xa2, 0x4a, 0xd4, 0x04, 0xcc, 0x1d, 0xe2, 0x77, 0xa4, 0xbb, 0x4b, 0x83, 0x00,
+	0xad, 0x42, 0xa9, 0x49, 0x83, 0xc0, 0xc1, 0xa1, 0xb4, 0x92, 0xf2, 0x41, 0xc1, 0x06, 0x19, 0xda,
+	0x56, 0x11, 0xb4, 0x0b, 0xff, 0x85, 0xb2, 0x3a, 0x76, 0x8a, 0x43, 0xfd, 0xfe, 0x7b, 0xad, 0x66,
+	0x4c, 0xa4, 0x7c, 0xb1, 0xef, 0x37, 0x0a, 0x57, 0x5f, 0x57, 0x35, 0xbb, 0xac, 0xfa, 0xfa, 0xb1,
+	0xda, 0xa5, 0x0e, 0xa5, 0x84, 0x97, 0x24, 0xae, 0x48, 0xe3, 0xea, 0xbf, 0x8b, 0x2b, 0x12, 0xb8,
+	0xe8, 0x25, 0x14, 0xe5, 0xb4, 0xbc, 0x62, 0xac, 0x4d, 0xad, 0x97, 0xb6, 0x96, 0x33, 0x85, 0xea,
+	0x6f, 0xdb, 0x07, 0x88, 0x1b, 0x6a, 0x9f, 0x0c, 0x58, 0xca, 0x75, 0x22, 0x3a, 0x00, 0x18, 0xbc,
+	0x06, 0x32, 0x98, 0xed, 0x49, 0x1a, 0x3c, 0xf1, 0x39, 0x5a, 0xc7, 0xc3, 0xf3, 0x70, 0xce, 0x44,
+	0x3f, 0x5a, 0x83, 0xb2, 0x1c, 0xc5, 0xe9, 0x60, 0x3a, 0xd4, 0x70, 0xde, 0x06, 0x19, 0x3b, 0xc2,
+	0x54, 0xee, 0xf1, 0x18, 0xca, 0x23, 0x5b, 0x53, 0x5f, 0x99, 0x79, 0xde, 0x2e, 0x0d, 0x63, 0xfb,
+	0x3e, 0x5a, 0x01, 0x10, 0x4c, 0xe0, 0xc0, 0xe1, 0xf4, 0x82, 0x28, 0xfb, 0x15, 0xec, 0x39, 0x15,
+	0x39, 0xa6, 0x17, 0x04, 0x2d, 0xc0, 0x34, 0xe5, 0x8e, 0x1b, 0xf5, 0x94, 0x67, 0x66, 0xed, 0x22,
+	0xe5, 0x8d, 0xa8, 0x37, 0x12, 0x68, 0xfa, 0xae, 0x02, 0x7d, 0x34, 0x60, 0x21, 0xd3, 0x64, 0x7f,
+	0x5d, 0x9c, 0x25, 0x98, 0x8d, 0xbf, 0x8c, 0xa1, 0x30, 0x33, 0xea, 0xf9, 0x1f, 0x88, 0xf2, 0xdd,
+	0x80, 0x6a, 0xfe, 0x75, 0x72, 0xcf, 0xca, 0x8c, 0x9b, 0xc2, 0x98, 0x34, 0xc5, 0xeb, 0xc1, 0x26,
+	0x53, 0x6a, 0x93, 0xad, 0x3b, 0xdd, 0x7e, 0xd6, 0xc4, 0x7e, 0xd5, 0x4b, 0x1d, 0x0a, 0x32, 0x8a,
+	0x9a, 0x50, 0x61, 0xcd, 0x26, 0x27, 0x42, 0xd0, 0x76, 0xcb, 0x19, 0x0d, 0xed, 0xd0, 0x3f, 0xdb,
+	0x6b, 0x71, 0x84, 0x96, 0xcc, 0x8e, 0xdf, 0x40, 0xc6, 0xf8, 0x0d, 0x54, 0xfb, 0xac, 0xc3, 0x62,
+	0xf6, 0x8d, 0x7a, 0xcf, 0x6a, 0x27, 0x5d, 0x66, 0xa4, 0x5d, 0x76, 0xcf, 0x2a, 0x37, 0x8e, 0xae,
+	0x6e, 0x4c, 0xfd, 0xfa, 0xc6, 0xd4, 0xbf, 0xdd, 0x98, 0xfa, 0x87, 0x5b, 0x53, 0xbb, 0xbe, 0x35,
+	0xb5, 0x2f, 0xb7, 0xa6, 0xf6, 0xf6, 0x45, 0x8b, 0x8a, 0x93, 0xc8, 0xb5, 0x3c, 0x16, 0xd6, 0x53,
+	0x7f, 0xf4, 0xb3, 0xe7, 0x9b, 0xde, 0x09, 0xa6, 0xed, 0xfa, 0x30, 0x72, 0x1e, 0xff, 0xe5, 0x45,
+	0xaf, 0x43, 0xb8, 0x3b, 0xad, 0xc2, 0xcf, 0x7e, 0x0c, 0x00, 0x86, 0x56, 0x77, 0x96, 0x7c, 0x08,
+	0x00, 0x00,
 }
 
 func (m *ClobMatch) Marshal() (dAtA []byte, err error) {
@@ -600,6 +801,48 @@ func (m *ClobMatch_MatchPerpetualDeleveraging) MarshalToSizedBuffer(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ClobMatch_MatchAssetDeleveraging) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClobMatch_MatchAssetDeleveraging) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MatchAssetDeleveraging != nil {
+		{
+			size, err := m.MatchAssetDeleveraging.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMatches(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ClobMatch_MatchAssetLiquidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClobMatch_MatchAssetLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MatchAssetLiquidation != nil {
+		{
+			size, err := m.MatchAssetLiquidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMatches(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MakerFill) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -757,6 +1000,78 @@ func (m *MatchPerpetualLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MatchAssetLiquidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchAssetLiquidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchAssetLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMatches(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintMatches(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintMatches(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintMatches(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Liquidated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMatches(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MatchPerpetualDeleveraging) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -847,40 +1162,92 @@ func (m *MatchPerpetualDeleveraging_Fill) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMatches(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMatches(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MatchAssetDeleveraging) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClobMatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Match != nil {
-		n += m.Match.Size()
-	}
-	return n
+
+func (m *MatchAssetDeleveraging) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClobMatch_MatchOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MatchAssetDeleveraging) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MatchOrders != nil {
-		l = m.MatchOrders.Size()
-		n += 1 + l + sovMatches(uint64(l))
-	}
-	return n
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMatches(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AssetId != 0 {
+		i = encodeVarintMatches(dAtA, i, uint64(m.AssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Liquidated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMatches(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMatches(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMatches(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClobMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Match != nil {
+		n += m.Match.Size()
+	}
+	return n
+}
+
+func (m *ClobMatch_MatchOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchOrders != nil {
+		l = m.MatchOrders.Size()
+		n += 1 + l + sovMatches(uint64(l))
+	}
+	return n
 }
 func (m *ClobMatch_MatchPerpetualLiquidation) Size() (n int) {
 	if m == nil {
@@ -906,6 +1273,30 @@ func (m *ClobMatch_MatchPerpetualDeleveraging) Size() (n int) {
 	}
 	return n
 }
+func (m *ClobMatch_MatchAssetDeleveraging) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchAssetDeleveraging != nil {
+		l = m.MatchAssetDeleveraging.Size()
+		n += 1 + l + sovMatches(uint64(l))
+	}
+	return n
+}
+func (m *ClobMatch_MatchAssetLiquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchAssetLiquidation != nil {
+		l = m.MatchAssetLiquidation.Size()
+		n += 1 + l + sovMatches(uint64(l))
+	}
+	return n
+}
 func (m *MakerFill) Size() (n int) {
 	if m == nil {
 		return 0
@@ -966,6 +1357,35 @@ func (m *MatchPerpetualLiquidation) Size() (n int) {
 	return n
 }
 
+func (m *MatchAssetLiquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Liquidated.Size()
+	n += 1 + l + sovMatches(uint64(l))
+	if m.ClobPairId != 0 {
+		n += 1 + sovMatches(uint64(m.ClobPairId))
+	}
+	if m.AssetId != 0 {
+		n += 1 + sovMatches(uint64(m.AssetId))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovMatches(uint64(m.TotalSize))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovMatches(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MatchPerpetualDeleveraging) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1000,6 +1420,26 @@ func (m *MatchPerpetualDeleveraging_Fill) Size() (n int) {
 	return n
 }
 
+func (m *MatchAssetDeleveraging) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Liquidated.Size()
+	n += 1 + l + sovMatches(uint64(l))
+	if m.AssetId != 0 {
+		n += 1 + sovMatches(uint64(m.AssetId))
+	}
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovMatches(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMatches(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1140,6 +1580,76 @@ func (m *ClobMatch) Unmarshal(dAtA []byte) error {
 			}
 			m.Match = &ClobMatch_MatchPerpetualDeleveraging{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchAssetDeleveraging", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatches
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MatchAssetDeleveraging{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Match = &ClobMatch_MatchAssetDeleveraging{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchAssetLiquidation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatches
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MatchAssetLiquidation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Match = &ClobMatch_MatchAssetLiquidation{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMatches(dAtA[iNdEx:])
@@ -1574,6 +2084,200 @@ func (m *MatchPerpetualLiquidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MatchAssetLiquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatches
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchAssetLiquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchAssetLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatches
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatches
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, MakerFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatches(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatches
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MatchPerpetualDeleveraging) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1812,6 +2516,142 @@ func (m *MatchPerpetualDeleveraging_Fill) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MatchAssetDeleveraging) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatches
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchAssetDeleveraging: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchAssetDeleveraging: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatches
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			m.AssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatches
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, MatchPerpetualDeleveraging_Fill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatches(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatches
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMatches(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
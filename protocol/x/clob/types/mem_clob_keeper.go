@@ -40,6 +40,7 @@ type MemClobKeeper interface {
 	CanDeleverageSubaccount(
 		ctx sdk.Context,
 		subaccountId satypes.SubaccountId,
+		perpetualId uint32,
 	) (bool, error)
 	GetStatePosition(
 		ctx sdk.Context,
@@ -57,6 +58,15 @@ type MemClobKeeper interface {
 		offchainUpdates *OffchainUpdates,
 		err error,
 	)
+	ReplayReplaceOrder(
+		ctx sdk.Context,
+		msg *MsgReplaceOrder,
+	) (
+		orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+		orderStatus OrderStatus,
+		offchainUpdates *OffchainUpdates,
+		err error,
+	)
 	AddPreexistingStatefulOrder(
 		ctx sdk.Context,
 		order *Order,
@@ -94,6 +104,15 @@ type MemClobKeeper interface {
 		fills []MatchPerpetualDeleveraging_Fill,
 		deltaQuantumsRemaining *big.Int,
 	)
+	OffsetSubaccountAssetPosition(
+		ctx sdk.Context,
+		liquidatedSubaccountId satypes.SubaccountId,
+		assetId uint32,
+		deltaQuantumsTotal *big.Int,
+	) (
+		fills []MatchPerpetualDeleveraging_Fill,
+		deltaQuantumsRemaining *big.Int,
+	)
 	GetIndexerEventManager() indexer_manager.IndexerEventManager
 	IsLiquidatable(
 		ctx sdk.Context,
@@ -13,6 +13,10 @@ import (
 // `MsgPlaceOrder` or `MsgCancelOrder` message will be considered valid by the validator.
 const ShortBlockWindow uint32 = 20
 
+// ShortTermOrderTimeWindow represents the maximum amount of time past the previous block time that the
+// `GoodTilBlockTime` of a Short-Term `MsgPlaceOrder` message will be considered valid by the validator.
+const ShortTermOrderTimeWindow time.Duration = 30 * time.Second
+
 // StatefulOrderTimeWindow represents the maximum amount of time in seconds past the current block time that a
 // long-term/conditional `MsgPlaceOrder` message will be considered valid by the validator.
 const StatefulOrderTimeWindow time.Duration = 95 * 24 * time.Hour // 95 days.
@@ -72,10 +76,31 @@ type MemClob interface {
 		subaccountId satypes.SubaccountId,
 		side Order_Side,
 	) ([]Order, error)
+	GetSubaccountOpenOrders(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+	) []Order
+	GetOrderbookLevels(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+		depth uint32,
+	) (
+		bids []OrderbookLevel,
+		asks []OrderbookLevel,
+		err error,
+	)
+	GetOffchainUpdatesForOrderbookSnapshot(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+	) (offchainUpdates *OffchainUpdates)
 	PlaceOrder(
 		ctx sdk.Context,
 		order Order,
 	) (satypes.BaseQuantums, OrderStatus, *OffchainUpdates, error)
+	ReplaceOrder(
+		ctx sdk.Context,
+		msgReplaceOrder *MsgReplaceOrder,
+	) (satypes.BaseQuantums, OrderStatus, *OffchainUpdates, error)
 	PlacePerpetualLiquidation(
 		ctx sdk.Context,
 		liquidationOrder LiquidationOrder,
@@ -85,6 +110,15 @@ type MemClob interface {
 		offchainUpdates *OffchainUpdates,
 		err error,
 	)
+	PlaceAssetLiquidation(
+		ctx sdk.Context,
+		liquidationOrder LiquidationOrder,
+	) (
+		orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+		orderStatus OrderStatus,
+		offchainUpdates *OffchainUpdates,
+		err error,
+	)
 	DeleverageSubaccount(
 		ctx sdk.Context,
 		subaccountId satypes.SubaccountId,
@@ -94,6 +128,15 @@ type MemClob interface {
 		quantumsDeleveraged *big.Int,
 		err error,
 	)
+	DeleverageSubaccountAsset(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		assetId uint32,
+		deltaQuantums *big.Int,
+	) (
+		quantumsDeleveraged *big.Int,
+		err error,
+	)
 	RemoveOrderIfFilled(
 		ctx sdk.Context,
 		orderId OrderId,
@@ -106,6 +149,11 @@ type MemClob interface {
 		premiumPpm int32,
 		err error,
 	)
+	RemoveOrdersForClobPair(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+		existingOffchainUpdates *OffchainUpdates,
+	) (offchainUpdates *OffchainUpdates)
 	RemoveAndClearOperationsQueue(
 		ctx sdk.Context,
 		localValidatorOperationsQueue []InternalOperation,
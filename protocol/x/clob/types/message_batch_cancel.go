@@ -0,0 +1,154 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+const TypeMsgBatchCancel = "batch_cancel"
+
+// MaxMsgBatchCancelOrderIds is the maximum number of orders that can be canceled by a single `MsgBatchCancel`.
+const MaxMsgBatchCancelOrderIds = 100
+
+var _ sdk.Msg = &MsgBatchCancel{}
+
+// NewMsgBatchCancelShortTerm constructs a MsgBatchCancel of Short-Term orders from a list of
+// `OrderBatch`es and a `GoodTilBlock`.
+func NewMsgBatchCancelShortTerm(
+	subaccountId satypes.SubaccountId,
+	orderBatches []OrderBatch,
+	goodTilBlock uint32,
+) *MsgBatchCancel {
+	return &MsgBatchCancel{
+		SubaccountId: subaccountId,
+		OrderBatches: orderBatches,
+		GoodTilOneof: &MsgBatchCancel_GoodTilBlock{GoodTilBlock: goodTilBlock},
+	}
+}
+
+// NewMsgBatchCancelStateful constructs a MsgBatchCancel of stateful orders from a list of
+// `OrderBatch`es and a `GoodTilBlockTime`.
+func NewMsgBatchCancelStateful(
+	subaccountId satypes.SubaccountId,
+	orderBatches []OrderBatch,
+	goodTilBlockTime uint32,
+) *MsgBatchCancel {
+	return &MsgBatchCancel{
+		SubaccountId: subaccountId,
+		OrderBatches: orderBatches,
+		GoodTilOneof: &MsgBatchCancel_GoodTilBlockTime{GoodTilBlockTime: goodTilBlockTime},
+	}
+}
+
+func (msg *MsgBatchCancel) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetOrderIds returns the IDs of all orders in the batch, in the order they appear in the message.
+func (msg *MsgBatchCancel) GetOrderIds() []OrderId {
+	orderIds := make([]OrderId, 0)
+	for _, orderBatch := range msg.OrderBatches {
+		for _, clientId := range orderBatch.ClientIds {
+			orderIds = append(
+				orderIds,
+				OrderId{
+					SubaccountId: msg.SubaccountId,
+					ClientId:     clientId,
+					OrderFlags:   orderBatch.OrderFlags,
+					ClobPairId:   orderBatch.ClobPairId,
+				},
+			)
+		}
+	}
+	return orderIds
+}
+
+// IsShortTermBatch returns true if the batch cancels Short-Term orders. Note that `ValidateBasic`
+// ensures that a batch does not contain both Short-Term and stateful orders.
+func (msg *MsgBatchCancel) IsShortTermBatch() bool {
+	return len(msg.OrderBatches) > 0 && msg.OrderBatches[0].OrderFlags == OrderIdFlags_ShortTerm
+}
+
+// GetCancelOrderMsgs returns a `MsgCancelOrder` for each order in the batch, which expire at the
+// `GoodTilBlock` or `GoodTilBlockTime` of the batch.
+func (msg *MsgBatchCancel) GetCancelOrderMsgs() []*MsgCancelOrder {
+	orderIds := msg.GetOrderIds()
+	msgs := make([]*MsgCancelOrder, 0, len(orderIds))
+	for _, orderId := range orderIds {
+		msgCancelOrder := &MsgCancelOrder{OrderId: orderId}
+		if orderId.IsShortTermOrder() {
+			msgCancelOrder.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{GoodTilBlock: msg.GetGoodTilBlock()}
+		} else {
+			msgCancelOrder.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{GoodTilBlockTime: msg.GetGoodTilBlockTime()}
+		}
+		msgs = append(msgs, msgCancelOrder)
+	}
+	return msgs
+}
+
+// ValidateBasic performs stateless validation of the batch cancel. It returns an error if:
+//   - The subaccount ID is invalid.
+//   - The batch is empty, contains more than `MaxMsgBatchCancelOrderIds` orders, or contains duplicate orders.
+//   - Any order ID is invalid.
+//   - The batch contains both Short-Term and stateful orders.
+//   - The `GoodTilBlock` is zero for Short-Term orders or the `GoodTilBlockTime` is zero for stateful orders.
+func (msg *MsgBatchCancel) ValidateBasic() error {
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	orderIds := msg.GetOrderIds()
+	if len(orderIds) == 0 {
+		return errorsmod.Wrapf(ErrInvalidBatchCancel, "batch cannot be empty")
+	}
+	if len(orderIds) > MaxMsgBatchCancelOrderIds {
+		return errorsmod.Wrapf(
+			ErrInvalidBatchCancel,
+			"batch contains %d orders, which exceeds the maximum of %d",
+			len(orderIds),
+			MaxMsgBatchCancelOrderIds,
+		)
+	}
+
+	isShortTermBatch := msg.IsShortTermBatch()
+	seenOrderIds := make(map[OrderId]struct{}, len(orderIds))
+	for _, orderId := range orderIds {
+		if err := orderId.Validate(); err != nil {
+			return err
+		}
+		if orderId.IsShortTermOrder() != isShortTermBatch {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchCancel,
+				"batch cannot contain both Short-Term and stateful orders",
+			)
+		}
+		if _, exists := seenOrderIds[orderId]; exists {
+			return errorsmod.Wrapf(ErrInvalidBatchCancel, "duplicate order %+v in batch", orderId)
+		}
+		seenOrderIds[orderId] = struct{}{}
+	}
+
+	if isShortTermBatch {
+		if msg.GetGoodTilBlock() == 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidOrderGoodTilBlock,
+				"batch cancellation goodTilBlock cannot be 0, subaccount %+v",
+				msg.SubaccountId,
+			)
+		}
+	} else {
+		if msg.GetGoodTilBlockTime() == 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidStatefulOrderGoodTilBlockTime,
+				"stateful batch cancellation goodTilBlockTime cannot be 0, subaccount %+v",
+				msg.SubaccountId,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchCancel_ValidateBasic(t *testing.T) {
+	subaccountId := satypes.SubaccountId{
+		Owner:  sample.AccAddress(),
+		Number: uint32(0),
+	}
+	tooManyClientIds := make([]uint32, MaxMsgBatchCancelOrderIds+1)
+	for i := range tooManyClientIds {
+		tooManyClientIds[i] = uint32(i)
+	}
+
+	tests := map[string]struct {
+		msg MsgBatchCancel
+		err error
+	}{
+		"invalid subaccountId owner": {
+			msg: *NewMsgBatchCancelShortTerm(
+				satypes.SubaccountId{Owner: "invalid_owner"},
+				[]OrderBatch{{ClientIds: []uint32{0}}},
+				100,
+			),
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"empty batch": {
+			msg: *NewMsgBatchCancelShortTerm(subaccountId, []OrderBatch{}, 100),
+			err: ErrInvalidBatchCancel,
+		},
+		"batch without client ids": {
+			msg: *NewMsgBatchCancelShortTerm(subaccountId, []OrderBatch{{ClobPairId: 0}}, 100),
+			err: ErrInvalidBatchCancel,
+		},
+		"batch exceeds maximum number of orders": {
+			msg: *NewMsgBatchCancelShortTerm(
+				subaccountId,
+				[]OrderBatch{{ClientIds: tooManyClientIds}},
+				100,
+			),
+			err: ErrInvalidBatchCancel,
+		},
+		"invalid order flags": {
+			msg: *NewMsgBatchCancelStateful(
+				subaccountId,
+				[]OrderBatch{{OrderFlags: 1, ClientIds: []uint32{0}}},
+				100,
+			),
+			err: ErrInvalidOrderFlag,
+		},
+		"batch contains both short term and stateful orders": {
+			msg: *NewMsgBatchCancelShortTerm(
+				subaccountId,
+				[]OrderBatch{
+					{ClientIds: []uint32{0}},
+					{OrderFlags: OrderIdFlags_LongTerm, ClientIds: []uint32{0}},
+				},
+				100,
+			),
+			err: ErrInvalidBatchCancel,
+		},
+		"batch contains duplicate orders": {
+			msg: *NewMsgBatchCancelShortTerm(
+				subaccountId,
+				[]OrderBatch{
+					{ClobPairId: 1, ClientIds: []uint32{0, 1}},
+					{ClobPairId: 1, ClientIds: []uint32{1}},
+				},
+				100,
+			),
+			err: ErrInvalidBatchCancel,
+		},
+		"short term batch invalid 0 valued GoodTilBlock": {
+			msg: *NewMsgBatchCancelShortTerm(subaccountId, []OrderBatch{{ClientIds: []uint32{0}}}, 0),
+			err: ErrInvalidOrderGoodTilBlock,
+		},
+		"short term batch with GoodTilBlockTime": {
+			msg: *NewMsgBatchCancelStateful(subaccountId, []OrderBatch{{ClientIds: []uint32{0}}}, 100),
+			err: ErrInvalidOrderGoodTilBlock,
+		},
+		"short term batch valid": {
+			msg: *NewMsgBatchCancelShortTerm(
+				subaccountId,
+				[]OrderBatch{
+					{ClobPairId: 0, ClientIds: []uint32{0, 1}},
+					{ClobPairId: 1, ClientIds: []uint32{0, 1}},
+				},
+				100,
+			),
+		},
+		"stateful batch invalid 0 valued GoodTilBlockTime": {
+			msg: *NewMsgBatchCancelStateful(
+				subaccountId,
+				[]OrderBatch{{OrderFlags: OrderIdFlags_LongTerm, ClientIds: []uint32{0}}},
+				0,
+			),
+			err: ErrInvalidStatefulOrderGoodTilBlockTime,
+		},
+		"stateful batch valid": {
+			msg: *NewMsgBatchCancelStateful(
+				subaccountId,
+				[]OrderBatch{
+					{OrderFlags: OrderIdFlags_LongTerm, ClientIds: []uint32{0, 1}},
+					{OrderFlags: OrderIdFlags_Conditional, ClientIds: []uint32{0, 1}},
+				},
+				100,
+			),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBatchCancel_GetCancelOrderMsgs(t *testing.T) {
+	subaccountId := satypes.SubaccountId{
+		Owner:  sample.AccAddress(),
+		Number: uint32(0),
+	}
+
+	shortTermBatch := NewMsgBatchCancelShortTerm(
+		subaccountId,
+		[]OrderBatch{
+			{ClobPairId: 0, ClientIds: []uint32{2, 1}},
+			{ClobPairId: 1, ClientIds: []uint32{0}},
+		},
+		100,
+	)
+	require.True(t, shortTermBatch.IsShortTermBatch())
+	require.Equal(
+		t,
+		[]*MsgCancelOrder{
+			NewMsgCancelOrderShortTerm(OrderId{SubaccountId: subaccountId, ClientId: 2, ClobPairId: 0}, 100),
+			NewMsgCancelOrderShortTerm(OrderId{SubaccountId: subaccountId, ClientId: 1, ClobPairId: 0}, 100),
+			NewMsgCancelOrderShortTerm(OrderId{SubaccountId: subaccountId, ClientId: 0, ClobPairId: 1}, 100),
+		},
+		shortTermBatch.GetCancelOrderMsgs(),
+	)
+
+	statefulBatch := NewMsgBatchCancelStateful(
+		subaccountId,
+		[]OrderBatch{
+			{ClobPairId: 0, OrderFlags: OrderIdFlags_LongTerm, ClientIds: []uint32{0}},
+			{ClobPairId: 0, OrderFlags: OrderIdFlags_Conditional, ClientIds: []uint32{0}},
+		},
+		100,
+	)
+	require.False(t, statefulBatch.IsShortTermBatch())
+	require.Equal(
+		t,
+		[]*MsgCancelOrder{
+			NewMsgCancelOrderStateful(
+				OrderId{SubaccountId: subaccountId, ClientId: 0, OrderFlags: OrderIdFlags_LongTerm},
+				100,
+			),
+			NewMsgCancelOrderStateful(
+				OrderId{SubaccountId: subaccountId, ClientId: 0, OrderFlags: OrderIdFlags_Conditional},
+				100,
+			),
+		},
+		statefulBatch.GetCancelOrderMsgs(),
+	)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+const TypeMsgCancelAllOrders = "cancel_all_orders"
+
+var _ sdk.Msg = &MsgCancelAllOrders{}
+
+func (msg *MsgCancelAllOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// CancelsOrder returns true if the order is of the subaccount and within the clob pair and
+// order flag scope of the message.
+func (msg *MsgCancelAllOrders) CancelsOrder(orderId OrderId) bool {
+	if orderId.SubaccountId != msg.SubaccountId {
+		return false
+	}
+	if len(msg.ClobPairIds) > 0 && !slices.Contains(msg.ClobPairIds, orderId.ClobPairId) {
+		return false
+	}
+	if len(msg.OrderFlags) > 0 && !slices.Contains(msg.OrderFlags, orderId.OrderFlags) {
+		return false
+	}
+	return true
+}
+
+// CancelsShortTermOrders returns true if the message cancels Short-Term orders.
+func (msg *MsgCancelAllOrders) CancelsShortTermOrders() bool {
+	return len(msg.OrderFlags) == 0 || slices.Contains(msg.OrderFlags, OrderIdFlags_ShortTerm)
+}
+
+// CancelsStatefulOrders returns true if the message cancels Long-Term or conditional orders.
+func (msg *MsgCancelAllOrders) CancelsStatefulOrders() bool {
+	return len(msg.OrderFlags) == 0 ||
+		slices.Contains(msg.OrderFlags, OrderIdFlags_LongTerm) ||
+		slices.Contains(msg.OrderFlags, OrderIdFlags_Conditional)
+}
+
+// ValidateBasic performs stateless validation of the message. It returns an error if:
+//   - The subaccount ID is invalid.
+//   - The clob pair IDs or order flags contain duplicates.
+//   - Any of the order flags is invalid.
+//   - The `GoodTilBlock` is zero.
+func (msg *MsgCancelAllOrders) ValidateBasic() error {
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	if lib.ContainsDuplicates(msg.ClobPairIds) {
+		return errorsmod.Wrapf(ErrInvalidCancelAllOrders, "duplicate clob pair ids %v", msg.ClobPairIds)
+	}
+	if lib.ContainsDuplicates(msg.OrderFlags) {
+		return errorsmod.Wrapf(ErrInvalidCancelAllOrders, "duplicate order flags %v", msg.OrderFlags)
+	}
+	for _, orderFlags := range msg.OrderFlags {
+		orderId := OrderId{OrderFlags: orderFlags}
+		if !orderId.IsShortTermOrder() && !orderId.IsStatefulOrder() {
+			return errorsmod.Wrapf(ErrInvalidOrderFlag, "order flags: %d", orderFlags)
+		}
+	}
+
+	if msg.GoodTilBlock == 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidOrderGoodTilBlock,
+			"cancel all orders goodTilBlock cannot be 0, subaccount %+v",
+			msg.SubaccountId,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelAllOrders_ValidateBasic(t *testing.T) {
+	subaccountId := satypes.SubaccountId{
+		Owner:  sample.AccAddress(),
+		Number: uint32(0),
+	}
+
+	tests := map[string]struct {
+		msg MsgCancelAllOrders
+		err error
+	}{
+		"invalid subaccountId owner": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: satypes.SubaccountId{Owner: "invalid_owner"},
+				GoodTilBlock: 100,
+			},
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"duplicate clob pair ids": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: subaccountId,
+				ClobPairIds:  []uint32{0, 1, 0},
+				GoodTilBlock: 100,
+			},
+			err: ErrInvalidCancelAllOrders,
+		},
+		"duplicate order flags": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: subaccountId,
+				OrderFlags:   []uint32{OrderIdFlags_LongTerm, OrderIdFlags_LongTerm},
+				GoodTilBlock: 100,
+			},
+			err: ErrInvalidCancelAllOrders,
+		},
+		"invalid order flags": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: subaccountId,
+				OrderFlags:   []uint32{1},
+				GoodTilBlock: 100,
+			},
+			err: ErrInvalidOrderFlag,
+		},
+		"invalid 0 valued GoodTilBlock": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: subaccountId,
+			},
+			err: ErrInvalidOrderGoodTilBlock,
+		},
+		"valid": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: subaccountId,
+				GoodTilBlock: 100,
+			},
+		},
+		"valid with clob pair ids and order flags": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: subaccountId,
+				ClobPairIds:  []uint32{0, 1},
+				OrderFlags:   []uint32{OrderIdFlags_ShortTerm, OrderIdFlags_Conditional},
+				GoodTilBlock: 100,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelAllOrders_CancelsOrder(t *testing.T) {
+	subaccountId := satypes.SubaccountId{
+		Owner:  sample.AccAddress(),
+		Number: uint32(0),
+	}
+	otherSubaccountId := satypes.SubaccountId{
+		Owner:  subaccountId.Owner,
+		Number: uint32(1),
+	}
+
+	tests := map[string]struct {
+		msg                            MsgCancelAllOrders
+		orderId                        OrderId
+		expectedCancelsOrder           bool
+		expectedCancelsShortTermOrders bool
+		expectedCancelsStatefulOrders  bool
+	}{
+		"cancels all orders of the subaccount": {
+			msg:                            MsgCancelAllOrders{SubaccountId: subaccountId},
+			orderId:                        OrderId{SubaccountId: subaccountId, ClobPairId: 1},
+			expectedCancelsOrder:           true,
+			expectedCancelsShortTermOrders: true,
+			expectedCancelsStatefulOrders:  true,
+		},
+		"does not cancel orders of other subaccounts": {
+			msg:                            MsgCancelAllOrders{SubaccountId: subaccountId},
+			orderId:                        OrderId{SubaccountId: otherSubaccountId},
+			expectedCancelsOrder:           false,
+			expectedCancelsShortTermOrders: true,
+			expectedCancelsStatefulOrders:  true,
+		},
+		"does not cancel orders of other clob pairs": {
+			msg:                            MsgCancelAllOrders{SubaccountId: subaccountId, ClobPairIds: []uint32{0}},
+			orderId:                        OrderId{SubaccountId: subaccountId, ClobPairId: 1},
+			expectedCancelsOrder:           false,
+			expectedCancelsShortTermOrders: true,
+			expectedCancelsStatefulOrders:  true,
+		},
+		"cancels orders with matching order flags": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: subaccountId,
+				OrderFlags:   []uint32{OrderIdFlags_Conditional},
+			},
+			orderId:                        OrderId{SubaccountId: subaccountId, OrderFlags: OrderIdFlags_Conditional},
+			expectedCancelsOrder:           true,
+			expectedCancelsShortTermOrders: false,
+			expectedCancelsStatefulOrders:  true,
+		},
+		"does not cancel orders with other order flags": {
+			msg: MsgCancelAllOrders{
+				SubaccountId: subaccountId,
+				OrderFlags:   []uint32{OrderIdFlags_ShortTerm},
+			},
+			orderId:                        OrderId{SubaccountId: subaccountId, OrderFlags: OrderIdFlags_LongTerm},
+			expectedCancelsOrder:           false,
+			expectedCancelsShortTermOrders: true,
+			expectedCancelsStatefulOrders:  false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedCancelsOrder, tc.msg.CancelsOrder(tc.orderId))
+			require.Equal(t, tc.expectedCancelsShortTermOrders, tc.msg.CancelsShortTermOrders())
+			require.Equal(t, tc.expectedCancelsStatefulOrders, tc.msg.CancelsStatefulOrders())
+		})
+	}
+}
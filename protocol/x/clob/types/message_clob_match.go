@@ -11,6 +11,18 @@ func NewClobMatchFromMatchOrders(
 	}
 }
 
+// NewClobMatchFromMatchAssetLiquidation creates a `ClobMatch` from the provided
+// `MatchAssetLiquidation`.
+func NewClobMatchFromMatchAssetLiquidation(
+	msgMatchAssetLiquidation *MatchAssetLiquidation,
+) *ClobMatch {
+	return &ClobMatch{
+		Match: &ClobMatch_MatchAssetLiquidation{
+			MatchAssetLiquidation: msgMatchAssetLiquidation,
+		},
+	}
+}
+
 // NewClobMatchFromMatchPerpetualLiquidation creates a `ClobMatch` from the provided
 // `MatchPerpetualLiquidation`.
 func NewClobMatchFromMatchPerpetualLiquidation(
@@ -17,5 +17,9 @@ func (msg *MsgCreateClobPair) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidAuthority, "authority cannot be empty")
 	}
 
+	if msg.ClobPair.Status == ClobPair_STATUS_FINAL_SETTLEMENT {
+		return errorsmod.Wrap(ErrInvalidClobPairParameter, "clob pair cannot be created in final settlement")
+	}
+
 	return msg.ClobPair.Validate()
 }
@@ -24,7 +24,7 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 		expectedErr string
 	}{
 		{
-			desc: "Invalid Metadata (SpotClobMetadata)",
+			desc: "Invalid Metadata (SpotClobMetadata with same base and quote asset)",
 			msg: types.MsgCreateClobPair{
 				Authority: lib.GovModuleAddress.String(),
 				ClobPair: types.ClobPair{
@@ -34,7 +34,7 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 					Status:           types.ClobPair_STATUS_ACTIVE,
 				},
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "must have different base and quote assets",
 		},
 		{
 			desc: "Empty authority",
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDelistClobPair_GetSigners(t *testing.T) {
+	msg := types.MsgDelistClobPair{
+		Authority: constants.AliceAccAddress.String(),
+	}
+	require.Equal(t, []sdk.AccAddress{constants.AliceAccAddress}, msg.GetSigners())
+}
+
+func TestMsgDelistClobPair_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		desc        string
+		msg         types.MsgDelistClobPair
+		expectedErr string
+	}{
+		{
+			desc: "Invalid authority",
+			msg: types.MsgDelistClobPair{
+				Authority:  "",
+				ClobPairId: 0,
+			},
+			expectedErr: "Authority is invalid",
+		},
+		{
+			desc: "Valid message settling at the oracle price",
+			msg: types.MsgDelistClobPair{
+				Authority:  validAuthority,
+				ClobPairId: 0,
+			},
+		},
+		{
+			desc: "Valid message settling at a given price",
+			msg: types.MsgDelistClobPair{
+				Authority:       validAuthority,
+				ClobPairId:      1,
+				SettlementPrice: 5_000_000_000,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
 
@@ -55,8 +56,15 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 
 	orderId := msg.Order.GetOrderId()
 	if orderId.IsShortTermOrder() {
-		// This also implicitly verifies that GoodTilBlockTime is not set / is zero for short-term orders.
-		if msg.Order.GetGoodTilBlock() == uint32(0) {
+		// Short-Term orders expire by either GoodTilBlock or GoodTilBlockTime, which must be non-zero.
+		if msg.Order.IsGoodTilBlockTimeShortTermOrder() {
+			if msg.Order.GetGoodTilBlockTime() == uint32(0) {
+				return errorsmod.Wrapf(
+					ErrInvalidShortTermOrderGoodTilBlockTime,
+					"short-term order goodTilBlockTime cannot be 0",
+				)
+			}
+		} else if msg.Order.GetGoodTilBlock() == uint32(0) {
 			return errorsmod.Wrapf(ErrInvalidOrderGoodTilBlock, "order goodTilBlock cannot be 0")
 		}
 	} else if orderId.IsStatefulOrder() {
@@ -103,5 +111,34 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		}
 	}
 
+	if msg.Order.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP {
+		if (msg.Order.ConditionalOrderTrailingPpm == 0) == (msg.Order.ConditionalOrderTrailingSubticks == 0) {
+			return errorsmod.Wrapf(
+				ErrInvalidConditionalOrderTrailingDistance,
+				"exactly one of trailing ppm and trailing subticks must be set for trailing stop orders",
+			)
+		}
+
+		if msg.Order.ConditionalOrderTrailingPpm >= lib.OneMillion {
+			return errorsmod.Wrapf(
+				ErrInvalidConditionalOrderTrailingDistance,
+				"trailing ppm %d must be less than %d",
+				msg.Order.ConditionalOrderTrailingPpm,
+				lib.OneMillion,
+			)
+		}
+	} else if msg.Order.ConditionalOrderTrailingPpm != 0 || msg.Order.ConditionalOrderTrailingSubticks != 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidConditionalOrderTrailingDistance,
+			"trailing distance specified for non-trailing stop order",
+		)
+	}
+
+	if msg.Order.OrderGroup != nil {
+		if err := msg.Order.OrderGroup.Validate(msg.Order); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
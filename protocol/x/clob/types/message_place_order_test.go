@@ -95,6 +95,40 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidOrderGoodTilBlock,
 		},
+		"short-term: zero GoodTilBlockTime": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+					Side:         Order_SIDE_BUY,
+					Quantums:     uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{},
+				},
+			},
+			err: ErrInvalidShortTermOrderGoodTilBlockTime,
+		},
+		"short-term: valid GoodTilBlockTime": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+					Side:     Order_SIDE_BUY,
+					Quantums: uint64(42),
+					Subticks: uint64(10),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+				},
+			},
+		},
 		"order flag: invalid order flag": {
 			msg: MsgPlaceOrder{
 				Order: Order{
@@ -314,6 +348,142 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidConditionalOrderTriggerSubticks,
 		},
+		"conditional: valid trailing stop with trailing ppm": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					ConditionalOrderTrailingPpm:     uint32(10_000),
+				},
+			},
+		},
+		"conditional: valid trailing stop with trailing subticks": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                    Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks:  uint64(10),
+					ConditionalOrderTrailingSubticks: uint64(5),
+				},
+			},
+		},
+		"conditional: trailing stop without trailing distance": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+				},
+			},
+			err: ErrInvalidConditionalOrderTrailingDistance,
+		},
+		"conditional: trailing stop with both trailing ppm and subticks": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                    Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks:  uint64(10),
+					ConditionalOrderTrailingPpm:      uint32(10_000),
+					ConditionalOrderTrailingSubticks: uint64(5),
+				},
+			},
+			err: ErrInvalidConditionalOrderTrailingDistance,
+		},
+		"conditional: trailing stop with trailing ppm of one million": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					ConditionalOrderTrailingPpm:     uint32(1_000_000),
+				},
+			},
+			err: ErrInvalidConditionalOrderTrailingDistance,
+		},
+		"conditional: trailing distance for stop loss order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                    Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks:  uint64(10),
+					ConditionalOrderTrailingSubticks: uint64(5),
+				},
+			},
+			err: ErrInvalidConditionalOrderTrailingDistance,
+		},
 		"non-conditional: specified condition type": {
 			msg: MsgPlaceOrder{
 				Order: Order{
@@ -7,6 +7,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 const TypeMsgProposedOperations = "proposed_operations"
@@ -78,7 +80,8 @@ func ValidateAndTransformRawOperations(
 	operations := make([]InternalOperation, 0, len(rawOperations))
 
 	validator := operationsQueueValidator{
-		ordersPlacedInBlock: make(map[OrderId]Order, 0),
+		ordersPlacedInBlock:         make(map[OrderId]Order, 0),
+		shortTermOrderHashesInBlock: make(map[OrderHash]bool, 0),
 	}
 
 	// Go through the operations one by one to validate them, updating state as necessary.
@@ -104,9 +107,14 @@ func ValidateAndTransformRawOperations(
 			if err != nil {
 				return nil, err
 			}
-			if err = validator.validateShortTermOrderPlacementOperation(
-				operation.GetShortTermOrderPlacement(),
-			); err != nil {
+			if orderReplacement := operation.GetShortTermOrderReplacement(); orderReplacement != nil {
+				err = validator.validateShortTermOrderReplacementOperation(orderReplacement)
+			} else {
+				err = validator.validateShortTermOrderPlacementOperation(
+					operation.GetShortTermOrderPlacement(),
+				)
+			}
+			if err != nil {
 				return nil, err
 			}
 		case *OperationRaw_OrderRemoval:
@@ -144,6 +152,9 @@ type operationsQueueValidator struct {
 	// ordersPlacedInBlock stores the most recently placed order.
 	// It tracks orders placed via `OrderPlacement` operations.
 	ordersPlacedInBlock map[OrderId]Order
+	// All the Short-Term order hashes placed or replaced in this block.
+	// This field is used to ensure an order which was atomically replaced cannot be placed again.
+	shortTermOrderHashesInBlock map[OrderHash]bool
 }
 
 // validateMatchOperation unwraps the match message and performs validation for each match type.
@@ -159,6 +170,11 @@ func (validator *operationsQueueValidator) validateMatchOperation(match *ClobMat
 		if err := validator.validateMatchPerpetualLiquidationOperation(matchPerpetualLiquidation); err != nil {
 			return err
 		}
+	case *ClobMatch_MatchAssetLiquidation:
+		matchAssetLiquidation := match.GetMatchAssetLiquidation()
+		if err := validator.validateMatchAssetLiquidationOperation(matchAssetLiquidation); err != nil {
+			return err
+		}
 	case *ClobMatch_MatchPerpetualDeleveraging:
 		matchPerpetualDeleveraging := match.GetMatchPerpetualDeleveraging()
 		if err := matchPerpetualDeleveraging.Validate(); err != nil {
@@ -168,6 +184,15 @@ func (validator *operationsQueueValidator) validateMatchOperation(match *ClobMat
 				matchPerpetualDeleveraging,
 			)
 		}
+	case *ClobMatch_MatchAssetDeleveraging:
+		matchAssetDeleveraging := match.GetMatchAssetDeleveraging()
+		if err := matchAssetDeleveraging.Validate(); err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"match: %+v",
+				matchAssetDeleveraging,
+			)
+		}
 	default:
 		panic("Unsupported Clob Match type")
 	}
@@ -182,6 +207,8 @@ func (validator *operationsQueueValidator) validateMatchOperation(match *ClobMat
 //
 //   - ValidateBasic for OrderPlacement message
 //   - Orders placed in the same block with same OrderId must not be the same.
+//   - Orders placed or replaced in the same block must not be placed again.
+//   - Orders placed in the same block with same OrderId must have the same expiration type.
 func (validator *operationsQueueValidator) validateShortTermOrderPlacementOperation(
 	orderPlacement *MsgPlaceOrder,
 ) error {
@@ -193,8 +220,27 @@ func (validator *operationsQueueValidator) validateShortTermOrderPlacementOperat
 	order := orderPlacement.GetOrder()
 	orderId := order.GetOrderId()
 
+	// Orders which were atomically replaced may have a higher priority than their replacement order,
+	// so verify the order was not previously placed in this block.
+	if validator.shortTermOrderHashesInBlock[order.GetOrderHash()] {
+		return errorsmod.Wrapf(
+			ErrInvalidPlaceOrder,
+			"Duplicate Order %s",
+			order.GetOrderTextString(),
+		)
+	}
+
 	// For orders with the same orderId placed within this block, verify replacement order priority.
 	if prevOrder, placedPreviously := validator.ordersPlacedInBlock[orderId]; placedPreviously {
+		// Orders cannot switch between block-height and block-time expiration.
+		if !prevOrder.HasSameExpirationType(&order) {
+			return errorsmod.Wrapf(
+				ErrInvalidReplacement,
+				"Replacement order has a different expiration type. order: %s, prevOrder: %s",
+				order.GetOrderTextString(),
+				prevOrder.GetOrderTextString(),
+			)
+		}
 		// No duplicate order placements allowed.
 		if prevOrder.MustCmpReplacementOrder(&order) == 0 {
 			return errorsmod.Wrapf(
@@ -216,6 +262,54 @@ func (validator *operationsQueueValidator) validateShortTermOrderPlacementOperat
 
 	// Record the placed order in validator.
 	validator.ordersPlacedInBlock[orderId] = order
+	validator.shortTermOrderHashesInBlock[order.GetOrderHash()] = true
+
+	return nil
+}
+
+// validateShortTermOrderReplacementOperation performs stateless validation on an order replacement.
+// It also populates the validator object with the replacement order.
+// This validation does not perform any state reads, or memclob reads.
+//
+// The following validation occurs in this method:
+//
+//   - ValidateBasic for OrderReplacement message
+//   - An order with the same OrderId must have been placed in the same block, and the replacement
+//     order must be a valid replacement of it.
+//   - Orders placed or replaced in the same block must not be placed again.
+func (validator *operationsQueueValidator) validateShortTermOrderReplacementOperation(
+	orderReplacement *MsgReplaceOrder,
+) error {
+	// Order replacement msg has to pass its own validation.
+	if err := orderReplacement.ValidateBasic(); err != nil {
+		return err
+	}
+
+	order := orderReplacement.GetOrder()
+	orderId := order.GetOrderId()
+
+	prevOrder, placedPreviously := validator.ordersPlacedInBlock[orderId]
+	if !placedPreviously {
+		return errorsmod.Wrapf(
+			ErrReplacedOrderDoesNotExist,
+			"Replaced order was not placed in this block. order: %s",
+			order.GetOrderTextString(),
+		)
+	}
+	if err := orderReplacement.ValidateReplacementOf(prevOrder); err != nil {
+		return err
+	}
+	if validator.shortTermOrderHashesInBlock[order.GetOrderHash()] {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"Duplicate Order %s",
+			order.GetOrderTextString(),
+		)
+	}
+
+	// Record the replacement order in validator.
+	validator.ordersPlacedInBlock[orderId] = order
+	validator.shortTermOrderHashesInBlock[order.GetOrderHash()] = true
 
 	return nil
 }
@@ -298,7 +392,56 @@ func (validator *operationsQueueValidator) validateMatchOrdersOperation(
 func (validator *operationsQueueValidator) validateMatchPerpetualLiquidationOperation(
 	liquidationMatch *MatchPerpetualLiquidation,
 ) error {
-	fills := liquidationMatch.GetFills()
+	return validator.validateLiquidationMatch(
+		liquidationMatch,
+		liquidationMatch.Liquidated,
+		liquidationMatch.GetTotalSize(),
+		liquidationMatch.GetFills(),
+	)
+}
+
+// validateMatchAssetLiquidationOperation performs stateless validation on an asset liquidation match.
+// This validation does not perform any state reads, or memclob reads.
+//
+// The following validation occurs in this method:
+//   - The liquidated asset is not the quote asset.
+//   - The liquidation order is a buy, since only borrowed asset positions are liquidated.
+//   - All conditions checked by `validateMatchPerpetualLiquidationOperation`.
+func (validator *operationsQueueValidator) validateMatchAssetLiquidationOperation(
+	liquidationMatch *MatchAssetLiquidation,
+) error {
+	if liquidationMatch.GetAssetId() == assettypes.AssetUsdc.Id {
+		return errorsmod.Wrapf(
+			ErrLiquidatingQuoteAsset,
+			"match: %+v",
+			liquidationMatch,
+		)
+	}
+
+	if !liquidationMatch.GetIsBuy() {
+		return errorsmod.Wrapf(
+			ErrInvalidLiquidationOrderSide,
+			"Asset liquidation match must be a buy. match: %+v",
+			liquidationMatch,
+		)
+	}
+
+	return validator.validateLiquidationMatch(
+		liquidationMatch,
+		liquidationMatch.Liquidated,
+		liquidationMatch.GetTotalSize(),
+		liquidationMatch.GetFills(),
+	)
+}
+
+// validateLiquidationMatch performs the stateless validation shared by perpetual and asset
+// liquidation matches.
+func (validator *operationsQueueValidator) validateLiquidationMatch(
+	liquidationMatch fmt.Stringer,
+	liquidated satypes.SubaccountId,
+	totalSize uint64,
+	fills []MakerFill,
+) error {
 	if len(fills) == 0 {
 		return errorsmod.Wrapf(
 			ErrInvalidMatchOrder,
@@ -308,7 +451,6 @@ func (validator *operationsQueueValidator) validateMatchPerpetualLiquidationOper
 	}
 
 	// Make sure the total size greater than zero.
-	totalSize := liquidationMatch.GetTotalSize()
 	if totalSize == 0 {
 		return errorsmod.Wrapf(
 			ErrInvalidLiquidationOrderTotalSize,
@@ -343,7 +485,7 @@ func (validator *operationsQueueValidator) validateMatchPerpetualLiquidationOper
 		}
 	}
 
-	if err := liquidationMatch.Liquidated.Validate(); err != nil {
+	if err := liquidated.Validate(); err != nil {
 		return err
 	}
 
@@ -8,6 +8,7 @@ import (
 	clobtestutils "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	testtx "github.com/dydxprotocol/v4-chain/protocol/testutil/tx"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/stretchr/testify/require"
@@ -241,6 +242,40 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 			},
 			expectedError: errors.New("Replacement order is not higher priority"),
 		},
+		"Stateless place order validation: replacement order has different expiration type": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15),
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
+			},
+			expectedError: errors.New("Replacement order has a different expiration type"),
+		},
+		"Stateless replace order validation: replacement of order placed in block": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20),
+				newShortTermOrderReplacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB21),
+			},
+		},
+		"Stateless replace order validation: replaced order not placed in block": {
+			operations: []types.OperationRaw{
+				newShortTermOrderReplacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB21),
+			},
+			expectedError: errors.New("Replaced order was not placed in this block"),
+		},
+		"Stateless replace order validation: replacement order decreases GoodTilBlock": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20),
+				newShortTermOrderReplacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15),
+			},
+			expectedError: errors.New("must be greater than existing order GoodTilBlock"),
+		},
+		"Stateless replace order validation: replaced order placed again": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20),
+				newShortTermOrderReplacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB21),
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20),
+			},
+			expectedError: errors.New("Duplicate Order"),
+		},
 		"Stateless place order validation: placeOrder has invalid side": {
 			operations: []types.OperationRaw{
 				clobtestutils.NewShortTermOrderPlacementOperationRaw(types.Order{
@@ -463,6 +498,65 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 			expectedError: types.ErrInvalidMatchOrder,
 		},
 
+		// Tests for Asset Liquidations
+		"Stateless asset liquidation validation: fails when liquidating the quote asset": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000),
+				clobtestutils.NewMatchOperationRawFromAssetLiquidation(types.MatchAssetLiquidation{
+					Liquidated: constants.Carl_Num0,
+					ClobPairId: 0,
+					AssetId:    assettypes.AssetUsdc.Id,
+					TotalSize:  100,
+					IsBuy:      true,
+					Fills: []types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000.GetOrderId(),
+							FillAmount:   100,
+						},
+					},
+				}),
+			},
+			expectedError: types.ErrLiquidatingQuoteAsset,
+		},
+		"Stateless asset liquidation validation: fails when the liquidation is a sell": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000),
+				clobtestutils.NewMatchOperationRawFromAssetLiquidation(types.MatchAssetLiquidation{
+					Liquidated: constants.Carl_Num0,
+					ClobPairId: 0,
+					AssetId:    constants.BtcUsd.Id,
+					TotalSize:  100,
+					IsBuy:      false,
+					Fills: []types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000.GetOrderId(),
+							FillAmount:   100,
+						},
+					},
+				}),
+			},
+			expectedError: types.ErrInvalidLiquidationOrderSide,
+		},
+		"Stateless asset liquidation validation: fails when total fill amount exceeds order size": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000),
+				clobtestutils.NewMatchOperationRawFromAssetLiquidation(types.MatchAssetLiquidation{
+					Liquidated: constants.Carl_Num0,
+					ClobPairId: 0,
+					AssetId:    constants.BtcUsd.Id,
+					TotalSize:  100,
+					IsBuy:      true,
+					Fills: []types.MakerFill{
+						{
+							MakerOrderId: constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000.GetOrderId(),
+							FillAmount:   200,
+						},
+					},
+				}),
+			},
+			expectedError: types.ErrTotalFillAmountExceedsOrderSize,
+		},
+
 		// Tests for Match Perpetual Deleveraging
 		"Stateless match perpetual deleveraging validation: forwards errors from validate": {
 			operations: []types.OperationRaw{
@@ -511,7 +605,7 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 					},
 				},
 			},
-			expectedError: errors.New("expected MsgPlaceOrder, got *types.MsgCancelOrder"),
+			expectedError: errors.New("expected MsgPlaceOrder or MsgReplaceOrder, got *types.MsgCancelOrder"),
 		},
 	}
 	for name, tc := range tests {
@@ -532,6 +626,14 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 	}
 }
 
+func newShortTermOrderReplacementOperationRaw(order types.Order) types.OperationRaw {
+	return types.OperationRaw{
+		Operation: &types.OperationRaw_ShortTermOrderPlacement{
+			ShortTermOrderPlacement: testtx.MustGetTxBytes(types.NewMsgReplaceOrder(order)),
+		},
+	}
+}
+
 func TestGetSigners(t *testing.T) {
 	msg := types.MsgProposedOperations{}
 	require.Empty(t, msg.GetSigners())
@@ -0,0 +1,121 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgReplaceOrder = "replace_order"
+
+var _ sdk.Msg = &MsgReplaceOrder{}
+
+func NewMsgReplaceOrder(order Order) *MsgReplaceOrder {
+	return &MsgReplaceOrder{
+		Order: order,
+	}
+}
+
+func (msg *MsgReplaceOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Order.OrderId.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// ValidateBasic performs stateless validation of the message. The replacement order must pass
+// the same validation as an order placed with `MsgPlaceOrder`, and additionally it returns an error if:
+//   - The replacement order is not a Short-Term order.
+//   - The replacement order is an IOC or FOK order.
+func (msg *MsgReplaceOrder) ValidateBasic() error {
+	if err := NewMsgPlaceOrder(msg.Order).ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !msg.Order.IsShortTermOrder() {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"only Short-Term orders can be replaced, got order flags %d",
+			msg.Order.OrderId.OrderFlags,
+		)
+	}
+
+	if msg.Order.RequiresImmediateExecution() {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"replacement order cannot be an IOC or FOK order, got time in force %s",
+			msg.Order.TimeInForce,
+		)
+	}
+
+	return nil
+}
+
+// ValidateReplacementOf returns an error if the order of the message is not a valid replacement of
+// `existingOrder`. The replacement order must have the same `OrderId` and expiration type as the existing
+// order and a strictly greater `GoodTilBlock` or `GoodTilBlockTime`, and may otherwise only differ from it
+// in size and price.
+//
+// Note that requiring a strictly greater expiration ensures that a replacement can never be replayed
+// after a later replacement of the same order, since each replacement supersedes all previous ones.
+func (msg *MsgReplaceOrder) ValidateReplacementOf(existingOrder Order) error {
+	replacementOrder := msg.Order
+	if replacementOrder.OrderId != existingOrder.OrderId {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"replacement order id %+v does not match existing order id %+v",
+			replacementOrder.OrderId,
+			existingOrder.OrderId,
+		)
+	}
+
+	if !replacementOrder.HasSameExpirationType(&existingOrder) {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"replacement order %s must have the same expiration type as existing order %s",
+			replacementOrder.GetOrderTextString(),
+			existingOrder.GetOrderTextString(),
+		)
+	}
+
+	if replacementOrder.IsGoodTilBlockTimeShortTermOrder() {
+		if replacementOrder.GetGoodTilBlockTime() <= existingOrder.GetGoodTilBlockTime() {
+			return errorsmod.Wrapf(
+				ErrInvalidReplaceOrder,
+				"replacement order GoodTilBlockTime %d must be greater than existing order GoodTilBlockTime %d",
+				replacementOrder.GetGoodTilBlockTime(),
+				existingOrder.GetGoodTilBlockTime(),
+			)
+		}
+	} else if replacementOrder.GetGoodTilBlock() <= existingOrder.GetGoodTilBlock() {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"replacement order GoodTilBlock %d must be greater than existing order GoodTilBlock %d",
+			replacementOrder.GetGoodTilBlock(),
+			existingOrder.GetGoodTilBlock(),
+		)
+	}
+
+	// Only the size, price and expiry of the order can be changed.
+	replacementOrder.Quantums = existingOrder.Quantums
+	replacementOrder.Subticks = existingOrder.Subticks
+	replacementOrder.GoodTilOneof = existingOrder.GoodTilOneof
+	if replacementOrder.GetOrderHash() != existingOrder.GetOrderHash() {
+		return errorsmod.Wrapf(
+			ErrInvalidReplaceOrder,
+			"replacement order %s may only change the size, price and expiry of existing order %s",
+			msg.Order.GetOrderTextString(),
+			existingOrder.GetOrderTextString(),
+		)
+	}
+
+	return nil
+}
+
+// IsSizeOnlyDecrease returns true if the order of the message only decreases the size of
+// `existingOrder`. Replacements that only decrease the size of an order keep its time priority.
+// This function assumes the order of the message is a valid replacement of `existingOrder`.
+func (msg *MsgReplaceOrder) IsSizeOnlyDecrease(existingOrder Order) bool {
+	return msg.Order.Quantums < existingOrder.Quantums &&
+		msg.Order.Subticks == existingOrder.Subticks
+}
@@ -0,0 +1,244 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newReplaceOrderTestOrder(owner string, quantums uint64, subticks uint64, goodTilBlock uint32) Order {
+	return Order{
+		OrderId: OrderId{
+			SubaccountId: satypes.SubaccountId{
+				Owner:  owner,
+				Number: uint32(0),
+			},
+			ClientId: 1,
+		},
+		Side:         Order_SIDE_BUY,
+		Quantums:     quantums,
+		Subticks:     subticks,
+		GoodTilOneof: &Order_GoodTilBlock{GoodTilBlock: goodTilBlock},
+	}
+}
+
+func TestMsgReplaceOrder_ValidateBasic(t *testing.T) {
+	owner := sample.AccAddress()
+
+	statefulOrder := newReplaceOrderTestOrder(owner, 10, 10, 0)
+	statefulOrder.OrderId.OrderFlags = OrderIdFlags_LongTerm
+	statefulOrder.GoodTilOneof = &Order_GoodTilBlockTime{GoodTilBlockTime: 100}
+
+	iocOrder := newReplaceOrderTestOrder(owner, 10, 10, 100)
+	iocOrder.TimeInForce = Order_TIME_IN_FORCE_IOC
+
+	fokOrder := newReplaceOrderTestOrder(owner, 10, 10, 100)
+	fokOrder.TimeInForce = Order_TIME_IN_FORCE_FILL_OR_KILL
+
+	postOnlyOrder := newReplaceOrderTestOrder(owner, 10, 10, 100)
+	postOnlyOrder.TimeInForce = Order_TIME_IN_FORCE_POST_ONLY
+
+	tests := map[string]struct {
+		msg MsgReplaceOrder
+		err error
+	}{
+		"invalid subaccountId owner": {
+			msg: *NewMsgReplaceOrder(newReplaceOrderTestOrder("invalid_owner", 10, 10, 100)),
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"invalid order quantums": {
+			msg: *NewMsgReplaceOrder(newReplaceOrderTestOrder(owner, 0, 10, 100)),
+			err: ErrInvalidOrderQuantums,
+		},
+		"stateful order": {
+			msg: *NewMsgReplaceOrder(statefulOrder),
+			err: ErrInvalidReplaceOrder,
+		},
+		"IOC order": {
+			msg: *NewMsgReplaceOrder(iocOrder),
+			err: ErrInvalidReplaceOrder,
+		},
+		"FOK order": {
+			msg: *NewMsgReplaceOrder(fokOrder),
+			err: ErrInvalidReplaceOrder,
+		},
+		"valid post-only order": {
+			msg: *NewMsgReplaceOrder(postOnlyOrder),
+		},
+		"valid": {
+			msg: *NewMsgReplaceOrder(newReplaceOrderTestOrder(owner, 10, 10, 100)),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgReplaceOrder_ValidateReplacementOf(t *testing.T) {
+	owner := sample.AccAddress()
+	existingOrder := newReplaceOrderTestOrder(owner, 10, 10, 100)
+
+	differentClientId := newReplaceOrderTestOrder(owner, 5, 10, 101)
+	differentClientId.OrderId.ClientId = 2
+
+	differentSide := newReplaceOrderTestOrder(owner, 10, 10, 101)
+	differentSide.Side = Order_SIDE_SELL
+
+	differentTimeInForce := newReplaceOrderTestOrder(owner, 10, 10, 101)
+	differentTimeInForce.TimeInForce = Order_TIME_IN_FORCE_POST_ONLY
+
+	differentReduceOnly := newReplaceOrderTestOrder(owner, 10, 10, 101)
+	differentReduceOnly.ReduceOnly = true
+
+	differentExpirationType := newReplaceOrderTestOrder(owner, 5, 10, 0)
+	differentExpirationType.GoodTilOneof = &Order_GoodTilBlockTime{GoodTilBlockTime: 101}
+
+	tests := map[string]struct {
+		replacementOrder Order
+		err              error
+	}{
+		"different order id": {
+			replacementOrder: differentClientId,
+			err:              ErrInvalidReplaceOrder,
+		},
+		"lower GoodTilBlock": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 5, 10, 99),
+			err:              ErrInvalidReplaceOrder,
+		},
+		"same GoodTilBlock": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 5, 10, 100),
+			err:              ErrInvalidReplaceOrder,
+		},
+		"identical order": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 10, 10, 100),
+			err:              ErrInvalidReplaceOrder,
+		},
+		"different side": {
+			replacementOrder: differentSide,
+			err:              ErrInvalidReplaceOrder,
+		},
+		"different time in force": {
+			replacementOrder: differentTimeInForce,
+			err:              ErrInvalidReplaceOrder,
+		},
+		"different reduce-only": {
+			replacementOrder: differentReduceOnly,
+			err:              ErrInvalidReplaceOrder,
+		},
+		"different expiration type": {
+			replacementOrder: differentExpirationType,
+			err:              ErrInvalidReplaceOrder,
+		},
+		"higher GoodTilBlock": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 10, 10, 101),
+		},
+		"decreases size": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 5, 10, 101),
+		},
+		"increases size": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 15, 10, 101),
+		},
+		"changes price": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 10, 20, 101),
+		},
+		"changes size, price and GoodTilBlock": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 5, 20, 105),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewMsgReplaceOrder(tc.replacementOrder).ValidateReplacementOf(existingOrder)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgReplaceOrder_ValidateReplacementOf_GoodTilBlockTime(t *testing.T) {
+	owner := sample.AccAddress()
+	newGoodTilBlockTimeOrder := func(quantums uint64, goodTilBlockTime uint32) Order {
+		order := newReplaceOrderTestOrder(owner, quantums, 10, 0)
+		order.GoodTilOneof = &Order_GoodTilBlockTime{GoodTilBlockTime: goodTilBlockTime}
+		return order
+	}
+	existingOrder := newGoodTilBlockTimeOrder(10, 100)
+
+	tests := map[string]struct {
+		replacementOrder Order
+		err              error
+	}{
+		"same GoodTilBlockTime": {
+			replacementOrder: newGoodTilBlockTimeOrder(5, 100),
+			err:              ErrInvalidReplaceOrder,
+		},
+		"lower GoodTilBlockTime": {
+			replacementOrder: newGoodTilBlockTimeOrder(5, 99),
+			err:              ErrInvalidReplaceOrder,
+		},
+		"different expiration type": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 5, 10, 101),
+			err:              ErrInvalidReplaceOrder,
+		},
+		"decreases size": {
+			replacementOrder: newGoodTilBlockTimeOrder(5, 101),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewMsgReplaceOrder(tc.replacementOrder).ValidateReplacementOf(existingOrder)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgReplaceOrder_IsSizeOnlyDecrease(t *testing.T) {
+	owner := sample.AccAddress()
+	existingOrder := newReplaceOrderTestOrder(owner, 10, 10, 100)
+
+	tests := map[string]struct {
+		replacementOrder Order
+		expected         bool
+	}{
+		"decreases size": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 5, 10, 101),
+			expected:         true,
+		},
+		"increases size": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 15, 10, 101),
+			expected:         false,
+		},
+		"decreases size and changes price": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 5, 20, 101),
+			expected:         false,
+		},
+		"changes price": {
+			replacementOrder: newReplaceOrderTestOrder(owner, 10, 20, 101),
+			expected:         false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expected,
+				NewMsgReplaceOrder(tc.replacementOrder).IsSizeOnlyDecrease(existingOrder),
+			)
+		})
+	}
+}
@@ -29,7 +29,7 @@ func TestMsgUpdateClobPair_ValidateBasic(t *testing.T) {
 		expectedErr string
 	}{
 		{
-			desc:      "Invalid Metadata (SpotClobMetadata)",
+			desc:      "Invalid Metadata (SpotClobMetadata with same base and quote asset)",
 			authority: validAuthority,
 			clobPair: types.ClobPair{
 				Metadata:         &types.ClobPair_SpotClobMetadata{},
@@ -37,7 +37,7 @@ func TestMsgUpdateClobPair_ValidateBasic(t *testing.T) {
 				SubticksPerTick:  1,
 				Status:           types.ClobPair_STATUS_ACTIVE,
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "must have different base and quote assets",
 		},
 		{
 			desc:      "UNSPECIFIED Status",
@@ -10,7 +10,8 @@ import (
 // decodeOperationRawShortTermOrderPlacementBytes performs stateless validation
 // on a short term order placement given its underlying raw tx bytes. It also
 // runs the transaction through an antehandler. The antehandler is needed to
-// do signature validation. Returns an Operation if successful.
+// do signature validation. Returns an Operation if successful. Note that the
+// underlying transaction is either a `MsgPlaceOrder` or a `MsgReplaceOrder`.
 func decodeOperationRawShortTermOrderPlacementBytes(
 	ctx sdk.Context,
 	bytes []byte,
@@ -31,16 +32,54 @@ func decodeOperationRawShortTermOrderPlacementBytes(
 		return nil, fmt.Errorf("expected 1 msg, got %d", len(msgs))
 	}
 
-	msg, ok := msgs[0].(*MsgPlaceOrder)
-	if !ok {
-		return nil, fmt.Errorf("expected MsgPlaceOrder, got %T", msgs[0])
+	switch msg := msgs[0].(type) {
+	case *MsgPlaceOrder:
+		return &InternalOperation{
+			Operation: &InternalOperation_ShortTermOrderPlacement{
+				ShortTermOrderPlacement: msg,
+			},
+		}, nil
+	case *MsgReplaceOrder:
+		return &InternalOperation{
+			Operation: &InternalOperation_ShortTermOrderReplacement{
+				ShortTermOrderReplacement: msg,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("expected MsgPlaceOrder or MsgReplaceOrder, got %T", msgs[0])
 	}
+}
 
-	return &InternalOperation{
-		Operation: &InternalOperation_ShortTermOrderPlacement{
-			ShortTermOrderPlacement: msg,
-		},
-	}, nil
+// MustGetShortTermOrderFromPlacementMsg returns the Short-Term order of a message included in a
+// `ShortTermOrderPlacement` raw operation. This function will panic if the message is not a
+// `MsgPlaceOrder` or a `MsgReplaceOrder`.
+func MustGetShortTermOrderFromPlacementMsg(msg sdk.Msg) Order {
+	switch msg := msg.(type) {
+	case *MsgPlaceOrder:
+		return msg.Order
+	case *MsgReplaceOrder:
+		return msg.Order
+	default:
+		panic(
+			fmt.Sprintf(
+				"MustGetShortTermOrderFromPlacementMsg: expected MsgPlaceOrder or MsgReplaceOrder, got %T",
+				msg,
+			),
+		)
+	}
+}
+
+// GetShortTermOrder returns the Short-Term order of this operation if it is a Short-Term order
+// placement or a Short-Term order replacement, and a boolean indicating whether it is either.
+func (o *InternalOperation) GetShortTermOrder() (order Order, ok bool) {
+	switch operation := o.Operation.(type) {
+	case *InternalOperation_ShortTermOrderPlacement:
+		return operation.ShortTermOrderPlacement.Order, true
+	case *InternalOperation_ShortTermOrderReplacement:
+		return operation.ShortTermOrderReplacement.Order, true
+	default:
+		return Order{}, false
+	}
 }
 
 // GetInternalOperationTextString returns the text string representation of this operation.
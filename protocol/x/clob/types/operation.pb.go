@@ -30,6 +30,7 @@ type Operation struct {
 	// of a pre-existing stateful order.
 	//
 	// Types that are valid to be assigned to Operation:
+	//
 	//	*Operation_Match
 	//	*Operation_ShortTermOrderPlacement
 	//	*Operation_ShortTermOrderCancellation
@@ -143,8 +144,8 @@ func (*Operation) XXX_OneofWrappers() []interface{} {
 // propose. InternalOperation is used internally within the memclob only.
 type InternalOperation struct {
 	// operation represents the operation that occurred, which can be a match,
-	// Short-Term order placement, or the placement of a pre-existing stateful
-	// order.
+	// Short-Term order placement, Short-Term order replacement, or the placement
+	// of a pre-existing stateful order.
 	//
 	// Types that are valid to be assigned to Operation:
 	//
@@ -152,6 +153,7 @@ type InternalOperation struct {
 	//	*InternalOperation_ShortTermOrderPlacement
 	//	*InternalOperation_PreexistingStatefulOrder
 	//	*InternalOperation_OrderRemoval
+	//	*InternalOperation_ShortTermOrderReplacement
 	Operation isInternalOperation_Operation `protobuf_oneof:"operation"`
 }
 
@@ -206,11 +208,15 @@ type InternalOperation_PreexistingStatefulOrder struct {
 type InternalOperation_OrderRemoval struct {
 	OrderRemoval *OrderRemoval `protobuf:"bytes,4,opt,name=order_removal,json=orderRemoval,proto3,oneof" json:"order_removal,omitempty"`
 }
+type InternalOperation_ShortTermOrderReplacement struct {
+	ShortTermOrderReplacement *MsgReplaceOrder `protobuf:"bytes,5,opt,name=short_term_order_replacement,json=shortTermOrderReplacement,proto3,oneof" json:"short_term_order_replacement,omitempty"`
+}
 
-func (*InternalOperation_Match) isInternalOperation_Operation()                    {}
-func (*InternalOperation_ShortTermOrderPlacement) isInternalOperation_Operation()  {}
-func (*InternalOperation_PreexistingStatefulOrder) isInternalOperation_Operation() {}
-func (*InternalOperation_OrderRemoval) isInternalOperation_Operation()             {}
+func (*InternalOperation_Match) isInternalOperation_Operation()                     {}
+func (*InternalOperation_ShortTermOrderPlacement) isInternalOperation_Operation()   {}
+func (*InternalOperation_PreexistingStatefulOrder) isInternalOperation_Operation()  {}
+func (*InternalOperation_OrderRemoval) isInternalOperation_Operation()              {}
+func (*InternalOperation_ShortTermOrderReplacement) isInternalOperation_Operation() {}
 
 func (m *InternalOperation) GetOperation() isInternalOperation_Operation {
 	if m != nil {
@@ -247,6 +253,13 @@ func (m *InternalOperation) GetOrderRemoval() *OrderRemoval {
 	return nil
 }
 
+func (m *InternalOperation) GetShortTermOrderReplacement() *MsgReplaceOrder {
+	if x, ok := m.GetOperation().(*InternalOperation_ShortTermOrderReplacement); ok {
+		return x.ShortTermOrderReplacement
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*InternalOperation) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -254,6 +267,7 @@ func (*InternalOperation) XXX_OneofWrappers() []interface{} {
 		(*InternalOperation_ShortTermOrderPlacement)(nil),
 		(*InternalOperation_PreexistingStatefulOrder)(nil),
 		(*InternalOperation_OrderRemoval)(nil),
+		(*InternalOperation_ShortTermOrderReplacement)(nil),
 	}
 }
 
@@ -265,32 +279,34 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/clob/operation.proto", fileDescriptor_5906bab2b2e9b3cf) }
 
 var fileDescriptor_5906bab2b2e9b3cf = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xcd, 0xaa, 0x9b, 0x40,
-	0x14, 0xd6, 0xa4, 0x2d, 0x64, 0xd2, 0x2e, 0xe2, 0xa6, 0x22, 0x8d, 0xf9, 0x59, 0x94, 0x6e, 0xaa,
-	0xd0, 0x86, 0x3e, 0x40, 0x02, 0xc5, 0x2c, 0x42, 0x82, 0xed, 0x2a, 0x1b, 0x19, 0xc7, 0x49, 0x14,
-	0x46, 0x47, 0xc6, 0x49, 0x30, 0x6f, 0xd1, 0xc7, 0xea, 0x32, 0xcb, 0x42, 0x37, 0x97, 0xe4, 0x11,
-	0xee, 0x0b, 0x5c, 0x1c, 0x35, 0x28, 0x9a, 0xbb, 0xb9, 0x9b, 0xbb, 0x9c, 0x73, 0xbe, 0x3f, 0x3e,
-	0xe6, 0x80, 0x89, 0x77, 0xf2, 0xd2, 0x98, 0x51, 0x4e, 0x11, 0x25, 0x26, 0x22, 0xd4, 0x35, 0x69,
-	0x8c, 0x19, 0xe4, 0x01, 0x8d, 0x0c, 0x31, 0x57, 0x06, 0x55, 0x88, 0x91, 0x41, 0xb4, 0x51, 0x93,
-	0x15, 0x42, 0x8e, 0x7c, 0x9c, 0xe4, 0x1c, 0x6d, 0xd8, 0x22, 0xcb, 0x3c, 0xcc, 0x8a, 0xf5, 0xe7,
-	0x3b, 0x6b, 0x87, 0xe1, 0x90, 0x1e, 0x21, 0x29, 0x65, 0xb4, 0x26, 0x8e, 0xa7, 0xf9, 0x6e, 0xfa,
-	0xd8, 0x01, 0xbd, 0x75, 0x19, 0x55, 0x99, 0x81, 0xb7, 0x22, 0x81, 0x2a, 0x8f, 0xe5, 0x2f, 0xfd,
-	0x6f, 0x9f, 0x8c, 0x46, 0x68, 0x63, 0x41, 0xa8, 0xbb, 0xca, 0x30, 0x96, 0x64, 0xe7, 0x60, 0xc5,
-	0x01, 0x5a, 0xe2, 0x53, 0xc6, 0x1d, 0x8e, 0x59, 0xe8, 0xe4, 0x11, 0x62, 0x02, 0x11, 0x0e, 0x71,
-	0xc4, 0xd5, 0x8e, 0x90, 0x1a, 0xb7, 0x48, 0xad, 0x92, 0xfd, 0x26, 0x83, 0xad, 0x33, 0x86, 0x25,
-	0xd9, 0x1f, 0x85, 0xca, 0x6f, 0xcc, 0x42, 0x31, 0xd9, 0x94, 0x12, 0xca, 0x0e, 0x0c, 0x1b, 0x06,
-	0x08, 0x46, 0x08, 0x13, 0x22, 0x72, 0xab, 0x5d, 0xe1, 0x31, 0x69, 0xf7, 0x58, 0x08, 0x64, 0x69,
-	0xa2, 0xd5, 0x4d, 0x16, 0x15, 0x19, 0x65, 0x0b, 0xb4, 0x98, 0x61, 0x9c, 0x06, 0x09, 0x0f, 0xa2,
-	0xbd, 0x93, 0x70, 0xc8, 0xf1, 0xee, 0x40, 0x72, 0x47, 0xf5, 0x8d, 0x30, 0xd1, 0x5a, 0x4c, 0x84,
-	0xd2, 0xd2, 0xb3, 0x24, 0x5b, 0xad, 0xf0, 0x7f, 0x15, 0x74, 0xb1, 0x9d, 0xf7, 0x41, 0xef, 0xf6,
-	0x25, 0xa6, 0xff, 0x3b, 0x60, 0xb0, 0x8c, 0x38, 0x66, 0x11, 0x24, 0xaf, 0xbe, 0xfd, 0xe7, 0x5b,
-	0xe9, 0xbe, 0xa4, 0x15, 0xe5, 0x27, 0xf8, 0x50, 0xfb, 0xb2, 0x45, 0xc9, 0xa3, 0x7b, 0x72, 0x76,
-	0x0e, 0xb3, 0x24, 0xfb, 0x3d, 0xad, 0xbc, 0x6b, 0xed, 0xce, 0x37, 0x7f, 0x2f, 0xba, 0x7c, 0xbe,
-	0xe8, 0xf2, 0xc3, 0x45, 0x97, 0xff, 0x5c, 0x75, 0xe9, 0x7c, 0xd5, 0xa5, 0x7f, 0x57, 0x5d, 0xda,
-	0xfe, 0xd8, 0x07, 0xdc, 0x3f, 0xb8, 0x06, 0xa2, 0xa1, 0x59, 0x3b, 0x8a, 0xe3, 0xec, 0x2b, 0xf2,
-	0x61, 0x10, 0x99, 0xb7, 0x49, 0x5a, 0x1c, 0xca, 0x29, 0xc6, 0x89, 0xfb, 0x4e, 0x8c, 0xbf, 0x3f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xf7, 0xd2, 0xfe, 0x7e, 0xe8, 0x03, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0xb1, 0x8e, 0x9b, 0x40,
+	0x10, 0x86, 0xe1, 0x9c, 0x8b, 0x74, 0x7b, 0x49, 0x71, 0x34, 0x21, 0xe8, 0x8e, 0xbb, 0x73, 0x11,
+	0xa5, 0x09, 0x48, 0xc9, 0x29, 0x0f, 0x60, 0x4b, 0x11, 0x2e, 0x2c, 0x5b, 0x24, 0x95, 0x1b, 0xb4,
+	0x2c, 0x63, 0x83, 0xb4, 0xb0, 0x68, 0x59, 0x5b, 0xf8, 0x2d, 0xf2, 0x4c, 0xa9, 0x52, 0xba, 0x4c,
+	0x19, 0xd9, 0x8f, 0x90, 0x17, 0x88, 0x58, 0xc0, 0x06, 0x81, 0xd3, 0xa4, 0xb9, 0x92, 0x99, 0x7f,
+	0xbe, 0x7f, 0xf4, 0xb3, 0x83, 0x1e, 0x83, 0x6d, 0x90, 0xa7, 0x9c, 0x09, 0x46, 0x18, 0xb5, 0x09,
+	0x65, 0xbe, 0xcd, 0x52, 0xe0, 0x58, 0x44, 0x2c, 0xb1, 0x64, 0x5d, 0xbb, 0x69, 0x4a, 0xac, 0x42,
+	0x62, 0xdc, 0x77, 0xa7, 0x62, 0x2c, 0x48, 0x08, 0x59, 0x39, 0x63, 0xdc, 0xf5, 0x60, 0x79, 0x00,
+	0xbc, 0x6a, 0xbf, 0x3b, 0xd3, 0xf6, 0x38, 0xc4, 0x6c, 0x83, 0x69, 0x8d, 0x31, 0xba, 0x3a, 0x91,
+	0x97, 0xbd, 0xe1, 0x9f, 0x0b, 0x74, 0x35, 0xab, 0x57, 0xd5, 0x9e, 0xd0, 0xa5, 0xdc, 0x40, 0x57,
+	0x1f, 0xd4, 0xf7, 0xd7, 0x1f, 0x6f, 0xad, 0xce, 0xd2, 0xd6, 0x98, 0x32, 0x7f, 0x5a, 0x68, 0x1c,
+	0xc5, 0x2d, 0xc5, 0x9a, 0x87, 0x8c, 0x2c, 0x64, 0x5c, 0x78, 0x02, 0x78, 0xec, 0x95, 0x2b, 0xa4,
+	0x14, 0x13, 0x88, 0x21, 0x11, 0xfa, 0x85, 0x44, 0x3d, 0xf4, 0xa0, 0xa6, 0xd9, 0x6a, 0x5e, 0xc8,
+	0x66, 0xc5, 0x84, 0xa3, 0xb8, 0x6f, 0x24, 0xe5, 0x1b, 0xf0, 0x58, 0x56, 0xe6, 0x35, 0x42, 0x5b,
+	0xa2, 0xbb, 0x8e, 0x01, 0xc1, 0x09, 0x01, 0x4a, 0xe5, 0xde, 0xfa, 0x40, 0x7a, 0x3c, 0xf6, 0x7b,
+	0x8c, 0xa5, 0xb2, 0x36, 0x31, 0xda, 0x26, 0xe3, 0x06, 0x46, 0x5b, 0x20, 0x23, 0xe5, 0x00, 0x79,
+	0x94, 0x89, 0x28, 0x59, 0x79, 0x99, 0xc0, 0x02, 0x96, 0x6b, 0x5a, 0x3a, 0xea, 0x2f, 0xa4, 0x89,
+	0xd1, 0x63, 0x22, 0x49, 0x93, 0xc0, 0x51, 0x5c, 0xbd, 0x31, 0xff, 0xb5, 0x1a, 0x97, 0xdd, 0xd1,
+	0x35, 0xba, 0x3a, 0x3e, 0x89, 0xe1, 0x8f, 0x01, 0xba, 0x99, 0x24, 0x02, 0x78, 0x82, 0xe9, 0xb3,
+	0x4f, 0xff, 0xdf, 0xa9, 0x0c, 0xfe, 0x27, 0x15, 0xed, 0x0b, 0x7a, 0xdd, 0x7a, 0xb2, 0x55, 0xc8,
+	0xf7, 0xe7, 0x70, 0x6e, 0x29, 0x73, 0x14, 0xf7, 0x15, 0x6b, 0x7c, 0x6b, 0x80, 0x6e, 0x3b, 0x21,
+	0x70, 0x38, 0xc5, 0x70, 0x29, 0xb1, 0xc3, 0xfe, 0x18, 0xdc, 0x52, 0x58, 0x07, 0xf1, 0xb6, 0x1d,
+	0x84, 0x7b, 0xc2, 0xb4, 0x7e, 0xe2, 0x68, 0xfe, 0x73, 0x6f, 0xaa, 0xbb, 0xbd, 0xa9, 0xfe, 0xde,
+	0x9b, 0xea, 0xf7, 0x83, 0xa9, 0xec, 0x0e, 0xa6, 0xf2, 0xeb, 0x60, 0x2a, 0x8b, 0xcf, 0xab, 0x48,
+	0x84, 0x6b, 0xdf, 0x22, 0x2c, 0xb6, 0x5b, 0xb7, 0xb7, 0x79, 0xfa, 0x40, 0x42, 0x1c, 0x25, 0xf6,
+	0xb1, 0x92, 0x57, 0xf7, 0xb8, 0x4d, 0x21, 0xf3, 0x5f, 0xca, 0xf2, 0xa7, 0xbf, 0x03, 0x00, 0x36,
+	0xc1, 0x51, 0xbc, 0x4f, 0x04, 0x00, 0x00,
 }
 
 func (m *Operation) Marshal() (dAtA []byte, err error) {
@@ -525,6 +541,27 @@ func (m *InternalOperation_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *InternalOperation_ShortTermOrderReplacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InternalOperation_ShortTermOrderReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShortTermOrderReplacement != nil {
+		{
+			size, err := m.ShortTermOrderReplacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOperation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintOperation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOperation(v)
 	base := offset
@@ -656,6 +693,18 @@ func (m *InternalOperation_OrderRemoval) Size() (n int) {
 	}
 	return n
 }
+func (m *InternalOperation_ShortTermOrderReplacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortTermOrderReplacement != nil {
+		l = m.ShortTermOrderReplacement.Size()
+		n += 1 + l + sovOperation(uint64(l))
+	}
+	return n
+}
 
 func sovOperation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -1022,6 +1071,41 @@ func (m *InternalOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &InternalOperation_OrderRemoval{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermOrderReplacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgReplaceOrder{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &InternalOperation_ShortTermOrderReplacement{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperation(dAtA[iNdEx:])
@@ -20,9 +20,9 @@ type OperationsToPropose struct {
 	// the purposes of constructing `MsgProposedOperations`.
 	ShortTermOrderHashToTxBytes map[OrderHash][]byte
 	// A map from order ID to the orders themselves for each order that
-	// was matched. Note: there may be multiple distinct orders with the same
-	// ID that are matched. In that case, only the "greatest" of any such orders
-	// is maintained in this map.
+	// was matched or added to the operations queue as an atomic replacement. Note: there may be
+	// multiple distinct orders with the same ID that are matched. In that case, only the "greatest"
+	// of any such orders, or the latest atomic replacement, is maintained in this map.
 	MatchedOrderIdToOrder map[OrderId]Order
 	// A set of order ids where the order removal has already been included in the operations queue.
 	OrderRemovalsInOperationsQueue map[OrderId]bool
@@ -115,6 +115,54 @@ func (o *OperationsToPropose) MustAddShortTermOrderPlacementToOperationsQueue(
 	o.OperationsQueue = append(o.OperationsQueue, NewShortTermOrderPlacementInternalOperation(order))
 }
 
+// MustAddShortTermOrderReplacementToOperationsQueue adds a Short-Term order replacement operation to the
+// operations queue, and records the replacement order in `MatchedOrderIdToOrder` so that subsequent orders
+// with the same `OrderId` are validated against it.
+// This function will panic if the order is not a Short-Term order, the order already exists in
+// `OrderHashesInOperationsQueue`, the order does not exist in `ShortTermOrderHashToTxBytes`, or the
+// order being replaced is not in the operations queue.
+func (o *OperationsToPropose) MustAddShortTermOrderReplacementToOperationsQueue(
+	replacedOrder Order,
+	order Order,
+) {
+	order.OrderId.MustBeShortTermOrder()
+
+	if !o.IsOrderPlacementInOperationsQueue(replacedOrder) {
+		panic(
+			fmt.Sprintf(
+				"MustAddShortTermOrderReplacementToOperationsQueue: Replaced order (%s) does not exist in "+
+					"`OrderHashesInOperationsQueue`.",
+				replacedOrder.GetOrderTextString(),
+			),
+		)
+	}
+
+	orderHash := order.GetOrderHash()
+	if _, exists := o.OrderHashesInOperationsQueue[orderHash]; exists {
+		panic(
+			fmt.Sprintf(
+				"MustAddShortTermOrderReplacementToOperationsQueue: Order (%s) already exists in "+
+					"`OrderHashesInOperationsQueue`.",
+				order.GetOrderTextString(),
+			),
+		)
+	}
+
+	if _, exists := o.ShortTermOrderHashToTxBytes[orderHash]; !exists {
+		panic(
+			fmt.Sprintf(
+				"MustAddShortTermOrderReplacementToOperationsQueue: Order (%s) does not exist in "+
+					"`ShortTermOrderHashToTxBytes`.",
+				order.GetOrderTextString(),
+			),
+		)
+	}
+
+	o.OrderHashesInOperationsQueue[orderHash] = true
+	o.MatchedOrderIdToOrder[order.OrderId] = order
+	o.OperationsQueue = append(o.OperationsQueue, NewShortTermOrderReplacementInternalOperation(order))
+}
+
 // RemoveShortTermOrderTxBytes removes a short term order from `ShortTermOrderHashToTxBytes`.
 // This function will panic for any of the following:
 // - the order is not a short term order.
@@ -204,7 +252,12 @@ func (o *OperationsToPropose) MustAddMatchToOperationsQueue(
 	// Else the order is not a liquidation order, create a regular match and verify the taker order
 	// is in the operations queue.
 	var matchOperation InternalOperation
-	if takerMatchableOrder.IsLiquidation() {
+	if takerMatchableOrder.IsAssetLiquidation() {
+		matchOperation = NewMatchAssetLiquidationInternalOperation(
+			takerMatchableOrder,
+			makerFills,
+		)
+	} else if takerMatchableOrder.IsLiquidation() {
 		matchOperation = NewMatchPerpetualLiquidationInternalOperation(
 			takerMatchableOrder,
 			makerFills,
@@ -310,6 +363,35 @@ func (o *OperationsToPropose) MustAddDeleveragingToOperationsQueue(
 	)
 }
 
+// MustAddAssetDeleveragingToOperationsQueue adds an asset deleveraging match operation to the
+// operations queue.
+// This function will panic if the resulting match fails stateless validation.
+func (o *OperationsToPropose) MustAddAssetDeleveragingToOperationsQueue(
+	liquidatedSubaccountId satypes.SubaccountId,
+	assetId uint32,
+	fills []MatchPerpetualDeleveraging_Fill,
+) {
+	operation := NewMatchAssetDeleveragingInternalOperation(
+		liquidatedSubaccountId,
+		assetId,
+		fills,
+	)
+	if err := operation.GetMatch().GetMatchAssetDeleveraging().Validate(); err != nil {
+		panic(
+			fmt.Sprintf(
+				"MustAddAssetDeleveragingToOperationsQueue: invalid asset deleveraging. "+
+					"liquidatedSubaccountId = (%+v), assetId = (%d), fills = (%+v), err = (%v)",
+				liquidatedSubaccountId,
+				assetId,
+				fills,
+				err,
+			),
+		)
+	}
+
+	o.OperationsQueue = append(o.OperationsQueue, operation)
+}
+
 // MustAddOrderRemovalToOperationsQueue adds an order removal operation to the
 // operations queue. This function will panic if given an unspecified removal reason.
 func (o *OperationsToPropose) MustAddOrderRemovalToOperationsQueue(
@@ -370,15 +452,15 @@ func (o *OperationsToPropose) GetOperationsToReplay() (
 	for _, operation := range o.OperationsQueue {
 		operations = append(operations, operation)
 
-		if shortTermOrder := operation.GetShortTermOrderPlacement(); shortTermOrder != nil {
-			orderHash := shortTermOrder.Order.GetOrderHash()
+		if shortTermOrder, ok := operation.GetShortTermOrder(); ok {
+			orderHash := shortTermOrder.GetOrderHash()
 			shortTermOrderBytes, exists := o.ShortTermOrderHashToTxBytes[orderHash]
 			if !exists {
 				panic(
 					fmt.Sprintf(
 						"GetOperationsToReplay: Short-Term order (%s) does not exist in "+
 							"`ShortTermOrderHashToTxBytes`.",
-						shortTermOrder.Order.GetOrderTextString(),
+						shortTermOrder.GetOrderTextString(),
 					),
 				)
 			} else if len(shortTermOrderBytes) == 0 {
@@ -386,7 +468,7 @@ func (o *OperationsToPropose) GetOperationsToReplay() (
 					fmt.Sprintf(
 						"GetOperationsToReplay: Short-Term order (%s) is assigned to an empty byte "+
 							"array in `ShortTermOrderHashToTxBytes`.",
-						shortTermOrder.Order.GetOrderTextString(),
+						shortTermOrder.GetOrderTextString(),
 					),
 				)
 			}
@@ -414,8 +496,8 @@ func (o *OperationsToPropose) GetOperationsToReplay() (
 func (o *OperationsToPropose) GetOperationsToPropose() []OperationRaw {
 	operationRaws := make([]OperationRaw, 0)
 
-	for _, operation := range o.OperationsQueue {
-		switch operation := operation.Operation.(type) {
+	for _, internalOperation := range o.OperationsQueue {
+		switch operation := internalOperation.Operation.(type) {
 		case *InternalOperation_Match:
 			operationRaws = append(operationRaws, OperationRaw{
 				Operation: &OperationRaw_Match{
@@ -424,8 +506,9 @@ func (o *OperationsToPropose) GetOperationsToPropose() []OperationRaw {
 					},
 				},
 			})
-		case *InternalOperation_ShortTermOrderPlacement:
-			order := operation.ShortTermOrderPlacement.GetOrder()
+		case *InternalOperation_ShortTermOrderPlacement, *InternalOperation_ShortTermOrderReplacement:
+			// Short-Term order replacements are proposed as the raw `MsgReplaceOrder` transaction bytes.
+			order, _ := internalOperation.GetShortTermOrder()
 			operationBytes, exists := o.ShortTermOrderHashToTxBytes[order.GetOrderHash()]
 			if !exists {
 				panic(
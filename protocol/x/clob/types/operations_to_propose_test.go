@@ -251,6 +251,65 @@ func TestMustAddShortTermOrderPlacementToOperationsQueue_PanicsOnOrderNotInShort
 	)
 }
 
+func TestMustAddShortTermOrderReplacementToOperationsQueue(t *testing.T) {
+	replacedOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20
+	replacementOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+
+	otp := types.NewOperationsToPropose()
+	for _, order := range []types.Order{replacedOrder, replacementOrder} {
+		otp.MustAddShortTermOrderTxBytes(order, order.GetOrderHash().ToBytes())
+	}
+	otp.MustAddShortTermOrderPlacementToOperationsQueue(replacedOrder)
+	otp.MustAddShortTermOrderReplacementToOperationsQueue(replacedOrder, replacementOrder)
+
+	require.True(t, otp.IsOrderPlacementInOperationsQueue(replacementOrder))
+	require.Equal(t, replacementOrder, otp.MatchedOrderIdToOrder[replacementOrder.OrderId])
+	require.Equal(
+		t,
+		[]types.InternalOperation{
+			types.NewShortTermOrderPlacementInternalOperation(replacedOrder),
+			types.NewShortTermOrderReplacementInternalOperation(replacementOrder),
+		},
+		otp.OperationsQueue,
+	)
+
+	// The order replacement is proposed as the TX bytes of the replacement order.
+	require.Equal(
+		t,
+		[]types.OperationRaw{
+			{
+				Operation: &types.OperationRaw_ShortTermOrderPlacement{
+					ShortTermOrderPlacement: replacedOrder.GetOrderHash().ToBytes(),
+				},
+			},
+			{
+				Operation: &types.OperationRaw_ShortTermOrderPlacement{
+					ShortTermOrderPlacement: replacementOrder.GetOrderHash().ToBytes(),
+				},
+			},
+		},
+		otp.GetOperationsToPropose(),
+	)
+}
+
+func TestMustAddShortTermOrderReplacementToOperationsQueue_PanicsOnReplacedOrderNotInOperationsQueue(t *testing.T) {
+	replacedOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy6_Price10_GTB20
+	replacementOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+	otp := types.NewOperationsToPropose()
+	otp.MustAddShortTermOrderTxBytes(replacementOrder, replacementOrder.GetOrderHash().ToBytes())
+	require.PanicsWithValue(
+		t,
+		fmt.Sprintf(
+			"MustAddShortTermOrderReplacementToOperationsQueue: Replaced order (%s) does not exist in "+
+				"`OrderHashesInOperationsQueue`.",
+			replacedOrder.GetOrderTextString(),
+		),
+		func() {
+			otp.MustAddShortTermOrderReplacementToOperationsQueue(replacedOrder, replacementOrder)
+		},
+	)
+}
+
 func TestRemoveShortTermOrderTxBytes(t *testing.T) {
 	shortTermOrder1 := constants.Order_Carl_Num0_Id0_Clob0_Buy05BTC_Price50000_GTB10_FOK
 	shortTermOrder2 := constants.Order_Carl_Num1_Id1_Clob0_Buy1kQtBTC_Price50000
@@ -9,6 +9,7 @@ import (
 
 	gometrics "github.com/armon/go-metrics"
 	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -76,8 +77,10 @@ func (o *Order) GetOrderSubticks() Subticks {
 // 0 if x = y
 // -1 if x < y
 // The orders are compared primarily by `GoodTilBlock` for Short-Term orders and `GoodTilBlockTime`
-// for stateful orders. If the order expirations are equal, then they are compared by their SHA256 hash.
-// Note that this function panics if the order IDs are not equal.
+// for stateful orders and Short-Term orders which expire by `GoodTilBlockTime`. If the order expirations
+// are equal, then they are compared by their SHA256 hash.
+// Note that this function panics if the order IDs are not equal or if the orders have different
+// expiration types.
 func (x *Order) MustCmpReplacementOrder(y *Order) int {
 	if x.OrderId != y.OrderId {
 		panic(
@@ -89,12 +92,22 @@ func (x *Order) MustCmpReplacementOrder(y *Order) int {
 		)
 	}
 
+	if !x.HasSameExpirationType(y) {
+		panic(
+			fmt.Sprintf(
+				"MustCmpReplacementOrder: order (%v) and order (%v) have different expiration types",
+				x,
+				y,
+			),
+		)
+	}
+
 	var orderXExpiration uint32
 	var orderYExpiration uint32
 
-	// If this is a Short-Term order, use the `GoodTilBlock` for comparison.
-	// Else this is a stateful order, therefore use `GoodTilBlockTime` for comparison.
-	if x.IsShortTermOrder() {
+	// If this is a Short-Term order that expires by `GoodTilBlock`, use the `GoodTilBlock` for comparison.
+	// Else use `GoodTilBlockTime` for comparison.
+	if x.IsShortTermOrder() && !x.IsGoodTilBlockTimeShortTermOrder() {
 		orderXExpiration = x.GetGoodTilBlock()
 		orderYExpiration = y.GetGoodTilBlock()
 	} else {
@@ -138,6 +151,18 @@ func (o *Order) MustGetLiquidatedPerpetualId() uint32 {
 	panic("MustGetLiquidatedPerpetualId: No liquidated perpetual on an Order type.")
 }
 
+// IsAssetLiquidation always returns false since this order is not a liquidation.
+// This function is necessary for the `Order` type to implement the `MatchableOrder` interface.
+func (o *Order) IsAssetLiquidation() bool {
+	return false
+}
+
+// MustGetLiquidatedAssetId always panics since there is no underlying asset ID for a `Order`.
+// This function is necessary for the `Order` type to implement the `MatchableOrder` interface.
+func (o *Order) MustGetLiquidatedAssetId() uint32 {
+	panic("MustGetLiquidatedAssetId: No liquidated asset on an Order type.")
+}
+
 // IsReduceOnly returns whether this is a reduce-only order.
 func (o *Order) IsReduceOnly() bool {
 	return o.ReduceOnly
@@ -153,6 +178,11 @@ func (o *Order) IsStopLossOrder() bool {
 	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS
 }
 
+// IsTrailingStopOrder returns whether this is order is a conditional trailing stop order.
+func (o *Order) IsTrailingStopOrder() bool {
+	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP
+}
+
 // RequiresImmediateExecution returns whether this order has to be executed immediately.
 func (o *Order) RequiresImmediateExecution() bool {
 	return o.GetTimeInForce() == Order_TIME_IN_FORCE_IOC || o.GetTimeInForce() == Order_TIME_IN_FORCE_FILL_OR_KILL
@@ -163,6 +193,24 @@ func (o *Order) IsShortTermOrder() bool {
 	return o.OrderId.IsShortTermOrder()
 }
 
+// IsGoodTilBlockTimeShortTermOrder returns whether this is a Short-Term order which expires by
+// `GoodTilBlockTime` instead of `GoodTilBlock`.
+func (o *Order) IsGoodTilBlockTimeShortTermOrder() bool {
+	if !o.IsShortTermOrder() {
+		return false
+	}
+	_, ok := o.GetGoodTilOneof().(*Order_GoodTilBlockTime)
+	return ok
+}
+
+// HasSameExpirationType returns whether this order and `other` both expire by `GoodTilBlock`
+// or both expire by `GoodTilBlockTime`.
+func (o *Order) HasSameExpirationType(other *Order) bool {
+	_, isGoodTilBlockTime := o.GetGoodTilOneof().(*Order_GoodTilBlockTime)
+	_, otherIsGoodTilBlockTime := other.GetGoodTilOneof().(*Order_GoodTilBlockTime)
+	return isGoodTilBlockTime == otherIsGoodTilBlockTime
+}
+
 // IsStatefulOrder returns whether this order is a stateful order, which is true for Long-Term
 // and conditional orders and false for Short-Term orders.
 func (o *Order) IsStatefulOrder() bool {
@@ -180,17 +228,61 @@ func (o *Order) CanTrigger(subticks Subticks) bool {
 	o.MustBeConditionalOrder()
 	orderTriggerSubticks := Subticks(o.ConditionalOrderTriggerSubticks)
 
-	// Take profit buys and stop loss sells trigger when the oracle price goes lower
+	// Take profit buys, stop loss sells and trailing stop sells trigger when the oracle price goes lower
 	// than or equal to the trigger price.
 	if o.ConditionType == Order_CONDITION_TYPE_TAKE_PROFIT && o.IsBuy() ||
-		o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS && !o.IsBuy() {
+		o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS && !o.IsBuy() ||
+		o.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP && !o.IsBuy() {
 		return orderTriggerSubticks >= subticks
 	}
-	// Take profit sells and stop loss buys trigger when the oracle price goes higher
+	// Take profit sells, stop loss buys and trailing stop buys trigger when the oracle price goes higher
 	// than or equal to the trigger price.
 	return orderTriggerSubticks <= subticks
 }
 
+// GetUpdatedTrailingStopTriggerSubticks returns the trigger price of a trailing stop order after it
+// follows the given oracle price, and whether it differs from the order's `ConditionalOrderTriggerSubticks`.
+// The trigger price trails the oracle price by the order's trailing distance, rounded away from the
+// oracle price to a multiple of `subticksPerTick`, and only moves in the order's favor: up for sells and
+// down for buys. Function will panic if order is not a trailing stop order.
+func (o *Order) GetUpdatedTrailingStopTriggerSubticks(
+	oraclePriceSubticksRat *big.Rat,
+	subticksPerTick SubticksPerTick,
+) (triggerSubticks Subticks, updated bool) {
+	if !o.IsTrailingStopOrder() {
+		panic(fmt.Sprintf("GetUpdatedTrailingStopTriggerSubticks: order is not a trailing stop order: %+v", o))
+	}
+	currentTriggerSubticks := Subticks(o.ConditionalOrderTriggerSubticks)
+
+	trailingDistance := new(big.Rat).SetUint64(o.ConditionalOrderTrailingSubticks)
+	if o.ConditionalOrderTrailingPpm != 0 {
+		trailingDistance = lib.BigRatMulPpm(oraclePriceSubticksRat, o.ConditionalOrderTrailingPpm)
+	}
+
+	// Sells trail below the oracle price and buys trail above it.
+	candidateRat := new(big.Rat)
+	if o.IsBuy() {
+		candidateRat.Add(oraclePriceSubticksRat, trailingDistance)
+	} else {
+		candidateRat.Sub(oraclePriceSubticksRat, trailingDistance)
+	}
+	candidate := lib.BigIntRoundToMultiple(
+		lib.BigRatRound(candidateRat, o.IsBuy()),
+		new(big.Int).SetUint64(uint64(subticksPerTick)),
+		o.IsBuy(),
+	)
+	if candidate.Sign() <= 0 || !candidate.IsUint64() {
+		return currentTriggerSubticks, false
+	}
+
+	candidateSubticks := Subticks(candidate.Uint64())
+	if o.IsBuy() && candidateSubticks < currentTriggerSubticks ||
+		!o.IsBuy() && candidateSubticks > currentTriggerSubticks {
+		return candidateSubticks, true
+	}
+	return currentTriggerSubticks, false
+}
+
 // MustGetUnixGoodTilBlockTime returns an instance of `Time` that represents the order's
 // `GoodTilBlockTime`. This function panics when the order is a short-term order or
 // when its `GoodTilBlockTime` is zero.
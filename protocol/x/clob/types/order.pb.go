@@ -120,18 +120,26 @@ const (
 	// order will trigger when the oracle price moves at or below the trigger
 	// price for buys and at or above the trigger price for sells.
 	Order_CONDITION_TYPE_TAKE_PROFIT Order_ConditionType = 2
+	// CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+	// trailing stop order triggers like a stop order, but its trigger price
+	// follows the oracle price at a fixed trailing distance as the oracle
+	// price moves in the order's favor: down for buys and up for sells. The
+	// trigger price never moves against the order.
+	Order_CONDITION_TYPE_TRAILING_STOP Order_ConditionType = 3
 )
 
 var Order_ConditionType_name = map[int32]string{
 	0: "CONDITION_TYPE_UNSPECIFIED",
 	1: "CONDITION_TYPE_STOP_LOSS",
 	2: "CONDITION_TYPE_TAKE_PROFIT",
+	3: "CONDITION_TYPE_TRAILING_STOP",
 }
 
 var Order_ConditionType_value = map[string]int32{
-	"CONDITION_TYPE_UNSPECIFIED": 0,
-	"CONDITION_TYPE_STOP_LOSS":   1,
-	"CONDITION_TYPE_TAKE_PROFIT": 2,
+	"CONDITION_TYPE_UNSPECIFIED":   0,
+	"CONDITION_TYPE_STOP_LOSS":     1,
+	"CONDITION_TYPE_TAKE_PROFIT":   2,
+	"CONDITION_TYPE_TRAILING_STOP": 3,
 }
 
 func (x Order_ConditionType) String() string {
@@ -142,6 +150,80 @@ func (Order_ConditionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_673c6f4faa93736b, []int{7, 2}
 }
 
+type OrderGroup_Type int32
+
+const (
+	// TYPE_UNSPECIFIED is invalid.
+	OrderGroup_TYPE_UNSPECIFIED OrderGroup_Type = 0
+	// TYPE_ONE_CANCELS_OTHER links two orders. When one of the orders is
+	// triggered, fully filled, canceled or removed, the other order is removed.
+	OrderGroup_TYPE_ONE_CANCELS_OTHER OrderGroup_Type = 1
+	// TYPE_BRACKET links an entry order with a take profit and a stop loss
+	// order on the same `ClobPair` and the opposite side of the entry order.
+	// When the take profit or stop loss order is triggered, fully filled,
+	// canceled or removed, the other one is removed. When the entry order is
+	// canceled or removed before being fully filled, both are removed.
+	OrderGroup_TYPE_BRACKET OrderGroup_Type = 2
+)
+
+var OrderGroup_Type_name = map[int32]string{
+	0: "TYPE_UNSPECIFIED",
+	1: "TYPE_ONE_CANCELS_OTHER",
+	2: "TYPE_BRACKET",
+}
+
+var OrderGroup_Type_value = map[string]int32{
+	"TYPE_UNSPECIFIED":       0,
+	"TYPE_ONE_CANCELS_OTHER": 1,
+	"TYPE_BRACKET":           2,
+}
+
+func (x OrderGroup_Type) String() string {
+	return proto.EnumName(OrderGroup_Type_name, int32(x))
+}
+
+func (OrderGroup_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{8, 0}
+}
+
+type OrderGroup_Leg int32
+
+const (
+	// LEG_UNSPECIFIED is used for orders of one-cancels-other groups.
+	OrderGroup_LEG_UNSPECIFIED OrderGroup_Leg = 0
+	// LEG_ENTRY is the entry order of a bracket group.
+	OrderGroup_LEG_ENTRY OrderGroup_Leg = 1
+	// LEG_TAKE_PROFIT is the take profit order of a bracket group. It must be
+	// a `CONDITION_TYPE_TAKE_PROFIT` conditional order.
+	OrderGroup_LEG_TAKE_PROFIT OrderGroup_Leg = 2
+	// LEG_STOP_LOSS is the stop loss order of a bracket group. It must be a
+	// `CONDITION_TYPE_STOP_LOSS` or `CONDITION_TYPE_TRAILING_STOP` conditional
+	// order.
+	OrderGroup_LEG_STOP_LOSS OrderGroup_Leg = 3
+)
+
+var OrderGroup_Leg_name = map[int32]string{
+	0: "LEG_UNSPECIFIED",
+	1: "LEG_ENTRY",
+	2: "LEG_TAKE_PROFIT",
+	3: "LEG_STOP_LOSS",
+}
+
+var OrderGroup_Leg_value = map[string]int32{
+	"LEG_UNSPECIFIED": 0,
+	"LEG_ENTRY":       1,
+	"LEG_TAKE_PROFIT": 2,
+	"LEG_STOP_LOSS":   3,
+}
+
+func (x OrderGroup_Leg) String() string {
+	return proto.EnumName(OrderGroup_Leg_name, int32(x))
+}
+
+func (OrderGroup_Leg) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{8, 1}
+}
+
 // OrderId refers to a single order belonging to a Subaccount.
 type OrderId struct {
 	// The subaccount ID that opened this order.
@@ -339,6 +421,11 @@ type OrderFillState struct {
 	// The block height at which the fillAmount state for this order can be
 	// pruned.
 	PrunableBlockHeight uint32 `protobuf:"varint,2,opt,name=prunable_block_height,json=prunableBlockHeight,proto3" json:"prunable_block_height,omitempty"`
+	// The unix timestamp (in seconds) at which the fillAmount state for this
+	// order can be pruned. Only set for Short-Term orders which expire by
+	// good_til_block_time, in which case prunable_block_height is the max
+	// uint32.
+	PrunableBlockTime uint32 `protobuf:"varint,3,opt,name=prunable_block_time,json=prunableBlockTime,proto3" json:"prunable_block_time,omitempty"`
 }
 
 func (m *OrderFillState) Reset()         { *m = OrderFillState{} }
@@ -388,6 +475,13 @@ func (m *OrderFillState) GetPrunableBlockHeight() uint32 {
 	return 0
 }
 
+func (m *OrderFillState) GetPrunableBlockTime() uint32 {
+	if m != nil {
+		return m.PrunableBlockTime
+	}
+	return 0
+}
+
 // StatefulOrderTimeSliceValue represents the type of the value of the
 // `StatefulOrdersTimeSlice` in state. The `StatefulOrdersTimeSlice`
 // in state consists of key/value pairs where the keys are UTF-8-encoded
@@ -451,6 +545,10 @@ type LongTermOrderPlacement struct {
 	// The block height and transaction index at which the order was placed.
 	// Used for ordering by time priority when the chain is restarted.
 	PlacementIndex TransactionOrdering `protobuf:"bytes,2,opt,name=placement_index,json=placementIndex,proto3" json:"placement_index"`
+	// The current trigger price in subticks of a trailing stop order. Set when
+	// the order is placed and updated as the oracle price moves in the order's
+	// favor until the order is triggered. Zero for all other orders.
+	TrailingStopTriggerSubticks uint64 `protobuf:"varint,3,opt,name=trailing_stop_trigger_subticks,json=trailingStopTriggerSubticks,proto3" json:"trailing_stop_trigger_subticks,omitempty"`
 }
 
 func (m *LongTermOrderPlacement) Reset()         { *m = LongTermOrderPlacement{} }
@@ -500,6 +598,13 @@ func (m *LongTermOrderPlacement) GetPlacementIndex() TransactionOrdering {
 	return TransactionOrdering{}
 }
 
+func (m *LongTermOrderPlacement) GetTrailingStopTriggerSubticks() uint64 {
+	if m != nil {
+		return m.TrailingStopTriggerSubticks
+	}
+	return 0
+}
+
 // ConditionalOrderPlacement represents the placement of a conditional order in
 // state. It stores the stateful order itself, the `BlockHeight` and
 // `TransactionIndex` at which the order was placed and triggered.
@@ -607,6 +712,19 @@ type Order struct {
 	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
 	// orderId.ClobPairId`).
 	ConditionalOrderTriggerSubticks uint64 `protobuf:"varint,11,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+	// conditional_order_trailing_ppm represents the trailing distance of a
+	// trailing stop order as parts-per-million of the oracle price. Exactly one
+	// of conditional_order_trailing_ppm and conditional_order_trailing_subticks
+	// must be nonzero if the condition_type is CONDITION_TYPE_TRAILING_STOP, and
+	// both are enforced to be 0 otherwise. Must be less than 1,000,000.
+	ConditionalOrderTrailingPpm uint32 `protobuf:"varint,12,opt,name=conditional_order_trailing_ppm,json=conditionalOrderTrailingPpm,proto3" json:"conditional_order_trailing_ppm,omitempty"`
+	// conditional_order_trailing_subticks represents the trailing distance of a
+	// trailing stop order in subticks. Must be a multiple of
+	// ClobPair.SubticksPerTick (where `ClobPair.Id = orderId.ClobPairId`).
+	ConditionalOrderTrailingSubticks uint64 `protobuf:"varint,13,opt,name=conditional_order_trailing_subticks,json=conditionalOrderTrailingSubticks,proto3" json:"conditional_order_trailing_subticks,omitempty"`
+	// The order group this order belongs to. Only stateful orders can be part
+	// of an order group. Nil if the order is not part of an order group.
+	OrderGroup *OrderGroup `protobuf:"bytes,14,opt,name=order_group,json=orderGroup,proto3" json:"order_group,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -742,6 +860,27 @@ func (m *Order) GetConditionalOrderTriggerSubticks() uint64 {
 	return 0
 }
 
+func (m *Order) GetConditionalOrderTrailingPpm() uint32 {
+	if m != nil {
+		return m.ConditionalOrderTrailingPpm
+	}
+	return 0
+}
+
+func (m *Order) GetConditionalOrderTrailingSubticks() uint64 {
+	if m != nil {
+		return m.ConditionalOrderTrailingSubticks
+	}
+	return 0
+}
+
+func (m *Order) GetOrderGroup() *OrderGroup {
+	if m != nil {
+		return m.OrderGroup
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Order) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -750,6 +889,122 @@ func (*Order) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// OrderGroup links stateful orders of the same subaccount so that the other
+// orders of the group are removed from state when one of them is triggered,
+// fully filled, canceled or removed. All orders of a group must have the same
+// `good_til_block_time`.
+type OrderGroup struct {
+	// The ID of the order group, chosen by the client. Order groups are unique
+	// per subaccount.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of the order group.
+	Type OrderGroup_Type `protobuf:"varint,2,opt,name=type,proto3,enum=dydxprotocol.clob.OrderGroup_Type" json:"type,omitempty"`
+	// The leg of the order within a bracket group. Must be unspecified for
+	// one-cancels-other groups.
+	Leg OrderGroup_Leg `protobuf:"varint,3,opt,name=leg,proto3,enum=dydxprotocol.clob.OrderGroup_Leg" json:"leg,omitempty"`
+}
+
+func (m *OrderGroup) Reset()         { *m = OrderGroup{} }
+func (m *OrderGroup) String() string { return proto.CompactTextString(m) }
+func (*OrderGroup) ProtoMessage()    {}
+func (*OrderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{8}
+}
+func (m *OrderGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderGroup.Merge(m, src)
+}
+func (m *OrderGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderGroup proto.InternalMessageInfo
+
+func (m *OrderGroup) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OrderGroup) GetType() OrderGroup_Type {
+	if m != nil {
+		return m.Type
+	}
+	return OrderGroup_TYPE_UNSPECIFIED
+}
+
+func (m *OrderGroup) GetLeg() OrderGroup_Leg {
+	if m != nil {
+		return m.Leg
+	}
+	return OrderGroup_LEG_UNSPECIFIED
+}
+
+// OrderGroupMembers represents the type of the value of an order group in
+// state. It contains the IDs of the stateful orders of the group that are
+// still in state, sorted by order ID.
+type OrderGroupMembers struct {
+	OrderIds []OrderId `protobuf:"bytes,1,rep,name=order_ids,json=orderIds,proto3" json:"order_ids"`
+}
+
+func (m *OrderGroupMembers) Reset()         { *m = OrderGroupMembers{} }
+func (m *OrderGroupMembers) String() string { return proto.CompactTextString(m) }
+func (*OrderGroupMembers) ProtoMessage()    {}
+func (*OrderGroupMembers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{9}
+}
+func (m *OrderGroupMembers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderGroupMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderGroupMembers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderGroupMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderGroupMembers.Merge(m, src)
+}
+func (m *OrderGroupMembers) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderGroupMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderGroupMembers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderGroupMembers proto.InternalMessageInfo
+
+func (m *OrderGroupMembers) GetOrderIds() []OrderId {
+	if m != nil {
+		return m.OrderIds
+	}
+	return nil
+}
+
 // TransactionOrdering represents a unique location in the block where a
 // transaction was placed. This proto includes both block height and the
 // transaction index that the specific transaction was placed. This information
@@ -765,7 +1020,7 @@ func (m *TransactionOrdering) Reset()         { *m = TransactionOrdering{} }
 func (m *TransactionOrdering) String() string { return proto.CompactTextString(m) }
 func (*TransactionOrdering) ProtoMessage()    {}
 func (*TransactionOrdering) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{8}
+	return fileDescriptor_673c6f4faa93736b, []int{10}
 }
 func (m *TransactionOrdering) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,6 +1067,8 @@ func init() {
 	proto.RegisterEnum("dydxprotocol.clob.Order_Side", Order_Side_name, Order_Side_value)
 	proto.RegisterEnum("dydxprotocol.clob.Order_TimeInForce", Order_TimeInForce_name, Order_TimeInForce_value)
 	proto.RegisterEnum("dydxprotocol.clob.Order_ConditionType", Order_ConditionType_name, Order_ConditionType_value)
+	proto.RegisterEnum("dydxprotocol.clob.OrderGroup_Type", OrderGroup_Type_name, OrderGroup_Type_value)
+	proto.RegisterEnum("dydxprotocol.clob.OrderGroup_Leg", OrderGroup_Leg_name, OrderGroup_Leg_value)
 	proto.RegisterType((*OrderId)(nil), "dydxprotocol.clob.OrderId")
 	proto.RegisterType((*OrdersFilledDuringLatestBlock)(nil), "dydxprotocol.clob.OrdersFilledDuringLatestBlock")
 	proto.RegisterType((*PotentiallyPrunableOrders)(nil), "dydxprotocol.clob.PotentiallyPrunableOrders")
@@ -820,75 +1077,93 @@ func init() {
 	proto.RegisterType((*LongTermOrderPlacement)(nil), "dydxprotocol.clob.LongTermOrderPlacement")
 	proto.RegisterType((*ConditionalOrderPlacement)(nil), "dydxprotocol.clob.ConditionalOrderPlacement")
 	proto.RegisterType((*Order)(nil), "dydxprotocol.clob.Order")
+	proto.RegisterType((*OrderGroup)(nil), "dydxprotocol.clob.OrderGroup")
+	proto.RegisterType((*OrderGroupMembers)(nil), "dydxprotocol.clob.OrderGroupMembers")
 	proto.RegisterType((*TransactionOrdering)(nil), "dydxprotocol.clob.TransactionOrdering")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/clob/order.proto", fileDescriptor_673c6f4faa93736b) }
 
 var fileDescriptor_673c6f4faa93736b = []byte{
-	// 989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0xdb, 0x6c, 0x9b, 0x9e, 0xfc, 0xac, 0x3b, 0xdd, 0x05, 0xb7, 0xa5, 0x69, 0x88, 0x50,
-	0x29, 0x42, 0x24, 0xa2, 0xac, 0x90, 0x10, 0xe2, 0x62, 0xdb, 0x26, 0xaa, 0xd5, 0xb4, 0x0e, 0xb6,
-	0x8b, 0xd4, 0x15, 0x62, 0xe4, 0xd8, 0x53, 0x77, 0xb4, 0x13, 0x4f, 0xb0, 0xc7, 0xa8, 0xb9, 0xe3,
-	0x11, 0x78, 0x09, 0xde, 0x82, 0x07, 0xd8, 0xcb, 0xbd, 0xe4, 0x0a, 0xa1, 0xf6, 0x19, 0x10, 0xb7,
-	0x68, 0xc6, 0x6e, 0xea, 0x74, 0xb7, 0x42, 0x68, 0x6f, 0xb8, 0xf3, 0x7c, 0xe7, 0x3b, 0xdf, 0x9c,
-	0xdf, 0x91, 0x61, 0x2b, 0x98, 0x06, 0x57, 0x93, 0x98, 0x0b, 0xee, 0x73, 0xd6, 0xf5, 0x19, 0x1f,
-	0x75, 0x79, 0x1c, 0x90, 0xb8, 0xa3, 0x30, 0xb4, 0x5a, 0x34, 0x77, 0xa4, 0x79, 0xe3, 0x49, 0xc8,
-	0x43, 0xae, 0xa0, 0xae, 0xfc, 0xca, 0x88, 0x1b, 0x9f, 0xcc, 0xe9, 0x24, 0xe9, 0xc8, 0xf3, 0x7d,
-	0x9e, 0x46, 0x22, 0x29, 0x7c, 0x67, 0xd4, 0xf6, 0x6f, 0x1a, 0x2c, 0x5b, 0xf2, 0x0e, 0x33, 0x40,
-	0xdf, 0x42, 0xfd, 0xce, 0x8e, 0x69, 0x60, 0x68, 0x2d, 0x6d, 0xb7, 0xba, 0xb7, 0xd3, 0x99, 0xbb,
-	0xb7, 0x20, 0xd7, 0x71, 0x66, 0xdf, 0x66, 0xb0, 0x5f, 0x7e, 0xf5, 0xc7, 0x76, 0xc9, 0xae, 0x25,
-	0x05, 0x0c, 0x6d, 0xc2, 0x8a, 0xcf, 0x28, 0xc9, 0xe4, 0x16, 0x5a, 0xda, 0xee, 0xb2, 0x5d, 0xc9,
-	0x00, 0x33, 0x40, 0xdb, 0x50, 0x55, 0xe9, 0xe1, 0x0b, 0xe6, 0x85, 0x89, 0xb1, 0xd8, 0xd2, 0x76,
-	0xeb, 0x36, 0x28, 0xa8, 0x2f, 0x11, 0xd4, 0x82, 0x9a, 0xcc, 0x12, 0x4f, 0x3c, 0x1a, 0x4b, 0x81,
-	0x72, 0xc6, 0x90, 0xd8, 0xd0, 0xa3, 0xb1, 0x19, 0xb4, 0x7f, 0x80, 0x2d, 0x15, 0x7d, 0xd2, 0xa7,
-	0x8c, 0x91, 0xe0, 0x30, 0x8d, 0x69, 0x14, 0x0e, 0x3c, 0x41, 0x12, 0xb1, 0xcf, 0xb8, 0xff, 0x12,
-	0x7d, 0x03, 0x2b, 0xd9, 0x1d, 0x34, 0x48, 0x0c, 0xad, 0xb5, 0xb8, 0x5b, 0xdd, 0xdb, 0xe8, 0xbc,
-	0x51, 0xc7, 0x4e, 0x5e, 0x82, 0x3c, 0x87, 0x0a, 0xcf, 0x8e, 0x49, 0xfb, 0x05, 0xac, 0x0f, 0xb9,
-	0x20, 0x91, 0xa0, 0x1e, 0x63, 0xd3, 0x61, 0x9c, 0x46, 0xde, 0x88, 0x91, 0xec, 0xca, 0x77, 0xd5,
-	0x26, 0xd0, 0x50, 0x26, 0x19, 0xba, 0x23, 0x3c, 0x41, 0x64, 0x41, 0x2e, 0x28, 0x63, 0xd8, 0x1b,
-	0xcb, 0xf2, 0xa9, 0xf2, 0x97, 0x6d, 0x90, 0xd0, 0x73, 0x85, 0xa0, 0x3d, 0x78, 0x3a, 0xc9, 0x63,
-	0xc0, 0x23, 0x99, 0x1f, 0xbe, 0x24, 0x34, 0xbc, 0x14, 0xaa, 0xb4, 0x75, 0x7b, 0xed, 0xd6, 0xa8,
-	0x72, 0x3f, 0x52, 0xa6, 0xf6, 0xf7, 0xb0, 0xa9, 0xd4, 0x2f, 0x52, 0xa6, 0xae, 0x73, 0xe9, 0x98,
-	0x38, 0x8c, 0xfa, 0xe4, 0x3b, 0x8f, 0xa5, 0xe4, 0x5d, 0x93, 0xf8, 0x55, 0x83, 0xf7, 0x06, 0x3c,
-	0x0a, 0x5d, 0x12, 0x8f, 0x15, 0x67, 0xc8, 0x3c, 0x9f, 0x8c, 0x49, 0x24, 0xd0, 0x33, 0x78, 0xa4,
-	0x68, 0xf9, 0x18, 0x19, 0x0f, 0xa9, 0xe6, 0x9a, 0x19, 0x19, 0x9d, 0xc1, 0xe3, 0xc9, 0xad, 0x04,
-	0xa6, 0x51, 0x40, 0xae, 0x54, 0x72, 0x6f, 0x8c, 0xa1, 0xf2, 0x77, 0x63, 0x2f, 0x4a, 0x3c, 0x5f,
-	0x50, 0x1e, 0x29, 0x29, 0x1a, 0x85, 0xb9, 0x5a, 0x63, 0x26, 0x62, 0x4a, 0x8d, 0xf6, 0x5f, 0x1a,
-	0xac, 0x1f, 0xf0, 0x28, 0xa0, 0x92, 0xeb, 0xb1, 0xff, 0x71, 0xa8, 0xe8, 0x18, 0xea, 0x22, 0xa6,
-	0x61, 0x28, 0x7b, 0xa2, 0x44, 0x17, 0xff, 0x8b, 0xa8, 0x5d, 0xcb, 0x9d, 0xb3, 0xbc, 0xff, 0x5e,
-	0x82, 0x47, 0xca, 0x84, 0xbe, 0x86, 0xca, 0x6d, 0xa3, 0xf3, 0x34, 0xff, 0xbd, 0xcf, 0xcb, 0x79,
-	0x9f, 0xd1, 0xe7, 0x50, 0x4e, 0x68, 0x40, 0x54, 0x7e, 0x8d, 0xbd, 0xad, 0x87, 0x1c, 0x3b, 0x0e,
-	0x0d, 0x88, 0xad, 0xa8, 0x68, 0x03, 0x2a, 0x3f, 0xa6, 0x5e, 0x24, 0xd2, 0x71, 0xb6, 0xda, 0x65,
-	0x7b, 0x76, 0x96, 0xb6, 0x24, 0x1d, 0x09, 0xea, 0xbf, 0x4c, 0xd4, 0x52, 0x97, 0xed, 0xd9, 0x19,
-	0xed, 0x40, 0x23, 0xe4, 0x3c, 0xc0, 0x82, 0xb2, 0x6c, 0xc6, 0x8d, 0x47, 0x72, 0xb8, 0x8f, 0x4a,
-	0x76, 0x4d, 0xe2, 0x2e, 0x65, 0xd9, 0x66, 0x77, 0x61, 0x6d, 0x9e, 0x87, 0x05, 0x1d, 0x13, 0x63,
-	0x49, 0x3e, 0x32, 0x47, 0x25, 0x5b, 0x2f, 0x92, 0xe5, 0xcc, 0xa3, 0x23, 0xa8, 0x4b, 0x06, 0xa6,
-	0x11, 0xbe, 0xe0, 0xb1, 0x4f, 0x8c, 0x65, 0x95, 0xcc, 0x47, 0x0f, 0x26, 0x23, 0xbd, 0xcc, 0xa8,
-	0x2f, 0xb9, 0x76, 0x55, 0xdc, 0x1d, 0xe4, 0x9e, 0xc6, 0x24, 0x48, 0x7d, 0x82, 0x79, 0xc4, 0xa6,
-	0x46, 0xa5, 0xa5, 0xed, 0x56, 0x6c, 0xc8, 0x20, 0x2b, 0x62, 0x53, 0xf4, 0x31, 0x3c, 0xce, 0x9f,
-	0xbd, 0x31, 0x11, 0x5e, 0xe0, 0x09, 0xcf, 0x58, 0x51, 0x1b, 0xda, 0xc8, 0xe0, 0x93, 0x1c, 0x45,
-	0x27, 0xd0, 0xf0, 0x6f, 0xa7, 0x12, 0x8b, 0xe9, 0x84, 0x18, 0xa0, 0x82, 0xda, 0x79, 0x30, 0xa8,
-	0xd9, 0x10, 0xbb, 0xd3, 0x09, 0xb1, 0xeb, 0x7e, 0xf1, 0x88, 0x8e, 0xa1, 0xed, 0xdf, 0x0d, 0x39,
-	0xce, 0xfa, 0x7d, 0x3b, 0x4c, 0xb3, 0x8a, 0x57, 0x55, 0xc5, 0xb7, 0xfd, 0x7b, 0xeb, 0xe0, 0x66,
-	0x3c, 0x27, 0xa7, 0xb5, 0xbf, 0x82, 0xb2, 0x6c, 0x27, 0x7a, 0x02, 0xba, 0x63, 0x1e, 0xf6, 0xf0,
-	0xd9, 0xa9, 0x33, 0xec, 0x1d, 0x98, 0x7d, 0xb3, 0x77, 0xa8, 0x97, 0x50, 0x0d, 0x2a, 0x0a, 0xdd,
-	0x3f, 0x3b, 0xd7, 0x35, 0x54, 0x87, 0x15, 0x75, 0x72, 0x7a, 0x83, 0x81, 0xbe, 0xd0, 0xfe, 0x59,
-	0x83, 0x6a, 0xa1, 0x7a, 0x68, 0x0b, 0xd6, 0x5d, 0xf3, 0xa4, 0x87, 0xcd, 0x53, 0xdc, 0xb7, 0xec,
-	0x83, 0xfb, 0x5a, 0x4f, 0x61, 0x75, 0xde, 0x6c, 0x5a, 0x07, 0xba, 0x86, 0x36, 0xe1, 0xfd, 0x79,
-	0x78, 0x68, 0x39, 0x2e, 0xb6, 0x4e, 0x07, 0xe7, 0xfa, 0x02, 0x6a, 0xc2, 0xc6, 0xbc, 0xb1, 0x6f,
-	0x0e, 0x06, 0xd8, 0xb2, 0xf1, 0xb1, 0x39, 0x18, 0xe8, 0x8b, 0xed, 0x31, 0xd4, 0xe7, 0x4a, 0x25,
-	0x1d, 0x0e, 0xac, 0xd3, 0x43, 0xd3, 0x35, 0xad, 0x53, 0xec, 0x9e, 0x0f, 0xef, 0x07, 0xf1, 0x01,
-	0x18, 0xf7, 0xec, 0x8e, 0x6b, 0x0d, 0xf1, 0xc0, 0x72, 0x1c, 0x5d, 0x7b, 0x8b, 0xb7, 0xfb, 0xfc,
-	0xb8, 0x87, 0x87, 0xb6, 0xd5, 0x37, 0x5d, 0x7d, 0x61, 0x5f, 0x2f, 0x4c, 0x2d, 0x8f, 0x08, 0xbf,
-	0x68, 0x13, 0x58, 0x7b, 0xcb, 0x7a, 0xa2, 0x0f, 0xa1, 0x36, 0xf7, 0x72, 0x6b, 0x6a, 0x2e, 0xaa,
-	0xa3, 0xbb, 0x17, 0x1b, 0x7d, 0x0a, 0xab, 0xe2, 0xce, 0xb3, 0xf0, 0xb2, 0xd4, 0x6d, 0xbd, 0x60,
-	0x50, 0x0b, 0xbe, 0x3f, 0x7c, 0x75, 0xdd, 0xd4, 0x5e, 0x5f, 0x37, 0xb5, 0x3f, 0xaf, 0x9b, 0xda,
-	0x2f, 0x37, 0xcd, 0xd2, 0xeb, 0x9b, 0x66, 0xe9, 0xf7, 0x9b, 0x66, 0xe9, 0xc5, 0x97, 0x21, 0x15,
-	0x97, 0xe9, 0xa8, 0xe3, 0xf3, 0x71, 0x77, 0xee, 0x87, 0xe0, 0xa7, 0x67, 0x9f, 0xf9, 0x97, 0x1e,
-	0x8d, 0xba, 0x33, 0xe4, 0x2a, 0xfb, 0xd9, 0x90, 0x03, 0x98, 0x8c, 0x96, 0x14, 0xfc, 0xc5, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x0b, 0xbd, 0x8a, 0x8e, 0x08, 0x00, 0x00,
+	// 1246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xdb, 0xc6,
+	0x12, 0x16, 0x25, 0x25, 0x96, 0x47, 0xa2, 0x42, 0xaf, 0x93, 0x3c, 0xc6, 0x8e, 0x15, 0x85, 0xef,
+	0x21, 0xcf, 0x0f, 0x0f, 0x95, 0x51, 0x27, 0x08, 0x50, 0x14, 0x2d, 0x60, 0xcb, 0x54, 0xcc, 0x9a,
+	0x16, 0x15, 0x92, 0x29, 0x90, 0xa0, 0xe8, 0x82, 0x22, 0xd7, 0xf4, 0x22, 0x14, 0xa9, 0x92, 0x54,
+	0x11, 0xdf, 0xfa, 0x0f, 0xda, 0x4b, 0xff, 0x43, 0x7f, 0x44, 0x7f, 0x40, 0x8e, 0x39, 0xf6, 0x54,
+	0x14, 0xc9, 0xad, 0x87, 0xde, 0x7a, 0x2f, 0x76, 0x97, 0x92, 0x25, 0xc5, 0x4e, 0x51, 0xa4, 0x87,
+	0xde, 0xb8, 0xdf, 0x7c, 0xf3, 0x71, 0x66, 0x76, 0x66, 0x77, 0x61, 0x2b, 0x38, 0x0b, 0x5e, 0x8c,
+	0xd3, 0x24, 0x4f, 0xfc, 0x24, 0xda, 0xf1, 0xa3, 0x64, 0xb8, 0x93, 0xa4, 0x01, 0x49, 0x3b, 0x1c,
+	0x43, 0x6b, 0xf3, 0xe6, 0x0e, 0x33, 0x6f, 0x5c, 0x0f, 0x93, 0x30, 0xe1, 0xd0, 0x0e, 0xfb, 0x12,
+	0xc4, 0x8d, 0xff, 0x2d, 0xe8, 0x64, 0x93, 0xa1, 0xe7, 0xfb, 0xc9, 0x24, 0xce, 0xb3, 0xb9, 0x6f,
+	0x41, 0xd5, 0x7e, 0x94, 0x60, 0xc5, 0x62, 0xff, 0x30, 0x02, 0xf4, 0x18, 0xe4, 0x73, 0x3b, 0xa6,
+	0x81, 0x2a, 0xb5, 0xa5, 0xed, 0xfa, 0xee, 0xbd, 0xce, 0xc2, 0x7f, 0xe7, 0xe4, 0x3a, 0xce, 0xec,
+	0xdb, 0x08, 0xf6, 0xab, 0x2f, 0x7f, 0xbe, 0x53, 0xb2, 0x1b, 0xd9, 0x1c, 0x86, 0x36, 0x61, 0xd5,
+	0x8f, 0x28, 0x11, 0x72, 0xe5, 0xb6, 0xb4, 0xbd, 0x62, 0xd7, 0x04, 0x60, 0x04, 0xe8, 0x0e, 0xd4,
+	0x79, 0x7a, 0xf8, 0x24, 0xf2, 0xc2, 0x4c, 0xad, 0xb4, 0xa5, 0x6d, 0xd9, 0x06, 0x0e, 0xf5, 0x18,
+	0x82, 0xda, 0xd0, 0x60, 0x59, 0xe2, 0xb1, 0x47, 0x53, 0x26, 0x50, 0x15, 0x0c, 0x86, 0x0d, 0x3c,
+	0x9a, 0x1a, 0x81, 0xf6, 0x25, 0x6c, 0xf1, 0xe8, 0xb3, 0x1e, 0x8d, 0x22, 0x12, 0x1c, 0x4c, 0x52,
+	0x1a, 0x87, 0xa6, 0x97, 0x93, 0x2c, 0xdf, 0x8f, 0x12, 0xff, 0x39, 0xfa, 0x04, 0x56, 0xc5, 0x3f,
+	0x68, 0x90, 0xa9, 0x52, 0xbb, 0xb2, 0x5d, 0xdf, 0xdd, 0xe8, 0xbc, 0x55, 0xc7, 0x4e, 0x51, 0x82,
+	0x22, 0x87, 0x5a, 0x22, 0x96, 0x99, 0xf6, 0x0c, 0x6e, 0x0d, 0x92, 0x9c, 0xc4, 0x39, 0xf5, 0xa2,
+	0xe8, 0x6c, 0x90, 0x4e, 0x62, 0x6f, 0x18, 0x11, 0xf1, 0xcb, 0xf7, 0xd5, 0xfe, 0x5e, 0x82, 0x26,
+	0xb7, 0xb1, 0xd8, 0x9d, 0xdc, 0xcb, 0x09, 0xab, 0xc8, 0x09, 0x8d, 0x22, 0xec, 0x8d, 0x58, 0xfd,
+	0x78, 0xfd, 0xab, 0x36, 0x30, 0x68, 0x8f, 0x23, 0x68, 0x17, 0x6e, 0x8c, 0x8b, 0x20, 0xf0, 0x90,
+	0x25, 0x88, 0x4f, 0x09, 0x0d, 0x4f, 0x73, 0x5e, 0x5b, 0xd9, 0x5e, 0x9f, 0x1a, 0x79, 0xf2, 0x87,
+	0xdc, 0x84, 0x3a, 0xb0, 0xbe, 0xe4, 0x93, 0xd3, 0x11, 0x29, 0xca, 0xbd, 0xb6, 0xe0, 0xe1, 0xd2,
+	0x11, 0xd1, 0xbe, 0x80, 0x4d, 0x1e, 0xcd, 0xc9, 0x24, 0xe2, 0xe1, 0x31, 0xd0, 0x89, 0xa8, 0x4f,
+	0x3e, 0xf7, 0xa2, 0x09, 0x79, 0xdf, 0xac, 0x7f, 0x95, 0xe0, 0xa6, 0x99, 0xc4, 0xa1, 0x4b, 0xd2,
+	0x11, 0xe7, 0x0c, 0x22, 0xcf, 0x27, 0x23, 0x12, 0xe7, 0xe8, 0x01, 0x5c, 0xe1, 0xb4, 0xa2, 0xef,
+	0xd4, 0xcb, 0x54, 0x0b, 0x4d, 0x41, 0x46, 0x4f, 0xe0, 0xda, 0x78, 0x2a, 0x81, 0x69, 0x1c, 0x90,
+	0x17, 0x6a, 0xf9, 0xa2, 0xbe, 0xe5, 0xfe, 0x6e, 0xea, 0xc5, 0x99, 0xe7, 0xe7, 0x34, 0x89, 0xb9,
+	0x14, 0x8d, 0xc3, 0x42, 0xad, 0x39, 0x13, 0x31, 0x98, 0x06, 0xea, 0x42, 0x2b, 0x4f, 0x3d, 0x1a,
+	0xd1, 0x38, 0xc4, 0x59, 0x9e, 0x8c, 0x71, 0x9e, 0xd2, 0x30, 0x24, 0x29, 0xce, 0x26, 0xc3, 0x9c,
+	0xfa, 0xcf, 0x45, 0xbf, 0x56, 0xed, 0xcd, 0x29, 0xcb, 0xc9, 0x93, 0xb1, 0x2b, 0x38, 0x4e, 0x41,
+	0xd1, 0x7e, 0x97, 0xe0, 0x56, 0x37, 0x89, 0x03, 0xca, 0x7e, 0xe8, 0x45, 0xff, 0xe4, 0x7c, 0x8f,
+	0x40, 0x9e, 0x66, 0x28, 0x44, 0x2b, 0x7f, 0x45, 0xd4, 0x6e, 0x14, 0xce, 0x5c, 0x4c, 0xfb, 0xad,
+	0x06, 0x57, 0xb8, 0x09, 0x7d, 0x0c, 0xb5, 0x69, 0xb7, 0x14, 0x69, 0xfe, 0x79, 0xb3, 0xac, 0x14,
+	0xcd, 0x82, 0x3e, 0x84, 0x6a, 0x46, 0x03, 0xc2, 0xf3, 0x6b, 0xee, 0x6e, 0x5d, 0xe6, 0xd8, 0x71,
+	0x68, 0x40, 0x6c, 0x4e, 0x45, 0x1b, 0x50, 0xfb, 0x6a, 0xe2, 0xc5, 0xf9, 0x64, 0x34, 0xdd, 0xa0,
+	0xd9, 0x9a, 0xd9, 0x66, 0x9b, 0x57, 0x15, 0xb6, 0xe9, 0x1a, 0xdd, 0x83, 0x66, 0x98, 0x24, 0x01,
+	0xce, 0x69, 0x24, 0x86, 0x44, 0xbd, 0xc2, 0xe6, 0xe3, 0xb0, 0x64, 0x37, 0x18, 0xee, 0xd2, 0x48,
+	0x9c, 0x27, 0x3b, 0xb0, 0xbe, 0xc8, 0x13, 0xc3, 0x74, 0x95, 0x1d, 0x6d, 0x87, 0x25, 0x5b, 0x99,
+	0x27, 0xb3, 0xc1, 0x41, 0x87, 0x20, 0x33, 0x06, 0xa6, 0x31, 0x3e, 0x49, 0x52, 0x9f, 0xa8, 0x2b,
+	0x3c, 0x99, 0xff, 0x5c, 0x9a, 0x0c, 0xf3, 0x32, 0xe2, 0x1e, 0xe3, 0xda, 0xf5, 0xfc, 0x7c, 0xc1,
+	0x0e, 0x87, 0x94, 0x04, 0x13, 0x9f, 0xe0, 0x24, 0x8e, 0xce, 0xd4, 0x5a, 0x5b, 0xda, 0xae, 0xd9,
+	0x20, 0x20, 0x2b, 0x8e, 0xce, 0xd0, 0x7f, 0xe1, 0x5a, 0x71, 0xd8, 0x8e, 0x48, 0xee, 0x05, 0x5e,
+	0xee, 0xa9, 0xab, 0x7c, 0xc8, 0x9b, 0x02, 0x3e, 0x2e, 0x50, 0x74, 0x0c, 0x4d, 0x7f, 0xda, 0x95,
+	0x38, 0x3f, 0x1b, 0x13, 0x15, 0x78, 0x50, 0xf7, 0x2e, 0x0d, 0x6a, 0xd6, 0xc4, 0xee, 0xd9, 0x98,
+	0xd8, 0xb2, 0x3f, 0xbf, 0x44, 0x47, 0xa0, 0xf9, 0xe7, 0x4d, 0x8e, 0xc5, 0x7e, 0xbf, 0x35, 0x2e,
+	0x75, 0x5e, 0xf1, 0x3b, 0xfe, 0xd2, 0x38, 0x2c, 0x8d, 0x0c, 0x9b, 0xbb, 0x8b, 0xc4, 0x8a, 0x49,
+	0x1c, 0x8f, 0x47, 0x6a, 0x83, 0xe7, 0xb4, 0xf9, 0xb6, 0x90, 0xe0, 0x0c, 0xc6, 0x23, 0x74, 0x0c,
+	0xff, 0x7e, 0x87, 0xc8, 0x2c, 0x24, 0x99, 0x87, 0xd4, 0xbe, 0x4c, 0x69, 0x16, 0xd3, 0xa7, 0xd3,
+	0x8b, 0x2a, 0x4c, 0x93, 0xc9, 0x58, 0x6d, 0xf2, 0x3e, 0xbe, 0xb4, 0x1d, 0x1f, 0x31, 0x52, 0x71,
+	0x8f, 0xf1, 0x6f, 0xed, 0x23, 0xa8, 0xb2, 0x16, 0x45, 0xd7, 0x41, 0x71, 0x8c, 0x03, 0x1d, 0x3f,
+	0xe9, 0x3b, 0x03, 0xbd, 0x6b, 0xf4, 0x0c, 0xfd, 0x40, 0x29, 0xa1, 0x06, 0xd4, 0x38, 0xba, 0xff,
+	0xe4, 0xa9, 0x22, 0x21, 0x19, 0x56, 0xf9, 0xca, 0xd1, 0x4d, 0x53, 0x29, 0x6b, 0xdf, 0x48, 0x50,
+	0x9f, 0xeb, 0x08, 0xb4, 0x05, 0xb7, 0x5c, 0xe3, 0x58, 0xc7, 0x46, 0x1f, 0xf7, 0x2c, 0xbb, 0xbb,
+	0xac, 0x75, 0x03, 0xd6, 0x16, 0xcd, 0x86, 0xd5, 0x55, 0x24, 0xb4, 0x09, 0xff, 0x5a, 0x84, 0x07,
+	0x96, 0xe3, 0x62, 0xab, 0x6f, 0x3e, 0x55, 0xca, 0xa8, 0x05, 0x1b, 0x8b, 0xc6, 0x9e, 0x61, 0x9a,
+	0xd8, 0xb2, 0xf1, 0x91, 0x61, 0x9a, 0x4a, 0x45, 0xfb, 0x56, 0x02, 0x79, 0x61, 0xff, 0x99, 0x47,
+	0xd7, 0xea, 0x1f, 0x18, 0xae, 0x61, 0xf5, 0xb1, 0xfb, 0x74, 0xb0, 0x1c, 0xc5, 0x6d, 0x50, 0x97,
+	0xec, 0x8e, 0x6b, 0x0d, 0xb0, 0x69, 0x39, 0x8e, 0x22, 0x5d, 0xe0, 0xed, 0xee, 0x1d, 0xe9, 0x78,
+	0x60, 0x5b, 0x3d, 0xc3, 0x55, 0xca, 0xa8, 0x0d, 0xb7, 0x97, 0xed, 0xf6, 0x9e, 0x61, 0x1a, 0xfd,
+	0x47, 0x5c, 0x46, 0xa9, 0xec, 0x2b, 0x73, 0xc3, 0x9a, 0xc4, 0x24, 0x39, 0xd1, 0x7e, 0x28, 0x03,
+	0x9c, 0x17, 0x1f, 0x35, 0xa1, 0x5c, 0x9c, 0x37, 0xb2, 0x5d, 0xa6, 0x01, 0x7a, 0x08, 0x55, 0xde,
+	0xe6, 0xe2, 0x20, 0xd1, 0xde, 0xb9, 0x73, 0x1d, 0xde, 0xe2, 0x9c, 0x8f, 0xee, 0x43, 0x25, 0x22,
+	0x21, 0x3f, 0x48, 0x9a, 0xbb, 0x77, 0xdf, 0xed, 0x66, 0x92, 0xd0, 0x66, 0x6c, 0xed, 0x33, 0xa8,
+	0xf2, 0x2a, 0x5d, 0x07, 0xe5, 0x82, 0xda, 0x6c, 0xc0, 0x4d, 0x8e, 0x5a, 0x7d, 0x1d, 0x77, 0xf7,
+	0xfa, 0x5d, 0xdd, 0x74, 0xb0, 0xe5, 0x1e, 0xea, 0xb6, 0x22, 0x21, 0x05, 0x1a, 0xdc, 0xb6, 0x6f,
+	0xef, 0x75, 0x8f, 0x74, 0x57, 0x29, 0x6b, 0x8f, 0xa1, 0x62, 0x92, 0x10, 0xad, 0xc3, 0x35, 0x53,
+	0x7f, 0xb4, 0xa4, 0x24, 0xc3, 0x2a, 0x03, 0xf5, 0xbe, 0x6b, 0xb3, 0xc6, 0x29, 0x38, 0x8b, 0xb5,
+	0x5c, 0x03, 0xd9, 0xd4, 0x45, 0xdd, 0x44, 0xf9, 0x2b, 0x9a, 0x0d, 0x6b, 0xe7, 0x51, 0x1f, 0x93,
+	0xd1, 0xf0, 0x6f, 0x78, 0xca, 0x10, 0x58, 0xbf, 0xe0, 0x52, 0x40, 0x77, 0xa1, 0xb1, 0xf0, 0x48,
+	0x11, 0x1b, 0x52, 0x1f, 0xce, 0x3d, 0x4e, 0xfe, 0x0f, 0x6b, 0xf9, 0xb9, 0xe7, 0xdc, 0x7d, 0x26,
+	0xdb, 0xca, 0x9c, 0x81, 0x5f, 0x2b, 0xfb, 0x83, 0x97, 0xaf, 0x5b, 0xd2, 0xab, 0xd7, 0x2d, 0xe9,
+	0x97, 0xd7, 0x2d, 0xe9, 0xbb, 0x37, 0xad, 0xd2, 0xab, 0x37, 0xad, 0xd2, 0x4f, 0x6f, 0x5a, 0xa5,
+	0x67, 0x0f, 0x43, 0x9a, 0x9f, 0x4e, 0x86, 0x1d, 0x3f, 0x19, 0xed, 0x2c, 0x3c, 0x7e, 0xbf, 0x7e,
+	0xf0, 0x81, 0x7f, 0xea, 0xd1, 0x78, 0x67, 0x86, 0xbc, 0x10, 0x0f, 0x6b, 0xb6, 0xbf, 0xd9, 0xf0,
+	0x2a, 0x87, 0xef, 0xff, 0x31, 0x00, 0x07, 0x6c, 0x41, 0x8a, 0x7a, 0x0b, 0x00, 0x00,
 }
 
 func (m *OrderId) Marshal() (dAtA []byte, err error) {
@@ -1034,6 +1309,11 @@ func (m *OrderFillState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PrunableBlockTime != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.PrunableBlockTime))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.PrunableBlockHeight != 0 {
 		i = encodeVarintOrder(dAtA, i, uint64(m.PrunableBlockHeight))
 		i--
@@ -1104,6 +1384,11 @@ func (m *LongTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.TrailingStopTriggerSubticks != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TrailingStopTriggerSubticks))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.PlacementIndex.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1202,6 +1487,28 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OrderGroup != nil {
+		{
+			size, err := m.OrderGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ConditionalOrderTrailingSubticks != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ConditionalOrderTrailingSubticks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ConditionalOrderTrailingPpm != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ConditionalOrderTrailingPpm))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		i = encodeVarintOrder(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
@@ -1294,6 +1601,81 @@ func (m *Order_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x35
 	return len(dAtA) - i, nil
 }
+func (m *OrderGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Leg != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Leg))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderGroupMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderGroupMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		for iNdEx := len(m.OrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TransactionOrdering) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1400,6 +1782,9 @@ func (m *OrderFillState) Size() (n int) {
 	if m.PrunableBlockHeight != 0 {
 		n += 1 + sovOrder(uint64(m.PrunableBlockHeight))
 	}
+	if m.PrunableBlockTime != 0 {
+		n += 1 + sovOrder(uint64(m.PrunableBlockTime))
+	}
 	return n
 }
 
@@ -1428,6 +1813,9 @@ func (m *LongTermOrderPlacement) Size() (n int) {
 	n += 1 + l + sovOrder(uint64(l))
 	l = m.PlacementIndex.Size()
 	n += 1 + l + sovOrder(uint64(l))
+	if m.TrailingStopTriggerSubticks != 0 {
+		n += 1 + sovOrder(uint64(m.TrailingStopTriggerSubticks))
+	}
 	return n
 }
 
@@ -1483,6 +1871,16 @@ func (m *Order) Size() (n int) {
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		n += 1 + sovOrder(uint64(m.ConditionalOrderTriggerSubticks))
 	}
+	if m.ConditionalOrderTrailingPpm != 0 {
+		n += 1 + sovOrder(uint64(m.ConditionalOrderTrailingPpm))
+	}
+	if m.ConditionalOrderTrailingSubticks != 0 {
+		n += 1 + sovOrder(uint64(m.ConditionalOrderTrailingSubticks))
+	}
+	if m.OrderGroup != nil {
+		l = m.OrderGroup.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
 	return n
 }
 
@@ -1504,6 +1902,39 @@ func (m *Order_GoodTilBlockTime) Size() (n int) {
 	n += 5
 	return n
 }
+func (m *OrderGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrder(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	if m.Leg != 0 {
+		n += 1 + sovOrder(uint64(m.Leg))
+	}
+	return n
+}
+
+func (m *OrderGroupMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		for _, e := range m.OrderIds {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TransactionOrdering) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1891,6 +2322,25 @@ func (m *OrderFillState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunableBlockTime", wireType)
+			}
+			m.PrunableBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunableBlockTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -2091,6 +2541,25 @@ func (m *LongTermOrderPlacement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingStopTriggerSubticks", wireType)
+			}
+			m.TrailingStopTriggerSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingStopTriggerSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -2510,6 +2979,271 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTrailingPpm", wireType)
+			}
+			m.ConditionalOrderTrailingPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderTrailingPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTrailingSubticks", wireType)
+			}
+			m.ConditionalOrderTrailingSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderTrailingSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderGroup == nil {
+				m.OrderGroup = &OrderGroup{}
+			}
+			if err := m.OrderGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderGroup_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leg", wireType)
+			}
+			m.Leg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Leg |= OrderGroup_Leg(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderGroupMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderGroupMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderGroupMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderIds = append(m.OrderIds, OrderId{})
+			if err := m.OrderIds[len(m.OrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -0,0 +1,75 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// OrderGroupKey returns the key of the order group with ID `orderGroupId` of a subaccount in state.
+func OrderGroupKey(subaccountId satypes.SubaccountId, orderGroupId uint32) []byte {
+	return append(subaccountId.ToStateKey(), lib.Uint32ToKey(orderGroupId)...)
+}
+
+// MaxSize returns the maximum number of orders in an order group of this type.
+func (t OrderGroup_Type) MaxSize() int {
+	switch t {
+	case OrderGroup_TYPE_ONE_CANCELS_OTHER:
+		return 2
+	case OrderGroup_TYPE_BRACKET:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// IsBracketEntry returns true if this is the entry order of a bracket group.
+func (g *OrderGroup) IsBracketEntry() bool {
+	return g.Type == OrderGroup_TYPE_BRACKET && g.Leg == OrderGroup_LEG_ENTRY
+}
+
+// Validate performs stateless validation of the order group of `order`. It returns an error if:
+//   - The order is not a stateful order.
+//   - The order group type is invalid.
+//   - The leg is specified for a one-cancels-other group.
+//   - The leg is invalid for a bracket group, or the order's condition type does not match its leg.
+func (g *OrderGroup) Validate(order Order) error {
+	if !order.IsStatefulOrder() {
+		return errorsmod.Wrapf(ErrInvalidOrderGroup, "only stateful orders can be part of an order group")
+	}
+
+	switch g.Type {
+	case OrderGroup_TYPE_ONE_CANCELS_OTHER:
+		if g.Leg != OrderGroup_LEG_UNSPECIFIED {
+			return errorsmod.Wrapf(
+				ErrInvalidOrderGroup,
+				"leg %v specified for one-cancels-other order group",
+				g.Leg,
+			)
+		}
+	case OrderGroup_TYPE_BRACKET:
+		switch g.Leg {
+		case OrderGroup_LEG_ENTRY:
+		case OrderGroup_LEG_TAKE_PROFIT:
+			if !order.IsTakeProfitOrder() {
+				return errorsmod.Wrapf(
+					ErrInvalidOrderGroup,
+					"take profit leg of bracket order group must be a take profit order",
+				)
+			}
+		case OrderGroup_LEG_STOP_LOSS:
+			if !order.IsStopLossOrder() && !order.IsTrailingStopOrder() {
+				return errorsmod.Wrapf(
+					ErrInvalidOrderGroup,
+					"stop loss leg of bracket order group must be a stop loss or trailing stop order",
+				)
+			}
+		default:
+			return errorsmod.Wrapf(ErrInvalidOrderGroup, "invalid bracket order group leg %v", g.Leg)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidOrderGroup, "invalid order group type %v", g.Type)
+	}
+
+	return nil
+}
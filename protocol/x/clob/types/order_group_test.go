@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderGroupTypeMaxSize(t *testing.T) {
+	require.Equal(t, 0, types.OrderGroup_TYPE_UNSPECIFIED.MaxSize())
+	require.Equal(t, 2, types.OrderGroup_TYPE_ONE_CANCELS_OTHER.MaxSize())
+	require.Equal(t, 3, types.OrderGroup_TYPE_BRACKET.MaxSize())
+}
+
+func TestOrderGroupValidate(t *testing.T) {
+	tests := map[string]struct {
+		order         types.Order
+		orderGroup    types.OrderGroup
+		expectedError string
+	}{
+		"Valid one-cancels-other order": {
+			order: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+			},
+		},
+		"Valid bracket entry order": {
+			order: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_BRACKET,
+				Leg:  types.OrderGroup_LEG_ENTRY,
+			},
+		},
+		"Valid bracket take profit order": {
+			order: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TakeProfit20,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_BRACKET,
+				Leg:  types.OrderGroup_LEG_TAKE_PROFIT,
+			},
+		},
+		"Valid bracket stop loss order": {
+			order: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_BRACKET,
+				Leg:  types.OrderGroup_LEG_STOP_LOSS,
+			},
+		},
+		"Valid bracket trailing stop order": {
+			order: constants.ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49000_Trail1000,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_BRACKET,
+				Leg:  types.OrderGroup_LEG_STOP_LOSS,
+			},
+		},
+		"Short-term order": {
+			order: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+			},
+			expectedError: "only stateful orders can be part of an order group",
+		},
+		"Unspecified type": {
+			order:         constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			orderGroup:    types.OrderGroup{},
+			expectedError: "invalid order group type",
+		},
+		"One-cancels-other order with leg": {
+			order: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_ONE_CANCELS_OTHER,
+				Leg:  types.OrderGroup_LEG_STOP_LOSS,
+			},
+			expectedError: "specified for one-cancels-other order group",
+		},
+		"Bracket order with unspecified leg": {
+			order: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_BRACKET,
+			},
+			expectedError: "invalid bracket order group leg",
+		},
+		"Bracket take profit leg with stop loss order": {
+			order: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_BRACKET,
+				Leg:  types.OrderGroup_LEG_TAKE_PROFIT,
+			},
+			expectedError: "take profit leg of bracket order group must be a take profit order",
+		},
+		"Bracket stop loss leg with long-term order": {
+			order: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			orderGroup: types.OrderGroup{
+				Type: types.OrderGroup_TYPE_BRACKET,
+				Leg:  types.OrderGroup_LEG_STOP_LOSS,
+			},
+			expectedError: "stop loss leg of bracket order group must be a stop loss or trailing stop order",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.orderGroup.Validate(tc.order)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidOrderGroup)
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
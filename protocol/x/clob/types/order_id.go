@@ -8,6 +8,7 @@ import (
 
 	gometrics "github.com/armon/go-metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 const (
@@ -108,6 +109,14 @@ func (o *OrderId) ToStateKey() []byte {
 	return b
 }
 
+// OrderIdKeyPrefixForSubaccount returns the prefix shared by the state keys of all order IDs of a
+// subaccount. Since the `SubaccountId` is the first field of an `OrderId` and is length-prefixed when
+// marshaled, the prefix does not match the order IDs of any other subaccount.
+func OrderIdKeyPrefixForSubaccount(subaccountId satypes.SubaccountId) []byte {
+	orderId := OrderId{SubaccountId: subaccountId}
+	return orderId.ToStateKey()
+}
+
 // SortedOrders is type alias for `*OrderId` which supports deterministic
 // sorting. Orders are first ordered by string comparison
 // of their `Subaccount` owner, followed by integer comparison of their
@@ -203,6 +203,26 @@ func TestOrder_MustCmpReplacementOrder(t *testing.T) {
 			y: types.Order{GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 2}},
 			r: 0,
 		},
+		"Short-Term GTBT 1": {
+			x: types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 2}},
+			y: types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 1}},
+			r: 1,
+		},
+		"Short-Term GTBT -1": {
+			x: types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 1}},
+			y: types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 2}},
+			r: -1,
+		},
+		"Short-Term GTBT Hash 1": {
+			x: types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 1}, Subticks: 1},
+			y: types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 1}, Subticks: 2},
+			r: 1,
+		},
+		"Short-Term GTBT Equal": {
+			x: types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 2}},
+			y: types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 2}},
+			r: 0,
+		},
 		"Long-Term GTBT 1": {
 			x: types.Order{
 				OrderId:      types.OrderId{OrderFlags: types.OrderIdFlags_LongTerm},
@@ -347,6 +367,61 @@ func TestOrder_MustCmpReplacementOrder_PanicsWithNonReplacementOrder(t *testing.
 	)
 }
 
+func TestOrder_MustCmpReplacementOrder_PanicsWithDifferentExpirationTypes(t *testing.T) {
+	order1 := types.Order{GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 1}}
+	order2 := types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 1}}
+
+	require.PanicsWithValue(
+		t,
+		fmt.Sprintf(
+			"MustCmpReplacementOrder: order (%v) and order (%v) have different expiration types",
+			&order1,
+			&order2,
+		),
+		func() {
+			order1.MustCmpReplacementOrder(&order2)
+		},
+	)
+}
+
+func TestOrder_IsGoodTilBlockTimeShortTermOrder(t *testing.T) {
+	tests := map[string]struct {
+		order    types.Order
+		expected bool
+	}{
+		"Short-Term GTB": {
+			order:    types.Order{GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 1}},
+			expected: false,
+		},
+		"Short-Term GTBT": {
+			order:    types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 1}},
+			expected: true,
+		},
+		"Long-Term GTBT": {
+			order: types.Order{
+				OrderId:      types.OrderId{OrderFlags: types.OrderIdFlags_LongTerm},
+				GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 1},
+			},
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.order.IsGoodTilBlockTimeShortTermOrder())
+		})
+	}
+}
+
+func TestOrder_HasSameExpirationType(t *testing.T) {
+	gtbOrder := types.Order{GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 1}}
+	gtbtOrder := types.Order{GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 1}}
+
+	require.True(t, gtbOrder.HasSameExpirationType(&gtbOrder))
+	require.True(t, gtbtOrder.HasSameExpirationType(&gtbtOrder))
+	require.False(t, gtbOrder.HasSameExpirationType(&gtbtOrder))
+	require.False(t, gtbtOrder.HasSameExpirationType(&gtbOrder))
+}
+
 func TestOrder_GetSubaccountId(t *testing.T) {
 	expectedSubaccountId := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId.SubaccountId
 	order := types.Order{
@@ -524,3 +599,100 @@ func TestOrder_GetClientMetadata(t *testing.T) {
 	clientMetadata := order.GetClientMetadata()
 	require.Equal(t, uint32(100), clientMetadata)
 }
+
+func TestOrder_CanTrigger_TrailingStop(t *testing.T) {
+	sell := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5
+	require.False(t, sell.CanTrigger(21))
+	require.True(t, sell.CanTrigger(20))
+	require.True(t, sell.CanTrigger(19))
+
+	buy := constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price30_GTBT15_TrailingStop20_Trail10Percent
+	require.False(t, buy.CanTrigger(19))
+	require.True(t, buy.CanTrigger(20))
+	require.True(t, buy.CanTrigger(21))
+}
+
+func TestOrder_GetUpdatedTrailingStopTriggerSubticks(t *testing.T) {
+	tests := map[string]struct {
+		order                  types.Order
+		oraclePriceSubticksRat *big.Rat
+		subticksPerTick        types.SubticksPerTick
+
+		expectedTriggerSubticks types.Subticks
+		expectedUpdated         bool
+	}{
+		"Sell trigger price moves up with the oracle price": {
+			order:                  constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5,
+			oraclePriceSubticksRat: big.NewRat(30, 1),
+			subticksPerTick:        1,
+
+			expectedTriggerSubticks: 25,
+			expectedUpdated:         true,
+		},
+		"Sell trigger price is rounded down to a multiple of subticks per tick": {
+			order:                  constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5,
+			oraclePriceSubticksRat: big.NewRat(61, 2),
+			subticksPerTick:        5,
+
+			expectedTriggerSubticks: 25,
+			expectedUpdated:         true,
+		},
+		"Sell trigger price does not move down with the oracle price": {
+			order:                  constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5,
+			oraclePriceSubticksRat: big.NewRat(24, 1),
+			subticksPerTick:        1,
+
+			expectedTriggerSubticks: 20,
+			expectedUpdated:         false,
+		},
+		"Sell trigger price is not updated if the trailing distance exceeds the oracle price": {
+			order:                  constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Trail5,
+			oraclePriceSubticksRat: big.NewRat(4, 1),
+			subticksPerTick:        1,
+
+			expectedTriggerSubticks: 20,
+			expectedUpdated:         false,
+		},
+		"Buy trigger price moves down with the oracle price": {
+			order:                  constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price30_GTBT15_TrailingStop20_Trail10Percent,
+			oraclePriceSubticksRat: big.NewRat(10, 1),
+			subticksPerTick:        1,
+
+			expectedTriggerSubticks: 11,
+			expectedUpdated:         true,
+		},
+		"Buy trigger price is rounded up to a multiple of subticks per tick": {
+			order:                  constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price30_GTBT15_TrailingStop20_Trail10Percent,
+			oraclePriceSubticksRat: big.NewRat(10, 1),
+			subticksPerTick:        4,
+
+			expectedTriggerSubticks: 12,
+			expectedUpdated:         true,
+		},
+		"Buy trigger price does not move up with the oracle price": {
+			order:                  constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy5_Price30_GTBT15_TrailingStop20_Trail10Percent,
+			oraclePriceSubticksRat: big.NewRat(20, 1),
+			subticksPerTick:        1,
+
+			expectedTriggerSubticks: 20,
+			expectedUpdated:         false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			triggerSubticks, updated := tc.order.GetUpdatedTrailingStopTriggerSubticks(
+				tc.oraclePriceSubticksRat,
+				tc.subticksPerTick,
+			)
+			require.Equal(t, tc.expectedTriggerSubticks, triggerSubticks)
+			require.Equal(t, tc.expectedUpdated, updated)
+		})
+	}
+}
+
+func TestOrder_GetUpdatedTrailingStopTriggerSubticks_PanicsWithNonTrailingStopOrder(t *testing.T) {
+	order := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss20
+	require.Panics(t, func() {
+		order.GetUpdatedTrailingStopTriggerSubticks(big.NewRat(30, 1), 1)
+	})
+}
@@ -234,15 +234,22 @@ type MatchableOrder interface {
 	// MustGetOrder returns the underlying order if this is not a liquidation order. Panics if called
 	// for a liquidation order.
 	MustGetOrder() Order
-	// MustGetLiquidatedPerpetualId returns the perpetual ID if this is a liquidation order. Panics
-	// if called for a non-liquidation order.
+	// MustGetLiquidatedPerpetualId returns the perpetual ID if this is a perpetual liquidation order.
+	// Panics if called for a non-liquidation order or an asset liquidation order.
 	MustGetLiquidatedPerpetualId() uint32
+	// IsAssetLiquidation returns true if this is a liquidation order of a borrowed asset position,
+	// false if not.
+	IsAssetLiquidation() bool
+	// MustGetLiquidatedAssetId returns the asset ID if this is an asset liquidation order. Panics
+	// if called for any other order.
+	MustGetLiquidatedAssetId() uint32
 	// GetBaseQuantums returns the base quantums of this order.
 	GetBaseQuantums() satypes.BaseQuantums
 	// GetOrderSubticks returns the subticks of this order.
 	GetOrderSubticks() Subticks
 	// GetOrderHash returns the hash of this order.
-	// If this is a liquidation it returns the hash of the `PerpetualLiquidationInfo`.
+	// If this is a liquidation it returns the hash of the `PerpetualLiquidationInfo`, or of the
+	// `AssetLiquidationInfo` for asset liquidations.
 	// Else, it returns the hash of the `Order` proto.
 	GetOrderHash() OrderHash
 	// IsReduceOnly returns whether this is a reduce-only order.
@@ -40,14 +40,24 @@ func (p *PendingUpdates) ConvertToUpdates() []satypes.Update {
 	// subaccounts module.
 	var updates = make([]satypes.Update, 0, len(allSubaccounts))
 	for _, subaccountId := range allSubaccounts {
+		pendingAssetUpdates := p.subaccountAssetUpdates[subaccountId]
+		if _, exists := pendingAssetUpdates[assettypes.AssetUsdc.Id]; !exists {
+			pendingAssetUpdates[assettypes.AssetUsdc.Id] = new(big.Int)
+		}
+
+		// Subtract quote balance delta with total fees paid by subaccount.
+		pendingAssetUpdates[assettypes.AssetUsdc.Id].Sub(
+			pendingAssetUpdates[assettypes.AssetUsdc.Id],
+			p.subaccountFee[subaccountId],
+		)
+
 		// Create an empty slice to store the asset updates for this subaccount.
 		assetUpdates := make(
 			[]satypes.AssetUpdate,
 			0,
-			len(p.subaccountAssetUpdates[subaccountId]),
+			len(pendingAssetUpdates),
 		)
 
-		pendingAssetUpdates := p.subaccountAssetUpdates[subaccountId]
 		for assetId, bigQuantumsDelta := range pendingAssetUpdates {
 			assetUpdate := satypes.AssetUpdate{
 				AssetId:          assetId,
@@ -56,21 +66,10 @@ func (p *PendingUpdates) ConvertToUpdates() []satypes.Update {
 			assetUpdates = append(assetUpdates, assetUpdate)
 		}
 
-		if _, exists := pendingAssetUpdates[assettypes.AssetUsdc.Id]; !exists {
-			pendingAssetUpdates[assettypes.AssetUsdc.Id] = new(big.Int)
-		}
-
-		// Subtract quote balance delta with total fees paid by subaccount.
-		pendingAssetUpdates[assettypes.AssetUsdc.Id].Sub(
-			pendingAssetUpdates[assettypes.AssetUsdc.Id],
-			p.subaccountFee[subaccountId],
-		)
-
-		// Panic if there is more than one asset updates since we only support
-		// USDC asset at the moment.
-		if len(assetUpdates) > 1 {
-			panic(ErrAssetUpdateNotImplemented)
-		}
+		// Sort the assetIds in ascending order for determinism.
+		sort.Slice(assetUpdates, func(i, j int) bool {
+			return assetUpdates[i].AssetId < assetUpdates[j].AssetId
+		})
 
 		// Create an empty slice to store the perpetual updates for this subaccount.
 		perpetualUpdates := make(
@@ -177,3 +176,51 @@ func (p *PendingUpdates) AddPerpetualFill(
 	)
 	p.subaccountFee[subaccountId] = totalFee
 }
+
+// AddAssetFill adds a new spot fill to the PendingUpdate object, by
+// updating quoteBalanceDelta, the base asset balance and fees paid or received by a subaccount.
+func (p *PendingUpdates) AddAssetFill(
+	subaccountId satypes.SubaccountId,
+	baseAssetId uint32,
+	isBuy bool,
+	feePpm int32,
+	bigFillBaseQuantums *big.Int,
+	bigFillQuoteQuantums *big.Int,
+) {
+	subaccountAssetUpdates, exists := p.subaccountAssetUpdates[subaccountId]
+	if !exists {
+		subaccountAssetUpdates = make(map[uint32]*big.Int)
+		p.subaccountAssetUpdates[subaccountId] = subaccountAssetUpdates
+	}
+
+	quoteBalanceUpdate, exists := subaccountAssetUpdates[assettypes.AssetUsdc.Id]
+	if !exists {
+		quoteBalanceUpdate = big.NewInt(0)
+		subaccountAssetUpdates[assettypes.AssetUsdc.Id] = quoteBalanceUpdate
+	}
+
+	baseBalanceUpdate, exists := subaccountAssetUpdates[baseAssetId]
+	if !exists {
+		baseBalanceUpdate = big.NewInt(0)
+		subaccountAssetUpdates[baseAssetId] = baseBalanceUpdate
+	}
+
+	if isBuy {
+		quoteBalanceUpdate.Sub(quoteBalanceUpdate, bigFillQuoteQuantums)
+		baseBalanceUpdate.Add(baseBalanceUpdate, bigFillBaseQuantums)
+	} else {
+		quoteBalanceUpdate.Add(quoteBalanceUpdate, bigFillQuoteQuantums)
+		baseBalanceUpdate.Sub(baseBalanceUpdate, bigFillBaseQuantums)
+	}
+
+	totalFee, exists := p.subaccountFee[subaccountId]
+	if !exists {
+		totalFee = big.NewInt(0)
+	}
+
+	totalFee.Add(
+		totalFee,
+		lib.BigIntMulSignedPpm(bigFillQuoteQuantums, feePpm, true),
+	)
+	p.subaccountFee[subaccountId] = totalFee
+}
@@ -312,3 +312,131 @@ func TestPendingUpdates(t *testing.T) {
 		})
 	}
 }
+
+type assetFill struct {
+	subaccountId         satypes.SubaccountId
+	baseAssetId          uint32
+	isBuy                bool
+	bigFillBaseQuantums  *big.Int
+	bigFillQuoteQuantums *big.Int
+	feePpm               int32
+}
+
+func TestPendingUpdates_AssetFills(t *testing.T) {
+	tests := []struct {
+		name            string
+		assetFills      []assetFill
+		expectedUpdates []satypes.Update
+	}{
+		{
+			name: "buy and sell (with fees)",
+			assetFills: []assetFill{
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                true,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(1_000),
+					feePpm:               10_000,
+				},
+				{
+					subaccountId:         constants.Alice_Num1,
+					baseAssetId:          uint32(1),
+					isBuy:                false,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(1_000),
+					feePpm:               -5_000,
+				},
+			},
+			expectedUpdates: []satypes.Update{
+				{
+					SubaccountId: constants.Alice_Num0,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId:          uint32(0),
+							BigQuantumsDelta: big.NewInt(-1_010),
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(100),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{},
+				},
+				{
+					SubaccountId: constants.Alice_Num1,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId:          uint32(0),
+							BigQuantumsDelta: big.NewInt(1_005),
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(-100),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{},
+				},
+			},
+		},
+		{
+			name: "multiple fills for the same subaccount",
+			assetFills: []assetFill{
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                true,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(1_000),
+				},
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                false,
+					bigFillBaseQuantums:  big.NewInt(40),
+					bigFillQuoteQuantums: big.NewInt(500),
+				},
+			},
+			expectedUpdates: []satypes.Update{
+				{
+					SubaccountId: constants.Alice_Num0,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId:          uint32(0),
+							BigQuantumsDelta: big.NewInt(-500),
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(60),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Run many times for determinism.
+			for i := 0; i < 100; i++ {
+				pendingUpdates := types.NewPendingUpdates()
+
+				for _, assetFill := range tt.assetFills {
+					pendingUpdates.AddAssetFill(
+						assetFill.subaccountId,
+						assetFill.baseAssetId,
+						assetFill.isBuy,
+						assetFill.feePpm,
+						assetFill.bigFillBaseQuantums,
+						assetFill.bigFillQuoteQuantums,
+					)
+				}
+
+				updates := pendingUpdates.ConvertToUpdates()
+
+				require.Equal(t, tt.expectedUpdates, updates)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // - Stateful order IDs forcefully removed in the last block.
 // - Conditional order IDs triggered in the last block.
 // - Conditional order IDs placed, but not triggered in the last block.
+// - Clob pair IDs moved to final settlement in the last block.
 // - The height of the block in which the events occurred.
 type ProcessProposerMatchesEvents struct {
 	PlacedLongTermOrderIds                  []OrderId `protobuf:"bytes,1,rep,name=placed_long_term_order_ids,json=placedLongTermOrderIds,proto3" json:"placed_long_term_order_ids"`
@@ -43,6 +44,7 @@ type ProcessProposerMatchesEvents struct {
 	ConditionalOrderIdsTriggeredInLastBlock []OrderId `protobuf:"bytes,6,rep,name=conditional_order_ids_triggered_in_last_block,json=conditionalOrderIdsTriggeredInLastBlock,proto3" json:"conditional_order_ids_triggered_in_last_block"`
 	PlacedConditionalOrderIds               []OrderId `protobuf:"bytes,7,rep,name=placed_conditional_order_ids,json=placedConditionalOrderIds,proto3" json:"placed_conditional_order_ids"`
 	BlockHeight                             uint32    `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	FinalSettlementClobPairIds              []uint32  `protobuf:"varint,9,rep,packed,name=final_settlement_clob_pair_ids,json=finalSettlementClobPairIds,proto3" json:"final_settlement_clob_pair_ids,omitempty"`
 }
 
 func (m *ProcessProposerMatchesEvents) Reset()         { *m = ProcessProposerMatchesEvents{} }
@@ -134,6 +136,13 @@ func (m *ProcessProposerMatchesEvents) GetBlockHeight() uint32 {
 	return 0
 }
 
+func (m *ProcessProposerMatchesEvents) GetFinalSettlementClobPairIds() []uint32 {
+	if m != nil {
+		return m.FinalSettlementClobPairIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProcessProposerMatchesEvents)(nil), "dydxprotocol.clob.ProcessProposerMatchesEvents")
 }
@@ -143,36 +152,38 @@ func init() {
 }
 
 var fileDescriptor_4626e94e6961a770 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
+	// 483 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x36, 0x0a, 0xf2, 0xe0, 0x40, 0x84, 0xa0, 0x44, 0x23, 0x8c, 0x1d, 0x60, 0x97,
-	0x25, 0x12, 0x20, 0xb8, 0x77, 0x02, 0x31, 0x69, 0x88, 0x6a, 0xec, 0x84, 0x40, 0x96, 0x6b, 0xbf,
-	0x25, 0x16, 0x8e, 0x5f, 0x64, 0x7b, 0x55, 0x77, 0xe3, 0x23, 0xf0, 0x09, 0xf8, 0x3c, 0x3b, 0xee,
-	0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0x38, 0x69, 0x95, 0x29, 0x3d, 0xe4, 0x16, 0x3d, 0x3f, 0xff,
-	0x7e, 0xef, 0xfd, 0x23, 0x93, 0x77, 0xe2, 0x52, 0xcc, 0x4b, 0x83, 0x0e, 0x39, 0xaa, 0x94, 0x2b,
-	0x9c, 0xa6, 0xa5, 0x41, 0x0e, 0xd6, 0xd2, 0xd2, 0x60, 0x89, 0x16, 0x0c, 0x2d, 0x98, 0xe3, 0x39,
-	0x58, 0x0a, 0x33, 0xd0, 0xce, 0x26, 0xbe, 0x3b, 0x7c, 0xd0, 0xbe, 0x98, 0x54, 0x17, 0xa3, 0x87,
-	0x19, 0x66, 0xe8, 0x4b, 0x69, 0xf5, 0x55, 0x37, 0x46, 0x4f, 0xbb, 0x06, 0x34, 0x02, 0x4c, 0x7d,
-	0xbc, 0xff, 0x7b, 0x48, 0x76, 0x27, 0xb5, 0x71, 0xd2, 0x08, 0x3f, 0xd5, 0xbe, 0xf7, 0x5e, 0x17,
-	0x7e, 0x23, 0x51, 0xa9, 0x18, 0x07, 0x41, 0x15, 0xea, 0x8c, 0x3a, 0x30, 0x05, 0xf5, 0x00, 0x2a,
-	0x85, 0x1d, 0x05, 0x7b, 0x5b, 0x07, 0x3b, 0xaf, 0xa2, 0xa4, 0x33, 0x4d, 0xf2, 0xb9, 0xea, 0x39,
-	0x16, 0xe3, 0xed, 0xab, 0xbf, 0xcf, 0x06, 0xa7, 0x8f, 0x6a, 0xc6, 0x09, 0xea, 0xec, 0x0c, 0x4c,
-	0xd1, 0x1c, 0xda, 0xf0, 0x3b, 0x89, 0x60, 0x5e, 0x4a, 0x03, 0x82, 0x5a, 0xc7, 0x1c, 0x9c, 0x5f,
-	0xa8, 0x16, 0xfd, 0x56, 0x4f, 0xfa, 0xe3, 0x86, 0xf1, 0xa5, 0x41, 0xac, 0xf1, 0x9c, 0xc4, 0x6b,
-	0x1a, 0x3d, 0x97, 0x4a, 0x81, 0xa0, 0x52, 0x53, 0xc5, 0xac, 0xa3, 0x53, 0x85, 0xfc, 0xc7, 0x68,
-	0xab, 0xa7, 0xe2, 0x09, 0x36, 0xcc, 0x0f, 0x9e, 0x72, 0xac, 0x4f, 0x98, 0x75, 0xe3, 0x0a, 0x11,
-	0x3a, 0xf2, 0xa2, 0x49, 0x68, 0xbd, 0x02, 0x67, 0x9a, 0x83, 0x52, 0xcc, 0x49, 0xd4, 0xad, 0x7d,
-	0xb6, 0x7b, 0xca, 0xf6, 0x6b, 0xde, 0x6a, 0x9d, 0xa3, 0x16, 0xad, 0x9d, 0x9c, 0x81, 0x02, 0x67,
-	0x9b, 0x93, 0xbb, 0xdd, 0x37, 0xb9, 0x86, 0xd1, 0x49, 0xee, 0x67, 0x40, 0x0e, 0x39, 0x6a, 0x21,
-	0x2b, 0x29, 0x6b, 0xa1, 0xa9, 0x33, 0x32, 0xcb, 0xc0, 0x74, 0x92, 0x1c, 0xf6, 0x54, 0xbe, 0x6c,
-	0x61, 0x57, 0xba, 0xb3, 0x15, 0xb3, 0x9d, 0x2b, 0x23, 0xbb, 0x4d, 0xae, 0x1b, 0x07, 0x19, 0xdd,
-	0xe9, 0xfb, 0xeb, 0x6a, 0xca, 0x51, 0x57, 0x1b, 0x3e, 0x27, 0xf7, 0xfc, 0xf0, 0x34, 0x07, 0x99,
-	0xe5, 0x6e, 0x74, 0x77, 0x2f, 0x38, 0xb8, 0x7f, 0xba, 0xe3, 0x6b, 0x1f, 0x7d, 0x69, 0x3c, 0xb9,
-	0x5a, 0xc4, 0xc1, 0xf5, 0x22, 0x0e, 0xfe, 0x2d, 0xe2, 0xe0, 0xd7, 0x32, 0x1e, 0x5c, 0x2f, 0xe3,
-	0xc1, 0x9f, 0x65, 0x3c, 0xf8, 0xfa, 0x36, 0x93, 0x2e, 0xbf, 0x98, 0x26, 0x1c, 0x8b, 0xf4, 0xc6,
-	0x23, 0x9b, 0xbd, 0x39, 0xe4, 0x39, 0x93, 0x3a, 0x5d, 0x57, 0xe6, 0xf5, 0xc3, 0x73, 0x97, 0x25,
-	0xd8, 0xe9, 0xd0, 0x97, 0x5f, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x2c, 0xfa, 0x18, 0x53, 0xfc,
-	0x03, 0x00, 0x00,
+	0x14, 0xc7, 0x1b, 0x3a, 0x0a, 0x78, 0xec, 0x40, 0x84, 0xa0, 0x44, 0x23, 0x94, 0x1d, 0xa0, 0x97,
+	0xb5, 0x12, 0x20, 0xb8, 0xb7, 0x02, 0x31, 0x69, 0x88, 0xaa, 0xdb, 0x09, 0x81, 0x2c, 0xd7, 0x79,
+	0x4d, 0x2c, 0x1c, 0xbf, 0xc8, 0xf6, 0xaa, 0xee, 0xc6, 0x47, 0xe0, 0x63, 0xed, 0xb8, 0x23, 0x27,
+	0x84, 0xda, 0x2f, 0x82, 0xe2, 0xa4, 0x51, 0xa6, 0xec, 0x90, 0x5b, 0xf4, 0xfc, 0xfc, 0xfb, 0xbd,
+	0xf7, 0x8f, 0x4c, 0x3e, 0x44, 0x97, 0xd1, 0x3a, 0xd3, 0x68, 0x91, 0xa3, 0x1c, 0x73, 0x89, 0x8b,
+	0x71, 0xa6, 0x91, 0x83, 0x31, 0x34, 0xd3, 0x98, 0xa1, 0x01, 0x4d, 0x53, 0x66, 0x79, 0x02, 0x86,
+	0xc2, 0x0a, 0x94, 0x35, 0x23, 0xd7, 0xed, 0x3f, 0xaa, 0x5f, 0x1c, 0xe5, 0x17, 0x83, 0xc7, 0x31,
+	0xc6, 0xe8, 0x4a, 0xe3, 0xfc, 0xab, 0x68, 0x0c, 0x9e, 0x37, 0x0d, 0xa8, 0x23, 0xd0, 0xc5, 0xf1,
+	0xd1, 0xa6, 0x47, 0x0e, 0x67, 0x85, 0x71, 0x56, 0x0a, 0xbf, 0x14, 0xbe, 0x8f, 0x4e, 0xe7, 0x7f,
+	0x27, 0x41, 0x26, 0x19, 0x87, 0x88, 0x4a, 0x54, 0x31, 0xb5, 0xa0, 0x53, 0xea, 0x00, 0x54, 0x44,
+	0xa6, 0xef, 0x0d, 0xba, 0xc3, 0xfd, 0x37, 0xc1, 0xa8, 0x31, 0xcd, 0xe8, 0x6b, 0xde, 0x73, 0x12,
+	0x4d, 0xf6, 0xae, 0xfe, 0xbe, 0xe8, 0xcc, 0x9f, 0x14, 0x8c, 0x53, 0x54, 0xf1, 0x39, 0xe8, 0xb4,
+	0x3c, 0x34, 0xfe, 0x0f, 0x12, 0xc0, 0x3a, 0x13, 0x1a, 0x22, 0x6a, 0x2c, 0xb3, 0xb0, 0xbc, 0x90,
+	0x35, 0xfa, 0x9d, 0x96, 0xf4, 0xa7, 0x25, 0xe3, 0xac, 0x44, 0x54, 0x78, 0x4e, 0xc2, 0x8a, 0x46,
+	0x97, 0x42, 0x4a, 0x88, 0xa8, 0x50, 0x54, 0x32, 0x63, 0xe9, 0x42, 0x22, 0xff, 0xd9, 0xef, 0xb6,
+	0x54, 0x3c, 0xc3, 0x92, 0xf9, 0xc9, 0x51, 0x4e, 0xd4, 0x29, 0x33, 0x76, 0x92, 0x23, 0x7c, 0x4b,
+	0x5e, 0x95, 0x09, 0x55, 0x2b, 0x70, 0xa6, 0x38, 0x48, 0xc9, 0xac, 0x40, 0x55, 0xdb, 0x67, 0xaf,
+	0xa5, 0xec, 0xa8, 0xe0, 0xed, 0xd6, 0x99, 0xd6, 0x68, 0xf5, 0xe4, 0x34, 0xa4, 0xb8, 0xba, 0x3d,
+	0xb9, 0xbb, 0x6d, 0x93, 0x2b, 0x19, 0x8d, 0xe4, 0x7e, 0x79, 0xe4, 0x98, 0xa3, 0x8a, 0x44, 0x2e,
+	0x65, 0x35, 0x34, 0xb5, 0x5a, 0xc4, 0x31, 0xe8, 0x46, 0x92, 0xbd, 0x96, 0xca, 0xd7, 0x35, 0xec,
+	0x4e, 0x77, 0xbe, 0x63, 0xd6, 0x73, 0x65, 0xe4, 0xb0, 0xcc, 0xf5, 0xd6, 0x41, 0xfa, 0xf7, 0xda,
+	0xfe, 0xba, 0x82, 0x32, 0x6d, 0x6a, 0xfd, 0x97, 0xe4, 0xa1, 0x1b, 0x9e, 0x26, 0x20, 0xe2, 0xc4,
+	0xf6, 0xef, 0x0f, 0xbc, 0xe1, 0xc1, 0x7c, 0xdf, 0xd5, 0x3e, 0xbb, 0x92, 0x3f, 0x21, 0xe1, 0x52,
+	0xe4, 0x62, 0x03, 0xd6, 0x4a, 0x48, 0x41, 0x59, 0x9a, 0x4b, 0x68, 0xc6, 0x44, 0x31, 0xc7, 0x83,
+	0x41, 0x77, 0x78, 0x30, 0x0f, 0x5c, 0xd7, 0x59, 0xd5, 0x34, 0x95, 0xb8, 0x98, 0x31, 0x91, 0x6b,
+	0x26, 0xb3, 0xab, 0x4d, 0xe8, 0x5d, 0x6f, 0x42, 0xef, 0xdf, 0x26, 0xf4, 0x7e, 0x6f, 0xc3, 0xce,
+	0xf5, 0x36, 0xec, 0xfc, 0xd9, 0x86, 0x9d, 0x6f, 0xef, 0x63, 0x61, 0x93, 0x8b, 0xc5, 0x88, 0x63,
+	0x3a, 0xbe, 0xf1, 0x50, 0x57, 0xef, 0x8e, 0x79, 0xc2, 0x84, 0x1a, 0x57, 0x95, 0x75, 0xf1, 0x78,
+	0xed, 0x65, 0x06, 0x66, 0xd1, 0x73, 0xe5, 0xb7, 0xff, 0x07, 0x00, 0xce, 0x1d, 0x85, 0x61, 0x40,
+	0x04, 0x00, 0x00,
 }
 
 func (m *ProcessProposerMatchesEvents) Marshal() (dAtA []byte, err error) {
@@ -195,6 +206,24 @@ func (m *ProcessProposerMatchesEvents) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.FinalSettlementClobPairIds) > 0 {
+		dAtA2 := make([]byte, len(m.FinalSettlementClobPairIds)*10)
+		var j1 int
+		for _, num := range m.FinalSettlementClobPairIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -363,6 +392,13 @@ func (m *ProcessProposerMatchesEvents) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovProcessProposerMatchesEvents(uint64(m.BlockHeight))
 	}
+	if len(m.FinalSettlementClobPairIds) > 0 {
+		l = 0
+		for _, e := range m.FinalSettlementClobPairIds {
+			l += sovProcessProposerMatchesEvents(uint64(e))
+		}
+		n += 1 + sovProcessProposerMatchesEvents(uint64(l)) + l
+	}
 	return n
 }
 
@@ -658,6 +694,82 @@ func (m *ProcessProposerMatchesEvents) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProcessProposerMatchesEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FinalSettlementClobPairIds = append(m.FinalSettlementClobPairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProcessProposerMatchesEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProcessProposerMatchesEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProcessProposerMatchesEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FinalSettlementClobPairIds) == 0 {
+					m.FinalSettlementClobPairIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProcessProposerMatchesEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FinalSettlementClobPairIds = append(m.FinalSettlementClobPairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalSettlementClobPairIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProcessProposerMatchesEvents(dAtA[iNdEx:])
@@ -67,6 +67,19 @@ func StatMsgProposedOperations(
 					stats.UniqueSubaccountsLiquidated++
 					stats.uniqueSubaccountsLiquidated[liquidated] = true
 				}
+			case *ClobMatch_MatchAssetLiquidation:
+				stats.LiquidationOrdersCount++
+
+				for _, makerOrderId := range castedOperation.Match.GetMatchAssetLiquidation().GetFills() {
+					stats.TotalFillsCount++
+					stats.statMatchedOrderId(makerOrderId.GetMakerOrderId())
+				}
+
+				liquidated := castedOperation.Match.GetMatchAssetLiquidation().GetLiquidated()
+				if _, exists := stats.uniqueSubaccountsLiquidated[liquidated]; !exists {
+					stats.UniqueSubaccountsLiquidated++
+					stats.uniqueSubaccountsLiquidated[liquidated] = true
+				}
 			case *ClobMatch_MatchPerpetualDeleveraging:
 				stats.DeleveragingOperationsCount++
 
@@ -84,6 +97,23 @@ func StatMsgProposedOperations(
 						stats.uniqueSubaccountsOffsettingDeleveraged[offsetting] = true
 					}
 				}
+			case *ClobMatch_MatchAssetDeleveraging:
+				stats.DeleveragingOperationsCount++
+
+				deleveraged := castedOperation.Match.GetMatchAssetDeleveraging().GetLiquidated()
+				if _, exists := stats.uniqueSubaccountsDeleveraged[deleveraged]; !exists {
+					stats.UniqueSubaccountsDeleveraged++
+					stats.uniqueSubaccountsDeleveraged[deleveraged] = true
+				}
+
+				for _, makerFill := range castedOperation.Match.GetMatchAssetDeleveraging().GetFills() {
+					offsetting := makerFill.OffsettingSubaccountId
+					if _, exists := stats.
+						uniqueSubaccountsOffsettingDeleveraged[offsetting]; !exists {
+						stats.UniqueSubaccountsOffsettingDeleveraged++
+						stats.uniqueSubaccountsOffsettingDeleveraged[offsetting] = true
+					}
+				}
 			}
 		case *OperationRaw_OrderRemoval:
 			orderId := castedOperation.OrderRemoval.GetOrderId()
@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -296,6 +297,7 @@ type MsgCancelOrder struct {
 	// Information about when the order cancellation expires.
 	//
 	// Types that are valid to be assigned to GoodTilOneof:
+	//
 	//	*MsgCancelOrder_GoodTilBlock
 	//	*MsgCancelOrder_GoodTilBlockTime
 	GoodTilOneof isMsgCancelOrder_GoodTilOneof `protobuf_oneof:"good_til_oneof"`
@@ -423,26 +425,28 @@ func (m *MsgCancelOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrderResponse proto.InternalMessageInfo
 
-// MsgUpdateClobPair is a request type used for updating a ClobPair in state.
-type MsgUpdateClobPair struct {
-	// Authority is the address that may send this message.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// `clob_pair` is the ClobPair to write to state.
-	ClobPair ClobPair `protobuf:"bytes,2,opt,name=clob_pair,json=clobPair,proto3" json:"clob_pair"`
+// MsgReplaceOrder is a request type used for replacing the size and price of
+// an existing Short-Term order while keeping its `OrderId`.
+type MsgReplaceOrder struct {
+	// The replacement order. Its `OrderId` must be the `OrderId` of the order
+	// being replaced, its `good_til_block` must be greater than the
+	// `good_til_block` of the order being replaced, and otherwise only its size
+	// and price may differ from the order being replaced.
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
 }
 
-func (m *MsgUpdateClobPair) Reset()         { *m = MsgUpdateClobPair{} }
-func (m *MsgUpdateClobPair) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateClobPair) ProtoMessage()    {}
-func (*MsgUpdateClobPair) Descriptor() ([]byte, []int) {
+func (m *MsgReplaceOrder) Reset()         { *m = MsgReplaceOrder{} }
+func (m *MsgReplaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrder) ProtoMessage()    {}
+func (*MsgReplaceOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{8}
 }
-func (m *MsgUpdateClobPair) XXX_Unmarshal(b []byte) error {
+func (m *MsgReplaceOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReplaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateClobPair.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReplaceOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -452,49 +456,41 @@ func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateClobPair) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateClobPair.Merge(m, src)
+func (m *MsgReplaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrder.Merge(m, src)
 }
-func (m *MsgUpdateClobPair) XXX_Size() int {
+func (m *MsgReplaceOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateClobPair) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateClobPair.DiscardUnknown(m)
+func (m *MsgReplaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateClobPair proto.InternalMessageInfo
-
-func (m *MsgUpdateClobPair) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
+var xxx_messageInfo_MsgReplaceOrder proto.InternalMessageInfo
 
-func (m *MsgUpdateClobPair) GetClobPair() ClobPair {
+func (m *MsgReplaceOrder) GetOrder() Order {
 	if m != nil {
-		return m.ClobPair
+		return m.Order
 	}
-	return ClobPair{}
+	return Order{}
 }
 
-// MsgUpdateClobPairResponse is a response type used for setting a ClobPair's
-// status.
-type MsgUpdateClobPairResponse struct {
+// MsgReplaceOrderResponse is a response type used for replacing orders.
+type MsgReplaceOrderResponse struct {
 }
 
-func (m *MsgUpdateClobPairResponse) Reset()         { *m = MsgUpdateClobPairResponse{} }
-func (m *MsgUpdateClobPairResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateClobPairResponse) ProtoMessage()    {}
-func (*MsgUpdateClobPairResponse) Descriptor() ([]byte, []int) {
+func (m *MsgReplaceOrderResponse) Reset()         { *m = MsgReplaceOrderResponse{} }
+func (m *MsgReplaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrderResponse) ProtoMessage()    {}
+func (*MsgReplaceOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{9}
 }
-func (m *MsgUpdateClobPairResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgReplaceOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgReplaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateClobPairResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgReplaceOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -504,44 +500,41 @@ func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateClobPairResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateClobPairResponse.Merge(m, src)
+func (m *MsgReplaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrderResponse.Merge(m, src)
 }
-func (m *MsgUpdateClobPairResponse) XXX_Size() int {
+func (m *MsgReplaceOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateClobPairResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateClobPairResponse.DiscardUnknown(m)
+func (m *MsgReplaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateClobPairResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgReplaceOrderResponse proto.InternalMessageInfo
 
-// OperationRaw represents an operation in the proposed operations.
-// Note that the `order_placement` operation is a signed message.
-type OperationRaw struct {
-	// operationRaw represents an operation that occurred, which can be a match,
-	// a signed order placement, or an order removal.
-	//
-	// Types that are valid to be assigned to Operation:
-	//
-	//	*OperationRaw_Match
-	//	*OperationRaw_ShortTermOrderPlacement
-	//	*OperationRaw_OrderRemoval
-	Operation isOperationRaw_Operation `protobuf_oneof:"operation"`
+// OrderBatch represents a batch of orders of a subaccount on a clob pair that
+// have the same order flags.
+type OrderBatch struct {
+	// The clob pair of the orders.
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The order flags of the orders.
+	OrderFlags uint32 `protobuf:"varint,2,opt,name=order_flags,json=orderFlags,proto3" json:"order_flags,omitempty"`
+	// The client ids of the orders.
+	ClientIds []uint32 `protobuf:"fixed32,3,rep,packed,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
 }
 
-func (m *OperationRaw) Reset()         { *m = OperationRaw{} }
-func (m *OperationRaw) String() string { return proto.CompactTextString(m) }
-func (*OperationRaw) ProtoMessage()    {}
-func (*OperationRaw) Descriptor() ([]byte, []int) {
+func (m *OrderBatch) Reset()         { *m = OrderBatch{} }
+func (m *OrderBatch) String() string { return proto.CompactTextString(m) }
+func (*OrderBatch) ProtoMessage()    {}
+func (*OrderBatch) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{10}
 }
-func (m *OperationRaw) XXX_Unmarshal(b []byte) error {
+func (m *OrderBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OrderBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OperationRaw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OrderBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -551,96 +544,68 @@ func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *OperationRaw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperationRaw.Merge(m, src)
+func (m *OrderBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBatch.Merge(m, src)
 }
-func (m *OperationRaw) XXX_Size() int {
+func (m *OrderBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *OperationRaw) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperationRaw.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_OperationRaw proto.InternalMessageInfo
-
-type isOperationRaw_Operation interface {
-	isOperationRaw_Operation()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type OperationRaw_Match struct {
-	Match *ClobMatch `protobuf:"bytes,1,opt,name=match,proto3,oneof" json:"match,omitempty"`
-}
-type OperationRaw_ShortTermOrderPlacement struct {
-	ShortTermOrderPlacement []byte `protobuf:"bytes,2,opt,name=short_term_order_placement,json=shortTermOrderPlacement,proto3,oneof" json:"short_term_order_placement,omitempty"`
-}
-type OperationRaw_OrderRemoval struct {
-	OrderRemoval *OrderRemoval `protobuf:"bytes,3,opt,name=order_removal,json=orderRemoval,proto3,oneof" json:"order_removal,omitempty"`
+func (m *OrderBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBatch.DiscardUnknown(m)
 }
 
-func (*OperationRaw_Match) isOperationRaw_Operation()                   {}
-func (*OperationRaw_ShortTermOrderPlacement) isOperationRaw_Operation() {}
-func (*OperationRaw_OrderRemoval) isOperationRaw_Operation()            {}
+var xxx_messageInfo_OrderBatch proto.InternalMessageInfo
 
-func (m *OperationRaw) GetOperation() isOperationRaw_Operation {
+func (m *OrderBatch) GetClobPairId() uint32 {
 	if m != nil {
-		return m.Operation
+		return m.ClobPairId
 	}
-	return nil
-}
-
-func (m *OperationRaw) GetMatch() *ClobMatch {
-	if x, ok := m.GetOperation().(*OperationRaw_Match); ok {
-		return x.Match
-	}
-	return nil
+	return 0
 }
 
-func (m *OperationRaw) GetShortTermOrderPlacement() []byte {
-	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderPlacement); ok {
-		return x.ShortTermOrderPlacement
+func (m *OrderBatch) GetOrderFlags() uint32 {
+	if m != nil {
+		return m.OrderFlags
 	}
-	return nil
+	return 0
 }
 
-func (m *OperationRaw) GetOrderRemoval() *OrderRemoval {
-	if x, ok := m.GetOperation().(*OperationRaw_OrderRemoval); ok {
-		return x.OrderRemoval
+func (m *OrderBatch) GetClientIds() []uint32 {
+	if m != nil {
+		return m.ClientIds
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*OperationRaw) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*OperationRaw_Match)(nil),
-		(*OperationRaw_ShortTermOrderPlacement)(nil),
-		(*OperationRaw_OrderRemoval)(nil),
-	}
-}
-
-// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
-// request type.
-type MsgUpdateEquityTierLimitConfiguration struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the equity tier limit configuration to update to. All fields must
-	// be set.
-	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,2,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
+// MsgBatchCancel is a request type used for canceling a batch of orders of a
+// subaccount. All orders in the batch must either be Short-Term orders or
+// stateful orders.
+type MsgBatchCancel struct {
+	// The subaccount of the orders.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The batches of orders to cancel.
+	OrderBatches []OrderBatch `protobuf:"bytes,2,rep,name=order_batches,json=orderBatches,proto3" json:"order_batches"`
+	// Information about when the order cancellations expire.
+	//
+	// Types that are valid to be assigned to GoodTilOneof:
+	//
+	//	*MsgBatchCancel_GoodTilBlock
+	//	*MsgBatchCancel_GoodTilBlockTime
+	GoodTilOneof isMsgBatchCancel_GoodTilOneof `protobuf_oneof:"good_til_oneof"`
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) Reset()         { *m = MsgUpdateEquityTierLimitConfiguration{} }
-func (m *MsgUpdateEquityTierLimitConfiguration) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateEquityTierLimitConfiguration) ProtoMessage()    {}
-func (*MsgUpdateEquityTierLimitConfiguration) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCancel) Reset()         { *m = MsgBatchCancel{} }
+func (m *MsgBatchCancel) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancel) ProtoMessage()    {}
+func (*MsgBatchCancel) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{11}
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancel.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -650,53 +615,99 @@ func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Merge(m, src)
+func (m *MsgBatchCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancel.Merge(m, src)
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Size() int {
+func (m *MsgBatchCancel) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.DiscardUnknown(m)
+func (m *MsgBatchCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancel proto.InternalMessageInfo
 
-func (m *MsgUpdateEquityTierLimitConfiguration) GetAuthority() string {
+type isMsgBatchCancel_GoodTilOneof interface {
+	isMsgBatchCancel_GoodTilOneof()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type MsgBatchCancel_GoodTilBlock struct {
+	GoodTilBlock uint32 `protobuf:"varint,3,opt,name=good_til_block,json=goodTilBlock,proto3,oneof" json:"good_til_block,omitempty"`
+}
+type MsgBatchCancel_GoodTilBlockTime struct {
+	GoodTilBlockTime uint32 `protobuf:"fixed32,4,opt,name=good_til_block_time,json=goodTilBlockTime,proto3,oneof" json:"good_til_block_time,omitempty"`
+}
+
+func (*MsgBatchCancel_GoodTilBlock) isMsgBatchCancel_GoodTilOneof()     {}
+func (*MsgBatchCancel_GoodTilBlockTime) isMsgBatchCancel_GoodTilOneof() {}
+
+func (m *MsgBatchCancel) GetGoodTilOneof() isMsgBatchCancel_GoodTilOneof {
 	if m != nil {
-		return m.Authority
+		return m.GoodTilOneof
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
+func (m *MsgBatchCancel) GetSubaccountId() types.SubaccountId {
 	if m != nil {
-		return m.EquityTierLimitConfig
+		return m.SubaccountId
 	}
-	return EquityTierLimitConfiguration{}
+	return types.SubaccountId{}
 }
 
-// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
-// response type.
-type MsgUpdateEquityTierLimitConfigurationResponse struct {
+func (m *MsgBatchCancel) GetOrderBatches() []OrderBatch {
+	if m != nil {
+		return m.OrderBatches
+	}
+	return nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Reset() {
-	*m = MsgUpdateEquityTierLimitConfigurationResponse{}
+func (m *MsgBatchCancel) GetGoodTilBlock() uint32 {
+	if x, ok := m.GetGoodTilOneof().(*MsgBatchCancel_GoodTilBlock); ok {
+		return x.GoodTilBlock
+	}
+	return 0
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) String() string {
-	return proto.CompactTextString(m)
+
+func (m *MsgBatchCancel) GetGoodTilBlockTime() uint32 {
+	if x, ok := m.GetGoodTilOneof().(*MsgBatchCancel_GoodTilBlockTime); ok {
+		return x.GoodTilBlockTime
+	}
+	return 0
 }
-func (*MsgUpdateEquityTierLimitConfigurationResponse) ProtoMessage() {}
-func (*MsgUpdateEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MsgBatchCancel) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MsgBatchCancel_GoodTilBlock)(nil),
+		(*MsgBatchCancel_GoodTilBlockTime)(nil),
+	}
+}
+
+// MsgBatchCancelResponse is a response type used for canceling a batch of
+// orders.
+type MsgBatchCancelResponse struct {
+	// The ids of the orders that were canceled.
+	CanceledOrderIds []OrderId `protobuf:"bytes,1,rep,name=canceled_order_ids,json=canceledOrderIds,proto3" json:"canceled_order_ids"`
+	// The ids of the orders that failed to be canceled, for example because
+	// they no longer exist.
+	FailedOrderIds []OrderId `protobuf:"bytes,2,rep,name=failed_order_ids,json=failedOrderIds,proto3" json:"failed_order_ids"`
+}
+
+func (m *MsgBatchCancelResponse) Reset()         { *m = MsgBatchCancelResponse{} }
+func (m *MsgBatchCancelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCancelResponse) ProtoMessage()    {}
+func (*MsgBatchCancelResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{12}
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchCancelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchCancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchCancelResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -706,39 +717,59 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, de
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Merge(m, src)
+func (m *MsgBatchCancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCancelResponse.Merge(m, src)
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Size() int {
+func (m *MsgBatchCancelResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.DiscardUnknown(m)
+func (m *MsgBatchCancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCancelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchCancelResponse proto.InternalMessageInfo
 
-// MsgUpdateBlockRateLimitConfiguration is the Msg/BlockRateLimitConfiguration
-// request type.
-type MsgUpdateBlockRateLimitConfiguration struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the block rate limit configuration to update to. All fields must be
-	// set.
-	BlockRateLimitConfig BlockRateLimitConfiguration `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
+func (m *MsgBatchCancelResponse) GetCanceledOrderIds() []OrderId {
+	if m != nil {
+		return m.CanceledOrderIds
+	}
+	return nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Reset()         { *m = MsgUpdateBlockRateLimitConfiguration{} }
-func (m *MsgUpdateBlockRateLimitConfiguration) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateBlockRateLimitConfiguration) ProtoMessage()    {}
-func (*MsgUpdateBlockRateLimitConfiguration) Descriptor() ([]byte, []int) {
+func (m *MsgBatchCancelResponse) GetFailedOrderIds() []OrderId {
+	if m != nil {
+		return m.FailedOrderIds
+	}
+	return nil
+}
+
+// MsgCancelAllOrders is a request type used for canceling all orders of a
+// subaccount. Short-Term orders are canceled in the in-memory orderbook and
+// stateful orders are removed from state.
+type MsgCancelAllOrders struct {
+	// The subaccount of the orders.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// If not empty, only orders on these clob pairs are canceled.
+	ClobPairIds []uint32 `protobuf:"varint,2,rep,packed,name=clob_pair_ids,json=clobPairIds,proto3" json:"clob_pair_ids,omitempty"`
+	// If not empty, only orders with these order flags are canceled.
+	OrderFlags []uint32 `protobuf:"varint,3,rep,packed,name=order_flags,json=orderFlags,proto3" json:"order_flags,omitempty"`
+	// The last block this cancellation can be executed at. Short-Term orders
+	// cannot be placed again until after this block.
+	GoodTilBlock uint32 `protobuf:"varint,4,opt,name=good_til_block,json=goodTilBlock,proto3" json:"good_til_block,omitempty"`
+}
+
+func (m *MsgCancelAllOrders) Reset()         { *m = MsgCancelAllOrders{} }
+func (m *MsgCancelAllOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrders) ProtoMessage()    {}
+func (*MsgCancelAllOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{13}
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAllOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAllOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAllOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -748,53 +779,65 @@ func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Merge(m, src)
+func (m *MsgCancelAllOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrders.Merge(m, src)
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Size() int {
+func (m *MsgCancelAllOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.DiscardUnknown(m)
+func (m *MsgCancelAllOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAllOrders proto.InternalMessageInfo
 
-func (m *MsgUpdateBlockRateLimitConfiguration) GetAuthority() string {
+func (m *MsgCancelAllOrders) GetSubaccountId() types.SubaccountId {
 	if m != nil {
-		return m.Authority
+		return m.SubaccountId
 	}
-	return ""
+	return types.SubaccountId{}
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) GetBlockRateLimitConfig() BlockRateLimitConfiguration {
+func (m *MsgCancelAllOrders) GetClobPairIds() []uint32 {
 	if m != nil {
-		return m.BlockRateLimitConfig
+		return m.ClobPairIds
 	}
-	return BlockRateLimitConfiguration{}
+	return nil
 }
 
-// MsgUpdateBlockRateLimitConfiguration is a response type for updating the
-// liquidations config.
-type MsgUpdateBlockRateLimitConfigurationResponse struct {
+func (m *MsgCancelAllOrders) GetOrderFlags() []uint32 {
+	if m != nil {
+		return m.OrderFlags
+	}
+	return nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Reset() {
-	*m = MsgUpdateBlockRateLimitConfigurationResponse{}
+func (m *MsgCancelAllOrders) GetGoodTilBlock() uint32 {
+	if m != nil {
+		return m.GoodTilBlock
+	}
+	return 0
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) String() string {
-	return proto.CompactTextString(m)
+
+// MsgCancelAllOrdersResponse is a response type used for canceling all orders
+// of a subaccount.
+type MsgCancelAllOrdersResponse struct {
+	// The ids of the stateful orders that were canceled.
+	CanceledOrderIds []OrderId `protobuf:"bytes,1,rep,name=canceled_order_ids,json=canceledOrderIds,proto3" json:"canceled_order_ids"`
 }
-func (*MsgUpdateBlockRateLimitConfigurationResponse) ProtoMessage() {}
-func (*MsgUpdateBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+
+func (m *MsgCancelAllOrdersResponse) Reset()         { *m = MsgCancelAllOrdersResponse{} }
+func (m *MsgCancelAllOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrdersResponse) ProtoMessage()    {}
+func (*MsgCancelAllOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{14}
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAllOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAllOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAllOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -804,40 +847,45 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, det
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Merge(m, src)
+func (m *MsgCancelAllOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrdersResponse.Merge(m, src)
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Size() int {
+func (m *MsgCancelAllOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.DiscardUnknown(m)
+func (m *MsgCancelAllOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAllOrdersResponse proto.InternalMessageInfo
 
-// MsgUpdateLiquidationsConfig is a request type for updating the liquidations
-// config.
-type MsgUpdateLiquidationsConfig struct {
+func (m *MsgCancelAllOrdersResponse) GetCanceledOrderIds() []OrderId {
+	if m != nil {
+		return m.CanceledOrderIds
+	}
+	return nil
+}
+
+// MsgUpdateClobPair is a request type used for updating a ClobPair in state.
+type MsgUpdateClobPair struct {
 	// Authority is the address that may send this message.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the liquidations configuration to update to. All fields must
-	// be set.
-	LiquidationsConfig LiquidationsConfig `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
+	// `clob_pair` is the ClobPair to write to state.
+	ClobPair ClobPair `protobuf:"bytes,2,opt,name=clob_pair,json=clobPair,proto3" json:"clob_pair"`
 }
 
-func (m *MsgUpdateLiquidationsConfig) Reset()         { *m = MsgUpdateLiquidationsConfig{} }
-func (m *MsgUpdateLiquidationsConfig) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateLiquidationsConfig) ProtoMessage()    {}
-func (*MsgUpdateLiquidationsConfig) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateClobPair) Reset()         { *m = MsgUpdateClobPair{} }
+func (m *MsgUpdateClobPair) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClobPair) ProtoMessage()    {}
+func (*MsgUpdateClobPair) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{15}
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateClobPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateLiquidationsConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateClobPair.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -847,48 +895,49 @@ func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateLiquidationsConfig.Merge(m, src)
+func (m *MsgUpdateClobPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClobPair.Merge(m, src)
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Size() int {
+func (m *MsgUpdateClobPair) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateLiquidationsConfig.DiscardUnknown(m)
+func (m *MsgUpdateClobPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClobPair.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateLiquidationsConfig proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateClobPair proto.InternalMessageInfo
 
-func (m *MsgUpdateLiquidationsConfig) GetAuthority() string {
+func (m *MsgUpdateClobPair) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateLiquidationsConfig) GetLiquidationsConfig() LiquidationsConfig {
+func (m *MsgUpdateClobPair) GetClobPair() ClobPair {
 	if m != nil {
-		return m.LiquidationsConfig
+		return m.ClobPair
 	}
-	return LiquidationsConfig{}
+	return ClobPair{}
 }
 
-// MsgUpdateLiquidationsConfig is the Msg/LiquidationsConfig response type.
-type MsgUpdateLiquidationsConfigResponse struct {
+// MsgUpdateClobPairResponse is a response type used for setting a ClobPair's
+// status.
+type MsgUpdateClobPairResponse struct {
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) Reset()         { *m = MsgUpdateLiquidationsConfigResponse{} }
-func (m *MsgUpdateLiquidationsConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateLiquidationsConfigResponse) ProtoMessage()    {}
-func (*MsgUpdateLiquidationsConfigResponse) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateClobPairResponse) Reset()         { *m = MsgUpdateClobPairResponse{} }
+func (m *MsgUpdateClobPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClobPairResponse) ProtoMessage()    {}
+func (*MsgUpdateClobPairResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_19b9e2c0de4ab64a, []int{16}
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateClobPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateClobPairResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -898,1347 +947,3208 @@ func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Merge(m, src)
+func (m *MsgUpdateClobPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClobPairResponse.Merge(m, src)
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Size() int {
+func (m *MsgUpdateClobPairResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.DiscardUnknown(m)
+func (m *MsgUpdateClobPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClobPairResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateLiquidationsConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateClobPairResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateClobPair)(nil), "dydxprotocol.clob.MsgCreateClobPair")
-	proto.RegisterType((*MsgCreateClobPairResponse)(nil), "dydxprotocol.clob.MsgCreateClobPairResponse")
-	proto.RegisterType((*MsgProposedOperations)(nil), "dydxprotocol.clob.MsgProposedOperations")
-	proto.RegisterType((*MsgProposedOperationsResponse)(nil), "dydxprotocol.clob.MsgProposedOperationsResponse")
-	proto.RegisterType((*MsgPlaceOrder)(nil), "dydxprotocol.clob.MsgPlaceOrder")
-	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "dydxprotocol.clob.MsgPlaceOrderResponse")
-	proto.RegisterType((*MsgCancelOrder)(nil), "dydxprotocol.clob.MsgCancelOrder")
-	proto.RegisterType((*MsgCancelOrderResponse)(nil), "dydxprotocol.clob.MsgCancelOrderResponse")
-	proto.RegisterType((*MsgUpdateClobPair)(nil), "dydxprotocol.clob.MsgUpdateClobPair")
-	proto.RegisterType((*MsgUpdateClobPairResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairResponse")
-	proto.RegisterType((*OperationRaw)(nil), "dydxprotocol.clob.OperationRaw")
-	proto.RegisterType((*MsgUpdateEquityTierLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration")
-	proto.RegisterType((*MsgUpdateEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse")
-	proto.RegisterType((*MsgUpdateBlockRateLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration")
-	proto.RegisterType((*MsgUpdateBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse")
-	proto.RegisterType((*MsgUpdateLiquidationsConfig)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfig")
-	proto.RegisterType((*MsgUpdateLiquidationsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse")
+// MsgDelistClobPair is a request type used by x/gov for delisting a perpetual
+// clob pair.
+type MsgDelistClobPair struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Id of the clob pair to delist.
+	ClobPairId uint32 `protobuf:"varint,2,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The price at which all open positions in the perpetual of the clob pair
+	// are closed, denominated in the exponent of the perpetual's market. If
+	// zero, positions are closed at the current oracle price.
+	SettlementPrice uint64 `protobuf:"varint,3,opt,name=settlement_price,json=settlementPrice,proto3" json:"settlement_price,omitempty"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
-
-var fileDescriptor_19b9e2c0de4ab64a = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xdf, 0x21, 0x40, 0x9a, 0xb7, 0x49, 0x48, 0xdd, 0x94, 0xb8, 0x0e, 0xdd, 0x6c, 0x96, 0xa6,
-	0x5a, 0xa0, 0x59, 0x97, 0x50, 0x05, 0x04, 0xe2, 0xdf, 0x46, 0x45, 0x41, 0x6a, 0xd4, 0xc5, 0x04,
-	0x09, 0x01, 0x92, 0xe5, 0xb5, 0xa7, 0xde, 0x51, 0x6d, 0x8f, 0x33, 0x9e, 0x0d, 0xc9, 0xb5, 0x9f,
-	0x80, 0x3b, 0x42, 0xe2, 0x23, 0x70, 0xe0, 0xc0, 0x19, 0x2e, 0x3d, 0x56, 0x9c, 0x2a, 0x21, 0x01,
-	0x4a, 0x0e, 0x7c, 0x0d, 0x64, 0x7b, 0x3c, 0xf1, 0xc6, 0xf6, 0x66, 0x59, 0x71, 0xe8, 0x65, 0x77,
-	0x67, 0xe6, 0xf7, 0xde, 0xef, 0xbd, 0xf7, 0x1b, 0xbf, 0xb7, 0x06, 0xcd, 0x39, 0x76, 0x8e, 0x42,
-	0x46, 0x39, 0xb5, 0xa9, 0xa7, 0xdb, 0x1e, 0xed, 0xeb, 0xfc, 0xa8, 0x93, 0x6c, 0x28, 0x97, 0xf3,
-	0x67, 0x9d, 0xf8, 0x4c, 0xbb, 0x66, 0xd3, 0xc8, 0xa7, 0x91, 0x99, 0xec, 0xea, 0xe9, 0x22, 0x45,
-	0x6b, 0x2b, 0xe9, 0x4a, 0xf7, 0x23, 0x57, 0x3f, 0x7c, 0x33, 0xfe, 0x12, 0x07, 0xcb, 0x2e, 0x75,
-	0x69, 0x6a, 0x10, 0xff, 0x12, 0xbb, 0x7a, 0x91, 0xb8, 0xef, 0x51, 0xfb, 0xa1, 0xc9, 0x2c, 0x8e,
-	0x4d, 0x8f, 0xf8, 0x84, 0x9b, 0x36, 0x0d, 0x1e, 0x90, 0xcc, 0xcd, 0x7a, 0xd1, 0x20, 0xfe, 0x30,
-	0x43, 0x8b, 0x30, 0x01, 0xb9, 0x5d, 0x84, 0xe0, 0x83, 0x21, 0xe1, 0xc7, 0x26, 0x27, 0x98, 0x95,
-	0x39, 0x5d, 0x2b, 0x5a, 0xf8, 0x16, 0xb7, 0x07, 0x38, 0xcb, 0xea, 0x7a, 0x11, 0x40, 0x99, 0x83,
-	0x33, 0xc6, 0x9b, 0x15, 0xc7, 0x26, 0xc3, 0x3e, 0x3d, 0xb4, 0xbc, 0xcc, 0xcd, 0x1b, 0x45, 0x9c,
-	0x47, 0x0e, 0x86, 0xc4, 0xb1, 0x38, 0xa1, 0x41, 0x34, 0x12, 0x54, 0xeb, 0x7b, 0x04, 0x97, 0xf7,
-	0x22, 0x77, 0x87, 0x61, 0x8b, 0xe3, 0x1d, 0x8f, 0xf6, 0x7b, 0x16, 0x61, 0xca, 0x36, 0xcc, 0x59,
-	0x43, 0x3e, 0xa0, 0x8c, 0xf0, 0x63, 0x15, 0x35, 0x51, 0x7b, 0xae, 0xab, 0xfe, 0xfe, 0xf3, 0xe6,
-	0xb2, 0x10, 0xe1, 0x63, 0xc7, 0x61, 0x38, 0x8a, 0x3e, 0xe7, 0x8c, 0x04, 0xae, 0x71, 0x06, 0x55,
-	0x3e, 0x80, 0x39, 0x59, 0x27, 0xf5, 0xb9, 0x26, 0x6a, 0xd7, 0xb7, 0x56, 0x3b, 0x05, 0x65, 0x3b,
-	0x19, 0x4f, 0xf7, 0xf9, 0xc7, 0x7f, 0xae, 0xd5, 0x8c, 0x4b, 0xb6, 0x58, 0xbf, 0xbb, 0xf8, 0xe8,
-	0x9f, 0x9f, 0x5e, 0x3f, 0xf3, 0xd7, 0x5a, 0x85, 0x6b, 0x85, 0xe0, 0x0c, 0x1c, 0x85, 0x34, 0x88,
-	0x70, 0x8b, 0xc0, 0xd5, 0xbd, 0xc8, 0xed, 0x31, 0x1a, 0xd2, 0x08, 0x3b, 0xf7, 0x43, 0xcc, 0xd2,
-	0x04, 0x95, 0x1e, 0x2c, 0x51, 0xb9, 0x32, 0x0f, 0x86, 0x78, 0x88, 0x55, 0xd4, 0x9c, 0x69, 0xd7,
-	0xb7, 0xd6, 0x4a, 0x82, 0x91, 0x86, 0x86, 0xf5, 0xad, 0x08, 0xe8, 0xa5, 0x33, 0xf3, 0xcf, 0x62,
-	0xeb, 0xd6, 0x1a, 0x5c, 0x2f, 0xa5, 0x92, 0xb1, 0xdc, 0x85, 0x85, 0x18, 0xe0, 0x59, 0x36, 0xbe,
-	0x1f, 0x6b, 0xa2, 0xdc, 0x81, 0x17, 0x12, 0x71, 0x92, 0xea, 0xd5, 0xb7, 0xd4, 0x32, 0xe2, 0xf8,
-	0x5c, 0x30, 0xa6, 0xe0, 0xd6, 0x4a, 0x9a, 0x92, 0x74, 0x23, 0xfd, 0xff, 0x82, 0x60, 0x31, 0xae,
-	0x84, 0x15, 0xd8, 0xd8, 0x4b, 0x19, 0xde, 0x83, 0x4b, 0xa9, 0xfc, 0xc4, 0x11, 0x24, 0x5a, 0x15,
-	0xc9, 0xa7, 0x8e, 0xa0, 0x99, 0xa5, 0xe9, 0x52, 0xb9, 0x09, 0x8b, 0x2e, 0xa5, 0x8e, 0xc9, 0x89,
-	0x67, 0x26, 0x8f, 0x42, 0xa2, 0xd6, 0xc2, 0x6e, 0xcd, 0x98, 0x8f, 0xf7, 0xf7, 0x89, 0xd7, 0x8d,
-	0x77, 0x15, 0x1d, 0xae, 0x8c, 0xe2, 0x4c, 0x4e, 0x7c, 0xac, 0xce, 0x34, 0x51, 0x7b, 0x76, 0xb7,
-	0x66, 0x2c, 0xe5, 0xc1, 0xfb, 0xc4, 0xc7, 0xdd, 0xa5, 0x9c, 0x63, 0x1a, 0x60, 0xfa, 0xa0, 0xa5,
-	0xc2, 0xcb, 0xa3, 0x91, 0xcb, 0xa4, 0xc4, 0xdd, 0xfb, 0x22, 0x74, 0x9e, 0xdd, 0xbb, 0x37, 0x1a,
-	0x9c, 0x0c, 0xfd, 0x29, 0x82, 0xf9, 0xfc, 0xc5, 0x89, 0xf5, 0x4e, 0x1e, 0x66, 0x21, 0xc5, 0x2b,
-	0x15, 0xcc, 0x7b, 0x31, 0x66, 0xb7, 0x66, 0xa4, 0x60, 0xe5, 0x7d, 0xd0, 0xa2, 0x01, 0x65, 0xdc,
-	0xe4, 0x98, 0xf9, 0x66, 0x2a, 0x67, 0x18, 0xab, 0xef, 0xe3, 0x80, 0x27, 0x49, 0xcc, 0xef, 0xd6,
-	0x8c, 0x95, 0x04, 0xb3, 0x8f, 0x99, 0x9f, 0xd4, 0xaf, 0x97, 0x01, 0x94, 0x4f, 0x60, 0x61, 0xa4,
-	0x03, 0x24, 0xba, 0x54, 0xdc, 0xf2, 0xb4, 0xf2, 0x09, 0x2c, 0x56, 0x99, 0xe6, 0xd6, 0xdd, 0x3a,
-	0xcc, 0xc9, 0x1b, 0xdf, 0xfa, 0x0b, 0xc1, 0x86, 0x4c, 0xfc, 0x6e, 0xd2, 0xd2, 0xf6, 0x09, 0x66,
-	0xf7, 0xe2, 0x86, 0xb6, 0x93, 0xb4, 0x8e, 0x61, 0x8a, 0x9c, 0x5a, 0xa9, 0x00, 0xd4, 0xaa, 0x56,
-	0x29, 0x84, 0xd3, 0x4b, 0x32, 0x18, 0x17, 0x8a, 0x10, 0xf3, 0x2a, 0x2e, 0xc3, 0x14, 0x94, 0xd5,
-	0x61, 0x73, 0xa2, 0x04, 0xa5, 0xda, 0x7f, 0x20, 0xb8, 0x21, 0x2d, 0x92, 0xbb, 0x6e, 0x58, 0x1c,
-	0xff, 0x8f, 0x15, 0x79, 0x08, 0x2b, 0x15, 0x03, 0x49, 0x48, 0xda, 0x29, 0x29, 0xc8, 0x98, 0x40,
-	0x44, 0x3d, 0x96, 0xfb, 0x25, 0x90, 0x42, 0x39, 0x3a, 0x70, 0x6b, 0x92, 0xe4, 0x64, 0x35, 0x7e,
-	0x43, 0xb0, 0x2a, 0x0d, 0xee, 0xe5, 0x26, 0x4b, 0x0a, 0x9f, 0xba, 0x08, 0xdf, 0xc0, 0x95, 0x92,
-	0x39, 0x25, 0x6e, 0xc4, 0x46, 0x49, 0x01, 0x8a, 0xdc, 0x22, 0x6f, 0xc5, 0x2b, 0x9c, 0x14, 0xb2,
-	0xde, 0x80, 0x57, 0xc7, 0x24, 0x91, 0x25, 0xbb, 0xf5, 0xeb, 0x2c, 0xcc, 0xec, 0x45, 0xae, 0x12,
-	0x82, 0x52, 0x32, 0x69, 0xda, 0x25, 0x51, 0x95, 0x0e, 0x0a, 0xed, 0xf6, 0xa4, 0xc8, 0x8c, 0x59,
-	0xf9, 0x12, 0x20, 0x37, 0x4f, 0x9a, 0x15, 0xf6, 0x12, 0xa1, 0xb5, 0x2f, 0x42, 0x48, 0xcf, 0x5f,
-	0x43, 0x3d, 0x3f, 0x48, 0xd6, 0xcb, 0x0d, 0x73, 0x10, 0xed, 0xb5, 0x0b, 0x21, 0xd2, 0xb9, 0x03,
-	0x8b, 0xe7, 0xfe, 0x4c, 0xdc, 0xa8, 0x30, 0x1e, 0x41, 0x69, 0xb7, 0x26, 0x41, 0xe5, 0x59, 0xce,
-	0x8d, 0x8d, 0x0a, 0x96, 0x51, 0x54, 0x15, 0x4b, 0x79, 0x97, 0x57, 0x7e, 0x44, 0xd0, 0x9a, 0xa0,
-	0x0f, 0xbe, 0x33, 0xce, 0xe9, 0x38, 0x4b, 0xed, 0xa3, 0x69, 0x2d, 0x65, 0x88, 0x3f, 0x20, 0x58,
-	0xbf, 0xb8, 0x2f, 0xbd, 0x3d, 0x8e, 0x67, 0x8c, 0xa1, 0xf6, 0xe1, 0x94, 0x86, 0x32, 0xbe, 0x47,
-	0x08, 0xd4, 0xca, 0x4e, 0xd1, 0x19, 0xe7, 0xbd, 0x88, 0xd7, 0xb6, 0xff, 0x1b, 0x3e, 0x0b, 0xa2,
-	0xdb, 0x7b, 0x7c, 0xd2, 0x40, 0x4f, 0x4e, 0x1a, 0xe8, 0xef, 0x93, 0x06, 0xfa, 0xee, 0xb4, 0x51,
-	0x7b, 0x72, 0xda, 0xa8, 0x3d, 0x3d, 0x6d, 0xd4, 0xbe, 0xda, 0x76, 0x09, 0x1f, 0x0c, 0xfb, 0x1d,
-	0x9b, 0xfa, 0xa3, 0x2f, 0x09, 0x87, 0x77, 0x36, 0xed, 0x81, 0x45, 0x02, 0x5d, 0xee, 0x1c, 0x89,
-	0x37, 0x96, 0xe3, 0x10, 0x47, 0xfd, 0x17, 0x93, 0xed, 0xb7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbb, 0xf1, 0x76, 0x35, 0xd3, 0x0c, 0x00, 0x00,
+func (m *MsgDelistClobPair) Reset()         { *m = MsgDelistClobPair{} }
+func (m *MsgDelistClobPair) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistClobPair) ProtoMessage()    {}
+func (*MsgDelistClobPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{17}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ProposedOperations is a temporary message used by block proposers
-	// for matching orders as part of the ABCI++ workaround.
-	ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error)
-	// PlaceOrder allows accounts to place orders on the orderbook.
-	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
-	// CancelOrder allows accounts to cancel existing orders on the orderbook.
-	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
-	// CreateClobPair creates a new clob pair.
-	CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error)
-	// UpdateClobPair sets the status of a clob pair. Should return an error
-	// if the authority is not in the clob keeper's set of authorities,
-	// if the ClobPair id is not found in state, or if the update includes
-	// an unsupported status transition.
-	UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error)
-	// UpdateEquityTierLimitConfiguration updates the equity tier limit
-	// configuration in state.
-	UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
-	// UpdateBlockRateLimitConfiguration updates the block rate limit
-	// configuration in state.
-	UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
-	// UpdateLiquidationsConfig updates the liquidations configuration in state.
-	UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error)
+func (m *MsgDelistClobPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDelistClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistClobPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgDelistClobPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistClobPair.Merge(m, src)
 }
-
-func (c *msgClient) ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error) {
-	out := new(MsgProposedOperationsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ProposedOperations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDelistClobPair) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
-	out := new(MsgPlaceOrderResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/PlaceOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDelistClobPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistClobPair.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
-	out := new(MsgCancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDelistClobPair proto.InternalMessageInfo
+
+func (m *MsgDelistClobPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error) {
-	out := new(MsgCreateClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CreateClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelistClobPair) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error) {
-	out := new(MsgUpdateClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelistClobPair) GetSettlementPrice() uint64 {
+	if m != nil {
+		return m.SettlementPrice
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
-	out := new(MsgUpdateEquityTierLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDelistClobPairResponse is a response type used for delisting a clob pair.
+type MsgDelistClobPairResponse struct {
 }
 
-func (c *msgClient) UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
-	out := new(MsgUpdateBlockRateLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelistClobPairResponse) Reset()         { *m = MsgDelistClobPairResponse{} }
+func (m *MsgDelistClobPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistClobPairResponse) ProtoMessage()    {}
+func (*MsgDelistClobPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{18}
+}
+func (m *MsgDelistClobPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistClobPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDelistClobPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistClobPairResponse.Merge(m, src)
+}
+func (m *MsgDelistClobPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistClobPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistClobPairResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error) {
-	out := new(MsgUpdateLiquidationsConfigResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgDelistClobPairResponse proto.InternalMessageInfo
+
+// OperationRaw represents an operation in the proposed operations.
+// Note that the `order_placement` operation is a signed message.
+type OperationRaw struct {
+	// operationRaw represents an operation that occurred, which can be a match,
+	// a signed order placement, or an order removal.
+	//
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*OperationRaw_Match
+	//	*OperationRaw_ShortTermOrderPlacement
+	//	*OperationRaw_OrderRemoval
+	Operation isOperationRaw_Operation `protobuf_oneof:"operation"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ProposedOperations is a temporary message used by block proposers
-	// for matching orders as part of the ABCI++ workaround.
-	ProposedOperations(context.Context, *MsgProposedOperations) (*MsgProposedOperationsResponse, error)
-	// PlaceOrder allows accounts to place orders on the orderbook.
-	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
-	// CancelOrder allows accounts to cancel existing orders on the orderbook.
-	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
-	// CreateClobPair creates a new clob pair.
-	CreateClobPair(context.Context, *MsgCreateClobPair) (*MsgCreateClobPairResponse, error)
-	// UpdateClobPair sets the status of a clob pair. Should return an error
-	// if the authority is not in the clob keeper's set of authorities,
-	// if the ClobPair id is not found in state, or if the update includes
-	// an unsupported status transition.
-	UpdateClobPair(context.Context, *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error)
-	// UpdateEquityTierLimitConfiguration updates the equity tier limit
-	// configuration in state.
-	UpdateEquityTierLimitConfiguration(context.Context, *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
-	// UpdateBlockRateLimitConfiguration updates the block rate limit
-	// configuration in state.
-	UpdateBlockRateLimitConfiguration(context.Context, *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
-	// UpdateLiquidationsConfig updates the liquidations configuration in state.
-	UpdateLiquidationsConfig(context.Context, *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error)
+func (m *OperationRaw) Reset()         { *m = OperationRaw{} }
+func (m *OperationRaw) String() string { return proto.CompactTextString(m) }
+func (*OperationRaw) ProtoMessage()    {}
+func (*OperationRaw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{19}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *OperationRaw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) ProposedOperations(ctx context.Context, req *MsgProposedOperations) (*MsgProposedOperationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposedOperations not implemented")
+func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationRaw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+func (m *OperationRaw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationRaw.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+func (m *OperationRaw) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CreateClobPair(ctx context.Context, req *MsgCreateClobPair) (*MsgCreateClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClobPair not implemented")
+func (m *OperationRaw) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationRaw.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateClobPair(ctx context.Context, req *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClobPair not implemented")
+
+var xxx_messageInfo_OperationRaw proto.InternalMessageInfo
+
+type isOperationRaw_Operation interface {
+	isOperationRaw_Operation()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
-func (*UnimplementedMsgServer) UpdateEquityTierLimitConfiguration(ctx context.Context, req *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEquityTierLimitConfiguration not implemented")
+
+type OperationRaw_Match struct {
+	Match *ClobMatch `protobuf:"bytes,1,opt,name=match,proto3,oneof" json:"match,omitempty"`
 }
-func (*UnimplementedMsgServer) UpdateBlockRateLimitConfiguration(ctx context.Context, req *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockRateLimitConfiguration not implemented")
+type OperationRaw_ShortTermOrderPlacement struct {
+	ShortTermOrderPlacement []byte `protobuf:"bytes,2,opt,name=short_term_order_placement,json=shortTermOrderPlacement,proto3,oneof" json:"short_term_order_placement,omitempty"`
 }
-func (*UnimplementedMsgServer) UpdateLiquidationsConfig(ctx context.Context, req *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationsConfig not implemented")
+type OperationRaw_OrderRemoval struct {
+	OrderRemoval *OrderRemoval `protobuf:"bytes,3,opt,name=order_removal,json=orderRemoval,proto3,oneof" json:"order_removal,omitempty"`
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+func (*OperationRaw_Match) isOperationRaw_Operation()                   {}
+func (*OperationRaw_ShortTermOrderPlacement) isOperationRaw_Operation() {}
+func (*OperationRaw_OrderRemoval) isOperationRaw_Operation()            {}
 
-func _Msg_ProposedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProposedOperations)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProposedOperations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/ProposedOperations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProposedOperations(ctx, req.(*MsgProposedOperations))
+func (m *OperationRaw) GetOperation() isOperationRaw_Operation {
+	if m != nil {
+		return m.Operation
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/PlaceOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+func (m *OperationRaw) GetMatch() *ClobMatch {
+	if x, ok := m.GetOperation().(*OperationRaw_Match); ok {
+		return x.Match
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/CancelOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+func (m *OperationRaw) GetShortTermOrderPlacement() []byte {
+	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderPlacement); ok {
+		return x.ShortTermOrderPlacement
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateClobPair)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/CreateClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateClobPair(ctx, req.(*MsgCreateClobPair))
+func (m *OperationRaw) GetOrderRemoval() *OrderRemoval {
+	if x, ok := m.GetOperation().(*OperationRaw_OrderRemoval); ok {
+		return x.OrderRemoval
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClobPair)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClobPair(ctx, req.(*MsgUpdateClobPair))
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*OperationRaw) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*OperationRaw_Match)(nil),
+		(*OperationRaw_ShortTermOrderPlacement)(nil),
+		(*OperationRaw_OrderRemoval)(nil),
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateEquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateEquityTierLimitConfiguration)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, req.(*MsgUpdateEquityTierLimitConfiguration))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
+// request type.
+type MsgUpdateEquityTierLimitConfiguration struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the equity tier limit configuration to update to. All fields must
+	// be set.
+	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,2,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
 }
 
-func _Msg_UpdateBlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateBlockRateLimitConfiguration)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration",
+func (m *MsgUpdateEquityTierLimitConfiguration) Reset()         { *m = MsgUpdateEquityTierLimitConfiguration{} }
+func (m *MsgUpdateEquityTierLimitConfiguration) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEquityTierLimitConfiguration) ProtoMessage()    {}
+func (*MsgUpdateEquityTierLimitConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{20}
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, req.(*MsgUpdateBlockRateLimitConfiguration))
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Merge(m, src)
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration proto.InternalMessageInfo
+
+func (m *MsgUpdateEquityTierLimitConfiguration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateLiquidationsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateLiquidationsConfig)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateEquityTierLimitConfiguration) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
+	if m != nil {
+		return m.EquityTierLimitConfig
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, req.(*MsgUpdateLiquidationsConfig))
-	}
-	return interceptor(ctx, in, info, handler)
+	return EquityTierLimitConfiguration{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.clob.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ProposedOperations",
-			Handler:    _Msg_ProposedOperations_Handler,
-		},
-		{
-			MethodName: "PlaceOrder",
-			Handler:    _Msg_PlaceOrder_Handler,
-		},
-		{
-			MethodName: "CancelOrder",
-			Handler:    _Msg_CancelOrder_Handler,
-		},
-		{
-			MethodName: "CreateClobPair",
-			Handler:    _Msg_CreateClobPair_Handler,
-		},
-		{
-			MethodName: "UpdateClobPair",
-			Handler:    _Msg_UpdateClobPair_Handler,
-		},
-		{
-			MethodName: "UpdateEquityTierLimitConfiguration",
-			Handler:    _Msg_UpdateEquityTierLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "UpdateBlockRateLimitConfiguration",
-			Handler:    _Msg_UpdateBlockRateLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "UpdateLiquidationsConfig",
-			Handler:    _Msg_UpdateLiquidationsConfig_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/clob/tx.proto",
+// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
+// response type.
+type MsgUpdateEquityTierLimitConfigurationResponse struct {
 }
 
-func (m *MsgCreateClobPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Reset() {
+	*m = MsgUpdateEquityTierLimitConfigurationResponse{}
 }
-
-func (m *MsgCreateClobPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *MsgCreateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (*MsgUpdateEquityTierLimitConfigurationResponse) ProtoMessage() {}
+func (*MsgUpdateEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{21}
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Merge(m, src)
 }
-
-func (m *MsgCreateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProposedOperations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse proto.InternalMessageInfo
 
-func (m *MsgProposedOperations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateBlockRateLimitConfiguration is the Msg/BlockRateLimitConfiguration
+// request type.
+type MsgUpdateBlockRateLimitConfiguration struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the block rate limit configuration to update to. All fields must be
+	// set.
+	BlockRateLimitConfig BlockRateLimitConfiguration `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
 }
 
-func (m *MsgProposedOperations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperationsQueue) > 0 {
-		for iNdEx := len(m.OperationsQueue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OperationsQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgUpdateBlockRateLimitConfiguration) Reset()         { *m = MsgUpdateBlockRateLimitConfiguration{} }
+func (m *MsgUpdateBlockRateLimitConfiguration) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlockRateLimitConfiguration) ProtoMessage()    {}
+func (*MsgUpdateBlockRateLimitConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{22}
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgProposedOperationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Merge(m, src)
 }
-
-func (m *MsgProposedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgProposedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration proto.InternalMessageInfo
+
+func (m *MsgUpdateBlockRateLimitConfiguration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlockRateLimitConfiguration) GetBlockRateLimitConfig() BlockRateLimitConfiguration {
+	if m != nil {
+		return m.BlockRateLimitConfig
+	}
+	return BlockRateLimitConfiguration{}
 }
 
-func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// MsgUpdateBlockRateLimitConfiguration is a response type for updating the
+// liquidations config.
+type MsgUpdateBlockRateLimitConfigurationResponse struct {
 }
 
-func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Reset() {
+	*m = MsgUpdateBlockRateLimitConfigurationResponse{}
 }
-
-func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*MsgUpdateBlockRateLimitConfigurationResponse) ProtoMessage() {}
+func (*MsgUpdateBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{23}
 }
-
-func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Merge(m, src)
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse proto.InternalMessageInfo
+
+// MsgUpdateLiquidationsConfig is a request type for updating the liquidations
+// config.
+type MsgUpdateLiquidationsConfig struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the liquidations configuration to update to. All fields must
+	// be set.
+	LiquidationsConfig LiquidationsConfig `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
 }
 
-func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GoodTilOneof != nil {
-		{
-			size := m.GoodTilOneof.Size()
-			i -= size
-			if _, err := m.GoodTilOneof.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	{
-		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateLiquidationsConfig) Reset()         { *m = MsgUpdateLiquidationsConfig{} }
+func (m *MsgUpdateLiquidationsConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLiquidationsConfig) ProtoMessage()    {}
+func (*MsgUpdateLiquidationsConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{24}
+}
+func (m *MsgUpdateLiquidationsConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLiquidationsConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCancelOrder_GoodTilBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateLiquidationsConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLiquidationsConfig.Merge(m, src)
 }
-
-func (m *MsgCancelOrder_GoodTilBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+func (m *MsgUpdateLiquidationsConfig) XXX_Size() int {
+	return m.Size()
 }
-func (m *MsgCancelOrder_GoodTilBlockTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateLiquidationsConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLiquidationsConfig.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrder_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 4
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GoodTilBlockTime))
-	i--
-	dAtA[i] = 0x1d
-	return len(dAtA) - i, nil
-}
-func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateLiquidationsConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateLiquidationsConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateLiquidationsConfig) GetLiquidationsConfig() LiquidationsConfig {
+	if m != nil {
+		return m.LiquidationsConfig
+	}
+	return LiquidationsConfig{}
 }
 
-func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateLiquidationsConfig is the Msg/LiquidationsConfig response type.
+type MsgUpdateLiquidationsConfigResponse struct {
 }
 
-func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateLiquidationsConfigResponse) Reset()         { *m = MsgUpdateLiquidationsConfigResponse{} }
+func (m *MsgUpdateLiquidationsConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLiquidationsConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateLiquidationsConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{25}
 }
-
-func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateLiquidationsConfigResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateClobPair)(nil), "dydxprotocol.clob.MsgCreateClobPair")
+	proto.RegisterType((*MsgCreateClobPairResponse)(nil), "dydxprotocol.clob.MsgCreateClobPairResponse")
+	proto.RegisterType((*MsgProposedOperations)(nil), "dydxprotocol.clob.MsgProposedOperations")
+	proto.RegisterType((*MsgProposedOperationsResponse)(nil), "dydxprotocol.clob.MsgProposedOperationsResponse")
+	proto.RegisterType((*MsgPlaceOrder)(nil), "dydxprotocol.clob.MsgPlaceOrder")
+	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "dydxprotocol.clob.MsgPlaceOrderResponse")
+	proto.RegisterType((*MsgCancelOrder)(nil), "dydxprotocol.clob.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "dydxprotocol.clob.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgReplaceOrder)(nil), "dydxprotocol.clob.MsgReplaceOrder")
+	proto.RegisterType((*MsgReplaceOrderResponse)(nil), "dydxprotocol.clob.MsgReplaceOrderResponse")
+	proto.RegisterType((*OrderBatch)(nil), "dydxprotocol.clob.OrderBatch")
+	proto.RegisterType((*MsgBatchCancel)(nil), "dydxprotocol.clob.MsgBatchCancel")
+	proto.RegisterType((*MsgBatchCancelResponse)(nil), "dydxprotocol.clob.MsgBatchCancelResponse")
+	proto.RegisterType((*MsgCancelAllOrders)(nil), "dydxprotocol.clob.MsgCancelAllOrders")
+	proto.RegisterType((*MsgCancelAllOrdersResponse)(nil), "dydxprotocol.clob.MsgCancelAllOrdersResponse")
+	proto.RegisterType((*MsgUpdateClobPair)(nil), "dydxprotocol.clob.MsgUpdateClobPair")
+	proto.RegisterType((*MsgUpdateClobPairResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairResponse")
+	proto.RegisterType((*MsgDelistClobPair)(nil), "dydxprotocol.clob.MsgDelistClobPair")
+	proto.RegisterType((*MsgDelistClobPairResponse)(nil), "dydxprotocol.clob.MsgDelistClobPairResponse")
+	proto.RegisterType((*OperationRaw)(nil), "dydxprotocol.clob.OperationRaw")
+	proto.RegisterType((*MsgUpdateEquityTierLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration")
+	proto.RegisterType((*MsgUpdateEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse")
+	proto.RegisterType((*MsgUpdateBlockRateLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration")
+	proto.RegisterType((*MsgUpdateBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse")
+	proto.RegisterType((*MsgUpdateLiquidationsConfig)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfig")
+	proto.RegisterType((*MsgUpdateLiquidationsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse")
 }
 
-func (m *MsgUpdateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
+
+var fileDescriptor_19b9e2c0de4ab64a = []byte{
+	// 1326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdf, 0x6f, 0xdb, 0xd4,
+	0x17, 0x8f, 0x9b, 0x7e, 0xbf, 0x5b, 0x4f, 0x92, 0x36, 0xf3, 0x36, 0x9a, 0x79, 0x34, 0xcd, 0xcc,
+	0x3a, 0xa5, 0xa3, 0x4d, 0x46, 0x99, 0x06, 0x02, 0xf1, 0x63, 0x19, 0x1b, 0x19, 0x5a, 0x59, 0xe6,
+	0x15, 0x09, 0x01, 0xc2, 0x72, 0xec, 0x5b, 0xf7, 0x6a, 0x4e, 0x6e, 0xe6, 0xeb, 0x8c, 0xf5, 0x75,
+	0x7f, 0x01, 0x8f, 0x48, 0x08, 0x89, 0x47, 0x1e, 0x79, 0xd8, 0x03, 0xef, 0xbc, 0xec, 0x09, 0x4d,
+	0x7b, 0x9a, 0x84, 0x04, 0x68, 0x7b, 0xe0, 0xdf, 0x40, 0xbe, 0xd7, 0xbe, 0xb1, 0x63, 0x3b, 0x49,
+	0x3b, 0x26, 0xf1, 0xb2, 0xd9, 0xe7, 0x7e, 0xce, 0xef, 0x8f, 0xcf, 0x3d, 0x0d, 0x28, 0xd6, 0xbe,
+	0x75, 0x7f, 0xe0, 0x12, 0x8f, 0x98, 0xc4, 0x69, 0x9a, 0x0e, 0xe9, 0x36, 0xbd, 0xfb, 0x0d, 0x26,
+	0x90, 0x8f, 0x45, 0xcf, 0x1a, 0xfe, 0x99, 0x72, 0xca, 0x24, 0xb4, 0x47, 0xa8, 0xce, 0xa4, 0x4d,
+	0xfe, 0xc2, 0xd1, 0xca, 0x32, 0x7f, 0x6b, 0xf6, 0xa8, 0xdd, 0xbc, 0xf7, 0x86, 0xff, 0x5f, 0x70,
+	0x70, 0xc2, 0x26, 0x36, 0xe1, 0x0a, 0xfe, 0x53, 0x20, 0x6d, 0x26, 0x1d, 0x77, 0x1d, 0x62, 0xde,
+	0xd1, 0x5d, 0xc3, 0x43, 0xba, 0x83, 0x7b, 0xd8, 0xd3, 0x4d, 0xd2, 0xdf, 0xc5, 0xa1, 0x99, 0x33,
+	0x49, 0x05, 0xff, 0x1f, 0x7d, 0x60, 0x60, 0x37, 0x80, 0x5c, 0x48, 0x42, 0xd0, 0xdd, 0x21, 0xf6,
+	0xf6, 0x75, 0x0f, 0x23, 0x37, 0xcd, 0xe8, 0x6a, 0x52, 0xa3, 0x67, 0x78, 0xe6, 0x1e, 0x0a, 0xb3,
+	0x5a, 0x49, 0x02, 0x88, 0x6b, 0xa1, 0xd0, 0xe3, 0xb9, 0x8c, 0x63, 0xdd, 0x45, 0x3d, 0x72, 0xcf,
+	0x70, 0x42, 0x33, 0xaf, 0x27, 0x71, 0x0e, 0xbe, 0x3b, 0xc4, 0x96, 0xe1, 0x61, 0xd2, 0xa7, 0xf1,
+	0xa0, 0xd6, 0x63, 0x60, 0x3a, 0xec, 0x1a, 0xa6, 0x49, 0x86, 0x7d, 0x8f, 0x46, 0x9e, 0x39, 0x54,
+	0xfd, 0x5e, 0x82, 0x63, 0xdb, 0xd4, 0xbe, 0xe2, 0x22, 0xc3, 0x43, 0x57, 0x1c, 0xd2, 0xed, 0x18,
+	0xd8, 0x95, 0x2f, 0xc1, 0x82, 0x31, 0xf4, 0xf6, 0x88, 0x8b, 0xbd, 0xfd, 0x8a, 0x54, 0x93, 0xea,
+	0x0b, 0xad, 0xca, 0x93, 0x87, 0x9b, 0x27, 0x82, 0x7e, 0x5d, 0xb6, 0x2c, 0x17, 0x51, 0x7a, 0xdb,
+	0x73, 0x71, 0xdf, 0xd6, 0x46, 0x50, 0xf9, 0x7d, 0x58, 0x10, 0x25, 0xad, 0xcc, 0xd5, 0xa4, 0x7a,
+	0x61, 0xeb, 0x74, 0x23, 0x41, 0x82, 0x46, 0xe8, 0xa7, 0x35, 0xff, 0xe8, 0x8f, 0xd5, 0x9c, 0x76,
+	0xd4, 0x0c, 0xde, 0xdf, 0x59, 0x7c, 0xf0, 0xf7, 0xcf, 0xe7, 0x47, 0xf6, 0xd4, 0xd3, 0x70, 0x2a,
+	0x11, 0x9c, 0x86, 0xe8, 0x80, 0xf4, 0x29, 0x52, 0x31, 0x9c, 0xdc, 0xa6, 0x76, 0xc7, 0x25, 0x03,
+	0x42, 0x91, 0x75, 0x73, 0x80, 0x5c, 0x5e, 0x0b, 0xb9, 0x03, 0x65, 0x22, 0xde, 0xf4, 0xbb, 0x43,
+	0x34, 0x44, 0x15, 0xa9, 0x96, 0xaf, 0x17, 0xb6, 0x56, 0x53, 0x82, 0x11, 0x8a, 0x9a, 0xf1, 0x4d,
+	0x10, 0xd0, 0xd2, 0x48, 0xfd, 0x96, 0xaf, 0xad, 0xae, 0xc2, 0x4a, 0xaa, 0x2b, 0x11, 0xcb, 0x55,
+	0x28, 0xf9, 0x00, 0xc7, 0x30, 0xd1, 0x4d, 0xbf, 0x7d, 0xf2, 0x45, 0xf8, 0x1f, 0xeb, 0x23, 0xab,
+	0x5e, 0x61, 0xab, 0x92, 0xe6, 0xd8, 0x3f, 0x0f, 0x3c, 0x72, 0xb0, 0xba, 0x0c, 0x27, 0x63, 0x66,
+	0x84, 0xfd, 0x5f, 0x24, 0x58, 0xf4, 0x2b, 0x61, 0xf4, 0x4d, 0xe4, 0x70, 0x0f, 0xef, 0xc2, 0x51,
+	0xce, 0x14, 0x6c, 0x05, 0x4e, 0x94, 0x2c, 0x27, 0xd7, 0xad, 0xc0, 0xcd, 0x11, 0xc2, 0x5f, 0xe5,
+	0x73, 0xb0, 0x68, 0x13, 0x62, 0xe9, 0x1e, 0x76, 0x74, 0xf6, 0xd5, 0xb0, 0x6e, 0x95, 0xda, 0x39,
+	0xad, 0xe8, 0xcb, 0x77, 0xb0, 0xd3, 0xf2, 0xa5, 0x72, 0x13, 0x8e, 0xc7, 0x71, 0xba, 0x87, 0x7b,
+	0xa8, 0x92, 0xaf, 0x49, 0xf5, 0x23, 0xed, 0x9c, 0x56, 0x8e, 0x82, 0x77, 0x70, 0x0f, 0xb5, 0xca,
+	0x11, 0xc3, 0xa4, 0x8f, 0xc8, 0xae, 0x5a, 0x81, 0x57, 0xe2, 0x91, 0x8b, 0xa4, 0x3e, 0x86, 0xa5,
+	0x6d, 0x6a, 0x6b, 0x68, 0xf0, 0xa2, 0x65, 0x3b, 0x05, 0xcb, 0x63, 0x86, 0x84, 0x8f, 0x3e, 0x00,
+	0x57, 0xf0, 0x3f, 0x4a, 0xb9, 0x06, 0x45, 0xc1, 0xcf, 0xb0, 0x6e, 0x25, 0x0d, 0x42, 0xfe, 0x5d,
+	0xb7, 0xe4, 0x55, 0x28, 0xf0, 0xaa, 0xee, 0x3a, 0x86, 0x4d, 0x79, 0x55, 0x34, 0x60, 0xa2, 0x6b,
+	0xbe, 0x44, 0x5e, 0x01, 0x30, 0x1d, 0x8c, 0xfa, 0x9e, 0x8e, 0x2d, 0x5a, 0xc9, 0xd7, 0xf2, 0xf5,
+	0x23, 0xda, 0x02, 0x97, 0x5c, 0xb7, 0xa8, 0xfa, 0xdd, 0x1c, 0x6b, 0x14, 0x73, 0xc7, 0x73, 0x96,
+	0x6f, 0x41, 0x69, 0xf4, 0xd9, 0x8d, 0xba, 0x75, 0x2e, 0x9e, 0xdb, 0x08, 0x42, 0x1b, 0xb7, 0xc5,
+	0xb3, 0xe8, 0x5c, 0x91, 0x46, 0x64, 0x72, 0x1b, 0x4a, 0x3c, 0xca, 0x2e, 0x9f, 0x35, 0x95, 0x39,
+	0x46, 0xef, 0x95, 0xcc, 0x72, 0xf9, 0xb0, 0xd0, 0x12, 0x11, 0x12, 0x44, 0x53, 0x88, 0x90, 0x3f,
+	0x08, 0x11, 0xe6, 0x0f, 0x40, 0x84, 0x87, 0x12, 0x63, 0x42, 0xa4, 0x34, 0x61, 0x97, 0xe4, 0x4f,
+	0x41, 0x36, 0x99, 0x04, 0x59, 0x7a, 0x48, 0x6a, 0x1a, 0x7c, 0xb3, 0xd3, 0x59, 0x5d, 0x0e, 0x75,
+	0x03, 0x31, 0x95, 0x3f, 0x81, 0xf2, 0xae, 0x81, 0xe3, 0xd6, 0xe6, 0x66, 0xb4, 0xb6, 0xc8, 0x35,
+	0x43, 0x5b, 0xea, 0x13, 0x09, 0x64, 0x41, 0xe0, 0xcb, 0x0e, 0xe7, 0x30, 0x7d, 0x19, 0x5d, 0x55,
+	0xa1, 0x14, 0x65, 0x27, 0x0f, 0xb9, 0xa4, 0x15, 0x46, 0xf4, 0xa4, 0xe3, 0xfc, 0xcc, 0xd7, 0xf2,
+	0x63, 0xfc, 0x3c, 0x9b, 0x68, 0xe8, 0x3c, 0xe3, 0x70, 0xac, 0x9d, 0xaa, 0x03, 0x4a, 0x32, 0xa7,
+	0x97, 0xd5, 0x8e, 0xf0, 0x92, 0xf9, 0x6c, 0x60, 0xfd, 0x77, 0x2f, 0x99, 0x78, 0x70, 0x62, 0x7e,
+	0xfc, 0xc4, 0x43, 0xff, 0x08, 0x39, 0x98, 0x7a, 0x2f, 0x1c, 0xfa, 0xf8, 0xfc, 0x99, 0x4b, 0xcc,
+	0x9f, 0x75, 0x28, 0x53, 0xe4, 0x79, 0x0e, 0xea, 0xf9, 0x23, 0x66, 0xe0, 0x62, 0x93, 0x4f, 0xdb,
+	0x79, 0x6d, 0x69, 0x24, 0xef, 0xf8, 0xe2, 0x8c, 0x3c, 0xe2, 0x91, 0x8a, 0x3c, 0x9e, 0x4a, 0x50,
+	0x8c, 0xde, 0x74, 0xfe, 0xa4, 0x65, 0x8b, 0x4a, 0xc0, 0xdb, 0x57, 0x33, 0x2a, 0xb8, 0xed, 0x63,
+	0xda, 0x39, 0x8d, 0x83, 0xe5, 0xf7, 0x40, 0xa1, 0x7b, 0xc4, 0xf5, 0x74, 0x0f, 0xb9, 0xbd, 0x80,
+	0x1b, 0x6c, 0xea, 0xfa, 0x61, 0xb1, 0x74, 0x8a, 0xed, 0x9c, 0xb6, 0xcc, 0x30, 0x3b, 0xc8, 0xed,
+	0x31, 0x0a, 0x74, 0x42, 0x80, 0x7c, 0x0d, 0x4a, 0xb1, 0xed, 0x86, 0xa5, 0x96, 0x71, 0x2d, 0xf3,
+	0x31, 0xce, 0x60, 0xed, 0x70, 0x6a, 0x05, 0xef, 0xad, 0x02, 0x2c, 0x88, 0x2b, 0x5a, 0xfd, 0x53,
+	0x82, 0x35, 0xd1, 0xc0, 0xab, 0x6c, 0x5d, 0xdb, 0xc1, 0xc8, 0xbd, 0xe1, 0x2f, 0x6b, 0x57, 0xd8,
+	0x5a, 0x34, 0xe4, 0xc8, 0x43, 0xb7, 0xad, 0x0f, 0x95, 0xac, 0x35, 0x30, 0x20, 0x60, 0x33, 0x25,
+	0x83, 0x49, 0xa1, 0x04, 0xa4, 0x3c, 0x89, 0xd2, 0x30, 0x89, 0xce, 0x36, 0x61, 0x73, 0xa6, 0x04,
+	0x45, 0xb7, 0x7f, 0x97, 0xe0, 0xac, 0xd0, 0x60, 0x5f, 0xbc, 0x66, 0x78, 0xe8, 0x5f, 0xac, 0xc8,
+	0x1d, 0x58, 0xce, 0x58, 0xb6, 0x83, 0x96, 0x36, 0x52, 0x0a, 0x32, 0x21, 0x90, 0xa0, 0x1e, 0x27,
+	0xba, 0x29, 0x90, 0x44, 0x39, 0x1a, 0xb0, 0x31, 0x4b, 0x72, 0xa2, 0x1a, 0xbf, 0x4a, 0x70, 0x5a,
+	0x28, 0xdc, 0x88, 0x6c, 0xcd, 0x1c, 0x7e, 0xe8, 0x22, 0x7c, 0x05, 0xc7, 0x53, 0x76, 0xf0, 0x80,
+	0x11, 0x6b, 0x29, 0x05, 0x48, 0xfa, 0x0e, 0xf2, 0x96, 0x9d, 0xc4, 0x49, 0x22, 0xeb, 0x35, 0x78,
+	0x6d, 0x42, 0x12, 0x61, 0xb2, 0x5b, 0xbf, 0x01, 0xe4, 0xb7, 0xa9, 0x2d, 0x0f, 0x40, 0x4e, 0x59,
+	0x8d, 0xeb, 0x29, 0x51, 0xa5, 0x6e, 0xb6, 0xca, 0x85, 0x59, 0x91, 0xe2, 0xd6, 0xf8, 0x1c, 0x20,
+	0xb2, 0x00, 0xd7, 0x32, 0xf4, 0x05, 0x42, 0xa9, 0x4f, 0x43, 0x08, 0xcb, 0x5f, 0x42, 0x21, 0xba,
+	0xf9, 0x9e, 0x49, 0x57, 0x8c, 0x40, 0x94, 0xf5, 0xa9, 0x10, 0x61, 0xfc, 0x6b, 0x28, 0xc6, 0x56,
+	0x50, 0x35, 0x5d, 0x35, 0x8a, 0x51, 0xce, 0x4f, 0xc7, 0x44, 0x83, 0x8f, 0x6e, 0x83, 0x19, 0xc1,
+	0x47, 0x20, 0xca, 0xfa, 0x54, 0x88, 0x30, 0x6e, 0xc3, 0xd2, 0xf8, 0x62, 0xb2, 0x36, 0x29, 0x75,
+	0x01, 0x53, 0x36, 0x67, 0x82, 0x09, 0x47, 0x16, 0x2c, 0x8e, 0xfd, 0x8d, 0x78, 0x36, 0xc3, 0x40,
+	0x0c, 0xa5, 0x6c, 0xcc, 0x82, 0x8a, 0x7a, 0x19, 0x5b, 0x12, 0x32, 0xbc, 0xc4, 0x51, 0xca, 0xc6,
+	0x2c, 0xa8, 0xa8, 0x97, 0xb1, 0xfb, 0x3c, 0xc3, 0x4b, 0x1c, 0xa5, 0x6c, 0xcc, 0x82, 0x12, 0x5e,
+	0x7e, 0x94, 0x40, 0x9d, 0xe1, 0x4e, 0x7a, 0x7b, 0x52, 0xe8, 0x93, 0x34, 0x95, 0x0f, 0x0f, 0xab,
+	0x29, 0x42, 0xfc, 0x41, 0x82, 0x33, 0xd3, 0xef, 0x88, 0xb7, 0x26, 0xf9, 0x99, 0xa0, 0xa8, 0x7c,
+	0x70, 0x48, 0x45, 0x11, 0xdf, 0x03, 0x09, 0x2a, 0x99, 0x53, 0xbb, 0x31, 0xc9, 0x7a, 0x12, 0xaf,
+	0x5c, 0x3a, 0x18, 0x3e, 0x0c, 0xa2, 0xd5, 0x79, 0xf4, 0xac, 0x2a, 0x3d, 0x7e, 0x56, 0x95, 0xfe,
+	0x7a, 0x56, 0x95, 0xbe, 0x7d, 0x5e, 0xcd, 0x3d, 0x7e, 0x5e, 0xcd, 0x3d, 0x7d, 0x5e, 0xcd, 0x7d,
+	0x71, 0xc9, 0xc6, 0xde, 0xde, 0xb0, 0xdb, 0x30, 0x49, 0x2f, 0xfe, 0x63, 0xd4, 0xbd, 0x8b, 0x9b,
+	0xe6, 0x9e, 0x81, 0xfb, 0x4d, 0x21, 0xb9, 0x1f, 0xfc, 0x32, 0xb6, 0x3f, 0x40, 0xb4, 0xfb, 0x7f,
+	0x26, 0x7e, 0xf3, 0x9f, 0x01, 0x00, 0xa7, 0x6e, 0xd8, 0x5c, 0x3b, 0x13, 0x00, 0x00,
 }
 
-func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *OperationRaw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *OperationRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Operation != nil {
-		{
-			size := m.Operation.Size()
-			i -= size
-			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ProposedOperations is a temporary message used by block proposers
+	// for matching orders as part of the ABCI++ workaround.
+	ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error)
+	// PlaceOrder allows accounts to place orders on the orderbook.
+	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
+	// CancelOrder allows accounts to cancel existing orders on the orderbook.
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// ReplaceOrder allows accounts to atomically replace the size and price of
+	// existing orders on the orderbook.
+	ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error)
+	// BatchCancel allows accounts to cancel a batch of orders of a subaccount.
+	BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error)
+	// CancelAllOrders allows accounts to cancel all orders of a subaccount,
+	// optionally scoped to clob pairs and order flags.
+	CancelAllOrders(ctx context.Context, in *MsgCancelAllOrders, opts ...grpc.CallOption) (*MsgCancelAllOrdersResponse, error)
+	// CreateClobPair creates a new clob pair.
+	CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error)
+	// UpdateClobPair sets the status of a clob pair. Should return an error
+	// if the authority is not in the clob keeper's set of authorities,
+	// if the ClobPair id is not found in state, or if the update includes
+	// an unsupported status transition.
+	UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error)
+	// DelistClobPair moves a perpetual clob pair to final settlement, removes
+	// all of its stateful orders and closes all open positions in its perpetual
+	// at the settlement price. Should return an error if the authority is not in
+	// the clob keeper's set of authorities, or if the clob pair is not a
+	// perpetual clob pair or was already delisted.
+	DelistClobPair(ctx context.Context, in *MsgDelistClobPair, opts ...grpc.CallOption) (*MsgDelistClobPairResponse, error)
+	// UpdateEquityTierLimitConfiguration updates the equity tier limit
+	// configuration in state.
+	UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
+	// UpdateBlockRateLimitConfiguration updates the block rate limit
+	// configuration in state.
+	UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
+	// UpdateLiquidationsConfig updates the liquidations configuration in state.
+	UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error)
 }
 
-func (m *OperationRaw_Match) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *OperationRaw_Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Match != nil {
-		{
-			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-func (m *OperationRaw_ShortTermOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *OperationRaw_ShortTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ShortTermOrderPlacement != nil {
-		i -= len(m.ShortTermOrderPlacement)
-		copy(dAtA[i:], m.ShortTermOrderPlacement)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortTermOrderPlacement)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error) {
+	out := new(MsgProposedOperationsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ProposedOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *OperationRaw_OrderRemoval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *OperationRaw_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OrderRemoval != nil {
-		{
-			size, err := m.OrderRemoval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
-}
-func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
+	out := new(MsgPlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/PlaceOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error) {
+	out := new(MsgReplaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error) {
+	out := new(MsgBatchCancelResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/BatchCancel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelAllOrders(ctx context.Context, in *MsgCancelAllOrders, opts ...grpc.CallOption) (*MsgCancelAllOrdersResponse, error) {
+	out := new(MsgCancelAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error) {
+	out := new(MsgCreateClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CreateClobPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error) {
+	out := new(MsgUpdateClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateClobPair", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DelistClobPair(ctx context.Context, in *MsgDelistClobPair, opts ...grpc.CallOption) (*MsgDelistClobPairResponse, error) {
+	out := new(MsgDelistClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/DelistClobPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
+	out := new(MsgUpdateEquityTierLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
+	out := new(MsgUpdateBlockRateLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error) {
+	out := new(MsgUpdateLiquidationsConfigResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ProposedOperations is a temporary message used by block proposers
+	// for matching orders as part of the ABCI++ workaround.
+	ProposedOperations(context.Context, *MsgProposedOperations) (*MsgProposedOperationsResponse, error)
+	// PlaceOrder allows accounts to place orders on the orderbook.
+	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
+	// CancelOrder allows accounts to cancel existing orders on the orderbook.
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	// ReplaceOrder allows accounts to atomically replace the size and price of
+	// existing orders on the orderbook.
+	ReplaceOrder(context.Context, *MsgReplaceOrder) (*MsgReplaceOrderResponse, error)
+	// BatchCancel allows accounts to cancel a batch of orders of a subaccount.
+	BatchCancel(context.Context, *MsgBatchCancel) (*MsgBatchCancelResponse, error)
+	// CancelAllOrders allows accounts to cancel all orders of a subaccount,
+	// optionally scoped to clob pairs and order flags.
+	CancelAllOrders(context.Context, *MsgCancelAllOrders) (*MsgCancelAllOrdersResponse, error)
+	// CreateClobPair creates a new clob pair.
+	CreateClobPair(context.Context, *MsgCreateClobPair) (*MsgCreateClobPairResponse, error)
+	// UpdateClobPair sets the status of a clob pair. Should return an error
+	// if the authority is not in the clob keeper's set of authorities,
+	// if the ClobPair id is not found in state, or if the update includes
+	// an unsupported status transition.
+	UpdateClobPair(context.Context, *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error)
+	// DelistClobPair moves a perpetual clob pair to final settlement, removes
+	// all of its stateful orders and closes all open positions in its perpetual
+	// at the settlement price. Should return an error if the authority is not in
+	// the clob keeper's set of authorities, or if the clob pair is not a
+	// perpetual clob pair or was already delisted.
+	DelistClobPair(context.Context, *MsgDelistClobPair) (*MsgDelistClobPairResponse, error)
+	// UpdateEquityTierLimitConfiguration updates the equity tier limit
+	// configuration in state.
+	UpdateEquityTierLimitConfiguration(context.Context, *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
+	// UpdateBlockRateLimitConfiguration updates the block rate limit
+	// configuration in state.
+	UpdateBlockRateLimitConfiguration(context.Context, *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
+	// UpdateLiquidationsConfig updates the liquidations configuration in state.
+	UpdateLiquidationsConfig(context.Context, *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error)
 }
 
-func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateLiquidationsConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) ProposedOperations(ctx context.Context, req *MsgProposedOperations) (*MsgProposedOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposedOperations not implemented")
 }
-
-func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
 }
-
-func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
-
-func (m *MsgUpdateLiquidationsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) ReplaceOrder(ctx context.Context, req *MsgReplaceOrder) (*MsgReplaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) BatchCancel(ctx context.Context, req *MsgBatchCancel) (*MsgBatchCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancel not implemented")
+}
+func (*UnimplementedMsgServer) CancelAllOrders(ctx context.Context, req *MsgCancelAllOrders) (*MsgCancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (*UnimplementedMsgServer) CreateClobPair(ctx context.Context, req *MsgCreateClobPair) (*MsgCreateClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClobPair not implemented")
+}
+func (*UnimplementedMsgServer) UpdateClobPair(ctx context.Context, req *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClobPair not implemented")
+}
+func (*UnimplementedMsgServer) DelistClobPair(ctx context.Context, req *MsgDelistClobPair) (*MsgDelistClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistClobPair not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEquityTierLimitConfiguration(ctx context.Context, req *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEquityTierLimitConfiguration not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBlockRateLimitConfiguration(ctx context.Context, req *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockRateLimitConfiguration not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLiquidationsConfig(ctx context.Context, req *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationsConfig not implemented")
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_ProposedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposedOperations)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateClobPair) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).ProposedOperations(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ProposedOperations",
 	}
-	l = m.ClobPair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgCreateClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposedOperations(ctx, req.(*MsgProposedOperations))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProposedOperations) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.OperationsQueue) > 0 {
-		for _, e := range m.OperationsQueue {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceOrder(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProposedOperationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPlaceOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceOrder(ctx, req.(*MsgReplaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPlaceOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BatchCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/BatchCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancel(ctx, req.(*MsgBatchCancel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAllOrders)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.OrderId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.GoodTilOneof != nil {
-		n += m.GoodTilOneof.Size()
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAllOrders(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/CancelAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAllOrders(ctx, req.(*MsgCancelAllOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelOrder_GoodTilBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClobPair)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovTx(uint64(m.GoodTilBlock))
-	return n
-}
-func (m *MsgCancelOrder_GoodTilBlockTime) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClobPair(ctx, in)
 	}
-	var l int
-	_ = l
-	n += 5
-	return n
-}
-func (m *MsgCancelOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/CreateClobPair",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClobPair(ctx, req.(*MsgCreateClobPair))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateClobPair) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClobPair)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClobPair(ctx, in)
 	}
-	l = m.ClobPair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateClobPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClobPair(ctx, req.(*MsgUpdateClobPair))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DelistClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistClobPair)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).DelistClobPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/DelistClobPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistClobPair(ctx, req.(*MsgDelistClobPair))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OperationRaw) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateEquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEquityTierLimitConfiguration)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Operation != nil {
-		n += m.Operation.Size()
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, req.(*MsgUpdateEquityTierLimitConfiguration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OperationRaw_Match) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateBlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockRateLimitConfiguration)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Match != nil {
-		l = m.Match.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, in)
 	}
-	return n
-}
-func (m *OperationRaw_ShortTermOrderPlacement) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration",
 	}
-	var l int
-	_ = l
-	if m.ShortTermOrderPlacement != nil {
-		l = len(m.ShortTermOrderPlacement)
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, req.(*MsgUpdateBlockRateLimitConfiguration))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *OperationRaw_OrderRemoval) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_UpdateLiquidationsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLiquidationsConfig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.OrderRemoval != nil {
-		l = m.OrderRemoval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, in)
 	}
-	return n
-}
-func (m *MsgUpdateEquityTierLimitConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig",
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, req.(*MsgUpdateLiquidationsConfig))
 	}
-	l = m.EquityTierLimitConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.clob.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProposedOperations",
+			Handler:    _Msg_ProposedOperations_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Msg_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Msg_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "BatchCancel",
+			Handler:    _Msg_BatchCancel_Handler,
+		},
+		{
+			MethodName: "CancelAllOrders",
+			Handler:    _Msg_CancelAllOrders_Handler,
+		},
+		{
+			MethodName: "CreateClobPair",
+			Handler:    _Msg_CreateClobPair_Handler,
+		},
+		{
+			MethodName: "UpdateClobPair",
+			Handler:    _Msg_UpdateClobPair_Handler,
+		},
+		{
+			MethodName: "DelistClobPair",
+			Handler:    _Msg_DelistClobPair_Handler,
+		},
+		{
+			MethodName: "UpdateEquityTierLimitConfiguration",
+			Handler:    _Msg_UpdateEquityTierLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "UpdateBlockRateLimitConfiguration",
+			Handler:    _Msg_UpdateBlockRateLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "UpdateLiquidationsConfig",
+			Handler:    _Msg_UpdateLiquidationsConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/clob/tx.proto",
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateClobPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.BlockRateLimitConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreateClobPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateLiquidationsConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.LiquidationsConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgProposedOperations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgProposedOperations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposedOperations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperationsQueue) > 0 {
+		for iNdEx := len(m.OperationsQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperationsQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposedOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GoodTilOneof != nil {
+		{
+			size := m.GoodTilOneof.Size()
+			i -= size
+			if _, err := m.GoodTilOneof.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder_GoodTilBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder_GoodTilBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *MsgCancelOrder_GoodTilBlockTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GoodTilBlockTime))
+	i--
+	dAtA[i] = 0x1d
+	return len(dAtA) - i, nil
+}
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.ClientIds[iNdEx]))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientIds)*4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderFlags != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderFlags))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GoodTilOneof != nil {
+		{
+			size := m.GoodTilOneof.Size()
+			i -= size
+			if _, err := m.GoodTilOneof.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.OrderBatches) > 0 {
+		for iNdEx := len(m.OrderBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancel_GoodTilBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancel_GoodTilBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *MsgBatchCancel_GoodTilBlockTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancel_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GoodTilBlockTime))
+	i--
+	dAtA[i] = 0x25
+	return len(dAtA) - i, nil
+}
+func (m *MsgBatchCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedOrderIds) > 0 {
+		for iNdEx := len(m.FailedOrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedOrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CanceledOrderIds) > 0 {
+		for iNdEx := len(m.CanceledOrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanceledOrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GoodTilBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OrderFlags) > 0 {
+		dAtA7 := make([]byte, len(m.OrderFlags)*10)
+		var j6 int
+		for _, num := range m.OrderFlags {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClobPairIds) > 0 {
+		dAtA9 := make([]byte, len(m.ClobPairIds)*10)
+		var j8 int
+		for _, num := range m.ClobPairIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CanceledOrderIds) > 0 {
+		for iNdEx := len(m.CanceledOrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanceledOrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistClobPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistClobPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementPrice != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementPrice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationRaw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size := m.Operation.Size()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationRaw_Match) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Match != nil {
+		{
+			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_ShortTermOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_ShortTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShortTermOrderPlacement != nil {
+		i -= len(m.ShortTermOrderPlacement)
+		copy(dAtA[i:], m.ShortTermOrderPlacement)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortTermOrderPlacement)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_OrderRemoval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderRemoval != nil {
+		{
+			size, err := m.OrderRemoval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationsConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClobPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ClobPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposedOperations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperationsQueue) > 0 {
+		for _, e := range m.OperationsQueue {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposedOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GoodTilOneof != nil {
+		n += m.GoodTilOneof.Size()
+	}
+	return n
+}
+
+func (m *MsgCancelOrder_GoodTilBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.GoodTilBlock))
+	return n
+}
+func (m *MsgCancelOrder_GoodTilBlockTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 5
+	return n
+}
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReplaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OrderBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovTx(uint64(m.ClobPairId))
+	}
+	if m.OrderFlags != 0 {
+		n += 1 + sovTx(uint64(m.OrderFlags))
+	}
+	if len(m.ClientIds) > 0 {
+		n += 1 + sovTx(uint64(len(m.ClientIds)*4)) + len(m.ClientIds)*4
+	}
+	return n
+}
+
+func (m *MsgBatchCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.OrderBatches) > 0 {
+		for _, e := range m.OrderBatches {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GoodTilOneof != nil {
+		n += m.GoodTilOneof.Size()
+	}
+	return n
+}
+
+func (m *MsgBatchCancel_GoodTilBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.GoodTilBlock))
+	return n
+}
+func (m *MsgBatchCancel_GoodTilBlockTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 5
+	return n
+}
+func (m *MsgBatchCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CanceledOrderIds) > 0 {
+		for _, e := range m.CanceledOrderIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FailedOrderIds) > 0 {
+		for _, e := range m.FailedOrderIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelAllOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ClobPairIds) > 0 {
+		l = 0
+		for _, e := range m.ClobPairIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.OrderFlags) > 0 {
+		l = 0
+		for _, e := range m.OrderFlags {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.GoodTilBlock != 0 {
+		n += 1 + sovTx(uint64(m.GoodTilBlock))
+	}
+	return n
+}
+
+func (m *MsgCancelAllOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CanceledOrderIds) > 0 {
+		for _, e := range m.CanceledOrderIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateClobPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ClobPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelistClobPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClobPairId != 0 {
+		n += 1 + sovTx(uint64(m.ClobPairId))
+	}
+	if m.SettlementPrice != 0 {
+		n += 1 + sovTx(uint64(m.SettlementPrice))
+	}
+	return n
+}
+
+func (m *MsgDelistClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OperationRaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		n += m.Operation.Size()
+	}
+	return n
+}
+
+func (m *OperationRaw_Match) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Match != nil {
+		l = m.Match.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_ShortTermOrderPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortTermOrderPlacement != nil {
+		l = len(m.ShortTermOrderPlacement)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_OrderRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderRemoval != nil {
+		l = m.OrderRemoval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.EquityTierLimitConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BlockRateLimitConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLiquidationsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LiquidationsConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClobPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClobPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposedOperations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationsQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationsQueue = append(m.OperationsQueue, OperationRaw{})
+			if err := m.OperationsQueue[len(m.OperationsQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposedOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{v}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2261,17 +4171,17 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClobPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2281,29 +4191,130 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2313,25 +4324,82 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClobPairId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderFlags", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.OrderFlags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderFlags |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.ClientIds = append(m.ClientIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.ClientIds) == 0 {
+					m.ClientIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.ClientIds = append(m.ClientIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2353,7 +4421,7 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2372,16 +4440,114 @@ func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClobPairResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBatches = append(m.OrderBatches, OrderBatch{})
+			if err := m.OrderBatches[len(m.OrderBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GoodTilOneof = &MsgBatchCancel_GoodTilBlock{v}
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GoodTilOneof = &MsgBatchCancel_GoodTilBlockTime{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2403,7 +4569,7 @@ func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchCancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2426,15 +4592,15 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposedOperations: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchCancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationsQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledOrderIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2461,61 +4627,45 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperationsQueue = append(m.OperationsQueue, OperationRaw{})
-			if err := m.OperationsQueue[len(m.OperationsQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CanceledOrderIds = append(m.CanceledOrderIds, OrderId{})
+			if err := m.CanceledOrderIds[len(m.CanceledOrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOrderIds", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FailedOrderIds = append(m.FailedOrderIds, OrderId{})
+			if err := m.FailedOrderIds[len(m.FailedOrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposedOperationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2537,7 +4687,7 @@ func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAllOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,15 +4710,15 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAllOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAllOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2595,10 +4745,181 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClobPairIds = append(m.ClobPairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClobPairIds) == 0 {
+					m.ClobPairIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClobPairIds = append(m.ClobPairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderFlags = append(m.OrderFlags, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderFlags) == 0 {
+					m.OrderFlags = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderFlags = append(m.OrderFlags, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderFlags", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			}
+			m.GoodTilBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2620,7 +4941,7 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAllOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2643,12 +4964,46 @@ func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAllOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledOrderIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanceledOrderIds = append(m.CanceledOrderIds, OrderId{})
+			if err := m.CanceledOrderIds[len(m.CanceledOrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2670,7 +5025,7 @@ func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2693,17 +5048,17 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClobPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2713,30 +5068,29 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
 			}
-			var v uint32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2746,23 +5100,25 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{v}
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{v}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2784,7 +5140,7 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClobPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2807,10 +5163,10 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClobPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2834,7 +5190,7 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
+func (m *MsgDelistClobPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2857,10 +5213,10 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClobPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelistClobPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelistClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2896,10 +5252,10 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
 			}
-			var msglen int
+			m.ClobPairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2909,25 +5265,30 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClobPairId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SettlementPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2949,7 +5310,7 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelistClobPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2972,10 +5333,10 @@ func (m *MsgUpdateClobPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClobPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelistClobPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelistClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -29,6 +29,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryPremiumSamples())
 	cmd.AddCommand(CmdQueryPremiumVotes())
+	cmd.AddCommand(CmdQueryFundingHistory())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
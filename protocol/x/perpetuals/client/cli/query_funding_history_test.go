@@ -0,0 +1,28 @@
+//go:build all || integration_test
+
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	tmcli "github.com/cometbft/cometbft/libs/cli"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/client/cli"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryFundingHistory(t *testing.T) {
+	net, _, _ := networkWithLiquidityTierAndPerpetualObjects(t, 2, 2)
+	ctx := net.Validators[0].ClientCtx
+
+	common := []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryFundingHistory(), append([]string{"0", "0"}, common...))
+	require.NoError(t, err)
+
+	var resp types.QueryFundingHistoryResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Empty(t, resp.Entries)
+}
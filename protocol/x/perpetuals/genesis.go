@@ -27,6 +27,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			elem.MaintenanceFractionPpm,
 			elem.BasePositionNotional,
 			elem.ImpactNotional,
+			elem.OpenInterestCapNotional,
 		)
 
 		if err != nil {
@@ -44,6 +45,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			elem.Params.AtomicResolution,
 			elem.Params.DefaultFundingPpm,
 			elem.Params.LiquidityTier,
+			elem.Params.OpenInterestCapNotional,
+			elem.Params.FundingRateBounds,
+			elem.Params.InterestRatePpm,
+			elem.Params.FundingTickEpoch,
+			elem.Params.FundingSampleEpoch,
 		)
 
 		if err != nil {
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+// ProcessFinalFunding pays the funding that a perpetual accrued during the current `funding-tick` epoch
+// of its funding schedule, ahead of the final settlement of its positions. The funding rate is computed
+// from the premium samples collected so far during the epoch, and is prorated by the time elapsed since
+// the epoch started. The premiums of the perpetual are removed from the premium stores afterwards.
+//
+// Note that funding is only settled on positions when they are updated, so this function must be called
+// before the positions of the perpetual are closed.
+func (k Keeper) ProcessFinalFunding(
+	ctx sdk.Context,
+	perpetualId uint32,
+) error {
+	perp, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+	if perp.Settled {
+		return errorsmod.Wrapf(types.ErrPerpetualSettled, "perpetual id = (%d)", perpetualId)
+	}
+
+	params := k.GetParams(ctx)
+	schedule := getFundingSchedule(perp)
+	fundingTickEpochInfo := k.mustGetEpochInfo(ctx, schedule.tickEpoch)
+	fundingSampleEpochInfo := k.mustGetEpochInfo(ctx, schedule.sampleEpoch)
+	premiumSamplesKey := types.GetPremiumSamplesKey(schedule.tickEpoch, schedule.sampleEpoch)
+
+	// The current `funding-tick` epoch started one duration before the next tick.
+	elapsedSeconds := uint32(0)
+	if fundingTickEpochInfo.IsInitialized {
+		epochStartTime := int64(fundingTickEpochInfo.NextTick) - int64(fundingTickEpochInfo.Duration)
+		elapsedSeconds = uint32(lib.Min(
+			lib.Max(ctx.BlockTime().Unix()-epochStartTime, 0),
+			int64(fundingTickEpochInfo.Duration),
+		))
+	}
+
+	// As for a full `funding-tick` epoch, missing samples are counted as zero premiums.
+	perpIdToPremiumPpm := k.processStoredPremiums(
+		ctx,
+		fundingTickEpochInfo,
+		premiumSamplesKey,
+		lib.MustDivideUint32RoundUp(elapsedSeconds, fundingSampleEpochInfo.Duration),
+		lib.AvgInt32,
+		k.GetRemoveSampleTailsFunc(ctx, params.RemovedTailSampleRatioPpm),
+	)
+
+	bigFundingRatePpm := k.getFundingRatePpm(ctx, perp, perpIdToPremiumPpm[perpetualId], params)
+	if bigFundingRatePpm.Sign() != 0 && elapsedSeconds > 0 {
+		fundingIndexDelta, err := k.getFundingIndexDelta(ctx, perp, bigFundingRatePpm, elapsedSeconds)
+		if err != nil {
+			return err
+		}
+
+		if err := k.ModifyFundingIndex(ctx, perpetualId, fundingIndexDelta); err != nil {
+			return err
+		}
+	}
+
+	k.removeFromPremiumStore(ctx, premiumSamplesKey, perpetualId)
+	k.removeFromPremiumStore(ctx, types.GetPremiumVotesKey(schedule.sampleEpoch), perpetualId)
+
+	// Get perpetual object with updated funding index.
+	perp, err = k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+
+	k.AppendFundingHistoryEntry(ctx, perpetualId, types.FundingHistoryEntry{
+		BlockHeight:     lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		FundingRatePpm:  int32(bigFundingRatePpm.Int64()),
+		FundingIndex:    perp.FundingIndex,
+		IntervalSeconds: elapsedSeconds,
+	})
+
+	k.indexerEventManager.AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeFundingValues,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		indexerevents.FundingValuesEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewFundingRatesAndIndicesEvent(
+				[]indexerevents.FundingUpdateV1{
+					{
+						PerpetualId:     perpetualId,
+						FundingValuePpm: int32(bigFundingRatePpm.Int64()),
+						FundingIndex:    perp.FundingIndex,
+					},
+				},
+				elapsedSeconds,
+			),
+		),
+	)
+
+	return nil
+}
+
+// SettlePerpetual marks a perpetual as settled at `settlementPrice` once all of its positions were closed
+// in final settlement, and emits an indexer event for the settlement. Settled perpetuals no longer accrue
+// funding, and positions in them can't be updated.
+func (k Keeper) SettlePerpetual(
+	ctx sdk.Context,
+	perpetualId uint32,
+	settlementPrice uint64,
+) error {
+	perp, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+	if perp.Settled {
+		return errorsmod.Wrapf(types.ErrPerpetualSettled, "perpetual id = (%d)", perpetualId)
+	}
+
+	perp.Settled = true
+	perp.SettlementPrice = settlementPrice
+	k.setPerpetual(ctx, perp)
+
+	k.indexerEventManager.AddTxnEvent(
+		ctx,
+		indexerevents.SubtypePerpetualSettlement,
+		indexerevents.PerpetualSettlementEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewPerpetualSettlementEvent(
+				perpetualId,
+				settlementPrice,
+				perp.FundingIndex,
+			),
+		),
+	)
+
+	return nil
+}
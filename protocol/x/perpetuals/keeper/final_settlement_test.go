@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessFinalFunding(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	// The current `funding-tick` epoch started at time 10_000, so half of it has elapsed.
+	ctx := pc.Ctx.WithTxBytes(constants.TestTxBytes).WithBlockHeight(15).WithBlockTime(time.Unix(11_800, 0))
+	keepertest.CreateTestMarkets(t, ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, pc.PerpetualsKeeper)
+
+	for _, epochInfo := range []epochstypes.EpochInfo{
+		{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			NextTick:               13_600,
+			Duration:               3600,
+			IsInitialized:          true,
+			CurrentEpoch:           1,
+			CurrentEpochStartBlock: 1,
+		},
+		{
+			Name:                   string(epochstypes.FundingSampleEpochInfoName),
+			NextTick:               11_820,
+			Duration:               60,
+			IsInitialized:          true,
+			CurrentEpoch:           30,
+			CurrentEpochStartBlock: 14,
+		},
+	} {
+		require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(ctx, epochInfo))
+	}
+
+	btcPerp, err := pc.PerpetualsKeeper.CreatePerpetual(
+		ctx, 0, "BTC-USD", 0, -10, 0, 2, 0, nil, 0, "", "",
+	)
+	require.NoError(t, err)
+	ethPerp, err := pc.PerpetualsKeeper.CreatePerpetual(
+		ctx, 1, "ETH-USD", 1, -9, 0, 2, 0, nil, 0, "", "",
+	)
+	require.NoError(t, err)
+
+	// 30 samples of 0.1% were taken for both perpetuals, and a vote of the current sample is pending.
+	keepertest.PopulateTestPremiumStore(
+		t,
+		ctx,
+		pc.PerpetualsKeeper,
+		[]types.Perpetual{btcPerp, ethPerp},
+		constants.GenerateConstantFundingPremiums(1000, 30),
+		false, // isVote
+	)
+	keepertest.PopulateTestPremiumStore(
+		t,
+		ctx,
+		pc.PerpetualsKeeper,
+		[]types.Perpetual{btcPerp, ethPerp},
+		[]int32{1000},
+		true, // isVote
+	)
+
+	require.NoError(t, pc.PerpetualsKeeper.ProcessFinalFunding(ctx, btcPerp.Params.Id))
+
+	// The funding rate of 0.1% is paid for the 1800 seconds elapsed in the epoch, which is half the
+	// funding index delta of a full epoch.
+	perp, err := pc.PerpetualsKeeper.GetPerpetual(ctx, btcPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(312), perp.FundingIndex.BigInt().Int64())
+	require.Equal(
+		t,
+		[]types.FundingHistoryEntry{
+			{
+				BlockHeight:     15,
+				FundingRatePpm:  1000,
+				FundingIndex:    perp.FundingIndex,
+				IntervalSeconds: 1800,
+			},
+		},
+		pc.PerpetualsKeeper.GetFundingHistory(ctx, btcPerp.Params.Id),
+	)
+	require.Contains(
+		t,
+		getFundingBlockEventsFromIndexerBlock(ctx, pc.PerpetualsKeeper),
+		indexerevents.NewFundingRatesAndIndicesEvent(
+			[]indexerevents.FundingUpdateV1{
+				{PerpetualId: btcPerp.Params.Id, FundingValuePpm: 1000, FundingIndex: perp.FundingIndex},
+			},
+			1800,
+		),
+	)
+
+	// Only the premiums of the other perpetual are kept, and its funding index is untouched.
+	for _, premiumStore := range []types.PremiumStore{
+		pc.PerpetualsKeeper.GetPremiumSamples(ctx),
+		pc.PerpetualsKeeper.GetPremiumVotes(ctx),
+	} {
+		require.Len(t, premiumStore.AllMarketPremiums, 1)
+		require.Equal(t, ethPerp.Params.Id, premiumStore.AllMarketPremiums[0].PerpetualId)
+	}
+	perp, err = pc.PerpetualsKeeper.GetPerpetual(ctx, ethPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, ethPerp.FundingIndex, perp.FundingIndex)
+}
+
+func TestSettlePerpetual(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	ctx := pc.Ctx.WithTxBytes(constants.TestTxBytes)
+	keepertest.CreateTestMarkets(t, ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, pc.PerpetualsKeeper)
+
+	perp, err := pc.PerpetualsKeeper.CreatePerpetual(
+		ctx, 0, "BTC-USD", 0, -10, 0, 2, 0, nil, 0, "", "",
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, pc.PerpetualsKeeper.SettlePerpetual(ctx, perp.Params.Id, 4_500_000_000))
+
+	settledPerp, err := pc.PerpetualsKeeper.GetPerpetual(ctx, perp.Params.Id)
+	require.NoError(t, err)
+	require.True(t, settledPerp.Settled)
+	require.Equal(t, uint64(4_500_000_000), settledPerp.SettlementPrice)
+
+	// Positions in settled perpetuals can't be updated.
+	updatable, err := pc.PerpetualsKeeper.IsPositionUpdatable(ctx, perp.Params.Id)
+	require.NoError(t, err)
+	require.False(t, updatable)
+
+	// Settled perpetuals can't be settled again, and don't receive funding.
+	require.ErrorIs(t, pc.PerpetualsKeeper.SettlePerpetual(ctx, perp.Params.Id, 1), types.ErrPerpetualSettled)
+	require.ErrorIs(t, pc.PerpetualsKeeper.ProcessFinalFunding(ctx, perp.Params.Id), types.ErrPerpetualSettled)
+	require.ErrorIs(
+		t,
+		pc.PerpetualsKeeper.AddPremiumVotes(ctx, []types.FundingPremium{{PerpetualId: perp.Params.Id, PremiumPpm: 1}}),
+		types.ErrPerpetualDoesNotExist,
+	)
+}
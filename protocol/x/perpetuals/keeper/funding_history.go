@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+// getFundingHistoryStore returns a prefix store of the funding history entries of a
+// perpetual, keyed by block height.
+func (k Keeper) getFundingHistoryStore(
+	ctx sdk.Context,
+	perpetualId uint32,
+) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingHistoryKeyPrefix))
+	return prefix.NewStore(store, lib.Uint32ToKey(perpetualId))
+}
+
+// getNumFundingHistoryEntries returns the number of funding history entries of a perpetual.
+func (k Keeper) getNumFundingHistoryEntries(
+	ctx sdk.Context,
+	perpetualId uint32,
+) uint32 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingHistoryCountKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+// setNumFundingHistoryEntries sets the number of funding history entries of a perpetual.
+func (k Keeper) setNumFundingHistoryEntries(
+	ctx sdk.Context,
+	perpetualId uint32,
+	numEntries uint32,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingHistoryCountKeyPrefix))
+	store.Set(lib.Uint32ToKey(perpetualId), lib.Uint32ToKey(numEntries))
+}
+
+// GetFundingHistory returns all funding history entries of a perpetual, sorted by block height.
+func (k Keeper) GetFundingHistory(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (entries []types.FundingHistoryEntry) {
+	iterator := k.getFundingHistoryStore(ctx, perpetualId).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.FundingHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// AppendFundingHistoryEntry records a funding history entry of a perpetual, and prunes the
+// oldest entries of the perpetual so that at most `MaxFundingHistoryEntries` are kept.
+func (k Keeper) AppendFundingHistoryEntry(
+	ctx sdk.Context,
+	perpetualId uint32,
+	entry types.FundingHistoryEntry,
+) {
+	store := k.getFundingHistoryStore(ctx, perpetualId)
+	key := lib.Uint32ToKey(entry.BlockHeight)
+	numEntries := k.getNumFundingHistoryEntries(ctx, perpetualId)
+	if !store.Has(key) {
+		numEntries++
+	}
+	store.Set(key, k.cdc.MustMarshal(&entry))
+
+	// Entries are keyed by block height, so only the oldest entries past the maximum are iterated.
+	// Collect their keys first, since the store can't be written to while iterating.
+	var prunedKeys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; numEntries > types.MaxFundingHistoryEntries && iterator.Valid(); iterator.Next() {
+		prunedKeys = append(prunedKeys, iterator.Key())
+		numEntries--
+	}
+	iterator.Close()
+
+	for _, prunedKey := range prunedKeys {
+		store.Delete(prunedKey)
+	}
+	k.setNumFundingHistoryEntries(ctx, perpetualId, numEntries)
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendFundingHistoryEntry(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+
+	// Fill the funding history of perpetual 0 beyond its maximum number of entries.
+	numEntries := types.MaxFundingHistoryEntries + 2
+	for i := 1; i <= numEntries; i++ {
+		pc.PerpetualsKeeper.AppendFundingHistoryEntry(pc.Ctx, 0, types.FundingHistoryEntry{
+			BlockHeight:     uint32(i * 10),
+			FundingRatePpm:  int32(i),
+			FundingIndex:    dtypes.NewInt(int64(i * 100)),
+			IntervalSeconds: 3600,
+		})
+	}
+	pc.PerpetualsKeeper.AppendFundingHistoryEntry(pc.Ctx, 1, types.FundingHistoryEntry{
+		BlockHeight:     10,
+		FundingRatePpm:  -1,
+		FundingIndex:    dtypes.NewInt(-100),
+		IntervalSeconds: 3600,
+	})
+
+	// The two oldest entries of perpetual 0 are pruned.
+	history := pc.PerpetualsKeeper.GetFundingHistory(pc.Ctx, 0)
+	require.Len(t, history, types.MaxFundingHistoryEntries)
+	require.Equal(t, uint32(30), history[0].BlockHeight)
+	require.Equal(t, int32(3), history[0].FundingRatePpm)
+	require.Equal(t, uint32(numEntries*10), history[len(history)-1].BlockHeight)
+
+	// Overwriting the entry of an existing block height doesn't prune any entries.
+	pc.PerpetualsKeeper.AppendFundingHistoryEntry(pc.Ctx, 0, types.FundingHistoryEntry{
+		BlockHeight:     uint32(numEntries * 10),
+		FundingRatePpm:  0,
+		FundingIndex:    dtypes.NewInt(0),
+		IntervalSeconds: 3600,
+	})
+	history = pc.PerpetualsKeeper.GetFundingHistory(pc.Ctx, 0)
+	require.Len(t, history, types.MaxFundingHistoryEntries)
+	require.Equal(t, uint32(30), history[0].BlockHeight)
+	require.Equal(t, int32(0), history[len(history)-1].FundingRatePpm)
+
+	// The funding history of perpetual 1 is kept separately.
+	require.Equal(
+		t,
+		[]types.FundingHistoryEntry{
+			{
+				BlockHeight:     10,
+				FundingRatePpm:  -1,
+				FundingIndex:    dtypes.NewInt(-100),
+				IntervalSeconds: 3600,
+			},
+		},
+		pc.PerpetualsKeeper.GetFundingHistory(pc.Ctx, 1),
+	)
+}
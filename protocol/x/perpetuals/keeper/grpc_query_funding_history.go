@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lowerBoundStore is a prefix store whose iterators start at or after `lowerBound`, so that
+// the keys before it are never iterated.
+type lowerBoundStore struct {
+	prefix.Store
+	lowerBound []byte
+}
+
+func (s lowerBoundStore) Iterator(start, end []byte) storetypes.Iterator {
+	if bytes.Compare(start, s.lowerBound) < 0 {
+		start = s.lowerBound
+	}
+	return s.Store.Iterator(start, end)
+}
+
+func (s lowerBoundStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	if bytes.Compare(start, s.lowerBound) < 0 {
+		start = s.lowerBound
+	}
+	return s.Store.ReverseIterator(start, end)
+}
+
+func (k Keeper) FundingHistory(
+	c context.Context,
+	req *types.QueryFundingHistoryRequest,
+) (*types.QueryFundingHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasPerpetual(ctx, req.PerpetualId) {
+		return nil,
+			status.Error(
+				codes.NotFound,
+				fmt.Sprintf(
+					"Perpetual id %+v not found.",
+					req.PerpetualId,
+				),
+			)
+	}
+
+	// Entries are keyed by block height, so iteration starts at the first entry at or after `FromHeight`.
+	entries := []types.FundingHistoryEntry{}
+	pageRes, err := query.Paginate(
+		lowerBoundStore{
+			Store:      k.getFundingHistoryStore(ctx, req.PerpetualId),
+			lowerBound: lib.Uint32ToKey(req.FromHeight),
+		},
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var entry types.FundingHistoryEntry
+			if err := k.cdc.Unmarshal(value, &entry); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFundingHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
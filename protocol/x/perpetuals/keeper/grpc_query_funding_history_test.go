@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+func TestFundingHistoryQuery(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	wctx := sdk.WrapSDKContext(pc.Ctx)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 2)
+
+	entries := make([]types.FundingHistoryEntry, 5)
+	for i := range entries {
+		entries[i] = types.FundingHistoryEntry{
+			BlockHeight:     uint32((i + 1) * 10),
+			FundingRatePpm:  int32(i * 100),
+			FundingIndex:    dtypes.NewInt(int64(i * 1000)),
+			IntervalSeconds: 3600,
+		}
+		pc.PerpetualsKeeper.AppendFundingHistoryEntry(pc.Ctx, perps[0].Params.Id, entries[i])
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryFundingHistoryRequest
+		response *types.QueryFundingHistoryResponse
+		err      error
+	}{
+		{
+			desc: "All entries",
+			request: &types.QueryFundingHistoryRequest{
+				PerpetualId: perps[0].Params.Id,
+			},
+			response: &types.QueryFundingHistoryResponse{
+				Entries:    entries,
+				Pagination: &query.PageResponse{Total: 5},
+			},
+		},
+		{
+			desc: "From height",
+			request: &types.QueryFundingHistoryRequest{
+				PerpetualId: perps[0].Params.Id,
+				FromHeight:  25,
+			},
+			response: &types.QueryFundingHistoryResponse{
+				Entries:    entries[2:],
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			desc: "From height with pagination",
+			request: &types.QueryFundingHistoryRequest{
+				PerpetualId: perps[0].Params.Id,
+				FromHeight:  20,
+				Pagination: &query.PageRequest{
+					Offset:     1,
+					Limit:      2,
+					CountTotal: true,
+				},
+			},
+			response: &types.QueryFundingHistoryResponse{
+				Entries: entries[2:4],
+				Pagination: &query.PageResponse{
+					NextKey: lib.Uint32ToKey(entries[4].BlockHeight),
+					Total:   4,
+				},
+			},
+		},
+		{
+			desc: "No funding history",
+			request: &types.QueryFundingHistoryRequest{
+				PerpetualId: perps[1].Params.Id,
+			},
+			response: &types.QueryFundingHistoryResponse{
+				Entries:    []types.FundingHistoryEntry{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			desc: "Perpetual not found",
+			request: &types.QueryFundingHistoryRequest{
+				PerpetualId: uint32(100000),
+			},
+			err: status.Error(codes.NotFound, fmt.Sprintf(
+				"Perpetual id %+v not found.",
+				uint32(100000),
+			)),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := pc.PerpetualsKeeper.FundingHistory(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -16,9 +16,12 @@ func (k Keeper) PremiumVotes(
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	premiumVotes := k.GetPremiumVotes(sdk.UnwrapSDKContext(c))
+	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryPremiumVotesResponse{PremiumVotes: premiumVotes}, nil
+	return &types.QueryPremiumVotesResponse{
+		PremiumVotes:           k.GetPremiumVotes(ctx),
+		PremiumVotesBySchedule: k.GetAllPremiumVotes(ctx),
+	}, nil
 }
 
 func (k Keeper) PremiumSamples(
@@ -29,7 +32,10 @@ func (k Keeper) PremiumSamples(
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	premiumSamples := k.GetPremiumSamples(sdk.UnwrapSDKContext(c))
+	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryPremiumSamplesResponse{PremiumSamples: premiumSamples}, nil
+	return &types.QueryPremiumSamplesResponse{
+		PremiumSamples:           k.GetPremiumSamples(ctx),
+		PremiumSamplesBySchedule: k.GetAllPremiumSamples(ctx),
+	}, nil
 }
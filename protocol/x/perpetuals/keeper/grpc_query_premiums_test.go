@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -28,6 +29,9 @@ func TestPremiumVotes(t *testing.T) {
 			req: &types.QueryPremiumVotesRequest{},
 			res: &types.QueryPremiumVotesResponse{
 				PremiumVotes: types.PremiumStore{},
+				PremiumVotesBySchedule: []types.FundingSchedulePremiumStore{
+					{FundingSampleEpoch: string(epochstypes.FundingSampleEpochInfoName)},
+				},
 			},
 		},
 	}
@@ -61,6 +65,12 @@ func TestPremiumSamples(t *testing.T) {
 			req: &types.QueryPremiumSamplesRequest{},
 			res: &types.QueryPremiumSamplesResponse{
 				PremiumSamples: types.PremiumStore{},
+				PremiumSamplesBySchedule: []types.FundingSchedulePremiumStore{
+					{
+						FundingTickEpoch:   string(epochstypes.FundingTickEpochInfoName),
+						FundingSampleEpoch: string(epochstypes.FundingSampleEpochInfoName),
+					},
+				},
 			},
 		},
 	}
@@ -31,6 +31,11 @@ func (k msgServer) CreatePerpetual(
 		msg.Params.AtomicResolution,
 		msg.Params.DefaultFundingPpm,
 		msg.Params.LiquidityTier,
+		msg.Params.OpenInterestCapNotional,
+		msg.Params.FundingRateBounds,
+		msg.Params.InterestRatePpm,
+		msg.Params.FundingTickEpoch,
+		msg.Params.FundingSampleEpoch,
 	)
 	if err != nil {
 		return &types.MsgCreatePerpetualResponse{}, err
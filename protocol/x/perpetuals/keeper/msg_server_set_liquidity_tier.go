@@ -31,6 +31,7 @@ func (k msgServer) SetLiquidityTier(
 		msg.LiquidityTier.MaintenanceFractionPpm,
 		msg.LiquidityTier.BasePositionNotional,
 		msg.LiquidityTier.ImpactNotional,
+		msg.LiquidityTier.OpenInterestCapNotional,
 	); err != nil {
 		return nil, err
 	}
@@ -135,6 +135,7 @@ func TestSetLiquidityTier(t *testing.T) {
 				testLt.MaintenanceFractionPpm,
 				testLt.BasePositionNotional,
 				testLt.ImpactNotional,
+				testLt.OpenInterestCapNotional,
 			)
 			require.NoError(t, err)
 
@@ -30,6 +30,11 @@ func (k msgServer) UpdatePerpetualParams(
 		msg.PerpetualParams.MarketId,
 		msg.PerpetualParams.DefaultFundingPpm,
 		msg.PerpetualParams.LiquidityTier,
+		msg.PerpetualParams.OpenInterestCapNotional,
+		msg.PerpetualParams.FundingRateBounds,
+		msg.PerpetualParams.InterestRatePpm,
+		msg.PerpetualParams.FundingTickEpoch,
+		msg.PerpetualParams.FundingSampleEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -37,6 +37,11 @@ func (k Keeper) CreatePerpetual(
 	atomicResolution int32,
 	defaultFundingPpm int32,
 	liquidityTier uint32,
+	openInterestCapNotional uint64,
+	fundingRateBounds *types.FundingRateBounds,
+	interestRatePpm int32,
+	fundingTickEpoch string,
+	fundingSampleEpoch string,
 ) (types.Perpetual, error) {
 	// Check if perpetual exists.
 	if k.HasPerpetual(ctx, id) {
@@ -49,12 +54,17 @@ func (k Keeper) CreatePerpetual(
 	// Create the perpetual.
 	perpetual := types.Perpetual{
 		Params: types.PerpetualParams{
-			Id:                id,
-			Ticker:            ticker,
-			MarketId:          marketId,
-			AtomicResolution:  atomicResolution,
-			DefaultFundingPpm: defaultFundingPpm,
-			LiquidityTier:     liquidityTier,
+			Id:                      id,
+			Ticker:                  ticker,
+			MarketId:                marketId,
+			AtomicResolution:        atomicResolution,
+			DefaultFundingPpm:       defaultFundingPpm,
+			LiquidityTier:           liquidityTier,
+			OpenInterestCapNotional: openInterestCapNotional,
+			FundingRateBounds:       fundingRateBounds,
+			InterestRatePpm:         interestRatePpm,
+			FundingTickEpoch:        fundingTickEpoch,
+			FundingSampleEpoch:      fundingSampleEpoch,
 		},
 		FundingIndex: dtypes.ZeroInt(),
 	}
@@ -72,6 +82,11 @@ func (k Keeper) CreatePerpetual(
 	k.SetEmptyPremiumSamples(ctx)
 	k.SetEmptyPremiumVotes(ctx)
 
+	// Also reset the premiums of the perpetual's own funding schedule, if it's not the default one.
+	schedule := getFundingSchedule(perpetual)
+	k.setPremiumStore(ctx, types.PremiumStore{}, types.GetPremiumSamplesKey(schedule.tickEpoch, schedule.sampleEpoch))
+	k.setPremiumStore(ctx, types.PremiumStore{}, types.GetPremiumVotesKey(schedule.sampleEpoch))
+
 	return perpetual, nil
 }
 
@@ -99,6 +114,11 @@ func (k Keeper) ModifyPerpetual(
 	marketId uint32,
 	defaultFundingPpm int32,
 	liquidityTier uint32,
+	openInterestCapNotional uint64,
+	fundingRateBounds *types.FundingRateBounds,
+	interestRatePpm int32,
+	fundingTickEpoch string,
+	fundingSampleEpoch string,
 ) (types.Perpetual, error) {
 	// Get perpetual.
 	perpetual, err := k.GetPerpetual(ctx, id)
@@ -106,11 +126,18 @@ func (k Keeper) ModifyPerpetual(
 		return perpetual, err
 	}
 
+	oldSchedule := getFundingSchedule(perpetual)
+
 	// Modify perpetual.
 	perpetual.Params.Ticker = ticker
 	perpetual.Params.MarketId = marketId
 	perpetual.Params.DefaultFundingPpm = defaultFundingPpm
 	perpetual.Params.LiquidityTier = liquidityTier
+	perpetual.Params.OpenInterestCapNotional = openInterestCapNotional
+	perpetual.Params.FundingRateBounds = fundingRateBounds
+	perpetual.Params.InterestRatePpm = interestRatePpm
+	perpetual.Params.FundingTickEpoch = fundingTickEpoch
+	perpetual.Params.FundingSampleEpoch = fundingSampleEpoch
 
 	// Validate updates to perpetual.
 	if err = k.validatePerpetual(
@@ -123,6 +150,19 @@ func (k Keeper) ModifyPerpetual(
 	// Store the modified perpetual.
 	k.setPerpetual(ctx, perpetual)
 
+	// Drop premiums collected on the previous funding schedule. The perpetual is treated
+	// as having zero premiums on its new schedule until the schedule's next epoch.
+	if newSchedule := getFundingSchedule(perpetual); newSchedule != oldSchedule {
+		k.removeFromPremiumStore(
+			ctx,
+			types.GetPremiumSamplesKey(oldSchedule.tickEpoch, oldSchedule.sampleEpoch),
+			perpetual.Params.Id,
+		)
+		if newSchedule.sampleEpoch != oldSchedule.sampleEpoch {
+			k.removeFromPremiumStore(ctx, types.GetPremiumVotesKey(oldSchedule.sampleEpoch), perpetual.Params.Id)
+		}
+	}
+
 	// Emit indexer event.
 	k.GetIndexerEventManager().AddTxnEvent(
 		ctx,
@@ -255,22 +295,116 @@ func (k Keeper) processStoredPremiums(
 	return perpIdToPremium
 }
 
-// processPremiumVotesIntoSamples summarizes premium votes from proposers into premium samples.
-// For each perpetual market:
+// fundingSchedule is a pair of `funding-tick` and `funding-sample` epochs that
+// a group of perpetuals process their funding on.
+type fundingSchedule struct {
+	tickEpoch   epochstypes.EpochInfoName
+	sampleEpoch epochstypes.EpochInfoName
+}
+
+// defaultFundingSchedule is the funding schedule of perpetuals that don't
+// reference their own funding epochs.
+var defaultFundingSchedule = fundingSchedule{
+	tickEpoch:   epochstypes.FundingTickEpochInfoName,
+	sampleEpoch: epochstypes.FundingSampleEpochInfoName,
+}
+
+// getFundingSchedule returns the funding schedule of a perpetual.
+func getFundingSchedule(perp types.Perpetual) fundingSchedule {
+	return fundingSchedule{
+		tickEpoch:   perp.Params.GetFundingTickEpochInfoName(),
+		sampleEpoch: perp.Params.GetFundingSampleEpochInfoName(),
+	}
+}
+
+// getPerpetualsByFundingSchedule groups all perpetuals that aren't settled by their funding schedule.
+// Returns the funding schedules in a deterministic order, with the default schedule
+// first. The default schedule is always returned, even if no perpetual is on it.
+func (k Keeper) getPerpetualsByFundingSchedule(ctx sdk.Context) (
+	schedules []fundingSchedule,
+	perpsBySchedule map[fundingSchedule][]types.Perpetual,
+) {
+	perpsBySchedule = map[fundingSchedule][]types.Perpetual{
+		defaultFundingSchedule: {},
+	}
+	for _, perp := range k.GetAllPerpetuals(ctx) {
+		// Settled perpetuals no longer accrue funding.
+		if perp.Settled {
+			continue
+		}
+		schedule := getFundingSchedule(perp)
+		perpsBySchedule[schedule] = append(perpsBySchedule[schedule], perp)
+	}
+
+	schedules = make([]fundingSchedule, 0, len(perpsBySchedule))
+	for schedule := range perpsBySchedule {
+		schedules = append(schedules, schedule)
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		if (schedules[i] == defaultFundingSchedule) != (schedules[j] == defaultFundingSchedule) {
+			return schedules[i] == defaultFundingSchedule
+		}
+		if schedules[i].tickEpoch != schedules[j].tickEpoch {
+			return schedules[i].tickEpoch < schedules[j].tickEpoch
+		}
+		return schedules[i].sampleEpoch < schedules[j].sampleEpoch
+	})
+
+	return schedules, perpsBySchedule
+}
+
+// mustGetEpochInfo returns the epoch info with the given name, and panics if
+// it doesn't exist.
+func (k Keeper) mustGetEpochInfo(
+	ctx sdk.Context,
+	epochInfoName epochstypes.EpochInfoName,
+) epochstypes.EpochInfo {
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, epochInfoName)
+	if !found {
+		panic(errorsmod.Wrapf(
+			epochstypes.ErrEpochInfoNotFound,
+			"name: %s",
+			epochInfoName,
+		))
+	}
+	return epochInfo
+}
+
+// isEpochStart returns true if the current block is the start of a new epoch with the
+// given name.
+func (k Keeper) isEpochStart(
+	ctx sdk.Context,
+	epochInfoName epochstypes.EpochInfoName,
+) bool {
+	numBlocks, err := k.epochsKeeper.NumBlocksSinceEpochStart(ctx, epochInfoName)
+	// Invariant broken: funding epochs must exist in epochs store.
+	if err != nil {
+		panic(err)
+	}
+	return numBlocks == 0
+}
+
+// processPremiumVotesIntoSamples summarizes premium votes from proposers into premium samples,
+// for all perpetuals sampled on the `funding-sample` epoch `newFundingSampleEpoch`.
+// For each of these perpetual markets:
 //  1. Get the median of `PremiumVotes` collected during the past `funding-sample` epoch.
 //     This median value is referred to as a "sample".
-//  2. Append the new "sample" to the `PremiumSamples` in state.
+//  2. Append the new "sample" to the `PremiumSamples` of its funding schedule in state.
 //  3. Clear `PremiumVotes` to an empty slice.
 func (k Keeper) processPremiumVotesIntoSamples(
 	ctx sdk.Context,
 	newFundingSampleEpoch epochstypes.EpochInfo,
+	schedules []fundingSchedule,
+	perpsBySchedule map[fundingSchedule][]types.Perpetual,
 ) {
+	premiumVotesKey := types.GetPremiumVotesKey(newFundingSampleEpoch.GetEpochInfoName())
+
 	// For premium votes, we take the median of all votes without modifying the list
 	// (using identify function as `filterFunc`)
 	perpIdToSummarizedPremium := k.processStoredPremiums(
 		ctx,
 		newFundingSampleEpoch,
-		types.PremiumVotesKey,
+		premiumVotesKey,
 		k.GetParams(ctx).MinNumVotesPerSample,
 		// `MustGetMedian` panics when the padded list is empty, which breaks the invariant that
 		// Max(premiumStore.NumPremiums, minNumPremiumsRequired) > 0.
@@ -279,87 +413,102 @@ func (k Keeper) processPremiumVotesIntoSamples(
 		func(input []int32) []int32 { return input }, // filterFunc
 	)
 
-	newSamples := []types.FundingPremium{}
 	newSamplesForEvent := []indexerevents.FundingUpdateV1{}
 
-	allPerps := k.GetAllPerpetuals(ctx)
-
-	for _, perp := range allPerps {
-		summarizedPremium, found := perpIdToSummarizedPremium[perp.GetId()]
-		if !found {
-			summarizedPremium = 0
+	for _, schedule := range schedules {
+		if schedule.sampleEpoch != newFundingSampleEpoch.GetEpochInfoName() {
+			continue
 		}
 
-		telemetry.SetGaugeWithLabels(
-			[]string{
-				types.ModuleName,
-				metrics.PremiumSampleValue,
-			},
-			float32(summarizedPremium),
-			[]gometrics.Label{
-				metrics.GetLabelForIntValue(
-					metrics.PerpetualId,
-					int(perp.GetId()),
-				),
-			},
-		)
+		newSamples := []types.FundingPremium{}
 
-		// Append all samples (including zeros) to `newSamplesForEvent`, since
-		// the indexer should forward all sample values to users.
-		newSamplesForEvent = append(newSamplesForEvent, indexerevents.FundingUpdateV1{
-			PerpetualId:     perp.GetId(),
-			FundingValuePpm: summarizedPremium,
-		})
+		for _, perp := range perpsBySchedule[schedule] {
+			summarizedPremium, found := perpIdToSummarizedPremium[perp.GetId()]
+			if !found {
+				summarizedPremium = 0
+			}
 
-		if summarizedPremium != 0 {
-			// Append non-zero sample to `PremiumSample` storage.
-			newSamples = append(newSamples, types.FundingPremium{
-				PerpetualId: perp.GetId(),
-				PremiumPpm:  summarizedPremium,
+			telemetry.SetGaugeWithLabels(
+				[]string{
+					types.ModuleName,
+					metrics.PremiumSampleValue,
+				},
+				float32(summarizedPremium),
+				[]gometrics.Label{
+					metrics.GetLabelForIntValue(
+						metrics.PerpetualId,
+						int(perp.GetId()),
+					),
+				},
+			)
+
+			// Append all samples (including zeros) to `newSamplesForEvent`, since
+			// the indexer should forward all sample values to users.
+			newSamplesForEvent = append(newSamplesForEvent, indexerevents.FundingUpdateV1{
+				PerpetualId:     perp.GetId(),
+				FundingValuePpm: summarizedPremium,
 			})
+
+			if summarizedPremium != 0 {
+				// Append non-zero sample to `PremiumSample` storage.
+				newSamples = append(newSamples, types.FundingPremium{
+					PerpetualId: perp.GetId(),
+					PremiumPpm:  summarizedPremium,
+				})
+			}
 		}
-	}
 
-	if err := k.AddPremiumSamples(ctx, newSamples); err != nil {
-		panic(err)
+		if err := k.addToPremiumStore(
+			ctx,
+			newSamples,
+			types.GetPremiumSamplesKey(schedule.tickEpoch, schedule.sampleEpoch),
+			metrics.AddPremiumSamples,
+		); err != nil {
+			panic(err)
+		}
 	}
 
+	sort.Slice(newSamplesForEvent, func(i, j int) bool {
+		return newSamplesForEvent[i].PerpetualId < newSamplesForEvent[j].PerpetualId
+	})
+
 	k.indexerEventManager.AddBlockEvent(
 		ctx,
 		indexerevents.SubtypeFundingValues,
 		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
 		indexerevents.FundingValuesEventVersion,
 		indexer_manager.GetBytes(
-			indexerevents.NewPremiumSamplesEvent(newSamplesForEvent),
+			indexerevents.NewPremiumSamplesEvent(newSamplesForEvent, newFundingSampleEpoch.Duration),
 		),
 	)
 
-	k.SetEmptyPremiumVotes(ctx)
+	k.setPremiumStore(ctx, types.PremiumStore{}, premiumVotesKey)
 }
 
 // MaybeProcessNewFundingSampleEpoch summarizes premium votes stored in application
-// states into new funding samples, if the current block is the start of a new
-// `funding-sample` epoch. Otherwise, does nothing.
+// states into new funding samples, for each `funding-sample` epoch referenced by a
+// perpetual that starts in the current block. Otherwise, does nothing.
 func (k Keeper) MaybeProcessNewFundingSampleEpoch(
 	ctx sdk.Context,
 ) {
-	numBlocks, err := k.epochsKeeper.NumBlocksSinceEpochStart(
-		ctx,
-		epochstypes.FundingSampleEpochInfoName,
-	)
-	// Invariant broken: `FundingSample` epoch must exist in epochs store.
-	if err != nil {
-		panic(err)
-	}
+	schedules, perpsBySchedule := k.getPerpetualsByFundingSchedule(ctx)
 
-	// If the current block is not the start of a new funding-sample epoch, do nothing.
-	if numBlocks != 0 {
-		return
-	}
+	processedSampleEpochs := make(map[epochstypes.EpochInfoName]bool)
+	for _, schedule := range schedules {
+		if processedSampleEpochs[schedule.sampleEpoch] {
+			continue
+		}
+		processedSampleEpochs[schedule.sampleEpoch] = true
+
+		// If the current block is not the start of a new funding-sample epoch, do nothing.
+		if !k.isEpochStart(ctx, schedule.sampleEpoch) {
+			continue
+		}
 
-	newFundingSampleEpoch := k.epochsKeeper.MustGetFundingSampleEpochInfo(ctx)
+		newFundingSampleEpoch := k.mustGetEpochInfo(ctx, schedule.sampleEpoch)
 
-	k.processPremiumVotesIntoSamples(ctx, newFundingSampleEpoch)
+		k.processPremiumVotesIntoSamples(ctx, newFundingSampleEpoch, schedules, perpsBySchedule)
+	}
 }
 
 // getFundingIndexDelta returns fundingIndexDelta which represents the change of FundingIndex since
@@ -453,6 +602,11 @@ func (k Keeper) sampleAllPerpetuals(ctx sdk.Context) (
 	marketIdToIndexPrice := k.pricesKeeper.GetMarketIdToValidIndexPrice(ctx)
 
 	for _, perp := range allPerpetuals {
+		// Settled perpetuals are not sampled.
+		if perp.Settled {
+			continue
+		}
+
 		indexPrice, exists := marketIdToIndexPrice[perp.Params.MarketId]
 		// Valid index price is missing
 		if !exists {
@@ -566,171 +720,218 @@ func (k Keeper) GetRemoveSampleTailsFunc(
 	}
 }
 
-// MaybeProcessNewFundingTickEpoch processes funding ticks if the current block
-// is the start of a new funding-tick epoch. Otherwise, do nothing.
+// MaybeProcessNewFundingTickEpoch processes funding ticks for each `funding-tick` epoch
+// referenced by a perpetual that starts in the current block. Otherwise, do nothing.
 func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
-	numBlocks, err := k.epochsKeeper.NumBlocksSinceEpochStart(
-		ctx,
-		epochstypes.FundingTickEpochInfoName,
-	)
-	if err != nil {
-		panic(err)
-	}
+	schedules, perpsBySchedule := k.getPerpetualsByFundingSchedule(ctx)
 
-	// If the current block is not the start of a new funding-tick epoch, do nothing.
-	if numBlocks != 0 {
-		return
-	}
+	processedTickEpochs := make(map[epochstypes.EpochInfoName]bool)
+	for _, schedule := range schedules {
+		if processedTickEpochs[schedule.tickEpoch] {
+			continue
+		}
+		processedTickEpochs[schedule.tickEpoch] = true
 
-	allPerps := k.GetAllPerpetuals(ctx)
-	params := k.GetParams(ctx)
+		// If the current block is not the start of a new funding-tick epoch, do nothing.
+		if !k.isEpochStart(ctx, schedule.tickEpoch) {
+			continue
+		}
 
-	fundingTickEpochInfo := k.epochsKeeper.MustGetFundingTickEpochInfo(ctx)
-	fundingSampleEpochInfo := k.epochsKeeper.MustGetFundingSampleEpochInfo(ctx)
+		fundingTickEpochInfo := k.mustGetEpochInfo(ctx, schedule.tickEpoch)
 
-	// Use the ratio between funding-tick and funding-sample durations
-	// as minimum number of samples required to get a premium rate.
-	minSampleRequiredForPremiumRate := lib.MustDivideUint32RoundUp(
-		fundingTickEpochInfo.Duration,
-		fundingSampleEpochInfo.Duration,
-	)
+		k.processFundingTick(ctx, fundingTickEpochInfo, schedules, perpsBySchedule)
+	}
+}
 
-	// TODO(DEC-1449): Read `RemovedTailSampleRatioPpm` from state. Determine initial value.
-	// This value should be 0% or some low value like 5%, since we already has a layer of
-	// filtering we compute samples as median of premium votes.
-	tailRemovalRatePpm := types.RemovedTailSampleRatioPpm
+// processFundingTick summarizes premium samples into funding rates, and updates the
+// funding indices of all perpetuals settled on the `funding-tick` epoch
+// `fundingTickEpochInfo`.
+func (k Keeper) processFundingTick(
+	ctx sdk.Context,
+	fundingTickEpochInfo epochstypes.EpochInfo,
+	schedules []fundingSchedule,
+	perpsBySchedule map[fundingSchedule][]types.Perpetual,
+) {
+	params := k.GetParams(ctx)
 
 	// Get `sampleTailsRemovalFunc` which removes a percentage of top and bottom samples
 	// from the input after sorting.
-
-	sampleTailsRemovalFunc := k.GetRemoveSampleTailsFunc(ctx, tailRemovalRatePpm)
-
-	// Process stored samples from last `funding-tick` epoch, and retrieve
-	// a mapping from `perpetualId` to summarized premium rate for this epoch.
-	// For premiums, we first remove a fixed amount of bottom/top samples, then
-	// take the average of the remaining samples.
-	perpIdToPremiumPpm := k.processStoredPremiums(
-		ctx,
-		fundingTickEpochInfo,
-		types.PremiumSamplesKey,
-		minSampleRequiredForPremiumRate,
-		lib.AvgInt32,           // combineFunc
-		sampleTailsRemovalFunc, // filterFunc
-	)
+	sampleTailsRemovalFunc := k.GetRemoveSampleTailsFunc(ctx, params.RemovedTailSampleRatioPpm)
 
 	newFundingRatesAndIndicesForEvent := []indexerevents.FundingUpdateV1{}
 
-	for _, perp := range allPerps {
-		premiumPpm, found := perpIdToPremiumPpm[perp.Params.Id]
-
-		if !found {
-			k.Logger(ctx).Info(
-				fmt.Sprintf(
-					"MaybeProcessNewFundingTickEpoch: No samples found for perpetual (%v) during `funding-tick` epoch\n",
-					perp.Params.Id,
-				),
-			)
-
-			premiumPpm = 0
+	for _, schedule := range schedules {
+		if schedule.tickEpoch != fundingTickEpochInfo.GetEpochInfoName() {
+			continue
 		}
 
-		bigFundingRatePpm := new(big.Int).SetInt64(int64(premiumPpm))
+		fundingSampleEpochInfo := k.mustGetEpochInfo(ctx, schedule.sampleEpoch)
+		premiumSamplesKey := types.GetPremiumSamplesKey(schedule.tickEpoch, schedule.sampleEpoch)
 
-		// funding rate = premium + default funding
-		bigFundingRatePpm.Add(
-			bigFundingRatePpm,
-			new(big.Int).SetInt64(int64(perp.Params.DefaultFundingPpm)),
+		// Use the ratio between funding-tick and funding-sample durations
+		// as minimum number of samples required to get a premium rate.
+		minSampleRequiredForPremiumRate := lib.MustDivideUint32RoundUp(
+			fundingTickEpochInfo.Duration,
+			fundingSampleEpochInfo.Duration,
 		)
 
-		liquidityTier, err := k.GetLiquidityTier(ctx, perp.Params.LiquidityTier)
-		if err != nil {
-			panic(err)
-		}
+		// Process stored samples from last `funding-tick` epoch, and retrieve
+		// a mapping from `perpetualId` to summarized premium rate for this epoch.
+		// For premiums, we first remove a fixed amount of bottom/top samples, then
+		// take the average of the remaining samples.
+		perpIdToPremiumPpm := k.processStoredPremiums(
+			ctx,
+			fundingTickEpochInfo,
+			premiumSamplesKey,
+			minSampleRequiredForPremiumRate,
+			lib.AvgInt32,           // combineFunc
+			sampleTailsRemovalFunc, // filterFunc
+		)
 
-		// Panic if maintenance fraction ppm is larger than its maximum value.
-		if liquidityTier.MaintenanceFractionPpm > types.MaxMaintenanceFractionPpm {
-			panic(errorsmod.Wrapf(
-				types.ErrMaintenanceFractionPpmExceedsMax,
-				"perpetual Id = (%d), liquidity tier Id = (%d), maintenance fraction ppm = (%v)",
-				perp.Params.Id, perp.Params.LiquidityTier, liquidityTier.MaintenanceFractionPpm,
-			))
-		}
+		for _, perp := range perpsBySchedule[schedule] {
+			premiumPpm, found := perpIdToPremiumPpm[perp.Params.Id]
 
-		// Clamp funding rate according to equation:
-		// |R| <= clamp_factor * (initial margin - maintenance margin)
-		fundingRateUpperBoundPpm := liquidityTier.GetMaxAbsFundingClampPpm(params.FundingRateClampFactorPpm)
-		bigFundingRatePpm = lib.BigIntClamp(
-			bigFundingRatePpm,
-			new(big.Int).Neg(fundingRateUpperBoundPpm),
-			fundingRateUpperBoundPpm,
-		)
+			if !found {
+				k.Logger(ctx).Info(
+					fmt.Sprintf(
+						"MaybeProcessNewFundingTickEpoch: No samples found for perpetual (%v) during `funding-tick` epoch\n",
+						perp.Params.Id,
+					),
+				)
 
-		// Emit clamped funding rate.
-		telemetry.SetGaugeWithLabels(
-			[]string{
-				types.ModuleName,
-				metrics.PremiumRate,
-			},
-			float32(bigFundingRatePpm.Int64()),
-			[]gometrics.Label{
-				metrics.GetLabelForIntValue(
-					metrics.PerpetualId,
-					int(perp.Params.Id),
-				),
-			},
-		)
+				premiumPpm = 0
+			}
 
-		if bigFundingRatePpm.Cmp(lib.BigMaxInt32()) > 0 {
-			panic(errorsmod.Wrapf(
-				types.ErrFundingRateInt32Overflow,
-				"perpetual Id = (%d), funding rate = (%v)",
-				perp.Params.Id, bigFundingRatePpm,
-			))
-		}
+			bigFundingRatePpm := k.getFundingRatePpm(ctx, perp, premiumPpm, params)
+
+			if bigFundingRatePpm.Sign() != 0 {
+				fundingIndexDelta, err := k.getFundingIndexDelta(
+					ctx,
+					perp,
+					bigFundingRatePpm,
+					// use funding-tick duration as `timeSinceLastFunding`
+					// TODO(DEC-1483): Handle the case when duration value is updated
+					// during the epoch.
+					fundingTickEpochInfo.Duration,
+				)
+				if err != nil {
+					panic(err)
+				}
 
-		if bigFundingRatePpm.Sign() != 0 {
-			fundingIndexDelta, err := k.getFundingIndexDelta(
-				ctx,
-				perp,
-				bigFundingRatePpm,
-				// use funding-tick duration as `timeSinceLastFunding`
-				// TODO(DEC-1483): Handle the case when duration value is updated
-				// during the epoch.
-				fundingTickEpochInfo.Duration,
-			)
-			if err != nil {
-				panic(err)
+				if err := k.ModifyFundingIndex(ctx, perp.Params.Id, fundingIndexDelta); err != nil {
+					panic(err)
+				}
 			}
 
-			if err := k.ModifyFundingIndex(ctx, perp.Params.Id, fundingIndexDelta); err != nil {
+			// Get perpetual object with updated funding index.
+			perp, err := k.GetPerpetual(ctx, perp.Params.Id)
+			if err != nil {
 				panic(err)
 			}
-		}
+			newFundingRatesAndIndicesForEvent = append(newFundingRatesAndIndicesForEvent, indexerevents.FundingUpdateV1{
+				PerpetualId:     perp.Params.Id,
+				FundingValuePpm: int32(bigFundingRatePpm.Int64()),
+				FundingIndex:    perp.FundingIndex,
+			})
 
-		// Get perpetual object with updated funding index.
-		perp, err = k.GetPerpetual(ctx, perp.Params.Id)
-		if err != nil {
-			panic(err)
+			k.AppendFundingHistoryEntry(ctx, perp.Params.Id, types.FundingHistoryEntry{
+				BlockHeight:     lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				FundingRatePpm:  int32(bigFundingRatePpm.Int64()),
+				FundingIndex:    perp.FundingIndex,
+				IntervalSeconds: fundingTickEpochInfo.Duration,
+			})
 		}
-		newFundingRatesAndIndicesForEvent = append(newFundingRatesAndIndicesForEvent, indexerevents.FundingUpdateV1{
-			PerpetualId:     perp.Params.Id,
-			FundingValuePpm: int32(bigFundingRatePpm.Int64()),
-			FundingIndex:    perp.FundingIndex,
-		})
+
+		// Clear premium samples.
+		k.setPremiumStore(ctx, types.PremiumStore{}, premiumSamplesKey)
 	}
 
+	sort.Slice(newFundingRatesAndIndicesForEvent, func(i, j int) bool {
+		return newFundingRatesAndIndicesForEvent[i].PerpetualId < newFundingRatesAndIndicesForEvent[j].PerpetualId
+	})
+
 	k.indexerEventManager.AddBlockEvent(
 		ctx,
 		indexerevents.SubtypeFundingValues,
 		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
 		indexerevents.FundingValuesEventVersion,
 		indexer_manager.GetBytes(
-			indexerevents.NewFundingRatesAndIndicesEvent(newFundingRatesAndIndicesForEvent),
+			indexerevents.NewFundingRatesAndIndicesEvent(
+				newFundingRatesAndIndicesForEvent,
+				fundingTickEpochInfo.Duration,
+			),
 		),
 	)
+}
 
-	// Clear premium samples.
-	k.SetEmptyPremiumSamples(ctx)
+// getFundingRatePpm returns the funding rate of a perpetual given its premium rate for a
+// `funding-tick` epoch. The funding rate is the sum of the premium rate, the default funding
+// rate and the interest rate of the perpetual, clamped according to its liquidity tier and
+// its funding rate bounds.
+func (k Keeper) getFundingRatePpm(
+	ctx sdk.Context,
+	perp types.Perpetual,
+	premiumPpm int32,
+	params types.Params,
+) (
+	bigFundingRatePpm *big.Int,
+) {
+	bigFundingRatePpm = new(big.Int).SetInt64(int64(premiumPpm))
+
+	// funding rate = premium + default funding + interest
+	bigFundingRatePpm.Add(
+		bigFundingRatePpm,
+		new(big.Int).SetInt64(int64(perp.Params.DefaultFundingPpm)),
+	)
+	bigFundingRatePpm.Add(
+		bigFundingRatePpm,
+		perp.Params.GetInterestFundingRatePpm(),
+	)
+
+	liquidityTier, err := k.GetLiquidityTier(ctx, perp.Params.LiquidityTier)
+	if err != nil {
+		panic(err)
+	}
+
+	// Panic if maintenance fraction ppm is larger than its maximum value.
+	if liquidityTier.MaintenanceFractionPpm > types.MaxMaintenanceFractionPpm {
+		panic(errorsmod.Wrapf(
+			types.ErrMaintenanceFractionPpmExceedsMax,
+			"perpetual Id = (%d), liquidity tier Id = (%d), maintenance fraction ppm = (%v)",
+			perp.Params.Id, perp.Params.LiquidityTier, liquidityTier.MaintenanceFractionPpm,
+		))
+	}
+
+	// Clamp funding rate according to equation:
+	// |R| <= clamp_factor * (initial margin - maintenance margin)
+	// and to the funding rate bounds of the perpetual, if any.
+	fundingRateUpperBoundPpm := liquidityTier.GetMaxAbsFundingClampPpm(params.FundingRateClampFactorPpm)
+	bigFundingRatePpm = perp.Params.ClampFundingRatePpm(bigFundingRatePpm, fundingRateUpperBoundPpm)
+
+	// Emit clamped funding rate.
+	telemetry.SetGaugeWithLabels(
+		[]string{
+			types.ModuleName,
+			metrics.PremiumRate,
+		},
+		float32(bigFundingRatePpm.Int64()),
+		[]gometrics.Label{
+			metrics.GetLabelForIntValue(
+				metrics.PerpetualId,
+				int(perp.Params.Id),
+			),
+		},
+	)
+
+	if bigFundingRatePpm.Cmp(lib.BigMaxInt32()) > 0 {
+		panic(errorsmod.Wrapf(
+			types.ErrFundingRateInt32Overflow,
+			"perpetual Id = (%d), funding rate = (%v)",
+			perp.Params.Id, bigFundingRatePpm,
+		))
+	}
+
+	return bigFundingRatePpm
 }
 
 // GetNetNotional returns the net notional in quote quantums, which can be represented by the following equation:
@@ -949,22 +1150,66 @@ func (k Keeper) GetSettlementPpm(
 	return bigNetSettlementPpm, perpetual.FundingIndex.BigInt(), nil
 }
 
-// GetPremiumSamples reads premium samples from the current `funding-tick` epoch,
-// stored in a `PremiumStore` struct.
+// GetPremiumSamples reads premium samples from the current `funding-tick` epoch
+// of the default funding schedule, stored in a `PremiumStore` struct.
 func (k Keeper) GetPremiumSamples(ctx sdk.Context) (
 	premiumStore types.PremiumStore,
 ) {
 	return k.getPremiumStore(ctx, types.PremiumSamplesKey)
 }
 
-// GetPremiumVotes premium sample votes from the current `funding-sample` epoch,
-// stored in a `PremiumStore` struct.
+// GetPremiumVotes premium sample votes from the current `funding-sample` epoch
+// of the default funding schedule, stored in a `PremiumStore` struct.
 func (k Keeper) GetPremiumVotes(ctx sdk.Context) (
 	premiumStore types.PremiumStore,
 ) {
 	return k.getPremiumStore(ctx, types.PremiumVotesKey)
 }
 
+// GetAllPremiumSamples returns the premium samples of each funding schedule referenced by a
+// perpetual, with the default funding schedule first.
+func (k Keeper) GetAllPremiumSamples(ctx sdk.Context) (
+	premiumStores []types.FundingSchedulePremiumStore,
+) {
+	schedules, _ := k.getPerpetualsByFundingSchedule(ctx)
+
+	premiumStores = make([]types.FundingSchedulePremiumStore, 0, len(schedules))
+	for _, schedule := range schedules {
+		premiumStores = append(premiumStores, types.FundingSchedulePremiumStore{
+			FundingTickEpoch:   string(schedule.tickEpoch),
+			FundingSampleEpoch: string(schedule.sampleEpoch),
+			PremiumStore: k.getPremiumStore(
+				ctx,
+				types.GetPremiumSamplesKey(schedule.tickEpoch, schedule.sampleEpoch),
+			),
+		})
+	}
+	return premiumStores
+}
+
+// GetAllPremiumVotes returns the premium votes of each `funding-sample` epoch referenced by a
+// perpetual, with the default `funding-sample` epoch first.
+func (k Keeper) GetAllPremiumVotes(ctx sdk.Context) (
+	premiumStores []types.FundingSchedulePremiumStore,
+) {
+	schedules, _ := k.getPerpetualsByFundingSchedule(ctx)
+
+	premiumStores = make([]types.FundingSchedulePremiumStore, 0, len(schedules))
+	processedSampleEpochs := make(map[epochstypes.EpochInfoName]bool)
+	for _, schedule := range schedules {
+		if processedSampleEpochs[schedule.sampleEpoch] {
+			continue
+		}
+		processedSampleEpochs[schedule.sampleEpoch] = true
+
+		premiumStores = append(premiumStores, types.FundingSchedulePremiumStore{
+			FundingSampleEpoch: string(schedule.sampleEpoch),
+			PremiumStore:       k.getPremiumStore(ctx, types.GetPremiumVotesKey(schedule.sampleEpoch)),
+		})
+	}
+	return premiumStores
+}
+
 func (k Keeper) getPremiumStore(ctx sdk.Context, key string) (
 	premiumStore types.PremiumStore,
 ) {
@@ -980,30 +1225,96 @@ func (k Keeper) getPremiumStore(ctx sdk.Context, key string) (
 	return premiumStore
 }
 
-// AddPremiumVotes adds a list of new premium votes to state.
+// AddPremiumVotes adds a list of new premium votes to state. Votes are added to the
+// premium votes of the `funding-sample` epoch of their perpetual, and every
+// `funding-sample` epoch referenced by a perpetual counts a new round of votes.
 func (k Keeper) AddPremiumVotes(
 	ctx sdk.Context,
 	newVotes []types.FundingPremium,
 ) error {
-	return k.addToPremiumStore(
-		ctx,
-		newVotes,
-		types.PremiumVotesKey,
-		metrics.AddPremiumVotes,
-	)
+	schedules, perpsBySchedule := k.getPerpetualsByFundingSchedule(ctx)
+
+	perpIdToSampleEpoch := make(map[uint32]epochstypes.EpochInfoName)
+	for _, schedule := range schedules {
+		for _, perp := range perpsBySchedule[schedule] {
+			perpIdToSampleEpoch[perp.GetId()] = schedule.sampleEpoch
+		}
+	}
+
+	votesBySampleEpoch := make(map[epochstypes.EpochInfoName][]types.FundingPremium)
+	for _, vote := range newVotes {
+		sampleEpoch, found := perpIdToSampleEpoch[vote.PerpetualId]
+		if !found {
+			return errorsmod.Wrapf(
+				types.ErrPerpetualDoesNotExist,
+				"perpetual ID = %d",
+				vote.PerpetualId,
+			)
+		}
+		votesBySampleEpoch[sampleEpoch] = append(votesBySampleEpoch[sampleEpoch], vote)
+	}
+
+	processedSampleEpochs := make(map[epochstypes.EpochInfoName]bool)
+	for _, schedule := range schedules {
+		if processedSampleEpochs[schedule.sampleEpoch] {
+			continue
+		}
+		processedSampleEpochs[schedule.sampleEpoch] = true
+
+		if err := k.addToPremiumStore(
+			ctx,
+			votesBySampleEpoch[schedule.sampleEpoch],
+			types.GetPremiumVotesKey(schedule.sampleEpoch),
+			metrics.AddPremiumVotes,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// AddPremiumSamples adds a list of new premium samples to state.
+// AddPremiumSamples adds a list of new premium samples to state. Samples are added to the
+// premium samples of the funding schedule of their perpetual, and every funding schedule
+// referenced by a perpetual counts a new round of samples.
 func (k Keeper) AddPremiumSamples(
 	ctx sdk.Context,
 	newSamples []types.FundingPremium,
 ) error {
-	return k.addToPremiumStore(
-		ctx,
-		newSamples,
-		types.PremiumSamplesKey,
-		metrics.AddPremiumSamples,
-	)
+	schedules, perpsBySchedule := k.getPerpetualsByFundingSchedule(ctx)
+
+	perpIdToSchedule := make(map[uint32]fundingSchedule)
+	for _, schedule := range schedules {
+		for _, perp := range perpsBySchedule[schedule] {
+			perpIdToSchedule[perp.GetId()] = schedule
+		}
+	}
+
+	samplesBySchedule := make(map[fundingSchedule][]types.FundingPremium)
+	for _, sample := range newSamples {
+		schedule, found := perpIdToSchedule[sample.PerpetualId]
+		if !found {
+			return errorsmod.Wrapf(
+				types.ErrPerpetualDoesNotExist,
+				"perpetual ID = %d",
+				sample.PerpetualId,
+			)
+		}
+		samplesBySchedule[schedule] = append(samplesBySchedule[schedule], sample)
+	}
+
+	for _, schedule := range schedules {
+		if err := k.addToPremiumStore(
+			ctx,
+			samplesBySchedule[schedule],
+			types.GetPremiumSamplesKey(schedule.tickEpoch, schedule.sampleEpoch),
+			metrics.AddPremiumSamples,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (k Keeper) addToPremiumStore(
@@ -1055,6 +1366,30 @@ func (k Keeper) addToPremiumStore(
 	return nil
 }
 
+// removeFromPremiumStore removes the premiums of a perpetual from a `PremiumStore`.
+func (k Keeper) removeFromPremiumStore(
+	ctx sdk.Context,
+	key string,
+	perpetualId uint32,
+) {
+	premiumStore := k.getPremiumStore(ctx, key)
+
+	marketPremiumsMap := premiumStore.GetMarketPremiumsMap()
+	if _, found := marketPremiumsMap[perpetualId]; !found {
+		return
+	}
+	delete(marketPremiumsMap, perpetualId)
+
+	k.setPremiumStore(
+		ctx,
+		*types.NewPremiumStoreFromMarketPremiumMap(
+			marketPremiumsMap,
+			premiumStore.NumPremiums,
+		),
+		key,
+	)
+}
+
 func (k Keeper) ModifyFundingIndex(
 	ctx sdk.Context,
 	perpetualId uint32,
@@ -1158,6 +1493,7 @@ func (k Keeper) GetPerpetualAndMarketPrice(
 // Performs the following validation (stateful and stateless) on a `Perpetual`
 // structs fields, returning an error if any conditions are false:
 // - MarketId is not a valid market.
+// - FundingTickEpoch or FundingSampleEpoch is set and is not an existing epoch.
 // - All stateless validation performed in `validatePerpetualStateless`.
 func (k Keeper) validatePerpetual(
 	ctx sdk.Context,
@@ -1178,6 +1514,20 @@ func (k Keeper) validatePerpetual(
 		return errorsmod.Wrap(types.ErrLiquidityTierDoesNotExist, lib.UintToString(perpetual.Params.LiquidityTier))
 	}
 
+	// Validate funding epochs referenced by the perpetual exist. The default funding epochs
+	// are required to exist by the funding logic.
+	for _, epochInfoName := range []string{
+		perpetual.Params.FundingTickEpoch,
+		perpetual.Params.FundingSampleEpoch,
+	} {
+		if epochInfoName == "" {
+			continue
+		}
+		if _, found := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.EpochInfoName(epochInfoName)); !found {
+			return errorsmod.Wrap(types.ErrFundingEpochDoesNotExist, epochInfoName)
+		}
+	}
+
 	return nil
 }
 
@@ -1299,18 +1649,20 @@ func (k Keeper) SetLiquidityTier(
 	maintenanceFractionPpm uint32,
 	basePositionNotional uint64,
 	impactNotional uint64,
+	openInterestCapNotional uint64,
 ) (
 	liquidityTier types.LiquidityTier,
 	err error,
 ) {
 	// Construct liquidity tier.
 	liquidityTier = types.LiquidityTier{
-		Id:                     id,
-		Name:                   name,
-		InitialMarginPpm:       initialMarginPpm,
-		MaintenanceFractionPpm: maintenanceFractionPpm,
-		BasePositionNotional:   basePositionNotional,
-		ImpactNotional:         impactNotional,
+		Id:                      id,
+		Name:                    name,
+		InitialMarginPpm:        initialMarginPpm,
+		MaintenanceFractionPpm:  maintenanceFractionPpm,
+		BasePositionNotional:    basePositionNotional,
+		ImpactNotional:          impactNotional,
+		OpenInterestCapNotional: openInterestCapNotional,
 	}
 
 	// Validate liquidity tier's fields.
@@ -1427,10 +1779,63 @@ func (k Keeper) getLiquidityTiertoMaxAbsPremiumVotePpm(
 	return ltToMaxAbsPremiumVotePpm
 }
 
+// GetOpenInterestCapNotional returns the open interest cap of a perpetual in quote quantums, which is the
+// lower of the non-zero caps of the perpetual and of its liquidity tier. Returns zero if neither is capped.
+func (k Keeper) GetOpenInterestCapNotional(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	openInterestCapNotional uint64,
+	err error,
+) {
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return 0, err
+	}
+
+	liquidityTier, err := k.GetLiquidityTier(ctx, perpetual.Params.LiquidityTier)
+	if err != nil {
+		return 0, err
+	}
+
+	openInterestCapNotional = perpetual.Params.OpenInterestCapNotional
+	if liquidityTier.OpenInterestCapNotional != 0 &&
+		(openInterestCapNotional == 0 || liquidityTier.OpenInterestCapNotional < openInterestCapNotional) {
+		openInterestCapNotional = liquidityTier.OpenInterestCapNotional
+	}
+	return openInterestCapNotional, nil
+}
+
+// IsOpenInterestWithinCap returns whether the given open interest of a perpetual, in base quantums, is
+// within the open interest cap of the perpetual when measured at the current oracle price.
+func (k Keeper) IsOpenInterestWithinCap(
+	ctx sdk.Context,
+	perpetualId uint32,
+	openInterest *big.Int,
+) (
+	withinCap bool,
+	err error,
+) {
+	openInterestCapNotional, err := k.GetOpenInterestCapNotional(ctx, perpetualId)
+	if err != nil {
+		return false, err
+	}
+	if openInterestCapNotional == 0 {
+		return true, nil
+	}
+
+	openInterestNotional, err := k.GetNetNotional(ctx, perpetualId, openInterest)
+	if err != nil {
+		return false, err
+	}
+	return openInterestNotional.Cmp(new(big.Int).SetUint64(openInterestCapNotional)) <= 0, nil
+}
+
 // IsPositionUpdatable returns whether position of a perptual is updatable.
 // A perpetual is not updatable if it satisfies:
 //   - Perpetual has zero oracle price. Since new oracle prices are created at zero by default and valid
 //     oracle priceupdates are non-zero, this indicates the absence of a valid oracle price update.
+//   - Perpetual is settled, since all of its positions were closed in final settlement.
 func (k Keeper) IsPositionUpdatable(
 	ctx sdk.Context,
 	perpetualId uint32,
@@ -1438,7 +1843,7 @@ func (k Keeper) IsPositionUpdatable(
 	updatable bool,
 	err error,
 ) {
-	_, oraclePrice, err := k.GetPerpetualAndMarketPrice(
+	perpetual, oraclePrice, err := k.GetPerpetualAndMarketPrice(
 		ctx,
 		perpetualId,
 	)
@@ -1446,6 +1851,11 @@ func (k Keeper) IsPositionUpdatable(
 		return false, err
 	}
 
+	// If perpetual is settled, it is considered not updatable.
+	if perpetual.Settled {
+		return false, nil
+	}
+
 	// If perpetual has zero oracle price, it is considered not updatable.
 	if oraclePrice.Price == 0 {
 		return false, nil
@@ -59,6 +59,11 @@ func TestModifyPerpetual_Success(t *testing.T) {
 			marketId,
 			defaultFundingPpm,
 			liquidityTier,
+			0,
+			nil,
+			0,
+			"",
+			"",
 		)
 		require.NoError(t, err)
 
@@ -143,6 +148,7 @@ func TestCreatePerpetual_Failure(t *testing.T) {
 		atomicResolution  int32
 		defaultFundingPpm int32
 		liquidityTier     uint32
+		fundingTickEpoch  string
 		expectedError     error
 	}{
 		"Price doesn't exist": {
@@ -196,6 +202,16 @@ func TestCreatePerpetual_Failure(t *testing.T) {
 			liquidityTier:     0,
 			expectedError:     types.ErrTickerEmptyString,
 		},
+		"Funding tick epoch doesn't exist": {
+			id:                0,
+			ticker:            "ticker",
+			marketId:          0,
+			atomicResolution:  -10,
+			defaultFundingPpm: 0,
+			liquidityTier:     0,
+			fundingTickEpoch:  "funding-tick-fast",
+			expectedError:     errorsmod.Wrap(types.ErrFundingEpochDoesNotExist, "funding-tick-fast"),
+		},
 	}
 
 	// Test setup.
@@ -214,6 +230,11 @@ func TestCreatePerpetual_Failure(t *testing.T) {
 				tc.atomicResolution,
 				tc.defaultFundingPpm,
 				tc.liquidityTier,
+				0,
+				nil,
+				0,
+				tc.fundingTickEpoch,
+				"",
 			)
 
 			require.Error(t, err)
@@ -280,6 +301,11 @@ func TestModifyPerpetual_Failure(t *testing.T) {
 				tc.marketId,
 				tc.defaultFundingPpm,
 				tc.liquidityTier,
+				0,
+				nil,
+				0,
+				"",
+				"",
 			)
 
 			require.Error(t, err)
@@ -345,6 +371,11 @@ func TestHasPerpetual(t *testing.T) {
 			perps[perp].Params.AtomicResolution,
 			perps[perp].Params.DefaultFundingPpm,
 			perps[perp].Params.LiquidityTier,
+			perps[perp].Params.OpenInterestCapNotional,
+			perps[perp].Params.FundingRateBounds,
+			perps[perp].Params.InterestRatePpm,
+			perps[perp].Params.FundingTickEpoch,
+			perps[perp].Params.FundingSampleEpoch,
 		)
 		require.NoError(t, err)
 	}
@@ -422,6 +453,11 @@ func TestGetAllPerpetuals_Sorted(t *testing.T) {
 			perps[perp].Params.AtomicResolution,
 			perps[perp].Params.DefaultFundingPpm,
 			perps[perp].Params.LiquidityTier,
+			perps[perp].Params.OpenInterestCapNotional,
+			perps[perp].Params.FundingRateBounds,
+			perps[perp].Params.InterestRatePpm,
+			perps[perp].Params.FundingTickEpoch,
+			perps[perp].Params.FundingSampleEpoch,
 		)
 		require.NoError(t, err)
 	}
@@ -705,6 +741,7 @@ func TestGetMarginRequirements_Success(t *testing.T) {
 				tc.maintenanceFractionPpm,
 				tc.basePositionNotional,
 				1, // dummy impact notional value
+				0,
 			)
 			require.NoError(t, err)
 
@@ -717,6 +754,11 @@ func TestGetMarginRequirements_Success(t *testing.T) {
 				tc.baseCurrencyAtomicResolution, // AtomicResolution
 				int32(0),                        // DefaultFundingPpm
 				0,                               // LiquidityTier
+				0,
+				nil,
+				0,
+				"",
+				"",
 			)
 			require.NoError(t, err)
 
@@ -919,6 +961,11 @@ func TestGetNetNotional_Success(t *testing.T) {
 				tc.baseCurrencyAtomicResolution, // AtomicResolution
 				int32(0),                        // DefaultFundingPpm
 				0,                               // LiquidityTier
+				0,
+				nil,
+				0,
+				"",
+				"",
 			)
 			require.NoError(t, err)
 
@@ -1081,6 +1128,11 @@ func TestGetNotionalInBaseQuantums_Success(t *testing.T) {
 				tc.baseCurrencyAtomicResolution, // AtomicResolution
 				int32(0),                        // DefaultFundingPpm
 				0,                               // LiquidityTier
+				0,
+				nil,
+				0,
+				"",
+				"",
 			)
 			require.NoError(t, err)
 
@@ -1244,6 +1296,11 @@ func TestGetNetCollateral_Success(t *testing.T) {
 				tc.baseCurrencyAtomicResolution, // AtomicResolution
 				int32(0),                        // DefaultFundingPpm
 				0,                               // LiquidityTier
+				0,
+				nil,
+				0,
+				"",
+				"",
 			)
 			require.NoError(t, err)
 
@@ -1570,6 +1627,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 		testFundingTickDuration          uint32
 		testPerpetuals                   []types.Perpetual
 		testFundingSamples               []int32
+		testRemovedTailSampleRatioPpm    uint32
 		expectedFundingIndexDeltas       []*big.Int
 		expectedFundingIndexDeltaStrings []string
 		fundingRatesAndIndices           []indexerevents.FundingUpdateV1
@@ -1904,6 +1962,104 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 				},
 			},
 		},
+		"Success: 59 samples of 0.1 percent and one outlier, outlier removed as tail": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			},
+			testFundingSamples: append(
+				constants.GenerateConstantFundingPremiums(1000, 59),
+				600_000,
+			),
+			// 2% of 60 samples is 1.2, so the top and bottom samples are removed.
+			testRemovedTailSampleRatioPpm:    20_000,
+			expectedFundingIndexDeltaStrings: []string{"625"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 1_000,
+					FundingIndex:    dtypes.NewInt(625),
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.1 percent, clamped to max funding rate of perpetual": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				*perptest.GeneratePerpetual(
+					perptest.WithPerpetual(constants.BtcUsd_0DefaultFunding_10AtomicResolution),
+					perptest.WithFundingRateBounds(-800, 800),
+				),
+			},
+			testFundingSamples:               constants.GenerateConstantFundingPremiums(1000, 60),
+			expectedFundingIndexDeltaStrings: []string{"500"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 800,
+					FundingIndex:    dtypes.NewInt(500),
+				},
+			},
+		},
+		"Success: 60 equivalent samples of -0.1 percent, clamped to min funding rate of perpetual": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				*perptest.GeneratePerpetual(
+					perptest.WithPerpetual(constants.BtcUsd_0DefaultFunding_10AtomicResolution),
+					perptest.WithFundingRateBounds(-800, 800),
+				),
+			},
+			testFundingSamples:               constants.GenerateConstantFundingPremiums(-1000, 60),
+			expectedFundingIndexDeltaStrings: []string{"-500"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: -800,
+					FundingIndex:    dtypes.NewInt(-500),
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.1 percent, clamped to zero max funding rate of perpetual": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				*perptest.GeneratePerpetual(
+					perptest.WithPerpetual(constants.BtcUsd_0DefaultFunding_10AtomicResolution),
+					perptest.WithFundingRateBounds(-800, 0),
+				),
+			},
+			testFundingSamples:               constants.GenerateConstantFundingPremiums(1000, 60),
+			expectedFundingIndexDeltaStrings: []string{"0"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 0,
+					FundingIndex:    dtypes.NewInt(0),
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.1 percent, 87.6 percent annual interest rate": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				*perptest.GeneratePerpetual(
+					perptest.WithPerpetual(constants.BtcUsd_0DefaultFunding_10AtomicResolution),
+					perptest.WithInterestRatePpm(876_000),
+				),
+			},
+			testFundingSamples: constants.GenerateConstantFundingPremiums(1000, 60),
+			// 8-hr interest rate is 876_000 * 8 / 8_760 = 800, so the 8-hr funding rate is 1_800.
+			expectedFundingIndexDeltaStrings: []string{"1125"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 1_800,
+					FundingIndex:    dtypes.NewInt(1125),
+				},
+			},
+		},
 	}
 
 	testCurrentFundingTickEpochStartBlock := uint32(23)
@@ -1919,6 +2075,10 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 			// Create liquidity tiers.
 			keepertest.CreateTestLiquidityTiers(t, ctx, pc.PerpetualsKeeper)
 
+			params := pc.PerpetualsKeeper.GetParams(pc.Ctx)
+			params.RemovedTailSampleRatioPpm = tc.testRemovedTailSampleRatioPpm
+			require.NoError(t, pc.PerpetualsKeeper.SetParams(pc.Ctx, params))
+
 			// Create test perpetuals.
 			// 1BTC = $50,000.
 			oldPerps := make([]types.Perpetual, len(tc.testPerpetuals))
@@ -1931,6 +2091,11 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 				oldPerps[i] = perp
@@ -2006,6 +2171,7 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 			fundingEvents := getFundingBlockEventsFromIndexerBlock(pc.Ctx, pc.PerpetualsKeeper)
 			expectedFundingEvent := indexerevents.NewFundingRatesAndIndicesEvent(
 				tc.fundingRatesAndIndices,
+				tc.testFundingTickDuration,
 			)
 			require.Contains(t, fundingEvents, expectedFundingEvent)
 		})
@@ -2824,6 +2990,210 @@ func TestMaybeProcessNewFundingSampleEpoch(t *testing.T) {
 	}
 }
 
+func TestMaybeProcessNewFundingEpochs_PerpetualFundingEpochs(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	ctx := pc.Ctx.WithTxBytes(constants.TestTxBytes)
+	keepertest.CreateTestMarkets(t, ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, pc.PerpetualsKeeper)
+
+	params := pc.PerpetualsKeeper.GetParams(ctx)
+	params.MinNumVotesPerSample = 1
+	require.NoError(t, pc.PerpetualsKeeper.SetParams(ctx, params))
+
+	// The default funding epochs start at block 10, the fast funding epochs at block 20.
+	for _, epochInfo := range []epochstypes.EpochInfo{
+		{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			CurrentEpochStartBlock: 10,
+			CurrentEpoch:           1,
+		},
+		{
+			Name:                   string(epochstypes.FundingSampleEpochInfoName),
+			Duration:               60,
+			CurrentEpochStartBlock: 10,
+			CurrentEpoch:           1,
+		},
+		{
+			Name:                   "funding-tick-fast",
+			Duration:               1800,
+			CurrentEpochStartBlock: 20,
+			CurrentEpoch:           1,
+		},
+		{
+			Name:                   "funding-sample-fast",
+			Duration:               30,
+			CurrentEpochStartBlock: 20,
+			CurrentEpoch:           1,
+		},
+	} {
+		require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(ctx, epochInfo))
+	}
+
+	// Perpetual 0 is on the default funding epochs, perpetual 1 on the fast ones.
+	defaultPerp, err := pc.PerpetualsKeeper.CreatePerpetual(
+		ctx, 0, "BTC-USD", 0, -10, 0, 2, 0, nil, 0, "", "",
+	)
+	require.NoError(t, err)
+	fastPerp, err := pc.PerpetualsKeeper.CreatePerpetual(
+		ctx, 1, "ETH-USD", 1, -9, 0, 2, 0, nil, 0, "funding-tick-fast", "funding-sample-fast",
+	)
+	require.NoError(t, err)
+
+	err = pc.PerpetualsKeeper.AddPremiumVotes(ctx, []types.FundingPremium{
+		{PerpetualId: defaultPerp.Params.Id, PremiumPpm: 1000},
+		{PerpetualId: fastPerp.Params.Id, PremiumPpm: 3000},
+	})
+	require.NoError(t, err)
+
+	// Only votes of the default perpetual are stored with the default premium votes.
+	require.Equal(
+		t,
+		types.PremiumStore{
+			AllMarketPremiums: []types.MarketPremiums{
+				{PerpetualId: defaultPerp.Params.Id, Premiums: []int32{1000}},
+			},
+			NumPremiums: 1,
+		},
+		pc.PerpetualsKeeper.GetPremiumVotes(ctx),
+	)
+
+	// Block 20 starts the fast funding epochs only.
+	ctx = ctx.WithBlockHeight(20)
+	pc.PerpetualsKeeper.MaybeProcessNewFundingSampleEpoch(ctx)
+	pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx)
+
+	// Premiums and funding index of the default perpetual are untouched.
+	require.Equal(t, uint32(1), pc.PerpetualsKeeper.GetPremiumVotes(ctx).NumPremiums)
+	require.Equal(t, types.PremiumStore{}, pc.PerpetualsKeeper.GetPremiumSamples(ctx))
+	perp, err := pc.PerpetualsKeeper.GetPerpetual(ctx, defaultPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, defaultPerp.FundingIndex, perp.FundingIndex)
+
+	// The fast perpetual is sampled and funded on its own epochs. Its funding rate is the
+	// sample averaged over the 1800 / 30 = 60 samples of a `funding-tick-fast` epoch.
+	perp, err = pc.PerpetualsKeeper.GetPerpetual(ctx, fastPerp.Params.Id)
+	require.NoError(t, err)
+	require.NotEqual(t, fastPerp.FundingIndex, perp.FundingIndex)
+
+	fundingEvents := getFundingBlockEventsFromIndexerBlock(ctx, pc.PerpetualsKeeper)
+	require.Equal(
+		t,
+		[]*indexerevents.FundingEventV1{
+			indexerevents.NewPremiumSamplesEvent(
+				[]indexerevents.FundingUpdateV1{
+					{PerpetualId: fastPerp.Params.Id, FundingValuePpm: 3000, FundingIndex: dtypes.ZeroInt()},
+				},
+				30,
+			),
+			indexerevents.NewFundingRatesAndIndicesEvent(
+				[]indexerevents.FundingUpdateV1{
+					{PerpetualId: fastPerp.Params.Id, FundingValuePpm: 50, FundingIndex: perp.FundingIndex},
+				},
+				1800,
+			),
+		},
+		fundingEvents,
+	)
+
+	// Only the funding tick of the fast perpetual is recorded in the funding history.
+	require.Empty(t, pc.PerpetualsKeeper.GetFundingHistory(ctx, defaultPerp.Params.Id))
+	require.Equal(
+		t,
+		[]types.FundingHistoryEntry{
+			{
+				BlockHeight:     20,
+				FundingRatePpm:  50,
+				FundingIndex:    perp.FundingIndex,
+				IntervalSeconds: 1800,
+			},
+		},
+		pc.PerpetualsKeeper.GetFundingHistory(ctx, fastPerp.Params.Id),
+	)
+}
+
+func TestAddPremiums_FundingSchedules(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	for _, epochInfo := range []epochstypes.EpochInfo{
+		{Name: "funding-tick-fast", Duration: 1800},
+		{Name: "funding-sample-fast", Duration: 30},
+	} {
+		require.NoError(t, pc.EpochsKeeper.CreateEpochInfo(pc.Ctx, epochInfo))
+	}
+
+	// Perpetual 0 is on the default funding epochs, perpetual 1 on the fast ones.
+	defaultPerp, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx, 0, "BTC-USD", 0, -10, 0, 2, 0, nil, 0, "", "",
+	)
+	require.NoError(t, err)
+	fastPerp, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx, 1, "ETH-USD", 1, -9, 0, 2, 0, nil, 0, "funding-tick-fast", "funding-sample-fast",
+	)
+	require.NoError(t, err)
+
+	premiums := []types.FundingPremium{
+		{PerpetualId: defaultPerp.Params.Id, PremiumPpm: 1000},
+		{PerpetualId: fastPerp.Params.Id, PremiumPpm: 3000},
+	}
+	require.NoError(t, pc.PerpetualsKeeper.AddPremiumVotes(pc.Ctx, premiums))
+	require.NoError(t, pc.PerpetualsKeeper.AddPremiumSamples(pc.Ctx, premiums))
+
+	// Samples are only added to the funding schedule of their perpetual, but every funding
+	// schedule counts the new round of samples.
+	require.Equal(
+		t,
+		[]types.FundingSchedulePremiumStore{
+			{
+				FundingTickEpoch:   string(epochstypes.FundingTickEpochInfoName),
+				FundingSampleEpoch: string(epochstypes.FundingSampleEpochInfoName),
+				PremiumStore: types.PremiumStore{
+					AllMarketPremiums: []types.MarketPremiums{
+						{PerpetualId: defaultPerp.Params.Id, Premiums: []int32{1000}},
+					},
+					NumPremiums: 1,
+				},
+			},
+			{
+				FundingTickEpoch:   "funding-tick-fast",
+				FundingSampleEpoch: "funding-sample-fast",
+				PremiumStore: types.PremiumStore{
+					AllMarketPremiums: []types.MarketPremiums{
+						{PerpetualId: fastPerp.Params.Id, Premiums: []int32{3000}},
+					},
+					NumPremiums: 1,
+				},
+			},
+		},
+		pc.PerpetualsKeeper.GetAllPremiumSamples(pc.Ctx),
+	)
+	require.Equal(
+		t,
+		[]types.FundingSchedulePremiumStore{
+			{
+				FundingSampleEpoch: string(epochstypes.FundingSampleEpochInfoName),
+				PremiumStore: types.PremiumStore{
+					AllMarketPremiums: []types.MarketPremiums{
+						{PerpetualId: defaultPerp.Params.Id, Premiums: []int32{1000}},
+					},
+					NumPremiums: 1,
+				},
+			},
+			{
+				FundingSampleEpoch: "funding-sample-fast",
+				PremiumStore: types.PremiumStore{
+					AllMarketPremiums: []types.MarketPremiums{
+						{PerpetualId: fastPerp.Params.Id, Premiums: []int32{3000}},
+					},
+					NumPremiums: 1,
+				},
+			},
+		},
+		pc.PerpetualsKeeper.GetAllPremiumVotes(pc.Ctx),
+	)
+}
+
 func TestGetAllLiquidityTiers_Sorted(t *testing.T) {
 	// Setup context and keepers
 	pc := keepertest.PerpetualsKeepers(t)
@@ -2847,6 +3217,7 @@ func TestGetAllLiquidityTiers_Sorted(t *testing.T) {
 			lt.MaintenanceFractionPpm,
 			lt.BasePositionNotional,
 			lt.ImpactNotional,
+			lt.OpenInterestCapNotional,
 		)
 		require.NoError(t, err)
 	}
@@ -2886,6 +3257,7 @@ func TestHasLiquidityTier(t *testing.T) {
 			lt.MaintenanceFractionPpm,
 			lt.BasePositionNotional,
 			lt.ImpactNotional,
+			lt.OpenInterestCapNotional,
 		)
 		require.NoError(t, err)
 	}
@@ -2911,6 +3283,7 @@ func TestCreateLiquidityTier_Success(t *testing.T) {
 			lt.MaintenanceFractionPpm,
 			lt.BasePositionNotional,
 			lt.ImpactNotional,
+			lt.OpenInterestCapNotional,
 		)
 		require.NoError(t, err)
 
@@ -2991,6 +3364,7 @@ func TestSetLiquidityTier_New_Failure(t *testing.T) {
 				tc.maintenanceFractionPpm,
 				tc.basePositionNotional,
 				tc.impactNotional,
+				0,
 			)
 
 			require.Error(t, err)
@@ -3010,6 +3384,7 @@ func TestModifyLiquidityTier_Success(t *testing.T) {
 			lt.MaintenanceFractionPpm,
 			lt.BasePositionNotional,
 			lt.ImpactNotional,
+			lt.OpenInterestCapNotional,
 		)
 		require.NoError(t, err)
 	}
@@ -3030,6 +3405,7 @@ func TestModifyLiquidityTier_Success(t *testing.T) {
 			maintenanceFractionPpm,
 			basePositionNotional,
 			impactNotional,
+			0,
 		)
 		require.NoError(t, err)
 		obtainedLt, err := pc.PerpetualsKeeper.GetLiquidityTier(pc.Ctx, lt.Id)
@@ -3132,6 +3508,7 @@ func TestSetLiquidityTier_Existing_Failure(t *testing.T) {
 				tc.maintenanceFractionPpm,
 				tc.basePositionNotional,
 				tc.impactNotional,
+				0,
 			)
 
 			require.Error(t, err)
@@ -3263,3 +3640,110 @@ func TestIsPositionUpdatable(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOpenInterestCapNotional_IsOpenInterestWithinCap(t *testing.T) {
+	testCases := map[string]struct {
+		perpCap           uint64
+		liquidityTierCap  uint64
+		queryPerpId       uint32
+		openInterest      *big.Int
+		expectedCap       uint64
+		expectedWithinCap bool
+		expectedErr       string
+	}{
+		"No cap": {
+			queryPerpId:       1,
+			openInterest:      big.NewInt(100_000_000_000),
+			expectedCap:       0,
+			expectedWithinCap: true,
+		},
+		"Perpetual cap only, within cap": {
+			perpCap:           50_000_000,
+			queryPerpId:       1,
+			openInterest:      big.NewInt(100_000_000), // $50 notional
+			expectedCap:       50_000_000,
+			expectedWithinCap: true,
+		},
+		"Perpetual cap only, above cap": {
+			perpCap:           50_000_000,
+			queryPerpId:       1,
+			openInterest:      big.NewInt(102_000_000), // $51 notional
+			expectedCap:       50_000_000,
+			expectedWithinCap: false,
+		},
+		"Liquidity tier cap only, above cap": {
+			liquidityTierCap:  40_000_000,
+			queryPerpId:       1,
+			openInterest:      big.NewInt(100_000_000), // $50 notional
+			expectedCap:       40_000_000,
+			expectedWithinCap: false,
+		},
+		"Lower of perpetual and liquidity tier caps is used": {
+			perpCap:           60_000_000,
+			liquidityTierCap:  50_000_000,
+			queryPerpId:       1,
+			openInterest:      big.NewInt(120_000_000), // $60 notional
+			expectedCap:       50_000_000,
+			expectedWithinCap: false,
+		},
+		"Error: Perp Id not found": {
+			perpCap:      50_000_000,
+			queryPerpId:  100, // doesn't exist
+			openInterest: big.NewInt(0),
+			expectedErr:  "Perpetual does not exist",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pc := keepertest.PerpetualsKeepers(t)
+			keepertest.CreateTestPricesAndPerpetualMarkets(
+				t,
+				pc.Ctx,
+				pc.PerpetualsKeeper,
+				pc.PricesKeeper,
+				[]types.Perpetual{
+					*perptest.GeneratePerpetual(
+						perptest.WithId(1),
+						perptest.WithMarketId(1),
+						perptest.WithOpenInterestCapNotional(tc.perpCap),
+					),
+				},
+				[]pricestypes.MarketParamPrice{
+					*pricestest.GenerateMarketParamPrice(
+						pricestest.WithId(1),
+						pricestest.WithPriceValue(5_000_000_000), // $50
+					),
+				},
+			)
+			lt := constants.LiquidityTiers[0]
+			_, err := pc.PerpetualsKeeper.SetLiquidityTier(
+				pc.Ctx,
+				lt.Id,
+				lt.Name,
+				lt.InitialMarginPpm,
+				lt.MaintenanceFractionPpm,
+				lt.BasePositionNotional,
+				lt.ImpactNotional,
+				tc.liquidityTierCap,
+			)
+			require.NoError(t, err)
+
+			capNotional, err := pc.PerpetualsKeeper.GetOpenInterestCapNotional(pc.Ctx, tc.queryPerpId)
+			withinCap, withinCapErr := pc.PerpetualsKeeper.IsOpenInterestWithinCap(
+				pc.Ctx,
+				tc.queryPerpId,
+				tc.openInterest,
+			)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.NoError(t, withinCapErr)
+				require.Equal(t, tc.expectedCap, capNotional)
+				require.Equal(t, tc.expectedWithinCap, withinCap)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.ErrorContains(t, withinCapErr, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -120,7 +120,8 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	require.Equal(
 		t,
 		`{"perpetuals":[],"liquidity_tiers":[],"params":{"funding_rate_clamp_factor_ppm":6000000,`+
-			`"premium_vote_clamp_factor_ppm":60000000,"min_num_votes_per_sample":15}}`,
+			`"premium_vote_clamp_factor_ppm":60000000,"min_num_votes_per_sample":15,`+
+			`"removed_tail_sample_ratio_ppm":0}}`,
 		string(json),
 	)
 }
@@ -223,6 +224,13 @@ func TestAppModuleBasic_RegisterGRPCGatewayRoutes(t *testing.T) {
 	router.ServeHTTP(recorder, req)
 	require.Contains(t, recorder.Body.String(), "no RPC client is defined in offline mode")
 
+	// Expect FundingHistory route registered
+	recorder = httptest.NewRecorder()
+	req, err = http.NewRequest("GET", "/dydxprotocol/perpetuals/funding_history/0?from_height=10", nil)
+	require.NoError(t, err)
+	router.ServeHTTP(recorder, req)
+	require.Contains(t, recorder.Body.String(), "no RPC client is defined in offline mode")
+
 	// Expect unexpected route not registered
 	recorder = httptest.NewRecorder()
 	req, err = http.NewRequest("GET", "/dydxprotocol/perpetuals/foo/bar/baz", nil)
@@ -244,12 +252,13 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "perpetuals", cmd.Use)
-	require.Equal(t, 5, len(cmd.Commands()))
-	require.Equal(t, "get-params", cmd.Commands()[0].Name())
-	require.Equal(t, "get-premium-samples", cmd.Commands()[1].Name())
-	require.Equal(t, "get-premium-votes", cmd.Commands()[2].Name())
-	require.Equal(t, "list-perpetual", cmd.Commands()[3].Name())
-	require.Equal(t, "show-perpetual", cmd.Commands()[4].Name())
+	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, "get-funding-history", cmd.Commands()[0].Name())
+	require.Equal(t, "get-params", cmd.Commands()[1].Name())
+	require.Equal(t, "get-premium-samples", cmd.Commands()[2].Name())
+	require.Equal(t, "get-premium-votes", cmd.Commands()[3].Name())
+	require.Equal(t, "list-perpetual", cmd.Commands()[4].Name())
+	require.Equal(t, "show-perpetual", cmd.Commands()[5].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -351,9 +360,16 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 				 "market_id":0,
 				 "atomic_resolution":0,
 				 "default_funding_ppm":0,
-				 "liquidity_tier":0
+				 "liquidity_tier":0,
+				 "open_interest_cap_notional":"0",
+				 "funding_rate_bounds":null,
+				 "interest_rate_ppm":0,
+				 "funding_tick_epoch":"",
+				 "funding_sample_epoch":""
 			  },
-			  "funding_index":"0"
+			  "funding_index":"0",
+			  "settled":false,
+			  "settlement_price":"0"
 		   }
 		],
 		"liquidity_tiers":[
@@ -363,13 +379,15 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 			  "initial_margin_ppm":50000,
 			  "maintenance_fraction_ppm":500000,
 			  "base_position_notional":"1000000000",
-			  "impact_notional":"10000000000"
+			  "impact_notional":"10000000000",
+			  "open_interest_cap_notional":"0"
 		   }
 		],
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "removed_tail_sample_ratio_ppm":0
 		}
 	 }`
 	require.Equal(t,
@@ -1,11 +1,12 @@
 package types
 
 const (
-	// RemovedTailSampleRatioPpm is the percentage (in ppm) of funding samples to be removed on each
-	// end of the sorted funding samples collected during a funding-tick epoch.
-	// For example, if 60 funding sample entries were collected during an epoch,
-	// `RemovedTailSamplePctPpm` of the top and bottom funding sample values are removed before
-	// taking the average.
-	// TODO(DEC-1105): Move this constant to state so that it can be changed via governance.
-	RemovedTailSampleRatioPpm uint32 = 0
+	// HoursPerYear is the number of hours in a year, used to convert annualized interest rates
+	// to 8-hour funding rates.
+	HoursPerYear = 365 * 24
+	// FundingRateHours is the number of hours a funding rate is denominated in.
+	FundingRateHours = 8
+	// MaxFundingHistoryEntries is the maximum number of funding history entries kept per
+	// perpetual, i.e. 90 days of hourly funding ticks. Older entries are pruned.
+	MaxFundingHistoryEntries = 90 * 24
 )
@@ -112,6 +112,31 @@ var (
 		22,
 		"MinNumVotesPerSample is zero",
 	)
+	ErrRemovedTailSampleRatioPpmTooLarge = errorsmod.Register(
+		ModuleName,
+		23,
+		"RemovedTailSampleRatioPpm must be less than 500,000",
+	)
+	ErrInvalidFundingRateBounds = errorsmod.Register(
+		ModuleName,
+		24,
+		"Funding rate bounds must not exclude a funding rate of zero",
+	)
+	ErrInterestRatePpmMagnitudeExceedsMax = errorsmod.Register(
+		ModuleName,
+		25,
+		"Interest rate ppm magnitude exceeds maximum value",
+	)
+	ErrFundingEpochDoesNotExist = errorsmod.Register(
+		ModuleName,
+		26,
+		"Funding epoch does not exist",
+	)
+	ErrPerpetualSettled = errorsmod.Register(
+		ModuleName,
+		27,
+		"Perpetual is settled",
+	)
 
 	// Errors for Not Implemented
 	ErrNotImplementedFunding = errorsmod.Register(ModuleName, 1001, "Not Implemented: Perpetuals Funding")
@@ -52,10 +52,8 @@ type EpochsKeeper interface {
 		ctx sdk.Context,
 		id epochstypes.EpochInfoName,
 	) (uint32, error)
-	MustGetFundingTickEpochInfo(
+	GetEpochInfo(
 		ctx sdk.Context,
-	) epochstypes.EpochInfo
-	MustGetFundingSampleEpochInfo(
-		ctx sdk.Context,
-	) epochstypes.EpochInfo
+		id epochstypes.EpochInfoName,
+	) (val epochstypes.EpochInfo, found bool)
 }
@@ -13,9 +13,17 @@ const (
 	DefaultPremiumVoteClampFactorPpm = 60 * lib.OneMillion
 	// Minimum number of votes per sample is by default 15.
 	DefaultMinNumVotesPerSample = 15
+	// No premium samples are removed by default, since samples are already computed as the median
+	// of premium votes.
+	DefaultRemovedTailSampleRatioPpm = 0
+
+	// Samples are removed from both ends, so less than 50% can be removed from each end.
+	MaxRemovedTailSampleRatioPpm = lib.OneMillion / 2
 
 	// Maximum default funding rate magnitude is 100%.
 	MaxDefaultFundingPpmAbs = lib.OneMillion
+	// Maximum annualized interest rate magnitude is 100%.
+	MaxInterestRatePpmAbs = lib.OneMillion
 
 	// Liquidity-tier related constants
 	MaxInitialMarginPpm       = lib.OneMillion
@@ -31,6 +39,7 @@ func DefaultGenesis() *GenesisState {
 			FundingRateClampFactorPpm: DefaultFundingRateClampFactorPpm,
 			PremiumVoteClampFactorPpm: DefaultPremiumVoteClampFactorPpm,
 			MinNumVotesPerSample:      DefaultMinNumVotesPerSample,
+			RemovedTailSampleRatioPpm: DefaultRemovedTailSampleRatioPpm,
 		},
 	}
 }
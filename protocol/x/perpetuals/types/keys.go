@@ -1,5 +1,9 @@
 package types
 
+import (
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+)
+
 // Module name and store keys
 const (
 	// ModuleName defines the module name
@@ -16,17 +20,50 @@ const (
 
 	// PremiumVotesKey is the key to retrieve `PremiumStore` object
 	// that represents existing premium sample votes during the current
-	// `funding-sample` epoch.
+	// `funding-sample` epoch. Perpetuals sampled on a different epoch
+	// store their votes under `GetPremiumVotesKey`.
 	PremiumVotesKey = "PremVotes"
 
 	// PremiumSamplesKey is the key to retrieve `PremiumStore` object
 	// that represents existing premium samples during the current
-	// `funding-tick` epoch.
+	// `funding-tick` epoch. Perpetuals on a different funding schedule
+	// store their samples under `GetPremiumSamplesKey`.
 	PremiumSamplesKey = "PremSamples"
 
+	// FundingHistoryKeyPrefix is the prefix to retrieve the `FundingHistoryEntry`s
+	// of all perpetuals.
+	FundingHistoryKeyPrefix = "FundHist:"
+
+	// FundingHistoryCountKeyPrefix is the prefix to retrieve the number of
+	// `FundingHistoryEntry`s of each perpetual.
+	FundingHistoryCountKeyPrefix = "FundHistCount:"
+
 	// LiquidityTierKeyPrefix is the prefix to retrieve all `LiquidityTier`s.
 	LiquidityTierKeyPrefix = "LiqTier:"
 
 	// ParamsKey is the key to retrieve all params for the module.
 	ParamsKey = "Params"
 )
+
+// GetPremiumVotesKey returns the key of the `PremiumStore` object that holds
+// premium votes of perpetuals sampled on the given `funding-sample` epoch.
+func GetPremiumVotesKey(fundingSampleEpoch epochstypes.EpochInfoName) string {
+	if fundingSampleEpoch == epochstypes.FundingSampleEpochInfoName {
+		return PremiumVotesKey
+	}
+	return PremiumVotesKey + ":" + string(fundingSampleEpoch)
+}
+
+// GetPremiumSamplesKey returns the key of the `PremiumStore` object that holds
+// premium samples of perpetuals on the given `funding-tick` and `funding-sample`
+// epochs.
+func GetPremiumSamplesKey(
+	fundingTickEpoch epochstypes.EpochInfoName,
+	fundingSampleEpoch epochstypes.EpochInfoName,
+) string {
+	if fundingTickEpoch == epochstypes.FundingTickEpochInfoName &&
+		fundingSampleEpoch == epochstypes.FundingSampleEpochInfoName {
+		return PremiumSamplesKey
+	}
+	return PremiumSamplesKey + ":" + string(fundingTickEpoch) + ":" + string(fundingSampleEpoch)
+}
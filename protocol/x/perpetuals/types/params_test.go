@@ -13,6 +13,7 @@ func TestParamsValidate(t *testing.T) {
 		fundingRateClampFactorPpm uint32
 		premiumVoteClampFactorPpm uint32
 		minNumVotesPerSample      uint32
+		removedTailSampleRatioPpm uint32
 		expectedError             error
 	}{
 		"Validates successfully": {
@@ -27,6 +28,13 @@ func TestParamsValidate(t *testing.T) {
 			minNumVotesPerSample:      math.MaxUint32,
 			expectedError:             nil,
 		},
+		"Validates successfully: max removed tail sample ratio ppm": {
+			fundingRateClampFactorPpm: 6_000_000,
+			premiumVoteClampFactorPpm: 60_000_000,
+			minNumVotesPerSample:      15,
+			removedTailSampleRatioPpm: 499_999,
+			expectedError:             nil,
+		},
 		"Failure: funding rate clamp factor ppm is zero": {
 			fundingRateClampFactorPpm: 0,
 			premiumVoteClampFactorPpm: 60_000_000,
@@ -45,6 +53,13 @@ func TestParamsValidate(t *testing.T) {
 			minNumVotesPerSample:      0,
 			expectedError:             types.ErrMinNumVotesPerSampleIsZero,
 		},
+		"Failure: removed tail sample ratio ppm removes all samples": {
+			fundingRateClampFactorPpm: 6_000_000,
+			premiumVoteClampFactorPpm: 60_000_000,
+			minNumVotesPerSample:      15,
+			removedTailSampleRatioPpm: 500_000,
+			expectedError:             types.ErrRemovedTailSampleRatioPpmTooLarge,
+		},
 	}
 
 	// Run tests.
@@ -54,6 +69,7 @@ func TestParamsValidate(t *testing.T) {
 				FundingRateClampFactorPpm: tc.fundingRateClampFactorPpm,
 				PremiumVoteClampFactorPpm: tc.premiumVoteClampFactorPpm,
 				MinNumVotesPerSample:      tc.minNumVotesPerSample,
+				RemovedTailSampleRatioPpm: tc.removedTailSampleRatioPpm,
 			}
 
 			err := params.Validate()
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	"github.com/pkg/errors"
 )
 
@@ -25,5 +28,66 @@ func (p *PerpetualParams) Validate() error {
 			lib.IntToString(p.DefaultFundingPpm))
 	}
 
+	// Validate `fundingRateBounds`.
+	if bounds := p.FundingRateBounds; bounds != nil && (bounds.MinPpm > 0 || bounds.MaxPpm < 0) {
+		return errorsmod.Wrapf(
+			ErrInvalidFundingRateBounds,
+			"min funding rate ppm = %d, max funding rate ppm = %d",
+			bounds.MinPpm,
+			bounds.MaxPpm,
+		)
+	}
+
+	// Validate `interestRatePpm`.
+	if lib.AbsInt32(p.InterestRatePpm) > MaxInterestRatePpmAbs {
+		return errorsmod.Wrap(
+			ErrInterestRatePpmMagnitudeExceedsMax,
+			lib.IntToString(p.InterestRatePpm))
+	}
+
 	return nil
 }
+
+// ClampFundingRatePpm clamps a funding rate in parts-per-million to the intersection of
+// `[-bigMaxAbsFundingRatePpm, bigMaxAbsFundingRatePpm]` and the funding rate bounds of the
+// perpetual, if any. `bigMaxAbsFundingRatePpm` must not be negative. Since valid funding rate
+// bounds contain zero, the intersection is never empty and the clamped funding rate never
+// exceeds `bigMaxAbsFundingRatePpm` in magnitude.
+func (p *PerpetualParams) ClampFundingRatePpm(
+	bigFundingRatePpm *big.Int,
+	bigMaxAbsFundingRatePpm *big.Int,
+) *big.Int {
+	lowerBound := new(big.Int).Neg(bigMaxAbsFundingRatePpm)
+	upperBound := new(big.Int).Set(bigMaxAbsFundingRatePpm)
+	if bounds := p.FundingRateBounds; bounds != nil {
+		lowerBound = lib.BigMax(lowerBound, big.NewInt(int64(bounds.MinPpm)))
+		upperBound = lib.BigMin(upperBound, big.NewInt(int64(bounds.MaxPpm)))
+	}
+	return lib.BigIntClamp(bigFundingRatePpm, lowerBound, upperBound)
+}
+
+// GetInterestFundingRatePpm returns the interest rate component of the 8-hour funding rate of the
+// perpetual in parts-per-million, converted from the annualized `InterestRatePpm` and rounded
+// towards zero.
+func (p *PerpetualParams) GetInterestFundingRatePpm() *big.Int {
+	bigInterestRatePpm := new(big.Int).SetInt64(int64(p.InterestRatePpm) * FundingRateHours)
+	return bigInterestRatePpm.Quo(bigInterestRatePpm, big.NewInt(HoursPerYear))
+}
+
+// GetFundingTickEpochInfoName returns the name of the epoch on which the perpetual's funding
+// rate is settled, which defaults to the `funding-tick` epoch.
+func (p *PerpetualParams) GetFundingTickEpochInfoName() epochstypes.EpochInfoName {
+	if p.FundingTickEpoch == "" {
+		return epochstypes.FundingTickEpochInfoName
+	}
+	return epochstypes.EpochInfoName(p.FundingTickEpoch)
+}
+
+// GetFundingSampleEpochInfoName returns the name of the epoch on which the perpetual's premium
+// votes are summarized into premium samples, which defaults to the `funding-sample` epoch.
+func (p *PerpetualParams) GetFundingSampleEpochInfoName() epochstypes.EpochInfoName {
+	if p.FundingSampleEpoch == "" {
+		return epochstypes.FundingSampleEpochInfoName
+	}
+	return epochstypes.EpochInfoName(p.FundingSampleEpoch)
+}
@@ -31,6 +31,13 @@ type Perpetual struct {
 	// The current index determined by the cumulative all-time
 	// history of the funding mechanism. Starts at zero.
 	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
+	// Whether the perpetual was delisted and all of its open positions were
+	// closed. Settled perpetuals don't accrue funding, and positions in them
+	// can't be updated.
+	Settled bool `protobuf:"varint,3,opt,name=settled,proto3" json:"settled,omitempty"`
+	// The price at which all open positions were closed when the perpetual was
+	// settled, denominated in the exponent of its market.
+	SettlementPrice uint64 `protobuf:"varint,4,opt,name=settlement_price,json=settlementPrice,proto3" json:"settlement_price,omitempty"`
 }
 
 func (m *Perpetual) Reset()         { *m = Perpetual{} }
@@ -73,6 +80,20 @@ func (m *Perpetual) GetParams() PerpetualParams {
 	return PerpetualParams{}
 }
 
+func (m *Perpetual) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+func (m *Perpetual) GetSettlementPrice() uint64 {
+	if m != nil {
+		return m.SettlementPrice
+	}
+	return 0
+}
+
 // PerpetualParams represents the parameters of a perpetual on the dYdX
 // exchange.
 type PerpetualParams struct {
@@ -94,6 +115,28 @@ type PerpetualParams struct {
 	DefaultFundingPpm int32 `protobuf:"zigzag32,5,opt,name=default_funding_ppm,json=defaultFundingPpm,proto3" json:"default_funding_ppm,omitempty"`
 	// The liquidity_tier that this perpetual is associated with.
 	LiquidityTier uint32 `protobuf:"varint,6,opt,name=liquidity_tier,json=liquidityTier,proto3" json:"liquidity_tier,omitempty"`
+	// The maximum open interest of this perpetual, in quote quantums and
+	// measured at the oracle price. Orders that would increase the open
+	// interest above this cap are rejected. Zero means the perpetual has no cap
+	// other than the cap of its liquidity tier.
+	OpenInterestCapNotional uint64 `protobuf:"varint,7,opt,name=open_interest_cap_notional,json=openInterestCapNotional,proto3" json:"open_interest_cap_notional,omitempty"`
+	// The funding rate bounds of this perpetual. Funding rates are clamped to
+	// the intersection of these bounds and the funding rate clamp of the
+	// liquidity tier. Unset means that funding rates are only bounded by that
+	// clamp.
+	FundingRateBounds *FundingRateBounds `protobuf:"bytes,8,opt,name=funding_rate_bounds,json=fundingRateBounds,proto3" json:"funding_rate_bounds,omitempty"`
+	// The annualized interest rate component of the funding rate, in
+	// parts-per-million. It is converted to an 8-hour rate and added to the
+	// premium and default funding of each funding tick. Zero means the funding
+	// rate has no interest rate component.
+	InterestRatePpm int32 `protobuf:"zigzag32,9,opt,name=interest_rate_ppm,json=interestRatePpm,proto3" json:"interest_rate_ppm,omitempty"`
+	// Name of the `x/epochs` epoch on which the perpetual's funding rate is
+	// settled. Empty means the default `funding-tick` epoch.
+	FundingTickEpoch string `protobuf:"bytes,10,opt,name=funding_tick_epoch,json=fundingTickEpoch,proto3" json:"funding_tick_epoch,omitempty"`
+	// Name of the `x/epochs` epoch on which the perpetual's premium votes are
+	// summarized into premium samples. Empty means the default `funding-sample`
+	// epoch.
+	FundingSampleEpoch string `protobuf:"bytes,11,opt,name=funding_sample_epoch,json=fundingSampleEpoch,proto3" json:"funding_sample_epoch,omitempty"`
 }
 
 func (m *PerpetualParams) Reset()         { *m = PerpetualParams{} }
@@ -171,6 +214,165 @@ func (m *PerpetualParams) GetLiquidityTier() uint32 {
 	return 0
 }
 
+func (m *PerpetualParams) GetOpenInterestCapNotional() uint64 {
+	if m != nil {
+		return m.OpenInterestCapNotional
+	}
+	return 0
+}
+
+func (m *PerpetualParams) GetFundingRateBounds() *FundingRateBounds {
+	if m != nil {
+		return m.FundingRateBounds
+	}
+	return nil
+}
+
+func (m *PerpetualParams) GetInterestRatePpm() int32 {
+	if m != nil {
+		return m.InterestRatePpm
+	}
+	return 0
+}
+
+func (m *PerpetualParams) GetFundingTickEpoch() string {
+	if m != nil {
+		return m.FundingTickEpoch
+	}
+	return ""
+}
+
+func (m *PerpetualParams) GetFundingSampleEpoch() string {
+	if m != nil {
+		return m.FundingSampleEpoch
+	}
+	return ""
+}
+
+// FundingRateBounds represents the minimum and maximum 8-hour funding rates of
+// a perpetual.
+type FundingRateBounds struct {
+	// The minimum funding rate in parts-per-million. Must not be positive.
+	MinPpm int32 `protobuf:"zigzag32,1,opt,name=min_ppm,json=minPpm,proto3" json:"min_ppm,omitempty"`
+	// The maximum funding rate in parts-per-million. Must not be negative.
+	MaxPpm int32 `protobuf:"zigzag32,2,opt,name=max_ppm,json=maxPpm,proto3" json:"max_ppm,omitempty"`
+}
+
+func (m *FundingRateBounds) Reset()         { *m = FundingRateBounds{} }
+func (m *FundingRateBounds) String() string { return proto.CompactTextString(m) }
+func (*FundingRateBounds) ProtoMessage()    {}
+func (*FundingRateBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{2}
+}
+func (m *FundingRateBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingRateBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingRateBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingRateBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingRateBounds.Merge(m, src)
+}
+func (m *FundingRateBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingRateBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingRateBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingRateBounds proto.InternalMessageInfo
+
+func (m *FundingRateBounds) GetMinPpm() int32 {
+	if m != nil {
+		return m.MinPpm
+	}
+	return 0
+}
+
+func (m *FundingRateBounds) GetMaxPpm() int32 {
+	if m != nil {
+		return m.MaxPpm
+	}
+	return 0
+}
+
+// FundingHistoryEntry records the funding rate and funding index of a
+// perpetual at the end of a `funding-tick` epoch.
+type FundingHistoryEntry struct {
+	// The block height at which the funding rate was settled.
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The 8-hour funding rate that was settled, in parts-per-million.
+	FundingRatePpm int32 `protobuf:"zigzag32,2,opt,name=funding_rate_ppm,json=fundingRatePpm,proto3" json:"funding_rate_ppm,omitempty"`
+	// The funding index of the perpetual after settling the funding rate.
+	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
+	// The duration in seconds of the `funding-tick` epoch the funding rate was
+	// settled over.
+	IntervalSeconds uint32 `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+}
+
+func (m *FundingHistoryEntry) Reset()         { *m = FundingHistoryEntry{} }
+func (m *FundingHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FundingHistoryEntry) ProtoMessage()    {}
+func (*FundingHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{3}
+}
+func (m *FundingHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingHistoryEntry.Merge(m, src)
+}
+func (m *FundingHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingHistoryEntry proto.InternalMessageInfo
+
+func (m *FundingHistoryEntry) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FundingHistoryEntry) GetFundingRatePpm() int32 {
+	if m != nil {
+		return m.FundingRatePpm
+	}
+	return 0
+}
+
+func (m *FundingHistoryEntry) GetIntervalSeconds() uint32 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
 // MarketPremiums stores a list of premiums for a single perpetual market.
 type MarketPremiums struct {
 	// perpetual_id is the Id of the perpetual market.
@@ -185,7 +387,7 @@ func (m *MarketPremiums) Reset()         { *m = MarketPremiums{} }
 func (m *MarketPremiums) String() string { return proto.CompactTextString(m) }
 func (*MarketPremiums) ProtoMessage()    {}
 func (*MarketPremiums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{2}
+	return fileDescriptor_ce7204eee10038be, []int{4}
 }
 func (m *MarketPremiums) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +453,7 @@ func (m *PremiumStore) Reset()         { *m = PremiumStore{} }
 func (m *PremiumStore) String() string { return proto.CompactTextString(m) }
 func (*PremiumStore) ProtoMessage()    {}
 func (*PremiumStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{3}
+	return fileDescriptor_ce7204eee10038be, []int{5}
 }
 func (m *PremiumStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,6 +496,72 @@ func (m *PremiumStore) GetNumPremiums() uint32 {
 	return 0
 }
 
+// FundingSchedulePremiumStore is the `PremiumStore` of the perpetuals that
+// process their funding on a funding schedule.
+type FundingSchedulePremiumStore struct {
+	// Name of the `funding-tick` epoch of the funding schedule. Empty for
+	// premium votes, which are only keyed by their `funding-sample` epoch.
+	FundingTickEpoch string `protobuf:"bytes,1,opt,name=funding_tick_epoch,json=fundingTickEpoch,proto3" json:"funding_tick_epoch,omitempty"`
+	// Name of the `funding-sample` epoch of the funding schedule.
+	FundingSampleEpoch string `protobuf:"bytes,2,opt,name=funding_sample_epoch,json=fundingSampleEpoch,proto3" json:"funding_sample_epoch,omitempty"`
+	// The premiums of the perpetuals on the funding schedule.
+	PremiumStore PremiumStore `protobuf:"bytes,3,opt,name=premium_store,json=premiumStore,proto3" json:"premium_store"`
+}
+
+func (m *FundingSchedulePremiumStore) Reset()         { *m = FundingSchedulePremiumStore{} }
+func (m *FundingSchedulePremiumStore) String() string { return proto.CompactTextString(m) }
+func (*FundingSchedulePremiumStore) ProtoMessage()    {}
+func (*FundingSchedulePremiumStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{6}
+}
+func (m *FundingSchedulePremiumStore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingSchedulePremiumStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingSchedulePremiumStore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingSchedulePremiumStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingSchedulePremiumStore.Merge(m, src)
+}
+func (m *FundingSchedulePremiumStore) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingSchedulePremiumStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingSchedulePremiumStore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingSchedulePremiumStore proto.InternalMessageInfo
+
+func (m *FundingSchedulePremiumStore) GetFundingTickEpoch() string {
+	if m != nil {
+		return m.FundingTickEpoch
+	}
+	return ""
+}
+
+func (m *FundingSchedulePremiumStore) GetFundingSampleEpoch() string {
+	if m != nil {
+		return m.FundingSampleEpoch
+	}
+	return ""
+}
+
+func (m *FundingSchedulePremiumStore) GetPremiumStore() PremiumStore {
+	if m != nil {
+		return m.PremiumStore
+	}
+	return PremiumStore{}
+}
+
 // LiquidityTier stores margin information.
 type LiquidityTier struct {
 	// Unique id.
@@ -318,13 +586,17 @@ type LiquidityTier struct {
 	// - Impact ask price = average execution price for a market buy of the
 	// impact notional value.
 	ImpactNotional uint64 `protobuf:"varint,6,opt,name=impact_notional,json=impactNotional,proto3" json:"impact_notional,omitempty"`
+	// The maximum open interest of each perpetual in this tier, in quote quantums
+	// and measured at the oracle price. Orders that would increase the open
+	// interest of a perpetual above this cap are rejected. Zero means no cap.
+	OpenInterestCapNotional uint64 `protobuf:"varint,7,opt,name=open_interest_cap_notional,json=openInterestCapNotional,proto3" json:"open_interest_cap_notional,omitempty"`
 }
 
 func (m *LiquidityTier) Reset()         { *m = LiquidityTier{} }
 func (m *LiquidityTier) String() string { return proto.CompactTextString(m) }
 func (*LiquidityTier) ProtoMessage()    {}
 func (*LiquidityTier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{4}
+	return fileDescriptor_ce7204eee10038be, []int{7}
 }
 func (m *LiquidityTier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,11 +667,21 @@ func (m *LiquidityTier) GetImpactNotional() uint64 {
 	return 0
 }
 
+func (m *LiquidityTier) GetOpenInterestCapNotional() uint64 {
+	if m != nil {
+		return m.OpenInterestCapNotional
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Perpetual)(nil), "dydxprotocol.perpetuals.Perpetual")
 	proto.RegisterType((*PerpetualParams)(nil), "dydxprotocol.perpetuals.PerpetualParams")
+	proto.RegisterType((*FundingRateBounds)(nil), "dydxprotocol.perpetuals.FundingRateBounds")
+	proto.RegisterType((*FundingHistoryEntry)(nil), "dydxprotocol.perpetuals.FundingHistoryEntry")
 	proto.RegisterType((*MarketPremiums)(nil), "dydxprotocol.perpetuals.MarketPremiums")
 	proto.RegisterType((*PremiumStore)(nil), "dydxprotocol.perpetuals.PremiumStore")
+	proto.RegisterType((*FundingSchedulePremiumStore)(nil), "dydxprotocol.perpetuals.FundingSchedulePremiumStore")
 	proto.RegisterType((*LiquidityTier)(nil), "dydxprotocol.perpetuals.LiquidityTier")
 }
 
@@ -408,44 +690,64 @@ func init() {
 }
 
 var fileDescriptor_ce7204eee10038be = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4b, 0x1b, 0x41,
-	0x14, 0xc7, 0xb3, 0x31, 0x0d, 0x3a, 0x26, 0xd1, 0x8c, 0x62, 0x17, 0x0b, 0x31, 0x0d, 0x14, 0x03,
-	0x6d, 0x37, 0x60, 0x3d, 0xf4, 0xd0, 0x43, 0xf1, 0x20, 0x0d, 0xd4, 0x36, 0xac, 0xa5, 0x87, 0x42,
-	0x59, 0x26, 0x3b, 0x63, 0x7c, 0x38, 0x3f, 0xb6, 0xb3, 0xb3, 0x45, 0xfb, 0x57, 0xf8, 0x37, 0xf5,
-	0xe4, 0xd1, 0x63, 0xf1, 0x20, 0x45, 0xff, 0x8b, 0x9e, 0xca, 0xce, 0x4e, 0x36, 0xb1, 0x22, 0xf4,
-	0x94, 0x99, 0xf7, 0xfd, 0xbc, 0x97, 0xef, 0x9b, 0x37, 0xb3, 0x68, 0x9b, 0x9e, 0xd1, 0xd3, 0x44,
-	0x2b, 0xa3, 0x62, 0xc5, 0x07, 0x09, 0xd3, 0x09, 0x33, 0x19, 0xe1, 0xe9, 0x6c, 0x19, 0x58, 0x15,
-	0x3f, 0x9e, 0x07, 0x83, 0x19, 0xb8, 0xb9, 0x3e, 0x51, 0x13, 0x65, 0x85, 0x41, 0xbe, 0x2a, 0xf0,
-	0xde, 0x4f, 0x0f, 0x2d, 0x8d, 0xa6, 0x10, 0xde, 0x47, 0xf5, 0x84, 0x68, 0x22, 0x52, 0xdf, 0xeb,
-	0x7a, 0xfd, 0xe5, 0x9d, 0x7e, 0xf0, 0x40, 0xb5, 0xa0, 0xcc, 0x19, 0x59, 0x7e, 0xaf, 0x76, 0x71,
-	0xbd, 0x55, 0x09, 0x5d, 0x36, 0x16, 0xa8, 0x79, 0x94, 0x49, 0x0a, 0x72, 0x12, 0x81, 0xa4, 0xec,
-	0xd4, 0xaf, 0x76, 0xbd, 0x7e, 0x63, 0xef, 0x5d, 0x0e, 0x5d, 0x5d, 0x6f, 0xbd, 0x9d, 0x80, 0x39,
-	0xce, 0xc6, 0x41, 0xac, 0xc4, 0xe0, 0x4e, 0x5f, 0xdf, 0x77, 0x5f, 0xc6, 0xc7, 0x04, 0xe4, 0xa0,
-	0x8c, 0x50, 0x73, 0x96, 0xb0, 0x34, 0x38, 0x64, 0x1a, 0x08, 0x87, 0x1f, 0x64, 0xcc, 0xd9, 0x50,
-	0x9a, 0xb0, 0xe1, 0xca, 0x0f, 0xf3, 0xea, 0xbd, 0x2b, 0x0f, 0xad, 0xfc, 0x63, 0x08, 0xb7, 0x50,
-	0x15, 0xa8, 0x6d, 0xa3, 0x19, 0x56, 0x81, 0xe2, 0x0d, 0x54, 0x37, 0x10, 0x9f, 0x30, 0x6d, 0xbd,
-	0x2c, 0x85, 0x6e, 0x87, 0x9f, 0xa0, 0x25, 0x41, 0xf4, 0x09, 0x33, 0x11, 0x50, 0x7f, 0xc1, 0xe2,
-	0x8b, 0x45, 0x60, 0x48, 0xf1, 0x73, 0xd4, 0x26, 0x46, 0x09, 0x88, 0x23, 0xcd, 0x52, 0xc5, 0x33,
-	0x03, 0x4a, 0xfa, 0xb5, 0xae, 0xd7, 0x6f, 0x87, 0xab, 0x85, 0x10, 0x96, 0x71, 0x1c, 0xa0, 0x35,
-	0xca, 0x8e, 0x48, 0xc6, 0x4d, 0x34, 0x6d, 0x3e, 0x49, 0x84, 0xff, 0xc8, 0xe2, 0x6d, 0x27, 0xed,
-	0x17, 0xca, 0x28, 0x11, 0xf8, 0x19, 0x6a, 0x71, 0xf8, 0x96, 0x01, 0x05, 0x73, 0x16, 0x19, 0x60,
-	0xda, 0xaf, 0xdb, 0xbf, 0x6f, 0x96, 0xd1, 0x4f, 0xc0, 0x74, 0xef, 0x23, 0x6a, 0x1d, 0x58, 0x3f,
-	0x23, 0xcd, 0x04, 0x64, 0x22, 0xc5, 0x4f, 0x51, 0xa3, 0x9c, 0x44, 0x54, 0x36, 0xb9, 0x5c, 0xc6,
-	0x86, 0x14, 0x6f, 0xa2, 0xc5, 0xc4, 0xe1, 0x7e, 0xb5, 0xbb, 0xd0, 0x6f, 0x87, 0xe5, 0xbe, 0x77,
-	0xee, 0xa1, 0x86, 0xab, 0x75, 0x68, 0x94, 0x66, 0xf8, 0x2b, 0x5a, 0x23, 0x9c, 0x47, 0xee, 0x18,
-	0xca, 0x3c, 0xaf, 0xbb, 0xd0, 0x5f, 0xde, 0xd9, 0x7e, 0xf0, 0x0a, 0xdc, 0x75, 0xe5, 0x6e, 0x40,
-	0x9b, 0x70, 0x7e, 0xdf, 0xae, 0xcc, 0x44, 0x34, 0xe7, 0xc7, 0xda, 0x95, 0x99, 0x98, 0x22, 0xbd,
-	0x3f, 0x1e, 0x6a, 0xbe, 0x9f, 0xef, 0xfa, 0xde, 0xf8, 0x30, 0xaa, 0x49, 0x22, 0x98, 0x1b, 0x9e,
-	0x5d, 0xe3, 0x17, 0x08, 0x83, 0x04, 0x03, 0xc4, 0x7a, 0x9f, 0x80, 0xb4, 0xe7, 0x5d, 0xcc, 0x70,
-	0xd5, 0x29, 0x07, 0x56, 0xc8, 0x8f, 0xfb, 0x35, 0xf2, 0x05, 0x01, 0x69, 0x98, 0x24, 0x32, 0x66,
-	0xd1, 0x91, 0x26, 0x71, 0x3e, 0x36, 0x9b, 0x53, 0xb3, 0x39, 0x1b, 0x73, 0xfa, 0xbe, 0x93, 0xf3,
-	0xcc, 0x5d, 0xb4, 0x31, 0x26, 0x29, 0x8b, 0x12, 0x95, 0x82, 0x4d, 0x91, 0x2a, 0xff, 0x21, 0xdc,
-	0xce, 0xb6, 0x16, 0xae, 0xe7, 0xea, 0xc8, 0x89, 0x1f, 0x9c, 0x86, 0xb7, 0xd1, 0x0a, 0x88, 0x84,
-	0xc4, 0x66, 0x86, 0xd7, 0x2d, 0xde, 0x2a, 0xc2, 0x53, 0x70, 0xef, 0xf3, 0xc5, 0x4d, 0xc7, 0xbb,
-	0xbc, 0xe9, 0x78, 0xbf, 0x6f, 0x3a, 0xde, 0xf9, 0x6d, 0xa7, 0x72, 0x79, 0xdb, 0xa9, 0xfc, 0xba,
-	0xed, 0x54, 0xbe, 0xbc, 0xf9, 0xff, 0x77, 0x72, 0x3a, 0xff, 0x4d, 0xb0, 0x6f, 0x66, 0x5c, 0xb7,
-	0xe2, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x2e, 0x21, 0x26, 0x3b, 0x04, 0x00, 0x00,
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0x6a, 0x1b, 0x47,
+	0x14, 0xf6, 0x4a, 0x8a, 0x6c, 0x8f, 0xfe, 0xc7, 0xc6, 0x5e, 0x1c, 0x50, 0x54, 0x41, 0xb0, 0x92,
+	0xb6, 0x72, 0x71, 0x73, 0x51, 0x68, 0x2f, 0x8a, 0x8b, 0x8d, 0x05, 0x4d, 0xbb, 0xac, 0x42, 0x2f,
+	0x02, 0x65, 0x18, 0xed, 0x8e, 0xa5, 0xc1, 0x3b, 0x3f, 0xdd, 0x9d, 0x0d, 0x52, 0x5f, 0xa0, 0xb7,
+	0x79, 0x9b, 0xbe, 0x42, 0xee, 0x9a, 0xcb, 0xd2, 0x0b, 0x53, 0xec, 0xd7, 0x28, 0xb4, 0xcc, 0xec,
+	0xec, 0x4a, 0xb2, 0x2b, 0x68, 0x29, 0xbd, 0x1b, 0x7d, 0xdf, 0x77, 0xce, 0x7e, 0x67, 0xe6, 0x9c,
+	0x23, 0x70, 0x1c, 0x2e, 0xc2, 0xb9, 0x8c, 0x85, 0x12, 0x81, 0x88, 0x4e, 0x24, 0x89, 0x25, 0x51,
+	0x29, 0x8e, 0x92, 0xe5, 0x71, 0x68, 0x58, 0x78, 0xb8, 0x2a, 0x1c, 0x2e, 0x85, 0x47, 0xfb, 0x53,
+	0x31, 0x15, 0x86, 0x38, 0xd1, 0xa7, 0x4c, 0xde, 0xff, 0xa9, 0x04, 0x76, 0xbd, 0x5c, 0x04, 0x2f,
+	0x40, 0x55, 0xe2, 0x18, 0xb3, 0xc4, 0x75, 0x7a, 0xce, 0xa0, 0x76, 0x3a, 0x18, 0x6e, 0xc8, 0x36,
+	0x2c, 0x62, 0x3c, 0xa3, 0x3f, 0xab, 0xbc, 0xbb, 0x79, 0xb2, 0xe5, 0xdb, 0x68, 0xc8, 0x40, 0xe3,
+	0x2a, 0xe5, 0x21, 0xe5, 0x53, 0x44, 0x79, 0x48, 0xe6, 0x6e, 0xa9, 0xe7, 0x0c, 0xea, 0x67, 0x97,
+	0x5a, 0xf4, 0xdb, 0xcd, 0x93, 0x2f, 0xa7, 0x54, 0xcd, 0xd2, 0xc9, 0x30, 0x10, 0xec, 0x64, 0xad,
+	0xae, 0x37, 0x2f, 0x3e, 0x0e, 0x66, 0x98, 0xf2, 0x93, 0x02, 0x09, 0xd5, 0x42, 0x92, 0x64, 0x38,
+	0x26, 0x31, 0xc5, 0x11, 0xfd, 0x11, 0x4f, 0x22, 0x32, 0xe2, 0xca, 0xaf, 0xdb, 0xf4, 0x23, 0x9d,
+	0x1d, 0xba, 0x60, 0x3b, 0x21, 0x4a, 0x45, 0x24, 0x74, 0xcb, 0x3d, 0x67, 0xb0, 0xe3, 0xe7, 0x3f,
+	0xe1, 0x33, 0xd0, 0xce, 0x8e, 0x8c, 0x70, 0x85, 0x64, 0x4c, 0x03, 0xe2, 0x56, 0x7a, 0xce, 0xa0,
+	0xe2, 0xb7, 0x96, 0xb8, 0xa7, 0xe1, 0xfe, 0x9f, 0x65, 0xd0, 0xba, 0x57, 0x15, 0x6c, 0x82, 0x12,
+	0x0d, 0xcd, 0x5d, 0x34, 0xfc, 0x12, 0x0d, 0xe1, 0x01, 0xa8, 0x2a, 0x1a, 0x5c, 0x93, 0xd8, 0x14,
+	0xb4, 0xeb, 0xdb, 0x5f, 0xf0, 0x31, 0xd8, 0x65, 0x38, 0xbe, 0x26, 0x0a, 0xd1, 0xcc, 0x42, 0xc3,
+	0xdf, 0xc9, 0x80, 0x51, 0x08, 0x3f, 0x04, 0x1d, 0xac, 0x04, 0xa3, 0x01, 0x8a, 0x49, 0x22, 0xa2,
+	0x54, 0x51, 0xc1, 0x8d, 0x89, 0x8e, 0xdf, 0xce, 0x08, 0xbf, 0xc0, 0xe1, 0x10, 0xec, 0x85, 0xe4,
+	0x0a, 0xa7, 0x91, 0x42, 0xf9, 0x0d, 0x4a, 0xc9, 0xdc, 0x47, 0x46, 0xde, 0xb1, 0xd4, 0x45, 0xc6,
+	0x78, 0x92, 0xc1, 0xa7, 0xa0, 0x19, 0xd1, 0x1f, 0x52, 0x1a, 0x52, 0xb5, 0x40, 0x8a, 0x92, 0xd8,
+	0xad, 0x9a, 0xcf, 0x37, 0x0a, 0xf4, 0x15, 0x25, 0x31, 0xfc, 0x1c, 0x1c, 0x09, 0x49, 0x38, 0xa2,
+	0x5c, 0x91, 0x98, 0x24, 0x0a, 0x05, 0x58, 0x22, 0x2e, 0xf4, 0x27, 0x71, 0xe4, 0x6e, 0x9b, 0x1b,
+	0x39, 0xd4, 0x8a, 0x91, 0x15, 0x7c, 0x85, 0xe5, 0x37, 0x96, 0x86, 0xaf, 0xc1, 0x5e, 0xee, 0x25,
+	0xc6, 0x8a, 0xa0, 0x89, 0x48, 0x79, 0x98, 0xb8, 0x3b, 0xa6, 0x45, 0x9e, 0x6f, 0x6c, 0x11, 0xeb,
+	0xd2, 0xc7, 0x8a, 0x9c, 0x99, 0x08, 0xbf, 0x73, 0x75, 0x1f, 0x82, 0xcf, 0x41, 0xa7, 0xf0, 0x64,
+	0x92, 0xeb, 0x6a, 0x77, 0x4d, 0xb5, 0xad, 0x9c, 0xd0, 0x72, 0x5d, 0xeb, 0x47, 0x00, 0xe6, 0x3e,
+	0xf4, 0xbd, 0x23, 0x22, 0x45, 0x30, 0x73, 0x81, 0x79, 0x89, 0xb6, 0x65, 0x5e, 0xd1, 0xe0, 0xfa,
+	0x5c, 0xe3, 0xf0, 0x13, 0xb0, 0x9f, 0xab, 0x13, 0xcc, 0x64, 0x44, 0xac, 0xbe, 0x66, 0xf4, 0x79,
+	0xa6, 0xb1, 0xa1, 0x4c, 0x44, 0xff, 0x1c, 0x74, 0x1e, 0x78, 0x86, 0x87, 0x60, 0x9b, 0x51, 0x6e,
+	0x6c, 0x39, 0xc6, 0x56, 0x95, 0x51, 0xae, 0xdd, 0x68, 0x02, 0xcf, 0x0d, 0x51, 0xb2, 0x04, 0x9e,
+	0x7b, 0x92, 0xf5, 0xff, 0x70, 0xc0, 0x9e, 0xcd, 0x73, 0x49, 0x13, 0x25, 0xe2, 0xc5, 0x39, 0x57,
+	0xf1, 0x02, 0x7e, 0x00, 0xea, 0x93, 0x48, 0x04, 0xd7, 0x68, 0x46, 0xe8, 0x74, 0xa6, 0x6c, 0x5b,
+	0xd5, 0x0c, 0x76, 0x69, 0x20, 0x38, 0x00, 0xed, 0xb5, 0x9b, 0x5e, 0x26, 0x6f, 0xae, 0x5c, 0x9d,
+	0xfe, 0xfa, 0x83, 0x09, 0x2b, 0xff, 0xaf, 0x13, 0xf6, 0x0c, 0xb4, 0xcd, 0x6b, 0xbc, 0xc1, 0x11,
+	0x4a, 0x48, 0x20, 0xf4, 0xfb, 0x57, 0x8c, 0xff, 0x56, 0x8e, 0x8f, 0x33, 0xb8, 0xff, 0x2d, 0x68,
+	0xbe, 0x34, 0xad, 0xef, 0xc5, 0x84, 0xd1, 0x94, 0x25, 0xba, 0xf0, 0xa2, 0x2d, 0x50, 0x31, 0x4f,
+	0xb5, 0x02, 0x1b, 0x85, 0xf0, 0x08, 0xec, 0x48, 0x2b, 0x77, 0x4b, 0xbd, 0xf2, 0xa0, 0xe3, 0x17,
+	0xbf, 0xfb, 0x6f, 0x1d, 0x50, 0xb7, 0xb9, 0xc6, 0x4a, 0xc4, 0x04, 0x7e, 0x0f, 0xf6, 0x70, 0x14,
+	0x21, 0x3b, 0x71, 0x45, 0x9c, 0xd3, 0x2b, 0x0f, 0x6a, 0xa7, 0xc7, 0x1b, 0xfb, 0x71, 0xdd, 0x95,
+	0xdd, 0x58, 0x1d, 0x1c, 0x45, 0x0f, 0xed, 0xf2, 0x94, 0xa1, 0x15, 0x3f, 0xc6, 0x2e, 0x4f, 0x59,
+	0x2e, 0xe9, 0xff, 0xe2, 0x80, 0xc7, 0xf6, 0x89, 0xc7, 0xc1, 0x8c, 0x84, 0x69, 0x44, 0xd6, 0x1c,
+	0xfe, 0x7d, 0xa7, 0x3a, 0xff, 0xb2, 0x53, 0x4b, 0x9b, 0x3a, 0x15, 0x7a, 0xa0, 0x61, 0xed, 0x21,
+	0xdd, 0x60, 0xc4, 0xbc, 0x7e, 0xed, 0xf4, 0xe9, 0xe6, 0x75, 0xbd, 0xe2, 0xce, 0x56, 0x5e, 0x97,
+	0x2b, 0x58, 0xff, 0xe7, 0x12, 0x68, 0x7c, 0xbd, 0xb6, 0x32, 0xee, 0xef, 0x3e, 0x08, 0x2a, 0x1c,
+	0x33, 0x62, 0x5d, 0x99, 0xb3, 0xae, 0x93, 0x72, 0xaa, 0x28, 0x36, 0xaf, 0x31, 0xb5, 0x73, 0x92,
+	0x2d, 0xc0, 0xb6, 0x65, 0x5e, 0x1a, 0x42, 0xf7, 0xec, 0x67, 0xc0, 0x65, 0x58, 0xb7, 0x0b, 0xc7,
+	0x3c, 0x20, 0xe8, 0x2a, 0xc6, 0x81, 0xa2, 0x22, 0x8b, 0xc9, 0x9a, 0xe9, 0x60, 0x85, 0xbf, 0xb0,
+	0xb4, 0x8e, 0x7c, 0x01, 0x0e, 0x26, 0x38, 0x21, 0x48, 0x8a, 0x84, 0x9a, 0x90, 0x62, 0x75, 0x3d,
+	0x32, 0xab, 0x6b, 0x5f, 0xb3, 0x9e, 0x25, 0x8b, 0xbd, 0x75, 0x0c, 0x5a, 0x94, 0x49, 0x1c, 0xa8,
+	0xa5, 0xbc, 0x6a, 0xe4, 0xcd, 0x0c, 0x2e, 0x84, 0xff, 0x65, 0x3b, 0x9e, 0x7d, 0xf7, 0xee, 0xb6,
+	0xeb, 0xbc, 0xbf, 0xed, 0x3a, 0xbf, 0xdf, 0x76, 0x9d, 0xb7, 0x77, 0xdd, 0xad, 0xf7, 0x77, 0xdd,
+	0xad, 0x5f, 0xef, 0xba, 0x5b, 0xaf, 0xbf, 0xf8, 0xe7, 0x43, 0x38, 0x5f, 0xfd, 0x4b, 0x37, 0x03,
+	0x39, 0xa9, 0x1a, 0xf2, 0xd3, 0xbf, 0x06, 0x00, 0x2e, 0x18, 0xfb, 0x23, 0xfa, 0x07, 0x00, 0x00,
 }
 
 func (m *Perpetual) Marshal() (dAtA []byte, err error) {
@@ -468,6 +770,21 @@ func (m *Perpetual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SettlementPrice != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.SettlementPrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.FundingIndex.Size()
 		i -= size
@@ -511,6 +828,42 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FundingSampleEpoch) > 0 {
+		i -= len(m.FundingSampleEpoch)
+		copy(dAtA[i:], m.FundingSampleEpoch)
+		i = encodeVarintPerpetual(dAtA, i, uint64(len(m.FundingSampleEpoch)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FundingTickEpoch) > 0 {
+		i -= len(m.FundingTickEpoch)
+		copy(dAtA[i:], m.FundingTickEpoch)
+		i = encodeVarintPerpetual(dAtA, i, uint64(len(m.FundingTickEpoch)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.InterestRatePpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.InterestRatePpm)<<1)^uint32((m.InterestRatePpm>>31))))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FundingRateBounds != nil {
+		{
+			size, err := m.FundingRateBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPerpetual(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.OpenInterestCapNotional != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.OpenInterestCapNotional))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.LiquidityTier != 0 {
 		i = encodeVarintPerpetual(dAtA, i, uint64(m.LiquidityTier))
 		i--
@@ -546,6 +899,87 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FundingRateBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRateBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRateBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.MaxPpm)<<1)^uint32((m.MaxPpm>>31))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.MinPpm)<<1)^uint32((m.MinPpm>>31))))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.FundingIndex.Size()
+		i -= size
+		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPerpetual(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FundingRatePpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.FundingRatePpm)<<1)^uint32((m.FundingRatePpm>>31))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketPremiums) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -567,21 +1001,21 @@ func (m *MarketPremiums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Premiums) > 0 {
-		dAtA2 := make([]byte, len(m.Premiums)*5)
-		var j3 int
+		dAtA3 := make([]byte, len(m.Premiums)*5)
+		var j4 int
 		for _, num := range m.Premiums {
-			x4 := (uint32(num) << 1) ^ uint32((num >> 31))
-			for x4 >= 1<<7 {
-				dAtA2[j3] = uint8(uint64(x4)&0x7f | 0x80)
-				j3++
-				x4 >>= 7
-			}
-			dAtA2[j3] = uint8(x4)
-			j3++
+			x5 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x5 >= 1<<7 {
+				dAtA3[j4] = uint8(uint64(x5)&0x7f | 0x80)
+				j4++
+				x5 >>= 7
+			}
+			dAtA3[j4] = uint8(x5)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA2[:j3])
-		i = encodeVarintPerpetual(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA3[:j4])
+		i = encodeVarintPerpetual(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -635,7 +1069,7 @@ func (m *PremiumStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityTier) Marshal() (dAtA []byte, err error) {
+func (m *FundingSchedulePremiumStore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -645,35 +1079,87 @@ func (m *LiquidityTier) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityTier) MarshalTo(dAtA []byte) (int, error) {
+func (m *FundingSchedulePremiumStore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FundingSchedulePremiumStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ImpactNotional != 0 {
-		i = encodeVarintPerpetual(dAtA, i, uint64(m.ImpactNotional))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.BasePositionNotional != 0 {
-		i = encodeVarintPerpetual(dAtA, i, uint64(m.BasePositionNotional))
-		i--
-		dAtA[i] = 0x28
+	{
+		size, err := m.PremiumStore.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPerpetual(dAtA, i, uint64(size))
 	}
-	if m.MaintenanceFractionPpm != 0 {
-		i = encodeVarintPerpetual(dAtA, i, uint64(m.MaintenanceFractionPpm))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FundingSampleEpoch) > 0 {
+		i -= len(m.FundingSampleEpoch)
+		copy(dAtA[i:], m.FundingSampleEpoch)
+		i = encodeVarintPerpetual(dAtA, i, uint64(len(m.FundingSampleEpoch)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
-	if m.InitialMarginPpm != 0 {
-		i = encodeVarintPerpetual(dAtA, i, uint64(m.InitialMarginPpm))
+	if len(m.FundingTickEpoch) > 0 {
+		i -= len(m.FundingTickEpoch)
+		copy(dAtA[i:], m.FundingTickEpoch)
+		i = encodeVarintPerpetual(dAtA, i, uint64(len(m.FundingTickEpoch)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenInterestCapNotional != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.OpenInterestCapNotional))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ImpactNotional != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.ImpactNotional))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BasePositionNotional != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.BasePositionNotional))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaintenanceFractionPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.MaintenanceFractionPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialMarginPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.InitialMarginPpm))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
@@ -711,6 +1197,12 @@ func (m *Perpetual) Size() (n int) {
 	n += 1 + l + sovPerpetual(uint64(l))
 	l = m.FundingIndex.Size()
 	n += 1 + l + sovPerpetual(uint64(l))
+	if m.Settled {
+		n += 2
+	}
+	if m.SettlementPrice != 0 {
+		n += 1 + sovPerpetual(uint64(m.SettlementPrice))
+	}
 	return n
 }
 
@@ -739,6 +1231,59 @@ func (m *PerpetualParams) Size() (n int) {
 	if m.LiquidityTier != 0 {
 		n += 1 + sovPerpetual(uint64(m.LiquidityTier))
 	}
+	if m.OpenInterestCapNotional != 0 {
+		n += 1 + sovPerpetual(uint64(m.OpenInterestCapNotional))
+	}
+	if m.FundingRateBounds != nil {
+		l = m.FundingRateBounds.Size()
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	if m.InterestRatePpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.InterestRatePpm))
+	}
+	l = len(m.FundingTickEpoch)
+	if l > 0 {
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	l = len(m.FundingSampleEpoch)
+	if l > 0 {
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	return n
+}
+
+func (m *FundingRateBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinPpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.MinPpm))
+	}
+	if m.MaxPpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.MaxPpm))
+	}
+	return n
+}
+
+func (m *FundingHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovPerpetual(uint64(m.BlockHeight))
+	}
+	if m.FundingRatePpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.FundingRatePpm))
+	}
+	l = m.FundingIndex.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovPerpetual(uint64(m.IntervalSeconds))
+	}
 	return n
 }
 
@@ -779,6 +1324,25 @@ func (m *PremiumStore) Size() (n int) {
 	return n
 }
 
+func (m *FundingSchedulePremiumStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FundingTickEpoch)
+	if l > 0 {
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	l = len(m.FundingSampleEpoch)
+	if l > 0 {
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	l = m.PremiumStore.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	return n
+}
+
 func (m *LiquidityTier) Size() (n int) {
 	if m == nil {
 		return 0
@@ -804,6 +1368,9 @@ func (m *LiquidityTier) Size() (n int) {
 	if m.ImpactNotional != 0 {
 		n += 1 + sovPerpetual(uint64(m.ImpactNotional))
 	}
+	if m.OpenInterestCapNotional != 0 {
+		n += 1 + sovPerpetual(uint64(m.OpenInterestCapNotional))
+	}
 	return n
 }
 
@@ -894,20 +1461,472 @@ func (m *Perpetual) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPerpetual
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPerpetual
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			if byteLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Settled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			m.SettlementPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.AtomicResolution = v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFundingPpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.DefaultFundingPpm = v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
+			}
+			m.LiquidityTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityTier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestCapNotional", wireType)
+			}
+			m.OpenInterestCapNotional = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenInterestCapNotional |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FundingRateBounds == nil {
+				m.FundingRateBounds = &FundingRateBounds{}
+			}
+			if err := m.FundingRateBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRatePpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.InterestRatePpm = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingTickEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingTickEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingSampleEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingSampleEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRateBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRateBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRateBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.MinPpm = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.MaxPpm = v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
@@ -929,7 +1948,7 @@ func (m *Perpetual) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
+func (m *FundingHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -952,17 +1971,17 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundingHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundingHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Id = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPerpetual
@@ -972,16 +1991,16 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.BlockHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRatePpm", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPerpetual
@@ -991,29 +2010,18 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPerpetual
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPerpetual
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.FundingRatePpm = v
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingIndex", wireType)
 			}
-			m.MarketId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPerpetual
@@ -1023,58 +2031,30 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthPerpetual
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPerpetual
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
 			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.AtomicResolution = v
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFundingPpm", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPerpetual
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.DefaultFundingPpm = v
-		case 6:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			m.LiquidityTier = 0
+			m.IntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPerpetual
@@ -1084,7 +2064,7 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LiquidityTier |= uint32(b&0x7F) << shift
+				m.IntervalSeconds |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1360,6 +2340,153 @@ func (m *PremiumStore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FundingSchedulePremiumStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingSchedulePremiumStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingSchedulePremiumStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingTickEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingTickEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingSampleEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingSampleEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1516,6 +2643,25 @@ func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestCapNotional", wireType)
+			}
+			m.OpenInterestCapNotional = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenInterestCapNotional |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
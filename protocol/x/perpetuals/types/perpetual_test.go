@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
@@ -37,6 +38,56 @@ func TestPerpetualParams_Validate(t *testing.T) {
 			},
 			expectedErr: "DefaultFundingPpm magnitude exceeds maximum value",
 		},
+		{
+			desc: "Valid funding rate bounds and interest rate",
+			params: types.PerpetualParams{
+				Ticker: "test",
+				FundingRateBounds: &types.FundingRateBounds{
+					MinPpm: -10_000,
+					MaxPpm: 10_000,
+				},
+				InterestRatePpm: -1_000_000,
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Valid zero funding rate bounds",
+			params: types.PerpetualParams{
+				Ticker:            "test",
+				FundingRateBounds: &types.FundingRateBounds{},
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Positive min funding rate",
+			params: types.PerpetualParams{
+				Ticker: "test",
+				FundingRateBounds: &types.FundingRateBounds{
+					MinPpm: 100,
+					MaxPpm: 10_000,
+				},
+			},
+			expectedErr: "Funding rate bounds must not exclude a funding rate of zero",
+		},
+		{
+			desc: "Negative max funding rate",
+			params: types.PerpetualParams{
+				Ticker: "test",
+				FundingRateBounds: &types.FundingRateBounds{
+					MinPpm: -10_000,
+					MaxPpm: -100,
+				},
+			},
+			expectedErr: "Funding rate bounds must not exclude a funding rate of zero",
+		},
+		{
+			desc: "Invalid InterestRatePpm",
+			params: types.PerpetualParams{
+				Ticker:          "test",
+				InterestRatePpm: 1_000_001,
+			},
+			expectedErr: "Interest rate ppm magnitude exceeds maximum value",
+		},
 	}
 
 	for _, tc := range tests {
@@ -50,3 +101,61 @@ func TestPerpetualParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestClampFundingRatePpm(t *testing.T) {
+	tests := map[string]struct {
+		fundingRateBounds      *types.FundingRateBounds
+		fundingRatePpm         int64
+		maxAbsFundingRatePpm   int64
+		expectedFundingRatePpm int64
+	}{
+		"unset bounds are only clamped by the max absolute funding rate": {
+			fundingRatePpm:         -1_000_000,
+			maxAbsFundingRatePpm:   10_000,
+			expectedFundingRatePpm: -10_000,
+		},
+		"within bounds": {
+			fundingRateBounds:      &types.FundingRateBounds{MinPpm: -800, MaxPpm: 800},
+			fundingRatePpm:         500,
+			maxAbsFundingRatePpm:   10_000,
+			expectedFundingRatePpm: 500,
+		},
+		"clamped to min": {
+			fundingRateBounds:      &types.FundingRateBounds{MinPpm: -800, MaxPpm: 800},
+			fundingRatePpm:         -1_000,
+			maxAbsFundingRatePpm:   10_000,
+			expectedFundingRatePpm: -800,
+		},
+		"clamped to max": {
+			fundingRateBounds:      &types.FundingRateBounds{MinPpm: -800, MaxPpm: 800},
+			fundingRatePpm:         1_000,
+			maxAbsFundingRatePpm:   10_000,
+			expectedFundingRatePpm: 800,
+		},
+		"zero bounds": {
+			fundingRateBounds:      &types.FundingRateBounds{},
+			fundingRatePpm:         1_000,
+			maxAbsFundingRatePpm:   10_000,
+			expectedFundingRatePpm: 0,
+		},
+		"bounds wider than the max absolute funding rate": {
+			fundingRateBounds:      &types.FundingRateBounds{MinPpm: -800, MaxPpm: 800},
+			fundingRatePpm:         -1_000,
+			maxAbsFundingRatePpm:   500,
+			expectedFundingRatePpm: -500,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.PerpetualParams{
+				FundingRateBounds: tc.fundingRateBounds,
+			}
+			require.Equal(
+				t,
+				big.NewInt(tc.expectedFundingRatePpm),
+				params.ClampFundingRatePpm(big.NewInt(tc.fundingRatePpm), big.NewInt(tc.maxAbsFundingRatePpm)),
+			)
+		})
+	}
+}
@@ -258,7 +258,11 @@ var xxx_messageInfo_QueryPremiumVotesRequest proto.InternalMessageInfo
 // QueryPremiumVotesResponse is the response type for the PremiumVotes RPC
 // method.
 type QueryPremiumVotesResponse struct {
+	// Premium votes of the perpetuals on the default `funding-sample` epoch.
 	PremiumVotes PremiumStore `protobuf:"bytes,1,opt,name=premium_votes,json=premiumVotes,proto3" json:"premium_votes"`
+	// Premium votes of each `funding-sample` epoch referenced by a perpetual,
+	// with the default `funding-sample` epoch first.
+	PremiumVotesBySchedule []FundingSchedulePremiumStore `protobuf:"bytes,2,rep,name=premium_votes_by_schedule,json=premiumVotesBySchedule,proto3" json:"premium_votes_by_schedule"`
 }
 
 func (m *QueryPremiumVotesResponse) Reset()         { *m = QueryPremiumVotesResponse{} }
@@ -301,6 +305,13 @@ func (m *QueryPremiumVotesResponse) GetPremiumVotes() PremiumStore {
 	return PremiumStore{}
 }
 
+func (m *QueryPremiumVotesResponse) GetPremiumVotesBySchedule() []FundingSchedulePremiumStore {
+	if m != nil {
+		return m.PremiumVotesBySchedule
+	}
+	return nil
+}
+
 // QueryPremiumSamplesRequest is the request type for the PremiumSamples RPC
 // method.
 type QueryPremiumSamplesRequest struct {
@@ -342,7 +353,11 @@ var xxx_messageInfo_QueryPremiumSamplesRequest proto.InternalMessageInfo
 // QueryPremiumSamplesResponse is the response type for the PremiumSamples RPC
 // method.
 type QueryPremiumSamplesResponse struct {
+	// Premium samples of the perpetuals on the default funding schedule.
 	PremiumSamples PremiumStore `protobuf:"bytes,1,opt,name=premium_samples,json=premiumSamples,proto3" json:"premium_samples"`
+	// Premium samples of each funding schedule referenced by a perpetual, with
+	// the default funding schedule first.
+	PremiumSamplesBySchedule []FundingSchedulePremiumStore `protobuf:"bytes,2,rep,name=premium_samples_by_schedule,json=premiumSamplesBySchedule,proto3" json:"premium_samples_by_schedule"`
 }
 
 func (m *QueryPremiumSamplesResponse) Reset()         { *m = QueryPremiumSamplesResponse{} }
@@ -385,6 +400,13 @@ func (m *QueryPremiumSamplesResponse) GetPremiumSamples() PremiumStore {
 	return PremiumStore{}
 }
 
+func (m *QueryPremiumSamplesResponse) GetPremiumSamplesBySchedule() []FundingSchedulePremiumStore {
+	if m != nil {
+		return m.PremiumSamplesBySchedule
+	}
+	return nil
+}
+
 // QueryParamsResponse is the response type for the Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -467,6 +489,125 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryFundingHistoryRequest is the request type for the FundingHistory RPC
+// method.
+type QueryFundingHistoryRequest struct {
+	// The perpetual to query the funding history of.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// Only funding history entries at or after this block height are returned.
+	FromHeight uint32             `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundingHistoryRequest) Reset()         { *m = QueryFundingHistoryRequest{} }
+func (m *QueryFundingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingHistoryRequest) ProtoMessage()    {}
+func (*QueryFundingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{10}
+}
+func (m *QueryFundingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingHistoryRequest.Merge(m, src)
+}
+func (m *QueryFundingHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFundingHistoryRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *QueryFundingHistoryRequest) GetFromHeight() uint32 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryFundingHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFundingHistoryResponse is the response type for the FundingHistory RPC
+// method.
+type QueryFundingHistoryResponse struct {
+	// Funding history entries, sorted by block height.
+	Entries    []FundingHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundingHistoryResponse) Reset()         { *m = QueryFundingHistoryResponse{} }
+func (m *QueryFundingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingHistoryResponse) ProtoMessage()    {}
+func (*QueryFundingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{11}
+}
+func (m *QueryFundingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingHistoryResponse.Merge(m, src)
+}
+func (m *QueryFundingHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFundingHistoryResponse) GetEntries() []FundingHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryFundingHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPerpetualRequest)(nil), "dydxprotocol.perpetuals.QueryPerpetualRequest")
 	proto.RegisterType((*QueryPerpetualResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualResponse")
@@ -478,6 +619,8 @@ func init() {
 	proto.RegisterType((*QueryPremiumSamplesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.perpetuals.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.perpetuals.QueryParamsResponse")
+	proto.RegisterType((*QueryFundingHistoryRequest)(nil), "dydxprotocol.perpetuals.QueryFundingHistoryRequest")
+	proto.RegisterType((*QueryFundingHistoryResponse)(nil), "dydxprotocol.perpetuals.QueryFundingHistoryResponse")
 }
 
 func init() {
@@ -485,47 +628,59 @@ func init() {
 }
 
 var fileDescriptor_13b6d29860ccef6b = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0xe3, 0x00, 0x91, 0x7a, 0x34, 0x41, 0x3a, 0x0a, 0x14, 0x53, 0x39, 0x60, 0x4a, 0x13,
-	0x02, 0xf8, 0x48, 0xd2, 0x11, 0x06, 0x3a, 0x94, 0x35, 0x84, 0xaa, 0x03, 0x0b, 0x5c, 0x92, 0x93,
-	0x6b, 0xc9, 0xf6, 0x5d, 0x7d, 0x4e, 0xd4, 0x08, 0xb1, 0x30, 0x33, 0x20, 0x31, 0xb3, 0xc1, 0xd8,
-	0x91, 0xff, 0xa1, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0xfe, 0x10, 0x94, 0xbb, 0x73, 0x62, 0xd3,
-	0xb8, 0x4e, 0xba, 0x45, 0xf7, 0xbe, 0xf7, 0xbe, 0xdf, 0x7d, 0xbe, 0xa7, 0x80, 0xfb, 0xbd, 0x61,
-	0xef, 0x88, 0x05, 0x34, 0xa4, 0x5d, 0xea, 0x22, 0x46, 0x02, 0x46, 0xc2, 0x3e, 0x76, 0x39, 0x3a,
-	0xec, 0x93, 0x60, 0x68, 0x89, 0x0a, 0xbc, 0x15, 0x17, 0x59, 0x33, 0x91, 0xbe, 0x66, 0x53, 0x9b,
-	0x8a, 0x02, 0x9a, 0xfc, 0x92, 0x72, 0x7d, 0xc3, 0xa6, 0xd4, 0x76, 0x09, 0xc2, 0xcc, 0x41, 0xd8,
-	0xf7, 0x69, 0x88, 0x43, 0x87, 0xfa, 0x5c, 0x55, 0x6b, 0x5d, 0xca, 0x3d, 0xca, 0x51, 0x07, 0x73,
-	0x22, 0x5d, 0xd0, 0xa0, 0xde, 0x21, 0x21, 0xae, 0x23, 0x86, 0x6d, 0xc7, 0x17, 0x62, 0xa5, 0xdd,
-	0x4c, 0xa3, 0x63, 0x38, 0xc0, 0x5e, 0x34, 0xb1, 0x92, 0xaa, 0x8a, 0x7e, 0x4a, 0xa1, 0x59, 0x01,
-	0x37, 0x5e, 0x4d, 0x0c, 0x5b, 0xd1, 0x79, 0x9b, 0x1c, 0xf6, 0x09, 0x0f, 0x61, 0x09, 0xe4, 0x9d,
-	0xde, 0xba, 0x76, 0x57, 0xab, 0x16, 0xdb, 0x79, 0xa7, 0x67, 0xbe, 0x03, 0x37, 0xff, 0x17, 0x72,
-	0x46, 0x7d, 0x4e, 0xe0, 0x2e, 0x58, 0x99, 0x4e, 0x15, 0x0d, 0x57, 0x1b, 0xa6, 0x95, 0x12, 0x8f,
-	0x35, 0x6d, 0xdf, 0xb9, 0x7c, 0xf2, 0xbb, 0x9c, 0x6b, 0xcf, 0x5a, 0xcd, 0x2e, 0xb8, 0x2d, 0x1c,
-	0x5e, 0xb8, 0xee, 0x54, 0xc5, 0x23, 0x9c, 0x5d, 0x00, 0x66, 0x51, 0x28, 0x97, 0x2d, 0x4b, 0xe6,
-	0x66, 0x4d, 0x72, 0xb3, 0xe4, 0xd7, 0x51, 0xb9, 0x59, 0x2d, 0x6c, 0x13, 0xd5, 0xdb, 0x8e, 0x75,
-	0x9a, 0xc7, 0x1a, 0xd0, 0xe7, 0xb9, 0xcc, 0xbf, 0xcb, 0xa5, 0x0b, 0xde, 0x05, 0xbe, 0x4c, 0xe0,
-	0xe6, 0x05, 0x6e, 0x25, 0x13, 0x57, 0x42, 0x24, 0x78, 0x75, 0xb0, 0x2e, 0x63, 0x0f, 0x88, 0xe7,
-	0xf4, 0xbd, 0x7d, 0x1a, 0x92, 0x28, 0x13, 0xd3, 0x53, 0x81, 0x25, 0x6b, 0xea, 0x26, 0x2d, 0x50,
-	0x64, 0xf2, 0xfc, 0xed, 0x60, 0x52, 0x50, 0x99, 0x3d, 0x48, 0xbf, 0x8d, 0x54, 0xbf, 0x0e, 0x69,
-	0x40, 0xd4, 0x85, 0x56, 0x59, 0x6c, 0xb2, 0xb9, 0xa1, 0x92, 0x8b, 0x84, 0xd8, 0x63, 0xee, 0x0c,
-	0x86, 0x83, 0x3b, 0x73, 0xab, 0x0a, 0x67, 0x0f, 0x5c, 0x8b, 0x70, 0xb8, 0x2c, 0x5d, 0x04, 0xa8,
-	0xc4, 0x12, 0xd3, 0xcd, 0x35, 0x00, 0xa5, 0xa9, 0x78, 0xfb, 0x11, 0xca, 0x1e, 0xb8, 0x9e, 0x38,
-	0x55, 0x08, 0xcf, 0x41, 0x41, 0xee, 0x88, 0x72, 0x2e, 0xa7, 0x3b, 0x0b, 0x99, 0xf2, 0x54, 0x4d,
-	0x8d, 0x1f, 0x05, 0x70, 0x45, 0x8c, 0x85, 0x5f, 0x35, 0xb0, 0x32, 0xfd, 0xf6, 0xd0, 0x4a, 0x1d,
-	0x33, 0x77, 0xb1, 0x74, 0xb4, 0xb0, 0x5e, 0x72, 0x9b, 0xe8, 0xe3, 0xcf, 0xbf, 0x5f, 0xf2, 0x0f,
-	0x61, 0x05, 0x65, 0x2e, 0x35, 0x7a, 0xef, 0xf4, 0x3e, 0xc0, 0x6f, 0x1a, 0x28, 0x26, 0x9e, 0x37,
-	0x6c, 0x9c, 0xef, 0x39, 0x6f, 0xe3, 0xf4, 0xe6, 0x52, 0x3d, 0x8a, 0xb5, 0x26, 0x58, 0x37, 0xa1,
-	0x99, 0xcd, 0x0a, 0xbf, 0x6b, 0x60, 0x35, 0xfe, 0x74, 0x61, 0x3d, 0x23, 0x99, 0xb3, 0x2b, 0xa0,
-	0x37, 0x96, 0x69, 0x51, 0x8c, 0x96, 0x60, 0xac, 0xc2, 0xad, 0x74, 0xc6, 0xf8, 0xe2, 0xc0, 0x63,
-	0x0d, 0x94, 0x92, 0xaf, 0x1a, 0x36, 0x17, 0xb2, 0x4d, 0x6e, 0x88, 0xbe, 0xbd, 0x5c, 0x93, 0xa2,
-	0x7d, 0x2a, 0x68, 0x6b, 0xb0, 0x9a, 0x49, 0xab, 0xf6, 0x0a, 0x7e, 0xd2, 0x40, 0x41, 0xbe, 0x60,
-	0xf8, 0x28, 0xc3, 0x32, 0xbe, 0x36, 0xfa, 0xe3, 0xc5, 0xc4, 0x8a, 0xab, 0x22, 0xb8, 0xee, 0xc1,
-	0x32, 0x3a, 0xff, 0x0f, 0x69, 0x67, 0xff, 0x64, 0x64, 0x68, 0xa7, 0x23, 0x43, 0xfb, 0x33, 0x32,
-	0xb4, 0xcf, 0x63, 0x23, 0x77, 0x3a, 0x36, 0x72, 0xbf, 0xc6, 0x46, 0xee, 0xcd, 0x33, 0xdb, 0x09,
-	0x0f, 0xfa, 0x1d, 0xab, 0x4b, 0xbd, 0xe4, 0x90, 0xc1, 0xf6, 0x93, 0xee, 0x01, 0x76, 0x7c, 0x34,
-	0x3d, 0x39, 0x8a, 0x0f, 0x0e, 0x87, 0x8c, 0xf0, 0x4e, 0x41, 0x14, 0x9b, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xe6, 0x1c, 0x39, 0xe0, 0xaf, 0x07, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbf, 0x4f, 0xdb, 0x5a,
+	0x14, 0x8e, 0xc3, 0x7b, 0x20, 0x2e, 0x24, 0x4f, 0xba, 0x8f, 0xc7, 0x0b, 0x06, 0x25, 0x0f, 0x3f,
+	0x4a, 0x28, 0xa5, 0xbe, 0x25, 0x44, 0xaa, 0x2a, 0xb5, 0xaa, 0x8a, 0x54, 0x4a, 0xa5, 0x0e, 0x34,
+	0x20, 0x86, 0x2e, 0xa9, 0x13, 0x5f, 0x1c, 0x4b, 0x89, 0xaf, 0xf1, 0x0f, 0x84, 0x85, 0x58, 0x3a,
+	0x77, 0xa8, 0xd4, 0xb9, 0x5b, 0x2b, 0x75, 0x61, 0xeb, 0xd8, 0x3f, 0x80, 0x11, 0xa9, 0x4b, 0xa7,
+	0xaa, 0x02, 0xe6, 0x4e, 0xfd, 0x03, 0xaa, 0xdc, 0x7b, 0xed, 0xd8, 0xa9, 0x8d, 0x09, 0x62, 0xb3,
+	0xce, 0xf9, 0xce, 0x39, 0xdf, 0xf9, 0x2e, 0xe7, 0x23, 0xe0, 0x7f, 0xd5, 0x53, 0xf7, 0x4d, 0x8b,
+	0x38, 0xa4, 0x49, 0xda, 0xc8, 0xc4, 0x96, 0x89, 0x1d, 0x57, 0x69, 0xdb, 0x68, 0xd7, 0xc5, 0x96,
+	0x27, 0xd3, 0x0c, 0xfc, 0x37, 0x0c, 0x92, 0x7b, 0x20, 0x71, 0x42, 0x23, 0x1a, 0xa1, 0x09, 0xd4,
+	0xfd, 0x62, 0x70, 0x71, 0x46, 0x23, 0x44, 0x6b, 0x63, 0xa4, 0x98, 0x3a, 0x52, 0x0c, 0x83, 0x38,
+	0x8a, 0xa3, 0x13, 0xc3, 0xe6, 0xd9, 0xc5, 0x26, 0xb1, 0x3b, 0xc4, 0x46, 0x0d, 0xc5, 0xc6, 0x6c,
+	0x0a, 0xda, 0x5b, 0x6e, 0x60, 0x47, 0x59, 0x46, 0xa6, 0xa2, 0xe9, 0x06, 0x05, 0x73, 0xec, 0x5c,
+	0x12, 0x3b, 0x53, 0xb1, 0x94, 0x8e, 0xdf, 0xb1, 0x9c, 0x88, 0xf2, 0x3f, 0x19, 0x50, 0x2a, 0x83,
+	0x7f, 0x9e, 0x77, 0x07, 0x6e, 0xf8, 0xf1, 0x1a, 0xde, 0x75, 0xb1, 0xed, 0xc0, 0x3c, 0xc8, 0xea,
+	0x6a, 0x41, 0xf8, 0x4f, 0x58, 0xc8, 0xd5, 0xb2, 0xba, 0x2a, 0xbd, 0x04, 0x93, 0xfd, 0x40, 0xdb,
+	0x24, 0x86, 0x8d, 0xe1, 0x1a, 0x18, 0x0d, 0xba, 0xd2, 0x82, 0xb1, 0x8a, 0x24, 0x27, 0xc8, 0x23,
+	0x07, 0xe5, 0xab, 0x7f, 0x1c, 0x7f, 0x2b, 0x65, 0x6a, 0xbd, 0x52, 0xa9, 0x09, 0xa6, 0xe8, 0x84,
+	0x47, 0xed, 0x76, 0x80, 0xb2, 0x7d, 0x3a, 0x6b, 0x00, 0xf4, 0xa4, 0xe0, 0x53, 0xe6, 0x65, 0xa6,
+	0x9b, 0xdc, 0xd5, 0x4d, 0x66, 0xaf, 0xc3, 0x75, 0x93, 0x37, 0x14, 0x0d, 0xf3, 0xda, 0x5a, 0xa8,
+	0x52, 0x3a, 0x12, 0x80, 0x18, 0x37, 0x25, 0x7e, 0x97, 0xa1, 0x2b, 0xee, 0x02, 0x9f, 0x44, 0xe8,
+	0x66, 0x29, 0xdd, 0x72, 0x2a, 0x5d, 0x46, 0x22, 0xc2, 0x57, 0x04, 0x05, 0x26, 0xbb, 0x85, 0x3b,
+	0xba, 0xdb, 0xd9, 0x26, 0x0e, 0xf6, 0x35, 0x91, 0xce, 0x05, 0x30, 0x15, 0x93, 0xe4, 0xab, 0x6c,
+	0x80, 0x9c, 0xc9, 0xe2, 0xf5, 0xbd, 0x6e, 0x82, 0x8b, 0x76, 0x23, 0x79, 0x1d, 0x86, 0xde, 0x74,
+	0x88, 0x85, 0xf9, 0x46, 0xe3, 0x66, 0xa8, 0x33, 0x74, 0xc1, 0x54, 0xa4, 0x63, 0xbd, 0xe1, 0xd5,
+	0xed, 0x66, 0x0b, 0xab, 0x6e, 0x1b, 0x17, 0xb2, 0x54, 0xac, 0x6a, 0x62, 0xf7, 0x35, 0xd7, 0x50,
+	0x75, 0x43, 0xdb, 0xe4, 0xf8, 0x98, 0x61, 0x93, 0xe1, 0x61, 0xab, 0x9e, 0x8f, 0x94, 0x66, 0xf8,
+	0x8b, 0xf9, 0x25, 0x4a, 0xc7, 0x6c, 0xf7, 0x44, 0xf8, 0x21, 0x80, 0xe9, 0xd8, 0x34, 0x97, 0x61,
+	0x0b, 0xfc, 0xe5, 0x93, 0xb6, 0x59, 0xea, 0x2a, 0x42, 0xe4, 0xcd, 0x48, 0x77, 0xe8, 0x81, 0xe9,
+	0xbe, 0xae, 0xd7, 0x2c, 0x46, 0x21, 0x3a, 0x30, 0x24, 0xc7, 0x04, 0x80, 0x6c, 0x5f, 0x7a, 0xef,
+	0xbe, 0x0c, 0x5b, 0xe0, 0xef, 0x48, 0x94, 0x6f, 0xff, 0x00, 0x0c, 0x33, 0x5f, 0xe0, 0x4b, 0x97,
+	0x92, 0x97, 0xa6, 0x30, 0x3e, 0x9d, 0x17, 0x49, 0x1f, 0xfd, 0x6b, 0xe1, 0x84, 0xd7, 0x75, 0xdb,
+	0x21, 0x96, 0xe7, 0x1f, 0xe5, 0x2c, 0x18, 0x0f, 0x3a, 0xd4, 0x03, 0xb7, 0x18, 0x0b, 0x62, 0x4f,
+	0x55, 0x58, 0x02, 0x63, 0x3b, 0x16, 0xe9, 0xd4, 0x5b, 0x58, 0xd7, 0x5a, 0x0e, 0xbd, 0x84, 0x5c,
+	0x0d, 0x74, 0x43, 0xeb, 0x34, 0xd2, 0x77, 0xd8, 0x43, 0x57, 0x3e, 0xec, 0x4f, 0xfe, 0xdf, 0x41,
+	0x3f, 0x55, 0xae, 0xc4, 0x33, 0x30, 0x82, 0x0d, 0xc7, 0xd2, 0xe9, 0xfb, 0x77, 0x5f, 0x67, 0x29,
+	0xed, 0x75, 0x78, 0x87, 0xc7, 0x86, 0x63, 0x79, 0x5c, 0x17, 0xbf, 0xc5, 0xb5, 0xdd, 0x77, 0xe5,
+	0xe7, 0x08, 0xf8, 0x93, 0xd2, 0x86, 0xef, 0x04, 0x30, 0x1a, 0x38, 0x0a, 0x94, 0x13, 0xd9, 0xc5,
+	0xda, 0xb5, 0x88, 0x2e, 0x8d, 0x67, 0x24, 0x24, 0xf4, 0xea, 0xcb, 0xf9, 0xdb, 0xec, 0x4d, 0x58,
+	0x46, 0xa9, 0xff, 0x2a, 0xd0, 0x81, 0xae, 0x1e, 0xc2, 0xf7, 0x02, 0xc8, 0x45, 0x4c, 0x13, 0x56,
+	0x2e, 0x9e, 0x19, 0xe7, 0xe3, 0xe2, 0xca, 0x40, 0x35, 0x9c, 0xeb, 0x22, 0xe5, 0x3a, 0x07, 0xa5,
+	0x74, 0xae, 0xf0, 0x83, 0x00, 0xc6, 0xc3, 0x7e, 0x08, 0x97, 0x53, 0x94, 0xf9, 0xdd, 0x58, 0xc5,
+	0xca, 0x20, 0x25, 0x9c, 0xa3, 0x4c, 0x39, 0x2e, 0xc0, 0xf9, 0x64, 0x8e, 0x61, 0xef, 0x84, 0x47,
+	0x02, 0xc8, 0x47, 0x2d, 0x0b, 0xae, 0x5c, 0x6a, 0x6c, 0xd4, 0xff, 0xc4, 0xea, 0x60, 0x45, 0x9c,
+	0xed, 0x1d, 0xca, 0x76, 0x11, 0x2e, 0xa4, 0xb2, 0xe5, 0xf6, 0x06, 0x5f, 0x0b, 0x60, 0x98, 0x79,
+	0x04, 0xbc, 0x95, 0x32, 0x32, 0x6c, 0x4c, 0xe2, 0xd2, 0xe5, 0xc0, 0x9c, 0x57, 0x99, 0xf2, 0x9a,
+	0x85, 0x25, 0x74, 0xf1, 0xcf, 0x1c, 0xf8, 0x59, 0x00, 0xf9, 0xe8, 0x9d, 0xa6, 0xc9, 0x17, 0x6b,
+	0x61, 0x62, 0x75, 0xb0, 0x22, 0x4e, 0xf3, 0x21, 0xa5, 0x79, 0x0f, 0xde, 0x4d, 0xa4, 0xb9, 0xc3,
+	0x0a, 0xeb, 0x2d, 0x56, 0x89, 0x0e, 0xc2, 0x46, 0x79, 0xb8, 0xba, 0x7d, 0x7c, 0x5a, 0x14, 0x4e,
+	0x4e, 0x8b, 0xc2, 0xf7, 0xd3, 0xa2, 0xf0, 0xe6, 0xac, 0x98, 0x39, 0x39, 0x2b, 0x66, 0xbe, 0x9e,
+	0x15, 0x33, 0x2f, 0xee, 0x6b, 0xba, 0xd3, 0x72, 0x1b, 0x72, 0x93, 0x74, 0xa2, 0xcd, 0xf7, 0xaa,
+	0xb7, 0x9b, 0x2d, 0x45, 0x37, 0x50, 0x10, 0xd9, 0x0f, 0x0f, 0x74, 0x3c, 0x13, 0xdb, 0x8d, 0x61,
+	0x9a, 0x5c, 0xf9, 0x35, 0x00, 0x99, 0x92, 0x78, 0x5d, 0xc4, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -550,6 +705,8 @@ type QueryClient interface {
 	PremiumSamples(ctx context.Context, in *QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*QueryPremiumSamplesResponse, error)
 	// Queries the perpetual params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the funding history of a perpetual.
+	FundingHistory(ctx context.Context, in *QueryFundingHistoryRequest, opts ...grpc.CallOption) (*QueryFundingHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -605,6 +762,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FundingHistory(ctx context.Context, in *QueryFundingHistoryRequest, opts ...grpc.CallOption) (*QueryFundingHistoryResponse, error) {
+	out := new(QueryFundingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/FundingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a Perpetual by id.
@@ -617,6 +783,8 @@ type QueryServer interface {
 	PremiumSamples(context.Context, *QueryPremiumSamplesRequest) (*QueryPremiumSamplesResponse, error)
 	// Queries the perpetual params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the funding history of a perpetual.
+	FundingHistory(context.Context, *QueryFundingHistoryRequest) (*QueryFundingHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -638,6 +806,9 @@ func (*UnimplementedQueryServer) PremiumSamples(ctx context.Context, req *QueryP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) FundingHistory(ctx context.Context, req *QueryFundingHistoryRequest) (*QueryFundingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -733,6 +904,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FundingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/FundingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingHistory(ctx, req.(*QueryFundingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.perpetuals.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -757,6 +946,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "FundingHistory",
+			Handler:    _Query_FundingHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/perpetuals/query.proto",
@@ -950,6 +1143,20 @@ func (m *QueryPremiumVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.PremiumVotesBySchedule) > 0 {
+		for iNdEx := len(m.PremiumVotesBySchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PremiumVotesBySchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.PremiumVotes.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1006,6 +1213,20 @@ func (m *QueryPremiumSamplesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.PremiumSamplesBySchedule) > 0 {
+		for iNdEx := len(m.PremiumSamplesBySchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PremiumSamplesBySchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.PremiumSamples.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1075,6 +1296,100 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFundingHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1158,6 +1473,12 @@ func (m *QueryPremiumVotesResponse) Size() (n int) {
 	_ = l
 	l = m.PremiumVotes.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PremiumVotesBySchedule) > 0 {
+		for _, e := range m.PremiumVotesBySchedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1178,6 +1499,12 @@ func (m *QueryPremiumSamplesResponse) Size() (n int) {
 	_ = l
 	l = m.PremiumSamples.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PremiumSamplesBySchedule) > 0 {
+		for _, e := range m.PremiumSamplesBySchedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1201,6 +1528,44 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFundingHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundingHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1677,21 +2042,55 @@ func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumVotesBySchedule", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PremiumVotesBySchedule = append(m.PremiumVotesBySchedule, FundingSchedulePremiumStore{})
+			if err := m.PremiumVotesBySchedule[len(m.PremiumVotesBySchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -1810,6 +2209,40 @@ func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumSamplesBySchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PremiumSamplesBySchedule = append(m.PremiumSamplesBySchedule, FundingSchedulePremiumStore{})
+			if err := m.PremiumSamplesBySchedule[len(m.PremiumSamplesBySchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1964,6 +2397,250 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFundingHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, FundingHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -76,6 +76,11 @@ type PerpetualsKeeper interface {
 		atomicResolution int32,
 		defaultFundingPpm int32,
 		liquidityTier uint32,
+		openInterestCapNotional uint64,
+		fundingRateBounds *FundingRateBounds,
+		interestRatePpm int32,
+		fundingTickEpoch string,
+		fundingSampleEpoch string,
 	) (Perpetual, error)
 	ModifyPerpetual(
 		ctx sdk.Context,
@@ -84,6 +89,11 @@ type PerpetualsKeeper interface {
 		marketId uint32,
 		defaultFundingPpm int32,
 		liquidityTier uint32,
+		openInterestCapNotional uint64,
+		fundingRateBounds *FundingRateBounds,
+		interestRatePpm int32,
+		fundingTickEpoch string,
+		fundingSampleEpoch string,
 	) (Perpetual, error)
 	SetLiquidityTier(
 		ctx sdk.Context,
@@ -93,6 +103,7 @@ type PerpetualsKeeper interface {
 		maintenanceFractionPpm uint32,
 		basePositionNotional uint64,
 		impactNotional uint64,
+		openInterestCapNotional uint64,
 	) (
 		liquidityTier LiquidityTier,
 		err error,
@@ -28,6 +28,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateTransfer())
 	cmd.AddCommand(CmdDepositToSubaccount())
 	cmd.AddCommand(CmdWithdrawFromSubaccount())
+	cmd.AddCommand(CmdTransferIsolatedCollateral())
 
 	return cmd
 }
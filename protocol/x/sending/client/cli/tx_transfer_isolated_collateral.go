@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// CmdTransferIsolatedCollateral initiates a transfer between the cross-margined collateral
+// of an `x/subaccounts` subaccount and one of its isolated perpetual positions.
+func CmdTransferIsolatedCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "transfer-isolated-collateral [owner_key_or_address] [subaccount_number] " +
+			"[perpetual_id] [quantums]",
+		Short: "Transfer collateral to or from an isolated perpetual position of a subaccount.",
+		Long: `Transfer collateral to or from an isolated perpetual position of a subaccount.
+Note, the '--from' flag is ignored as it is implied from [owner_key_or_address].
+[owner_key_or_address] and [subaccount_number] together specify the subaccount.
+[perpetual_id] specifies the perpetual of the isolated position.
+[quantums] specifies the amount to transfer. Positive amounts are transferred to the isolated
+position and negative amounts are transferred back to the cross-margined collateral. Use '--'
+before the arguments to pass a negative amount.
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+			err = cmd.Flags().Set(flags.FlagFrom, argOwner)
+			if err != nil {
+				return err
+			}
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argPerpetualId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argAmount, err := cast.ToInt64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferIsolatedCollateral(
+				satypes.SubaccountId{
+					Owner:  clientCtx.GetFromAddress().String(),
+					Number: argNumber,
+				},
+				argPerpetualId,
+				argAmount,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -100,6 +100,34 @@ func (k msgServer) WithdrawFromSubaccount(
 	return &types.MsgWithdrawFromSubaccountResponse{}, nil
 }
 
+// TransferIsolatedCollateral initiates a transfer between the cross-margined collateral of an
+// `x/subaccounts` subaccount and one of its isolated perpetual positions.
+func (k msgServer) TransferIsolatedCollateral(
+	goCtx context.Context,
+	msg *types.MsgTransferIsolatedCollateral,
+) (*types.MsgTransferIsolatedCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Process the transfer by applying the subaccount update.
+	err := k.Keeper.ProcessTransferIsolatedCollateral(ctx, msg)
+	if err != nil {
+		telemetry.IncrCounter(1, types.ModuleName, metrics.ProcessTransferIsolatedCollateral, metrics.Error)
+		return nil, err
+	}
+	telemetry.IncrCounter(1, types.ModuleName, metrics.ProcessTransferIsolatedCollateral, metrics.Success)
+
+	// emit transfer_isolated_collateral event
+	ctx.EventManager().EmitEvent(
+		types.NewTransferIsolatedCollateralEvent(
+			msg.SubaccountId,
+			msg.PerpetualId,
+			msg.Quantums,
+		),
+	)
+
+	return &types.MsgTransferIsolatedCollateralResponse{}, nil
+}
+
 // SendFromModuleToAccount sends coins from a module to an account.
 func (k msgServer) SendFromModuleToAccount(
 	goCtx context.Context,
@@ -24,7 +24,8 @@ type MsgServerTransferTestCase struct {
 }
 
 func createMsgServerTransferTestCases[
-	T *types.Transfer | *types.MsgDepositToSubaccount | *types.MsgWithdrawFromSubaccount,
+	T *types.Transfer | *types.MsgDepositToSubaccount | *types.MsgWithdrawFromSubaccount |
+		*types.MsgTransferIsolatedCollateral,
 ](
 	mockMethodName string,
 	msg T,
@@ -258,6 +259,62 @@ func TestWithdrawFromSubaccount(t *testing.T) {
 	}
 }
 
+func TestTransferIsolatedCollateral(t *testing.T) {
+	msg := constants.MsgTransferIsolatedCollateral_Alice_Num0_To_Btc_500
+	tests := createMsgServerTransferTestCases("ProcessTransferIsolatedCollateral", &msg)
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockKeeper, msgServer, goCtx := setUpTestCase(t, tc)
+
+			if tc.shouldPanic {
+				// Call TransferIsolatedCollateral.
+				require.PanicsWithValue(t, tc.expectedErr.Error(), func() {
+					//nolint:errcheck
+					msgServer.TransferIsolatedCollateral(goCtx, &msg)
+				})
+			} else {
+				// Call TransferIsolatedCollateral.
+				resp, err := msgServer.TransferIsolatedCollateral(goCtx, &msg)
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
+				} else {
+					require.NoError(t, err)
+					require.NotNil(t, resp)
+
+					ctx := sdk.UnwrapSDKContext(goCtx)
+					require.Len(t, ctx.EventManager().Events(), 1)
+					event := ctx.EventManager().Events()[0]
+					require.Equal(t, event.Type, types.EventTypeTransferIsolatedCollateral)
+					require.Equal(t, event.Attributes, []abci.EventAttribute{
+						{
+							Key:   types.AttributeKeySender,
+							Value: msg.SubaccountId.Owner,
+						},
+						{
+							Key:   types.AttributeKeySenderNumber,
+							Value: fmt.Sprintf("%d", msg.SubaccountId.Number),
+						},
+						{
+							Key:   types.AttributeKeyPerpetualId,
+							Value: fmt.Sprintf("%d", msg.PerpetualId),
+						},
+						{
+							Key:   types.AttributeKeyQuantums,
+							Value: fmt.Sprintf("%d", msg.Quantums),
+						},
+					})
+				}
+			}
+
+			// Assert mock expectations.
+			result := mockKeeper.AssertExpectations(t)
+			require.True(t, result)
+		})
+	}
+}
+
 func TestMsgServerSendFromModuleToAccount(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
@@ -206,6 +206,40 @@ func (k Keeper) GenerateWithdrawEvent(withdraw *types.MsgWithdrawFromSubaccount)
 	)
 }
 
+// ProcessTransferIsolatedCollateral transfers quote balance between the cross-margined collateral of a
+// subaccount and the collateral allotted to one of its isolated perpetual positions.
+func (k Keeper) ProcessTransferIsolatedCollateral(
+	ctx sdk.Context,
+	msgTransferIsolatedCollateral *types.MsgTransferIsolatedCollateral,
+) (err error) {
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.ProcessTransferIsolatedCollateral,
+		metrics.Latency,
+	)
+
+	updates := []satypes.Update{
+		{
+			SubaccountId: msgTransferIsolatedCollateral.SubaccountId,
+			IsolatedCollateralUpdates: []satypes.IsolatedCollateralUpdate{
+				{
+					PerpetualId:      msgTransferIsolatedCollateral.PerpetualId,
+					BigQuantumsDelta: big.NewInt(msgTransferIsolatedCollateral.Quantums),
+				},
+			},
+		},
+	}
+
+	success, successPerUpdate, err := k.subaccountsKeeper.UpdateSubaccounts(ctx, updates)
+	if err != nil {
+		return err
+	}
+
+	// If not successful, return error indicating why.
+	return satypes.GetErrorFromUpdateResults(success, successPerUpdate, updates)
+}
+
 // SendFromModuleToAccount sends coins from a module account to an `x/bank` recipient.
 func (k Keeper) SendFromModuleToAccount(
 	ctx sdk.Context,
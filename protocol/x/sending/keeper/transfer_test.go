@@ -133,6 +133,11 @@ func runProcessTransferTest(t *testing.T, tc TransferTestCase) {
 			p.Params.AtomicResolution,
 			p.Params.DefaultFundingPpm,
 			p.Params.LiquidityTier,
+			p.Params.OpenInterestCapNotional,
+			p.Params.FundingRateBounds,
+			p.Params.InterestRatePpm,
+			p.Params.FundingTickEpoch,
+			p.Params.FundingSampleEpoch,
 		)
 		require.NoError(t, err)
 	}
@@ -259,6 +264,11 @@ func TestProcessTransfer_CreateRecipientAccount(t *testing.T) {
 			p.Params.AtomicResolution,
 			p.Params.DefaultFundingPpm,
 			p.Params.LiquidityTier,
+			p.Params.OpenInterestCapNotional,
+			p.Params.FundingRateBounds,
+			p.Params.InterestRatePpm,
+			p.Params.FundingTickEpoch,
+			p.Params.FundingSampleEpoch,
 		)
 		require.NoError(t, err)
 	}
@@ -293,6 +303,90 @@ func TestProcessTransfer_CreateRecipientAccount(t *testing.T) {
 	require.True(t, ks.AccountKeeper.HasAccount(ks.Ctx, recipientAddr))
 }
 
+func TestProcessTransferIsolatedCollateral(t *testing.T) {
+	tests := map[string]struct {
+		msg                           types.MsgTransferIsolatedCollateral
+		expectedUsdcBalance           *big.Int
+		expectedIsolatedQuoteBalances []*big.Int
+		expectedErr                   string
+	}{
+		"Transfer into isolated margin succeeds": {
+			msg: types.MsgTransferIsolatedCollateral{
+				SubaccountId: constants.Carl_Num0,
+				PerpetualId:  0,
+				Quantums:     500_000_000,
+			},
+			expectedUsdcBalance:           big.NewInt(599_000_000), // balance unchanged
+			expectedIsolatedQuoteBalances: []*big.Int{big.NewInt(500_000_000)},
+		},
+		"Subaccount does not have sufficient cross collateral": {
+			msg: types.MsgTransferIsolatedCollateral{
+				SubaccountId: constants.Carl_Num0,
+				PerpetualId:  0,
+				Quantums:     600_000_000,
+			},
+			expectedUsdcBalance: big.NewInt(599_000_000), // balance unchanged
+			expectedErr: fmt.Sprintf(
+				"Subaccount with id %v failed with UpdateResult: NewlyUndercollateralized: failed to apply subaccount updates",
+				constants.Carl_Num0,
+			),
+		},
+		"Transfer out of perpetual without isolated collateral fails": {
+			msg: types.MsgTransferIsolatedCollateral{
+				SubaccountId: constants.Carl_Num0,
+				PerpetualId:  0,
+				Quantums:     -500_000_000,
+			},
+			expectedUsdcBalance: big.NewInt(599_000_000), // balance unchanged
+			expectedErr: fmt.Sprintf(
+				"Subaccount with id %v failed with UpdateResult: NewlyUndercollateralized: failed to apply subaccount updates",
+				constants.Carl_Num0,
+			),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ks := keepertest.SendingKeepers(t)
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+
+			p := constants.BtcUsd_100PercentMarginRequirement
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.OpenInterestCapNotional,
+				p.Params.FundingRateBounds,
+				p.Params.InterestRatePpm,
+				p.Params.FundingTickEpoch,
+				p.Params.FundingSampleEpoch,
+			)
+			require.NoError(t, err)
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, constants.Carl_Num0_599USD)
+
+			err = ks.SendingKeeper.ProcessTransferIsolatedCollateral(ks.Ctx, &tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			subaccount := ks.SubaccountsKeeper.GetSubaccount(ks.Ctx, tc.msg.SubaccountId)
+			require.Equal(t, tc.expectedUsdcBalance, subaccount.GetUsdcPosition())
+			require.Len(t, subaccount.IsolatedMargins, len(tc.expectedIsolatedQuoteBalances))
+			for i, expected := range tc.expectedIsolatedQuoteBalances {
+				require.Equal(t, expected, subaccount.IsolatedMargins[i].GetBigQuoteBalance())
+			}
+		})
+	}
+}
+
 func TestProcessDepositToSubaccount(t *testing.T) {
 	testError := errors.New("error")
 
@@ -92,7 +92,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	mockRegistry.On("RegisterImplementations", (*sdk.Msg)(nil), mock.Anything).Return()
 	mockRegistry.On("RegisterImplementations", (*tx.MsgResponse)(nil), mock.Anything).Return()
 	am.RegisterInterfaces(mockRegistry)
-	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 8)
+	mockRegistry.AssertNumberOfCalls(t, "RegisterImplementations", 10)
 	mockRegistry.AssertExpectations(t)
 }
 
@@ -166,10 +166,11 @@ func TestAppModuleBasic_GetTxCmd(t *testing.T) {
 
 	cmd := am.GetTxCmd()
 	require.Equal(t, "sending", cmd.Use)
-	require.Equal(t, 3, len(cmd.Commands()))
+	require.Equal(t, 4, len(cmd.Commands()))
 	require.Equal(t, "create-transfer", cmd.Commands()[0].Name())
 	require.Equal(t, "deposit-to-subaccount", cmd.Commands()[1].Name())
-	require.Equal(t, "withdraw-from-subaccount", cmd.Commands()[2].Name())
+	require.Equal(t, "transfer-isolated-collateral", cmd.Commands()[2].Name())
+	require.Equal(t, "withdraw-from-subaccount", cmd.Commands()[3].Name())
 }
 
 func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
@@ -9,9 +9,10 @@ import (
 
 // sending module event types
 const (
-	EventTypeCreateTransfer         = "create_transfer"
-	EventTypeDepositToSubaccount    = "deposit_to_subaccount"
-	EventTypeWithdrawFromSubaccount = "withdraw_from_subaccount"
+	EventTypeCreateTransfer             = "create_transfer"
+	EventTypeDepositToSubaccount        = "deposit_to_subaccount"
+	EventTypeWithdrawFromSubaccount     = "withdraw_from_subaccount"
+	EventTypeTransferIsolatedCollateral = "transfer_isolated_collateral"
 
 	AttributeKeySender          = "sender"
 	AttributeKeySenderNumber    = "sender_number"
@@ -19,6 +20,7 @@ const (
 	AttributeKeyRecipientNumber = "recipient_number"
 	AttributeKeyQuantums        = "quantums"
 	AttributeKeyAssetId         = "asset_id"
+	AttributeKeyPerpetualId     = "perpetual_id"
 )
 
 // NewCreateTransferEvent constructs a new create_transfer sdk.Event
@@ -72,3 +74,18 @@ func NewWithdrawFromSubaccountEvent(
 		sdk.NewAttribute(AttributeKeyQuantums, fmt.Sprintf("%d", quantums)),
 	)
 }
+
+// NewTransferIsolatedCollateralEvent constructs a new transfer_isolated_collateral sdk.Event
+func NewTransferIsolatedCollateralEvent(
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	quantums int64,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeTransferIsolatedCollateral,
+		sdk.NewAttribute(AttributeKeySender, subaccountId.Owner),
+		sdk.NewAttribute(AttributeKeySenderNumber, fmt.Sprintf("%d", subaccountId.Number)),
+		sdk.NewAttribute(AttributeKeyPerpetualId, fmt.Sprintf("%d", perpetualId)),
+		sdk.NewAttribute(AttributeKeyQuantums, fmt.Sprintf("%d", quantums)),
+	)
+}
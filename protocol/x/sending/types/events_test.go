@@ -109,3 +109,30 @@ func TestNewWithdrawFromSubaccountEvent(t *testing.T) {
 		},
 	})
 }
+
+func TestNewTransferIsolatedCollateralEvent(t *testing.T) {
+	subaccountId := constants.Alice_Num1
+	perpetualId := uint32(1)
+	quantums := int64(-100000000)
+
+	event := types.NewTransferIsolatedCollateralEvent(subaccountId, perpetualId, quantums)
+	require.Equal(t, event.Type, types.EventTypeTransferIsolatedCollateral)
+	require.Equal(t, event.Attributes, []abci.EventAttribute{
+		{
+			Key:   types.AttributeKeySender,
+			Value: "dydx199tqg4wdlnu4qjlxchpd7seg454937hjrknju4",
+		},
+		{
+			Key:   types.AttributeKeySenderNumber,
+			Value: "1",
+		},
+		{
+			Key:   types.AttributeKeyPerpetualId,
+			Value: "1",
+		},
+		{
+			Key:   types.AttributeKeyQuantums,
+			Value: "-100000000",
+		},
+	})
+}
@@ -3,7 +3,6 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
-	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -49,11 +48,6 @@ func (msg *MsgDepositToSubaccount) ValidateBasic() error {
 		return err
 	}
 
-	// Validate that asset is USDC.
-	if msg.AssetId != assettypes.AssetUsdc.Id {
-		return ErrNonUsdcAssetTransferNotImplemented
-	}
-
 	// Validate that quantums is not zero.
 	if msg.Quantums == lib.ZeroUint64 {
 		return ErrInvalidTransferAmount
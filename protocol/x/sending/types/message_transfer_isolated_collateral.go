@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var _ sdk.Msg = &MsgTransferIsolatedCollateral{}
+
+// NewMsgTransferIsolatedCollateral constructs a `MsgTransferIsolatedCollateral` from an
+// `x/subaccounts` subaccount, a perpetual ID, and a signed number of quantums.
+func NewMsgTransferIsolatedCollateral(
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	quantums int64,
+) *MsgTransferIsolatedCollateral {
+	return &MsgTransferIsolatedCollateral{
+		SubaccountId: subaccountId,
+		PerpetualId:  perpetualId,
+		Quantums:     quantums,
+	}
+}
+
+// GetSigners specifies that the owner of the subaccount must sign.
+func (msg *MsgTransferIsolatedCollateral) GetSigners() []sdk.AccAddress {
+	// Get address of the subaccount's account address.
+	owner, err := sdk.AccAddressFromBech32(msg.SubaccountId.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic runs validation on the fields of a MsgTransferIsolatedCollateral.
+func (msg *MsgTransferIsolatedCollateral) ValidateBasic() error {
+	// Validate subaccount.
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	// Validate that quantums is not zero.
+	if msg.Quantums == 0 {
+		return ErrInvalidTransferAmount
+	}
+
+	return nil
+}
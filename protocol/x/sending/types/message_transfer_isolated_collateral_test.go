@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferIsolatedCollateral_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg types.MsgTransferIsolatedCollateral
+		err error
+	}{
+		"Valid - transfer to isolated position": {
+			msg: *types.NewMsgTransferIsolatedCollateral(constants.Alice_Num0, 0, 500),
+		},
+		"Valid - transfer from isolated position": {
+			msg: *types.NewMsgTransferIsolatedCollateral(constants.Alice_Num0, 0, -500),
+		},
+		"Invalid subaccount owner": {
+			msg: types.MsgTransferIsolatedCollateral{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  "invalid_owner",
+					Number: uint32(0),
+				},
+				Quantums: 500,
+			},
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"Invalid subaccount number": {
+			msg: types.MsgTransferIsolatedCollateral{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  constants.AliceAccAddress.String(),
+					Number: uint32(128),
+				},
+				Quantums: 500,
+			},
+			err: satypes.ErrInvalidSubaccountIdNumber,
+		},
+		"Invalid quantums": {
+			msg: *types.NewMsgTransferIsolatedCollateral(constants.Alice_Num0, 0, 0),
+			err: types.ErrInvalidTransferAmount,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -248,6 +248,76 @@ func (m *MsgWithdrawFromSubaccount) GetQuantums() uint64 {
 	return 0
 }
 
+// MsgTransferIsolatedCollateral represents a single transfer of USDC between
+// the cross-margined collateral of an `x/subaccounts` subaccount and the
+// collateral allotted to one of its isolated-margin perpetual positions.
+// Transferring collateral to a perpetual that is not isolated yet makes the
+// subaccount's future positions in the perpetual isolated.
+type MsgTransferIsolatedCollateral struct {
+	// The subaccount ID.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// Id of the perpetual of the isolated position.
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The number of quantums of USDC to transfer. Positive values transfer
+	// collateral to the isolated position, negative values transfer it back
+	// to the cross-margined collateral.
+	Quantums int64 `protobuf:"zigzag64,3,opt,name=quantums,proto3" json:"quantums,omitempty"`
+}
+
+func (m *MsgTransferIsolatedCollateral) Reset()         { *m = MsgTransferIsolatedCollateral{} }
+func (m *MsgTransferIsolatedCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferIsolatedCollateral) ProtoMessage()    {}
+func (*MsgTransferIsolatedCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ef1d018df19de71, []int{3}
+}
+func (m *MsgTransferIsolatedCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferIsolatedCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferIsolatedCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferIsolatedCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferIsolatedCollateral.Merge(m, src)
+}
+func (m *MsgTransferIsolatedCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferIsolatedCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferIsolatedCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferIsolatedCollateral proto.InternalMessageInfo
+
+func (m *MsgTransferIsolatedCollateral) GetSubaccountId() types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return types.SubaccountId{}
+}
+
+func (m *MsgTransferIsolatedCollateral) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *MsgTransferIsolatedCollateral) GetQuantums() int64 {
+	if m != nil {
+		return m.Quantums
+	}
+	return 0
+}
+
 // MsgSendFromModuleToAccount represents a single transfer from a module
 // to an `x/bank` account (can be either a module account address or a user
 // account address).
@@ -267,7 +337,7 @@ func (m *MsgSendFromModuleToAccount) Reset()         { *m = MsgSendFromModuleToA
 func (m *MsgSendFromModuleToAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgSendFromModuleToAccount) ProtoMessage()    {}
 func (*MsgSendFromModuleToAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ef1d018df19de71, []int{3}
+	return fileDescriptor_6ef1d018df19de71, []int{4}
 }
 func (m *MsgSendFromModuleToAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,6 +398,7 @@ func init() {
 	proto.RegisterType((*Transfer)(nil), "dydxprotocol.sending.Transfer")
 	proto.RegisterType((*MsgDepositToSubaccount)(nil), "dydxprotocol.sending.MsgDepositToSubaccount")
 	proto.RegisterType((*MsgWithdrawFromSubaccount)(nil), "dydxprotocol.sending.MsgWithdrawFromSubaccount")
+	proto.RegisterType((*MsgTransferIsolatedCollateral)(nil), "dydxprotocol.sending.MsgTransferIsolatedCollateral")
 	proto.RegisterType((*MsgSendFromModuleToAccount)(nil), "dydxprotocol.sending.MsgSendFromModuleToAccount")
 }
 
@@ -336,40 +407,44 @@ func init() {
 }
 
 var fileDescriptor_6ef1d018df19de71 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x6d, 0x88, 0xc9, 0x04, 0x45, 0x96, 0x50, 0x37, 0x39, 0xac, 0x21, 0x82, 0x44,
-	0xb1, 0xbb, 0xa6, 0x95, 0x82, 0xbd, 0x35, 0x16, 0xa1, 0x42, 0x3c, 0x24, 0x01, 0xc1, 0x4b, 0x98,
-	0xdd, 0x19, 0x27, 0x03, 0x9d, 0x99, 0x38, 0x33, 0x1b, 0x9b, 0xab, 0x9f, 0xc0, 0x8f, 0xe2, 0xc1,
-	0x0f, 0xd1, 0x9b, 0xc1, 0x93, 0x78, 0x10, 0x49, 0x0e, 0x7e, 0x0c, 0x65, 0x77, 0x87, 0x6c, 0xf6,
-	0x54, 0xb1, 0xd0, 0xd3, 0xce, 0x9b, 0xff, 0x7b, 0x6f, 0xde, 0xef, 0x3d, 0xde, 0xc2, 0x07, 0x78,
-	0x81, 0x2f, 0x66, 0x4a, 0x1a, 0x19, 0xc9, 0xf3, 0x40, 0x13, 0x81, 0x99, 0xa0, 0x81, 0x51, 0x48,
-	0xe8, 0x77, 0x44, 0xf9, 0xa9, 0xe2, 0x34, 0xb6, 0x9d, 0x7c, 0xeb, 0xd4, 0x6a, 0x46, 0x52, 0x73,
-	0xa9, 0x27, 0xa9, 0x10, 0x64, 0x46, 0x16, 0xd0, 0xf2, 0x32, 0x2b, 0x08, 0x91, 0x26, 0xc1, 0xbc,
-	0x17, 0x12, 0x83, 0x7a, 0x41, 0x24, 0x99, 0xb0, 0xfa, 0x3d, 0xab, 0x73, 0x4d, 0x83, 0x79, 0x2f,
-	0xf9, 0x58, 0xa1, 0x41, 0x25, 0x95, 0x59, 0xc2, 0xe4, 0x64, 0x6f, 0x1f, 0x15, 0x8b, 0x8c, 0x43,
-	0x14, 0x45, 0x32, 0x16, 0x46, 0x6f, 0x9d, 0x33, 0xd7, 0xce, 0x57, 0x00, 0xab, 0x63, 0x5b, 0xbd,
-	0x73, 0x0a, 0x2b, 0x49, 0xb1, 0x44, 0xb9, 0xa0, 0x0d, 0xba, 0xf5, 0x83, 0x87, 0x7e, 0x11, 0x24,
-	0x4f, 0xe4, 0x8f, 0x36, 0xe7, 0x33, 0xdc, 0x2f, 0x5f, 0xfe, 0xbc, 0x5f, 0x1a, 0xda, 0x58, 0xe7,
-	0x15, 0xac, 0x29, 0x12, 0xb1, 0x19, 0x23, 0xc2, 0xb8, 0x3b, 0xff, 0x91, 0x28, 0x0f, 0x77, 0x9a,
-	0xb0, 0x8a, 0xb4, 0x26, 0x66, 0xc2, 0xb0, 0xbb, 0xdb, 0x06, 0xdd, 0xdb, 0xc3, 0x5b, 0xa9, 0x7d,
-	0x86, 0x9d, 0x3d, 0x58, 0x41, 0x3c, 0x89, 0x73, 0xcb, 0x6d, 0xd0, 0x2d, 0x0f, 0xad, 0xd5, 0xf9,
-	0x01, 0xe0, 0xde, 0x40, 0xd3, 0x53, 0x32, 0x93, 0x9a, 0x99, 0xb1, 0xcc, 0x1f, 0x70, 0x9e, 0x16,
-	0xf8, 0x6a, 0x7d, 0xf7, 0xdb, 0x97, 0xfd, 0x86, 0x1d, 0xc4, 0x09, 0xc6, 0x8a, 0x68, 0x3d, 0x32,
-	0x8a, 0x09, 0x7a, 0xd3, 0x2c, 0x2d, 0x58, 0x7d, 0x1f, 0x23, 0x61, 0x62, 0xae, 0x2d, 0xcd, 0xc6,
-	0x3e, 0xae, 0x7f, 0xfc, 0xfd, 0xf9, 0xb1, 0xad, 0xa7, 0xb3, 0x04, 0xb0, 0x39, 0xd0, 0xf4, 0x0d,
-	0x33, 0x53, 0xac, 0xd0, 0x87, 0x97, 0x4a, 0xf2, 0x2d, 0xbe, 0x7c, 0x7e, 0x3b, 0xd7, 0x98, 0xdf,
-	0xd1, 0x36, 0xf3, 0x55, 0x8d, 0xba, 0x36, 0x5f, 0xe7, 0x0f, 0x80, 0xad, 0x81, 0xa6, 0x23, 0x22,
-	0x70, 0x82, 0x33, 0x90, 0x38, 0x3e, 0x27, 0x63, 0x79, 0x62, 0x99, 0x8e, 0x60, 0x0d, 0xc5, 0x66,
-	0x2a, 0x15, 0x33, 0x8b, 0xab, 0xab, 0xd9, 0xb8, 0x3a, 0x4f, 0xa0, 0x93, 0xf1, 0x4c, 0x78, 0x9a,
-	0x71, 0x22, 0x10, 0x27, 0x69, 0x5f, 0x6a, 0xc3, 0xbb, 0x99, 0x92, 0x3d, 0xf5, 0x1a, 0x71, 0x52,
-	0x64, 0xde, 0xfd, 0x77, 0xe6, 0x43, 0x58, 0x4e, 0xd6, 0x34, 0x85, 0xaa, 0x1f, 0x34, 0x7d, 0xeb,
-	0x9f, 0xec, 0xb1, 0x6f, 0xf7, 0xd8, 0x7f, 0x21, 0x99, 0xb0, 0x2d, 0x4e, 0x9d, 0x8f, 0xef, 0x24,
-	0x13, 0xcd, 0x4b, 0xed, 0x8f, 0x2e, 0x57, 0x1e, 0x58, 0xae, 0x3c, 0xf0, 0x6b, 0xe5, 0x81, 0x4f,
-	0x6b, 0xaf, 0xb4, 0x5c, 0x7b, 0xa5, 0xef, 0x6b, 0xaf, 0xf4, 0xf6, 0x39, 0x65, 0x66, 0x1a, 0x87,
-	0x7e, 0x24, 0x79, 0x50, 0xd8, 0xe9, 0xf9, 0xb3, 0xfd, 0x68, 0x8a, 0x98, 0x08, 0x36, 0x37, 0x17,
-	0xf9, 0xcf, 0x68, 0x31, 0x23, 0x3a, 0xac, 0xa4, 0xca, 0xe1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x16, 0x70, 0xd0, 0xce, 0xb1, 0x04, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xb4, 0xa1, 0x36, 0x93, 0x56, 0x64, 0x29, 0x35, 0x09, 0xb8, 0xc6, 0x08, 0x12, 0xc5,
+	0xee, 0x9a, 0x56, 0x0a, 0xf6, 0xd6, 0xb4, 0x08, 0x11, 0x22, 0x98, 0x04, 0x04, 0x2f, 0x61, 0xb2,
+	0x33, 0x6e, 0x06, 0x76, 0x67, 0xd6, 0x99, 0xd9, 0xd8, 0x5c, 0xfd, 0x05, 0xfe, 0x14, 0x05, 0x7f,
+	0x44, 0x6f, 0x06, 0x4f, 0xe2, 0x41, 0x24, 0x39, 0xf8, 0x33, 0x94, 0xd9, 0x1d, 0xb3, 0x1b, 0x2f,
+	0x95, 0x16, 0x3c, 0xed, 0xbc, 0xf7, 0xbd, 0xf7, 0xf6, 0xfb, 0xe6, 0x1b, 0x1e, 0xbc, 0x8b, 0xa7,
+	0xf8, 0x2c, 0x12, 0x5c, 0x71, 0x8f, 0x07, 0xae, 0x24, 0x0c, 0x53, 0xe6, 0xbb, 0x4a, 0x20, 0x26,
+	0x5f, 0x13, 0xe1, 0x24, 0x88, 0xb5, 0x93, 0x2f, 0x72, 0x4c, 0x51, 0xad, 0xea, 0x71, 0x19, 0x72,
+	0x39, 0x4c, 0x00, 0x37, 0x0d, 0xd2, 0x86, 0x9a, 0x9d, 0x46, 0xee, 0x08, 0x49, 0xe2, 0x4e, 0x5a,
+	0x23, 0xa2, 0x50, 0xcb, 0xf5, 0x38, 0x65, 0x06, 0xbf, 0x69, 0xf0, 0x50, 0xfa, 0xee, 0xa4, 0xa5,
+	0x3f, 0x06, 0xd8, 0xf1, 0xb9, 0xcf, 0xd3, 0x81, 0xfa, 0x64, 0xb2, 0xf7, 0x57, 0x49, 0xc6, 0x23,
+	0xe4, 0x79, 0x3c, 0x66, 0x4a, 0xe6, 0xce, 0x69, 0x69, 0xe3, 0x33, 0x80, 0x9b, 0x03, 0xc3, 0xde,
+	0x3a, 0x85, 0x1b, 0x9a, 0x2c, 0x11, 0x15, 0x50, 0x07, 0xcd, 0xf2, 0xfe, 0x3d, 0x67, 0x55, 0x48,
+	0x36, 0xc8, 0xe9, 0x2f, 0xcf, 0x1d, 0xdc, 0x2e, 0x9e, 0x7f, 0xbf, 0x5d, 0xe8, 0x99, 0x5e, 0xeb,
+	0x19, 0x2c, 0x09, 0xe2, 0xd1, 0x88, 0x12, 0xa6, 0x2a, 0x6b, 0x97, 0x18, 0x94, 0xb5, 0x5b, 0x55,
+	0xb8, 0x89, 0xa4, 0x24, 0x6a, 0x48, 0x71, 0x65, 0xbd, 0x0e, 0x9a, 0xdb, 0xbd, 0x6b, 0x49, 0xdc,
+	0xc1, 0xd6, 0x2e, 0xdc, 0x40, 0xa1, 0xee, 0xab, 0x14, 0xeb, 0xa0, 0x59, 0xec, 0x99, 0xa8, 0xf1,
+	0x0d, 0xc0, 0xdd, 0xae, 0xf4, 0x4f, 0x49, 0xc4, 0x25, 0x55, 0x03, 0x9e, 0xfd, 0xc0, 0x7a, 0xb4,
+	0xa2, 0xaf, 0xd4, 0xae, 0x7c, 0xf9, 0xb4, 0xb7, 0x63, 0x8c, 0x38, 0xc6, 0x58, 0x10, 0x29, 0xfb,
+	0x4a, 0x50, 0xe6, 0xff, 0x6f, 0x2d, 0x35, 0xb8, 0xf9, 0x26, 0x46, 0x4c, 0xc5, 0xa1, 0x34, 0x6a,
+	0x96, 0xf1, 0x51, 0xf9, 0xdd, 0xcf, 0x0f, 0x0f, 0x0c, 0x9f, 0xc6, 0x0c, 0xc0, 0x6a, 0x57, 0xfa,
+	0x2f, 0xa9, 0x1a, 0x63, 0x81, 0xde, 0x3e, 0x15, 0x3c, 0xcc, 0xe9, 0xcb, 0xfc, 0x5b, 0xbb, 0x82,
+	0x7f, 0x87, 0x79, 0xcd, 0x17, 0x5d, 0xd4, 0x95, 0xf5, 0x35, 0x3e, 0x02, 0x78, 0xab, 0x2b, 0xfd,
+	0x3f, 0x8f, 0xb0, 0x23, 0x79, 0x80, 0x14, 0xc1, 0x27, 0x3c, 0xd0, 0x5f, 0x81, 0x02, 0xeb, 0x05,
+	0xdc, 0xce, 0xa8, 0xeb, 0xe9, 0x97, 0x79, 0x9d, 0x5b, 0x32, 0x97, 0xb3, 0xee, 0xc0, 0xad, 0x88,
+	0x88, 0x88, 0xa8, 0x18, 0x05, 0x7a, 0xe2, 0x5a, 0xc2, 0xb7, 0xbc, 0xcc, 0xfd, 0xc5, 0x59, 0xcb,
+	0xb1, 0x72, 0x9c, 0x7f, 0x01, 0x58, 0xeb, 0x4a, 0xbf, 0x4f, 0x18, 0xd6, 0x16, 0x74, 0x39, 0x8e,
+	0x03, 0x32, 0xe0, 0xc7, 0xc6, 0x87, 0x43, 0x58, 0x42, 0xb1, 0x1a, 0x73, 0x41, 0xd5, 0xf4, 0xe2,
+	0x1b, 0x5c, 0x96, 0x5a, 0x0f, 0xa1, 0x95, 0x7a, 0x30, 0x0c, 0x93, 0x89, 0x43, 0x86, 0x42, 0x92,
+	0x70, 0x2b, 0xf5, 0x6e, 0xa4, 0x48, 0xfa, 0xab, 0xe7, 0x28, 0x24, 0xab, 0x3e, 0xad, 0xff, 0xbb,
+	0x4f, 0x07, 0xb0, 0xa8, 0x57, 0x4b, 0x62, 0x44, 0x79, 0xbf, 0xea, 0x98, 0x7a, 0xbd, 0x7b, 0x1c,
+	0xb3, 0x7b, 0x9c, 0x13, 0x4e, 0x99, 0xb9, 0xb8, 0xa4, 0xf8, 0xe8, 0xba, 0x7e, 0x85, 0x19, 0xd5,
+	0x76, 0xff, 0x7c, 0x6e, 0x83, 0xd9, 0xdc, 0x06, 0x3f, 0xe6, 0x36, 0x78, 0xbf, 0xb0, 0x0b, 0xb3,
+	0x85, 0x5d, 0xf8, 0xba, 0xb0, 0x0b, 0xaf, 0x9e, 0xf8, 0x54, 0x8d, 0xe3, 0x91, 0xe3, 0xf1, 0xd0,
+	0x5d, 0xd9, 0x43, 0x93, 0xc7, 0x7b, 0xde, 0x18, 0x51, 0xe6, 0x2e, 0x33, 0x67, 0xd9, 0x02, 0x9d,
+	0x46, 0x44, 0x8e, 0x36, 0x12, 0xe4, 0xe0, 0xf7, 0x00, 0x35, 0x80, 0x2a, 0x5a, 0x65, 0x05, 0x00,
+	0x00,
 }
 
 func (m *Transfer) Marshal() (dAtA []byte, err error) {
@@ -525,6 +600,49 @@ func (m *MsgWithdrawFromSubaccount) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferIsolatedCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferIsolatedCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferIsolatedCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quantums != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64((uint64(m.Quantums)<<1)^uint64((m.Quantums>>63))))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSendFromModuleToAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -651,6 +769,23 @@ func (m *MsgWithdrawFromSubaccount) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferIsolatedCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	if m.PerpetualId != 0 {
+		n += 1 + sovTransfer(uint64(m.PerpetualId))
+	}
+	if m.Quantums != 0 {
+		n += 1 + sozTransfer(uint64(m.Quantums))
+	}
+	return n
+}
+
 func (m *MsgSendFromModuleToAccount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1140,6 +1275,129 @@ func (m *MsgWithdrawFromSubaccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferIsolatedCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferIsolatedCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferIsolatedCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.Quantums = int64(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSendFromModuleToAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
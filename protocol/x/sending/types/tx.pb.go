@@ -185,6 +185,44 @@ func (m *MsgWithdrawFromSubaccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawFromSubaccountResponse proto.InternalMessageInfo
 
+// MsgTransferIsolatedCollateralResponse is a response type used for new
+// isolated collateral transfers.
+type MsgTransferIsolatedCollateralResponse struct {
+}
+
+func (m *MsgTransferIsolatedCollateralResponse) Reset()         { *m = MsgTransferIsolatedCollateralResponse{} }
+func (m *MsgTransferIsolatedCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferIsolatedCollateralResponse) ProtoMessage()    {}
+func (*MsgTransferIsolatedCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056a3cb0feba7dbf, []int{4}
+}
+func (m *MsgTransferIsolatedCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferIsolatedCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferIsolatedCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferIsolatedCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferIsolatedCollateralResponse.Merge(m, src)
+}
+func (m *MsgTransferIsolatedCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferIsolatedCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferIsolatedCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferIsolatedCollateralResponse proto.InternalMessageInfo
+
 // MsgSendFromModuleToAccountResponse is a response type used for new
 // module-to-account transfers.
 type MsgSendFromModuleToAccountResponse struct {
@@ -194,7 +232,7 @@ func (m *MsgSendFromModuleToAccountResponse) Reset()         { *m = MsgSendFromM
 func (m *MsgSendFromModuleToAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendFromModuleToAccountResponse) ProtoMessage()    {}
 func (*MsgSendFromModuleToAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056a3cb0feba7dbf, []int{4}
+	return fileDescriptor_056a3cb0feba7dbf, []int{5}
 }
 func (m *MsgSendFromModuleToAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,35 +266,39 @@ func init() {
 	proto.RegisterType((*MsgCreateTransferResponse)(nil), "dydxprotocol.sending.MsgCreateTransferResponse")
 	proto.RegisterType((*MsgDepositToSubaccountResponse)(nil), "dydxprotocol.sending.MsgDepositToSubaccountResponse")
 	proto.RegisterType((*MsgWithdrawFromSubaccountResponse)(nil), "dydxprotocol.sending.MsgWithdrawFromSubaccountResponse")
+	proto.RegisterType((*MsgTransferIsolatedCollateralResponse)(nil), "dydxprotocol.sending.MsgTransferIsolatedCollateralResponse")
 	proto.RegisterType((*MsgSendFromModuleToAccountResponse)(nil), "dydxprotocol.sending.MsgSendFromModuleToAccountResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/sending/tx.proto", fileDescriptor_056a3cb0feba7dbf) }
 
 var fileDescriptor_056a3cb0feba7dbf = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4e, 0xcd, 0x4b, 0xc9, 0xcc, 0x4b,
-	0xd7, 0x2f, 0xa9, 0xd0, 0x03, 0x8b, 0x09, 0x89, 0x20, 0x4b, 0xeb, 0x41, 0xa5, 0xa5, 0x94, 0xb1,
-	0x6b, 0x2a, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0x82, 0x68, 0x55, 0xf2, 0xe7, 0x12, 0xf4, 0x2d,
-	0x4e, 0x77, 0x2e, 0x4a, 0x4d, 0x2c, 0x49, 0x0d, 0x81, 0x4a, 0x09, 0x59, 0x71, 0x71, 0xc0, 0x94,
-	0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe9, 0x61, 0xb3, 0x42, 0x0f, 0xa6, 0x23, 0x08,
-	0xae, 0x5e, 0x49, 0x9a, 0x4b, 0x12, 0xc3, 0xc0, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54,
-	0x25, 0x05, 0x2e, 0x39, 0xdf, 0xe2, 0x74, 0x97, 0xd4, 0x82, 0xfc, 0xe2, 0xcc, 0x92, 0x90, 0xfc,
-	0xe0, 0xd2, 0xa4, 0xc4, 0xe4, 0xe4, 0xfc, 0xd2, 0xbc, 0x12, 0xb8, 0x0a, 0x65, 0x2e, 0x45, 0xdf,
-	0xe2, 0xf4, 0xf0, 0xcc, 0x92, 0x8c, 0x94, 0xa2, 0xc4, 0x72, 0xb7, 0xa2, 0xfc, 0x5c, 0x2c, 0x8a,
-	0x54, 0xb8, 0x94, 0x7c, 0x8b, 0xd3, 0x83, 0x53, 0xf3, 0x52, 0x40, 0x0a, 0x7c, 0xf3, 0x53, 0x4a,
-	0x73, 0x52, 0x43, 0xf2, 0x1d, 0x51, 0x55, 0x19, 0xfd, 0x64, 0xe6, 0x62, 0xf6, 0x2d, 0x4e, 0x17,
-	0xca, 0xe2, 0xe2, 0x43, 0xf3, 0x9f, 0x3a, 0x76, 0xdf, 0x60, 0xb8, 0x5b, 0x4a, 0x9f, 0x48, 0x85,
-	0x30, 0x3b, 0x85, 0x2a, 0xb9, 0x84, 0xb1, 0xf8, 0x4e, 0x48, 0x07, 0xa7, 0x39, 0x58, 0x54, 0x4b,
-	0x99, 0x90, 0xa2, 0x1a, 0x6e, 0x75, 0x13, 0x23, 0x97, 0x18, 0xf6, 0x70, 0x13, 0xc2, 0xed, 0x0d,
-	0xec, 0x1a, 0xa4, 0xcc, 0x49, 0xd4, 0x00, 0x77, 0x44, 0x2b, 0x23, 0x97, 0x38, 0x8e, 0x78, 0x11,
-	0x32, 0xc0, 0x69, 0x28, 0x0e, 0x1d, 0x52, 0x16, 0xa4, 0xea, 0x80, 0xb9, 0xc3, 0x29, 0xf8, 0xc4,
-	0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x2c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93,
-	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0x32, 0x48, 0x99, 0x89, 0x6e, 0x72, 0x46, 0x62, 0x66, 0x9e,
-	0x3e, 0x5c, 0xa4, 0x02, 0x91, 0x69, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x32, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x8a, 0x93, 0x09, 0x8e, 0x03, 0x00, 0x00,
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4d, 0x4b, 0xc3, 0x30,
+	0x1c, 0xc6, 0x17, 0x44, 0x91, 0x08, 0x82, 0x51, 0x7c, 0xa9, 0x18, 0x66, 0xa7, 0xcc, 0x83, 0xb6,
+	0xb2, 0x0d, 0x7c, 0x3b, 0xe9, 0x44, 0xf0, 0x50, 0x84, 0x6d, 0x20, 0x78, 0xeb, 0xda, 0x98, 0x55,
+	0xba, 0xa6, 0x34, 0xa9, 0x6e, 0x57, 0xc1, 0x8b, 0x07, 0xf1, 0x63, 0x79, 0xdc, 0xd1, 0xa3, 0x6c,
+	0x5f, 0x44, 0x36, 0xd7, 0xea, 0x5c, 0x02, 0xce, 0x53, 0x21, 0xcf, 0xf3, 0x7b, 0xfe, 0x2f, 0x4d,
+	0xe0, 0x86, 0xdb, 0x76, 0x5b, 0x61, 0xc4, 0x04, 0x73, 0x98, 0x6f, 0x72, 0x12, 0xb8, 0x5e, 0x40,
+	0x4d, 0xd1, 0x32, 0x06, 0x67, 0x68, 0xe9, 0xa7, 0x6c, 0x0c, 0x65, 0x2d, 0x27, 0x87, 0x22, 0x3b,
+	0xe0, 0xb7, 0x24, 0xfa, 0x42, 0xf5, 0x2b, 0xb8, 0x60, 0x71, 0x5a, 0x8e, 0x88, 0x2d, 0x48, 0x6d,
+	0x28, 0xa1, 0x63, 0x38, 0x9b, 0xd8, 0x56, 0x41, 0x16, 0xec, 0xcc, 0x15, 0xb0, 0x21, 0x2b, 0x61,
+	0x24, 0x44, 0x25, 0xf5, 0xeb, 0xeb, 0x70, 0x6d, 0x2c, 0xb0, 0x42, 0x78, 0xc8, 0x02, 0x4e, 0xf4,
+	0x2c, 0xc4, 0x16, 0xa7, 0xe7, 0x24, 0x64, 0xdc, 0x13, 0x35, 0x56, 0x8d, 0xeb, 0xb6, 0xe3, 0xb0,
+	0x38, 0x10, 0xa9, 0x23, 0x07, 0x37, 0x2d, 0x4e, 0xaf, 0x3d, 0xd1, 0x70, 0x23, 0xfb, 0xe1, 0x22,
+	0x62, 0x4d, 0x89, 0x29, 0x0f, 0xb7, 0x2d, 0x4e, 0x93, 0xf4, 0x4b, 0xce, 0x7c, 0x5b, 0x10, 0xb7,
+	0xcc, 0xfc, 0xfe, 0x37, 0xb2, 0xfd, 0xd4, 0xb8, 0x05, 0x75, 0x8b, 0xd3, 0x2a, 0x09, 0xdc, 0x7e,
+	0x92, 0xc5, 0xdc, 0xd8, 0x27, 0x35, 0x76, 0x3a, 0x1a, 0x57, 0x78, 0x9e, 0x86, 0x53, 0x16, 0xa7,
+	0xe8, 0x0e, 0xce, 0xff, 0x5a, 0x44, 0x5e, 0x3e, 0xf6, 0xd8, 0x80, 0x9a, 0xf9, 0x47, 0x63, 0x52,
+	0x13, 0xb5, 0xe1, 0xa2, 0x64, 0x0d, 0x68, 0x57, 0x99, 0x23, 0x71, 0x6b, 0xa5, 0x49, 0xdc, 0x69,
+	0xe9, 0x47, 0x00, 0x97, 0xe5, 0x0b, 0x46, 0xea, 0x31, 0xe4, 0x80, 0x76, 0x30, 0x21, 0x90, 0x36,
+	0xf1, 0x02, 0xa0, 0xa6, 0xfe, 0x81, 0xa8, 0xa8, 0xcc, 0x55, 0x43, 0xda, 0xc9, 0x3f, 0xa0, 0xb4,
+	0xa1, 0x27, 0x00, 0x57, 0x14, 0x17, 0x05, 0xed, 0x2b, 0x83, 0x15, 0x84, 0x76, 0x38, 0x29, 0x91,
+	0xf4, 0x71, 0x56, 0x7d, 0xeb, 0x62, 0xd0, 0xe9, 0x62, 0xf0, 0xd1, 0xc5, 0xe0, 0xb5, 0x87, 0x33,
+	0x9d, 0x1e, 0xce, 0xbc, 0xf7, 0x70, 0xe6, 0xe6, 0x88, 0x7a, 0xa2, 0x11, 0xd7, 0x0d, 0x87, 0x35,
+	0xcd, 0x91, 0xa7, 0x7d, 0x5f, 0xda, 0x73, 0x1a, 0xb6, 0x17, 0x98, 0xe9, 0x49, 0xeb, 0xfb, 0xb9,
+	0xb7, 0x43, 0xc2, 0xeb, 0x33, 0x03, 0xa5, 0xf8, 0x39, 0x00, 0xbb, 0x89, 0xca, 0x60, 0x48, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +321,10 @@ type MsgClient interface {
 	// WithdrawFromSubaccount initiates a new transfer from an `x/subaccounts`
 	// subaccount to an `x/bank` account.
 	WithdrawFromSubaccount(ctx context.Context, in *MsgWithdrawFromSubaccount, opts ...grpc.CallOption) (*MsgWithdrawFromSubaccountResponse, error)
+	// TransferIsolatedCollateral initiates a new transfer between the
+	// cross-margined collateral of an `x/subaccounts` subaccount and one of its
+	// isolated-margin perpetual positions.
+	TransferIsolatedCollateral(ctx context.Context, in *MsgTransferIsolatedCollateral, opts ...grpc.CallOption) (*MsgTransferIsolatedCollateralResponse, error)
 	// SendFromModuleToAccount initiates a new transfer from a module to an
 	// `x/bank` account (should only be executed by governance).
 	SendFromModuleToAccount(ctx context.Context, in *MsgSendFromModuleToAccount, opts ...grpc.CallOption) (*MsgSendFromModuleToAccountResponse, error)
@@ -319,6 +365,15 @@ func (c *msgClient) WithdrawFromSubaccount(ctx context.Context, in *MsgWithdrawF
 	return out, nil
 }
 
+func (c *msgClient) TransferIsolatedCollateral(ctx context.Context, in *MsgTransferIsolatedCollateral, opts ...grpc.CallOption) (*MsgTransferIsolatedCollateralResponse, error) {
+	out := new(MsgTransferIsolatedCollateralResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.sending.Msg/TransferIsolatedCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SendFromModuleToAccount(ctx context.Context, in *MsgSendFromModuleToAccount, opts ...grpc.CallOption) (*MsgSendFromModuleToAccountResponse, error) {
 	out := new(MsgSendFromModuleToAccountResponse)
 	err := c.cc.Invoke(ctx, "/dydxprotocol.sending.Msg/SendFromModuleToAccount", in, out, opts...)
@@ -338,6 +393,10 @@ type MsgServer interface {
 	// WithdrawFromSubaccount initiates a new transfer from an `x/subaccounts`
 	// subaccount to an `x/bank` account.
 	WithdrawFromSubaccount(context.Context, *MsgWithdrawFromSubaccount) (*MsgWithdrawFromSubaccountResponse, error)
+	// TransferIsolatedCollateral initiates a new transfer between the
+	// cross-margined collateral of an `x/subaccounts` subaccount and one of its
+	// isolated-margin perpetual positions.
+	TransferIsolatedCollateral(context.Context, *MsgTransferIsolatedCollateral) (*MsgTransferIsolatedCollateralResponse, error)
 	// SendFromModuleToAccount initiates a new transfer from a module to an
 	// `x/bank` account (should only be executed by governance).
 	SendFromModuleToAccount(context.Context, *MsgSendFromModuleToAccount) (*MsgSendFromModuleToAccountResponse, error)
@@ -356,6 +415,9 @@ func (*UnimplementedMsgServer) DepositToSubaccount(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) WithdrawFromSubaccount(ctx context.Context, req *MsgWithdrawFromSubaccount) (*MsgWithdrawFromSubaccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromSubaccount not implemented")
 }
+func (*UnimplementedMsgServer) TransferIsolatedCollateral(ctx context.Context, req *MsgTransferIsolatedCollateral) (*MsgTransferIsolatedCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferIsolatedCollateral not implemented")
+}
 func (*UnimplementedMsgServer) SendFromModuleToAccount(ctx context.Context, req *MsgSendFromModuleToAccount) (*MsgSendFromModuleToAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendFromModuleToAccount not implemented")
 }
@@ -418,6 +480,24 @@ func _Msg_WithdrawFromSubaccount_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferIsolatedCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferIsolatedCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferIsolatedCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.sending.Msg/TransferIsolatedCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferIsolatedCollateral(ctx, req.(*MsgTransferIsolatedCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SendFromModuleToAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSendFromModuleToAccount)
 	if err := dec(in); err != nil {
@@ -452,6 +532,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawFromSubaccount",
 			Handler:    _Msg_WithdrawFromSubaccount_Handler,
 		},
+		{
+			MethodName: "TransferIsolatedCollateral",
+			Handler:    _Msg_TransferIsolatedCollateral_Handler,
+		},
 		{
 			MethodName: "SendFromModuleToAccount",
 			Handler:    _Msg_SendFromModuleToAccount_Handler,
@@ -565,6 +649,29 @@ func (m *MsgWithdrawFromSubaccountResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferIsolatedCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferIsolatedCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferIsolatedCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSendFromModuleToAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -639,6 +746,15 @@ func (m *MsgWithdrawFromSubaccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferIsolatedCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSendFromModuleToAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -890,6 +1006,56 @@ func (m *MsgWithdrawFromSubaccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferIsolatedCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferIsolatedCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferIsolatedCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSendFromModuleToAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,6 +14,10 @@ type SendingKeeper interface {
 		ctx sdk.Context,
 		msgWithdrawFromSubaccount *MsgWithdrawFromSubaccount,
 	) error
+	ProcessTransferIsolatedCollateral(
+		ctx sdk.Context,
+		msgTransferIsolatedCollateral *MsgTransferIsolatedCollateral,
+	) error
 	SendFromModuleToAccount(
 		ctx sdk.Context,
 		msg *MsgSendFromModuleToAccount,
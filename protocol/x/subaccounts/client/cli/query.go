@@ -23,6 +23,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListSubaccount())
 	cmd.AddCommand(CmdShowSubaccount())
+	cmd.AddCommand(CmdListSubaccountsByPerpetual())
+	cmd.AddCommand(CmdListSubaccountsWithBorrowings())
+	cmd.AddCommand(CmdShowOpenInterest())
 
 	return cmd
 }
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdListSubaccountsByPerpetual() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-subaccounts-by-perpetual [perpetual-id]",
+		Short: "list the ids of all subaccounts with an open position in a perpetual",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			argPerpetualId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySubaccountsByPerpetualRequest{
+				PerpetualId: argPerpetualId,
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.SubaccountsByPerpetual(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListSubaccountsWithBorrowings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-subaccounts-with-borrowings",
+		Short: "list the ids of all subaccounts with a negative asset position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySubaccountsWithBorrowingsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SubaccountsWithBorrowings(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowOpenInterest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-open-interest [perpetual-id]",
+		Short: "shows the open interest of a perpetual",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPerpetualId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOpenInterestRequest{
+				PerpetualId: argPerpetualId,
+			}
+
+			res, err := queryClient.OpenInterest(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) OpenInterest(
+	c context.Context,
+	req *types.QueryOpenInterestRequest,
+) (*types.QueryOpenInterestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	openInterestCapNotional, err := k.perpetualsKeeper.GetOpenInterestCapNotional(ctx, req.PerpetualId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	openInterest := k.GetOpenInterest(ctx, req.PerpetualId)
+	openInterestNotional, err := k.perpetualsKeeper.GetNetNotional(ctx, req.PerpetualId, openInterest)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOpenInterestResponse{
+		OpenInterest:            dtypes.NewIntFromBigInt(openInterest),
+		OpenInterestNotional:    dtypes.NewIntFromBigInt(openInterestNotional),
+		OpenInterestCapNotional: openInterestCapNotional,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestOpenInterestQuery(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	wctx := sdk.WrapSDKContext(ctx)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_SmallMarginRequirement_OpenInterestCap60000USD,
+		constants.EthUsd_NoMarginRequirement,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.OpenInterestCapNotional,
+			p.Params.FundingRateBounds,
+			p.Params.InterestRatePpm,
+			p.Params.FundingTickEpoch,
+			p.Params.FundingSampleEpoch,
+		)
+		require.NoError(t, err)
+	}
+
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Bob_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCShort},
+	})
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryOpenInterestRequest
+		response *types.QueryOpenInterestResponse
+		err      error
+	}{
+		{
+			desc:    "Open positions",
+			request: &types.QueryOpenInterestRequest{PerpetualId: 0},
+			response: &types.QueryOpenInterestResponse{
+				OpenInterest:            dtypes.NewInt(100_000_000),    // 1 BTC
+				OpenInterestNotional:    dtypes.NewInt(50_000_000_000), // $50,000
+				OpenInterestCapNotional: 60_000_000_000,                // $60,000
+			},
+		},
+		{
+			desc:    "No open positions",
+			request: &types.QueryOpenInterestRequest{PerpetualId: 1},
+			response: &types.QueryOpenInterestResponse{
+				OpenInterest:         dtypes.NewInt(0),
+				OpenInterestNotional: dtypes.NewInt(0),
+			},
+		},
+		{
+			desc:    "Perpetual does not exist",
+			request: &types.QueryOpenInterestRequest{PerpetualId: 2},
+			err: status.Error(
+				codes.NotFound,
+				"2: Perpetual does not exist",
+			),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.OpenInterest(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
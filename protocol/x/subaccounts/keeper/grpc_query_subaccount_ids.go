@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SubaccountsByPerpetual(
+	c context.Context,
+	req *types.QuerySubaccountsByPerpetualRequest,
+) (*types.QuerySubaccountsByPerpetualResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var subaccountIds []types.SubaccountId
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Keys in this store are prefixed by the position side, followed by the subaccount ID.
+	indexStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append([]byte(types.PerpetualPositionIndexKeyPrefix), lib.Uint32ToKey(req.PerpetualId)...),
+	)
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		var subaccountId types.SubaccountId
+		if err := subaccountId.Unmarshal(key[1:]); err != nil {
+			return err
+		}
+
+		subaccountIds = append(subaccountIds, subaccountId)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubaccountsByPerpetualResponse{SubaccountIds: subaccountIds, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SubaccountsWithBorrowings(
+	c context.Context,
+	req *types.QuerySubaccountsWithBorrowingsRequest,
+) (*types.QuerySubaccountsWithBorrowingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var subaccountIds []types.SubaccountId
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BorrowerIndexKeyPrefix))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		var subaccountId types.SubaccountId
+		if err := subaccountId.Unmarshal(key); err != nil {
+			return err
+		}
+
+		subaccountIds = append(subaccountIds, subaccountId)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubaccountsWithBorrowingsResponse{SubaccountIds: subaccountIds, Pagination: pageRes}, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestSubaccountsByPerpetualQueryPaginated(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// Alice and Carl are long BTC, Bob is short BTC and Dave only holds ETH.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Bob_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCShort},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Carl_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneTenthBTCLong},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Dave_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneTenthEthLong},
+	})
+	btcHolders := []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0, constants.Carl_Num0}
+
+	request := func(next []byte, limit uint64, total bool) *types.QuerySubaccountsByPerpetualRequest {
+		return &types.QuerySubaccountsByPerpetualRequest{
+			PerpetualId: 0,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		subaccountIds := make([]types.SubaccountId, 0)
+		for i := 0; i < len(btcHolders); i += step {
+			resp, err := keeper.SubaccountsByPerpetual(wctx, request(next, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.SubaccountIds), step)
+			subaccountIds = append(subaccountIds, resp.SubaccountIds...)
+			next = resp.Pagination.NextKey
+		}
+		require.Empty(t, next)
+		require.ElementsMatch(t, btcHolders, subaccountIds)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.SubaccountsByPerpetual(wctx, request(nil, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(btcHolders), int(resp.Pagination.Total))
+		require.ElementsMatch(t, btcHolders, resp.SubaccountIds)
+	})
+	t.Run("NoPositions", func(t *testing.T) {
+		resp, err := keeper.SubaccountsByPerpetual(
+			wctx,
+			&types.QuerySubaccountsByPerpetualRequest{PerpetualId: 2},
+		)
+		require.NoError(t, err)
+		require.Empty(t, resp.SubaccountIds)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.SubaccountsByPerpetual(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestSubaccountsWithBorrowingsQuery(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// Alice borrows USDC, Bob borrows BTC and Carl has no borrowings.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(-1_000)),
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Bob_Num0,
+		AssetPositions: []*types.AssetPosition{
+			&constants.Usdc_Asset_100_000,
+			&constants.Short_Asset_1BTC,
+		},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Carl_Num0,
+		AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(1_000)),
+	})
+
+	resp, err := keeper.SubaccountsWithBorrowings(wctx, &types.QuerySubaccountsWithBorrowingsRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0}, resp.SubaccountIds)
+
+	// Repaying all borrowings removes a subaccount from the index.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Alice_Num0,
+		AssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(1_000)),
+	})
+	resp, err = keeper.SubaccountsWithBorrowings(wctx, &types.QuerySubaccountsWithBorrowingsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.SubaccountId{constants.Bob_Num0}, resp.SubaccountIds)
+
+	_, err = keeper.SubaccountsWithBorrowings(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
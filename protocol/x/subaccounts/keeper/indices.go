@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+const (
+	shortPositionIndexByte byte = 0
+	longPositionIndexByte  byte = 1
+)
+
+// getPerpetualPositionIndexKeyPrefix returns the key prefix under which the IDs of all subaccounts
+// with an open position on the given side of a perpetual are stored.
+func getPerpetualPositionIndexKeyPrefix(perpetualId uint32, isLong bool) []byte {
+	sideByte := shortPositionIndexByte
+	if isLong {
+		sideByte = longPositionIndexByte
+	}
+	return append(lib.Uint32ToKey(perpetualId), sideByte)
+}
+
+// updateSubaccountIndices updates all secondary indices of a subaccount given its state before and after
+// an update. It must be called whenever a subaccount is written to state.
+func (k Keeper) updateSubaccountIndices(
+	ctx sdk.Context,
+	oldSubaccount types.Subaccount,
+	newSubaccount types.Subaccount,
+) {
+	k.updatePerpetualPositionIndex(
+		ctx,
+		*newSubaccount.Id,
+		oldSubaccount.PerpetualPositions,
+		newSubaccount.PerpetualPositions,
+	)
+	k.updateOpenInterest(ctx, oldSubaccount.PerpetualPositions, newSubaccount.PerpetualPositions)
+	k.updateBorrowerIndex(ctx, *newSubaccount.Id, oldSubaccount.AssetPositions, newSubaccount.AssetPositions)
+}
+
+// updatePerpetualPositionIndex updates the perpetual position index of a subaccount given its perpetual
+// positions before and after an update. Index entries are removed for positions that were closed or
+// flipped sides, and added for positions that were opened or flipped sides.
+func (k Keeper) updatePerpetualPositionIndex(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	oldPositions []*types.PerpetualPosition,
+	newPositions []*types.PerpetualPosition,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PerpetualPositionIndexKeyPrefix))
+	subaccountKey := subaccountId.ToStateKey()
+
+	// Returns the sign of the position in the given perpetual, or zero if there is none.
+	getPositionSign := func(positions []*types.PerpetualPosition, perpetualId uint32) int {
+		for _, position := range positions {
+			if position.PerpetualId == perpetualId {
+				return position.Quantums.BigInt().Sign()
+			}
+		}
+		return 0
+	}
+
+	for _, position := range oldPositions {
+		sign := position.Quantums.BigInt().Sign()
+		if sign != 0 && sign != getPositionSign(newPositions, position.PerpetualId) {
+			store.Delete(append(getPerpetualPositionIndexKeyPrefix(position.PerpetualId, sign > 0), subaccountKey...))
+		}
+	}
+
+	for _, position := range newPositions {
+		sign := position.Quantums.BigInt().Sign()
+		if sign != 0 && sign != getPositionSign(oldPositions, position.PerpetualId) {
+			store.Set(append(getPerpetualPositionIndexKeyPrefix(position.PerpetualId, sign > 0), subaccountKey...), []byte{})
+		}
+	}
+}
+
+// ForEachSubaccountIdWithPerpetualPosition performs a callback across the IDs of all subaccounts with an
+// open position on the given side of a perpetual, in ascending order of their state keys.
+// The callback function should return a boolean if we should end iteration or not.
+func (k Keeper) ForEachSubaccountIdWithPerpetualPosition(
+	ctx sdk.Context,
+	perpetualId uint32,
+	isLong bool,
+	callback func(types.SubaccountId) (finished bool),
+) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append([]byte(types.PerpetualPositionIndexKeyPrefix), getPerpetualPositionIndexKeyPrefix(perpetualId, isLong)...),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var subaccountId types.SubaccountId
+		if err := subaccountId.Unmarshal(iterator.Key()); err != nil {
+			panic(err)
+		}
+		if callback(subaccountId) {
+			break
+		}
+	}
+}
+
+// updateOpenInterest updates the open interest of all perpetuals in which a subaccount's long position
+// changed, given its perpetual positions before and after an update.
+func (k Keeper) updateOpenInterest(
+	ctx sdk.Context,
+	oldPositions []*types.PerpetualPosition,
+	newPositions []*types.PerpetualPosition,
+) {
+	// Open interest only counts long positions, so that every matched trade is counted exactly once.
+	deltas := make(map[uint32]*big.Int)
+	perpetualIds := make([]uint32, 0)
+	addLongQuantums := func(perpetualId uint32, quantums *big.Int, sign int) {
+		if quantums.Sign() <= 0 {
+			return
+		}
+		if _, exists := deltas[perpetualId]; !exists {
+			deltas[perpetualId] = new(big.Int)
+			perpetualIds = append(perpetualIds, perpetualId)
+		}
+		if sign > 0 {
+			deltas[perpetualId].Add(deltas[perpetualId], quantums)
+		} else {
+			deltas[perpetualId].Sub(deltas[perpetualId], quantums)
+		}
+	}
+	for _, position := range oldPositions {
+		addLongQuantums(position.PerpetualId, position.Quantums.BigInt(), -1)
+	}
+	for _, position := range newPositions {
+		addLongQuantums(position.PerpetualId, position.Quantums.BigInt(), 1)
+	}
+
+	for _, perpetualId := range perpetualIds {
+		if deltas[perpetualId].Sign() == 0 {
+			continue
+		}
+		openInterest := k.GetOpenInterest(ctx, perpetualId)
+		k.setOpenInterest(ctx, perpetualId, openInterest.Add(openInterest, deltas[perpetualId]))
+	}
+}
+
+// GetOpenInterest returns the open interest of a perpetual in base quantums, which is the total size of all
+// long positions in the perpetual. This is equal to the total size of all short positions.
+func (k Keeper) GetOpenInterest(ctx sdk.Context, perpetualId uint32) *big.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OpenInterestKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return new(big.Int)
+	}
+
+	var openInterest dtypes.SerializableInt
+	if err := openInterest.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return openInterest.BigInt()
+}
+
+// setOpenInterest sets the open interest of a perpetual in state, removing it if it is zero.
+func (k Keeper) setOpenInterest(ctx sdk.Context, perpetualId uint32, openInterest *big.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OpenInterestKeyPrefix))
+	if openInterest.Sign() == 0 {
+		store.Delete(lib.Uint32ToKey(perpetualId))
+		return
+	}
+
+	b, err := dtypes.NewIntFromBigInt(openInterest).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(lib.Uint32ToKey(perpetualId), b)
+}
+
+// updateBorrowerIndex adds a subaccount to the borrower index if it has a negative asset position after an
+// update, and removes it if it no longer has one.
+func (k Keeper) updateBorrowerIndex(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	oldPositions []*types.AssetPosition,
+	newPositions []*types.AssetPosition,
+) {
+	hasBorrowing := func(positions []*types.AssetPosition) bool {
+		for _, position := range positions {
+			if position.Quantums.BigInt().Sign() < 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	wasBorrower, isBorrower := hasBorrowing(oldPositions), hasBorrowing(newPositions)
+	if wasBorrower == isBorrower {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BorrowerIndexKeyPrefix))
+	if isBorrower {
+		store.Set(subaccountId.ToStateKey(), []byte{})
+	} else {
+		store.Delete(subaccountId.ToStateKey())
+	}
+}
+
+// InitializeSubaccountIndices populates all secondary indices from the subaccounts in state. This is used
+// to build the indices for subaccounts that were written before the indices existed, and must only be
+// called while the indices are empty.
+func (k Keeper) InitializeSubaccountIndices(ctx sdk.Context) {
+	for _, subaccount := range k.GetAllSubaccount(ctx) {
+		k.updateSubaccountIndices(ctx, types.Subaccount{Id: subaccount.Id}, subaccount)
+	}
+}
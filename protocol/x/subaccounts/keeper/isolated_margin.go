@@ -0,0 +1,392 @@
+package keeper
+
+import (
+	"math/big"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetCrossNetCollateralAndMarginRequirements returns the net collateral, initial margin requirement, and
+// maintenance margin requirement of the cross-margined positions of a subaccount. These are all positions
+// and collateral of the subaccount except for its isolated positions and the collateral allotted to them.
+//
+// All return values are denoted in quote quantums.
+func (k Keeper) GetCrossNetCollateralAndMarginRequirements(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	bigNetCollateral *big.Int,
+	bigInitialMargin *big.Int,
+	bigMaintenanceMargin *big.Int,
+	err error,
+) {
+	settledSubaccount, _, _, err := k.getSettledSubaccount(ctx, k.GetSubaccount(ctx, subaccountId))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	update := settledUpdate{SettledSubaccount: settledSubaccount}
+	deficit, _, err := k.getIsolatedDeficits(ctx, update, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return k.internalGetNetCollateralAndMarginRequirements(
+		ctx,
+		getCrossMarginUpdate(update, nil, deficit, deficit),
+	)
+}
+
+// GetIsolatedNetCollateralAndMarginRequirements returns the net collateral, initial margin requirement, and
+// maintenance margin requirement of the isolated position of a subaccount in a perpetual. These only take
+// into account the position and the collateral allotted to it.
+// Returns an error if the perpetual is not isolated for the subaccount.
+//
+// All return values are denoted in quote quantums.
+func (k Keeper) GetIsolatedNetCollateralAndMarginRequirements(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+	perpetualId uint32,
+) (
+	bigNetCollateral *big.Int,
+	bigInitialMargin *big.Int,
+	bigMaintenanceMargin *big.Int,
+	err error,
+) {
+	settledSubaccount, _, _, err := k.getSettledSubaccount(ctx, k.GetSubaccount(ctx, subaccountId))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if _, exists := settledSubaccount.GetIsolatedMarginForId(perpetualId); !exists {
+		return nil, nil, nil, errorsmod.Wrapf(
+			types.ErrPerpetualNotIsolated,
+			"SubaccountId: %v, perpetualId: %d",
+			subaccountId,
+			perpetualId,
+		)
+	}
+
+	return k.internalGetNetCollateralAndMarginRequirements(
+		ctx,
+		getIsolatedMarginUpdate(settledUpdate{SettledSubaccount: settledSubaccount}, perpetualId, nil),
+	)
+}
+
+// getIsolatedQuoteBalanceDeltas returns the change in quote balance of each isolated margin of the
+// subaccount caused by `update`, keyed by perpetual id. Isolated collateral updates move USDC between the
+// cross-margined collateral and the isolated margins, and the USDC asset update of an update that trades
+// an isolated perpetual is settled against the isolated margin of that perpetual.
+//
+// Returns false if the update is not valid with respect to isolated margin, that is if it:
+// - contains more than one isolated collateral update for the same perpetual.
+// - isolates a perpetual in which the subaccount holds a cross-margined position.
+// - trades an isolated perpetual together with other perpetuals.
+func getIsolatedQuoteBalanceDeltas(
+	update settledUpdate,
+) (
+	deltas map[uint32]*big.Int,
+	valid bool,
+) {
+	deltas = make(map[uint32]*big.Int)
+	for _, isolatedCollateralUpdate := range update.IsolatedCollateralUpdates {
+		perpetualId := isolatedCollateralUpdate.PerpetualId
+		if _, exists := deltas[perpetualId]; exists {
+			return nil, false
+		}
+
+		_, isIsolated := update.SettledSubaccount.GetIsolatedMarginForId(perpetualId)
+		_, hasPosition := update.SettledSubaccount.GetPerpetualPositionForId(perpetualId)
+		if !isIsolated && hasPosition {
+			return nil, false
+		}
+
+		deltas[perpetualId] = new(big.Int).Set(isolatedCollateralUpdate.BigQuantumsDelta)
+	}
+
+	for _, perpetualUpdate := range update.PerpetualUpdates {
+		perpetualId := perpetualUpdate.PerpetualId
+		_, isIsolated := update.SettledSubaccount.GetIsolatedMarginForId(perpetualId)
+		delta, isNewlyIsolated := deltas[perpetualId]
+		if !isIsolated && !isNewlyIsolated {
+			continue
+		}
+
+		if len(update.PerpetualUpdates) != 1 {
+			return nil, false
+		}
+
+		if delta == nil {
+			delta = new(big.Int)
+			deltas[perpetualId] = delta
+		}
+		delta.Add(delta, getUsdcDelta(update.AssetUpdates))
+	}
+
+	return deltas, true
+}
+
+// getUsdcDelta returns the total change in the USDC asset position of `assetUpdates`.
+func getUsdcDelta(assetUpdates []types.AssetUpdate) *big.Int {
+	usdcDelta := new(big.Int)
+	for _, assetUpdate := range assetUpdates {
+		if assetUpdate.AssetId == assettypes.AssetUsdc.Id {
+			usdcDelta.Add(usdcDelta, assetUpdate.GetBigQuantums())
+		}
+	}
+	return usdcDelta
+}
+
+// getIsolatedDeficits returns the total deficit of the isolated positions of the subaccount of `update`
+// before and after the update is applied, given the quote balance deltas of its isolated margins. The
+// deficit of an isolated position is the amount by which its net collateral is negative, that is the
+// amount by which it is bankrupt. Isolated positions are only backed by the cross-margined collateral to
+// cover their deficits.
+func (k Keeper) getIsolatedDeficits(
+	ctx sdk.Context,
+	update settledUpdate,
+	isolatedQuoteBalanceDeltas map[uint32]*big.Int,
+) (
+	bigDeficitBefore *big.Int,
+	bigDeficitAfter *big.Int,
+	err error,
+) {
+	// Returns the amount by which the net collateral of an isolated position is negative.
+	getDeficit := func(isolatedUpdate settledUpdate) (*big.Int, error) {
+		bigNetCollateral, _, _, err := k.internalGetNetCollateralAndMarginRequirements(ctx, isolatedUpdate)
+		if err != nil {
+			return nil, err
+		}
+		if bigNetCollateral.Sign() >= 0 {
+			return new(big.Int), nil
+		}
+		return bigNetCollateral.Neg(bigNetCollateral), nil
+	}
+
+	bigDeficitBefore = new(big.Int)
+	bigDeficitAfter = new(big.Int)
+	currentUpdate := settledUpdate{SettledSubaccount: update.SettledSubaccount}
+	for _, isolatedMargin := range update.SettledSubaccount.IsolatedMargins {
+		deficit, err := getDeficit(getIsolatedMarginUpdate(currentUpdate, isolatedMargin.PerpetualId, nil))
+		if err != nil {
+			return nil, nil, err
+		}
+		bigDeficitBefore.Add(bigDeficitBefore, deficit)
+		if _, changed := isolatedQuoteBalanceDeltas[isolatedMargin.PerpetualId]; !changed {
+			bigDeficitAfter.Add(bigDeficitAfter, deficit)
+		}
+	}
+	for perpetualId, delta := range isolatedQuoteBalanceDeltas {
+		deficit, err := getDeficit(getIsolatedMarginUpdate(update, perpetualId, delta))
+		if err != nil {
+			return nil, nil, err
+		}
+		bigDeficitAfter.Add(bigDeficitAfter, deficit)
+	}
+	return bigDeficitBefore, bigDeficitAfter, nil
+}
+
+// splitIsolatedMargins splits a settled update into the update of the cross-margined positions of the
+// subaccount, followed by the updates of each isolated position that is changed by the update, in ascending
+// order of perpetual id. If the subaccount has no isolated positions, the update is returned as is.
+//
+// Returns false if the update is not valid with respect to isolated margin. See
+// `getIsolatedQuoteBalanceDeltas` for more details.
+func (k Keeper) splitIsolatedMargins(
+	ctx sdk.Context,
+	update settledUpdate,
+) (
+	marginUpdates []settledUpdate,
+	valid bool,
+	err error,
+) {
+	if len(update.SettledSubaccount.IsolatedMargins) == 0 && len(update.IsolatedCollateralUpdates) == 0 {
+		return []settledUpdate{update}, true, nil
+	}
+
+	deltas, valid := getIsolatedQuoteBalanceDeltas(update)
+	if !valid {
+		return nil, false, nil
+	}
+
+	deficitBefore, deficitAfter, err := k.getIsolatedDeficits(ctx, update, deltas)
+	if err != nil {
+		return nil, false, err
+	}
+
+	isolatedPerpetualIds := make([]uint32, 0, len(deltas))
+	for perpetualId := range deltas {
+		isolatedPerpetualIds = append(isolatedPerpetualIds, perpetualId)
+	}
+	sort.Slice(isolatedPerpetualIds, func(i, j int) bool {
+		return isolatedPerpetualIds[i] < isolatedPerpetualIds[j]
+	})
+
+	marginUpdates = make([]settledUpdate, 0, len(isolatedPerpetualIds)+1)
+	marginUpdates = append(marginUpdates, getCrossMarginUpdate(update, deltas, deficitBefore, deficitAfter))
+	for _, perpetualId := range isolatedPerpetualIds {
+		marginUpdates = append(marginUpdates, getIsolatedMarginUpdate(update, perpetualId, deltas[perpetualId]))
+	}
+	return marginUpdates, true, nil
+}
+
+// getCrossMarginUpdate returns the part of a settled update that applies to the cross-margined positions
+// of the subaccount. The settled subaccount of the returned update only holds the cross-margined positions
+// and collateral, and `isolatedQuoteBalanceDeltas` are deducted from its USDC asset update.
+//
+// The deficits of bankrupt isolated positions before and after the update, see `getIsolatedDeficits`, are
+// charged against the cross-margined collateral.
+func getCrossMarginUpdate(
+	update settledUpdate,
+	isolatedQuoteBalanceDeltas map[uint32]*big.Int,
+	isolatedDeficitBefore *big.Int,
+	isolatedDeficitAfter *big.Int,
+) settledUpdate {
+	subaccount := update.SettledSubaccount
+	crossSubaccount := types.Subaccount{
+		Id:                 subaccount.Id,
+		AssetPositions:     make([]*types.AssetPosition, 0, len(subaccount.AssetPositions)),
+		PerpetualPositions: make([]*types.PerpetualPosition, 0, len(subaccount.PerpetualPositions)),
+		MarginEnabled:      subaccount.MarginEnabled,
+	}
+	for _, assetPosition := range subaccount.AssetPositions {
+		if assetPosition.AssetId != assettypes.AssetUsdc.Id {
+			crossSubaccount.AssetPositions = append(crossSubaccount.AssetPositions, assetPosition)
+		}
+	}
+	crossUsdcPosition := new(big.Int).Sub(subaccount.GetUsdcPosition(), subaccount.GetTotalIsolatedQuoteBalance())
+	crossSubaccount.SetUsdcAssetPosition(crossUsdcPosition.Sub(crossUsdcPosition, isolatedDeficitBefore))
+	for _, perpetualPosition := range subaccount.PerpetualPositions {
+		if _, isIsolated := subaccount.GetIsolatedMarginForId(perpetualPosition.PerpetualId); !isIsolated {
+			crossSubaccount.PerpetualPositions = append(crossSubaccount.PerpetualPositions, perpetualPosition)
+		}
+	}
+
+	crossUsdcDelta := getUsdcDelta(update.AssetUpdates)
+	for _, delta := range isolatedQuoteBalanceDeltas {
+		crossUsdcDelta.Sub(crossUsdcDelta, delta)
+	}
+	crossUsdcDelta.Sub(crossUsdcDelta, new(big.Int).Sub(isolatedDeficitAfter, isolatedDeficitBefore))
+	crossAssetUpdates := make([]types.AssetUpdate, 0, len(update.AssetUpdates)+1)
+	if crossUsdcDelta.Sign() != 0 {
+		crossAssetUpdates = append(crossAssetUpdates, types.AssetUpdate{
+			AssetId:          assettypes.AssetUsdc.Id,
+			BigQuantumsDelta: crossUsdcDelta,
+		})
+	}
+	for _, assetUpdate := range update.AssetUpdates {
+		if assetUpdate.AssetId != assettypes.AssetUsdc.Id {
+			crossAssetUpdates = append(crossAssetUpdates, assetUpdate)
+		}
+	}
+
+	crossPerpetualUpdates := make([]types.PerpetualUpdate, 0, len(update.PerpetualUpdates))
+	for _, perpetualUpdate := range update.PerpetualUpdates {
+		if _, isIsolated := isolatedQuoteBalanceDeltas[perpetualUpdate.PerpetualId]; isIsolated {
+			continue
+		}
+		if _, isIsolated := subaccount.GetIsolatedMarginForId(perpetualUpdate.PerpetualId); isIsolated {
+			continue
+		}
+		crossPerpetualUpdates = append(crossPerpetualUpdates, perpetualUpdate)
+	}
+
+	return settledUpdate{
+		SettledSubaccount: crossSubaccount,
+		AssetUpdates:      crossAssetUpdates,
+		PerpetualUpdates:  crossPerpetualUpdates,
+	}
+}
+
+// getIsolatedMarginUpdate returns the part of a settled update that applies to the isolated position of
+// the subaccount in a perpetual. The settled subaccount of the returned update only holds the isolated
+// position and the collateral allotted to it, and `quoteBalanceDelta` is its USDC asset update.
+func getIsolatedMarginUpdate(
+	update settledUpdate,
+	perpetualId uint32,
+	quoteBalanceDelta *big.Int,
+) settledUpdate {
+	isolatedSubaccount := types.Subaccount{
+		Id:            update.SettledSubaccount.Id,
+		MarginEnabled: update.SettledSubaccount.MarginEnabled,
+	}
+	isolatedMargin, _ := update.SettledSubaccount.GetIsolatedMarginForId(perpetualId)
+	isolatedSubaccount.SetUsdcAssetPosition(isolatedMargin.GetBigQuoteBalance())
+	if perpetualPosition, exists := update.SettledSubaccount.GetPerpetualPositionForId(perpetualId); exists {
+		isolatedSubaccount.PerpetualPositions = []*types.PerpetualPosition{perpetualPosition}
+	}
+
+	isolatedUpdate := settledUpdate{
+		SettledSubaccount: isolatedSubaccount,
+	}
+	if quoteBalanceDelta != nil && quoteBalanceDelta.Sign() != 0 {
+		isolatedUpdate.AssetUpdates = []types.AssetUpdate{
+			{
+				AssetId:          assettypes.AssetUsdc.Id,
+				BigQuantumsDelta: quoteBalanceDelta,
+			},
+		}
+	}
+	for _, perpetualUpdate := range update.PerpetualUpdates {
+		if perpetualUpdate.PerpetualId == perpetualId {
+			isolatedUpdate.PerpetualUpdates = append(isolatedUpdate.PerpetualUpdates, perpetualUpdate)
+		}
+	}
+	return isolatedUpdate
+}
+
+// UpdateIsolatedMargins updates the SettledSubaccount.IsolatedMargins of each settledUpdate in
+// settledUpdates by the matching quote balance deltas returned by `getIsolatedQuoteBalanceDeltas`.
+// Isolated margins without quote balance are removed once the subaccount no longer holds a position
+// in the perpetual. Must be called after the perpetual positions of the updates are applied.
+func UpdateIsolatedMargins(
+	settledUpdates []settledUpdate,
+	isolatedQuoteBalanceDeltas []map[uint32]*big.Int,
+) {
+	for i, u := range settledUpdates {
+		if len(isolatedQuoteBalanceDeltas[i]) == 0 {
+			continue
+		}
+
+		// Build a map of all the Subaccount's Isolated Margins by id.
+		isolatedMarginsMap := make(map[uint32]*types.IsolatedMargin)
+		for _, isolatedMargin := range u.SettledSubaccount.IsolatedMargins {
+			isolatedMarginsMap[isolatedMargin.PerpetualId] = isolatedMargin
+		}
+
+		for perpetualId, delta := range isolatedQuoteBalanceDeltas[i] {
+			newQuoteBalance := new(big.Int).Add(
+				isolatedMarginsMap[perpetualId].GetBigQuoteBalance(),
+				delta,
+			)
+
+			_, hasPosition := u.SettledSubaccount.GetPerpetualPositionForId(perpetualId)
+			if newQuoteBalance.Sign() == 0 && !hasPosition {
+				delete(isolatedMarginsMap, perpetualId)
+				continue
+			}
+			isolatedMarginsMap[perpetualId] = &types.IsolatedMargin{
+				PerpetualId:  perpetualId,
+				QuoteBalance: dtypes.NewIntFromBigInt(newQuoteBalance),
+			}
+		}
+
+		// Convert the new IsolatedMargin values back into a slice.
+		isolatedMargins := make([]*types.IsolatedMargin, 0, len(isolatedMarginsMap))
+		for _, value := range isolatedMarginsMap {
+			isolatedMargins = append(isolatedMargins, value)
+		}
+
+		// Sort the new IsolatedMargins in ascending order by PerpetualId.
+		sort.Slice(isolatedMargins, func(i, j int) bool {
+			return isolatedMargins[i].PerpetualId < isolatedMargins[j].PerpetualId
+		})
+
+		settledUpdates[i].SettledSubaccount.IsolatedMargins = isolatedMargins
+	}
+}
@@ -0,0 +1,403 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSubaccounts_IsolatedMargin(t *testing.T) {
+	tests := map[string]struct {
+		// Subaccount state.
+		usdcBalance        *big.Int
+		perpetualPositions []*types.PerpetualPosition
+		isolatedMargins    []*types.IsolatedMargin
+
+		// Updates.
+		update types.Update
+
+		// Expectations.
+		expectedSuccessPerUpdate []types.UpdateResult
+		expectedUsdcBalance      *big.Int
+		expectedIsolatedMargins  []*types.IsolatedMargin
+	}{
+		"transfer collateral into isolated margin": {
+			usdcBalance: big.NewInt(100_000_000_000), // $100,000
+			update: types.Update{
+				IsolatedCollateralUpdates: []types.IsolatedCollateralUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(10_000_000_000), // $10,000
+					},
+				},
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			expectedUsdcBalance:      big.NewInt(100_000_000_000),
+			expectedIsolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(10_000_000_000),
+				},
+			},
+		},
+		"transfer all collateral out of isolated margin without position removes isolated margin": {
+			usdcBalance: big.NewInt(100_000_000_000), // $100,000
+			isolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(10_000_000_000), // $10,000
+				},
+			},
+			update: types.Update{
+				IsolatedCollateralUpdates: []types.IsolatedCollateralUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(-10_000_000_000),
+					},
+				},
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			expectedUsdcBalance:      big.NewInt(100_000_000_000),
+			expectedIsolatedMargins:  []*types.IsolatedMargin{},
+		},
+		"transfer more collateral into isolated margin than cross collateral": {
+			usdcBalance: big.NewInt(1_000_000_000), // $1,000
+			update: types.Update{
+				IsolatedCollateralUpdates: []types.IsolatedCollateralUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(2_000_000_000), // $2,000
+					},
+				},
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized},
+			expectedUsdcBalance:      big.NewInt(1_000_000_000),
+		},
+		"open isolated position using only its own collateral": {
+			usdcBalance: big.NewInt(100_000_000_000), // $100,000
+			isolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(10_000_000_000), // $10,000
+				},
+			},
+			update: types.Update{
+				AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-50_000_000_000)),
+				PerpetualUpdates: []types.PerpetualUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+					},
+				},
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			expectedUsdcBalance:      big.NewInt(50_000_000_000),
+			expectedIsolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(-40_000_000_000),
+				},
+			},
+		},
+		"isolated position cannot use cross collateral": {
+			usdcBalance: big.NewInt(100_000_000_000), // $100,000
+			isolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(5_000_000_000), // $5,000
+				},
+			},
+			update: types.Update{
+				AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-50_000_000_000)),
+				PerpetualUpdates: []types.PerpetualUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+					},
+				},
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized},
+			expectedUsdcBalance:      big.NewInt(100_000_000_000),
+			expectedIsolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(5_000_000_000),
+				},
+			},
+		},
+		"undercollateralized isolated position does not affect cross-margined updates": {
+			usdcBalance: big.NewInt(50_000_000_000), // $50,000
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  0,
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			isolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(-49_000_000_000), // $1,000 net collateral
+				},
+			},
+			update: types.Update{
+				AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-90_000_000_000)), // -$90,000
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			expectedUsdcBalance:      big.NewInt(-40_000_000_000),
+			expectedIsolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(-49_000_000_000),
+				},
+			},
+		},
+		"deficit of bankrupt isolated position is charged against cross-margined collateral": {
+			usdcBalance: big.NewInt(50_000_000_000), // $50,000
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  0,
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			isolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(-55_000_000_000), // -$5,000 net collateral
+				},
+			},
+			update: types.Update{
+				AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-100_000_000_000)), // -$100,000
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			expectedUsdcBalance:      big.NewInt(-50_000_000_000),
+			expectedIsolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(-55_000_000_000),
+				},
+			},
+		},
+		"cross-margined collateral must cover deficit of bankrupt isolated position": {
+			usdcBalance: big.NewInt(50_000_000_000), // $50,000
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  0,
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			isolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(-55_000_000_000), // -$5,000 net collateral
+				},
+			},
+			update: types.Update{
+				AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-100_000_000_001)),
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized},
+			expectedUsdcBalance:      big.NewInt(50_000_000_000),
+			expectedIsolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(-55_000_000_000),
+				},
+			},
+		},
+		"isolating a perpetual with a cross-margined position violates isolated margin": {
+			usdcBalance: big.NewInt(100_000_000_000), // $100,000
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  0,
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			update: types.Update{
+				IsolatedCollateralUpdates: []types.IsolatedCollateralUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(10_000_000_000),
+					},
+				},
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.ViolatesIsolatedMargin},
+			expectedUsdcBalance:      big.NewInt(100_000_000_000),
+		},
+		"duplicate isolated collateral updates violate isolated margin": {
+			usdcBalance: big.NewInt(100_000_000_000), // $100,000
+			update: types.Update{
+				IsolatedCollateralUpdates: []types.IsolatedCollateralUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(10_000_000_000),
+					},
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(10_000_000_000),
+					},
+				},
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.ViolatesIsolatedMargin},
+			expectedUsdcBalance:      big.NewInt(100_000_000_000),
+		},
+		"trading an isolated perpetual together with another perpetual violates isolated margin": {
+			usdcBalance: big.NewInt(100_000_000_000), // $100,000
+			isolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(10_000_000_000), // $10,000
+				},
+			},
+			update: types.Update{
+				PerpetualUpdates: []types.PerpetualUpdate{
+					{
+						PerpetualId:      0,
+						BigQuantumsDelta: big.NewInt(1_000),
+					},
+					{
+						PerpetualId:      1,
+						BigQuantumsDelta: big.NewInt(-1_000),
+					},
+				},
+			},
+			expectedSuccessPerUpdate: []types.UpdateResult{types.ViolatesIsolatedMargin},
+			expectedUsdcBalance:      big.NewInt(100_000_000_000),
+			expectedIsolatedMargins: []*types.IsolatedMargin{
+				{
+					PerpetualId:  0,
+					QuoteBalance: dtypes.NewInt(10_000_000_000),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _ := testutil.SubaccountsKeepers(
+				t,
+				false,
+			)
+			testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+			testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				constants.EthUsd_20PercentInitial_10PercentMaintenance,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
+				)
+				require.NoError(t, err)
+			}
+
+			subaccount := createNSubaccount(keeper, ctx, 1, tc.usdcBalance)[0]
+			subaccount.PerpetualPositions = tc.perpetualPositions
+			subaccount.IsolatedMargins = tc.isolatedMargins
+			keeper.SetSubaccount(ctx, subaccount)
+
+			tc.update.SubaccountId = *subaccount.Id
+			_, successPerUpdate, err := keeper.UpdateSubaccounts(ctx, []types.Update{tc.update})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSuccessPerUpdate, successPerUpdate)
+
+			newSubaccount := keeper.GetSubaccount(ctx, *subaccount.Id)
+			require.Equal(t, tc.expectedUsdcBalance, newSubaccount.GetUsdcPosition())
+			require.Equal(t, len(tc.expectedIsolatedMargins), len(newSubaccount.IsolatedMargins))
+			for i, expected := range tc.expectedIsolatedMargins {
+				require.Equal(t, *expected, *newSubaccount.IsolatedMargins[i])
+			}
+		})
+	}
+}
+
+func TestGetIsolatedNetCollateralAndMarginRequirements(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _ := testutil.SubaccountsKeepers(t, false)
+	testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+	testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+	p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.OpenInterestCapNotional,
+		p.Params.FundingRateBounds,
+		p.Params.InterestRatePpm,
+		p.Params.FundingTickEpoch,
+		p.Params.FundingSampleEpoch,
+	)
+	require.NoError(t, err)
+
+	subaccount := createNSubaccount(keeper, ctx, 1, big.NewInt(60_000_000_000))[0] // $60,000
+	subaccount.PerpetualPositions = []*types.PerpetualPosition{
+		{
+			PerpetualId:  0,
+			Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+			FundingIndex: dtypes.NewInt(0),
+		},
+	}
+	subaccount.IsolatedMargins = []*types.IsolatedMargin{
+		{
+			PerpetualId:  0,
+			QuoteBalance: dtypes.NewInt(-45_000_000_000),
+		},
+	}
+	keeper.SetSubaccount(ctx, subaccount)
+
+	// The isolated position only uses the collateral allotted to it.
+	netCollateral, initialMargin, maintenanceMargin, err := keeper.GetIsolatedNetCollateralAndMarginRequirements(
+		ctx,
+		*subaccount.Id,
+		0,
+	)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5_000_000_000), netCollateral)
+	require.Equal(t, big.NewInt(10_000_000_000), initialMargin)
+	require.Equal(t, big.NewInt(5_000_000_000), maintenanceMargin)
+
+	// The cross-margined collateral excludes the isolated position and its collateral.
+	netCollateral, initialMargin, maintenanceMargin, err = keeper.GetCrossNetCollateralAndMarginRequirements(
+		ctx,
+		*subaccount.Id,
+	)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(105_000_000_000), netCollateral)
+	require.Equal(t, big.NewInt(0), initialMargin)
+	require.Equal(t, big.NewInt(0), maintenanceMargin)
+
+	// The deficit of a bankrupt isolated position is charged against the cross-margined collateral.
+	subaccount.IsolatedMargins[0].QuoteBalance = dtypes.NewInt(-55_000_000_000) // -$5,000 net collateral
+	keeper.SetSubaccount(ctx, subaccount)
+	netCollateral, _, _, err = keeper.GetCrossNetCollateralAndMarginRequirements(ctx, *subaccount.Id)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(110_000_000_000), netCollateral)
+
+	_, _, _, err = keeper.GetIsolatedNetCollateralAndMarginRequirements(ctx, *subaccount.Id, 1)
+	require.ErrorIs(t, err, types.ErrPerpetualNotIsolated)
+}
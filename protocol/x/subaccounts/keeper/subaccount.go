@@ -17,6 +17,7 @@ import (
 	indexer_manager "github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -26,7 +27,18 @@ func (k Keeper) SetSubaccount(ctx sdk.Context, subaccount types.Subaccount) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SubaccountKeyPrefix))
 	key := subaccount.Id.ToStateKey()
 
-	if len(subaccount.PerpetualPositions) == 0 && len(subaccount.AssetPositions) == 0 {
+	// Keep the total borrowed quantums of each asset and the secondary indices in sync with the
+	// positions being written.
+	oldSubaccount := types.Subaccount{Id: subaccount.Id}
+	if b := store.Get(key); b != nil {
+		k.cdc.MustUnmarshal(b, &oldSubaccount)
+	}
+	k.updateTotalBorrowed(ctx, oldSubaccount.AssetPositions, subaccount.AssetPositions)
+	k.updateSubaccountIndices(ctx, oldSubaccount, subaccount)
+
+	if len(subaccount.PerpetualPositions) == 0 &&
+		len(subaccount.AssetPositions) == 0 &&
+		len(subaccount.IsolatedMargins) == 0 {
 		if store.Has(key) {
 			store.Delete(key)
 		}
@@ -36,6 +48,43 @@ func (k Keeper) SetSubaccount(ctx sdk.Context, subaccount types.Subaccount) {
 	}
 }
 
+// updateTotalBorrowed updates the total borrowed quantums of every non-USDC asset whose negative balance
+// changed, given a subaccount's asset positions before and after an update. Negative USDC balances are
+// not borrows of an asset and are not tracked.
+func (k Keeper) updateTotalBorrowed(
+	ctx sdk.Context,
+	oldPositions []*types.AssetPosition,
+	newPositions []*types.AssetPosition,
+) {
+	deltas := make(map[uint32]*big.Int)
+	assetIds := make([]uint32, 0)
+	addBorrowedQuantums := func(assetId uint32, quantums *big.Int, sign int) {
+		// Only negative balances of non-USDC assets are borrowed.
+		if assetId == assettypes.AssetUsdc.Id || quantums.Sign() >= 0 {
+			return
+		}
+		if _, exists := deltas[assetId]; !exists {
+			deltas[assetId] = new(big.Int)
+			assetIds = append(assetIds, assetId)
+		}
+		if sign > 0 {
+			deltas[assetId].Sub(deltas[assetId], quantums)
+		} else {
+			deltas[assetId].Add(deltas[assetId], quantums)
+		}
+	}
+	for _, position := range oldPositions {
+		addBorrowedQuantums(position.AssetId, position.Quantums.BigInt(), -1)
+	}
+	for _, position := range newPositions {
+		addBorrowedQuantums(position.AssetId, position.Quantums.BigInt(), 1)
+	}
+
+	for _, assetId := range assetIds {
+		k.assetsKeeper.ModifyTotalBorrowed(ctx, assetId, deltas[assetId])
+	}
+}
+
 // GetSubaccount returns a subaccount from its index.
 //
 // Note that this function is getting called very frequently; metrics in this function
@@ -191,8 +240,9 @@ func (k Keeper) getRandomBytes(ctx sdk.Context, rand *rand.Rand) ([]byte, error)
 
 // getSettledUpdates takes in a list of updates and for each update, retrieves
 // the updated subaccount in its settled form, and returns a list of settledUpdate
-// structs and a map that indicates for each subaccount which perpetuals had funding
-// updates. If requireUniqueSubaccount is true, the SubaccountIds in the input updates
+// structs, a map that indicates for each subaccount which perpetuals had funding
+// updates, and a map that indicates for each subaccount the borrow interest paid.
+// If requireUniqueSubaccount is true, the SubaccountIds in the input updates
 // must be unique.
 func (k Keeper) getSettledUpdates(
 	ctx sdk.Context,
@@ -201,44 +251,49 @@ func (k Keeper) getSettledUpdates(
 ) (
 	settledUpdates []settledUpdate,
 	subaccountIdToFundingPayments map[types.SubaccountId]map[uint32]dtypes.SerializableInt,
+	subaccountIdToBorrowInterest map[types.SubaccountId]*big.Int,
 	err error,
 ) {
 	var idToSettledSubaccount = make(map[types.SubaccountId]types.Subaccount)
 	settledUpdates = make([]settledUpdate, len(updates))
 	subaccountIdToFundingPayments = make(map[types.SubaccountId]map[uint32]dtypes.SerializableInt)
+	subaccountIdToBorrowInterest = make(map[types.SubaccountId]*big.Int)
 
 	// Iterate over all updates and query the relevant `Subaccounts`.
 	for i, u := range updates {
 		settledSubaccount, exists := idToSettledSubaccount[u.SubaccountId]
 		var fundingPayments map[uint32]dtypes.SerializableInt
+		var borrowInterest *big.Int
 
 		if exists && requireUniqueSubaccount {
-			return nil, nil, types.ErrNonUniqueUpdatesSubaccount
+			return nil, nil, nil, types.ErrNonUniqueUpdatesSubaccount
 		}
 
 		// Get and store the settledSubaccount if SubaccountId doesn't exist in
 		// idToSettledSubaccount map.
 		if !exists {
 			subaccount := k.GetSubaccount(ctx, u.SubaccountId)
-			settledSubaccount, fundingPayments, err = k.getSettledSubaccount(ctx, subaccount)
+			settledSubaccount, fundingPayments, borrowInterest, err = k.getSettledSubaccount(ctx, subaccount)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 
 			idToSettledSubaccount[u.SubaccountId] = settledSubaccount
 			subaccountIdToFundingPayments[u.SubaccountId] = fundingPayments
+			subaccountIdToBorrowInterest[u.SubaccountId] = borrowInterest
 		}
 
 		settledUpdate := settledUpdate{
-			SettledSubaccount: settledSubaccount,
-			AssetUpdates:      u.AssetUpdates,
-			PerpetualUpdates:  u.PerpetualUpdates,
+			SettledSubaccount:         settledSubaccount,
+			AssetUpdates:              u.AssetUpdates,
+			PerpetualUpdates:          u.PerpetualUpdates,
+			IsolatedCollateralUpdates: u.IsolatedCollateralUpdates,
 		}
 
 		settledUpdates[i] = settledUpdate
 	}
 
-	return settledUpdates, subaccountIdToFundingPayments, nil
+	return settledUpdates, subaccountIdToFundingPayments, subaccountIdToBorrowInterest, nil
 }
 
 // UpdateSubaccounts validates and applies all `updates` to the relevant subaccounts as long as this is a
@@ -265,7 +320,11 @@ func (k Keeper) UpdateSubaccounts(
 		metrics.Latency,
 	)
 
-	settledUpdates, subaccountIdToFundingPayments, err := k.getSettledUpdates(ctx, updates, true)
+	settledUpdates, subaccountIdToFundingPayments, subaccountIdToBorrowInterest, err := k.getSettledUpdates(
+		ctx,
+		updates,
+		true,
+	)
 	if err != nil {
 		return false, nil, err
 	}
@@ -275,6 +334,86 @@ func (k Keeper) UpdateSubaccounts(
 		return success, successPerUpdate, err
 	}
 
+	if err := k.applySettledUpdates(
+		ctx,
+		settledUpdates,
+		subaccountIdToFundingPayments,
+		subaccountIdToBorrowInterest,
+	); err != nil {
+		return false, nil, err
+	}
+
+	return success, successPerUpdate, nil
+}
+
+// ForceUpdateSubaccounts applies all `updates` to the relevant subaccounts without checking that they are
+// valid state-transitions. Collateralization, open interest caps, isolated margin requirements and
+// whether the updated positions are updatable are not checked. This is only meant for updates that the
+// protocol must apply regardless of the state of the subaccounts, such as closing positions in final
+// settlement. All `updates` are made atomically.
+//
+// Each `SubaccountId` in the `updates` must be unique or an error is returned.
+func (k Keeper) ForceUpdateSubaccounts(
+	ctx sdk.Context,
+	updates []types.Update,
+) (
+	err error,
+) {
+	defer telemetry.ModuleMeasureSince(
+		types.ModuleName,
+		time.Now(),
+		metrics.ForceUpdateSubaccounts,
+		metrics.Latency,
+	)
+
+	settledUpdates, subaccountIdToFundingPayments, subaccountIdToBorrowInterest, err := k.getSettledUpdates(
+		ctx,
+		updates,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Updates that are malformed for isolated margins can't be applied at all.
+	for i, u := range settledUpdates {
+		if _, valid := getIsolatedQuoteBalanceDeltas(u); !valid {
+			return errorsmod.Wrapf(
+				types.ErrFailedToUpdateSubaccounts,
+				"invalid isolated margin update for subaccount %+v",
+				updates[i].SubaccountId,
+			)
+		}
+	}
+
+	return k.applySettledUpdates(ctx, settledUpdates, subaccountIdToFundingPayments, subaccountIdToBorrowInterest)
+}
+
+// applySettledUpdates applies `settledUpdates` to the relevant subaccounts, pays the settled borrow
+// interest to the insurance fund, and emits indexer and cometbft events for the updates. The updates are
+// not validated.
+func (k Keeper) applySettledUpdates(
+	ctx sdk.Context,
+	settledUpdates []settledUpdate,
+	subaccountIdToFundingPayments map[types.SubaccountId]map[uint32]dtypes.SerializableInt,
+	subaccountIdToBorrowInterest map[types.SubaccountId]*big.Int,
+) error {
+	// Borrow interest settled from the USDC balances of subaccounts is paid to the insurance fund.
+	totalBorrowInterest := big.NewInt(0)
+	for _, borrowInterest := range subaccountIdToBorrowInterest {
+		totalBorrowInterest.Add(totalBorrowInterest, borrowInterest)
+	}
+	if err := k.TransferInsuranceFundPayments(ctx, totalBorrowInterest); err != nil {
+		return err
+	}
+
+	// Get the changes to the isolated margins of each update. These depend on the perpetual positions
+	// before the updates are applied. Note that the callers check that the updates are well-formed.
+	isolatedQuoteBalanceDeltas := make([]map[uint32]*big.Int, len(settledUpdates))
+	for i, u := range settledUpdates {
+		isolatedQuoteBalanceDeltas[i], _ = getIsolatedQuoteBalanceDeltas(u)
+	}
+
 	// Get a mapping from perpetual Id to current perpetual funding index.
 	allPerps := k.perpetualsKeeper.GetAllPerpetuals(ctx)
 	perpIdToFundingIndex := make(map[uint32]dtypes.SerializableInt)
@@ -288,8 +427,24 @@ func (k Keeper) UpdateSubaccounts(
 		perpIdToFundingIndex,
 	)
 
+	// Get a mapping from asset Id to current asset borrow index.
+	allAssets := k.assetsKeeper.GetAllAssets(ctx)
+	assetIdToBorrowIndex := make(map[uint32]uint64)
+	for _, asset := range allAssets {
+		assetIdToBorrowIndex[asset.Id] = asset.BorrowIndex
+	}
+
 	// Apply the updates to asset positions.
-	UpdateAssetPositions(settledUpdates)
+	UpdateAssetPositions(
+		settledUpdates,
+		assetIdToBorrowIndex,
+	)
+
+	// Apply the updates to isolated margins.
+	UpdateIsolatedMargins(
+		settledUpdates,
+		isolatedQuoteBalanceDeltas,
+	)
 
 	// Apply all updates, including a subaccount update event in the Indexer block message
 	// per update and emit a cometbft event for each settled funding payment.
@@ -309,7 +464,10 @@ func (k Keeper) UpdateSubaccounts(
 						u,
 						fundingPayments,
 					),
-					getUpdatedAssetPositions(u),
+					getUpdatedAssetPositions(
+						u,
+						subaccountIdToBorrowInterest[*u.SettledSubaccount.Id],
+					),
 					fundingPayments,
 				),
 			),
@@ -329,7 +487,7 @@ func (k Keeper) UpdateSubaccounts(
 		}
 	}
 
-	return success, successPerUpdate, err
+	return nil
 }
 
 // CanUpdateSubaccounts will validate all `updates` to the relevant subaccounts.
@@ -357,7 +515,7 @@ func (k Keeper) CanUpdateSubaccounts(
 		metrics.Latency,
 	)
 
-	settledUpdates, _, err := k.getSettledUpdates(ctx, updates, false)
+	settledUpdates, _, _, err := k.getSettledUpdates(ctx, updates, false)
 	if err != nil {
 		return false, nil, err
 	}
@@ -366,15 +524,17 @@ func (k Keeper) CanUpdateSubaccounts(
 }
 
 // getSettledSubaccount returns 1. a new settled subaccount given an unsettled subaccount,
-// updating the USDC AssetPosition, FundingIndex, and LastFundingPayment fields accordingly
-// (does not persist any changes) and 2. a map with perpetual ID as key and last funding
-// payment as value (for emitting funding payments to indexer).
+// updating the USDC AssetPosition, FundingIndex, LastFundingPayment and asset position Index
+// fields accordingly (does not persist any changes), 2. a map with perpetual ID as key and
+// last funding payment as value (for emitting funding payments to indexer) and 3. the total
+// borrow interest paid on negative asset positions, in quote quantums.
 func (k Keeper) getSettledSubaccount(
 	ctx sdk.Context,
 	subaccount types.Subaccount,
 ) (
 	settledSubaccount types.Subaccount,
 	fundingPayments map[uint32]dtypes.SerializableInt,
+	borrowInterest *big.Int,
 	err error,
 ) {
 	totalNetSettlementPpm := big.NewInt(0)
@@ -382,6 +542,18 @@ func (k Keeper) getSettledSubaccount(
 	newPerpetualPositions := []*types.PerpetualPosition{}
 	fundingPayments = make(map[uint32]dtypes.SerializableInt)
 
+	// Copy the isolated margins, since funding of isolated positions is settled against them.
+	newIsolatedMargins := make([]*types.IsolatedMargin, 0, len(subaccount.IsolatedMargins))
+	isolatedMarginsMap := make(map[uint32]*types.IsolatedMargin)
+	for _, m := range subaccount.IsolatedMargins {
+		newIsolatedMargin := &types.IsolatedMargin{
+			PerpetualId:  m.PerpetualId,
+			QuoteBalance: m.QuoteBalance,
+		}
+		newIsolatedMargins = append(newIsolatedMargins, newIsolatedMargin)
+		isolatedMarginsMap[m.PerpetualId] = newIsolatedMargin
+	}
+
 	// Iterate through and settle all perpetual positions.
 	for _, p := range subaccount.PerpetualPositions {
 		bigNetSettlementPpm, newFundingIndex, err := k.perpetualsKeeper.GetSettlementPpm(
@@ -391,7 +563,7 @@ func (k Keeper) getSettledSubaccount(
 			p.FundingIndex.BigInt(),
 		)
 		if err != nil {
-			return types.Subaccount{}, nil, err
+			return types.Subaccount{}, nil, nil, err
 		}
 		// Record non-zero funding payment (to be later emitted in SubaccountUpdateEvent to indexer).
 		// Note: Funding payment is the negative of settlement, i.e. positive settlement is equivalent
@@ -407,6 +579,17 @@ func (k Keeper) getSettledSubaccount(
 		// Aggregate all net settlements.
 		totalNetSettlementPpm.Add(totalNetSettlementPpm, bigNetSettlementPpm)
 
+		// Settle the funding of isolated positions against their isolated margin.
+		if isolatedMargin, isIsolated := isolatedMarginsMap[p.PerpetualId]; isIsolated &&
+			bigNetSettlementPpm.Sign() != 0 {
+			isolatedMargin.QuoteBalance = dtypes.NewIntFromBigInt(
+				new(big.Int).Add(
+					isolatedMargin.GetBigQuoteBalance(),
+					new(big.Int).Div(bigNetSettlementPpm, lib.BigIntOneMillion()),
+				),
+			)
+		}
+
 		// Update cached funding index of the perpetual position.
 		newPerpetualPositions = append(newPerpetualPositions, &types.PerpetualPosition{
 			PerpetualId:  p.PerpetualId,
@@ -415,11 +598,59 @@ func (k Keeper) getSettledSubaccount(
 		})
 	}
 
+	newAssetPositions := make([]*types.AssetPosition, 0, len(subaccount.AssetPositions))
+	totalBorrowInterestPpm := big.NewInt(0)
+
+	// Iterate through and settle borrow interest on all non-USDC asset positions.
+	for _, a := range subaccount.AssetPositions {
+		if a.AssetId == assettypes.AssetUsdc.Id {
+			newAssetPositions = append(newAssetPositions, &types.AssetPosition{
+				AssetId:  a.AssetId,
+				Quantums: a.Quantums,
+			})
+			continue
+		}
+
+		asset, exists := k.assetsKeeper.GetAsset(ctx, a.AssetId)
+		if !exists {
+			return types.Subaccount{}, nil, nil, errorsmod.Wrap(
+				assettypes.ErrAssetDoesNotExist,
+				lib.UintToString(a.AssetId),
+			)
+		}
+
+		// Interest accrues on borrowed balances only.
+		bigQuantums := a.GetBigQuantums()
+		if bigQuantums.Sign() < 0 && asset.BorrowIndex > a.Index {
+			totalBorrowInterestPpm.Add(
+				totalBorrowInterestPpm,
+				new(big.Int).Mul(
+					new(big.Int).SetUint64(asset.BorrowIndex-a.Index),
+					new(big.Int).Neg(bigQuantums),
+				),
+			)
+		}
+
+		// Update cached borrow index of the asset position.
+		newAssetPositions = append(newAssetPositions, &types.AssetPosition{
+			AssetId:  a.AssetId,
+			Quantums: a.Quantums,
+			Index:    asset.BorrowIndex,
+		})
+	}
+
+	// Borrow interest is rounded up so that it is never under-charged.
+	borrowInterest = lib.BigRatRound(
+		new(big.Rat).SetFrac(totalBorrowInterestPpm, lib.BigIntOneMillion()),
+		true,
+	)
+
 	newSubaccount := types.Subaccount{
 		Id:                 subaccount.Id,
-		AssetPositions:     subaccount.AssetPositions,
+		AssetPositions:     newAssetPositions,
 		PerpetualPositions: newPerpetualPositions,
 		MarginEnabled:      subaccount.MarginEnabled,
+		IsolatedMargins:    newIsolatedMargins,
 	}
 	newUsdcPosition := new(big.Int).Add(
 		subaccount.GetUsdcPosition(),
@@ -427,9 +658,10 @@ func (k Keeper) getSettledSubaccount(
 		// division result always rounds towards negative infinity.
 		totalNetSettlementPpm.Div(totalNetSettlementPpm, lib.BigIntOneMillion()),
 	)
+	newUsdcPosition.Sub(newUsdcPosition, borrowInterest)
 	// TODO(CLOB-993): Remove this function and use `UpdateAssetPositions` instead.
 	newSubaccount.SetUsdcAssetPosition(newUsdcPosition)
-	return newSubaccount, fundingPayments, nil
+	return newSubaccount, fundingPayments, borrowInterest, nil
 }
 
 func checkPositionUpdatable(
@@ -462,7 +694,8 @@ func checkPositionUpdatable(
 // The `updates` do not have to contain `Subaccounts` with unique `SubaccountIds`.
 // Each update is considered in isolation. Thus if two updates are provided
 // with the same `Subaccount`, they are validated without respect to each
-// other.
+// other. The only exception is open interest caps, which are checked against the
+// net change in open interest across all updates.
 // The input subaccounts must be settled.
 //
 // Returns a `success` value of `true` if all updates are valid.
@@ -480,6 +713,9 @@ func (k Keeper) internalCanUpdateSubaccounts(
 	success = true
 	successPerUpdate = make([]types.UpdateResult, len(settledUpdates))
 
+	// Open interest caps apply to the net change in open interest across all updates.
+	openInterestDeltas := getOpenInterestDeltas(settledUpdates)
+
 	// Iterate over all updates.
 	for i, u := range settledUpdates {
 		// Check all updated perps are updatable.
@@ -490,6 +726,18 @@ func (k Keeper) internalCanUpdateSubaccounts(
 			}
 		}
 
+		// Check all perps of isolated collateral updates are updatable.
+		for _, isolatedCollateralUpdate := range u.IsolatedCollateralUpdates {
+			err := checkPositionUpdatable(
+				ctx,
+				k.perpetualsKeeper,
+				types.PerpetualUpdate{PerpetualId: isolatedCollateralUpdate.PerpetualId},
+			)
+			if err != nil {
+				return false, nil, err
+			}
+		}
+
 		// Check all updated assets are updatable.
 		for _, assetUpdate := range u.AssetUpdates {
 			err := checkPositionUpdatable(ctx, k.assetsKeeper, assetUpdate)
@@ -498,44 +746,47 @@ func (k Keeper) internalCanUpdateSubaccounts(
 			}
 		}
 
-		// Get the new collateralization and margin requirements with the update applied.
-		bigNewNetCollateral,
-			bigNewInitialMargin,
-			bigNewMaintenanceMargin,
-			err := k.internalGetNetCollateralAndMarginRequirements(ctx, u)
+		// The update would leave the subaccount with a negative balance of an asset that cannot
+		// be borrowed, e.g. selling more of a spot asset than the subaccount holds.
+		if k.hasNegativeNonBorrowableAssetBalance(ctx, u) {
+			success = false
+			successPerUpdate[i] = types.NegativeAssetBalance
+			continue
+		}
+
+		// The update would grow a perpetual position while the updates increase the open interest of the
+		// perpetual above its cap. Updates that only reduce positions are always allowed.
+		violatesOpenInterestCap, err := k.violatesOpenInterestCap(ctx, u, openInterestDeltas)
 		if err != nil {
 			return false, nil, err
 		}
+		if violatesOpenInterestCap {
+			success = false
+			successPerUpdate[i] = types.ViolatesOpenInterestCap
+			continue
+		}
 
-		var result = types.Success
-
-		// The subaccount is not well-collateralized after the update.
-		// We must now check if the state transition is valid.
-		if bigNewInitialMargin.Cmp(bigNewNetCollateral) > 0 {
-			// Get the current collateralization and margin requirements without the update applied.
-			emptyUpdate := settledUpdate{
-				SettledSubaccount: u.SettledSubaccount,
-			}
+		// Isolated positions are only backed by the collateral allotted to them, so the cross-margined
+		// positions and each isolated position changed by the update are checked separately.
+		marginUpdates, valid, err := k.splitIsolatedMargins(ctx, u)
+		if err != nil {
+			return false, nil, err
+		}
+		if !valid {
+			success = false
+			successPerUpdate[i] = types.ViolatesIsolatedMargin
+			continue
+		}
 
-			bigCurNetCollateral,
-				bigCurInitialMargin,
-				bigCurMaintenanceMargin,
-				err := k.internalGetNetCollateralAndMarginRequirements(
-				ctx,
-				emptyUpdate,
-			)
+		var result = types.Success
+		for _, marginUpdate := range marginUpdates {
+			result, err = k.getCollateralizationResult(ctx, marginUpdate)
 			if err != nil {
 				return false, nil, err
 			}
-
-			// Determine whether the state transition is valid.
-			result = IsValidStateTransitionForUndercollateralizedSubaccount(
-				bigCurNetCollateral,
-				bigCurInitialMargin,
-				bigCurMaintenanceMargin,
-				bigNewNetCollateral,
-				bigNewMaintenanceMargin,
-			)
+			if !result.IsSuccess() {
+				break
+			}
 		}
 
 		// If this state transition is not valid, the overall success is now false.
@@ -549,6 +800,58 @@ func (k Keeper) internalCanUpdateSubaccounts(
 	return success, successPerUpdate, nil
 }
 
+// getCollateralizationResult returns whether the settled subaccount of `u` is well-collateralized after
+// the update is applied, or if not, whether the update is a valid state transition for an
+// undercollateralized subaccount.
+func (k Keeper) getCollateralizationResult(
+	ctx sdk.Context,
+	u settledUpdate,
+) (
+	result types.UpdateResult,
+	err error,
+) {
+	// Get the new collateralization and margin requirements with the update applied.
+	bigNewNetCollateral,
+		bigNewInitialMargin,
+		bigNewMaintenanceMargin,
+		err := k.internalGetNetCollateralAndMarginRequirements(ctx, u)
+	if err != nil {
+		return types.UpdateCausedError, err
+	}
+
+	// The subaccount is well-collateralized after the update.
+	if bigNewInitialMargin.Cmp(bigNewNetCollateral) <= 0 {
+		return types.Success, nil
+	}
+
+	// The subaccount is not well-collateralized after the update.
+	// We must now check if the state transition is valid.
+	// Get the current collateralization and margin requirements without the update applied.
+	emptyUpdate := settledUpdate{
+		SettledSubaccount: u.SettledSubaccount,
+	}
+
+	bigCurNetCollateral,
+		bigCurInitialMargin,
+		bigCurMaintenanceMargin,
+		err := k.internalGetNetCollateralAndMarginRequirements(
+		ctx,
+		emptyUpdate,
+	)
+	if err != nil {
+		return types.UpdateCausedError, err
+	}
+
+	// Determine whether the state transition is valid.
+	return IsValidStateTransitionForUndercollateralizedSubaccount(
+		bigCurNetCollateral,
+		bigCurInitialMargin,
+		bigCurMaintenanceMargin,
+		bigNewNetCollateral,
+		bigNewMaintenanceMargin,
+	), nil
+}
+
 // IsValidStateTransitionForUndercollateralizedSubaccount returns an `UpdateResult`
 // denoting whether this state transition is valid. This function accepts the collateral and
 // margin requirements of a subaccount before and after an update ("cur" and
@@ -638,7 +941,7 @@ func (k Keeper) GetNetCollateralAndMarginRequirements(
 ) {
 	subaccount := k.GetSubaccount(ctx, update.SubaccountId)
 
-	settledSubaccount, _, err := k.getSettledSubaccount(ctx, subaccount)
+	settledSubaccount, _, _, err := k.getSettledSubaccount(ctx, subaccount)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -2,17 +2,22 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // getUpdatedAssetPositions filters out all the asset positions on a subaccount that have
-// been updated. This will include any asset postions that were closed due to an update.
+// been updated. This will include any asset postions that were closed due to an update, and
+// the USDC position if borrow interest was paid.
 // TODO(DEC-1295): look into reducing code duplication here using Generics+Reflect.
 func getUpdatedAssetPositions(
 	update settledUpdate,
+	borrowInterest *big.Int,
 ) []*types.AssetPosition {
 	assetIdToPositionMap := make(map[uint32]*types.AssetPosition)
 	for _, assetPosition := range update.SettledSubaccount.AssetPositions {
@@ -23,6 +28,10 @@ func getUpdatedAssetPositions(
 	for _, assetUpdate := range update.AssetUpdates {
 		updatedAssetIds[assetUpdate.AssetId] = struct{}{}
 	}
+	// Mark USDC as updated if borrow interest was paid from it.
+	if borrowInterest != nil && borrowInterest.Sign() != 0 {
+		updatedAssetIds[assettypes.AssetUsdc.Id] = struct{}{}
+	}
 
 	updatedAssetPositions := make([]*types.AssetPosition, 0, len(updatedAssetIds))
 	for updatedId := range updatedAssetIds {
@@ -49,6 +58,95 @@ func getUpdatedAssetPositions(
 	return updatedAssetPositions
 }
 
+// hasNegativeNonBorrowableAssetBalance returns true if applying the asset updates of `update` would
+// result in a negative position for any asset other than USDC that is not borrowable.
+func (k Keeper) hasNegativeNonBorrowableAssetBalance(
+	ctx sdk.Context,
+	update settledUpdate,
+) bool {
+	for _, assetUpdate := range update.AssetUpdates {
+		if assetUpdate.AssetId == assettypes.AssetUsdc.Id {
+			continue
+		}
+
+		assetPosition, _ := update.SettledSubaccount.GetAssetPositionForId(assetUpdate.AssetId)
+		bigNewQuantums := new(big.Int).Add(assetPosition.GetBigQuantums(), assetUpdate.GetBigQuantums())
+		if bigNewQuantums.Sign() >= 0 {
+			continue
+		}
+
+		if asset, exists := k.assetsKeeper.GetAsset(ctx, assetUpdate.AssetId); exists && !asset.IsBorrowable() {
+			return true
+		}
+	}
+	return false
+}
+
+// getOpenInterestDeltas returns the net change in open interest of each perpetual if all `settledUpdates`
+// were applied. As in `updateOpenInterest`, open interest only counts long positions, so that a matched trade
+// between two subaccounts in the same batch is counted exactly once.
+func getOpenInterestDeltas(settledUpdates []settledUpdate) map[uint32]*big.Int {
+	openInterestDeltas := make(map[uint32]*big.Int)
+	for _, u := range settledUpdates {
+		for _, perpetualUpdate := range u.PerpetualUpdates {
+			perpetualPosition, _ := u.SettledSubaccount.GetPerpetualPositionForId(perpetualUpdate.PerpetualId)
+			bigOldQuantums := perpetualPosition.GetBigQuantums()
+			bigNewQuantums := new(big.Int).Add(bigOldQuantums, perpetualUpdate.GetBigQuantums())
+
+			delta, exists := openInterestDeltas[perpetualUpdate.PerpetualId]
+			if !exists {
+				delta = new(big.Int)
+				openInterestDeltas[perpetualUpdate.PerpetualId] = delta
+			}
+			if bigNewQuantums.Sign() > 0 {
+				delta.Add(delta, bigNewQuantums)
+			}
+			if bigOldQuantums.Sign() > 0 {
+				delta.Sub(delta, bigOldQuantums)
+			}
+		}
+	}
+	return openInterestDeltas
+}
+
+// violatesOpenInterestCap returns true if applying the perpetual updates of `update` would increase the
+// size of a perpetual position in a perpetual whose open interest increases by `openInterestDeltas` across
+// the whole batch of updates to above its open interest cap. Updates that reduce the size of a position, and
+// updates to perpetuals whose open interest does not increase, never violate the cap.
+func (k Keeper) violatesOpenInterestCap(
+	ctx sdk.Context,
+	update settledUpdate,
+	openInterestDeltas map[uint32]*big.Int,
+) (bool, error) {
+	for _, perpetualUpdate := range update.PerpetualUpdates {
+		perpetualPosition, _ := update.SettledSubaccount.GetPerpetualPositionForId(perpetualUpdate.PerpetualId)
+		bigOldQuantums := perpetualPosition.GetBigQuantums()
+		bigNewQuantums := new(big.Int).Add(bigOldQuantums, perpetualUpdate.GetBigQuantums())
+		if bigNewQuantums.CmpAbs(bigOldQuantums) <= 0 {
+			continue
+		}
+
+		openInterestDelta := openInterestDeltas[perpetualUpdate.PerpetualId]
+		if openInterestDelta == nil || openInterestDelta.Sign() <= 0 {
+			continue
+		}
+
+		openInterest := k.GetOpenInterest(ctx, perpetualUpdate.PerpetualId)
+		withinCap, err := k.perpetualsKeeper.IsOpenInterestWithinCap(
+			ctx,
+			perpetualUpdate.PerpetualId,
+			openInterest.Add(openInterest, openInterestDelta),
+		)
+		if err != nil {
+			return false, err
+		}
+		if !withinCap {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // getUpdatedPerpetualPositions filters out all the perpetual positions on a subaccount that have
 // been updated. This will include any perpetual postions that were closed due to an update or that
 // received / paid out funding payments..
@@ -165,8 +263,10 @@ func UpdatePerpetualPositions(
 
 // For each settledUpdate in settledUpdates, updates its SettledSubaccount.AssetPositions
 // to reflect settledUpdate.AssetUpdates.
+// For newly created positions, use `assetIdToBorrowIndex` map to populate the `Index` field.
 func UpdateAssetPositions(
 	settledUpdates []settledUpdate,
+	assetIdToBorrowIndex map[uint32]uint64,
 ) {
 	// Apply the updates.
 	for i, u := range settledUpdates {
@@ -196,9 +296,16 @@ func UpdateAssetPositions(
 				// This subaccount does not have a matching asset position for this update.
 
 				// Create the new asset position.
+				borrowIndex, exists := assetIdToBorrowIndex[au.AssetId]
+				if !exists {
+					// Invariant: `assetIdToBorrowIndex` contains all existing assets,
+					// and asset position update must refer to an existing asset.
+					panic(fmt.Sprintf("asset id %d not found in assetIdToBorrowIndex", au.AssetId))
+				}
 				assetPosition := &types.AssetPosition{
 					AssetId:  au.AssetId,
 					Quantums: dtypes.NewIntFromBigInt(au.GetBigQuantums()),
+					Index:    borrowIndex,
 				}
 
 				// Add the new asset position to the map.
@@ -8,8 +8,11 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	auth_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/auth"
+	bank_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/bank"
 	big_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/big"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
@@ -17,6 +20,7 @@ import (
 	perptest "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
 	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
 	asstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/keeper"
@@ -156,6 +160,74 @@ func TestGetAllSubaccount(t *testing.T) {
 	)
 }
 
+func TestSetSubaccount_TotalBorrowed(t *testing.T) {
+	ctx, keeper, _, _, _, _, assetsKeeper, _ := testutil.SubaccountsKeepers(t, true)
+	btcId := constants.BtcUsd_Borrowable.Id
+
+	// Alice and Bob borrow BTC.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*types.AssetPosition{
+			{AssetId: asstypes.AssetUsdc.Id, Quantums: dtypes.NewInt(1_000_000)},
+			{AssetId: btcId, Quantums: dtypes.NewInt(-100)},
+		},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Bob_Num0,
+		AssetPositions: []*types.AssetPosition{
+			{AssetId: asstypes.AssetUsdc.Id, Quantums: dtypes.NewInt(1_000_000)},
+			{AssetId: btcId, Quantums: dtypes.NewInt(-50)},
+		},
+	})
+	require.Equal(t, big.NewInt(150), assetsKeeper.GetTotalBorrowed(ctx, btcId))
+
+	// Alice flips to a positive balance, and Bob borrows more.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*types.AssetPosition{
+			{AssetId: asstypes.AssetUsdc.Id, Quantums: dtypes.NewInt(1_000_000)},
+			{AssetId: btcId, Quantums: dtypes.NewInt(20)},
+		},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Bob_Num0,
+		AssetPositions: []*types.AssetPosition{
+			{AssetId: asstypes.AssetUsdc.Id, Quantums: dtypes.NewInt(1_000_000)},
+			{AssetId: btcId, Quantums: dtypes.NewInt(-70)},
+		},
+	})
+	require.Equal(t, big.NewInt(70), assetsKeeper.GetTotalBorrowed(ctx, btcId))
+
+	// Removing Bob's subaccount from state repays the rest.
+	keeper.SetSubaccount(ctx, types.Subaccount{Id: &constants.Bob_Num0})
+	require.Equal(t, big.NewInt(0), assetsKeeper.GetTotalBorrowed(ctx, btcId))
+}
+
+func TestSetSubaccount_TotalBorrowedIgnoresUsdc(t *testing.T) {
+	ctx, keeper, _, _, _, _, assetsKeeper, _ := testutil.SubaccountsKeepers(t, true)
+	btcId := constants.BtcUsd_Borrowable.Id
+
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*types.AssetPosition{
+			{AssetId: asstypes.AssetUsdc.Id, Quantums: dtypes.NewInt(1_000_000)},
+			{AssetId: btcId, Quantums: dtypes.NewInt(-100)},
+		},
+	})
+	require.Equal(t, big.NewInt(100), assetsKeeper.GetTotalBorrowed(ctx, btcId))
+
+	// A negative USDC balance is not a borrow.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*types.AssetPosition{
+			{AssetId: asstypes.AssetUsdc.Id, Quantums: dtypes.NewInt(-1_000_000)},
+			{AssetId: btcId, Quantums: dtypes.NewInt(-100)},
+		},
+	})
+	require.Equal(t, big.NewInt(0), assetsKeeper.GetTotalBorrowed(ctx, asstypes.AssetUsdc.Id))
+	require.Equal(t, big.NewInt(100), assetsKeeper.GetTotalBorrowed(ctx, btcId))
+}
+
 func TestForEachSubaccount(t *testing.T) {
 	tests := map[string]struct {
 		numSubaccountsInState int
@@ -206,6 +278,162 @@ func TestForEachSubaccount(t *testing.T) {
 	}
 }
 
+func TestForEachSubaccountIdWithPerpetualPosition(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _ := testutil.SubaccountsKeepers(t, true)
+	getSubaccountIds := func(perpetualId uint32, isLong bool) []types.SubaccountId {
+		subaccountIds := make([]types.SubaccountId, 0)
+		keeper.ForEachSubaccountIdWithPerpetualPosition(
+			ctx,
+			perpetualId,
+			isLong,
+			func(subaccountId types.SubaccountId) bool {
+				subaccountIds = append(subaccountIds, subaccountId)
+				return false
+			},
+		)
+		return subaccountIds
+	}
+
+	// Open a long BTC position for Alice, a short BTC position for Bob, and long BTC and ETH positions for Carl.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Bob_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCShort},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Carl_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{
+			&constants.PerpetualPosition_OneTenthBTCLong,
+			&constants.PerpetualPosition_OneTenthEthLong,
+		},
+	})
+	require.ElementsMatch(
+		t,
+		[]types.SubaccountId{constants.Alice_Num0, constants.Carl_Num0},
+		getSubaccountIds(0, true),
+	)
+	require.Equal(t, []types.SubaccountId{constants.Bob_Num0}, getSubaccountIds(0, false))
+	require.Equal(t, []types.SubaccountId{constants.Carl_Num0}, getSubaccountIds(1, true))
+	require.Empty(t, getSubaccountIds(1, false))
+
+	// Flip Alice's position to short, close Bob's position and close Carl's ETH position.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneTenthBTCShort},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000)),
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Carl_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneTenthBTCLong},
+	})
+	require.Equal(t, []types.SubaccountId{constants.Carl_Num0}, getSubaccountIds(0, true))
+	require.Equal(t, []types.SubaccountId{constants.Alice_Num0}, getSubaccountIds(0, false))
+	require.Empty(t, getSubaccountIds(1, true))
+	require.Empty(t, getSubaccountIds(1, false))
+
+	// Removing a subaccount from state removes it from the index.
+	keeper.SetSubaccount(ctx, types.Subaccount{Id: &constants.Carl_Num0})
+	require.Empty(t, getSubaccountIds(0, true))
+}
+
+func TestGetOpenInterest(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _ := testutil.SubaccountsKeepers(t, true)
+	require.Equal(t, big.NewInt(0), keeper.GetOpenInterest(ctx, 0))
+
+	// Alice is long 1 BTC and Bob and Carl are short 0.9 BTC and 0.1 BTC. Carl is also long 0.1 ETH.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Bob_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{
+			{
+				PerpetualId: 0,
+				Quantums:    dtypes.NewInt(-90_000_000),
+			},
+		},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Carl_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{
+			&constants.PerpetualPosition_OneTenthBTCShort,
+			&constants.PerpetualPosition_OneTenthEthLong,
+		},
+	})
+	require.Equal(t, big.NewInt(100_000_000), keeper.GetOpenInterest(ctx, 0))
+	require.Equal(t, big.NewInt(100_000_000), keeper.GetOpenInterest(ctx, 1))
+
+	// Alice sells 1.1 BTC to Bob and Carl, flipping her position to short 0.1 BTC and flipping
+	// Carl's position to long 0.1 BTC.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneTenthBTCShort},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:             &constants.Bob_Num0,
+		AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000)),
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id: &constants.Carl_Num0,
+		PerpetualPositions: []*types.PerpetualPosition{
+			&constants.PerpetualPosition_OneTenthBTCLong,
+			&constants.PerpetualPosition_OneTenthEthLong,
+		},
+	})
+	require.Equal(t, big.NewInt(10_000_000), keeper.GetOpenInterest(ctx, 0))
+	require.Equal(t, big.NewInt(100_000_000), keeper.GetOpenInterest(ctx, 1))
+
+	// Removing all positions from state removes all open interest.
+	keeper.SetSubaccount(ctx, types.Subaccount{Id: &constants.Alice_Num0})
+	keeper.SetSubaccount(ctx, types.Subaccount{Id: &constants.Carl_Num0})
+	require.Equal(t, big.NewInt(0), keeper.GetOpenInterest(ctx, 0))
+	require.Equal(t, big.NewInt(0), keeper.GetOpenInterest(ctx, 1))
+}
+
+func TestInitializeSubaccountIndices(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, storeKey := testutil.SubaccountsKeepers(t, true)
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		AssetPositions:     testutil.CreateUsdcAssetPosition(big.NewInt(-1_000)),
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong},
+	})
+
+	// Clear the indices, as if the subaccount had been written before they existed.
+	store := ctx.KVStore(storeKey)
+	for _, keyPrefix := range []string{
+		types.PerpetualPositionIndexKeyPrefix,
+		types.BorrowerIndexKeyPrefix,
+		types.OpenInterestKeyPrefix,
+	} {
+		iterator := sdk.KVStorePrefixIterator(store, []byte(keyPrefix))
+		for ; iterator.Valid(); iterator.Next() {
+			store.Delete(iterator.Key())
+		}
+		iterator.Close()
+	}
+	require.Equal(t, big.NewInt(0), keeper.GetOpenInterest(ctx, 0))
+
+	keeper.InitializeSubaccountIndices(ctx)
+	require.Equal(t, big.NewInt(100_000_000), keeper.GetOpenInterest(ctx, 0))
+	keeper.ForEachSubaccountIdWithPerpetualPosition(ctx, 0, true, func(subaccountId types.SubaccountId) bool {
+		require.Equal(t, constants.Alice_Num0, subaccountId)
+		return false
+	})
+	res, err := keeper.SubaccountsWithBorrowings(
+		sdk.WrapSDKContext(ctx),
+		&types.QuerySubaccountsWithBorrowingsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.SubaccountId{constants.Alice_Num0}, res.SubaccountIds)
+}
+
 func TestForEachSubaccountRandomStart(t *testing.T) {
 	tests := map[string]struct {
 		numSubaccountsInState int
@@ -1549,6 +1777,122 @@ func TestUpdateSubaccounts(t *testing.T) {
 			},
 			msgSenderEnabled: true,
 		},
+		"update would make balance of non-borrowable asset negative": {
+			expectedQuoteBalance:     big.NewInt(0),
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NegativeAssetBalance},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+			expectedAssetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(-200_000_000), // -2 BTC
+						},
+					},
+				},
+			},
+			msgSenderEnabled: true,
+		},
+		"update would increase open interest above the cap": {
+			expectedQuoteBalance:     big.NewInt(0),
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.ViolatesOpenInterestCap},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_SmallMarginRequirement_OpenInterestCap60000USD,
+			},
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  uint32(0),
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			expectedPerpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  uint32(0),
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-25_000_000_000)), // -$25,000
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(0),
+							BigQuantumsDelta: big.NewInt(50_000_000), // 0.5 BTC
+						},
+					},
+				},
+			},
+			msgSenderEnabled: true,
+		},
+		"update reducing a position is allowed while open interest is above the cap": {
+			expectedQuoteBalance:     big.NewInt(50_000_000_000), // $50,000
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_SmallMarginRequirement_OpenInterestCap60000USD,
+			},
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  uint32(0),
+					Quantums:     dtypes.NewInt(200_000_000), // 2 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			expectedPerpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  uint32(0),
+					Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  uint32(0),
+					Quantums: dtypes.NewInt(50_000_000_000), // $50,000
+				},
+			},
+			expectedUpdatedPerpetualPositions: map[types.SubaccountId][]*types.PerpetualPosition{
+				defaultSubaccountId: {
+					{
+						PerpetualId:  uint32(0),
+						Quantums:     dtypes.NewInt(100_000_000), // 1 BTC
+						FundingIndex: dtypes.NewInt(0),
+					},
+				},
+			},
+			expectedUpdatedAssetPositions: map[types.SubaccountId][]*types.AssetPosition{
+				defaultSubaccountId: {
+					{
+						AssetId:  uint32(0),
+						Quantums: dtypes.NewInt(50_000_000_000), // $50,000
+					},
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(50_000_000_000)), // $50,000
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(0),
+							BigQuantumsDelta: big.NewInt(-100_000_000), // -1 BTC
+						},
+					},
+				},
+			},
+			msgSenderEnabled: true,
+		},
 		"update would make account undercollateralized": {
 			expectedQuoteBalance:     big.NewInt(0),
 			expectedSuccess:          false,
@@ -2177,8 +2521,20 @@ func TestUpdateSubaccounts(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
+
+				if a.IsBorrowable() {
+					_, err = assetsKeeper.SetMarginParams(
+						ctx,
+						a.Id,
+						a.InitialMarginPpm,
+						a.MaintenanceFractionPpm,
+						a.BorrowRatePpm,
+					)
+					require.NoError(t, err)
+				}
 			}
 
 			for i, p := range tc.perpetuals {
@@ -2190,6 +2546,11 @@ func TestUpdateSubaccounts(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 
@@ -2260,6 +2621,251 @@ func TestUpdateSubaccounts(t *testing.T) {
 	}
 }
 
+func TestUpdateSubaccounts_BorrowInterest(t *testing.T) {
+	tests := map[string]struct {
+		// state
+		borrowIndexDelta *big.Int
+
+		// subaccount state
+		assetPositions []*types.AssetPosition
+
+		// updates
+		assetUpdates []types.AssetUpdate
+
+		// expectations
+		expectedAssetPositions        []*types.AssetPosition
+		expectedInsuranceFundBalance  int64
+		expectedUpdatedAssetPositions []*types.AssetPosition
+	}{
+		"new borrow is indexed at the current borrow index": {
+			borrowIndexDelta: big.NewInt(1_000_000),
+			assetPositions:   testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+			assetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          asstypes.AssetUsdc.Id,
+					BigQuantumsDelta: big.NewInt(50_000_000_000),
+				},
+				{
+					AssetId:          constants.BtcUsd_Borrowable.Id,
+					BigQuantumsDelta: big.NewInt(-100_000_000), // -1 BTC
+				},
+			},
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(150_000_000_000),
+				},
+				{
+					AssetId:  constants.BtcUsd_Borrowable.Id,
+					Quantums: dtypes.NewInt(-100_000_000),
+					Index:    1_000_000,
+				},
+			},
+			expectedInsuranceFundBalance: 0,
+			expectedUpdatedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(150_000_000_000),
+				},
+				{
+					AssetId:  constants.BtcUsd_Borrowable.Id,
+					Quantums: dtypes.NewInt(-100_000_000),
+					Index:    1_000_000,
+				},
+			},
+		},
+		"borrow interest is settled from USDC and paid to the insurance fund": {
+			borrowIndexDelta: big.NewInt(1_000_000),
+			assetPositions: append(
+				testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+				&constants.Short_Asset_1BTC,
+			),
+			assetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          asstypes.AssetUsdc.Id,
+					BigQuantumsDelta: big.NewInt(1_000_000),
+				},
+			},
+			// $100,000 + $1 - (1_000_000 * 100_000_000 / 1_000_000) interest.
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(99_901_000_000),
+				},
+				{
+					AssetId:  constants.BtcUsd_Borrowable.Id,
+					Quantums: dtypes.NewInt(-100_000_000),
+					Index:    1_000_000,
+				},
+			},
+			expectedInsuranceFundBalance: 100_000_000,
+			expectedUpdatedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(99_901_000_000),
+				},
+			},
+		},
+		"borrow interest is rounded up": {
+			borrowIndexDelta: big.NewInt(3),
+			assetPositions: append(
+				testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+				&types.AssetPosition{
+					AssetId:  constants.BtcUsd_Borrowable.Id,
+					Quantums: dtypes.NewInt(-1_000_000),
+				},
+			),
+			assetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          constants.BtcUsd_Borrowable.Id,
+					BigQuantumsDelta: big.NewInt(-1_000_000),
+				},
+			},
+			// 3 * 1_000_000 / 1_000_000 = 3.
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(99_999_999_997),
+				},
+				{
+					AssetId:  constants.BtcUsd_Borrowable.Id,
+					Quantums: dtypes.NewInt(-2_000_000),
+					Index:    3,
+				},
+			},
+			expectedInsuranceFundBalance: 3,
+			expectedUpdatedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(99_999_999_997),
+				},
+				{
+					AssetId:  constants.BtcUsd_Borrowable.Id,
+					Quantums: dtypes.NewInt(-2_000_000),
+					Index:    3,
+				},
+			},
+		},
+		"positive balances do not pay borrow interest": {
+			borrowIndexDelta: big.NewInt(1_000_000),
+			assetPositions: append(
+				testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+				&constants.Long_Asset_1BTC,
+			),
+			assetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          asstypes.AssetUsdc.Id,
+					BigQuantumsDelta: big.NewInt(1_000_000),
+				},
+			},
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(100_001_000_000),
+				},
+				{
+					AssetId:  constants.BtcUsd_Borrowable.Id,
+					Quantums: dtypes.NewInt(100_000_000),
+					Index:    1_000_000,
+				},
+			},
+			expectedInsuranceFundBalance: 0,
+			expectedUpdatedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(100_001_000_000),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, _, accountKeeper, bankKeeper, assetsKeeper, _ := testutil.SubaccountsKeepers(
+				t,
+				true,
+			)
+			testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+
+			auth_testutil.CreateTestModuleAccount(ctx, accountKeeper, types.ModuleName, []string{})
+			auth_testutil.CreateTestModuleAccount(ctx, accountKeeper, clobtypes.InsuranceFundName, []string{})
+			err := bank_testutil.FundModuleAccount(
+				ctx,
+				types.ModuleName,
+				sdk.Coins{
+					sdk.NewInt64Coin(constants.Usdc.Denom, 100_000_000_000),
+				},
+				*bankKeeper,
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+			a := constants.BtcUsd_Borrowable
+			_, err = assetsKeeper.CreateAsset(
+				ctx,
+				a.Id,
+				a.Symbol,
+				a.Denom,
+				a.DenomExponent,
+				a.HasMarket,
+				a.MarketId,
+				a.AtomicResolution,
+				a.CollateralWeightPpm,
+			)
+			require.NoError(t, err)
+			_, err = assetsKeeper.SetMarginParams(
+				ctx,
+				a.Id,
+				a.InitialMarginPpm,
+				a.MaintenanceFractionPpm,
+				a.BorrowRatePpm,
+			)
+			require.NoError(t, err)
+			require.NoError(t, assetsKeeper.ModifyBorrowIndex(ctx, a.Id, tc.borrowIndexDelta))
+
+			subaccount := createNSubaccount(keeper, ctx, 1, big.NewInt(0))[0]
+			subaccount.AssetPositions = tc.assetPositions
+			keeper.SetSubaccount(ctx, subaccount)
+
+			updates := []types.Update{
+				{
+					SubaccountId: *subaccount.Id,
+					AssetUpdates: tc.assetUpdates,
+				},
+			}
+			success, successPerUpdate, err := keeper.UpdateSubaccounts(ctx, updates)
+			require.NoError(t, err)
+			require.True(t, success)
+			require.Equal(t, []types.UpdateResult{types.Success}, successPerUpdate)
+
+			newSubaccount := keeper.GetSubaccount(ctx, *subaccount.Id)
+			require.Equal(t, tc.expectedAssetPositions, newSubaccount.AssetPositions)
+
+			insuranceFundBalance := bankKeeper.GetBalance(
+				ctx,
+				authtypes.NewModuleAddress(clobtypes.InsuranceFundName),
+				constants.Usdc.Denom,
+			)
+			require.Equal(t, tc.expectedInsuranceFundBalance, insuranceFundBalance.Amount.Int64())
+
+			assertSubaccountUpdateEventsInIndexerBlock(
+				t,
+				keeper,
+				ctx,
+				nil,
+				true,
+				updates,
+				successPerUpdate,
+				map[types.SubaccountId][]*types.PerpetualPosition{},
+				map[types.SubaccountId]map[uint32]dtypes.SerializableInt{},
+				map[types.SubaccountId][]*types.AssetPosition{
+					*subaccount.Id: tc.expectedUpdatedAssetPositions,
+				},
+			)
+		})
+	}
+}
+
 func TestCanUpdateSubaccounts(t *testing.T) {
 	tests := map[string]struct {
 		// State.
@@ -2789,8 +3395,20 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
+
+				if a.IsBorrowable() {
+					_, err = assetsKeeper.SetMarginParams(
+						ctx,
+						a.Id,
+						a.InitialMarginPpm,
+						a.MaintenanceFractionPpm,
+						a.BorrowRatePpm,
+					)
+					require.NoError(t, err)
+				}
 			}
 
 			for _, m := range tc.marketParamPrices {
@@ -2811,6 +3429,11 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -2843,6 +3466,166 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 	}
 }
 
+func TestCanUpdateSubaccounts_OpenInterestCap(t *testing.T) {
+	tests := map[string]struct {
+		// Perpetual updates of the subaccounts with a 1 BTC long, 0.5 BTC long and 1.5 BTC short position.
+		perpetualQuantumsDeltas []int64
+
+		// Expectations.
+		expectedSuccess          bool
+		expectedSuccessPerUpdate []types.UpdateResult
+	}{
+		"position moved between subaccounts does not increase open interest above the cap": {
+			perpetualQuantumsDeltas: []int64{50_000_000, -50_000_000, 0},
+			expectedSuccess:         true,
+			expectedSuccessPerUpdate: []types.UpdateResult{
+				types.Success,
+				types.Success,
+				types.Success,
+			},
+		},
+		"new positions increase open interest above the cap": {
+			perpetualQuantumsDeltas: []int64{50_000_000, 0, -50_000_000},
+			expectedSuccessPerUpdate: []types.UpdateResult{
+				types.ViolatesOpenInterestCap,
+				types.Success,
+				types.ViolatesOpenInterestCap,
+			},
+		},
+		"closing positions decreases open interest": {
+			perpetualQuantumsDeltas: []int64{-50_000_000, 0, 50_000_000},
+			expectedSuccess:         true,
+			expectedSuccessPerUpdate: []types.UpdateResult{
+				types.Success,
+				types.Success,
+				types.Success,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _ := testutil.SubaccountsKeepers(t, true)
+			testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+			testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+			p := constants.BtcUsd_SmallMarginRequirement_OpenInterestCap60000USD
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.OpenInterestCapNotional,
+				p.Params.FundingRateBounds,
+				p.Params.InterestRatePpm,
+				p.Params.FundingTickEpoch,
+				p.Params.FundingSampleEpoch,
+			)
+			require.NoError(t, err)
+
+			// Open interest of 1.5 BTC ($75,000) is already above the cap.
+			subaccounts := createNSubaccount(keeper, ctx, 3, big.NewInt(100_000_000_000))
+			updates := make([]types.Update, len(subaccounts))
+			for i, quantums := range []int64{100_000_000, 50_000_000, -150_000_000} {
+				subaccounts[i].PerpetualPositions = []*types.PerpetualPosition{
+					{
+						PerpetualId:  p.Params.Id,
+						Quantums:     dtypes.NewInt(quantums),
+						FundingIndex: dtypes.NewInt(0),
+					},
+				}
+				keeper.SetSubaccount(ctx, subaccounts[i])
+
+				updates[i] = types.Update{SubaccountId: *subaccounts[i].Id}
+				if tc.perpetualQuantumsDeltas[i] != 0 {
+					updates[i].PerpetualUpdates = []types.PerpetualUpdate{
+						{
+							PerpetualId:      p.Params.Id,
+							BigQuantumsDelta: big.NewInt(tc.perpetualQuantumsDeltas[i]),
+						},
+					}
+				}
+			}
+			require.Equal(t, big.NewInt(150_000_000), keeper.GetOpenInterest(ctx, p.Params.Id))
+
+			success, successPerUpdate, err := keeper.CanUpdateSubaccounts(ctx, updates)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSuccessPerUpdate, successPerUpdate)
+			require.Equal(t, tc.expectedSuccess, success)
+		})
+	}
+}
+
+func TestForceUpdateSubaccounts(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _ := testutil.SubaccountsKeepers(t, true)
+	testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+	testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+	p := constants.BtcUsd_SmallMarginRequirement_OpenInterestCap60000USD
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.OpenInterestCapNotional,
+		p.Params.FundingRateBounds,
+		p.Params.InterestRatePpm,
+		p.Params.FundingTickEpoch,
+		p.Params.FundingSampleEpoch,
+	)
+	require.NoError(t, err)
+
+	// Opening a 2 BTC ($100,000) long with no collateral leaves the subaccount undercollateralized and
+	// increases the open interest above the cap.
+	subaccount := createNSubaccount(keeper, ctx, 1, big.NewInt(1_000_000))[0]
+	updates := []types.Update{
+		{
+			SubaccountId: *subaccount.Id,
+			AssetUpdates: testutil.CreateUsdcAssetUpdate(big.NewInt(-1_000_000)),
+			PerpetualUpdates: []types.PerpetualUpdate{
+				{
+					PerpetualId:      p.Params.Id,
+					BigQuantumsDelta: big.NewInt(200_000_000),
+				},
+			},
+		},
+	}
+	success, _, err := keeper.UpdateSubaccounts(ctx, updates)
+	require.NoError(t, err)
+	require.False(t, success)
+
+	require.NoError(t, keeper.ForceUpdateSubaccounts(ctx, updates))
+	updatedSubaccount := keeper.GetSubaccount(ctx, *subaccount.Id)
+	require.Empty(t, updatedSubaccount.AssetPositions)
+	require.Equal(
+		t,
+		[]*types.PerpetualPosition{
+			{
+				PerpetualId:  p.Params.Id,
+				Quantums:     dtypes.NewInt(200_000_000),
+				FundingIndex: dtypes.NewInt(0),
+			},
+		},
+		updatedSubaccount.PerpetualPositions,
+	)
+	require.Equal(t, big.NewInt(200_000_000), keeper.GetOpenInterest(ctx, p.Params.Id))
+
+	// Updates of the same subaccount are still rejected.
+	require.ErrorIs(
+		t,
+		keeper.ForceUpdateSubaccounts(ctx, []types.Update{updates[0], updates[0]}),
+		types.ErrNonUniqueUpdatesSubaccount,
+	)
+}
+
 func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 	tests := map[string]struct {
 		// state
@@ -3052,6 +3835,9 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 		},
 		"multiple asset updates for the same position": {
 			expectedErr: types.ErrNonUniqueUpdatesPosition,
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_NoMarginRequirement,
 			},
@@ -3162,8 +3948,8 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 				},
 			},
 		},
-		"asset with no balance and update": {
-			expectedErr: asstypes.ErrNotImplementedMulticollateral,
+		"non-collateral asset with balance and update": {
+			expectedNetCollateral: big.NewInt(0),
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -3177,8 +3963,8 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 				},
 			},
 		},
-		"single positive asset": {
-			expectedErr: asstypes.ErrNotImplementedMulticollateral,
+		"single positive non-collateral asset": {
+			expectedNetCollateral: big.NewInt(0),
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -3186,8 +3972,48 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 				&constants.Long_Asset_1BTC,
 			},
 		},
+		"single positive collateral asset": {
+			expectedNetCollateral: big.NewInt(40_000_000_000), // 80% of 1 BTC at $50,000
+			assets: []*asstypes.Asset{
+				constants.BtcUsd_80PercentCollateralWeight,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+		},
+		"collateral asset and perpetual": {
+			expectedNetCollateral:     big.NewInt(90_000_000_000), // 80% of 1 BTC at $50,000 plus 1 BTC perpetual
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
+			assets: []*asstypes.Asset{
+				constants.BtcUsd_80PercentCollateralWeight,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_NoMarginRequirement,
+			},
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId: uint32(0),
+					Quantums:    dtypes.NewInt(100_000_000), // 1 BTC
+				},
+			},
+		},
+		"single negative borrowable asset": {
+			expectedNetCollateral:     big.NewInt(-50_000_000_000), // -1 BTC at $50,000
+			expectedInitialMargin:     big.NewInt(10_000_000_000),  // 20% of $50,000
+			expectedMaintenanceMargin: big.NewInt(5_000_000_000),   // 50% of initial margin
+			assets: []*asstypes.Asset{
+				constants.BtcUsd_Borrowable,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Short_Asset_1BTC,
+			},
+		},
 		"single negative asset": {
-			expectedErr: asstypes.ErrNotImplementedMargin,
+			expectedErr: asstypes.ErrAssetNotBorrowable,
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -3214,8 +4040,20 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
+
+				if a.IsBorrowable() {
+					_, err = assetsKeeper.SetMarginParams(
+						ctx,
+						a.Id,
+						a.InitialMarginPpm,
+						a.MaintenanceFractionPpm,
+						a.BorrowRatePpm,
+					)
+					require.NoError(t, err)
+				}
 			}
 
 			for _, p := range tc.perpetuals {
@@ -3227,6 +4065,11 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 					p.Params.AtomicResolution,
 					p.Params.DefaultFundingPpm,
 					p.Params.LiquidityTier,
+					p.Params.OpenInterestCapNotional,
+					p.Params.FundingRateBounds,
+					p.Params.InterestRatePpm,
+					p.Params.FundingTickEpoch,
+					p.Params.FundingSampleEpoch,
 				)
 				require.NoError(t, err)
 			}
@@ -32,21 +32,16 @@ func (k Keeper) getValidSubaccountUpdatesForTransfer(
 		bigBalanceDelta.Neg(bigBalanceDelta)
 	}
 
-	if assetId == 0 {
-		updates = []types.Update{
-			{
-				SubaccountId: subaccountId,
-				AssetUpdates: []types.AssetUpdate{
-					{
-						AssetId:          assettypes.AssetUsdc.Id,
-						BigQuantumsDelta: bigBalanceDelta,
-					},
+	updates = []types.Update{
+		{
+			SubaccountId: subaccountId,
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assetId,
+					BigQuantumsDelta: bigBalanceDelta,
 				},
 			},
-		}
-	} else {
-		// TODO(DEC-715): Support non-USDC assets.
-		return nil, types.ErrAssetTransferThroughBankNotImplemented
+		},
 	}
 
 	success, successPerUpdate, err := k.CanUpdateSubaccounts(ctx, updates)
@@ -205,6 +200,7 @@ func (k Keeper) TransferFundsFromModuleToSubaccount(
 // fails. Otherwise, increases the asset quantums in the subaccount, translates the
 // `assetId` and `quantums` into a `sdk.Coin`, and calls
 // `bankKeeper.SendCoinsFromAccountToModule()`.
+// Only USDC and assets with a non-zero collateral weight can be deposited.
 // TODO(CORE-168): Change function interface to accept `denom` and `amount` instead of `assetId` and `quantums`.
 func (k Keeper) DepositFundsFromAccountToSubaccount(
 	ctx sdk.Context,
@@ -213,9 +209,12 @@ func (k Keeper) DepositFundsFromAccountToSubaccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	asset, exists := k.assetsKeeper.GetAsset(ctx, assetId)
+	if !exists {
+		return errorsmod.Wrap(assettypes.ErrAssetDoesNotExist, lib.UintToString(assetId))
+	}
+	if !asset.IsCollateral() {
+		return errorsmod.Wrap(types.ErrAssetDepositNotCollateral, lib.UintToString(assetId))
 	}
 
 	if quantums.Sign() <= 0 {
@@ -263,6 +262,7 @@ func (k Keeper) DepositFundsFromAccountToSubaccount(
 // WithdrawFundsFromSubaccountToAccount returns an error if the call to `k.CanUpdateSubaccounts()`
 // fails. Otherwise, deducts the asset quantums from the subaccount, translates the
 // `assetId` and `quantums` into a `sdk.Coin`, and calls `bankKeeper.SendCoinsFromModuleToAccount()`.
+// Any asset held by the subaccount can be withdrawn, including assets which are no longer collateral.
 func (k Keeper) WithdrawFundsFromSubaccountToAccount(
 	ctx sdk.Context,
 	fromSubaccountId types.SubaccountId,
@@ -270,11 +270,6 @@ func (k Keeper) WithdrawFundsFromSubaccountToAccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
-	}
-
 	if quantums.Sign() <= 0 {
 		return errorsmod.Wrap(types.ErrAssetTransferQuantumsNotPositive, lib.UintToString(assetId))
 	}
@@ -12,6 +12,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	auth_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/auth"
 	bank_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/bank"
@@ -126,9 +127,83 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			),
 			expectedAccAddressBalance: big.NewInt(0),
 		},
+		"DepositFundsFromAccountToSubaccount: send collateral asset from account to subaccount": {
+			testTransferFundToAccount:  false,
+			asset:                      *constants.BtcUsd_80PercentCollateralWeight,
+			subaccountModuleAccBalance: big.NewInt(200),
+			accAddressBalance:          big.NewInt(2000),
+			quantums:                   big.NewInt(500),
+			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(150)),
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(150),
+				},
+				{
+					AssetId:  constants.BtcUsd_80PercentCollateralWeight.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+			},
+			expectedQuoteBalance:                big.NewInt(150),  // unchanged
+			expectedSubaccountsModuleAccBalance: big.NewInt(700),  // 200 + 500
+			expectedAccAddressBalance:           big.NewInt(1500), // 2000 - 500
+		},
+		"WithdrawFundsFromSubaccountToAccount: withdraw collateral while remaining collateralized": {
+			testTransferFundToAccount:  true,
+			asset:                      *constants.BtcUsd_80PercentCollateralWeight,
+			subaccountModuleAccBalance: big.NewInt(100_000_000),
+			accAddressBalance:          big.NewInt(0),
+			quantums:                   big.NewInt(80_000_000), // 0.8 BTC
+			// -$5,000 USDC and 1 BTC worth $40,000 of collateral. $8,000 of collateral remains.
+			assetPositions: append(
+				keepertest.CreateUsdcAssetPosition(big.NewInt(-5_000_000_000)),
+				&types.AssetPosition{
+					AssetId:  constants.BtcUsd_80PercentCollateralWeight.Id,
+					Quantums: dtypes.NewInt(100_000_000),
+				},
+			),
+			expectedAssetPositions: append(
+				keepertest.CreateUsdcAssetPosition(big.NewInt(-5_000_000_000)),
+				&types.AssetPosition{
+					AssetId:  constants.BtcUsd_80PercentCollateralWeight.Id,
+					Quantums: dtypes.NewInt(20_000_000),
+				},
+			),
+			expectedQuoteBalance:                big.NewInt(-5_000_000_000), // unchanged
+			expectedSubaccountsModuleAccBalance: big.NewInt(20_000_000),     // 100_000_000 - 80_000_000
+			expectedAccAddressBalance:           big.NewInt(80_000_000),     // 0 + 80_000_000
+		},
+		"WithdrawFundsFromSubaccountToAccount: send non-collateral asset from subaccount to account": {
+			testTransferFundToAccount:  true,
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(600),
+			accAddressBalance:          big.NewInt(2500),
+			quantums:                   big.NewInt(300),
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(150),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+			},
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  asstypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(150),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(200),
+				},
+			},
+			expectedQuoteBalance:                big.NewInt(150),  // unchanged
+			expectedSubaccountsModuleAccBalance: big.NewInt(300),  // 600 - 300
+			expectedAccAddressBalance:           big.NewInt(2800), // 2500 + 300
+		},
 
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
 		// TODO(CORE-169): Add tests for when the input quantums is rounded down to
 		// a integer denom amount.
 	}
@@ -174,6 +249,10 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 				require.NoError(t, err)
 			}
 
+			if tc.asset.Id != asstypes.AssetUsdc.Id {
+				require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			}
+
 			_, err = assetsKeeper.CreateAsset(
 				ctx,
 				tc.asset.Id,
@@ -183,6 +262,7 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 				tc.asset.HasMarket,
 				tc.asset.MarketId,
 				tc.asset.AtomicResolution,
+				tc.asset.CollateralWeightPpm,
 			)
 			require.NoError(t, err)
 
@@ -300,14 +380,30 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedErr:                types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"WithdrawFundsFromSubaccountToAccount: do not support assets other than USDC": {
+		"WithdrawFundsFromSubaccountToAccount: withdrawing collateral would leave subaccount undercollateralized": {
 			testTransferFundToAccount:  true,
 			accAddressBalance:          big.NewInt(500),
-			asset:                      *constants.BtcUsd,
+			asset:                      *constants.BtcUsd_80PercentCollateralWeight,
+			subaccountModuleAccBalance: big.NewInt(100_000_000),
+			quantums:                   big.NewInt(80_000_000), // 0.8 BTC
+			// -$10,000 USDC and 1 BTC worth $40,000 of collateral. Only $8,000 of collateral would remain.
+			assetPositions: append(
+				keepertest.CreateUsdcAssetPosition(big.NewInt(-10_000_000_000)),
+				&types.AssetPosition{
+					AssetId:  constants.BtcUsd_80PercentCollateralWeight.Id,
+					Quantums: dtypes.NewInt(100_000_000),
+				},
+			),
+			expectedErr: types.ErrFailedToUpdateSubaccounts,
+		},
+		"WithdrawFundsFromSubaccountToAccount: asset balance would become negative": {
+			testTransferFundToAccount:  true,
+			accAddressBalance:          big.NewInt(500),
+			asset:                      *constants.BtcUsd_80PercentCollateralWeight,
 			subaccountModuleAccBalance: big.NewInt(500),
 			quantums:                   big.NewInt(500),
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                types.ErrFailedToUpdateSubaccounts,
 		},
 		"WithdrawFundsFromSubaccountToAccount: asset ID doesn't exist": {
 			testTransferFundToAccount:  true,
@@ -337,14 +433,14 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedErr:                types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"DepositFundsFromAccountToSubaccount: do not support assets other than USDC": {
+		"DepositFundsFromAccountToSubaccount: do not support non-collateral assets": {
 			testTransferFundToAccount:  false,
 			accAddressBalance:          big.NewInt(500),
 			asset:                      *constants.BtcUsd,
 			subaccountModuleAccBalance: big.NewInt(500),
 			quantums:                   big.NewInt(500),
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
-			expectedErr:                types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                types.ErrAssetDepositNotCollateral,
 		},
 		"DepositFundsFromAccountToSubaccount: failure, asset ID doesn't exist": {
 			testTransferFundToAccount:  false,
@@ -356,8 +452,6 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			expectedErr:                asstypes.ErrAssetDoesNotExist,
 		},
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
 	}
 
 	for name, tc := range tests {
@@ -417,6 +511,7 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -716,6 +811,7 @@ func TestTransferFundsFromSubaccountToModule_TransferFundsFromModuleToSubaccount
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -925,6 +1021,7 @@ func TestTransferFeesToFeeCollectorModule(t *testing.T) {
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.CollateralWeightPpm,
 				)
 				require.NoError(t, err)
 			}
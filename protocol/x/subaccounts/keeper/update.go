@@ -15,4 +15,6 @@ type settledUpdate struct {
 	AssetUpdates []types.AssetUpdate
 	// A list of changes to make to any `PerpetualPositions` in the `Subaccount`.
 	PerpetualUpdates []types.PerpetualUpdate
+	// A list of transfers of collateral to and from the `IsolatedMargins` in the `Subaccount`.
+	IsolatedCollateralUpdates []types.IsolatedCollateralUpdate
 }
@@ -31,9 +31,8 @@ type AssetPosition struct {
 	AssetId uint32 `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 	// The absolute size of the position in base quantums.
 	Quantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums"`
-	// The `Index` (either `LongIndex` or `ShortIndex`) of the `Asset` the last
-	// time this position was settled
-	// TODO(DEC-582): pending margin trading being added.
+	// The `borrow_index` of the `Asset` the last time this position was
+	// settled.
 	Index uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
 }
 
@@ -93,7 +92,7 @@ func init() {
 }
 
 var fileDescriptor_21b5b4cb0c7ef07c = []byte{
-	// 257 bytes of a gzipped FileDescriptorProto
+	// 254 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4d, 0xa9, 0x4c, 0xa9,
 	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x2e, 0x4d, 0x4a, 0x4c, 0x4e, 0xce,
 	0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0x89, 0x2f, 0xc8, 0x2f, 0xce, 0x2c,
@@ -109,8 +108,7 @@ var fileDescriptor_21b5b4cb0c7ef07c = []byte{
 	0x90, 0x60, 0x56, 0x60, 0xd4, 0x60, 0x09, 0x82, 0x70, 0x9c, 0xc2, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
 	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
 	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x96, 0x78, 0xbb, 0x2b, 0x50, 0x02, 0x10, 0xec, 0x90, 0x24,
-	0x36, 0xb0, 0xac, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x36, 0x84, 0xfb, 0x96, 0x69, 0x01, 0x00,
-	0x00,
+	0x36, 0xb0, 0xac, 0x31, 0x60, 0x00, 0x36, 0x84, 0xfb, 0x96, 0x69, 0x01, 0x00, 0x00,
 }
 
 func (m *AssetPosition) Marshal() (dAtA []byte, err error) {
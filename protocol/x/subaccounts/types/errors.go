@@ -23,19 +23,21 @@ var (
 	ErrDuplicateSubaccountIds    = errorsmod.Register(ModuleName, 202, "duplicate subaccount id found in genesis")
 
 	// 300 - 399: asset position related.
-	ErrAssetPositionsOutOfOrder       = errorsmod.Register(ModuleName, 300, "asset positions are out of order")
-	ErrAssetPositionZeroQuantum       = errorsmod.Register(ModuleName, 301, "asset position's quantum cannot be zero")
-	ErrAssetPositionNotSupported      = errorsmod.Register(ModuleName, 302, "asset position is not supported")
-	ErrMultAssetPositionsNotSupported = errorsmod.Register(
-		ModuleName, 303, "having multiple asset positions is not supported")
+	ErrAssetPositionsOutOfOrder  = errorsmod.Register(ModuleName, 300, "asset positions are out of order")
+	ErrAssetPositionZeroQuantum  = errorsmod.Register(ModuleName, 301, "asset position's quantum cannot be zero")
+	ErrAssetPositionNotSupported = errorsmod.Register(ModuleName, 302, "asset position is not supported")
 
 	// 400 - 499: perpetual position related.
-	ErrPerpPositionsOutOfOrder = errorsmod.Register(ModuleName, 400, "perpetual positions are out of order")
-	ErrPerpPositionZeroQuantum = errorsmod.Register(ModuleName, 401, "perpetual position's quantum cannot be zero")
+	ErrPerpPositionsOutOfOrder   = errorsmod.Register(ModuleName, 400, "perpetual positions are out of order")
+	ErrPerpPositionZeroQuantum   = errorsmod.Register(ModuleName, 401, "perpetual position's quantum cannot be zero")
+	ErrIsolatedMarginsOutOfOrder = errorsmod.Register(ModuleName, 402, "isolated margins are out of order")
+	ErrPerpetualNotIsolated      = errorsmod.Register(ModuleName, 403, "perpetual is not isolated for the subaccount")
 
 	// 500 - 599: transfer related.
 	ErrAssetTransferQuantumsNotPositive = errorsmod.Register(
 		ModuleName, 500, "asset transfer quantums is not positive")
 	ErrAssetTransferThroughBankNotImplemented = errorsmod.Register(
 		ModuleName, 501, "asset transfer (other than USDC) through the bank module is not implemented")
+	ErrAssetDepositNotCollateral = errorsmod.Register(
+		ModuleName, 502, "only USDC and collateral assets can be deposited into a subaccount")
 )
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	asstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 )
 
@@ -39,6 +40,9 @@ type ProductKeeper interface {
 
 type AssetsKeeper interface {
 	ProductKeeper
+	GetAsset(ctx sdk.Context, id uint32) (val asstypes.Asset, exists bool)
+	GetAllAssets(ctx sdk.Context) []asstypes.Asset
+	ModifyTotalBorrowed(ctx sdk.Context, assetId uint32, bigBorrowedDelta *big.Int)
 	ConvertAssetToCoin(
 		ctx sdk.Context,
 		assetId uint32,
@@ -63,6 +67,29 @@ type PerpetualsKeeper interface {
 		err error,
 	)
 	GetAllPerpetuals(ctx sdk.Context) []perptypes.Perpetual
+	GetNetNotional(
+		ctx sdk.Context,
+		id uint32,
+		bigQuantums *big.Int,
+	) (
+		bigNetNotionalQuoteQuantums *big.Int,
+		err error,
+	)
+	GetOpenInterestCapNotional(
+		ctx sdk.Context,
+		perpetualId uint32,
+	) (
+		openInterestCapNotional uint64,
+		err error,
+	)
+	IsOpenInterestWithinCap(
+		ctx sdk.Context,
+		perpetualId uint32,
+		openInterest *big.Int,
+	) (
+		withinCap bool,
+		err error,
+	)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -27,22 +27,18 @@ func (gs GenesisState) Validate() error {
 		includedAccounts[*subaccountId] = true
 
 		// Validate AssetPositions.
-		// TODO(DEC-582): once we support different assets, remove this validation.
-		if len(sa.GetAssetPositions()) > 1 {
-			return ErrMultAssetPositionsNotSupported
-		}
 		for i := 0; i < len(sa.GetAssetPositions()); i++ {
 			assetP := sa.GetAssetPositions()[i]
 			if i > 0 && assetP.AssetId <= sa.GetAssetPositions()[i-1].AssetId {
 				return ErrAssetPositionsOutOfOrder
 			}
-			// TODO(DEC-582): once we support different assets, remove this validation.
-			if assetP.AssetId != 0 {
-				return ErrAssetPositionNotSupported
-			}
 			if assetP.GetBigQuantums().Sign() == 0 {
 				return ErrAssetPositionZeroQuantum
 			}
+			// TODO(DEC-582): once we support margin trading of assets, remove this validation.
+			if assetP.AssetId != 0 && assetP.GetBigQuantums().Sign() < 0 {
+				return ErrAssetPositionNotSupported
+			}
 		}
 
 		// Validate PerpetualPositions.
@@ -55,6 +51,14 @@ func (gs GenesisState) Validate() error {
 				return ErrPerpPositionZeroQuantum
 			}
 		}
+
+		// Validate IsolatedMargins.
+		for i := 0; i < len(sa.GetIsolatedMargins()); i++ {
+			isolatedMargin := sa.GetIsolatedMargins()[i]
+			if i > 0 && isolatedMargin.PerpetualId <= sa.GetIsolatedMargins()[i-1].PerpetualId {
+				return ErrIsolatedMarginsOutOfOrder
+			}
+		}
 	}
 	return nil
 }
@@ -117,7 +117,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedError: types.ErrDuplicateSubaccountIds,
 		},
-		"invalid: multiple asset positions": {
+		"valid: multiple asset positions": {
 			genState: &types.GenesisState{
 				Subaccounts: []types.Subaccount{
 					{
@@ -127,19 +127,19 @@ func TestGenesisState_Validate(t *testing.T) {
 						},
 						AssetPositions: []*types.AssetPosition{ // multiple asset positions.
 							{
-								AssetId: 0,
+								AssetId:  0,
+								Quantums: dtypes.NewInt(1_000),
 							},
 							{
-								AssetId: 1,
+								AssetId:  1,
+								Quantums: dtypes.NewInt(1_000),
 							},
 						},
 					},
 				},
 			},
-			expectedError: types.ErrMultAssetPositionsNotSupported,
 		},
-		// TODO(DEC-582): once we support different assets, add a test case for the asset ordering.
-		"invalid: asset position id != 0": {
+		"invalid: asset positions out of order": {
 			genState: &types.GenesisState{
 				Subaccounts: []types.Subaccount{
 					{
@@ -149,13 +149,36 @@ func TestGenesisState_Validate(t *testing.T) {
 						},
 						AssetPositions: []*types.AssetPosition{
 							{
-								AssetId:  1, // asset id must be zero (0 = USDC).
+								AssetId:  1,
+								Quantums: dtypes.NewInt(1_000),
+							},
+							{
+								AssetId:  0,
 								Quantums: dtypes.NewInt(1_000),
 							},
 						},
 					},
 				},
 			},
+			expectedError: types.ErrAssetPositionsOutOfOrder,
+		},
+		"invalid: negative asset position id != 0": {
+			genState: &types.GenesisState{
+				Subaccounts: []types.Subaccount{
+					{
+						Id: &types.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(127),
+						},
+						AssetPositions: []*types.AssetPosition{
+							{
+								AssetId:  1, // only USDC (0) positions can be negative.
+								Quantums: dtypes.NewInt(-1_000),
+							},
+						},
+					},
+				},
+			},
 			expectedError: types.ErrAssetPositionNotSupported,
 		},
 		"invalid: asset position quantum == 0": {
@@ -204,6 +227,29 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedError: types.ErrPerpPositionsOutOfOrder,
 		},
+		"invalid: isolated margins out of order": {
+			genState: &types.GenesisState{
+				Subaccounts: []types.Subaccount{
+					{
+						Id: &types.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(127),
+						},
+						IsolatedMargins: []*types.IsolatedMargin{
+							{
+								PerpetualId:  2, // out of order.
+								QuoteBalance: dtypes.NewInt(1_000),
+							},
+							{
+								PerpetualId:  1,
+								QuoteBalance: dtypes.NewInt(1_000),
+							},
+						},
+					},
+				},
+			},
+			expectedError: types.ErrIsolatedMarginsOutOfOrder,
+		},
 		"invalid: perpetual position quantum == 0": {
 			genState: &types.GenesisState{
 				Subaccounts: []types.Subaccount{
@@ -14,3 +14,18 @@ const (
 	// SubaccountKeyPrefix is the prefix to retrieve all Subaccount
 	SubaccountKeyPrefix = "SA:"
 )
+
+// State indices
+const (
+	// PerpetualPositionIndexKeyPrefix is the prefix to retrieve the IDs of all subaccounts with an open
+	// position in a perpetual, keyed by perpetual ID, position side and subaccount ID.
+	PerpetualPositionIndexKeyPrefix = "PerpPos:"
+
+	// BorrowerIndexKeyPrefix is the prefix to retrieve the IDs of all subaccounts with a negative
+	// asset position, keyed by subaccount ID.
+	BorrowerIndexKeyPrefix = "Borrower:"
+
+	// OpenInterestKeyPrefix is the prefix to retrieve the open interest of a perpetual, keyed by
+	// perpetual ID.
+	OpenInterestKeyPrefix = "OI:"
+)
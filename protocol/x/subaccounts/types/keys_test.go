@@ -14,4 +14,7 @@ func TestModuleKeys(t *testing.T) {
 
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "SA:", types.SubaccountKeyPrefix)
+	require.Equal(t, "PerpPos:", types.PerpetualPositionIndexKeyPrefix)
+	require.Equal(t, "Borrower:", types.BorrowerIndexKeyPrefix)
+	require.Equal(t, "OI:", types.OpenInterestKeyPrefix)
 }
@@ -76,8 +76,63 @@ func (m *PerpetualPosition) GetPerpetualId() uint32 {
 	return 0
 }
 
+// IsolatedMargin is the collateral allotted to an isolated-margin perpetual
+// position of a subaccount. The position is only backed by this collateral and
+// its own unrealized PnL, and is margined and liquidated independently from the
+// other positions of the subaccount.
+type IsolatedMargin struct {
+	// The `Id` of the `Perpetual`.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The part of the subaccount's USDC asset position allotted to the position,
+	// in quote quantums. Trades, fees and funding payments of the position are
+	// settled against this balance, so it may be negative while the position is
+	// open.
+	QuoteBalance github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quote_balance,json=quoteBalance,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quote_balance"`
+}
+
+func (m *IsolatedMargin) Reset()         { *m = IsolatedMargin{} }
+func (m *IsolatedMargin) String() string { return proto.CompactTextString(m) }
+func (*IsolatedMargin) ProtoMessage()    {}
+func (*IsolatedMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659838f8a8f5498, []int{1}
+}
+func (m *IsolatedMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedMargin.Merge(m, src)
+}
+func (m *IsolatedMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedMargin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedMargin proto.InternalMessageInfo
+
+func (m *IsolatedMargin) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PerpetualPosition)(nil), "dydxprotocol.subaccounts.PerpetualPosition")
+	proto.RegisterType((*IsolatedMargin)(nil), "dydxprotocol.subaccounts.IsolatedMargin")
 }
 
 func init() {
@@ -85,24 +140,27 @@ func init() {
 }
 
 var fileDescriptor_e659838f8a8f5498 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4c, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x2e, 0x4d, 0x4a, 0x4c, 0x4e, 0xce,
-	0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x48, 0x2d, 0x2a, 0x48, 0x2d, 0x29, 0x4d, 0xcc, 0x89, 0x2f,
-	0xc8, 0x2f, 0xce, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0xab, 0x13, 0x92, 0x40, 0xd6, 0xa2, 0x87,
-	0xa4, 0x45, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xa3, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x75,
-	0x33, 0x71, 0x09, 0x06, 0xc0, 0x0c, 0x0b, 0x80, 0x9a, 0x25, 0xa4, 0xc8, 0xc5, 0x83, 0xb0, 0x21,
-	0x33, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x37, 0x88, 0x1b, 0x2e, 0xe6, 0x99, 0x22, 0x94, 0xc2,
-	0xc5, 0x51, 0x58, 0x9a, 0x98, 0x57, 0x52, 0x9a, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0xe3,
-	0xe4, 0x71, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0x0e, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x28, 0x1e, 0x28, 0x33, 0xd1, 0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3,
-	0x87, 0x8b, 0xa4, 0x94, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0x05, 0xa7, 0x16, 0x65, 0x26, 0xe6, 0x64,
-	0x56, 0x25, 0x26, 0xe5, 0xa4, 0x7a, 0xe6, 0x95, 0x04, 0xc1, 0x4d, 0x16, 0xca, 0xe5, 0xe2, 0x4d,
-	0x2b, 0xcd, 0x4b, 0xc9, 0xcc, 0x4b, 0x8f, 0xcf, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60, 0xa6, 0xb2,
-	0x55, 0x3c, 0x50, 0xe3, 0x3d, 0x41, 0xa6, 0x3b, 0x85, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x2d, 0xf1, 0x36, 0x55, 0xa0, 0xc4, 0x14, 0xd8, 0xda, 0x24, 0x36, 0xb0,
-	0xac, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xec, 0xcb, 0x17, 0xd2, 0x01, 0x00, 0x00,
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x4a, 0xc3, 0x40,
+	0x1c, 0xc7, 0x73, 0x15, 0x44, 0xce, 0x54, 0x30, 0x38, 0x04, 0x87, 0x6b, 0xed, 0xd4, 0xc5, 0x04,
+	0xd1, 0x55, 0x90, 0x4e, 0x66, 0x10, 0x4a, 0x1d, 0x04, 0x97, 0x72, 0xb9, 0x3b, 0xd3, 0x83, 0xcb,
+	0x5d, 0x9a, 0xbb, 0x93, 0xd6, 0x57, 0x70, 0xf1, 0x19, 0x7c, 0x9a, 0x8e, 0x1d, 0xc5, 0xa1, 0x48,
+	0xf2, 0x22, 0xd2, 0xb4, 0xc6, 0x76, 0xeb, 0xd0, 0xed, 0xf8, 0xfd, 0xf9, 0x7c, 0xb8, 0x2f, 0x3f,
+	0x78, 0x45, 0xa7, 0x74, 0x92, 0xe5, 0xca, 0x28, 0xa2, 0x44, 0xa8, 0x6d, 0x8c, 0x09, 0x51, 0x56,
+	0x1a, 0x1d, 0x66, 0x2c, 0xcf, 0x98, 0xb1, 0x58, 0x0c, 0x33, 0xa5, 0xb9, 0xe1, 0x4a, 0x06, 0xd5,
+	0x9c, 0xe7, 0x6f, 0xae, 0x04, 0x1b, 0x2b, 0xe7, 0x67, 0x89, 0x4a, 0x54, 0xd5, 0x09, 0x97, 0xaf,
+	0xd5, 0x7c, 0xe7, 0xbd, 0x01, 0x4f, 0xfb, 0x7f, 0xb0, 0xfe, 0x9a, 0xe5, 0x5d, 0x40, 0xf7, 0xdf,
+	0xc0, 0xa9, 0x0f, 0xda, 0xa0, 0xdb, 0x1c, 0x1c, 0xd7, 0xb5, 0x88, 0x7a, 0x14, 0x1e, 0x8d, 0x2d,
+	0x96, 0xc6, 0xa6, 0xda, 0x6f, 0xb4, 0x41, 0xd7, 0xed, 0xdd, 0xcf, 0x16, 0x2d, 0xe7, 0x7b, 0xd1,
+	0xba, 0x4b, 0xb8, 0x19, 0xd9, 0x38, 0x20, 0x2a, 0x0d, 0xb7, 0x3e, 0xf0, 0x7a, 0x73, 0x49, 0x46,
+	0x98, 0xcb, 0xb0, 0xae, 0x50, 0x33, 0xcd, 0x98, 0x0e, 0x1e, 0x59, 0xce, 0xb1, 0xe0, 0x6f, 0x38,
+	0x16, 0x2c, 0x92, 0x66, 0x50, 0x93, 0xbd, 0x14, 0x36, 0x5f, 0xac, 0xa4, 0x5c, 0x26, 0x43, 0x2e,
+	0x29, 0x9b, 0xf8, 0x07, 0x7b, 0x56, 0xb9, 0x6b, 0x7c, 0xb4, 0xa4, 0x77, 0x3e, 0x01, 0x3c, 0x89,
+	0xb4, 0x12, 0xd8, 0x30, 0xfa, 0x80, 0xf3, 0x84, 0xef, 0x14, 0x45, 0x0a, 0x9b, 0x63, 0xab, 0x0c,
+	0x1b, 0xc6, 0x58, 0x60, 0x49, 0xd8, 0xde, 0xf3, 0x70, 0x2b, 0x7c, 0x6f, 0x45, 0xef, 0x3d, 0xcd,
+	0x0a, 0x04, 0xe6, 0x05, 0x02, 0x3f, 0x05, 0x02, 0x1f, 0x25, 0x72, 0xe6, 0x25, 0x72, 0xbe, 0x4a,
+	0xe4, 0x3c, 0xdf, 0xee, 0x6e, 0x9a, 0x6c, 0x9d, 0x53, 0xa5, 0x8d, 0x0f, 0xab, 0xee, 0xf5, 0xef,
+	0x00, 0x08, 0x91, 0x91, 0xb1, 0x77, 0x02, 0x00, 0x00,
 }
 
 func (m *PerpetualPosition) Marshal() (dAtA []byte, err error) {
@@ -153,6 +211,44 @@ func (m *PerpetualPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IsolatedMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IsolatedMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsolatedMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteBalance.Size()
+		i -= size
+		if _, err := m.QuoteBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPerpetualPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintPerpetualPosition(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPerpetualPosition(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPerpetualPosition(v)
 	base := offset
@@ -180,6 +276,20 @@ func (m *PerpetualPosition) Size() (n int) {
 	return n
 }
 
+func (m *IsolatedMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovPerpetualPosition(uint64(m.PerpetualId))
+	}
+	l = m.QuoteBalance.Size()
+	n += 1 + l + sovPerpetualPosition(uint64(l))
+	return n
+}
+
 func sovPerpetualPosition(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -321,6 +431,108 @@ func (m *PerpetualPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IsolatedMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetualPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsolatedMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsolatedMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetualPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteBalance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetualPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPerpetualPosition
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetualPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetualPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetualPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPerpetualPosition(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
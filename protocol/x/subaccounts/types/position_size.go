@@ -103,6 +103,14 @@ func (m *PerpetualPosition) GetBigQuantums() *big.Int {
 	return m.Quantums.BigInt()
 }
 
+// Get the quote balance of the isolated margin in big.Int.
+func (m *IsolatedMargin) GetBigQuoteBalance() *big.Int {
+	if m == nil {
+		return new(big.Int)
+	}
+	return m.QuoteBalance.BigInt()
+}
+
 func (m *PerpetualPosition) GetIsLong() bool {
 	if m == nil {
 		return false
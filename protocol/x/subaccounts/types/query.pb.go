@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -227,11 +228,325 @@ func (m *QuerySubaccountAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySubaccountsByPerpetualRequest is request type for the
+// SubaccountsByPerpetual RPC method.
+type QuerySubaccountsByPerpetualRequest struct {
+	PerpetualId uint32             `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubaccountsByPerpetualRequest) Reset()         { *m = QuerySubaccountsByPerpetualRequest{} }
+func (m *QuerySubaccountsByPerpetualRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountsByPerpetualRequest) ProtoMessage()    {}
+func (*QuerySubaccountsByPerpetualRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{4}
+}
+func (m *QuerySubaccountsByPerpetualRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountsByPerpetualRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountsByPerpetualRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountsByPerpetualRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountsByPerpetualRequest.Merge(m, src)
+}
+func (m *QuerySubaccountsByPerpetualRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountsByPerpetualRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountsByPerpetualRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountsByPerpetualRequest proto.InternalMessageInfo
+
+func (m *QuerySubaccountsByPerpetualRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *QuerySubaccountsByPerpetualRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySubaccountsByPerpetualResponse is response type for the
+// SubaccountsByPerpetual RPC method.
+type QuerySubaccountsByPerpetualResponse struct {
+	SubaccountIds []SubaccountId      `protobuf:"bytes,1,rep,name=subaccount_ids,json=subaccountIds,proto3" json:"subaccount_ids"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubaccountsByPerpetualResponse) Reset()         { *m = QuerySubaccountsByPerpetualResponse{} }
+func (m *QuerySubaccountsByPerpetualResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountsByPerpetualResponse) ProtoMessage()    {}
+func (*QuerySubaccountsByPerpetualResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{5}
+}
+func (m *QuerySubaccountsByPerpetualResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountsByPerpetualResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountsByPerpetualResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountsByPerpetualResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountsByPerpetualResponse.Merge(m, src)
+}
+func (m *QuerySubaccountsByPerpetualResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountsByPerpetualResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountsByPerpetualResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountsByPerpetualResponse proto.InternalMessageInfo
+
+func (m *QuerySubaccountsByPerpetualResponse) GetSubaccountIds() []SubaccountId {
+	if m != nil {
+		return m.SubaccountIds
+	}
+	return nil
+}
+
+func (m *QuerySubaccountsByPerpetualResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySubaccountsWithBorrowingsRequest is request type for the
+// SubaccountsWithBorrowings RPC method.
+type QuerySubaccountsWithBorrowingsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubaccountsWithBorrowingsRequest) Reset()         { *m = QuerySubaccountsWithBorrowingsRequest{} }
+func (m *QuerySubaccountsWithBorrowingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountsWithBorrowingsRequest) ProtoMessage()    {}
+func (*QuerySubaccountsWithBorrowingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{6}
+}
+func (m *QuerySubaccountsWithBorrowingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountsWithBorrowingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountsWithBorrowingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountsWithBorrowingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountsWithBorrowingsRequest.Merge(m, src)
+}
+func (m *QuerySubaccountsWithBorrowingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountsWithBorrowingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountsWithBorrowingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountsWithBorrowingsRequest proto.InternalMessageInfo
+
+func (m *QuerySubaccountsWithBorrowingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySubaccountsWithBorrowingsResponse is response type for the
+// SubaccountsWithBorrowings RPC method.
+type QuerySubaccountsWithBorrowingsResponse struct {
+	SubaccountIds []SubaccountId      `protobuf:"bytes,1,rep,name=subaccount_ids,json=subaccountIds,proto3" json:"subaccount_ids"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubaccountsWithBorrowingsResponse) Reset() {
+	*m = QuerySubaccountsWithBorrowingsResponse{}
+}
+func (m *QuerySubaccountsWithBorrowingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountsWithBorrowingsResponse) ProtoMessage()    {}
+func (*QuerySubaccountsWithBorrowingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{7}
+}
+func (m *QuerySubaccountsWithBorrowingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountsWithBorrowingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountsWithBorrowingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountsWithBorrowingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountsWithBorrowingsResponse.Merge(m, src)
+}
+func (m *QuerySubaccountsWithBorrowingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountsWithBorrowingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountsWithBorrowingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountsWithBorrowingsResponse proto.InternalMessageInfo
+
+func (m *QuerySubaccountsWithBorrowingsResponse) GetSubaccountIds() []SubaccountId {
+	if m != nil {
+		return m.SubaccountIds
+	}
+	return nil
+}
+
+func (m *QuerySubaccountsWithBorrowingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOpenInterestRequest is request type for the OpenInterest RPC method.
+type QueryOpenInterestRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryOpenInterestRequest) Reset()         { *m = QueryOpenInterestRequest{} }
+func (m *QueryOpenInterestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenInterestRequest) ProtoMessage()    {}
+func (*QueryOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{8}
+}
+func (m *QueryOpenInterestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenInterestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenInterestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOpenInterestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenInterestRequest.Merge(m, src)
+}
+func (m *QueryOpenInterestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenInterestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenInterestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOpenInterestRequest proto.InternalMessageInfo
+
+func (m *QueryOpenInterestRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryOpenInterestResponse is response type for the OpenInterest RPC method.
+type QueryOpenInterestResponse struct {
+	// The total size of all long positions in the perpetual, in base quantums.
+	// This is equal to the total size of all short positions.
+	OpenInterest github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=open_interest,json=openInterest,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"open_interest"`
+	// The open interest in quote quantums, measured at the oracle price.
+	OpenInterestNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=open_interest_notional,json=openInterestNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"open_interest_notional"`
+	// The open interest cap of the perpetual in quote quantums, which is the
+	// lower of the caps of the perpetual and of its liquidity tier. Zero means
+	// the perpetual has no cap.
+	OpenInterestCapNotional uint64 `protobuf:"varint,3,opt,name=open_interest_cap_notional,json=openInterestCapNotional,proto3" json:"open_interest_cap_notional,omitempty"`
+}
+
+func (m *QueryOpenInterestResponse) Reset()         { *m = QueryOpenInterestResponse{} }
+func (m *QueryOpenInterestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenInterestResponse) ProtoMessage()    {}
+func (*QueryOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{9}
+}
+func (m *QueryOpenInterestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenInterestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenInterestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOpenInterestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenInterestResponse.Merge(m, src)
+}
+func (m *QueryOpenInterestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenInterestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenInterestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOpenInterestResponse proto.InternalMessageInfo
+
+func (m *QueryOpenInterestResponse) GetOpenInterestCapNotional() uint64 {
+	if m != nil {
+		return m.OpenInterestCapNotional
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryGetSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryGetSubaccountRequest")
 	proto.RegisterType((*QuerySubaccountResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountResponse")
 	proto.RegisterType((*QueryAllSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryAllSubaccountRequest")
 	proto.RegisterType((*QuerySubaccountAllResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountAllResponse")
+	proto.RegisterType((*QuerySubaccountsByPerpetualRequest)(nil), "dydxprotocol.subaccounts.QuerySubaccountsByPerpetualRequest")
+	proto.RegisterType((*QuerySubaccountsByPerpetualResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountsByPerpetualResponse")
+	proto.RegisterType((*QuerySubaccountsWithBorrowingsRequest)(nil), "dydxprotocol.subaccounts.QuerySubaccountsWithBorrowingsRequest")
+	proto.RegisterType((*QuerySubaccountsWithBorrowingsResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountsWithBorrowingsResponse")
+	proto.RegisterType((*QueryOpenInterestRequest)(nil), "dydxprotocol.subaccounts.QueryOpenInterestRequest")
+	proto.RegisterType((*QueryOpenInterestResponse)(nil), "dydxprotocol.subaccounts.QueryOpenInterestResponse")
 }
 
 func init() {
@@ -239,38 +554,58 @@ func init() {
 }
 
 var fileDescriptor_adc19ff1d5b72954 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0x14, 0x31,
-	0x14, 0xc7, 0x37, 0xab, 0x2d, 0x18, 0xe9, 0x25, 0x14, 0xdd, 0x0e, 0x32, 0x96, 0x61, 0xa9, 0x55,
-	0x6c, 0xc2, 0xb6, 0x15, 0x4f, 0x3d, 0xec, 0x1e, 0x2c, 0x78, 0xd2, 0xe9, 0x41, 0xf0, 0x22, 0x99,
-	0xd9, 0x90, 0x0e, 0xcc, 0x26, 0xd3, 0x49, 0xa6, 0x76, 0x29, 0xbd, 0xf8, 0x09, 0x04, 0xbf, 0x84,
-	0x57, 0xd1, 0x0f, 0xd1, 0x63, 0xd1, 0x8b, 0x27, 0x91, 0x5d, 0x0f, 0x7e, 0x0c, 0xd9, 0x24, 0xee,
-	0xcc, 0xba, 0x0e, 0xbb, 0xf4, 0x96, 0xe4, 0xbd, 0xff, 0x7b, 0xbf, 0xff, 0xcb, 0x83, 0xed, 0xfe,
-	0xb0, 0x7f, 0x96, 0xe5, 0x52, 0xcb, 0x58, 0xa6, 0x44, 0x15, 0x11, 0x8d, 0x63, 0x59, 0x08, 0xad,
-	0xc8, 0x49, 0xc1, 0xf2, 0x21, 0x36, 0x21, 0xd4, 0xaa, 0x66, 0xe1, 0x4a, 0x96, 0xb7, 0x11, 0x4b,
-	0x35, 0x90, 0xea, 0x8d, 0x09, 0x12, 0x7b, 0xb1, 0x22, 0x6f, 0x9d, 0x4b, 0x2e, 0xed, 0xfb, 0xe4,
-	0xe4, 0x5e, 0xef, 0x71, 0x29, 0x79, 0xca, 0x08, 0xcd, 0x12, 0x42, 0x85, 0x90, 0x9a, 0xea, 0x44,
-	0x8a, 0xbf, 0x9a, 0x47, 0xb6, 0x02, 0x89, 0xa8, 0x62, 0x96, 0x80, 0x9c, 0x76, 0x22, 0xa6, 0x69,
-	0x87, 0x64, 0x94, 0x27, 0xc2, 0x24, 0xbb, 0xdc, 0x87, 0xb5, 0xe8, 0xe5, 0xd9, 0xa6, 0x06, 0x31,
-	0xdc, 0x78, 0x39, 0x29, 0x76, 0xc8, 0xf4, 0xd1, 0x34, 0x16, 0xb2, 0x93, 0x82, 0x29, 0x8d, 0x30,
-	0x5c, 0x91, 0x6f, 0x05, 0xcb, 0x5b, 0x60, 0x13, 0x6c, 0xdf, 0xea, 0xb5, 0xbe, 0x7e, 0xd9, 0x59,
-	0x77, 0x46, 0xba, 0xfd, 0x7e, 0xce, 0x94, 0x3a, 0xd2, 0x79, 0x22, 0x78, 0x68, 0xd3, 0xd0, 0x1d,
-	0xb8, 0x2a, 0x8a, 0x41, 0xc4, 0xf2, 0x56, 0x73, 0x13, 0x6c, 0xaf, 0x85, 0xee, 0x16, 0x30, 0x78,
-	0xd7, 0x34, 0xa9, 0x76, 0x50, 0x99, 0x14, 0x8a, 0xa1, 0xe7, 0x10, 0x96, 0x4c, 0xa6, 0xcf, 0xed,
-	0xdd, 0x36, 0xae, 0x1b, 0x2a, 0x2e, 0x2b, 0xf4, 0x6e, 0x5e, 0xfe, 0xb8, 0xdf, 0x08, 0x2b, 0xea,
-	0xa9, 0x97, 0x6e, 0x9a, 0xce, 0x7b, 0x79, 0x06, 0x61, 0x39, 0x27, 0xd7, 0x68, 0x0b, 0x3b, 0x37,
-	0x93, 0xa1, 0x62, 0xfb, 0xad, 0x6e, 0xa8, 0xf8, 0x05, 0xe5, 0xcc, 0x69, 0xc3, 0x8a, 0x32, 0xf8,
-	0x04, 0xa0, 0xf7, 0x8f, 0x99, 0x6e, 0x9a, 0xd6, 0xfa, 0xb9, 0x71, 0x7d, 0x3f, 0xe8, 0x70, 0x06,
-	0xb9, 0x69, 0x90, 0x1f, 0x2c, 0x44, 0xb6, 0x20, 0x55, 0xe6, 0xdd, 0xdf, 0x4d, 0xb8, 0x62, 0x98,
-	0xd1, 0x67, 0x00, 0x61, 0xd9, 0x13, 0xed, 0xd5, 0x93, 0xd5, 0x6e, 0x85, 0xd7, 0x59, 0x20, 0x9a,
-	0xff, 0xe5, 0xe0, 0xe0, 0xdd, 0xb7, 0x5f, 0x1f, 0x9a, 0x4f, 0xd1, 0x13, 0xb2, 0xc4, 0x66, 0x92,
-	0x73, 0xb3, 0x4d, 0x17, 0xe4, 0xdc, 0xae, 0xcf, 0x05, 0xfa, 0x08, 0xe0, 0xda, 0xcc, 0xb8, 0x17,
-	0x82, 0xff, 0x6f, 0x05, 0xbc, 0xfd, 0xa5, 0xc1, 0x2b, 0x3f, 0x1a, 0x3c, 0x36, 0xec, 0x5b, 0xa8,
-	0xbd, 0x0c, 0x7b, 0xef, 0xd5, 0xe5, 0xc8, 0x07, 0x57, 0x23, 0x1f, 0xfc, 0x1c, 0xf9, 0xe0, 0xfd,
-	0xd8, 0x6f, 0x5c, 0x8d, 0xfd, 0xc6, 0xf7, 0xb1, 0xdf, 0x78, 0x7d, 0xc0, 0x13, 0x7d, 0x5c, 0x44,
-	0x38, 0x96, 0x83, 0xd9, 0x4a, 0xa7, 0xfb, 0x3b, 0xf1, 0x31, 0x4d, 0x04, 0x99, 0xbe, 0x9c, 0xcd,
-	0x54, 0xd7, 0xc3, 0x8c, 0xa9, 0x68, 0xd5, 0x44, 0xf7, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3a,
-	0x73, 0x87, 0x23, 0x97, 0x04, 0x00, 0x00,
+	// 812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4b, 0x6f, 0xe3, 0x44,
+	0x1c, 0xcf, 0xa4, 0x0f, 0xc4, 0x34, 0xe1, 0x30, 0xaa, 0xda, 0xc4, 0x42, 0x69, 0x09, 0x25, 0x14,
+	0x44, 0x6d, 0xf5, 0xc1, 0x43, 0x82, 0x40, 0x93, 0x4a, 0x2d, 0xe1, 0x00, 0xc5, 0x39, 0x54, 0xe2,
+	0x62, 0xf9, 0x31, 0x72, 0x2c, 0x39, 0x33, 0xae, 0xc7, 0x69, 0x1b, 0xaa, 0x72, 0xe0, 0x0b, 0x80,
+	0xc4, 0x97, 0xe0, 0x8a, 0x40, 0xe2, 0x03, 0x20, 0x41, 0x8f, 0x15, 0x7b, 0x59, 0xad, 0xb4, 0x55,
+	0xd5, 0xee, 0x7e, 0x8f, 0x55, 0xc6, 0x93, 0xd8, 0x4e, 0x93, 0x8d, 0x37, 0xea, 0x65, 0x6f, 0xf6,
+	0xcc, 0xff, 0x3f, 0xbf, 0x87, 0xc7, 0xbf, 0x3f, 0x5c, 0xb3, 0xba, 0xd6, 0x99, 0xe7, 0xd3, 0x80,
+	0x9a, 0xd4, 0x55, 0x58, 0xc7, 0xd0, 0x4d, 0x93, 0x76, 0x48, 0xc0, 0x94, 0xe3, 0x0e, 0xf6, 0xbb,
+	0x32, 0xdf, 0x42, 0x85, 0x78, 0x95, 0x1c, 0xab, 0x92, 0x8a, 0x26, 0x65, 0x6d, 0xca, 0x34, 0xbe,
+	0xa9, 0x84, 0x2f, 0x61, 0x93, 0xb4, 0x68, 0x53, 0x9b, 0x86, 0xeb, 0xbd, 0x27, 0xb1, 0xfa, 0xb6,
+	0x4d, 0xa9, 0xed, 0x62, 0x45, 0xf7, 0x1c, 0x45, 0x27, 0x84, 0x06, 0x7a, 0xe0, 0x50, 0xd2, 0xef,
+	0xf9, 0x30, 0x3c, 0x41, 0x31, 0x74, 0x86, 0x43, 0x06, 0xca, 0xc9, 0xa6, 0x81, 0x03, 0x7d, 0x53,
+	0xf1, 0x74, 0xdb, 0x21, 0xbc, 0x58, 0xd4, 0x7e, 0x30, 0x96, 0x7a, 0xf4, 0x1c, 0x96, 0x96, 0x4d,
+	0x58, 0xfc, 0xbe, 0x77, 0xd8, 0x01, 0x0e, 0x9a, 0x83, 0x3d, 0x15, 0x1f, 0x77, 0x30, 0x0b, 0x90,
+	0x0c, 0xe7, 0xe8, 0x29, 0xc1, 0x7e, 0x01, 0xac, 0x82, 0xf5, 0x37, 0xeb, 0x85, 0xff, 0xff, 0xda,
+	0x58, 0x14, 0x42, 0x6a, 0x96, 0xe5, 0x63, 0xc6, 0x9a, 0x81, 0xef, 0x10, 0x5b, 0x0d, 0xcb, 0xd0,
+	0x12, 0x9c, 0x27, 0x9d, 0xb6, 0x81, 0xfd, 0x42, 0x76, 0x15, 0xac, 0xe7, 0x55, 0xf1, 0x56, 0xc6,
+	0x70, 0x99, 0x83, 0xc4, 0x11, 0x98, 0x47, 0x09, 0xc3, 0xe8, 0x1b, 0x08, 0x23, 0x4e, 0x1c, 0x67,
+	0x61, 0x6b, 0x4d, 0x1e, 0x67, 0xaa, 0x1c, 0x9d, 0x50, 0x9f, 0xbd, 0xbc, 0x5e, 0xc9, 0xa8, 0xb1,
+	0xee, 0x81, 0x96, 0x9a, 0xeb, 0xde, 0xd7, 0xb2, 0x0f, 0x61, 0xe4, 0x93, 0x00, 0xaa, 0xc8, 0x42,
+	0x4d, 0xcf, 0x54, 0x39, 0xfc, 0xac, 0xc2, 0x54, 0xf9, 0x50, 0xb7, 0xb1, 0xe8, 0x55, 0x63, 0x9d,
+	0xe5, 0x3f, 0x00, 0x94, 0x86, 0xc4, 0xd4, 0x5c, 0x77, 0xac, 0x9e, 0x99, 0xe9, 0xf5, 0xa0, 0x83,
+	0x04, 0xe5, 0x2c, 0xa7, 0xfc, 0xfe, 0x44, 0xca, 0x21, 0x91, 0x04, 0xe7, 0x5f, 0x00, 0x2c, 0x0f,
+	0x71, 0x66, 0xf5, 0xee, 0x21, 0xf6, 0x3d, 0x1c, 0x74, 0x74, 0xb7, 0x6f, 0xd1, 0x3b, 0x30, 0xe7,
+	0xf5, 0xd7, 0x34, 0xc7, 0xe2, 0x26, 0xe5, 0xd5, 0x85, 0xc1, 0x5a, 0xc3, 0x42, 0xfb, 0x23, 0x28,
+	0x4d, 0xe3, 0xe2, 0x3f, 0x00, 0xbe, 0xfb, 0x52, 0x46, 0xc2, 0xce, 0x26, 0x7c, 0x2b, 0x32, 0x44,
+	0x73, 0x2c, 0x26, 0x2c, 0xad, 0xa4, 0xb1, 0xb4, 0x61, 0x09, 0x53, 0xf3, 0x2c, 0xb6, 0xc6, 0x1e,
+	0xce, 0x57, 0x0a, 0xdf, 0x1b, 0x16, 0x71, 0xe4, 0x04, 0xad, 0x3a, 0xf5, 0x7d, 0x7a, 0xea, 0x10,
+	0x9b, 0x3d, 0xf4, 0xe5, 0xfb, 0x17, 0xc0, 0xca, 0x24, 0xc4, 0xd7, 0xc2, 0xb9, 0x2a, 0x2c, 0x70,
+	0x1d, 0xdf, 0x79, 0x98, 0x34, 0x48, 0x80, 0xfd, 0x9e, 0xd2, 0xd4, 0xd7, 0xb0, 0xfc, 0x5f, 0x16,
+	0x16, 0x47, 0xf4, 0x0b, 0xe9, 0x6d, 0x98, 0xa7, 0x1e, 0x26, 0x9a, 0x23, 0x36, 0xf8, 0x09, 0xb9,
+	0xfa, 0xd7, 0x3d, 0x45, 0x4f, 0xae, 0x57, 0x76, 0x6d, 0x27, 0x68, 0x75, 0x0c, 0xd9, 0xa4, 0x6d,
+	0x25, 0x11, 0x94, 0x27, 0x3b, 0x1b, 0x66, 0x4b, 0x77, 0x88, 0x32, 0x58, 0xb1, 0x82, 0xae, 0x87,
+	0x99, 0xdc, 0xc4, 0xbe, 0xa3, 0xbb, 0xce, 0x8f, 0xba, 0xe1, 0xe2, 0x06, 0x09, 0xd4, 0x1c, 0x8d,
+	0xc1, 0xa2, 0x9f, 0xe0, 0x52, 0x02, 0x4e, 0x23, 0xb4, 0xa7, 0x51, 0x77, 0x0b, 0xd9, 0x07, 0xc6,
+	0x5d, 0x8c, 0xe3, 0x7e, 0x2b, 0x50, 0xd0, 0xe7, 0x50, 0x4a, 0xe2, 0x9b, 0xba, 0x17, 0x71, 0x98,
+	0x59, 0x05, 0xeb, 0xb3, 0xea, 0x72, 0xbc, 0x73, 0x4f, 0xf7, 0xfa, 0xcd, 0x5b, 0xcf, 0xdf, 0x80,
+	0x73, 0xdc, 0x49, 0xf4, 0x27, 0x80, 0x30, 0xba, 0x01, 0x68, 0x7b, 0xfc, 0x3d, 0x19, 0x3b, 0x30,
+	0xa4, 0xcd, 0x09, 0x4d, 0xf7, 0x07, 0x40, 0xb9, 0xfa, 0xf3, 0xa3, 0x67, 0xbf, 0x65, 0x3f, 0x45,
+	0x1f, 0x2b, 0x29, 0x86, 0x96, 0x72, 0xce, 0x07, 0xcd, 0x85, 0x72, 0x1e, 0x4e, 0x96, 0x0b, 0xf4,
+	0x3b, 0x80, 0xf9, 0x44, 0x12, 0x4f, 0x24, 0x3e, 0x6a, 0x3a, 0x48, 0x3b, 0xa9, 0x89, 0xc7, 0xc2,
+	0xbe, 0xfc, 0x11, 0xe7, 0x5e, 0x41, 0x6b, 0x69, 0xb8, 0xa3, 0x1b, 0x00, 0x97, 0x46, 0xc7, 0x1d,
+	0xfa, 0x22, 0x35, 0xfc, 0x88, 0xdc, 0x96, 0xaa, 0x53, 0x76, 0x0b, 0x15, 0x0d, 0xae, 0x62, 0x0f,
+	0xd5, 0xd2, 0xa8, 0x60, 0x9a, 0xd1, 0xd5, 0x06, 0xbf, 0xa2, 0x72, 0x1e, 0xff, 0x53, 0x2f, 0xd0,
+	0x53, 0x00, 0x8b, 0x63, 0xa3, 0x09, 0x7d, 0x95, 0x9e, 0xe7, 0xc8, 0x18, 0x95, 0x76, 0xa7, 0x3f,
+	0x60, 0x9a, 0xdb, 0xc6, 0xb4, 0x53, 0x27, 0x68, 0x69, 0x46, 0xa4, 0xe0, 0x6f, 0x00, 0x73, 0xf1,
+	0xc8, 0x41, 0x5b, 0x13, 0x18, 0x8d, 0xc8, 0x37, 0x69, 0xfb, 0x95, 0x7a, 0x04, 0xf1, 0x2f, 0x39,
+	0xf1, 0xcf, 0xd0, 0x27, 0xe3, 0x89, 0x27, 0x42, 0x60, 0xe8, 0xcb, 0xd4, 0x8f, 0x2e, 0x6f, 0x4b,
+	0xe0, 0xea, 0xb6, 0x04, 0x6e, 0x6e, 0x4b, 0xe0, 0xd7, 0xbb, 0x52, 0xe6, 0xea, 0xae, 0x94, 0x79,
+	0x7c, 0x57, 0xca, 0xfc, 0x50, 0x4d, 0x1f, 0x4b, 0x67, 0x09, 0x3c, 0x9e, 0x51, 0xc6, 0x3c, 0xdf,
+	0xdd, 0x7e, 0x31, 0x00, 0x77, 0x10, 0x39, 0x8e, 0x2f, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -289,6 +624,12 @@ type QueryClient interface {
 	Subaccount(ctx context.Context, in *QueryGetSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountResponse, error)
 	// Queries a list of Subaccount items.
 	SubaccountAll(ctx context.Context, in *QueryAllSubaccountRequest, opts ...grpc.CallOption) (*QuerySubaccountAllResponse, error)
+	// Queries the IDs of all subaccounts with an open position in a perpetual.
+	SubaccountsByPerpetual(ctx context.Context, in *QuerySubaccountsByPerpetualRequest, opts ...grpc.CallOption) (*QuerySubaccountsByPerpetualResponse, error)
+	// Queries the IDs of all subaccounts with a negative asset position.
+	SubaccountsWithBorrowings(ctx context.Context, in *QuerySubaccountsWithBorrowingsRequest, opts ...grpc.CallOption) (*QuerySubaccountsWithBorrowingsResponse, error)
+	// Queries the open interest of a perpetual.
+	OpenInterest(ctx context.Context, in *QueryOpenInterestRequest, opts ...grpc.CallOption) (*QueryOpenInterestResponse, error)
 }
 
 type queryClient struct {
@@ -317,12 +658,45 @@ func (c *queryClient) SubaccountAll(ctx context.Context, in *QueryAllSubaccountR
 	return out, nil
 }
 
+func (c *queryClient) SubaccountsByPerpetual(ctx context.Context, in *QuerySubaccountsByPerpetualRequest, opts ...grpc.CallOption) (*QuerySubaccountsByPerpetualResponse, error) {
+	out := new(QuerySubaccountsByPerpetualResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/SubaccountsByPerpetual", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubaccountsWithBorrowings(ctx context.Context, in *QuerySubaccountsWithBorrowingsRequest, opts ...grpc.CallOption) (*QuerySubaccountsWithBorrowingsResponse, error) {
+	out := new(QuerySubaccountsWithBorrowingsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/SubaccountsWithBorrowings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OpenInterest(ctx context.Context, in *QueryOpenInterestRequest, opts ...grpc.CallOption) (*QueryOpenInterestResponse, error) {
+	out := new(QueryOpenInterestResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/OpenInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a Subaccount by id
 	Subaccount(context.Context, *QueryGetSubaccountRequest) (*QuerySubaccountResponse, error)
 	// Queries a list of Subaccount items.
 	SubaccountAll(context.Context, *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error)
+	// Queries the IDs of all subaccounts with an open position in a perpetual.
+	SubaccountsByPerpetual(context.Context, *QuerySubaccountsByPerpetualRequest) (*QuerySubaccountsByPerpetualResponse, error)
+	// Queries the IDs of all subaccounts with a negative asset position.
+	SubaccountsWithBorrowings(context.Context, *QuerySubaccountsWithBorrowingsRequest) (*QuerySubaccountsWithBorrowingsResponse, error)
+	// Queries the open interest of a perpetual.
+	OpenInterest(context.Context, *QueryOpenInterestRequest) (*QueryOpenInterestResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -335,6 +709,15 @@ func (*UnimplementedQueryServer) Subaccount(ctx context.Context, req *QueryGetSu
 func (*UnimplementedQueryServer) SubaccountAll(ctx context.Context, req *QueryAllSubaccountRequest) (*QuerySubaccountAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubaccountAll not implemented")
 }
+func (*UnimplementedQueryServer) SubaccountsByPerpetual(ctx context.Context, req *QuerySubaccountsByPerpetualRequest) (*QuerySubaccountsByPerpetualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountsByPerpetual not implemented")
+}
+func (*UnimplementedQueryServer) SubaccountsWithBorrowings(ctx context.Context, req *QuerySubaccountsWithBorrowingsRequest) (*QuerySubaccountsWithBorrowingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountsWithBorrowings not implemented")
+}
+func (*UnimplementedQueryServer) OpenInterest(ctx context.Context, req *QueryOpenInterestRequest) (*QueryOpenInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenInterest not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -376,6 +759,60 @@ func _Query_SubaccountAll_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SubaccountsByPerpetual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountsByPerpetualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountsByPerpetual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/SubaccountsByPerpetual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountsByPerpetual(ctx, req.(*QuerySubaccountsByPerpetualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubaccountsWithBorrowings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountsWithBorrowingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountsWithBorrowings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/SubaccountsWithBorrowings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountsWithBorrowings(ctx, req.(*QuerySubaccountsWithBorrowingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpenInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpenInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/OpenInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenInterest(ctx, req.(*QueryOpenInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.subaccounts.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -388,6 +825,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubaccountAll",
 			Handler:    _Query_SubaccountAll_Handler,
 		},
+		{
+			MethodName: "SubaccountsByPerpetual",
+			Handler:    _Query_SubaccountsByPerpetual_Handler,
+		},
+		{
+			MethodName: "SubaccountsWithBorrowings",
+			Handler:    _Query_SubaccountsWithBorrowings_Handler,
+		},
+		{
+			MethodName: "OpenInterest",
+			Handler:    _Query_OpenInterest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/subaccounts/query.proto",
@@ -545,15 +994,264 @@ func (m *QuerySubaccountAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySubaccountsByPerpetualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountsByPerpetualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountsByPerpetualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountsByPerpetualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountsByPerpetualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountsByPerpetualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubaccountIds) > 0 {
+		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountsWithBorrowingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountsWithBorrowingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountsWithBorrowingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountsWithBorrowingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountsWithBorrowingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountsWithBorrowingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubaccountIds) > 0 {
+		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenInterestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenInterestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenInterestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenInterestCapNotional != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OpenInterestCapNotional))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.OpenInterestNotional.Size()
+		i -= size
+		if _, err := m.OpenInterestNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.OpenInterest.Size()
+		i -= size
+		if _, err := m.OpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *QueryGetSubaccountRequest) Size() (n int) {
@@ -608,20 +1306,610 @@ func (m *QuerySubaccountAllResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubaccountsByPerpetualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubaccountsByPerpetualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for _, e := range m.SubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubaccountsWithBorrowingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubaccountsWithBorrowingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for _, e := range m.SubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenInterestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryOpenInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OpenInterest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OpenInterestNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OpenInterestCapNotional != 0 {
+		n += 1 + sovQuery(uint64(m.OpenInterestCapNotional))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSubaccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSubaccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subaccount = append(m.Subaccount, Subaccount{})
+			if err := m.Subaccount[len(m.Subaccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountsByPerpetualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountsByPerpetualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountsByPerpetualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountsByPerpetualResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -644,17 +1932,17 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSubaccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountsByPerpetualResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountsByPerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -664,29 +1952,31 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.SubaccountIds = append(m.SubaccountIds, SubaccountId{})
+			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Number = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -696,11 +1986,28 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -722,7 +2029,7 @@ func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountsWithBorrowingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -745,15 +2052,15 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubaccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountsWithBorrowingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubaccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountsWithBorrowingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -780,7 +2087,10 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subaccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -805,7 +2115,7 @@ func (m *QuerySubaccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountsWithBorrowingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -828,13 +2138,47 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSubaccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountsWithBorrowingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSubaccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountsWithBorrowingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountIds = append(m.SubaccountIds, SubaccountId{})
+			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -864,7 +2208,7 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -891,7 +2235,7 @@ func (m *QueryAllSubaccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOpenInterestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -914,17 +2258,86 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubaccountAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOpenInterestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubaccountAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOpenInterestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenInterestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenInterestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subaccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterest", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -934,31 +2347,30 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subaccount = append(m.Subaccount, Subaccount{})
-			if err := m.Subaccount[len(m.Subaccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestNotional", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -968,28 +2380,44 @@ func (m *QuerySubaccountAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenInterestNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestCapNotional", wireType)
+			}
+			m.OpenInterestCapNotional = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenInterestCapNotional |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
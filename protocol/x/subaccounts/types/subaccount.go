@@ -63,6 +63,59 @@ func (m *Subaccount) GetPerpetualPositionForId(
 	return nil, false
 }
 
+// GetIsolatedMarginForId returns the isolated margin of the subaccount's position
+// with the given perpetual id. Returns nil if the perpetual is not isolated for
+// the subaccount.
+func (m *Subaccount) GetIsolatedMarginForId(
+	perpetualId uint32,
+) (
+	isolatedMargin *IsolatedMargin,
+	exists bool,
+) {
+	if m != nil {
+		for _, margin := range m.IsolatedMargins {
+			if margin.PerpetualId == perpetualId {
+				return margin, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// GetAssetPositionForId returns the asset position with the given asset id.
+// Returns nil if subaccount does not have a position for the asset.
+func (m *Subaccount) GetAssetPositionForId(
+	assetId uint32,
+) (
+	assetPosition *AssetPosition,
+	exists bool,
+) {
+	if m != nil {
+		for _, position := range m.AssetPositions {
+			if position.AssetId == assetId {
+				return position, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// GetBorrowedAssetPosition returns the first non-USDC asset position with a
+// negative balance. Returns nil if subaccount does not borrow any asset.
+func (m *Subaccount) GetBorrowedAssetPosition() (
+	assetPosition *AssetPosition,
+	exists bool,
+) {
+	if m != nil {
+		for _, position := range m.AssetPositions {
+			if position.AssetId != assettypes.AssetUsdc.Id && position.GetBigQuantums().Sign() < 0 {
+				return position, true
+			}
+		}
+	}
+	return nil, false
+}
+
 // GetUsdcPosition returns the balance of the USDC asset position.
 func (m *Subaccount) GetUsdcPosition() *big.Int {
 	usdcAssetPosition := m.getUsdcAssetPosition()
@@ -72,6 +125,18 @@ func (m *Subaccount) GetUsdcPosition() *big.Int {
 	return usdcAssetPosition.GetBigQuantums()
 }
 
+// GetTotalIsolatedQuoteBalance returns the part of the USDC asset position that is
+// allotted to isolated positions.
+func (m *Subaccount) GetTotalIsolatedQuoteBalance() *big.Int {
+	total := new(big.Int)
+	if m != nil {
+		for _, margin := range m.IsolatedMargins {
+			total.Add(total, margin.GetBigQuoteBalance())
+		}
+	}
+	return total
+}
+
 // SetUsdcAssetPosition sets the balance of the USDC asset position to `newUsdcPosition`.
 func (m *Subaccount) SetUsdcAssetPosition(newUsdcPosition *big.Int) {
 	if m == nil {
@@ -93,6 +93,11 @@ type Subaccount struct {
 	// Set by the owner. If true, then margin trades can be made in this
 	// subaccount.
 	MarginEnabled bool `protobuf:"varint,4,opt,name=margin_enabled,json=marginEnabled,proto3" json:"margin_enabled,omitempty"`
+	// The `IsolatedMargin`s of the isolated-margin perpetual positions of this
+	// subaccount. A perpetual is isolated for as long as it has an entry here,
+	// even if the subaccount has no open position in it.
+	// Always sorted ascending by `perpetual_id`.
+	IsolatedMargins []*IsolatedMargin `protobuf:"bytes,5,rep,name=isolated_margins,json=isolatedMargins,proto3" json:"isolated_margins,omitempty"`
 }
 
 func (m *Subaccount) Reset()         { *m = Subaccount{} }
@@ -156,6 +161,13 @@ func (m *Subaccount) GetMarginEnabled() bool {
 	return false
 }
 
+func (m *Subaccount) GetIsolatedMargins() []*IsolatedMargin {
+	if m != nil {
+		return m.IsolatedMargins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SubaccountId)(nil), "dydxprotocol.subaccounts.SubaccountId")
 	proto.RegisterType((*Subaccount)(nil), "dydxprotocol.subaccounts.Subaccount")
@@ -166,30 +178,31 @@ func init() {
 }
 
 var fileDescriptor_5a7b1af2704a634c = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0x9b, 0x54, 0x8b, 0x6e, 0x6d, 0x85, 0x55, 0x24, 0xf6, 0x10, 0x42, 0x41, 0x8d, 0x48,
-	0x13, 0xac, 0xe2, 0xcd, 0x43, 0x0b, 0x1e, 0xbc, 0x95, 0x14, 0x14, 0x44, 0x28, 0x49, 0x76, 0x69,
-	0x17, 0x9a, 0xdd, 0x90, 0xd9, 0x68, 0xfb, 0x16, 0xde, 0x7d, 0x0d, 0x1f, 0xc2, 0x63, 0xf1, 0xe4,
-	0x51, 0xda, 0x17, 0x11, 0x93, 0x58, 0xd3, 0x4a, 0x6e, 0x3b, 0xff, 0xfc, 0xff, 0xc7, 0xec, 0x0c,
-	0x3a, 0x25, 0x53, 0x32, 0x09, 0x23, 0x21, 0x85, 0x2f, 0xc6, 0x36, 0xc4, 0x9e, 0xeb, 0xfb, 0x22,
-	0xe6, 0x12, 0x72, 0x6f, 0x2b, 0xe9, 0x63, 0x2d, 0x6f, 0xb5, 0x72, 0xd6, 0xc6, 0xa1, 0x2f, 0x20,
-	0x10, 0x30, 0x48, 0x9a, 0x76, 0x5a, 0xa4, 0xa1, 0x46, 0xab, 0x90, 0xef, 0x02, 0x50, 0x39, 0x08,
-	0x05, 0x30, 0xc9, 0x04, 0xcf, 0xec, 0xe7, 0x85, 0xf6, 0x90, 0x46, 0x21, 0x95, 0xb1, 0x3b, 0x5e,
-	0x8b, 0x34, 0xef, 0xd0, 0x4e, 0x7f, 0xe9, 0xbb, 0x25, 0xd8, 0x42, 0x9b, 0xe2, 0x99, 0xd3, 0x48,
-	0x53, 0x0c, 0xc5, 0xdc, 0xee, 0x6a, 0x1f, 0x6f, 0xad, 0xfd, 0x6c, 0xa4, 0x0e, 0x21, 0x11, 0x05,
-	0xe8, 0xcb, 0x88, 0xf1, 0xa1, 0x93, 0xda, 0xf0, 0x01, 0xaa, 0xf0, 0x38, 0xf0, 0x68, 0xa4, 0xa9,
-	0x86, 0x62, 0xd6, 0x9c, 0xac, 0x6a, 0xbe, 0xaa, 0x08, 0xfd, 0x81, 0xf1, 0x15, 0x52, 0x19, 0x49,
-	0x98, 0xd5, 0xf6, 0xb1, 0x55, 0xb4, 0x0a, 0x2b, 0x3f, 0x8a, 0xa3, 0x32, 0x82, 0x7b, 0x68, 0x77,
-	0xf5, 0xa7, 0xa0, 0xa9, 0x46, 0xd9, 0xac, 0xb6, 0x4f, 0x8a, 0x21, 0x9d, 0x9f, 0x40, 0x2f, 0xf3,
-	0x3b, 0x75, 0x37, 0x5f, 0x02, 0x7e, 0x44, 0x7b, 0xff, 0x97, 0x01, 0x5a, 0x39, 0xa1, 0x9e, 0x15,
-	0x53, 0x7b, 0xbf, 0xa1, 0x25, 0x19, 0x87, 0xeb, 0x12, 0xe0, 0x23, 0x54, 0x0f, 0xdc, 0x68, 0xc8,
-	0xf8, 0x80, 0x72, 0xd7, 0x1b, 0x53, 0xa2, 0x6d, 0x18, 0x8a, 0xb9, 0xe5, 0xd4, 0x52, 0xf5, 0x26,
-	0x15, 0xbb, 0xf7, 0xef, 0x73, 0x5d, 0x99, 0xcd, 0x75, 0xe5, 0x6b, 0xae, 0x2b, 0x2f, 0x0b, 0xbd,
-	0x34, 0x5b, 0xe8, 0xa5, 0xcf, 0x85, 0x5e, 0x7a, 0xb8, 0x1e, 0x32, 0x39, 0x8a, 0x3d, 0xcb, 0x17,
-	0x81, 0xbd, 0x72, 0xcd, 0xa7, 0xcb, 0x96, 0x3f, 0x72, 0x19, 0xb7, 0x97, 0xca, 0x64, 0xe5, 0xc2,
-	0x72, 0x1a, 0x52, 0xf0, 0x2a, 0x49, 0xf7, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x62, 0x2c, 0xfc,
-	0xe7, 0x99, 0x02, 0x00, 0x00,
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcf, 0xea, 0xd3, 0x30,
+	0x1c, 0x5f, 0x3b, 0x37, 0x34, 0x73, 0x9b, 0x44, 0x91, 0xb8, 0x43, 0x29, 0x03, 0xb5, 0x22, 0x6b,
+	0x71, 0x8a, 0x37, 0x0f, 0x1b, 0x78, 0xd8, 0x41, 0x18, 0x1d, 0x28, 0x88, 0x50, 0xd2, 0x26, 0x6c,
+	0x81, 0x36, 0x29, 0xfd, 0xa6, 0xba, 0xbd, 0x85, 0x0f, 0xe3, 0x43, 0x78, 0x1c, 0x9e, 0x3c, 0xca,
+	0xf6, 0x02, 0x3e, 0x82, 0xd8, 0xd6, 0xd9, 0xee, 0x47, 0x6f, 0xfd, 0xfc, 0xe5, 0xd3, 0xf0, 0x45,
+	0xcf, 0xd8, 0x81, 0xed, 0xd3, 0x4c, 0x69, 0x15, 0xa9, 0xd8, 0x83, 0x3c, 0xa4, 0x51, 0xa4, 0x72,
+	0xa9, 0xa1, 0xf6, 0xed, 0x16, 0x3a, 0x26, 0x75, 0xab, 0x5b, 0xb3, 0x4e, 0x1e, 0x45, 0x0a, 0x12,
+	0x05, 0x41, 0x21, 0x7a, 0x25, 0x28, 0x43, 0x93, 0x59, 0x6b, 0x3f, 0x05, 0xe0, 0x3a, 0x48, 0x15,
+	0x08, 0x2d, 0x94, 0xac, 0xec, 0x2f, 0x5a, 0xed, 0x29, 0xcf, 0x52, 0xae, 0x73, 0x1a, 0x5f, 0x45,
+	0xa6, 0xef, 0xd1, 0xdd, 0xcd, 0xc5, 0xb7, 0x62, 0xd8, 0x45, 0x3d, 0xf5, 0x45, 0xf2, 0x8c, 0x18,
+	0xb6, 0xe1, 0xdc, 0x59, 0x92, 0x1f, 0xdf, 0x66, 0x0f, 0xaa, 0x49, 0x0b, 0xc6, 0x32, 0x0e, 0xb0,
+	0xd1, 0x99, 0x90, 0x5b, 0xbf, 0xb4, 0xe1, 0x87, 0xa8, 0x2f, 0xf3, 0x24, 0xe4, 0x19, 0x31, 0x6d,
+	0xc3, 0x19, 0xfa, 0x15, 0x9a, 0xfe, 0x36, 0x11, 0xfa, 0x5f, 0x8c, 0x5f, 0x23, 0x53, 0xb0, 0xa2,
+	0x73, 0x30, 0x7f, 0xe2, 0xb6, 0x3d, 0x85, 0x5b, 0x9f, 0xe2, 0x9b, 0x82, 0xe1, 0x35, 0x1a, 0x37,
+	0xff, 0x14, 0x88, 0x69, 0x77, 0x9d, 0xc1, 0xfc, 0x69, 0x7b, 0xc9, 0xe2, 0x6f, 0x60, 0x5d, 0xf9,
+	0xfd, 0x11, 0xad, 0x43, 0xc0, 0x9f, 0xd0, 0xfd, 0x9b, 0x8f, 0x01, 0xa4, 0x5b, 0xb4, 0x3e, 0x6f,
+	0x6f, 0x5d, 0xff, 0x0b, 0x5d, 0x9a, 0x71, 0x7a, 0x4d, 0x01, 0x7e, 0x8c, 0x46, 0x09, 0xcd, 0xb6,
+	0x42, 0x06, 0x5c, 0xd2, 0x30, 0xe6, 0x8c, 0xdc, 0xb2, 0x0d, 0xe7, 0xb6, 0x3f, 0x2c, 0xd9, 0xb7,
+	0x25, 0x89, 0x37, 0xe8, 0x9e, 0x00, 0x15, 0x53, 0xcd, 0x59, 0x50, 0x2a, 0x40, 0x7a, 0xc5, 0x02,
+	0xa7, 0x7d, 0xc1, 0xaa, 0x4a, 0xbc, 0x2b, 0x02, 0xfe, 0x58, 0x34, 0x30, 0x2c, 0x3f, 0x7c, 0x3f,
+	0x59, 0xc6, 0xf1, 0x64, 0x19, 0xbf, 0x4e, 0x96, 0xf1, 0xf5, 0x6c, 0x75, 0x8e, 0x67, 0xab, 0xf3,
+	0xf3, 0x6c, 0x75, 0x3e, 0xbe, 0xd9, 0x0a, 0xbd, 0xcb, 0x43, 0x37, 0x52, 0x89, 0xd7, 0x38, 0x91,
+	0xcf, 0xaf, 0x66, 0xd1, 0x8e, 0x0a, 0xe9, 0x5d, 0x98, 0x7d, 0xe3, 0x6c, 0xf4, 0x21, 0xe5, 0x10,
+	0xf6, 0x0b, 0xf5, 0xe5, 0x9f, 0x01, 0x00, 0x6c, 0x62, 0x1b, 0x7c, 0xee, 0x02, 0x00, 0x00,
 }
 
 func (m *SubaccountId) Marshal() (dAtA []byte, err error) {
@@ -247,6 +260,20 @@ func (m *Subaccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IsolatedMargins) > 0 {
+		for iNdEx := len(m.IsolatedMargins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolatedMargins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubaccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.MarginEnabled {
 		i--
 		if m.MarginEnabled {
@@ -352,6 +379,12 @@ func (m *Subaccount) Size() (n int) {
 	if m.MarginEnabled {
 		n += 2
 	}
+	if len(m.IsolatedMargins) > 0 {
+		for _, e := range m.IsolatedMargins {
+			l = e.Size()
+			n += 1 + l + sovSubaccount(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -615,6 +648,40 @@ func (m *Subaccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.MarginEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedMargins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolatedMargins = append(m.IsolatedMargins, &IsolatedMargin{})
+			if err := m.IsolatedMargins[len(m.IsolatedMargins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubaccount(dAtA[iNdEx:])
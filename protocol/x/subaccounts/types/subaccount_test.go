@@ -143,6 +143,90 @@ func TestSubaccountGetPerpetualPositionForId(t *testing.T) {
 	require.Nil(t, position)
 }
 
+func TestSubaccountGetAssetPositionForId(t *testing.T) {
+	expectedAssetPositions := []*types.AssetPosition{
+		{
+			AssetId:  0,
+			Quantums: dtypes.NewInt(100),
+		},
+		{
+			AssetId:  1,
+			Quantums: dtypes.NewInt(-100),
+		},
+	}
+	subaccount := types.Subaccount{
+		AssetPositions: expectedAssetPositions,
+	}
+
+	position, exists := subaccount.GetAssetPositionForId(0)
+	require.True(t, exists)
+	require.Equal(t, expectedAssetPositions[0], position)
+
+	position, exists = subaccount.GetAssetPositionForId(1)
+	require.True(t, exists)
+	require.Equal(t, expectedAssetPositions[1], position)
+
+	position, exists = subaccount.GetAssetPositionForId(2)
+	require.False(t, exists)
+	require.Nil(t, position)
+}
+
+func TestSubaccountGetBorrowedAssetPosition(t *testing.T) {
+	tests := map[string]struct {
+		assetPositions   []*types.AssetPosition
+		expectedPosition *types.AssetPosition
+	}{
+		"no asset positions": {
+			assetPositions:   nil,
+			expectedPosition: nil,
+		},
+		"negative USDC is not a borrowed asset": {
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  assettypes.AssetUsdc.Id,
+					Quantums: dtypes.NewInt(-100),
+				},
+			},
+			expectedPosition: nil,
+		},
+		"positive asset is not borrowed": {
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+			expectedPosition: nil,
+		},
+		"returns first borrowed asset": {
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+				{
+					AssetId:  2,
+					Quantums: dtypes.NewInt(-100),
+				},
+				{
+					AssetId:  3,
+					Quantums: dtypes.NewInt(-200),
+				},
+			},
+			expectedPosition: &types.AssetPosition{
+				AssetId:  2,
+				Quantums: dtypes.NewInt(-100),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subaccount := types.Subaccount{
+				AssetPositions: tc.assetPositions,
+			}
+
+			position, exists := subaccount.GetBorrowedAssetPosition()
+			require.Equal(t, tc.expectedPosition != nil, exists)
+			require.Equal(t, tc.expectedPosition, position)
+		})
+	}
+}
+
 func TestGetSubaccountQuoteBalance(t *testing.T) {
 	tests := map[string]struct {
 		subaccount           *types.Subaccount
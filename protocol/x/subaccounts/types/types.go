@@ -35,6 +35,12 @@ type SubaccountsKeeper interface {
 		successPerUpdate []UpdateResult,
 		err error,
 	)
+	ForceUpdateSubaccounts(
+		ctx sdk.Context,
+		updates []Update,
+	) (
+		err error,
+	)
 	DepositFundsFromAccountToSubaccount(
 		ctx sdk.Context,
 		fromAccount sdk.AccAddress,
@@ -54,6 +54,9 @@ var updateResultStringMap = map[UpdateResult]string{
 	1: "NewlyUndercollateralized",
 	2: "StillUndercollateralized",
 	3: "UpdateCausedError",
+	4: "NegativeAssetBalance",
+	5: "ViolatesOpenInterestCap",
+	6: "ViolatesIsolatedMargin",
 }
 
 const (
@@ -61,6 +64,9 @@ const (
 	NewlyUndercollateralized
 	StillUndercollateralized
 	UpdateCausedError
+	NegativeAssetBalance
+	ViolatesOpenInterestCap
+	ViolatesIsolatedMargin
 )
 
 // Update is used by the subaccounts keeper to allow other modules
@@ -73,6 +79,9 @@ type Update struct {
 	AssetUpdates []AssetUpdate
 	// A list of changes to make to any `PerpetualPositions` in the `Subaccount`.
 	PerpetualUpdates []PerpetualUpdate
+	// A list of transfers of USDC collateral between the cross-margined collateral
+	// and the `IsolatedMargins` of the `Subaccount`.
+	IsolatedCollateralUpdates []IsolatedCollateralUpdate
 }
 
 type AssetUpdate struct {
@@ -89,3 +98,12 @@ type PerpetualUpdate struct {
 	// represented in base quantums.
 	BigQuantumsDelta *big.Int
 }
+
+type IsolatedCollateralUpdate struct {
+	// The `Id` of the `Perpetual` for which the `IsolatedMargin` is for.
+	PerpetualId uint32
+	// The signed change in the quote balance of the `IsolatedMargin`. Positive values
+	// move USDC from the cross-margined collateral into the isolated margin, negative
+	// values move it back.
+	BigQuantumsDelta *big.Int
+}
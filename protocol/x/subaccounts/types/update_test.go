@@ -88,8 +88,20 @@ func TestUpdateResultString(t *testing.T) {
 			value:          types.UpdateCausedError,
 			expectedResult: "UpdateCausedError",
 		},
+		"NegativeAssetBalance": {
+			value:          types.NegativeAssetBalance,
+			expectedResult: "NegativeAssetBalance",
+		},
+		"ViolatesOpenInterestCap": {
+			value:          types.ViolatesOpenInterestCap,
+			expectedResult: "ViolatesOpenInterestCap",
+		},
+		"ViolatesIsolatedMargin": {
+			value:          types.ViolatesIsolatedMargin,
+			expectedResult: "ViolatesIsolatedMargin",
+		},
 		"UnexpectedError": {
-			value:          types.UpdateResult(5),
+			value:          types.UpdateResult(7),
 			expectedResult: "UnexpectedError",
 		},
 	}